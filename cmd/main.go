@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"mceasy/configs"
 	"mceasy/configs/cache"
@@ -11,7 +12,10 @@ import (
 	"mceasy/configs/swagger"
 	"mceasy/configs/validator"
 	"mceasy/ent"
+	"mceasy/internal/adapter/cli"
+	"mceasy/internal/adapter/job"
 	restApi "mceasy/internal/adapter/rest"
+	"mceasy/internal/component/scheduler"
 	"mceasy/middleware"
 	"net/http"
 	"os"
@@ -69,12 +73,26 @@ func main() {
 		}
 	}()
 
+	//run a cli subcommand such as import-punches instead of the server when one is given:
+	if args := flag.Args(); len(args) > 0 {
+		if err := cli.RunCommand(context.Background(), args, dbConnection, redisConnection); err != nil {
+			log.Fatalf("command %s failed: %v", args[0], err)
+		}
+		return
+	}
+
 	//setup swagger:
 	swagger.InitSwagger()
 
 	//setup router
 	restApi.SetupRouteHandler(e, dbConnection, redisConnection)
 
+	//setup in-process scheduled jobs, stopped before the database connection is closed:
+	jobScheduler := scheduler.NewScheduler()
+	job.SetupScheduledJobs(jobScheduler, dbConnection, redisConnection)
+	jobScheduler.Start(context.Background())
+	defer jobScheduler.Stop()
+
 	port := viper.GetString("application.port")
 
 	// Start server
@@ -39,6 +39,7 @@ func setDefaultKeys() {
 	viper.SetDefault("application.port", 8080)
 	viper.SetDefault("application.health.url", "/health")
 	viper.SetDefault("application.mode", "dev")
+	viper.SetDefault("application.timezone", "Asia/Jakarta")
 
 	host := []string{"localhost", "https://labstack.com", "https://labstack.net"}
 	viper.SetDefault("application.cors.allowedHost", host)
@@ -56,6 +57,13 @@ func setDefaultKeys() {
 	viper.SetDefault("swagger.host", "localhost:8888")
 	viper.SetDefault("rabbitmq.configs.recovery", 30)
 
+	viper.SetDefault("scheduler.autoAbsent.enabled", true)
+	viper.SetDefault("scheduler.autoAbsent.interval", "15m")
+	viper.SetDefault("scheduler.anomalyDetection.enabled", true)
+	viper.SetDefault("scheduler.anomalyDetection.interval", "24h")
+	viper.SetDefault("scheduler.autoCheckOut.enabled", true)
+	viper.SetDefault("scheduler.autoCheckOut.interval", "1h")
+
 	viper.SetDefault("newrelic.name", "content-service-skypiea")
 	viper.SetDefault("newrelic.key", "key-new-relic")
 
@@ -4,6 +4,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
+	"github.com/shopspring/decimal"
 )
 
 type CustomValidator struct {
@@ -32,6 +33,9 @@ func NewValidator() *validator.Validate {
 		return nil
 	}
 
+	//register money amounts:
+	validate.RegisterCustomTypeFunc(DecimalValue, decimal.Decimal{})
+
 	return validate
 }
 
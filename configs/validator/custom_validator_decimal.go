@@ -0,0 +1,17 @@
+package validator
+
+import (
+	"reflect"
+
+	"github.com/shopspring/decimal"
+)
+
+// DecimalValue lets the numeric tags such as min and max validate decimal.Decimal amounts like numbers
+func DecimalValue(field reflect.Value) interface{} {
+	if amount, ok := field.Interface().(decimal.Decimal); ok {
+		value, _ := amount.Float64()
+		return value
+	}
+
+	return nil
+}
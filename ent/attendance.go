@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"mceasy/ent/attendance"
 	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/overtime"
 	"strings"
 	"time"
 
@@ -26,20 +29,44 @@ type Attendance struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Foreign key to employees table
 	EmployeeID uint64 `json:"employee_id,omitempty"`
-	// Date of attendance
+	// Date of the shift, overnight shifts keep the date they started on
 	AttendanceDate time.Time `json:"attendance_date,omitempty"`
-	// Actual check-in time
+	// First in punch of the day
 	CheckInTime time.Time `json:"check_in_time,omitempty"`
-	// Actual check-out time
+	// Last out punch of the day, empty while the employee is still in
 	CheckOutTime time.Time `json:"check_out_time,omitempty"`
-	// Status holds the value of the "status" field.
+	// leave and unpaid_leave are written by approved leave requests, remote and business_trip are worked away from the office
 	Status attendance.Status `json:"status,omitempty"`
-	// True for Saturday and Sunday
+	// Details of the status, e.g. the destination of a business trip or the sick note of a sick day
+	StatusMetadata map[string]string `json:"status_metadata,omitempty"`
+	// True when the date is a rest day in the employee's work schedule
 	IsWeekend bool `json:"is_weekend,omitempty"`
 	// Additional notes for attendance
 	Notes string `json:"notes,omitempty"`
 	// True if manually marked by admin
 	MarkedByAdmin bool `json:"marked_by_admin,omitempty"`
+	// CheckInLatitude holds the value of the "check_in_latitude" field.
+	CheckInLatitude *float64 `json:"check_in_latitude,omitempty"`
+	// CheckInLongitude holds the value of the "check_in_longitude" field.
+	CheckInLongitude *float64 `json:"check_in_longitude,omitempty"`
+	// Distance between the check-in and the assigned office
+	CheckInDistanceMeters *float64 `json:"check_in_distance_meters,omitempty"`
+	// CheckOutLatitude holds the value of the "check_out_latitude" field.
+	CheckOutLatitude *float64 `json:"check_out_latitude,omitempty"`
+	// CheckOutLongitude holds the value of the "check_out_longitude" field.
+	CheckOutLongitude *float64 `json:"check_out_longitude,omitempty"`
+	// Distance between the check-out and the assigned office
+	CheckOutDistanceMeters *float64 `json:"check_out_distance_meters,omitempty"`
+	// True when a punch was outside the office radius and needs review
+	LocationFlagged bool `json:"location_flagged,omitempty"`
+	// True when the check-out was recorded by the auto check-out job instead of the employee
+	AutoClosed bool `json:"auto_closed,omitempty"`
+	// Minutes worked derived from the punches, excluding breaks
+	WorkedMinutes int `json:"worked_minutes,omitempty"`
+	// Minutes spent on breaks derived from the punches
+	BreakMinutes int `json:"break_minutes,omitempty"`
+	// Kiosk whose rotating code was scanned for the first check-in
+	CheckInKioskID *uint64 `json:"check_in_kiosk_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AttendanceQuery when eager-loading is set.
 	Edges        AttendanceEdges `json:"edges"`
@@ -50,9 +77,19 @@ type Attendance struct {
 type AttendanceEdges struct {
 	// Employee holds the value of the employee edge.
 	Employee *Employee `json:"employee,omitempty"`
+	// Corrections holds the value of the corrections edge.
+	Corrections []*AttendanceCorrection `json:"corrections,omitempty"`
+	// Anomalies holds the value of the anomalies edge.
+	Anomalies []*AttendanceAnomaly `json:"anomalies,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*AttendancePunch `json:"punches,omitempty"`
+	// Overtime holds the value of the overtime edge.
+	Overtime *Overtime `json:"overtime,omitempty"`
+	// CheckInKiosk holds the value of the check_in_kiosk edge.
+	CheckInKiosk *Kiosk `json:"check_in_kiosk,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // EmployeeOrErr returns the Employee value or an error if the edge
@@ -68,14 +105,71 @@ func (e AttendanceEdges) EmployeeOrErr() (*Employee, error) {
 	return nil, &NotLoadedError{edge: "employee"}
 }
 
+// CorrectionsOrErr returns the Corrections value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendanceEdges) CorrectionsOrErr() ([]*AttendanceCorrection, error) {
+	if e.loadedTypes[1] {
+		return e.Corrections, nil
+	}
+	return nil, &NotLoadedError{edge: "corrections"}
+}
+
+// AnomaliesOrErr returns the Anomalies value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendanceEdges) AnomaliesOrErr() ([]*AttendanceAnomaly, error) {
+	if e.loadedTypes[2] {
+		return e.Anomalies, nil
+	}
+	return nil, &NotLoadedError{edge: "anomalies"}
+}
+
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendanceEdges) PunchesOrErr() ([]*AttendancePunch, error) {
+	if e.loadedTypes[3] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
+// OvertimeOrErr returns the Overtime value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceEdges) OvertimeOrErr() (*Overtime, error) {
+	if e.loadedTypes[4] {
+		if e.Overtime == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: overtime.Label}
+		}
+		return e.Overtime, nil
+	}
+	return nil, &NotLoadedError{edge: "overtime"}
+}
+
+// CheckInKioskOrErr returns the CheckInKiosk value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceEdges) CheckInKioskOrErr() (*Kiosk, error) {
+	if e.loadedTypes[5] {
+		if e.CheckInKiosk == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: kiosk.Label}
+		}
+		return e.CheckInKiosk, nil
+	}
+	return nil, &NotLoadedError{edge: "check_in_kiosk"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Attendance) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attendance.FieldIsWeekend, attendance.FieldMarkedByAdmin:
+		case attendance.FieldStatusMetadata:
+			values[i] = new([]byte)
+		case attendance.FieldIsWeekend, attendance.FieldMarkedByAdmin, attendance.FieldLocationFlagged, attendance.FieldAutoClosed:
 			values[i] = new(sql.NullBool)
-		case attendance.FieldID, attendance.FieldEmployeeID:
+		case attendance.FieldCheckInLatitude, attendance.FieldCheckInLongitude, attendance.FieldCheckInDistanceMeters, attendance.FieldCheckOutLatitude, attendance.FieldCheckOutLongitude, attendance.FieldCheckOutDistanceMeters:
+			values[i] = new(sql.NullFloat64)
+		case attendance.FieldID, attendance.FieldEmployeeID, attendance.FieldWorkedMinutes, attendance.FieldBreakMinutes, attendance.FieldCheckInKioskID:
 			values[i] = new(sql.NullInt64)
 		case attendance.FieldStatus, attendance.FieldNotes:
 			values[i] = new(sql.NullString)
@@ -150,6 +244,14 @@ func (a *Attendance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Status = attendance.Status(value.String)
 			}
+		case attendance.FieldStatusMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field status_metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.StatusMetadata); err != nil {
+					return fmt.Errorf("unmarshal field status_metadata: %w", err)
+				}
+			}
 		case attendance.FieldIsWeekend:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_weekend", values[i])
@@ -168,6 +270,79 @@ func (a *Attendance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.MarkedByAdmin = value.Bool
 			}
+		case attendance.FieldCheckInLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_latitude", values[i])
+			} else if value.Valid {
+				a.CheckInLatitude = new(float64)
+				*a.CheckInLatitude = value.Float64
+			}
+		case attendance.FieldCheckInLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_longitude", values[i])
+			} else if value.Valid {
+				a.CheckInLongitude = new(float64)
+				*a.CheckInLongitude = value.Float64
+			}
+		case attendance.FieldCheckInDistanceMeters:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_distance_meters", values[i])
+			} else if value.Valid {
+				a.CheckInDistanceMeters = new(float64)
+				*a.CheckInDistanceMeters = value.Float64
+			}
+		case attendance.FieldCheckOutLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_out_latitude", values[i])
+			} else if value.Valid {
+				a.CheckOutLatitude = new(float64)
+				*a.CheckOutLatitude = value.Float64
+			}
+		case attendance.FieldCheckOutLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_out_longitude", values[i])
+			} else if value.Valid {
+				a.CheckOutLongitude = new(float64)
+				*a.CheckOutLongitude = value.Float64
+			}
+		case attendance.FieldCheckOutDistanceMeters:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_out_distance_meters", values[i])
+			} else if value.Valid {
+				a.CheckOutDistanceMeters = new(float64)
+				*a.CheckOutDistanceMeters = value.Float64
+			}
+		case attendance.FieldLocationFlagged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field location_flagged", values[i])
+			} else if value.Valid {
+				a.LocationFlagged = value.Bool
+			}
+		case attendance.FieldAutoClosed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_closed", values[i])
+			} else if value.Valid {
+				a.AutoClosed = value.Bool
+			}
+		case attendance.FieldWorkedMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field worked_minutes", values[i])
+			} else if value.Valid {
+				a.WorkedMinutes = int(value.Int64)
+			}
+		case attendance.FieldBreakMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field break_minutes", values[i])
+			} else if value.Valid {
+				a.BreakMinutes = int(value.Int64)
+			}
+		case attendance.FieldCheckInKioskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_kiosk_id", values[i])
+			} else if value.Valid {
+				a.CheckInKioskID = new(uint64)
+				*a.CheckInKioskID = uint64(value.Int64)
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -186,6 +361,31 @@ func (a *Attendance) QueryEmployee() *EmployeeQuery {
 	return NewAttendanceClient(a.config).QueryEmployee(a)
 }
 
+// QueryCorrections queries the "corrections" edge of the Attendance entity.
+func (a *Attendance) QueryCorrections() *AttendanceCorrectionQuery {
+	return NewAttendanceClient(a.config).QueryCorrections(a)
+}
+
+// QueryAnomalies queries the "anomalies" edge of the Attendance entity.
+func (a *Attendance) QueryAnomalies() *AttendanceAnomalyQuery {
+	return NewAttendanceClient(a.config).QueryAnomalies(a)
+}
+
+// QueryPunches queries the "punches" edge of the Attendance entity.
+func (a *Attendance) QueryPunches() *AttendancePunchQuery {
+	return NewAttendanceClient(a.config).QueryPunches(a)
+}
+
+// QueryOvertime queries the "overtime" edge of the Attendance entity.
+func (a *Attendance) QueryOvertime() *OvertimeQuery {
+	return NewAttendanceClient(a.config).QueryOvertime(a)
+}
+
+// QueryCheckInKiosk queries the "check_in_kiosk" edge of the Attendance entity.
+func (a *Attendance) QueryCheckInKiosk() *KioskQuery {
+	return NewAttendanceClient(a.config).QueryCheckInKiosk(a)
+}
+
 // Update returns a builder for updating this Attendance.
 // Note that you need to call Attendance.Unwrap() before calling this method if this Attendance
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -233,6 +433,9 @@ func (a *Attendance) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", a.Status))
 	builder.WriteString(", ")
+	builder.WriteString("status_metadata=")
+	builder.WriteString(fmt.Sprintf("%v", a.StatusMetadata))
+	builder.WriteString(", ")
 	builder.WriteString("is_weekend=")
 	builder.WriteString(fmt.Sprintf("%v", a.IsWeekend))
 	builder.WriteString(", ")
@@ -241,6 +444,53 @@ func (a *Attendance) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("marked_by_admin=")
 	builder.WriteString(fmt.Sprintf("%v", a.MarkedByAdmin))
+	builder.WriteString(", ")
+	if v := a.CheckInLatitude; v != nil {
+		builder.WriteString("check_in_latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CheckInLongitude; v != nil {
+		builder.WriteString("check_in_longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CheckInDistanceMeters; v != nil {
+		builder.WriteString("check_in_distance_meters=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CheckOutLatitude; v != nil {
+		builder.WriteString("check_out_latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CheckOutLongitude; v != nil {
+		builder.WriteString("check_out_longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CheckOutDistanceMeters; v != nil {
+		builder.WriteString("check_out_distance_meters=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("location_flagged=")
+	builder.WriteString(fmt.Sprintf("%v", a.LocationFlagged))
+	builder.WriteString(", ")
+	builder.WriteString("auto_closed=")
+	builder.WriteString(fmt.Sprintf("%v", a.AutoClosed))
+	builder.WriteString(", ")
+	builder.WriteString("worked_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", a.WorkedMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("break_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", a.BreakMinutes))
+	builder.WriteString(", ")
+	if v := a.CheckInKioskID; v != nil {
+		builder.WriteString("check_in_kiosk_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
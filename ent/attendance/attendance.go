@@ -31,14 +31,48 @@ const (
 	FieldCheckOutTime = "check_out_time"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldStatusMetadata holds the string denoting the status_metadata field in the database.
+	FieldStatusMetadata = "status_metadata"
 	// FieldIsWeekend holds the string denoting the is_weekend field in the database.
 	FieldIsWeekend = "is_weekend"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
 	// FieldMarkedByAdmin holds the string denoting the marked_by_admin field in the database.
 	FieldMarkedByAdmin = "marked_by_admin"
+	// FieldCheckInLatitude holds the string denoting the check_in_latitude field in the database.
+	FieldCheckInLatitude = "check_in_latitude"
+	// FieldCheckInLongitude holds the string denoting the check_in_longitude field in the database.
+	FieldCheckInLongitude = "check_in_longitude"
+	// FieldCheckInDistanceMeters holds the string denoting the check_in_distance_meters field in the database.
+	FieldCheckInDistanceMeters = "check_in_distance_meters"
+	// FieldCheckOutLatitude holds the string denoting the check_out_latitude field in the database.
+	FieldCheckOutLatitude = "check_out_latitude"
+	// FieldCheckOutLongitude holds the string denoting the check_out_longitude field in the database.
+	FieldCheckOutLongitude = "check_out_longitude"
+	// FieldCheckOutDistanceMeters holds the string denoting the check_out_distance_meters field in the database.
+	FieldCheckOutDistanceMeters = "check_out_distance_meters"
+	// FieldLocationFlagged holds the string denoting the location_flagged field in the database.
+	FieldLocationFlagged = "location_flagged"
+	// FieldAutoClosed holds the string denoting the auto_closed field in the database.
+	FieldAutoClosed = "auto_closed"
+	// FieldWorkedMinutes holds the string denoting the worked_minutes field in the database.
+	FieldWorkedMinutes = "worked_minutes"
+	// FieldBreakMinutes holds the string denoting the break_minutes field in the database.
+	FieldBreakMinutes = "break_minutes"
+	// FieldCheckInKioskID holds the string denoting the check_in_kiosk_id field in the database.
+	FieldCheckInKioskID = "check_in_kiosk_id"
 	// EdgeEmployee holds the string denoting the employee edge name in mutations.
 	EdgeEmployee = "employee"
+	// EdgeCorrections holds the string denoting the corrections edge name in mutations.
+	EdgeCorrections = "corrections"
+	// EdgeAnomalies holds the string denoting the anomalies edge name in mutations.
+	EdgeAnomalies = "anomalies"
+	// EdgePunches holds the string denoting the punches edge name in mutations.
+	EdgePunches = "punches"
+	// EdgeOvertime holds the string denoting the overtime edge name in mutations.
+	EdgeOvertime = "overtime"
+	// EdgeCheckInKiosk holds the string denoting the check_in_kiosk edge name in mutations.
+	EdgeCheckInKiosk = "check_in_kiosk"
 	// Table holds the table name of the attendance in the database.
 	Table = "attendances"
 	// EmployeeTable is the table that holds the employee relation/edge.
@@ -48,6 +82,41 @@ const (
 	EmployeeInverseTable = "employees"
 	// EmployeeColumn is the table column denoting the employee relation/edge.
 	EmployeeColumn = "employee_id"
+	// CorrectionsTable is the table that holds the corrections relation/edge.
+	CorrectionsTable = "attendance_corrections"
+	// CorrectionsInverseTable is the table name for the AttendanceCorrection entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancecorrection" package.
+	CorrectionsInverseTable = "attendance_corrections"
+	// CorrectionsColumn is the table column denoting the corrections relation/edge.
+	CorrectionsColumn = "attendance_id"
+	// AnomaliesTable is the table that holds the anomalies relation/edge.
+	AnomaliesTable = "attendance_anomalies"
+	// AnomaliesInverseTable is the table name for the AttendanceAnomaly entity.
+	// It exists in this package in order to avoid circular dependency with the "attendanceanomaly" package.
+	AnomaliesInverseTable = "attendance_anomalies"
+	// AnomaliesColumn is the table column denoting the anomalies relation/edge.
+	AnomaliesColumn = "attendance_id"
+	// PunchesTable is the table that holds the punches relation/edge.
+	PunchesTable = "attendance_punches"
+	// PunchesInverseTable is the table name for the AttendancePunch entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancepunch" package.
+	PunchesInverseTable = "attendance_punches"
+	// PunchesColumn is the table column denoting the punches relation/edge.
+	PunchesColumn = "attendance_id"
+	// OvertimeTable is the table that holds the overtime relation/edge.
+	OvertimeTable = "overtimes"
+	// OvertimeInverseTable is the table name for the Overtime entity.
+	// It exists in this package in order to avoid circular dependency with the "overtime" package.
+	OvertimeInverseTable = "overtimes"
+	// OvertimeColumn is the table column denoting the overtime relation/edge.
+	OvertimeColumn = "attendance_id"
+	// CheckInKioskTable is the table that holds the check_in_kiosk relation/edge.
+	CheckInKioskTable = "attendances"
+	// CheckInKioskInverseTable is the table name for the Kiosk entity.
+	// It exists in this package in order to avoid circular dependency with the "kiosk" package.
+	CheckInKioskInverseTable = "kiosks"
+	// CheckInKioskColumn is the table column denoting the check_in_kiosk relation/edge.
+	CheckInKioskColumn = "check_in_kiosk_id"
 )
 
 // Columns holds all SQL columns for attendance fields.
@@ -61,9 +130,21 @@ var Columns = []string{
 	FieldCheckInTime,
 	FieldCheckOutTime,
 	FieldStatus,
+	FieldStatusMetadata,
 	FieldIsWeekend,
 	FieldNotes,
 	FieldMarkedByAdmin,
+	FieldCheckInLatitude,
+	FieldCheckInLongitude,
+	FieldCheckInDistanceMeters,
+	FieldCheckOutLatitude,
+	FieldCheckOutLongitude,
+	FieldCheckOutDistanceMeters,
+	FieldLocationFlagged,
+	FieldAutoClosed,
+	FieldWorkedMinutes,
+	FieldBreakMinutes,
+	FieldCheckInKioskID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,6 +168,14 @@ var (
 	DefaultIsWeekend bool
 	// DefaultMarkedByAdmin holds the default value on creation for the "marked_by_admin" field.
 	DefaultMarkedByAdmin bool
+	// DefaultLocationFlagged holds the default value on creation for the "location_flagged" field.
+	DefaultLocationFlagged bool
+	// DefaultAutoClosed holds the default value on creation for the "auto_closed" field.
+	DefaultAutoClosed bool
+	// DefaultWorkedMinutes holds the default value on creation for the "worked_minutes" field.
+	DefaultWorkedMinutes int
+	// DefaultBreakMinutes holds the default value on creation for the "break_minutes" field.
+	DefaultBreakMinutes int
 )
 
 // Status defines the type for the "status" enum field.
@@ -97,10 +186,15 @@ const DefaultStatus = StatusAbsent
 
 // Status values.
 const (
-	StatusPresent Status = "present"
-	StatusAbsent  Status = "absent"
-	StatusLate    Status = "late"
-	StatusHalfDay Status = "half_day"
+	StatusPresent      Status = "present"
+	StatusAbsent       Status = "absent"
+	StatusLate         Status = "late"
+	StatusHalfDay      Status = "half_day"
+	StatusLeave        Status = "leave"
+	StatusUnpaidLeave  Status = "unpaid_leave"
+	StatusRemote       Status = "remote"
+	StatusBusinessTrip Status = "business_trip"
+	StatusSick         Status = "sick"
 )
 
 func (s Status) String() string {
@@ -110,7 +204,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPresent, StatusAbsent, StatusLate, StatusHalfDay:
+	case StatusPresent, StatusAbsent, StatusLate, StatusHalfDay, StatusLeave, StatusUnpaidLeave, StatusRemote, StatusBusinessTrip, StatusSick:
 		return nil
 	default:
 		return fmt.Errorf("attendance: invalid enum value for status field: %q", s)
@@ -180,12 +274,123 @@ func ByMarkedByAdmin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMarkedByAdmin, opts...).ToFunc()
 }
 
+// ByCheckInLatitude orders the results by the check_in_latitude field.
+func ByCheckInLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInLatitude, opts...).ToFunc()
+}
+
+// ByCheckInLongitude orders the results by the check_in_longitude field.
+func ByCheckInLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInLongitude, opts...).ToFunc()
+}
+
+// ByCheckInDistanceMeters orders the results by the check_in_distance_meters field.
+func ByCheckInDistanceMeters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInDistanceMeters, opts...).ToFunc()
+}
+
+// ByCheckOutLatitude orders the results by the check_out_latitude field.
+func ByCheckOutLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckOutLatitude, opts...).ToFunc()
+}
+
+// ByCheckOutLongitude orders the results by the check_out_longitude field.
+func ByCheckOutLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckOutLongitude, opts...).ToFunc()
+}
+
+// ByCheckOutDistanceMeters orders the results by the check_out_distance_meters field.
+func ByCheckOutDistanceMeters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckOutDistanceMeters, opts...).ToFunc()
+}
+
+// ByLocationFlagged orders the results by the location_flagged field.
+func ByLocationFlagged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationFlagged, opts...).ToFunc()
+}
+
+// ByAutoClosed orders the results by the auto_closed field.
+func ByAutoClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoClosed, opts...).ToFunc()
+}
+
+// ByWorkedMinutes orders the results by the worked_minutes field.
+func ByWorkedMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkedMinutes, opts...).ToFunc()
+}
+
+// ByBreakMinutes orders the results by the break_minutes field.
+func ByBreakMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakMinutes, opts...).ToFunc()
+}
+
+// ByCheckInKioskID orders the results by the check_in_kiosk_id field.
+func ByCheckInKioskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInKioskID, opts...).ToFunc()
+}
+
 // ByEmployeeField orders the results by employee field.
 func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCorrectionsCount orders the results by corrections count.
+func ByCorrectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCorrectionsStep(), opts...)
+	}
+}
+
+// ByCorrections orders the results by corrections terms.
+func ByCorrections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCorrectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnomaliesCount orders the results by anomalies count.
+func ByAnomaliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnomaliesStep(), opts...)
+	}
+}
+
+// ByAnomalies orders the results by anomalies terms.
+func ByAnomalies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnomaliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPunchesCount orders the results by punches count.
+func ByPunchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchesStep(), opts...)
+	}
+}
+
+// ByPunches orders the results by punches terms.
+func ByPunches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOvertimeField orders the results by overtime field.
+func ByOvertimeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCheckInKioskField orders the results by check_in_kiosk field.
+func ByCheckInKioskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckInKioskStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newEmployeeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -193,3 +398,38 @@ func newEmployeeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
 	)
 }
+func newCorrectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CorrectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+	)
+}
+func newAnomaliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnomaliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnomaliesTable, AnomaliesColumn),
+	)
+}
+func newPunchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+	)
+}
+func newOvertimeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, OvertimeTable, OvertimeColumn),
+	)
+}
+func newCheckInKioskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckInKioskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CheckInKioskTable, CheckInKioskColumn),
+	)
+}
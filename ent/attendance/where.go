@@ -105,6 +105,61 @@ func MarkedByAdmin(v bool) predicate.Attendance {
 	return predicate.Attendance(sql.FieldEQ(FieldMarkedByAdmin, v))
 }
 
+// CheckInLatitude applies equality check predicate on the "check_in_latitude" field. It's identical to CheckInLatitudeEQ.
+func CheckInLatitude(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInLatitude, v))
+}
+
+// CheckInLongitude applies equality check predicate on the "check_in_longitude" field. It's identical to CheckInLongitudeEQ.
+func CheckInLongitude(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInLongitude, v))
+}
+
+// CheckInDistanceMeters applies equality check predicate on the "check_in_distance_meters" field. It's identical to CheckInDistanceMetersEQ.
+func CheckInDistanceMeters(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInDistanceMeters, v))
+}
+
+// CheckOutLatitude applies equality check predicate on the "check_out_latitude" field. It's identical to CheckOutLatitudeEQ.
+func CheckOutLatitude(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLongitude applies equality check predicate on the "check_out_longitude" field. It's identical to CheckOutLongitudeEQ.
+func CheckOutLongitude(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutLongitude, v))
+}
+
+// CheckOutDistanceMeters applies equality check predicate on the "check_out_distance_meters" field. It's identical to CheckOutDistanceMetersEQ.
+func CheckOutDistanceMeters(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutDistanceMeters, v))
+}
+
+// LocationFlagged applies equality check predicate on the "location_flagged" field. It's identical to LocationFlaggedEQ.
+func LocationFlagged(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldLocationFlagged, v))
+}
+
+// AutoClosed applies equality check predicate on the "auto_closed" field. It's identical to AutoClosedEQ.
+func AutoClosed(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldAutoClosed, v))
+}
+
+// WorkedMinutes applies equality check predicate on the "worked_minutes" field. It's identical to WorkedMinutesEQ.
+func WorkedMinutes(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldWorkedMinutes, v))
+}
+
+// BreakMinutes applies equality check predicate on the "break_minutes" field. It's identical to BreakMinutesEQ.
+func BreakMinutes(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldBreakMinutes, v))
+}
+
+// CheckInKioskID applies equality check predicate on the "check_in_kiosk_id" field. It's identical to CheckInKioskIDEQ.
+func CheckInKioskID(v uint64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInKioskID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Attendance {
 	return predicate.Attendance(sql.FieldEQ(FieldCreatedAt, v))
@@ -415,6 +470,16 @@ func StatusNotIn(vs ...Status) predicate.Attendance {
 	return predicate.Attendance(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// StatusMetadataIsNil applies the IsNil predicate on the "status_metadata" field.
+func StatusMetadataIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldStatusMetadata))
+}
+
+// StatusMetadataNotNil applies the NotNil predicate on the "status_metadata" field.
+func StatusMetadataNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldStatusMetadata))
+}
+
 // IsWeekendEQ applies the EQ predicate on the "is_weekend" field.
 func IsWeekendEQ(v bool) predicate.Attendance {
 	return predicate.Attendance(sql.FieldEQ(FieldIsWeekend, v))
@@ -510,6 +575,436 @@ func MarkedByAdminNEQ(v bool) predicate.Attendance {
 	return predicate.Attendance(sql.FieldNEQ(FieldMarkedByAdmin, v))
 }
 
+// CheckInLatitudeEQ applies the EQ predicate on the "check_in_latitude" field.
+func CheckInLatitudeEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeNEQ applies the NEQ predicate on the "check_in_latitude" field.
+func CheckInLatitudeNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeIn applies the In predicate on the "check_in_latitude" field.
+func CheckInLatitudeIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckInLatitude, vs...))
+}
+
+// CheckInLatitudeNotIn applies the NotIn predicate on the "check_in_latitude" field.
+func CheckInLatitudeNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckInLatitude, vs...))
+}
+
+// CheckInLatitudeGT applies the GT predicate on the "check_in_latitude" field.
+func CheckInLatitudeGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeGTE applies the GTE predicate on the "check_in_latitude" field.
+func CheckInLatitudeGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeLT applies the LT predicate on the "check_in_latitude" field.
+func CheckInLatitudeLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeLTE applies the LTE predicate on the "check_in_latitude" field.
+func CheckInLatitudeLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckInLatitude, v))
+}
+
+// CheckInLatitudeIsNil applies the IsNil predicate on the "check_in_latitude" field.
+func CheckInLatitudeIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckInLatitude))
+}
+
+// CheckInLatitudeNotNil applies the NotNil predicate on the "check_in_latitude" field.
+func CheckInLatitudeNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckInLatitude))
+}
+
+// CheckInLongitudeEQ applies the EQ predicate on the "check_in_longitude" field.
+func CheckInLongitudeEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeNEQ applies the NEQ predicate on the "check_in_longitude" field.
+func CheckInLongitudeNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeIn applies the In predicate on the "check_in_longitude" field.
+func CheckInLongitudeIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckInLongitude, vs...))
+}
+
+// CheckInLongitudeNotIn applies the NotIn predicate on the "check_in_longitude" field.
+func CheckInLongitudeNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckInLongitude, vs...))
+}
+
+// CheckInLongitudeGT applies the GT predicate on the "check_in_longitude" field.
+func CheckInLongitudeGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeGTE applies the GTE predicate on the "check_in_longitude" field.
+func CheckInLongitudeGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeLT applies the LT predicate on the "check_in_longitude" field.
+func CheckInLongitudeLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeLTE applies the LTE predicate on the "check_in_longitude" field.
+func CheckInLongitudeLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckInLongitude, v))
+}
+
+// CheckInLongitudeIsNil applies the IsNil predicate on the "check_in_longitude" field.
+func CheckInLongitudeIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckInLongitude))
+}
+
+// CheckInLongitudeNotNil applies the NotNil predicate on the "check_in_longitude" field.
+func CheckInLongitudeNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckInLongitude))
+}
+
+// CheckInDistanceMetersEQ applies the EQ predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersNEQ applies the NEQ predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersIn applies the In predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckInDistanceMeters, vs...))
+}
+
+// CheckInDistanceMetersNotIn applies the NotIn predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckInDistanceMeters, vs...))
+}
+
+// CheckInDistanceMetersGT applies the GT predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersGTE applies the GTE predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersLT applies the LT predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersLTE applies the LTE predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckInDistanceMeters, v))
+}
+
+// CheckInDistanceMetersIsNil applies the IsNil predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckInDistanceMeters))
+}
+
+// CheckInDistanceMetersNotNil applies the NotNil predicate on the "check_in_distance_meters" field.
+func CheckInDistanceMetersNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckInDistanceMeters))
+}
+
+// CheckOutLatitudeEQ applies the EQ predicate on the "check_out_latitude" field.
+func CheckOutLatitudeEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeNEQ applies the NEQ predicate on the "check_out_latitude" field.
+func CheckOutLatitudeNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeIn applies the In predicate on the "check_out_latitude" field.
+func CheckOutLatitudeIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckOutLatitude, vs...))
+}
+
+// CheckOutLatitudeNotIn applies the NotIn predicate on the "check_out_latitude" field.
+func CheckOutLatitudeNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckOutLatitude, vs...))
+}
+
+// CheckOutLatitudeGT applies the GT predicate on the "check_out_latitude" field.
+func CheckOutLatitudeGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeGTE applies the GTE predicate on the "check_out_latitude" field.
+func CheckOutLatitudeGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeLT applies the LT predicate on the "check_out_latitude" field.
+func CheckOutLatitudeLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeLTE applies the LTE predicate on the "check_out_latitude" field.
+func CheckOutLatitudeLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckOutLatitude, v))
+}
+
+// CheckOutLatitudeIsNil applies the IsNil predicate on the "check_out_latitude" field.
+func CheckOutLatitudeIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckOutLatitude))
+}
+
+// CheckOutLatitudeNotNil applies the NotNil predicate on the "check_out_latitude" field.
+func CheckOutLatitudeNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckOutLatitude))
+}
+
+// CheckOutLongitudeEQ applies the EQ predicate on the "check_out_longitude" field.
+func CheckOutLongitudeEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeNEQ applies the NEQ predicate on the "check_out_longitude" field.
+func CheckOutLongitudeNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeIn applies the In predicate on the "check_out_longitude" field.
+func CheckOutLongitudeIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckOutLongitude, vs...))
+}
+
+// CheckOutLongitudeNotIn applies the NotIn predicate on the "check_out_longitude" field.
+func CheckOutLongitudeNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckOutLongitude, vs...))
+}
+
+// CheckOutLongitudeGT applies the GT predicate on the "check_out_longitude" field.
+func CheckOutLongitudeGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeGTE applies the GTE predicate on the "check_out_longitude" field.
+func CheckOutLongitudeGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeLT applies the LT predicate on the "check_out_longitude" field.
+func CheckOutLongitudeLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeLTE applies the LTE predicate on the "check_out_longitude" field.
+func CheckOutLongitudeLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckOutLongitude, v))
+}
+
+// CheckOutLongitudeIsNil applies the IsNil predicate on the "check_out_longitude" field.
+func CheckOutLongitudeIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckOutLongitude))
+}
+
+// CheckOutLongitudeNotNil applies the NotNil predicate on the "check_out_longitude" field.
+func CheckOutLongitudeNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckOutLongitude))
+}
+
+// CheckOutDistanceMetersEQ applies the EQ predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersNEQ applies the NEQ predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersNEQ(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersIn applies the In predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckOutDistanceMeters, vs...))
+}
+
+// CheckOutDistanceMetersNotIn applies the NotIn predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersNotIn(vs ...float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckOutDistanceMeters, vs...))
+}
+
+// CheckOutDistanceMetersGT applies the GT predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersGT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersGTE applies the GTE predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersGTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersLT applies the LT predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersLT(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersLTE applies the LTE predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersLTE(v float64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldCheckOutDistanceMeters, v))
+}
+
+// CheckOutDistanceMetersIsNil applies the IsNil predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckOutDistanceMeters))
+}
+
+// CheckOutDistanceMetersNotNil applies the NotNil predicate on the "check_out_distance_meters" field.
+func CheckOutDistanceMetersNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckOutDistanceMeters))
+}
+
+// LocationFlaggedEQ applies the EQ predicate on the "location_flagged" field.
+func LocationFlaggedEQ(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldLocationFlagged, v))
+}
+
+// LocationFlaggedNEQ applies the NEQ predicate on the "location_flagged" field.
+func LocationFlaggedNEQ(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldLocationFlagged, v))
+}
+
+// AutoClosedEQ applies the EQ predicate on the "auto_closed" field.
+func AutoClosedEQ(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldAutoClosed, v))
+}
+
+// AutoClosedNEQ applies the NEQ predicate on the "auto_closed" field.
+func AutoClosedNEQ(v bool) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldAutoClosed, v))
+}
+
+// WorkedMinutesEQ applies the EQ predicate on the "worked_minutes" field.
+func WorkedMinutesEQ(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesNEQ applies the NEQ predicate on the "worked_minutes" field.
+func WorkedMinutesNEQ(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesIn applies the In predicate on the "worked_minutes" field.
+func WorkedMinutesIn(vs ...int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldWorkedMinutes, vs...))
+}
+
+// WorkedMinutesNotIn applies the NotIn predicate on the "worked_minutes" field.
+func WorkedMinutesNotIn(vs ...int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldWorkedMinutes, vs...))
+}
+
+// WorkedMinutesGT applies the GT predicate on the "worked_minutes" field.
+func WorkedMinutesGT(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesGTE applies the GTE predicate on the "worked_minutes" field.
+func WorkedMinutesGTE(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesLT applies the LT predicate on the "worked_minutes" field.
+func WorkedMinutesLT(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesLTE applies the LTE predicate on the "worked_minutes" field.
+func WorkedMinutesLTE(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldWorkedMinutes, v))
+}
+
+// BreakMinutesEQ applies the EQ predicate on the "break_minutes" field.
+func BreakMinutesEQ(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldBreakMinutes, v))
+}
+
+// BreakMinutesNEQ applies the NEQ predicate on the "break_minutes" field.
+func BreakMinutesNEQ(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldBreakMinutes, v))
+}
+
+// BreakMinutesIn applies the In predicate on the "break_minutes" field.
+func BreakMinutesIn(vs ...int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldBreakMinutes, vs...))
+}
+
+// BreakMinutesNotIn applies the NotIn predicate on the "break_minutes" field.
+func BreakMinutesNotIn(vs ...int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldBreakMinutes, vs...))
+}
+
+// BreakMinutesGT applies the GT predicate on the "break_minutes" field.
+func BreakMinutesGT(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGT(FieldBreakMinutes, v))
+}
+
+// BreakMinutesGTE applies the GTE predicate on the "break_minutes" field.
+func BreakMinutesGTE(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldGTE(FieldBreakMinutes, v))
+}
+
+// BreakMinutesLT applies the LT predicate on the "break_minutes" field.
+func BreakMinutesLT(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLT(FieldBreakMinutes, v))
+}
+
+// BreakMinutesLTE applies the LTE predicate on the "break_minutes" field.
+func BreakMinutesLTE(v int) predicate.Attendance {
+	return predicate.Attendance(sql.FieldLTE(FieldBreakMinutes, v))
+}
+
+// CheckInKioskIDEQ applies the EQ predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDEQ(v uint64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldEQ(FieldCheckInKioskID, v))
+}
+
+// CheckInKioskIDNEQ applies the NEQ predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDNEQ(v uint64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNEQ(FieldCheckInKioskID, v))
+}
+
+// CheckInKioskIDIn applies the In predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDIn(vs ...uint64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldIn(FieldCheckInKioskID, vs...))
+}
+
+// CheckInKioskIDNotIn applies the NotIn predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDNotIn(vs ...uint64) predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotIn(FieldCheckInKioskID, vs...))
+}
+
+// CheckInKioskIDIsNil applies the IsNil predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDIsNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldIsNull(FieldCheckInKioskID))
+}
+
+// CheckInKioskIDNotNil applies the NotNil predicate on the "check_in_kiosk_id" field.
+func CheckInKioskIDNotNil() predicate.Attendance {
+	return predicate.Attendance(sql.FieldNotNull(FieldCheckInKioskID))
+}
+
 // HasEmployee applies the HasEdge predicate on the "employee" edge.
 func HasEmployee() predicate.Attendance {
 	return predicate.Attendance(func(s *sql.Selector) {
@@ -533,6 +1028,121 @@ func HasEmployeeWith(preds ...predicate.Employee) predicate.Attendance {
 	})
 }
 
+// HasCorrections applies the HasEdge predicate on the "corrections" edge.
+func HasCorrections() predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCorrectionsWith applies the HasEdge predicate on the "corrections" edge with a given conditions (other predicates).
+func HasCorrectionsWith(preds ...predicate.AttendanceCorrection) predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := newCorrectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnomalies applies the HasEdge predicate on the "anomalies" edge.
+func HasAnomalies() predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnomaliesTable, AnomaliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnomaliesWith applies the HasEdge predicate on the "anomalies" edge with a given conditions (other predicates).
+func HasAnomaliesWith(preds ...predicate.AttendanceAnomaly) predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := newAnomaliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.AttendancePunch) predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOvertime applies the HasEdge predicate on the "overtime" edge.
+func HasOvertime() predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, OvertimeTable, OvertimeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimeWith applies the HasEdge predicate on the "overtime" edge with a given conditions (other predicates).
+func HasOvertimeWith(preds ...predicate.Overtime) predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := newOvertimeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCheckInKiosk applies the HasEdge predicate on the "check_in_kiosk" edge.
+func HasCheckInKiosk() predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CheckInKioskTable, CheckInKioskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckInKioskWith applies the HasEdge predicate on the "check_in_kiosk" edge with a given conditions (other predicates).
+func HasCheckInKioskWith(preds ...predicate.Kiosk) predicate.Attendance {
+	return predicate.Attendance(func(s *sql.Selector) {
+		step := newCheckInKioskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Attendance) predicate.Attendance {
 	return predicate.Attendance(func(s *sql.Selector) {
@@ -7,7 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepunch"
 	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/overtime"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -117,6 +122,12 @@ func (ac *AttendanceCreate) SetNillableStatus(a *attendance.Status) *AttendanceC
 	return ac
 }
 
+// SetStatusMetadata sets the "status_metadata" field.
+func (ac *AttendanceCreate) SetStatusMetadata(m map[string]string) *AttendanceCreate {
+	ac.mutation.SetStatusMetadata(m)
+	return ac
+}
+
 // SetIsWeekend sets the "is_weekend" field.
 func (ac *AttendanceCreate) SetIsWeekend(b bool) *AttendanceCreate {
 	ac.mutation.SetIsWeekend(b)
@@ -159,6 +170,160 @@ func (ac *AttendanceCreate) SetNillableMarkedByAdmin(b *bool) *AttendanceCreate
 	return ac
 }
 
+// SetCheckInLatitude sets the "check_in_latitude" field.
+func (ac *AttendanceCreate) SetCheckInLatitude(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckInLatitude(f)
+	return ac
+}
+
+// SetNillableCheckInLatitude sets the "check_in_latitude" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckInLatitude(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckInLatitude(*f)
+	}
+	return ac
+}
+
+// SetCheckInLongitude sets the "check_in_longitude" field.
+func (ac *AttendanceCreate) SetCheckInLongitude(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckInLongitude(f)
+	return ac
+}
+
+// SetNillableCheckInLongitude sets the "check_in_longitude" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckInLongitude(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckInLongitude(*f)
+	}
+	return ac
+}
+
+// SetCheckInDistanceMeters sets the "check_in_distance_meters" field.
+func (ac *AttendanceCreate) SetCheckInDistanceMeters(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckInDistanceMeters(f)
+	return ac
+}
+
+// SetNillableCheckInDistanceMeters sets the "check_in_distance_meters" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckInDistanceMeters(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckInDistanceMeters(*f)
+	}
+	return ac
+}
+
+// SetCheckOutLatitude sets the "check_out_latitude" field.
+func (ac *AttendanceCreate) SetCheckOutLatitude(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckOutLatitude(f)
+	return ac
+}
+
+// SetNillableCheckOutLatitude sets the "check_out_latitude" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckOutLatitude(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckOutLatitude(*f)
+	}
+	return ac
+}
+
+// SetCheckOutLongitude sets the "check_out_longitude" field.
+func (ac *AttendanceCreate) SetCheckOutLongitude(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckOutLongitude(f)
+	return ac
+}
+
+// SetNillableCheckOutLongitude sets the "check_out_longitude" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckOutLongitude(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckOutLongitude(*f)
+	}
+	return ac
+}
+
+// SetCheckOutDistanceMeters sets the "check_out_distance_meters" field.
+func (ac *AttendanceCreate) SetCheckOutDistanceMeters(f float64) *AttendanceCreate {
+	ac.mutation.SetCheckOutDistanceMeters(f)
+	return ac
+}
+
+// SetNillableCheckOutDistanceMeters sets the "check_out_distance_meters" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckOutDistanceMeters(f *float64) *AttendanceCreate {
+	if f != nil {
+		ac.SetCheckOutDistanceMeters(*f)
+	}
+	return ac
+}
+
+// SetLocationFlagged sets the "location_flagged" field.
+func (ac *AttendanceCreate) SetLocationFlagged(b bool) *AttendanceCreate {
+	ac.mutation.SetLocationFlagged(b)
+	return ac
+}
+
+// SetNillableLocationFlagged sets the "location_flagged" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableLocationFlagged(b *bool) *AttendanceCreate {
+	if b != nil {
+		ac.SetLocationFlagged(*b)
+	}
+	return ac
+}
+
+// SetAutoClosed sets the "auto_closed" field.
+func (ac *AttendanceCreate) SetAutoClosed(b bool) *AttendanceCreate {
+	ac.mutation.SetAutoClosed(b)
+	return ac
+}
+
+// SetNillableAutoClosed sets the "auto_closed" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableAutoClosed(b *bool) *AttendanceCreate {
+	if b != nil {
+		ac.SetAutoClosed(*b)
+	}
+	return ac
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (ac *AttendanceCreate) SetWorkedMinutes(i int) *AttendanceCreate {
+	ac.mutation.SetWorkedMinutes(i)
+	return ac
+}
+
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableWorkedMinutes(i *int) *AttendanceCreate {
+	if i != nil {
+		ac.SetWorkedMinutes(*i)
+	}
+	return ac
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (ac *AttendanceCreate) SetBreakMinutes(i int) *AttendanceCreate {
+	ac.mutation.SetBreakMinutes(i)
+	return ac
+}
+
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableBreakMinutes(i *int) *AttendanceCreate {
+	if i != nil {
+		ac.SetBreakMinutes(*i)
+	}
+	return ac
+}
+
+// SetCheckInKioskID sets the "check_in_kiosk_id" field.
+func (ac *AttendanceCreate) SetCheckInKioskID(u uint64) *AttendanceCreate {
+	ac.mutation.SetCheckInKioskID(u)
+	return ac
+}
+
+// SetNillableCheckInKioskID sets the "check_in_kiosk_id" field if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableCheckInKioskID(u *uint64) *AttendanceCreate {
+	if u != nil {
+		ac.SetCheckInKioskID(*u)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AttendanceCreate) SetID(u uint64) *AttendanceCreate {
 	ac.mutation.SetID(u)
@@ -170,6 +335,75 @@ func (ac *AttendanceCreate) SetEmployee(e *Employee) *AttendanceCreate {
 	return ac.SetEmployeeID(e.ID)
 }
 
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (ac *AttendanceCreate) AddCorrectionIDs(ids ...uint64) *AttendanceCreate {
+	ac.mutation.AddCorrectionIDs(ids...)
+	return ac
+}
+
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (ac *AttendanceCreate) AddCorrections(a ...*AttendanceCorrection) *AttendanceCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddCorrectionIDs(ids...)
+}
+
+// AddAnomalyIDs adds the "anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (ac *AttendanceCreate) AddAnomalyIDs(ids ...uint64) *AttendanceCreate {
+	ac.mutation.AddAnomalyIDs(ids...)
+	return ac
+}
+
+// AddAnomalies adds the "anomalies" edges to the AttendanceAnomaly entity.
+func (ac *AttendanceCreate) AddAnomalies(a ...*AttendanceAnomaly) *AttendanceCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddAnomalyIDs(ids...)
+}
+
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (ac *AttendanceCreate) AddPunchIDs(ids ...uint64) *AttendanceCreate {
+	ac.mutation.AddPunchIDs(ids...)
+	return ac
+}
+
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (ac *AttendanceCreate) AddPunches(a ...*AttendancePunch) *AttendanceCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddPunchIDs(ids...)
+}
+
+// SetOvertimeID sets the "overtime" edge to the Overtime entity by ID.
+func (ac *AttendanceCreate) SetOvertimeID(id uint64) *AttendanceCreate {
+	ac.mutation.SetOvertimeID(id)
+	return ac
+}
+
+// SetNillableOvertimeID sets the "overtime" edge to the Overtime entity by ID if the given value is not nil.
+func (ac *AttendanceCreate) SetNillableOvertimeID(id *uint64) *AttendanceCreate {
+	if id != nil {
+		ac = ac.SetOvertimeID(*id)
+	}
+	return ac
+}
+
+// SetOvertime sets the "overtime" edge to the Overtime entity.
+func (ac *AttendanceCreate) SetOvertime(o *Overtime) *AttendanceCreate {
+	return ac.SetOvertimeID(o.ID)
+}
+
+// SetCheckInKiosk sets the "check_in_kiosk" edge to the Kiosk entity.
+func (ac *AttendanceCreate) SetCheckInKiosk(k *Kiosk) *AttendanceCreate {
+	return ac.SetCheckInKioskID(k.ID)
+}
+
 // Mutation returns the AttendanceMutation object of the builder.
 func (ac *AttendanceCreate) Mutation() *AttendanceMutation {
 	return ac.mutation
@@ -225,6 +459,22 @@ func (ac *AttendanceCreate) defaults() {
 		v := attendance.DefaultMarkedByAdmin
 		ac.mutation.SetMarkedByAdmin(v)
 	}
+	if _, ok := ac.mutation.LocationFlagged(); !ok {
+		v := attendance.DefaultLocationFlagged
+		ac.mutation.SetLocationFlagged(v)
+	}
+	if _, ok := ac.mutation.AutoClosed(); !ok {
+		v := attendance.DefaultAutoClosed
+		ac.mutation.SetAutoClosed(v)
+	}
+	if _, ok := ac.mutation.WorkedMinutes(); !ok {
+		v := attendance.DefaultWorkedMinutes
+		ac.mutation.SetWorkedMinutes(v)
+	}
+	if _, ok := ac.mutation.BreakMinutes(); !ok {
+		v := attendance.DefaultBreakMinutes
+		ac.mutation.SetBreakMinutes(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -255,6 +505,18 @@ func (ac *AttendanceCreate) check() error {
 	if _, ok := ac.mutation.MarkedByAdmin(); !ok {
 		return &ValidationError{Name: "marked_by_admin", err: errors.New(`ent: missing required field "Attendance.marked_by_admin"`)}
 	}
+	if _, ok := ac.mutation.LocationFlagged(); !ok {
+		return &ValidationError{Name: "location_flagged", err: errors.New(`ent: missing required field "Attendance.location_flagged"`)}
+	}
+	if _, ok := ac.mutation.AutoClosed(); !ok {
+		return &ValidationError{Name: "auto_closed", err: errors.New(`ent: missing required field "Attendance.auto_closed"`)}
+	}
+	if _, ok := ac.mutation.WorkedMinutes(); !ok {
+		return &ValidationError{Name: "worked_minutes", err: errors.New(`ent: missing required field "Attendance.worked_minutes"`)}
+	}
+	if _, ok := ac.mutation.BreakMinutes(); !ok {
+		return &ValidationError{Name: "break_minutes", err: errors.New(`ent: missing required field "Attendance.break_minutes"`)}
+	}
 	if _, ok := ac.mutation.EmployeeID(); !ok {
 		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "Attendance.employee"`)}
 	}
@@ -318,6 +580,10 @@ func (ac *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 		_spec.SetField(attendance.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := ac.mutation.StatusMetadata(); ok {
+		_spec.SetField(attendance.FieldStatusMetadata, field.TypeJSON, value)
+		_node.StatusMetadata = value
+	}
 	if value, ok := ac.mutation.IsWeekend(); ok {
 		_spec.SetField(attendance.FieldIsWeekend, field.TypeBool, value)
 		_node.IsWeekend = value
@@ -330,6 +596,46 @@ func (ac *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 		_spec.SetField(attendance.FieldMarkedByAdmin, field.TypeBool, value)
 		_node.MarkedByAdmin = value
 	}
+	if value, ok := ac.mutation.CheckInLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLatitude, field.TypeFloat64, value)
+		_node.CheckInLatitude = &value
+	}
+	if value, ok := ac.mutation.CheckInLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLongitude, field.TypeFloat64, value)
+		_node.CheckInLongitude = &value
+	}
+	if value, ok := ac.mutation.CheckInDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64, value)
+		_node.CheckInDistanceMeters = &value
+	}
+	if value, ok := ac.mutation.CheckOutLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLatitude, field.TypeFloat64, value)
+		_node.CheckOutLatitude = &value
+	}
+	if value, ok := ac.mutation.CheckOutLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLongitude, field.TypeFloat64, value)
+		_node.CheckOutLongitude = &value
+	}
+	if value, ok := ac.mutation.CheckOutDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64, value)
+		_node.CheckOutDistanceMeters = &value
+	}
+	if value, ok := ac.mutation.LocationFlagged(); ok {
+		_spec.SetField(attendance.FieldLocationFlagged, field.TypeBool, value)
+		_node.LocationFlagged = value
+	}
+	if value, ok := ac.mutation.AutoClosed(); ok {
+		_spec.SetField(attendance.FieldAutoClosed, field.TypeBool, value)
+		_node.AutoClosed = value
+	}
+	if value, ok := ac.mutation.WorkedMinutes(); ok {
+		_spec.SetField(attendance.FieldWorkedMinutes, field.TypeInt, value)
+		_node.WorkedMinutes = value
+	}
+	if value, ok := ac.mutation.BreakMinutes(); ok {
+		_spec.SetField(attendance.FieldBreakMinutes, field.TypeInt, value)
+		_node.BreakMinutes = value
+	}
 	if nodes := ac.mutation.EmployeeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -347,6 +653,87 @@ func (ac *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 		_node.EmployeeID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.OvertimeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attendance.OvertimeTable,
+			Columns: []string{attendance.OvertimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.CheckInKioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendance.CheckInKioskTable,
+			Columns: []string{attendance.CheckInKioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CheckInKioskID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
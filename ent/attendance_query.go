@@ -4,10 +4,16 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepunch"
 	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/overtime"
 	"mceasy/ent/predicate"
 
 	"entgo.io/ent/dialect/sql"
@@ -18,12 +24,17 @@ import (
 // AttendanceQuery is the builder for querying Attendance entities.
 type AttendanceQuery struct {
 	config
-	ctx          *QueryContext
-	order        []attendance.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Attendance
-	withEmployee *EmployeeQuery
-	modifiers    []func(*sql.Selector)
+	ctx              *QueryContext
+	order            []attendance.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Attendance
+	withEmployee     *EmployeeQuery
+	withCorrections  *AttendanceCorrectionQuery
+	withAnomalies    *AttendanceAnomalyQuery
+	withPunches      *AttendancePunchQuery
+	withOvertime     *OvertimeQuery
+	withCheckInKiosk *KioskQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +93,116 @@ func (aq *AttendanceQuery) QueryEmployee() *EmployeeQuery {
 	return query
 }
 
+// QueryCorrections chains the current query on the "corrections" edge.
+func (aq *AttendanceQuery) QueryCorrections() *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, selector),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.CorrectionsTable, attendance.CorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnomalies chains the current query on the "anomalies" edge.
+func (aq *AttendanceQuery) QueryAnomalies() *AttendanceAnomalyQuery {
+	query := (&AttendanceAnomalyClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, selector),
+			sqlgraph.To(attendanceanomaly.Table, attendanceanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.AnomaliesTable, attendance.AnomaliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPunches chains the current query on the "punches" edge.
+func (aq *AttendanceQuery) QueryPunches() *AttendancePunchQuery {
+	query := (&AttendancePunchClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, selector),
+			sqlgraph.To(attendancepunch.Table, attendancepunch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.PunchesTable, attendance.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOvertime chains the current query on the "overtime" edge.
+func (aq *AttendanceQuery) QueryOvertime() *OvertimeQuery {
+	query := (&OvertimeClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, selector),
+			sqlgraph.To(overtime.Table, overtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attendance.OvertimeTable, attendance.OvertimeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCheckInKiosk chains the current query on the "check_in_kiosk" edge.
+func (aq *AttendanceQuery) QueryCheckInKiosk() *KioskQuery {
+	query := (&KioskClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, selector),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendance.CheckInKioskTable, attendance.CheckInKioskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Attendance entity from the query.
 // Returns a *NotFoundError when no Attendance was found.
 func (aq *AttendanceQuery) First(ctx context.Context) (*Attendance, error) {
@@ -269,12 +390,17 @@ func (aq *AttendanceQuery) Clone() *AttendanceQuery {
 		return nil
 	}
 	return &AttendanceQuery{
-		config:       aq.config,
-		ctx:          aq.ctx.Clone(),
-		order:        append([]attendance.OrderOption{}, aq.order...),
-		inters:       append([]Interceptor{}, aq.inters...),
-		predicates:   append([]predicate.Attendance{}, aq.predicates...),
-		withEmployee: aq.withEmployee.Clone(),
+		config:           aq.config,
+		ctx:              aq.ctx.Clone(),
+		order:            append([]attendance.OrderOption{}, aq.order...),
+		inters:           append([]Interceptor{}, aq.inters...),
+		predicates:       append([]predicate.Attendance{}, aq.predicates...),
+		withEmployee:     aq.withEmployee.Clone(),
+		withCorrections:  aq.withCorrections.Clone(),
+		withAnomalies:    aq.withAnomalies.Clone(),
+		withPunches:      aq.withPunches.Clone(),
+		withOvertime:     aq.withOvertime.Clone(),
+		withCheckInKiosk: aq.withCheckInKiosk.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -292,6 +418,61 @@ func (aq *AttendanceQuery) WithEmployee(opts ...func(*EmployeeQuery)) *Attendanc
 	return aq
 }
 
+// WithCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AttendanceQuery) WithCorrections(opts ...func(*AttendanceCorrectionQuery)) *AttendanceQuery {
+	query := (&AttendanceCorrectionClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withCorrections = query
+	return aq
+}
+
+// WithAnomalies tells the query-builder to eager-load the nodes that are connected to
+// the "anomalies" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AttendanceQuery) WithAnomalies(opts ...func(*AttendanceAnomalyQuery)) *AttendanceQuery {
+	query := (&AttendanceAnomalyClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withAnomalies = query
+	return aq
+}
+
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AttendanceQuery) WithPunches(opts ...func(*AttendancePunchQuery)) *AttendanceQuery {
+	query := (&AttendancePunchClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withPunches = query
+	return aq
+}
+
+// WithOvertime tells the query-builder to eager-load the nodes that are connected to
+// the "overtime" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AttendanceQuery) WithOvertime(opts ...func(*OvertimeQuery)) *AttendanceQuery {
+	query := (&OvertimeClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withOvertime = query
+	return aq
+}
+
+// WithCheckInKiosk tells the query-builder to eager-load the nodes that are connected to
+// the "check_in_kiosk" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AttendanceQuery) WithCheckInKiosk(opts ...func(*KioskQuery)) *AttendanceQuery {
+	query := (&KioskClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withCheckInKiosk = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +551,13 @@ func (aq *AttendanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 	var (
 		nodes       = []*Attendance{}
 		_spec       = aq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [6]bool{
 			aq.withEmployee != nil,
+			aq.withCorrections != nil,
+			aq.withAnomalies != nil,
+			aq.withPunches != nil,
+			aq.withOvertime != nil,
+			aq.withCheckInKiosk != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +587,39 @@ func (aq *AttendanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 			return nil, err
 		}
 	}
+	if query := aq.withCorrections; query != nil {
+		if err := aq.loadCorrections(ctx, query, nodes,
+			func(n *Attendance) { n.Edges.Corrections = []*AttendanceCorrection{} },
+			func(n *Attendance, e *AttendanceCorrection) { n.Edges.Corrections = append(n.Edges.Corrections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withAnomalies; query != nil {
+		if err := aq.loadAnomalies(ctx, query, nodes,
+			func(n *Attendance) { n.Edges.Anomalies = []*AttendanceAnomaly{} },
+			func(n *Attendance, e *AttendanceAnomaly) { n.Edges.Anomalies = append(n.Edges.Anomalies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withPunches; query != nil {
+		if err := aq.loadPunches(ctx, query, nodes,
+			func(n *Attendance) { n.Edges.Punches = []*AttendancePunch{} },
+			func(n *Attendance, e *AttendancePunch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withOvertime; query != nil {
+		if err := aq.loadOvertime(ctx, query, nodes, nil,
+			func(n *Attendance, e *Overtime) { n.Edges.Overtime = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withCheckInKiosk; query != nil {
+		if err := aq.loadCheckInKiosk(ctx, query, nodes, nil,
+			func(n *Attendance, e *Kiosk) { n.Edges.CheckInKiosk = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +652,155 @@ func (aq *AttendanceQuery) loadEmployee(ctx context.Context, query *EmployeeQuer
 	}
 	return nil
 }
+func (aq *AttendanceQuery) loadCorrections(ctx context.Context, query *AttendanceCorrectionQuery, nodes []*Attendance, init func(*Attendance), assign func(*Attendance, *AttendanceCorrection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Attendance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancecorrection.FieldAttendanceID)
+	}
+	query.Where(predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendance.CorrectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AttendanceQuery) loadAnomalies(ctx context.Context, query *AttendanceAnomalyQuery, nodes []*Attendance, init func(*Attendance), assign func(*Attendance, *AttendanceAnomaly)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Attendance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendanceanomaly.FieldAttendanceID)
+	}
+	query.Where(predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendance.AnomaliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AttendanceQuery) loadPunches(ctx context.Context, query *AttendancePunchQuery, nodes []*Attendance, init func(*Attendance), assign func(*Attendance, *AttendancePunch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Attendance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancepunch.FieldAttendanceID)
+	}
+	query.Where(predicate.AttendancePunch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendance.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AttendanceQuery) loadOvertime(ctx context.Context, query *OvertimeQuery, nodes []*Attendance, init func(*Attendance), assign func(*Attendance, *Overtime)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Attendance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(overtime.FieldAttendanceID)
+	}
+	query.Where(predicate.Overtime(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendance.OvertimeColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AttendanceQuery) loadCheckInKiosk(ctx context.Context, query *KioskQuery, nodes []*Attendance, init func(*Attendance), assign func(*Attendance, *Kiosk)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*Attendance)
+	for i := range nodes {
+		if nodes[i].CheckInKioskID == nil {
+			continue
+		}
+		fk := *nodes[i].CheckInKioskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(kiosk.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "check_in_kiosk_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (aq *AttendanceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -465,6 +833,9 @@ func (aq *AttendanceQuery) querySpec() *sqlgraph.QuerySpec {
 		if aq.withEmployee != nil {
 			_spec.Node.AddColumnOnce(attendance.FieldEmployeeID)
 		}
+		if aq.withCheckInKiosk != nil {
+			_spec.Node.AddColumnOnce(attendance.FieldCheckInKioskID)
+		}
 	}
 	if ps := aq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
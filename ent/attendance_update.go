@@ -7,7 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepunch"
 	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/overtime"
 	"mceasy/ent/predicate"
 	"time"
 
@@ -122,6 +127,18 @@ func (au *AttendanceUpdate) SetNillableStatus(a *attendance.Status) *AttendanceU
 	return au
 }
 
+// SetStatusMetadata sets the "status_metadata" field.
+func (au *AttendanceUpdate) SetStatusMetadata(m map[string]string) *AttendanceUpdate {
+	au.mutation.SetStatusMetadata(m)
+	return au
+}
+
+// ClearStatusMetadata clears the value of the "status_metadata" field.
+func (au *AttendanceUpdate) ClearStatusMetadata() *AttendanceUpdate {
+	au.mutation.ClearStatusMetadata()
+	return au
+}
+
 // SetIsWeekend sets the "is_weekend" field.
 func (au *AttendanceUpdate) SetIsWeekend(b bool) *AttendanceUpdate {
 	au.mutation.SetIsWeekend(b)
@@ -170,11 +187,332 @@ func (au *AttendanceUpdate) SetNillableMarkedByAdmin(b *bool) *AttendanceUpdate
 	return au
 }
 
+// SetCheckInLatitude sets the "check_in_latitude" field.
+func (au *AttendanceUpdate) SetCheckInLatitude(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckInLatitude()
+	au.mutation.SetCheckInLatitude(f)
+	return au
+}
+
+// SetNillableCheckInLatitude sets the "check_in_latitude" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckInLatitude(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckInLatitude(*f)
+	}
+	return au
+}
+
+// AddCheckInLatitude adds f to the "check_in_latitude" field.
+func (au *AttendanceUpdate) AddCheckInLatitude(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckInLatitude(f)
+	return au
+}
+
+// ClearCheckInLatitude clears the value of the "check_in_latitude" field.
+func (au *AttendanceUpdate) ClearCheckInLatitude() *AttendanceUpdate {
+	au.mutation.ClearCheckInLatitude()
+	return au
+}
+
+// SetCheckInLongitude sets the "check_in_longitude" field.
+func (au *AttendanceUpdate) SetCheckInLongitude(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckInLongitude()
+	au.mutation.SetCheckInLongitude(f)
+	return au
+}
+
+// SetNillableCheckInLongitude sets the "check_in_longitude" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckInLongitude(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckInLongitude(*f)
+	}
+	return au
+}
+
+// AddCheckInLongitude adds f to the "check_in_longitude" field.
+func (au *AttendanceUpdate) AddCheckInLongitude(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckInLongitude(f)
+	return au
+}
+
+// ClearCheckInLongitude clears the value of the "check_in_longitude" field.
+func (au *AttendanceUpdate) ClearCheckInLongitude() *AttendanceUpdate {
+	au.mutation.ClearCheckInLongitude()
+	return au
+}
+
+// SetCheckInDistanceMeters sets the "check_in_distance_meters" field.
+func (au *AttendanceUpdate) SetCheckInDistanceMeters(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckInDistanceMeters()
+	au.mutation.SetCheckInDistanceMeters(f)
+	return au
+}
+
+// SetNillableCheckInDistanceMeters sets the "check_in_distance_meters" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckInDistanceMeters(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckInDistanceMeters(*f)
+	}
+	return au
+}
+
+// AddCheckInDistanceMeters adds f to the "check_in_distance_meters" field.
+func (au *AttendanceUpdate) AddCheckInDistanceMeters(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckInDistanceMeters(f)
+	return au
+}
+
+// ClearCheckInDistanceMeters clears the value of the "check_in_distance_meters" field.
+func (au *AttendanceUpdate) ClearCheckInDistanceMeters() *AttendanceUpdate {
+	au.mutation.ClearCheckInDistanceMeters()
+	return au
+}
+
+// SetCheckOutLatitude sets the "check_out_latitude" field.
+func (au *AttendanceUpdate) SetCheckOutLatitude(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckOutLatitude()
+	au.mutation.SetCheckOutLatitude(f)
+	return au
+}
+
+// SetNillableCheckOutLatitude sets the "check_out_latitude" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckOutLatitude(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckOutLatitude(*f)
+	}
+	return au
+}
+
+// AddCheckOutLatitude adds f to the "check_out_latitude" field.
+func (au *AttendanceUpdate) AddCheckOutLatitude(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckOutLatitude(f)
+	return au
+}
+
+// ClearCheckOutLatitude clears the value of the "check_out_latitude" field.
+func (au *AttendanceUpdate) ClearCheckOutLatitude() *AttendanceUpdate {
+	au.mutation.ClearCheckOutLatitude()
+	return au
+}
+
+// SetCheckOutLongitude sets the "check_out_longitude" field.
+func (au *AttendanceUpdate) SetCheckOutLongitude(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckOutLongitude()
+	au.mutation.SetCheckOutLongitude(f)
+	return au
+}
+
+// SetNillableCheckOutLongitude sets the "check_out_longitude" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckOutLongitude(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckOutLongitude(*f)
+	}
+	return au
+}
+
+// AddCheckOutLongitude adds f to the "check_out_longitude" field.
+func (au *AttendanceUpdate) AddCheckOutLongitude(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckOutLongitude(f)
+	return au
+}
+
+// ClearCheckOutLongitude clears the value of the "check_out_longitude" field.
+func (au *AttendanceUpdate) ClearCheckOutLongitude() *AttendanceUpdate {
+	au.mutation.ClearCheckOutLongitude()
+	return au
+}
+
+// SetCheckOutDistanceMeters sets the "check_out_distance_meters" field.
+func (au *AttendanceUpdate) SetCheckOutDistanceMeters(f float64) *AttendanceUpdate {
+	au.mutation.ResetCheckOutDistanceMeters()
+	au.mutation.SetCheckOutDistanceMeters(f)
+	return au
+}
+
+// SetNillableCheckOutDistanceMeters sets the "check_out_distance_meters" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckOutDistanceMeters(f *float64) *AttendanceUpdate {
+	if f != nil {
+		au.SetCheckOutDistanceMeters(*f)
+	}
+	return au
+}
+
+// AddCheckOutDistanceMeters adds f to the "check_out_distance_meters" field.
+func (au *AttendanceUpdate) AddCheckOutDistanceMeters(f float64) *AttendanceUpdate {
+	au.mutation.AddCheckOutDistanceMeters(f)
+	return au
+}
+
+// ClearCheckOutDistanceMeters clears the value of the "check_out_distance_meters" field.
+func (au *AttendanceUpdate) ClearCheckOutDistanceMeters() *AttendanceUpdate {
+	au.mutation.ClearCheckOutDistanceMeters()
+	return au
+}
+
+// SetLocationFlagged sets the "location_flagged" field.
+func (au *AttendanceUpdate) SetLocationFlagged(b bool) *AttendanceUpdate {
+	au.mutation.SetLocationFlagged(b)
+	return au
+}
+
+// SetNillableLocationFlagged sets the "location_flagged" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableLocationFlagged(b *bool) *AttendanceUpdate {
+	if b != nil {
+		au.SetLocationFlagged(*b)
+	}
+	return au
+}
+
+// SetAutoClosed sets the "auto_closed" field.
+func (au *AttendanceUpdate) SetAutoClosed(b bool) *AttendanceUpdate {
+	au.mutation.SetAutoClosed(b)
+	return au
+}
+
+// SetNillableAutoClosed sets the "auto_closed" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableAutoClosed(b *bool) *AttendanceUpdate {
+	if b != nil {
+		au.SetAutoClosed(*b)
+	}
+	return au
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (au *AttendanceUpdate) SetWorkedMinutes(i int) *AttendanceUpdate {
+	au.mutation.ResetWorkedMinutes()
+	au.mutation.SetWorkedMinutes(i)
+	return au
+}
+
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableWorkedMinutes(i *int) *AttendanceUpdate {
+	if i != nil {
+		au.SetWorkedMinutes(*i)
+	}
+	return au
+}
+
+// AddWorkedMinutes adds i to the "worked_minutes" field.
+func (au *AttendanceUpdate) AddWorkedMinutes(i int) *AttendanceUpdate {
+	au.mutation.AddWorkedMinutes(i)
+	return au
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (au *AttendanceUpdate) SetBreakMinutes(i int) *AttendanceUpdate {
+	au.mutation.ResetBreakMinutes()
+	au.mutation.SetBreakMinutes(i)
+	return au
+}
+
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableBreakMinutes(i *int) *AttendanceUpdate {
+	if i != nil {
+		au.SetBreakMinutes(*i)
+	}
+	return au
+}
+
+// AddBreakMinutes adds i to the "break_minutes" field.
+func (au *AttendanceUpdate) AddBreakMinutes(i int) *AttendanceUpdate {
+	au.mutation.AddBreakMinutes(i)
+	return au
+}
+
+// SetCheckInKioskID sets the "check_in_kiosk_id" field.
+func (au *AttendanceUpdate) SetCheckInKioskID(u uint64) *AttendanceUpdate {
+	au.mutation.SetCheckInKioskID(u)
+	return au
+}
+
+// SetNillableCheckInKioskID sets the "check_in_kiosk_id" field if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableCheckInKioskID(u *uint64) *AttendanceUpdate {
+	if u != nil {
+		au.SetCheckInKioskID(*u)
+	}
+	return au
+}
+
+// ClearCheckInKioskID clears the value of the "check_in_kiosk_id" field.
+func (au *AttendanceUpdate) ClearCheckInKioskID() *AttendanceUpdate {
+	au.mutation.ClearCheckInKioskID()
+	return au
+}
+
 // SetEmployee sets the "employee" edge to the Employee entity.
 func (au *AttendanceUpdate) SetEmployee(e *Employee) *AttendanceUpdate {
 	return au.SetEmployeeID(e.ID)
 }
 
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (au *AttendanceUpdate) AddCorrectionIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.AddCorrectionIDs(ids...)
+	return au
+}
+
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (au *AttendanceUpdate) AddCorrections(a ...*AttendanceCorrection) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddCorrectionIDs(ids...)
+}
+
+// AddAnomalyIDs adds the "anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (au *AttendanceUpdate) AddAnomalyIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.AddAnomalyIDs(ids...)
+	return au
+}
+
+// AddAnomalies adds the "anomalies" edges to the AttendanceAnomaly entity.
+func (au *AttendanceUpdate) AddAnomalies(a ...*AttendanceAnomaly) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddAnomalyIDs(ids...)
+}
+
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (au *AttendanceUpdate) AddPunchIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.AddPunchIDs(ids...)
+	return au
+}
+
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (au *AttendanceUpdate) AddPunches(a ...*AttendancePunch) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddPunchIDs(ids...)
+}
+
+// SetOvertimeID sets the "overtime" edge to the Overtime entity by ID.
+func (au *AttendanceUpdate) SetOvertimeID(id uint64) *AttendanceUpdate {
+	au.mutation.SetOvertimeID(id)
+	return au
+}
+
+// SetNillableOvertimeID sets the "overtime" edge to the Overtime entity by ID if the given value is not nil.
+func (au *AttendanceUpdate) SetNillableOvertimeID(id *uint64) *AttendanceUpdate {
+	if id != nil {
+		au = au.SetOvertimeID(*id)
+	}
+	return au
+}
+
+// SetOvertime sets the "overtime" edge to the Overtime entity.
+func (au *AttendanceUpdate) SetOvertime(o *Overtime) *AttendanceUpdate {
+	return au.SetOvertimeID(o.ID)
+}
+
+// SetCheckInKiosk sets the "check_in_kiosk" edge to the Kiosk entity.
+func (au *AttendanceUpdate) SetCheckInKiosk(k *Kiosk) *AttendanceUpdate {
+	return au.SetCheckInKioskID(k.ID)
+}
+
 // Mutation returns the AttendanceMutation object of the builder.
 func (au *AttendanceUpdate) Mutation() *AttendanceMutation {
 	return au.mutation
@@ -186,6 +524,81 @@ func (au *AttendanceUpdate) ClearEmployee() *AttendanceUpdate {
 	return au
 }
 
+// ClearCorrections clears all "corrections" edges to the AttendanceCorrection entity.
+func (au *AttendanceUpdate) ClearCorrections() *AttendanceUpdate {
+	au.mutation.ClearCorrections()
+	return au
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to AttendanceCorrection entities by IDs.
+func (au *AttendanceUpdate) RemoveCorrectionIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.RemoveCorrectionIDs(ids...)
+	return au
+}
+
+// RemoveCorrections removes "corrections" edges to AttendanceCorrection entities.
+func (au *AttendanceUpdate) RemoveCorrections(a ...*AttendanceCorrection) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveCorrectionIDs(ids...)
+}
+
+// ClearAnomalies clears all "anomalies" edges to the AttendanceAnomaly entity.
+func (au *AttendanceUpdate) ClearAnomalies() *AttendanceUpdate {
+	au.mutation.ClearAnomalies()
+	return au
+}
+
+// RemoveAnomalyIDs removes the "anomalies" edge to AttendanceAnomaly entities by IDs.
+func (au *AttendanceUpdate) RemoveAnomalyIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.RemoveAnomalyIDs(ids...)
+	return au
+}
+
+// RemoveAnomalies removes "anomalies" edges to AttendanceAnomaly entities.
+func (au *AttendanceUpdate) RemoveAnomalies(a ...*AttendanceAnomaly) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveAnomalyIDs(ids...)
+}
+
+// ClearPunches clears all "punches" edges to the AttendancePunch entity.
+func (au *AttendanceUpdate) ClearPunches() *AttendanceUpdate {
+	au.mutation.ClearPunches()
+	return au
+}
+
+// RemovePunchIDs removes the "punches" edge to AttendancePunch entities by IDs.
+func (au *AttendanceUpdate) RemovePunchIDs(ids ...uint64) *AttendanceUpdate {
+	au.mutation.RemovePunchIDs(ids...)
+	return au
+}
+
+// RemovePunches removes "punches" edges to AttendancePunch entities.
+func (au *AttendanceUpdate) RemovePunches(a ...*AttendancePunch) *AttendanceUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemovePunchIDs(ids...)
+}
+
+// ClearOvertime clears the "overtime" edge to the Overtime entity.
+func (au *AttendanceUpdate) ClearOvertime() *AttendanceUpdate {
+	au.mutation.ClearOvertime()
+	return au
+}
+
+// ClearCheckInKiosk clears the "check_in_kiosk" edge to the Kiosk entity.
+func (au *AttendanceUpdate) ClearCheckInKiosk() *AttendanceUpdate {
+	au.mutation.ClearCheckInKiosk()
+	return au
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AttendanceUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -280,6 +693,12 @@ func (au *AttendanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Status(); ok {
 		_spec.SetField(attendance.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := au.mutation.StatusMetadata(); ok {
+		_spec.SetField(attendance.FieldStatusMetadata, field.TypeJSON, value)
+	}
+	if au.mutation.StatusMetadataCleared() {
+		_spec.ClearField(attendance.FieldStatusMetadata, field.TypeJSON)
+	}
 	if value, ok := au.mutation.IsWeekend(); ok {
 		_spec.SetField(attendance.FieldIsWeekend, field.TypeBool, value)
 	}
@@ -292,6 +711,78 @@ func (au *AttendanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.MarkedByAdmin(); ok {
 		_spec.SetField(attendance.FieldMarkedByAdmin, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.CheckInLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckInLatitude(); ok {
+		_spec.AddField(attendance.FieldCheckInLatitude, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckInLatitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckInLatitude, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.CheckInLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckInLongitude(); ok {
+		_spec.AddField(attendance.FieldCheckInLongitude, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckInLongitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckInLongitude, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.CheckInDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckInDistanceMeters(); ok {
+		_spec.AddField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckInDistanceMetersCleared() {
+		_spec.ClearField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.CheckOutLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckOutLatitude(); ok {
+		_spec.AddField(attendance.FieldCheckOutLatitude, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckOutLatitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckOutLatitude, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.CheckOutLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckOutLongitude(); ok {
+		_spec.AddField(attendance.FieldCheckOutLongitude, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckOutLongitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckOutLongitude, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.CheckOutDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedCheckOutDistanceMeters(); ok {
+		_spec.AddField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64, value)
+	}
+	if au.mutation.CheckOutDistanceMetersCleared() {
+		_spec.ClearField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.LocationFlagged(); ok {
+		_spec.SetField(attendance.FieldLocationFlagged, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.AutoClosed(); ok {
+		_spec.SetField(attendance.FieldAutoClosed, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.WorkedMinutes(); ok {
+		_spec.SetField(attendance.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedWorkedMinutes(); ok {
+		_spec.AddField(attendance.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.BreakMinutes(); ok {
+		_spec.SetField(attendance.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedBreakMinutes(); ok {
+		_spec.AddField(attendance.FieldBreakMinutes, field.TypeInt, value)
+	}
 	if au.mutation.EmployeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -321,41 +812,234 @@ func (au *AttendanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(au.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{attendance.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if au.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	au.mutation.done = true
-	return n, nil
-}
-
-// AttendanceUpdateOne is the builder for updating a single Attendance entity.
-type AttendanceUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *AttendanceMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetModifiedAt sets the "modified_at" field.
-func (auo *AttendanceUpdateOne) SetModifiedAt(t time.Time) *AttendanceUpdateOne {
-	auo.mutation.SetModifiedAt(t)
-	return auo
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (auo *AttendanceUpdateOne) SetDeletedAt(t time.Time) *AttendanceUpdateOne {
-	auo.mutation.SetDeletedAt(t)
-	return auo
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+	if nodes := au.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !au.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedAnomaliesIDs(); len(nodes) > 0 && !au.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !au.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.OvertimeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attendance.OvertimeTable,
+			Columns: []string{attendance.OvertimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OvertimeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attendance.OvertimeTable,
+			Columns: []string{attendance.OvertimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.CheckInKioskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendance.CheckInKioskTable,
+			Columns: []string{attendance.CheckInKioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CheckInKioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendance.CheckInKioskTable,
+			Columns: []string{attendance.CheckInKioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttendanceUpdateOne is the builder for updating a single Attendance entity.
+type AttendanceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttendanceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (auo *AttendanceUpdateOne) SetModifiedAt(t time.Time) *AttendanceUpdateOne {
+	auo.mutation.SetModifiedAt(t)
+	return auo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AttendanceUpdateOne) SetDeletedAt(t time.Time) *AttendanceUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
 func (auo *AttendanceUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendanceUpdateOne {
 	if t != nil {
 		auo.SetDeletedAt(*t)
@@ -363,129 +1047,462 @@ func (auo *AttendanceUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendanceUp
 	return auo
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (auo *AttendanceUpdateOne) ClearDeletedAt() *AttendanceUpdateOne {
-	auo.mutation.ClearDeletedAt()
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AttendanceUpdateOne) ClearDeletedAt() *AttendanceUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (auo *AttendanceUpdateOne) SetEmployeeID(u uint64) *AttendanceUpdateOne {
+	auo.mutation.SetEmployeeID(u)
+	return auo
+}
+
+// SetAttendanceDate sets the "attendance_date" field.
+func (auo *AttendanceUpdateOne) SetAttendanceDate(t time.Time) *AttendanceUpdateOne {
+	auo.mutation.SetAttendanceDate(t)
+	return auo
+}
+
+// SetCheckInTime sets the "check_in_time" field.
+func (auo *AttendanceUpdateOne) SetCheckInTime(t time.Time) *AttendanceUpdateOne {
+	auo.mutation.SetCheckInTime(t)
+	return auo
+}
+
+// SetNillableCheckInTime sets the "check_in_time" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckInTime(t *time.Time) *AttendanceUpdateOne {
+	if t != nil {
+		auo.SetCheckInTime(*t)
+	}
+	return auo
+}
+
+// ClearCheckInTime clears the value of the "check_in_time" field.
+func (auo *AttendanceUpdateOne) ClearCheckInTime() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInTime()
+	return auo
+}
+
+// SetCheckOutTime sets the "check_out_time" field.
+func (auo *AttendanceUpdateOne) SetCheckOutTime(t time.Time) *AttendanceUpdateOne {
+	auo.mutation.SetCheckOutTime(t)
+	return auo
+}
+
+// SetNillableCheckOutTime sets the "check_out_time" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckOutTime(t *time.Time) *AttendanceUpdateOne {
+	if t != nil {
+		auo.SetCheckOutTime(*t)
+	}
+	return auo
+}
+
+// ClearCheckOutTime clears the value of the "check_out_time" field.
+func (auo *AttendanceUpdateOne) ClearCheckOutTime() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckOutTime()
+	return auo
+}
+
+// SetStatus sets the "status" field.
+func (auo *AttendanceUpdateOne) SetStatus(a attendance.Status) *AttendanceUpdateOne {
+	auo.mutation.SetStatus(a)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableStatus(a *attendance.Status) *AttendanceUpdateOne {
+	if a != nil {
+		auo.SetStatus(*a)
+	}
+	return auo
+}
+
+// SetStatusMetadata sets the "status_metadata" field.
+func (auo *AttendanceUpdateOne) SetStatusMetadata(m map[string]string) *AttendanceUpdateOne {
+	auo.mutation.SetStatusMetadata(m)
+	return auo
+}
+
+// ClearStatusMetadata clears the value of the "status_metadata" field.
+func (auo *AttendanceUpdateOne) ClearStatusMetadata() *AttendanceUpdateOne {
+	auo.mutation.ClearStatusMetadata()
+	return auo
+}
+
+// SetIsWeekend sets the "is_weekend" field.
+func (auo *AttendanceUpdateOne) SetIsWeekend(b bool) *AttendanceUpdateOne {
+	auo.mutation.SetIsWeekend(b)
+	return auo
+}
+
+// SetNillableIsWeekend sets the "is_weekend" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableIsWeekend(b *bool) *AttendanceUpdateOne {
+	if b != nil {
+		auo.SetIsWeekend(*b)
+	}
+	return auo
+}
+
+// SetNotes sets the "notes" field.
+func (auo *AttendanceUpdateOne) SetNotes(s string) *AttendanceUpdateOne {
+	auo.mutation.SetNotes(s)
+	return auo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableNotes(s *string) *AttendanceUpdateOne {
+	if s != nil {
+		auo.SetNotes(*s)
+	}
+	return auo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (auo *AttendanceUpdateOne) ClearNotes() *AttendanceUpdateOne {
+	auo.mutation.ClearNotes()
+	return auo
+}
+
+// SetMarkedByAdmin sets the "marked_by_admin" field.
+func (auo *AttendanceUpdateOne) SetMarkedByAdmin(b bool) *AttendanceUpdateOne {
+	auo.mutation.SetMarkedByAdmin(b)
+	return auo
+}
+
+// SetNillableMarkedByAdmin sets the "marked_by_admin" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableMarkedByAdmin(b *bool) *AttendanceUpdateOne {
+	if b != nil {
+		auo.SetMarkedByAdmin(*b)
+	}
+	return auo
+}
+
+// SetCheckInLatitude sets the "check_in_latitude" field.
+func (auo *AttendanceUpdateOne) SetCheckInLatitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckInLatitude()
+	auo.mutation.SetCheckInLatitude(f)
+	return auo
+}
+
+// SetNillableCheckInLatitude sets the "check_in_latitude" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckInLatitude(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckInLatitude(*f)
+	}
+	return auo
+}
+
+// AddCheckInLatitude adds f to the "check_in_latitude" field.
+func (auo *AttendanceUpdateOne) AddCheckInLatitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckInLatitude(f)
+	return auo
+}
+
+// ClearCheckInLatitude clears the value of the "check_in_latitude" field.
+func (auo *AttendanceUpdateOne) ClearCheckInLatitude() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInLatitude()
+	return auo
+}
+
+// SetCheckInLongitude sets the "check_in_longitude" field.
+func (auo *AttendanceUpdateOne) SetCheckInLongitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckInLongitude()
+	auo.mutation.SetCheckInLongitude(f)
+	return auo
+}
+
+// SetNillableCheckInLongitude sets the "check_in_longitude" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckInLongitude(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckInLongitude(*f)
+	}
+	return auo
+}
+
+// AddCheckInLongitude adds f to the "check_in_longitude" field.
+func (auo *AttendanceUpdateOne) AddCheckInLongitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckInLongitude(f)
+	return auo
+}
+
+// ClearCheckInLongitude clears the value of the "check_in_longitude" field.
+func (auo *AttendanceUpdateOne) ClearCheckInLongitude() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInLongitude()
+	return auo
+}
+
+// SetCheckInDistanceMeters sets the "check_in_distance_meters" field.
+func (auo *AttendanceUpdateOne) SetCheckInDistanceMeters(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckInDistanceMeters()
+	auo.mutation.SetCheckInDistanceMeters(f)
+	return auo
+}
+
+// SetNillableCheckInDistanceMeters sets the "check_in_distance_meters" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckInDistanceMeters(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckInDistanceMeters(*f)
+	}
+	return auo
+}
+
+// AddCheckInDistanceMeters adds f to the "check_in_distance_meters" field.
+func (auo *AttendanceUpdateOne) AddCheckInDistanceMeters(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckInDistanceMeters(f)
+	return auo
+}
+
+// ClearCheckInDistanceMeters clears the value of the "check_in_distance_meters" field.
+func (auo *AttendanceUpdateOne) ClearCheckInDistanceMeters() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInDistanceMeters()
+	return auo
+}
+
+// SetCheckOutLatitude sets the "check_out_latitude" field.
+func (auo *AttendanceUpdateOne) SetCheckOutLatitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckOutLatitude()
+	auo.mutation.SetCheckOutLatitude(f)
+	return auo
+}
+
+// SetNillableCheckOutLatitude sets the "check_out_latitude" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckOutLatitude(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckOutLatitude(*f)
+	}
+	return auo
+}
+
+// AddCheckOutLatitude adds f to the "check_out_latitude" field.
+func (auo *AttendanceUpdateOne) AddCheckOutLatitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckOutLatitude(f)
+	return auo
+}
+
+// ClearCheckOutLatitude clears the value of the "check_out_latitude" field.
+func (auo *AttendanceUpdateOne) ClearCheckOutLatitude() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckOutLatitude()
+	return auo
+}
+
+// SetCheckOutLongitude sets the "check_out_longitude" field.
+func (auo *AttendanceUpdateOne) SetCheckOutLongitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckOutLongitude()
+	auo.mutation.SetCheckOutLongitude(f)
+	return auo
+}
+
+// SetNillableCheckOutLongitude sets the "check_out_longitude" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckOutLongitude(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckOutLongitude(*f)
+	}
+	return auo
+}
+
+// AddCheckOutLongitude adds f to the "check_out_longitude" field.
+func (auo *AttendanceUpdateOne) AddCheckOutLongitude(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckOutLongitude(f)
+	return auo
+}
+
+// ClearCheckOutLongitude clears the value of the "check_out_longitude" field.
+func (auo *AttendanceUpdateOne) ClearCheckOutLongitude() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckOutLongitude()
+	return auo
+}
+
+// SetCheckOutDistanceMeters sets the "check_out_distance_meters" field.
+func (auo *AttendanceUpdateOne) SetCheckOutDistanceMeters(f float64) *AttendanceUpdateOne {
+	auo.mutation.ResetCheckOutDistanceMeters()
+	auo.mutation.SetCheckOutDistanceMeters(f)
+	return auo
+}
+
+// SetNillableCheckOutDistanceMeters sets the "check_out_distance_meters" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckOutDistanceMeters(f *float64) *AttendanceUpdateOne {
+	if f != nil {
+		auo.SetCheckOutDistanceMeters(*f)
+	}
+	return auo
+}
+
+// AddCheckOutDistanceMeters adds f to the "check_out_distance_meters" field.
+func (auo *AttendanceUpdateOne) AddCheckOutDistanceMeters(f float64) *AttendanceUpdateOne {
+	auo.mutation.AddCheckOutDistanceMeters(f)
+	return auo
+}
+
+// ClearCheckOutDistanceMeters clears the value of the "check_out_distance_meters" field.
+func (auo *AttendanceUpdateOne) ClearCheckOutDistanceMeters() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckOutDistanceMeters()
+	return auo
+}
+
+// SetLocationFlagged sets the "location_flagged" field.
+func (auo *AttendanceUpdateOne) SetLocationFlagged(b bool) *AttendanceUpdateOne {
+	auo.mutation.SetLocationFlagged(b)
+	return auo
+}
+
+// SetNillableLocationFlagged sets the "location_flagged" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableLocationFlagged(b *bool) *AttendanceUpdateOne {
+	if b != nil {
+		auo.SetLocationFlagged(*b)
+	}
+	return auo
+}
+
+// SetAutoClosed sets the "auto_closed" field.
+func (auo *AttendanceUpdateOne) SetAutoClosed(b bool) *AttendanceUpdateOne {
+	auo.mutation.SetAutoClosed(b)
+	return auo
+}
+
+// SetNillableAutoClosed sets the "auto_closed" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableAutoClosed(b *bool) *AttendanceUpdateOne {
+	if b != nil {
+		auo.SetAutoClosed(*b)
+	}
+	return auo
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (auo *AttendanceUpdateOne) SetWorkedMinutes(i int) *AttendanceUpdateOne {
+	auo.mutation.ResetWorkedMinutes()
+	auo.mutation.SetWorkedMinutes(i)
 	return auo
 }
 
-// SetEmployeeID sets the "employee_id" field.
-func (auo *AttendanceUpdateOne) SetEmployeeID(u uint64) *AttendanceUpdateOne {
-	auo.mutation.SetEmployeeID(u)
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableWorkedMinutes(i *int) *AttendanceUpdateOne {
+	if i != nil {
+		auo.SetWorkedMinutes(*i)
+	}
 	return auo
 }
 
-// SetAttendanceDate sets the "attendance_date" field.
-func (auo *AttendanceUpdateOne) SetAttendanceDate(t time.Time) *AttendanceUpdateOne {
-	auo.mutation.SetAttendanceDate(t)
+// AddWorkedMinutes adds i to the "worked_minutes" field.
+func (auo *AttendanceUpdateOne) AddWorkedMinutes(i int) *AttendanceUpdateOne {
+	auo.mutation.AddWorkedMinutes(i)
 	return auo
 }
 
-// SetCheckInTime sets the "check_in_time" field.
-func (auo *AttendanceUpdateOne) SetCheckInTime(t time.Time) *AttendanceUpdateOne {
-	auo.mutation.SetCheckInTime(t)
+// SetBreakMinutes sets the "break_minutes" field.
+func (auo *AttendanceUpdateOne) SetBreakMinutes(i int) *AttendanceUpdateOne {
+	auo.mutation.ResetBreakMinutes()
+	auo.mutation.SetBreakMinutes(i)
 	return auo
 }
 
-// SetNillableCheckInTime sets the "check_in_time" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableCheckInTime(t *time.Time) *AttendanceUpdateOne {
-	if t != nil {
-		auo.SetCheckInTime(*t)
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableBreakMinutes(i *int) *AttendanceUpdateOne {
+	if i != nil {
+		auo.SetBreakMinutes(*i)
 	}
 	return auo
 }
 
-// ClearCheckInTime clears the value of the "check_in_time" field.
-func (auo *AttendanceUpdateOne) ClearCheckInTime() *AttendanceUpdateOne {
-	auo.mutation.ClearCheckInTime()
+// AddBreakMinutes adds i to the "break_minutes" field.
+func (auo *AttendanceUpdateOne) AddBreakMinutes(i int) *AttendanceUpdateOne {
+	auo.mutation.AddBreakMinutes(i)
 	return auo
 }
 
-// SetCheckOutTime sets the "check_out_time" field.
-func (auo *AttendanceUpdateOne) SetCheckOutTime(t time.Time) *AttendanceUpdateOne {
-	auo.mutation.SetCheckOutTime(t)
+// SetCheckInKioskID sets the "check_in_kiosk_id" field.
+func (auo *AttendanceUpdateOne) SetCheckInKioskID(u uint64) *AttendanceUpdateOne {
+	auo.mutation.SetCheckInKioskID(u)
 	return auo
 }
 
-// SetNillableCheckOutTime sets the "check_out_time" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableCheckOutTime(t *time.Time) *AttendanceUpdateOne {
-	if t != nil {
-		auo.SetCheckOutTime(*t)
+// SetNillableCheckInKioskID sets the "check_in_kiosk_id" field if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableCheckInKioskID(u *uint64) *AttendanceUpdateOne {
+	if u != nil {
+		auo.SetCheckInKioskID(*u)
 	}
 	return auo
 }
 
-// ClearCheckOutTime clears the value of the "check_out_time" field.
-func (auo *AttendanceUpdateOne) ClearCheckOutTime() *AttendanceUpdateOne {
-	auo.mutation.ClearCheckOutTime()
+// ClearCheckInKioskID clears the value of the "check_in_kiosk_id" field.
+func (auo *AttendanceUpdateOne) ClearCheckInKioskID() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInKioskID()
 	return auo
 }
 
-// SetStatus sets the "status" field.
-func (auo *AttendanceUpdateOne) SetStatus(a attendance.Status) *AttendanceUpdateOne {
-	auo.mutation.SetStatus(a)
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (auo *AttendanceUpdateOne) SetEmployee(e *Employee) *AttendanceUpdateOne {
+	return auo.SetEmployeeID(e.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (auo *AttendanceUpdateOne) AddCorrectionIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.AddCorrectionIDs(ids...)
 	return auo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableStatus(a *attendance.Status) *AttendanceUpdateOne {
-	if a != nil {
-		auo.SetStatus(*a)
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (auo *AttendanceUpdateOne) AddCorrections(a ...*AttendanceCorrection) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return auo
+	return auo.AddCorrectionIDs(ids...)
 }
 
-// SetIsWeekend sets the "is_weekend" field.
-func (auo *AttendanceUpdateOne) SetIsWeekend(b bool) *AttendanceUpdateOne {
-	auo.mutation.SetIsWeekend(b)
+// AddAnomalyIDs adds the "anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (auo *AttendanceUpdateOne) AddAnomalyIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.AddAnomalyIDs(ids...)
 	return auo
 }
 
-// SetNillableIsWeekend sets the "is_weekend" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableIsWeekend(b *bool) *AttendanceUpdateOne {
-	if b != nil {
-		auo.SetIsWeekend(*b)
+// AddAnomalies adds the "anomalies" edges to the AttendanceAnomaly entity.
+func (auo *AttendanceUpdateOne) AddAnomalies(a ...*AttendanceAnomaly) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return auo
+	return auo.AddAnomalyIDs(ids...)
 }
 
-// SetNotes sets the "notes" field.
-func (auo *AttendanceUpdateOne) SetNotes(s string) *AttendanceUpdateOne {
-	auo.mutation.SetNotes(s)
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (auo *AttendanceUpdateOne) AddPunchIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.AddPunchIDs(ids...)
 	return auo
 }
 
-// SetNillableNotes sets the "notes" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableNotes(s *string) *AttendanceUpdateOne {
-	if s != nil {
-		auo.SetNotes(*s)
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (auo *AttendanceUpdateOne) AddPunches(a ...*AttendancePunch) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return auo
+	return auo.AddPunchIDs(ids...)
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (auo *AttendanceUpdateOne) ClearNotes() *AttendanceUpdateOne {
-	auo.mutation.ClearNotes()
+// SetOvertimeID sets the "overtime" edge to the Overtime entity by ID.
+func (auo *AttendanceUpdateOne) SetOvertimeID(id uint64) *AttendanceUpdateOne {
+	auo.mutation.SetOvertimeID(id)
 	return auo
 }
 
-// SetMarkedByAdmin sets the "marked_by_admin" field.
-func (auo *AttendanceUpdateOne) SetMarkedByAdmin(b bool) *AttendanceUpdateOne {
-	auo.mutation.SetMarkedByAdmin(b)
+// SetNillableOvertimeID sets the "overtime" edge to the Overtime entity by ID if the given value is not nil.
+func (auo *AttendanceUpdateOne) SetNillableOvertimeID(id *uint64) *AttendanceUpdateOne {
+	if id != nil {
+		auo = auo.SetOvertimeID(*id)
+	}
 	return auo
 }
 
-// SetNillableMarkedByAdmin sets the "marked_by_admin" field if the given value is not nil.
-func (auo *AttendanceUpdateOne) SetNillableMarkedByAdmin(b *bool) *AttendanceUpdateOne {
-	if b != nil {
-		auo.SetMarkedByAdmin(*b)
-	}
-	return auo
+// SetOvertime sets the "overtime" edge to the Overtime entity.
+func (auo *AttendanceUpdateOne) SetOvertime(o *Overtime) *AttendanceUpdateOne {
+	return auo.SetOvertimeID(o.ID)
 }
 
-// SetEmployee sets the "employee" edge to the Employee entity.
-func (auo *AttendanceUpdateOne) SetEmployee(e *Employee) *AttendanceUpdateOne {
-	return auo.SetEmployeeID(e.ID)
+// SetCheckInKiosk sets the "check_in_kiosk" edge to the Kiosk entity.
+func (auo *AttendanceUpdateOne) SetCheckInKiosk(k *Kiosk) *AttendanceUpdateOne {
+	return auo.SetCheckInKioskID(k.ID)
 }
 
 // Mutation returns the AttendanceMutation object of the builder.
@@ -499,6 +1516,81 @@ func (auo *AttendanceUpdateOne) ClearEmployee() *AttendanceUpdateOne {
 	return auo
 }
 
+// ClearCorrections clears all "corrections" edges to the AttendanceCorrection entity.
+func (auo *AttendanceUpdateOne) ClearCorrections() *AttendanceUpdateOne {
+	auo.mutation.ClearCorrections()
+	return auo
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to AttendanceCorrection entities by IDs.
+func (auo *AttendanceUpdateOne) RemoveCorrectionIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.RemoveCorrectionIDs(ids...)
+	return auo
+}
+
+// RemoveCorrections removes "corrections" edges to AttendanceCorrection entities.
+func (auo *AttendanceUpdateOne) RemoveCorrections(a ...*AttendanceCorrection) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveCorrectionIDs(ids...)
+}
+
+// ClearAnomalies clears all "anomalies" edges to the AttendanceAnomaly entity.
+func (auo *AttendanceUpdateOne) ClearAnomalies() *AttendanceUpdateOne {
+	auo.mutation.ClearAnomalies()
+	return auo
+}
+
+// RemoveAnomalyIDs removes the "anomalies" edge to AttendanceAnomaly entities by IDs.
+func (auo *AttendanceUpdateOne) RemoveAnomalyIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.RemoveAnomalyIDs(ids...)
+	return auo
+}
+
+// RemoveAnomalies removes "anomalies" edges to AttendanceAnomaly entities.
+func (auo *AttendanceUpdateOne) RemoveAnomalies(a ...*AttendanceAnomaly) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveAnomalyIDs(ids...)
+}
+
+// ClearPunches clears all "punches" edges to the AttendancePunch entity.
+func (auo *AttendanceUpdateOne) ClearPunches() *AttendanceUpdateOne {
+	auo.mutation.ClearPunches()
+	return auo
+}
+
+// RemovePunchIDs removes the "punches" edge to AttendancePunch entities by IDs.
+func (auo *AttendanceUpdateOne) RemovePunchIDs(ids ...uint64) *AttendanceUpdateOne {
+	auo.mutation.RemovePunchIDs(ids...)
+	return auo
+}
+
+// RemovePunches removes "punches" edges to AttendancePunch entities.
+func (auo *AttendanceUpdateOne) RemovePunches(a ...*AttendancePunch) *AttendanceUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemovePunchIDs(ids...)
+}
+
+// ClearOvertime clears the "overtime" edge to the Overtime entity.
+func (auo *AttendanceUpdateOne) ClearOvertime() *AttendanceUpdateOne {
+	auo.mutation.ClearOvertime()
+	return auo
+}
+
+// ClearCheckInKiosk clears the "check_in_kiosk" edge to the Kiosk entity.
+func (auo *AttendanceUpdateOne) ClearCheckInKiosk() *AttendanceUpdateOne {
+	auo.mutation.ClearCheckInKiosk()
+	return auo
+}
+
 // Where appends a list predicates to the AttendanceUpdate builder.
 func (auo *AttendanceUpdateOne) Where(ps ...predicate.Attendance) *AttendanceUpdateOne {
 	auo.mutation.Where(ps...)
@@ -623,6 +1715,12 @@ func (auo *AttendanceUpdateOne) sqlSave(ctx context.Context) (_node *Attendance,
 	if value, ok := auo.mutation.Status(); ok {
 		_spec.SetField(attendance.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := auo.mutation.StatusMetadata(); ok {
+		_spec.SetField(attendance.FieldStatusMetadata, field.TypeJSON, value)
+	}
+	if auo.mutation.StatusMetadataCleared() {
+		_spec.ClearField(attendance.FieldStatusMetadata, field.TypeJSON)
+	}
 	if value, ok := auo.mutation.IsWeekend(); ok {
 		_spec.SetField(attendance.FieldIsWeekend, field.TypeBool, value)
 	}
@@ -635,6 +1733,78 @@ func (auo *AttendanceUpdateOne) sqlSave(ctx context.Context) (_node *Attendance,
 	if value, ok := auo.mutation.MarkedByAdmin(); ok {
 		_spec.SetField(attendance.FieldMarkedByAdmin, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.CheckInLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckInLatitude(); ok {
+		_spec.AddField(attendance.FieldCheckInLatitude, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckInLatitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckInLatitude, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.CheckInLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckInLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckInLongitude(); ok {
+		_spec.AddField(attendance.FieldCheckInLongitude, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckInLongitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckInLongitude, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.CheckInDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckInDistanceMeters(); ok {
+		_spec.AddField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckInDistanceMetersCleared() {
+		_spec.ClearField(attendance.FieldCheckInDistanceMeters, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.CheckOutLatitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckOutLatitude(); ok {
+		_spec.AddField(attendance.FieldCheckOutLatitude, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckOutLatitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckOutLatitude, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.CheckOutLongitude(); ok {
+		_spec.SetField(attendance.FieldCheckOutLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckOutLongitude(); ok {
+		_spec.AddField(attendance.FieldCheckOutLongitude, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckOutLongitudeCleared() {
+		_spec.ClearField(attendance.FieldCheckOutLongitude, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.CheckOutDistanceMeters(); ok {
+		_spec.SetField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedCheckOutDistanceMeters(); ok {
+		_spec.AddField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64, value)
+	}
+	if auo.mutation.CheckOutDistanceMetersCleared() {
+		_spec.ClearField(attendance.FieldCheckOutDistanceMeters, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.LocationFlagged(); ok {
+		_spec.SetField(attendance.FieldLocationFlagged, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.AutoClosed(); ok {
+		_spec.SetField(attendance.FieldAutoClosed, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.WorkedMinutes(); ok {
+		_spec.SetField(attendance.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedWorkedMinutes(); ok {
+		_spec.AddField(attendance.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.BreakMinutes(); ok {
+		_spec.SetField(attendance.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedBreakMinutes(); ok {
+		_spec.AddField(attendance.FieldBreakMinutes, field.TypeInt, value)
+	}
 	if auo.mutation.EmployeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -664,6 +1834,199 @@ func (auo *AttendanceUpdateOne) sqlSave(ctx context.Context) (_node *Attendance,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !auo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.CorrectionsTable,
+			Columns: []string{attendance.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedAnomaliesIDs(); len(nodes) > 0 && !auo.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.AnomaliesTable,
+			Columns: []string{attendance.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !auo.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendance.PunchesTable,
+			Columns: []string{attendance.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.OvertimeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attendance.OvertimeTable,
+			Columns: []string{attendance.OvertimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OvertimeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attendance.OvertimeTable,
+			Columns: []string{attendance.OvertimeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.CheckInKioskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendance.CheckInKioskTable,
+			Columns: []string{attendance.CheckInKioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CheckInKioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendance.CheckInKioskTable,
+			Columns: []string{attendance.CheckInKioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(auo.modifiers...)
 	_node = &Attendance{config: auo.config}
 	_spec.Assign = _node.assignValues
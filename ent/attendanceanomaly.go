@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/employee"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttendanceAnomaly is the model entity for the AttendanceAnomaly schema.
+type AttendanceAnomaly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to attendances table
+	AttendanceID uint64 `json:"attendance_id,omitempty"`
+	// Employee whose attendance looks wrong
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Date of the attendance, copied for filtering by payroll month
+	AttendanceDate time.Time `json:"attendance_date,omitempty"`
+	// Detection rule that found the anomaly
+	Rule attendanceanomaly.Rule `json:"rule,omitempty"`
+	// What the rule found, e.g. the length of the day
+	Details string `json:"details,omitempty"`
+	// Status holds the value of the "status" field.
+	Status attendanceanomaly.Status `json:"status,omitempty"`
+	// HR user who resolved the anomaly, empty when the detector found it fixed
+	ResolvedBy *uint64 `json:"resolved_by,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ResolutionNotes holds the value of the "resolution_notes" field.
+	ResolutionNotes string `json:"resolution_notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendanceAnomalyQuery when eager-loading is set.
+	Edges        AttendanceAnomalyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendanceAnomalyEdges holds the relations/edges for other nodes in the graph.
+type AttendanceAnomalyEdges struct {
+	// Attendance holds the value of the attendance edge.
+	Attendance *Attendance `json:"attendance,omitempty"`
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttendanceOrErr returns the Attendance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceAnomalyEdges) AttendanceOrErr() (*Attendance, error) {
+	if e.loadedTypes[0] {
+		if e.Attendance == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: attendance.Label}
+		}
+		return e.Attendance, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance"}
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceAnomalyEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[1] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendanceAnomaly) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendanceanomaly.FieldID, attendanceanomaly.FieldAttendanceID, attendanceanomaly.FieldEmployeeID, attendanceanomaly.FieldResolvedBy:
+			values[i] = new(sql.NullInt64)
+		case attendanceanomaly.FieldRule, attendanceanomaly.FieldDetails, attendanceanomaly.FieldStatus, attendanceanomaly.FieldResolutionNotes:
+			values[i] = new(sql.NullString)
+		case attendanceanomaly.FieldCreatedAt, attendanceanomaly.FieldModifiedAt, attendanceanomaly.FieldDeletedAt, attendanceanomaly.FieldAttendanceDate, attendanceanomaly.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendanceAnomaly fields.
+func (aa *AttendanceAnomaly) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendanceanomaly.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			aa.ID = uint64(value.Int64)
+		case attendanceanomaly.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				aa.CreatedAt = value.Time
+			}
+		case attendanceanomaly.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				aa.ModifiedAt = value.Time
+			}
+		case attendanceanomaly.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				aa.DeletedAt = value.Time
+			}
+		case attendanceanomaly.FieldAttendanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_id", values[i])
+			} else if value.Valid {
+				aa.AttendanceID = uint64(value.Int64)
+			}
+		case attendanceanomaly.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				aa.EmployeeID = uint64(value.Int64)
+			}
+		case attendanceanomaly.FieldAttendanceDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_date", values[i])
+			} else if value.Valid {
+				aa.AttendanceDate = value.Time
+			}
+		case attendanceanomaly.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				aa.Rule = attendanceanomaly.Rule(value.String)
+			}
+		case attendanceanomaly.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				aa.Details = value.String
+			}
+		case attendanceanomaly.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				aa.Status = attendanceanomaly.Status(value.String)
+			}
+		case attendanceanomaly.FieldResolvedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by", values[i])
+			} else if value.Valid {
+				aa.ResolvedBy = new(uint64)
+				*aa.ResolvedBy = uint64(value.Int64)
+			}
+		case attendanceanomaly.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				aa.ResolvedAt = new(time.Time)
+				*aa.ResolvedAt = value.Time
+			}
+		case attendanceanomaly.FieldResolutionNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_notes", values[i])
+			} else if value.Valid {
+				aa.ResolutionNotes = value.String
+			}
+		default:
+			aa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendanceAnomaly.
+// This includes values selected through modifiers, order, etc.
+func (aa *AttendanceAnomaly) Value(name string) (ent.Value, error) {
+	return aa.selectValues.Get(name)
+}
+
+// QueryAttendance queries the "attendance" edge of the AttendanceAnomaly entity.
+func (aa *AttendanceAnomaly) QueryAttendance() *AttendanceQuery {
+	return NewAttendanceAnomalyClient(aa.config).QueryAttendance(aa)
+}
+
+// QueryEmployee queries the "employee" edge of the AttendanceAnomaly entity.
+func (aa *AttendanceAnomaly) QueryEmployee() *EmployeeQuery {
+	return NewAttendanceAnomalyClient(aa.config).QueryEmployee(aa)
+}
+
+// Update returns a builder for updating this AttendanceAnomaly.
+// Note that you need to call AttendanceAnomaly.Unwrap() before calling this method if this AttendanceAnomaly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aa *AttendanceAnomaly) Update() *AttendanceAnomalyUpdateOne {
+	return NewAttendanceAnomalyClient(aa.config).UpdateOne(aa)
+}
+
+// Unwrap unwraps the AttendanceAnomaly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aa *AttendanceAnomaly) Unwrap() *AttendanceAnomaly {
+	_tx, ok := aa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendanceAnomaly is not a transactional entity")
+	}
+	aa.config.driver = _tx.drv
+	return aa
+}
+
+// String implements the fmt.Stringer.
+func (aa *AttendanceAnomaly) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendanceAnomaly(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", aa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(aa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(aa.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(aa.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_id=")
+	builder.WriteString(fmt.Sprintf("%v", aa.AttendanceID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", aa.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_date=")
+	builder.WriteString(aa.AttendanceDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Rule))
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(aa.Details)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Status))
+	builder.WriteString(", ")
+	if v := aa.ResolvedBy; v != nil {
+		builder.WriteString("resolved_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := aa.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution_notes=")
+	builder.WriteString(aa.ResolutionNotes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendanceAnomalies is a parsable slice of AttendanceAnomaly.
+type AttendanceAnomalies []*AttendanceAnomaly
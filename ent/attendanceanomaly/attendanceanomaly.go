@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendanceanomaly
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attendanceanomaly type in the database.
+	Label = "attendance_anomaly"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldAttendanceID holds the string denoting the attendance_id field in the database.
+	FieldAttendanceID = "attendance_id"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldAttendanceDate holds the string denoting the attendance_date field in the database.
+	FieldAttendanceDate = "attendance_date"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResolvedBy holds the string denoting the resolved_by field in the database.
+	FieldResolvedBy = "resolved_by"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldResolutionNotes holds the string denoting the resolution_notes field in the database.
+	FieldResolutionNotes = "resolution_notes"
+	// EdgeAttendance holds the string denoting the attendance edge name in mutations.
+	EdgeAttendance = "attendance"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// Table holds the table name of the attendanceanomaly in the database.
+	Table = "attendance_anomalies"
+	// AttendanceTable is the table that holds the attendance relation/edge.
+	AttendanceTable = "attendance_anomalies"
+	// AttendanceInverseTable is the table name for the Attendance entity.
+	// It exists in this package in order to avoid circular dependency with the "attendance" package.
+	AttendanceInverseTable = "attendances"
+	// AttendanceColumn is the table column denoting the attendance relation/edge.
+	AttendanceColumn = "attendance_id"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "attendance_anomalies"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+)
+
+// Columns holds all SQL columns for attendanceanomaly fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldAttendanceID,
+	FieldEmployeeID,
+	FieldAttendanceDate,
+	FieldRule,
+	FieldDetails,
+	FieldStatus,
+	FieldResolvedBy,
+	FieldResolvedAt,
+	FieldResolutionNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// DetailsValidator is a validator for the "details" field. It is called by the builders before save.
+	DetailsValidator func(string) error
+)
+
+// Rule defines the type for the "rule" enum field.
+type Rule string
+
+// Rule values.
+const (
+	RuleMissingCheckOut Rule = "missing_check_out"
+	RuleLongDay         Rule = "long_day"
+	RuleOddHourCheckIn  Rule = "odd_hour_check_in"
+	RuleEarlyCheckOut   Rule = "early_check_out"
+	RuleSkippedPunch    Rule = "skipped_punch"
+)
+
+func (r Rule) String() string {
+	return string(r)
+}
+
+// RuleValidator is a validator for the "rule" field enum values. It is called by the builders before save.
+func RuleValidator(r Rule) error {
+	switch r {
+	case RuleMissingCheckOut, RuleLongDay, RuleOddHourCheckIn, RuleEarlyCheckOut, RuleSkippedPunch:
+		return nil
+	default:
+		return fmt.Errorf("attendanceanomaly: invalid enum value for rule field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen     Status = "open"
+	StatusResolved Status = "resolved"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusResolved:
+		return nil
+	default:
+		return fmt.Errorf("attendanceanomaly: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AttendanceAnomaly queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByAttendanceID orders the results by the attendance_id field.
+func ByAttendanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceID, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByAttendanceDate orders the results by the attendance_date field.
+func ByAttendanceDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceDate, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
+
+// ByDetails orders the results by the details field.
+func ByDetails(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetails, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResolvedBy orders the results by the resolved_by field.
+func ByResolvedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedBy, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByResolutionNotes orders the results by the resolution_notes field.
+func ByResolutionNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionNotes, opts...).ToFunc()
+}
+
+// ByAttendanceField orders the results by attendance field.
+func ByAttendanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttendanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+	)
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
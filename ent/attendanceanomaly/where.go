@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendanceanomaly
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AttendanceID applies equality check predicate on the "attendance_id" field. It's identical to AttendanceIDEQ.
+func AttendanceID(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// AttendanceDate applies equality check predicate on the "attendance_date" field. It's identical to AttendanceDateEQ.
+func AttendanceDate(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldAttendanceDate, v))
+}
+
+// Details applies equality check predicate on the "details" field. It's identical to DetailsEQ.
+func Details(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldDetails, v))
+}
+
+// ResolvedBy applies equality check predicate on the "resolved_by" field. It's identical to ResolvedByEQ.
+func ResolvedBy(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolutionNotes applies equality check predicate on the "resolution_notes" field. It's identical to ResolutionNotesEQ.
+func ResolutionNotes(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolutionNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// AttendanceIDEQ applies the EQ predicate on the "attendance_id" field.
+func AttendanceIDEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDNEQ applies the NEQ predicate on the "attendance_id" field.
+func AttendanceIDNEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDIn applies the In predicate on the "attendance_id" field.
+func AttendanceIDIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldAttendanceID, vs...))
+}
+
+// AttendanceIDNotIn applies the NotIn predicate on the "attendance_id" field.
+func AttendanceIDNotIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldAttendanceID, vs...))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// AttendanceDateEQ applies the EQ predicate on the "attendance_date" field.
+func AttendanceDateEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldAttendanceDate, v))
+}
+
+// AttendanceDateNEQ applies the NEQ predicate on the "attendance_date" field.
+func AttendanceDateNEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldAttendanceDate, v))
+}
+
+// AttendanceDateIn applies the In predicate on the "attendance_date" field.
+func AttendanceDateIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldAttendanceDate, vs...))
+}
+
+// AttendanceDateNotIn applies the NotIn predicate on the "attendance_date" field.
+func AttendanceDateNotIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldAttendanceDate, vs...))
+}
+
+// AttendanceDateGT applies the GT predicate on the "attendance_date" field.
+func AttendanceDateGT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldAttendanceDate, v))
+}
+
+// AttendanceDateGTE applies the GTE predicate on the "attendance_date" field.
+func AttendanceDateGTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldAttendanceDate, v))
+}
+
+// AttendanceDateLT applies the LT predicate on the "attendance_date" field.
+func AttendanceDateLT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldAttendanceDate, v))
+}
+
+// AttendanceDateLTE applies the LTE predicate on the "attendance_date" field.
+func AttendanceDateLTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldAttendanceDate, v))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v Rule) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v Rule) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...Rule) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...Rule) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// DetailsEQ applies the EQ predicate on the "details" field.
+func DetailsEQ(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldDetails, v))
+}
+
+// DetailsNEQ applies the NEQ predicate on the "details" field.
+func DetailsNEQ(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldDetails, v))
+}
+
+// DetailsIn applies the In predicate on the "details" field.
+func DetailsIn(vs ...string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldDetails, vs...))
+}
+
+// DetailsNotIn applies the NotIn predicate on the "details" field.
+func DetailsNotIn(vs ...string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldDetails, vs...))
+}
+
+// DetailsGT applies the GT predicate on the "details" field.
+func DetailsGT(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldDetails, v))
+}
+
+// DetailsGTE applies the GTE predicate on the "details" field.
+func DetailsGTE(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldDetails, v))
+}
+
+// DetailsLT applies the LT predicate on the "details" field.
+func DetailsLT(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldDetails, v))
+}
+
+// DetailsLTE applies the LTE predicate on the "details" field.
+func DetailsLTE(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldDetails, v))
+}
+
+// DetailsContains applies the Contains predicate on the "details" field.
+func DetailsContains(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldContains(FieldDetails, v))
+}
+
+// DetailsHasPrefix applies the HasPrefix predicate on the "details" field.
+func DetailsHasPrefix(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldHasPrefix(FieldDetails, v))
+}
+
+// DetailsHasSuffix applies the HasSuffix predicate on the "details" field.
+func DetailsHasSuffix(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldHasSuffix(FieldDetails, v))
+}
+
+// DetailsEqualFold applies the EqualFold predicate on the "details" field.
+func DetailsEqualFold(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEqualFold(FieldDetails, v))
+}
+
+// DetailsContainsFold applies the ContainsFold predicate on the "details" field.
+func DetailsContainsFold(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldContainsFold(FieldDetails, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResolvedByEQ applies the EQ predicate on the "resolved_by" field.
+func ResolvedByEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByNEQ applies the NEQ predicate on the "resolved_by" field.
+func ResolvedByNEQ(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByIn applies the In predicate on the "resolved_by" field.
+func ResolvedByIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByNotIn applies the NotIn predicate on the "resolved_by" field.
+func ResolvedByNotIn(vs ...uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByGT applies the GT predicate on the "resolved_by" field.
+func ResolvedByGT(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldResolvedBy, v))
+}
+
+// ResolvedByGTE applies the GTE predicate on the "resolved_by" field.
+func ResolvedByGTE(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldResolvedBy, v))
+}
+
+// ResolvedByLT applies the LT predicate on the "resolved_by" field.
+func ResolvedByLT(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldResolvedBy, v))
+}
+
+// ResolvedByLTE applies the LTE predicate on the "resolved_by" field.
+func ResolvedByLTE(v uint64) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldResolvedBy, v))
+}
+
+// ResolvedByIsNil applies the IsNil predicate on the "resolved_by" field.
+func ResolvedByIsNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIsNull(FieldResolvedBy))
+}
+
+// ResolvedByNotNil applies the NotNil predicate on the "resolved_by" field.
+func ResolvedByNotNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotNull(FieldResolvedBy))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// ResolutionNotesEQ applies the EQ predicate on the "resolution_notes" field.
+func ResolutionNotesEQ(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEQ(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesNEQ applies the NEQ predicate on the "resolution_notes" field.
+func ResolutionNotesNEQ(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNEQ(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesIn applies the In predicate on the "resolution_notes" field.
+func ResolutionNotesIn(vs ...string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIn(FieldResolutionNotes, vs...))
+}
+
+// ResolutionNotesNotIn applies the NotIn predicate on the "resolution_notes" field.
+func ResolutionNotesNotIn(vs ...string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotIn(FieldResolutionNotes, vs...))
+}
+
+// ResolutionNotesGT applies the GT predicate on the "resolution_notes" field.
+func ResolutionNotesGT(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGT(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesGTE applies the GTE predicate on the "resolution_notes" field.
+func ResolutionNotesGTE(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldGTE(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesLT applies the LT predicate on the "resolution_notes" field.
+func ResolutionNotesLT(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLT(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesLTE applies the LTE predicate on the "resolution_notes" field.
+func ResolutionNotesLTE(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldLTE(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesContains applies the Contains predicate on the "resolution_notes" field.
+func ResolutionNotesContains(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldContains(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesHasPrefix applies the HasPrefix predicate on the "resolution_notes" field.
+func ResolutionNotesHasPrefix(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldHasPrefix(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesHasSuffix applies the HasSuffix predicate on the "resolution_notes" field.
+func ResolutionNotesHasSuffix(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldHasSuffix(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesIsNil applies the IsNil predicate on the "resolution_notes" field.
+func ResolutionNotesIsNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldIsNull(FieldResolutionNotes))
+}
+
+// ResolutionNotesNotNil applies the NotNil predicate on the "resolution_notes" field.
+func ResolutionNotesNotNil() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldNotNull(FieldResolutionNotes))
+}
+
+// ResolutionNotesEqualFold applies the EqualFold predicate on the "resolution_notes" field.
+func ResolutionNotesEqualFold(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldEqualFold(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesContainsFold applies the ContainsFold predicate on the "resolution_notes" field.
+func ResolutionNotesContainsFold(v string) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(sql.FieldContainsFold(FieldResolutionNotes, v))
+}
+
+// HasAttendance applies the HasEdge predicate on the "attendance" edge.
+func HasAttendance() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceWith applies the HasEdge predicate on the "attendance" edge with a given conditions (other predicates).
+func HasAttendanceWith(preds ...predicate.Attendance) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		step := newAttendanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendanceAnomaly) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendanceAnomaly) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendanceAnomaly) predicate.AttendanceAnomaly {
+	return predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
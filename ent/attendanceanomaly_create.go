@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/employee"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceAnomalyCreate is the builder for creating a AttendanceAnomaly entity.
+type AttendanceAnomalyCreate struct {
+	config
+	mutation *AttendanceAnomalyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aac *AttendanceAnomalyCreate) SetCreatedAt(t time.Time) *AttendanceAnomalyCreate {
+	aac.mutation.SetCreatedAt(t)
+	return aac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableCreatedAt(t *time.Time) *AttendanceAnomalyCreate {
+	if t != nil {
+		aac.SetCreatedAt(*t)
+	}
+	return aac
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (aac *AttendanceAnomalyCreate) SetModifiedAt(t time.Time) *AttendanceAnomalyCreate {
+	aac.mutation.SetModifiedAt(t)
+	return aac
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableModifiedAt(t *time.Time) *AttendanceAnomalyCreate {
+	if t != nil {
+		aac.SetModifiedAt(*t)
+	}
+	return aac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aac *AttendanceAnomalyCreate) SetDeletedAt(t time.Time) *AttendanceAnomalyCreate {
+	aac.mutation.SetDeletedAt(t)
+	return aac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableDeletedAt(t *time.Time) *AttendanceAnomalyCreate {
+	if t != nil {
+		aac.SetDeletedAt(*t)
+	}
+	return aac
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (aac *AttendanceAnomalyCreate) SetAttendanceID(u uint64) *AttendanceAnomalyCreate {
+	aac.mutation.SetAttendanceID(u)
+	return aac
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (aac *AttendanceAnomalyCreate) SetEmployeeID(u uint64) *AttendanceAnomalyCreate {
+	aac.mutation.SetEmployeeID(u)
+	return aac
+}
+
+// SetAttendanceDate sets the "attendance_date" field.
+func (aac *AttendanceAnomalyCreate) SetAttendanceDate(t time.Time) *AttendanceAnomalyCreate {
+	aac.mutation.SetAttendanceDate(t)
+	return aac
+}
+
+// SetRule sets the "rule" field.
+func (aac *AttendanceAnomalyCreate) SetRule(a attendanceanomaly.Rule) *AttendanceAnomalyCreate {
+	aac.mutation.SetRule(a)
+	return aac
+}
+
+// SetDetails sets the "details" field.
+func (aac *AttendanceAnomalyCreate) SetDetails(s string) *AttendanceAnomalyCreate {
+	aac.mutation.SetDetails(s)
+	return aac
+}
+
+// SetStatus sets the "status" field.
+func (aac *AttendanceAnomalyCreate) SetStatus(a attendanceanomaly.Status) *AttendanceAnomalyCreate {
+	aac.mutation.SetStatus(a)
+	return aac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableStatus(a *attendanceanomaly.Status) *AttendanceAnomalyCreate {
+	if a != nil {
+		aac.SetStatus(*a)
+	}
+	return aac
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (aac *AttendanceAnomalyCreate) SetResolvedBy(u uint64) *AttendanceAnomalyCreate {
+	aac.mutation.SetResolvedBy(u)
+	return aac
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableResolvedBy(u *uint64) *AttendanceAnomalyCreate {
+	if u != nil {
+		aac.SetResolvedBy(*u)
+	}
+	return aac
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (aac *AttendanceAnomalyCreate) SetResolvedAt(t time.Time) *AttendanceAnomalyCreate {
+	aac.mutation.SetResolvedAt(t)
+	return aac
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableResolvedAt(t *time.Time) *AttendanceAnomalyCreate {
+	if t != nil {
+		aac.SetResolvedAt(*t)
+	}
+	return aac
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (aac *AttendanceAnomalyCreate) SetResolutionNotes(s string) *AttendanceAnomalyCreate {
+	aac.mutation.SetResolutionNotes(s)
+	return aac
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (aac *AttendanceAnomalyCreate) SetNillableResolutionNotes(s *string) *AttendanceAnomalyCreate {
+	if s != nil {
+		aac.SetResolutionNotes(*s)
+	}
+	return aac
+}
+
+// SetID sets the "id" field.
+func (aac *AttendanceAnomalyCreate) SetID(u uint64) *AttendanceAnomalyCreate {
+	aac.mutation.SetID(u)
+	return aac
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (aac *AttendanceAnomalyCreate) SetAttendance(a *Attendance) *AttendanceAnomalyCreate {
+	return aac.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (aac *AttendanceAnomalyCreate) SetEmployee(e *Employee) *AttendanceAnomalyCreate {
+	return aac.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceAnomalyMutation object of the builder.
+func (aac *AttendanceAnomalyCreate) Mutation() *AttendanceAnomalyMutation {
+	return aac.mutation
+}
+
+// Save creates the AttendanceAnomaly in the database.
+func (aac *AttendanceAnomalyCreate) Save(ctx context.Context) (*AttendanceAnomaly, error) {
+	aac.defaults()
+	return withHooks(ctx, aac.sqlSave, aac.mutation, aac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aac *AttendanceAnomalyCreate) SaveX(ctx context.Context) *AttendanceAnomaly {
+	v, err := aac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aac *AttendanceAnomalyCreate) Exec(ctx context.Context) error {
+	_, err := aac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aac *AttendanceAnomalyCreate) ExecX(ctx context.Context) {
+	if err := aac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aac *AttendanceAnomalyCreate) defaults() {
+	if _, ok := aac.mutation.CreatedAt(); !ok {
+		v := attendanceanomaly.DefaultCreatedAt()
+		aac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aac.mutation.ModifiedAt(); !ok {
+		v := attendanceanomaly.DefaultModifiedAt()
+		aac.mutation.SetModifiedAt(v)
+	}
+	if _, ok := aac.mutation.Status(); !ok {
+		v := attendanceanomaly.DefaultStatus
+		aac.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aac *AttendanceAnomalyCreate) check() error {
+	if _, ok := aac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendanceAnomaly.created_at"`)}
+	}
+	if _, ok := aac.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "AttendanceAnomaly.modified_at"`)}
+	}
+	if _, ok := aac.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance_id", err: errors.New(`ent: missing required field "AttendanceAnomaly.attendance_id"`)}
+	}
+	if _, ok := aac.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "AttendanceAnomaly.employee_id"`)}
+	}
+	if _, ok := aac.mutation.AttendanceDate(); !ok {
+		return &ValidationError{Name: "attendance_date", err: errors.New(`ent: missing required field "AttendanceAnomaly.attendance_date"`)}
+	}
+	if _, ok := aac.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "AttendanceAnomaly.rule"`)}
+	}
+	if v, ok := aac.mutation.Rule(); ok {
+		if err := attendanceanomaly.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.rule": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.Details(); !ok {
+		return &ValidationError{Name: "details", err: errors.New(`ent: missing required field "AttendanceAnomaly.details"`)}
+	}
+	if v, ok := aac.mutation.Details(); ok {
+		if err := attendanceanomaly.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.details": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AttendanceAnomaly.status"`)}
+	}
+	if v, ok := aac.mutation.Status(); ok {
+		if err := attendanceanomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.status": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance", err: errors.New(`ent: missing required edge "AttendanceAnomaly.attendance"`)}
+	}
+	if _, ok := aac.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "AttendanceAnomaly.employee"`)}
+	}
+	return nil
+}
+
+func (aac *AttendanceAnomalyCreate) sqlSave(ctx context.Context) (*AttendanceAnomaly, error) {
+	if err := aac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	aac.mutation.id = &_node.ID
+	aac.mutation.done = true
+	return _node, nil
+}
+
+func (aac *AttendanceAnomalyCreate) createSpec() (*AttendanceAnomaly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendanceAnomaly{config: aac.config}
+		_spec = sqlgraph.NewCreateSpec(attendanceanomaly.Table, sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64))
+	)
+	if id, ok := aac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aac.mutation.CreatedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aac.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := aac.mutation.DeletedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := aac.mutation.AttendanceDate(); ok {
+		_spec.SetField(attendanceanomaly.FieldAttendanceDate, field.TypeTime, value)
+		_node.AttendanceDate = value
+	}
+	if value, ok := aac.mutation.Rule(); ok {
+		_spec.SetField(attendanceanomaly.FieldRule, field.TypeEnum, value)
+		_node.Rule = value
+	}
+	if value, ok := aac.mutation.Details(); ok {
+		_spec.SetField(attendanceanomaly.FieldDetails, field.TypeString, value)
+		_node.Details = value
+	}
+	if value, ok := aac.mutation.Status(); ok {
+		_spec.SetField(attendanceanomaly.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := aac.mutation.ResolvedBy(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedBy, field.TypeUint64, value)
+		_node.ResolvedBy = &value
+	}
+	if value, ok := aac.mutation.ResolvedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := aac.mutation.ResolutionNotes(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolutionNotes, field.TypeString, value)
+		_node.ResolutionNotes = value
+	}
+	if nodes := aac.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.AttendanceTable,
+			Columns: []string{attendanceanomaly.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aac.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.EmployeeTable,
+			Columns: []string{attendanceanomaly.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttendanceAnomalyCreateBulk is the builder for creating many AttendanceAnomaly entities in bulk.
+type AttendanceAnomalyCreateBulk struct {
+	config
+	builders []*AttendanceAnomalyCreate
+}
+
+// Save creates the AttendanceAnomaly entities in the database.
+func (aacb *AttendanceAnomalyCreateBulk) Save(ctx context.Context) ([]*AttendanceAnomaly, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aacb.builders))
+	nodes := make([]*AttendanceAnomaly, len(aacb.builders))
+	mutators := make([]Mutator, len(aacb.builders))
+	for i := range aacb.builders {
+		func(i int, root context.Context) {
+			builder := aacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendanceAnomalyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aacb *AttendanceAnomalyCreateBulk) SaveX(ctx context.Context) []*AttendanceAnomaly {
+	v, err := aacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aacb *AttendanceAnomalyCreateBulk) Exec(ctx context.Context) error {
+	_, err := aacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aacb *AttendanceAnomalyCreateBulk) ExecX(ctx context.Context) {
+	if err := aacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceAnomalyDelete is the builder for deleting a AttendanceAnomaly entity.
+type AttendanceAnomalyDelete struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceAnomalyMutation
+}
+
+// Where appends a list predicates to the AttendanceAnomalyDelete builder.
+func (aad *AttendanceAnomalyDelete) Where(ps ...predicate.AttendanceAnomaly) *AttendanceAnomalyDelete {
+	aad.mutation.Where(ps...)
+	return aad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aad *AttendanceAnomalyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aad.sqlExec, aad.mutation, aad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aad *AttendanceAnomalyDelete) ExecX(ctx context.Context) int {
+	n, err := aad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aad *AttendanceAnomalyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(attendanceanomaly.Table, sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64))
+	if ps := aad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aad.mutation.done = true
+	return affected, err
+}
+
+// AttendanceAnomalyDeleteOne is the builder for deleting a single AttendanceAnomaly entity.
+type AttendanceAnomalyDeleteOne struct {
+	aad *AttendanceAnomalyDelete
+}
+
+// Where appends a list predicates to the AttendanceAnomalyDelete builder.
+func (aado *AttendanceAnomalyDeleteOne) Where(ps ...predicate.AttendanceAnomaly) *AttendanceAnomalyDeleteOne {
+	aado.aad.mutation.Where(ps...)
+	return aado
+}
+
+// Exec executes the deletion query.
+func (aado *AttendanceAnomalyDeleteOne) Exec(ctx context.Context) error {
+	n, err := aado.aad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{attendanceanomaly.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aado *AttendanceAnomalyDeleteOne) ExecX(ctx context.Context) {
+	if err := aado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
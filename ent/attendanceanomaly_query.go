@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceAnomalyQuery is the builder for querying AttendanceAnomaly entities.
+type AttendanceAnomalyQuery struct {
+	config
+	ctx            *QueryContext
+	order          []attendanceanomaly.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.AttendanceAnomaly
+	withAttendance *AttendanceQuery
+	withEmployee   *EmployeeQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendanceAnomalyQuery builder.
+func (aaq *AttendanceAnomalyQuery) Where(ps ...predicate.AttendanceAnomaly) *AttendanceAnomalyQuery {
+	aaq.predicates = append(aaq.predicates, ps...)
+	return aaq
+}
+
+// Limit the number of records to be returned by this query.
+func (aaq *AttendanceAnomalyQuery) Limit(limit int) *AttendanceAnomalyQuery {
+	aaq.ctx.Limit = &limit
+	return aaq
+}
+
+// Offset to start from.
+func (aaq *AttendanceAnomalyQuery) Offset(offset int) *AttendanceAnomalyQuery {
+	aaq.ctx.Offset = &offset
+	return aaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aaq *AttendanceAnomalyQuery) Unique(unique bool) *AttendanceAnomalyQuery {
+	aaq.ctx.Unique = &unique
+	return aaq
+}
+
+// Order specifies how the records should be ordered.
+func (aaq *AttendanceAnomalyQuery) Order(o ...attendanceanomaly.OrderOption) *AttendanceAnomalyQuery {
+	aaq.order = append(aaq.order, o...)
+	return aaq
+}
+
+// QueryAttendance chains the current query on the "attendance" edge.
+func (aaq *AttendanceAnomalyQuery) QueryAttendance() *AttendanceQuery {
+	query := (&AttendanceClient{config: aaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceanomaly.Table, attendanceanomaly.FieldID, selector),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendanceanomaly.AttendanceTable, attendanceanomaly.AttendanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (aaq *AttendanceAnomalyQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: aaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceanomaly.Table, attendanceanomaly.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendanceanomaly.EmployeeTable, attendanceanomaly.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendanceAnomaly entity from the query.
+// Returns a *NotFoundError when no AttendanceAnomaly was found.
+func (aaq *AttendanceAnomalyQuery) First(ctx context.Context) (*AttendanceAnomaly, error) {
+	nodes, err := aaq.Limit(1).All(setContextOp(ctx, aaq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendanceanomaly.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) FirstX(ctx context.Context) *AttendanceAnomaly {
+	node, err := aaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendanceAnomaly ID from the query.
+// Returns a *NotFoundError when no AttendanceAnomaly ID was found.
+func (aaq *AttendanceAnomalyQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = aaq.Limit(1).IDs(setContextOp(ctx, aaq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendanceanomaly.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := aaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendanceAnomaly entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendanceAnomaly entity is found.
+// Returns a *NotFoundError when no AttendanceAnomaly entities are found.
+func (aaq *AttendanceAnomalyQuery) Only(ctx context.Context) (*AttendanceAnomaly, error) {
+	nodes, err := aaq.Limit(2).All(setContextOp(ctx, aaq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendanceanomaly.Label}
+	default:
+		return nil, &NotSingularError{attendanceanomaly.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) OnlyX(ctx context.Context) *AttendanceAnomaly {
+	node, err := aaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendanceAnomaly ID in the query.
+// Returns a *NotSingularError when more than one AttendanceAnomaly ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aaq *AttendanceAnomalyQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = aaq.Limit(2).IDs(setContextOp(ctx, aaq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendanceanomaly.Label}
+	default:
+		err = &NotSingularError{attendanceanomaly.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := aaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendanceAnomalies.
+func (aaq *AttendanceAnomalyQuery) All(ctx context.Context) ([]*AttendanceAnomaly, error) {
+	ctx = setContextOp(ctx, aaq.ctx, "All")
+	if err := aaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendanceAnomaly, *AttendanceAnomalyQuery]()
+	return withInterceptors[[]*AttendanceAnomaly](ctx, aaq, qr, aaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) AllX(ctx context.Context) []*AttendanceAnomaly {
+	nodes, err := aaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendanceAnomaly IDs.
+func (aaq *AttendanceAnomalyQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if aaq.ctx.Unique == nil && aaq.path != nil {
+		aaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aaq.ctx, "IDs")
+	if err = aaq.Select(attendanceanomaly.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := aaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aaq *AttendanceAnomalyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aaq.ctx, "Count")
+	if err := aaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aaq, querierCount[*AttendanceAnomalyQuery](), aaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) CountX(ctx context.Context) int {
+	count, err := aaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aaq *AttendanceAnomalyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aaq.ctx, "Exist")
+	switch _, err := aaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aaq *AttendanceAnomalyQuery) ExistX(ctx context.Context) bool {
+	exist, err := aaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendanceAnomalyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aaq *AttendanceAnomalyQuery) Clone() *AttendanceAnomalyQuery {
+	if aaq == nil {
+		return nil
+	}
+	return &AttendanceAnomalyQuery{
+		config:         aaq.config,
+		ctx:            aaq.ctx.Clone(),
+		order:          append([]attendanceanomaly.OrderOption{}, aaq.order...),
+		inters:         append([]Interceptor{}, aaq.inters...),
+		predicates:     append([]predicate.AttendanceAnomaly{}, aaq.predicates...),
+		withAttendance: aaq.withAttendance.Clone(),
+		withEmployee:   aaq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  aaq.sql.Clone(),
+		path: aaq.path,
+	}
+}
+
+// WithAttendance tells the query-builder to eager-load the nodes that are connected to
+// the "attendance" edge. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AttendanceAnomalyQuery) WithAttendance(opts ...func(*AttendanceQuery)) *AttendanceAnomalyQuery {
+	query := (&AttendanceClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aaq.withAttendance = query
+	return aaq
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AttendanceAnomalyQuery) WithEmployee(opts ...func(*EmployeeQuery)) *AttendanceAnomalyQuery {
+	query := (&EmployeeClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aaq.withEmployee = query
+	return aaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendanceAnomaly.Query().
+//		GroupBy(attendanceanomaly.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aaq *AttendanceAnomalyQuery) GroupBy(field string, fields ...string) *AttendanceAnomalyGroupBy {
+	aaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendanceAnomalyGroupBy{build: aaq}
+	grbuild.flds = &aaq.ctx.Fields
+	grbuild.label = attendanceanomaly.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.AttendanceAnomaly.Query().
+//		Select(attendanceanomaly.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (aaq *AttendanceAnomalyQuery) Select(fields ...string) *AttendanceAnomalySelect {
+	aaq.ctx.Fields = append(aaq.ctx.Fields, fields...)
+	sbuild := &AttendanceAnomalySelect{AttendanceAnomalyQuery: aaq}
+	sbuild.label = attendanceanomaly.Label
+	sbuild.flds, sbuild.scan = &aaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendanceAnomalySelect configured with the given aggregations.
+func (aaq *AttendanceAnomalyQuery) Aggregate(fns ...AggregateFunc) *AttendanceAnomalySelect {
+	return aaq.Select().Aggregate(fns...)
+}
+
+func (aaq *AttendanceAnomalyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aaq.ctx.Fields {
+		if !attendanceanomaly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aaq.path != nil {
+		prev, err := aaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aaq.sql = prev
+	}
+	return nil
+}
+
+func (aaq *AttendanceAnomalyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendanceAnomaly, error) {
+	var (
+		nodes       = []*AttendanceAnomaly{}
+		_spec       = aaq.querySpec()
+		loadedTypes = [2]bool{
+			aaq.withAttendance != nil,
+			aaq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendanceAnomaly).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendanceAnomaly{config: aaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(aaq.modifiers) > 0 {
+		_spec.Modifiers = aaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aaq.withAttendance; query != nil {
+		if err := aaq.loadAttendance(ctx, query, nodes, nil,
+			func(n *AttendanceAnomaly, e *Attendance) { n.Edges.Attendance = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aaq.withEmployee; query != nil {
+		if err := aaq.loadEmployee(ctx, query, nodes, nil,
+			func(n *AttendanceAnomaly, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aaq *AttendanceAnomalyQuery) loadAttendance(ctx context.Context, query *AttendanceQuery, nodes []*AttendanceAnomaly, init func(*AttendanceAnomaly), assign func(*AttendanceAnomaly, *Attendance)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*AttendanceAnomaly)
+	for i := range nodes {
+		fk := nodes[i].AttendanceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(attendance.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attendance_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aaq *AttendanceAnomalyQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*AttendanceAnomaly, init func(*AttendanceAnomaly), assign func(*AttendanceAnomaly, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*AttendanceAnomaly)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (aaq *AttendanceAnomalyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aaq.querySpec()
+	if len(aaq.modifiers) > 0 {
+		_spec.Modifiers = aaq.modifiers
+	}
+	_spec.Node.Columns = aaq.ctx.Fields
+	if len(aaq.ctx.Fields) > 0 {
+		_spec.Unique = aaq.ctx.Unique != nil && *aaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aaq.driver, _spec)
+}
+
+func (aaq *AttendanceAnomalyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendanceanomaly.Table, attendanceanomaly.Columns, sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64))
+	_spec.From = aaq.sql
+	if unique := aaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendanceanomaly.FieldID)
+		for i := range fields {
+			if fields[i] != attendanceanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if aaq.withAttendance != nil {
+			_spec.Node.AddColumnOnce(attendanceanomaly.FieldAttendanceID)
+		}
+		if aaq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(attendanceanomaly.FieldEmployeeID)
+		}
+	}
+	if ps := aaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aaq *AttendanceAnomalyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aaq.driver.Dialect())
+	t1 := builder.Table(attendanceanomaly.Table)
+	columns := aaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendanceanomaly.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aaq.sql != nil {
+		selector = aaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aaq.ctx.Unique != nil && *aaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range aaq.modifiers {
+		m(selector)
+	}
+	for _, p := range aaq.predicates {
+		p(selector)
+	}
+	for _, p := range aaq.order {
+		p(selector)
+	}
+	if offset := aaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aaq *AttendanceAnomalyQuery) Modify(modifiers ...func(s *sql.Selector)) *AttendanceAnomalySelect {
+	aaq.modifiers = append(aaq.modifiers, modifiers...)
+	return aaq.Select()
+}
+
+// AttendanceAnomalyGroupBy is the group-by builder for AttendanceAnomaly entities.
+type AttendanceAnomalyGroupBy struct {
+	selector
+	build *AttendanceAnomalyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aagb *AttendanceAnomalyGroupBy) Aggregate(fns ...AggregateFunc) *AttendanceAnomalyGroupBy {
+	aagb.fns = append(aagb.fns, fns...)
+	return aagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aagb *AttendanceAnomalyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aagb.build.ctx, "GroupBy")
+	if err := aagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceAnomalyQuery, *AttendanceAnomalyGroupBy](ctx, aagb.build, aagb, aagb.build.inters, v)
+}
+
+func (aagb *AttendanceAnomalyGroupBy) sqlScan(ctx context.Context, root *AttendanceAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aagb.fns))
+	for _, fn := range aagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aagb.flds)+len(aagb.fns))
+		for _, f := range *aagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendanceAnomalySelect is the builder for selecting fields of AttendanceAnomaly entities.
+type AttendanceAnomalySelect struct {
+	*AttendanceAnomalyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aas *AttendanceAnomalySelect) Aggregate(fns ...AggregateFunc) *AttendanceAnomalySelect {
+	aas.fns = append(aas.fns, fns...)
+	return aas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aas *AttendanceAnomalySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aas.ctx, "Select")
+	if err := aas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceAnomalyQuery, *AttendanceAnomalySelect](ctx, aas.AttendanceAnomalyQuery, aas, aas.inters, v)
+}
+
+func (aas *AttendanceAnomalySelect) sqlScan(ctx context.Context, root *AttendanceAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aas.fns))
+	for _, fn := range aas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aas *AttendanceAnomalySelect) Modify(modifiers ...func(s *sql.Selector)) *AttendanceAnomalySelect {
+	aas.modifiers = append(aas.modifiers, modifiers...)
+	return aas
+}
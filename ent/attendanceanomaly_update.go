@@ -0,0 +1,788 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceAnomalyUpdate is the builder for updating AttendanceAnomaly entities.
+type AttendanceAnomalyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttendanceAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttendanceAnomalyUpdate builder.
+func (aau *AttendanceAnomalyUpdate) Where(ps ...predicate.AttendanceAnomaly) *AttendanceAnomalyUpdate {
+	aau.mutation.Where(ps...)
+	return aau
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (aau *AttendanceAnomalyUpdate) SetModifiedAt(t time.Time) *AttendanceAnomalyUpdate {
+	aau.mutation.SetModifiedAt(t)
+	return aau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aau *AttendanceAnomalyUpdate) SetDeletedAt(t time.Time) *AttendanceAnomalyUpdate {
+	aau.mutation.SetDeletedAt(t)
+	return aau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aau *AttendanceAnomalyUpdate) SetNillableDeletedAt(t *time.Time) *AttendanceAnomalyUpdate {
+	if t != nil {
+		aau.SetDeletedAt(*t)
+	}
+	return aau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aau *AttendanceAnomalyUpdate) ClearDeletedAt() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearDeletedAt()
+	return aau
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (aau *AttendanceAnomalyUpdate) SetAttendanceID(u uint64) *AttendanceAnomalyUpdate {
+	aau.mutation.SetAttendanceID(u)
+	return aau
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (aau *AttendanceAnomalyUpdate) SetEmployeeID(u uint64) *AttendanceAnomalyUpdate {
+	aau.mutation.SetEmployeeID(u)
+	return aau
+}
+
+// SetAttendanceDate sets the "attendance_date" field.
+func (aau *AttendanceAnomalyUpdate) SetAttendanceDate(t time.Time) *AttendanceAnomalyUpdate {
+	aau.mutation.SetAttendanceDate(t)
+	return aau
+}
+
+// SetRule sets the "rule" field.
+func (aau *AttendanceAnomalyUpdate) SetRule(a attendanceanomaly.Rule) *AttendanceAnomalyUpdate {
+	aau.mutation.SetRule(a)
+	return aau
+}
+
+// SetDetails sets the "details" field.
+func (aau *AttendanceAnomalyUpdate) SetDetails(s string) *AttendanceAnomalyUpdate {
+	aau.mutation.SetDetails(s)
+	return aau
+}
+
+// SetStatus sets the "status" field.
+func (aau *AttendanceAnomalyUpdate) SetStatus(a attendanceanomaly.Status) *AttendanceAnomalyUpdate {
+	aau.mutation.SetStatus(a)
+	return aau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aau *AttendanceAnomalyUpdate) SetNillableStatus(a *attendanceanomaly.Status) *AttendanceAnomalyUpdate {
+	if a != nil {
+		aau.SetStatus(*a)
+	}
+	return aau
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (aau *AttendanceAnomalyUpdate) SetResolvedBy(u uint64) *AttendanceAnomalyUpdate {
+	aau.mutation.ResetResolvedBy()
+	aau.mutation.SetResolvedBy(u)
+	return aau
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (aau *AttendanceAnomalyUpdate) SetNillableResolvedBy(u *uint64) *AttendanceAnomalyUpdate {
+	if u != nil {
+		aau.SetResolvedBy(*u)
+	}
+	return aau
+}
+
+// AddResolvedBy adds u to the "resolved_by" field.
+func (aau *AttendanceAnomalyUpdate) AddResolvedBy(u int64) *AttendanceAnomalyUpdate {
+	aau.mutation.AddResolvedBy(u)
+	return aau
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (aau *AttendanceAnomalyUpdate) ClearResolvedBy() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearResolvedBy()
+	return aau
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (aau *AttendanceAnomalyUpdate) SetResolvedAt(t time.Time) *AttendanceAnomalyUpdate {
+	aau.mutation.SetResolvedAt(t)
+	return aau
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (aau *AttendanceAnomalyUpdate) SetNillableResolvedAt(t *time.Time) *AttendanceAnomalyUpdate {
+	if t != nil {
+		aau.SetResolvedAt(*t)
+	}
+	return aau
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (aau *AttendanceAnomalyUpdate) ClearResolvedAt() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearResolvedAt()
+	return aau
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (aau *AttendanceAnomalyUpdate) SetResolutionNotes(s string) *AttendanceAnomalyUpdate {
+	aau.mutation.SetResolutionNotes(s)
+	return aau
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (aau *AttendanceAnomalyUpdate) SetNillableResolutionNotes(s *string) *AttendanceAnomalyUpdate {
+	if s != nil {
+		aau.SetResolutionNotes(*s)
+	}
+	return aau
+}
+
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (aau *AttendanceAnomalyUpdate) ClearResolutionNotes() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearResolutionNotes()
+	return aau
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (aau *AttendanceAnomalyUpdate) SetAttendance(a *Attendance) *AttendanceAnomalyUpdate {
+	return aau.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (aau *AttendanceAnomalyUpdate) SetEmployee(e *Employee) *AttendanceAnomalyUpdate {
+	return aau.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceAnomalyMutation object of the builder.
+func (aau *AttendanceAnomalyUpdate) Mutation() *AttendanceAnomalyMutation {
+	return aau.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (aau *AttendanceAnomalyUpdate) ClearAttendance() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearAttendance()
+	return aau
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (aau *AttendanceAnomalyUpdate) ClearEmployee() *AttendanceAnomalyUpdate {
+	aau.mutation.ClearEmployee()
+	return aau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aau *AttendanceAnomalyUpdate) Save(ctx context.Context) (int, error) {
+	aau.defaults()
+	return withHooks(ctx, aau.sqlSave, aau.mutation, aau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aau *AttendanceAnomalyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aau *AttendanceAnomalyUpdate) Exec(ctx context.Context) error {
+	_, err := aau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aau *AttendanceAnomalyUpdate) ExecX(ctx context.Context) {
+	if err := aau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aau *AttendanceAnomalyUpdate) defaults() {
+	if _, ok := aau.mutation.ModifiedAt(); !ok {
+		v := attendanceanomaly.UpdateDefaultModifiedAt()
+		aau.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aau *AttendanceAnomalyUpdate) check() error {
+	if v, ok := aau.mutation.Rule(); ok {
+		if err := attendanceanomaly.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.rule": %w`, err)}
+		}
+	}
+	if v, ok := aau.mutation.Details(); ok {
+		if err := attendanceanomaly.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.details": %w`, err)}
+		}
+	}
+	if v, ok := aau.mutation.Status(); ok {
+		if err := attendanceanomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.status": %w`, err)}
+		}
+	}
+	if _, ok := aau.mutation.AttendanceID(); aau.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceAnomaly.attendance"`)
+	}
+	if _, ok := aau.mutation.EmployeeID(); aau.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceAnomaly.employee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aau *AttendanceAnomalyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendanceAnomalyUpdate {
+	aau.modifiers = append(aau.modifiers, modifiers...)
+	return aau
+}
+
+func (aau *AttendanceAnomalyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendanceanomaly.Table, attendanceanomaly.Columns, sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64))
+	if ps := aau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aau.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := aau.mutation.DeletedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aau.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendanceanomaly.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aau.mutation.AttendanceDate(); ok {
+		_spec.SetField(attendanceanomaly.FieldAttendanceDate, field.TypeTime, value)
+	}
+	if value, ok := aau.mutation.Rule(); ok {
+		_spec.SetField(attendanceanomaly.FieldRule, field.TypeEnum, value)
+	}
+	if value, ok := aau.mutation.Details(); ok {
+		_spec.SetField(attendanceanomaly.FieldDetails, field.TypeString, value)
+	}
+	if value, ok := aau.mutation.Status(); ok {
+		_spec.SetField(attendanceanomaly.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aau.mutation.ResolvedBy(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if value, ok := aau.mutation.AddedResolvedBy(); ok {
+		_spec.AddField(attendanceanomaly.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if aau.mutation.ResolvedByCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolvedBy, field.TypeUint64)
+	}
+	if value, ok := aau.mutation.ResolvedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedAt, field.TypeTime, value)
+	}
+	if aau.mutation.ResolvedAtCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := aau.mutation.ResolutionNotes(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolutionNotes, field.TypeString, value)
+	}
+	if aau.mutation.ResolutionNotesCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolutionNotes, field.TypeString)
+	}
+	if aau.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.AttendanceTable,
+			Columns: []string{attendanceanomaly.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.AttendanceTable,
+			Columns: []string{attendanceanomaly.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aau.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.EmployeeTable,
+			Columns: []string{attendanceanomaly.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.EmployeeTable,
+			Columns: []string{attendanceanomaly.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(aau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, aau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendanceanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aau.mutation.done = true
+	return n, nil
+}
+
+// AttendanceAnomalyUpdateOne is the builder for updating a single AttendanceAnomaly entity.
+type AttendanceAnomalyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttendanceAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetModifiedAt(t time.Time) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetModifiedAt(t)
+	return aauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetDeletedAt(t time.Time) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetDeletedAt(t)
+	return aauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aauo *AttendanceAnomalyUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendanceAnomalyUpdateOne {
+	if t != nil {
+		aauo.SetDeletedAt(*t)
+	}
+	return aauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aauo *AttendanceAnomalyUpdateOne) ClearDeletedAt() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearDeletedAt()
+	return aauo
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetAttendanceID(u uint64) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetAttendanceID(u)
+	return aauo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetEmployeeID(u uint64) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetEmployeeID(u)
+	return aauo
+}
+
+// SetAttendanceDate sets the "attendance_date" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetAttendanceDate(t time.Time) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetAttendanceDate(t)
+	return aauo
+}
+
+// SetRule sets the "rule" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetRule(a attendanceanomaly.Rule) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetRule(a)
+	return aauo
+}
+
+// SetDetails sets the "details" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetDetails(s string) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetDetails(s)
+	return aauo
+}
+
+// SetStatus sets the "status" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetStatus(a attendanceanomaly.Status) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetStatus(a)
+	return aauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aauo *AttendanceAnomalyUpdateOne) SetNillableStatus(a *attendanceanomaly.Status) *AttendanceAnomalyUpdateOne {
+	if a != nil {
+		aauo.SetStatus(*a)
+	}
+	return aauo
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetResolvedBy(u uint64) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ResetResolvedBy()
+	aauo.mutation.SetResolvedBy(u)
+	return aauo
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (aauo *AttendanceAnomalyUpdateOne) SetNillableResolvedBy(u *uint64) *AttendanceAnomalyUpdateOne {
+	if u != nil {
+		aauo.SetResolvedBy(*u)
+	}
+	return aauo
+}
+
+// AddResolvedBy adds u to the "resolved_by" field.
+func (aauo *AttendanceAnomalyUpdateOne) AddResolvedBy(u int64) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.AddResolvedBy(u)
+	return aauo
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (aauo *AttendanceAnomalyUpdateOne) ClearResolvedBy() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearResolvedBy()
+	return aauo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetResolvedAt(t time.Time) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetResolvedAt(t)
+	return aauo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (aauo *AttendanceAnomalyUpdateOne) SetNillableResolvedAt(t *time.Time) *AttendanceAnomalyUpdateOne {
+	if t != nil {
+		aauo.SetResolvedAt(*t)
+	}
+	return aauo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (aauo *AttendanceAnomalyUpdateOne) ClearResolvedAt() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearResolvedAt()
+	return aauo
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (aauo *AttendanceAnomalyUpdateOne) SetResolutionNotes(s string) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.SetResolutionNotes(s)
+	return aauo
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (aauo *AttendanceAnomalyUpdateOne) SetNillableResolutionNotes(s *string) *AttendanceAnomalyUpdateOne {
+	if s != nil {
+		aauo.SetResolutionNotes(*s)
+	}
+	return aauo
+}
+
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (aauo *AttendanceAnomalyUpdateOne) ClearResolutionNotes() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearResolutionNotes()
+	return aauo
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (aauo *AttendanceAnomalyUpdateOne) SetAttendance(a *Attendance) *AttendanceAnomalyUpdateOne {
+	return aauo.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (aauo *AttendanceAnomalyUpdateOne) SetEmployee(e *Employee) *AttendanceAnomalyUpdateOne {
+	return aauo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceAnomalyMutation object of the builder.
+func (aauo *AttendanceAnomalyUpdateOne) Mutation() *AttendanceAnomalyMutation {
+	return aauo.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (aauo *AttendanceAnomalyUpdateOne) ClearAttendance() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearAttendance()
+	return aauo
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (aauo *AttendanceAnomalyUpdateOne) ClearEmployee() *AttendanceAnomalyUpdateOne {
+	aauo.mutation.ClearEmployee()
+	return aauo
+}
+
+// Where appends a list predicates to the AttendanceAnomalyUpdate builder.
+func (aauo *AttendanceAnomalyUpdateOne) Where(ps ...predicate.AttendanceAnomaly) *AttendanceAnomalyUpdateOne {
+	aauo.mutation.Where(ps...)
+	return aauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aauo *AttendanceAnomalyUpdateOne) Select(field string, fields ...string) *AttendanceAnomalyUpdateOne {
+	aauo.fields = append([]string{field}, fields...)
+	return aauo
+}
+
+// Save executes the query and returns the updated AttendanceAnomaly entity.
+func (aauo *AttendanceAnomalyUpdateOne) Save(ctx context.Context) (*AttendanceAnomaly, error) {
+	aauo.defaults()
+	return withHooks(ctx, aauo.sqlSave, aauo.mutation, aauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aauo *AttendanceAnomalyUpdateOne) SaveX(ctx context.Context) *AttendanceAnomaly {
+	node, err := aauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aauo *AttendanceAnomalyUpdateOne) Exec(ctx context.Context) error {
+	_, err := aauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aauo *AttendanceAnomalyUpdateOne) ExecX(ctx context.Context) {
+	if err := aauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aauo *AttendanceAnomalyUpdateOne) defaults() {
+	if _, ok := aauo.mutation.ModifiedAt(); !ok {
+		v := attendanceanomaly.UpdateDefaultModifiedAt()
+		aauo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aauo *AttendanceAnomalyUpdateOne) check() error {
+	if v, ok := aauo.mutation.Rule(); ok {
+		if err := attendanceanomaly.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.rule": %w`, err)}
+		}
+	}
+	if v, ok := aauo.mutation.Details(); ok {
+		if err := attendanceanomaly.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.details": %w`, err)}
+		}
+	}
+	if v, ok := aauo.mutation.Status(); ok {
+		if err := attendanceanomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceAnomaly.status": %w`, err)}
+		}
+	}
+	if _, ok := aauo.mutation.AttendanceID(); aauo.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceAnomaly.attendance"`)
+	}
+	if _, ok := aauo.mutation.EmployeeID(); aauo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceAnomaly.employee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aauo *AttendanceAnomalyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendanceAnomalyUpdateOne {
+	aauo.modifiers = append(aauo.modifiers, modifiers...)
+	return aauo
+}
+
+func (aauo *AttendanceAnomalyUpdateOne) sqlSave(ctx context.Context) (_node *AttendanceAnomaly, err error) {
+	if err := aauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendanceanomaly.Table, attendanceanomaly.Columns, sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64))
+	id, ok := aauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendanceAnomaly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendanceanomaly.FieldID)
+		for _, f := range fields {
+			if !attendanceanomaly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendanceanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aauo.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := aauo.mutation.DeletedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendanceanomaly.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aauo.mutation.AttendanceDate(); ok {
+		_spec.SetField(attendanceanomaly.FieldAttendanceDate, field.TypeTime, value)
+	}
+	if value, ok := aauo.mutation.Rule(); ok {
+		_spec.SetField(attendanceanomaly.FieldRule, field.TypeEnum, value)
+	}
+	if value, ok := aauo.mutation.Details(); ok {
+		_spec.SetField(attendanceanomaly.FieldDetails, field.TypeString, value)
+	}
+	if value, ok := aauo.mutation.Status(); ok {
+		_spec.SetField(attendanceanomaly.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aauo.mutation.ResolvedBy(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if value, ok := aauo.mutation.AddedResolvedBy(); ok {
+		_spec.AddField(attendanceanomaly.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if aauo.mutation.ResolvedByCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolvedBy, field.TypeUint64)
+	}
+	if value, ok := aauo.mutation.ResolvedAt(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolvedAt, field.TypeTime, value)
+	}
+	if aauo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := aauo.mutation.ResolutionNotes(); ok {
+		_spec.SetField(attendanceanomaly.FieldResolutionNotes, field.TypeString, value)
+	}
+	if aauo.mutation.ResolutionNotesCleared() {
+		_spec.ClearField(attendanceanomaly.FieldResolutionNotes, field.TypeString)
+	}
+	if aauo.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.AttendanceTable,
+			Columns: []string{attendanceanomaly.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.AttendanceTable,
+			Columns: []string{attendanceanomaly.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aauo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.EmployeeTable,
+			Columns: []string{attendanceanomaly.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendanceanomaly.EmployeeTable,
+			Columns: []string{attendanceanomaly.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(aauo.modifiers...)
+	_node = &AttendanceAnomaly{config: aauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendanceanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/employee"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttendanceCorrection is the model entity for the AttendanceCorrection schema.
+type AttendanceCorrection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to attendances table
+	AttendanceID uint64 `json:"attendance_id,omitempty"`
+	// Employee who requested the correction
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Check-in time when the correction was requested
+	OriginalCheckInTime *time.Time `json:"original_check_in_time,omitempty"`
+	// Check-out time when the correction was requested
+	OriginalCheckOutTime *time.Time `json:"original_check_out_time,omitempty"`
+	// Attendance status when the correction was requested
+	OriginalStatus string `json:"original_status,omitempty"`
+	// ProposedCheckInTime holds the value of the "proposed_check_in_time" field.
+	ProposedCheckInTime *time.Time `json:"proposed_check_in_time,omitempty"`
+	// ProposedCheckOutTime holds the value of the "proposed_check_out_time" field.
+	ProposedCheckOutTime *time.Time `json:"proposed_check_out_time,omitempty"`
+	// ProposedStatus holds the value of the "proposed_status" field.
+	ProposedStatus string `json:"proposed_status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status attendancecorrection.Status `json:"status,omitempty"`
+	// Manager who approved or rejected the correction
+	ReviewedBy *uint64 `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes string `json:"review_notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendanceCorrectionQuery when eager-loading is set.
+	Edges        AttendanceCorrectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendanceCorrectionEdges holds the relations/edges for other nodes in the graph.
+type AttendanceCorrectionEdges struct {
+	// Attendance holds the value of the attendance edge.
+	Attendance *Attendance `json:"attendance,omitempty"`
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttendanceOrErr returns the Attendance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceCorrectionEdges) AttendanceOrErr() (*Attendance, error) {
+	if e.loadedTypes[0] {
+		if e.Attendance == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: attendance.Label}
+		}
+		return e.Attendance, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance"}
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceCorrectionEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[1] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendanceCorrection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendancecorrection.FieldID, attendancecorrection.FieldAttendanceID, attendancecorrection.FieldEmployeeID, attendancecorrection.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case attendancecorrection.FieldOriginalStatus, attendancecorrection.FieldProposedStatus, attendancecorrection.FieldReason, attendancecorrection.FieldStatus, attendancecorrection.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case attendancecorrection.FieldCreatedAt, attendancecorrection.FieldModifiedAt, attendancecorrection.FieldDeletedAt, attendancecorrection.FieldOriginalCheckInTime, attendancecorrection.FieldOriginalCheckOutTime, attendancecorrection.FieldProposedCheckInTime, attendancecorrection.FieldProposedCheckOutTime, attendancecorrection.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendanceCorrection fields.
+func (ac *AttendanceCorrection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendancecorrection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = uint64(value.Int64)
+		case attendancecorrection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ac.CreatedAt = value.Time
+			}
+		case attendancecorrection.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				ac.ModifiedAt = value.Time
+			}
+		case attendancecorrection.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ac.DeletedAt = value.Time
+			}
+		case attendancecorrection.FieldAttendanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_id", values[i])
+			} else if value.Valid {
+				ac.AttendanceID = uint64(value.Int64)
+			}
+		case attendancecorrection.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				ac.EmployeeID = uint64(value.Int64)
+			}
+		case attendancecorrection.FieldOriginalCheckInTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field original_check_in_time", values[i])
+			} else if value.Valid {
+				ac.OriginalCheckInTime = new(time.Time)
+				*ac.OriginalCheckInTime = value.Time
+			}
+		case attendancecorrection.FieldOriginalCheckOutTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field original_check_out_time", values[i])
+			} else if value.Valid {
+				ac.OriginalCheckOutTime = new(time.Time)
+				*ac.OriginalCheckOutTime = value.Time
+			}
+		case attendancecorrection.FieldOriginalStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_status", values[i])
+			} else if value.Valid {
+				ac.OriginalStatus = value.String
+			}
+		case attendancecorrection.FieldProposedCheckInTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field proposed_check_in_time", values[i])
+			} else if value.Valid {
+				ac.ProposedCheckInTime = new(time.Time)
+				*ac.ProposedCheckInTime = value.Time
+			}
+		case attendancecorrection.FieldProposedCheckOutTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field proposed_check_out_time", values[i])
+			} else if value.Valid {
+				ac.ProposedCheckOutTime = new(time.Time)
+				*ac.ProposedCheckOutTime = value.Time
+			}
+		case attendancecorrection.FieldProposedStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proposed_status", values[i])
+			} else if value.Valid {
+				ac.ProposedStatus = value.String
+			}
+		case attendancecorrection.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ac.Reason = value.String
+			}
+		case attendancecorrection.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ac.Status = attendancecorrection.Status(value.String)
+			}
+		case attendancecorrection.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				ac.ReviewedBy = new(uint64)
+				*ac.ReviewedBy = uint64(value.Int64)
+			}
+		case attendancecorrection.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ac.ReviewedAt = new(time.Time)
+				*ac.ReviewedAt = value.Time
+			}
+		case attendancecorrection.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				ac.ReviewNotes = value.String
+			}
+		default:
+			ac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendanceCorrection.
+// This includes values selected through modifiers, order, etc.
+func (ac *AttendanceCorrection) Value(name string) (ent.Value, error) {
+	return ac.selectValues.Get(name)
+}
+
+// QueryAttendance queries the "attendance" edge of the AttendanceCorrection entity.
+func (ac *AttendanceCorrection) QueryAttendance() *AttendanceQuery {
+	return NewAttendanceCorrectionClient(ac.config).QueryAttendance(ac)
+}
+
+// QueryEmployee queries the "employee" edge of the AttendanceCorrection entity.
+func (ac *AttendanceCorrection) QueryEmployee() *EmployeeQuery {
+	return NewAttendanceCorrectionClient(ac.config).QueryEmployee(ac)
+}
+
+// Update returns a builder for updating this AttendanceCorrection.
+// Note that you need to call AttendanceCorrection.Unwrap() before calling this method if this AttendanceCorrection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AttendanceCorrection) Update() *AttendanceCorrectionUpdateOne {
+	return NewAttendanceCorrectionClient(ac.config).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AttendanceCorrection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AttendanceCorrection) Unwrap() *AttendanceCorrection {
+	_tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendanceCorrection is not a transactional entity")
+	}
+	ac.config.driver = _tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AttendanceCorrection) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendanceCorrection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ac.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(ac.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ac.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.AttendanceID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.EmployeeID))
+	builder.WriteString(", ")
+	if v := ac.OriginalCheckInTime; v != nil {
+		builder.WriteString("original_check_in_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ac.OriginalCheckOutTime; v != nil {
+		builder.WriteString("original_check_out_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("original_status=")
+	builder.WriteString(ac.OriginalStatus)
+	builder.WriteString(", ")
+	if v := ac.ProposedCheckInTime; v != nil {
+		builder.WriteString("proposed_check_in_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ac.ProposedCheckOutTime; v != nil {
+		builder.WriteString("proposed_check_out_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("proposed_status=")
+	builder.WriteString(ac.ProposedStatus)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ac.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ac.Status))
+	builder.WriteString(", ")
+	if v := ac.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ac.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_notes=")
+	builder.WriteString(ac.ReviewNotes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendanceCorrections is a parsable slice of AttendanceCorrection.
+type AttendanceCorrections []*AttendanceCorrection
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancecorrection
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attendancecorrection type in the database.
+	Label = "attendance_correction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldAttendanceID holds the string denoting the attendance_id field in the database.
+	FieldAttendanceID = "attendance_id"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldOriginalCheckInTime holds the string denoting the original_check_in_time field in the database.
+	FieldOriginalCheckInTime = "original_check_in_time"
+	// FieldOriginalCheckOutTime holds the string denoting the original_check_out_time field in the database.
+	FieldOriginalCheckOutTime = "original_check_out_time"
+	// FieldOriginalStatus holds the string denoting the original_status field in the database.
+	FieldOriginalStatus = "original_status"
+	// FieldProposedCheckInTime holds the string denoting the proposed_check_in_time field in the database.
+	FieldProposedCheckInTime = "proposed_check_in_time"
+	// FieldProposedCheckOutTime holds the string denoting the proposed_check_out_time field in the database.
+	FieldProposedCheckOutTime = "proposed_check_out_time"
+	// FieldProposedStatus holds the string denoting the proposed_status field in the database.
+	FieldProposedStatus = "proposed_status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewNotes holds the string denoting the review_notes field in the database.
+	FieldReviewNotes = "review_notes"
+	// EdgeAttendance holds the string denoting the attendance edge name in mutations.
+	EdgeAttendance = "attendance"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// Table holds the table name of the attendancecorrection in the database.
+	Table = "attendance_corrections"
+	// AttendanceTable is the table that holds the attendance relation/edge.
+	AttendanceTable = "attendance_corrections"
+	// AttendanceInverseTable is the table name for the Attendance entity.
+	// It exists in this package in order to avoid circular dependency with the "attendance" package.
+	AttendanceInverseTable = "attendances"
+	// AttendanceColumn is the table column denoting the attendance relation/edge.
+	AttendanceColumn = "attendance_id"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "attendance_corrections"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+)
+
+// Columns holds all SQL columns for attendancecorrection fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldAttendanceID,
+	FieldEmployeeID,
+	FieldOriginalCheckInTime,
+	FieldOriginalCheckOutTime,
+	FieldOriginalStatus,
+	FieldProposedCheckInTime,
+	FieldProposedCheckOutTime,
+	FieldProposedStatus,
+	FieldReason,
+	FieldStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldReviewNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// OriginalStatusValidator is a validator for the "original_status" field. It is called by the builders before save.
+	OriginalStatusValidator func(string) error
+	// ProposedStatusValidator is a validator for the "proposed_status" field. It is called by the builders before save.
+	ProposedStatusValidator func(string) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("attendancecorrection: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AttendanceCorrection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByAttendanceID orders the results by the attendance_id field.
+func ByAttendanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceID, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByOriginalCheckInTime orders the results by the original_check_in_time field.
+func ByOriginalCheckInTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCheckInTime, opts...).ToFunc()
+}
+
+// ByOriginalCheckOutTime orders the results by the original_check_out_time field.
+func ByOriginalCheckOutTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCheckOutTime, opts...).ToFunc()
+}
+
+// ByOriginalStatus orders the results by the original_status field.
+func ByOriginalStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalStatus, opts...).ToFunc()
+}
+
+// ByProposedCheckInTime orders the results by the proposed_check_in_time field.
+func ByProposedCheckInTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProposedCheckInTime, opts...).ToFunc()
+}
+
+// ByProposedCheckOutTime orders the results by the proposed_check_out_time field.
+func ByProposedCheckOutTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProposedCheckOutTime, opts...).ToFunc()
+}
+
+// ByProposedStatus orders the results by the proposed_status field.
+func ByProposedStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProposedStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewNotes orders the results by the review_notes field.
+func ByReviewNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNotes, opts...).ToFunc()
+}
+
+// ByAttendanceField orders the results by attendance field.
+func ByAttendanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttendanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+	)
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
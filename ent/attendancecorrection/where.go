@@ -0,0 +1,979 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancecorrection
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AttendanceID applies equality check predicate on the "attendance_id" field. It's identical to AttendanceIDEQ.
+func AttendanceID(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// OriginalCheckInTime applies equality check predicate on the "original_check_in_time" field. It's identical to OriginalCheckInTimeEQ.
+func OriginalCheckInTime(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckOutTime applies equality check predicate on the "original_check_out_time" field. It's identical to OriginalCheckOutTimeEQ.
+func OriginalCheckOutTime(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalStatus applies equality check predicate on the "original_status" field. It's identical to OriginalStatusEQ.
+func OriginalStatus(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalStatus, v))
+}
+
+// ProposedCheckInTime applies equality check predicate on the "proposed_check_in_time" field. It's identical to ProposedCheckInTimeEQ.
+func ProposedCheckInTime(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckOutTime applies equality check predicate on the "proposed_check_out_time" field. It's identical to ProposedCheckOutTimeEQ.
+func ProposedCheckOutTime(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedCheckOutTime, v))
+}
+
+// ProposedStatus applies equality check predicate on the "proposed_status" field. It's identical to ProposedStatusEQ.
+func ProposedStatus(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedStatus, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// AttendanceIDEQ applies the EQ predicate on the "attendance_id" field.
+func AttendanceIDEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDNEQ applies the NEQ predicate on the "attendance_id" field.
+func AttendanceIDNEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDIn applies the In predicate on the "attendance_id" field.
+func AttendanceIDIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldAttendanceID, vs...))
+}
+
+// AttendanceIDNotIn applies the NotIn predicate on the "attendance_id" field.
+func AttendanceIDNotIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldAttendanceID, vs...))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// OriginalCheckInTimeEQ applies the EQ predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeNEQ applies the NEQ predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeIn applies the In predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldOriginalCheckInTime, vs...))
+}
+
+// OriginalCheckInTimeNotIn applies the NotIn predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldOriginalCheckInTime, vs...))
+}
+
+// OriginalCheckInTimeGT applies the GT predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeGTE applies the GTE predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeLT applies the LT predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeLTE applies the LTE predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldOriginalCheckInTime, v))
+}
+
+// OriginalCheckInTimeIsNil applies the IsNil predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldOriginalCheckInTime))
+}
+
+// OriginalCheckInTimeNotNil applies the NotNil predicate on the "original_check_in_time" field.
+func OriginalCheckInTimeNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldOriginalCheckInTime))
+}
+
+// OriginalCheckOutTimeEQ applies the EQ predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeNEQ applies the NEQ predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeIn applies the In predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldOriginalCheckOutTime, vs...))
+}
+
+// OriginalCheckOutTimeNotIn applies the NotIn predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldOriginalCheckOutTime, vs...))
+}
+
+// OriginalCheckOutTimeGT applies the GT predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeGTE applies the GTE predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeLT applies the LT predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeLTE applies the LTE predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldOriginalCheckOutTime, v))
+}
+
+// OriginalCheckOutTimeIsNil applies the IsNil predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldOriginalCheckOutTime))
+}
+
+// OriginalCheckOutTimeNotNil applies the NotNil predicate on the "original_check_out_time" field.
+func OriginalCheckOutTimeNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldOriginalCheckOutTime))
+}
+
+// OriginalStatusEQ applies the EQ predicate on the "original_status" field.
+func OriginalStatusEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOriginalStatus, v))
+}
+
+// OriginalStatusNEQ applies the NEQ predicate on the "original_status" field.
+func OriginalStatusNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldOriginalStatus, v))
+}
+
+// OriginalStatusIn applies the In predicate on the "original_status" field.
+func OriginalStatusIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldOriginalStatus, vs...))
+}
+
+// OriginalStatusNotIn applies the NotIn predicate on the "original_status" field.
+func OriginalStatusNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldOriginalStatus, vs...))
+}
+
+// OriginalStatusGT applies the GT predicate on the "original_status" field.
+func OriginalStatusGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldOriginalStatus, v))
+}
+
+// OriginalStatusGTE applies the GTE predicate on the "original_status" field.
+func OriginalStatusGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldOriginalStatus, v))
+}
+
+// OriginalStatusLT applies the LT predicate on the "original_status" field.
+func OriginalStatusLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldOriginalStatus, v))
+}
+
+// OriginalStatusLTE applies the LTE predicate on the "original_status" field.
+func OriginalStatusLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldOriginalStatus, v))
+}
+
+// OriginalStatusContains applies the Contains predicate on the "original_status" field.
+func OriginalStatusContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldOriginalStatus, v))
+}
+
+// OriginalStatusHasPrefix applies the HasPrefix predicate on the "original_status" field.
+func OriginalStatusHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldOriginalStatus, v))
+}
+
+// OriginalStatusHasSuffix applies the HasSuffix predicate on the "original_status" field.
+func OriginalStatusHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldOriginalStatus, v))
+}
+
+// OriginalStatusEqualFold applies the EqualFold predicate on the "original_status" field.
+func OriginalStatusEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldOriginalStatus, v))
+}
+
+// OriginalStatusContainsFold applies the ContainsFold predicate on the "original_status" field.
+func OriginalStatusContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldOriginalStatus, v))
+}
+
+// ProposedCheckInTimeEQ applies the EQ predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeNEQ applies the NEQ predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeIn applies the In predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldProposedCheckInTime, vs...))
+}
+
+// ProposedCheckInTimeNotIn applies the NotIn predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldProposedCheckInTime, vs...))
+}
+
+// ProposedCheckInTimeGT applies the GT predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeGTE applies the GTE predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeLT applies the LT predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeLTE applies the LTE predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldProposedCheckInTime, v))
+}
+
+// ProposedCheckInTimeIsNil applies the IsNil predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldProposedCheckInTime))
+}
+
+// ProposedCheckInTimeNotNil applies the NotNil predicate on the "proposed_check_in_time" field.
+func ProposedCheckInTimeNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldProposedCheckInTime))
+}
+
+// ProposedCheckOutTimeEQ applies the EQ predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeNEQ applies the NEQ predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeIn applies the In predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldProposedCheckOutTime, vs...))
+}
+
+// ProposedCheckOutTimeNotIn applies the NotIn predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldProposedCheckOutTime, vs...))
+}
+
+// ProposedCheckOutTimeGT applies the GT predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeGTE applies the GTE predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeLT applies the LT predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeLTE applies the LTE predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldProposedCheckOutTime, v))
+}
+
+// ProposedCheckOutTimeIsNil applies the IsNil predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldProposedCheckOutTime))
+}
+
+// ProposedCheckOutTimeNotNil applies the NotNil predicate on the "proposed_check_out_time" field.
+func ProposedCheckOutTimeNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldProposedCheckOutTime))
+}
+
+// ProposedStatusEQ applies the EQ predicate on the "proposed_status" field.
+func ProposedStatusEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldProposedStatus, v))
+}
+
+// ProposedStatusNEQ applies the NEQ predicate on the "proposed_status" field.
+func ProposedStatusNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldProposedStatus, v))
+}
+
+// ProposedStatusIn applies the In predicate on the "proposed_status" field.
+func ProposedStatusIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldProposedStatus, vs...))
+}
+
+// ProposedStatusNotIn applies the NotIn predicate on the "proposed_status" field.
+func ProposedStatusNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldProposedStatus, vs...))
+}
+
+// ProposedStatusGT applies the GT predicate on the "proposed_status" field.
+func ProposedStatusGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldProposedStatus, v))
+}
+
+// ProposedStatusGTE applies the GTE predicate on the "proposed_status" field.
+func ProposedStatusGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldProposedStatus, v))
+}
+
+// ProposedStatusLT applies the LT predicate on the "proposed_status" field.
+func ProposedStatusLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldProposedStatus, v))
+}
+
+// ProposedStatusLTE applies the LTE predicate on the "proposed_status" field.
+func ProposedStatusLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldProposedStatus, v))
+}
+
+// ProposedStatusContains applies the Contains predicate on the "proposed_status" field.
+func ProposedStatusContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldProposedStatus, v))
+}
+
+// ProposedStatusHasPrefix applies the HasPrefix predicate on the "proposed_status" field.
+func ProposedStatusHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldProposedStatus, v))
+}
+
+// ProposedStatusHasSuffix applies the HasSuffix predicate on the "proposed_status" field.
+func ProposedStatusHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldProposedStatus, v))
+}
+
+// ProposedStatusIsNil applies the IsNil predicate on the "proposed_status" field.
+func ProposedStatusIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldProposedStatus))
+}
+
+// ProposedStatusNotNil applies the NotNil predicate on the "proposed_status" field.
+func ProposedStatusNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldProposedStatus))
+}
+
+// ProposedStatusEqualFold applies the EqualFold predicate on the "proposed_status" field.
+func ProposedStatusEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldProposedStatus, v))
+}
+
+// ProposedStatusContainsFold applies the ContainsFold predicate on the "proposed_status" field.
+func ProposedStatusContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldProposedStatus, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uint64) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// HasAttendance applies the HasEdge predicate on the "attendance" edge.
+func HasAttendance() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceWith applies the HasEdge predicate on the "attendance" edge with a given conditions (other predicates).
+func HasAttendanceWith(preds ...predicate.Attendance) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := newAttendanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/employee"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceCorrectionCreate is the builder for creating a AttendanceCorrection entity.
+type AttendanceCorrectionCreate struct {
+	config
+	mutation *AttendanceCorrectionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acc *AttendanceCorrectionCreate) SetCreatedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetCreatedAt(t)
+	return acc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableCreatedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetCreatedAt(*t)
+	}
+	return acc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (acc *AttendanceCorrectionCreate) SetModifiedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetModifiedAt(t)
+	return acc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableModifiedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetModifiedAt(*t)
+	}
+	return acc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acc *AttendanceCorrectionCreate) SetDeletedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetDeletedAt(t)
+	return acc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableDeletedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetDeletedAt(*t)
+	}
+	return acc
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (acc *AttendanceCorrectionCreate) SetAttendanceID(u uint64) *AttendanceCorrectionCreate {
+	acc.mutation.SetAttendanceID(u)
+	return acc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acc *AttendanceCorrectionCreate) SetEmployeeID(u uint64) *AttendanceCorrectionCreate {
+	acc.mutation.SetEmployeeID(u)
+	return acc
+}
+
+// SetOriginalCheckInTime sets the "original_check_in_time" field.
+func (acc *AttendanceCorrectionCreate) SetOriginalCheckInTime(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetOriginalCheckInTime(t)
+	return acc
+}
+
+// SetNillableOriginalCheckInTime sets the "original_check_in_time" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableOriginalCheckInTime(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetOriginalCheckInTime(*t)
+	}
+	return acc
+}
+
+// SetOriginalCheckOutTime sets the "original_check_out_time" field.
+func (acc *AttendanceCorrectionCreate) SetOriginalCheckOutTime(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetOriginalCheckOutTime(t)
+	return acc
+}
+
+// SetNillableOriginalCheckOutTime sets the "original_check_out_time" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableOriginalCheckOutTime(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetOriginalCheckOutTime(*t)
+	}
+	return acc
+}
+
+// SetOriginalStatus sets the "original_status" field.
+func (acc *AttendanceCorrectionCreate) SetOriginalStatus(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetOriginalStatus(s)
+	return acc
+}
+
+// SetProposedCheckInTime sets the "proposed_check_in_time" field.
+func (acc *AttendanceCorrectionCreate) SetProposedCheckInTime(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetProposedCheckInTime(t)
+	return acc
+}
+
+// SetNillableProposedCheckInTime sets the "proposed_check_in_time" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableProposedCheckInTime(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetProposedCheckInTime(*t)
+	}
+	return acc
+}
+
+// SetProposedCheckOutTime sets the "proposed_check_out_time" field.
+func (acc *AttendanceCorrectionCreate) SetProposedCheckOutTime(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetProposedCheckOutTime(t)
+	return acc
+}
+
+// SetNillableProposedCheckOutTime sets the "proposed_check_out_time" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableProposedCheckOutTime(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetProposedCheckOutTime(*t)
+	}
+	return acc
+}
+
+// SetProposedStatus sets the "proposed_status" field.
+func (acc *AttendanceCorrectionCreate) SetProposedStatus(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetProposedStatus(s)
+	return acc
+}
+
+// SetNillableProposedStatus sets the "proposed_status" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableProposedStatus(s *string) *AttendanceCorrectionCreate {
+	if s != nil {
+		acc.SetProposedStatus(*s)
+	}
+	return acc
+}
+
+// SetReason sets the "reason" field.
+func (acc *AttendanceCorrectionCreate) SetReason(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetReason(s)
+	return acc
+}
+
+// SetStatus sets the "status" field.
+func (acc *AttendanceCorrectionCreate) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionCreate {
+	acc.mutation.SetStatus(a)
+	return acc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionCreate {
+	if a != nil {
+		acc.SetStatus(*a)
+	}
+	return acc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (acc *AttendanceCorrectionCreate) SetReviewedBy(u uint64) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewedBy(u)
+	return acc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewedBy(u *uint64) *AttendanceCorrectionCreate {
+	if u != nil {
+		acc.SetReviewedBy(*u)
+	}
+	return acc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acc *AttendanceCorrectionCreate) SetReviewedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewedAt(t)
+	return acc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetReviewedAt(*t)
+	}
+	return acc
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (acc *AttendanceCorrectionCreate) SetReviewNotes(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewNotes(s)
+	return acc
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewNotes(s *string) *AttendanceCorrectionCreate {
+	if s != nil {
+		acc.SetReviewNotes(*s)
+	}
+	return acc
+}
+
+// SetID sets the "id" field.
+func (acc *AttendanceCorrectionCreate) SetID(u uint64) *AttendanceCorrectionCreate {
+	acc.mutation.SetID(u)
+	return acc
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (acc *AttendanceCorrectionCreate) SetAttendance(a *Attendance) *AttendanceCorrectionCreate {
+	return acc.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acc *AttendanceCorrectionCreate) SetEmployee(e *Employee) *AttendanceCorrectionCreate {
+	return acc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acc *AttendanceCorrectionCreate) Mutation() *AttendanceCorrectionMutation {
+	return acc.mutation
+}
+
+// Save creates the AttendanceCorrection in the database.
+func (acc *AttendanceCorrectionCreate) Save(ctx context.Context) (*AttendanceCorrection, error) {
+	acc.defaults()
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AttendanceCorrectionCreate) SaveX(ctx context.Context) *AttendanceCorrection {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *AttendanceCorrectionCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *AttendanceCorrectionCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acc *AttendanceCorrectionCreate) defaults() {
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		v := attendancecorrection.DefaultCreatedAt()
+		acc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := acc.mutation.ModifiedAt(); !ok {
+		v := attendancecorrection.DefaultModifiedAt()
+		acc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := acc.mutation.Status(); !ok {
+		v := attendancecorrection.DefaultStatus
+		acc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AttendanceCorrectionCreate) check() error {
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendanceCorrection.created_at"`)}
+	}
+	if _, ok := acc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "AttendanceCorrection.modified_at"`)}
+	}
+	if _, ok := acc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance_id", err: errors.New(`ent: missing required field "AttendanceCorrection.attendance_id"`)}
+	}
+	if _, ok := acc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "AttendanceCorrection.employee_id"`)}
+	}
+	if _, ok := acc.mutation.OriginalStatus(); !ok {
+		return &ValidationError{Name: "original_status", err: errors.New(`ent: missing required field "AttendanceCorrection.original_status"`)}
+	}
+	if v, ok := acc.mutation.OriginalStatus(); ok {
+		if err := attendancecorrection.OriginalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "original_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.original_status": %w`, err)}
+		}
+	}
+	if v, ok := acc.mutation.ProposedStatus(); ok {
+		if err := attendancecorrection.ProposedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.proposed_status": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AttendanceCorrection.reason"`)}
+	}
+	if v, ok := acc.mutation.Reason(); ok {
+		if err := attendancecorrection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.reason": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AttendanceCorrection.status"`)}
+	}
+	if v, ok := acc.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance", err: errors.New(`ent: missing required edge "AttendanceCorrection.attendance"`)}
+	}
+	if _, ok := acc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "AttendanceCorrection.employee"`)}
+	}
+	return nil
+}
+
+func (acc *AttendanceCorrectionCreate) sqlSave(ctx context.Context) (*AttendanceCorrection, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *AttendanceCorrectionCreate) createSpec() (*AttendanceCorrection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendanceCorrection{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(attendancecorrection.Table, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64))
+	)
+	if id, ok := acc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := acc.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := acc.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := acc.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := acc.mutation.OriginalCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckInTime, field.TypeTime, value)
+		_node.OriginalCheckInTime = &value
+	}
+	if value, ok := acc.mutation.OriginalCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckOutTime, field.TypeTime, value)
+		_node.OriginalCheckOutTime = &value
+	}
+	if value, ok := acc.mutation.OriginalStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalStatus, field.TypeString, value)
+		_node.OriginalStatus = value
+	}
+	if value, ok := acc.mutation.ProposedCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckInTime, field.TypeTime, value)
+		_node.ProposedCheckInTime = &value
+	}
+	if value, ok := acc.mutation.ProposedCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckOutTime, field.TypeTime, value)
+		_node.ProposedCheckOutTime = &value
+	}
+	if value, ok := acc.mutation.ProposedStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedStatus, field.TypeString, value)
+		_node.ProposedStatus = value
+	}
+	if value, ok := acc.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := acc.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := acc.mutation.ReviewedBy(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedBy, field.TypeUint64, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := acc.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := acc.mutation.ReviewNotes(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = value
+	}
+	if nodes := acc.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceTable,
+			Columns: []string{attendancecorrection.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttendanceCorrectionCreateBulk is the builder for creating many AttendanceCorrection entities in bulk.
+type AttendanceCorrectionCreateBulk struct {
+	config
+	builders []*AttendanceCorrectionCreate
+}
+
+// Save creates the AttendanceCorrection entities in the database.
+func (accb *AttendanceCorrectionCreateBulk) Save(ctx context.Context) ([]*AttendanceCorrection, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AttendanceCorrection, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendanceCorrectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AttendanceCorrectionCreateBulk) SaveX(ctx context.Context) []*AttendanceCorrection {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *AttendanceCorrectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *AttendanceCorrectionCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceCorrectionUpdate is the builder for updating AttendanceCorrection entities.
+type AttendanceCorrectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttendanceCorrectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttendanceCorrectionUpdate builder.
+func (acu *AttendanceCorrectionUpdate) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (acu *AttendanceCorrectionUpdate) SetModifiedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetModifiedAt(t)
+	return acu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acu *AttendanceCorrectionUpdate) SetDeletedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetDeletedAt(t)
+	return acu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableDeletedAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetDeletedAt(*t)
+	}
+	return acu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (acu *AttendanceCorrectionUpdate) ClearDeletedAt() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearDeletedAt()
+	return acu
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (acu *AttendanceCorrectionUpdate) SetAttendanceID(u uint64) *AttendanceCorrectionUpdate {
+	acu.mutation.SetAttendanceID(u)
+	return acu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acu *AttendanceCorrectionUpdate) SetEmployeeID(u uint64) *AttendanceCorrectionUpdate {
+	acu.mutation.SetEmployeeID(u)
+	return acu
+}
+
+// SetOriginalCheckInTime sets the "original_check_in_time" field.
+func (acu *AttendanceCorrectionUpdate) SetOriginalCheckInTime(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetOriginalCheckInTime(t)
+	return acu
+}
+
+// SetNillableOriginalCheckInTime sets the "original_check_in_time" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableOriginalCheckInTime(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetOriginalCheckInTime(*t)
+	}
+	return acu
+}
+
+// ClearOriginalCheckInTime clears the value of the "original_check_in_time" field.
+func (acu *AttendanceCorrectionUpdate) ClearOriginalCheckInTime() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearOriginalCheckInTime()
+	return acu
+}
+
+// SetOriginalCheckOutTime sets the "original_check_out_time" field.
+func (acu *AttendanceCorrectionUpdate) SetOriginalCheckOutTime(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetOriginalCheckOutTime(t)
+	return acu
+}
+
+// SetNillableOriginalCheckOutTime sets the "original_check_out_time" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableOriginalCheckOutTime(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetOriginalCheckOutTime(*t)
+	}
+	return acu
+}
+
+// ClearOriginalCheckOutTime clears the value of the "original_check_out_time" field.
+func (acu *AttendanceCorrectionUpdate) ClearOriginalCheckOutTime() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearOriginalCheckOutTime()
+	return acu
+}
+
+// SetOriginalStatus sets the "original_status" field.
+func (acu *AttendanceCorrectionUpdate) SetOriginalStatus(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetOriginalStatus(s)
+	return acu
+}
+
+// SetProposedCheckInTime sets the "proposed_check_in_time" field.
+func (acu *AttendanceCorrectionUpdate) SetProposedCheckInTime(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetProposedCheckInTime(t)
+	return acu
+}
+
+// SetNillableProposedCheckInTime sets the "proposed_check_in_time" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableProposedCheckInTime(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetProposedCheckInTime(*t)
+	}
+	return acu
+}
+
+// ClearProposedCheckInTime clears the value of the "proposed_check_in_time" field.
+func (acu *AttendanceCorrectionUpdate) ClearProposedCheckInTime() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearProposedCheckInTime()
+	return acu
+}
+
+// SetProposedCheckOutTime sets the "proposed_check_out_time" field.
+func (acu *AttendanceCorrectionUpdate) SetProposedCheckOutTime(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetProposedCheckOutTime(t)
+	return acu
+}
+
+// SetNillableProposedCheckOutTime sets the "proposed_check_out_time" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableProposedCheckOutTime(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetProposedCheckOutTime(*t)
+	}
+	return acu
+}
+
+// ClearProposedCheckOutTime clears the value of the "proposed_check_out_time" field.
+func (acu *AttendanceCorrectionUpdate) ClearProposedCheckOutTime() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearProposedCheckOutTime()
+	return acu
+}
+
+// SetProposedStatus sets the "proposed_status" field.
+func (acu *AttendanceCorrectionUpdate) SetProposedStatus(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetProposedStatus(s)
+	return acu
+}
+
+// SetNillableProposedStatus sets the "proposed_status" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableProposedStatus(s *string) *AttendanceCorrectionUpdate {
+	if s != nil {
+		acu.SetProposedStatus(*s)
+	}
+	return acu
+}
+
+// ClearProposedStatus clears the value of the "proposed_status" field.
+func (acu *AttendanceCorrectionUpdate) ClearProposedStatus() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearProposedStatus()
+	return acu
+}
+
+// SetReason sets the "reason" field.
+func (acu *AttendanceCorrectionUpdate) SetReason(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReason(s)
+	return acu
+}
+
+// SetStatus sets the "status" field.
+func (acu *AttendanceCorrectionUpdate) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionUpdate {
+	acu.mutation.SetStatus(a)
+	return acu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionUpdate {
+	if a != nil {
+		acu.SetStatus(*a)
+	}
+	return acu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewedBy(u uint64) *AttendanceCorrectionUpdate {
+	acu.mutation.ResetReviewedBy()
+	acu.mutation.SetReviewedBy(u)
+	return acu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewedBy(u *uint64) *AttendanceCorrectionUpdate {
+	if u != nil {
+		acu.SetReviewedBy(*u)
+	}
+	return acu
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (acu *AttendanceCorrectionUpdate) AddReviewedBy(u int64) *AttendanceCorrectionUpdate {
+	acu.mutation.AddReviewedBy(u)
+	return acu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewedBy() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewedBy()
+	return acu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReviewedAt(t)
+	return acu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetReviewedAt(*t)
+	}
+	return acu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewedAt() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewedAt()
+	return acu
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewNotes(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReviewNotes(s)
+	return acu
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewNotes(s *string) *AttendanceCorrectionUpdate {
+	if s != nil {
+		acu.SetReviewNotes(*s)
+	}
+	return acu
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewNotes() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewNotes()
+	return acu
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (acu *AttendanceCorrectionUpdate) SetAttendance(a *Attendance) *AttendanceCorrectionUpdate {
+	return acu.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acu *AttendanceCorrectionUpdate) SetEmployee(e *Employee) *AttendanceCorrectionUpdate {
+	return acu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acu *AttendanceCorrectionUpdate) Mutation() *AttendanceCorrectionMutation {
+	return acu.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (acu *AttendanceCorrectionUpdate) ClearAttendance() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearAttendance()
+	return acu
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (acu *AttendanceCorrectionUpdate) ClearEmployee() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearEmployee()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AttendanceCorrectionUpdate) Save(ctx context.Context) (int, error) {
+	acu.defaults()
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AttendanceCorrectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AttendanceCorrectionUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AttendanceCorrectionUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acu *AttendanceCorrectionUpdate) defaults() {
+	if _, ok := acu.mutation.ModifiedAt(); !ok {
+		v := attendancecorrection.UpdateDefaultModifiedAt()
+		acu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *AttendanceCorrectionUpdate) check() error {
+	if v, ok := acu.mutation.OriginalStatus(); ok {
+		if err := attendancecorrection.OriginalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "original_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.original_status": %w`, err)}
+		}
+	}
+	if v, ok := acu.mutation.ProposedStatus(); ok {
+		if err := attendancecorrection.ProposedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.proposed_status": %w`, err)}
+		}
+	}
+	if v, ok := acu.mutation.Reason(); ok {
+		if err := attendancecorrection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.reason": %w`, err)}
+		}
+	}
+	if v, ok := acu.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if _, ok := acu.mutation.AttendanceID(); acu.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.attendance"`)
+	}
+	if _, ok := acu.mutation.EmployeeID(); acu.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.employee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (acu *AttendanceCorrectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendanceCorrectionUpdate {
+	acu.modifiers = append(acu.modifiers, modifiers...)
+	return acu
+}
+
+func (acu *AttendanceCorrectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := acu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancecorrection.Table, attendancecorrection.Columns, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := acu.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if acu.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := acu.mutation.OriginalCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckInTime, field.TypeTime, value)
+	}
+	if acu.mutation.OriginalCheckInTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldOriginalCheckInTime, field.TypeTime)
+	}
+	if value, ok := acu.mutation.OriginalCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckOutTime, field.TypeTime, value)
+	}
+	if acu.mutation.OriginalCheckOutTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldOriginalCheckOutTime, field.TypeTime)
+	}
+	if value, ok := acu.mutation.OriginalStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalStatus, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.ProposedCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckInTime, field.TypeTime, value)
+	}
+	if acu.mutation.ProposedCheckInTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedCheckInTime, field.TypeTime)
+	}
+	if value, ok := acu.mutation.ProposedCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckOutTime, field.TypeTime, value)
+	}
+	if acu.mutation.ProposedCheckOutTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedCheckOutTime, field.TypeTime)
+	}
+	if value, ok := acu.mutation.ProposedStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedStatus, field.TypeString, value)
+	}
+	if acu.mutation.ProposedStatusCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedStatus, field.TypeString)
+	}
+	if value, ok := acu.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := acu.mutation.ReviewedBy(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := acu.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(attendancecorrection.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if acu.mutation.ReviewedByCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := acu.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+	}
+	if acu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := acu.mutation.ReviewNotes(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewNotes, field.TypeString, value)
+	}
+	if acu.mutation.ReviewNotesCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewNotes, field.TypeString)
+	}
+	if acu.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceTable,
+			Columns: []string{attendancecorrection.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceTable,
+			Columns: []string{attendancecorrection.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(acu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancecorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// AttendanceCorrectionUpdateOne is the builder for updating a single AttendanceCorrection entity.
+type AttendanceCorrectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttendanceCorrectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetModifiedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetModifiedAt(t)
+	return acuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetDeletedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetDeletedAt(t)
+	return acuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetDeletedAt(*t)
+	}
+	return acuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearDeletedAt() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearDeletedAt()
+	return acuo
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetAttendanceID(u uint64) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetAttendanceID(u)
+	return acuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetEmployeeID(u uint64) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetEmployeeID(u)
+	return acuo
+}
+
+// SetOriginalCheckInTime sets the "original_check_in_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetOriginalCheckInTime(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetOriginalCheckInTime(t)
+	return acuo
+}
+
+// SetNillableOriginalCheckInTime sets the "original_check_in_time" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableOriginalCheckInTime(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetOriginalCheckInTime(*t)
+	}
+	return acuo
+}
+
+// ClearOriginalCheckInTime clears the value of the "original_check_in_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearOriginalCheckInTime() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearOriginalCheckInTime()
+	return acuo
+}
+
+// SetOriginalCheckOutTime sets the "original_check_out_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetOriginalCheckOutTime(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetOriginalCheckOutTime(t)
+	return acuo
+}
+
+// SetNillableOriginalCheckOutTime sets the "original_check_out_time" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableOriginalCheckOutTime(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetOriginalCheckOutTime(*t)
+	}
+	return acuo
+}
+
+// ClearOriginalCheckOutTime clears the value of the "original_check_out_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearOriginalCheckOutTime() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearOriginalCheckOutTime()
+	return acuo
+}
+
+// SetOriginalStatus sets the "original_status" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetOriginalStatus(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetOriginalStatus(s)
+	return acuo
+}
+
+// SetProposedCheckInTime sets the "proposed_check_in_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetProposedCheckInTime(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetProposedCheckInTime(t)
+	return acuo
+}
+
+// SetNillableProposedCheckInTime sets the "proposed_check_in_time" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableProposedCheckInTime(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetProposedCheckInTime(*t)
+	}
+	return acuo
+}
+
+// ClearProposedCheckInTime clears the value of the "proposed_check_in_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearProposedCheckInTime() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearProposedCheckInTime()
+	return acuo
+}
+
+// SetProposedCheckOutTime sets the "proposed_check_out_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetProposedCheckOutTime(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetProposedCheckOutTime(t)
+	return acuo
+}
+
+// SetNillableProposedCheckOutTime sets the "proposed_check_out_time" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableProposedCheckOutTime(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetProposedCheckOutTime(*t)
+	}
+	return acuo
+}
+
+// ClearProposedCheckOutTime clears the value of the "proposed_check_out_time" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearProposedCheckOutTime() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearProposedCheckOutTime()
+	return acuo
+}
+
+// SetProposedStatus sets the "proposed_status" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetProposedStatus(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetProposedStatus(s)
+	return acuo
+}
+
+// SetNillableProposedStatus sets the "proposed_status" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableProposedStatus(s *string) *AttendanceCorrectionUpdateOne {
+	if s != nil {
+		acuo.SetProposedStatus(*s)
+	}
+	return acuo
+}
+
+// ClearProposedStatus clears the value of the "proposed_status" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearProposedStatus() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearProposedStatus()
+	return acuo
+}
+
+// SetReason sets the "reason" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReason(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReason(s)
+	return acuo
+}
+
+// SetStatus sets the "status" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetStatus(a)
+	return acuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionUpdateOne {
+	if a != nil {
+		acuo.SetStatus(*a)
+	}
+	return acuo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewedBy(u uint64) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ResetReviewedBy()
+	acuo.mutation.SetReviewedBy(u)
+	return acuo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewedBy(u *uint64) *AttendanceCorrectionUpdateOne {
+	if u != nil {
+		acuo.SetReviewedBy(*u)
+	}
+	return acuo
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (acuo *AttendanceCorrectionUpdateOne) AddReviewedBy(u int64) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.AddReviewedBy(u)
+	return acuo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewedBy() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewedBy()
+	return acuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReviewedAt(t)
+	return acuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetReviewedAt(*t)
+	}
+	return acuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewedAt() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewedAt()
+	return acuo
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewNotes(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReviewNotes(s)
+	return acuo
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewNotes(s *string) *AttendanceCorrectionUpdateOne {
+	if s != nil {
+		acuo.SetReviewNotes(*s)
+	}
+	return acuo
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewNotes() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewNotes()
+	return acuo
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (acuo *AttendanceCorrectionUpdateOne) SetAttendance(a *Attendance) *AttendanceCorrectionUpdateOne {
+	return acuo.SetAttendanceID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acuo *AttendanceCorrectionUpdateOne) SetEmployee(e *Employee) *AttendanceCorrectionUpdateOne {
+	return acuo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acuo *AttendanceCorrectionUpdateOne) Mutation() *AttendanceCorrectionMutation {
+	return acuo.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (acuo *AttendanceCorrectionUpdateOne) ClearAttendance() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearAttendance()
+	return acuo
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (acuo *AttendanceCorrectionUpdateOne) ClearEmployee() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearEmployee()
+	return acuo
+}
+
+// Where appends a list predicates to the AttendanceCorrectionUpdate builder.
+func (acuo *AttendanceCorrectionUpdateOne) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *AttendanceCorrectionUpdateOne) Select(field string, fields ...string) *AttendanceCorrectionUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated AttendanceCorrection entity.
+func (acuo *AttendanceCorrectionUpdateOne) Save(ctx context.Context) (*AttendanceCorrection, error) {
+	acuo.defaults()
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AttendanceCorrectionUpdateOne) SaveX(ctx context.Context) *AttendanceCorrection {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AttendanceCorrectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AttendanceCorrectionUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acuo *AttendanceCorrectionUpdateOne) defaults() {
+	if _, ok := acuo.mutation.ModifiedAt(); !ok {
+		v := attendancecorrection.UpdateDefaultModifiedAt()
+		acuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *AttendanceCorrectionUpdateOne) check() error {
+	if v, ok := acuo.mutation.OriginalStatus(); ok {
+		if err := attendancecorrection.OriginalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "original_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.original_status": %w`, err)}
+		}
+	}
+	if v, ok := acuo.mutation.ProposedStatus(); ok {
+		if err := attendancecorrection.ProposedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.proposed_status": %w`, err)}
+		}
+	}
+	if v, ok := acuo.mutation.Reason(); ok {
+		if err := attendancecorrection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.reason": %w`, err)}
+		}
+	}
+	if v, ok := acuo.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if _, ok := acuo.mutation.AttendanceID(); acuo.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.attendance"`)
+	}
+	if _, ok := acuo.mutation.EmployeeID(); acuo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.employee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (acuo *AttendanceCorrectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendanceCorrectionUpdateOne {
+	acuo.modifiers = append(acuo.modifiers, modifiers...)
+	return acuo
+}
+
+func (acuo *AttendanceCorrectionUpdateOne) sqlSave(ctx context.Context) (_node *AttendanceCorrection, err error) {
+	if err := acuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancecorrection.Table, attendancecorrection.Columns, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendanceCorrection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancecorrection.FieldID)
+		for _, f := range fields {
+			if !attendancecorrection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendancecorrection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := acuo.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if acuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.OriginalCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckInTime, field.TypeTime, value)
+	}
+	if acuo.mutation.OriginalCheckInTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldOriginalCheckInTime, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.OriginalCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalCheckOutTime, field.TypeTime, value)
+	}
+	if acuo.mutation.OriginalCheckOutTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldOriginalCheckOutTime, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.OriginalStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldOriginalStatus, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.ProposedCheckInTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckInTime, field.TypeTime, value)
+	}
+	if acuo.mutation.ProposedCheckInTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedCheckInTime, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.ProposedCheckOutTime(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedCheckOutTime, field.TypeTime, value)
+	}
+	if acuo.mutation.ProposedCheckOutTimeCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedCheckOutTime, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.ProposedStatus(); ok {
+		_spec.SetField(attendancecorrection.FieldProposedStatus, field.TypeString, value)
+	}
+	if acuo.mutation.ProposedStatusCleared() {
+		_spec.ClearField(attendancecorrection.FieldProposedStatus, field.TypeString)
+	}
+	if value, ok := acuo.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := acuo.mutation.ReviewedBy(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := acuo.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(attendancecorrection.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if acuo.mutation.ReviewedByCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := acuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+	}
+	if acuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.ReviewNotes(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewNotes, field.TypeString, value)
+	}
+	if acuo.mutation.ReviewNotesCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewNotes, field.TypeString)
+	}
+	if acuo.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceTable,
+			Columns: []string{attendancecorrection.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceTable,
+			Columns: []string{attendancecorrection.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(acuo.modifiers...)
+	_node = &AttendanceCorrection{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancecorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
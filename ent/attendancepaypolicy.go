@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// AttendancePayPolicy is the model entity for the AttendancePayPolicy schema.
+type AttendancePayPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Share of a day's pay earned by a present day
+	PresentWeight decimal.Decimal `json:"present_weight,omitempty"`
+	// Share of a day's pay earned by a late day
+	LateWeight decimal.Decimal `json:"late_weight,omitempty"`
+	// Share of a day's pay earned by a half day
+	HalfDayWeight decimal.Decimal `json:"half_day_weight,omitempty"`
+	// Fixed deduction for every late day beyond the free late days, 0 disables
+	LatePenaltyAmount decimal.Decimal `json:"late_penalty_amount,omitempty"`
+	// Late days per month tolerated before the late penalty applies
+	LatePenaltyFreeDays int `json:"late_penalty_free_days,omitempty"`
+	// Applied to work schedules without an attendance pay policy
+	IsDefault bool `json:"is_default,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendancePayPolicyQuery when eager-loading is set.
+	Edges        AttendancePayPolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendancePayPolicyEdges holds the relations/edges for other nodes in the graph.
+type AttendancePayPolicyEdges struct {
+	// WorkSchedules holds the value of the work_schedules edge.
+	WorkSchedules []*WorkSchedule `json:"work_schedules,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WorkSchedulesOrErr returns the WorkSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendancePayPolicyEdges) WorkSchedulesOrErr() ([]*WorkSchedule, error) {
+	if e.loadedTypes[0] {
+		return e.WorkSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "work_schedules"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendancePayPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendancepaypolicy.FieldPresentWeight, attendancepaypolicy.FieldLateWeight, attendancepaypolicy.FieldHalfDayWeight, attendancepaypolicy.FieldLatePenaltyAmount:
+			values[i] = new(decimal.Decimal)
+		case attendancepaypolicy.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case attendancepaypolicy.FieldID, attendancepaypolicy.FieldLatePenaltyFreeDays:
+			values[i] = new(sql.NullInt64)
+		case attendancepaypolicy.FieldName:
+			values[i] = new(sql.NullString)
+		case attendancepaypolicy.FieldCreatedAt, attendancepaypolicy.FieldModifiedAt, attendancepaypolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendancePayPolicy fields.
+func (app *AttendancePayPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendancepaypolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			app.ID = uint64(value.Int64)
+		case attendancepaypolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				app.CreatedAt = value.Time
+			}
+		case attendancepaypolicy.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				app.ModifiedAt = value.Time
+			}
+		case attendancepaypolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				app.DeletedAt = value.Time
+			}
+		case attendancepaypolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				app.Name = value.String
+			}
+		case attendancepaypolicy.FieldPresentWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field present_weight", values[i])
+			} else if value != nil {
+				app.PresentWeight = *value
+			}
+		case attendancepaypolicy.FieldLateWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field late_weight", values[i])
+			} else if value != nil {
+				app.LateWeight = *value
+			}
+		case attendancepaypolicy.FieldHalfDayWeight:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field half_day_weight", values[i])
+			} else if value != nil {
+				app.HalfDayWeight = *value
+			}
+		case attendancepaypolicy.FieldLatePenaltyAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field late_penalty_amount", values[i])
+			} else if value != nil {
+				app.LatePenaltyAmount = *value
+			}
+		case attendancepaypolicy.FieldLatePenaltyFreeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_penalty_free_days", values[i])
+			} else if value.Valid {
+				app.LatePenaltyFreeDays = int(value.Int64)
+			}
+		case attendancepaypolicy.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				app.IsDefault = value.Bool
+			}
+		default:
+			app.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendancePayPolicy.
+// This includes values selected through modifiers, order, etc.
+func (app *AttendancePayPolicy) Value(name string) (ent.Value, error) {
+	return app.selectValues.Get(name)
+}
+
+// QueryWorkSchedules queries the "work_schedules" edge of the AttendancePayPolicy entity.
+func (app *AttendancePayPolicy) QueryWorkSchedules() *WorkScheduleQuery {
+	return NewAttendancePayPolicyClient(app.config).QueryWorkSchedules(app)
+}
+
+// Update returns a builder for updating this AttendancePayPolicy.
+// Note that you need to call AttendancePayPolicy.Unwrap() before calling this method if this AttendancePayPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (app *AttendancePayPolicy) Update() *AttendancePayPolicyUpdateOne {
+	return NewAttendancePayPolicyClient(app.config).UpdateOne(app)
+}
+
+// Unwrap unwraps the AttendancePayPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (app *AttendancePayPolicy) Unwrap() *AttendancePayPolicy {
+	_tx, ok := app.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendancePayPolicy is not a transactional entity")
+	}
+	app.config.driver = _tx.drv
+	return app
+}
+
+// String implements the fmt.Stringer.
+func (app *AttendancePayPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendancePayPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", app.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(app.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(app.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(app.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(app.Name)
+	builder.WriteString(", ")
+	builder.WriteString("present_weight=")
+	builder.WriteString(fmt.Sprintf("%v", app.PresentWeight))
+	builder.WriteString(", ")
+	builder.WriteString("late_weight=")
+	builder.WriteString(fmt.Sprintf("%v", app.LateWeight))
+	builder.WriteString(", ")
+	builder.WriteString("half_day_weight=")
+	builder.WriteString(fmt.Sprintf("%v", app.HalfDayWeight))
+	builder.WriteString(", ")
+	builder.WriteString("late_penalty_amount=")
+	builder.WriteString(fmt.Sprintf("%v", app.LatePenaltyAmount))
+	builder.WriteString(", ")
+	builder.WriteString("late_penalty_free_days=")
+	builder.WriteString(fmt.Sprintf("%v", app.LatePenaltyFreeDays))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", app.IsDefault))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendancePayPolicies is a parsable slice of AttendancePayPolicy.
+type AttendancePayPolicies []*AttendancePayPolicy
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancepaypolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the attendancepaypolicy type in the database.
+	Label = "attendance_pay_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPresentWeight holds the string denoting the present_weight field in the database.
+	FieldPresentWeight = "present_weight"
+	// FieldLateWeight holds the string denoting the late_weight field in the database.
+	FieldLateWeight = "late_weight"
+	// FieldHalfDayWeight holds the string denoting the half_day_weight field in the database.
+	FieldHalfDayWeight = "half_day_weight"
+	// FieldLatePenaltyAmount holds the string denoting the late_penalty_amount field in the database.
+	FieldLatePenaltyAmount = "late_penalty_amount"
+	// FieldLatePenaltyFreeDays holds the string denoting the late_penalty_free_days field in the database.
+	FieldLatePenaltyFreeDays = "late_penalty_free_days"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// EdgeWorkSchedules holds the string denoting the work_schedules edge name in mutations.
+	EdgeWorkSchedules = "work_schedules"
+	// Table holds the table name of the attendancepaypolicy in the database.
+	Table = "attendance_pay_policies"
+	// WorkSchedulesTable is the table that holds the work_schedules relation/edge.
+	WorkSchedulesTable = "work_schedules"
+	// WorkSchedulesInverseTable is the table name for the WorkSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "workschedule" package.
+	WorkSchedulesInverseTable = "work_schedules"
+	// WorkSchedulesColumn is the table column denoting the work_schedules relation/edge.
+	WorkSchedulesColumn = "attendance_pay_policy_id"
+)
+
+// Columns holds all SQL columns for attendancepaypolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldPresentWeight,
+	FieldLateWeight,
+	FieldHalfDayWeight,
+	FieldLatePenaltyAmount,
+	FieldLatePenaltyFreeDays,
+	FieldIsDefault,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPresentWeight holds the default value on creation for the "present_weight" field.
+	DefaultPresentWeight decimal.Decimal
+	// DefaultLateWeight holds the default value on creation for the "late_weight" field.
+	DefaultLateWeight decimal.Decimal
+	// DefaultHalfDayWeight holds the default value on creation for the "half_day_weight" field.
+	DefaultHalfDayWeight decimal.Decimal
+	// DefaultLatePenaltyAmount holds the default value on creation for the "late_penalty_amount" field.
+	DefaultLatePenaltyAmount decimal.Decimal
+	// DefaultLatePenaltyFreeDays holds the default value on creation for the "late_penalty_free_days" field.
+	DefaultLatePenaltyFreeDays int
+	// LatePenaltyFreeDaysValidator is a validator for the "late_penalty_free_days" field. It is called by the builders before save.
+	LatePenaltyFreeDaysValidator func(int) error
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+)
+
+// OrderOption defines the ordering options for the AttendancePayPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPresentWeight orders the results by the present_weight field.
+func ByPresentWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPresentWeight, opts...).ToFunc()
+}
+
+// ByLateWeight orders the results by the late_weight field.
+func ByLateWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateWeight, opts...).ToFunc()
+}
+
+// ByHalfDayWeight orders the results by the half_day_weight field.
+func ByHalfDayWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHalfDayWeight, opts...).ToFunc()
+}
+
+// ByLatePenaltyAmount orders the results by the late_penalty_amount field.
+func ByLatePenaltyAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatePenaltyAmount, opts...).ToFunc()
+}
+
+// ByLatePenaltyFreeDays orders the results by the late_penalty_free_days field.
+func ByLatePenaltyFreeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatePenaltyFreeDays, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByWorkSchedulesCount orders the results by work_schedules count.
+func ByWorkSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkSchedulesStep(), opts...)
+	}
+}
+
+// ByWorkSchedules orders the results by work_schedules terms.
+func ByWorkSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkSchedulesTable, WorkSchedulesColumn),
+	)
+}
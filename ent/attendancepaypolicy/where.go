@@ -0,0 +1,567 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancepaypolicy
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// PresentWeight applies equality check predicate on the "present_weight" field. It's identical to PresentWeightEQ.
+func PresentWeight(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldPresentWeight, v))
+}
+
+// LateWeight applies equality check predicate on the "late_weight" field. It's identical to LateWeightEQ.
+func LateWeight(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLateWeight, v))
+}
+
+// HalfDayWeight applies equality check predicate on the "half_day_weight" field. It's identical to HalfDayWeightEQ.
+func HalfDayWeight(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldHalfDayWeight, v))
+}
+
+// LatePenaltyAmount applies equality check predicate on the "late_penalty_amount" field. It's identical to LatePenaltyAmountEQ.
+func LatePenaltyAmount(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyFreeDays applies equality check predicate on the "late_penalty_free_days" field. It's identical to LatePenaltyFreeDaysEQ.
+func LatePenaltyFreeDays(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLatePenaltyFreeDays, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// PresentWeightEQ applies the EQ predicate on the "present_weight" field.
+func PresentWeightEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldPresentWeight, v))
+}
+
+// PresentWeightNEQ applies the NEQ predicate on the "present_weight" field.
+func PresentWeightNEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldPresentWeight, v))
+}
+
+// PresentWeightIn applies the In predicate on the "present_weight" field.
+func PresentWeightIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldPresentWeight, vs...))
+}
+
+// PresentWeightNotIn applies the NotIn predicate on the "present_weight" field.
+func PresentWeightNotIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldPresentWeight, vs...))
+}
+
+// PresentWeightGT applies the GT predicate on the "present_weight" field.
+func PresentWeightGT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldPresentWeight, v))
+}
+
+// PresentWeightGTE applies the GTE predicate on the "present_weight" field.
+func PresentWeightGTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldPresentWeight, v))
+}
+
+// PresentWeightLT applies the LT predicate on the "present_weight" field.
+func PresentWeightLT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldPresentWeight, v))
+}
+
+// PresentWeightLTE applies the LTE predicate on the "present_weight" field.
+func PresentWeightLTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldPresentWeight, v))
+}
+
+// LateWeightEQ applies the EQ predicate on the "late_weight" field.
+func LateWeightEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLateWeight, v))
+}
+
+// LateWeightNEQ applies the NEQ predicate on the "late_weight" field.
+func LateWeightNEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldLateWeight, v))
+}
+
+// LateWeightIn applies the In predicate on the "late_weight" field.
+func LateWeightIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldLateWeight, vs...))
+}
+
+// LateWeightNotIn applies the NotIn predicate on the "late_weight" field.
+func LateWeightNotIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldLateWeight, vs...))
+}
+
+// LateWeightGT applies the GT predicate on the "late_weight" field.
+func LateWeightGT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldLateWeight, v))
+}
+
+// LateWeightGTE applies the GTE predicate on the "late_weight" field.
+func LateWeightGTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldLateWeight, v))
+}
+
+// LateWeightLT applies the LT predicate on the "late_weight" field.
+func LateWeightLT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldLateWeight, v))
+}
+
+// LateWeightLTE applies the LTE predicate on the "late_weight" field.
+func LateWeightLTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldLateWeight, v))
+}
+
+// HalfDayWeightEQ applies the EQ predicate on the "half_day_weight" field.
+func HalfDayWeightEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldHalfDayWeight, v))
+}
+
+// HalfDayWeightNEQ applies the NEQ predicate on the "half_day_weight" field.
+func HalfDayWeightNEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldHalfDayWeight, v))
+}
+
+// HalfDayWeightIn applies the In predicate on the "half_day_weight" field.
+func HalfDayWeightIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldHalfDayWeight, vs...))
+}
+
+// HalfDayWeightNotIn applies the NotIn predicate on the "half_day_weight" field.
+func HalfDayWeightNotIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldHalfDayWeight, vs...))
+}
+
+// HalfDayWeightGT applies the GT predicate on the "half_day_weight" field.
+func HalfDayWeightGT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldHalfDayWeight, v))
+}
+
+// HalfDayWeightGTE applies the GTE predicate on the "half_day_weight" field.
+func HalfDayWeightGTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldHalfDayWeight, v))
+}
+
+// HalfDayWeightLT applies the LT predicate on the "half_day_weight" field.
+func HalfDayWeightLT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldHalfDayWeight, v))
+}
+
+// HalfDayWeightLTE applies the LTE predicate on the "half_day_weight" field.
+func HalfDayWeightLTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldHalfDayWeight, v))
+}
+
+// LatePenaltyAmountEQ applies the EQ predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyAmountNEQ applies the NEQ predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountNEQ(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyAmountIn applies the In predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldLatePenaltyAmount, vs...))
+}
+
+// LatePenaltyAmountNotIn applies the NotIn predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountNotIn(vs ...decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldLatePenaltyAmount, vs...))
+}
+
+// LatePenaltyAmountGT applies the GT predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountGT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyAmountGTE applies the GTE predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountGTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyAmountLT applies the LT predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountLT(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyAmountLTE applies the LTE predicate on the "late_penalty_amount" field.
+func LatePenaltyAmountLTE(v decimal.Decimal) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldLatePenaltyAmount, v))
+}
+
+// LatePenaltyFreeDaysEQ applies the EQ predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysEQ(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldLatePenaltyFreeDays, v))
+}
+
+// LatePenaltyFreeDaysNEQ applies the NEQ predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysNEQ(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldLatePenaltyFreeDays, v))
+}
+
+// LatePenaltyFreeDaysIn applies the In predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysIn(vs ...int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldIn(FieldLatePenaltyFreeDays, vs...))
+}
+
+// LatePenaltyFreeDaysNotIn applies the NotIn predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysNotIn(vs ...int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNotIn(FieldLatePenaltyFreeDays, vs...))
+}
+
+// LatePenaltyFreeDaysGT applies the GT predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysGT(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGT(FieldLatePenaltyFreeDays, v))
+}
+
+// LatePenaltyFreeDaysGTE applies the GTE predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysGTE(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldGTE(FieldLatePenaltyFreeDays, v))
+}
+
+// LatePenaltyFreeDaysLT applies the LT predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysLT(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLT(FieldLatePenaltyFreeDays, v))
+}
+
+// LatePenaltyFreeDaysLTE applies the LTE predicate on the "late_penalty_free_days" field.
+func LatePenaltyFreeDaysLTE(v int) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldLTE(FieldLatePenaltyFreeDays, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// HasWorkSchedules applies the HasEdge predicate on the "work_schedules" edge.
+func HasWorkSchedules() predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkSchedulesTable, WorkSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkSchedulesWith applies the HasEdge predicate on the "work_schedules" edge with a given conditions (other predicates).
+func HasWorkSchedulesWith(preds ...predicate.WorkSchedule) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(func(s *sql.Selector) {
+		step := newWorkSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendancePayPolicy) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendancePayPolicy) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendancePayPolicy) predicate.AttendancePayPolicy {
+	return predicate.AttendancePayPolicy(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
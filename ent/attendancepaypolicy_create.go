@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// AttendancePayPolicyCreate is the builder for creating a AttendancePayPolicy entity.
+type AttendancePayPolicyCreate struct {
+	config
+	mutation *AttendancePayPolicyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (appc *AttendancePayPolicyCreate) SetCreatedAt(t time.Time) *AttendancePayPolicyCreate {
+	appc.mutation.SetCreatedAt(t)
+	return appc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableCreatedAt(t *time.Time) *AttendancePayPolicyCreate {
+	if t != nil {
+		appc.SetCreatedAt(*t)
+	}
+	return appc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (appc *AttendancePayPolicyCreate) SetModifiedAt(t time.Time) *AttendancePayPolicyCreate {
+	appc.mutation.SetModifiedAt(t)
+	return appc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableModifiedAt(t *time.Time) *AttendancePayPolicyCreate {
+	if t != nil {
+		appc.SetModifiedAt(*t)
+	}
+	return appc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (appc *AttendancePayPolicyCreate) SetDeletedAt(t time.Time) *AttendancePayPolicyCreate {
+	appc.mutation.SetDeletedAt(t)
+	return appc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableDeletedAt(t *time.Time) *AttendancePayPolicyCreate {
+	if t != nil {
+		appc.SetDeletedAt(*t)
+	}
+	return appc
+}
+
+// SetName sets the "name" field.
+func (appc *AttendancePayPolicyCreate) SetName(s string) *AttendancePayPolicyCreate {
+	appc.mutation.SetName(s)
+	return appc
+}
+
+// SetPresentWeight sets the "present_weight" field.
+func (appc *AttendancePayPolicyCreate) SetPresentWeight(d decimal.Decimal) *AttendancePayPolicyCreate {
+	appc.mutation.SetPresentWeight(d)
+	return appc
+}
+
+// SetNillablePresentWeight sets the "present_weight" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillablePresentWeight(d *decimal.Decimal) *AttendancePayPolicyCreate {
+	if d != nil {
+		appc.SetPresentWeight(*d)
+	}
+	return appc
+}
+
+// SetLateWeight sets the "late_weight" field.
+func (appc *AttendancePayPolicyCreate) SetLateWeight(d decimal.Decimal) *AttendancePayPolicyCreate {
+	appc.mutation.SetLateWeight(d)
+	return appc
+}
+
+// SetNillableLateWeight sets the "late_weight" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableLateWeight(d *decimal.Decimal) *AttendancePayPolicyCreate {
+	if d != nil {
+		appc.SetLateWeight(*d)
+	}
+	return appc
+}
+
+// SetHalfDayWeight sets the "half_day_weight" field.
+func (appc *AttendancePayPolicyCreate) SetHalfDayWeight(d decimal.Decimal) *AttendancePayPolicyCreate {
+	appc.mutation.SetHalfDayWeight(d)
+	return appc
+}
+
+// SetNillableHalfDayWeight sets the "half_day_weight" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableHalfDayWeight(d *decimal.Decimal) *AttendancePayPolicyCreate {
+	if d != nil {
+		appc.SetHalfDayWeight(*d)
+	}
+	return appc
+}
+
+// SetLatePenaltyAmount sets the "late_penalty_amount" field.
+func (appc *AttendancePayPolicyCreate) SetLatePenaltyAmount(d decimal.Decimal) *AttendancePayPolicyCreate {
+	appc.mutation.SetLatePenaltyAmount(d)
+	return appc
+}
+
+// SetNillableLatePenaltyAmount sets the "late_penalty_amount" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableLatePenaltyAmount(d *decimal.Decimal) *AttendancePayPolicyCreate {
+	if d != nil {
+		appc.SetLatePenaltyAmount(*d)
+	}
+	return appc
+}
+
+// SetLatePenaltyFreeDays sets the "late_penalty_free_days" field.
+func (appc *AttendancePayPolicyCreate) SetLatePenaltyFreeDays(i int) *AttendancePayPolicyCreate {
+	appc.mutation.SetLatePenaltyFreeDays(i)
+	return appc
+}
+
+// SetNillableLatePenaltyFreeDays sets the "late_penalty_free_days" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableLatePenaltyFreeDays(i *int) *AttendancePayPolicyCreate {
+	if i != nil {
+		appc.SetLatePenaltyFreeDays(*i)
+	}
+	return appc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (appc *AttendancePayPolicyCreate) SetIsDefault(b bool) *AttendancePayPolicyCreate {
+	appc.mutation.SetIsDefault(b)
+	return appc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (appc *AttendancePayPolicyCreate) SetNillableIsDefault(b *bool) *AttendancePayPolicyCreate {
+	if b != nil {
+		appc.SetIsDefault(*b)
+	}
+	return appc
+}
+
+// SetID sets the "id" field.
+func (appc *AttendancePayPolicyCreate) SetID(u uint64) *AttendancePayPolicyCreate {
+	appc.mutation.SetID(u)
+	return appc
+}
+
+// AddWorkScheduleIDs adds the "work_schedules" edge to the WorkSchedule entity by IDs.
+func (appc *AttendancePayPolicyCreate) AddWorkScheduleIDs(ids ...uint64) *AttendancePayPolicyCreate {
+	appc.mutation.AddWorkScheduleIDs(ids...)
+	return appc
+}
+
+// AddWorkSchedules adds the "work_schedules" edges to the WorkSchedule entity.
+func (appc *AttendancePayPolicyCreate) AddWorkSchedules(w ...*WorkSchedule) *AttendancePayPolicyCreate {
+	ids := make([]uint64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return appc.AddWorkScheduleIDs(ids...)
+}
+
+// Mutation returns the AttendancePayPolicyMutation object of the builder.
+func (appc *AttendancePayPolicyCreate) Mutation() *AttendancePayPolicyMutation {
+	return appc.mutation
+}
+
+// Save creates the AttendancePayPolicy in the database.
+func (appc *AttendancePayPolicyCreate) Save(ctx context.Context) (*AttendancePayPolicy, error) {
+	appc.defaults()
+	return withHooks(ctx, appc.sqlSave, appc.mutation, appc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (appc *AttendancePayPolicyCreate) SaveX(ctx context.Context) *AttendancePayPolicy {
+	v, err := appc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (appc *AttendancePayPolicyCreate) Exec(ctx context.Context) error {
+	_, err := appc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appc *AttendancePayPolicyCreate) ExecX(ctx context.Context) {
+	if err := appc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (appc *AttendancePayPolicyCreate) defaults() {
+	if _, ok := appc.mutation.CreatedAt(); !ok {
+		v := attendancepaypolicy.DefaultCreatedAt()
+		appc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := appc.mutation.ModifiedAt(); !ok {
+		v := attendancepaypolicy.DefaultModifiedAt()
+		appc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := appc.mutation.PresentWeight(); !ok {
+		v := attendancepaypolicy.DefaultPresentWeight
+		appc.mutation.SetPresentWeight(v)
+	}
+	if _, ok := appc.mutation.LateWeight(); !ok {
+		v := attendancepaypolicy.DefaultLateWeight
+		appc.mutation.SetLateWeight(v)
+	}
+	if _, ok := appc.mutation.HalfDayWeight(); !ok {
+		v := attendancepaypolicy.DefaultHalfDayWeight
+		appc.mutation.SetHalfDayWeight(v)
+	}
+	if _, ok := appc.mutation.LatePenaltyAmount(); !ok {
+		v := attendancepaypolicy.DefaultLatePenaltyAmount
+		appc.mutation.SetLatePenaltyAmount(v)
+	}
+	if _, ok := appc.mutation.LatePenaltyFreeDays(); !ok {
+		v := attendancepaypolicy.DefaultLatePenaltyFreeDays
+		appc.mutation.SetLatePenaltyFreeDays(v)
+	}
+	if _, ok := appc.mutation.IsDefault(); !ok {
+		v := attendancepaypolicy.DefaultIsDefault
+		appc.mutation.SetIsDefault(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (appc *AttendancePayPolicyCreate) check() error {
+	if _, ok := appc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendancePayPolicy.created_at"`)}
+	}
+	if _, ok := appc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "AttendancePayPolicy.modified_at"`)}
+	}
+	if _, ok := appc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AttendancePayPolicy.name"`)}
+	}
+	if v, ok := appc.mutation.Name(); ok {
+		if err := attendancepaypolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := appc.mutation.PresentWeight(); !ok {
+		return &ValidationError{Name: "present_weight", err: errors.New(`ent: missing required field "AttendancePayPolicy.present_weight"`)}
+	}
+	if _, ok := appc.mutation.LateWeight(); !ok {
+		return &ValidationError{Name: "late_weight", err: errors.New(`ent: missing required field "AttendancePayPolicy.late_weight"`)}
+	}
+	if _, ok := appc.mutation.HalfDayWeight(); !ok {
+		return &ValidationError{Name: "half_day_weight", err: errors.New(`ent: missing required field "AttendancePayPolicy.half_day_weight"`)}
+	}
+	if _, ok := appc.mutation.LatePenaltyAmount(); !ok {
+		return &ValidationError{Name: "late_penalty_amount", err: errors.New(`ent: missing required field "AttendancePayPolicy.late_penalty_amount"`)}
+	}
+	if _, ok := appc.mutation.LatePenaltyFreeDays(); !ok {
+		return &ValidationError{Name: "late_penalty_free_days", err: errors.New(`ent: missing required field "AttendancePayPolicy.late_penalty_free_days"`)}
+	}
+	if v, ok := appc.mutation.LatePenaltyFreeDays(); ok {
+		if err := attendancepaypolicy.LatePenaltyFreeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_free_days", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.late_penalty_free_days": %w`, err)}
+		}
+	}
+	if _, ok := appc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "AttendancePayPolicy.is_default"`)}
+	}
+	return nil
+}
+
+func (appc *AttendancePayPolicyCreate) sqlSave(ctx context.Context) (*AttendancePayPolicy, error) {
+	if err := appc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := appc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, appc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	appc.mutation.id = &_node.ID
+	appc.mutation.done = true
+	return _node, nil
+}
+
+func (appc *AttendancePayPolicyCreate) createSpec() (*AttendancePayPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendancePayPolicy{config: appc.config}
+		_spec = sqlgraph.NewCreateSpec(attendancepaypolicy.Table, sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64))
+	)
+	if id, ok := appc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := appc.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := appc.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := appc.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := appc.mutation.Name(); ok {
+		_spec.SetField(attendancepaypolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := appc.mutation.PresentWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldPresentWeight, field.TypeOther, value)
+		_node.PresentWeight = value
+	}
+	if value, ok := appc.mutation.LateWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLateWeight, field.TypeOther, value)
+		_node.LateWeight = value
+	}
+	if value, ok := appc.mutation.HalfDayWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldHalfDayWeight, field.TypeOther, value)
+		_node.HalfDayWeight = value
+	}
+	if value, ok := appc.mutation.LatePenaltyAmount(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyAmount, field.TypeOther, value)
+		_node.LatePenaltyAmount = value
+	}
+	if value, ok := appc.mutation.LatePenaltyFreeDays(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyFreeDays, field.TypeInt, value)
+		_node.LatePenaltyFreeDays = value
+	}
+	if value, ok := appc.mutation.IsDefault(); ok {
+		_spec.SetField(attendancepaypolicy.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if nodes := appc.mutation.WorkSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttendancePayPolicyCreateBulk is the builder for creating many AttendancePayPolicy entities in bulk.
+type AttendancePayPolicyCreateBulk struct {
+	config
+	builders []*AttendancePayPolicyCreate
+}
+
+// Save creates the AttendancePayPolicy entities in the database.
+func (appcb *AttendancePayPolicyCreateBulk) Save(ctx context.Context) ([]*AttendancePayPolicy, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(appcb.builders))
+	nodes := make([]*AttendancePayPolicy, len(appcb.builders))
+	mutators := make([]Mutator, len(appcb.builders))
+	for i := range appcb.builders {
+		func(i int, root context.Context) {
+			builder := appcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendancePayPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, appcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, appcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, appcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (appcb *AttendancePayPolicyCreateBulk) SaveX(ctx context.Context) []*AttendancePayPolicy {
+	v, err := appcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (appcb *AttendancePayPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := appcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appcb *AttendancePayPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := appcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
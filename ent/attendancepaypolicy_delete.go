@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendancePayPolicyDelete is the builder for deleting a AttendancePayPolicy entity.
+type AttendancePayPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *AttendancePayPolicyMutation
+}
+
+// Where appends a list predicates to the AttendancePayPolicyDelete builder.
+func (appd *AttendancePayPolicyDelete) Where(ps ...predicate.AttendancePayPolicy) *AttendancePayPolicyDelete {
+	appd.mutation.Where(ps...)
+	return appd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (appd *AttendancePayPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, appd.sqlExec, appd.mutation, appd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appd *AttendancePayPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := appd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (appd *AttendancePayPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(attendancepaypolicy.Table, sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64))
+	if ps := appd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, appd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	appd.mutation.done = true
+	return affected, err
+}
+
+// AttendancePayPolicyDeleteOne is the builder for deleting a single AttendancePayPolicy entity.
+type AttendancePayPolicyDeleteOne struct {
+	appd *AttendancePayPolicyDelete
+}
+
+// Where appends a list predicates to the AttendancePayPolicyDelete builder.
+func (appdo *AttendancePayPolicyDeleteOne) Where(ps ...predicate.AttendancePayPolicy) *AttendancePayPolicyDeleteOne {
+	appdo.appd.mutation.Where(ps...)
+	return appdo
+}
+
+// Exec executes the deletion query.
+func (appdo *AttendancePayPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := appdo.appd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{attendancepaypolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appdo *AttendancePayPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := appdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/predicate"
+	"mceasy/ent/workschedule"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendancePayPolicyQuery is the builder for querying AttendancePayPolicy entities.
+type AttendancePayPolicyQuery struct {
+	config
+	ctx               *QueryContext
+	order             []attendancepaypolicy.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.AttendancePayPolicy
+	withWorkSchedules *WorkScheduleQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendancePayPolicyQuery builder.
+func (appq *AttendancePayPolicyQuery) Where(ps ...predicate.AttendancePayPolicy) *AttendancePayPolicyQuery {
+	appq.predicates = append(appq.predicates, ps...)
+	return appq
+}
+
+// Limit the number of records to be returned by this query.
+func (appq *AttendancePayPolicyQuery) Limit(limit int) *AttendancePayPolicyQuery {
+	appq.ctx.Limit = &limit
+	return appq
+}
+
+// Offset to start from.
+func (appq *AttendancePayPolicyQuery) Offset(offset int) *AttendancePayPolicyQuery {
+	appq.ctx.Offset = &offset
+	return appq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (appq *AttendancePayPolicyQuery) Unique(unique bool) *AttendancePayPolicyQuery {
+	appq.ctx.Unique = &unique
+	return appq
+}
+
+// Order specifies how the records should be ordered.
+func (appq *AttendancePayPolicyQuery) Order(o ...attendancepaypolicy.OrderOption) *AttendancePayPolicyQuery {
+	appq.order = append(appq.order, o...)
+	return appq
+}
+
+// QueryWorkSchedules chains the current query on the "work_schedules" edge.
+func (appq *AttendancePayPolicyQuery) QueryWorkSchedules() *WorkScheduleQuery {
+	query := (&WorkScheduleClient{config: appq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := appq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := appq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepaypolicy.Table, attendancepaypolicy.FieldID, selector),
+			sqlgraph.To(workschedule.Table, workschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendancepaypolicy.WorkSchedulesTable, attendancepaypolicy.WorkSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(appq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendancePayPolicy entity from the query.
+// Returns a *NotFoundError when no AttendancePayPolicy was found.
+func (appq *AttendancePayPolicyQuery) First(ctx context.Context) (*AttendancePayPolicy, error) {
+	nodes, err := appq.Limit(1).All(setContextOp(ctx, appq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendancepaypolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) FirstX(ctx context.Context) *AttendancePayPolicy {
+	node, err := appq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendancePayPolicy ID from the query.
+// Returns a *NotFoundError when no AttendancePayPolicy ID was found.
+func (appq *AttendancePayPolicyQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = appq.Limit(1).IDs(setContextOp(ctx, appq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendancepaypolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := appq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendancePayPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendancePayPolicy entity is found.
+// Returns a *NotFoundError when no AttendancePayPolicy entities are found.
+func (appq *AttendancePayPolicyQuery) Only(ctx context.Context) (*AttendancePayPolicy, error) {
+	nodes, err := appq.Limit(2).All(setContextOp(ctx, appq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendancepaypolicy.Label}
+	default:
+		return nil, &NotSingularError{attendancepaypolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) OnlyX(ctx context.Context) *AttendancePayPolicy {
+	node, err := appq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendancePayPolicy ID in the query.
+// Returns a *NotSingularError when more than one AttendancePayPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (appq *AttendancePayPolicyQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = appq.Limit(2).IDs(setContextOp(ctx, appq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendancepaypolicy.Label}
+	default:
+		err = &NotSingularError{attendancepaypolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := appq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendancePayPolicies.
+func (appq *AttendancePayPolicyQuery) All(ctx context.Context) ([]*AttendancePayPolicy, error) {
+	ctx = setContextOp(ctx, appq.ctx, "All")
+	if err := appq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendancePayPolicy, *AttendancePayPolicyQuery]()
+	return withInterceptors[[]*AttendancePayPolicy](ctx, appq, qr, appq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) AllX(ctx context.Context) []*AttendancePayPolicy {
+	nodes, err := appq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendancePayPolicy IDs.
+func (appq *AttendancePayPolicyQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if appq.ctx.Unique == nil && appq.path != nil {
+		appq.Unique(true)
+	}
+	ctx = setContextOp(ctx, appq.ctx, "IDs")
+	if err = appq.Select(attendancepaypolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := appq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (appq *AttendancePayPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, appq.ctx, "Count")
+	if err := appq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, appq, querierCount[*AttendancePayPolicyQuery](), appq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) CountX(ctx context.Context) int {
+	count, err := appq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (appq *AttendancePayPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, appq.ctx, "Exist")
+	switch _, err := appq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (appq *AttendancePayPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := appq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendancePayPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (appq *AttendancePayPolicyQuery) Clone() *AttendancePayPolicyQuery {
+	if appq == nil {
+		return nil
+	}
+	return &AttendancePayPolicyQuery{
+		config:            appq.config,
+		ctx:               appq.ctx.Clone(),
+		order:             append([]attendancepaypolicy.OrderOption{}, appq.order...),
+		inters:            append([]Interceptor{}, appq.inters...),
+		predicates:        append([]predicate.AttendancePayPolicy{}, appq.predicates...),
+		withWorkSchedules: appq.withWorkSchedules.Clone(),
+		// clone intermediate query.
+		sql:  appq.sql.Clone(),
+		path: appq.path,
+	}
+}
+
+// WithWorkSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "work_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (appq *AttendancePayPolicyQuery) WithWorkSchedules(opts ...func(*WorkScheduleQuery)) *AttendancePayPolicyQuery {
+	query := (&WorkScheduleClient{config: appq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	appq.withWorkSchedules = query
+	return appq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendancePayPolicy.Query().
+//		GroupBy(attendancepaypolicy.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (appq *AttendancePayPolicyQuery) GroupBy(field string, fields ...string) *AttendancePayPolicyGroupBy {
+	appq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendancePayPolicyGroupBy{build: appq}
+	grbuild.flds = &appq.ctx.Fields
+	grbuild.label = attendancepaypolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.AttendancePayPolicy.Query().
+//		Select(attendancepaypolicy.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (appq *AttendancePayPolicyQuery) Select(fields ...string) *AttendancePayPolicySelect {
+	appq.ctx.Fields = append(appq.ctx.Fields, fields...)
+	sbuild := &AttendancePayPolicySelect{AttendancePayPolicyQuery: appq}
+	sbuild.label = attendancepaypolicy.Label
+	sbuild.flds, sbuild.scan = &appq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendancePayPolicySelect configured with the given aggregations.
+func (appq *AttendancePayPolicyQuery) Aggregate(fns ...AggregateFunc) *AttendancePayPolicySelect {
+	return appq.Select().Aggregate(fns...)
+}
+
+func (appq *AttendancePayPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range appq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, appq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range appq.ctx.Fields {
+		if !attendancepaypolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if appq.path != nil {
+		prev, err := appq.path(ctx)
+		if err != nil {
+			return err
+		}
+		appq.sql = prev
+	}
+	return nil
+}
+
+func (appq *AttendancePayPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendancePayPolicy, error) {
+	var (
+		nodes       = []*AttendancePayPolicy{}
+		_spec       = appq.querySpec()
+		loadedTypes = [1]bool{
+			appq.withWorkSchedules != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendancePayPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendancePayPolicy{config: appq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(appq.modifiers) > 0 {
+		_spec.Modifiers = appq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, appq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := appq.withWorkSchedules; query != nil {
+		if err := appq.loadWorkSchedules(ctx, query, nodes,
+			func(n *AttendancePayPolicy) { n.Edges.WorkSchedules = []*WorkSchedule{} },
+			func(n *AttendancePayPolicy, e *WorkSchedule) {
+				n.Edges.WorkSchedules = append(n.Edges.WorkSchedules, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (appq *AttendancePayPolicyQuery) loadWorkSchedules(ctx context.Context, query *WorkScheduleQuery, nodes []*AttendancePayPolicy, init func(*AttendancePayPolicy), assign func(*AttendancePayPolicy, *WorkSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*AttendancePayPolicy)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workschedule.FieldAttendancePayPolicyID)
+	}
+	query.Where(predicate.WorkSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendancepaypolicy.WorkSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendancePayPolicyID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attendance_pay_policy_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_pay_policy_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (appq *AttendancePayPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := appq.querySpec()
+	if len(appq.modifiers) > 0 {
+		_spec.Modifiers = appq.modifiers
+	}
+	_spec.Node.Columns = appq.ctx.Fields
+	if len(appq.ctx.Fields) > 0 {
+		_spec.Unique = appq.ctx.Unique != nil && *appq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, appq.driver, _spec)
+}
+
+func (appq *AttendancePayPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendancepaypolicy.Table, attendancepaypolicy.Columns, sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64))
+	_spec.From = appq.sql
+	if unique := appq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if appq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := appq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancepaypolicy.FieldID)
+		for i := range fields {
+			if fields[i] != attendancepaypolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := appq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := appq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := appq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := appq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (appq *AttendancePayPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(appq.driver.Dialect())
+	t1 := builder.Table(attendancepaypolicy.Table)
+	columns := appq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendancepaypolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if appq.sql != nil {
+		selector = appq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if appq.ctx.Unique != nil && *appq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range appq.modifiers {
+		m(selector)
+	}
+	for _, p := range appq.predicates {
+		p(selector)
+	}
+	for _, p := range appq.order {
+		p(selector)
+	}
+	if offset := appq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := appq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (appq *AttendancePayPolicyQuery) Modify(modifiers ...func(s *sql.Selector)) *AttendancePayPolicySelect {
+	appq.modifiers = append(appq.modifiers, modifiers...)
+	return appq.Select()
+}
+
+// AttendancePayPolicyGroupBy is the group-by builder for AttendancePayPolicy entities.
+type AttendancePayPolicyGroupBy struct {
+	selector
+	build *AttendancePayPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (appgb *AttendancePayPolicyGroupBy) Aggregate(fns ...AggregateFunc) *AttendancePayPolicyGroupBy {
+	appgb.fns = append(appgb.fns, fns...)
+	return appgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (appgb *AttendancePayPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, appgb.build.ctx, "GroupBy")
+	if err := appgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendancePayPolicyQuery, *AttendancePayPolicyGroupBy](ctx, appgb.build, appgb, appgb.build.inters, v)
+}
+
+func (appgb *AttendancePayPolicyGroupBy) sqlScan(ctx context.Context, root *AttendancePayPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(appgb.fns))
+	for _, fn := range appgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*appgb.flds)+len(appgb.fns))
+		for _, f := range *appgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*appgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := appgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendancePayPolicySelect is the builder for selecting fields of AttendancePayPolicy entities.
+type AttendancePayPolicySelect struct {
+	*AttendancePayPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (apps *AttendancePayPolicySelect) Aggregate(fns ...AggregateFunc) *AttendancePayPolicySelect {
+	apps.fns = append(apps.fns, fns...)
+	return apps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (apps *AttendancePayPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, apps.ctx, "Select")
+	if err := apps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendancePayPolicyQuery, *AttendancePayPolicySelect](ctx, apps.AttendancePayPolicyQuery, apps, apps.inters, v)
+}
+
+func (apps *AttendancePayPolicySelect) sqlScan(ctx context.Context, root *AttendancePayPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(apps.fns))
+	for _, fn := range apps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*apps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := apps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (apps *AttendancePayPolicySelect) Modify(modifiers ...func(s *sql.Selector)) *AttendancePayPolicySelect {
+	apps.modifiers = append(apps.modifiers, modifiers...)
+	return apps
+}
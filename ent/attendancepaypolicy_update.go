@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/predicate"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// AttendancePayPolicyUpdate is the builder for updating AttendancePayPolicy entities.
+type AttendancePayPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttendancePayPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttendancePayPolicyUpdate builder.
+func (appu *AttendancePayPolicyUpdate) Where(ps ...predicate.AttendancePayPolicy) *AttendancePayPolicyUpdate {
+	appu.mutation.Where(ps...)
+	return appu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (appu *AttendancePayPolicyUpdate) SetModifiedAt(t time.Time) *AttendancePayPolicyUpdate {
+	appu.mutation.SetModifiedAt(t)
+	return appu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (appu *AttendancePayPolicyUpdate) SetDeletedAt(t time.Time) *AttendancePayPolicyUpdate {
+	appu.mutation.SetDeletedAt(t)
+	return appu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableDeletedAt(t *time.Time) *AttendancePayPolicyUpdate {
+	if t != nil {
+		appu.SetDeletedAt(*t)
+	}
+	return appu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (appu *AttendancePayPolicyUpdate) ClearDeletedAt() *AttendancePayPolicyUpdate {
+	appu.mutation.ClearDeletedAt()
+	return appu
+}
+
+// SetName sets the "name" field.
+func (appu *AttendancePayPolicyUpdate) SetName(s string) *AttendancePayPolicyUpdate {
+	appu.mutation.SetName(s)
+	return appu
+}
+
+// SetPresentWeight sets the "present_weight" field.
+func (appu *AttendancePayPolicyUpdate) SetPresentWeight(d decimal.Decimal) *AttendancePayPolicyUpdate {
+	appu.mutation.SetPresentWeight(d)
+	return appu
+}
+
+// SetNillablePresentWeight sets the "present_weight" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillablePresentWeight(d *decimal.Decimal) *AttendancePayPolicyUpdate {
+	if d != nil {
+		appu.SetPresentWeight(*d)
+	}
+	return appu
+}
+
+// SetLateWeight sets the "late_weight" field.
+func (appu *AttendancePayPolicyUpdate) SetLateWeight(d decimal.Decimal) *AttendancePayPolicyUpdate {
+	appu.mutation.SetLateWeight(d)
+	return appu
+}
+
+// SetNillableLateWeight sets the "late_weight" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableLateWeight(d *decimal.Decimal) *AttendancePayPolicyUpdate {
+	if d != nil {
+		appu.SetLateWeight(*d)
+	}
+	return appu
+}
+
+// SetHalfDayWeight sets the "half_day_weight" field.
+func (appu *AttendancePayPolicyUpdate) SetHalfDayWeight(d decimal.Decimal) *AttendancePayPolicyUpdate {
+	appu.mutation.SetHalfDayWeight(d)
+	return appu
+}
+
+// SetNillableHalfDayWeight sets the "half_day_weight" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableHalfDayWeight(d *decimal.Decimal) *AttendancePayPolicyUpdate {
+	if d != nil {
+		appu.SetHalfDayWeight(*d)
+	}
+	return appu
+}
+
+// SetLatePenaltyAmount sets the "late_penalty_amount" field.
+func (appu *AttendancePayPolicyUpdate) SetLatePenaltyAmount(d decimal.Decimal) *AttendancePayPolicyUpdate {
+	appu.mutation.SetLatePenaltyAmount(d)
+	return appu
+}
+
+// SetNillableLatePenaltyAmount sets the "late_penalty_amount" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableLatePenaltyAmount(d *decimal.Decimal) *AttendancePayPolicyUpdate {
+	if d != nil {
+		appu.SetLatePenaltyAmount(*d)
+	}
+	return appu
+}
+
+// SetLatePenaltyFreeDays sets the "late_penalty_free_days" field.
+func (appu *AttendancePayPolicyUpdate) SetLatePenaltyFreeDays(i int) *AttendancePayPolicyUpdate {
+	appu.mutation.ResetLatePenaltyFreeDays()
+	appu.mutation.SetLatePenaltyFreeDays(i)
+	return appu
+}
+
+// SetNillableLatePenaltyFreeDays sets the "late_penalty_free_days" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableLatePenaltyFreeDays(i *int) *AttendancePayPolicyUpdate {
+	if i != nil {
+		appu.SetLatePenaltyFreeDays(*i)
+	}
+	return appu
+}
+
+// AddLatePenaltyFreeDays adds i to the "late_penalty_free_days" field.
+func (appu *AttendancePayPolicyUpdate) AddLatePenaltyFreeDays(i int) *AttendancePayPolicyUpdate {
+	appu.mutation.AddLatePenaltyFreeDays(i)
+	return appu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (appu *AttendancePayPolicyUpdate) SetIsDefault(b bool) *AttendancePayPolicyUpdate {
+	appu.mutation.SetIsDefault(b)
+	return appu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (appu *AttendancePayPolicyUpdate) SetNillableIsDefault(b *bool) *AttendancePayPolicyUpdate {
+	if b != nil {
+		appu.SetIsDefault(*b)
+	}
+	return appu
+}
+
+// AddWorkScheduleIDs adds the "work_schedules" edge to the WorkSchedule entity by IDs.
+func (appu *AttendancePayPolicyUpdate) AddWorkScheduleIDs(ids ...uint64) *AttendancePayPolicyUpdate {
+	appu.mutation.AddWorkScheduleIDs(ids...)
+	return appu
+}
+
+// AddWorkSchedules adds the "work_schedules" edges to the WorkSchedule entity.
+func (appu *AttendancePayPolicyUpdate) AddWorkSchedules(w ...*WorkSchedule) *AttendancePayPolicyUpdate {
+	ids := make([]uint64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return appu.AddWorkScheduleIDs(ids...)
+}
+
+// Mutation returns the AttendancePayPolicyMutation object of the builder.
+func (appu *AttendancePayPolicyUpdate) Mutation() *AttendancePayPolicyMutation {
+	return appu.mutation
+}
+
+// ClearWorkSchedules clears all "work_schedules" edges to the WorkSchedule entity.
+func (appu *AttendancePayPolicyUpdate) ClearWorkSchedules() *AttendancePayPolicyUpdate {
+	appu.mutation.ClearWorkSchedules()
+	return appu
+}
+
+// RemoveWorkScheduleIDs removes the "work_schedules" edge to WorkSchedule entities by IDs.
+func (appu *AttendancePayPolicyUpdate) RemoveWorkScheduleIDs(ids ...uint64) *AttendancePayPolicyUpdate {
+	appu.mutation.RemoveWorkScheduleIDs(ids...)
+	return appu
+}
+
+// RemoveWorkSchedules removes "work_schedules" edges to WorkSchedule entities.
+func (appu *AttendancePayPolicyUpdate) RemoveWorkSchedules(w ...*WorkSchedule) *AttendancePayPolicyUpdate {
+	ids := make([]uint64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return appu.RemoveWorkScheduleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (appu *AttendancePayPolicyUpdate) Save(ctx context.Context) (int, error) {
+	appu.defaults()
+	return withHooks(ctx, appu.sqlSave, appu.mutation, appu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (appu *AttendancePayPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := appu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (appu *AttendancePayPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := appu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appu *AttendancePayPolicyUpdate) ExecX(ctx context.Context) {
+	if err := appu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (appu *AttendancePayPolicyUpdate) defaults() {
+	if _, ok := appu.mutation.ModifiedAt(); !ok {
+		v := attendancepaypolicy.UpdateDefaultModifiedAt()
+		appu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (appu *AttendancePayPolicyUpdate) check() error {
+	if v, ok := appu.mutation.Name(); ok {
+		if err := attendancepaypolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := appu.mutation.LatePenaltyFreeDays(); ok {
+		if err := attendancepaypolicy.LatePenaltyFreeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_free_days", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.late_penalty_free_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (appu *AttendancePayPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendancePayPolicyUpdate {
+	appu.modifiers = append(appu.modifiers, modifiers...)
+	return appu
+}
+
+func (appu *AttendancePayPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := appu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancepaypolicy.Table, attendancepaypolicy.Columns, sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64))
+	if ps := appu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := appu.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := appu.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if appu.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancepaypolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := appu.mutation.Name(); ok {
+		_spec.SetField(attendancepaypolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := appu.mutation.PresentWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldPresentWeight, field.TypeOther, value)
+	}
+	if value, ok := appu.mutation.LateWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLateWeight, field.TypeOther, value)
+	}
+	if value, ok := appu.mutation.HalfDayWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldHalfDayWeight, field.TypeOther, value)
+	}
+	if value, ok := appu.mutation.LatePenaltyAmount(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyAmount, field.TypeOther, value)
+	}
+	if value, ok := appu.mutation.LatePenaltyFreeDays(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyFreeDays, field.TypeInt, value)
+	}
+	if value, ok := appu.mutation.AddedLatePenaltyFreeDays(); ok {
+		_spec.AddField(attendancepaypolicy.FieldLatePenaltyFreeDays, field.TypeInt, value)
+	}
+	if value, ok := appu.mutation.IsDefault(); ok {
+		_spec.SetField(attendancepaypolicy.FieldIsDefault, field.TypeBool, value)
+	}
+	if appu.mutation.WorkSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := appu.mutation.RemovedWorkSchedulesIDs(); len(nodes) > 0 && !appu.mutation.WorkSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := appu.mutation.WorkSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(appu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, appu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancepaypolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	appu.mutation.done = true
+	return n, nil
+}
+
+// AttendancePayPolicyUpdateOne is the builder for updating a single AttendancePayPolicy entity.
+type AttendancePayPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttendancePayPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetModifiedAt(t time.Time) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetModifiedAt(t)
+	return appuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetDeletedAt(t time.Time) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetDeletedAt(t)
+	return appuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendancePayPolicyUpdateOne {
+	if t != nil {
+		appuo.SetDeletedAt(*t)
+	}
+	return appuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (appuo *AttendancePayPolicyUpdateOne) ClearDeletedAt() *AttendancePayPolicyUpdateOne {
+	appuo.mutation.ClearDeletedAt()
+	return appuo
+}
+
+// SetName sets the "name" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetName(s string) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetName(s)
+	return appuo
+}
+
+// SetPresentWeight sets the "present_weight" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetPresentWeight(d decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetPresentWeight(d)
+	return appuo
+}
+
+// SetNillablePresentWeight sets the "present_weight" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillablePresentWeight(d *decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	if d != nil {
+		appuo.SetPresentWeight(*d)
+	}
+	return appuo
+}
+
+// SetLateWeight sets the "late_weight" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetLateWeight(d decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetLateWeight(d)
+	return appuo
+}
+
+// SetNillableLateWeight sets the "late_weight" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableLateWeight(d *decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	if d != nil {
+		appuo.SetLateWeight(*d)
+	}
+	return appuo
+}
+
+// SetHalfDayWeight sets the "half_day_weight" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetHalfDayWeight(d decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetHalfDayWeight(d)
+	return appuo
+}
+
+// SetNillableHalfDayWeight sets the "half_day_weight" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableHalfDayWeight(d *decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	if d != nil {
+		appuo.SetHalfDayWeight(*d)
+	}
+	return appuo
+}
+
+// SetLatePenaltyAmount sets the "late_penalty_amount" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetLatePenaltyAmount(d decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetLatePenaltyAmount(d)
+	return appuo
+}
+
+// SetNillableLatePenaltyAmount sets the "late_penalty_amount" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableLatePenaltyAmount(d *decimal.Decimal) *AttendancePayPolicyUpdateOne {
+	if d != nil {
+		appuo.SetLatePenaltyAmount(*d)
+	}
+	return appuo
+}
+
+// SetLatePenaltyFreeDays sets the "late_penalty_free_days" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetLatePenaltyFreeDays(i int) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.ResetLatePenaltyFreeDays()
+	appuo.mutation.SetLatePenaltyFreeDays(i)
+	return appuo
+}
+
+// SetNillableLatePenaltyFreeDays sets the "late_penalty_free_days" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableLatePenaltyFreeDays(i *int) *AttendancePayPolicyUpdateOne {
+	if i != nil {
+		appuo.SetLatePenaltyFreeDays(*i)
+	}
+	return appuo
+}
+
+// AddLatePenaltyFreeDays adds i to the "late_penalty_free_days" field.
+func (appuo *AttendancePayPolicyUpdateOne) AddLatePenaltyFreeDays(i int) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.AddLatePenaltyFreeDays(i)
+	return appuo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (appuo *AttendancePayPolicyUpdateOne) SetIsDefault(b bool) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.SetIsDefault(b)
+	return appuo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (appuo *AttendancePayPolicyUpdateOne) SetNillableIsDefault(b *bool) *AttendancePayPolicyUpdateOne {
+	if b != nil {
+		appuo.SetIsDefault(*b)
+	}
+	return appuo
+}
+
+// AddWorkScheduleIDs adds the "work_schedules" edge to the WorkSchedule entity by IDs.
+func (appuo *AttendancePayPolicyUpdateOne) AddWorkScheduleIDs(ids ...uint64) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.AddWorkScheduleIDs(ids...)
+	return appuo
+}
+
+// AddWorkSchedules adds the "work_schedules" edges to the WorkSchedule entity.
+func (appuo *AttendancePayPolicyUpdateOne) AddWorkSchedules(w ...*WorkSchedule) *AttendancePayPolicyUpdateOne {
+	ids := make([]uint64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return appuo.AddWorkScheduleIDs(ids...)
+}
+
+// Mutation returns the AttendancePayPolicyMutation object of the builder.
+func (appuo *AttendancePayPolicyUpdateOne) Mutation() *AttendancePayPolicyMutation {
+	return appuo.mutation
+}
+
+// ClearWorkSchedules clears all "work_schedules" edges to the WorkSchedule entity.
+func (appuo *AttendancePayPolicyUpdateOne) ClearWorkSchedules() *AttendancePayPolicyUpdateOne {
+	appuo.mutation.ClearWorkSchedules()
+	return appuo
+}
+
+// RemoveWorkScheduleIDs removes the "work_schedules" edge to WorkSchedule entities by IDs.
+func (appuo *AttendancePayPolicyUpdateOne) RemoveWorkScheduleIDs(ids ...uint64) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.RemoveWorkScheduleIDs(ids...)
+	return appuo
+}
+
+// RemoveWorkSchedules removes "work_schedules" edges to WorkSchedule entities.
+func (appuo *AttendancePayPolicyUpdateOne) RemoveWorkSchedules(w ...*WorkSchedule) *AttendancePayPolicyUpdateOne {
+	ids := make([]uint64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return appuo.RemoveWorkScheduleIDs(ids...)
+}
+
+// Where appends a list predicates to the AttendancePayPolicyUpdate builder.
+func (appuo *AttendancePayPolicyUpdateOne) Where(ps ...predicate.AttendancePayPolicy) *AttendancePayPolicyUpdateOne {
+	appuo.mutation.Where(ps...)
+	return appuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (appuo *AttendancePayPolicyUpdateOne) Select(field string, fields ...string) *AttendancePayPolicyUpdateOne {
+	appuo.fields = append([]string{field}, fields...)
+	return appuo
+}
+
+// Save executes the query and returns the updated AttendancePayPolicy entity.
+func (appuo *AttendancePayPolicyUpdateOne) Save(ctx context.Context) (*AttendancePayPolicy, error) {
+	appuo.defaults()
+	return withHooks(ctx, appuo.sqlSave, appuo.mutation, appuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (appuo *AttendancePayPolicyUpdateOne) SaveX(ctx context.Context) *AttendancePayPolicy {
+	node, err := appuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (appuo *AttendancePayPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := appuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (appuo *AttendancePayPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := appuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (appuo *AttendancePayPolicyUpdateOne) defaults() {
+	if _, ok := appuo.mutation.ModifiedAt(); !ok {
+		v := attendancepaypolicy.UpdateDefaultModifiedAt()
+		appuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (appuo *AttendancePayPolicyUpdateOne) check() error {
+	if v, ok := appuo.mutation.Name(); ok {
+		if err := attendancepaypolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := appuo.mutation.LatePenaltyFreeDays(); ok {
+		if err := attendancepaypolicy.LatePenaltyFreeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_free_days", err: fmt.Errorf(`ent: validator failed for field "AttendancePayPolicy.late_penalty_free_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (appuo *AttendancePayPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendancePayPolicyUpdateOne {
+	appuo.modifiers = append(appuo.modifiers, modifiers...)
+	return appuo
+}
+
+func (appuo *AttendancePayPolicyUpdateOne) sqlSave(ctx context.Context) (_node *AttendancePayPolicy, err error) {
+	if err := appuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancepaypolicy.Table, attendancepaypolicy.Columns, sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64))
+	id, ok := appuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendancePayPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := appuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancepaypolicy.FieldID)
+		for _, f := range fields {
+			if !attendancepaypolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendancepaypolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := appuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := appuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := appuo.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepaypolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if appuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancepaypolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := appuo.mutation.Name(); ok {
+		_spec.SetField(attendancepaypolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := appuo.mutation.PresentWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldPresentWeight, field.TypeOther, value)
+	}
+	if value, ok := appuo.mutation.LateWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLateWeight, field.TypeOther, value)
+	}
+	if value, ok := appuo.mutation.HalfDayWeight(); ok {
+		_spec.SetField(attendancepaypolicy.FieldHalfDayWeight, field.TypeOther, value)
+	}
+	if value, ok := appuo.mutation.LatePenaltyAmount(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyAmount, field.TypeOther, value)
+	}
+	if value, ok := appuo.mutation.LatePenaltyFreeDays(); ok {
+		_spec.SetField(attendancepaypolicy.FieldLatePenaltyFreeDays, field.TypeInt, value)
+	}
+	if value, ok := appuo.mutation.AddedLatePenaltyFreeDays(); ok {
+		_spec.AddField(attendancepaypolicy.FieldLatePenaltyFreeDays, field.TypeInt, value)
+	}
+	if value, ok := appuo.mutation.IsDefault(); ok {
+		_spec.SetField(attendancepaypolicy.FieldIsDefault, field.TypeBool, value)
+	}
+	if appuo.mutation.WorkSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := appuo.mutation.RemovedWorkSchedulesIDs(); len(nodes) > 0 && !appuo.mutation.WorkSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := appuo.mutation.WorkSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancepaypolicy.WorkSchedulesTable,
+			Columns: []string{attendancepaypolicy.WorkSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(appuo.modifiers...)
+	_node = &AttendancePayPolicy{config: appuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, appuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancepaypolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	appuo.mutation.done = true
+	return _node, nil
+}
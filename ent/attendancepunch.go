@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttendancePunch is the model entity for the AttendancePunch schema.
+type AttendancePunch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Daily attendance the punch belongs to
+	AttendanceID uint64 `json:"attendance_id,omitempty"`
+	// PunchType holds the value of the "punch_type" field.
+	PunchType attendancepunch.PunchType `json:"punch_type,omitempty"`
+	// PunchTime holds the value of the "punch_time" field.
+	PunchTime time.Time `json:"punch_time,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Distance between the punch and the assigned office
+	DistanceMeters *float64 `json:"distance_meters,omitempty"`
+	// Kiosk whose rotating code was scanned for the punch
+	KioskID *uint64 `json:"kiosk_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendancePunchQuery when eager-loading is set.
+	Edges        AttendancePunchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendancePunchEdges holds the relations/edges for other nodes in the graph.
+type AttendancePunchEdges struct {
+	// Attendance holds the value of the attendance edge.
+	Attendance *Attendance `json:"attendance,omitempty"`
+	// Kiosk holds the value of the kiosk edge.
+	Kiosk *Kiosk `json:"kiosk,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttendanceOrErr returns the Attendance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendancePunchEdges) AttendanceOrErr() (*Attendance, error) {
+	if e.loadedTypes[0] {
+		if e.Attendance == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: attendance.Label}
+		}
+		return e.Attendance, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance"}
+}
+
+// KioskOrErr returns the Kiosk value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendancePunchEdges) KioskOrErr() (*Kiosk, error) {
+	if e.loadedTypes[1] {
+		if e.Kiosk == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: kiosk.Label}
+		}
+		return e.Kiosk, nil
+	}
+	return nil, &NotLoadedError{edge: "kiosk"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendancePunch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendancepunch.FieldLatitude, attendancepunch.FieldLongitude, attendancepunch.FieldDistanceMeters:
+			values[i] = new(sql.NullFloat64)
+		case attendancepunch.FieldID, attendancepunch.FieldAttendanceID, attendancepunch.FieldKioskID:
+			values[i] = new(sql.NullInt64)
+		case attendancepunch.FieldPunchType:
+			values[i] = new(sql.NullString)
+		case attendancepunch.FieldCreatedAt, attendancepunch.FieldModifiedAt, attendancepunch.FieldDeletedAt, attendancepunch.FieldPunchTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendancePunch fields.
+func (ap *AttendancePunch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendancepunch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ap.ID = uint64(value.Int64)
+		case attendancepunch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ap.CreatedAt = value.Time
+			}
+		case attendancepunch.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				ap.ModifiedAt = value.Time
+			}
+		case attendancepunch.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ap.DeletedAt = value.Time
+			}
+		case attendancepunch.FieldAttendanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_id", values[i])
+			} else if value.Valid {
+				ap.AttendanceID = uint64(value.Int64)
+			}
+		case attendancepunch.FieldPunchType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field punch_type", values[i])
+			} else if value.Valid {
+				ap.PunchType = attendancepunch.PunchType(value.String)
+			}
+		case attendancepunch.FieldPunchTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field punch_time", values[i])
+			} else if value.Valid {
+				ap.PunchTime = value.Time
+			}
+		case attendancepunch.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				ap.Latitude = new(float64)
+				*ap.Latitude = value.Float64
+			}
+		case attendancepunch.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				ap.Longitude = new(float64)
+				*ap.Longitude = value.Float64
+			}
+		case attendancepunch.FieldDistanceMeters:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field distance_meters", values[i])
+			} else if value.Valid {
+				ap.DistanceMeters = new(float64)
+				*ap.DistanceMeters = value.Float64
+			}
+		case attendancepunch.FieldKioskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kiosk_id", values[i])
+			} else if value.Valid {
+				ap.KioskID = new(uint64)
+				*ap.KioskID = uint64(value.Int64)
+			}
+		default:
+			ap.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendancePunch.
+// This includes values selected through modifiers, order, etc.
+func (ap *AttendancePunch) Value(name string) (ent.Value, error) {
+	return ap.selectValues.Get(name)
+}
+
+// QueryAttendance queries the "attendance" edge of the AttendancePunch entity.
+func (ap *AttendancePunch) QueryAttendance() *AttendanceQuery {
+	return NewAttendancePunchClient(ap.config).QueryAttendance(ap)
+}
+
+// QueryKiosk queries the "kiosk" edge of the AttendancePunch entity.
+func (ap *AttendancePunch) QueryKiosk() *KioskQuery {
+	return NewAttendancePunchClient(ap.config).QueryKiosk(ap)
+}
+
+// Update returns a builder for updating this AttendancePunch.
+// Note that you need to call AttendancePunch.Unwrap() before calling this method if this AttendancePunch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *AttendancePunch) Update() *AttendancePunchUpdateOne {
+	return NewAttendancePunchClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the AttendancePunch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *AttendancePunch) Unwrap() *AttendancePunch {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendancePunch is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *AttendancePunch) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendancePunch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ap.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(ap.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ap.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_id=")
+	builder.WriteString(fmt.Sprintf("%v", ap.AttendanceID))
+	builder.WriteString(", ")
+	builder.WriteString("punch_type=")
+	builder.WriteString(fmt.Sprintf("%v", ap.PunchType))
+	builder.WriteString(", ")
+	builder.WriteString("punch_time=")
+	builder.WriteString(ap.PunchTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ap.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ap.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ap.DistanceMeters; v != nil {
+		builder.WriteString("distance_meters=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ap.KioskID; v != nil {
+		builder.WriteString("kiosk_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendancePunches is a parsable slice of AttendancePunch.
+type AttendancePunches []*AttendancePunch
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancepunch
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attendancepunch type in the database.
+	Label = "attendance_punch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldAttendanceID holds the string denoting the attendance_id field in the database.
+	FieldAttendanceID = "attendance_id"
+	// FieldPunchType holds the string denoting the punch_type field in the database.
+	FieldPunchType = "punch_type"
+	// FieldPunchTime holds the string denoting the punch_time field in the database.
+	FieldPunchTime = "punch_time"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldDistanceMeters holds the string denoting the distance_meters field in the database.
+	FieldDistanceMeters = "distance_meters"
+	// FieldKioskID holds the string denoting the kiosk_id field in the database.
+	FieldKioskID = "kiosk_id"
+	// EdgeAttendance holds the string denoting the attendance edge name in mutations.
+	EdgeAttendance = "attendance"
+	// EdgeKiosk holds the string denoting the kiosk edge name in mutations.
+	EdgeKiosk = "kiosk"
+	// Table holds the table name of the attendancepunch in the database.
+	Table = "attendance_punches"
+	// AttendanceTable is the table that holds the attendance relation/edge.
+	AttendanceTable = "attendance_punches"
+	// AttendanceInverseTable is the table name for the Attendance entity.
+	// It exists in this package in order to avoid circular dependency with the "attendance" package.
+	AttendanceInverseTable = "attendances"
+	// AttendanceColumn is the table column denoting the attendance relation/edge.
+	AttendanceColumn = "attendance_id"
+	// KioskTable is the table that holds the kiosk relation/edge.
+	KioskTable = "attendance_punches"
+	// KioskInverseTable is the table name for the Kiosk entity.
+	// It exists in this package in order to avoid circular dependency with the "kiosk" package.
+	KioskInverseTable = "kiosks"
+	// KioskColumn is the table column denoting the kiosk relation/edge.
+	KioskColumn = "kiosk_id"
+)
+
+// Columns holds all SQL columns for attendancepunch fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldAttendanceID,
+	FieldPunchType,
+	FieldPunchTime,
+	FieldLatitude,
+	FieldLongitude,
+	FieldDistanceMeters,
+	FieldKioskID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+)
+
+// PunchType defines the type for the "punch_type" enum field.
+type PunchType string
+
+// PunchType values.
+const (
+	PunchTypeCheckIn    PunchType = "check_in"
+	PunchTypeCheckOut   PunchType = "check_out"
+	PunchTypeBreakStart PunchType = "break_start"
+	PunchTypeBreakEnd   PunchType = "break_end"
+)
+
+func (pt PunchType) String() string {
+	return string(pt)
+}
+
+// PunchTypeValidator is a validator for the "punch_type" field enum values. It is called by the builders before save.
+func PunchTypeValidator(pt PunchType) error {
+	switch pt {
+	case PunchTypeCheckIn, PunchTypeCheckOut, PunchTypeBreakStart, PunchTypeBreakEnd:
+		return nil
+	default:
+		return fmt.Errorf("attendancepunch: invalid enum value for punch_type field: %q", pt)
+	}
+}
+
+// OrderOption defines the ordering options for the AttendancePunch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByAttendanceID orders the results by the attendance_id field.
+func ByAttendanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceID, opts...).ToFunc()
+}
+
+// ByPunchType orders the results by the punch_type field.
+func ByPunchType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPunchType, opts...).ToFunc()
+}
+
+// ByPunchTime orders the results by the punch_time field.
+func ByPunchTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPunchTime, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByDistanceMeters orders the results by the distance_meters field.
+func ByDistanceMeters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistanceMeters, opts...).ToFunc()
+}
+
+// ByKioskID orders the results by the kiosk_id field.
+func ByKioskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKioskID, opts...).ToFunc()
+}
+
+// ByAttendanceField orders the results by attendance field.
+func ByAttendanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByKioskField orders the results by kiosk field.
+func ByKioskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKioskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttendanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+	)
+}
+func newKioskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KioskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, KioskTable, KioskColumn),
+	)
+}
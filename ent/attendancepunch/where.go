@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancepunch
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AttendanceID applies equality check predicate on the "attendance_id" field. It's identical to AttendanceIDEQ.
+func AttendanceID(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// PunchTime applies equality check predicate on the "punch_time" field. It's identical to PunchTimeEQ.
+func PunchTime(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldPunchTime, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldLongitude, v))
+}
+
+// DistanceMeters applies equality check predicate on the "distance_meters" field. It's identical to DistanceMetersEQ.
+func DistanceMeters(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldDistanceMeters, v))
+}
+
+// KioskID applies equality check predicate on the "kiosk_id" field. It's identical to KioskIDEQ.
+func KioskID(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldKioskID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// AttendanceIDEQ applies the EQ predicate on the "attendance_id" field.
+func AttendanceIDEQ(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDNEQ applies the NEQ predicate on the "attendance_id" field.
+func AttendanceIDNEQ(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDIn applies the In predicate on the "attendance_id" field.
+func AttendanceIDIn(vs ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldAttendanceID, vs...))
+}
+
+// AttendanceIDNotIn applies the NotIn predicate on the "attendance_id" field.
+func AttendanceIDNotIn(vs ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldAttendanceID, vs...))
+}
+
+// PunchTypeEQ applies the EQ predicate on the "punch_type" field.
+func PunchTypeEQ(v PunchType) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldPunchType, v))
+}
+
+// PunchTypeNEQ applies the NEQ predicate on the "punch_type" field.
+func PunchTypeNEQ(v PunchType) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldPunchType, v))
+}
+
+// PunchTypeIn applies the In predicate on the "punch_type" field.
+func PunchTypeIn(vs ...PunchType) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldPunchType, vs...))
+}
+
+// PunchTypeNotIn applies the NotIn predicate on the "punch_type" field.
+func PunchTypeNotIn(vs ...PunchType) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldPunchType, vs...))
+}
+
+// PunchTimeEQ applies the EQ predicate on the "punch_time" field.
+func PunchTimeEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldPunchTime, v))
+}
+
+// PunchTimeNEQ applies the NEQ predicate on the "punch_time" field.
+func PunchTimeNEQ(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldPunchTime, v))
+}
+
+// PunchTimeIn applies the In predicate on the "punch_time" field.
+func PunchTimeIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldPunchTime, vs...))
+}
+
+// PunchTimeNotIn applies the NotIn predicate on the "punch_time" field.
+func PunchTimeNotIn(vs ...time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldPunchTime, vs...))
+}
+
+// PunchTimeGT applies the GT predicate on the "punch_time" field.
+func PunchTimeGT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldPunchTime, v))
+}
+
+// PunchTimeGTE applies the GTE predicate on the "punch_time" field.
+func PunchTimeGTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldPunchTime, v))
+}
+
+// PunchTimeLT applies the LT predicate on the "punch_time" field.
+func PunchTimeLT(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldPunchTime, v))
+}
+
+// PunchTimeLTE applies the LTE predicate on the "punch_time" field.
+func PunchTimeLTE(v time.Time) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldPunchTime, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotNull(FieldLongitude))
+}
+
+// DistanceMetersEQ applies the EQ predicate on the "distance_meters" field.
+func DistanceMetersEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldDistanceMeters, v))
+}
+
+// DistanceMetersNEQ applies the NEQ predicate on the "distance_meters" field.
+func DistanceMetersNEQ(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldDistanceMeters, v))
+}
+
+// DistanceMetersIn applies the In predicate on the "distance_meters" field.
+func DistanceMetersIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldDistanceMeters, vs...))
+}
+
+// DistanceMetersNotIn applies the NotIn predicate on the "distance_meters" field.
+func DistanceMetersNotIn(vs ...float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldDistanceMeters, vs...))
+}
+
+// DistanceMetersGT applies the GT predicate on the "distance_meters" field.
+func DistanceMetersGT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGT(FieldDistanceMeters, v))
+}
+
+// DistanceMetersGTE applies the GTE predicate on the "distance_meters" field.
+func DistanceMetersGTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldGTE(FieldDistanceMeters, v))
+}
+
+// DistanceMetersLT applies the LT predicate on the "distance_meters" field.
+func DistanceMetersLT(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLT(FieldDistanceMeters, v))
+}
+
+// DistanceMetersLTE applies the LTE predicate on the "distance_meters" field.
+func DistanceMetersLTE(v float64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldLTE(FieldDistanceMeters, v))
+}
+
+// DistanceMetersIsNil applies the IsNil predicate on the "distance_meters" field.
+func DistanceMetersIsNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIsNull(FieldDistanceMeters))
+}
+
+// DistanceMetersNotNil applies the NotNil predicate on the "distance_meters" field.
+func DistanceMetersNotNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotNull(FieldDistanceMeters))
+}
+
+// KioskIDEQ applies the EQ predicate on the "kiosk_id" field.
+func KioskIDEQ(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldEQ(FieldKioskID, v))
+}
+
+// KioskIDNEQ applies the NEQ predicate on the "kiosk_id" field.
+func KioskIDNEQ(v uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNEQ(FieldKioskID, v))
+}
+
+// KioskIDIn applies the In predicate on the "kiosk_id" field.
+func KioskIDIn(vs ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIn(FieldKioskID, vs...))
+}
+
+// KioskIDNotIn applies the NotIn predicate on the "kiosk_id" field.
+func KioskIDNotIn(vs ...uint64) predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotIn(FieldKioskID, vs...))
+}
+
+// KioskIDIsNil applies the IsNil predicate on the "kiosk_id" field.
+func KioskIDIsNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldIsNull(FieldKioskID))
+}
+
+// KioskIDNotNil applies the NotNil predicate on the "kiosk_id" field.
+func KioskIDNotNil() predicate.AttendancePunch {
+	return predicate.AttendancePunch(sql.FieldNotNull(FieldKioskID))
+}
+
+// HasAttendance applies the HasEdge predicate on the "attendance" edge.
+func HasAttendance() predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendanceTable, AttendanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceWith applies the HasEdge predicate on the "attendance" edge with a given conditions (other predicates).
+func HasAttendanceWith(preds ...predicate.Attendance) predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		step := newAttendanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKiosk applies the HasEdge predicate on the "kiosk" edge.
+func HasKiosk() predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, KioskTable, KioskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKioskWith applies the HasEdge predicate on the "kiosk" edge with a given conditions (other predicates).
+func HasKioskWith(preds ...predicate.Kiosk) predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		step := newKioskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendancePunch) predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendancePunch) predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendancePunch) predicate.AttendancePunch {
+	return predicate.AttendancePunch(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendancePunchCreate is the builder for creating a AttendancePunch entity.
+type AttendancePunchCreate struct {
+	config
+	mutation *AttendancePunchMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (apc *AttendancePunchCreate) SetCreatedAt(t time.Time) *AttendancePunchCreate {
+	apc.mutation.SetCreatedAt(t)
+	return apc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableCreatedAt(t *time.Time) *AttendancePunchCreate {
+	if t != nil {
+		apc.SetCreatedAt(*t)
+	}
+	return apc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (apc *AttendancePunchCreate) SetModifiedAt(t time.Time) *AttendancePunchCreate {
+	apc.mutation.SetModifiedAt(t)
+	return apc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableModifiedAt(t *time.Time) *AttendancePunchCreate {
+	if t != nil {
+		apc.SetModifiedAt(*t)
+	}
+	return apc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apc *AttendancePunchCreate) SetDeletedAt(t time.Time) *AttendancePunchCreate {
+	apc.mutation.SetDeletedAt(t)
+	return apc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableDeletedAt(t *time.Time) *AttendancePunchCreate {
+	if t != nil {
+		apc.SetDeletedAt(*t)
+	}
+	return apc
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (apc *AttendancePunchCreate) SetAttendanceID(u uint64) *AttendancePunchCreate {
+	apc.mutation.SetAttendanceID(u)
+	return apc
+}
+
+// SetPunchType sets the "punch_type" field.
+func (apc *AttendancePunchCreate) SetPunchType(at attendancepunch.PunchType) *AttendancePunchCreate {
+	apc.mutation.SetPunchType(at)
+	return apc
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (apc *AttendancePunchCreate) SetPunchTime(t time.Time) *AttendancePunchCreate {
+	apc.mutation.SetPunchTime(t)
+	return apc
+}
+
+// SetLatitude sets the "latitude" field.
+func (apc *AttendancePunchCreate) SetLatitude(f float64) *AttendancePunchCreate {
+	apc.mutation.SetLatitude(f)
+	return apc
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableLatitude(f *float64) *AttendancePunchCreate {
+	if f != nil {
+		apc.SetLatitude(*f)
+	}
+	return apc
+}
+
+// SetLongitude sets the "longitude" field.
+func (apc *AttendancePunchCreate) SetLongitude(f float64) *AttendancePunchCreate {
+	apc.mutation.SetLongitude(f)
+	return apc
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableLongitude(f *float64) *AttendancePunchCreate {
+	if f != nil {
+		apc.SetLongitude(*f)
+	}
+	return apc
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (apc *AttendancePunchCreate) SetDistanceMeters(f float64) *AttendancePunchCreate {
+	apc.mutation.SetDistanceMeters(f)
+	return apc
+}
+
+// SetNillableDistanceMeters sets the "distance_meters" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableDistanceMeters(f *float64) *AttendancePunchCreate {
+	if f != nil {
+		apc.SetDistanceMeters(*f)
+	}
+	return apc
+}
+
+// SetKioskID sets the "kiosk_id" field.
+func (apc *AttendancePunchCreate) SetKioskID(u uint64) *AttendancePunchCreate {
+	apc.mutation.SetKioskID(u)
+	return apc
+}
+
+// SetNillableKioskID sets the "kiosk_id" field if the given value is not nil.
+func (apc *AttendancePunchCreate) SetNillableKioskID(u *uint64) *AttendancePunchCreate {
+	if u != nil {
+		apc.SetKioskID(*u)
+	}
+	return apc
+}
+
+// SetID sets the "id" field.
+func (apc *AttendancePunchCreate) SetID(u uint64) *AttendancePunchCreate {
+	apc.mutation.SetID(u)
+	return apc
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (apc *AttendancePunchCreate) SetAttendance(a *Attendance) *AttendancePunchCreate {
+	return apc.SetAttendanceID(a.ID)
+}
+
+// SetKiosk sets the "kiosk" edge to the Kiosk entity.
+func (apc *AttendancePunchCreate) SetKiosk(k *Kiosk) *AttendancePunchCreate {
+	return apc.SetKioskID(k.ID)
+}
+
+// Mutation returns the AttendancePunchMutation object of the builder.
+func (apc *AttendancePunchCreate) Mutation() *AttendancePunchMutation {
+	return apc.mutation
+}
+
+// Save creates the AttendancePunch in the database.
+func (apc *AttendancePunchCreate) Save(ctx context.Context) (*AttendancePunch, error) {
+	apc.defaults()
+	return withHooks(ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *AttendancePunchCreate) SaveX(ctx context.Context) *AttendancePunch {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *AttendancePunchCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *AttendancePunchCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *AttendancePunchCreate) defaults() {
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		v := attendancepunch.DefaultCreatedAt()
+		apc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := apc.mutation.ModifiedAt(); !ok {
+		v := attendancepunch.DefaultModifiedAt()
+		apc.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *AttendancePunchCreate) check() error {
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendancePunch.created_at"`)}
+	}
+	if _, ok := apc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "AttendancePunch.modified_at"`)}
+	}
+	if _, ok := apc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance_id", err: errors.New(`ent: missing required field "AttendancePunch.attendance_id"`)}
+	}
+	if _, ok := apc.mutation.PunchType(); !ok {
+		return &ValidationError{Name: "punch_type", err: errors.New(`ent: missing required field "AttendancePunch.punch_type"`)}
+	}
+	if v, ok := apc.mutation.PunchType(); ok {
+		if err := attendancepunch.PunchTypeValidator(v); err != nil {
+			return &ValidationError{Name: "punch_type", err: fmt.Errorf(`ent: validator failed for field "AttendancePunch.punch_type": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.PunchTime(); !ok {
+		return &ValidationError{Name: "punch_time", err: errors.New(`ent: missing required field "AttendancePunch.punch_time"`)}
+	}
+	if _, ok := apc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance", err: errors.New(`ent: missing required edge "AttendancePunch.attendance"`)}
+	}
+	return nil
+}
+
+func (apc *AttendancePunchCreate) sqlSave(ctx context.Context) (*AttendancePunch, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *AttendancePunchCreate) createSpec() (*AttendancePunch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendancePunch{config: apc.config}
+		_spec = sqlgraph.NewCreateSpec(attendancepunch.Table, sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64))
+	)
+	if id, ok := apc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := apc.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancepunch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := apc.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepunch.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := apc.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepunch.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := apc.mutation.PunchType(); ok {
+		_spec.SetField(attendancepunch.FieldPunchType, field.TypeEnum, value)
+		_node.PunchType = value
+	}
+	if value, ok := apc.mutation.PunchTime(); ok {
+		_spec.SetField(attendancepunch.FieldPunchTime, field.TypeTime, value)
+		_node.PunchTime = value
+	}
+	if value, ok := apc.mutation.Latitude(); ok {
+		_spec.SetField(attendancepunch.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := apc.mutation.Longitude(); ok {
+		_spec.SetField(attendancepunch.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := apc.mutation.DistanceMeters(); ok {
+		_spec.SetField(attendancepunch.FieldDistanceMeters, field.TypeFloat64, value)
+		_node.DistanceMeters = &value
+	}
+	if nodes := apc.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.AttendanceTable,
+			Columns: []string{attendancepunch.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := apc.mutation.KioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.KioskTable,
+			Columns: []string{attendancepunch.KioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.KioskID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttendancePunchCreateBulk is the builder for creating many AttendancePunch entities in bulk.
+type AttendancePunchCreateBulk struct {
+	config
+	builders []*AttendancePunchCreate
+}
+
+// Save creates the AttendancePunch entities in the database.
+func (apcb *AttendancePunchCreateBulk) Save(ctx context.Context) ([]*AttendancePunch, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*AttendancePunch, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendancePunchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *AttendancePunchCreateBulk) SaveX(ctx context.Context) []*AttendancePunch {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *AttendancePunchCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *AttendancePunchCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
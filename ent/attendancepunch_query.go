@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendancePunchQuery is the builder for querying AttendancePunch entities.
+type AttendancePunchQuery struct {
+	config
+	ctx            *QueryContext
+	order          []attendancepunch.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.AttendancePunch
+	withAttendance *AttendanceQuery
+	withKiosk      *KioskQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendancePunchQuery builder.
+func (apq *AttendancePunchQuery) Where(ps ...predicate.AttendancePunch) *AttendancePunchQuery {
+	apq.predicates = append(apq.predicates, ps...)
+	return apq
+}
+
+// Limit the number of records to be returned by this query.
+func (apq *AttendancePunchQuery) Limit(limit int) *AttendancePunchQuery {
+	apq.ctx.Limit = &limit
+	return apq
+}
+
+// Offset to start from.
+func (apq *AttendancePunchQuery) Offset(offset int) *AttendancePunchQuery {
+	apq.ctx.Offset = &offset
+	return apq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (apq *AttendancePunchQuery) Unique(unique bool) *AttendancePunchQuery {
+	apq.ctx.Unique = &unique
+	return apq
+}
+
+// Order specifies how the records should be ordered.
+func (apq *AttendancePunchQuery) Order(o ...attendancepunch.OrderOption) *AttendancePunchQuery {
+	apq.order = append(apq.order, o...)
+	return apq
+}
+
+// QueryAttendance chains the current query on the "attendance" edge.
+func (apq *AttendancePunchQuery) QueryAttendance() *AttendanceQuery {
+	query := (&AttendanceClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepunch.Table, attendancepunch.FieldID, selector),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancepunch.AttendanceTable, attendancepunch.AttendanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryKiosk chains the current query on the "kiosk" edge.
+func (apq *AttendancePunchQuery) QueryKiosk() *KioskQuery {
+	query := (&KioskClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepunch.Table, attendancepunch.FieldID, selector),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancepunch.KioskTable, attendancepunch.KioskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendancePunch entity from the query.
+// Returns a *NotFoundError when no AttendancePunch was found.
+func (apq *AttendancePunchQuery) First(ctx context.Context) (*AttendancePunch, error) {
+	nodes, err := apq.Limit(1).All(setContextOp(ctx, apq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendancepunch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (apq *AttendancePunchQuery) FirstX(ctx context.Context) *AttendancePunch {
+	node, err := apq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendancePunch ID from the query.
+// Returns a *NotFoundError when no AttendancePunch ID was found.
+func (apq *AttendancePunchQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = apq.Limit(1).IDs(setContextOp(ctx, apq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendancepunch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (apq *AttendancePunchQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := apq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendancePunch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendancePunch entity is found.
+// Returns a *NotFoundError when no AttendancePunch entities are found.
+func (apq *AttendancePunchQuery) Only(ctx context.Context) (*AttendancePunch, error) {
+	nodes, err := apq.Limit(2).All(setContextOp(ctx, apq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendancepunch.Label}
+	default:
+		return nil, &NotSingularError{attendancepunch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (apq *AttendancePunchQuery) OnlyX(ctx context.Context) *AttendancePunch {
+	node, err := apq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendancePunch ID in the query.
+// Returns a *NotSingularError when more than one AttendancePunch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (apq *AttendancePunchQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = apq.Limit(2).IDs(setContextOp(ctx, apq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendancepunch.Label}
+	default:
+		err = &NotSingularError{attendancepunch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (apq *AttendancePunchQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := apq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendancePunches.
+func (apq *AttendancePunchQuery) All(ctx context.Context) ([]*AttendancePunch, error) {
+	ctx = setContextOp(ctx, apq.ctx, "All")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendancePunch, *AttendancePunchQuery]()
+	return withInterceptors[[]*AttendancePunch](ctx, apq, qr, apq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (apq *AttendancePunchQuery) AllX(ctx context.Context) []*AttendancePunch {
+	nodes, err := apq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendancePunch IDs.
+func (apq *AttendancePunchQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if apq.ctx.Unique == nil && apq.path != nil {
+		apq.Unique(true)
+	}
+	ctx = setContextOp(ctx, apq.ctx, "IDs")
+	if err = apq.Select(attendancepunch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (apq *AttendancePunchQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := apq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (apq *AttendancePunchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Count")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, apq, querierCount[*AttendancePunchQuery](), apq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (apq *AttendancePunchQuery) CountX(ctx context.Context) int {
+	count, err := apq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (apq *AttendancePunchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Exist")
+	switch _, err := apq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (apq *AttendancePunchQuery) ExistX(ctx context.Context) bool {
+	exist, err := apq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendancePunchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (apq *AttendancePunchQuery) Clone() *AttendancePunchQuery {
+	if apq == nil {
+		return nil
+	}
+	return &AttendancePunchQuery{
+		config:         apq.config,
+		ctx:            apq.ctx.Clone(),
+		order:          append([]attendancepunch.OrderOption{}, apq.order...),
+		inters:         append([]Interceptor{}, apq.inters...),
+		predicates:     append([]predicate.AttendancePunch{}, apq.predicates...),
+		withAttendance: apq.withAttendance.Clone(),
+		withKiosk:      apq.withKiosk.Clone(),
+		// clone intermediate query.
+		sql:  apq.sql.Clone(),
+		path: apq.path,
+	}
+}
+
+// WithAttendance tells the query-builder to eager-load the nodes that are connected to
+// the "attendance" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *AttendancePunchQuery) WithAttendance(opts ...func(*AttendanceQuery)) *AttendancePunchQuery {
+	query := (&AttendanceClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withAttendance = query
+	return apq
+}
+
+// WithKiosk tells the query-builder to eager-load the nodes that are connected to
+// the "kiosk" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *AttendancePunchQuery) WithKiosk(opts ...func(*KioskQuery)) *AttendancePunchQuery {
+	query := (&KioskClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withKiosk = query
+	return apq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendancePunch.Query().
+//		GroupBy(attendancepunch.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (apq *AttendancePunchQuery) GroupBy(field string, fields ...string) *AttendancePunchGroupBy {
+	apq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendancePunchGroupBy{build: apq}
+	grbuild.flds = &apq.ctx.Fields
+	grbuild.label = attendancepunch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.AttendancePunch.Query().
+//		Select(attendancepunch.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (apq *AttendancePunchQuery) Select(fields ...string) *AttendancePunchSelect {
+	apq.ctx.Fields = append(apq.ctx.Fields, fields...)
+	sbuild := &AttendancePunchSelect{AttendancePunchQuery: apq}
+	sbuild.label = attendancepunch.Label
+	sbuild.flds, sbuild.scan = &apq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendancePunchSelect configured with the given aggregations.
+func (apq *AttendancePunchQuery) Aggregate(fns ...AggregateFunc) *AttendancePunchSelect {
+	return apq.Select().Aggregate(fns...)
+}
+
+func (apq *AttendancePunchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range apq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, apq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range apq.ctx.Fields {
+		if !attendancepunch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if apq.path != nil {
+		prev, err := apq.path(ctx)
+		if err != nil {
+			return err
+		}
+		apq.sql = prev
+	}
+	return nil
+}
+
+func (apq *AttendancePunchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendancePunch, error) {
+	var (
+		nodes       = []*AttendancePunch{}
+		_spec       = apq.querySpec()
+		loadedTypes = [2]bool{
+			apq.withAttendance != nil,
+			apq.withKiosk != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendancePunch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendancePunch{config: apq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, apq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := apq.withAttendance; query != nil {
+		if err := apq.loadAttendance(ctx, query, nodes, nil,
+			func(n *AttendancePunch, e *Attendance) { n.Edges.Attendance = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := apq.withKiosk; query != nil {
+		if err := apq.loadKiosk(ctx, query, nodes, nil,
+			func(n *AttendancePunch, e *Kiosk) { n.Edges.Kiosk = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (apq *AttendancePunchQuery) loadAttendance(ctx context.Context, query *AttendanceQuery, nodes []*AttendancePunch, init func(*AttendancePunch), assign func(*AttendancePunch, *Attendance)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*AttendancePunch)
+	for i := range nodes {
+		fk := nodes[i].AttendanceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(attendance.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attendance_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (apq *AttendancePunchQuery) loadKiosk(ctx context.Context, query *KioskQuery, nodes []*AttendancePunch, init func(*AttendancePunch), assign func(*AttendancePunch, *Kiosk)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*AttendancePunch)
+	for i := range nodes {
+		if nodes[i].KioskID == nil {
+			continue
+		}
+		fk := *nodes[i].KioskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(kiosk.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "kiosk_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (apq *AttendancePunchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := apq.querySpec()
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	_spec.Node.Columns = apq.ctx.Fields
+	if len(apq.ctx.Fields) > 0 {
+		_spec.Unique = apq.ctx.Unique != nil && *apq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, apq.driver, _spec)
+}
+
+func (apq *AttendancePunchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendancepunch.Table, attendancepunch.Columns, sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64))
+	_spec.From = apq.sql
+	if unique := apq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if apq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := apq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancepunch.FieldID)
+		for i := range fields {
+			if fields[i] != attendancepunch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if apq.withAttendance != nil {
+			_spec.Node.AddColumnOnce(attendancepunch.FieldAttendanceID)
+		}
+		if apq.withKiosk != nil {
+			_spec.Node.AddColumnOnce(attendancepunch.FieldKioskID)
+		}
+	}
+	if ps := apq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := apq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (apq *AttendancePunchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(apq.driver.Dialect())
+	t1 := builder.Table(attendancepunch.Table)
+	columns := apq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendancepunch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if apq.sql != nil {
+		selector = apq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if apq.ctx.Unique != nil && *apq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range apq.modifiers {
+		m(selector)
+	}
+	for _, p := range apq.predicates {
+		p(selector)
+	}
+	for _, p := range apq.order {
+		p(selector)
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (apq *AttendancePunchQuery) Modify(modifiers ...func(s *sql.Selector)) *AttendancePunchSelect {
+	apq.modifiers = append(apq.modifiers, modifiers...)
+	return apq.Select()
+}
+
+// AttendancePunchGroupBy is the group-by builder for AttendancePunch entities.
+type AttendancePunchGroupBy struct {
+	selector
+	build *AttendancePunchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (apgb *AttendancePunchGroupBy) Aggregate(fns ...AggregateFunc) *AttendancePunchGroupBy {
+	apgb.fns = append(apgb.fns, fns...)
+	return apgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (apgb *AttendancePunchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, apgb.build.ctx, "GroupBy")
+	if err := apgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendancePunchQuery, *AttendancePunchGroupBy](ctx, apgb.build, apgb, apgb.build.inters, v)
+}
+
+func (apgb *AttendancePunchGroupBy) sqlScan(ctx context.Context, root *AttendancePunchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(apgb.fns))
+	for _, fn := range apgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*apgb.flds)+len(apgb.fns))
+		for _, f := range *apgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*apgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := apgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendancePunchSelect is the builder for selecting fields of AttendancePunch entities.
+type AttendancePunchSelect struct {
+	*AttendancePunchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aps *AttendancePunchSelect) Aggregate(fns ...AggregateFunc) *AttendancePunchSelect {
+	aps.fns = append(aps.fns, fns...)
+	return aps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aps *AttendancePunchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aps.ctx, "Select")
+	if err := aps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendancePunchQuery, *AttendancePunchSelect](ctx, aps.AttendancePunchQuery, aps, aps.inters, v)
+}
+
+func (aps *AttendancePunchSelect) sqlScan(ctx context.Context, root *AttendancePunchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aps.fns))
+	for _, fn := range aps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aps *AttendancePunchSelect) Modify(modifiers ...func(s *sql.Selector)) *AttendancePunchSelect {
+	aps.modifiers = append(aps.modifiers, modifiers...)
+	return aps
+}
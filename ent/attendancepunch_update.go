@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendancePunchUpdate is the builder for updating AttendancePunch entities.
+type AttendancePunchUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttendancePunchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttendancePunchUpdate builder.
+func (apu *AttendancePunchUpdate) Where(ps ...predicate.AttendancePunch) *AttendancePunchUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (apu *AttendancePunchUpdate) SetModifiedAt(t time.Time) *AttendancePunchUpdate {
+	apu.mutation.SetModifiedAt(t)
+	return apu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apu *AttendancePunchUpdate) SetDeletedAt(t time.Time) *AttendancePunchUpdate {
+	apu.mutation.SetDeletedAt(t)
+	return apu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apu *AttendancePunchUpdate) SetNillableDeletedAt(t *time.Time) *AttendancePunchUpdate {
+	if t != nil {
+		apu.SetDeletedAt(*t)
+	}
+	return apu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (apu *AttendancePunchUpdate) ClearDeletedAt() *AttendancePunchUpdate {
+	apu.mutation.ClearDeletedAt()
+	return apu
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (apu *AttendancePunchUpdate) SetAttendanceID(u uint64) *AttendancePunchUpdate {
+	apu.mutation.SetAttendanceID(u)
+	return apu
+}
+
+// SetPunchType sets the "punch_type" field.
+func (apu *AttendancePunchUpdate) SetPunchType(at attendancepunch.PunchType) *AttendancePunchUpdate {
+	apu.mutation.SetPunchType(at)
+	return apu
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (apu *AttendancePunchUpdate) SetPunchTime(t time.Time) *AttendancePunchUpdate {
+	apu.mutation.SetPunchTime(t)
+	return apu
+}
+
+// SetLatitude sets the "latitude" field.
+func (apu *AttendancePunchUpdate) SetLatitude(f float64) *AttendancePunchUpdate {
+	apu.mutation.ResetLatitude()
+	apu.mutation.SetLatitude(f)
+	return apu
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (apu *AttendancePunchUpdate) SetNillableLatitude(f *float64) *AttendancePunchUpdate {
+	if f != nil {
+		apu.SetLatitude(*f)
+	}
+	return apu
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (apu *AttendancePunchUpdate) AddLatitude(f float64) *AttendancePunchUpdate {
+	apu.mutation.AddLatitude(f)
+	return apu
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (apu *AttendancePunchUpdate) ClearLatitude() *AttendancePunchUpdate {
+	apu.mutation.ClearLatitude()
+	return apu
+}
+
+// SetLongitude sets the "longitude" field.
+func (apu *AttendancePunchUpdate) SetLongitude(f float64) *AttendancePunchUpdate {
+	apu.mutation.ResetLongitude()
+	apu.mutation.SetLongitude(f)
+	return apu
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (apu *AttendancePunchUpdate) SetNillableLongitude(f *float64) *AttendancePunchUpdate {
+	if f != nil {
+		apu.SetLongitude(*f)
+	}
+	return apu
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (apu *AttendancePunchUpdate) AddLongitude(f float64) *AttendancePunchUpdate {
+	apu.mutation.AddLongitude(f)
+	return apu
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (apu *AttendancePunchUpdate) ClearLongitude() *AttendancePunchUpdate {
+	apu.mutation.ClearLongitude()
+	return apu
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (apu *AttendancePunchUpdate) SetDistanceMeters(f float64) *AttendancePunchUpdate {
+	apu.mutation.ResetDistanceMeters()
+	apu.mutation.SetDistanceMeters(f)
+	return apu
+}
+
+// SetNillableDistanceMeters sets the "distance_meters" field if the given value is not nil.
+func (apu *AttendancePunchUpdate) SetNillableDistanceMeters(f *float64) *AttendancePunchUpdate {
+	if f != nil {
+		apu.SetDistanceMeters(*f)
+	}
+	return apu
+}
+
+// AddDistanceMeters adds f to the "distance_meters" field.
+func (apu *AttendancePunchUpdate) AddDistanceMeters(f float64) *AttendancePunchUpdate {
+	apu.mutation.AddDistanceMeters(f)
+	return apu
+}
+
+// ClearDistanceMeters clears the value of the "distance_meters" field.
+func (apu *AttendancePunchUpdate) ClearDistanceMeters() *AttendancePunchUpdate {
+	apu.mutation.ClearDistanceMeters()
+	return apu
+}
+
+// SetKioskID sets the "kiosk_id" field.
+func (apu *AttendancePunchUpdate) SetKioskID(u uint64) *AttendancePunchUpdate {
+	apu.mutation.SetKioskID(u)
+	return apu
+}
+
+// SetNillableKioskID sets the "kiosk_id" field if the given value is not nil.
+func (apu *AttendancePunchUpdate) SetNillableKioskID(u *uint64) *AttendancePunchUpdate {
+	if u != nil {
+		apu.SetKioskID(*u)
+	}
+	return apu
+}
+
+// ClearKioskID clears the value of the "kiosk_id" field.
+func (apu *AttendancePunchUpdate) ClearKioskID() *AttendancePunchUpdate {
+	apu.mutation.ClearKioskID()
+	return apu
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (apu *AttendancePunchUpdate) SetAttendance(a *Attendance) *AttendancePunchUpdate {
+	return apu.SetAttendanceID(a.ID)
+}
+
+// SetKiosk sets the "kiosk" edge to the Kiosk entity.
+func (apu *AttendancePunchUpdate) SetKiosk(k *Kiosk) *AttendancePunchUpdate {
+	return apu.SetKioskID(k.ID)
+}
+
+// Mutation returns the AttendancePunchMutation object of the builder.
+func (apu *AttendancePunchUpdate) Mutation() *AttendancePunchMutation {
+	return apu.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (apu *AttendancePunchUpdate) ClearAttendance() *AttendancePunchUpdate {
+	apu.mutation.ClearAttendance()
+	return apu
+}
+
+// ClearKiosk clears the "kiosk" edge to the Kiosk entity.
+func (apu *AttendancePunchUpdate) ClearKiosk() *AttendancePunchUpdate {
+	apu.mutation.ClearKiosk()
+	return apu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *AttendancePunchUpdate) Save(ctx context.Context) (int, error) {
+	apu.defaults()
+	return withHooks(ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *AttendancePunchUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *AttendancePunchUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *AttendancePunchUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apu *AttendancePunchUpdate) defaults() {
+	if _, ok := apu.mutation.ModifiedAt(); !ok {
+		v := attendancepunch.UpdateDefaultModifiedAt()
+		apu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *AttendancePunchUpdate) check() error {
+	if v, ok := apu.mutation.PunchType(); ok {
+		if err := attendancepunch.PunchTypeValidator(v); err != nil {
+			return &ValidationError{Name: "punch_type", err: fmt.Errorf(`ent: validator failed for field "AttendancePunch.punch_type": %w`, err)}
+		}
+	}
+	if _, ok := apu.mutation.AttendanceID(); apu.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendancePunch.attendance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (apu *AttendancePunchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendancePunchUpdate {
+	apu.modifiers = append(apu.modifiers, modifiers...)
+	return apu
+}
+
+func (apu *AttendancePunchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancepunch.Table, attendancepunch.Columns, sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64))
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepunch.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := apu.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepunch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if apu.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancepunch.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := apu.mutation.PunchType(); ok {
+		_spec.SetField(attendancepunch.FieldPunchType, field.TypeEnum, value)
+	}
+	if value, ok := apu.mutation.PunchTime(); ok {
+		_spec.SetField(attendancepunch.FieldPunchTime, field.TypeTime, value)
+	}
+	if value, ok := apu.mutation.Latitude(); ok {
+		_spec.SetField(attendancepunch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := apu.mutation.AddedLatitude(); ok {
+		_spec.AddField(attendancepunch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if apu.mutation.LatitudeCleared() {
+		_spec.ClearField(attendancepunch.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := apu.mutation.Longitude(); ok {
+		_spec.SetField(attendancepunch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := apu.mutation.AddedLongitude(); ok {
+		_spec.AddField(attendancepunch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if apu.mutation.LongitudeCleared() {
+		_spec.ClearField(attendancepunch.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := apu.mutation.DistanceMeters(); ok {
+		_spec.SetField(attendancepunch.FieldDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := apu.mutation.AddedDistanceMeters(); ok {
+		_spec.AddField(attendancepunch.FieldDistanceMeters, field.TypeFloat64, value)
+	}
+	if apu.mutation.DistanceMetersCleared() {
+		_spec.ClearField(attendancepunch.FieldDistanceMeters, field.TypeFloat64)
+	}
+	if apu.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.AttendanceTable,
+			Columns: []string{attendancepunch.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.AttendanceTable,
+			Columns: []string{attendancepunch.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apu.mutation.KioskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.KioskTable,
+			Columns: []string{attendancepunch.KioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.KioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.KioskTable,
+			Columns: []string{attendancepunch.KioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(apu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancepunch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// AttendancePunchUpdateOne is the builder for updating a single AttendancePunch entity.
+type AttendancePunchUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttendancePunchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (apuo *AttendancePunchUpdateOne) SetModifiedAt(t time.Time) *AttendancePunchUpdateOne {
+	apuo.mutation.SetModifiedAt(t)
+	return apuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apuo *AttendancePunchUpdateOne) SetDeletedAt(t time.Time) *AttendancePunchUpdateOne {
+	apuo.mutation.SetDeletedAt(t)
+	return apuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apuo *AttendancePunchUpdateOne) SetNillableDeletedAt(t *time.Time) *AttendancePunchUpdateOne {
+	if t != nil {
+		apuo.SetDeletedAt(*t)
+	}
+	return apuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (apuo *AttendancePunchUpdateOne) ClearDeletedAt() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearDeletedAt()
+	return apuo
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (apuo *AttendancePunchUpdateOne) SetAttendanceID(u uint64) *AttendancePunchUpdateOne {
+	apuo.mutation.SetAttendanceID(u)
+	return apuo
+}
+
+// SetPunchType sets the "punch_type" field.
+func (apuo *AttendancePunchUpdateOne) SetPunchType(at attendancepunch.PunchType) *AttendancePunchUpdateOne {
+	apuo.mutation.SetPunchType(at)
+	return apuo
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (apuo *AttendancePunchUpdateOne) SetPunchTime(t time.Time) *AttendancePunchUpdateOne {
+	apuo.mutation.SetPunchTime(t)
+	return apuo
+}
+
+// SetLatitude sets the "latitude" field.
+func (apuo *AttendancePunchUpdateOne) SetLatitude(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.ResetLatitude()
+	apuo.mutation.SetLatitude(f)
+	return apuo
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (apuo *AttendancePunchUpdateOne) SetNillableLatitude(f *float64) *AttendancePunchUpdateOne {
+	if f != nil {
+		apuo.SetLatitude(*f)
+	}
+	return apuo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (apuo *AttendancePunchUpdateOne) AddLatitude(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.AddLatitude(f)
+	return apuo
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (apuo *AttendancePunchUpdateOne) ClearLatitude() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearLatitude()
+	return apuo
+}
+
+// SetLongitude sets the "longitude" field.
+func (apuo *AttendancePunchUpdateOne) SetLongitude(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.ResetLongitude()
+	apuo.mutation.SetLongitude(f)
+	return apuo
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (apuo *AttendancePunchUpdateOne) SetNillableLongitude(f *float64) *AttendancePunchUpdateOne {
+	if f != nil {
+		apuo.SetLongitude(*f)
+	}
+	return apuo
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (apuo *AttendancePunchUpdateOne) AddLongitude(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.AddLongitude(f)
+	return apuo
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (apuo *AttendancePunchUpdateOne) ClearLongitude() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearLongitude()
+	return apuo
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (apuo *AttendancePunchUpdateOne) SetDistanceMeters(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.ResetDistanceMeters()
+	apuo.mutation.SetDistanceMeters(f)
+	return apuo
+}
+
+// SetNillableDistanceMeters sets the "distance_meters" field if the given value is not nil.
+func (apuo *AttendancePunchUpdateOne) SetNillableDistanceMeters(f *float64) *AttendancePunchUpdateOne {
+	if f != nil {
+		apuo.SetDistanceMeters(*f)
+	}
+	return apuo
+}
+
+// AddDistanceMeters adds f to the "distance_meters" field.
+func (apuo *AttendancePunchUpdateOne) AddDistanceMeters(f float64) *AttendancePunchUpdateOne {
+	apuo.mutation.AddDistanceMeters(f)
+	return apuo
+}
+
+// ClearDistanceMeters clears the value of the "distance_meters" field.
+func (apuo *AttendancePunchUpdateOne) ClearDistanceMeters() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearDistanceMeters()
+	return apuo
+}
+
+// SetKioskID sets the "kiosk_id" field.
+func (apuo *AttendancePunchUpdateOne) SetKioskID(u uint64) *AttendancePunchUpdateOne {
+	apuo.mutation.SetKioskID(u)
+	return apuo
+}
+
+// SetNillableKioskID sets the "kiosk_id" field if the given value is not nil.
+func (apuo *AttendancePunchUpdateOne) SetNillableKioskID(u *uint64) *AttendancePunchUpdateOne {
+	if u != nil {
+		apuo.SetKioskID(*u)
+	}
+	return apuo
+}
+
+// ClearKioskID clears the value of the "kiosk_id" field.
+func (apuo *AttendancePunchUpdateOne) ClearKioskID() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearKioskID()
+	return apuo
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (apuo *AttendancePunchUpdateOne) SetAttendance(a *Attendance) *AttendancePunchUpdateOne {
+	return apuo.SetAttendanceID(a.ID)
+}
+
+// SetKiosk sets the "kiosk" edge to the Kiosk entity.
+func (apuo *AttendancePunchUpdateOne) SetKiosk(k *Kiosk) *AttendancePunchUpdateOne {
+	return apuo.SetKioskID(k.ID)
+}
+
+// Mutation returns the AttendancePunchMutation object of the builder.
+func (apuo *AttendancePunchUpdateOne) Mutation() *AttendancePunchMutation {
+	return apuo.mutation
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (apuo *AttendancePunchUpdateOne) ClearAttendance() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearAttendance()
+	return apuo
+}
+
+// ClearKiosk clears the "kiosk" edge to the Kiosk entity.
+func (apuo *AttendancePunchUpdateOne) ClearKiosk() *AttendancePunchUpdateOne {
+	apuo.mutation.ClearKiosk()
+	return apuo
+}
+
+// Where appends a list predicates to the AttendancePunchUpdate builder.
+func (apuo *AttendancePunchUpdateOne) Where(ps ...predicate.AttendancePunch) *AttendancePunchUpdateOne {
+	apuo.mutation.Where(ps...)
+	return apuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *AttendancePunchUpdateOne) Select(field string, fields ...string) *AttendancePunchUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated AttendancePunch entity.
+func (apuo *AttendancePunchUpdateOne) Save(ctx context.Context) (*AttendancePunch, error) {
+	apuo.defaults()
+	return withHooks(ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *AttendancePunchUpdateOne) SaveX(ctx context.Context) *AttendancePunch {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *AttendancePunchUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *AttendancePunchUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apuo *AttendancePunchUpdateOne) defaults() {
+	if _, ok := apuo.mutation.ModifiedAt(); !ok {
+		v := attendancepunch.UpdateDefaultModifiedAt()
+		apuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *AttendancePunchUpdateOne) check() error {
+	if v, ok := apuo.mutation.PunchType(); ok {
+		if err := attendancepunch.PunchTypeValidator(v); err != nil {
+			return &ValidationError{Name: "punch_type", err: fmt.Errorf(`ent: validator failed for field "AttendancePunch.punch_type": %w`, err)}
+		}
+	}
+	if _, ok := apuo.mutation.AttendanceID(); apuo.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AttendancePunch.attendance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (apuo *AttendancePunchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttendancePunchUpdateOne {
+	apuo.modifiers = append(apuo.modifiers, modifiers...)
+	return apuo
+}
+
+func (apuo *AttendancePunchUpdateOne) sqlSave(ctx context.Context) (_node *AttendancePunch, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancepunch.Table, attendancepunch.Columns, sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64))
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendancePunch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancepunch.FieldID)
+		for _, f := range fields {
+			if !attendancepunch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendancepunch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(attendancepunch.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := apuo.mutation.DeletedAt(); ok {
+		_spec.SetField(attendancepunch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if apuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attendancepunch.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := apuo.mutation.PunchType(); ok {
+		_spec.SetField(attendancepunch.FieldPunchType, field.TypeEnum, value)
+	}
+	if value, ok := apuo.mutation.PunchTime(); ok {
+		_spec.SetField(attendancepunch.FieldPunchTime, field.TypeTime, value)
+	}
+	if value, ok := apuo.mutation.Latitude(); ok {
+		_spec.SetField(attendancepunch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := apuo.mutation.AddedLatitude(); ok {
+		_spec.AddField(attendancepunch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if apuo.mutation.LatitudeCleared() {
+		_spec.ClearField(attendancepunch.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := apuo.mutation.Longitude(); ok {
+		_spec.SetField(attendancepunch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := apuo.mutation.AddedLongitude(); ok {
+		_spec.AddField(attendancepunch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if apuo.mutation.LongitudeCleared() {
+		_spec.ClearField(attendancepunch.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := apuo.mutation.DistanceMeters(); ok {
+		_spec.SetField(attendancepunch.FieldDistanceMeters, field.TypeFloat64, value)
+	}
+	if value, ok := apuo.mutation.AddedDistanceMeters(); ok {
+		_spec.AddField(attendancepunch.FieldDistanceMeters, field.TypeFloat64, value)
+	}
+	if apuo.mutation.DistanceMetersCleared() {
+		_spec.ClearField(attendancepunch.FieldDistanceMeters, field.TypeFloat64)
+	}
+	if apuo.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.AttendanceTable,
+			Columns: []string{attendancepunch.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.AttendanceTable,
+			Columns: []string{attendancepunch.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apuo.mutation.KioskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.KioskTable,
+			Columns: []string{attendancepunch.KioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.KioskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancepunch.KioskTable,
+			Columns: []string{attendancepunch.KioskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(apuo.modifiers...)
+	_node = &AttendancePunch{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancepunch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
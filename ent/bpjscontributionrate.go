@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/bpjscontributionrate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// BpjsContributionRate is the model entity for the BpjsContributionRate schema.
+type BpjsContributionRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// BPJS Kesehatan or one of the BPJS Ketenagakerjaan programs
+	Program bpjscontributionrate.Program `json:"program,omitempty"`
+	// JKK risk class the rate applies to, 0 for the other programs
+	RiskClass int `json:"risk_class,omitempty"`
+	// First day the rate applies, it applies until a later row of the program takes over
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// Percentage of the wage paid by the employer
+	EmployerRate decimal.Decimal `json:"employer_rate,omitempty"`
+	// Percentage of the wage deducted from the salary
+	EmployeeRate decimal.Decimal `json:"employee_rate,omitempty"`
+	// Highest monthly wage contributions are calculated from, no cap when empty
+	WageCap *decimal.Decimal `json:"wage_cap,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes        string `json:"notes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BpjsContributionRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bpjscontributionrate.FieldWageCap:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case bpjscontributionrate.FieldEmployerRate, bpjscontributionrate.FieldEmployeeRate:
+			values[i] = new(decimal.Decimal)
+		case bpjscontributionrate.FieldID, bpjscontributionrate.FieldRiskClass:
+			values[i] = new(sql.NullInt64)
+		case bpjscontributionrate.FieldProgram, bpjscontributionrate.FieldNotes:
+			values[i] = new(sql.NullString)
+		case bpjscontributionrate.FieldCreatedAt, bpjscontributionrate.FieldModifiedAt, bpjscontributionrate.FieldDeletedAt, bpjscontributionrate.FieldEffectiveFrom:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BpjsContributionRate fields.
+func (bcr *BpjsContributionRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bpjscontributionrate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bcr.ID = uint64(value.Int64)
+		case bpjscontributionrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bcr.CreatedAt = value.Time
+			}
+		case bpjscontributionrate.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				bcr.ModifiedAt = value.Time
+			}
+		case bpjscontributionrate.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				bcr.DeletedAt = value.Time
+			}
+		case bpjscontributionrate.FieldProgram:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field program", values[i])
+			} else if value.Valid {
+				bcr.Program = bpjscontributionrate.Program(value.String)
+			}
+		case bpjscontributionrate.FieldRiskClass:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_class", values[i])
+			} else if value.Valid {
+				bcr.RiskClass = int(value.Int64)
+			}
+		case bpjscontributionrate.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				bcr.EffectiveFrom = value.Time
+			}
+		case bpjscontributionrate.FieldEmployerRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field employer_rate", values[i])
+			} else if value != nil {
+				bcr.EmployerRate = *value
+			}
+		case bpjscontributionrate.FieldEmployeeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_rate", values[i])
+			} else if value != nil {
+				bcr.EmployeeRate = *value
+			}
+		case bpjscontributionrate.FieldWageCap:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field wage_cap", values[i])
+			} else if value.Valid {
+				bcr.WageCap = new(decimal.Decimal)
+				*bcr.WageCap = *value.S.(*decimal.Decimal)
+			}
+		case bpjscontributionrate.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				bcr.Notes = value.String
+			}
+		default:
+			bcr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BpjsContributionRate.
+// This includes values selected through modifiers, order, etc.
+func (bcr *BpjsContributionRate) Value(name string) (ent.Value, error) {
+	return bcr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BpjsContributionRate.
+// Note that you need to call BpjsContributionRate.Unwrap() before calling this method if this BpjsContributionRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bcr *BpjsContributionRate) Update() *BpjsContributionRateUpdateOne {
+	return NewBpjsContributionRateClient(bcr.config).UpdateOne(bcr)
+}
+
+// Unwrap unwraps the BpjsContributionRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bcr *BpjsContributionRate) Unwrap() *BpjsContributionRate {
+	_tx, ok := bcr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BpjsContributionRate is not a transactional entity")
+	}
+	bcr.config.driver = _tx.drv
+	return bcr
+}
+
+// String implements the fmt.Stringer.
+func (bcr *BpjsContributionRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("BpjsContributionRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bcr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(bcr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(bcr.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(bcr.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("program=")
+	builder.WriteString(fmt.Sprintf("%v", bcr.Program))
+	builder.WriteString(", ")
+	builder.WriteString("risk_class=")
+	builder.WriteString(fmt.Sprintf("%v", bcr.RiskClass))
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(bcr.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("employer_rate=")
+	builder.WriteString(fmt.Sprintf("%v", bcr.EmployerRate))
+	builder.WriteString(", ")
+	builder.WriteString("employee_rate=")
+	builder.WriteString(fmt.Sprintf("%v", bcr.EmployeeRate))
+	builder.WriteString(", ")
+	if v := bcr.WageCap; v != nil {
+		builder.WriteString("wage_cap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(bcr.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BpjsContributionRates is a parsable slice of BpjsContributionRate.
+type BpjsContributionRates []*BpjsContributionRate
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bpjscontributionrate
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the bpjscontributionrate type in the database.
+	Label = "bpjs_contribution_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldProgram holds the string denoting the program field in the database.
+	FieldProgram = "program"
+	// FieldRiskClass holds the string denoting the risk_class field in the database.
+	FieldRiskClass = "risk_class"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldEmployerRate holds the string denoting the employer_rate field in the database.
+	FieldEmployerRate = "employer_rate"
+	// FieldEmployeeRate holds the string denoting the employee_rate field in the database.
+	FieldEmployeeRate = "employee_rate"
+	// FieldWageCap holds the string denoting the wage_cap field in the database.
+	FieldWageCap = "wage_cap"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// Table holds the table name of the bpjscontributionrate in the database.
+	Table = "bpjs_contribution_rates"
+)
+
+// Columns holds all SQL columns for bpjscontributionrate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldProgram,
+	FieldRiskClass,
+	FieldEffectiveFrom,
+	FieldEmployerRate,
+	FieldEmployeeRate,
+	FieldWageCap,
+	FieldNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// DefaultRiskClass holds the default value on creation for the "risk_class" field.
+	DefaultRiskClass int
+	// RiskClassValidator is a validator for the "risk_class" field. It is called by the builders before save.
+	RiskClassValidator func(int) error
+	// DefaultEmployerRate holds the default value on creation for the "employer_rate" field.
+	DefaultEmployerRate decimal.Decimal
+	// DefaultEmployeeRate holds the default value on creation for the "employee_rate" field.
+	DefaultEmployeeRate decimal.Decimal
+)
+
+// Program defines the type for the "program" enum field.
+type Program string
+
+// Program values.
+const (
+	ProgramKesehatan Program = "kesehatan"
+	ProgramJht       Program = "jht"
+	ProgramJp        Program = "jp"
+	ProgramJkk       Program = "jkk"
+	ProgramJkm       Program = "jkm"
+)
+
+func (pr Program) String() string {
+	return string(pr)
+}
+
+// ProgramValidator is a validator for the "program" field enum values. It is called by the builders before save.
+func ProgramValidator(pr Program) error {
+	switch pr {
+	case ProgramKesehatan, ProgramJht, ProgramJp, ProgramJkk, ProgramJkm:
+		return nil
+	default:
+		return fmt.Errorf("bpjscontributionrate: invalid enum value for program field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the BpjsContributionRate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByProgram orders the results by the program field.
+func ByProgram(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgram, opts...).ToFunc()
+}
+
+// ByRiskClass orders the results by the risk_class field.
+func ByRiskClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskClass, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByEmployerRate orders the results by the employer_rate field.
+func ByEmployerRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployerRate, opts...).ToFunc()
+}
+
+// ByEmployeeRate orders the results by the employee_rate field.
+func ByEmployeeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeRate, opts...).ToFunc()
+}
+
+// ByWageCap orders the results by the wage_cap field.
+func ByWageCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWageCap, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bpjscontributionrate
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// RiskClass applies equality check predicate on the "risk_class" field. It's identical to RiskClassEQ.
+func RiskClass(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldRiskClass, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EmployerRate applies equality check predicate on the "employer_rate" field. It's identical to EmployerRateEQ.
+func EmployerRate(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEmployerRate, v))
+}
+
+// EmployeeRate applies equality check predicate on the "employee_rate" field. It's identical to EmployeeRateEQ.
+func EmployeeRate(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEmployeeRate, v))
+}
+
+// WageCap applies equality check predicate on the "wage_cap" field. It's identical to WageCapEQ.
+func WageCap(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldWageCap, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ProgramEQ applies the EQ predicate on the "program" field.
+func ProgramEQ(v Program) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldProgram, v))
+}
+
+// ProgramNEQ applies the NEQ predicate on the "program" field.
+func ProgramNEQ(v Program) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldProgram, v))
+}
+
+// ProgramIn applies the In predicate on the "program" field.
+func ProgramIn(vs ...Program) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldProgram, vs...))
+}
+
+// ProgramNotIn applies the NotIn predicate on the "program" field.
+func ProgramNotIn(vs ...Program) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldProgram, vs...))
+}
+
+// RiskClassEQ applies the EQ predicate on the "risk_class" field.
+func RiskClassEQ(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldRiskClass, v))
+}
+
+// RiskClassNEQ applies the NEQ predicate on the "risk_class" field.
+func RiskClassNEQ(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldRiskClass, v))
+}
+
+// RiskClassIn applies the In predicate on the "risk_class" field.
+func RiskClassIn(vs ...int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldRiskClass, vs...))
+}
+
+// RiskClassNotIn applies the NotIn predicate on the "risk_class" field.
+func RiskClassNotIn(vs ...int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldRiskClass, vs...))
+}
+
+// RiskClassGT applies the GT predicate on the "risk_class" field.
+func RiskClassGT(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldRiskClass, v))
+}
+
+// RiskClassGTE applies the GTE predicate on the "risk_class" field.
+func RiskClassGTE(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldRiskClass, v))
+}
+
+// RiskClassLT applies the LT predicate on the "risk_class" field.
+func RiskClassLT(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldRiskClass, v))
+}
+
+// RiskClassLTE applies the LTE predicate on the "risk_class" field.
+func RiskClassLTE(v int) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldRiskClass, v))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// EmployerRateEQ applies the EQ predicate on the "employer_rate" field.
+func EmployerRateEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEmployerRate, v))
+}
+
+// EmployerRateNEQ applies the NEQ predicate on the "employer_rate" field.
+func EmployerRateNEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldEmployerRate, v))
+}
+
+// EmployerRateIn applies the In predicate on the "employer_rate" field.
+func EmployerRateIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldEmployerRate, vs...))
+}
+
+// EmployerRateNotIn applies the NotIn predicate on the "employer_rate" field.
+func EmployerRateNotIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldEmployerRate, vs...))
+}
+
+// EmployerRateGT applies the GT predicate on the "employer_rate" field.
+func EmployerRateGT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldEmployerRate, v))
+}
+
+// EmployerRateGTE applies the GTE predicate on the "employer_rate" field.
+func EmployerRateGTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldEmployerRate, v))
+}
+
+// EmployerRateLT applies the LT predicate on the "employer_rate" field.
+func EmployerRateLT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldEmployerRate, v))
+}
+
+// EmployerRateLTE applies the LTE predicate on the "employer_rate" field.
+func EmployerRateLTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldEmployerRate, v))
+}
+
+// EmployeeRateEQ applies the EQ predicate on the "employee_rate" field.
+func EmployeeRateEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldEmployeeRate, v))
+}
+
+// EmployeeRateNEQ applies the NEQ predicate on the "employee_rate" field.
+func EmployeeRateNEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldEmployeeRate, v))
+}
+
+// EmployeeRateIn applies the In predicate on the "employee_rate" field.
+func EmployeeRateIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldEmployeeRate, vs...))
+}
+
+// EmployeeRateNotIn applies the NotIn predicate on the "employee_rate" field.
+func EmployeeRateNotIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldEmployeeRate, vs...))
+}
+
+// EmployeeRateGT applies the GT predicate on the "employee_rate" field.
+func EmployeeRateGT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldEmployeeRate, v))
+}
+
+// EmployeeRateGTE applies the GTE predicate on the "employee_rate" field.
+func EmployeeRateGTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldEmployeeRate, v))
+}
+
+// EmployeeRateLT applies the LT predicate on the "employee_rate" field.
+func EmployeeRateLT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldEmployeeRate, v))
+}
+
+// EmployeeRateLTE applies the LTE predicate on the "employee_rate" field.
+func EmployeeRateLTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldEmployeeRate, v))
+}
+
+// WageCapEQ applies the EQ predicate on the "wage_cap" field.
+func WageCapEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldWageCap, v))
+}
+
+// WageCapNEQ applies the NEQ predicate on the "wage_cap" field.
+func WageCapNEQ(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldWageCap, v))
+}
+
+// WageCapIn applies the In predicate on the "wage_cap" field.
+func WageCapIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldWageCap, vs...))
+}
+
+// WageCapNotIn applies the NotIn predicate on the "wage_cap" field.
+func WageCapNotIn(vs ...decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldWageCap, vs...))
+}
+
+// WageCapGT applies the GT predicate on the "wage_cap" field.
+func WageCapGT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldWageCap, v))
+}
+
+// WageCapGTE applies the GTE predicate on the "wage_cap" field.
+func WageCapGTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldWageCap, v))
+}
+
+// WageCapLT applies the LT predicate on the "wage_cap" field.
+func WageCapLT(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldWageCap, v))
+}
+
+// WageCapLTE applies the LTE predicate on the "wage_cap" field.
+func WageCapLTE(v decimal.Decimal) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldWageCap, v))
+}
+
+// WageCapIsNil applies the IsNil predicate on the "wage_cap" field.
+func WageCapIsNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIsNull(FieldWageCap))
+}
+
+// WageCapNotNil applies the NotNil predicate on the "wage_cap" field.
+func WageCapNotNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotNull(FieldWageCap))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BpjsContributionRate) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BpjsContributionRate) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BpjsContributionRate) predicate.BpjsContributionRate {
+	return predicate.BpjsContributionRate(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
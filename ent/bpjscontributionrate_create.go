@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/bpjscontributionrate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BpjsContributionRateCreate is the builder for creating a BpjsContributionRate entity.
+type BpjsContributionRateCreate struct {
+	config
+	mutation *BpjsContributionRateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bcrc *BpjsContributionRateCreate) SetCreatedAt(t time.Time) *BpjsContributionRateCreate {
+	bcrc.mutation.SetCreatedAt(t)
+	return bcrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableCreatedAt(t *time.Time) *BpjsContributionRateCreate {
+	if t != nil {
+		bcrc.SetCreatedAt(*t)
+	}
+	return bcrc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (bcrc *BpjsContributionRateCreate) SetModifiedAt(t time.Time) *BpjsContributionRateCreate {
+	bcrc.mutation.SetModifiedAt(t)
+	return bcrc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableModifiedAt(t *time.Time) *BpjsContributionRateCreate {
+	if t != nil {
+		bcrc.SetModifiedAt(*t)
+	}
+	return bcrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bcrc *BpjsContributionRateCreate) SetDeletedAt(t time.Time) *BpjsContributionRateCreate {
+	bcrc.mutation.SetDeletedAt(t)
+	return bcrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableDeletedAt(t *time.Time) *BpjsContributionRateCreate {
+	if t != nil {
+		bcrc.SetDeletedAt(*t)
+	}
+	return bcrc
+}
+
+// SetProgram sets the "program" field.
+func (bcrc *BpjsContributionRateCreate) SetProgram(b bpjscontributionrate.Program) *BpjsContributionRateCreate {
+	bcrc.mutation.SetProgram(b)
+	return bcrc
+}
+
+// SetRiskClass sets the "risk_class" field.
+func (bcrc *BpjsContributionRateCreate) SetRiskClass(i int) *BpjsContributionRateCreate {
+	bcrc.mutation.SetRiskClass(i)
+	return bcrc
+}
+
+// SetNillableRiskClass sets the "risk_class" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableRiskClass(i *int) *BpjsContributionRateCreate {
+	if i != nil {
+		bcrc.SetRiskClass(*i)
+	}
+	return bcrc
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (bcrc *BpjsContributionRateCreate) SetEffectiveFrom(t time.Time) *BpjsContributionRateCreate {
+	bcrc.mutation.SetEffectiveFrom(t)
+	return bcrc
+}
+
+// SetEmployerRate sets the "employer_rate" field.
+func (bcrc *BpjsContributionRateCreate) SetEmployerRate(d decimal.Decimal) *BpjsContributionRateCreate {
+	bcrc.mutation.SetEmployerRate(d)
+	return bcrc
+}
+
+// SetNillableEmployerRate sets the "employer_rate" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableEmployerRate(d *decimal.Decimal) *BpjsContributionRateCreate {
+	if d != nil {
+		bcrc.SetEmployerRate(*d)
+	}
+	return bcrc
+}
+
+// SetEmployeeRate sets the "employee_rate" field.
+func (bcrc *BpjsContributionRateCreate) SetEmployeeRate(d decimal.Decimal) *BpjsContributionRateCreate {
+	bcrc.mutation.SetEmployeeRate(d)
+	return bcrc
+}
+
+// SetNillableEmployeeRate sets the "employee_rate" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableEmployeeRate(d *decimal.Decimal) *BpjsContributionRateCreate {
+	if d != nil {
+		bcrc.SetEmployeeRate(*d)
+	}
+	return bcrc
+}
+
+// SetWageCap sets the "wage_cap" field.
+func (bcrc *BpjsContributionRateCreate) SetWageCap(d decimal.Decimal) *BpjsContributionRateCreate {
+	bcrc.mutation.SetWageCap(d)
+	return bcrc
+}
+
+// SetNillableWageCap sets the "wage_cap" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableWageCap(d *decimal.Decimal) *BpjsContributionRateCreate {
+	if d != nil {
+		bcrc.SetWageCap(*d)
+	}
+	return bcrc
+}
+
+// SetNotes sets the "notes" field.
+func (bcrc *BpjsContributionRateCreate) SetNotes(s string) *BpjsContributionRateCreate {
+	bcrc.mutation.SetNotes(s)
+	return bcrc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bcrc *BpjsContributionRateCreate) SetNillableNotes(s *string) *BpjsContributionRateCreate {
+	if s != nil {
+		bcrc.SetNotes(*s)
+	}
+	return bcrc
+}
+
+// SetID sets the "id" field.
+func (bcrc *BpjsContributionRateCreate) SetID(u uint64) *BpjsContributionRateCreate {
+	bcrc.mutation.SetID(u)
+	return bcrc
+}
+
+// Mutation returns the BpjsContributionRateMutation object of the builder.
+func (bcrc *BpjsContributionRateCreate) Mutation() *BpjsContributionRateMutation {
+	return bcrc.mutation
+}
+
+// Save creates the BpjsContributionRate in the database.
+func (bcrc *BpjsContributionRateCreate) Save(ctx context.Context) (*BpjsContributionRate, error) {
+	bcrc.defaults()
+	return withHooks(ctx, bcrc.sqlSave, bcrc.mutation, bcrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bcrc *BpjsContributionRateCreate) SaveX(ctx context.Context) *BpjsContributionRate {
+	v, err := bcrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcrc *BpjsContributionRateCreate) Exec(ctx context.Context) error {
+	_, err := bcrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcrc *BpjsContributionRateCreate) ExecX(ctx context.Context) {
+	if err := bcrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bcrc *BpjsContributionRateCreate) defaults() {
+	if _, ok := bcrc.mutation.CreatedAt(); !ok {
+		v := bpjscontributionrate.DefaultCreatedAt()
+		bcrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bcrc.mutation.ModifiedAt(); !ok {
+		v := bpjscontributionrate.DefaultModifiedAt()
+		bcrc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := bcrc.mutation.RiskClass(); !ok {
+		v := bpjscontributionrate.DefaultRiskClass
+		bcrc.mutation.SetRiskClass(v)
+	}
+	if _, ok := bcrc.mutation.EmployerRate(); !ok {
+		v := bpjscontributionrate.DefaultEmployerRate
+		bcrc.mutation.SetEmployerRate(v)
+	}
+	if _, ok := bcrc.mutation.EmployeeRate(); !ok {
+		v := bpjscontributionrate.DefaultEmployeeRate
+		bcrc.mutation.SetEmployeeRate(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcrc *BpjsContributionRateCreate) check() error {
+	if _, ok := bcrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BpjsContributionRate.created_at"`)}
+	}
+	if _, ok := bcrc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "BpjsContributionRate.modified_at"`)}
+	}
+	if _, ok := bcrc.mutation.Program(); !ok {
+		return &ValidationError{Name: "program", err: errors.New(`ent: missing required field "BpjsContributionRate.program"`)}
+	}
+	if v, ok := bcrc.mutation.Program(); ok {
+		if err := bpjscontributionrate.ProgramValidator(v); err != nil {
+			return &ValidationError{Name: "program", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.program": %w`, err)}
+		}
+	}
+	if _, ok := bcrc.mutation.RiskClass(); !ok {
+		return &ValidationError{Name: "risk_class", err: errors.New(`ent: missing required field "BpjsContributionRate.risk_class"`)}
+	}
+	if v, ok := bcrc.mutation.RiskClass(); ok {
+		if err := bpjscontributionrate.RiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "risk_class", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.risk_class": %w`, err)}
+		}
+	}
+	if _, ok := bcrc.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "BpjsContributionRate.effective_from"`)}
+	}
+	if _, ok := bcrc.mutation.EmployerRate(); !ok {
+		return &ValidationError{Name: "employer_rate", err: errors.New(`ent: missing required field "BpjsContributionRate.employer_rate"`)}
+	}
+	if _, ok := bcrc.mutation.EmployeeRate(); !ok {
+		return &ValidationError{Name: "employee_rate", err: errors.New(`ent: missing required field "BpjsContributionRate.employee_rate"`)}
+	}
+	return nil
+}
+
+func (bcrc *BpjsContributionRateCreate) sqlSave(ctx context.Context) (*BpjsContributionRate, error) {
+	if err := bcrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bcrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bcrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	bcrc.mutation.id = &_node.ID
+	bcrc.mutation.done = true
+	return _node, nil
+}
+
+func (bcrc *BpjsContributionRateCreate) createSpec() (*BpjsContributionRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BpjsContributionRate{config: bcrc.config}
+		_spec = sqlgraph.NewCreateSpec(bpjscontributionrate.Table, sqlgraph.NewFieldSpec(bpjscontributionrate.FieldID, field.TypeUint64))
+	)
+	if id, ok := bcrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bcrc.mutation.CreatedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bcrc.mutation.ModifiedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := bcrc.mutation.DeletedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := bcrc.mutation.Program(); ok {
+		_spec.SetField(bpjscontributionrate.FieldProgram, field.TypeEnum, value)
+		_node.Program = value
+	}
+	if value, ok := bcrc.mutation.RiskClass(); ok {
+		_spec.SetField(bpjscontributionrate.FieldRiskClass, field.TypeInt, value)
+		_node.RiskClass = value
+	}
+	if value, ok := bcrc.mutation.EffectiveFrom(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := bcrc.mutation.EmployerRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployerRate, field.TypeOther, value)
+		_node.EmployerRate = value
+	}
+	if value, ok := bcrc.mutation.EmployeeRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployeeRate, field.TypeOther, value)
+		_node.EmployeeRate = value
+	}
+	if value, ok := bcrc.mutation.WageCap(); ok {
+		_spec.SetField(bpjscontributionrate.FieldWageCap, field.TypeOther, value)
+		_node.WageCap = &value
+	}
+	if value, ok := bcrc.mutation.Notes(); ok {
+		_spec.SetField(bpjscontributionrate.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	return _node, _spec
+}
+
+// BpjsContributionRateCreateBulk is the builder for creating many BpjsContributionRate entities in bulk.
+type BpjsContributionRateCreateBulk struct {
+	config
+	builders []*BpjsContributionRateCreate
+}
+
+// Save creates the BpjsContributionRate entities in the database.
+func (bcrcb *BpjsContributionRateCreateBulk) Save(ctx context.Context) ([]*BpjsContributionRate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcrcb.builders))
+	nodes := make([]*BpjsContributionRate, len(bcrcb.builders))
+	mutators := make([]Mutator, len(bcrcb.builders))
+	for i := range bcrcb.builders {
+		func(i int, root context.Context) {
+			builder := bcrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BpjsContributionRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcrcb *BpjsContributionRateCreateBulk) SaveX(ctx context.Context) []*BpjsContributionRate {
+	v, err := bcrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcrcb *BpjsContributionRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcrcb *BpjsContributionRateCreateBulk) ExecX(ctx context.Context) {
+	if err := bcrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
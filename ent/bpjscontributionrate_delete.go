@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BpjsContributionRateDelete is the builder for deleting a BpjsContributionRate entity.
+type BpjsContributionRateDelete struct {
+	config
+	hooks    []Hook
+	mutation *BpjsContributionRateMutation
+}
+
+// Where appends a list predicates to the BpjsContributionRateDelete builder.
+func (bcrd *BpjsContributionRateDelete) Where(ps ...predicate.BpjsContributionRate) *BpjsContributionRateDelete {
+	bcrd.mutation.Where(ps...)
+	return bcrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bcrd *BpjsContributionRateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bcrd.sqlExec, bcrd.mutation, bcrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcrd *BpjsContributionRateDelete) ExecX(ctx context.Context) int {
+	n, err := bcrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bcrd *BpjsContributionRateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bpjscontributionrate.Table, sqlgraph.NewFieldSpec(bpjscontributionrate.FieldID, field.TypeUint64))
+	if ps := bcrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bcrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bcrd.mutation.done = true
+	return affected, err
+}
+
+// BpjsContributionRateDeleteOne is the builder for deleting a single BpjsContributionRate entity.
+type BpjsContributionRateDeleteOne struct {
+	bcrd *BpjsContributionRateDelete
+}
+
+// Where appends a list predicates to the BpjsContributionRateDelete builder.
+func (bcrdo *BpjsContributionRateDeleteOne) Where(ps ...predicate.BpjsContributionRate) *BpjsContributionRateDeleteOne {
+	bcrdo.bcrd.mutation.Where(ps...)
+	return bcrdo
+}
+
+// Exec executes the deletion query.
+func (bcrdo *BpjsContributionRateDeleteOne) Exec(ctx context.Context) error {
+	n, err := bcrdo.bcrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bpjscontributionrate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcrdo *BpjsContributionRateDeleteOne) ExecX(ctx context.Context) {
+	if err := bcrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
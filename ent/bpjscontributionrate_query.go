@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BpjsContributionRateQuery is the builder for querying BpjsContributionRate entities.
+type BpjsContributionRateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bpjscontributionrate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BpjsContributionRate
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BpjsContributionRateQuery builder.
+func (bcrq *BpjsContributionRateQuery) Where(ps ...predicate.BpjsContributionRate) *BpjsContributionRateQuery {
+	bcrq.predicates = append(bcrq.predicates, ps...)
+	return bcrq
+}
+
+// Limit the number of records to be returned by this query.
+func (bcrq *BpjsContributionRateQuery) Limit(limit int) *BpjsContributionRateQuery {
+	bcrq.ctx.Limit = &limit
+	return bcrq
+}
+
+// Offset to start from.
+func (bcrq *BpjsContributionRateQuery) Offset(offset int) *BpjsContributionRateQuery {
+	bcrq.ctx.Offset = &offset
+	return bcrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bcrq *BpjsContributionRateQuery) Unique(unique bool) *BpjsContributionRateQuery {
+	bcrq.ctx.Unique = &unique
+	return bcrq
+}
+
+// Order specifies how the records should be ordered.
+func (bcrq *BpjsContributionRateQuery) Order(o ...bpjscontributionrate.OrderOption) *BpjsContributionRateQuery {
+	bcrq.order = append(bcrq.order, o...)
+	return bcrq
+}
+
+// First returns the first BpjsContributionRate entity from the query.
+// Returns a *NotFoundError when no BpjsContributionRate was found.
+func (bcrq *BpjsContributionRateQuery) First(ctx context.Context) (*BpjsContributionRate, error) {
+	nodes, err := bcrq.Limit(1).All(setContextOp(ctx, bcrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bpjscontributionrate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) FirstX(ctx context.Context) *BpjsContributionRate {
+	node, err := bcrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BpjsContributionRate ID from the query.
+// Returns a *NotFoundError when no BpjsContributionRate ID was found.
+func (bcrq *BpjsContributionRateQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = bcrq.Limit(1).IDs(setContextOp(ctx, bcrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bpjscontributionrate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := bcrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BpjsContributionRate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BpjsContributionRate entity is found.
+// Returns a *NotFoundError when no BpjsContributionRate entities are found.
+func (bcrq *BpjsContributionRateQuery) Only(ctx context.Context) (*BpjsContributionRate, error) {
+	nodes, err := bcrq.Limit(2).All(setContextOp(ctx, bcrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bpjscontributionrate.Label}
+	default:
+		return nil, &NotSingularError{bpjscontributionrate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) OnlyX(ctx context.Context) *BpjsContributionRate {
+	node, err := bcrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BpjsContributionRate ID in the query.
+// Returns a *NotSingularError when more than one BpjsContributionRate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bcrq *BpjsContributionRateQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = bcrq.Limit(2).IDs(setContextOp(ctx, bcrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bpjscontributionrate.Label}
+	default:
+		err = &NotSingularError{bpjscontributionrate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := bcrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BpjsContributionRates.
+func (bcrq *BpjsContributionRateQuery) All(ctx context.Context) ([]*BpjsContributionRate, error) {
+	ctx = setContextOp(ctx, bcrq.ctx, "All")
+	if err := bcrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BpjsContributionRate, *BpjsContributionRateQuery]()
+	return withInterceptors[[]*BpjsContributionRate](ctx, bcrq, qr, bcrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) AllX(ctx context.Context) []*BpjsContributionRate {
+	nodes, err := bcrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BpjsContributionRate IDs.
+func (bcrq *BpjsContributionRateQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if bcrq.ctx.Unique == nil && bcrq.path != nil {
+		bcrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bcrq.ctx, "IDs")
+	if err = bcrq.Select(bpjscontributionrate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := bcrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bcrq *BpjsContributionRateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bcrq.ctx, "Count")
+	if err := bcrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bcrq, querierCount[*BpjsContributionRateQuery](), bcrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) CountX(ctx context.Context) int {
+	count, err := bcrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bcrq *BpjsContributionRateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bcrq.ctx, "Exist")
+	switch _, err := bcrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bcrq *BpjsContributionRateQuery) ExistX(ctx context.Context) bool {
+	exist, err := bcrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BpjsContributionRateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bcrq *BpjsContributionRateQuery) Clone() *BpjsContributionRateQuery {
+	if bcrq == nil {
+		return nil
+	}
+	return &BpjsContributionRateQuery{
+		config:     bcrq.config,
+		ctx:        bcrq.ctx.Clone(),
+		order:      append([]bpjscontributionrate.OrderOption{}, bcrq.order...),
+		inters:     append([]Interceptor{}, bcrq.inters...),
+		predicates: append([]predicate.BpjsContributionRate{}, bcrq.predicates...),
+		// clone intermediate query.
+		sql:  bcrq.sql.Clone(),
+		path: bcrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BpjsContributionRate.Query().
+//		GroupBy(bpjscontributionrate.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bcrq *BpjsContributionRateQuery) GroupBy(field string, fields ...string) *BpjsContributionRateGroupBy {
+	bcrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BpjsContributionRateGroupBy{build: bcrq}
+	grbuild.flds = &bcrq.ctx.Fields
+	grbuild.label = bpjscontributionrate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.BpjsContributionRate.Query().
+//		Select(bpjscontributionrate.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (bcrq *BpjsContributionRateQuery) Select(fields ...string) *BpjsContributionRateSelect {
+	bcrq.ctx.Fields = append(bcrq.ctx.Fields, fields...)
+	sbuild := &BpjsContributionRateSelect{BpjsContributionRateQuery: bcrq}
+	sbuild.label = bpjscontributionrate.Label
+	sbuild.flds, sbuild.scan = &bcrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BpjsContributionRateSelect configured with the given aggregations.
+func (bcrq *BpjsContributionRateQuery) Aggregate(fns ...AggregateFunc) *BpjsContributionRateSelect {
+	return bcrq.Select().Aggregate(fns...)
+}
+
+func (bcrq *BpjsContributionRateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bcrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bcrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bcrq.ctx.Fields {
+		if !bpjscontributionrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bcrq.path != nil {
+		prev, err := bcrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bcrq.sql = prev
+	}
+	return nil
+}
+
+func (bcrq *BpjsContributionRateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BpjsContributionRate, error) {
+	var (
+		nodes = []*BpjsContributionRate{}
+		_spec = bcrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BpjsContributionRate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BpjsContributionRate{config: bcrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(bcrq.modifiers) > 0 {
+		_spec.Modifiers = bcrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bcrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bcrq *BpjsContributionRateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bcrq.querySpec()
+	if len(bcrq.modifiers) > 0 {
+		_spec.Modifiers = bcrq.modifiers
+	}
+	_spec.Node.Columns = bcrq.ctx.Fields
+	if len(bcrq.ctx.Fields) > 0 {
+		_spec.Unique = bcrq.ctx.Unique != nil && *bcrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bcrq.driver, _spec)
+}
+
+func (bcrq *BpjsContributionRateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bpjscontributionrate.Table, bpjscontributionrate.Columns, sqlgraph.NewFieldSpec(bpjscontributionrate.FieldID, field.TypeUint64))
+	_spec.From = bcrq.sql
+	if unique := bcrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bcrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bcrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bpjscontributionrate.FieldID)
+		for i := range fields {
+			if fields[i] != bpjscontributionrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bcrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bcrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bcrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bcrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bcrq *BpjsContributionRateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bcrq.driver.Dialect())
+	t1 := builder.Table(bpjscontributionrate.Table)
+	columns := bcrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = bpjscontributionrate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bcrq.sql != nil {
+		selector = bcrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bcrq.ctx.Unique != nil && *bcrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range bcrq.modifiers {
+		m(selector)
+	}
+	for _, p := range bcrq.predicates {
+		p(selector)
+	}
+	for _, p := range bcrq.order {
+		p(selector)
+	}
+	if offset := bcrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bcrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bcrq *BpjsContributionRateQuery) Modify(modifiers ...func(s *sql.Selector)) *BpjsContributionRateSelect {
+	bcrq.modifiers = append(bcrq.modifiers, modifiers...)
+	return bcrq.Select()
+}
+
+// BpjsContributionRateGroupBy is the group-by builder for BpjsContributionRate entities.
+type BpjsContributionRateGroupBy struct {
+	selector
+	build *BpjsContributionRateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bcrgb *BpjsContributionRateGroupBy) Aggregate(fns ...AggregateFunc) *BpjsContributionRateGroupBy {
+	bcrgb.fns = append(bcrgb.fns, fns...)
+	return bcrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bcrgb *BpjsContributionRateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bcrgb.build.ctx, "GroupBy")
+	if err := bcrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BpjsContributionRateQuery, *BpjsContributionRateGroupBy](ctx, bcrgb.build, bcrgb, bcrgb.build.inters, v)
+}
+
+func (bcrgb *BpjsContributionRateGroupBy) sqlScan(ctx context.Context, root *BpjsContributionRateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bcrgb.fns))
+	for _, fn := range bcrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bcrgb.flds)+len(bcrgb.fns))
+		for _, f := range *bcrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bcrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bcrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BpjsContributionRateSelect is the builder for selecting fields of BpjsContributionRate entities.
+type BpjsContributionRateSelect struct {
+	*BpjsContributionRateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bcrs *BpjsContributionRateSelect) Aggregate(fns ...AggregateFunc) *BpjsContributionRateSelect {
+	bcrs.fns = append(bcrs.fns, fns...)
+	return bcrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bcrs *BpjsContributionRateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bcrs.ctx, "Select")
+	if err := bcrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BpjsContributionRateQuery, *BpjsContributionRateSelect](ctx, bcrs.BpjsContributionRateQuery, bcrs, bcrs.inters, v)
+}
+
+func (bcrs *BpjsContributionRateSelect) sqlScan(ctx context.Context, root *BpjsContributionRateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bcrs.fns))
+	for _, fn := range bcrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bcrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bcrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bcrs *BpjsContributionRateSelect) Modify(modifiers ...func(s *sql.Selector)) *BpjsContributionRateSelect {
+	bcrs.modifiers = append(bcrs.modifiers, modifiers...)
+	return bcrs
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BpjsContributionRateUpdate is the builder for updating BpjsContributionRate entities.
+type BpjsContributionRateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BpjsContributionRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BpjsContributionRateUpdate builder.
+func (bcru *BpjsContributionRateUpdate) Where(ps ...predicate.BpjsContributionRate) *BpjsContributionRateUpdate {
+	bcru.mutation.Where(ps...)
+	return bcru
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (bcru *BpjsContributionRateUpdate) SetModifiedAt(t time.Time) *BpjsContributionRateUpdate {
+	bcru.mutation.SetModifiedAt(t)
+	return bcru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bcru *BpjsContributionRateUpdate) SetDeletedAt(t time.Time) *BpjsContributionRateUpdate {
+	bcru.mutation.SetDeletedAt(t)
+	return bcru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableDeletedAt(t *time.Time) *BpjsContributionRateUpdate {
+	if t != nil {
+		bcru.SetDeletedAt(*t)
+	}
+	return bcru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bcru *BpjsContributionRateUpdate) ClearDeletedAt() *BpjsContributionRateUpdate {
+	bcru.mutation.ClearDeletedAt()
+	return bcru
+}
+
+// SetProgram sets the "program" field.
+func (bcru *BpjsContributionRateUpdate) SetProgram(b bpjscontributionrate.Program) *BpjsContributionRateUpdate {
+	bcru.mutation.SetProgram(b)
+	return bcru
+}
+
+// SetRiskClass sets the "risk_class" field.
+func (bcru *BpjsContributionRateUpdate) SetRiskClass(i int) *BpjsContributionRateUpdate {
+	bcru.mutation.ResetRiskClass()
+	bcru.mutation.SetRiskClass(i)
+	return bcru
+}
+
+// SetNillableRiskClass sets the "risk_class" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableRiskClass(i *int) *BpjsContributionRateUpdate {
+	if i != nil {
+		bcru.SetRiskClass(*i)
+	}
+	return bcru
+}
+
+// AddRiskClass adds i to the "risk_class" field.
+func (bcru *BpjsContributionRateUpdate) AddRiskClass(i int) *BpjsContributionRateUpdate {
+	bcru.mutation.AddRiskClass(i)
+	return bcru
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (bcru *BpjsContributionRateUpdate) SetEffectiveFrom(t time.Time) *BpjsContributionRateUpdate {
+	bcru.mutation.SetEffectiveFrom(t)
+	return bcru
+}
+
+// SetEmployerRate sets the "employer_rate" field.
+func (bcru *BpjsContributionRateUpdate) SetEmployerRate(d decimal.Decimal) *BpjsContributionRateUpdate {
+	bcru.mutation.SetEmployerRate(d)
+	return bcru
+}
+
+// SetNillableEmployerRate sets the "employer_rate" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableEmployerRate(d *decimal.Decimal) *BpjsContributionRateUpdate {
+	if d != nil {
+		bcru.SetEmployerRate(*d)
+	}
+	return bcru
+}
+
+// SetEmployeeRate sets the "employee_rate" field.
+func (bcru *BpjsContributionRateUpdate) SetEmployeeRate(d decimal.Decimal) *BpjsContributionRateUpdate {
+	bcru.mutation.SetEmployeeRate(d)
+	return bcru
+}
+
+// SetNillableEmployeeRate sets the "employee_rate" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableEmployeeRate(d *decimal.Decimal) *BpjsContributionRateUpdate {
+	if d != nil {
+		bcru.SetEmployeeRate(*d)
+	}
+	return bcru
+}
+
+// SetWageCap sets the "wage_cap" field.
+func (bcru *BpjsContributionRateUpdate) SetWageCap(d decimal.Decimal) *BpjsContributionRateUpdate {
+	bcru.mutation.SetWageCap(d)
+	return bcru
+}
+
+// SetNillableWageCap sets the "wage_cap" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableWageCap(d *decimal.Decimal) *BpjsContributionRateUpdate {
+	if d != nil {
+		bcru.SetWageCap(*d)
+	}
+	return bcru
+}
+
+// ClearWageCap clears the value of the "wage_cap" field.
+func (bcru *BpjsContributionRateUpdate) ClearWageCap() *BpjsContributionRateUpdate {
+	bcru.mutation.ClearWageCap()
+	return bcru
+}
+
+// SetNotes sets the "notes" field.
+func (bcru *BpjsContributionRateUpdate) SetNotes(s string) *BpjsContributionRateUpdate {
+	bcru.mutation.SetNotes(s)
+	return bcru
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bcru *BpjsContributionRateUpdate) SetNillableNotes(s *string) *BpjsContributionRateUpdate {
+	if s != nil {
+		bcru.SetNotes(*s)
+	}
+	return bcru
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (bcru *BpjsContributionRateUpdate) ClearNotes() *BpjsContributionRateUpdate {
+	bcru.mutation.ClearNotes()
+	return bcru
+}
+
+// Mutation returns the BpjsContributionRateMutation object of the builder.
+func (bcru *BpjsContributionRateUpdate) Mutation() *BpjsContributionRateMutation {
+	return bcru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bcru *BpjsContributionRateUpdate) Save(ctx context.Context) (int, error) {
+	bcru.defaults()
+	return withHooks(ctx, bcru.sqlSave, bcru.mutation, bcru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcru *BpjsContributionRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := bcru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bcru *BpjsContributionRateUpdate) Exec(ctx context.Context) error {
+	_, err := bcru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcru *BpjsContributionRateUpdate) ExecX(ctx context.Context) {
+	if err := bcru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bcru *BpjsContributionRateUpdate) defaults() {
+	if _, ok := bcru.mutation.ModifiedAt(); !ok {
+		v := bpjscontributionrate.UpdateDefaultModifiedAt()
+		bcru.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcru *BpjsContributionRateUpdate) check() error {
+	if v, ok := bcru.mutation.Program(); ok {
+		if err := bpjscontributionrate.ProgramValidator(v); err != nil {
+			return &ValidationError{Name: "program", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.program": %w`, err)}
+		}
+	}
+	if v, ok := bcru.mutation.RiskClass(); ok {
+		if err := bpjscontributionrate.RiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "risk_class", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.risk_class": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bcru *BpjsContributionRateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BpjsContributionRateUpdate {
+	bcru.modifiers = append(bcru.modifiers, modifiers...)
+	return bcru
+}
+
+func (bcru *BpjsContributionRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bcru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bpjscontributionrate.Table, bpjscontributionrate.Columns, sqlgraph.NewFieldSpec(bpjscontributionrate.FieldID, field.TypeUint64))
+	if ps := bcru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcru.mutation.ModifiedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := bcru.mutation.DeletedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bcru.mutation.DeletedAtCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := bcru.mutation.Program(); ok {
+		_spec.SetField(bpjscontributionrate.FieldProgram, field.TypeEnum, value)
+	}
+	if value, ok := bcru.mutation.RiskClass(); ok {
+		_spec.SetField(bpjscontributionrate.FieldRiskClass, field.TypeInt, value)
+	}
+	if value, ok := bcru.mutation.AddedRiskClass(); ok {
+		_spec.AddField(bpjscontributionrate.FieldRiskClass, field.TypeInt, value)
+	}
+	if value, ok := bcru.mutation.EffectiveFrom(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := bcru.mutation.EmployerRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployerRate, field.TypeOther, value)
+	}
+	if value, ok := bcru.mutation.EmployeeRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployeeRate, field.TypeOther, value)
+	}
+	if value, ok := bcru.mutation.WageCap(); ok {
+		_spec.SetField(bpjscontributionrate.FieldWageCap, field.TypeOther, value)
+	}
+	if bcru.mutation.WageCapCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldWageCap, field.TypeOther)
+	}
+	if value, ok := bcru.mutation.Notes(); ok {
+		_spec.SetField(bpjscontributionrate.FieldNotes, field.TypeString, value)
+	}
+	if bcru.mutation.NotesCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(bcru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bcru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bpjscontributionrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bcru.mutation.done = true
+	return n, nil
+}
+
+// BpjsContributionRateUpdateOne is the builder for updating a single BpjsContributionRate entity.
+type BpjsContributionRateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BpjsContributionRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetModifiedAt(t time.Time) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetModifiedAt(t)
+	return bcruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetDeletedAt(t time.Time) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetDeletedAt(t)
+	return bcruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableDeletedAt(t *time.Time) *BpjsContributionRateUpdateOne {
+	if t != nil {
+		bcruo.SetDeletedAt(*t)
+	}
+	return bcruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bcruo *BpjsContributionRateUpdateOne) ClearDeletedAt() *BpjsContributionRateUpdateOne {
+	bcruo.mutation.ClearDeletedAt()
+	return bcruo
+}
+
+// SetProgram sets the "program" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetProgram(b bpjscontributionrate.Program) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetProgram(b)
+	return bcruo
+}
+
+// SetRiskClass sets the "risk_class" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetRiskClass(i int) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.ResetRiskClass()
+	bcruo.mutation.SetRiskClass(i)
+	return bcruo
+}
+
+// SetNillableRiskClass sets the "risk_class" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableRiskClass(i *int) *BpjsContributionRateUpdateOne {
+	if i != nil {
+		bcruo.SetRiskClass(*i)
+	}
+	return bcruo
+}
+
+// AddRiskClass adds i to the "risk_class" field.
+func (bcruo *BpjsContributionRateUpdateOne) AddRiskClass(i int) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.AddRiskClass(i)
+	return bcruo
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetEffectiveFrom(t time.Time) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetEffectiveFrom(t)
+	return bcruo
+}
+
+// SetEmployerRate sets the "employer_rate" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetEmployerRate(d decimal.Decimal) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetEmployerRate(d)
+	return bcruo
+}
+
+// SetNillableEmployerRate sets the "employer_rate" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableEmployerRate(d *decimal.Decimal) *BpjsContributionRateUpdateOne {
+	if d != nil {
+		bcruo.SetEmployerRate(*d)
+	}
+	return bcruo
+}
+
+// SetEmployeeRate sets the "employee_rate" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetEmployeeRate(d decimal.Decimal) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetEmployeeRate(d)
+	return bcruo
+}
+
+// SetNillableEmployeeRate sets the "employee_rate" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableEmployeeRate(d *decimal.Decimal) *BpjsContributionRateUpdateOne {
+	if d != nil {
+		bcruo.SetEmployeeRate(*d)
+	}
+	return bcruo
+}
+
+// SetWageCap sets the "wage_cap" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetWageCap(d decimal.Decimal) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetWageCap(d)
+	return bcruo
+}
+
+// SetNillableWageCap sets the "wage_cap" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableWageCap(d *decimal.Decimal) *BpjsContributionRateUpdateOne {
+	if d != nil {
+		bcruo.SetWageCap(*d)
+	}
+	return bcruo
+}
+
+// ClearWageCap clears the value of the "wage_cap" field.
+func (bcruo *BpjsContributionRateUpdateOne) ClearWageCap() *BpjsContributionRateUpdateOne {
+	bcruo.mutation.ClearWageCap()
+	return bcruo
+}
+
+// SetNotes sets the "notes" field.
+func (bcruo *BpjsContributionRateUpdateOne) SetNotes(s string) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.SetNotes(s)
+	return bcruo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bcruo *BpjsContributionRateUpdateOne) SetNillableNotes(s *string) *BpjsContributionRateUpdateOne {
+	if s != nil {
+		bcruo.SetNotes(*s)
+	}
+	return bcruo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (bcruo *BpjsContributionRateUpdateOne) ClearNotes() *BpjsContributionRateUpdateOne {
+	bcruo.mutation.ClearNotes()
+	return bcruo
+}
+
+// Mutation returns the BpjsContributionRateMutation object of the builder.
+func (bcruo *BpjsContributionRateUpdateOne) Mutation() *BpjsContributionRateMutation {
+	return bcruo.mutation
+}
+
+// Where appends a list predicates to the BpjsContributionRateUpdate builder.
+func (bcruo *BpjsContributionRateUpdateOne) Where(ps ...predicate.BpjsContributionRate) *BpjsContributionRateUpdateOne {
+	bcruo.mutation.Where(ps...)
+	return bcruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bcruo *BpjsContributionRateUpdateOne) Select(field string, fields ...string) *BpjsContributionRateUpdateOne {
+	bcruo.fields = append([]string{field}, fields...)
+	return bcruo
+}
+
+// Save executes the query and returns the updated BpjsContributionRate entity.
+func (bcruo *BpjsContributionRateUpdateOne) Save(ctx context.Context) (*BpjsContributionRate, error) {
+	bcruo.defaults()
+	return withHooks(ctx, bcruo.sqlSave, bcruo.mutation, bcruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcruo *BpjsContributionRateUpdateOne) SaveX(ctx context.Context) *BpjsContributionRate {
+	node, err := bcruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bcruo *BpjsContributionRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := bcruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcruo *BpjsContributionRateUpdateOne) ExecX(ctx context.Context) {
+	if err := bcruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bcruo *BpjsContributionRateUpdateOne) defaults() {
+	if _, ok := bcruo.mutation.ModifiedAt(); !ok {
+		v := bpjscontributionrate.UpdateDefaultModifiedAt()
+		bcruo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcruo *BpjsContributionRateUpdateOne) check() error {
+	if v, ok := bcruo.mutation.Program(); ok {
+		if err := bpjscontributionrate.ProgramValidator(v); err != nil {
+			return &ValidationError{Name: "program", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.program": %w`, err)}
+		}
+	}
+	if v, ok := bcruo.mutation.RiskClass(); ok {
+		if err := bpjscontributionrate.RiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "risk_class", err: fmt.Errorf(`ent: validator failed for field "BpjsContributionRate.risk_class": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bcruo *BpjsContributionRateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BpjsContributionRateUpdateOne {
+	bcruo.modifiers = append(bcruo.modifiers, modifiers...)
+	return bcruo
+}
+
+func (bcruo *BpjsContributionRateUpdateOne) sqlSave(ctx context.Context) (_node *BpjsContributionRate, err error) {
+	if err := bcruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bpjscontributionrate.Table, bpjscontributionrate.Columns, sqlgraph.NewFieldSpec(bpjscontributionrate.FieldID, field.TypeUint64))
+	id, ok := bcruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BpjsContributionRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bcruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bpjscontributionrate.FieldID)
+		for _, f := range fields {
+			if !bpjscontributionrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bpjscontributionrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bcruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcruo.mutation.ModifiedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := bcruo.mutation.DeletedAt(); ok {
+		_spec.SetField(bpjscontributionrate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bcruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := bcruo.mutation.Program(); ok {
+		_spec.SetField(bpjscontributionrate.FieldProgram, field.TypeEnum, value)
+	}
+	if value, ok := bcruo.mutation.RiskClass(); ok {
+		_spec.SetField(bpjscontributionrate.FieldRiskClass, field.TypeInt, value)
+	}
+	if value, ok := bcruo.mutation.AddedRiskClass(); ok {
+		_spec.AddField(bpjscontributionrate.FieldRiskClass, field.TypeInt, value)
+	}
+	if value, ok := bcruo.mutation.EffectiveFrom(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := bcruo.mutation.EmployerRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployerRate, field.TypeOther, value)
+	}
+	if value, ok := bcruo.mutation.EmployeeRate(); ok {
+		_spec.SetField(bpjscontributionrate.FieldEmployeeRate, field.TypeOther, value)
+	}
+	if value, ok := bcruo.mutation.WageCap(); ok {
+		_spec.SetField(bpjscontributionrate.FieldWageCap, field.TypeOther, value)
+	}
+	if bcruo.mutation.WageCapCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldWageCap, field.TypeOther)
+	}
+	if value, ok := bcruo.mutation.Notes(); ok {
+		_spec.SetField(bpjscontributionrate.FieldNotes, field.TypeString, value)
+	}
+	if bcruo.mutation.NotesCleared() {
+		_spec.ClearField(bpjscontributionrate.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(bcruo.modifiers...)
+	_node = &BpjsContributionRate{config: bcruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bcruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bpjscontributionrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bcruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/employee"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CalendarFeed is the model entity for the CalendarFeed schema.
+type CalendarFeed struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Whose attendance and leave the feed shows, holidays are shown in every feed
+	Scope calendarfeed.Scope `json:"scope,omitempty"`
+	// Employee of an employee feed
+	EmployeeID *uint64 `json:"employee_id,omitempty"`
+	// Department of a department feed
+	Department string `json:"department,omitempty"`
+	// Random token in the feed URL, calendar apps cannot send credentials
+	Token string `json:"-"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Last time a calendar app fetched the feed
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CalendarFeedQuery when eager-loading is set.
+	Edges        CalendarFeedEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CalendarFeedEdges holds the relations/edges for other nodes in the graph.
+type CalendarFeedEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CalendarFeedEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[0] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CalendarFeed) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case calendarfeed.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case calendarfeed.FieldID, calendarfeed.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case calendarfeed.FieldName, calendarfeed.FieldScope, calendarfeed.FieldDepartment, calendarfeed.FieldToken:
+			values[i] = new(sql.NullString)
+		case calendarfeed.FieldCreatedAt, calendarfeed.FieldModifiedAt, calendarfeed.FieldDeletedAt, calendarfeed.FieldLastAccessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CalendarFeed fields.
+func (cf *CalendarFeed) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case calendarfeed.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cf.ID = uint64(value.Int64)
+		case calendarfeed.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cf.CreatedAt = value.Time
+			}
+		case calendarfeed.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				cf.ModifiedAt = value.Time
+			}
+		case calendarfeed.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				cf.DeletedAt = value.Time
+			}
+		case calendarfeed.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cf.Name = value.String
+			}
+		case calendarfeed.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				cf.Scope = calendarfeed.Scope(value.String)
+			}
+		case calendarfeed.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				cf.EmployeeID = new(uint64)
+				*cf.EmployeeID = uint64(value.Int64)
+			}
+		case calendarfeed.FieldDepartment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field department", values[i])
+			} else if value.Valid {
+				cf.Department = value.String
+			}
+		case calendarfeed.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				cf.Token = value.String
+			}
+		case calendarfeed.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				cf.IsActive = value.Bool
+			}
+		case calendarfeed.FieldLastAccessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_accessed_at", values[i])
+			} else if value.Valid {
+				cf.LastAccessedAt = new(time.Time)
+				*cf.LastAccessedAt = value.Time
+			}
+		default:
+			cf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CalendarFeed.
+// This includes values selected through modifiers, order, etc.
+func (cf *CalendarFeed) Value(name string) (ent.Value, error) {
+	return cf.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the CalendarFeed entity.
+func (cf *CalendarFeed) QueryEmployee() *EmployeeQuery {
+	return NewCalendarFeedClient(cf.config).QueryEmployee(cf)
+}
+
+// Update returns a builder for updating this CalendarFeed.
+// Note that you need to call CalendarFeed.Unwrap() before calling this method if this CalendarFeed
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cf *CalendarFeed) Update() *CalendarFeedUpdateOne {
+	return NewCalendarFeedClient(cf.config).UpdateOne(cf)
+}
+
+// Unwrap unwraps the CalendarFeed entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cf *CalendarFeed) Unwrap() *CalendarFeed {
+	_tx, ok := cf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CalendarFeed is not a transactional entity")
+	}
+	cf.config.driver = _tx.drv
+	return cf
+}
+
+// String implements the fmt.Stringer.
+func (cf *CalendarFeed) String() string {
+	var builder strings.Builder
+	builder.WriteString("CalendarFeed(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cf.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(cf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(cf.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(cf.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cf.Name)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", cf.Scope))
+	builder.WriteString(", ")
+	if v := cf.EmployeeID; v != nil {
+		builder.WriteString("employee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("department=")
+	builder.WriteString(cf.Department)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", cf.IsActive))
+	builder.WriteString(", ")
+	if v := cf.LastAccessedAt; v != nil {
+		builder.WriteString("last_accessed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CalendarFeeds is a parsable slice of CalendarFeed.
+type CalendarFeeds []*CalendarFeed
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/employee"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarFeedCreate is the builder for creating a CalendarFeed entity.
+type CalendarFeedCreate struct {
+	config
+	mutation *CalendarFeedMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfc *CalendarFeedCreate) SetCreatedAt(t time.Time) *CalendarFeedCreate {
+	cfc.mutation.SetCreatedAt(t)
+	return cfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableCreatedAt(t *time.Time) *CalendarFeedCreate {
+	if t != nil {
+		cfc.SetCreatedAt(*t)
+	}
+	return cfc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (cfc *CalendarFeedCreate) SetModifiedAt(t time.Time) *CalendarFeedCreate {
+	cfc.mutation.SetModifiedAt(t)
+	return cfc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableModifiedAt(t *time.Time) *CalendarFeedCreate {
+	if t != nil {
+		cfc.SetModifiedAt(*t)
+	}
+	return cfc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfc *CalendarFeedCreate) SetDeletedAt(t time.Time) *CalendarFeedCreate {
+	cfc.mutation.SetDeletedAt(t)
+	return cfc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableDeletedAt(t *time.Time) *CalendarFeedCreate {
+	if t != nil {
+		cfc.SetDeletedAt(*t)
+	}
+	return cfc
+}
+
+// SetName sets the "name" field.
+func (cfc *CalendarFeedCreate) SetName(s string) *CalendarFeedCreate {
+	cfc.mutation.SetName(s)
+	return cfc
+}
+
+// SetScope sets the "scope" field.
+func (cfc *CalendarFeedCreate) SetScope(c calendarfeed.Scope) *CalendarFeedCreate {
+	cfc.mutation.SetScope(c)
+	return cfc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (cfc *CalendarFeedCreate) SetEmployeeID(u uint64) *CalendarFeedCreate {
+	cfc.mutation.SetEmployeeID(u)
+	return cfc
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableEmployeeID(u *uint64) *CalendarFeedCreate {
+	if u != nil {
+		cfc.SetEmployeeID(*u)
+	}
+	return cfc
+}
+
+// SetDepartment sets the "department" field.
+func (cfc *CalendarFeedCreate) SetDepartment(s string) *CalendarFeedCreate {
+	cfc.mutation.SetDepartment(s)
+	return cfc
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableDepartment(s *string) *CalendarFeedCreate {
+	if s != nil {
+		cfc.SetDepartment(*s)
+	}
+	return cfc
+}
+
+// SetToken sets the "token" field.
+func (cfc *CalendarFeedCreate) SetToken(s string) *CalendarFeedCreate {
+	cfc.mutation.SetToken(s)
+	return cfc
+}
+
+// SetIsActive sets the "is_active" field.
+func (cfc *CalendarFeedCreate) SetIsActive(b bool) *CalendarFeedCreate {
+	cfc.mutation.SetIsActive(b)
+	return cfc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableIsActive(b *bool) *CalendarFeedCreate {
+	if b != nil {
+		cfc.SetIsActive(*b)
+	}
+	return cfc
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (cfc *CalendarFeedCreate) SetLastAccessedAt(t time.Time) *CalendarFeedCreate {
+	cfc.mutation.SetLastAccessedAt(t)
+	return cfc
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (cfc *CalendarFeedCreate) SetNillableLastAccessedAt(t *time.Time) *CalendarFeedCreate {
+	if t != nil {
+		cfc.SetLastAccessedAt(*t)
+	}
+	return cfc
+}
+
+// SetID sets the "id" field.
+func (cfc *CalendarFeedCreate) SetID(u uint64) *CalendarFeedCreate {
+	cfc.mutation.SetID(u)
+	return cfc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (cfc *CalendarFeedCreate) SetEmployee(e *Employee) *CalendarFeedCreate {
+	return cfc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the CalendarFeedMutation object of the builder.
+func (cfc *CalendarFeedCreate) Mutation() *CalendarFeedMutation {
+	return cfc.mutation
+}
+
+// Save creates the CalendarFeed in the database.
+func (cfc *CalendarFeedCreate) Save(ctx context.Context) (*CalendarFeed, error) {
+	cfc.defaults()
+	return withHooks(ctx, cfc.sqlSave, cfc.mutation, cfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfc *CalendarFeedCreate) SaveX(ctx context.Context) *CalendarFeed {
+	v, err := cfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfc *CalendarFeedCreate) Exec(ctx context.Context) error {
+	_, err := cfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfc *CalendarFeedCreate) ExecX(ctx context.Context) {
+	if err := cfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfc *CalendarFeedCreate) defaults() {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		v := calendarfeed.DefaultCreatedAt()
+		cfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cfc.mutation.ModifiedAt(); !ok {
+		v := calendarfeed.DefaultModifiedAt()
+		cfc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := cfc.mutation.IsActive(); !ok {
+		v := calendarfeed.DefaultIsActive
+		cfc.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfc *CalendarFeedCreate) check() error {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CalendarFeed.created_at"`)}
+	}
+	if _, ok := cfc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "CalendarFeed.modified_at"`)}
+	}
+	if _, ok := cfc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CalendarFeed.name"`)}
+	}
+	if v, ok := cfc.mutation.Name(); ok {
+		if err := calendarfeed.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.name": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "CalendarFeed.scope"`)}
+	}
+	if v, ok := cfc.mutation.Scope(); ok {
+		if err := calendarfeed.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.scope": %w`, err)}
+		}
+	}
+	if v, ok := cfc.mutation.Department(); ok {
+		if err := calendarfeed.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.department": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "CalendarFeed.token"`)}
+	}
+	if v, ok := cfc.mutation.Token(); ok {
+		if err := calendarfeed.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.token": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "CalendarFeed.is_active"`)}
+	}
+	return nil
+}
+
+func (cfc *CalendarFeedCreate) sqlSave(ctx context.Context) (*CalendarFeed, error) {
+	if err := cfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	cfc.mutation.id = &_node.ID
+	cfc.mutation.done = true
+	return _node, nil
+}
+
+func (cfc *CalendarFeedCreate) createSpec() (*CalendarFeed, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CalendarFeed{config: cfc.config}
+		_spec = sqlgraph.NewCreateSpec(calendarfeed.Table, sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64))
+	)
+	if id, ok := cfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cfc.mutation.CreatedAt(); ok {
+		_spec.SetField(calendarfeed.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cfc.mutation.ModifiedAt(); ok {
+		_spec.SetField(calendarfeed.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := cfc.mutation.DeletedAt(); ok {
+		_spec.SetField(calendarfeed.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := cfc.mutation.Name(); ok {
+		_spec.SetField(calendarfeed.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cfc.mutation.Scope(); ok {
+		_spec.SetField(calendarfeed.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := cfc.mutation.Department(); ok {
+		_spec.SetField(calendarfeed.FieldDepartment, field.TypeString, value)
+		_node.Department = value
+	}
+	if value, ok := cfc.mutation.Token(); ok {
+		_spec.SetField(calendarfeed.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := cfc.mutation.IsActive(); ok {
+		_spec.SetField(calendarfeed.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := cfc.mutation.LastAccessedAt(); ok {
+		_spec.SetField(calendarfeed.FieldLastAccessedAt, field.TypeTime, value)
+		_node.LastAccessedAt = &value
+	}
+	if nodes := cfc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calendarfeed.EmployeeTable,
+			Columns: []string{calendarfeed.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CalendarFeedCreateBulk is the builder for creating many CalendarFeed entities in bulk.
+type CalendarFeedCreateBulk struct {
+	config
+	builders []*CalendarFeedCreate
+}
+
+// Save creates the CalendarFeed entities in the database.
+func (cfcb *CalendarFeedCreateBulk) Save(ctx context.Context) ([]*CalendarFeed, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cfcb.builders))
+	nodes := make([]*CalendarFeed, len(cfcb.builders))
+	mutators := make([]Mutator, len(cfcb.builders))
+	for i := range cfcb.builders {
+		func(i int, root context.Context) {
+			builder := cfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CalendarFeedMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfcb *CalendarFeedCreateBulk) SaveX(ctx context.Context) []*CalendarFeed {
+	v, err := cfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfcb *CalendarFeedCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfcb *CalendarFeedCreateBulk) ExecX(ctx context.Context) {
+	if err := cfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
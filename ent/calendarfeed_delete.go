@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarFeedDelete is the builder for deleting a CalendarFeed entity.
+type CalendarFeedDelete struct {
+	config
+	hooks    []Hook
+	mutation *CalendarFeedMutation
+}
+
+// Where appends a list predicates to the CalendarFeedDelete builder.
+func (cfd *CalendarFeedDelete) Where(ps ...predicate.CalendarFeed) *CalendarFeedDelete {
+	cfd.mutation.Where(ps...)
+	return cfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cfd *CalendarFeedDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfd.sqlExec, cfd.mutation, cfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfd *CalendarFeedDelete) ExecX(ctx context.Context) int {
+	n, err := cfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cfd *CalendarFeedDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(calendarfeed.Table, sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64))
+	if ps := cfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cfd.mutation.done = true
+	return affected, err
+}
+
+// CalendarFeedDeleteOne is the builder for deleting a single CalendarFeed entity.
+type CalendarFeedDeleteOne struct {
+	cfd *CalendarFeedDelete
+}
+
+// Where appends a list predicates to the CalendarFeedDelete builder.
+func (cfdo *CalendarFeedDeleteOne) Where(ps ...predicate.CalendarFeed) *CalendarFeedDeleteOne {
+	cfdo.cfd.mutation.Where(ps...)
+	return cfdo
+}
+
+// Exec executes the deletion query.
+func (cfdo *CalendarFeedDeleteOne) Exec(ctx context.Context) error {
+	n, err := cfdo.cfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{calendarfeed.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfdo *CalendarFeedDeleteOne) ExecX(ctx context.Context) {
+	if err := cfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarFeedQuery is the builder for querying CalendarFeed entities.
+type CalendarFeedQuery struct {
+	config
+	ctx          *QueryContext
+	order        []calendarfeed.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.CalendarFeed
+	withEmployee *EmployeeQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CalendarFeedQuery builder.
+func (cfq *CalendarFeedQuery) Where(ps ...predicate.CalendarFeed) *CalendarFeedQuery {
+	cfq.predicates = append(cfq.predicates, ps...)
+	return cfq
+}
+
+// Limit the number of records to be returned by this query.
+func (cfq *CalendarFeedQuery) Limit(limit int) *CalendarFeedQuery {
+	cfq.ctx.Limit = &limit
+	return cfq
+}
+
+// Offset to start from.
+func (cfq *CalendarFeedQuery) Offset(offset int) *CalendarFeedQuery {
+	cfq.ctx.Offset = &offset
+	return cfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfq *CalendarFeedQuery) Unique(unique bool) *CalendarFeedQuery {
+	cfq.ctx.Unique = &unique
+	return cfq
+}
+
+// Order specifies how the records should be ordered.
+func (cfq *CalendarFeedQuery) Order(o ...calendarfeed.OrderOption) *CalendarFeedQuery {
+	cfq.order = append(cfq.order, o...)
+	return cfq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (cfq *CalendarFeedQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(calendarfeed.Table, calendarfeed.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, calendarfeed.EmployeeTable, calendarfeed.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CalendarFeed entity from the query.
+// Returns a *NotFoundError when no CalendarFeed was found.
+func (cfq *CalendarFeedQuery) First(ctx context.Context) (*CalendarFeed, error) {
+	nodes, err := cfq.Limit(1).All(setContextOp(ctx, cfq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{calendarfeed.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) FirstX(ctx context.Context) *CalendarFeed {
+	node, err := cfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CalendarFeed ID from the query.
+// Returns a *NotFoundError when no CalendarFeed ID was found.
+func (cfq *CalendarFeedQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = cfq.Limit(1).IDs(setContextOp(ctx, cfq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{calendarfeed.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := cfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CalendarFeed entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CalendarFeed entity is found.
+// Returns a *NotFoundError when no CalendarFeed entities are found.
+func (cfq *CalendarFeedQuery) Only(ctx context.Context) (*CalendarFeed, error) {
+	nodes, err := cfq.Limit(2).All(setContextOp(ctx, cfq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{calendarfeed.Label}
+	default:
+		return nil, &NotSingularError{calendarfeed.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) OnlyX(ctx context.Context) *CalendarFeed {
+	node, err := cfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CalendarFeed ID in the query.
+// Returns a *NotSingularError when more than one CalendarFeed ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfq *CalendarFeedQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = cfq.Limit(2).IDs(setContextOp(ctx, cfq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{calendarfeed.Label}
+	default:
+		err = &NotSingularError{calendarfeed.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := cfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CalendarFeeds.
+func (cfq *CalendarFeedQuery) All(ctx context.Context) ([]*CalendarFeed, error) {
+	ctx = setContextOp(ctx, cfq.ctx, "All")
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CalendarFeed, *CalendarFeedQuery]()
+	return withInterceptors[[]*CalendarFeed](ctx, cfq, qr, cfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) AllX(ctx context.Context) []*CalendarFeed {
+	nodes, err := cfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CalendarFeed IDs.
+func (cfq *CalendarFeedQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if cfq.ctx.Unique == nil && cfq.path != nil {
+		cfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cfq.ctx, "IDs")
+	if err = cfq.Select(calendarfeed.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := cfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfq *CalendarFeedQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cfq.ctx, "Count")
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cfq, querierCount[*CalendarFeedQuery](), cfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) CountX(ctx context.Context) int {
+	count, err := cfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfq *CalendarFeedQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cfq.ctx, "Exist")
+	switch _, err := cfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfq *CalendarFeedQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CalendarFeedQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfq *CalendarFeedQuery) Clone() *CalendarFeedQuery {
+	if cfq == nil {
+		return nil
+	}
+	return &CalendarFeedQuery{
+		config:       cfq.config,
+		ctx:          cfq.ctx.Clone(),
+		order:        append([]calendarfeed.OrderOption{}, cfq.order...),
+		inters:       append([]Interceptor{}, cfq.inters...),
+		predicates:   append([]predicate.CalendarFeed{}, cfq.predicates...),
+		withEmployee: cfq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  cfq.sql.Clone(),
+		path: cfq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *CalendarFeedQuery) WithEmployee(opts ...func(*EmployeeQuery)) *CalendarFeedQuery {
+	query := (&EmployeeClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withEmployee = query
+	return cfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CalendarFeed.Query().
+//		GroupBy(calendarfeed.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cfq *CalendarFeedQuery) GroupBy(field string, fields ...string) *CalendarFeedGroupBy {
+	cfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CalendarFeedGroupBy{build: cfq}
+	grbuild.flds = &cfq.ctx.Fields
+	grbuild.label = calendarfeed.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CalendarFeed.Query().
+//		Select(calendarfeed.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (cfq *CalendarFeedQuery) Select(fields ...string) *CalendarFeedSelect {
+	cfq.ctx.Fields = append(cfq.ctx.Fields, fields...)
+	sbuild := &CalendarFeedSelect{CalendarFeedQuery: cfq}
+	sbuild.label = calendarfeed.Label
+	sbuild.flds, sbuild.scan = &cfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CalendarFeedSelect configured with the given aggregations.
+func (cfq *CalendarFeedQuery) Aggregate(fns ...AggregateFunc) *CalendarFeedSelect {
+	return cfq.Select().Aggregate(fns...)
+}
+
+func (cfq *CalendarFeedQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cfq.ctx.Fields {
+		if !calendarfeed.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfq.path != nil {
+		prev, err := cfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfq.sql = prev
+	}
+	return nil
+}
+
+func (cfq *CalendarFeedQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CalendarFeed, error) {
+	var (
+		nodes       = []*CalendarFeed{}
+		_spec       = cfq.querySpec()
+		loadedTypes = [1]bool{
+			cfq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CalendarFeed).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CalendarFeed{config: cfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cfq.modifiers) > 0 {
+		_spec.Modifiers = cfq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cfq.withEmployee; query != nil {
+		if err := cfq.loadEmployee(ctx, query, nodes, nil,
+			func(n *CalendarFeed, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cfq *CalendarFeedQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*CalendarFeed, init func(*CalendarFeed), assign func(*CalendarFeed, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*CalendarFeed)
+	for i := range nodes {
+		if nodes[i].EmployeeID == nil {
+			continue
+		}
+		fk := *nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cfq *CalendarFeedQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfq.querySpec()
+	if len(cfq.modifiers) > 0 {
+		_spec.Modifiers = cfq.modifiers
+	}
+	_spec.Node.Columns = cfq.ctx.Fields
+	if len(cfq.ctx.Fields) > 0 {
+		_spec.Unique = cfq.ctx.Unique != nil && *cfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cfq.driver, _spec)
+}
+
+func (cfq *CalendarFeedQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(calendarfeed.Table, calendarfeed.Columns, sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64))
+	_spec.From = cfq.sql
+	if unique := cfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, calendarfeed.FieldID)
+		for i := range fields {
+			if fields[i] != calendarfeed.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cfq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(calendarfeed.FieldEmployeeID)
+		}
+	}
+	if ps := cfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfq *CalendarFeedQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfq.driver.Dialect())
+	t1 := builder.Table(calendarfeed.Table)
+	columns := cfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = calendarfeed.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfq.sql != nil {
+		selector = cfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfq.ctx.Unique != nil && *cfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cfq.modifiers {
+		m(selector)
+	}
+	for _, p := range cfq.predicates {
+		p(selector)
+	}
+	for _, p := range cfq.order {
+		p(selector)
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cfq *CalendarFeedQuery) Modify(modifiers ...func(s *sql.Selector)) *CalendarFeedSelect {
+	cfq.modifiers = append(cfq.modifiers, modifiers...)
+	return cfq.Select()
+}
+
+// CalendarFeedGroupBy is the group-by builder for CalendarFeed entities.
+type CalendarFeedGroupBy struct {
+	selector
+	build *CalendarFeedQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfgb *CalendarFeedGroupBy) Aggregate(fns ...AggregateFunc) *CalendarFeedGroupBy {
+	cfgb.fns = append(cfgb.fns, fns...)
+	return cfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfgb *CalendarFeedGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfgb.build.ctx, "GroupBy")
+	if err := cfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CalendarFeedQuery, *CalendarFeedGroupBy](ctx, cfgb.build, cfgb, cfgb.build.inters, v)
+}
+
+func (cfgb *CalendarFeedGroupBy) sqlScan(ctx context.Context, root *CalendarFeedQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cfgb.fns))
+	for _, fn := range cfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cfgb.flds)+len(cfgb.fns))
+		for _, f := range *cfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CalendarFeedSelect is the builder for selecting fields of CalendarFeed entities.
+type CalendarFeedSelect struct {
+	*CalendarFeedQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cfs *CalendarFeedSelect) Aggregate(fns ...AggregateFunc) *CalendarFeedSelect {
+	cfs.fns = append(cfs.fns, fns...)
+	return cfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfs *CalendarFeedSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfs.ctx, "Select")
+	if err := cfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CalendarFeedQuery, *CalendarFeedSelect](ctx, cfs.CalendarFeedQuery, cfs, cfs.inters, v)
+}
+
+func (cfs *CalendarFeedSelect) sqlScan(ctx context.Context, root *CalendarFeedQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cfs.fns))
+	for _, fn := range cfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cfs *CalendarFeedSelect) Modify(modifiers ...func(s *sql.Selector)) *CalendarFeedSelect {
+	cfs.modifiers = append(cfs.modifiers, modifiers...)
+	return cfs
+}
@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CalendarFeedUpdate is the builder for updating CalendarFeed entities.
+type CalendarFeedUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CalendarFeedMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CalendarFeedUpdate builder.
+func (cfu *CalendarFeedUpdate) Where(ps ...predicate.CalendarFeed) *CalendarFeedUpdate {
+	cfu.mutation.Where(ps...)
+	return cfu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (cfu *CalendarFeedUpdate) SetModifiedAt(t time.Time) *CalendarFeedUpdate {
+	cfu.mutation.SetModifiedAt(t)
+	return cfu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfu *CalendarFeedUpdate) SetDeletedAt(t time.Time) *CalendarFeedUpdate {
+	cfu.mutation.SetDeletedAt(t)
+	return cfu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfu *CalendarFeedUpdate) SetNillableDeletedAt(t *time.Time) *CalendarFeedUpdate {
+	if t != nil {
+		cfu.SetDeletedAt(*t)
+	}
+	return cfu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfu *CalendarFeedUpdate) ClearDeletedAt() *CalendarFeedUpdate {
+	cfu.mutation.ClearDeletedAt()
+	return cfu
+}
+
+// SetName sets the "name" field.
+func (cfu *CalendarFeedUpdate) SetName(s string) *CalendarFeedUpdate {
+	cfu.mutation.SetName(s)
+	return cfu
+}
+
+// SetScope sets the "scope" field.
+func (cfu *CalendarFeedUpdate) SetScope(c calendarfeed.Scope) *CalendarFeedUpdate {
+	cfu.mutation.SetScope(c)
+	return cfu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (cfu *CalendarFeedUpdate) SetEmployeeID(u uint64) *CalendarFeedUpdate {
+	cfu.mutation.SetEmployeeID(u)
+	return cfu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (cfu *CalendarFeedUpdate) SetNillableEmployeeID(u *uint64) *CalendarFeedUpdate {
+	if u != nil {
+		cfu.SetEmployeeID(*u)
+	}
+	return cfu
+}
+
+// ClearEmployeeID clears the value of the "employee_id" field.
+func (cfu *CalendarFeedUpdate) ClearEmployeeID() *CalendarFeedUpdate {
+	cfu.mutation.ClearEmployeeID()
+	return cfu
+}
+
+// SetDepartment sets the "department" field.
+func (cfu *CalendarFeedUpdate) SetDepartment(s string) *CalendarFeedUpdate {
+	cfu.mutation.SetDepartment(s)
+	return cfu
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (cfu *CalendarFeedUpdate) SetNillableDepartment(s *string) *CalendarFeedUpdate {
+	if s != nil {
+		cfu.SetDepartment(*s)
+	}
+	return cfu
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (cfu *CalendarFeedUpdate) ClearDepartment() *CalendarFeedUpdate {
+	cfu.mutation.ClearDepartment()
+	return cfu
+}
+
+// SetToken sets the "token" field.
+func (cfu *CalendarFeedUpdate) SetToken(s string) *CalendarFeedUpdate {
+	cfu.mutation.SetToken(s)
+	return cfu
+}
+
+// SetIsActive sets the "is_active" field.
+func (cfu *CalendarFeedUpdate) SetIsActive(b bool) *CalendarFeedUpdate {
+	cfu.mutation.SetIsActive(b)
+	return cfu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cfu *CalendarFeedUpdate) SetNillableIsActive(b *bool) *CalendarFeedUpdate {
+	if b != nil {
+		cfu.SetIsActive(*b)
+	}
+	return cfu
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (cfu *CalendarFeedUpdate) SetLastAccessedAt(t time.Time) *CalendarFeedUpdate {
+	cfu.mutation.SetLastAccessedAt(t)
+	return cfu
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (cfu *CalendarFeedUpdate) SetNillableLastAccessedAt(t *time.Time) *CalendarFeedUpdate {
+	if t != nil {
+		cfu.SetLastAccessedAt(*t)
+	}
+	return cfu
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (cfu *CalendarFeedUpdate) ClearLastAccessedAt() *CalendarFeedUpdate {
+	cfu.mutation.ClearLastAccessedAt()
+	return cfu
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (cfu *CalendarFeedUpdate) SetEmployee(e *Employee) *CalendarFeedUpdate {
+	return cfu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the CalendarFeedMutation object of the builder.
+func (cfu *CalendarFeedUpdate) Mutation() *CalendarFeedMutation {
+	return cfu.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (cfu *CalendarFeedUpdate) ClearEmployee() *CalendarFeedUpdate {
+	cfu.mutation.ClearEmployee()
+	return cfu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfu *CalendarFeedUpdate) Save(ctx context.Context) (int, error) {
+	cfu.defaults()
+	return withHooks(ctx, cfu.sqlSave, cfu.mutation, cfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfu *CalendarFeedUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfu *CalendarFeedUpdate) Exec(ctx context.Context) error {
+	_, err := cfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfu *CalendarFeedUpdate) ExecX(ctx context.Context) {
+	if err := cfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfu *CalendarFeedUpdate) defaults() {
+	if _, ok := cfu.mutation.ModifiedAt(); !ok {
+		v := calendarfeed.UpdateDefaultModifiedAt()
+		cfu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfu *CalendarFeedUpdate) check() error {
+	if v, ok := cfu.mutation.Name(); ok {
+		if err := calendarfeed.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.name": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Scope(); ok {
+		if err := calendarfeed.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.scope": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Department(); ok {
+		if err := calendarfeed.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.department": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Token(); ok {
+		if err := calendarfeed.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cfu *CalendarFeedUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CalendarFeedUpdate {
+	cfu.modifiers = append(cfu.modifiers, modifiers...)
+	return cfu
+}
+
+func (cfu *CalendarFeedUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(calendarfeed.Table, calendarfeed.Columns, sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64))
+	if ps := cfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfu.mutation.ModifiedAt(); ok {
+		_spec.SetField(calendarfeed.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := cfu.mutation.DeletedAt(); ok {
+		_spec.SetField(calendarfeed.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cfu.mutation.DeletedAtCleared() {
+		_spec.ClearField(calendarfeed.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cfu.mutation.Name(); ok {
+		_spec.SetField(calendarfeed.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.Scope(); ok {
+		_spec.SetField(calendarfeed.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := cfu.mutation.Department(); ok {
+		_spec.SetField(calendarfeed.FieldDepartment, field.TypeString, value)
+	}
+	if cfu.mutation.DepartmentCleared() {
+		_spec.ClearField(calendarfeed.FieldDepartment, field.TypeString)
+	}
+	if value, ok := cfu.mutation.Token(); ok {
+		_spec.SetField(calendarfeed.FieldToken, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.IsActive(); ok {
+		_spec.SetField(calendarfeed.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := cfu.mutation.LastAccessedAt(); ok {
+		_spec.SetField(calendarfeed.FieldLastAccessedAt, field.TypeTime, value)
+	}
+	if cfu.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(calendarfeed.FieldLastAccessedAt, field.TypeTime)
+	}
+	if cfu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calendarfeed.EmployeeTable,
+			Columns: []string{calendarfeed.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calendarfeed.EmployeeTable,
+			Columns: []string{calendarfeed.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cfu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calendarfeed.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cfu.mutation.done = true
+	return n, nil
+}
+
+// CalendarFeedUpdateOne is the builder for updating a single CalendarFeed entity.
+type CalendarFeedUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CalendarFeedMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (cfuo *CalendarFeedUpdateOne) SetModifiedAt(t time.Time) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetModifiedAt(t)
+	return cfuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfuo *CalendarFeedUpdateOne) SetDeletedAt(t time.Time) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetDeletedAt(t)
+	return cfuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfuo *CalendarFeedUpdateOne) SetNillableDeletedAt(t *time.Time) *CalendarFeedUpdateOne {
+	if t != nil {
+		cfuo.SetDeletedAt(*t)
+	}
+	return cfuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfuo *CalendarFeedUpdateOne) ClearDeletedAt() *CalendarFeedUpdateOne {
+	cfuo.mutation.ClearDeletedAt()
+	return cfuo
+}
+
+// SetName sets the "name" field.
+func (cfuo *CalendarFeedUpdateOne) SetName(s string) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetName(s)
+	return cfuo
+}
+
+// SetScope sets the "scope" field.
+func (cfuo *CalendarFeedUpdateOne) SetScope(c calendarfeed.Scope) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetScope(c)
+	return cfuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (cfuo *CalendarFeedUpdateOne) SetEmployeeID(u uint64) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetEmployeeID(u)
+	return cfuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (cfuo *CalendarFeedUpdateOne) SetNillableEmployeeID(u *uint64) *CalendarFeedUpdateOne {
+	if u != nil {
+		cfuo.SetEmployeeID(*u)
+	}
+	return cfuo
+}
+
+// ClearEmployeeID clears the value of the "employee_id" field.
+func (cfuo *CalendarFeedUpdateOne) ClearEmployeeID() *CalendarFeedUpdateOne {
+	cfuo.mutation.ClearEmployeeID()
+	return cfuo
+}
+
+// SetDepartment sets the "department" field.
+func (cfuo *CalendarFeedUpdateOne) SetDepartment(s string) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetDepartment(s)
+	return cfuo
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (cfuo *CalendarFeedUpdateOne) SetNillableDepartment(s *string) *CalendarFeedUpdateOne {
+	if s != nil {
+		cfuo.SetDepartment(*s)
+	}
+	return cfuo
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (cfuo *CalendarFeedUpdateOne) ClearDepartment() *CalendarFeedUpdateOne {
+	cfuo.mutation.ClearDepartment()
+	return cfuo
+}
+
+// SetToken sets the "token" field.
+func (cfuo *CalendarFeedUpdateOne) SetToken(s string) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetToken(s)
+	return cfuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (cfuo *CalendarFeedUpdateOne) SetIsActive(b bool) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetIsActive(b)
+	return cfuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cfuo *CalendarFeedUpdateOne) SetNillableIsActive(b *bool) *CalendarFeedUpdateOne {
+	if b != nil {
+		cfuo.SetIsActive(*b)
+	}
+	return cfuo
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (cfuo *CalendarFeedUpdateOne) SetLastAccessedAt(t time.Time) *CalendarFeedUpdateOne {
+	cfuo.mutation.SetLastAccessedAt(t)
+	return cfuo
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (cfuo *CalendarFeedUpdateOne) SetNillableLastAccessedAt(t *time.Time) *CalendarFeedUpdateOne {
+	if t != nil {
+		cfuo.SetLastAccessedAt(*t)
+	}
+	return cfuo
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (cfuo *CalendarFeedUpdateOne) ClearLastAccessedAt() *CalendarFeedUpdateOne {
+	cfuo.mutation.ClearLastAccessedAt()
+	return cfuo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (cfuo *CalendarFeedUpdateOne) SetEmployee(e *Employee) *CalendarFeedUpdateOne {
+	return cfuo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the CalendarFeedMutation object of the builder.
+func (cfuo *CalendarFeedUpdateOne) Mutation() *CalendarFeedMutation {
+	return cfuo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (cfuo *CalendarFeedUpdateOne) ClearEmployee() *CalendarFeedUpdateOne {
+	cfuo.mutation.ClearEmployee()
+	return cfuo
+}
+
+// Where appends a list predicates to the CalendarFeedUpdate builder.
+func (cfuo *CalendarFeedUpdateOne) Where(ps ...predicate.CalendarFeed) *CalendarFeedUpdateOne {
+	cfuo.mutation.Where(ps...)
+	return cfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfuo *CalendarFeedUpdateOne) Select(field string, fields ...string) *CalendarFeedUpdateOne {
+	cfuo.fields = append([]string{field}, fields...)
+	return cfuo
+}
+
+// Save executes the query and returns the updated CalendarFeed entity.
+func (cfuo *CalendarFeedUpdateOne) Save(ctx context.Context) (*CalendarFeed, error) {
+	cfuo.defaults()
+	return withHooks(ctx, cfuo.sqlSave, cfuo.mutation, cfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfuo *CalendarFeedUpdateOne) SaveX(ctx context.Context) *CalendarFeed {
+	node, err := cfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfuo *CalendarFeedUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfuo *CalendarFeedUpdateOne) ExecX(ctx context.Context) {
+	if err := cfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfuo *CalendarFeedUpdateOne) defaults() {
+	if _, ok := cfuo.mutation.ModifiedAt(); !ok {
+		v := calendarfeed.UpdateDefaultModifiedAt()
+		cfuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfuo *CalendarFeedUpdateOne) check() error {
+	if v, ok := cfuo.mutation.Name(); ok {
+		if err := calendarfeed.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.name": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Scope(); ok {
+		if err := calendarfeed.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.scope": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Department(); ok {
+		if err := calendarfeed.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.department": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Token(); ok {
+		if err := calendarfeed.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "CalendarFeed.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cfuo *CalendarFeedUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CalendarFeedUpdateOne {
+	cfuo.modifiers = append(cfuo.modifiers, modifiers...)
+	return cfuo
+}
+
+func (cfuo *CalendarFeedUpdateOne) sqlSave(ctx context.Context) (_node *CalendarFeed, err error) {
+	if err := cfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(calendarfeed.Table, calendarfeed.Columns, sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64))
+	id, ok := cfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CalendarFeed.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, calendarfeed.FieldID)
+		for _, f := range fields {
+			if !calendarfeed.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != calendarfeed.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(calendarfeed.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := cfuo.mutation.DeletedAt(); ok {
+		_spec.SetField(calendarfeed.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cfuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(calendarfeed.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cfuo.mutation.Name(); ok {
+		_spec.SetField(calendarfeed.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.Scope(); ok {
+		_spec.SetField(calendarfeed.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := cfuo.mutation.Department(); ok {
+		_spec.SetField(calendarfeed.FieldDepartment, field.TypeString, value)
+	}
+	if cfuo.mutation.DepartmentCleared() {
+		_spec.ClearField(calendarfeed.FieldDepartment, field.TypeString)
+	}
+	if value, ok := cfuo.mutation.Token(); ok {
+		_spec.SetField(calendarfeed.FieldToken, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.IsActive(); ok {
+		_spec.SetField(calendarfeed.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := cfuo.mutation.LastAccessedAt(); ok {
+		_spec.SetField(calendarfeed.FieldLastAccessedAt, field.TypeTime, value)
+	}
+	if cfuo.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(calendarfeed.FieldLastAccessedAt, field.TypeTime)
+	}
+	if cfuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calendarfeed.EmployeeTable,
+			Columns: []string{calendarfeed.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calendarfeed.EmployeeTable,
+			Columns: []string{calendarfeed.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cfuo.modifiers...)
+	_node = &CalendarFeed{config: cfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calendarfeed.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cfuo.mutation.done = true
+	return _node, nil
+}
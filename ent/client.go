@@ -11,11 +11,35 @@ import (
 	"mceasy/ent/migrate"
 
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
 	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
 	"mceasy/ent/role"
 	"mceasy/ent/roleuser"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 	"mceasy/ent/user"
+	"mceasy/ent/workschedule"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -32,16 +56,64 @@ type Client struct {
 	Schema *migrate.Schema
 	// Attendance is the client for interacting with the Attendance builders.
 	Attendance *AttendanceClient
+	// AttendanceAnomaly is the client for interacting with the AttendanceAnomaly builders.
+	AttendanceAnomaly *AttendanceAnomalyClient
+	// AttendanceCorrection is the client for interacting with the AttendanceCorrection builders.
+	AttendanceCorrection *AttendanceCorrectionClient
+	// AttendancePayPolicy is the client for interacting with the AttendancePayPolicy builders.
+	AttendancePayPolicy *AttendancePayPolicyClient
+	// AttendancePunch is the client for interacting with the AttendancePunch builders.
+	AttendancePunch *AttendancePunchClient
+	// BpjsContributionRate is the client for interacting with the BpjsContributionRate builders.
+	BpjsContributionRate *BpjsContributionRateClient
+	// CalendarFeed is the client for interacting with the CalendarFeed builders.
+	CalendarFeed *CalendarFeedClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// DevicePunchRejection is the client for interacting with the DevicePunchRejection builders.
+	DevicePunchRejection *DevicePunchRejectionClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// Kiosk is the client for interacting with the Kiosk builders.
+	Kiosk *KioskClient
+	// LeaveBalance is the client for interacting with the LeaveBalance builders.
+	LeaveBalance *LeaveBalanceClient
+	// LeaveRequest is the client for interacting with the LeaveRequest builders.
+	LeaveRequest *LeaveRequestClient
+	// LeaveType is the client for interacting with the LeaveType builders.
+	LeaveType *LeaveTypeClient
+	// OfficeLocation is the client for interacting with the OfficeLocation builders.
+	OfficeLocation *OfficeLocationClient
+	// Overtime is the client for interacting with the Overtime builders.
+	Overtime *OvertimeClient
+	// PayrollPeriod is the client for interacting with the PayrollPeriod builders.
+	PayrollPeriod *PayrollPeriodClient
+	// PayrollPeriodEvent is the client for interacting with the PayrollPeriodEvent builders.
+	PayrollPeriodEvent *PayrollPeriodEventClient
+	// PayrollRun is the client for interacting with the PayrollRun builders.
+	PayrollRun *PayrollRunClient
+	// PayrollRunEvent is the client for interacting with the PayrollRunEvent builders.
+	PayrollRunEvent *PayrollRunEventClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleUser is the client for interacting with the RoleUser builders.
 	RoleUser *RoleUserClient
 	// SalaryCalculation is the client for interacting with the SalaryCalculation builders.
 	SalaryCalculation *SalaryCalculationClient
+	// SalaryCalculationItem is the client for interacting with the SalaryCalculationItem builders.
+	SalaryCalculationItem *SalaryCalculationItemClient
+	// SalaryComponent is the client for interacting with the SalaryComponent builders.
+	SalaryComponent *SalaryComponentClient
+	// SalaryComponentAssignment is the client for interacting with the SalaryComponentAssignment builders.
+	SalaryComponentAssignment *SalaryComponentAssignmentClient
+	// ShiftAssignment is the client for interacting with the ShiftAssignment builders.
+	ShiftAssignment *ShiftAssignmentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WorkSchedule is the client for interacting with the WorkSchedule builders.
+	WorkSchedule *WorkScheduleClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -56,11 +128,35 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Attendance = NewAttendanceClient(c.config)
+	c.AttendanceAnomaly = NewAttendanceAnomalyClient(c.config)
+	c.AttendanceCorrection = NewAttendanceCorrectionClient(c.config)
+	c.AttendancePayPolicy = NewAttendancePayPolicyClient(c.config)
+	c.AttendancePunch = NewAttendancePunchClient(c.config)
+	c.BpjsContributionRate = NewBpjsContributionRateClient(c.config)
+	c.CalendarFeed = NewCalendarFeedClient(c.config)
+	c.Device = NewDeviceClient(c.config)
+	c.DevicePunchRejection = NewDevicePunchRejectionClient(c.config)
 	c.Employee = NewEmployeeClient(c.config)
+	c.Holiday = NewHolidayClient(c.config)
+	c.Kiosk = NewKioskClient(c.config)
+	c.LeaveBalance = NewLeaveBalanceClient(c.config)
+	c.LeaveRequest = NewLeaveRequestClient(c.config)
+	c.LeaveType = NewLeaveTypeClient(c.config)
+	c.OfficeLocation = NewOfficeLocationClient(c.config)
+	c.Overtime = NewOvertimeClient(c.config)
+	c.PayrollPeriod = NewPayrollPeriodClient(c.config)
+	c.PayrollPeriodEvent = NewPayrollPeriodEventClient(c.config)
+	c.PayrollRun = NewPayrollRunClient(c.config)
+	c.PayrollRunEvent = NewPayrollRunEventClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleUser = NewRoleUserClient(c.config)
 	c.SalaryCalculation = NewSalaryCalculationClient(c.config)
+	c.SalaryCalculationItem = NewSalaryCalculationItemClient(c.config)
+	c.SalaryComponent = NewSalaryComponentClient(c.config)
+	c.SalaryComponentAssignment = NewSalaryComponentAssignmentClient(c.config)
+	c.ShiftAssignment = NewShiftAssignmentClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WorkSchedule = NewWorkScheduleClient(c.config)
 }
 
 type (
@@ -141,14 +237,38 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Attendance:        NewAttendanceClient(cfg),
-		Employee:          NewEmployeeClient(cfg),
-		Role:              NewRoleClient(cfg),
-		RoleUser:          NewRoleUserClient(cfg),
-		SalaryCalculation: NewSalaryCalculationClient(cfg),
-		User:              NewUserClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		Attendance:                NewAttendanceClient(cfg),
+		AttendanceAnomaly:         NewAttendanceAnomalyClient(cfg),
+		AttendanceCorrection:      NewAttendanceCorrectionClient(cfg),
+		AttendancePayPolicy:       NewAttendancePayPolicyClient(cfg),
+		AttendancePunch:           NewAttendancePunchClient(cfg),
+		BpjsContributionRate:      NewBpjsContributionRateClient(cfg),
+		CalendarFeed:              NewCalendarFeedClient(cfg),
+		Device:                    NewDeviceClient(cfg),
+		DevicePunchRejection:      NewDevicePunchRejectionClient(cfg),
+		Employee:                  NewEmployeeClient(cfg),
+		Holiday:                   NewHolidayClient(cfg),
+		Kiosk:                     NewKioskClient(cfg),
+		LeaveBalance:              NewLeaveBalanceClient(cfg),
+		LeaveRequest:              NewLeaveRequestClient(cfg),
+		LeaveType:                 NewLeaveTypeClient(cfg),
+		OfficeLocation:            NewOfficeLocationClient(cfg),
+		Overtime:                  NewOvertimeClient(cfg),
+		PayrollPeriod:             NewPayrollPeriodClient(cfg),
+		PayrollPeriodEvent:        NewPayrollPeriodEventClient(cfg),
+		PayrollRun:                NewPayrollRunClient(cfg),
+		PayrollRunEvent:           NewPayrollRunEventClient(cfg),
+		Role:                      NewRoleClient(cfg),
+		RoleUser:                  NewRoleUserClient(cfg),
+		SalaryCalculation:         NewSalaryCalculationClient(cfg),
+		SalaryCalculationItem:     NewSalaryCalculationItemClient(cfg),
+		SalaryComponent:           NewSalaryComponentClient(cfg),
+		SalaryComponentAssignment: NewSalaryComponentAssignmentClient(cfg),
+		ShiftAssignment:           NewShiftAssignmentClient(cfg),
+		User:                      NewUserClient(cfg),
+		WorkSchedule:              NewWorkScheduleClient(cfg),
 	}, nil
 }
 
@@ -166,14 +286,38 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Attendance:        NewAttendanceClient(cfg),
-		Employee:          NewEmployeeClient(cfg),
-		Role:              NewRoleClient(cfg),
-		RoleUser:          NewRoleUserClient(cfg),
-		SalaryCalculation: NewSalaryCalculationClient(cfg),
-		User:              NewUserClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		Attendance:                NewAttendanceClient(cfg),
+		AttendanceAnomaly:         NewAttendanceAnomalyClient(cfg),
+		AttendanceCorrection:      NewAttendanceCorrectionClient(cfg),
+		AttendancePayPolicy:       NewAttendancePayPolicyClient(cfg),
+		AttendancePunch:           NewAttendancePunchClient(cfg),
+		BpjsContributionRate:      NewBpjsContributionRateClient(cfg),
+		CalendarFeed:              NewCalendarFeedClient(cfg),
+		Device:                    NewDeviceClient(cfg),
+		DevicePunchRejection:      NewDevicePunchRejectionClient(cfg),
+		Employee:                  NewEmployeeClient(cfg),
+		Holiday:                   NewHolidayClient(cfg),
+		Kiosk:                     NewKioskClient(cfg),
+		LeaveBalance:              NewLeaveBalanceClient(cfg),
+		LeaveRequest:              NewLeaveRequestClient(cfg),
+		LeaveType:                 NewLeaveTypeClient(cfg),
+		OfficeLocation:            NewOfficeLocationClient(cfg),
+		Overtime:                  NewOvertimeClient(cfg),
+		PayrollPeriod:             NewPayrollPeriodClient(cfg),
+		PayrollPeriodEvent:        NewPayrollPeriodEventClient(cfg),
+		PayrollRun:                NewPayrollRunClient(cfg),
+		PayrollRunEvent:           NewPayrollRunEventClient(cfg),
+		Role:                      NewRoleClient(cfg),
+		RoleUser:                  NewRoleUserClient(cfg),
+		SalaryCalculation:         NewSalaryCalculationClient(cfg),
+		SalaryCalculationItem:     NewSalaryCalculationItemClient(cfg),
+		SalaryComponent:           NewSalaryComponentClient(cfg),
+		SalaryComponentAssignment: NewSalaryComponentAssignmentClient(cfg),
+		ShiftAssignment:           NewShiftAssignmentClient(cfg),
+		User:                      NewUserClient(cfg),
+		WorkSchedule:              NewWorkScheduleClient(cfg),
 	}, nil
 }
 
@@ -203,7 +347,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attendance, c.Employee, c.Role, c.RoleUser, c.SalaryCalculation, c.User,
+		c.Attendance, c.AttendanceAnomaly, c.AttendanceCorrection,
+		c.AttendancePayPolicy, c.AttendancePunch, c.BpjsContributionRate,
+		c.CalendarFeed, c.Device, c.DevicePunchRejection, c.Employee, c.Holiday,
+		c.Kiosk, c.LeaveBalance, c.LeaveRequest, c.LeaveType, c.OfficeLocation,
+		c.Overtime, c.PayrollPeriod, c.PayrollPeriodEvent, c.PayrollRun,
+		c.PayrollRunEvent, c.Role, c.RoleUser, c.SalaryCalculation,
+		c.SalaryCalculationItem, c.SalaryComponent, c.SalaryComponentAssignment,
+		c.ShiftAssignment, c.User, c.WorkSchedule,
 	} {
 		n.Use(hooks...)
 	}
@@ -213,7 +364,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attendance, c.Employee, c.Role, c.RoleUser, c.SalaryCalculation, c.User,
+		c.Attendance, c.AttendanceAnomaly, c.AttendanceCorrection,
+		c.AttendancePayPolicy, c.AttendancePunch, c.BpjsContributionRate,
+		c.CalendarFeed, c.Device, c.DevicePunchRejection, c.Employee, c.Holiday,
+		c.Kiosk, c.LeaveBalance, c.LeaveRequest, c.LeaveType, c.OfficeLocation,
+		c.Overtime, c.PayrollPeriod, c.PayrollPeriodEvent, c.PayrollRun,
+		c.PayrollRunEvent, c.Role, c.RoleUser, c.SalaryCalculation,
+		c.SalaryCalculationItem, c.SalaryComponent, c.SalaryComponentAssignment,
+		c.ShiftAssignment, c.User, c.WorkSchedule,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -224,16 +382,64 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AttendanceMutation:
 		return c.Attendance.mutate(ctx, m)
+	case *AttendanceAnomalyMutation:
+		return c.AttendanceAnomaly.mutate(ctx, m)
+	case *AttendanceCorrectionMutation:
+		return c.AttendanceCorrection.mutate(ctx, m)
+	case *AttendancePayPolicyMutation:
+		return c.AttendancePayPolicy.mutate(ctx, m)
+	case *AttendancePunchMutation:
+		return c.AttendancePunch.mutate(ctx, m)
+	case *BpjsContributionRateMutation:
+		return c.BpjsContributionRate.mutate(ctx, m)
+	case *CalendarFeedMutation:
+		return c.CalendarFeed.mutate(ctx, m)
+	case *DeviceMutation:
+		return c.Device.mutate(ctx, m)
+	case *DevicePunchRejectionMutation:
+		return c.DevicePunchRejection.mutate(ctx, m)
 	case *EmployeeMutation:
 		return c.Employee.mutate(ctx, m)
+	case *HolidayMutation:
+		return c.Holiday.mutate(ctx, m)
+	case *KioskMutation:
+		return c.Kiosk.mutate(ctx, m)
+	case *LeaveBalanceMutation:
+		return c.LeaveBalance.mutate(ctx, m)
+	case *LeaveRequestMutation:
+		return c.LeaveRequest.mutate(ctx, m)
+	case *LeaveTypeMutation:
+		return c.LeaveType.mutate(ctx, m)
+	case *OfficeLocationMutation:
+		return c.OfficeLocation.mutate(ctx, m)
+	case *OvertimeMutation:
+		return c.Overtime.mutate(ctx, m)
+	case *PayrollPeriodMutation:
+		return c.PayrollPeriod.mutate(ctx, m)
+	case *PayrollPeriodEventMutation:
+		return c.PayrollPeriodEvent.mutate(ctx, m)
+	case *PayrollRunMutation:
+		return c.PayrollRun.mutate(ctx, m)
+	case *PayrollRunEventMutation:
+		return c.PayrollRunEvent.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleUserMutation:
 		return c.RoleUser.mutate(ctx, m)
 	case *SalaryCalculationMutation:
 		return c.SalaryCalculation.mutate(ctx, m)
+	case *SalaryCalculationItemMutation:
+		return c.SalaryCalculationItem.mutate(ctx, m)
+	case *SalaryComponentMutation:
+		return c.SalaryComponent.mutate(ctx, m)
+	case *SalaryComponentAssignmentMutation:
+		return c.SalaryComponentAssignment.mutate(ctx, m)
+	case *ShiftAssignmentMutation:
+		return c.ShiftAssignment.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WorkScheduleMutation:
+		return c.WorkSchedule.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -348,6 +554,86 @@ func (c *AttendanceClient) QueryEmployee(a *Attendance) *EmployeeQuery {
 	return query
 }
 
+// QueryCorrections queries the corrections edge of a Attendance.
+func (c *AttendanceClient) QueryCorrections(a *Attendance) *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, id),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.CorrectionsTable, attendance.CorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnomalies queries the anomalies edge of a Attendance.
+func (c *AttendanceClient) QueryAnomalies(a *Attendance) *AttendanceAnomalyQuery {
+	query := (&AttendanceAnomalyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, id),
+			sqlgraph.To(attendanceanomaly.Table, attendanceanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.AnomaliesTable, attendance.AnomaliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPunches queries the punches edge of a Attendance.
+func (c *AttendanceClient) QueryPunches(a *Attendance) *AttendancePunchQuery {
+	query := (&AttendancePunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, id),
+			sqlgraph.To(attendancepunch.Table, attendancepunch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendance.PunchesTable, attendance.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertime queries the overtime edge of a Attendance.
+func (c *AttendanceClient) QueryOvertime(a *Attendance) *OvertimeQuery {
+	query := (&OvertimeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, id),
+			sqlgraph.To(overtime.Table, overtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attendance.OvertimeTable, attendance.OvertimeColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCheckInKiosk queries the check_in_kiosk edge of a Attendance.
+func (c *AttendanceClient) QueryCheckInKiosk(a *Attendance) *KioskQuery {
+	query := (&KioskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendance.Table, attendance.FieldID, id),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendance.CheckInKioskTable, attendance.CheckInKioskColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AttendanceClient) Hooks() []Hook {
 	return c.hooks.Attendance
@@ -373,92 +659,92 @@ func (c *AttendanceClient) mutate(ctx context.Context, m *AttendanceMutation) (V
 	}
 }
 
-// EmployeeClient is a client for the Employee schema.
-type EmployeeClient struct {
+// AttendanceAnomalyClient is a client for the AttendanceAnomaly schema.
+type AttendanceAnomalyClient struct {
 	config
 }
 
-// NewEmployeeClient returns a client for the Employee from the given config.
-func NewEmployeeClient(c config) *EmployeeClient {
-	return &EmployeeClient{config: c}
+// NewAttendanceAnomalyClient returns a client for the AttendanceAnomaly from the given config.
+func NewAttendanceAnomalyClient(c config) *AttendanceAnomalyClient {
+	return &AttendanceAnomalyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `employee.Hooks(f(g(h())))`.
-func (c *EmployeeClient) Use(hooks ...Hook) {
-	c.hooks.Employee = append(c.hooks.Employee, hooks...)
+// A call to `Use(f, g, h)` equals to `attendanceanomaly.Hooks(f(g(h())))`.
+func (c *AttendanceAnomalyClient) Use(hooks ...Hook) {
+	c.hooks.AttendanceAnomaly = append(c.hooks.AttendanceAnomaly, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `employee.Intercept(f(g(h())))`.
-func (c *EmployeeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Employee = append(c.inters.Employee, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attendanceanomaly.Intercept(f(g(h())))`.
+func (c *AttendanceAnomalyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendanceAnomaly = append(c.inters.AttendanceAnomaly, interceptors...)
 }
 
-// Create returns a builder for creating a Employee entity.
-func (c *EmployeeClient) Create() *EmployeeCreate {
-	mutation := newEmployeeMutation(c.config, OpCreate)
-	return &EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttendanceAnomaly entity.
+func (c *AttendanceAnomalyClient) Create() *AttendanceAnomalyCreate {
+	mutation := newAttendanceAnomalyMutation(c.config, OpCreate)
+	return &AttendanceAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Employee entities.
-func (c *EmployeeClient) CreateBulk(builders ...*EmployeeCreate) *EmployeeCreateBulk {
-	return &EmployeeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttendanceAnomaly entities.
+func (c *AttendanceAnomalyClient) CreateBulk(builders ...*AttendanceAnomalyCreate) *AttendanceAnomalyCreateBulk {
+	return &AttendanceAnomalyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Employee.
-func (c *EmployeeClient) Update() *EmployeeUpdate {
-	mutation := newEmployeeMutation(c.config, OpUpdate)
-	return &EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttendanceAnomaly.
+func (c *AttendanceAnomalyClient) Update() *AttendanceAnomalyUpdate {
+	mutation := newAttendanceAnomalyMutation(c.config, OpUpdate)
+	return &AttendanceAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmployeeClient) UpdateOne(e *Employee) *EmployeeUpdateOne {
-	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployee(e))
-	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceAnomalyClient) UpdateOne(aa *AttendanceAnomaly) *AttendanceAnomalyUpdateOne {
+	mutation := newAttendanceAnomalyMutation(c.config, OpUpdateOne, withAttendanceAnomaly(aa))
+	return &AttendanceAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmployeeClient) UpdateOneID(id uint64) *EmployeeUpdateOne {
-	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployeeID(id))
-	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceAnomalyClient) UpdateOneID(id uint64) *AttendanceAnomalyUpdateOne {
+	mutation := newAttendanceAnomalyMutation(c.config, OpUpdateOne, withAttendanceAnomalyID(id))
+	return &AttendanceAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Employee.
-func (c *EmployeeClient) Delete() *EmployeeDelete {
-	mutation := newEmployeeMutation(c.config, OpDelete)
-	return &EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttendanceAnomaly.
+func (c *AttendanceAnomalyClient) Delete() *AttendanceAnomalyDelete {
+	mutation := newAttendanceAnomalyMutation(c.config, OpDelete)
+	return &AttendanceAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmployeeClient) DeleteOne(e *Employee) *EmployeeDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *AttendanceAnomalyClient) DeleteOne(aa *AttendanceAnomaly) *AttendanceAnomalyDeleteOne {
+	return c.DeleteOneID(aa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmployeeClient) DeleteOneID(id uint64) *EmployeeDeleteOne {
-	builder := c.Delete().Where(employee.ID(id))
+func (c *AttendanceAnomalyClient) DeleteOneID(id uint64) *AttendanceAnomalyDeleteOne {
+	builder := c.Delete().Where(attendanceanomaly.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmployeeDeleteOne{builder}
+	return &AttendanceAnomalyDeleteOne{builder}
 }
 
-// Query returns a query builder for Employee.
-func (c *EmployeeClient) Query() *EmployeeQuery {
-	return &EmployeeQuery{
+// Query returns a query builder for AttendanceAnomaly.
+func (c *AttendanceAnomalyClient) Query() *AttendanceAnomalyQuery {
+	return &AttendanceAnomalyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmployee},
+		ctx:    &QueryContext{Type: TypeAttendanceAnomaly},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Employee entity by its id.
-func (c *EmployeeClient) Get(ctx context.Context, id uint64) (*Employee, error) {
-	return c.Query().Where(employee.ID(id)).Only(ctx)
+// Get returns a AttendanceAnomaly entity by its id.
+func (c *AttendanceAnomalyClient) Get(ctx context.Context, id uint64) (*AttendanceAnomaly, error) {
+	return c.Query().Where(attendanceanomaly.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmployeeClient) GetX(ctx context.Context, id uint64) *Employee {
+func (c *AttendanceAnomalyClient) GetX(ctx context.Context, id uint64) *AttendanceAnomaly {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -466,149 +752,3567 @@ func (c *EmployeeClient) GetX(ctx context.Context, id uint64) *Employee {
 	return obj
 }
 
-// QueryAttendances queries the attendances edge of a Employee.
-func (c *EmployeeClient) QueryAttendances(e *Employee) *AttendanceQuery {
+// QueryAttendance queries the attendance edge of a AttendanceAnomaly.
+func (c *AttendanceAnomalyClient) QueryAttendance(aa *AttendanceAnomaly) *AttendanceQuery {
 	query := (&AttendanceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := aa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.From(attendanceanomaly.Table, attendanceanomaly.FieldID, id),
 			sqlgraph.To(attendance.Table, attendance.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendancesTable, employee.AttendancesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendanceanomaly.AttendanceTable, attendanceanomaly.AttendanceColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(aa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QuerySalaryCalculations queries the salary_calculations edge of a Employee.
-func (c *EmployeeClient) QuerySalaryCalculations(e *Employee) *SalaryCalculationQuery {
-	query := (&SalaryCalculationClient{config: c.config}).Query()
+// QueryEmployee queries the employee edge of a AttendanceAnomaly.
+func (c *AttendanceAnomalyClient) QueryEmployee(aa *AttendanceAnomaly) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := aa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.SalaryCalculationsTable, employee.SalaryCalculationsColumn),
+			sqlgraph.From(attendanceanomaly.Table, attendanceanomaly.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendanceanomaly.EmployeeTable, attendanceanomaly.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(aa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *EmployeeClient) Hooks() []Hook {
-	return c.hooks.Employee
+func (c *AttendanceAnomalyClient) Hooks() []Hook {
+	return c.hooks.AttendanceAnomaly
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmployeeClient) Interceptors() []Interceptor {
-	return c.inters.Employee
+func (c *AttendanceAnomalyClient) Interceptors() []Interceptor {
+	return c.inters.AttendanceAnomaly
 }
 
-func (c *EmployeeClient) mutate(ctx context.Context, m *EmployeeMutation) (Value, error) {
+func (c *AttendanceAnomalyClient) mutate(ctx context.Context, m *AttendanceAnomalyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AttendanceAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Employee mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AttendanceAnomaly mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// AttendanceCorrectionClient is a client for the AttendanceCorrection schema.
+type AttendanceCorrectionClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewAttendanceCorrectionClient returns a client for the AttendanceCorrection from the given config.
+func NewAttendanceCorrectionClient(c config) *AttendanceCorrectionClient {
+	return &AttendanceCorrectionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `attendancecorrection.Hooks(f(g(h())))`.
+func (c *AttendanceCorrectionClient) Use(hooks ...Hook) {
+	c.hooks.AttendanceCorrection = append(c.hooks.AttendanceCorrection, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attendancecorrection.Intercept(f(g(h())))`.
+func (c *AttendanceCorrectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendanceCorrection = append(c.inters.AttendanceCorrection, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttendanceCorrection entity.
+func (c *AttendanceCorrectionClient) Create() *AttendanceCorrectionCreate {
+	mutation := newAttendanceCorrectionMutation(c.config, OpCreate)
+	return &AttendanceCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttendanceCorrection entities.
+func (c *AttendanceCorrectionClient) CreateBulk(builders ...*AttendanceCorrectionCreate) *AttendanceCorrectionCreateBulk {
+	return &AttendanceCorrectionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Update() *AttendanceCorrectionUpdate {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdate)
+	return &AttendanceCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceCorrectionClient) UpdateOne(ac *AttendanceCorrection) *AttendanceCorrectionUpdateOne {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdateOne, withAttendanceCorrection(ac))
+	return &AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id uint64) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceCorrectionClient) UpdateOneID(id uint64) *AttendanceCorrectionUpdateOne {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdateOne, withAttendanceCorrectionID(id))
+	return &AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Delete() *AttendanceCorrectionDelete {
+	mutation := newAttendanceCorrectionMutation(c.config, OpDelete)
+	return &AttendanceCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *AttendanceCorrectionClient) DeleteOne(ac *AttendanceCorrection) *AttendanceCorrectionDeleteOne {
+	return c.DeleteOneID(ac.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id uint64) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *AttendanceCorrectionClient) DeleteOneID(id uint64) *AttendanceCorrectionDeleteOne {
+	builder := c.Delete().Where(attendancecorrection.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &AttendanceCorrectionDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Query() *AttendanceCorrectionQuery {
+	return &AttendanceCorrectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttendanceCorrection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttendanceCorrection entity by its id.
+func (c *AttendanceCorrectionClient) Get(ctx context.Context, id uint64) (*AttendanceCorrection, error) {
+	return c.Query().Where(attendancecorrection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttendanceCorrectionClient) GetX(ctx context.Context, id uint64) *AttendanceCorrection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttendance queries the attendance edge of a AttendanceCorrection.
+func (c *AttendanceCorrectionClient) QueryAttendance(ac *AttendanceCorrection) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ac.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.AttendanceTable, attendancecorrection.AttendanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmployee queries the employee edge of a AttendanceCorrection.
+func (c *AttendanceCorrectionClient) QueryEmployee(ac *AttendanceCorrection) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ac.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.EmployeeTable, attendancecorrection.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttendanceCorrectionClient) Hooks() []Hook {
+	return c.hooks.AttendanceCorrection
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttendanceCorrectionClient) Interceptors() []Interceptor {
+	return c.inters.AttendanceCorrection
+}
+
+func (c *AttendanceCorrectionClient) mutate(ctx context.Context, m *AttendanceCorrectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttendanceCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttendanceCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttendanceCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttendanceCorrection mutation op: %q", m.Op())
+	}
+}
+
+// AttendancePayPolicyClient is a client for the AttendancePayPolicy schema.
+type AttendancePayPolicyClient struct {
+	config
+}
+
+// NewAttendancePayPolicyClient returns a client for the AttendancePayPolicy from the given config.
+func NewAttendancePayPolicyClient(c config) *AttendancePayPolicyClient {
+	return &AttendancePayPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attendancepaypolicy.Hooks(f(g(h())))`.
+func (c *AttendancePayPolicyClient) Use(hooks ...Hook) {
+	c.hooks.AttendancePayPolicy = append(c.hooks.AttendancePayPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attendancepaypolicy.Intercept(f(g(h())))`.
+func (c *AttendancePayPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendancePayPolicy = append(c.inters.AttendancePayPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a AttendancePayPolicy entity.
+func (c *AttendancePayPolicyClient) Create() *AttendancePayPolicyCreate {
+	mutation := newAttendancePayPolicyMutation(c.config, OpCreate)
+	return &AttendancePayPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttendancePayPolicy entities.
+func (c *AttendancePayPolicyClient) CreateBulk(builders ...*AttendancePayPolicyCreate) *AttendancePayPolicyCreateBulk {
+	return &AttendancePayPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttendancePayPolicy.
+func (c *AttendancePayPolicyClient) Update() *AttendancePayPolicyUpdate {
+	mutation := newAttendancePayPolicyMutation(c.config, OpUpdate)
+	return &AttendancePayPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttendancePayPolicyClient) UpdateOne(app *AttendancePayPolicy) *AttendancePayPolicyUpdateOne {
+	mutation := newAttendancePayPolicyMutation(c.config, OpUpdateOne, withAttendancePayPolicy(app))
+	return &AttendancePayPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttendancePayPolicyClient) UpdateOneID(id uint64) *AttendancePayPolicyUpdateOne {
+	mutation := newAttendancePayPolicyMutation(c.config, OpUpdateOne, withAttendancePayPolicyID(id))
+	return &AttendancePayPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttendancePayPolicy.
+func (c *AttendancePayPolicyClient) Delete() *AttendancePayPolicyDelete {
+	mutation := newAttendancePayPolicyMutation(c.config, OpDelete)
+	return &AttendancePayPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttendancePayPolicyClient) DeleteOne(app *AttendancePayPolicy) *AttendancePayPolicyDeleteOne {
+	return c.DeleteOneID(app.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttendancePayPolicyClient) DeleteOneID(id uint64) *AttendancePayPolicyDeleteOne {
+	builder := c.Delete().Where(attendancepaypolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttendancePayPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for AttendancePayPolicy.
+func (c *AttendancePayPolicyClient) Query() *AttendancePayPolicyQuery {
+	return &AttendancePayPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttendancePayPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttendancePayPolicy entity by its id.
+func (c *AttendancePayPolicyClient) Get(ctx context.Context, id uint64) (*AttendancePayPolicy, error) {
+	return c.Query().Where(attendancepaypolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttendancePayPolicyClient) GetX(ctx context.Context, id uint64) *AttendancePayPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkSchedules queries the work_schedules edge of a AttendancePayPolicy.
+func (c *AttendancePayPolicyClient) QueryWorkSchedules(app *AttendancePayPolicy) *WorkScheduleQuery {
+	query := (&WorkScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := app.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepaypolicy.Table, attendancepaypolicy.FieldID, id),
+			sqlgraph.To(workschedule.Table, workschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendancepaypolicy.WorkSchedulesTable, attendancepaypolicy.WorkSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(app.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttendancePayPolicyClient) Hooks() []Hook {
+	return c.hooks.AttendancePayPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttendancePayPolicyClient) Interceptors() []Interceptor {
+	return c.inters.AttendancePayPolicy
+}
+
+func (c *AttendancePayPolicyClient) mutate(ctx context.Context, m *AttendancePayPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttendancePayPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttendancePayPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttendancePayPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttendancePayPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttendancePayPolicy mutation op: %q", m.Op())
+	}
+}
+
+// AttendancePunchClient is a client for the AttendancePunch schema.
+type AttendancePunchClient struct {
+	config
+}
+
+// NewAttendancePunchClient returns a client for the AttendancePunch from the given config.
+func NewAttendancePunchClient(c config) *AttendancePunchClient {
+	return &AttendancePunchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attendancepunch.Hooks(f(g(h())))`.
+func (c *AttendancePunchClient) Use(hooks ...Hook) {
+	c.hooks.AttendancePunch = append(c.hooks.AttendancePunch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attendancepunch.Intercept(f(g(h())))`.
+func (c *AttendancePunchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendancePunch = append(c.inters.AttendancePunch, interceptors...)
+}
+
+// Create returns a builder for creating a AttendancePunch entity.
+func (c *AttendancePunchClient) Create() *AttendancePunchCreate {
+	mutation := newAttendancePunchMutation(c.config, OpCreate)
+	return &AttendancePunchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttendancePunch entities.
+func (c *AttendancePunchClient) CreateBulk(builders ...*AttendancePunchCreate) *AttendancePunchCreateBulk {
+	return &AttendancePunchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttendancePunch.
+func (c *AttendancePunchClient) Update() *AttendancePunchUpdate {
+	mutation := newAttendancePunchMutation(c.config, OpUpdate)
+	return &AttendancePunchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttendancePunchClient) UpdateOne(ap *AttendancePunch) *AttendancePunchUpdateOne {
+	mutation := newAttendancePunchMutation(c.config, OpUpdateOne, withAttendancePunch(ap))
+	return &AttendancePunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttendancePunchClient) UpdateOneID(id uint64) *AttendancePunchUpdateOne {
+	mutation := newAttendancePunchMutation(c.config, OpUpdateOne, withAttendancePunchID(id))
+	return &AttendancePunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttendancePunch.
+func (c *AttendancePunchClient) Delete() *AttendancePunchDelete {
+	mutation := newAttendancePunchMutation(c.config, OpDelete)
+	return &AttendancePunchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttendancePunchClient) DeleteOne(ap *AttendancePunch) *AttendancePunchDeleteOne {
+	return c.DeleteOneID(ap.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttendancePunchClient) DeleteOneID(id uint64) *AttendancePunchDeleteOne {
+	builder := c.Delete().Where(attendancepunch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttendancePunchDeleteOne{builder}
+}
+
+// Query returns a query builder for AttendancePunch.
+func (c *AttendancePunchClient) Query() *AttendancePunchQuery {
+	return &AttendancePunchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttendancePunch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttendancePunch entity by its id.
+func (c *AttendancePunchClient) Get(ctx context.Context, id uint64) (*AttendancePunch, error) {
+	return c.Query().Where(attendancepunch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttendancePunchClient) GetX(ctx context.Context, id uint64) *AttendancePunch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttendance queries the attendance edge of a AttendancePunch.
+func (c *AttendancePunchClient) QueryAttendance(ap *AttendancePunch) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ap.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepunch.Table, attendancepunch.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancepunch.AttendanceTable, attendancepunch.AttendanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(ap.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKiosk queries the kiosk edge of a AttendancePunch.
+func (c *AttendancePunchClient) QueryKiosk(ap *AttendancePunch) *KioskQuery {
+	query := (&KioskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ap.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancepunch.Table, attendancepunch.FieldID, id),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancepunch.KioskTable, attendancepunch.KioskColumn),
+		)
+		fromV = sqlgraph.Neighbors(ap.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttendancePunchClient) Hooks() []Hook {
+	return c.hooks.AttendancePunch
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttendancePunchClient) Interceptors() []Interceptor {
+	return c.inters.AttendancePunch
+}
+
+func (c *AttendancePunchClient) mutate(ctx context.Context, m *AttendancePunchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttendancePunchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttendancePunchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttendancePunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttendancePunchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttendancePunch mutation op: %q", m.Op())
+	}
+}
+
+// BpjsContributionRateClient is a client for the BpjsContributionRate schema.
+type BpjsContributionRateClient struct {
+	config
+}
+
+// NewBpjsContributionRateClient returns a client for the BpjsContributionRate from the given config.
+func NewBpjsContributionRateClient(c config) *BpjsContributionRateClient {
+	return &BpjsContributionRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bpjscontributionrate.Hooks(f(g(h())))`.
+func (c *BpjsContributionRateClient) Use(hooks ...Hook) {
+	c.hooks.BpjsContributionRate = append(c.hooks.BpjsContributionRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bpjscontributionrate.Intercept(f(g(h())))`.
+func (c *BpjsContributionRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BpjsContributionRate = append(c.inters.BpjsContributionRate, interceptors...)
+}
+
+// Create returns a builder for creating a BpjsContributionRate entity.
+func (c *BpjsContributionRateClient) Create() *BpjsContributionRateCreate {
+	mutation := newBpjsContributionRateMutation(c.config, OpCreate)
+	return &BpjsContributionRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BpjsContributionRate entities.
+func (c *BpjsContributionRateClient) CreateBulk(builders ...*BpjsContributionRateCreate) *BpjsContributionRateCreateBulk {
+	return &BpjsContributionRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BpjsContributionRate.
+func (c *BpjsContributionRateClient) Update() *BpjsContributionRateUpdate {
+	mutation := newBpjsContributionRateMutation(c.config, OpUpdate)
+	return &BpjsContributionRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BpjsContributionRateClient) UpdateOne(bcr *BpjsContributionRate) *BpjsContributionRateUpdateOne {
+	mutation := newBpjsContributionRateMutation(c.config, OpUpdateOne, withBpjsContributionRate(bcr))
+	return &BpjsContributionRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BpjsContributionRateClient) UpdateOneID(id uint64) *BpjsContributionRateUpdateOne {
+	mutation := newBpjsContributionRateMutation(c.config, OpUpdateOne, withBpjsContributionRateID(id))
+	return &BpjsContributionRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BpjsContributionRate.
+func (c *BpjsContributionRateClient) Delete() *BpjsContributionRateDelete {
+	mutation := newBpjsContributionRateMutation(c.config, OpDelete)
+	return &BpjsContributionRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BpjsContributionRateClient) DeleteOne(bcr *BpjsContributionRate) *BpjsContributionRateDeleteOne {
+	return c.DeleteOneID(bcr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BpjsContributionRateClient) DeleteOneID(id uint64) *BpjsContributionRateDeleteOne {
+	builder := c.Delete().Where(bpjscontributionrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BpjsContributionRateDeleteOne{builder}
+}
+
+// Query returns a query builder for BpjsContributionRate.
+func (c *BpjsContributionRateClient) Query() *BpjsContributionRateQuery {
+	return &BpjsContributionRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBpjsContributionRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BpjsContributionRate entity by its id.
+func (c *BpjsContributionRateClient) Get(ctx context.Context, id uint64) (*BpjsContributionRate, error) {
+	return c.Query().Where(bpjscontributionrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BpjsContributionRateClient) GetX(ctx context.Context, id uint64) *BpjsContributionRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BpjsContributionRateClient) Hooks() []Hook {
+	return c.hooks.BpjsContributionRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *BpjsContributionRateClient) Interceptors() []Interceptor {
+	return c.inters.BpjsContributionRate
+}
+
+func (c *BpjsContributionRateClient) mutate(ctx context.Context, m *BpjsContributionRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BpjsContributionRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BpjsContributionRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BpjsContributionRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BpjsContributionRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BpjsContributionRate mutation op: %q", m.Op())
+	}
+}
+
+// CalendarFeedClient is a client for the CalendarFeed schema.
+type CalendarFeedClient struct {
+	config
+}
+
+// NewCalendarFeedClient returns a client for the CalendarFeed from the given config.
+func NewCalendarFeedClient(c config) *CalendarFeedClient {
+	return &CalendarFeedClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `calendarfeed.Hooks(f(g(h())))`.
+func (c *CalendarFeedClient) Use(hooks ...Hook) {
+	c.hooks.CalendarFeed = append(c.hooks.CalendarFeed, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `calendarfeed.Intercept(f(g(h())))`.
+func (c *CalendarFeedClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CalendarFeed = append(c.inters.CalendarFeed, interceptors...)
+}
+
+// Create returns a builder for creating a CalendarFeed entity.
+func (c *CalendarFeedClient) Create() *CalendarFeedCreate {
+	mutation := newCalendarFeedMutation(c.config, OpCreate)
+	return &CalendarFeedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CalendarFeed entities.
+func (c *CalendarFeedClient) CreateBulk(builders ...*CalendarFeedCreate) *CalendarFeedCreateBulk {
+	return &CalendarFeedCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CalendarFeed.
+func (c *CalendarFeedClient) Update() *CalendarFeedUpdate {
+	mutation := newCalendarFeedMutation(c.config, OpUpdate)
+	return &CalendarFeedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CalendarFeedClient) UpdateOne(cf *CalendarFeed) *CalendarFeedUpdateOne {
+	mutation := newCalendarFeedMutation(c.config, OpUpdateOne, withCalendarFeed(cf))
+	return &CalendarFeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CalendarFeedClient) UpdateOneID(id uint64) *CalendarFeedUpdateOne {
+	mutation := newCalendarFeedMutation(c.config, OpUpdateOne, withCalendarFeedID(id))
+	return &CalendarFeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CalendarFeed.
+func (c *CalendarFeedClient) Delete() *CalendarFeedDelete {
+	mutation := newCalendarFeedMutation(c.config, OpDelete)
+	return &CalendarFeedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CalendarFeedClient) DeleteOne(cf *CalendarFeed) *CalendarFeedDeleteOne {
+	return c.DeleteOneID(cf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CalendarFeedClient) DeleteOneID(id uint64) *CalendarFeedDeleteOne {
+	builder := c.Delete().Where(calendarfeed.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CalendarFeedDeleteOne{builder}
+}
+
+// Query returns a query builder for CalendarFeed.
+func (c *CalendarFeedClient) Query() *CalendarFeedQuery {
+	return &CalendarFeedQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCalendarFeed},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CalendarFeed entity by its id.
+func (c *CalendarFeedClient) Get(ctx context.Context, id uint64) (*CalendarFeed, error) {
+	return c.Query().Where(calendarfeed.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CalendarFeedClient) GetX(ctx context.Context, id uint64) *CalendarFeed {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a CalendarFeed.
+func (c *CalendarFeedClient) QueryEmployee(cf *CalendarFeed) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(calendarfeed.Table, calendarfeed.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, calendarfeed.EmployeeTable, calendarfeed.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(cf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CalendarFeedClient) Hooks() []Hook {
+	return c.hooks.CalendarFeed
+}
+
+// Interceptors returns the client interceptors.
+func (c *CalendarFeedClient) Interceptors() []Interceptor {
+	return c.inters.CalendarFeed
+}
+
+func (c *CalendarFeedClient) mutate(ctx context.Context, m *CalendarFeedMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CalendarFeedCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CalendarFeedUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CalendarFeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CalendarFeedDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CalendarFeed mutation op: %q", m.Op())
+	}
+}
+
+// DeviceClient is a client for the Device schema.
+type DeviceClient struct {
+	config
+}
+
+// NewDeviceClient returns a client for the Device from the given config.
+func NewDeviceClient(c config) *DeviceClient {
+	return &DeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `device.Hooks(f(g(h())))`.
+func (c *DeviceClient) Use(hooks ...Hook) {
+	c.hooks.Device = append(c.hooks.Device, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
+func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Device = append(c.inters.Device, interceptors...)
+}
+
+// Create returns a builder for creating a Device entity.
+func (c *DeviceClient) Create() *DeviceCreate {
+	mutation := newDeviceMutation(c.config, OpCreate)
+	return &DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Device entities.
+func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Device.
+func (c *DeviceClient) Update() *DeviceUpdate {
+	mutation := newDeviceMutation(c.config, OpUpdate)
+	return &DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceClient) UpdateOne(d *Device) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDevice(d))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceClient) UpdateOneID(id uint64) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDeviceID(id))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Device.
+func (c *DeviceClient) Delete() *DeviceDelete {
+	mutation := newDeviceMutation(c.config, OpDelete)
+	return &DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceClient) DeleteOne(d *Device) *DeviceDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceClient) DeleteOneID(id uint64) *DeviceDeleteOne {
+	builder := c.Delete().Where(device.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for Device.
+func (c *DeviceClient) Query() *DeviceQuery {
+	return &DeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Device entity by its id.
+func (c *DeviceClient) Get(ctx context.Context, id uint64) (*Device, error) {
+	return c.Query().Where(device.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceClient) GetX(ctx context.Context, id uint64) *Device {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOfficeLocation queries the office_location edge of a Device.
+func (c *DeviceClient) QueryOfficeLocation(d *Device) *OfficeLocationQuery {
+	query := (&OfficeLocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(officelocation.Table, officelocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.OfficeLocationTable, device.OfficeLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPunchRejections queries the punch_rejections edge of a Device.
+func (c *DeviceClient) QueryPunchRejections(d *Device) *DevicePunchRejectionQuery {
+	query := (&DevicePunchRejectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(devicepunchrejection.Table, devicepunchrejection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.PunchRejectionsTable, device.PunchRejectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceClient) Hooks() []Hook {
+	return c.hooks.Device
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	return c.inters.Device
+}
+
+func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+	}
+}
+
+// DevicePunchRejectionClient is a client for the DevicePunchRejection schema.
+type DevicePunchRejectionClient struct {
+	config
+}
+
+// NewDevicePunchRejectionClient returns a client for the DevicePunchRejection from the given config.
+func NewDevicePunchRejectionClient(c config) *DevicePunchRejectionClient {
+	return &DevicePunchRejectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicepunchrejection.Hooks(f(g(h())))`.
+func (c *DevicePunchRejectionClient) Use(hooks ...Hook) {
+	c.hooks.DevicePunchRejection = append(c.hooks.DevicePunchRejection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicepunchrejection.Intercept(f(g(h())))`.
+func (c *DevicePunchRejectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DevicePunchRejection = append(c.inters.DevicePunchRejection, interceptors...)
+}
+
+// Create returns a builder for creating a DevicePunchRejection entity.
+func (c *DevicePunchRejectionClient) Create() *DevicePunchRejectionCreate {
+	mutation := newDevicePunchRejectionMutation(c.config, OpCreate)
+	return &DevicePunchRejectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DevicePunchRejection entities.
+func (c *DevicePunchRejectionClient) CreateBulk(builders ...*DevicePunchRejectionCreate) *DevicePunchRejectionCreateBulk {
+	return &DevicePunchRejectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DevicePunchRejection.
+func (c *DevicePunchRejectionClient) Update() *DevicePunchRejectionUpdate {
+	mutation := newDevicePunchRejectionMutation(c.config, OpUpdate)
+	return &DevicePunchRejectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DevicePunchRejectionClient) UpdateOne(dpr *DevicePunchRejection) *DevicePunchRejectionUpdateOne {
+	mutation := newDevicePunchRejectionMutation(c.config, OpUpdateOne, withDevicePunchRejection(dpr))
+	return &DevicePunchRejectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DevicePunchRejectionClient) UpdateOneID(id uint64) *DevicePunchRejectionUpdateOne {
+	mutation := newDevicePunchRejectionMutation(c.config, OpUpdateOne, withDevicePunchRejectionID(id))
+	return &DevicePunchRejectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DevicePunchRejection.
+func (c *DevicePunchRejectionClient) Delete() *DevicePunchRejectionDelete {
+	mutation := newDevicePunchRejectionMutation(c.config, OpDelete)
+	return &DevicePunchRejectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DevicePunchRejectionClient) DeleteOne(dpr *DevicePunchRejection) *DevicePunchRejectionDeleteOne {
+	return c.DeleteOneID(dpr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DevicePunchRejectionClient) DeleteOneID(id uint64) *DevicePunchRejectionDeleteOne {
+	builder := c.Delete().Where(devicepunchrejection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DevicePunchRejectionDeleteOne{builder}
+}
+
+// Query returns a query builder for DevicePunchRejection.
+func (c *DevicePunchRejectionClient) Query() *DevicePunchRejectionQuery {
+	return &DevicePunchRejectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDevicePunchRejection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DevicePunchRejection entity by its id.
+func (c *DevicePunchRejectionClient) Get(ctx context.Context, id uint64) (*DevicePunchRejection, error) {
+	return c.Query().Where(devicepunchrejection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DevicePunchRejectionClient) GetX(ctx context.Context, id uint64) *DevicePunchRejection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevice queries the device edge of a DevicePunchRejection.
+func (c *DevicePunchRejectionClient) QueryDevice(dpr *DevicePunchRejection) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dpr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(devicepunchrejection.Table, devicepunchrejection.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicepunchrejection.DeviceTable, devicepunchrejection.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(dpr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DevicePunchRejectionClient) Hooks() []Hook {
+	return c.hooks.DevicePunchRejection
+}
+
+// Interceptors returns the client interceptors.
+func (c *DevicePunchRejectionClient) Interceptors() []Interceptor {
+	return c.inters.DevicePunchRejection
+}
+
+func (c *DevicePunchRejectionClient) mutate(ctx context.Context, m *DevicePunchRejectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DevicePunchRejectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DevicePunchRejectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DevicePunchRejectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DevicePunchRejectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DevicePunchRejection mutation op: %q", m.Op())
+	}
+}
+
+// EmployeeClient is a client for the Employee schema.
+type EmployeeClient struct {
+	config
+}
+
+// NewEmployeeClient returns a client for the Employee from the given config.
+func NewEmployeeClient(c config) *EmployeeClient {
+	return &EmployeeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `employee.Hooks(f(g(h())))`.
+func (c *EmployeeClient) Use(hooks ...Hook) {
+	c.hooks.Employee = append(c.hooks.Employee, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `employee.Intercept(f(g(h())))`.
+func (c *EmployeeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Employee = append(c.inters.Employee, interceptors...)
+}
+
+// Create returns a builder for creating a Employee entity.
+func (c *EmployeeClient) Create() *EmployeeCreate {
+	mutation := newEmployeeMutation(c.config, OpCreate)
+	return &EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Employee entities.
+func (c *EmployeeClient) CreateBulk(builders ...*EmployeeCreate) *EmployeeCreateBulk {
+	return &EmployeeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Employee.
+func (c *EmployeeClient) Update() *EmployeeUpdate {
+	mutation := newEmployeeMutation(c.config, OpUpdate)
+	return &EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmployeeClient) UpdateOne(e *Employee) *EmployeeUpdateOne {
+	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployee(e))
+	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmployeeClient) UpdateOneID(id uint64) *EmployeeUpdateOne {
+	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployeeID(id))
+	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Employee.
+func (c *EmployeeClient) Delete() *EmployeeDelete {
+	mutation := newEmployeeMutation(c.config, OpDelete)
+	return &EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmployeeClient) DeleteOne(e *Employee) *EmployeeDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmployeeClient) DeleteOneID(id uint64) *EmployeeDeleteOne {
+	builder := c.Delete().Where(employee.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmployeeDeleteOne{builder}
+}
+
+// Query returns a query builder for Employee.
+func (c *EmployeeClient) Query() *EmployeeQuery {
+	return &EmployeeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmployee},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Employee entity by its id.
+func (c *EmployeeClient) Get(ctx context.Context, id uint64) (*Employee, error) {
+	return c.Query().Where(employee.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmployeeClient) GetX(ctx context.Context, id uint64) *Employee {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttendances queries the attendances edge of a Employee.
+func (c *EmployeeClient) QueryAttendances(e *Employee) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendancesTable, employee.AttendancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySalaryCalculations queries the salary_calculations edge of a Employee.
+func (c *EmployeeClient) QuerySalaryCalculations(e *Employee) *SalaryCalculationQuery {
+	query := (&SalaryCalculationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.SalaryCalculationsTable, employee.SalaryCalculationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShiftAssignments queries the shift_assignments edge of a Employee.
+func (c *EmployeeClient) QueryShiftAssignments(e *Employee) *ShiftAssignmentQuery {
+	query := (&ShiftAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(shiftassignment.Table, shiftassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ShiftAssignmentsTable, employee.ShiftAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests queries the leave_requests edge of a Employee.
+func (c *EmployeeClient) QueryLeaveRequests(e *Employee) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveRequestsTable, employee.LeaveRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveBalances queries the leave_balances edge of a Employee.
+func (c *EmployeeClient) QueryLeaveBalances(e *Employee) *LeaveBalanceQuery {
+	query := (&LeaveBalanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(leavebalance.Table, leavebalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveBalancesTable, employee.LeaveBalancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceCorrections queries the attendance_corrections edge of a Employee.
+func (c *EmployeeClient) QueryAttendanceCorrections(e *Employee) *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceCorrectionsTable, employee.AttendanceCorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceAnomalies queries the attendance_anomalies edge of a Employee.
+func (c *EmployeeClient) QueryAttendanceAnomalies(e *Employee) *AttendanceAnomalyQuery {
+	query := (&AttendanceAnomalyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attendanceanomaly.Table, attendanceanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceAnomaliesTable, employee.AttendanceAnomaliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertimes queries the overtimes edge of a Employee.
+func (c *EmployeeClient) QueryOvertimes(e *Employee) *OvertimeQuery {
+	query := (&OvertimeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(overtime.Table, overtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.OvertimesTable, employee.OvertimesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCalendarFeeds queries the calendar_feeds edge of a Employee.
+func (c *EmployeeClient) QueryCalendarFeeds(e *Employee) *CalendarFeedQuery {
+	query := (&CalendarFeedClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(calendarfeed.Table, calendarfeed.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.CalendarFeedsTable, employee.CalendarFeedsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySalaryComponentAssignments queries the salary_component_assignments edge of a Employee.
+func (c *EmployeeClient) QuerySalaryComponentAssignments(e *Employee) *SalaryComponentAssignmentQuery {
+	query := (&SalaryComponentAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(salarycomponentassignment.Table, salarycomponentassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.SalaryComponentAssignmentsTable, employee.SalaryComponentAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOfficeLocation queries the office_location edge of a Employee.
+func (c *EmployeeClient) QueryOfficeLocation(e *Employee) *OfficeLocationQuery {
+	query := (&OfficeLocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(officelocation.Table, officelocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employee.OfficeLocationTable, employee.OfficeLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmployeeClient) Hooks() []Hook {
+	return c.hooks.Employee
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmployeeClient) Interceptors() []Interceptor {
+	return c.inters.Employee
+}
+
+func (c *EmployeeClient) mutate(ctx context.Context, m *EmployeeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Employee mutation op: %q", m.Op())
+	}
+}
+
+// HolidayClient is a client for the Holiday schema.
+type HolidayClient struct {
+	config
+}
+
+// NewHolidayClient returns a client for the Holiday from the given config.
+func NewHolidayClient(c config) *HolidayClient {
+	return &HolidayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `holiday.Hooks(f(g(h())))`.
+func (c *HolidayClient) Use(hooks ...Hook) {
+	c.hooks.Holiday = append(c.hooks.Holiday, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `holiday.Intercept(f(g(h())))`.
+func (c *HolidayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Holiday = append(c.inters.Holiday, interceptors...)
+}
+
+// Create returns a builder for creating a Holiday entity.
+func (c *HolidayClient) Create() *HolidayCreate {
+	mutation := newHolidayMutation(c.config, OpCreate)
+	return &HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Holiday entities.
+func (c *HolidayClient) CreateBulk(builders ...*HolidayCreate) *HolidayCreateBulk {
+	return &HolidayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Holiday.
+func (c *HolidayClient) Update() *HolidayUpdate {
+	mutation := newHolidayMutation(c.config, OpUpdate)
+	return &HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HolidayClient) UpdateOne(h *Holiday) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHoliday(h))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HolidayClient) UpdateOneID(id uint64) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHolidayID(id))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Holiday.
+func (c *HolidayClient) Delete() *HolidayDelete {
+	mutation := newHolidayMutation(c.config, OpDelete)
+	return &HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HolidayClient) DeleteOne(h *Holiday) *HolidayDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HolidayClient) DeleteOneID(id uint64) *HolidayDeleteOne {
+	builder := c.Delete().Where(holiday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HolidayDeleteOne{builder}
+}
+
+// Query returns a query builder for Holiday.
+func (c *HolidayClient) Query() *HolidayQuery {
+	return &HolidayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHoliday},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Holiday entity by its id.
+func (c *HolidayClient) Get(ctx context.Context, id uint64) (*Holiday, error) {
+	return c.Query().Where(holiday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HolidayClient) GetX(ctx context.Context, id uint64) *Holiday {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HolidayClient) Hooks() []Hook {
+	return c.hooks.Holiday
+}
+
+// Interceptors returns the client interceptors.
+func (c *HolidayClient) Interceptors() []Interceptor {
+	return c.inters.Holiday
+}
+
+func (c *HolidayClient) mutate(ctx context.Context, m *HolidayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Holiday mutation op: %q", m.Op())
+	}
+}
+
+// KioskClient is a client for the Kiosk schema.
+type KioskClient struct {
+	config
+}
+
+// NewKioskClient returns a client for the Kiosk from the given config.
+func NewKioskClient(c config) *KioskClient {
+	return &KioskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kiosk.Hooks(f(g(h())))`.
+func (c *KioskClient) Use(hooks ...Hook) {
+	c.hooks.Kiosk = append(c.hooks.Kiosk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kiosk.Intercept(f(g(h())))`.
+func (c *KioskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Kiosk = append(c.inters.Kiosk, interceptors...)
+}
+
+// Create returns a builder for creating a Kiosk entity.
+func (c *KioskClient) Create() *KioskCreate {
+	mutation := newKioskMutation(c.config, OpCreate)
+	return &KioskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Kiosk entities.
+func (c *KioskClient) CreateBulk(builders ...*KioskCreate) *KioskCreateBulk {
+	return &KioskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Kiosk.
+func (c *KioskClient) Update() *KioskUpdate {
+	mutation := newKioskMutation(c.config, OpUpdate)
+	return &KioskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KioskClient) UpdateOne(k *Kiosk) *KioskUpdateOne {
+	mutation := newKioskMutation(c.config, OpUpdateOne, withKiosk(k))
+	return &KioskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KioskClient) UpdateOneID(id uint64) *KioskUpdateOne {
+	mutation := newKioskMutation(c.config, OpUpdateOne, withKioskID(id))
+	return &KioskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Kiosk.
+func (c *KioskClient) Delete() *KioskDelete {
+	mutation := newKioskMutation(c.config, OpDelete)
+	return &KioskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KioskClient) DeleteOne(k *Kiosk) *KioskDeleteOne {
+	return c.DeleteOneID(k.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KioskClient) DeleteOneID(id uint64) *KioskDeleteOne {
+	builder := c.Delete().Where(kiosk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KioskDeleteOne{builder}
+}
+
+// Query returns a query builder for Kiosk.
+func (c *KioskClient) Query() *KioskQuery {
+	return &KioskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKiosk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Kiosk entity by its id.
+func (c *KioskClient) Get(ctx context.Context, id uint64) (*Kiosk, error) {
+	return c.Query().Where(kiosk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KioskClient) GetX(ctx context.Context, id uint64) *Kiosk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOfficeLocation queries the office_location edge of a Kiosk.
+func (c *KioskClient) QueryOfficeLocation(k *Kiosk) *OfficeLocationQuery {
+	query := (&OfficeLocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := k.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, id),
+			sqlgraph.To(officelocation.Table, officelocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, kiosk.OfficeLocationTable, kiosk.OfficeLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(k.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendances queries the attendances edge of a Kiosk.
+func (c *KioskClient) QueryAttendances(k *Kiosk) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := k.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, kiosk.AttendancesTable, kiosk.AttendancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(k.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPunches queries the punches edge of a Kiosk.
+func (c *KioskClient) QueryPunches(k *Kiosk) *AttendancePunchQuery {
+	query := (&AttendancePunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := k.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, id),
+			sqlgraph.To(attendancepunch.Table, attendancepunch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, kiosk.PunchesTable, kiosk.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(k.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KioskClient) Hooks() []Hook {
+	return c.hooks.Kiosk
+}
+
+// Interceptors returns the client interceptors.
+func (c *KioskClient) Interceptors() []Interceptor {
+	return c.inters.Kiosk
+}
+
+func (c *KioskClient) mutate(ctx context.Context, m *KioskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KioskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KioskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KioskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KioskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Kiosk mutation op: %q", m.Op())
+	}
+}
+
+// LeaveBalanceClient is a client for the LeaveBalance schema.
+type LeaveBalanceClient struct {
+	config
+}
+
+// NewLeaveBalanceClient returns a client for the LeaveBalance from the given config.
+func NewLeaveBalanceClient(c config) *LeaveBalanceClient {
+	return &LeaveBalanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leavebalance.Hooks(f(g(h())))`.
+func (c *LeaveBalanceClient) Use(hooks ...Hook) {
+	c.hooks.LeaveBalance = append(c.hooks.LeaveBalance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leavebalance.Intercept(f(g(h())))`.
+func (c *LeaveBalanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveBalance = append(c.inters.LeaveBalance, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveBalance entity.
+func (c *LeaveBalanceClient) Create() *LeaveBalanceCreate {
+	mutation := newLeaveBalanceMutation(c.config, OpCreate)
+	return &LeaveBalanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveBalance entities.
+func (c *LeaveBalanceClient) CreateBulk(builders ...*LeaveBalanceCreate) *LeaveBalanceCreateBulk {
+	return &LeaveBalanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveBalance.
+func (c *LeaveBalanceClient) Update() *LeaveBalanceUpdate {
+	mutation := newLeaveBalanceMutation(c.config, OpUpdate)
+	return &LeaveBalanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveBalanceClient) UpdateOne(lb *LeaveBalance) *LeaveBalanceUpdateOne {
+	mutation := newLeaveBalanceMutation(c.config, OpUpdateOne, withLeaveBalance(lb))
+	return &LeaveBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveBalanceClient) UpdateOneID(id uint64) *LeaveBalanceUpdateOne {
+	mutation := newLeaveBalanceMutation(c.config, OpUpdateOne, withLeaveBalanceID(id))
+	return &LeaveBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveBalance.
+func (c *LeaveBalanceClient) Delete() *LeaveBalanceDelete {
+	mutation := newLeaveBalanceMutation(c.config, OpDelete)
+	return &LeaveBalanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveBalanceClient) DeleteOne(lb *LeaveBalance) *LeaveBalanceDeleteOne {
+	return c.DeleteOneID(lb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveBalanceClient) DeleteOneID(id uint64) *LeaveBalanceDeleteOne {
+	builder := c.Delete().Where(leavebalance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveBalanceDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveBalance.
+func (c *LeaveBalanceClient) Query() *LeaveBalanceQuery {
+	return &LeaveBalanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveBalance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveBalance entity by its id.
+func (c *LeaveBalanceClient) Get(ctx context.Context, id uint64) (*LeaveBalance, error) {
+	return c.Query().Where(leavebalance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveBalanceClient) GetX(ctx context.Context, id uint64) *LeaveBalance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a LeaveBalance.
+func (c *LeaveBalanceClient) QueryEmployee(lb *LeaveBalance) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavebalance.Table, leavebalance.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leavebalance.EmployeeTable, leavebalance.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(lb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveType queries the leave_type edge of a LeaveBalance.
+func (c *LeaveBalanceClient) QueryLeaveType(lb *LeaveBalance) *LeaveTypeQuery {
+	query := (&LeaveTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavebalance.Table, leavebalance.FieldID, id),
+			sqlgraph.To(leavetype.Table, leavetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leavebalance.LeaveTypeTable, leavebalance.LeaveTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(lb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveBalanceClient) Hooks() []Hook {
+	return c.hooks.LeaveBalance
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveBalanceClient) Interceptors() []Interceptor {
+	return c.inters.LeaveBalance
+}
+
+func (c *LeaveBalanceClient) mutate(ctx context.Context, m *LeaveBalanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveBalanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveBalanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveBalanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveBalanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveBalance mutation op: %q", m.Op())
+	}
+}
+
+// LeaveRequestClient is a client for the LeaveRequest schema.
+type LeaveRequestClient struct {
+	config
+}
+
+// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
+func NewLeaveRequestClient(c config) *LeaveRequestClient {
+	return &LeaveRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
+func (c *LeaveRequestClient) Use(hooks ...Hook) {
+	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
+func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveRequest entity.
+func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
+	mutation := newLeaveRequestMutation(c.config, OpCreate)
+	return &LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
+func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveRequest.
+func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
+	mutation := newLeaveRequestMutation(c.config, OpUpdate)
+	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveRequestClient) UpdateOne(lr *LeaveRequest) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(lr))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveRequestClient) UpdateOneID(id uint64) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveRequest.
+func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
+	mutation := newLeaveRequestMutation(c.config, OpDelete)
+	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveRequestClient) DeleteOne(lr *LeaveRequest) *LeaveRequestDeleteOne {
+	return c.DeleteOneID(lr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveRequestClient) DeleteOneID(id uint64) *LeaveRequestDeleteOne {
+	builder := c.Delete().Where(leaverequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveRequest.
+func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
+	return &LeaveRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveRequest entity by its id.
+func (c *LeaveRequestClient) Get(ctx context.Context, id uint64) (*LeaveRequest, error) {
+	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveRequestClient) GetX(ctx context.Context, id uint64) *LeaveRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryEmployee(lr *LeaveRequest) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.EmployeeTable, leaverequest.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveType queries the leave_type edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryLeaveType(lr *LeaveRequest) *LeaveTypeQuery {
+	query := (&LeaveTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(leavetype.Table, leavetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.LeaveTypeTable, leaverequest.LeaveTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveRequestClient) Hooks() []Hook {
+	return c.hooks.LeaveRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveRequestClient) Interceptors() []Interceptor {
+	return c.inters.LeaveRequest
+}
+
+func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+	}
+}
+
+// LeaveTypeClient is a client for the LeaveType schema.
+type LeaveTypeClient struct {
+	config
+}
+
+// NewLeaveTypeClient returns a client for the LeaveType from the given config.
+func NewLeaveTypeClient(c config) *LeaveTypeClient {
+	return &LeaveTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leavetype.Hooks(f(g(h())))`.
+func (c *LeaveTypeClient) Use(hooks ...Hook) {
+	c.hooks.LeaveType = append(c.hooks.LeaveType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leavetype.Intercept(f(g(h())))`.
+func (c *LeaveTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveType = append(c.inters.LeaveType, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveType entity.
+func (c *LeaveTypeClient) Create() *LeaveTypeCreate {
+	mutation := newLeaveTypeMutation(c.config, OpCreate)
+	return &LeaveTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveType entities.
+func (c *LeaveTypeClient) CreateBulk(builders ...*LeaveTypeCreate) *LeaveTypeCreateBulk {
+	return &LeaveTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveType.
+func (c *LeaveTypeClient) Update() *LeaveTypeUpdate {
+	mutation := newLeaveTypeMutation(c.config, OpUpdate)
+	return &LeaveTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveTypeClient) UpdateOne(lt *LeaveType) *LeaveTypeUpdateOne {
+	mutation := newLeaveTypeMutation(c.config, OpUpdateOne, withLeaveType(lt))
+	return &LeaveTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveTypeClient) UpdateOneID(id uint64) *LeaveTypeUpdateOne {
+	mutation := newLeaveTypeMutation(c.config, OpUpdateOne, withLeaveTypeID(id))
+	return &LeaveTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveType.
+func (c *LeaveTypeClient) Delete() *LeaveTypeDelete {
+	mutation := newLeaveTypeMutation(c.config, OpDelete)
+	return &LeaveTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveTypeClient) DeleteOne(lt *LeaveType) *LeaveTypeDeleteOne {
+	return c.DeleteOneID(lt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveTypeClient) DeleteOneID(id uint64) *LeaveTypeDeleteOne {
+	builder := c.Delete().Where(leavetype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveType.
+func (c *LeaveTypeClient) Query() *LeaveTypeQuery {
+	return &LeaveTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveType entity by its id.
+func (c *LeaveTypeClient) Get(ctx context.Context, id uint64) (*LeaveType, error) {
+	return c.Query().Where(leavetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveTypeClient) GetX(ctx context.Context, id uint64) *LeaveType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLeaveRequests queries the leave_requests edge of a LeaveType.
+func (c *LeaveTypeClient) QueryLeaveRequests(lt *LeaveType) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavetype.Table, leavetype.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, leavetype.LeaveRequestsTable, leavetype.LeaveRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveBalances queries the leave_balances edge of a LeaveType.
+func (c *LeaveTypeClient) QueryLeaveBalances(lt *LeaveType) *LeaveBalanceQuery {
+	query := (&LeaveBalanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavetype.Table, leavetype.FieldID, id),
+			sqlgraph.To(leavebalance.Table, leavebalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, leavetype.LeaveBalancesTable, leavetype.LeaveBalancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveTypeClient) Hooks() []Hook {
+	return c.hooks.LeaveType
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveTypeClient) Interceptors() []Interceptor {
+	return c.inters.LeaveType
+}
+
+func (c *LeaveTypeClient) mutate(ctx context.Context, m *LeaveTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveType mutation op: %q", m.Op())
+	}
+}
+
+// OfficeLocationClient is a client for the OfficeLocation schema.
+type OfficeLocationClient struct {
+	config
+}
+
+// NewOfficeLocationClient returns a client for the OfficeLocation from the given config.
+func NewOfficeLocationClient(c config) *OfficeLocationClient {
+	return &OfficeLocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `officelocation.Hooks(f(g(h())))`.
+func (c *OfficeLocationClient) Use(hooks ...Hook) {
+	c.hooks.OfficeLocation = append(c.hooks.OfficeLocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `officelocation.Intercept(f(g(h())))`.
+func (c *OfficeLocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OfficeLocation = append(c.inters.OfficeLocation, interceptors...)
+}
+
+// Create returns a builder for creating a OfficeLocation entity.
+func (c *OfficeLocationClient) Create() *OfficeLocationCreate {
+	mutation := newOfficeLocationMutation(c.config, OpCreate)
+	return &OfficeLocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OfficeLocation entities.
+func (c *OfficeLocationClient) CreateBulk(builders ...*OfficeLocationCreate) *OfficeLocationCreateBulk {
+	return &OfficeLocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OfficeLocation.
+func (c *OfficeLocationClient) Update() *OfficeLocationUpdate {
+	mutation := newOfficeLocationMutation(c.config, OpUpdate)
+	return &OfficeLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OfficeLocationClient) UpdateOne(ol *OfficeLocation) *OfficeLocationUpdateOne {
+	mutation := newOfficeLocationMutation(c.config, OpUpdateOne, withOfficeLocation(ol))
+	return &OfficeLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OfficeLocationClient) UpdateOneID(id uint64) *OfficeLocationUpdateOne {
+	mutation := newOfficeLocationMutation(c.config, OpUpdateOne, withOfficeLocationID(id))
+	return &OfficeLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OfficeLocation.
+func (c *OfficeLocationClient) Delete() *OfficeLocationDelete {
+	mutation := newOfficeLocationMutation(c.config, OpDelete)
+	return &OfficeLocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OfficeLocationClient) DeleteOne(ol *OfficeLocation) *OfficeLocationDeleteOne {
+	return c.DeleteOneID(ol.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OfficeLocationClient) DeleteOneID(id uint64) *OfficeLocationDeleteOne {
+	builder := c.Delete().Where(officelocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OfficeLocationDeleteOne{builder}
+}
+
+// Query returns a query builder for OfficeLocation.
+func (c *OfficeLocationClient) Query() *OfficeLocationQuery {
+	return &OfficeLocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOfficeLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OfficeLocation entity by its id.
+func (c *OfficeLocationClient) Get(ctx context.Context, id uint64) (*OfficeLocation, error) {
+	return c.Query().Where(officelocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OfficeLocationClient) GetX(ctx context.Context, id uint64) *OfficeLocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployees queries the employees edge of a OfficeLocation.
+func (c *OfficeLocationClient) QueryEmployees(ol *OfficeLocation) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ol.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.EmployeesTable, officelocation.EmployeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ol.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a OfficeLocation.
+func (c *OfficeLocationClient) QueryDevices(ol *OfficeLocation) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ol.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.DevicesTable, officelocation.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ol.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKiosks queries the kiosks edge of a OfficeLocation.
+func (c *OfficeLocationClient) QueryKiosks(ol *OfficeLocation) *KioskQuery {
+	query := (&KioskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ol.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, id),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.KiosksTable, officelocation.KiosksColumn),
+		)
+		fromV = sqlgraph.Neighbors(ol.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OfficeLocationClient) Hooks() []Hook {
+	return c.hooks.OfficeLocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *OfficeLocationClient) Interceptors() []Interceptor {
+	return c.inters.OfficeLocation
+}
+
+func (c *OfficeLocationClient) mutate(ctx context.Context, m *OfficeLocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OfficeLocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OfficeLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OfficeLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OfficeLocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OfficeLocation mutation op: %q", m.Op())
+	}
+}
+
+// OvertimeClient is a client for the Overtime schema.
+type OvertimeClient struct {
+	config
+}
+
+// NewOvertimeClient returns a client for the Overtime from the given config.
+func NewOvertimeClient(c config) *OvertimeClient {
+	return &OvertimeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `overtime.Hooks(f(g(h())))`.
+func (c *OvertimeClient) Use(hooks ...Hook) {
+	c.hooks.Overtime = append(c.hooks.Overtime, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `overtime.Intercept(f(g(h())))`.
+func (c *OvertimeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Overtime = append(c.inters.Overtime, interceptors...)
+}
+
+// Create returns a builder for creating a Overtime entity.
+func (c *OvertimeClient) Create() *OvertimeCreate {
+	mutation := newOvertimeMutation(c.config, OpCreate)
+	return &OvertimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Overtime entities.
+func (c *OvertimeClient) CreateBulk(builders ...*OvertimeCreate) *OvertimeCreateBulk {
+	return &OvertimeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Overtime.
+func (c *OvertimeClient) Update() *OvertimeUpdate {
+	mutation := newOvertimeMutation(c.config, OpUpdate)
+	return &OvertimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OvertimeClient) UpdateOne(o *Overtime) *OvertimeUpdateOne {
+	mutation := newOvertimeMutation(c.config, OpUpdateOne, withOvertime(o))
+	return &OvertimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OvertimeClient) UpdateOneID(id uint64) *OvertimeUpdateOne {
+	mutation := newOvertimeMutation(c.config, OpUpdateOne, withOvertimeID(id))
+	return &OvertimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Overtime.
+func (c *OvertimeClient) Delete() *OvertimeDelete {
+	mutation := newOvertimeMutation(c.config, OpDelete)
+	return &OvertimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OvertimeClient) DeleteOne(o *Overtime) *OvertimeDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OvertimeClient) DeleteOneID(id uint64) *OvertimeDeleteOne {
+	builder := c.Delete().Where(overtime.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OvertimeDeleteOne{builder}
+}
+
+// Query returns a query builder for Overtime.
+func (c *OvertimeClient) Query() *OvertimeQuery {
+	return &OvertimeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOvertime},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Overtime entity by its id.
+func (c *OvertimeClient) Get(ctx context.Context, id uint64) (*Overtime, error) {
+	return c.Query().Where(overtime.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OvertimeClient) GetX(ctx context.Context, id uint64) *Overtime {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a Overtime.
+func (c *OvertimeClient) QueryEmployee(o *Overtime) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtime.Table, overtime.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtime.EmployeeTable, overtime.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendance queries the attendance edge of a Overtime.
+func (c *OvertimeClient) QueryAttendance(o *Overtime) *AttendanceQuery {
+	query := (&AttendanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtime.Table, overtime.FieldID, id),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, overtime.AttendanceTable, overtime.AttendanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OvertimeClient) Hooks() []Hook {
+	return c.hooks.Overtime
+}
+
+// Interceptors returns the client interceptors.
+func (c *OvertimeClient) Interceptors() []Interceptor {
+	return c.inters.Overtime
+}
+
+func (c *OvertimeClient) mutate(ctx context.Context, m *OvertimeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OvertimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OvertimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OvertimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OvertimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Overtime mutation op: %q", m.Op())
+	}
+}
+
+// PayrollPeriodClient is a client for the PayrollPeriod schema.
+type PayrollPeriodClient struct {
+	config
+}
+
+// NewPayrollPeriodClient returns a client for the PayrollPeriod from the given config.
+func NewPayrollPeriodClient(c config) *PayrollPeriodClient {
+	return &PayrollPeriodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrollperiod.Hooks(f(g(h())))`.
+func (c *PayrollPeriodClient) Use(hooks ...Hook) {
+	c.hooks.PayrollPeriod = append(c.hooks.PayrollPeriod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrollperiod.Intercept(f(g(h())))`.
+func (c *PayrollPeriodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayrollPeriod = append(c.inters.PayrollPeriod, interceptors...)
+}
+
+// Create returns a builder for creating a PayrollPeriod entity.
+func (c *PayrollPeriodClient) Create() *PayrollPeriodCreate {
+	mutation := newPayrollPeriodMutation(c.config, OpCreate)
+	return &PayrollPeriodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayrollPeriod entities.
+func (c *PayrollPeriodClient) CreateBulk(builders ...*PayrollPeriodCreate) *PayrollPeriodCreateBulk {
+	return &PayrollPeriodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayrollPeriod.
+func (c *PayrollPeriodClient) Update() *PayrollPeriodUpdate {
+	mutation := newPayrollPeriodMutation(c.config, OpUpdate)
+	return &PayrollPeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayrollPeriodClient) UpdateOne(pp *PayrollPeriod) *PayrollPeriodUpdateOne {
+	mutation := newPayrollPeriodMutation(c.config, OpUpdateOne, withPayrollPeriod(pp))
+	return &PayrollPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayrollPeriodClient) UpdateOneID(id uint64) *PayrollPeriodUpdateOne {
+	mutation := newPayrollPeriodMutation(c.config, OpUpdateOne, withPayrollPeriodID(id))
+	return &PayrollPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayrollPeriod.
+func (c *PayrollPeriodClient) Delete() *PayrollPeriodDelete {
+	mutation := newPayrollPeriodMutation(c.config, OpDelete)
+	return &PayrollPeriodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayrollPeriodClient) DeleteOne(pp *PayrollPeriod) *PayrollPeriodDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayrollPeriodClient) DeleteOneID(id uint64) *PayrollPeriodDeleteOne {
+	builder := c.Delete().Where(payrollperiod.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayrollPeriodDeleteOne{builder}
+}
+
+// Query returns a query builder for PayrollPeriod.
+func (c *PayrollPeriodClient) Query() *PayrollPeriodQuery {
+	return &PayrollPeriodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayrollPeriod},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayrollPeriod entity by its id.
+func (c *PayrollPeriodClient) Get(ctx context.Context, id uint64) (*PayrollPeriod, error) {
+	return c.Query().Where(payrollperiod.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayrollPeriodClient) GetX(ctx context.Context, id uint64) *PayrollPeriod {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEvents queries the events edge of a PayrollPeriod.
+func (c *PayrollPeriodClient) QueryEvents(pp *PayrollPeriod) *PayrollPeriodEventQuery {
+	query := (&PayrollPeriodEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollperiod.Table, payrollperiod.FieldID, id),
+			sqlgraph.To(payrollperiodevent.Table, payrollperiodevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollperiod.EventsTable, payrollperiod.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayrollPeriodClient) Hooks() []Hook {
+	return c.hooks.PayrollPeriod
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayrollPeriodClient) Interceptors() []Interceptor {
+	return c.inters.PayrollPeriod
+}
+
+func (c *PayrollPeriodClient) mutate(ctx context.Context, m *PayrollPeriodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayrollPeriodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayrollPeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayrollPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayrollPeriodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayrollPeriod mutation op: %q", m.Op())
+	}
+}
+
+// PayrollPeriodEventClient is a client for the PayrollPeriodEvent schema.
+type PayrollPeriodEventClient struct {
+	config
+}
+
+// NewPayrollPeriodEventClient returns a client for the PayrollPeriodEvent from the given config.
+func NewPayrollPeriodEventClient(c config) *PayrollPeriodEventClient {
+	return &PayrollPeriodEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrollperiodevent.Hooks(f(g(h())))`.
+func (c *PayrollPeriodEventClient) Use(hooks ...Hook) {
+	c.hooks.PayrollPeriodEvent = append(c.hooks.PayrollPeriodEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrollperiodevent.Intercept(f(g(h())))`.
+func (c *PayrollPeriodEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayrollPeriodEvent = append(c.inters.PayrollPeriodEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PayrollPeriodEvent entity.
+func (c *PayrollPeriodEventClient) Create() *PayrollPeriodEventCreate {
+	mutation := newPayrollPeriodEventMutation(c.config, OpCreate)
+	return &PayrollPeriodEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayrollPeriodEvent entities.
+func (c *PayrollPeriodEventClient) CreateBulk(builders ...*PayrollPeriodEventCreate) *PayrollPeriodEventCreateBulk {
+	return &PayrollPeriodEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayrollPeriodEvent.
+func (c *PayrollPeriodEventClient) Update() *PayrollPeriodEventUpdate {
+	mutation := newPayrollPeriodEventMutation(c.config, OpUpdate)
+	return &PayrollPeriodEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayrollPeriodEventClient) UpdateOne(ppe *PayrollPeriodEvent) *PayrollPeriodEventUpdateOne {
+	mutation := newPayrollPeriodEventMutation(c.config, OpUpdateOne, withPayrollPeriodEvent(ppe))
+	return &PayrollPeriodEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayrollPeriodEventClient) UpdateOneID(id uint64) *PayrollPeriodEventUpdateOne {
+	mutation := newPayrollPeriodEventMutation(c.config, OpUpdateOne, withPayrollPeriodEventID(id))
+	return &PayrollPeriodEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayrollPeriodEvent.
+func (c *PayrollPeriodEventClient) Delete() *PayrollPeriodEventDelete {
+	mutation := newPayrollPeriodEventMutation(c.config, OpDelete)
+	return &PayrollPeriodEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayrollPeriodEventClient) DeleteOne(ppe *PayrollPeriodEvent) *PayrollPeriodEventDeleteOne {
+	return c.DeleteOneID(ppe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayrollPeriodEventClient) DeleteOneID(id uint64) *PayrollPeriodEventDeleteOne {
+	builder := c.Delete().Where(payrollperiodevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayrollPeriodEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PayrollPeriodEvent.
+func (c *PayrollPeriodEventClient) Query() *PayrollPeriodEventQuery {
+	return &PayrollPeriodEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayrollPeriodEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayrollPeriodEvent entity by its id.
+func (c *PayrollPeriodEventClient) Get(ctx context.Context, id uint64) (*PayrollPeriodEvent, error) {
+	return c.Query().Where(payrollperiodevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayrollPeriodEventClient) GetX(ctx context.Context, id uint64) *PayrollPeriodEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPayrollPeriod queries the payroll_period edge of a PayrollPeriodEvent.
+func (c *PayrollPeriodEventClient) QueryPayrollPeriod(ppe *PayrollPeriodEvent) *PayrollPeriodQuery {
+	query := (&PayrollPeriodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ppe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollperiodevent.Table, payrollperiodevent.FieldID, id),
+			sqlgraph.To(payrollperiod.Table, payrollperiod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payrollperiodevent.PayrollPeriodTable, payrollperiodevent.PayrollPeriodColumn),
+		)
+		fromV = sqlgraph.Neighbors(ppe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayrollPeriodEventClient) Hooks() []Hook {
+	return c.hooks.PayrollPeriodEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayrollPeriodEventClient) Interceptors() []Interceptor {
+	return c.inters.PayrollPeriodEvent
+}
+
+func (c *PayrollPeriodEventClient) mutate(ctx context.Context, m *PayrollPeriodEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayrollPeriodEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayrollPeriodEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayrollPeriodEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayrollPeriodEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayrollPeriodEvent mutation op: %q", m.Op())
+	}
+}
+
+// PayrollRunClient is a client for the PayrollRun schema.
+type PayrollRunClient struct {
+	config
+}
+
+// NewPayrollRunClient returns a client for the PayrollRun from the given config.
+func NewPayrollRunClient(c config) *PayrollRunClient {
+	return &PayrollRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrollrun.Hooks(f(g(h())))`.
+func (c *PayrollRunClient) Use(hooks ...Hook) {
+	c.hooks.PayrollRun = append(c.hooks.PayrollRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrollrun.Intercept(f(g(h())))`.
+func (c *PayrollRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayrollRun = append(c.inters.PayrollRun, interceptors...)
+}
+
+// Create returns a builder for creating a PayrollRun entity.
+func (c *PayrollRunClient) Create() *PayrollRunCreate {
+	mutation := newPayrollRunMutation(c.config, OpCreate)
+	return &PayrollRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayrollRun entities.
+func (c *PayrollRunClient) CreateBulk(builders ...*PayrollRunCreate) *PayrollRunCreateBulk {
+	return &PayrollRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayrollRun.
+func (c *PayrollRunClient) Update() *PayrollRunUpdate {
+	mutation := newPayrollRunMutation(c.config, OpUpdate)
+	return &PayrollRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayrollRunClient) UpdateOne(pr *PayrollRun) *PayrollRunUpdateOne {
+	mutation := newPayrollRunMutation(c.config, OpUpdateOne, withPayrollRun(pr))
+	return &PayrollRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayrollRunClient) UpdateOneID(id uint64) *PayrollRunUpdateOne {
+	mutation := newPayrollRunMutation(c.config, OpUpdateOne, withPayrollRunID(id))
+	return &PayrollRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayrollRun.
+func (c *PayrollRunClient) Delete() *PayrollRunDelete {
+	mutation := newPayrollRunMutation(c.config, OpDelete)
+	return &PayrollRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayrollRunClient) DeleteOne(pr *PayrollRun) *PayrollRunDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayrollRunClient) DeleteOneID(id uint64) *PayrollRunDeleteOne {
+	builder := c.Delete().Where(payrollrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayrollRunDeleteOne{builder}
+}
+
+// Query returns a query builder for PayrollRun.
+func (c *PayrollRunClient) Query() *PayrollRunQuery {
+	return &PayrollRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayrollRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayrollRun entity by its id.
+func (c *PayrollRunClient) Get(ctx context.Context, id uint64) (*PayrollRun, error) {
+	return c.Query().Where(payrollrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayrollRunClient) GetX(ctx context.Context, id uint64) *PayrollRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySalaryCalculations queries the salary_calculations edge of a PayrollRun.
+func (c *PayrollRunClient) QuerySalaryCalculations(pr *PayrollRun) *SalaryCalculationQuery {
+	query := (&SalaryCalculationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrun.Table, payrollrun.FieldID, id),
+			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollrun.SalaryCalculationsTable, payrollrun.SalaryCalculationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvents queries the events edge of a PayrollRun.
+func (c *PayrollRunClient) QueryEvents(pr *PayrollRun) *PayrollRunEventQuery {
+	query := (&PayrollRunEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrun.Table, payrollrun.FieldID, id),
+			sqlgraph.To(payrollrunevent.Table, payrollrunevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollrun.EventsTable, payrollrun.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayrollRunClient) Hooks() []Hook {
+	return c.hooks.PayrollRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayrollRunClient) Interceptors() []Interceptor {
+	return c.inters.PayrollRun
+}
+
+func (c *PayrollRunClient) mutate(ctx context.Context, m *PayrollRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayrollRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayrollRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayrollRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayrollRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayrollRun mutation op: %q", m.Op())
+	}
+}
+
+// PayrollRunEventClient is a client for the PayrollRunEvent schema.
+type PayrollRunEventClient struct {
+	config
+}
+
+// NewPayrollRunEventClient returns a client for the PayrollRunEvent from the given config.
+func NewPayrollRunEventClient(c config) *PayrollRunEventClient {
+	return &PayrollRunEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrollrunevent.Hooks(f(g(h())))`.
+func (c *PayrollRunEventClient) Use(hooks ...Hook) {
+	c.hooks.PayrollRunEvent = append(c.hooks.PayrollRunEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrollrunevent.Intercept(f(g(h())))`.
+func (c *PayrollRunEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayrollRunEvent = append(c.inters.PayrollRunEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PayrollRunEvent entity.
+func (c *PayrollRunEventClient) Create() *PayrollRunEventCreate {
+	mutation := newPayrollRunEventMutation(c.config, OpCreate)
+	return &PayrollRunEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayrollRunEvent entities.
+func (c *PayrollRunEventClient) CreateBulk(builders ...*PayrollRunEventCreate) *PayrollRunEventCreateBulk {
+	return &PayrollRunEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayrollRunEvent.
+func (c *PayrollRunEventClient) Update() *PayrollRunEventUpdate {
+	mutation := newPayrollRunEventMutation(c.config, OpUpdate)
+	return &PayrollRunEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayrollRunEventClient) UpdateOne(pre *PayrollRunEvent) *PayrollRunEventUpdateOne {
+	mutation := newPayrollRunEventMutation(c.config, OpUpdateOne, withPayrollRunEvent(pre))
+	return &PayrollRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayrollRunEventClient) UpdateOneID(id uint64) *PayrollRunEventUpdateOne {
+	mutation := newPayrollRunEventMutation(c.config, OpUpdateOne, withPayrollRunEventID(id))
+	return &PayrollRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayrollRunEvent.
+func (c *PayrollRunEventClient) Delete() *PayrollRunEventDelete {
+	mutation := newPayrollRunEventMutation(c.config, OpDelete)
+	return &PayrollRunEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayrollRunEventClient) DeleteOne(pre *PayrollRunEvent) *PayrollRunEventDeleteOne {
+	return c.DeleteOneID(pre.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayrollRunEventClient) DeleteOneID(id uint64) *PayrollRunEventDeleteOne {
+	builder := c.Delete().Where(payrollrunevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayrollRunEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PayrollRunEvent.
+func (c *PayrollRunEventClient) Query() *PayrollRunEventQuery {
+	return &PayrollRunEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayrollRunEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayrollRunEvent entity by its id.
+func (c *PayrollRunEventClient) Get(ctx context.Context, id uint64) (*PayrollRunEvent, error) {
+	return c.Query().Where(payrollrunevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayrollRunEventClient) GetX(ctx context.Context, id uint64) *PayrollRunEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPayrollRun queries the payroll_run edge of a PayrollRunEvent.
+func (c *PayrollRunEventClient) QueryPayrollRun(pre *PayrollRunEvent) *PayrollRunQuery {
+	query := (&PayrollRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pre.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrunevent.Table, payrollrunevent.FieldID, id),
+			sqlgraph.To(payrollrun.Table, payrollrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payrollrunevent.PayrollRunTable, payrollrunevent.PayrollRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(pre.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayrollRunEventClient) Hooks() []Hook {
+	return c.hooks.PayrollRunEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayrollRunEventClient) Interceptors() []Interceptor {
+	return c.inters.PayrollRunEvent
+}
+
+func (c *PayrollRunEventClient) mutate(ctx context.Context, m *PayrollRunEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayrollRunEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayrollRunEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayrollRunEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayrollRunEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayrollRunEvent mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id uint64) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id uint64) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id uint64) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// RoleUserClient is a client for the RoleUser schema.
+type RoleUserClient struct {
+	config
+}
+
+// NewRoleUserClient returns a client for the RoleUser from the given config.
+func NewRoleUserClient(c config) *RoleUserClient {
+	return &RoleUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roleuser.Hooks(f(g(h())))`.
+func (c *RoleUserClient) Use(hooks ...Hook) {
+	c.hooks.RoleUser = append(c.hooks.RoleUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roleuser.Intercept(f(g(h())))`.
+func (c *RoleUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleUser = append(c.inters.RoleUser, interceptors...)
+}
+
+// Create returns a builder for creating a RoleUser entity.
+func (c *RoleUserClient) Create() *RoleUserCreate {
+	mutation := newRoleUserMutation(c.config, OpCreate)
+	return &RoleUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleUser entities.
+func (c *RoleUserClient) CreateBulk(builders ...*RoleUserCreate) *RoleUserCreateBulk {
+	return &RoleUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleUser.
+func (c *RoleUserClient) Update() *RoleUserUpdate {
+	mutation := newRoleUserMutation(c.config, OpUpdate)
+	return &RoleUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleUserClient) UpdateOne(ru *RoleUser) *RoleUserUpdateOne {
+	mutation := newRoleUserMutation(c.config, OpUpdateOne, withRoleUser(ru))
+	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleUserClient) UpdateOneID(id uint64) *RoleUserUpdateOne {
+	mutation := newRoleUserMutation(c.config, OpUpdateOne, withRoleUserID(id))
+	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleUser.
+func (c *RoleUserClient) Delete() *RoleUserDelete {
+	mutation := newRoleUserMutation(c.config, OpDelete)
+	return &RoleUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleUserClient) DeleteOne(ru *RoleUser) *RoleUserDeleteOne {
+	return c.DeleteOneID(ru.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleUserClient) DeleteOneID(id uint64) *RoleUserDeleteOne {
+	builder := c.Delete().Where(roleuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleUserDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleUser.
+func (c *RoleUserClient) Query() *RoleUserQuery {
+	return &RoleUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleUser entity by its id.
+func (c *RoleUserClient) Get(ctx context.Context, id uint64) (*RoleUser, error) {
+	return c.Query().Where(roleuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleUserClient) GetX(ctx context.Context, id uint64) *RoleUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoleUserClient) Hooks() []Hook {
+	return c.hooks.RoleUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleUserClient) Interceptors() []Interceptor {
+	return c.inters.RoleUser
+}
+
+func (c *RoleUserClient) mutate(ctx context.Context, m *RoleUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleUser mutation op: %q", m.Op())
+	}
+}
+
+// SalaryCalculationClient is a client for the SalaryCalculation schema.
+type SalaryCalculationClient struct {
+	config
+}
+
+// NewSalaryCalculationClient returns a client for the SalaryCalculation from the given config.
+func NewSalaryCalculationClient(c config) *SalaryCalculationClient {
+	return &SalaryCalculationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `salarycalculation.Hooks(f(g(h())))`.
+func (c *SalaryCalculationClient) Use(hooks ...Hook) {
+	c.hooks.SalaryCalculation = append(c.hooks.SalaryCalculation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `salarycalculation.Intercept(f(g(h())))`.
+func (c *SalaryCalculationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SalaryCalculation = append(c.inters.SalaryCalculation, interceptors...)
+}
+
+// Create returns a builder for creating a SalaryCalculation entity.
+func (c *SalaryCalculationClient) Create() *SalaryCalculationCreate {
+	mutation := newSalaryCalculationMutation(c.config, OpCreate)
+	return &SalaryCalculationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SalaryCalculation entities.
+func (c *SalaryCalculationClient) CreateBulk(builders ...*SalaryCalculationCreate) *SalaryCalculationCreateBulk {
+	return &SalaryCalculationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SalaryCalculation.
+func (c *SalaryCalculationClient) Update() *SalaryCalculationUpdate {
+	mutation := newSalaryCalculationMutation(c.config, OpUpdate)
+	return &SalaryCalculationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SalaryCalculationClient) UpdateOne(sc *SalaryCalculation) *SalaryCalculationUpdateOne {
+	mutation := newSalaryCalculationMutation(c.config, OpUpdateOne, withSalaryCalculation(sc))
+	return &SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SalaryCalculationClient) UpdateOneID(id uint64) *SalaryCalculationUpdateOne {
+	mutation := newSalaryCalculationMutation(c.config, OpUpdateOne, withSalaryCalculationID(id))
+	return &SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SalaryCalculation.
+func (c *SalaryCalculationClient) Delete() *SalaryCalculationDelete {
+	mutation := newSalaryCalculationMutation(c.config, OpDelete)
+	return &SalaryCalculationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SalaryCalculationClient) DeleteOne(sc *SalaryCalculation) *SalaryCalculationDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SalaryCalculationClient) DeleteOneID(id uint64) *SalaryCalculationDeleteOne {
+	builder := c.Delete().Where(salarycalculation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SalaryCalculationDeleteOne{builder}
+}
+
+// Query returns a query builder for SalaryCalculation.
+func (c *SalaryCalculationClient) Query() *SalaryCalculationQuery {
+	return &SalaryCalculationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSalaryCalculation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SalaryCalculation entity by its id.
+func (c *SalaryCalculationClient) Get(ctx context.Context, id uint64) (*SalaryCalculation, error) {
+	return c.Query().Where(salarycalculation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SalaryCalculationClient) GetX(ctx context.Context, id uint64) *SalaryCalculation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a SalaryCalculation.
+func (c *SalaryCalculationClient) QueryEmployee(sc *SalaryCalculation) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculation.EmployeeTable, salarycalculation.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayrollRun queries the payroll_run edge of a SalaryCalculation.
+func (c *SalaryCalculationClient) QueryPayrollRun(sc *SalaryCalculation) *PayrollRunQuery {
+	query := (&PayrollRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, id),
+			sqlgraph.To(payrollrun.Table, payrollrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculation.PayrollRunTable, salarycalculation.PayrollRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a SalaryCalculation.
+func (c *SalaryCalculationClient) QueryItems(sc *SalaryCalculation) *SalaryCalculationItemQuery {
+	query := (&SalaryCalculationItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, id),
+			sqlgraph.To(salarycalculationitem.Table, salarycalculationitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycalculation.ItemsTable, salarycalculation.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SalaryCalculationClient) Hooks() []Hook {
+	return c.hooks.SalaryCalculation
+}
+
+// Interceptors returns the client interceptors.
+func (c *SalaryCalculationClient) Interceptors() []Interceptor {
+	return c.inters.SalaryCalculation
+}
+
+func (c *SalaryCalculationClient) mutate(ctx context.Context, m *SalaryCalculationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SalaryCalculationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SalaryCalculationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SalaryCalculationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SalaryCalculation mutation op: %q", m.Op())
+	}
+}
+
+// SalaryCalculationItemClient is a client for the SalaryCalculationItem schema.
+type SalaryCalculationItemClient struct {
+	config
+}
+
+// NewSalaryCalculationItemClient returns a client for the SalaryCalculationItem from the given config.
+func NewSalaryCalculationItemClient(c config) *SalaryCalculationItemClient {
+	return &SalaryCalculationItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `salarycalculationitem.Hooks(f(g(h())))`.
+func (c *SalaryCalculationItemClient) Use(hooks ...Hook) {
+	c.hooks.SalaryCalculationItem = append(c.hooks.SalaryCalculationItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `salarycalculationitem.Intercept(f(g(h())))`.
+func (c *SalaryCalculationItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SalaryCalculationItem = append(c.inters.SalaryCalculationItem, interceptors...)
+}
+
+// Create returns a builder for creating a SalaryCalculationItem entity.
+func (c *SalaryCalculationItemClient) Create() *SalaryCalculationItemCreate {
+	mutation := newSalaryCalculationItemMutation(c.config, OpCreate)
+	return &SalaryCalculationItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SalaryCalculationItem entities.
+func (c *SalaryCalculationItemClient) CreateBulk(builders ...*SalaryCalculationItemCreate) *SalaryCalculationItemCreateBulk {
+	return &SalaryCalculationItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SalaryCalculationItem.
+func (c *SalaryCalculationItemClient) Update() *SalaryCalculationItemUpdate {
+	mutation := newSalaryCalculationItemMutation(c.config, OpUpdate)
+	return &SalaryCalculationItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SalaryCalculationItemClient) UpdateOne(sci *SalaryCalculationItem) *SalaryCalculationItemUpdateOne {
+	mutation := newSalaryCalculationItemMutation(c.config, OpUpdateOne, withSalaryCalculationItem(sci))
+	return &SalaryCalculationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SalaryCalculationItemClient) UpdateOneID(id uint64) *SalaryCalculationItemUpdateOne {
+	mutation := newSalaryCalculationItemMutation(c.config, OpUpdateOne, withSalaryCalculationItemID(id))
+	return &SalaryCalculationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SalaryCalculationItem.
+func (c *SalaryCalculationItemClient) Delete() *SalaryCalculationItemDelete {
+	mutation := newSalaryCalculationItemMutation(c.config, OpDelete)
+	return &SalaryCalculationItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SalaryCalculationItemClient) DeleteOne(sci *SalaryCalculationItem) *SalaryCalculationItemDeleteOne {
+	return c.DeleteOneID(sci.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SalaryCalculationItemClient) DeleteOneID(id uint64) *SalaryCalculationItemDeleteOne {
+	builder := c.Delete().Where(salarycalculationitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SalaryCalculationItemDeleteOne{builder}
+}
+
+// Query returns a query builder for SalaryCalculationItem.
+func (c *SalaryCalculationItemClient) Query() *SalaryCalculationItemQuery {
+	return &SalaryCalculationItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSalaryCalculationItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SalaryCalculationItem entity by its id.
+func (c *SalaryCalculationItemClient) Get(ctx context.Context, id uint64) (*SalaryCalculationItem, error) {
+	return c.Query().Where(salarycalculationitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SalaryCalculationItemClient) GetX(ctx context.Context, id uint64) *SalaryCalculationItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySalaryCalculation queries the salary_calculation edge of a SalaryCalculationItem.
+func (c *SalaryCalculationItemClient) QuerySalaryCalculation(sci *SalaryCalculationItem) *SalaryCalculationQuery {
+	query := (&SalaryCalculationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sci.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculationitem.Table, salarycalculationitem.FieldID, id),
+			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculationitem.SalaryCalculationTable, salarycalculationitem.SalaryCalculationColumn),
+		)
+		fromV = sqlgraph.Neighbors(sci.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySalaryComponent queries the salary_component edge of a SalaryCalculationItem.
+func (c *SalaryCalculationItemClient) QuerySalaryComponent(sci *SalaryCalculationItem) *SalaryComponentQuery {
+	query := (&SalaryComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sci.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculationitem.Table, salarycalculationitem.FieldID, id),
+			sqlgraph.To(salarycomponent.Table, salarycomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculationitem.SalaryComponentTable, salarycalculationitem.SalaryComponentColumn),
+		)
+		fromV = sqlgraph.Neighbors(sci.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SalaryCalculationItemClient) Hooks() []Hook {
+	return c.hooks.SalaryCalculationItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *SalaryCalculationItemClient) Interceptors() []Interceptor {
+	return c.inters.SalaryCalculationItem
+}
+
+func (c *SalaryCalculationItemClient) mutate(ctx context.Context, m *SalaryCalculationItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SalaryCalculationItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SalaryCalculationItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SalaryCalculationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SalaryCalculationItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SalaryCalculationItem mutation op: %q", m.Op())
+	}
+}
+
+// SalaryComponentClient is a client for the SalaryComponent schema.
+type SalaryComponentClient struct {
+	config
+}
+
+// NewSalaryComponentClient returns a client for the SalaryComponent from the given config.
+func NewSalaryComponentClient(c config) *SalaryComponentClient {
+	return &SalaryComponentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `salarycomponent.Hooks(f(g(h())))`.
+func (c *SalaryComponentClient) Use(hooks ...Hook) {
+	c.hooks.SalaryComponent = append(c.hooks.SalaryComponent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `salarycomponent.Intercept(f(g(h())))`.
+func (c *SalaryComponentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SalaryComponent = append(c.inters.SalaryComponent, interceptors...)
+}
+
+// Create returns a builder for creating a SalaryComponent entity.
+func (c *SalaryComponentClient) Create() *SalaryComponentCreate {
+	mutation := newSalaryComponentMutation(c.config, OpCreate)
+	return &SalaryComponentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SalaryComponent entities.
+func (c *SalaryComponentClient) CreateBulk(builders ...*SalaryComponentCreate) *SalaryComponentCreateBulk {
+	return &SalaryComponentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SalaryComponent.
+func (c *SalaryComponentClient) Update() *SalaryComponentUpdate {
+	mutation := newSalaryComponentMutation(c.config, OpUpdate)
+	return &SalaryComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SalaryComponentClient) UpdateOne(sc *SalaryComponent) *SalaryComponentUpdateOne {
+	mutation := newSalaryComponentMutation(c.config, OpUpdateOne, withSalaryComponent(sc))
+	return &SalaryComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SalaryComponentClient) UpdateOneID(id uint64) *SalaryComponentUpdateOne {
+	mutation := newSalaryComponentMutation(c.config, OpUpdateOne, withSalaryComponentID(id))
+	return &SalaryComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SalaryComponent.
+func (c *SalaryComponentClient) Delete() *SalaryComponentDelete {
+	mutation := newSalaryComponentMutation(c.config, OpDelete)
+	return &SalaryComponentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SalaryComponentClient) DeleteOne(sc *SalaryComponent) *SalaryComponentDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SalaryComponentClient) DeleteOneID(id uint64) *SalaryComponentDeleteOne {
+	builder := c.Delete().Where(salarycomponent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SalaryComponentDeleteOne{builder}
+}
+
+// Query returns a query builder for SalaryComponent.
+func (c *SalaryComponentClient) Query() *SalaryComponentQuery {
+	return &SalaryComponentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeSalaryComponent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id uint64) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a SalaryComponent entity by its id.
+func (c *SalaryComponentClient) Get(ctx context.Context, id uint64) (*SalaryComponent, error) {
+	return c.Query().Where(salarycomponent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
+func (c *SalaryComponentClient) GetX(ctx context.Context, id uint64) *SalaryComponent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -616,117 +4320,149 @@ func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
 	return obj
 }
 
+// QueryAssignments queries the assignments edge of a SalaryComponent.
+func (c *SalaryComponentClient) QueryAssignments(sc *SalaryComponent) *SalaryComponentAssignmentQuery {
+	query := (&SalaryComponentAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponent.Table, salarycomponent.FieldID, id),
+			sqlgraph.To(salarycomponentassignment.Table, salarycomponentassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycomponent.AssignmentsTable, salarycomponent.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCalculationItems queries the calculation_items edge of a SalaryComponent.
+func (c *SalaryComponentClient) QueryCalculationItems(sc *SalaryComponent) *SalaryCalculationItemQuery {
+	query := (&SalaryCalculationItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponent.Table, salarycomponent.FieldID, id),
+			sqlgraph.To(salarycalculationitem.Table, salarycalculationitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycomponent.CalculationItemsTable, salarycomponent.CalculationItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *SalaryComponentClient) Hooks() []Hook {
+	return c.hooks.SalaryComponent
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *SalaryComponentClient) Interceptors() []Interceptor {
+	return c.inters.SalaryComponent
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *SalaryComponentClient) mutate(ctx context.Context, m *SalaryComponentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SalaryComponentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SalaryComponent mutation op: %q", m.Op())
 	}
 }
 
-// RoleUserClient is a client for the RoleUser schema.
-type RoleUserClient struct {
+// SalaryComponentAssignmentClient is a client for the SalaryComponentAssignment schema.
+type SalaryComponentAssignmentClient struct {
 	config
 }
 
-// NewRoleUserClient returns a client for the RoleUser from the given config.
-func NewRoleUserClient(c config) *RoleUserClient {
-	return &RoleUserClient{config: c}
+// NewSalaryComponentAssignmentClient returns a client for the SalaryComponentAssignment from the given config.
+func NewSalaryComponentAssignmentClient(c config) *SalaryComponentAssignmentClient {
+	return &SalaryComponentAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `roleuser.Hooks(f(g(h())))`.
-func (c *RoleUserClient) Use(hooks ...Hook) {
-	c.hooks.RoleUser = append(c.hooks.RoleUser, hooks...)
+// A call to `Use(f, g, h)` equals to `salarycomponentassignment.Hooks(f(g(h())))`.
+func (c *SalaryComponentAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.SalaryComponentAssignment = append(c.hooks.SalaryComponentAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `roleuser.Intercept(f(g(h())))`.
-func (c *RoleUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RoleUser = append(c.inters.RoleUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `salarycomponentassignment.Intercept(f(g(h())))`.
+func (c *SalaryComponentAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SalaryComponentAssignment = append(c.inters.SalaryComponentAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a RoleUser entity.
-func (c *RoleUserClient) Create() *RoleUserCreate {
-	mutation := newRoleUserMutation(c.config, OpCreate)
-	return &RoleUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SalaryComponentAssignment entity.
+func (c *SalaryComponentAssignmentClient) Create() *SalaryComponentAssignmentCreate {
+	mutation := newSalaryComponentAssignmentMutation(c.config, OpCreate)
+	return &SalaryComponentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RoleUser entities.
-func (c *RoleUserClient) CreateBulk(builders ...*RoleUserCreate) *RoleUserCreateBulk {
-	return &RoleUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SalaryComponentAssignment entities.
+func (c *SalaryComponentAssignmentClient) CreateBulk(builders ...*SalaryComponentAssignmentCreate) *SalaryComponentAssignmentCreateBulk {
+	return &SalaryComponentAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RoleUser.
-func (c *RoleUserClient) Update() *RoleUserUpdate {
-	mutation := newRoleUserMutation(c.config, OpUpdate)
-	return &RoleUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SalaryComponentAssignment.
+func (c *SalaryComponentAssignmentClient) Update() *SalaryComponentAssignmentUpdate {
+	mutation := newSalaryComponentAssignmentMutation(c.config, OpUpdate)
+	return &SalaryComponentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleUserClient) UpdateOne(ru *RoleUser) *RoleUserUpdateOne {
-	mutation := newRoleUserMutation(c.config, OpUpdateOne, withRoleUser(ru))
-	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SalaryComponentAssignmentClient) UpdateOne(sca *SalaryComponentAssignment) *SalaryComponentAssignmentUpdateOne {
+	mutation := newSalaryComponentAssignmentMutation(c.config, OpUpdateOne, withSalaryComponentAssignment(sca))
+	return &SalaryComponentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleUserClient) UpdateOneID(id uint64) *RoleUserUpdateOne {
-	mutation := newRoleUserMutation(c.config, OpUpdateOne, withRoleUserID(id))
-	return &RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SalaryComponentAssignmentClient) UpdateOneID(id uint64) *SalaryComponentAssignmentUpdateOne {
+	mutation := newSalaryComponentAssignmentMutation(c.config, OpUpdateOne, withSalaryComponentAssignmentID(id))
+	return &SalaryComponentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RoleUser.
-func (c *RoleUserClient) Delete() *RoleUserDelete {
-	mutation := newRoleUserMutation(c.config, OpDelete)
-	return &RoleUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SalaryComponentAssignment.
+func (c *SalaryComponentAssignmentClient) Delete() *SalaryComponentAssignmentDelete {
+	mutation := newSalaryComponentAssignmentMutation(c.config, OpDelete)
+	return &SalaryComponentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleUserClient) DeleteOne(ru *RoleUser) *RoleUserDeleteOne {
-	return c.DeleteOneID(ru.ID)
+func (c *SalaryComponentAssignmentClient) DeleteOne(sca *SalaryComponentAssignment) *SalaryComponentAssignmentDeleteOne {
+	return c.DeleteOneID(sca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleUserClient) DeleteOneID(id uint64) *RoleUserDeleteOne {
-	builder := c.Delete().Where(roleuser.ID(id))
+func (c *SalaryComponentAssignmentClient) DeleteOneID(id uint64) *SalaryComponentAssignmentDeleteOne {
+	builder := c.Delete().Where(salarycomponentassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleUserDeleteOne{builder}
+	return &SalaryComponentAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for RoleUser.
-func (c *RoleUserClient) Query() *RoleUserQuery {
-	return &RoleUserQuery{
+// Query returns a query builder for SalaryComponentAssignment.
+func (c *SalaryComponentAssignmentClient) Query() *SalaryComponentAssignmentQuery {
+	return &SalaryComponentAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoleUser},
+		ctx:    &QueryContext{Type: TypeSalaryComponentAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RoleUser entity by its id.
-func (c *RoleUserClient) Get(ctx context.Context, id uint64) (*RoleUser, error) {
-	return c.Query().Where(roleuser.ID(id)).Only(ctx)
+// Get returns a SalaryComponentAssignment entity by its id.
+func (c *SalaryComponentAssignmentClient) Get(ctx context.Context, id uint64) (*SalaryComponentAssignment, error) {
+	return c.Query().Where(salarycomponentassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleUserClient) GetX(ctx context.Context, id uint64) *RoleUser {
+func (c *SalaryComponentAssignmentClient) GetX(ctx context.Context, id uint64) *SalaryComponentAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -734,117 +4470,149 @@ func (c *RoleUserClient) GetX(ctx context.Context, id uint64) *RoleUser {
 	return obj
 }
 
+// QuerySalaryComponent queries the salary_component edge of a SalaryComponentAssignment.
+func (c *SalaryComponentAssignmentClient) QuerySalaryComponent(sca *SalaryComponentAssignment) *SalaryComponentQuery {
+	query := (&SalaryComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponentassignment.Table, salarycomponentassignment.FieldID, id),
+			sqlgraph.To(salarycomponent.Table, salarycomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycomponentassignment.SalaryComponentTable, salarycomponentassignment.SalaryComponentColumn),
+		)
+		fromV = sqlgraph.Neighbors(sca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmployee queries the employee edge of a SalaryComponentAssignment.
+func (c *SalaryComponentAssignmentClient) QueryEmployee(sca *SalaryComponentAssignment) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponentassignment.Table, salarycomponentassignment.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycomponentassignment.EmployeeTable, salarycomponentassignment.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(sca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *RoleUserClient) Hooks() []Hook {
-	return c.hooks.RoleUser
+func (c *SalaryComponentAssignmentClient) Hooks() []Hook {
+	return c.hooks.SalaryComponentAssignment
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleUserClient) Interceptors() []Interceptor {
-	return c.inters.RoleUser
+func (c *SalaryComponentAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.SalaryComponentAssignment
 }
 
-func (c *RoleUserClient) mutate(ctx context.Context, m *RoleUserMutation) (Value, error) {
+func (c *SalaryComponentAssignmentClient) mutate(ctx context.Context, m *SalaryComponentAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SalaryComponentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SalaryComponentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RoleUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SalaryComponentAssignment mutation op: %q", m.Op())
 	}
 }
 
-// SalaryCalculationClient is a client for the SalaryCalculation schema.
-type SalaryCalculationClient struct {
+// ShiftAssignmentClient is a client for the ShiftAssignment schema.
+type ShiftAssignmentClient struct {
 	config
 }
 
-// NewSalaryCalculationClient returns a client for the SalaryCalculation from the given config.
-func NewSalaryCalculationClient(c config) *SalaryCalculationClient {
-	return &SalaryCalculationClient{config: c}
+// NewShiftAssignmentClient returns a client for the ShiftAssignment from the given config.
+func NewShiftAssignmentClient(c config) *ShiftAssignmentClient {
+	return &ShiftAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `salarycalculation.Hooks(f(g(h())))`.
-func (c *SalaryCalculationClient) Use(hooks ...Hook) {
-	c.hooks.SalaryCalculation = append(c.hooks.SalaryCalculation, hooks...)
+// A call to `Use(f, g, h)` equals to `shiftassignment.Hooks(f(g(h())))`.
+func (c *ShiftAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ShiftAssignment = append(c.hooks.ShiftAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `salarycalculation.Intercept(f(g(h())))`.
-func (c *SalaryCalculationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SalaryCalculation = append(c.inters.SalaryCalculation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `shiftassignment.Intercept(f(g(h())))`.
+func (c *ShiftAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShiftAssignment = append(c.inters.ShiftAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a SalaryCalculation entity.
-func (c *SalaryCalculationClient) Create() *SalaryCalculationCreate {
-	mutation := newSalaryCalculationMutation(c.config, OpCreate)
-	return &SalaryCalculationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ShiftAssignment entity.
+func (c *ShiftAssignmentClient) Create() *ShiftAssignmentCreate {
+	mutation := newShiftAssignmentMutation(c.config, OpCreate)
+	return &ShiftAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SalaryCalculation entities.
-func (c *SalaryCalculationClient) CreateBulk(builders ...*SalaryCalculationCreate) *SalaryCalculationCreateBulk {
-	return &SalaryCalculationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ShiftAssignment entities.
+func (c *ShiftAssignmentClient) CreateBulk(builders ...*ShiftAssignmentCreate) *ShiftAssignmentCreateBulk {
+	return &ShiftAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SalaryCalculation.
-func (c *SalaryCalculationClient) Update() *SalaryCalculationUpdate {
-	mutation := newSalaryCalculationMutation(c.config, OpUpdate)
-	return &SalaryCalculationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ShiftAssignment.
+func (c *ShiftAssignmentClient) Update() *ShiftAssignmentUpdate {
+	mutation := newShiftAssignmentMutation(c.config, OpUpdate)
+	return &ShiftAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SalaryCalculationClient) UpdateOne(sc *SalaryCalculation) *SalaryCalculationUpdateOne {
-	mutation := newSalaryCalculationMutation(c.config, OpUpdateOne, withSalaryCalculation(sc))
-	return &SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShiftAssignmentClient) UpdateOne(sa *ShiftAssignment) *ShiftAssignmentUpdateOne {
+	mutation := newShiftAssignmentMutation(c.config, OpUpdateOne, withShiftAssignment(sa))
+	return &ShiftAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SalaryCalculationClient) UpdateOneID(id uint64) *SalaryCalculationUpdateOne {
-	mutation := newSalaryCalculationMutation(c.config, OpUpdateOne, withSalaryCalculationID(id))
-	return &SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShiftAssignmentClient) UpdateOneID(id uint64) *ShiftAssignmentUpdateOne {
+	mutation := newShiftAssignmentMutation(c.config, OpUpdateOne, withShiftAssignmentID(id))
+	return &ShiftAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SalaryCalculation.
-func (c *SalaryCalculationClient) Delete() *SalaryCalculationDelete {
-	mutation := newSalaryCalculationMutation(c.config, OpDelete)
-	return &SalaryCalculationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ShiftAssignment.
+func (c *ShiftAssignmentClient) Delete() *ShiftAssignmentDelete {
+	mutation := newShiftAssignmentMutation(c.config, OpDelete)
+	return &ShiftAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SalaryCalculationClient) DeleteOne(sc *SalaryCalculation) *SalaryCalculationDeleteOne {
-	return c.DeleteOneID(sc.ID)
+func (c *ShiftAssignmentClient) DeleteOne(sa *ShiftAssignment) *ShiftAssignmentDeleteOne {
+	return c.DeleteOneID(sa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SalaryCalculationClient) DeleteOneID(id uint64) *SalaryCalculationDeleteOne {
-	builder := c.Delete().Where(salarycalculation.ID(id))
+func (c *ShiftAssignmentClient) DeleteOneID(id uint64) *ShiftAssignmentDeleteOne {
+	builder := c.Delete().Where(shiftassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SalaryCalculationDeleteOne{builder}
+	return &ShiftAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for SalaryCalculation.
-func (c *SalaryCalculationClient) Query() *SalaryCalculationQuery {
-	return &SalaryCalculationQuery{
+// Query returns a query builder for ShiftAssignment.
+func (c *ShiftAssignmentClient) Query() *ShiftAssignmentQuery {
+	return &ShiftAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSalaryCalculation},
+		ctx:    &QueryContext{Type: TypeShiftAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SalaryCalculation entity by its id.
-func (c *SalaryCalculationClient) Get(ctx context.Context, id uint64) (*SalaryCalculation, error) {
-	return c.Query().Where(salarycalculation.ID(id)).Only(ctx)
+// Get returns a ShiftAssignment entity by its id.
+func (c *ShiftAssignmentClient) Get(ctx context.Context, id uint64) (*ShiftAssignment, error) {
+	return c.Query().Where(shiftassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SalaryCalculationClient) GetX(ctx context.Context, id uint64) *SalaryCalculation {
+func (c *ShiftAssignmentClient) GetX(ctx context.Context, id uint64) *ShiftAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -852,44 +4620,60 @@ func (c *SalaryCalculationClient) GetX(ctx context.Context, id uint64) *SalaryCa
 	return obj
 }
 
-// QueryEmployee queries the employee edge of a SalaryCalculation.
-func (c *SalaryCalculationClient) QueryEmployee(sc *SalaryCalculation) *EmployeeQuery {
+// QueryEmployee queries the employee edge of a ShiftAssignment.
+func (c *ShiftAssignmentClient) QueryEmployee(sa *ShiftAssignment) *EmployeeQuery {
 	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sc.ID
+		id := sa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, id),
+			sqlgraph.From(shiftassignment.Table, shiftassignment.FieldID, id),
 			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculation.EmployeeTable, salarycalculation.EmployeeColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftassignment.EmployeeTable, shiftassignment.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkSchedule queries the work_schedule edge of a ShiftAssignment.
+func (c *ShiftAssignmentClient) QueryWorkSchedule(sa *ShiftAssignment) *WorkScheduleQuery {
+	query := (&WorkScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftassignment.Table, shiftassignment.FieldID, id),
+			sqlgraph.To(workschedule.Table, workschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftassignment.WorkScheduleTable, shiftassignment.WorkScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SalaryCalculationClient) Hooks() []Hook {
-	return c.hooks.SalaryCalculation
+func (c *ShiftAssignmentClient) Hooks() []Hook {
+	return c.hooks.ShiftAssignment
 }
 
 // Interceptors returns the client interceptors.
-func (c *SalaryCalculationClient) Interceptors() []Interceptor {
-	return c.inters.SalaryCalculation
+func (c *ShiftAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ShiftAssignment
 }
 
-func (c *SalaryCalculationClient) mutate(ctx context.Context, m *SalaryCalculationMutation) (Value, error) {
+func (c *ShiftAssignmentClient) mutate(ctx context.Context, m *ShiftAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SalaryCalculationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShiftAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SalaryCalculationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShiftAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SalaryCalculationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShiftAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SalaryCalculationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ShiftAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SalaryCalculation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ShiftAssignment mutation op: %q", m.Op())
 	}
 }
 
@@ -1011,13 +4795,175 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WorkScheduleClient is a client for the WorkSchedule schema.
+type WorkScheduleClient struct {
+	config
+}
+
+// NewWorkScheduleClient returns a client for the WorkSchedule from the given config.
+func NewWorkScheduleClient(c config) *WorkScheduleClient {
+	return &WorkScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workschedule.Hooks(f(g(h())))`.
+func (c *WorkScheduleClient) Use(hooks ...Hook) {
+	c.hooks.WorkSchedule = append(c.hooks.WorkSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workschedule.Intercept(f(g(h())))`.
+func (c *WorkScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkSchedule = append(c.inters.WorkSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a WorkSchedule entity.
+func (c *WorkScheduleClient) Create() *WorkScheduleCreate {
+	mutation := newWorkScheduleMutation(c.config, OpCreate)
+	return &WorkScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkSchedule entities.
+func (c *WorkScheduleClient) CreateBulk(builders ...*WorkScheduleCreate) *WorkScheduleCreateBulk {
+	return &WorkScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkSchedule.
+func (c *WorkScheduleClient) Update() *WorkScheduleUpdate {
+	mutation := newWorkScheduleMutation(c.config, OpUpdate)
+	return &WorkScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkScheduleClient) UpdateOne(ws *WorkSchedule) *WorkScheduleUpdateOne {
+	mutation := newWorkScheduleMutation(c.config, OpUpdateOne, withWorkSchedule(ws))
+	return &WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkScheduleClient) UpdateOneID(id uint64) *WorkScheduleUpdateOne {
+	mutation := newWorkScheduleMutation(c.config, OpUpdateOne, withWorkScheduleID(id))
+	return &WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkSchedule.
+func (c *WorkScheduleClient) Delete() *WorkScheduleDelete {
+	mutation := newWorkScheduleMutation(c.config, OpDelete)
+	return &WorkScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkScheduleClient) DeleteOne(ws *WorkSchedule) *WorkScheduleDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkScheduleClient) DeleteOneID(id uint64) *WorkScheduleDeleteOne {
+	builder := c.Delete().Where(workschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkSchedule.
+func (c *WorkScheduleClient) Query() *WorkScheduleQuery {
+	return &WorkScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkSchedule entity by its id.
+func (c *WorkScheduleClient) Get(ctx context.Context, id uint64) (*WorkSchedule, error) {
+	return c.Query().Where(workschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkScheduleClient) GetX(ctx context.Context, id uint64) *WorkSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShiftAssignments queries the shift_assignments edge of a WorkSchedule.
+func (c *WorkScheduleClient) QueryShiftAssignments(ws *WorkSchedule) *ShiftAssignmentQuery {
+	query := (&ShiftAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workschedule.Table, workschedule.FieldID, id),
+			sqlgraph.To(shiftassignment.Table, shiftassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workschedule.ShiftAssignmentsTable, workschedule.ShiftAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendancePayPolicy queries the attendance_pay_policy edge of a WorkSchedule.
+func (c *WorkScheduleClient) QueryAttendancePayPolicy(ws *WorkSchedule) *AttendancePayPolicyQuery {
+	query := (&AttendancePayPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workschedule.Table, workschedule.FieldID, id),
+			sqlgraph.To(attendancepaypolicy.Table, attendancepaypolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workschedule.AttendancePayPolicyTable, workschedule.AttendancePayPolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkScheduleClient) Hooks() []Hook {
+	return c.hooks.WorkSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkScheduleClient) Interceptors() []Interceptor {
+	return c.inters.WorkSchedule
+}
+
+func (c *WorkScheduleClient) mutate(ctx context.Context, m *WorkScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkSchedule mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attendance, Employee, Role, RoleUser, SalaryCalculation, User []ent.Hook
+		Attendance, AttendanceAnomaly, AttendanceCorrection, AttendancePayPolicy,
+		AttendancePunch, BpjsContributionRate, CalendarFeed, Device,
+		DevicePunchRejection, Employee, Holiday, Kiosk, LeaveBalance, LeaveRequest,
+		LeaveType, OfficeLocation, Overtime, PayrollPeriod, PayrollPeriodEvent,
+		PayrollRun, PayrollRunEvent, Role, RoleUser, SalaryCalculation,
+		SalaryCalculationItem, SalaryComponent, SalaryComponentAssignment,
+		ShiftAssignment, User, WorkSchedule []ent.Hook
 	}
 	inters struct {
-		Attendance, Employee, Role, RoleUser, SalaryCalculation, User []ent.Interceptor
+		Attendance, AttendanceAnomaly, AttendanceCorrection, AttendancePayPolicy,
+		AttendancePunch, BpjsContributionRate, CalendarFeed, Device,
+		DevicePunchRejection, Employee, Holiday, Kiosk, LeaveBalance, LeaveRequest,
+		LeaveType, OfficeLocation, Overtime, PayrollPeriod, PayrollPeriodEvent,
+		PayrollRun, PayrollRunEvent, Role, RoleUser, SalaryCalculation,
+		SalaryCalculationItem, SalaryComponent, SalaryComponentAssignment,
+		ShiftAssignment, User, WorkSchedule []ent.Interceptor
 	}
 )
 
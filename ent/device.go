@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/officelocation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Device is the model entity for the Device schema.
+type Device struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Serial number the terminal identifies itself with on every request
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// pending terminals registered themselves and wait for approval, only active terminals may push punches
+	Status device.Status `json:"status,omitempty"`
+	// Office the terminal is installed at
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty"`
+	// IANA timezone of the terminal clock, the office timezone applies when empty
+	Timezone string `json:"timezone,omitempty"`
+	// Push protocol version reported by the terminal
+	PushVersion string `json:"push_version,omitempty"`
+	// Firmware, user and punch counts reported by the terminal
+	DeviceInfo string `json:"device_info,omitempty"`
+	// Address of the last request of the terminal
+	IPAddress string `json:"ip_address,omitempty"`
+	// IP addresses or CIDR ranges the terminal may push from, an active terminal without any is refused
+	AllowedNetworks []string `json:"allowed_networks,omitempty"`
+	// Stamp of the last ATTLOG push, the terminal resumes uploading after it
+	AttlogStamp string `json:"attlog_stamp,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// Terminal clock time of the newest punch pushed
+	LastPunchAt *time.Time `json:"last_punch_at,omitempty"`
+	// Terminal clock minus server time, measured on real-time pushes of a single punch
+	ClockOffsetSeconds *int `json:"clock_offset_seconds,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceQuery when eager-loading is set.
+	Edges        DeviceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeviceEdges holds the relations/edges for other nodes in the graph.
+type DeviceEdges struct {
+	// OfficeLocation holds the value of the office_location edge.
+	OfficeLocation *OfficeLocation `json:"office_location,omitempty"`
+	// PunchRejections holds the value of the punch_rejections edge.
+	PunchRejections []*DevicePunchRejection `json:"punch_rejections,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OfficeLocationOrErr returns the OfficeLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) OfficeLocationOrErr() (*OfficeLocation, error) {
+	if e.loadedTypes[0] {
+		if e.OfficeLocation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: officelocation.Label}
+		}
+		return e.OfficeLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "office_location"}
+}
+
+// PunchRejectionsOrErr returns the PunchRejections value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) PunchRejectionsOrErr() ([]*DevicePunchRejection, error) {
+	if e.loadedTypes[1] {
+		return e.PunchRejections, nil
+	}
+	return nil, &NotLoadedError{edge: "punch_rejections"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Device) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldAllowedNetworks:
+			values[i] = new([]byte)
+		case device.FieldID, device.FieldOfficeLocationID, device.FieldClockOffsetSeconds:
+			values[i] = new(sql.NullInt64)
+		case device.FieldSerialNumber, device.FieldName, device.FieldStatus, device.FieldTimezone, device.FieldPushVersion, device.FieldDeviceInfo, device.FieldIPAddress, device.FieldAttlogStamp:
+			values[i] = new(sql.NullString)
+		case device.FieldCreatedAt, device.FieldModifiedAt, device.FieldDeletedAt, device.FieldLastSeenAt, device.FieldLastPunchAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Device fields.
+func (d *Device) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = uint64(value.Int64)
+		case device.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case device.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				d.ModifiedAt = value.Time
+			}
+		case device.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				d.DeletedAt = value.Time
+			}
+		case device.FieldSerialNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
+			} else if value.Valid {
+				d.SerialNumber = value.String
+			}
+		case device.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case device.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				d.Status = device.Status(value.String)
+			}
+		case device.FieldOfficeLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field office_location_id", values[i])
+			} else if value.Valid {
+				d.OfficeLocationID = new(uint64)
+				*d.OfficeLocationID = uint64(value.Int64)
+			}
+		case device.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				d.Timezone = value.String
+			}
+		case device.FieldPushVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_version", values[i])
+			} else if value.Valid {
+				d.PushVersion = value.String
+			}
+		case device.FieldDeviceInfo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_info", values[i])
+			} else if value.Valid {
+				d.DeviceInfo = value.String
+			}
+		case device.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				d.IPAddress = value.String
+			}
+		case device.FieldAllowedNetworks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_networks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.AllowedNetworks); err != nil {
+					return fmt.Errorf("unmarshal field allowed_networks: %w", err)
+				}
+			}
+		case device.FieldAttlogStamp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attlog_stamp", values[i])
+			} else if value.Valid {
+				d.AttlogStamp = value.String
+			}
+		case device.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				d.LastSeenAt = new(time.Time)
+				*d.LastSeenAt = value.Time
+			}
+		case device.FieldLastPunchAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_punch_at", values[i])
+			} else if value.Valid {
+				d.LastPunchAt = new(time.Time)
+				*d.LastPunchAt = value.Time
+			}
+		case device.FieldClockOffsetSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_offset_seconds", values[i])
+			} else if value.Valid {
+				d.ClockOffsetSeconds = new(int)
+				*d.ClockOffsetSeconds = int(value.Int64)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Device.
+// This includes values selected through modifiers, order, etc.
+func (d *Device) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryOfficeLocation queries the "office_location" edge of the Device entity.
+func (d *Device) QueryOfficeLocation() *OfficeLocationQuery {
+	return NewDeviceClient(d.config).QueryOfficeLocation(d)
+}
+
+// QueryPunchRejections queries the "punch_rejections" edge of the Device entity.
+func (d *Device) QueryPunchRejections() *DevicePunchRejectionQuery {
+	return NewDeviceClient(d.config).QueryPunchRejections(d)
+}
+
+// Update returns a builder for updating this Device.
+// Note that you need to call Device.Unwrap() before calling this method if this Device
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Device) Update() *DeviceUpdateOne {
+	return NewDeviceClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Device entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Device) Unwrap() *Device {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Device is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Device) String() string {
+	var builder strings.Builder
+	builder.WriteString("Device(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(d.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(d.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("serial_number=")
+	builder.WriteString(d.SerialNumber)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", d.Status))
+	builder.WriteString(", ")
+	if v := d.OfficeLocationID; v != nil {
+		builder.WriteString("office_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(d.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("push_version=")
+	builder.WriteString(d.PushVersion)
+	builder.WriteString(", ")
+	builder.WriteString("device_info=")
+	builder.WriteString(d.DeviceInfo)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(d.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("allowed_networks=")
+	builder.WriteString(fmt.Sprintf("%v", d.AllowedNetworks))
+	builder.WriteString(", ")
+	builder.WriteString("attlog_stamp=")
+	builder.WriteString(d.AttlogStamp)
+	builder.WriteString(", ")
+	if v := d.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.LastPunchAt; v != nil {
+		builder.WriteString("last_punch_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.ClockOffsetSeconds; v != nil {
+		builder.WriteString("clock_offset_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Devices is a parsable slice of Device.
+type Devices []*Device
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the device type in the database.
+	Label = "device"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSerialNumber holds the string denoting the serial_number field in the database.
+	FieldSerialNumber = "serial_number"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldOfficeLocationID holds the string denoting the office_location_id field in the database.
+	FieldOfficeLocationID = "office_location_id"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldPushVersion holds the string denoting the push_version field in the database.
+	FieldPushVersion = "push_version"
+	// FieldDeviceInfo holds the string denoting the device_info field in the database.
+	FieldDeviceInfo = "device_info"
+	// FieldIPAddress holds the string denoting the ip_address field in the database.
+	FieldIPAddress = "ip_address"
+	// FieldAllowedNetworks holds the string denoting the allowed_networks field in the database.
+	FieldAllowedNetworks = "allowed_networks"
+	// FieldAttlogStamp holds the string denoting the attlog_stamp field in the database.
+	FieldAttlogStamp = "attlog_stamp"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldLastPunchAt holds the string denoting the last_punch_at field in the database.
+	FieldLastPunchAt = "last_punch_at"
+	// FieldClockOffsetSeconds holds the string denoting the clock_offset_seconds field in the database.
+	FieldClockOffsetSeconds = "clock_offset_seconds"
+	// EdgeOfficeLocation holds the string denoting the office_location edge name in mutations.
+	EdgeOfficeLocation = "office_location"
+	// EdgePunchRejections holds the string denoting the punch_rejections edge name in mutations.
+	EdgePunchRejections = "punch_rejections"
+	// Table holds the table name of the device in the database.
+	Table = "devices"
+	// OfficeLocationTable is the table that holds the office_location relation/edge.
+	OfficeLocationTable = "devices"
+	// OfficeLocationInverseTable is the table name for the OfficeLocation entity.
+	// It exists in this package in order to avoid circular dependency with the "officelocation" package.
+	OfficeLocationInverseTable = "office_locations"
+	// OfficeLocationColumn is the table column denoting the office_location relation/edge.
+	OfficeLocationColumn = "office_location_id"
+	// PunchRejectionsTable is the table that holds the punch_rejections relation/edge.
+	PunchRejectionsTable = "device_punch_rejections"
+	// PunchRejectionsInverseTable is the table name for the DevicePunchRejection entity.
+	// It exists in this package in order to avoid circular dependency with the "devicepunchrejection" package.
+	PunchRejectionsInverseTable = "device_punch_rejections"
+	// PunchRejectionsColumn is the table column denoting the punch_rejections relation/edge.
+	PunchRejectionsColumn = "device_id"
+)
+
+// Columns holds all SQL columns for device fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldSerialNumber,
+	FieldName,
+	FieldStatus,
+	FieldOfficeLocationID,
+	FieldTimezone,
+	FieldPushVersion,
+	FieldDeviceInfo,
+	FieldIPAddress,
+	FieldAllowedNetworks,
+	FieldAttlogStamp,
+	FieldLastSeenAt,
+	FieldLastPunchAt,
+	FieldClockOffsetSeconds,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	SerialNumberValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// PushVersionValidator is a validator for the "push_version" field. It is called by the builders before save.
+	PushVersionValidator func(string) error
+	// DeviceInfoValidator is a validator for the "device_info" field. It is called by the builders before save.
+	DeviceInfoValidator func(string) error
+	// IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	IPAddressValidator func(string) error
+	// AttlogStampValidator is a validator for the "attlog_stamp" field. It is called by the builders before save.
+	AttlogStampValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusActive   Status = "active"
+	StatusDisabled Status = "disabled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusActive, StatusDisabled:
+		return nil
+	default:
+		return fmt.Errorf("device: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Device queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySerialNumber orders the results by the serial_number field.
+func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByOfficeLocationID orders the results by the office_location_id field.
+func ByOfficeLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfficeLocationID, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByPushVersion orders the results by the push_version field.
+func ByPushVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushVersion, opts...).ToFunc()
+}
+
+// ByDeviceInfo orders the results by the device_info field.
+func ByDeviceInfo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceInfo, opts...).ToFunc()
+}
+
+// ByIPAddress orders the results by the ip_address field.
+func ByIPAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIPAddress, opts...).ToFunc()
+}
+
+// ByAttlogStamp orders the results by the attlog_stamp field.
+func ByAttlogStamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttlogStamp, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByLastPunchAt orders the results by the last_punch_at field.
+func ByLastPunchAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPunchAt, opts...).ToFunc()
+}
+
+// ByClockOffsetSeconds orders the results by the clock_offset_seconds field.
+func ByClockOffsetSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockOffsetSeconds, opts...).ToFunc()
+}
+
+// ByOfficeLocationField orders the results by office_location field.
+func ByOfficeLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOfficeLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPunchRejectionsCount orders the results by punch_rejections count.
+func ByPunchRejectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchRejectionsStep(), opts...)
+	}
+}
+
+// ByPunchRejections orders the results by punch_rejections terms.
+func ByPunchRejections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchRejectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOfficeLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OfficeLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OfficeLocationTable, OfficeLocationColumn),
+	)
+}
+func newPunchRejectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchRejectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchRejectionsTable, PunchRejectionsColumn),
+	)
+}
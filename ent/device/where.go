@@ -0,0 +1,1059 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldName, v))
+}
+
+// OfficeLocationID applies equality check predicate on the "office_location_id" field. It's identical to OfficeLocationIDEQ.
+func OfficeLocationID(v uint64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldTimezone, v))
+}
+
+// PushVersion applies equality check predicate on the "push_version" field. It's identical to PushVersionEQ.
+func PushVersion(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPushVersion, v))
+}
+
+// DeviceInfo applies equality check predicate on the "device_info" field. It's identical to DeviceInfoEQ.
+func DeviceInfo(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeviceInfo, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// AttlogStamp applies equality check predicate on the "attlog_stamp" field. It's identical to AttlogStampEQ.
+func AttlogStamp(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldAttlogStamp, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastPunchAt applies equality check predicate on the "last_punch_at" field. It's identical to LastPunchAtEQ.
+func LastPunchAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPunchAt, v))
+}
+
+// ClockOffsetSeconds applies equality check predicate on the "clock_offset_seconds" field. It's identical to ClockOffsetSecondsEQ.
+func ClockOffsetSeconds(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldClockOffsetSeconds, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldSerialNumber, v))
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldSerialNumber, v))
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldSerialNumber, v))
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldSerialNumber, v))
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldSerialNumber, v))
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldSerialNumber, v))
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldSerialNumber, v))
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldSerialNumber, v))
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldSerialNumber, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// OfficeLocationIDEQ applies the EQ predicate on the "office_location_id" field.
+func OfficeLocationIDEQ(v uint64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDNEQ applies the NEQ predicate on the "office_location_id" field.
+func OfficeLocationIDNEQ(v uint64) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDIn applies the In predicate on the "office_location_id" field.
+func OfficeLocationIDIn(vs ...uint64) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDNotIn applies the NotIn predicate on the "office_location_id" field.
+func OfficeLocationIDNotIn(vs ...uint64) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDIsNil applies the IsNil predicate on the "office_location_id" field.
+func OfficeLocationIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldOfficeLocationID))
+}
+
+// OfficeLocationIDNotNil applies the NotNil predicate on the "office_location_id" field.
+func OfficeLocationIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldOfficeLocationID))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// PushVersionEQ applies the EQ predicate on the "push_version" field.
+func PushVersionEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPushVersion, v))
+}
+
+// PushVersionNEQ applies the NEQ predicate on the "push_version" field.
+func PushVersionNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldPushVersion, v))
+}
+
+// PushVersionIn applies the In predicate on the "push_version" field.
+func PushVersionIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldPushVersion, vs...))
+}
+
+// PushVersionNotIn applies the NotIn predicate on the "push_version" field.
+func PushVersionNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldPushVersion, vs...))
+}
+
+// PushVersionGT applies the GT predicate on the "push_version" field.
+func PushVersionGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldPushVersion, v))
+}
+
+// PushVersionGTE applies the GTE predicate on the "push_version" field.
+func PushVersionGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldPushVersion, v))
+}
+
+// PushVersionLT applies the LT predicate on the "push_version" field.
+func PushVersionLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldPushVersion, v))
+}
+
+// PushVersionLTE applies the LTE predicate on the "push_version" field.
+func PushVersionLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldPushVersion, v))
+}
+
+// PushVersionContains applies the Contains predicate on the "push_version" field.
+func PushVersionContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldPushVersion, v))
+}
+
+// PushVersionHasPrefix applies the HasPrefix predicate on the "push_version" field.
+func PushVersionHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldPushVersion, v))
+}
+
+// PushVersionHasSuffix applies the HasSuffix predicate on the "push_version" field.
+func PushVersionHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldPushVersion, v))
+}
+
+// PushVersionIsNil applies the IsNil predicate on the "push_version" field.
+func PushVersionIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldPushVersion))
+}
+
+// PushVersionNotNil applies the NotNil predicate on the "push_version" field.
+func PushVersionNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldPushVersion))
+}
+
+// PushVersionEqualFold applies the EqualFold predicate on the "push_version" field.
+func PushVersionEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldPushVersion, v))
+}
+
+// PushVersionContainsFold applies the ContainsFold predicate on the "push_version" field.
+func PushVersionContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldPushVersion, v))
+}
+
+// DeviceInfoEQ applies the EQ predicate on the "device_info" field.
+func DeviceInfoEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeviceInfo, v))
+}
+
+// DeviceInfoNEQ applies the NEQ predicate on the "device_info" field.
+func DeviceInfoNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeviceInfo, v))
+}
+
+// DeviceInfoIn applies the In predicate on the "device_info" field.
+func DeviceInfoIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeviceInfo, vs...))
+}
+
+// DeviceInfoNotIn applies the NotIn predicate on the "device_info" field.
+func DeviceInfoNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeviceInfo, vs...))
+}
+
+// DeviceInfoGT applies the GT predicate on the "device_info" field.
+func DeviceInfoGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeviceInfo, v))
+}
+
+// DeviceInfoGTE applies the GTE predicate on the "device_info" field.
+func DeviceInfoGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeviceInfo, v))
+}
+
+// DeviceInfoLT applies the LT predicate on the "device_info" field.
+func DeviceInfoLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeviceInfo, v))
+}
+
+// DeviceInfoLTE applies the LTE predicate on the "device_info" field.
+func DeviceInfoLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeviceInfo, v))
+}
+
+// DeviceInfoContains applies the Contains predicate on the "device_info" field.
+func DeviceInfoContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldDeviceInfo, v))
+}
+
+// DeviceInfoHasPrefix applies the HasPrefix predicate on the "device_info" field.
+func DeviceInfoHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldDeviceInfo, v))
+}
+
+// DeviceInfoHasSuffix applies the HasSuffix predicate on the "device_info" field.
+func DeviceInfoHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldDeviceInfo, v))
+}
+
+// DeviceInfoIsNil applies the IsNil predicate on the "device_info" field.
+func DeviceInfoIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDeviceInfo))
+}
+
+// DeviceInfoNotNil applies the NotNil predicate on the "device_info" field.
+func DeviceInfoNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDeviceInfo))
+}
+
+// DeviceInfoEqualFold applies the EqualFold predicate on the "device_info" field.
+func DeviceInfoEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldDeviceInfo, v))
+}
+
+// DeviceInfoContainsFold applies the ContainsFold predicate on the "device_info" field.
+func DeviceInfoContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldDeviceInfo, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// AllowedNetworksIsNil applies the IsNil predicate on the "allowed_networks" field.
+func AllowedNetworksIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldAllowedNetworks))
+}
+
+// AllowedNetworksNotNil applies the NotNil predicate on the "allowed_networks" field.
+func AllowedNetworksNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldAllowedNetworks))
+}
+
+// AttlogStampEQ applies the EQ predicate on the "attlog_stamp" field.
+func AttlogStampEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldAttlogStamp, v))
+}
+
+// AttlogStampNEQ applies the NEQ predicate on the "attlog_stamp" field.
+func AttlogStampNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldAttlogStamp, v))
+}
+
+// AttlogStampIn applies the In predicate on the "attlog_stamp" field.
+func AttlogStampIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldAttlogStamp, vs...))
+}
+
+// AttlogStampNotIn applies the NotIn predicate on the "attlog_stamp" field.
+func AttlogStampNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldAttlogStamp, vs...))
+}
+
+// AttlogStampGT applies the GT predicate on the "attlog_stamp" field.
+func AttlogStampGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldAttlogStamp, v))
+}
+
+// AttlogStampGTE applies the GTE predicate on the "attlog_stamp" field.
+func AttlogStampGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldAttlogStamp, v))
+}
+
+// AttlogStampLT applies the LT predicate on the "attlog_stamp" field.
+func AttlogStampLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldAttlogStamp, v))
+}
+
+// AttlogStampLTE applies the LTE predicate on the "attlog_stamp" field.
+func AttlogStampLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldAttlogStamp, v))
+}
+
+// AttlogStampContains applies the Contains predicate on the "attlog_stamp" field.
+func AttlogStampContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldAttlogStamp, v))
+}
+
+// AttlogStampHasPrefix applies the HasPrefix predicate on the "attlog_stamp" field.
+func AttlogStampHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldAttlogStamp, v))
+}
+
+// AttlogStampHasSuffix applies the HasSuffix predicate on the "attlog_stamp" field.
+func AttlogStampHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldAttlogStamp, v))
+}
+
+// AttlogStampIsNil applies the IsNil predicate on the "attlog_stamp" field.
+func AttlogStampIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldAttlogStamp))
+}
+
+// AttlogStampNotNil applies the NotNil predicate on the "attlog_stamp" field.
+func AttlogStampNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldAttlogStamp))
+}
+
+// AttlogStampEqualFold applies the EqualFold predicate on the "attlog_stamp" field.
+func AttlogStampEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldAttlogStamp, v))
+}
+
+// AttlogStampContainsFold applies the ContainsFold predicate on the "attlog_stamp" field.
+func AttlogStampContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldAttlogStamp, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// LastPunchAtEQ applies the EQ predicate on the "last_punch_at" field.
+func LastPunchAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastPunchAt, v))
+}
+
+// LastPunchAtNEQ applies the NEQ predicate on the "last_punch_at" field.
+func LastPunchAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastPunchAt, v))
+}
+
+// LastPunchAtIn applies the In predicate on the "last_punch_at" field.
+func LastPunchAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastPunchAt, vs...))
+}
+
+// LastPunchAtNotIn applies the NotIn predicate on the "last_punch_at" field.
+func LastPunchAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastPunchAt, vs...))
+}
+
+// LastPunchAtGT applies the GT predicate on the "last_punch_at" field.
+func LastPunchAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastPunchAt, v))
+}
+
+// LastPunchAtGTE applies the GTE predicate on the "last_punch_at" field.
+func LastPunchAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastPunchAt, v))
+}
+
+// LastPunchAtLT applies the LT predicate on the "last_punch_at" field.
+func LastPunchAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastPunchAt, v))
+}
+
+// LastPunchAtLTE applies the LTE predicate on the "last_punch_at" field.
+func LastPunchAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastPunchAt, v))
+}
+
+// LastPunchAtIsNil applies the IsNil predicate on the "last_punch_at" field.
+func LastPunchAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastPunchAt))
+}
+
+// LastPunchAtNotNil applies the NotNil predicate on the "last_punch_at" field.
+func LastPunchAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastPunchAt))
+}
+
+// ClockOffsetSecondsEQ applies the EQ predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsNEQ applies the NEQ predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsIn applies the In predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldClockOffsetSeconds, vs...))
+}
+
+// ClockOffsetSecondsNotIn applies the NotIn predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldClockOffsetSeconds, vs...))
+}
+
+// ClockOffsetSecondsGT applies the GT predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsGT(v int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsGTE applies the GTE predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsGTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsLT applies the LT predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsLT(v int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsLTE applies the LTE predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsLTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldClockOffsetSeconds, v))
+}
+
+// ClockOffsetSecondsIsNil applies the IsNil predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldClockOffsetSeconds))
+}
+
+// ClockOffsetSecondsNotNil applies the NotNil predicate on the "clock_offset_seconds" field.
+func ClockOffsetSecondsNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldClockOffsetSeconds))
+}
+
+// HasOfficeLocation applies the HasEdge predicate on the "office_location" edge.
+func HasOfficeLocation() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OfficeLocationTable, OfficeLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOfficeLocationWith applies the HasEdge predicate on the "office_location" edge with a given conditions (other predicates).
+func HasOfficeLocationWith(preds ...predicate.OfficeLocation) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newOfficeLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPunchRejections applies the HasEdge predicate on the "punch_rejections" edge.
+func HasPunchRejections() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchRejectionsTable, PunchRejectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchRejectionsWith applies the HasEdge predicate on the "punch_rejections" edge with a given conditions (other predicates).
+func HasPunchRejectionsWith(preds ...predicate.DevicePunchRejection) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newPunchRejectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Device) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
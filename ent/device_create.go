@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/ent/officelocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceCreate is the builder for creating a Device entity.
+type DeviceCreate struct {
+	config
+	mutation *DeviceMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeviceCreate) SetCreatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableCreatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (dc *DeviceCreate) SetModifiedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetModifiedAt(t)
+	return dc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableModifiedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetModifiedAt(*t)
+	}
+	return dc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dc *DeviceCreate) SetDeletedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetDeletedAt(t)
+	return dc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDeletedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetDeletedAt(*t)
+	}
+	return dc
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (dc *DeviceCreate) SetSerialNumber(s string) *DeviceCreate {
+	dc.mutation.SetSerialNumber(s)
+	return dc
+}
+
+// SetName sets the "name" field.
+func (dc *DeviceCreate) SetName(s string) *DeviceCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableName(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetName(*s)
+	}
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DeviceCreate) SetStatus(d device.Status) *DeviceCreate {
+	dc.mutation.SetStatus(d)
+	return dc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableStatus(d *device.Status) *DeviceCreate {
+	if d != nil {
+		dc.SetStatus(*d)
+	}
+	return dc
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (dc *DeviceCreate) SetOfficeLocationID(u uint64) *DeviceCreate {
+	dc.mutation.SetOfficeLocationID(u)
+	return dc
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableOfficeLocationID(u *uint64) *DeviceCreate {
+	if u != nil {
+		dc.SetOfficeLocationID(*u)
+	}
+	return dc
+}
+
+// SetTimezone sets the "timezone" field.
+func (dc *DeviceCreate) SetTimezone(s string) *DeviceCreate {
+	dc.mutation.SetTimezone(s)
+	return dc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableTimezone(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetTimezone(*s)
+	}
+	return dc
+}
+
+// SetPushVersion sets the "push_version" field.
+func (dc *DeviceCreate) SetPushVersion(s string) *DeviceCreate {
+	dc.mutation.SetPushVersion(s)
+	return dc
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillablePushVersion(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetPushVersion(*s)
+	}
+	return dc
+}
+
+// SetDeviceInfo sets the "device_info" field.
+func (dc *DeviceCreate) SetDeviceInfo(s string) *DeviceCreate {
+	dc.mutation.SetDeviceInfo(s)
+	return dc
+}
+
+// SetNillableDeviceInfo sets the "device_info" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDeviceInfo(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetDeviceInfo(*s)
+	}
+	return dc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (dc *DeviceCreate) SetIPAddress(s string) *DeviceCreate {
+	dc.mutation.SetIPAddress(s)
+	return dc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableIPAddress(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetIPAddress(*s)
+	}
+	return dc
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (dc *DeviceCreate) SetAllowedNetworks(s []string) *DeviceCreate {
+	dc.mutation.SetAllowedNetworks(s)
+	return dc
+}
+
+// SetAttlogStamp sets the "attlog_stamp" field.
+func (dc *DeviceCreate) SetAttlogStamp(s string) *DeviceCreate {
+	dc.mutation.SetAttlogStamp(s)
+	return dc
+}
+
+// SetNillableAttlogStamp sets the "attlog_stamp" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableAttlogStamp(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetAttlogStamp(*s)
+	}
+	return dc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (dc *DeviceCreate) SetLastSeenAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastSeenAt(t)
+	return dc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastSeenAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastSeenAt(*t)
+	}
+	return dc
+}
+
+// SetLastPunchAt sets the "last_punch_at" field.
+func (dc *DeviceCreate) SetLastPunchAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastPunchAt(t)
+	return dc
+}
+
+// SetNillableLastPunchAt sets the "last_punch_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastPunchAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastPunchAt(*t)
+	}
+	return dc
+}
+
+// SetClockOffsetSeconds sets the "clock_offset_seconds" field.
+func (dc *DeviceCreate) SetClockOffsetSeconds(i int) *DeviceCreate {
+	dc.mutation.SetClockOffsetSeconds(i)
+	return dc
+}
+
+// SetNillableClockOffsetSeconds sets the "clock_offset_seconds" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableClockOffsetSeconds(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetClockOffsetSeconds(*i)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DeviceCreate) SetID(u uint64) *DeviceCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (dc *DeviceCreate) SetOfficeLocation(o *OfficeLocation) *DeviceCreate {
+	return dc.SetOfficeLocationID(o.ID)
+}
+
+// AddPunchRejectionIDs adds the "punch_rejections" edge to the DevicePunchRejection entity by IDs.
+func (dc *DeviceCreate) AddPunchRejectionIDs(ids ...uint64) *DeviceCreate {
+	dc.mutation.AddPunchRejectionIDs(ids...)
+	return dc
+}
+
+// AddPunchRejections adds the "punch_rejections" edges to the DevicePunchRejection entity.
+func (dc *DeviceCreate) AddPunchRejections(d ...*DevicePunchRejection) *DeviceCreate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddPunchRejectionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (dc *DeviceCreate) Mutation() *DeviceMutation {
+	return dc.mutation
+}
+
+// Save creates the Device in the database.
+func (dc *DeviceCreate) Save(ctx context.Context) (*Device, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeviceCreate) SaveX(ctx context.Context) *Device {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeviceCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeviceCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeviceCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := device.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.ModifiedAt(); !ok {
+		v := device.DefaultModifiedAt()
+		dc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		v := device.DefaultStatus
+		dc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeviceCreate) check() error {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Device.created_at"`)}
+	}
+	if _, ok := dc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "Device.modified_at"`)}
+	}
+	if _, ok := dc.mutation.SerialNumber(); !ok {
+		return &ValidationError{Name: "serial_number", err: errors.New(`ent: missing required field "Device.serial_number"`)}
+	}
+	if v, ok := dc.mutation.SerialNumber(); ok {
+		if err := device.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Device.serial_number": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.Name(); ok {
+		if err := device.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Device.name": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Device.status"`)}
+	}
+	if v, ok := dc.mutation.Status(); ok {
+		if err := device.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Device.status": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.Timezone(); ok {
+		if err := device.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Device.timezone": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.PushVersion(); ok {
+		if err := device.PushVersionValidator(v); err != nil {
+			return &ValidationError{Name: "push_version", err: fmt.Errorf(`ent: validator failed for field "Device.push_version": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.DeviceInfo(); ok {
+		if err := device.DeviceInfoValidator(v); err != nil {
+			return &ValidationError{Name: "device_info", err: fmt.Errorf(`ent: validator failed for field "Device.device_info": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.IPAddress(); ok {
+		if err := device.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "Device.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.AttlogStamp(); ok {
+		if err := device.AttlogStampValidator(v); err != nil {
+			return &ValidationError{Name: "attlog_stamp", err: fmt.Errorf(`ent: validator failed for field "Device.attlog_stamp": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Device{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(device.Table, sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64))
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.ModifiedAt(); ok {
+		_spec.SetField(device.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := dc.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := dc.mutation.SerialNumber(); ok {
+		_spec.SetField(device.FieldSerialNumber, field.TypeString, value)
+		_node.SerialNumber = value
+	}
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.Timezone(); ok {
+		_spec.SetField(device.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := dc.mutation.PushVersion(); ok {
+		_spec.SetField(device.FieldPushVersion, field.TypeString, value)
+		_node.PushVersion = value
+	}
+	if value, ok := dc.mutation.DeviceInfo(); ok {
+		_spec.SetField(device.FieldDeviceInfo, field.TypeString, value)
+		_node.DeviceInfo = value
+	}
+	if value, ok := dc.mutation.IPAddress(); ok {
+		_spec.SetField(device.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := dc.mutation.AllowedNetworks(); ok {
+		_spec.SetField(device.FieldAllowedNetworks, field.TypeJSON, value)
+		_node.AllowedNetworks = value
+	}
+	if value, ok := dc.mutation.AttlogStamp(); ok {
+		_spec.SetField(device.FieldAttlogStamp, field.TypeString, value)
+		_node.AttlogStamp = value
+	}
+	if value, ok := dc.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := dc.mutation.LastPunchAt(); ok {
+		_spec.SetField(device.FieldLastPunchAt, field.TypeTime, value)
+		_node.LastPunchAt = &value
+	}
+	if value, ok := dc.mutation.ClockOffsetSeconds(); ok {
+		_spec.SetField(device.FieldClockOffsetSeconds, field.TypeInt, value)
+		_node.ClockOffsetSeconds = &value
+	}
+	if nodes := dc.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OfficeLocationTable,
+			Columns: []string{device.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OfficeLocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.PunchRejectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeviceCreateBulk is the builder for creating many Device entities in bulk.
+type DeviceCreateBulk struct {
+	config
+	builders []*DeviceCreate
+}
+
+// Save creates the Device entities in the database.
+func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Device, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) SaveX(ctx context.Context) []*Device {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceUpdate is the builder for updating Device entities.
+type DeviceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeviceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (du *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (du *DeviceUpdate) SetModifiedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetModifiedAt(t)
+	return du
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (du *DeviceUpdate) SetDeletedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetDeletedAt(t)
+	return du
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDeletedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetDeletedAt(*t)
+	}
+	return du
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (du *DeviceUpdate) ClearDeletedAt() *DeviceUpdate {
+	du.mutation.ClearDeletedAt()
+	return du
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (du *DeviceUpdate) SetSerialNumber(s string) *DeviceUpdate {
+	du.mutation.SetSerialNumber(s)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DeviceUpdate) SetName(s string) *DeviceUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableName(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetName(*s)
+	}
+	return du
+}
+
+// ClearName clears the value of the "name" field.
+func (du *DeviceUpdate) ClearName() *DeviceUpdate {
+	du.mutation.ClearName()
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DeviceUpdate) SetStatus(d device.Status) *DeviceUpdate {
+	du.mutation.SetStatus(d)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableStatus(d *device.Status) *DeviceUpdate {
+	if d != nil {
+		du.SetStatus(*d)
+	}
+	return du
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (du *DeviceUpdate) SetOfficeLocationID(u uint64) *DeviceUpdate {
+	du.mutation.SetOfficeLocationID(u)
+	return du
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableOfficeLocationID(u *uint64) *DeviceUpdate {
+	if u != nil {
+		du.SetOfficeLocationID(*u)
+	}
+	return du
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (du *DeviceUpdate) ClearOfficeLocationID() *DeviceUpdate {
+	du.mutation.ClearOfficeLocationID()
+	return du
+}
+
+// SetTimezone sets the "timezone" field.
+func (du *DeviceUpdate) SetTimezone(s string) *DeviceUpdate {
+	du.mutation.SetTimezone(s)
+	return du
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableTimezone(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetTimezone(*s)
+	}
+	return du
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (du *DeviceUpdate) ClearTimezone() *DeviceUpdate {
+	du.mutation.ClearTimezone()
+	return du
+}
+
+// SetPushVersion sets the "push_version" field.
+func (du *DeviceUpdate) SetPushVersion(s string) *DeviceUpdate {
+	du.mutation.SetPushVersion(s)
+	return du
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillablePushVersion(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetPushVersion(*s)
+	}
+	return du
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (du *DeviceUpdate) ClearPushVersion() *DeviceUpdate {
+	du.mutation.ClearPushVersion()
+	return du
+}
+
+// SetDeviceInfo sets the "device_info" field.
+func (du *DeviceUpdate) SetDeviceInfo(s string) *DeviceUpdate {
+	du.mutation.SetDeviceInfo(s)
+	return du
+}
+
+// SetNillableDeviceInfo sets the "device_info" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDeviceInfo(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetDeviceInfo(*s)
+	}
+	return du
+}
+
+// ClearDeviceInfo clears the value of the "device_info" field.
+func (du *DeviceUpdate) ClearDeviceInfo() *DeviceUpdate {
+	du.mutation.ClearDeviceInfo()
+	return du
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (du *DeviceUpdate) SetIPAddress(s string) *DeviceUpdate {
+	du.mutation.SetIPAddress(s)
+	return du
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableIPAddress(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetIPAddress(*s)
+	}
+	return du
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (du *DeviceUpdate) ClearIPAddress() *DeviceUpdate {
+	du.mutation.ClearIPAddress()
+	return du
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (du *DeviceUpdate) SetAllowedNetworks(s []string) *DeviceUpdate {
+	du.mutation.SetAllowedNetworks(s)
+	return du
+}
+
+// AppendAllowedNetworks appends s to the "allowed_networks" field.
+func (du *DeviceUpdate) AppendAllowedNetworks(s []string) *DeviceUpdate {
+	du.mutation.AppendAllowedNetworks(s)
+	return du
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (du *DeviceUpdate) ClearAllowedNetworks() *DeviceUpdate {
+	du.mutation.ClearAllowedNetworks()
+	return du
+}
+
+// SetAttlogStamp sets the "attlog_stamp" field.
+func (du *DeviceUpdate) SetAttlogStamp(s string) *DeviceUpdate {
+	du.mutation.SetAttlogStamp(s)
+	return du
+}
+
+// SetNillableAttlogStamp sets the "attlog_stamp" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableAttlogStamp(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetAttlogStamp(*s)
+	}
+	return du
+}
+
+// ClearAttlogStamp clears the value of the "attlog_stamp" field.
+func (du *DeviceUpdate) ClearAttlogStamp() *DeviceUpdate {
+	du.mutation.ClearAttlogStamp()
+	return du
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (du *DeviceUpdate) SetLastSeenAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastSeenAt(t)
+	return du
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastSeenAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastSeenAt(*t)
+	}
+	return du
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (du *DeviceUpdate) ClearLastSeenAt() *DeviceUpdate {
+	du.mutation.ClearLastSeenAt()
+	return du
+}
+
+// SetLastPunchAt sets the "last_punch_at" field.
+func (du *DeviceUpdate) SetLastPunchAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastPunchAt(t)
+	return du
+}
+
+// SetNillableLastPunchAt sets the "last_punch_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastPunchAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastPunchAt(*t)
+	}
+	return du
+}
+
+// ClearLastPunchAt clears the value of the "last_punch_at" field.
+func (du *DeviceUpdate) ClearLastPunchAt() *DeviceUpdate {
+	du.mutation.ClearLastPunchAt()
+	return du
+}
+
+// SetClockOffsetSeconds sets the "clock_offset_seconds" field.
+func (du *DeviceUpdate) SetClockOffsetSeconds(i int) *DeviceUpdate {
+	du.mutation.ResetClockOffsetSeconds()
+	du.mutation.SetClockOffsetSeconds(i)
+	return du
+}
+
+// SetNillableClockOffsetSeconds sets the "clock_offset_seconds" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableClockOffsetSeconds(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetClockOffsetSeconds(*i)
+	}
+	return du
+}
+
+// AddClockOffsetSeconds adds i to the "clock_offset_seconds" field.
+func (du *DeviceUpdate) AddClockOffsetSeconds(i int) *DeviceUpdate {
+	du.mutation.AddClockOffsetSeconds(i)
+	return du
+}
+
+// ClearClockOffsetSeconds clears the value of the "clock_offset_seconds" field.
+func (du *DeviceUpdate) ClearClockOffsetSeconds() *DeviceUpdate {
+	du.mutation.ClearClockOffsetSeconds()
+	return du
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (du *DeviceUpdate) SetOfficeLocation(o *OfficeLocation) *DeviceUpdate {
+	return du.SetOfficeLocationID(o.ID)
+}
+
+// AddPunchRejectionIDs adds the "punch_rejections" edge to the DevicePunchRejection entity by IDs.
+func (du *DeviceUpdate) AddPunchRejectionIDs(ids ...uint64) *DeviceUpdate {
+	du.mutation.AddPunchRejectionIDs(ids...)
+	return du
+}
+
+// AddPunchRejections adds the "punch_rejections" edges to the DevicePunchRejection entity.
+func (du *DeviceUpdate) AddPunchRejections(d ...*DevicePunchRejection) *DeviceUpdate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddPunchRejectionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (du *DeviceUpdate) Mutation() *DeviceMutation {
+	return du.mutation
+}
+
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (du *DeviceUpdate) ClearOfficeLocation() *DeviceUpdate {
+	du.mutation.ClearOfficeLocation()
+	return du
+}
+
+// ClearPunchRejections clears all "punch_rejections" edges to the DevicePunchRejection entity.
+func (du *DeviceUpdate) ClearPunchRejections() *DeviceUpdate {
+	du.mutation.ClearPunchRejections()
+	return du
+}
+
+// RemovePunchRejectionIDs removes the "punch_rejections" edge to DevicePunchRejection entities by IDs.
+func (du *DeviceUpdate) RemovePunchRejectionIDs(ids ...uint64) *DeviceUpdate {
+	du.mutation.RemovePunchRejectionIDs(ids...)
+	return du
+}
+
+// RemovePunchRejections removes "punch_rejections" edges to DevicePunchRejection entities.
+func (du *DeviceUpdate) RemovePunchRejections(d ...*DevicePunchRejection) *DeviceUpdate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemovePunchRejectionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DeviceUpdate) defaults() {
+	if _, ok := du.mutation.ModifiedAt(); !ok {
+		v := device.UpdateDefaultModifiedAt()
+		du.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeviceUpdate) check() error {
+	if v, ok := du.mutation.SerialNumber(); ok {
+		if err := device.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Device.serial_number": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Name(); ok {
+		if err := device.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Device.name": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Status(); ok {
+		if err := device.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Device.status": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Timezone(); ok {
+		if err := device.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Device.timezone": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.PushVersion(); ok {
+		if err := device.PushVersionValidator(v); err != nil {
+			return &ValidationError{Name: "push_version", err: fmt.Errorf(`ent: validator failed for field "Device.push_version": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.DeviceInfo(); ok {
+		if err := device.DeviceInfoValidator(v); err != nil {
+			return &ValidationError{Name: "device_info", err: fmt.Errorf(`ent: validator failed for field "Device.device_info": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.IPAddress(); ok {
+		if err := device.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "Device.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.AttlogStamp(); ok {
+		if err := device.AttlogStampValidator(v); err != nil {
+			return &ValidationError{Name: "attlog_stamp", err: fmt.Errorf(`ent: validator failed for field "Device.attlog_stamp": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (du *DeviceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceUpdate {
+	du.modifiers = append(du.modifiers, modifiers...)
+	return du
+}
+
+func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.ModifiedAt(); ok {
+		_spec.SetField(device.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+	}
+	if du.mutation.DeletedAtCleared() {
+		_spec.ClearField(device.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.SerialNumber(); ok {
+		_spec.SetField(device.FieldSerialNumber, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if du.mutation.NameCleared() {
+		_spec.ClearField(device.FieldName, field.TypeString)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.Timezone(); ok {
+		_spec.SetField(device.FieldTimezone, field.TypeString, value)
+	}
+	if du.mutation.TimezoneCleared() {
+		_spec.ClearField(device.FieldTimezone, field.TypeString)
+	}
+	if value, ok := du.mutation.PushVersion(); ok {
+		_spec.SetField(device.FieldPushVersion, field.TypeString, value)
+	}
+	if du.mutation.PushVersionCleared() {
+		_spec.ClearField(device.FieldPushVersion, field.TypeString)
+	}
+	if value, ok := du.mutation.DeviceInfo(); ok {
+		_spec.SetField(device.FieldDeviceInfo, field.TypeString, value)
+	}
+	if du.mutation.DeviceInfoCleared() {
+		_spec.ClearField(device.FieldDeviceInfo, field.TypeString)
+	}
+	if value, ok := du.mutation.IPAddress(); ok {
+		_spec.SetField(device.FieldIPAddress, field.TypeString, value)
+	}
+	if du.mutation.IPAddressCleared() {
+		_spec.ClearField(device.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := du.mutation.AllowedNetworks(); ok {
+		_spec.SetField(device.FieldAllowedNetworks, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedAllowedNetworks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldAllowedNetworks, value)
+		})
+	}
+	if du.mutation.AllowedNetworksCleared() {
+		_spec.ClearField(device.FieldAllowedNetworks, field.TypeJSON)
+	}
+	if value, ok := du.mutation.AttlogStamp(); ok {
+		_spec.SetField(device.FieldAttlogStamp, field.TypeString, value)
+	}
+	if du.mutation.AttlogStampCleared() {
+		_spec.ClearField(device.FieldAttlogStamp, field.TypeString)
+	}
+	if value, ok := du.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if du.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.LastPunchAt(); ok {
+		_spec.SetField(device.FieldLastPunchAt, field.TypeTime, value)
+	}
+	if du.mutation.LastPunchAtCleared() {
+		_spec.ClearField(device.FieldLastPunchAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.ClockOffsetSeconds(); ok {
+		_spec.SetField(device.FieldClockOffsetSeconds, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedClockOffsetSeconds(); ok {
+		_spec.AddField(device.FieldClockOffsetSeconds, field.TypeInt, value)
+	}
+	if du.mutation.ClockOffsetSecondsCleared() {
+		_spec.ClearField(device.FieldClockOffsetSeconds, field.TypeInt)
+	}
+	if du.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OfficeLocationTable,
+			Columns: []string{device.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OfficeLocationTable,
+			Columns: []string{device.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.PunchRejectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedPunchRejectionsIDs(); len(nodes) > 0 && !du.mutation.PunchRejectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.PunchRejectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(du.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeviceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (duo *DeviceUpdateOne) SetModifiedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetModifiedAt(t)
+	return duo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (duo *DeviceUpdateOne) SetDeletedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetDeletedAt(t)
+	return duo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDeletedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetDeletedAt(*t)
+	}
+	return duo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (duo *DeviceUpdateOne) ClearDeletedAt() *DeviceUpdateOne {
+	duo.mutation.ClearDeletedAt()
+	return duo
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (duo *DeviceUpdateOne) SetSerialNumber(s string) *DeviceUpdateOne {
+	duo.mutation.SetSerialNumber(s)
+	return duo
+}
+
+// SetName sets the "name" field.
+func (duo *DeviceUpdateOne) SetName(s string) *DeviceUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableName(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetName(*s)
+	}
+	return duo
+}
+
+// ClearName clears the value of the "name" field.
+func (duo *DeviceUpdateOne) ClearName() *DeviceUpdateOne {
+	duo.mutation.ClearName()
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DeviceUpdateOne) SetStatus(d device.Status) *DeviceUpdateOne {
+	duo.mutation.SetStatus(d)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableStatus(d *device.Status) *DeviceUpdateOne {
+	if d != nil {
+		duo.SetStatus(*d)
+	}
+	return duo
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (duo *DeviceUpdateOne) SetOfficeLocationID(u uint64) *DeviceUpdateOne {
+	duo.mutation.SetOfficeLocationID(u)
+	return duo
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableOfficeLocationID(u *uint64) *DeviceUpdateOne {
+	if u != nil {
+		duo.SetOfficeLocationID(*u)
+	}
+	return duo
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (duo *DeviceUpdateOne) ClearOfficeLocationID() *DeviceUpdateOne {
+	duo.mutation.ClearOfficeLocationID()
+	return duo
+}
+
+// SetTimezone sets the "timezone" field.
+func (duo *DeviceUpdateOne) SetTimezone(s string) *DeviceUpdateOne {
+	duo.mutation.SetTimezone(s)
+	return duo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableTimezone(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetTimezone(*s)
+	}
+	return duo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (duo *DeviceUpdateOne) ClearTimezone() *DeviceUpdateOne {
+	duo.mutation.ClearTimezone()
+	return duo
+}
+
+// SetPushVersion sets the "push_version" field.
+func (duo *DeviceUpdateOne) SetPushVersion(s string) *DeviceUpdateOne {
+	duo.mutation.SetPushVersion(s)
+	return duo
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillablePushVersion(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetPushVersion(*s)
+	}
+	return duo
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (duo *DeviceUpdateOne) ClearPushVersion() *DeviceUpdateOne {
+	duo.mutation.ClearPushVersion()
+	return duo
+}
+
+// SetDeviceInfo sets the "device_info" field.
+func (duo *DeviceUpdateOne) SetDeviceInfo(s string) *DeviceUpdateOne {
+	duo.mutation.SetDeviceInfo(s)
+	return duo
+}
+
+// SetNillableDeviceInfo sets the "device_info" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDeviceInfo(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetDeviceInfo(*s)
+	}
+	return duo
+}
+
+// ClearDeviceInfo clears the value of the "device_info" field.
+func (duo *DeviceUpdateOne) ClearDeviceInfo() *DeviceUpdateOne {
+	duo.mutation.ClearDeviceInfo()
+	return duo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (duo *DeviceUpdateOne) SetIPAddress(s string) *DeviceUpdateOne {
+	duo.mutation.SetIPAddress(s)
+	return duo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableIPAddress(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetIPAddress(*s)
+	}
+	return duo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (duo *DeviceUpdateOne) ClearIPAddress() *DeviceUpdateOne {
+	duo.mutation.ClearIPAddress()
+	return duo
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (duo *DeviceUpdateOne) SetAllowedNetworks(s []string) *DeviceUpdateOne {
+	duo.mutation.SetAllowedNetworks(s)
+	return duo
+}
+
+// AppendAllowedNetworks appends s to the "allowed_networks" field.
+func (duo *DeviceUpdateOne) AppendAllowedNetworks(s []string) *DeviceUpdateOne {
+	duo.mutation.AppendAllowedNetworks(s)
+	return duo
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (duo *DeviceUpdateOne) ClearAllowedNetworks() *DeviceUpdateOne {
+	duo.mutation.ClearAllowedNetworks()
+	return duo
+}
+
+// SetAttlogStamp sets the "attlog_stamp" field.
+func (duo *DeviceUpdateOne) SetAttlogStamp(s string) *DeviceUpdateOne {
+	duo.mutation.SetAttlogStamp(s)
+	return duo
+}
+
+// SetNillableAttlogStamp sets the "attlog_stamp" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableAttlogStamp(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetAttlogStamp(*s)
+	}
+	return duo
+}
+
+// ClearAttlogStamp clears the value of the "attlog_stamp" field.
+func (duo *DeviceUpdateOne) ClearAttlogStamp() *DeviceUpdateOne {
+	duo.mutation.ClearAttlogStamp()
+	return duo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (duo *DeviceUpdateOne) SetLastSeenAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastSeenAt(t)
+	return duo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastSeenAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastSeenAt(*t)
+	}
+	return duo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (duo *DeviceUpdateOne) ClearLastSeenAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastSeenAt()
+	return duo
+}
+
+// SetLastPunchAt sets the "last_punch_at" field.
+func (duo *DeviceUpdateOne) SetLastPunchAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastPunchAt(t)
+	return duo
+}
+
+// SetNillableLastPunchAt sets the "last_punch_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastPunchAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastPunchAt(*t)
+	}
+	return duo
+}
+
+// ClearLastPunchAt clears the value of the "last_punch_at" field.
+func (duo *DeviceUpdateOne) ClearLastPunchAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastPunchAt()
+	return duo
+}
+
+// SetClockOffsetSeconds sets the "clock_offset_seconds" field.
+func (duo *DeviceUpdateOne) SetClockOffsetSeconds(i int) *DeviceUpdateOne {
+	duo.mutation.ResetClockOffsetSeconds()
+	duo.mutation.SetClockOffsetSeconds(i)
+	return duo
+}
+
+// SetNillableClockOffsetSeconds sets the "clock_offset_seconds" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableClockOffsetSeconds(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetClockOffsetSeconds(*i)
+	}
+	return duo
+}
+
+// AddClockOffsetSeconds adds i to the "clock_offset_seconds" field.
+func (duo *DeviceUpdateOne) AddClockOffsetSeconds(i int) *DeviceUpdateOne {
+	duo.mutation.AddClockOffsetSeconds(i)
+	return duo
+}
+
+// ClearClockOffsetSeconds clears the value of the "clock_offset_seconds" field.
+func (duo *DeviceUpdateOne) ClearClockOffsetSeconds() *DeviceUpdateOne {
+	duo.mutation.ClearClockOffsetSeconds()
+	return duo
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (duo *DeviceUpdateOne) SetOfficeLocation(o *OfficeLocation) *DeviceUpdateOne {
+	return duo.SetOfficeLocationID(o.ID)
+}
+
+// AddPunchRejectionIDs adds the "punch_rejections" edge to the DevicePunchRejection entity by IDs.
+func (duo *DeviceUpdateOne) AddPunchRejectionIDs(ids ...uint64) *DeviceUpdateOne {
+	duo.mutation.AddPunchRejectionIDs(ids...)
+	return duo
+}
+
+// AddPunchRejections adds the "punch_rejections" edges to the DevicePunchRejection entity.
+func (duo *DeviceUpdateOne) AddPunchRejections(d ...*DevicePunchRejection) *DeviceUpdateOne {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddPunchRejectionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (duo *DeviceUpdateOne) Mutation() *DeviceMutation {
+	return duo.mutation
+}
+
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (duo *DeviceUpdateOne) ClearOfficeLocation() *DeviceUpdateOne {
+	duo.mutation.ClearOfficeLocation()
+	return duo
+}
+
+// ClearPunchRejections clears all "punch_rejections" edges to the DevicePunchRejection entity.
+func (duo *DeviceUpdateOne) ClearPunchRejections() *DeviceUpdateOne {
+	duo.mutation.ClearPunchRejections()
+	return duo
+}
+
+// RemovePunchRejectionIDs removes the "punch_rejections" edge to DevicePunchRejection entities by IDs.
+func (duo *DeviceUpdateOne) RemovePunchRejectionIDs(ids ...uint64) *DeviceUpdateOne {
+	duo.mutation.RemovePunchRejectionIDs(ids...)
+	return duo
+}
+
+// RemovePunchRejections removes "punch_rejections" edges to DevicePunchRejection entities.
+func (duo *DeviceUpdateOne) RemovePunchRejections(d ...*DevicePunchRejection) *DeviceUpdateOne {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemovePunchRejectionIDs(ids...)
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (duo *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Device entity.
+func (duo *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeviceUpdateOne) SaveX(ctx context.Context) *Device {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DeviceUpdateOne) defaults() {
+	if _, ok := duo.mutation.ModifiedAt(); !ok {
+		v := device.UpdateDefaultModifiedAt()
+		duo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeviceUpdateOne) check() error {
+	if v, ok := duo.mutation.SerialNumber(); ok {
+		if err := device.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Device.serial_number": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Name(); ok {
+		if err := device.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Device.name": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Status(); ok {
+		if err := device.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Device.status": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Timezone(); ok {
+		if err := device.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Device.timezone": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.PushVersion(); ok {
+		if err := device.PushVersionValidator(v); err != nil {
+			return &ValidationError{Name: "push_version", err: fmt.Errorf(`ent: validator failed for field "Device.push_version": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.DeviceInfo(); ok {
+		if err := device.DeviceInfoValidator(v); err != nil {
+			return &ValidationError{Name: "device_info", err: fmt.Errorf(`ent: validator failed for field "Device.device_info": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.IPAddress(); ok {
+		if err := device.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "Device.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.AttlogStamp(); ok {
+		if err := device.AttlogStampValidator(v); err != nil {
+			return &ValidationError{Name: "attlog_stamp", err: fmt.Errorf(`ent: validator failed for field "Device.attlog_stamp": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (duo *DeviceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceUpdateOne {
+	duo.modifiers = append(duo.modifiers, modifiers...)
+	return duo
+}
+
+func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Device.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for _, f := range fields {
+			if !device.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.ModifiedAt(); ok {
+		_spec.SetField(device.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+	}
+	if duo.mutation.DeletedAtCleared() {
+		_spec.ClearField(device.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.SerialNumber(); ok {
+		_spec.SetField(device.FieldSerialNumber, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if duo.mutation.NameCleared() {
+		_spec.ClearField(device.FieldName, field.TypeString)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.Timezone(); ok {
+		_spec.SetField(device.FieldTimezone, field.TypeString, value)
+	}
+	if duo.mutation.TimezoneCleared() {
+		_spec.ClearField(device.FieldTimezone, field.TypeString)
+	}
+	if value, ok := duo.mutation.PushVersion(); ok {
+		_spec.SetField(device.FieldPushVersion, field.TypeString, value)
+	}
+	if duo.mutation.PushVersionCleared() {
+		_spec.ClearField(device.FieldPushVersion, field.TypeString)
+	}
+	if value, ok := duo.mutation.DeviceInfo(); ok {
+		_spec.SetField(device.FieldDeviceInfo, field.TypeString, value)
+	}
+	if duo.mutation.DeviceInfoCleared() {
+		_spec.ClearField(device.FieldDeviceInfo, field.TypeString)
+	}
+	if value, ok := duo.mutation.IPAddress(); ok {
+		_spec.SetField(device.FieldIPAddress, field.TypeString, value)
+	}
+	if duo.mutation.IPAddressCleared() {
+		_spec.ClearField(device.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := duo.mutation.AllowedNetworks(); ok {
+		_spec.SetField(device.FieldAllowedNetworks, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedAllowedNetworks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldAllowedNetworks, value)
+		})
+	}
+	if duo.mutation.AllowedNetworksCleared() {
+		_spec.ClearField(device.FieldAllowedNetworks, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.AttlogStamp(); ok {
+		_spec.SetField(device.FieldAttlogStamp, field.TypeString, value)
+	}
+	if duo.mutation.AttlogStampCleared() {
+		_spec.ClearField(device.FieldAttlogStamp, field.TypeString)
+	}
+	if value, ok := duo.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.LastPunchAt(); ok {
+		_spec.SetField(device.FieldLastPunchAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastPunchAtCleared() {
+		_spec.ClearField(device.FieldLastPunchAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.ClockOffsetSeconds(); ok {
+		_spec.SetField(device.FieldClockOffsetSeconds, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedClockOffsetSeconds(); ok {
+		_spec.AddField(device.FieldClockOffsetSeconds, field.TypeInt, value)
+	}
+	if duo.mutation.ClockOffsetSecondsCleared() {
+		_spec.ClearField(device.FieldClockOffsetSeconds, field.TypeInt)
+	}
+	if duo.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OfficeLocationTable,
+			Columns: []string{device.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OfficeLocationTable,
+			Columns: []string{device.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.PunchRejectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedPunchRejectionsIDs(); len(nodes) > 0 && !duo.mutation.PunchRejectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.PunchRejectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchRejectionsTable,
+			Columns: []string{device.PunchRejectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(duo.modifiers...)
+	_node = &Device{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DevicePunchRejection is the model entity for the DevicePunchRejection schema.
+type DevicePunchRejection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Terminal that pushed the punch
+	DeviceID uint64 `json:"device_id,omitempty"`
+	// PIN the punch was recorded for on the terminal
+	DevicePin string `json:"device_pin,omitempty"`
+	// Punch time as read from the terminal clock
+	PunchTime time.Time `json:"punch_time,omitempty"`
+	// In/out state the terminal recorded
+	PunchState int `json:"punch_state,omitempty"`
+	// VerifyMode holds the value of the "verify_mode" field.
+	VerifyMode int `json:"verify_mode,omitempty"`
+	// Why the punch was not recorded, e.g. an unknown PIN or a locked payroll period
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status devicepunchrejection.Status `json:"status,omitempty"`
+	// HR user who reviewed the punch
+	ResolvedBy *uint64 `json:"resolved_by,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ResolutionNotes holds the value of the "resolution_notes" field.
+	ResolutionNotes string `json:"resolution_notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DevicePunchRejectionQuery when eager-loading is set.
+	Edges        DevicePunchRejectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DevicePunchRejectionEdges holds the relations/edges for other nodes in the graph.
+type DevicePunchRejectionEdges struct {
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DevicePunchRejectionEdges) DeviceOrErr() (*Device, error) {
+	if e.loadedTypes[0] {
+		if e.Device == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: device.Label}
+		}
+		return e.Device, nil
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DevicePunchRejection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case devicepunchrejection.FieldID, devicepunchrejection.FieldDeviceID, devicepunchrejection.FieldPunchState, devicepunchrejection.FieldVerifyMode, devicepunchrejection.FieldResolvedBy:
+			values[i] = new(sql.NullInt64)
+		case devicepunchrejection.FieldDevicePin, devicepunchrejection.FieldReason, devicepunchrejection.FieldStatus, devicepunchrejection.FieldResolutionNotes:
+			values[i] = new(sql.NullString)
+		case devicepunchrejection.FieldCreatedAt, devicepunchrejection.FieldModifiedAt, devicepunchrejection.FieldDeletedAt, devicepunchrejection.FieldPunchTime, devicepunchrejection.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DevicePunchRejection fields.
+func (dpr *DevicePunchRejection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case devicepunchrejection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dpr.ID = uint64(value.Int64)
+		case devicepunchrejection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dpr.CreatedAt = value.Time
+			}
+		case devicepunchrejection.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				dpr.ModifiedAt = value.Time
+			}
+		case devicepunchrejection.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dpr.DeletedAt = value.Time
+			}
+		case devicepunchrejection.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				dpr.DeviceID = uint64(value.Int64)
+			}
+		case devicepunchrejection.FieldDevicePin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_pin", values[i])
+			} else if value.Valid {
+				dpr.DevicePin = value.String
+			}
+		case devicepunchrejection.FieldPunchTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field punch_time", values[i])
+			} else if value.Valid {
+				dpr.PunchTime = value.Time
+			}
+		case devicepunchrejection.FieldPunchState:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field punch_state", values[i])
+			} else if value.Valid {
+				dpr.PunchState = int(value.Int64)
+			}
+		case devicepunchrejection.FieldVerifyMode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field verify_mode", values[i])
+			} else if value.Valid {
+				dpr.VerifyMode = int(value.Int64)
+			}
+		case devicepunchrejection.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				dpr.Reason = value.String
+			}
+		case devicepunchrejection.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dpr.Status = devicepunchrejection.Status(value.String)
+			}
+		case devicepunchrejection.FieldResolvedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by", values[i])
+			} else if value.Valid {
+				dpr.ResolvedBy = new(uint64)
+				*dpr.ResolvedBy = uint64(value.Int64)
+			}
+		case devicepunchrejection.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				dpr.ResolvedAt = new(time.Time)
+				*dpr.ResolvedAt = value.Time
+			}
+		case devicepunchrejection.FieldResolutionNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_notes", values[i])
+			} else if value.Valid {
+				dpr.ResolutionNotes = value.String
+			}
+		default:
+			dpr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DevicePunchRejection.
+// This includes values selected through modifiers, order, etc.
+func (dpr *DevicePunchRejection) Value(name string) (ent.Value, error) {
+	return dpr.selectValues.Get(name)
+}
+
+// QueryDevice queries the "device" edge of the DevicePunchRejection entity.
+func (dpr *DevicePunchRejection) QueryDevice() *DeviceQuery {
+	return NewDevicePunchRejectionClient(dpr.config).QueryDevice(dpr)
+}
+
+// Update returns a builder for updating this DevicePunchRejection.
+// Note that you need to call DevicePunchRejection.Unwrap() before calling this method if this DevicePunchRejection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dpr *DevicePunchRejection) Update() *DevicePunchRejectionUpdateOne {
+	return NewDevicePunchRejectionClient(dpr.config).UpdateOne(dpr)
+}
+
+// Unwrap unwraps the DevicePunchRejection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dpr *DevicePunchRejection) Unwrap() *DevicePunchRejection {
+	_tx, ok := dpr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DevicePunchRejection is not a transactional entity")
+	}
+	dpr.config.driver = _tx.drv
+	return dpr
+}
+
+// String implements the fmt.Stringer.
+func (dpr *DevicePunchRejection) String() string {
+	var builder strings.Builder
+	builder.WriteString("DevicePunchRejection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dpr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dpr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(dpr.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(dpr.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", dpr.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("device_pin=")
+	builder.WriteString(dpr.DevicePin)
+	builder.WriteString(", ")
+	builder.WriteString("punch_time=")
+	builder.WriteString(dpr.PunchTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("punch_state=")
+	builder.WriteString(fmt.Sprintf("%v", dpr.PunchState))
+	builder.WriteString(", ")
+	builder.WriteString("verify_mode=")
+	builder.WriteString(fmt.Sprintf("%v", dpr.VerifyMode))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(dpr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dpr.Status))
+	builder.WriteString(", ")
+	if v := dpr.ResolvedBy; v != nil {
+		builder.WriteString("resolved_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dpr.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution_notes=")
+	builder.WriteString(dpr.ResolutionNotes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DevicePunchRejections is a parsable slice of DevicePunchRejection.
+type DevicePunchRejections []*DevicePunchRejection
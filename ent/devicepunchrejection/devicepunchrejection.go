@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package devicepunchrejection
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the devicepunchrejection type in the database.
+	Label = "device_punch_rejection"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldDevicePin holds the string denoting the device_pin field in the database.
+	FieldDevicePin = "device_pin"
+	// FieldPunchTime holds the string denoting the punch_time field in the database.
+	FieldPunchTime = "punch_time"
+	// FieldPunchState holds the string denoting the punch_state field in the database.
+	FieldPunchState = "punch_state"
+	// FieldVerifyMode holds the string denoting the verify_mode field in the database.
+	FieldVerifyMode = "verify_mode"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResolvedBy holds the string denoting the resolved_by field in the database.
+	FieldResolvedBy = "resolved_by"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldResolutionNotes holds the string denoting the resolution_notes field in the database.
+	FieldResolutionNotes = "resolution_notes"
+	// EdgeDevice holds the string denoting the device edge name in mutations.
+	EdgeDevice = "device"
+	// Table holds the table name of the devicepunchrejection in the database.
+	Table = "device_punch_rejections"
+	// DeviceTable is the table that holds the device relation/edge.
+	DeviceTable = "device_punch_rejections"
+	// DeviceInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DeviceInverseTable = "devices"
+	// DeviceColumn is the table column denoting the device relation/edge.
+	DeviceColumn = "device_id"
+)
+
+// Columns holds all SQL columns for devicepunchrejection fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldDeviceID,
+	FieldDevicePin,
+	FieldPunchTime,
+	FieldPunchState,
+	FieldVerifyMode,
+	FieldReason,
+	FieldStatus,
+	FieldResolvedBy,
+	FieldResolvedAt,
+	FieldResolutionNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// DevicePinValidator is a validator for the "device_pin" field. It is called by the builders before save.
+	DevicePinValidator func(string) error
+	// DefaultPunchState holds the default value on creation for the "punch_state" field.
+	DefaultPunchState int
+	// DefaultVerifyMode holds the default value on creation for the "verify_mode" field.
+	DefaultVerifyMode int
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen     Status = "open"
+	StatusResolved Status = "resolved"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusResolved:
+		return nil
+	default:
+		return fmt.Errorf("devicepunchrejection: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DevicePunchRejection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByDevicePin orders the results by the device_pin field.
+func ByDevicePin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDevicePin, opts...).ToFunc()
+}
+
+// ByPunchTime orders the results by the punch_time field.
+func ByPunchTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPunchTime, opts...).ToFunc()
+}
+
+// ByPunchState orders the results by the punch_state field.
+func ByPunchState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPunchState, opts...).ToFunc()
+}
+
+// ByVerifyMode orders the results by the verify_mode field.
+func ByVerifyMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifyMode, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResolvedBy orders the results by the resolved_by field.
+func ByResolvedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedBy, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByResolutionNotes orders the results by the resolution_notes field.
+func ByResolutionNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionNotes, opts...).ToFunc()
+}
+
+// ByDeviceField orders the results by device field.
+func ByDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeviceTable, DeviceColumn),
+	)
+}
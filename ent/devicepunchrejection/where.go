@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package devicepunchrejection
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DevicePin applies equality check predicate on the "device_pin" field. It's identical to DevicePinEQ.
+func DevicePin(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDevicePin, v))
+}
+
+// PunchTime applies equality check predicate on the "punch_time" field. It's identical to PunchTimeEQ.
+func PunchTime(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldPunchTime, v))
+}
+
+// PunchState applies equality check predicate on the "punch_state" field. It's identical to PunchStateEQ.
+func PunchState(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldPunchState, v))
+}
+
+// VerifyMode applies equality check predicate on the "verify_mode" field. It's identical to VerifyModeEQ.
+func VerifyMode(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldVerifyMode, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldReason, v))
+}
+
+// ResolvedBy applies equality check predicate on the "resolved_by" field. It's identical to ResolvedByEQ.
+func ResolvedBy(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolutionNotes applies equality check predicate on the "resolution_notes" field. It's identical to ResolutionNotesEQ.
+func ResolutionNotes(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolutionNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DevicePinEQ applies the EQ predicate on the "device_pin" field.
+func DevicePinEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldDevicePin, v))
+}
+
+// DevicePinNEQ applies the NEQ predicate on the "device_pin" field.
+func DevicePinNEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldDevicePin, v))
+}
+
+// DevicePinIn applies the In predicate on the "device_pin" field.
+func DevicePinIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldDevicePin, vs...))
+}
+
+// DevicePinNotIn applies the NotIn predicate on the "device_pin" field.
+func DevicePinNotIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldDevicePin, vs...))
+}
+
+// DevicePinGT applies the GT predicate on the "device_pin" field.
+func DevicePinGT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldDevicePin, v))
+}
+
+// DevicePinGTE applies the GTE predicate on the "device_pin" field.
+func DevicePinGTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldDevicePin, v))
+}
+
+// DevicePinLT applies the LT predicate on the "device_pin" field.
+func DevicePinLT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldDevicePin, v))
+}
+
+// DevicePinLTE applies the LTE predicate on the "device_pin" field.
+func DevicePinLTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldDevicePin, v))
+}
+
+// DevicePinContains applies the Contains predicate on the "device_pin" field.
+func DevicePinContains(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContains(FieldDevicePin, v))
+}
+
+// DevicePinHasPrefix applies the HasPrefix predicate on the "device_pin" field.
+func DevicePinHasPrefix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasPrefix(FieldDevicePin, v))
+}
+
+// DevicePinHasSuffix applies the HasSuffix predicate on the "device_pin" field.
+func DevicePinHasSuffix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasSuffix(FieldDevicePin, v))
+}
+
+// DevicePinEqualFold applies the EqualFold predicate on the "device_pin" field.
+func DevicePinEqualFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEqualFold(FieldDevicePin, v))
+}
+
+// DevicePinContainsFold applies the ContainsFold predicate on the "device_pin" field.
+func DevicePinContainsFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContainsFold(FieldDevicePin, v))
+}
+
+// PunchTimeEQ applies the EQ predicate on the "punch_time" field.
+func PunchTimeEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldPunchTime, v))
+}
+
+// PunchTimeNEQ applies the NEQ predicate on the "punch_time" field.
+func PunchTimeNEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldPunchTime, v))
+}
+
+// PunchTimeIn applies the In predicate on the "punch_time" field.
+func PunchTimeIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldPunchTime, vs...))
+}
+
+// PunchTimeNotIn applies the NotIn predicate on the "punch_time" field.
+func PunchTimeNotIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldPunchTime, vs...))
+}
+
+// PunchTimeGT applies the GT predicate on the "punch_time" field.
+func PunchTimeGT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldPunchTime, v))
+}
+
+// PunchTimeGTE applies the GTE predicate on the "punch_time" field.
+func PunchTimeGTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldPunchTime, v))
+}
+
+// PunchTimeLT applies the LT predicate on the "punch_time" field.
+func PunchTimeLT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldPunchTime, v))
+}
+
+// PunchTimeLTE applies the LTE predicate on the "punch_time" field.
+func PunchTimeLTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldPunchTime, v))
+}
+
+// PunchStateEQ applies the EQ predicate on the "punch_state" field.
+func PunchStateEQ(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldPunchState, v))
+}
+
+// PunchStateNEQ applies the NEQ predicate on the "punch_state" field.
+func PunchStateNEQ(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldPunchState, v))
+}
+
+// PunchStateIn applies the In predicate on the "punch_state" field.
+func PunchStateIn(vs ...int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldPunchState, vs...))
+}
+
+// PunchStateNotIn applies the NotIn predicate on the "punch_state" field.
+func PunchStateNotIn(vs ...int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldPunchState, vs...))
+}
+
+// PunchStateGT applies the GT predicate on the "punch_state" field.
+func PunchStateGT(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldPunchState, v))
+}
+
+// PunchStateGTE applies the GTE predicate on the "punch_state" field.
+func PunchStateGTE(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldPunchState, v))
+}
+
+// PunchStateLT applies the LT predicate on the "punch_state" field.
+func PunchStateLT(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldPunchState, v))
+}
+
+// PunchStateLTE applies the LTE predicate on the "punch_state" field.
+func PunchStateLTE(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldPunchState, v))
+}
+
+// VerifyModeEQ applies the EQ predicate on the "verify_mode" field.
+func VerifyModeEQ(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldVerifyMode, v))
+}
+
+// VerifyModeNEQ applies the NEQ predicate on the "verify_mode" field.
+func VerifyModeNEQ(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldVerifyMode, v))
+}
+
+// VerifyModeIn applies the In predicate on the "verify_mode" field.
+func VerifyModeIn(vs ...int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldVerifyMode, vs...))
+}
+
+// VerifyModeNotIn applies the NotIn predicate on the "verify_mode" field.
+func VerifyModeNotIn(vs ...int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldVerifyMode, vs...))
+}
+
+// VerifyModeGT applies the GT predicate on the "verify_mode" field.
+func VerifyModeGT(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldVerifyMode, v))
+}
+
+// VerifyModeGTE applies the GTE predicate on the "verify_mode" field.
+func VerifyModeGTE(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldVerifyMode, v))
+}
+
+// VerifyModeLT applies the LT predicate on the "verify_mode" field.
+func VerifyModeLT(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldVerifyMode, v))
+}
+
+// VerifyModeLTE applies the LTE predicate on the "verify_mode" field.
+func VerifyModeLTE(v int) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldVerifyMode, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResolvedByEQ applies the EQ predicate on the "resolved_by" field.
+func ResolvedByEQ(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByNEQ applies the NEQ predicate on the "resolved_by" field.
+func ResolvedByNEQ(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByIn applies the In predicate on the "resolved_by" field.
+func ResolvedByIn(vs ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByNotIn applies the NotIn predicate on the "resolved_by" field.
+func ResolvedByNotIn(vs ...uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByGT applies the GT predicate on the "resolved_by" field.
+func ResolvedByGT(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldResolvedBy, v))
+}
+
+// ResolvedByGTE applies the GTE predicate on the "resolved_by" field.
+func ResolvedByGTE(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldResolvedBy, v))
+}
+
+// ResolvedByLT applies the LT predicate on the "resolved_by" field.
+func ResolvedByLT(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldResolvedBy, v))
+}
+
+// ResolvedByLTE applies the LTE predicate on the "resolved_by" field.
+func ResolvedByLTE(v uint64) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldResolvedBy, v))
+}
+
+// ResolvedByIsNil applies the IsNil predicate on the "resolved_by" field.
+func ResolvedByIsNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIsNull(FieldResolvedBy))
+}
+
+// ResolvedByNotNil applies the NotNil predicate on the "resolved_by" field.
+func ResolvedByNotNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotNull(FieldResolvedBy))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// ResolutionNotesEQ applies the EQ predicate on the "resolution_notes" field.
+func ResolutionNotesEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEQ(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesNEQ applies the NEQ predicate on the "resolution_notes" field.
+func ResolutionNotesNEQ(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNEQ(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesIn applies the In predicate on the "resolution_notes" field.
+func ResolutionNotesIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIn(FieldResolutionNotes, vs...))
+}
+
+// ResolutionNotesNotIn applies the NotIn predicate on the "resolution_notes" field.
+func ResolutionNotesNotIn(vs ...string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotIn(FieldResolutionNotes, vs...))
+}
+
+// ResolutionNotesGT applies the GT predicate on the "resolution_notes" field.
+func ResolutionNotesGT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGT(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesGTE applies the GTE predicate on the "resolution_notes" field.
+func ResolutionNotesGTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldGTE(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesLT applies the LT predicate on the "resolution_notes" field.
+func ResolutionNotesLT(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLT(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesLTE applies the LTE predicate on the "resolution_notes" field.
+func ResolutionNotesLTE(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldLTE(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesContains applies the Contains predicate on the "resolution_notes" field.
+func ResolutionNotesContains(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContains(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesHasPrefix applies the HasPrefix predicate on the "resolution_notes" field.
+func ResolutionNotesHasPrefix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasPrefix(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesHasSuffix applies the HasSuffix predicate on the "resolution_notes" field.
+func ResolutionNotesHasSuffix(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldHasSuffix(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesIsNil applies the IsNil predicate on the "resolution_notes" field.
+func ResolutionNotesIsNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldIsNull(FieldResolutionNotes))
+}
+
+// ResolutionNotesNotNil applies the NotNil predicate on the "resolution_notes" field.
+func ResolutionNotesNotNil() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldNotNull(FieldResolutionNotes))
+}
+
+// ResolutionNotesEqualFold applies the EqualFold predicate on the "resolution_notes" field.
+func ResolutionNotesEqualFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldEqualFold(FieldResolutionNotes, v))
+}
+
+// ResolutionNotesContainsFold applies the ContainsFold predicate on the "resolution_notes" field.
+func ResolutionNotesContainsFold(v string) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(sql.FieldContainsFold(FieldResolutionNotes, v))
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DevicePunchRejection) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DevicePunchRejection) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DevicePunchRejection) predicate.DevicePunchRejection {
+	return predicate.DevicePunchRejection(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DevicePunchRejectionCreate is the builder for creating a DevicePunchRejection entity.
+type DevicePunchRejectionCreate struct {
+	config
+	mutation *DevicePunchRejectionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dprc *DevicePunchRejectionCreate) SetCreatedAt(t time.Time) *DevicePunchRejectionCreate {
+	dprc.mutation.SetCreatedAt(t)
+	return dprc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableCreatedAt(t *time.Time) *DevicePunchRejectionCreate {
+	if t != nil {
+		dprc.SetCreatedAt(*t)
+	}
+	return dprc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (dprc *DevicePunchRejectionCreate) SetModifiedAt(t time.Time) *DevicePunchRejectionCreate {
+	dprc.mutation.SetModifiedAt(t)
+	return dprc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableModifiedAt(t *time.Time) *DevicePunchRejectionCreate {
+	if t != nil {
+		dprc.SetModifiedAt(*t)
+	}
+	return dprc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dprc *DevicePunchRejectionCreate) SetDeletedAt(t time.Time) *DevicePunchRejectionCreate {
+	dprc.mutation.SetDeletedAt(t)
+	return dprc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableDeletedAt(t *time.Time) *DevicePunchRejectionCreate {
+	if t != nil {
+		dprc.SetDeletedAt(*t)
+	}
+	return dprc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dprc *DevicePunchRejectionCreate) SetDeviceID(u uint64) *DevicePunchRejectionCreate {
+	dprc.mutation.SetDeviceID(u)
+	return dprc
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (dprc *DevicePunchRejectionCreate) SetDevicePin(s string) *DevicePunchRejectionCreate {
+	dprc.mutation.SetDevicePin(s)
+	return dprc
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (dprc *DevicePunchRejectionCreate) SetPunchTime(t time.Time) *DevicePunchRejectionCreate {
+	dprc.mutation.SetPunchTime(t)
+	return dprc
+}
+
+// SetPunchState sets the "punch_state" field.
+func (dprc *DevicePunchRejectionCreate) SetPunchState(i int) *DevicePunchRejectionCreate {
+	dprc.mutation.SetPunchState(i)
+	return dprc
+}
+
+// SetNillablePunchState sets the "punch_state" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillablePunchState(i *int) *DevicePunchRejectionCreate {
+	if i != nil {
+		dprc.SetPunchState(*i)
+	}
+	return dprc
+}
+
+// SetVerifyMode sets the "verify_mode" field.
+func (dprc *DevicePunchRejectionCreate) SetVerifyMode(i int) *DevicePunchRejectionCreate {
+	dprc.mutation.SetVerifyMode(i)
+	return dprc
+}
+
+// SetNillableVerifyMode sets the "verify_mode" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableVerifyMode(i *int) *DevicePunchRejectionCreate {
+	if i != nil {
+		dprc.SetVerifyMode(*i)
+	}
+	return dprc
+}
+
+// SetReason sets the "reason" field.
+func (dprc *DevicePunchRejectionCreate) SetReason(s string) *DevicePunchRejectionCreate {
+	dprc.mutation.SetReason(s)
+	return dprc
+}
+
+// SetStatus sets the "status" field.
+func (dprc *DevicePunchRejectionCreate) SetStatus(d devicepunchrejection.Status) *DevicePunchRejectionCreate {
+	dprc.mutation.SetStatus(d)
+	return dprc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableStatus(d *devicepunchrejection.Status) *DevicePunchRejectionCreate {
+	if d != nil {
+		dprc.SetStatus(*d)
+	}
+	return dprc
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (dprc *DevicePunchRejectionCreate) SetResolvedBy(u uint64) *DevicePunchRejectionCreate {
+	dprc.mutation.SetResolvedBy(u)
+	return dprc
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableResolvedBy(u *uint64) *DevicePunchRejectionCreate {
+	if u != nil {
+		dprc.SetResolvedBy(*u)
+	}
+	return dprc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dprc *DevicePunchRejectionCreate) SetResolvedAt(t time.Time) *DevicePunchRejectionCreate {
+	dprc.mutation.SetResolvedAt(t)
+	return dprc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableResolvedAt(t *time.Time) *DevicePunchRejectionCreate {
+	if t != nil {
+		dprc.SetResolvedAt(*t)
+	}
+	return dprc
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (dprc *DevicePunchRejectionCreate) SetResolutionNotes(s string) *DevicePunchRejectionCreate {
+	dprc.mutation.SetResolutionNotes(s)
+	return dprc
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (dprc *DevicePunchRejectionCreate) SetNillableResolutionNotes(s *string) *DevicePunchRejectionCreate {
+	if s != nil {
+		dprc.SetResolutionNotes(*s)
+	}
+	return dprc
+}
+
+// SetID sets the "id" field.
+func (dprc *DevicePunchRejectionCreate) SetID(u uint64) *DevicePunchRejectionCreate {
+	dprc.mutation.SetID(u)
+	return dprc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (dprc *DevicePunchRejectionCreate) SetDevice(d *Device) *DevicePunchRejectionCreate {
+	return dprc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the DevicePunchRejectionMutation object of the builder.
+func (dprc *DevicePunchRejectionCreate) Mutation() *DevicePunchRejectionMutation {
+	return dprc.mutation
+}
+
+// Save creates the DevicePunchRejection in the database.
+func (dprc *DevicePunchRejectionCreate) Save(ctx context.Context) (*DevicePunchRejection, error) {
+	dprc.defaults()
+	return withHooks(ctx, dprc.sqlSave, dprc.mutation, dprc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dprc *DevicePunchRejectionCreate) SaveX(ctx context.Context) *DevicePunchRejection {
+	v, err := dprc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dprc *DevicePunchRejectionCreate) Exec(ctx context.Context) error {
+	_, err := dprc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dprc *DevicePunchRejectionCreate) ExecX(ctx context.Context) {
+	if err := dprc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dprc *DevicePunchRejectionCreate) defaults() {
+	if _, ok := dprc.mutation.CreatedAt(); !ok {
+		v := devicepunchrejection.DefaultCreatedAt()
+		dprc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dprc.mutation.ModifiedAt(); !ok {
+		v := devicepunchrejection.DefaultModifiedAt()
+		dprc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := dprc.mutation.PunchState(); !ok {
+		v := devicepunchrejection.DefaultPunchState
+		dprc.mutation.SetPunchState(v)
+	}
+	if _, ok := dprc.mutation.VerifyMode(); !ok {
+		v := devicepunchrejection.DefaultVerifyMode
+		dprc.mutation.SetVerifyMode(v)
+	}
+	if _, ok := dprc.mutation.Status(); !ok {
+		v := devicepunchrejection.DefaultStatus
+		dprc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dprc *DevicePunchRejectionCreate) check() error {
+	if _, ok := dprc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DevicePunchRejection.created_at"`)}
+	}
+	if _, ok := dprc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "DevicePunchRejection.modified_at"`)}
+	}
+	if _, ok := dprc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DevicePunchRejection.device_id"`)}
+	}
+	if _, ok := dprc.mutation.DevicePin(); !ok {
+		return &ValidationError{Name: "device_pin", err: errors.New(`ent: missing required field "DevicePunchRejection.device_pin"`)}
+	}
+	if v, ok := dprc.mutation.DevicePin(); ok {
+		if err := devicepunchrejection.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.device_pin": %w`, err)}
+		}
+	}
+	if _, ok := dprc.mutation.PunchTime(); !ok {
+		return &ValidationError{Name: "punch_time", err: errors.New(`ent: missing required field "DevicePunchRejection.punch_time"`)}
+	}
+	if _, ok := dprc.mutation.PunchState(); !ok {
+		return &ValidationError{Name: "punch_state", err: errors.New(`ent: missing required field "DevicePunchRejection.punch_state"`)}
+	}
+	if _, ok := dprc.mutation.VerifyMode(); !ok {
+		return &ValidationError{Name: "verify_mode", err: errors.New(`ent: missing required field "DevicePunchRejection.verify_mode"`)}
+	}
+	if _, ok := dprc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "DevicePunchRejection.reason"`)}
+	}
+	if v, ok := dprc.mutation.Reason(); ok {
+		if err := devicepunchrejection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.reason": %w`, err)}
+		}
+	}
+	if _, ok := dprc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DevicePunchRejection.status"`)}
+	}
+	if v, ok := dprc.mutation.Status(); ok {
+		if err := devicepunchrejection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.status": %w`, err)}
+		}
+	}
+	if _, ok := dprc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "DevicePunchRejection.device"`)}
+	}
+	return nil
+}
+
+func (dprc *DevicePunchRejectionCreate) sqlSave(ctx context.Context) (*DevicePunchRejection, error) {
+	if err := dprc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dprc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dprc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	dprc.mutation.id = &_node.ID
+	dprc.mutation.done = true
+	return _node, nil
+}
+
+func (dprc *DevicePunchRejectionCreate) createSpec() (*DevicePunchRejection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DevicePunchRejection{config: dprc.config}
+		_spec = sqlgraph.NewCreateSpec(devicepunchrejection.Table, sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64))
+	)
+	if id, ok := dprc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dprc.mutation.CreatedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dprc.mutation.ModifiedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := dprc.mutation.DeletedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := dprc.mutation.DevicePin(); ok {
+		_spec.SetField(devicepunchrejection.FieldDevicePin, field.TypeString, value)
+		_node.DevicePin = value
+	}
+	if value, ok := dprc.mutation.PunchTime(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchTime, field.TypeTime, value)
+		_node.PunchTime = value
+	}
+	if value, ok := dprc.mutation.PunchState(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchState, field.TypeInt, value)
+		_node.PunchState = value
+	}
+	if value, ok := dprc.mutation.VerifyMode(); ok {
+		_spec.SetField(devicepunchrejection.FieldVerifyMode, field.TypeInt, value)
+		_node.VerifyMode = value
+	}
+	if value, ok := dprc.mutation.Reason(); ok {
+		_spec.SetField(devicepunchrejection.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := dprc.mutation.Status(); ok {
+		_spec.SetField(devicepunchrejection.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dprc.mutation.ResolvedBy(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedBy, field.TypeUint64, value)
+		_node.ResolvedBy = &value
+	}
+	if value, ok := dprc.mutation.ResolvedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := dprc.mutation.ResolutionNotes(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolutionNotes, field.TypeString, value)
+		_node.ResolutionNotes = value
+	}
+	if nodes := dprc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   devicepunchrejection.DeviceTable,
+			Columns: []string{devicepunchrejection.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeviceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DevicePunchRejectionCreateBulk is the builder for creating many DevicePunchRejection entities in bulk.
+type DevicePunchRejectionCreateBulk struct {
+	config
+	builders []*DevicePunchRejectionCreate
+}
+
+// Save creates the DevicePunchRejection entities in the database.
+func (dprcb *DevicePunchRejectionCreateBulk) Save(ctx context.Context) ([]*DevicePunchRejection, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dprcb.builders))
+	nodes := make([]*DevicePunchRejection, len(dprcb.builders))
+	mutators := make([]Mutator, len(dprcb.builders))
+	for i := range dprcb.builders {
+		func(i int, root context.Context) {
+			builder := dprcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DevicePunchRejectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dprcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dprcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dprcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dprcb *DevicePunchRejectionCreateBulk) SaveX(ctx context.Context) []*DevicePunchRejection {
+	v, err := dprcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dprcb *DevicePunchRejectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := dprcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dprcb *DevicePunchRejectionCreateBulk) ExecX(ctx context.Context) {
+	if err := dprcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DevicePunchRejectionDelete is the builder for deleting a DevicePunchRejection entity.
+type DevicePunchRejectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DevicePunchRejectionMutation
+}
+
+// Where appends a list predicates to the DevicePunchRejectionDelete builder.
+func (dprd *DevicePunchRejectionDelete) Where(ps ...predicate.DevicePunchRejection) *DevicePunchRejectionDelete {
+	dprd.mutation.Where(ps...)
+	return dprd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dprd *DevicePunchRejectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dprd.sqlExec, dprd.mutation, dprd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dprd *DevicePunchRejectionDelete) ExecX(ctx context.Context) int {
+	n, err := dprd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dprd *DevicePunchRejectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(devicepunchrejection.Table, sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64))
+	if ps := dprd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dprd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dprd.mutation.done = true
+	return affected, err
+}
+
+// DevicePunchRejectionDeleteOne is the builder for deleting a single DevicePunchRejection entity.
+type DevicePunchRejectionDeleteOne struct {
+	dprd *DevicePunchRejectionDelete
+}
+
+// Where appends a list predicates to the DevicePunchRejectionDelete builder.
+func (dprdo *DevicePunchRejectionDeleteOne) Where(ps ...predicate.DevicePunchRejection) *DevicePunchRejectionDeleteOne {
+	dprdo.dprd.mutation.Where(ps...)
+	return dprdo
+}
+
+// Exec executes the deletion query.
+func (dprdo *DevicePunchRejectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := dprdo.dprd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{devicepunchrejection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dprdo *DevicePunchRejectionDeleteOne) ExecX(ctx context.Context) {
+	if err := dprdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
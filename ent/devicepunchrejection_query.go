@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DevicePunchRejectionQuery is the builder for querying DevicePunchRejection entities.
+type DevicePunchRejectionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []devicepunchrejection.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DevicePunchRejection
+	withDevice *DeviceQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DevicePunchRejectionQuery builder.
+func (dprq *DevicePunchRejectionQuery) Where(ps ...predicate.DevicePunchRejection) *DevicePunchRejectionQuery {
+	dprq.predicates = append(dprq.predicates, ps...)
+	return dprq
+}
+
+// Limit the number of records to be returned by this query.
+func (dprq *DevicePunchRejectionQuery) Limit(limit int) *DevicePunchRejectionQuery {
+	dprq.ctx.Limit = &limit
+	return dprq
+}
+
+// Offset to start from.
+func (dprq *DevicePunchRejectionQuery) Offset(offset int) *DevicePunchRejectionQuery {
+	dprq.ctx.Offset = &offset
+	return dprq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dprq *DevicePunchRejectionQuery) Unique(unique bool) *DevicePunchRejectionQuery {
+	dprq.ctx.Unique = &unique
+	return dprq
+}
+
+// Order specifies how the records should be ordered.
+func (dprq *DevicePunchRejectionQuery) Order(o ...devicepunchrejection.OrderOption) *DevicePunchRejectionQuery {
+	dprq.order = append(dprq.order, o...)
+	return dprq
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (dprq *DevicePunchRejectionQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: dprq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dprq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dprq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(devicepunchrejection.Table, devicepunchrejection.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicepunchrejection.DeviceTable, devicepunchrejection.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dprq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DevicePunchRejection entity from the query.
+// Returns a *NotFoundError when no DevicePunchRejection was found.
+func (dprq *DevicePunchRejectionQuery) First(ctx context.Context) (*DevicePunchRejection, error) {
+	nodes, err := dprq.Limit(1).All(setContextOp(ctx, dprq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{devicepunchrejection.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) FirstX(ctx context.Context) *DevicePunchRejection {
+	node, err := dprq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DevicePunchRejection ID from the query.
+// Returns a *NotFoundError when no DevicePunchRejection ID was found.
+func (dprq *DevicePunchRejectionQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = dprq.Limit(1).IDs(setContextOp(ctx, dprq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{devicepunchrejection.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := dprq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DevicePunchRejection entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DevicePunchRejection entity is found.
+// Returns a *NotFoundError when no DevicePunchRejection entities are found.
+func (dprq *DevicePunchRejectionQuery) Only(ctx context.Context) (*DevicePunchRejection, error) {
+	nodes, err := dprq.Limit(2).All(setContextOp(ctx, dprq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{devicepunchrejection.Label}
+	default:
+		return nil, &NotSingularError{devicepunchrejection.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) OnlyX(ctx context.Context) *DevicePunchRejection {
+	node, err := dprq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DevicePunchRejection ID in the query.
+// Returns a *NotSingularError when more than one DevicePunchRejection ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dprq *DevicePunchRejectionQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = dprq.Limit(2).IDs(setContextOp(ctx, dprq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{devicepunchrejection.Label}
+	default:
+		err = &NotSingularError{devicepunchrejection.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := dprq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DevicePunchRejections.
+func (dprq *DevicePunchRejectionQuery) All(ctx context.Context) ([]*DevicePunchRejection, error) {
+	ctx = setContextOp(ctx, dprq.ctx, "All")
+	if err := dprq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DevicePunchRejection, *DevicePunchRejectionQuery]()
+	return withInterceptors[[]*DevicePunchRejection](ctx, dprq, qr, dprq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) AllX(ctx context.Context) []*DevicePunchRejection {
+	nodes, err := dprq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DevicePunchRejection IDs.
+func (dprq *DevicePunchRejectionQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if dprq.ctx.Unique == nil && dprq.path != nil {
+		dprq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dprq.ctx, "IDs")
+	if err = dprq.Select(devicepunchrejection.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := dprq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dprq *DevicePunchRejectionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dprq.ctx, "Count")
+	if err := dprq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dprq, querierCount[*DevicePunchRejectionQuery](), dprq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) CountX(ctx context.Context) int {
+	count, err := dprq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dprq *DevicePunchRejectionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dprq.ctx, "Exist")
+	switch _, err := dprq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dprq *DevicePunchRejectionQuery) ExistX(ctx context.Context) bool {
+	exist, err := dprq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DevicePunchRejectionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dprq *DevicePunchRejectionQuery) Clone() *DevicePunchRejectionQuery {
+	if dprq == nil {
+		return nil
+	}
+	return &DevicePunchRejectionQuery{
+		config:     dprq.config,
+		ctx:        dprq.ctx.Clone(),
+		order:      append([]devicepunchrejection.OrderOption{}, dprq.order...),
+		inters:     append([]Interceptor{}, dprq.inters...),
+		predicates: append([]predicate.DevicePunchRejection{}, dprq.predicates...),
+		withDevice: dprq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  dprq.sql.Clone(),
+		path: dprq.path,
+	}
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (dprq *DevicePunchRejectionQuery) WithDevice(opts ...func(*DeviceQuery)) *DevicePunchRejectionQuery {
+	query := (&DeviceClient{config: dprq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dprq.withDevice = query
+	return dprq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DevicePunchRejection.Query().
+//		GroupBy(devicepunchrejection.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dprq *DevicePunchRejectionQuery) GroupBy(field string, fields ...string) *DevicePunchRejectionGroupBy {
+	dprq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DevicePunchRejectionGroupBy{build: dprq}
+	grbuild.flds = &dprq.ctx.Fields
+	grbuild.label = devicepunchrejection.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DevicePunchRejection.Query().
+//		Select(devicepunchrejection.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dprq *DevicePunchRejectionQuery) Select(fields ...string) *DevicePunchRejectionSelect {
+	dprq.ctx.Fields = append(dprq.ctx.Fields, fields...)
+	sbuild := &DevicePunchRejectionSelect{DevicePunchRejectionQuery: dprq}
+	sbuild.label = devicepunchrejection.Label
+	sbuild.flds, sbuild.scan = &dprq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DevicePunchRejectionSelect configured with the given aggregations.
+func (dprq *DevicePunchRejectionQuery) Aggregate(fns ...AggregateFunc) *DevicePunchRejectionSelect {
+	return dprq.Select().Aggregate(fns...)
+}
+
+func (dprq *DevicePunchRejectionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dprq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dprq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dprq.ctx.Fields {
+		if !devicepunchrejection.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dprq.path != nil {
+		prev, err := dprq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dprq.sql = prev
+	}
+	return nil
+}
+
+func (dprq *DevicePunchRejectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DevicePunchRejection, error) {
+	var (
+		nodes       = []*DevicePunchRejection{}
+		_spec       = dprq.querySpec()
+		loadedTypes = [1]bool{
+			dprq.withDevice != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DevicePunchRejection).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DevicePunchRejection{config: dprq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dprq.modifiers) > 0 {
+		_spec.Modifiers = dprq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dprq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dprq.withDevice; query != nil {
+		if err := dprq.loadDevice(ctx, query, nodes, nil,
+			func(n *DevicePunchRejection, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dprq *DevicePunchRejectionQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*DevicePunchRejection, init func(*DevicePunchRejection), assign func(*DevicePunchRejection, *Device)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*DevicePunchRejection)
+	for i := range nodes {
+		fk := nodes[i].DeviceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "device_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dprq *DevicePunchRejectionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dprq.querySpec()
+	if len(dprq.modifiers) > 0 {
+		_spec.Modifiers = dprq.modifiers
+	}
+	_spec.Node.Columns = dprq.ctx.Fields
+	if len(dprq.ctx.Fields) > 0 {
+		_spec.Unique = dprq.ctx.Unique != nil && *dprq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dprq.driver, _spec)
+}
+
+func (dprq *DevicePunchRejectionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(devicepunchrejection.Table, devicepunchrejection.Columns, sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64))
+	_spec.From = dprq.sql
+	if unique := dprq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dprq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dprq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, devicepunchrejection.FieldID)
+		for i := range fields {
+			if fields[i] != devicepunchrejection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if dprq.withDevice != nil {
+			_spec.Node.AddColumnOnce(devicepunchrejection.FieldDeviceID)
+		}
+	}
+	if ps := dprq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dprq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dprq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dprq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dprq *DevicePunchRejectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dprq.driver.Dialect())
+	t1 := builder.Table(devicepunchrejection.Table)
+	columns := dprq.ctx.Fields
+	if len(columns) == 0 {
+		columns = devicepunchrejection.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dprq.sql != nil {
+		selector = dprq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dprq.ctx.Unique != nil && *dprq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dprq.modifiers {
+		m(selector)
+	}
+	for _, p := range dprq.predicates {
+		p(selector)
+	}
+	for _, p := range dprq.order {
+		p(selector)
+	}
+	if offset := dprq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dprq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dprq *DevicePunchRejectionQuery) Modify(modifiers ...func(s *sql.Selector)) *DevicePunchRejectionSelect {
+	dprq.modifiers = append(dprq.modifiers, modifiers...)
+	return dprq.Select()
+}
+
+// DevicePunchRejectionGroupBy is the group-by builder for DevicePunchRejection entities.
+type DevicePunchRejectionGroupBy struct {
+	selector
+	build *DevicePunchRejectionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dprgb *DevicePunchRejectionGroupBy) Aggregate(fns ...AggregateFunc) *DevicePunchRejectionGroupBy {
+	dprgb.fns = append(dprgb.fns, fns...)
+	return dprgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dprgb *DevicePunchRejectionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dprgb.build.ctx, "GroupBy")
+	if err := dprgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DevicePunchRejectionQuery, *DevicePunchRejectionGroupBy](ctx, dprgb.build, dprgb, dprgb.build.inters, v)
+}
+
+func (dprgb *DevicePunchRejectionGroupBy) sqlScan(ctx context.Context, root *DevicePunchRejectionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dprgb.fns))
+	for _, fn := range dprgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dprgb.flds)+len(dprgb.fns))
+		for _, f := range *dprgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dprgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dprgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DevicePunchRejectionSelect is the builder for selecting fields of DevicePunchRejection entities.
+type DevicePunchRejectionSelect struct {
+	*DevicePunchRejectionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dprs *DevicePunchRejectionSelect) Aggregate(fns ...AggregateFunc) *DevicePunchRejectionSelect {
+	dprs.fns = append(dprs.fns, fns...)
+	return dprs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dprs *DevicePunchRejectionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dprs.ctx, "Select")
+	if err := dprs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DevicePunchRejectionQuery, *DevicePunchRejectionSelect](ctx, dprs.DevicePunchRejectionQuery, dprs, dprs.inters, v)
+}
+
+func (dprs *DevicePunchRejectionSelect) sqlScan(ctx context.Context, root *DevicePunchRejectionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dprs.fns))
+	for _, fn := range dprs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dprs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dprs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dprs *DevicePunchRejectionSelect) Modify(modifiers ...func(s *sql.Selector)) *DevicePunchRejectionSelect {
+	dprs.modifiers = append(dprs.modifiers, modifiers...)
+	return dprs
+}
@@ -0,0 +1,797 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DevicePunchRejectionUpdate is the builder for updating DevicePunchRejection entities.
+type DevicePunchRejectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DevicePunchRejectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DevicePunchRejectionUpdate builder.
+func (dpru *DevicePunchRejectionUpdate) Where(ps ...predicate.DevicePunchRejection) *DevicePunchRejectionUpdate {
+	dpru.mutation.Where(ps...)
+	return dpru
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (dpru *DevicePunchRejectionUpdate) SetModifiedAt(t time.Time) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetModifiedAt(t)
+	return dpru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dpru *DevicePunchRejectionUpdate) SetDeletedAt(t time.Time) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetDeletedAt(t)
+	return dpru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableDeletedAt(t *time.Time) *DevicePunchRejectionUpdate {
+	if t != nil {
+		dpru.SetDeletedAt(*t)
+	}
+	return dpru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dpru *DevicePunchRejectionUpdate) ClearDeletedAt() *DevicePunchRejectionUpdate {
+	dpru.mutation.ClearDeletedAt()
+	return dpru
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dpru *DevicePunchRejectionUpdate) SetDeviceID(u uint64) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetDeviceID(u)
+	return dpru
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (dpru *DevicePunchRejectionUpdate) SetDevicePin(s string) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetDevicePin(s)
+	return dpru
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (dpru *DevicePunchRejectionUpdate) SetPunchTime(t time.Time) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetPunchTime(t)
+	return dpru
+}
+
+// SetPunchState sets the "punch_state" field.
+func (dpru *DevicePunchRejectionUpdate) SetPunchState(i int) *DevicePunchRejectionUpdate {
+	dpru.mutation.ResetPunchState()
+	dpru.mutation.SetPunchState(i)
+	return dpru
+}
+
+// SetNillablePunchState sets the "punch_state" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillablePunchState(i *int) *DevicePunchRejectionUpdate {
+	if i != nil {
+		dpru.SetPunchState(*i)
+	}
+	return dpru
+}
+
+// AddPunchState adds i to the "punch_state" field.
+func (dpru *DevicePunchRejectionUpdate) AddPunchState(i int) *DevicePunchRejectionUpdate {
+	dpru.mutation.AddPunchState(i)
+	return dpru
+}
+
+// SetVerifyMode sets the "verify_mode" field.
+func (dpru *DevicePunchRejectionUpdate) SetVerifyMode(i int) *DevicePunchRejectionUpdate {
+	dpru.mutation.ResetVerifyMode()
+	dpru.mutation.SetVerifyMode(i)
+	return dpru
+}
+
+// SetNillableVerifyMode sets the "verify_mode" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableVerifyMode(i *int) *DevicePunchRejectionUpdate {
+	if i != nil {
+		dpru.SetVerifyMode(*i)
+	}
+	return dpru
+}
+
+// AddVerifyMode adds i to the "verify_mode" field.
+func (dpru *DevicePunchRejectionUpdate) AddVerifyMode(i int) *DevicePunchRejectionUpdate {
+	dpru.mutation.AddVerifyMode(i)
+	return dpru
+}
+
+// SetReason sets the "reason" field.
+func (dpru *DevicePunchRejectionUpdate) SetReason(s string) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetReason(s)
+	return dpru
+}
+
+// SetStatus sets the "status" field.
+func (dpru *DevicePunchRejectionUpdate) SetStatus(d devicepunchrejection.Status) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetStatus(d)
+	return dpru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableStatus(d *devicepunchrejection.Status) *DevicePunchRejectionUpdate {
+	if d != nil {
+		dpru.SetStatus(*d)
+	}
+	return dpru
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (dpru *DevicePunchRejectionUpdate) SetResolvedBy(u uint64) *DevicePunchRejectionUpdate {
+	dpru.mutation.ResetResolvedBy()
+	dpru.mutation.SetResolvedBy(u)
+	return dpru
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableResolvedBy(u *uint64) *DevicePunchRejectionUpdate {
+	if u != nil {
+		dpru.SetResolvedBy(*u)
+	}
+	return dpru
+}
+
+// AddResolvedBy adds u to the "resolved_by" field.
+func (dpru *DevicePunchRejectionUpdate) AddResolvedBy(u int64) *DevicePunchRejectionUpdate {
+	dpru.mutation.AddResolvedBy(u)
+	return dpru
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (dpru *DevicePunchRejectionUpdate) ClearResolvedBy() *DevicePunchRejectionUpdate {
+	dpru.mutation.ClearResolvedBy()
+	return dpru
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dpru *DevicePunchRejectionUpdate) SetResolvedAt(t time.Time) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetResolvedAt(t)
+	return dpru
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableResolvedAt(t *time.Time) *DevicePunchRejectionUpdate {
+	if t != nil {
+		dpru.SetResolvedAt(*t)
+	}
+	return dpru
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dpru *DevicePunchRejectionUpdate) ClearResolvedAt() *DevicePunchRejectionUpdate {
+	dpru.mutation.ClearResolvedAt()
+	return dpru
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (dpru *DevicePunchRejectionUpdate) SetResolutionNotes(s string) *DevicePunchRejectionUpdate {
+	dpru.mutation.SetResolutionNotes(s)
+	return dpru
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (dpru *DevicePunchRejectionUpdate) SetNillableResolutionNotes(s *string) *DevicePunchRejectionUpdate {
+	if s != nil {
+		dpru.SetResolutionNotes(*s)
+	}
+	return dpru
+}
+
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (dpru *DevicePunchRejectionUpdate) ClearResolutionNotes() *DevicePunchRejectionUpdate {
+	dpru.mutation.ClearResolutionNotes()
+	return dpru
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (dpru *DevicePunchRejectionUpdate) SetDevice(d *Device) *DevicePunchRejectionUpdate {
+	return dpru.SetDeviceID(d.ID)
+}
+
+// Mutation returns the DevicePunchRejectionMutation object of the builder.
+func (dpru *DevicePunchRejectionUpdate) Mutation() *DevicePunchRejectionMutation {
+	return dpru.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (dpru *DevicePunchRejectionUpdate) ClearDevice() *DevicePunchRejectionUpdate {
+	dpru.mutation.ClearDevice()
+	return dpru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dpru *DevicePunchRejectionUpdate) Save(ctx context.Context) (int, error) {
+	dpru.defaults()
+	return withHooks(ctx, dpru.sqlSave, dpru.mutation, dpru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dpru *DevicePunchRejectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := dpru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dpru *DevicePunchRejectionUpdate) Exec(ctx context.Context) error {
+	_, err := dpru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpru *DevicePunchRejectionUpdate) ExecX(ctx context.Context) {
+	if err := dpru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dpru *DevicePunchRejectionUpdate) defaults() {
+	if _, ok := dpru.mutation.ModifiedAt(); !ok {
+		v := devicepunchrejection.UpdateDefaultModifiedAt()
+		dpru.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dpru *DevicePunchRejectionUpdate) check() error {
+	if v, ok := dpru.mutation.DevicePin(); ok {
+		if err := devicepunchrejection.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.device_pin": %w`, err)}
+		}
+	}
+	if v, ok := dpru.mutation.Reason(); ok {
+		if err := devicepunchrejection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.reason": %w`, err)}
+		}
+	}
+	if v, ok := dpru.mutation.Status(); ok {
+		if err := devicepunchrejection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.status": %w`, err)}
+		}
+	}
+	if _, ok := dpru.mutation.DeviceID(); dpru.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DevicePunchRejection.device"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dpru *DevicePunchRejectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DevicePunchRejectionUpdate {
+	dpru.modifiers = append(dpru.modifiers, modifiers...)
+	return dpru
+}
+
+func (dpru *DevicePunchRejectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dpru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(devicepunchrejection.Table, devicepunchrejection.Columns, sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64))
+	if ps := dpru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dpru.mutation.ModifiedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := dpru.mutation.DeletedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dpru.mutation.DeletedAtCleared() {
+		_spec.ClearField(devicepunchrejection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dpru.mutation.DevicePin(); ok {
+		_spec.SetField(devicepunchrejection.FieldDevicePin, field.TypeString, value)
+	}
+	if value, ok := dpru.mutation.PunchTime(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchTime, field.TypeTime, value)
+	}
+	if value, ok := dpru.mutation.PunchState(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchState, field.TypeInt, value)
+	}
+	if value, ok := dpru.mutation.AddedPunchState(); ok {
+		_spec.AddField(devicepunchrejection.FieldPunchState, field.TypeInt, value)
+	}
+	if value, ok := dpru.mutation.VerifyMode(); ok {
+		_spec.SetField(devicepunchrejection.FieldVerifyMode, field.TypeInt, value)
+	}
+	if value, ok := dpru.mutation.AddedVerifyMode(); ok {
+		_spec.AddField(devicepunchrejection.FieldVerifyMode, field.TypeInt, value)
+	}
+	if value, ok := dpru.mutation.Reason(); ok {
+		_spec.SetField(devicepunchrejection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := dpru.mutation.Status(); ok {
+		_spec.SetField(devicepunchrejection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dpru.mutation.ResolvedBy(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if value, ok := dpru.mutation.AddedResolvedBy(); ok {
+		_spec.AddField(devicepunchrejection.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if dpru.mutation.ResolvedByCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolvedBy, field.TypeUint64)
+	}
+	if value, ok := dpru.mutation.ResolvedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dpru.mutation.ResolvedAtCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := dpru.mutation.ResolutionNotes(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolutionNotes, field.TypeString, value)
+	}
+	if dpru.mutation.ResolutionNotesCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolutionNotes, field.TypeString)
+	}
+	if dpru.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   devicepunchrejection.DeviceTable,
+			Columns: []string{devicepunchrejection.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dpru.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   devicepunchrejection.DeviceTable,
+			Columns: []string{devicepunchrejection.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dpru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dpru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicepunchrejection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dpru.mutation.done = true
+	return n, nil
+}
+
+// DevicePunchRejectionUpdateOne is the builder for updating a single DevicePunchRejection entity.
+type DevicePunchRejectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DevicePunchRejectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetModifiedAt(t time.Time) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetModifiedAt(t)
+	return dpruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetDeletedAt(t time.Time) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetDeletedAt(t)
+	return dpruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableDeletedAt(t *time.Time) *DevicePunchRejectionUpdateOne {
+	if t != nil {
+		dpruo.SetDeletedAt(*t)
+	}
+	return dpruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dpruo *DevicePunchRejectionUpdateOne) ClearDeletedAt() *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ClearDeletedAt()
+	return dpruo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetDeviceID(u uint64) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetDeviceID(u)
+	return dpruo
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetDevicePin(s string) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetDevicePin(s)
+	return dpruo
+}
+
+// SetPunchTime sets the "punch_time" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetPunchTime(t time.Time) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetPunchTime(t)
+	return dpruo
+}
+
+// SetPunchState sets the "punch_state" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetPunchState(i int) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ResetPunchState()
+	dpruo.mutation.SetPunchState(i)
+	return dpruo
+}
+
+// SetNillablePunchState sets the "punch_state" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillablePunchState(i *int) *DevicePunchRejectionUpdateOne {
+	if i != nil {
+		dpruo.SetPunchState(*i)
+	}
+	return dpruo
+}
+
+// AddPunchState adds i to the "punch_state" field.
+func (dpruo *DevicePunchRejectionUpdateOne) AddPunchState(i int) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.AddPunchState(i)
+	return dpruo
+}
+
+// SetVerifyMode sets the "verify_mode" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetVerifyMode(i int) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ResetVerifyMode()
+	dpruo.mutation.SetVerifyMode(i)
+	return dpruo
+}
+
+// SetNillableVerifyMode sets the "verify_mode" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableVerifyMode(i *int) *DevicePunchRejectionUpdateOne {
+	if i != nil {
+		dpruo.SetVerifyMode(*i)
+	}
+	return dpruo
+}
+
+// AddVerifyMode adds i to the "verify_mode" field.
+func (dpruo *DevicePunchRejectionUpdateOne) AddVerifyMode(i int) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.AddVerifyMode(i)
+	return dpruo
+}
+
+// SetReason sets the "reason" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetReason(s string) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetReason(s)
+	return dpruo
+}
+
+// SetStatus sets the "status" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetStatus(d devicepunchrejection.Status) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetStatus(d)
+	return dpruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableStatus(d *devicepunchrejection.Status) *DevicePunchRejectionUpdateOne {
+	if d != nil {
+		dpruo.SetStatus(*d)
+	}
+	return dpruo
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetResolvedBy(u uint64) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ResetResolvedBy()
+	dpruo.mutation.SetResolvedBy(u)
+	return dpruo
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableResolvedBy(u *uint64) *DevicePunchRejectionUpdateOne {
+	if u != nil {
+		dpruo.SetResolvedBy(*u)
+	}
+	return dpruo
+}
+
+// AddResolvedBy adds u to the "resolved_by" field.
+func (dpruo *DevicePunchRejectionUpdateOne) AddResolvedBy(u int64) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.AddResolvedBy(u)
+	return dpruo
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (dpruo *DevicePunchRejectionUpdateOne) ClearResolvedBy() *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ClearResolvedBy()
+	return dpruo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetResolvedAt(t time.Time) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetResolvedAt(t)
+	return dpruo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableResolvedAt(t *time.Time) *DevicePunchRejectionUpdateOne {
+	if t != nil {
+		dpruo.SetResolvedAt(*t)
+	}
+	return dpruo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dpruo *DevicePunchRejectionUpdateOne) ClearResolvedAt() *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ClearResolvedAt()
+	return dpruo
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (dpruo *DevicePunchRejectionUpdateOne) SetResolutionNotes(s string) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.SetResolutionNotes(s)
+	return dpruo
+}
+
+// SetNillableResolutionNotes sets the "resolution_notes" field if the given value is not nil.
+func (dpruo *DevicePunchRejectionUpdateOne) SetNillableResolutionNotes(s *string) *DevicePunchRejectionUpdateOne {
+	if s != nil {
+		dpruo.SetResolutionNotes(*s)
+	}
+	return dpruo
+}
+
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (dpruo *DevicePunchRejectionUpdateOne) ClearResolutionNotes() *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ClearResolutionNotes()
+	return dpruo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (dpruo *DevicePunchRejectionUpdateOne) SetDevice(d *Device) *DevicePunchRejectionUpdateOne {
+	return dpruo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the DevicePunchRejectionMutation object of the builder.
+func (dpruo *DevicePunchRejectionUpdateOne) Mutation() *DevicePunchRejectionMutation {
+	return dpruo.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (dpruo *DevicePunchRejectionUpdateOne) ClearDevice() *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.ClearDevice()
+	return dpruo
+}
+
+// Where appends a list predicates to the DevicePunchRejectionUpdate builder.
+func (dpruo *DevicePunchRejectionUpdateOne) Where(ps ...predicate.DevicePunchRejection) *DevicePunchRejectionUpdateOne {
+	dpruo.mutation.Where(ps...)
+	return dpruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dpruo *DevicePunchRejectionUpdateOne) Select(field string, fields ...string) *DevicePunchRejectionUpdateOne {
+	dpruo.fields = append([]string{field}, fields...)
+	return dpruo
+}
+
+// Save executes the query and returns the updated DevicePunchRejection entity.
+func (dpruo *DevicePunchRejectionUpdateOne) Save(ctx context.Context) (*DevicePunchRejection, error) {
+	dpruo.defaults()
+	return withHooks(ctx, dpruo.sqlSave, dpruo.mutation, dpruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dpruo *DevicePunchRejectionUpdateOne) SaveX(ctx context.Context) *DevicePunchRejection {
+	node, err := dpruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dpruo *DevicePunchRejectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := dpruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dpruo *DevicePunchRejectionUpdateOne) ExecX(ctx context.Context) {
+	if err := dpruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dpruo *DevicePunchRejectionUpdateOne) defaults() {
+	if _, ok := dpruo.mutation.ModifiedAt(); !ok {
+		v := devicepunchrejection.UpdateDefaultModifiedAt()
+		dpruo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dpruo *DevicePunchRejectionUpdateOne) check() error {
+	if v, ok := dpruo.mutation.DevicePin(); ok {
+		if err := devicepunchrejection.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.device_pin": %w`, err)}
+		}
+	}
+	if v, ok := dpruo.mutation.Reason(); ok {
+		if err := devicepunchrejection.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.reason": %w`, err)}
+		}
+	}
+	if v, ok := dpruo.mutation.Status(); ok {
+		if err := devicepunchrejection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DevicePunchRejection.status": %w`, err)}
+		}
+	}
+	if _, ok := dpruo.mutation.DeviceID(); dpruo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DevicePunchRejection.device"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dpruo *DevicePunchRejectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DevicePunchRejectionUpdateOne {
+	dpruo.modifiers = append(dpruo.modifiers, modifiers...)
+	return dpruo
+}
+
+func (dpruo *DevicePunchRejectionUpdateOne) sqlSave(ctx context.Context) (_node *DevicePunchRejection, err error) {
+	if err := dpruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(devicepunchrejection.Table, devicepunchrejection.Columns, sqlgraph.NewFieldSpec(devicepunchrejection.FieldID, field.TypeUint64))
+	id, ok := dpruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DevicePunchRejection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dpruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, devicepunchrejection.FieldID)
+		for _, f := range fields {
+			if !devicepunchrejection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != devicepunchrejection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dpruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dpruo.mutation.ModifiedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := dpruo.mutation.DeletedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dpruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(devicepunchrejection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dpruo.mutation.DevicePin(); ok {
+		_spec.SetField(devicepunchrejection.FieldDevicePin, field.TypeString, value)
+	}
+	if value, ok := dpruo.mutation.PunchTime(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchTime, field.TypeTime, value)
+	}
+	if value, ok := dpruo.mutation.PunchState(); ok {
+		_spec.SetField(devicepunchrejection.FieldPunchState, field.TypeInt, value)
+	}
+	if value, ok := dpruo.mutation.AddedPunchState(); ok {
+		_spec.AddField(devicepunchrejection.FieldPunchState, field.TypeInt, value)
+	}
+	if value, ok := dpruo.mutation.VerifyMode(); ok {
+		_spec.SetField(devicepunchrejection.FieldVerifyMode, field.TypeInt, value)
+	}
+	if value, ok := dpruo.mutation.AddedVerifyMode(); ok {
+		_spec.AddField(devicepunchrejection.FieldVerifyMode, field.TypeInt, value)
+	}
+	if value, ok := dpruo.mutation.Reason(); ok {
+		_spec.SetField(devicepunchrejection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := dpruo.mutation.Status(); ok {
+		_spec.SetField(devicepunchrejection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dpruo.mutation.ResolvedBy(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if value, ok := dpruo.mutation.AddedResolvedBy(); ok {
+		_spec.AddField(devicepunchrejection.FieldResolvedBy, field.TypeUint64, value)
+	}
+	if dpruo.mutation.ResolvedByCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolvedBy, field.TypeUint64)
+	}
+	if value, ok := dpruo.mutation.ResolvedAt(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dpruo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := dpruo.mutation.ResolutionNotes(); ok {
+		_spec.SetField(devicepunchrejection.FieldResolutionNotes, field.TypeString, value)
+	}
+	if dpruo.mutation.ResolutionNotesCleared() {
+		_spec.ClearField(devicepunchrejection.FieldResolutionNotes, field.TypeString)
+	}
+	if dpruo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   devicepunchrejection.DeviceTable,
+			Columns: []string{devicepunchrejection.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dpruo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   devicepunchrejection.DeviceTable,
+			Columns: []string{devicepunchrejection.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dpruo.modifiers...)
+	_node = &DevicePunchRejection{config: dpruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dpruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicepunchrejection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dpruo.mutation.done = true
+	return _node, nil
+}
@@ -5,11 +5,13 @@ package ent
 import (
 	"fmt"
 	"mceasy/ent/employee"
+	"mceasy/ent/officelocation"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 // Employee is the model entity for the Employee schema.
@@ -38,9 +40,21 @@ type Employee struct {
 	// Employee hire date
 	HireDate time.Time `json:"hire_date,omitempty"`
 	// Base salary in IDR
-	BaseSalary float64 `json:"base_salary,omitempty"`
+	BaseSalary decimal.Decimal `json:"base_salary,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Office whose geofence applies to check-in and check-out, no geofence when empty
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty"`
+	// IANA timezone of the employee, overrides the office timezone when set
+	Timezone string `json:"timezone,omitempty"`
+	// User PIN enrolled on the fingerprint terminals, maps device punch logs to the employee
+	DevicePin *string `json:"device_pin,omitempty"`
+	// PTKP status for PPh 21, single (TK) or married (K) with the number of dependents
+	PtkpStatus employee.PtkpStatus `json:"ptkp_status,omitempty"`
+	// Tax ID (NPWP or NIK), PPh 21 is withheld 20% higher without one
+	Npwp string `json:"npwp,omitempty"`
+	// BPJS JKK work accident risk class of the workplace, 1 (very low) to 5 (very high)
+	JkkRiskClass int `json:"jkk_risk_class,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EmployeeQuery when eager-loading is set.
 	Edges        EmployeeEdges `json:"edges"`
@@ -53,9 +67,27 @@ type EmployeeEdges struct {
 	Attendances []*Attendance `json:"attendances,omitempty"`
 	// SalaryCalculations holds the value of the salary_calculations edge.
 	SalaryCalculations []*SalaryCalculation `json:"salary_calculations,omitempty"`
+	// ShiftAssignments holds the value of the shift_assignments edge.
+	ShiftAssignments []*ShiftAssignment `json:"shift_assignments,omitempty"`
+	// LeaveRequests holds the value of the leave_requests edge.
+	LeaveRequests []*LeaveRequest `json:"leave_requests,omitempty"`
+	// LeaveBalances holds the value of the leave_balances edge.
+	LeaveBalances []*LeaveBalance `json:"leave_balances,omitempty"`
+	// AttendanceCorrections holds the value of the attendance_corrections edge.
+	AttendanceCorrections []*AttendanceCorrection `json:"attendance_corrections,omitempty"`
+	// AttendanceAnomalies holds the value of the attendance_anomalies edge.
+	AttendanceAnomalies []*AttendanceAnomaly `json:"attendance_anomalies,omitempty"`
+	// Overtimes holds the value of the overtimes edge.
+	Overtimes []*Overtime `json:"overtimes,omitempty"`
+	// CalendarFeeds holds the value of the calendar_feeds edge.
+	CalendarFeeds []*CalendarFeed `json:"calendar_feeds,omitempty"`
+	// SalaryComponentAssignments holds the value of the salary_component_assignments edge.
+	SalaryComponentAssignments []*SalaryComponentAssignment `json:"salary_component_assignments,omitempty"`
+	// OfficeLocation holds the value of the office_location edge.
+	OfficeLocation *OfficeLocation `json:"office_location,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [11]bool
 }
 
 // AttendancesOrErr returns the Attendances value or an error if the edge
@@ -76,18 +108,103 @@ func (e EmployeeEdges) SalaryCalculationsOrErr() ([]*SalaryCalculation, error) {
 	return nil, &NotLoadedError{edge: "salary_calculations"}
 }
 
+// ShiftAssignmentsOrErr returns the ShiftAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) ShiftAssignmentsOrErr() ([]*ShiftAssignment, error) {
+	if e.loadedTypes[2] {
+		return e.ShiftAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "shift_assignments"}
+}
+
+// LeaveRequestsOrErr returns the LeaveRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) LeaveRequestsOrErr() ([]*LeaveRequest, error) {
+	if e.loadedTypes[3] {
+		return e.LeaveRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_requests"}
+}
+
+// LeaveBalancesOrErr returns the LeaveBalances value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) LeaveBalancesOrErr() ([]*LeaveBalance, error) {
+	if e.loadedTypes[4] {
+		return e.LeaveBalances, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_balances"}
+}
+
+// AttendanceCorrectionsOrErr returns the AttendanceCorrections value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AttendanceCorrectionsOrErr() ([]*AttendanceCorrection, error) {
+	if e.loadedTypes[5] {
+		return e.AttendanceCorrections, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_corrections"}
+}
+
+// AttendanceAnomaliesOrErr returns the AttendanceAnomalies value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AttendanceAnomaliesOrErr() ([]*AttendanceAnomaly, error) {
+	if e.loadedTypes[6] {
+		return e.AttendanceAnomalies, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_anomalies"}
+}
+
+// OvertimesOrErr returns the Overtimes value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) OvertimesOrErr() ([]*Overtime, error) {
+	if e.loadedTypes[7] {
+		return e.Overtimes, nil
+	}
+	return nil, &NotLoadedError{edge: "overtimes"}
+}
+
+// CalendarFeedsOrErr returns the CalendarFeeds value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) CalendarFeedsOrErr() ([]*CalendarFeed, error) {
+	if e.loadedTypes[8] {
+		return e.CalendarFeeds, nil
+	}
+	return nil, &NotLoadedError{edge: "calendar_feeds"}
+}
+
+// SalaryComponentAssignmentsOrErr returns the SalaryComponentAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) SalaryComponentAssignmentsOrErr() ([]*SalaryComponentAssignment, error) {
+	if e.loadedTypes[9] {
+		return e.SalaryComponentAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_component_assignments"}
+}
+
+// OfficeLocationOrErr returns the OfficeLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmployeeEdges) OfficeLocationOrErr() (*OfficeLocation, error) {
+	if e.loadedTypes[10] {
+		if e.OfficeLocation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: officelocation.Label}
+		}
+		return e.OfficeLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "office_location"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Employee) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case employee.FieldBaseSalary:
+			values[i] = new(decimal.Decimal)
 		case employee.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case employee.FieldBaseSalary:
-			values[i] = new(sql.NullFloat64)
-		case employee.FieldID:
+		case employee.FieldID, employee.FieldOfficeLocationID, employee.FieldJkkRiskClass:
 			values[i] = new(sql.NullInt64)
-		case employee.FieldEmployeeID, employee.FieldFullName, employee.FieldEmail, employee.FieldPhone, employee.FieldPosition, employee.FieldDepartment:
+		case employee.FieldEmployeeID, employee.FieldFullName, employee.FieldEmail, employee.FieldPhone, employee.FieldPosition, employee.FieldDepartment, employee.FieldTimezone, employee.FieldDevicePin, employee.FieldPtkpStatus, employee.FieldNpwp:
 			values[i] = new(sql.NullString)
 		case employee.FieldCreatedAt, employee.FieldModifiedAt, employee.FieldDeletedAt, employee.FieldHireDate:
 			values[i] = new(sql.NullTime)
@@ -173,10 +290,10 @@ func (e *Employee) assignValues(columns []string, values []any) error {
 				e.HireDate = value.Time
 			}
 		case employee.FieldBaseSalary:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field base_salary", values[i])
-			} else if value.Valid {
-				e.BaseSalary = value.Float64
+			} else if value != nil {
+				e.BaseSalary = *value
 			}
 		case employee.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
@@ -184,6 +301,44 @@ func (e *Employee) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.IsActive = value.Bool
 			}
+		case employee.FieldOfficeLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field office_location_id", values[i])
+			} else if value.Valid {
+				e.OfficeLocationID = new(uint64)
+				*e.OfficeLocationID = uint64(value.Int64)
+			}
+		case employee.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				e.Timezone = value.String
+			}
+		case employee.FieldDevicePin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_pin", values[i])
+			} else if value.Valid {
+				e.DevicePin = new(string)
+				*e.DevicePin = value.String
+			}
+		case employee.FieldPtkpStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ptkp_status", values[i])
+			} else if value.Valid {
+				e.PtkpStatus = employee.PtkpStatus(value.String)
+			}
+		case employee.FieldNpwp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field npwp", values[i])
+			} else if value.Valid {
+				e.Npwp = value.String
+			}
+		case employee.FieldJkkRiskClass:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jkk_risk_class", values[i])
+			} else if value.Valid {
+				e.JkkRiskClass = int(value.Int64)
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -207,6 +362,51 @@ func (e *Employee) QuerySalaryCalculations() *SalaryCalculationQuery {
 	return NewEmployeeClient(e.config).QuerySalaryCalculations(e)
 }
 
+// QueryShiftAssignments queries the "shift_assignments" edge of the Employee entity.
+func (e *Employee) QueryShiftAssignments() *ShiftAssignmentQuery {
+	return NewEmployeeClient(e.config).QueryShiftAssignments(e)
+}
+
+// QueryLeaveRequests queries the "leave_requests" edge of the Employee entity.
+func (e *Employee) QueryLeaveRequests() *LeaveRequestQuery {
+	return NewEmployeeClient(e.config).QueryLeaveRequests(e)
+}
+
+// QueryLeaveBalances queries the "leave_balances" edge of the Employee entity.
+func (e *Employee) QueryLeaveBalances() *LeaveBalanceQuery {
+	return NewEmployeeClient(e.config).QueryLeaveBalances(e)
+}
+
+// QueryAttendanceCorrections queries the "attendance_corrections" edge of the Employee entity.
+func (e *Employee) QueryAttendanceCorrections() *AttendanceCorrectionQuery {
+	return NewEmployeeClient(e.config).QueryAttendanceCorrections(e)
+}
+
+// QueryAttendanceAnomalies queries the "attendance_anomalies" edge of the Employee entity.
+func (e *Employee) QueryAttendanceAnomalies() *AttendanceAnomalyQuery {
+	return NewEmployeeClient(e.config).QueryAttendanceAnomalies(e)
+}
+
+// QueryOvertimes queries the "overtimes" edge of the Employee entity.
+func (e *Employee) QueryOvertimes() *OvertimeQuery {
+	return NewEmployeeClient(e.config).QueryOvertimes(e)
+}
+
+// QueryCalendarFeeds queries the "calendar_feeds" edge of the Employee entity.
+func (e *Employee) QueryCalendarFeeds() *CalendarFeedQuery {
+	return NewEmployeeClient(e.config).QueryCalendarFeeds(e)
+}
+
+// QuerySalaryComponentAssignments queries the "salary_component_assignments" edge of the Employee entity.
+func (e *Employee) QuerySalaryComponentAssignments() *SalaryComponentAssignmentQuery {
+	return NewEmployeeClient(e.config).QuerySalaryComponentAssignments(e)
+}
+
+// QueryOfficeLocation queries the "office_location" edge of the Employee entity.
+func (e *Employee) QueryOfficeLocation() *OfficeLocationQuery {
+	return NewEmployeeClient(e.config).QueryOfficeLocation(e)
+}
+
 // Update returns a builder for updating this Employee.
 // Note that you need to call Employee.Unwrap() before calling this method if this Employee
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -265,6 +465,28 @@ func (e *Employee) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", e.IsActive))
+	builder.WriteString(", ")
+	if v := e.OfficeLocationID; v != nil {
+		builder.WriteString("office_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(e.Timezone)
+	builder.WriteString(", ")
+	if v := e.DevicePin; v != nil {
+		builder.WriteString("device_pin=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ptkp_status=")
+	builder.WriteString(fmt.Sprintf("%v", e.PtkpStatus))
+	builder.WriteString(", ")
+	builder.WriteString("npwp=")
+	builder.WriteString(e.Npwp)
+	builder.WriteString(", ")
+	builder.WriteString("jkk_risk_class=")
+	builder.WriteString(fmt.Sprintf("%v", e.JkkRiskClass))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,10 +3,12 @@
 package employee
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -38,10 +40,40 @@ const (
 	FieldBaseSalary = "base_salary"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldOfficeLocationID holds the string denoting the office_location_id field in the database.
+	FieldOfficeLocationID = "office_location_id"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldDevicePin holds the string denoting the device_pin field in the database.
+	FieldDevicePin = "device_pin"
+	// FieldPtkpStatus holds the string denoting the ptkp_status field in the database.
+	FieldPtkpStatus = "ptkp_status"
+	// FieldNpwp holds the string denoting the npwp field in the database.
+	FieldNpwp = "npwp"
+	// FieldJkkRiskClass holds the string denoting the jkk_risk_class field in the database.
+	FieldJkkRiskClass = "jkk_risk_class"
 	// EdgeAttendances holds the string denoting the attendances edge name in mutations.
 	EdgeAttendances = "attendances"
 	// EdgeSalaryCalculations holds the string denoting the salary_calculations edge name in mutations.
 	EdgeSalaryCalculations = "salary_calculations"
+	// EdgeShiftAssignments holds the string denoting the shift_assignments edge name in mutations.
+	EdgeShiftAssignments = "shift_assignments"
+	// EdgeLeaveRequests holds the string denoting the leave_requests edge name in mutations.
+	EdgeLeaveRequests = "leave_requests"
+	// EdgeLeaveBalances holds the string denoting the leave_balances edge name in mutations.
+	EdgeLeaveBalances = "leave_balances"
+	// EdgeAttendanceCorrections holds the string denoting the attendance_corrections edge name in mutations.
+	EdgeAttendanceCorrections = "attendance_corrections"
+	// EdgeAttendanceAnomalies holds the string denoting the attendance_anomalies edge name in mutations.
+	EdgeAttendanceAnomalies = "attendance_anomalies"
+	// EdgeOvertimes holds the string denoting the overtimes edge name in mutations.
+	EdgeOvertimes = "overtimes"
+	// EdgeCalendarFeeds holds the string denoting the calendar_feeds edge name in mutations.
+	EdgeCalendarFeeds = "calendar_feeds"
+	// EdgeSalaryComponentAssignments holds the string denoting the salary_component_assignments edge name in mutations.
+	EdgeSalaryComponentAssignments = "salary_component_assignments"
+	// EdgeOfficeLocation holds the string denoting the office_location edge name in mutations.
+	EdgeOfficeLocation = "office_location"
 	// Table holds the table name of the employee in the database.
 	Table = "employees"
 	// AttendancesTable is the table that holds the attendances relation/edge.
@@ -58,6 +90,69 @@ const (
 	SalaryCalculationsInverseTable = "salary_calculations"
 	// SalaryCalculationsColumn is the table column denoting the salary_calculations relation/edge.
 	SalaryCalculationsColumn = "employee_id"
+	// ShiftAssignmentsTable is the table that holds the shift_assignments relation/edge.
+	ShiftAssignmentsTable = "shift_assignments"
+	// ShiftAssignmentsInverseTable is the table name for the ShiftAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "shiftassignment" package.
+	ShiftAssignmentsInverseTable = "shift_assignments"
+	// ShiftAssignmentsColumn is the table column denoting the shift_assignments relation/edge.
+	ShiftAssignmentsColumn = "employee_id"
+	// LeaveRequestsTable is the table that holds the leave_requests relation/edge.
+	LeaveRequestsTable = "leave_requests"
+	// LeaveRequestsInverseTable is the table name for the LeaveRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "leaverequest" package.
+	LeaveRequestsInverseTable = "leave_requests"
+	// LeaveRequestsColumn is the table column denoting the leave_requests relation/edge.
+	LeaveRequestsColumn = "employee_id"
+	// LeaveBalancesTable is the table that holds the leave_balances relation/edge.
+	LeaveBalancesTable = "leave_balances"
+	// LeaveBalancesInverseTable is the table name for the LeaveBalance entity.
+	// It exists in this package in order to avoid circular dependency with the "leavebalance" package.
+	LeaveBalancesInverseTable = "leave_balances"
+	// LeaveBalancesColumn is the table column denoting the leave_balances relation/edge.
+	LeaveBalancesColumn = "employee_id"
+	// AttendanceCorrectionsTable is the table that holds the attendance_corrections relation/edge.
+	AttendanceCorrectionsTable = "attendance_corrections"
+	// AttendanceCorrectionsInverseTable is the table name for the AttendanceCorrection entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancecorrection" package.
+	AttendanceCorrectionsInverseTable = "attendance_corrections"
+	// AttendanceCorrectionsColumn is the table column denoting the attendance_corrections relation/edge.
+	AttendanceCorrectionsColumn = "employee_id"
+	// AttendanceAnomaliesTable is the table that holds the attendance_anomalies relation/edge.
+	AttendanceAnomaliesTable = "attendance_anomalies"
+	// AttendanceAnomaliesInverseTable is the table name for the AttendanceAnomaly entity.
+	// It exists in this package in order to avoid circular dependency with the "attendanceanomaly" package.
+	AttendanceAnomaliesInverseTable = "attendance_anomalies"
+	// AttendanceAnomaliesColumn is the table column denoting the attendance_anomalies relation/edge.
+	AttendanceAnomaliesColumn = "employee_id"
+	// OvertimesTable is the table that holds the overtimes relation/edge.
+	OvertimesTable = "overtimes"
+	// OvertimesInverseTable is the table name for the Overtime entity.
+	// It exists in this package in order to avoid circular dependency with the "overtime" package.
+	OvertimesInverseTable = "overtimes"
+	// OvertimesColumn is the table column denoting the overtimes relation/edge.
+	OvertimesColumn = "employee_id"
+	// CalendarFeedsTable is the table that holds the calendar_feeds relation/edge.
+	CalendarFeedsTable = "calendar_feeds"
+	// CalendarFeedsInverseTable is the table name for the CalendarFeed entity.
+	// It exists in this package in order to avoid circular dependency with the "calendarfeed" package.
+	CalendarFeedsInverseTable = "calendar_feeds"
+	// CalendarFeedsColumn is the table column denoting the calendar_feeds relation/edge.
+	CalendarFeedsColumn = "employee_id"
+	// SalaryComponentAssignmentsTable is the table that holds the salary_component_assignments relation/edge.
+	SalaryComponentAssignmentsTable = "salary_component_assignments"
+	// SalaryComponentAssignmentsInverseTable is the table name for the SalaryComponentAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "salarycomponentassignment" package.
+	SalaryComponentAssignmentsInverseTable = "salary_component_assignments"
+	// SalaryComponentAssignmentsColumn is the table column denoting the salary_component_assignments relation/edge.
+	SalaryComponentAssignmentsColumn = "employee_id"
+	// OfficeLocationTable is the table that holds the office_location relation/edge.
+	OfficeLocationTable = "employees"
+	// OfficeLocationInverseTable is the table name for the OfficeLocation entity.
+	// It exists in this package in order to avoid circular dependency with the "officelocation" package.
+	OfficeLocationInverseTable = "office_locations"
+	// OfficeLocationColumn is the table column denoting the office_location relation/edge.
+	OfficeLocationColumn = "office_location_id"
 )
 
 // Columns holds all SQL columns for employee fields.
@@ -75,6 +170,12 @@ var Columns = []string{
 	FieldHireDate,
 	FieldBaseSalary,
 	FieldIsActive,
+	FieldOfficeLocationID,
+	FieldTimezone,
+	FieldDevicePin,
+	FieldPtkpStatus,
+	FieldNpwp,
+	FieldJkkRiskClass,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -107,11 +208,53 @@ var (
 	// DepartmentValidator is a validator for the "department" field. It is called by the builders before save.
 	DepartmentValidator func(string) error
 	// DefaultBaseSalary holds the default value on creation for the "base_salary" field.
-	DefaultBaseSalary float64
+	DefaultBaseSalary decimal.Decimal
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// DevicePinValidator is a validator for the "device_pin" field. It is called by the builders before save.
+	DevicePinValidator func(string) error
+	// NpwpValidator is a validator for the "npwp" field. It is called by the builders before save.
+	NpwpValidator func(string) error
+	// DefaultJkkRiskClass holds the default value on creation for the "jkk_risk_class" field.
+	DefaultJkkRiskClass int
+	// JkkRiskClassValidator is a validator for the "jkk_risk_class" field. It is called by the builders before save.
+	JkkRiskClassValidator func(int) error
 )
 
+// PtkpStatus defines the type for the "ptkp_status" enum field.
+type PtkpStatus string
+
+// PtkpStatusTK0 is the default value of the PtkpStatus enum.
+const DefaultPtkpStatus = PtkpStatusTK0
+
+// PtkpStatus values.
+const (
+	PtkpStatusTK0 PtkpStatus = "TK/0"
+	PtkpStatusTK1 PtkpStatus = "TK/1"
+	PtkpStatusTK2 PtkpStatus = "TK/2"
+	PtkpStatusTK3 PtkpStatus = "TK/3"
+	PtkpStatusK0  PtkpStatus = "K/0"
+	PtkpStatusK1  PtkpStatus = "K/1"
+	PtkpStatusK2  PtkpStatus = "K/2"
+	PtkpStatusK3  PtkpStatus = "K/3"
+)
+
+func (ps PtkpStatus) String() string {
+	return string(ps)
+}
+
+// PtkpStatusValidator is a validator for the "ptkp_status" field enum values. It is called by the builders before save.
+func PtkpStatusValidator(ps PtkpStatus) error {
+	switch ps {
+	case PtkpStatusTK0, PtkpStatusTK1, PtkpStatusTK2, PtkpStatusTK3, PtkpStatusK0, PtkpStatusK1, PtkpStatusK2, PtkpStatusK3:
+		return nil
+	default:
+		return fmt.Errorf("employee: invalid enum value for ptkp_status field: %q", ps)
+	}
+}
+
 // OrderOption defines the ordering options for the Employee queries.
 type OrderOption func(*sql.Selector)
 
@@ -180,6 +323,36 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByOfficeLocationID orders the results by the office_location_id field.
+func ByOfficeLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfficeLocationID, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByDevicePin orders the results by the device_pin field.
+func ByDevicePin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDevicePin, opts...).ToFunc()
+}
+
+// ByPtkpStatus orders the results by the ptkp_status field.
+func ByPtkpStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPtkpStatus, opts...).ToFunc()
+}
+
+// ByNpwp orders the results by the npwp field.
+func ByNpwp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNpwp, opts...).ToFunc()
+}
+
+// ByJkkRiskClass orders the results by the jkk_risk_class field.
+func ByJkkRiskClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJkkRiskClass, opts...).ToFunc()
+}
+
 // ByAttendancesCount orders the results by attendances count.
 func ByAttendancesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -207,6 +380,125 @@ func BySalaryCalculations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newSalaryCalculationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByShiftAssignmentsCount orders the results by shift_assignments count.
+func ByShiftAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShiftAssignmentsStep(), opts...)
+	}
+}
+
+// ByShiftAssignments orders the results by shift_assignments terms.
+func ByShiftAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeaveRequestsCount orders the results by leave_requests count.
+func ByLeaveRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeaveRequestsStep(), opts...)
+	}
+}
+
+// ByLeaveRequests orders the results by leave_requests terms.
+func ByLeaveRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeaveRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeaveBalancesCount orders the results by leave_balances count.
+func ByLeaveBalancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeaveBalancesStep(), opts...)
+	}
+}
+
+// ByLeaveBalances orders the results by leave_balances terms.
+func ByLeaveBalances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeaveBalancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendanceCorrectionsCount orders the results by attendance_corrections count.
+func ByAttendanceCorrectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttendanceCorrectionsStep(), opts...)
+	}
+}
+
+// ByAttendanceCorrections orders the results by attendance_corrections terms.
+func ByAttendanceCorrections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceCorrectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendanceAnomaliesCount orders the results by attendance_anomalies count.
+func ByAttendanceAnomaliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttendanceAnomaliesStep(), opts...)
+	}
+}
+
+// ByAttendanceAnomalies orders the results by attendance_anomalies terms.
+func ByAttendanceAnomalies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceAnomaliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOvertimesCount orders the results by overtimes count.
+func ByOvertimesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOvertimesStep(), opts...)
+	}
+}
+
+// ByOvertimes orders the results by overtimes terms.
+func ByOvertimes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCalendarFeedsCount orders the results by calendar_feeds count.
+func ByCalendarFeedsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCalendarFeedsStep(), opts...)
+	}
+}
+
+// ByCalendarFeeds orders the results by calendar_feeds terms.
+func ByCalendarFeeds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCalendarFeedsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySalaryComponentAssignmentsCount orders the results by salary_component_assignments count.
+func BySalaryComponentAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSalaryComponentAssignmentsStep(), opts...)
+	}
+}
+
+// BySalaryComponentAssignments orders the results by salary_component_assignments terms.
+func BySalaryComponentAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryComponentAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOfficeLocationField orders the results by office_location field.
+func ByOfficeLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOfficeLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newAttendancesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -221,3 +513,66 @@ func newSalaryCalculationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SalaryCalculationsTable, SalaryCalculationsColumn),
 	)
 }
+func newShiftAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShiftAssignmentsTable, ShiftAssignmentsColumn),
+	)
+}
+func newLeaveRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeaveRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
+	)
+}
+func newLeaveBalancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeaveBalancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeaveBalancesTable, LeaveBalancesColumn),
+	)
+}
+func newAttendanceCorrectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceCorrectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttendanceCorrectionsTable, AttendanceCorrectionsColumn),
+	)
+}
+func newAttendanceAnomaliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceAnomaliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttendanceAnomaliesTable, AttendanceAnomaliesColumn),
+	)
+}
+func newOvertimesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OvertimesTable, OvertimesColumn),
+	)
+}
+func newCalendarFeedsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CalendarFeedsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CalendarFeedsTable, CalendarFeedsColumn),
+	)
+}
+func newSalaryComponentAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryComponentAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SalaryComponentAssignmentsTable, SalaryComponentAssignmentsColumn),
+	)
+}
+func newOfficeLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OfficeLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OfficeLocationTable, OfficeLocationColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -106,7 +107,7 @@ func HireDate(v time.Time) predicate.Employee {
 }
 
 // BaseSalary applies equality check predicate on the "base_salary" field. It's identical to BaseSalaryEQ.
-func BaseSalary(v float64) predicate.Employee {
+func BaseSalary(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldBaseSalary, v))
 }
 
@@ -115,6 +116,31 @@ func IsActive(v bool) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldIsActive, v))
 }
 
+// OfficeLocationID applies equality check predicate on the "office_location_id" field. It's identical to OfficeLocationIDEQ.
+func OfficeLocationID(v uint64) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTimezone, v))
+}
+
+// DevicePin applies equality check predicate on the "device_pin" field. It's identical to DevicePinEQ.
+func DevicePin(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldDevicePin, v))
+}
+
+// Npwp applies equality check predicate on the "npwp" field. It's identical to NpwpEQ.
+func Npwp(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldNpwp, v))
+}
+
+// JkkRiskClass applies equality check predicate on the "jkk_risk_class" field. It's identical to JkkRiskClassEQ.
+func JkkRiskClass(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldJkkRiskClass, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldCreatedAt, v))
@@ -716,42 +742,42 @@ func HireDateLTE(v time.Time) predicate.Employee {
 }
 
 // BaseSalaryEQ applies the EQ predicate on the "base_salary" field.
-func BaseSalaryEQ(v float64) predicate.Employee {
+func BaseSalaryEQ(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldBaseSalary, v))
 }
 
 // BaseSalaryNEQ applies the NEQ predicate on the "base_salary" field.
-func BaseSalaryNEQ(v float64) predicate.Employee {
+func BaseSalaryNEQ(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldNEQ(FieldBaseSalary, v))
 }
 
 // BaseSalaryIn applies the In predicate on the "base_salary" field.
-func BaseSalaryIn(vs ...float64) predicate.Employee {
+func BaseSalaryIn(vs ...decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldIn(FieldBaseSalary, vs...))
 }
 
 // BaseSalaryNotIn applies the NotIn predicate on the "base_salary" field.
-func BaseSalaryNotIn(vs ...float64) predicate.Employee {
+func BaseSalaryNotIn(vs ...decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldNotIn(FieldBaseSalary, vs...))
 }
 
 // BaseSalaryGT applies the GT predicate on the "base_salary" field.
-func BaseSalaryGT(v float64) predicate.Employee {
+func BaseSalaryGT(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldGT(FieldBaseSalary, v))
 }
 
 // BaseSalaryGTE applies the GTE predicate on the "base_salary" field.
-func BaseSalaryGTE(v float64) predicate.Employee {
+func BaseSalaryGTE(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldGTE(FieldBaseSalary, v))
 }
 
 // BaseSalaryLT applies the LT predicate on the "base_salary" field.
-func BaseSalaryLT(v float64) predicate.Employee {
+func BaseSalaryLT(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldLT(FieldBaseSalary, v))
 }
 
 // BaseSalaryLTE applies the LTE predicate on the "base_salary" field.
-func BaseSalaryLTE(v float64) predicate.Employee {
+func BaseSalaryLTE(v decimal.Decimal) predicate.Employee {
 	return predicate.Employee(sql.FieldLTE(FieldBaseSalary, v))
 }
 
@@ -765,6 +791,321 @@ func IsActiveNEQ(v bool) predicate.Employee {
 	return predicate.Employee(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// OfficeLocationIDEQ applies the EQ predicate on the "office_location_id" field.
+func OfficeLocationIDEQ(v uint64) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDNEQ applies the NEQ predicate on the "office_location_id" field.
+func OfficeLocationIDNEQ(v uint64) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDIn applies the In predicate on the "office_location_id" field.
+func OfficeLocationIDIn(vs ...uint64) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDNotIn applies the NotIn predicate on the "office_location_id" field.
+func OfficeLocationIDNotIn(vs ...uint64) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDIsNil applies the IsNil predicate on the "office_location_id" field.
+func OfficeLocationIDIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldOfficeLocationID))
+}
+
+// OfficeLocationIDNotNil applies the NotNil predicate on the "office_location_id" field.
+func OfficeLocationIDNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldOfficeLocationID))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// DevicePinEQ applies the EQ predicate on the "device_pin" field.
+func DevicePinEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldDevicePin, v))
+}
+
+// DevicePinNEQ applies the NEQ predicate on the "device_pin" field.
+func DevicePinNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldDevicePin, v))
+}
+
+// DevicePinIn applies the In predicate on the "device_pin" field.
+func DevicePinIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldDevicePin, vs...))
+}
+
+// DevicePinNotIn applies the NotIn predicate on the "device_pin" field.
+func DevicePinNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldDevicePin, vs...))
+}
+
+// DevicePinGT applies the GT predicate on the "device_pin" field.
+func DevicePinGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldDevicePin, v))
+}
+
+// DevicePinGTE applies the GTE predicate on the "device_pin" field.
+func DevicePinGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldDevicePin, v))
+}
+
+// DevicePinLT applies the LT predicate on the "device_pin" field.
+func DevicePinLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldDevicePin, v))
+}
+
+// DevicePinLTE applies the LTE predicate on the "device_pin" field.
+func DevicePinLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldDevicePin, v))
+}
+
+// DevicePinContains applies the Contains predicate on the "device_pin" field.
+func DevicePinContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldDevicePin, v))
+}
+
+// DevicePinHasPrefix applies the HasPrefix predicate on the "device_pin" field.
+func DevicePinHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldDevicePin, v))
+}
+
+// DevicePinHasSuffix applies the HasSuffix predicate on the "device_pin" field.
+func DevicePinHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldDevicePin, v))
+}
+
+// DevicePinIsNil applies the IsNil predicate on the "device_pin" field.
+func DevicePinIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldDevicePin))
+}
+
+// DevicePinNotNil applies the NotNil predicate on the "device_pin" field.
+func DevicePinNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldDevicePin))
+}
+
+// DevicePinEqualFold applies the EqualFold predicate on the "device_pin" field.
+func DevicePinEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldDevicePin, v))
+}
+
+// DevicePinContainsFold applies the ContainsFold predicate on the "device_pin" field.
+func DevicePinContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldDevicePin, v))
+}
+
+// PtkpStatusEQ applies the EQ predicate on the "ptkp_status" field.
+func PtkpStatusEQ(v PtkpStatus) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldPtkpStatus, v))
+}
+
+// PtkpStatusNEQ applies the NEQ predicate on the "ptkp_status" field.
+func PtkpStatusNEQ(v PtkpStatus) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldPtkpStatus, v))
+}
+
+// PtkpStatusIn applies the In predicate on the "ptkp_status" field.
+func PtkpStatusIn(vs ...PtkpStatus) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldPtkpStatus, vs...))
+}
+
+// PtkpStatusNotIn applies the NotIn predicate on the "ptkp_status" field.
+func PtkpStatusNotIn(vs ...PtkpStatus) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldPtkpStatus, vs...))
+}
+
+// NpwpEQ applies the EQ predicate on the "npwp" field.
+func NpwpEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldNpwp, v))
+}
+
+// NpwpNEQ applies the NEQ predicate on the "npwp" field.
+func NpwpNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldNpwp, v))
+}
+
+// NpwpIn applies the In predicate on the "npwp" field.
+func NpwpIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldNpwp, vs...))
+}
+
+// NpwpNotIn applies the NotIn predicate on the "npwp" field.
+func NpwpNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldNpwp, vs...))
+}
+
+// NpwpGT applies the GT predicate on the "npwp" field.
+func NpwpGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldNpwp, v))
+}
+
+// NpwpGTE applies the GTE predicate on the "npwp" field.
+func NpwpGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldNpwp, v))
+}
+
+// NpwpLT applies the LT predicate on the "npwp" field.
+func NpwpLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldNpwp, v))
+}
+
+// NpwpLTE applies the LTE predicate on the "npwp" field.
+func NpwpLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldNpwp, v))
+}
+
+// NpwpContains applies the Contains predicate on the "npwp" field.
+func NpwpContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldNpwp, v))
+}
+
+// NpwpHasPrefix applies the HasPrefix predicate on the "npwp" field.
+func NpwpHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldNpwp, v))
+}
+
+// NpwpHasSuffix applies the HasSuffix predicate on the "npwp" field.
+func NpwpHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldNpwp, v))
+}
+
+// NpwpIsNil applies the IsNil predicate on the "npwp" field.
+func NpwpIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldNpwp))
+}
+
+// NpwpNotNil applies the NotNil predicate on the "npwp" field.
+func NpwpNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldNpwp))
+}
+
+// NpwpEqualFold applies the EqualFold predicate on the "npwp" field.
+func NpwpEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldNpwp, v))
+}
+
+// NpwpContainsFold applies the ContainsFold predicate on the "npwp" field.
+func NpwpContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldNpwp, v))
+}
+
+// JkkRiskClassEQ applies the EQ predicate on the "jkk_risk_class" field.
+func JkkRiskClassEQ(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldJkkRiskClass, v))
+}
+
+// JkkRiskClassNEQ applies the NEQ predicate on the "jkk_risk_class" field.
+func JkkRiskClassNEQ(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldJkkRiskClass, v))
+}
+
+// JkkRiskClassIn applies the In predicate on the "jkk_risk_class" field.
+func JkkRiskClassIn(vs ...int) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldJkkRiskClass, vs...))
+}
+
+// JkkRiskClassNotIn applies the NotIn predicate on the "jkk_risk_class" field.
+func JkkRiskClassNotIn(vs ...int) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldJkkRiskClass, vs...))
+}
+
+// JkkRiskClassGT applies the GT predicate on the "jkk_risk_class" field.
+func JkkRiskClassGT(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldJkkRiskClass, v))
+}
+
+// JkkRiskClassGTE applies the GTE predicate on the "jkk_risk_class" field.
+func JkkRiskClassGTE(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldJkkRiskClass, v))
+}
+
+// JkkRiskClassLT applies the LT predicate on the "jkk_risk_class" field.
+func JkkRiskClassLT(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldJkkRiskClass, v))
+}
+
+// JkkRiskClassLTE applies the LTE predicate on the "jkk_risk_class" field.
+func JkkRiskClassLTE(v int) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldJkkRiskClass, v))
+}
+
 // HasAttendances applies the HasEdge predicate on the "attendances" edge.
 func HasAttendances() predicate.Employee {
 	return predicate.Employee(func(s *sql.Selector) {
@@ -811,6 +1152,213 @@ func HasSalaryCalculationsWith(preds ...predicate.SalaryCalculation) predicate.E
 	})
 }
 
+// HasShiftAssignments applies the HasEdge predicate on the "shift_assignments" edge.
+func HasShiftAssignments() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShiftAssignmentsTable, ShiftAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShiftAssignmentsWith applies the HasEdge predicate on the "shift_assignments" edge with a given conditions (other predicates).
+func HasShiftAssignmentsWith(preds ...predicate.ShiftAssignment) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newShiftAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaveRequests applies the HasEdge predicate on the "leave_requests" edge.
+func HasLeaveRequests() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveRequestsWith applies the HasEdge predicate on the "leave_requests" edge with a given conditions (other predicates).
+func HasLeaveRequestsWith(preds ...predicate.LeaveRequest) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newLeaveRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaveBalances applies the HasEdge predicate on the "leave_balances" edge.
+func HasLeaveBalances() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaveBalancesTable, LeaveBalancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveBalancesWith applies the HasEdge predicate on the "leave_balances" edge with a given conditions (other predicates).
+func HasLeaveBalancesWith(preds ...predicate.LeaveBalance) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newLeaveBalancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceCorrections applies the HasEdge predicate on the "attendance_corrections" edge.
+func HasAttendanceCorrections() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendanceCorrectionsTable, AttendanceCorrectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceCorrectionsWith applies the HasEdge predicate on the "attendance_corrections" edge with a given conditions (other predicates).
+func HasAttendanceCorrectionsWith(preds ...predicate.AttendanceCorrection) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAttendanceCorrectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceAnomalies applies the HasEdge predicate on the "attendance_anomalies" edge.
+func HasAttendanceAnomalies() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendanceAnomaliesTable, AttendanceAnomaliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceAnomaliesWith applies the HasEdge predicate on the "attendance_anomalies" edge with a given conditions (other predicates).
+func HasAttendanceAnomaliesWith(preds ...predicate.AttendanceAnomaly) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAttendanceAnomaliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOvertimes applies the HasEdge predicate on the "overtimes" edge.
+func HasOvertimes() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OvertimesTable, OvertimesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimesWith applies the HasEdge predicate on the "overtimes" edge with a given conditions (other predicates).
+func HasOvertimesWith(preds ...predicate.Overtime) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newOvertimesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCalendarFeeds applies the HasEdge predicate on the "calendar_feeds" edge.
+func HasCalendarFeeds() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CalendarFeedsTable, CalendarFeedsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCalendarFeedsWith applies the HasEdge predicate on the "calendar_feeds" edge with a given conditions (other predicates).
+func HasCalendarFeedsWith(preds ...predicate.CalendarFeed) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newCalendarFeedsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSalaryComponentAssignments applies the HasEdge predicate on the "salary_component_assignments" edge.
+func HasSalaryComponentAssignments() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SalaryComponentAssignmentsTable, SalaryComponentAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryComponentAssignmentsWith applies the HasEdge predicate on the "salary_component_assignments" edge with a given conditions (other predicates).
+func HasSalaryComponentAssignmentsWith(preds ...predicate.SalaryComponentAssignment) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newSalaryComponentAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOfficeLocation applies the HasEdge predicate on the "office_location" edge.
+func HasOfficeLocation() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OfficeLocationTable, OfficeLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOfficeLocationWith applies the HasEdge predicate on the "office_location" edge with a given conditions (other predicates).
+func HasOfficeLocationWith(preds ...predicate.OfficeLocation) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newOfficeLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Employee) predicate.Employee {
 	return predicate.Employee(func(s *sql.Selector) {
@@ -7,12 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/calendarfeed"
 	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // EmployeeCreate is the builder for creating a Employee entity.
@@ -139,15 +149,15 @@ func (ec *EmployeeCreate) SetHireDate(t time.Time) *EmployeeCreate {
 }
 
 // SetBaseSalary sets the "base_salary" field.
-func (ec *EmployeeCreate) SetBaseSalary(f float64) *EmployeeCreate {
-	ec.mutation.SetBaseSalary(f)
+func (ec *EmployeeCreate) SetBaseSalary(d decimal.Decimal) *EmployeeCreate {
+	ec.mutation.SetBaseSalary(d)
 	return ec
 }
 
 // SetNillableBaseSalary sets the "base_salary" field if the given value is not nil.
-func (ec *EmployeeCreate) SetNillableBaseSalary(f *float64) *EmployeeCreate {
-	if f != nil {
-		ec.SetBaseSalary(*f)
+func (ec *EmployeeCreate) SetNillableBaseSalary(d *decimal.Decimal) *EmployeeCreate {
+	if d != nil {
+		ec.SetBaseSalary(*d)
 	}
 	return ec
 }
@@ -166,6 +176,90 @@ func (ec *EmployeeCreate) SetNillableIsActive(b *bool) *EmployeeCreate {
 	return ec
 }
 
+// SetOfficeLocationID sets the "office_location_id" field.
+func (ec *EmployeeCreate) SetOfficeLocationID(u uint64) *EmployeeCreate {
+	ec.mutation.SetOfficeLocationID(u)
+	return ec
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillableOfficeLocationID(u *uint64) *EmployeeCreate {
+	if u != nil {
+		ec.SetOfficeLocationID(*u)
+	}
+	return ec
+}
+
+// SetTimezone sets the "timezone" field.
+func (ec *EmployeeCreate) SetTimezone(s string) *EmployeeCreate {
+	ec.mutation.SetTimezone(s)
+	return ec
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillableTimezone(s *string) *EmployeeCreate {
+	if s != nil {
+		ec.SetTimezone(*s)
+	}
+	return ec
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (ec *EmployeeCreate) SetDevicePin(s string) *EmployeeCreate {
+	ec.mutation.SetDevicePin(s)
+	return ec
+}
+
+// SetNillableDevicePin sets the "device_pin" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillableDevicePin(s *string) *EmployeeCreate {
+	if s != nil {
+		ec.SetDevicePin(*s)
+	}
+	return ec
+}
+
+// SetPtkpStatus sets the "ptkp_status" field.
+func (ec *EmployeeCreate) SetPtkpStatus(es employee.PtkpStatus) *EmployeeCreate {
+	ec.mutation.SetPtkpStatus(es)
+	return ec
+}
+
+// SetNillablePtkpStatus sets the "ptkp_status" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillablePtkpStatus(es *employee.PtkpStatus) *EmployeeCreate {
+	if es != nil {
+		ec.SetPtkpStatus(*es)
+	}
+	return ec
+}
+
+// SetNpwp sets the "npwp" field.
+func (ec *EmployeeCreate) SetNpwp(s string) *EmployeeCreate {
+	ec.mutation.SetNpwp(s)
+	return ec
+}
+
+// SetNillableNpwp sets the "npwp" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillableNpwp(s *string) *EmployeeCreate {
+	if s != nil {
+		ec.SetNpwp(*s)
+	}
+	return ec
+}
+
+// SetJkkRiskClass sets the "jkk_risk_class" field.
+func (ec *EmployeeCreate) SetJkkRiskClass(i int) *EmployeeCreate {
+	ec.mutation.SetJkkRiskClass(i)
+	return ec
+}
+
+// SetNillableJkkRiskClass sets the "jkk_risk_class" field if the given value is not nil.
+func (ec *EmployeeCreate) SetNillableJkkRiskClass(i *int) *EmployeeCreate {
+	if i != nil {
+		ec.SetJkkRiskClass(*i)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EmployeeCreate) SetID(u uint64) *EmployeeCreate {
 	ec.mutation.SetID(u)
@@ -202,6 +296,131 @@ func (ec *EmployeeCreate) AddSalaryCalculations(s ...*SalaryCalculation) *Employ
 	return ec.AddSalaryCalculationIDs(ids...)
 }
 
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (ec *EmployeeCreate) AddShiftAssignmentIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddShiftAssignmentIDs(ids...)
+	return ec
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (ec *EmployeeCreate) AddShiftAssignments(s ...*ShiftAssignment) *EmployeeCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddShiftAssignmentIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (ec *EmployeeCreate) AddLeaveRequestIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddLeaveRequestIDs(ids...)
+	return ec
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (ec *EmployeeCreate) AddLeaveRequests(l ...*LeaveRequest) *EmployeeCreate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ec.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (ec *EmployeeCreate) AddLeaveBalanceIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddLeaveBalanceIDs(ids...)
+	return ec
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (ec *EmployeeCreate) AddLeaveBalances(l ...*LeaveBalance) *EmployeeCreate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ec.AddLeaveBalanceIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (ec *EmployeeCreate) AddAttendanceCorrectionIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddAttendanceCorrectionIDs(ids...)
+	return ec
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (ec *EmployeeCreate) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddAttendanceAnomalyIDs adds the "attendance_anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (ec *EmployeeCreate) AddAttendanceAnomalyIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddAttendanceAnomalyIDs(ids...)
+	return ec
+}
+
+// AddAttendanceAnomalies adds the "attendance_anomalies" edges to the AttendanceAnomaly entity.
+func (ec *EmployeeCreate) AddAttendanceAnomalies(a ...*AttendanceAnomaly) *EmployeeCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAttendanceAnomalyIDs(ids...)
+}
+
+// AddOvertimeIDs adds the "overtimes" edge to the Overtime entity by IDs.
+func (ec *EmployeeCreate) AddOvertimeIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddOvertimeIDs(ids...)
+	return ec
+}
+
+// AddOvertimes adds the "overtimes" edges to the Overtime entity.
+func (ec *EmployeeCreate) AddOvertimes(o ...*Overtime) *EmployeeCreate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ec.AddOvertimeIDs(ids...)
+}
+
+// AddCalendarFeedIDs adds the "calendar_feeds" edge to the CalendarFeed entity by IDs.
+func (ec *EmployeeCreate) AddCalendarFeedIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddCalendarFeedIDs(ids...)
+	return ec
+}
+
+// AddCalendarFeeds adds the "calendar_feeds" edges to the CalendarFeed entity.
+func (ec *EmployeeCreate) AddCalendarFeeds(c ...*CalendarFeed) *EmployeeCreate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ec.AddCalendarFeedIDs(ids...)
+}
+
+// AddSalaryComponentAssignmentIDs adds the "salary_component_assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (ec *EmployeeCreate) AddSalaryComponentAssignmentIDs(ids ...uint64) *EmployeeCreate {
+	ec.mutation.AddSalaryComponentAssignmentIDs(ids...)
+	return ec
+}
+
+// AddSalaryComponentAssignments adds the "salary_component_assignments" edges to the SalaryComponentAssignment entity.
+func (ec *EmployeeCreate) AddSalaryComponentAssignments(s ...*SalaryComponentAssignment) *EmployeeCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddSalaryComponentAssignmentIDs(ids...)
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (ec *EmployeeCreate) SetOfficeLocation(o *OfficeLocation) *EmployeeCreate {
+	return ec.SetOfficeLocationID(o.ID)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (ec *EmployeeCreate) Mutation() *EmployeeMutation {
 	return ec.mutation
@@ -253,6 +472,14 @@ func (ec *EmployeeCreate) defaults() {
 		v := employee.DefaultIsActive
 		ec.mutation.SetIsActive(v)
 	}
+	if _, ok := ec.mutation.PtkpStatus(); !ok {
+		v := employee.DefaultPtkpStatus
+		ec.mutation.SetPtkpStatus(v)
+	}
+	if _, ok := ec.mutation.JkkRiskClass(); !ok {
+		v := employee.DefaultJkkRiskClass
+		ec.mutation.SetJkkRiskClass(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -308,6 +535,37 @@ func (ec *EmployeeCreate) check() error {
 	if _, ok := ec.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Employee.is_active"`)}
 	}
+	if v, ok := ec.mutation.Timezone(); ok {
+		if err := employee.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Employee.timezone": %w`, err)}
+		}
+	}
+	if v, ok := ec.mutation.DevicePin(); ok {
+		if err := employee.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "Employee.device_pin": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.PtkpStatus(); !ok {
+		return &ValidationError{Name: "ptkp_status", err: errors.New(`ent: missing required field "Employee.ptkp_status"`)}
+	}
+	if v, ok := ec.mutation.PtkpStatus(); ok {
+		if err := employee.PtkpStatusValidator(v); err != nil {
+			return &ValidationError{Name: "ptkp_status", err: fmt.Errorf(`ent: validator failed for field "Employee.ptkp_status": %w`, err)}
+		}
+	}
+	if v, ok := ec.mutation.Npwp(); ok {
+		if err := employee.NpwpValidator(v); err != nil {
+			return &ValidationError{Name: "npwp", err: fmt.Errorf(`ent: validator failed for field "Employee.npwp": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.JkkRiskClass(); !ok {
+		return &ValidationError{Name: "jkk_risk_class", err: errors.New(`ent: missing required field "Employee.jkk_risk_class"`)}
+	}
+	if v, ok := ec.mutation.JkkRiskClass(); ok {
+		if err := employee.JkkRiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "jkk_risk_class", err: fmt.Errorf(`ent: validator failed for field "Employee.jkk_risk_class": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -381,13 +639,33 @@ func (ec *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		_node.HireDate = value
 	}
 	if value, ok := ec.mutation.BaseSalary(); ok {
-		_spec.SetField(employee.FieldBaseSalary, field.TypeFloat64, value)
+		_spec.SetField(employee.FieldBaseSalary, field.TypeOther, value)
 		_node.BaseSalary = value
 	}
 	if value, ok := ec.mutation.IsActive(); ok {
 		_spec.SetField(employee.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := ec.mutation.Timezone(); ok {
+		_spec.SetField(employee.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := ec.mutation.DevicePin(); ok {
+		_spec.SetField(employee.FieldDevicePin, field.TypeString, value)
+		_node.DevicePin = &value
+	}
+	if value, ok := ec.mutation.PtkpStatus(); ok {
+		_spec.SetField(employee.FieldPtkpStatus, field.TypeEnum, value)
+		_node.PtkpStatus = value
+	}
+	if value, ok := ec.mutation.Npwp(); ok {
+		_spec.SetField(employee.FieldNpwp, field.TypeString, value)
+		_node.Npwp = value
+	}
+	if value, ok := ec.mutation.JkkRiskClass(); ok {
+		_spec.SetField(employee.FieldJkkRiskClass, field.TypeInt, value)
+		_node.JkkRiskClass = value
+	}
 	if nodes := ec.mutation.AttendancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -420,6 +698,151 @@ func (ec *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AttendanceAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.OvertimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.CalendarFeedsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.SalaryComponentAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.OfficeLocationTable,
+			Columns: []string{employee.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OfficeLocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
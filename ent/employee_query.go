@@ -8,9 +8,18 @@ import (
 	"fmt"
 	"math"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/calendarfeed"
 	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
 	"mceasy/ent/predicate"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,13 +29,22 @@ import (
 // EmployeeQuery is the builder for querying Employee entities.
 type EmployeeQuery struct {
 	config
-	ctx                    *QueryContext
-	order                  []employee.OrderOption
-	inters                 []Interceptor
-	predicates             []predicate.Employee
-	withAttendances        *AttendanceQuery
-	withSalaryCalculations *SalaryCalculationQuery
-	modifiers              []func(*sql.Selector)
+	ctx                            *QueryContext
+	order                          []employee.OrderOption
+	inters                         []Interceptor
+	predicates                     []predicate.Employee
+	withAttendances                *AttendanceQuery
+	withSalaryCalculations         *SalaryCalculationQuery
+	withShiftAssignments           *ShiftAssignmentQuery
+	withLeaveRequests              *LeaveRequestQuery
+	withLeaveBalances              *LeaveBalanceQuery
+	withAttendanceCorrections      *AttendanceCorrectionQuery
+	withAttendanceAnomalies        *AttendanceAnomalyQuery
+	withOvertimes                  *OvertimeQuery
+	withCalendarFeeds              *CalendarFeedQuery
+	withSalaryComponentAssignments *SalaryComponentAssignmentQuery
+	withOfficeLocation             *OfficeLocationQuery
+	modifiers                      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +125,204 @@ func (eq *EmployeeQuery) QuerySalaryCalculations() *SalaryCalculationQuery {
 	return query
 }
 
+// QueryShiftAssignments chains the current query on the "shift_assignments" edge.
+func (eq *EmployeeQuery) QueryShiftAssignments() *ShiftAssignmentQuery {
+	query := (&ShiftAssignmentClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(shiftassignment.Table, shiftassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ShiftAssignmentsTable, employee.ShiftAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests chains the current query on the "leave_requests" edge.
+func (eq *EmployeeQuery) QueryLeaveRequests() *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveRequestsTable, employee.LeaveRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveBalances chains the current query on the "leave_balances" edge.
+func (eq *EmployeeQuery) QueryLeaveBalances() *LeaveBalanceQuery {
+	query := (&LeaveBalanceClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(leavebalance.Table, leavebalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveBalancesTable, employee.LeaveBalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendanceCorrections chains the current query on the "attendance_corrections" edge.
+func (eq *EmployeeQuery) QueryAttendanceCorrections() *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceCorrectionsTable, employee.AttendanceCorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendanceAnomalies chains the current query on the "attendance_anomalies" edge.
+func (eq *EmployeeQuery) QueryAttendanceAnomalies() *AttendanceAnomalyQuery {
+	query := (&AttendanceAnomalyClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(attendanceanomaly.Table, attendanceanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceAnomaliesTable, employee.AttendanceAnomaliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOvertimes chains the current query on the "overtimes" edge.
+func (eq *EmployeeQuery) QueryOvertimes() *OvertimeQuery {
+	query := (&OvertimeClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(overtime.Table, overtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.OvertimesTable, employee.OvertimesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCalendarFeeds chains the current query on the "calendar_feeds" edge.
+func (eq *EmployeeQuery) QueryCalendarFeeds() *CalendarFeedQuery {
+	query := (&CalendarFeedClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(calendarfeed.Table, calendarfeed.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.CalendarFeedsTable, employee.CalendarFeedsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySalaryComponentAssignments chains the current query on the "salary_component_assignments" edge.
+func (eq *EmployeeQuery) QuerySalaryComponentAssignments() *SalaryComponentAssignmentQuery {
+	query := (&SalaryComponentAssignmentClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(salarycomponentassignment.Table, salarycomponentassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.SalaryComponentAssignmentsTable, employee.SalaryComponentAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOfficeLocation chains the current query on the "office_location" edge.
+func (eq *EmployeeQuery) QueryOfficeLocation() *OfficeLocationQuery {
+	query := (&OfficeLocationClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(officelocation.Table, officelocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employee.OfficeLocationTable, employee.OfficeLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Employee entity from the query.
 // Returns a *NotFoundError when no Employee was found.
 func (eq *EmployeeQuery) First(ctx context.Context) (*Employee, error) {
@@ -294,13 +510,22 @@ func (eq *EmployeeQuery) Clone() *EmployeeQuery {
 		return nil
 	}
 	return &EmployeeQuery{
-		config:                 eq.config,
-		ctx:                    eq.ctx.Clone(),
-		order:                  append([]employee.OrderOption{}, eq.order...),
-		inters:                 append([]Interceptor{}, eq.inters...),
-		predicates:             append([]predicate.Employee{}, eq.predicates...),
-		withAttendances:        eq.withAttendances.Clone(),
-		withSalaryCalculations: eq.withSalaryCalculations.Clone(),
+		config:                         eq.config,
+		ctx:                            eq.ctx.Clone(),
+		order:                          append([]employee.OrderOption{}, eq.order...),
+		inters:                         append([]Interceptor{}, eq.inters...),
+		predicates:                     append([]predicate.Employee{}, eq.predicates...),
+		withAttendances:                eq.withAttendances.Clone(),
+		withSalaryCalculations:         eq.withSalaryCalculations.Clone(),
+		withShiftAssignments:           eq.withShiftAssignments.Clone(),
+		withLeaveRequests:              eq.withLeaveRequests.Clone(),
+		withLeaveBalances:              eq.withLeaveBalances.Clone(),
+		withAttendanceCorrections:      eq.withAttendanceCorrections.Clone(),
+		withAttendanceAnomalies:        eq.withAttendanceAnomalies.Clone(),
+		withOvertimes:                  eq.withOvertimes.Clone(),
+		withCalendarFeeds:              eq.withCalendarFeeds.Clone(),
+		withSalaryComponentAssignments: eq.withSalaryComponentAssignments.Clone(),
+		withOfficeLocation:             eq.withOfficeLocation.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -329,6 +554,105 @@ func (eq *EmployeeQuery) WithSalaryCalculations(opts ...func(*SalaryCalculationQ
 	return eq
 }
 
+// WithShiftAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "shift_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithShiftAssignments(opts ...func(*ShiftAssignmentQuery)) *EmployeeQuery {
+	query := (&ShiftAssignmentClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withShiftAssignments = query
+	return eq
+}
+
+// WithLeaveRequests tells the query-builder to eager-load the nodes that are connected to
+// the "leave_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithLeaveRequests(opts ...func(*LeaveRequestQuery)) *EmployeeQuery {
+	query := (&LeaveRequestClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withLeaveRequests = query
+	return eq
+}
+
+// WithLeaveBalances tells the query-builder to eager-load the nodes that are connected to
+// the "leave_balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithLeaveBalances(opts ...func(*LeaveBalanceQuery)) *EmployeeQuery {
+	query := (&LeaveBalanceClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withLeaveBalances = query
+	return eq
+}
+
+// WithAttendanceCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithAttendanceCorrections(opts ...func(*AttendanceCorrectionQuery)) *EmployeeQuery {
+	query := (&AttendanceCorrectionClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAttendanceCorrections = query
+	return eq
+}
+
+// WithAttendanceAnomalies tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_anomalies" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithAttendanceAnomalies(opts ...func(*AttendanceAnomalyQuery)) *EmployeeQuery {
+	query := (&AttendanceAnomalyClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAttendanceAnomalies = query
+	return eq
+}
+
+// WithOvertimes tells the query-builder to eager-load the nodes that are connected to
+// the "overtimes" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithOvertimes(opts ...func(*OvertimeQuery)) *EmployeeQuery {
+	query := (&OvertimeClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withOvertimes = query
+	return eq
+}
+
+// WithCalendarFeeds tells the query-builder to eager-load the nodes that are connected to
+// the "calendar_feeds" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithCalendarFeeds(opts ...func(*CalendarFeedQuery)) *EmployeeQuery {
+	query := (&CalendarFeedClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCalendarFeeds = query
+	return eq
+}
+
+// WithSalaryComponentAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "salary_component_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithSalaryComponentAssignments(opts ...func(*SalaryComponentAssignmentQuery)) *EmployeeQuery {
+	query := (&SalaryComponentAssignmentClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSalaryComponentAssignments = query
+	return eq
+}
+
+// WithOfficeLocation tells the query-builder to eager-load the nodes that are connected to
+// the "office_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithOfficeLocation(opts ...func(*OfficeLocationQuery)) *EmployeeQuery {
+	query := (&OfficeLocationClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withOfficeLocation = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +731,18 @@ func (eq *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 	var (
 		nodes       = []*Employee{}
 		_spec       = eq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [11]bool{
 			eq.withAttendances != nil,
 			eq.withSalaryCalculations != nil,
+			eq.withShiftAssignments != nil,
+			eq.withLeaveRequests != nil,
+			eq.withLeaveBalances != nil,
+			eq.withAttendanceCorrections != nil,
+			eq.withAttendanceAnomalies != nil,
+			eq.withOvertimes != nil,
+			eq.withCalendarFeeds != nil,
+			eq.withSalaryComponentAssignments != nil,
+			eq.withOfficeLocation != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -449,6 +782,74 @@ func (eq *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 			return nil, err
 		}
 	}
+	if query := eq.withShiftAssignments; query != nil {
+		if err := eq.loadShiftAssignments(ctx, query, nodes,
+			func(n *Employee) { n.Edges.ShiftAssignments = []*ShiftAssignment{} },
+			func(n *Employee, e *ShiftAssignment) { n.Edges.ShiftAssignments = append(n.Edges.ShiftAssignments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withLeaveRequests; query != nil {
+		if err := eq.loadLeaveRequests(ctx, query, nodes,
+			func(n *Employee) { n.Edges.LeaveRequests = []*LeaveRequest{} },
+			func(n *Employee, e *LeaveRequest) { n.Edges.LeaveRequests = append(n.Edges.LeaveRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withLeaveBalances; query != nil {
+		if err := eq.loadLeaveBalances(ctx, query, nodes,
+			func(n *Employee) { n.Edges.LeaveBalances = []*LeaveBalance{} },
+			func(n *Employee, e *LeaveBalance) { n.Edges.LeaveBalances = append(n.Edges.LeaveBalances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAttendanceCorrections; query != nil {
+		if err := eq.loadAttendanceCorrections(ctx, query, nodes,
+			func(n *Employee) { n.Edges.AttendanceCorrections = []*AttendanceCorrection{} },
+			func(n *Employee, e *AttendanceCorrection) {
+				n.Edges.AttendanceCorrections = append(n.Edges.AttendanceCorrections, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAttendanceAnomalies; query != nil {
+		if err := eq.loadAttendanceAnomalies(ctx, query, nodes,
+			func(n *Employee) { n.Edges.AttendanceAnomalies = []*AttendanceAnomaly{} },
+			func(n *Employee, e *AttendanceAnomaly) {
+				n.Edges.AttendanceAnomalies = append(n.Edges.AttendanceAnomalies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withOvertimes; query != nil {
+		if err := eq.loadOvertimes(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Overtimes = []*Overtime{} },
+			func(n *Employee, e *Overtime) { n.Edges.Overtimes = append(n.Edges.Overtimes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withCalendarFeeds; query != nil {
+		if err := eq.loadCalendarFeeds(ctx, query, nodes,
+			func(n *Employee) { n.Edges.CalendarFeeds = []*CalendarFeed{} },
+			func(n *Employee, e *CalendarFeed) { n.Edges.CalendarFeeds = append(n.Edges.CalendarFeeds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withSalaryComponentAssignments; query != nil {
+		if err := eq.loadSalaryComponentAssignments(ctx, query, nodes,
+			func(n *Employee) { n.Edges.SalaryComponentAssignments = []*SalaryComponentAssignment{} },
+			func(n *Employee, e *SalaryComponentAssignment) {
+				n.Edges.SalaryComponentAssignments = append(n.Edges.SalaryComponentAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withOfficeLocation; query != nil {
+		if err := eq.loadOfficeLocation(ctx, query, nodes, nil,
+			func(n *Employee, e *OfficeLocation) { n.Edges.OfficeLocation = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -512,6 +913,284 @@ func (eq *EmployeeQuery) loadSalaryCalculations(ctx context.Context, query *Sala
 	}
 	return nil
 }
+func (eq *EmployeeQuery) loadShiftAssignments(ctx context.Context, query *ShiftAssignmentQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *ShiftAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shiftassignment.FieldEmployeeID)
+	}
+	query.Where(predicate.ShiftAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.ShiftAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadLeaveRequests(ctx context.Context, query *LeaveRequestQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *LeaveRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leaverequest.FieldEmployeeID)
+	}
+	query.Where(predicate.LeaveRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.LeaveRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadLeaveBalances(ctx context.Context, query *LeaveBalanceQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *LeaveBalance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leavebalance.FieldEmployeeID)
+	}
+	query.Where(predicate.LeaveBalance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.LeaveBalancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadAttendanceCorrections(ctx context.Context, query *AttendanceCorrectionQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *AttendanceCorrection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancecorrection.FieldEmployeeID)
+	}
+	query.Where(predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AttendanceCorrectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadAttendanceAnomalies(ctx context.Context, query *AttendanceAnomalyQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *AttendanceAnomaly)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendanceanomaly.FieldEmployeeID)
+	}
+	query.Where(predicate.AttendanceAnomaly(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AttendanceAnomaliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadOvertimes(ctx context.Context, query *OvertimeQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Overtime)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(overtime.FieldEmployeeID)
+	}
+	query.Where(predicate.Overtime(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.OvertimesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadCalendarFeeds(ctx context.Context, query *CalendarFeedQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *CalendarFeed)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(calendarfeed.FieldEmployeeID)
+	}
+	query.Where(predicate.CalendarFeed(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.CalendarFeedsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "employee_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadSalaryComponentAssignments(ctx context.Context, query *SalaryComponentAssignmentQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *SalaryComponentAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarycomponentassignment.FieldEmployeeID)
+	}
+	query.Where(predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.SalaryComponentAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "employee_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadOfficeLocation(ctx context.Context, query *OfficeLocationQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *OfficeLocation)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*Employee)
+	for i := range nodes {
+		if nodes[i].OfficeLocationID == nil {
+			continue
+		}
+		fk := *nodes[i].OfficeLocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(officelocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "office_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (eq *EmployeeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
@@ -541,6 +1220,9 @@ func (eq *EmployeeQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if eq.withOfficeLocation != nil {
+			_spec.Node.AddColumnOnce(employee.FieldOfficeLocationID)
+		}
 	}
 	if ps := eq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
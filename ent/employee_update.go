@@ -7,14 +7,24 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/calendarfeed"
 	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
 	"mceasy/ent/predicate"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // EmployeeUpdate is the builder for updating Employee entities.
@@ -156,26 +166,19 @@ func (eu *EmployeeUpdate) SetHireDate(t time.Time) *EmployeeUpdate {
 }
 
 // SetBaseSalary sets the "base_salary" field.
-func (eu *EmployeeUpdate) SetBaseSalary(f float64) *EmployeeUpdate {
-	eu.mutation.ResetBaseSalary()
-	eu.mutation.SetBaseSalary(f)
+func (eu *EmployeeUpdate) SetBaseSalary(d decimal.Decimal) *EmployeeUpdate {
+	eu.mutation.SetBaseSalary(d)
 	return eu
 }
 
 // SetNillableBaseSalary sets the "base_salary" field if the given value is not nil.
-func (eu *EmployeeUpdate) SetNillableBaseSalary(f *float64) *EmployeeUpdate {
-	if f != nil {
-		eu.SetBaseSalary(*f)
+func (eu *EmployeeUpdate) SetNillableBaseSalary(d *decimal.Decimal) *EmployeeUpdate {
+	if d != nil {
+		eu.SetBaseSalary(*d)
 	}
 	return eu
 }
 
-// AddBaseSalary adds f to the "base_salary" field.
-func (eu *EmployeeUpdate) AddBaseSalary(f float64) *EmployeeUpdate {
-	eu.mutation.AddBaseSalary(f)
-	return eu
-}
-
 // SetIsActive sets the "is_active" field.
 func (eu *EmployeeUpdate) SetIsActive(b bool) *EmployeeUpdate {
 	eu.mutation.SetIsActive(b)
@@ -190,6 +193,121 @@ func (eu *EmployeeUpdate) SetNillableIsActive(b *bool) *EmployeeUpdate {
 	return eu
 }
 
+// SetOfficeLocationID sets the "office_location_id" field.
+func (eu *EmployeeUpdate) SetOfficeLocationID(u uint64) *EmployeeUpdate {
+	eu.mutation.SetOfficeLocationID(u)
+	return eu
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillableOfficeLocationID(u *uint64) *EmployeeUpdate {
+	if u != nil {
+		eu.SetOfficeLocationID(*u)
+	}
+	return eu
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (eu *EmployeeUpdate) ClearOfficeLocationID() *EmployeeUpdate {
+	eu.mutation.ClearOfficeLocationID()
+	return eu
+}
+
+// SetTimezone sets the "timezone" field.
+func (eu *EmployeeUpdate) SetTimezone(s string) *EmployeeUpdate {
+	eu.mutation.SetTimezone(s)
+	return eu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillableTimezone(s *string) *EmployeeUpdate {
+	if s != nil {
+		eu.SetTimezone(*s)
+	}
+	return eu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (eu *EmployeeUpdate) ClearTimezone() *EmployeeUpdate {
+	eu.mutation.ClearTimezone()
+	return eu
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (eu *EmployeeUpdate) SetDevicePin(s string) *EmployeeUpdate {
+	eu.mutation.SetDevicePin(s)
+	return eu
+}
+
+// SetNillableDevicePin sets the "device_pin" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillableDevicePin(s *string) *EmployeeUpdate {
+	if s != nil {
+		eu.SetDevicePin(*s)
+	}
+	return eu
+}
+
+// ClearDevicePin clears the value of the "device_pin" field.
+func (eu *EmployeeUpdate) ClearDevicePin() *EmployeeUpdate {
+	eu.mutation.ClearDevicePin()
+	return eu
+}
+
+// SetPtkpStatus sets the "ptkp_status" field.
+func (eu *EmployeeUpdate) SetPtkpStatus(es employee.PtkpStatus) *EmployeeUpdate {
+	eu.mutation.SetPtkpStatus(es)
+	return eu
+}
+
+// SetNillablePtkpStatus sets the "ptkp_status" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillablePtkpStatus(es *employee.PtkpStatus) *EmployeeUpdate {
+	if es != nil {
+		eu.SetPtkpStatus(*es)
+	}
+	return eu
+}
+
+// SetNpwp sets the "npwp" field.
+func (eu *EmployeeUpdate) SetNpwp(s string) *EmployeeUpdate {
+	eu.mutation.SetNpwp(s)
+	return eu
+}
+
+// SetNillableNpwp sets the "npwp" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillableNpwp(s *string) *EmployeeUpdate {
+	if s != nil {
+		eu.SetNpwp(*s)
+	}
+	return eu
+}
+
+// ClearNpwp clears the value of the "npwp" field.
+func (eu *EmployeeUpdate) ClearNpwp() *EmployeeUpdate {
+	eu.mutation.ClearNpwp()
+	return eu
+}
+
+// SetJkkRiskClass sets the "jkk_risk_class" field.
+func (eu *EmployeeUpdate) SetJkkRiskClass(i int) *EmployeeUpdate {
+	eu.mutation.ResetJkkRiskClass()
+	eu.mutation.SetJkkRiskClass(i)
+	return eu
+}
+
+// SetNillableJkkRiskClass sets the "jkk_risk_class" field if the given value is not nil.
+func (eu *EmployeeUpdate) SetNillableJkkRiskClass(i *int) *EmployeeUpdate {
+	if i != nil {
+		eu.SetJkkRiskClass(*i)
+	}
+	return eu
+}
+
+// AddJkkRiskClass adds i to the "jkk_risk_class" field.
+func (eu *EmployeeUpdate) AddJkkRiskClass(i int) *EmployeeUpdate {
+	eu.mutation.AddJkkRiskClass(i)
+	return eu
+}
+
 // AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
 func (eu *EmployeeUpdate) AddAttendanceIDs(ids ...uint64) *EmployeeUpdate {
 	eu.mutation.AddAttendanceIDs(ids...)
@@ -220,6 +338,131 @@ func (eu *EmployeeUpdate) AddSalaryCalculations(s ...*SalaryCalculation) *Employ
 	return eu.AddSalaryCalculationIDs(ids...)
 }
 
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (eu *EmployeeUpdate) AddShiftAssignmentIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddShiftAssignmentIDs(ids...)
+	return eu
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (eu *EmployeeUpdate) AddShiftAssignments(s ...*ShiftAssignment) *EmployeeUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddShiftAssignmentIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (eu *EmployeeUpdate) AddLeaveRequestIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddLeaveRequestIDs(ids...)
+	return eu
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (eu *EmployeeUpdate) AddLeaveRequests(l ...*LeaveRequest) *EmployeeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return eu.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (eu *EmployeeUpdate) AddLeaveBalanceIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddLeaveBalanceIDs(ids...)
+	return eu
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (eu *EmployeeUpdate) AddLeaveBalances(l ...*LeaveBalance) *EmployeeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return eu.AddLeaveBalanceIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (eu *EmployeeUpdate) AddAttendanceCorrectionIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddAttendanceCorrectionIDs(ids...)
+	return eu
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (eu *EmployeeUpdate) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddAttendanceAnomalyIDs adds the "attendance_anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (eu *EmployeeUpdate) AddAttendanceAnomalyIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddAttendanceAnomalyIDs(ids...)
+	return eu
+}
+
+// AddAttendanceAnomalies adds the "attendance_anomalies" edges to the AttendanceAnomaly entity.
+func (eu *EmployeeUpdate) AddAttendanceAnomalies(a ...*AttendanceAnomaly) *EmployeeUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAttendanceAnomalyIDs(ids...)
+}
+
+// AddOvertimeIDs adds the "overtimes" edge to the Overtime entity by IDs.
+func (eu *EmployeeUpdate) AddOvertimeIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddOvertimeIDs(ids...)
+	return eu
+}
+
+// AddOvertimes adds the "overtimes" edges to the Overtime entity.
+func (eu *EmployeeUpdate) AddOvertimes(o ...*Overtime) *EmployeeUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return eu.AddOvertimeIDs(ids...)
+}
+
+// AddCalendarFeedIDs adds the "calendar_feeds" edge to the CalendarFeed entity by IDs.
+func (eu *EmployeeUpdate) AddCalendarFeedIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddCalendarFeedIDs(ids...)
+	return eu
+}
+
+// AddCalendarFeeds adds the "calendar_feeds" edges to the CalendarFeed entity.
+func (eu *EmployeeUpdate) AddCalendarFeeds(c ...*CalendarFeed) *EmployeeUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.AddCalendarFeedIDs(ids...)
+}
+
+// AddSalaryComponentAssignmentIDs adds the "salary_component_assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (eu *EmployeeUpdate) AddSalaryComponentAssignmentIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.AddSalaryComponentAssignmentIDs(ids...)
+	return eu
+}
+
+// AddSalaryComponentAssignments adds the "salary_component_assignments" edges to the SalaryComponentAssignment entity.
+func (eu *EmployeeUpdate) AddSalaryComponentAssignments(s ...*SalaryComponentAssignment) *EmployeeUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddSalaryComponentAssignmentIDs(ids...)
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (eu *EmployeeUpdate) SetOfficeLocation(o *OfficeLocation) *EmployeeUpdate {
+	return eu.SetOfficeLocationID(o.ID)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (eu *EmployeeUpdate) Mutation() *EmployeeMutation {
 	return eu.mutation
@@ -267,6 +510,180 @@ func (eu *EmployeeUpdate) RemoveSalaryCalculations(s ...*SalaryCalculation) *Emp
 	return eu.RemoveSalaryCalculationIDs(ids...)
 }
 
+// ClearShiftAssignments clears all "shift_assignments" edges to the ShiftAssignment entity.
+func (eu *EmployeeUpdate) ClearShiftAssignments() *EmployeeUpdate {
+	eu.mutation.ClearShiftAssignments()
+	return eu
+}
+
+// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to ShiftAssignment entities by IDs.
+func (eu *EmployeeUpdate) RemoveShiftAssignmentIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveShiftAssignmentIDs(ids...)
+	return eu
+}
+
+// RemoveShiftAssignments removes "shift_assignments" edges to ShiftAssignment entities.
+func (eu *EmployeeUpdate) RemoveShiftAssignments(s ...*ShiftAssignment) *EmployeeUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveShiftAssignmentIDs(ids...)
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (eu *EmployeeUpdate) ClearLeaveRequests() *EmployeeUpdate {
+	eu.mutation.ClearLeaveRequests()
+	return eu
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (eu *EmployeeUpdate) RemoveLeaveRequestIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveLeaveRequestIDs(ids...)
+	return eu
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (eu *EmployeeUpdate) RemoveLeaveRequests(l ...*LeaveRequest) *EmployeeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return eu.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearLeaveBalances clears all "leave_balances" edges to the LeaveBalance entity.
+func (eu *EmployeeUpdate) ClearLeaveBalances() *EmployeeUpdate {
+	eu.mutation.ClearLeaveBalances()
+	return eu
+}
+
+// RemoveLeaveBalanceIDs removes the "leave_balances" edge to LeaveBalance entities by IDs.
+func (eu *EmployeeUpdate) RemoveLeaveBalanceIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveLeaveBalanceIDs(ids...)
+	return eu
+}
+
+// RemoveLeaveBalances removes "leave_balances" edges to LeaveBalance entities.
+func (eu *EmployeeUpdate) RemoveLeaveBalances(l ...*LeaveBalance) *EmployeeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return eu.RemoveLeaveBalanceIDs(ids...)
+}
+
+// ClearAttendanceCorrections clears all "attendance_corrections" edges to the AttendanceCorrection entity.
+func (eu *EmployeeUpdate) ClearAttendanceCorrections() *EmployeeUpdate {
+	eu.mutation.ClearAttendanceCorrections()
+	return eu
+}
+
+// RemoveAttendanceCorrectionIDs removes the "attendance_corrections" edge to AttendanceCorrection entities by IDs.
+func (eu *EmployeeUpdate) RemoveAttendanceCorrectionIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveAttendanceCorrectionIDs(ids...)
+	return eu
+}
+
+// RemoveAttendanceCorrections removes "attendance_corrections" edges to AttendanceCorrection entities.
+func (eu *EmployeeUpdate) RemoveAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAttendanceCorrectionIDs(ids...)
+}
+
+// ClearAttendanceAnomalies clears all "attendance_anomalies" edges to the AttendanceAnomaly entity.
+func (eu *EmployeeUpdate) ClearAttendanceAnomalies() *EmployeeUpdate {
+	eu.mutation.ClearAttendanceAnomalies()
+	return eu
+}
+
+// RemoveAttendanceAnomalyIDs removes the "attendance_anomalies" edge to AttendanceAnomaly entities by IDs.
+func (eu *EmployeeUpdate) RemoveAttendanceAnomalyIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveAttendanceAnomalyIDs(ids...)
+	return eu
+}
+
+// RemoveAttendanceAnomalies removes "attendance_anomalies" edges to AttendanceAnomaly entities.
+func (eu *EmployeeUpdate) RemoveAttendanceAnomalies(a ...*AttendanceAnomaly) *EmployeeUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAttendanceAnomalyIDs(ids...)
+}
+
+// ClearOvertimes clears all "overtimes" edges to the Overtime entity.
+func (eu *EmployeeUpdate) ClearOvertimes() *EmployeeUpdate {
+	eu.mutation.ClearOvertimes()
+	return eu
+}
+
+// RemoveOvertimeIDs removes the "overtimes" edge to Overtime entities by IDs.
+func (eu *EmployeeUpdate) RemoveOvertimeIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveOvertimeIDs(ids...)
+	return eu
+}
+
+// RemoveOvertimes removes "overtimes" edges to Overtime entities.
+func (eu *EmployeeUpdate) RemoveOvertimes(o ...*Overtime) *EmployeeUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return eu.RemoveOvertimeIDs(ids...)
+}
+
+// ClearCalendarFeeds clears all "calendar_feeds" edges to the CalendarFeed entity.
+func (eu *EmployeeUpdate) ClearCalendarFeeds() *EmployeeUpdate {
+	eu.mutation.ClearCalendarFeeds()
+	return eu
+}
+
+// RemoveCalendarFeedIDs removes the "calendar_feeds" edge to CalendarFeed entities by IDs.
+func (eu *EmployeeUpdate) RemoveCalendarFeedIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveCalendarFeedIDs(ids...)
+	return eu
+}
+
+// RemoveCalendarFeeds removes "calendar_feeds" edges to CalendarFeed entities.
+func (eu *EmployeeUpdate) RemoveCalendarFeeds(c ...*CalendarFeed) *EmployeeUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.RemoveCalendarFeedIDs(ids...)
+}
+
+// ClearSalaryComponentAssignments clears all "salary_component_assignments" edges to the SalaryComponentAssignment entity.
+func (eu *EmployeeUpdate) ClearSalaryComponentAssignments() *EmployeeUpdate {
+	eu.mutation.ClearSalaryComponentAssignments()
+	return eu
+}
+
+// RemoveSalaryComponentAssignmentIDs removes the "salary_component_assignments" edge to SalaryComponentAssignment entities by IDs.
+func (eu *EmployeeUpdate) RemoveSalaryComponentAssignmentIDs(ids ...uint64) *EmployeeUpdate {
+	eu.mutation.RemoveSalaryComponentAssignmentIDs(ids...)
+	return eu
+}
+
+// RemoveSalaryComponentAssignments removes "salary_component_assignments" edges to SalaryComponentAssignment entities.
+func (eu *EmployeeUpdate) RemoveSalaryComponentAssignments(s ...*SalaryComponentAssignment) *EmployeeUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveSalaryComponentAssignmentIDs(ids...)
+}
+
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (eu *EmployeeUpdate) ClearOfficeLocation() *EmployeeUpdate {
+	eu.mutation.ClearOfficeLocation()
+	return eu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EmployeeUpdate) Save(ctx context.Context) (int, error) {
 	eu.defaults()
@@ -335,6 +752,31 @@ func (eu *EmployeeUpdate) check() error {
 			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "Employee.department": %w`, err)}
 		}
 	}
+	if v, ok := eu.mutation.Timezone(); ok {
+		if err := employee.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Employee.timezone": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.DevicePin(); ok {
+		if err := employee.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "Employee.device_pin": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.PtkpStatus(); ok {
+		if err := employee.PtkpStatusValidator(v); err != nil {
+			return &ValidationError{Name: "ptkp_status", err: fmt.Errorf(`ent: validator failed for field "Employee.ptkp_status": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.Npwp(); ok {
+		if err := employee.NpwpValidator(v); err != nil {
+			return &ValidationError{Name: "npwp", err: fmt.Errorf(`ent: validator failed for field "Employee.npwp": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.JkkRiskClass(); ok {
+		if err := employee.JkkRiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "jkk_risk_class", err: fmt.Errorf(`ent: validator failed for field "Employee.jkk_risk_class": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -399,14 +841,38 @@ func (eu *EmployeeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
 	}
 	if value, ok := eu.mutation.BaseSalary(); ok {
-		_spec.SetField(employee.FieldBaseSalary, field.TypeFloat64, value)
-	}
-	if value, ok := eu.mutation.AddedBaseSalary(); ok {
-		_spec.AddField(employee.FieldBaseSalary, field.TypeFloat64, value)
+		_spec.SetField(employee.FieldBaseSalary, field.TypeOther, value)
 	}
 	if value, ok := eu.mutation.IsActive(); ok {
 		_spec.SetField(employee.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := eu.mutation.Timezone(); ok {
+		_spec.SetField(employee.FieldTimezone, field.TypeString, value)
+	}
+	if eu.mutation.TimezoneCleared() {
+		_spec.ClearField(employee.FieldTimezone, field.TypeString)
+	}
+	if value, ok := eu.mutation.DevicePin(); ok {
+		_spec.SetField(employee.FieldDevicePin, field.TypeString, value)
+	}
+	if eu.mutation.DevicePinCleared() {
+		_spec.ClearField(employee.FieldDevicePin, field.TypeString)
+	}
+	if value, ok := eu.mutation.PtkpStatus(); ok {
+		_spec.SetField(employee.FieldPtkpStatus, field.TypeEnum, value)
+	}
+	if value, ok := eu.mutation.Npwp(); ok {
+		_spec.SetField(employee.FieldNpwp, field.TypeString, value)
+	}
+	if eu.mutation.NpwpCleared() {
+		_spec.ClearField(employee.FieldNpwp, field.TypeString)
+	}
+	if value, ok := eu.mutation.JkkRiskClass(); ok {
+		_spec.SetField(employee.FieldJkkRiskClass, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedJkkRiskClass(); ok {
+		_spec.AddField(employee.FieldJkkRiskClass, field.TypeInt, value)
+	}
 	if eu.mutation.AttendancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -497,456 +963,1661 @@ func (eu *EmployeeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(eu.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{employee.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if eu.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	eu.mutation.done = true
-	return n, nil
-}
-
-// EmployeeUpdateOne is the builder for updating a single Employee entity.
-type EmployeeUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *EmployeeMutation
+	if nodes := eu.mutation.RemovedShiftAssignmentsIDs(); len(nodes) > 0 && !eu.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !eu.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedLeaveBalancesIDs(); len(nodes) > 0 && !eu.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedAttendanceCorrectionsIDs(); len(nodes) > 0 && !eu.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.AttendanceAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedAttendanceAnomaliesIDs(); len(nodes) > 0 && !eu.mutation.AttendanceAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.AttendanceAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.OvertimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedOvertimesIDs(); len(nodes) > 0 && !eu.mutation.OvertimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.OvertimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.CalendarFeedsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedCalendarFeedsIDs(); len(nodes) > 0 && !eu.mutation.CalendarFeedsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.CalendarFeedsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.SalaryComponentAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedSalaryComponentAssignmentsIDs(); len(nodes) > 0 && !eu.mutation.SalaryComponentAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SalaryComponentAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.OfficeLocationTable,
+			Columns: []string{employee.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.OfficeLocationTable,
+			Columns: []string{employee.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(eu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EmployeeUpdateOne is the builder for updating a single Employee entity.
+type EmployeeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmployeeMutation
 	modifiers []func(*sql.UpdateBuilder)
 }
 
-// SetModifiedAt sets the "modified_at" field.
-func (euo *EmployeeUpdateOne) SetModifiedAt(t time.Time) *EmployeeUpdateOne {
-	euo.mutation.SetModifiedAt(t)
+// SetModifiedAt sets the "modified_at" field.
+func (euo *EmployeeUpdateOne) SetModifiedAt(t time.Time) *EmployeeUpdateOne {
+	euo.mutation.SetModifiedAt(t)
+	return euo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (euo *EmployeeUpdateOne) SetDeletedAt(t time.Time) *EmployeeUpdateOne {
+	euo.mutation.SetDeletedAt(t)
+	return euo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableDeletedAt(t *time.Time) *EmployeeUpdateOne {
+	if t != nil {
+		euo.SetDeletedAt(*t)
+	}
+	return euo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (euo *EmployeeUpdateOne) ClearDeletedAt() *EmployeeUpdateOne {
+	euo.mutation.ClearDeletedAt()
+	return euo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (euo *EmployeeUpdateOne) SetEmployeeID(s string) *EmployeeUpdateOne {
+	euo.mutation.SetEmployeeID(s)
+	return euo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableEmployeeID(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetEmployeeID(*s)
+	}
+	return euo
+}
+
+// ClearEmployeeID clears the value of the "employee_id" field.
+func (euo *EmployeeUpdateOne) ClearEmployeeID() *EmployeeUpdateOne {
+	euo.mutation.ClearEmployeeID()
+	return euo
+}
+
+// SetFullName sets the "full_name" field.
+func (euo *EmployeeUpdateOne) SetFullName(s string) *EmployeeUpdateOne {
+	euo.mutation.SetFullName(s)
+	return euo
+}
+
+// SetEmail sets the "email" field.
+func (euo *EmployeeUpdateOne) SetEmail(s string) *EmployeeUpdateOne {
+	euo.mutation.SetEmail(s)
+	return euo
+}
+
+// SetPhone sets the "phone" field.
+func (euo *EmployeeUpdateOne) SetPhone(s string) *EmployeeUpdateOne {
+	euo.mutation.SetPhone(s)
+	return euo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillablePhone(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetPhone(*s)
+	}
+	return euo
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (euo *EmployeeUpdateOne) ClearPhone() *EmployeeUpdateOne {
+	euo.mutation.ClearPhone()
+	return euo
+}
+
+// SetPosition sets the "position" field.
+func (euo *EmployeeUpdateOne) SetPosition(s string) *EmployeeUpdateOne {
+	euo.mutation.SetPosition(s)
+	return euo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillablePosition(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetPosition(*s)
+	}
+	return euo
+}
+
+// ClearPosition clears the value of the "position" field.
+func (euo *EmployeeUpdateOne) ClearPosition() *EmployeeUpdateOne {
+	euo.mutation.ClearPosition()
+	return euo
+}
+
+// SetDepartment sets the "department" field.
+func (euo *EmployeeUpdateOne) SetDepartment(s string) *EmployeeUpdateOne {
+	euo.mutation.SetDepartment(s)
+	return euo
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableDepartment(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetDepartment(*s)
+	}
+	return euo
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (euo *EmployeeUpdateOne) ClearDepartment() *EmployeeUpdateOne {
+	euo.mutation.ClearDepartment()
+	return euo
+}
+
+// SetHireDate sets the "hire_date" field.
+func (euo *EmployeeUpdateOne) SetHireDate(t time.Time) *EmployeeUpdateOne {
+	euo.mutation.SetHireDate(t)
 	return euo
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (euo *EmployeeUpdateOne) SetDeletedAt(t time.Time) *EmployeeUpdateOne {
-	euo.mutation.SetDeletedAt(t)
+// SetBaseSalary sets the "base_salary" field.
+func (euo *EmployeeUpdateOne) SetBaseSalary(d decimal.Decimal) *EmployeeUpdateOne {
+	euo.mutation.SetBaseSalary(d)
 	return euo
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableDeletedAt(t *time.Time) *EmployeeUpdateOne {
-	if t != nil {
-		euo.SetDeletedAt(*t)
+// SetNillableBaseSalary sets the "base_salary" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableBaseSalary(d *decimal.Decimal) *EmployeeUpdateOne {
+	if d != nil {
+		euo.SetBaseSalary(*d)
+	}
+	return euo
+}
+
+// SetIsActive sets the "is_active" field.
+func (euo *EmployeeUpdateOne) SetIsActive(b bool) *EmployeeUpdateOne {
+	euo.mutation.SetIsActive(b)
+	return euo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableIsActive(b *bool) *EmployeeUpdateOne {
+	if b != nil {
+		euo.SetIsActive(*b)
+	}
+	return euo
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (euo *EmployeeUpdateOne) SetOfficeLocationID(u uint64) *EmployeeUpdateOne {
+	euo.mutation.SetOfficeLocationID(u)
+	return euo
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableOfficeLocationID(u *uint64) *EmployeeUpdateOne {
+	if u != nil {
+		euo.SetOfficeLocationID(*u)
+	}
+	return euo
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (euo *EmployeeUpdateOne) ClearOfficeLocationID() *EmployeeUpdateOne {
+	euo.mutation.ClearOfficeLocationID()
+	return euo
+}
+
+// SetTimezone sets the "timezone" field.
+func (euo *EmployeeUpdateOne) SetTimezone(s string) *EmployeeUpdateOne {
+	euo.mutation.SetTimezone(s)
+	return euo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableTimezone(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetTimezone(*s)
+	}
+	return euo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (euo *EmployeeUpdateOne) ClearTimezone() *EmployeeUpdateOne {
+	euo.mutation.ClearTimezone()
+	return euo
+}
+
+// SetDevicePin sets the "device_pin" field.
+func (euo *EmployeeUpdateOne) SetDevicePin(s string) *EmployeeUpdateOne {
+	euo.mutation.SetDevicePin(s)
+	return euo
+}
+
+// SetNillableDevicePin sets the "device_pin" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableDevicePin(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetDevicePin(*s)
+	}
+	return euo
+}
+
+// ClearDevicePin clears the value of the "device_pin" field.
+func (euo *EmployeeUpdateOne) ClearDevicePin() *EmployeeUpdateOne {
+	euo.mutation.ClearDevicePin()
+	return euo
+}
+
+// SetPtkpStatus sets the "ptkp_status" field.
+func (euo *EmployeeUpdateOne) SetPtkpStatus(es employee.PtkpStatus) *EmployeeUpdateOne {
+	euo.mutation.SetPtkpStatus(es)
+	return euo
+}
+
+// SetNillablePtkpStatus sets the "ptkp_status" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillablePtkpStatus(es *employee.PtkpStatus) *EmployeeUpdateOne {
+	if es != nil {
+		euo.SetPtkpStatus(*es)
+	}
+	return euo
+}
+
+// SetNpwp sets the "npwp" field.
+func (euo *EmployeeUpdateOne) SetNpwp(s string) *EmployeeUpdateOne {
+	euo.mutation.SetNpwp(s)
+	return euo
+}
+
+// SetNillableNpwp sets the "npwp" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableNpwp(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetNpwp(*s)
+	}
+	return euo
+}
+
+// ClearNpwp clears the value of the "npwp" field.
+func (euo *EmployeeUpdateOne) ClearNpwp() *EmployeeUpdateOne {
+	euo.mutation.ClearNpwp()
+	return euo
+}
+
+// SetJkkRiskClass sets the "jkk_risk_class" field.
+func (euo *EmployeeUpdateOne) SetJkkRiskClass(i int) *EmployeeUpdateOne {
+	euo.mutation.ResetJkkRiskClass()
+	euo.mutation.SetJkkRiskClass(i)
+	return euo
+}
+
+// SetNillableJkkRiskClass sets the "jkk_risk_class" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableJkkRiskClass(i *int) *EmployeeUpdateOne {
+	if i != nil {
+		euo.SetJkkRiskClass(*i)
+	}
+	return euo
+}
+
+// AddJkkRiskClass adds i to the "jkk_risk_class" field.
+func (euo *EmployeeUpdateOne) AddJkkRiskClass(i int) *EmployeeUpdateOne {
+	euo.mutation.AddJkkRiskClass(i)
+	return euo
+}
+
+// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttendanceIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddAttendanceIDs(ids...)
+	return euo
+}
+
+// AddAttendances adds the "attendances" edges to the Attendance entity.
+func (euo *EmployeeUpdateOne) AddAttendances(a ...*Attendance) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttendanceIDs(ids...)
+}
+
+// AddSalaryCalculationIDs adds the "salary_calculations" edge to the SalaryCalculation entity by IDs.
+func (euo *EmployeeUpdateOne) AddSalaryCalculationIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddSalaryCalculationIDs(ids...)
+	return euo
+}
+
+// AddSalaryCalculations adds the "salary_calculations" edges to the SalaryCalculation entity.
+func (euo *EmployeeUpdateOne) AddSalaryCalculations(s ...*SalaryCalculation) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSalaryCalculationIDs(ids...)
+}
+
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (euo *EmployeeUpdateOne) AddShiftAssignmentIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddShiftAssignmentIDs(ids...)
+	return euo
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (euo *EmployeeUpdateOne) AddShiftAssignments(s ...*ShiftAssignment) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddShiftAssignmentIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (euo *EmployeeUpdateOne) AddLeaveRequestIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddLeaveRequestIDs(ids...)
+	return euo
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (euo *EmployeeUpdateOne) AddLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (euo *EmployeeUpdateOne) AddLeaveBalanceIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddLeaveBalanceIDs(ids...)
+	return euo
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (euo *EmployeeUpdateOne) AddLeaveBalances(l ...*LeaveBalance) *EmployeeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.AddLeaveBalanceIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttendanceCorrectionIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddAttendanceCorrectionIDs(ids...)
+	return euo
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (euo *EmployeeUpdateOne) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddAttendanceAnomalyIDs adds the "attendance_anomalies" edge to the AttendanceAnomaly entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttendanceAnomalyIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddAttendanceAnomalyIDs(ids...)
+	return euo
+}
+
+// AddAttendanceAnomalies adds the "attendance_anomalies" edges to the AttendanceAnomaly entity.
+func (euo *EmployeeUpdateOne) AddAttendanceAnomalies(a ...*AttendanceAnomaly) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttendanceAnomalyIDs(ids...)
+}
+
+// AddOvertimeIDs adds the "overtimes" edge to the Overtime entity by IDs.
+func (euo *EmployeeUpdateOne) AddOvertimeIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddOvertimeIDs(ids...)
+	return euo
+}
+
+// AddOvertimes adds the "overtimes" edges to the Overtime entity.
+func (euo *EmployeeUpdateOne) AddOvertimes(o ...*Overtime) *EmployeeUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return euo.AddOvertimeIDs(ids...)
+}
+
+// AddCalendarFeedIDs adds the "calendar_feeds" edge to the CalendarFeed entity by IDs.
+func (euo *EmployeeUpdateOne) AddCalendarFeedIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddCalendarFeedIDs(ids...)
+	return euo
+}
+
+// AddCalendarFeeds adds the "calendar_feeds" edges to the CalendarFeed entity.
+func (euo *EmployeeUpdateOne) AddCalendarFeeds(c ...*CalendarFeed) *EmployeeUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.AddCalendarFeedIDs(ids...)
+}
+
+// AddSalaryComponentAssignmentIDs adds the "salary_component_assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (euo *EmployeeUpdateOne) AddSalaryComponentAssignmentIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.AddSalaryComponentAssignmentIDs(ids...)
+	return euo
+}
+
+// AddSalaryComponentAssignments adds the "salary_component_assignments" edges to the SalaryComponentAssignment entity.
+func (euo *EmployeeUpdateOne) AddSalaryComponentAssignments(s ...*SalaryComponentAssignment) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSalaryComponentAssignmentIDs(ids...)
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (euo *EmployeeUpdateOne) SetOfficeLocation(o *OfficeLocation) *EmployeeUpdateOne {
+	return euo.SetOfficeLocationID(o.ID)
+}
+
+// Mutation returns the EmployeeMutation object of the builder.
+func (euo *EmployeeUpdateOne) Mutation() *EmployeeMutation {
+	return euo.mutation
+}
+
+// ClearAttendances clears all "attendances" edges to the Attendance entity.
+func (euo *EmployeeUpdateOne) ClearAttendances() *EmployeeUpdateOne {
+	euo.mutation.ClearAttendances()
+	return euo
+}
+
+// RemoveAttendanceIDs removes the "attendances" edge to Attendance entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttendanceIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttendanceIDs(ids...)
+	return euo
+}
+
+// RemoveAttendances removes "attendances" edges to Attendance entities.
+func (euo *EmployeeUpdateOne) RemoveAttendances(a ...*Attendance) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAttendanceIDs(ids...)
+}
+
+// ClearSalaryCalculations clears all "salary_calculations" edges to the SalaryCalculation entity.
+func (euo *EmployeeUpdateOne) ClearSalaryCalculations() *EmployeeUpdateOne {
+	euo.mutation.ClearSalaryCalculations()
+	return euo
+}
+
+// RemoveSalaryCalculationIDs removes the "salary_calculations" edge to SalaryCalculation entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveSalaryCalculationIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveSalaryCalculationIDs(ids...)
+	return euo
+}
+
+// RemoveSalaryCalculations removes "salary_calculations" edges to SalaryCalculation entities.
+func (euo *EmployeeUpdateOne) RemoveSalaryCalculations(s ...*SalaryCalculation) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveSalaryCalculationIDs(ids...)
+}
+
+// ClearShiftAssignments clears all "shift_assignments" edges to the ShiftAssignment entity.
+func (euo *EmployeeUpdateOne) ClearShiftAssignments() *EmployeeUpdateOne {
+	euo.mutation.ClearShiftAssignments()
+	return euo
+}
+
+// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to ShiftAssignment entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveShiftAssignmentIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveShiftAssignmentIDs(ids...)
+	return euo
+}
+
+// RemoveShiftAssignments removes "shift_assignments" edges to ShiftAssignment entities.
+func (euo *EmployeeUpdateOne) RemoveShiftAssignments(s ...*ShiftAssignment) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveShiftAssignmentIDs(ids...)
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (euo *EmployeeUpdateOne) ClearLeaveRequests() *EmployeeUpdateOne {
+	euo.mutation.ClearLeaveRequests()
+	return euo
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveLeaveRequestIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveLeaveRequestIDs(ids...)
+	return euo
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (euo *EmployeeUpdateOne) RemoveLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
+	return euo.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearLeaveBalances clears all "leave_balances" edges to the LeaveBalance entity.
+func (euo *EmployeeUpdateOne) ClearLeaveBalances() *EmployeeUpdateOne {
+	euo.mutation.ClearLeaveBalances()
+	return euo
+}
+
+// RemoveLeaveBalanceIDs removes the "leave_balances" edge to LeaveBalance entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveLeaveBalanceIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveLeaveBalanceIDs(ids...)
 	return euo
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (euo *EmployeeUpdateOne) ClearDeletedAt() *EmployeeUpdateOne {
-	euo.mutation.ClearDeletedAt()
+// RemoveLeaveBalances removes "leave_balances" edges to LeaveBalance entities.
+func (euo *EmployeeUpdateOne) RemoveLeaveBalances(l ...*LeaveBalance) *EmployeeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.RemoveLeaveBalanceIDs(ids...)
+}
+
+// ClearAttendanceCorrections clears all "attendance_corrections" edges to the AttendanceCorrection entity.
+func (euo *EmployeeUpdateOne) ClearAttendanceCorrections() *EmployeeUpdateOne {
+	euo.mutation.ClearAttendanceCorrections()
 	return euo
 }
 
-// SetEmployeeID sets the "employee_id" field.
-func (euo *EmployeeUpdateOne) SetEmployeeID(s string) *EmployeeUpdateOne {
-	euo.mutation.SetEmployeeID(s)
+// RemoveAttendanceCorrectionIDs removes the "attendance_corrections" edge to AttendanceCorrection entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttendanceCorrectionIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttendanceCorrectionIDs(ids...)
 	return euo
 }
 
-// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableEmployeeID(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetEmployeeID(*s)
+// RemoveAttendanceCorrections removes "attendance_corrections" edges to AttendanceCorrection entities.
+func (euo *EmployeeUpdateOne) RemoveAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return euo
+	return euo.RemoveAttendanceCorrectionIDs(ids...)
 }
 
-// ClearEmployeeID clears the value of the "employee_id" field.
-func (euo *EmployeeUpdateOne) ClearEmployeeID() *EmployeeUpdateOne {
-	euo.mutation.ClearEmployeeID()
+// ClearAttendanceAnomalies clears all "attendance_anomalies" edges to the AttendanceAnomaly entity.
+func (euo *EmployeeUpdateOne) ClearAttendanceAnomalies() *EmployeeUpdateOne {
+	euo.mutation.ClearAttendanceAnomalies()
 	return euo
 }
 
-// SetFullName sets the "full_name" field.
-func (euo *EmployeeUpdateOne) SetFullName(s string) *EmployeeUpdateOne {
-	euo.mutation.SetFullName(s)
+// RemoveAttendanceAnomalyIDs removes the "attendance_anomalies" edge to AttendanceAnomaly entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttendanceAnomalyIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttendanceAnomalyIDs(ids...)
 	return euo
 }
 
-// SetEmail sets the "email" field.
-func (euo *EmployeeUpdateOne) SetEmail(s string) *EmployeeUpdateOne {
-	euo.mutation.SetEmail(s)
+// RemoveAttendanceAnomalies removes "attendance_anomalies" edges to AttendanceAnomaly entities.
+func (euo *EmployeeUpdateOne) RemoveAttendanceAnomalies(a ...*AttendanceAnomaly) *EmployeeUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAttendanceAnomalyIDs(ids...)
+}
+
+// ClearOvertimes clears all "overtimes" edges to the Overtime entity.
+func (euo *EmployeeUpdateOne) ClearOvertimes() *EmployeeUpdateOne {
+	euo.mutation.ClearOvertimes()
 	return euo
 }
 
-// SetPhone sets the "phone" field.
-func (euo *EmployeeUpdateOne) SetPhone(s string) *EmployeeUpdateOne {
-	euo.mutation.SetPhone(s)
+// RemoveOvertimeIDs removes the "overtimes" edge to Overtime entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveOvertimeIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveOvertimeIDs(ids...)
 	return euo
 }
 
-// SetNillablePhone sets the "phone" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillablePhone(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetPhone(*s)
+// RemoveOvertimes removes "overtimes" edges to Overtime entities.
+func (euo *EmployeeUpdateOne) RemoveOvertimes(o ...*Overtime) *EmployeeUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return euo
+	return euo.RemoveOvertimeIDs(ids...)
 }
 
-// ClearPhone clears the value of the "phone" field.
-func (euo *EmployeeUpdateOne) ClearPhone() *EmployeeUpdateOne {
-	euo.mutation.ClearPhone()
+// ClearCalendarFeeds clears all "calendar_feeds" edges to the CalendarFeed entity.
+func (euo *EmployeeUpdateOne) ClearCalendarFeeds() *EmployeeUpdateOne {
+	euo.mutation.ClearCalendarFeeds()
 	return euo
 }
 
-// SetPosition sets the "position" field.
-func (euo *EmployeeUpdateOne) SetPosition(s string) *EmployeeUpdateOne {
-	euo.mutation.SetPosition(s)
+// RemoveCalendarFeedIDs removes the "calendar_feeds" edge to CalendarFeed entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveCalendarFeedIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveCalendarFeedIDs(ids...)
 	return euo
 }
 
-// SetNillablePosition sets the "position" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillablePosition(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetPosition(*s)
+// RemoveCalendarFeeds removes "calendar_feeds" edges to CalendarFeed entities.
+func (euo *EmployeeUpdateOne) RemoveCalendarFeeds(c ...*CalendarFeed) *EmployeeUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return euo
+	return euo.RemoveCalendarFeedIDs(ids...)
 }
 
-// ClearPosition clears the value of the "position" field.
-func (euo *EmployeeUpdateOne) ClearPosition() *EmployeeUpdateOne {
-	euo.mutation.ClearPosition()
+// ClearSalaryComponentAssignments clears all "salary_component_assignments" edges to the SalaryComponentAssignment entity.
+func (euo *EmployeeUpdateOne) ClearSalaryComponentAssignments() *EmployeeUpdateOne {
+	euo.mutation.ClearSalaryComponentAssignments()
 	return euo
 }
 
-// SetDepartment sets the "department" field.
-func (euo *EmployeeUpdateOne) SetDepartment(s string) *EmployeeUpdateOne {
-	euo.mutation.SetDepartment(s)
+// RemoveSalaryComponentAssignmentIDs removes the "salary_component_assignments" edge to SalaryComponentAssignment entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveSalaryComponentAssignmentIDs(ids ...uint64) *EmployeeUpdateOne {
+	euo.mutation.RemoveSalaryComponentAssignmentIDs(ids...)
 	return euo
 }
 
-// SetNillableDepartment sets the "department" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableDepartment(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetDepartment(*s)
+// RemoveSalaryComponentAssignments removes "salary_component_assignments" edges to SalaryComponentAssignment entities.
+func (euo *EmployeeUpdateOne) RemoveSalaryComponentAssignments(s ...*SalaryComponentAssignment) *EmployeeUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return euo
+	return euo.RemoveSalaryComponentAssignmentIDs(ids...)
 }
 
-// ClearDepartment clears the value of the "department" field.
-func (euo *EmployeeUpdateOne) ClearDepartment() *EmployeeUpdateOne {
-	euo.mutation.ClearDepartment()
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (euo *EmployeeUpdateOne) ClearOfficeLocation() *EmployeeUpdateOne {
+	euo.mutation.ClearOfficeLocation()
 	return euo
 }
 
-// SetHireDate sets the "hire_date" field.
-func (euo *EmployeeUpdateOne) SetHireDate(t time.Time) *EmployeeUpdateOne {
-	euo.mutation.SetHireDate(t)
+// Where appends a list predicates to the EmployeeUpdate builder.
+func (euo *EmployeeUpdateOne) Where(ps ...predicate.Employee) *EmployeeUpdateOne {
+	euo.mutation.Where(ps...)
 	return euo
 }
 
-// SetBaseSalary sets the "base_salary" field.
-func (euo *EmployeeUpdateOne) SetBaseSalary(f float64) *EmployeeUpdateOne {
-	euo.mutation.ResetBaseSalary()
-	euo.mutation.SetBaseSalary(f)
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EmployeeUpdateOne) Select(field string, fields ...string) *EmployeeUpdateOne {
+	euo.fields = append([]string{field}, fields...)
 	return euo
 }
 
-// SetNillableBaseSalary sets the "base_salary" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableBaseSalary(f *float64) *EmployeeUpdateOne {
-	if f != nil {
-		euo.SetBaseSalary(*f)
+// Save executes the query and returns the updated Employee entity.
+func (euo *EmployeeUpdateOne) Save(ctx context.Context) (*Employee, error) {
+	euo.defaults()
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EmployeeUpdateOne) SaveX(ctx context.Context) *Employee {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
 	}
-	return euo
+	return node
 }
 
-// AddBaseSalary adds f to the "base_salary" field.
-func (euo *EmployeeUpdateOne) AddBaseSalary(f float64) *EmployeeUpdateOne {
-	euo.mutation.AddBaseSalary(f)
-	return euo
+// Exec executes the query on the entity.
+func (euo *EmployeeUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
 }
 
-// SetIsActive sets the "is_active" field.
-func (euo *EmployeeUpdateOne) SetIsActive(b bool) *EmployeeUpdateOne {
-	euo.mutation.SetIsActive(b)
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EmployeeUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (euo *EmployeeUpdateOne) defaults() {
+	if _, ok := euo.mutation.ModifiedAt(); !ok {
+		v := employee.UpdateDefaultModifiedAt()
+		euo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EmployeeUpdateOne) check() error {
+	if v, ok := euo.mutation.EmployeeID(); ok {
+		if err := employee.EmployeeIDValidator(v); err != nil {
+			return &ValidationError{Name: "employee_id", err: fmt.Errorf(`ent: validator failed for field "Employee.employee_id": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.FullName(); ok {
+		if err := employee.FullNameValidator(v); err != nil {
+			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "Employee.full_name": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Email(); ok {
+		if err := employee.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Employee.email": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Phone(); ok {
+		if err := employee.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Employee.phone": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Position(); ok {
+		if err := employee.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Employee.position": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Department(); ok {
+		if err := employee.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "Employee.department": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Timezone(); ok {
+		if err := employee.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Employee.timezone": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.DevicePin(); ok {
+		if err := employee.DevicePinValidator(v); err != nil {
+			return &ValidationError{Name: "device_pin", err: fmt.Errorf(`ent: validator failed for field "Employee.device_pin": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.PtkpStatus(); ok {
+		if err := employee.PtkpStatusValidator(v); err != nil {
+			return &ValidationError{Name: "ptkp_status", err: fmt.Errorf(`ent: validator failed for field "Employee.ptkp_status": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Npwp(); ok {
+		if err := employee.NpwpValidator(v); err != nil {
+			return &ValidationError{Name: "npwp", err: fmt.Errorf(`ent: validator failed for field "Employee.npwp": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.JkkRiskClass(); ok {
+		if err := employee.JkkRiskClassValidator(v); err != nil {
+			return &ValidationError{Name: "jkk_risk_class", err: fmt.Errorf(`ent: validator failed for field "Employee.jkk_risk_class": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (euo *EmployeeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmployeeUpdateOne {
+	euo.modifiers = append(euo.modifiers, modifiers...)
 	return euo
 }
 
-// SetNillableIsActive sets the "is_active" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableIsActive(b *bool) *EmployeeUpdateOne {
-	if b != nil {
-		euo.SetIsActive(*b)
+func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employee.Table, employee.Columns, sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Employee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employee.FieldID)
+		for _, f := range fields {
+			if !employee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != employee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.ModifiedAt(); ok {
+		_spec.SetField(employee.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.DeletedAt(); ok {
+		_spec.SetField(employee.FieldDeletedAt, field.TypeTime, value)
+	}
+	if euo.mutation.DeletedAtCleared() {
+		_spec.ClearField(employee.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.EmployeeID(); ok {
+		_spec.SetField(employee.FieldEmployeeID, field.TypeString, value)
+	}
+	if euo.mutation.EmployeeIDCleared() {
+		_spec.ClearField(employee.FieldEmployeeID, field.TypeString)
+	}
+	if value, ok := euo.mutation.FullName(); ok {
+		_spec.SetField(employee.FieldFullName, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Email(); ok {
+		_spec.SetField(employee.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Phone(); ok {
+		_spec.SetField(employee.FieldPhone, field.TypeString, value)
+	}
+	if euo.mutation.PhoneCleared() {
+		_spec.ClearField(employee.FieldPhone, field.TypeString)
+	}
+	if value, ok := euo.mutation.Position(); ok {
+		_spec.SetField(employee.FieldPosition, field.TypeString, value)
+	}
+	if euo.mutation.PositionCleared() {
+		_spec.ClearField(employee.FieldPosition, field.TypeString)
+	}
+	if value, ok := euo.mutation.Department(); ok {
+		_spec.SetField(employee.FieldDepartment, field.TypeString, value)
+	}
+	if euo.mutation.DepartmentCleared() {
+		_spec.ClearField(employee.FieldDepartment, field.TypeString)
+	}
+	if value, ok := euo.mutation.HireDate(); ok {
+		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.BaseSalary(); ok {
+		_spec.SetField(employee.FieldBaseSalary, field.TypeOther, value)
+	}
+	if value, ok := euo.mutation.IsActive(); ok {
+		_spec.SetField(employee.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := euo.mutation.Timezone(); ok {
+		_spec.SetField(employee.FieldTimezone, field.TypeString, value)
+	}
+	if euo.mutation.TimezoneCleared() {
+		_spec.ClearField(employee.FieldTimezone, field.TypeString)
+	}
+	if value, ok := euo.mutation.DevicePin(); ok {
+		_spec.SetField(employee.FieldDevicePin, field.TypeString, value)
+	}
+	if euo.mutation.DevicePinCleared() {
+		_spec.ClearField(employee.FieldDevicePin, field.TypeString)
+	}
+	if value, ok := euo.mutation.PtkpStatus(); ok {
+		_spec.SetField(employee.FieldPtkpStatus, field.TypeEnum, value)
+	}
+	if value, ok := euo.mutation.Npwp(); ok {
+		_spec.SetField(employee.FieldNpwp, field.TypeString, value)
+	}
+	if euo.mutation.NpwpCleared() {
+		_spec.ClearField(employee.FieldNpwp, field.TypeString)
+	}
+	if value, ok := euo.mutation.JkkRiskClass(); ok {
+		_spec.SetField(employee.FieldJkkRiskClass, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedJkkRiskClass(); ok {
+		_spec.AddField(employee.FieldJkkRiskClass, field.TypeInt, value)
+	}
+	if euo.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendancesTable,
+			Columns: []string{employee.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo
-}
-
-// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
-func (euo *EmployeeUpdateOne) AddAttendanceIDs(ids ...uint64) *EmployeeUpdateOne {
-	euo.mutation.AddAttendanceIDs(ids...)
-	return euo
-}
-
-// AddAttendances adds the "attendances" edges to the Attendance entity.
-func (euo *EmployeeUpdateOne) AddAttendances(a ...*Attendance) *EmployeeUpdateOne {
-	ids := make([]uint64, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if nodes := euo.mutation.RemovedAttendancesIDs(); len(nodes) > 0 && !euo.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendancesTable,
+			Columns: []string{employee.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddAttendanceIDs(ids...)
-}
-
-// AddSalaryCalculationIDs adds the "salary_calculations" edge to the SalaryCalculation entity by IDs.
-func (euo *EmployeeUpdateOne) AddSalaryCalculationIDs(ids ...uint64) *EmployeeUpdateOne {
-	euo.mutation.AddSalaryCalculationIDs(ids...)
-	return euo
-}
-
-// AddSalaryCalculations adds the "salary_calculations" edges to the SalaryCalculation entity.
-func (euo *EmployeeUpdateOne) AddSalaryCalculations(s ...*SalaryCalculation) *EmployeeUpdateOne {
-	ids := make([]uint64, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if nodes := euo.mutation.AttendancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendancesTable,
+			Columns: []string{employee.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo.AddSalaryCalculationIDs(ids...)
-}
-
-// Mutation returns the EmployeeMutation object of the builder.
-func (euo *EmployeeUpdateOne) Mutation() *EmployeeMutation {
-	return euo.mutation
-}
-
-// ClearAttendances clears all "attendances" edges to the Attendance entity.
-func (euo *EmployeeUpdateOne) ClearAttendances() *EmployeeUpdateOne {
-	euo.mutation.ClearAttendances()
-	return euo
-}
-
-// RemoveAttendanceIDs removes the "attendances" edge to Attendance entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveAttendanceIDs(ids ...uint64) *EmployeeUpdateOne {
-	euo.mutation.RemoveAttendanceIDs(ids...)
-	return euo
-}
-
-// RemoveAttendances removes "attendances" edges to Attendance entities.
-func (euo *EmployeeUpdateOne) RemoveAttendances(a ...*Attendance) *EmployeeUpdateOne {
-	ids := make([]uint64, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if euo.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryCalculationsTable,
+			Columns: []string{employee.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveAttendanceIDs(ids...)
-}
-
-// ClearSalaryCalculations clears all "salary_calculations" edges to the SalaryCalculation entity.
-func (euo *EmployeeUpdateOne) ClearSalaryCalculations() *EmployeeUpdateOne {
-	euo.mutation.ClearSalaryCalculations()
-	return euo
-}
-
-// RemoveSalaryCalculationIDs removes the "salary_calculations" edge to SalaryCalculation entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveSalaryCalculationIDs(ids ...uint64) *EmployeeUpdateOne {
-	euo.mutation.RemoveSalaryCalculationIDs(ids...)
-	return euo
-}
-
-// RemoveSalaryCalculations removes "salary_calculations" edges to SalaryCalculation entities.
-func (euo *EmployeeUpdateOne) RemoveSalaryCalculations(s ...*SalaryCalculation) *EmployeeUpdateOne {
-	ids := make([]uint64, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if nodes := euo.mutation.RemovedSalaryCalculationsIDs(); len(nodes) > 0 && !euo.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryCalculationsTable,
+			Columns: []string{employee.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveSalaryCalculationIDs(ids...)
-}
-
-// Where appends a list predicates to the EmployeeUpdate builder.
-func (euo *EmployeeUpdateOne) Where(ps ...predicate.Employee) *EmployeeUpdateOne {
-	euo.mutation.Where(ps...)
-	return euo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (euo *EmployeeUpdateOne) Select(field string, fields ...string) *EmployeeUpdateOne {
-	euo.fields = append([]string{field}, fields...)
-	return euo
-}
-
-// Save executes the query and returns the updated Employee entity.
-func (euo *EmployeeUpdateOne) Save(ctx context.Context) (*Employee, error) {
-	euo.defaults()
-	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (euo *EmployeeUpdateOne) SaveX(ctx context.Context) *Employee {
-	node, err := euo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := euo.mutation.SalaryCalculationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.SalaryCalculationsTable,
+			Columns: []string{employee.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (euo *EmployeeUpdateOne) Exec(ctx context.Context) error {
-	_, err := euo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (euo *EmployeeUpdateOne) ExecX(ctx context.Context) {
-	if err := euo.Exec(ctx); err != nil {
-		panic(err)
+	if euo.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (euo *EmployeeUpdateOne) defaults() {
-	if _, ok := euo.mutation.ModifiedAt(); !ok {
-		v := employee.UpdateDefaultModifiedAt()
-		euo.mutation.SetModifiedAt(v)
+	if nodes := euo.mutation.RemovedShiftAssignmentsIDs(); len(nodes) > 0 && !euo.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (euo *EmployeeUpdateOne) check() error {
-	if v, ok := euo.mutation.EmployeeID(); ok {
-		if err := employee.EmployeeIDValidator(v); err != nil {
-			return &ValidationError{Name: "employee_id", err: fmt.Errorf(`ent: validator failed for field "Employee.employee_id": %w`, err)}
+	if nodes := euo.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ShiftAssignmentsTable,
+			Columns: []string{employee.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := euo.mutation.FullName(); ok {
-		if err := employee.FullNameValidator(v); err != nil {
-			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "Employee.full_name": %w`, err)}
+	if euo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !euo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := euo.mutation.Email(); ok {
-		if err := employee.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Employee.email": %w`, err)}
+	if nodes := euo.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
 		}
-	}
-	if v, ok := euo.mutation.Phone(); ok {
-		if err := employee.PhoneValidator(v); err != nil {
-			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Employee.phone": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := euo.mutation.Position(); ok {
-		if err := employee.PositionValidator(v); err != nil {
-			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Employee.position": %w`, err)}
+	if euo.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := euo.mutation.Department(); ok {
-		if err := employee.DepartmentValidator(v); err != nil {
-			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "Employee.department": %w`, err)}
+	if nodes := euo.mutation.RemovedLeaveBalancesIDs(); len(nodes) > 0 && !euo.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
 		}
-	}
-	return nil
-}
-
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (euo *EmployeeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmployeeUpdateOne {
-	euo.modifiers = append(euo.modifiers, modifiers...)
-	return euo
-}
-
-func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err error) {
-	if err := euo.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(employee.Table, employee.Columns, sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64))
-	id, ok := euo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Employee.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := euo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, employee.FieldID)
-		for _, f := range fields {
-			if !employee.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != employee.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := euo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := euo.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveBalancesTable,
+			Columns: []string{employee.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := euo.mutation.ModifiedAt(); ok {
-		_spec.SetField(employee.FieldModifiedAt, field.TypeTime, value)
-	}
-	if value, ok := euo.mutation.DeletedAt(); ok {
-		_spec.SetField(employee.FieldDeletedAt, field.TypeTime, value)
-	}
-	if euo.mutation.DeletedAtCleared() {
-		_spec.ClearField(employee.FieldDeletedAt, field.TypeTime)
-	}
-	if value, ok := euo.mutation.EmployeeID(); ok {
-		_spec.SetField(employee.FieldEmployeeID, field.TypeString, value)
-	}
-	if euo.mutation.EmployeeIDCleared() {
-		_spec.ClearField(employee.FieldEmployeeID, field.TypeString)
-	}
-	if value, ok := euo.mutation.FullName(); ok {
-		_spec.SetField(employee.FieldFullName, field.TypeString, value)
-	}
-	if value, ok := euo.mutation.Email(); ok {
-		_spec.SetField(employee.FieldEmail, field.TypeString, value)
-	}
-	if value, ok := euo.mutation.Phone(); ok {
-		_spec.SetField(employee.FieldPhone, field.TypeString, value)
-	}
-	if euo.mutation.PhoneCleared() {
-		_spec.ClearField(employee.FieldPhone, field.TypeString)
+	if euo.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.Position(); ok {
-		_spec.SetField(employee.FieldPosition, field.TypeString, value)
+	if nodes := euo.mutation.RemovedAttendanceCorrectionsIDs(); len(nodes) > 0 && !euo.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if euo.mutation.PositionCleared() {
-		_spec.ClearField(employee.FieldPosition, field.TypeString)
+	if nodes := euo.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := euo.mutation.Department(); ok {
-		_spec.SetField(employee.FieldDepartment, field.TypeString, value)
+	if euo.mutation.AttendanceAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if euo.mutation.DepartmentCleared() {
-		_spec.ClearField(employee.FieldDepartment, field.TypeString)
+	if nodes := euo.mutation.RemovedAttendanceAnomaliesIDs(); len(nodes) > 0 && !euo.mutation.AttendanceAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.HireDate(); ok {
-		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
+	if nodes := euo.mutation.AttendanceAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceAnomaliesTable,
+			Columns: []string{employee.AttendanceAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceanomaly.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := euo.mutation.BaseSalary(); ok {
-		_spec.SetField(employee.FieldBaseSalary, field.TypeFloat64, value)
+	if euo.mutation.OvertimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.AddedBaseSalary(); ok {
-		_spec.AddField(employee.FieldBaseSalary, field.TypeFloat64, value)
+	if nodes := euo.mutation.RemovedOvertimesIDs(); len(nodes) > 0 && !euo.mutation.OvertimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.IsActive(); ok {
-		_spec.SetField(employee.FieldIsActive, field.TypeBool, value)
+	if nodes := euo.mutation.OvertimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimesTable,
+			Columns: []string{employee.OvertimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.AttendancesCleared() {
+	if euo.mutation.CalendarFeedsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AttendancesTable,
-			Columns: []string{employee.AttendancesColumn},
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedAttendancesIDs(); len(nodes) > 0 && !euo.mutation.AttendancesCleared() {
+	if nodes := euo.mutation.RemovedCalendarFeedsIDs(); len(nodes) > 0 && !euo.mutation.CalendarFeedsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AttendancesTable,
-			Columns: []string{employee.AttendancesColumn},
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -954,15 +2625,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.AttendancesIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.CalendarFeedsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AttendancesTable,
-			Columns: []string{employee.AttendancesColumn},
+			Table:   employee.CalendarFeedsTable,
+			Columns: []string{employee.CalendarFeedsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(calendarfeed.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -970,28 +2641,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.SalaryCalculationsCleared() {
+	if euo.mutation.SalaryComponentAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.SalaryCalculationsTable,
-			Columns: []string{employee.SalaryCalculationsColumn},
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedSalaryCalculationsIDs(); len(nodes) > 0 && !euo.mutation.SalaryCalculationsCleared() {
+	if nodes := euo.mutation.RemovedSalaryComponentAssignmentsIDs(); len(nodes) > 0 && !euo.mutation.SalaryComponentAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.SalaryCalculationsTable,
-			Columns: []string{employee.SalaryCalculationsColumn},
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -999,15 +2670,44 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.SalaryCalculationsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.SalaryComponentAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.SalaryCalculationsTable,
-			Columns: []string{employee.SalaryCalculationsColumn},
+			Table:   employee.SalaryComponentAssignmentsTable,
+			Columns: []string{employee.SalaryComponentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.OfficeLocationTable,
+			Columns: []string{employee.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.OfficeLocationTable,
+			Columns: []string{employee.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
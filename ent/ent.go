@@ -7,11 +7,35 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
 	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
 	"mceasy/ent/role"
 	"mceasy/ent/roleuser"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 	"mceasy/ent/user"
+	"mceasy/ent/workschedule"
 	"reflect"
 	"sync"
 
@@ -78,12 +102,36 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attendance.Table:        attendance.ValidColumn,
-			employee.Table:          employee.ValidColumn,
-			role.Table:              role.ValidColumn,
-			roleuser.Table:          roleuser.ValidColumn,
-			salarycalculation.Table: salarycalculation.ValidColumn,
-			user.Table:              user.ValidColumn,
+			attendance.Table:                attendance.ValidColumn,
+			attendanceanomaly.Table:         attendanceanomaly.ValidColumn,
+			attendancecorrection.Table:      attendancecorrection.ValidColumn,
+			attendancepaypolicy.Table:       attendancepaypolicy.ValidColumn,
+			attendancepunch.Table:           attendancepunch.ValidColumn,
+			bpjscontributionrate.Table:      bpjscontributionrate.ValidColumn,
+			calendarfeed.Table:              calendarfeed.ValidColumn,
+			device.Table:                    device.ValidColumn,
+			devicepunchrejection.Table:      devicepunchrejection.ValidColumn,
+			employee.Table:                  employee.ValidColumn,
+			holiday.Table:                   holiday.ValidColumn,
+			kiosk.Table:                     kiosk.ValidColumn,
+			leavebalance.Table:              leavebalance.ValidColumn,
+			leaverequest.Table:              leaverequest.ValidColumn,
+			leavetype.Table:                 leavetype.ValidColumn,
+			officelocation.Table:            officelocation.ValidColumn,
+			overtime.Table:                  overtime.ValidColumn,
+			payrollperiod.Table:             payrollperiod.ValidColumn,
+			payrollperiodevent.Table:        payrollperiodevent.ValidColumn,
+			payrollrun.Table:                payrollrun.ValidColumn,
+			payrollrunevent.Table:           payrollrunevent.ValidColumn,
+			role.Table:                      role.ValidColumn,
+			roleuser.Table:                  roleuser.ValidColumn,
+			salarycalculation.Table:         salarycalculation.ValidColumn,
+			salarycalculationitem.Table:     salarycalculationitem.ValidColumn,
+			salarycomponent.Table:           salarycomponent.ValidColumn,
+			salarycomponentassignment.Table: salarycomponentassignment.ValidColumn,
+			shiftassignment.Table:           shiftassignment.ValidColumn,
+			user.Table:                      user.ValidColumn,
+			workschedule.Table:              workschedule.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/holiday"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Holiday is the model entity for the Holiday schema.
+type Holiday struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Date of the holiday
+	HolidayDate time.Time `json:"holiday_date,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// National public holiday, collective leave (cuti bersama) or company holiday
+	HolidayType holiday.HolidayType `json:"holiday_type,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holiday) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID:
+			values[i] = new(sql.NullInt64)
+		case holiday.FieldName, holiday.FieldHolidayType, holiday.FieldDescription:
+			values[i] = new(sql.NullString)
+		case holiday.FieldCreatedAt, holiday.FieldModifiedAt, holiday.FieldDeletedAt, holiday.FieldHolidayDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holiday fields.
+func (h *Holiday) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			h.ID = uint64(value.Int64)
+		case holiday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				h.CreatedAt = value.Time
+			}
+		case holiday.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				h.ModifiedAt = value.Time
+			}
+		case holiday.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				h.DeletedAt = value.Time
+			}
+		case holiday.FieldHolidayDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field holiday_date", values[i])
+			} else if value.Valid {
+				h.HolidayDate = value.Time
+			}
+		case holiday.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				h.Name = value.String
+			}
+		case holiday.FieldHolidayType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holiday_type", values[i])
+			} else if value.Valid {
+				h.HolidayType = holiday.HolidayType(value.String)
+			}
+		case holiday.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				h.Description = value.String
+			}
+		default:
+			h.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holiday.
+// This includes values selected through modifiers, order, etc.
+func (h *Holiday) Value(name string) (ent.Value, error) {
+	return h.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Holiday.
+// Note that you need to call Holiday.Unwrap() before calling this method if this Holiday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Holiday) Update() *HolidayUpdateOne {
+	return NewHolidayClient(h.config).UpdateOne(h)
+}
+
+// Unwrap unwraps the Holiday entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (h *Holiday) Unwrap() *Holiday {
+	_tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holiday is not a transactional entity")
+	}
+	h.config.driver = _tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Holiday) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holiday(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", h.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(h.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(h.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(h.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("holiday_date=")
+	builder.WriteString(h.HolidayDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(h.Name)
+	builder.WriteString(", ")
+	builder.WriteString("holiday_type=")
+	builder.WriteString(fmt.Sprintf("%v", h.HolidayType))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(h.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holidays is a parsable slice of Holiday.
+type Holidays []*Holiday
@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holiday
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the holiday type in the database.
+	Label = "holiday"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldHolidayDate holds the string denoting the holiday_date field in the database.
+	FieldHolidayDate = "holiday_date"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldHolidayType holds the string denoting the holiday_type field in the database.
+	FieldHolidayType = "holiday_type"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// Table holds the table name of the holiday in the database.
+	Table = "holidays"
+)
+
+// Columns holds all SQL columns for holiday fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldHolidayDate,
+	FieldName,
+	FieldHolidayType,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// HolidayType defines the type for the "holiday_type" enum field.
+type HolidayType string
+
+// HolidayTypeNational is the default value of the HolidayType enum.
+const DefaultHolidayType = HolidayTypeNational
+
+// HolidayType values.
+const (
+	HolidayTypeNational    HolidayType = "national"
+	HolidayTypeCutiBersama HolidayType = "cuti_bersama"
+	HolidayTypeCompany     HolidayType = "company"
+)
+
+func (ht HolidayType) String() string {
+	return string(ht)
+}
+
+// HolidayTypeValidator is a validator for the "holiday_type" field enum values. It is called by the builders before save.
+func HolidayTypeValidator(ht HolidayType) error {
+	switch ht {
+	case HolidayTypeNational, HolidayTypeCutiBersama, HolidayTypeCompany:
+		return nil
+	default:
+		return fmt.Errorf("holiday: invalid enum value for holiday_type field: %q", ht)
+	}
+}
+
+// OrderOption defines the ordering options for the Holiday queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByHolidayDate orders the results by the holiday_date field.
+func ByHolidayDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolidayDate, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByHolidayType orders the results by the holiday_type field.
+func ByHolidayType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolidayType, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
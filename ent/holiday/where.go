@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holiday
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// HolidayDate applies equality check predicate on the "holiday_date" field. It's identical to HolidayDateEQ.
+func HolidayDate(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldHolidayDate, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HolidayDateEQ applies the EQ predicate on the "holiday_date" field.
+func HolidayDateEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldHolidayDate, v))
+}
+
+// HolidayDateNEQ applies the NEQ predicate on the "holiday_date" field.
+func HolidayDateNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldHolidayDate, v))
+}
+
+// HolidayDateIn applies the In predicate on the "holiday_date" field.
+func HolidayDateIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldHolidayDate, vs...))
+}
+
+// HolidayDateNotIn applies the NotIn predicate on the "holiday_date" field.
+func HolidayDateNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldHolidayDate, vs...))
+}
+
+// HolidayDateGT applies the GT predicate on the "holiday_date" field.
+func HolidayDateGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldHolidayDate, v))
+}
+
+// HolidayDateGTE applies the GTE predicate on the "holiday_date" field.
+func HolidayDateGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldHolidayDate, v))
+}
+
+// HolidayDateLT applies the LT predicate on the "holiday_date" field.
+func HolidayDateLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldHolidayDate, v))
+}
+
+// HolidayDateLTE applies the LTE predicate on the "holiday_date" field.
+func HolidayDateLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldHolidayDate, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContainsFold(FieldName, v))
+}
+
+// HolidayTypeEQ applies the EQ predicate on the "holiday_type" field.
+func HolidayTypeEQ(v HolidayType) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldHolidayType, v))
+}
+
+// HolidayTypeNEQ applies the NEQ predicate on the "holiday_type" field.
+func HolidayTypeNEQ(v HolidayType) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldHolidayType, v))
+}
+
+// HolidayTypeIn applies the In predicate on the "holiday_type" field.
+func HolidayTypeIn(vs ...HolidayType) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldHolidayType, vs...))
+}
+
+// HolidayTypeNotIn applies the NotIn predicate on the "holiday_type" field.
+func HolidayTypeNotIn(vs ...HolidayType) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldHolidayType, vs...))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/holiday"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HolidayCreate is the builder for creating a Holiday entity.
+type HolidayCreate struct {
+	config
+	mutation *HolidayMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hc *HolidayCreate) SetCreatedAt(t time.Time) *HolidayCreate {
+	hc.mutation.SetCreatedAt(t)
+	return hc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableCreatedAt(t *time.Time) *HolidayCreate {
+	if t != nil {
+		hc.SetCreatedAt(*t)
+	}
+	return hc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (hc *HolidayCreate) SetModifiedAt(t time.Time) *HolidayCreate {
+	hc.mutation.SetModifiedAt(t)
+	return hc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableModifiedAt(t *time.Time) *HolidayCreate {
+	if t != nil {
+		hc.SetModifiedAt(*t)
+	}
+	return hc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (hc *HolidayCreate) SetDeletedAt(t time.Time) *HolidayCreate {
+	hc.mutation.SetDeletedAt(t)
+	return hc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableDeletedAt(t *time.Time) *HolidayCreate {
+	if t != nil {
+		hc.SetDeletedAt(*t)
+	}
+	return hc
+}
+
+// SetHolidayDate sets the "holiday_date" field.
+func (hc *HolidayCreate) SetHolidayDate(t time.Time) *HolidayCreate {
+	hc.mutation.SetHolidayDate(t)
+	return hc
+}
+
+// SetName sets the "name" field.
+func (hc *HolidayCreate) SetName(s string) *HolidayCreate {
+	hc.mutation.SetName(s)
+	return hc
+}
+
+// SetHolidayType sets the "holiday_type" field.
+func (hc *HolidayCreate) SetHolidayType(ht holiday.HolidayType) *HolidayCreate {
+	hc.mutation.SetHolidayType(ht)
+	return hc
+}
+
+// SetNillableHolidayType sets the "holiday_type" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableHolidayType(ht *holiday.HolidayType) *HolidayCreate {
+	if ht != nil {
+		hc.SetHolidayType(*ht)
+	}
+	return hc
+}
+
+// SetDescription sets the "description" field.
+func (hc *HolidayCreate) SetDescription(s string) *HolidayCreate {
+	hc.mutation.SetDescription(s)
+	return hc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableDescription(s *string) *HolidayCreate {
+	if s != nil {
+		hc.SetDescription(*s)
+	}
+	return hc
+}
+
+// SetID sets the "id" field.
+func (hc *HolidayCreate) SetID(u uint64) *HolidayCreate {
+	hc.mutation.SetID(u)
+	return hc
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hc *HolidayCreate) Mutation() *HolidayMutation {
+	return hc.mutation
+}
+
+// Save creates the Holiday in the database.
+func (hc *HolidayCreate) Save(ctx context.Context) (*Holiday, error) {
+	hc.defaults()
+	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HolidayCreate) SaveX(ctx context.Context) *Holiday {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hc *HolidayCreate) Exec(ctx context.Context) error {
+	_, err := hc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hc *HolidayCreate) ExecX(ctx context.Context) {
+	if err := hc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hc *HolidayCreate) defaults() {
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		v := holiday.DefaultCreatedAt()
+		hc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := hc.mutation.ModifiedAt(); !ok {
+		v := holiday.DefaultModifiedAt()
+		hc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := hc.mutation.HolidayType(); !ok {
+		v := holiday.DefaultHolidayType
+		hc.mutation.SetHolidayType(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hc *HolidayCreate) check() error {
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Holiday.created_at"`)}
+	}
+	if _, ok := hc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "Holiday.modified_at"`)}
+	}
+	if _, ok := hc.mutation.HolidayDate(); !ok {
+		return &ValidationError{Name: "holiday_date", err: errors.New(`ent: missing required field "Holiday.holiday_date"`)}
+	}
+	if _, ok := hc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Holiday.name"`)}
+	}
+	if v, ok := hc.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _, ok := hc.mutation.HolidayType(); !ok {
+		return &ValidationError{Name: "holiday_type", err: errors.New(`ent: missing required field "Holiday.holiday_type"`)}
+	}
+	if v, ok := hc.mutation.HolidayType(); ok {
+		if err := holiday.HolidayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_type", err: fmt.Errorf(`ent: validator failed for field "Holiday.holiday_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (hc *HolidayCreate) sqlSave(ctx context.Context) (*Holiday, error) {
+	if err := hc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	hc.mutation.id = &_node.ID
+	hc.mutation.done = true
+	return _node, nil
+}
+
+func (hc *HolidayCreate) createSpec() (*Holiday, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holiday{config: hc.config}
+		_spec = sqlgraph.NewCreateSpec(holiday.Table, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeUint64))
+	)
+	if id, ok := hc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := hc.mutation.CreatedAt(); ok {
+		_spec.SetField(holiday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hc.mutation.ModifiedAt(); ok {
+		_spec.SetField(holiday.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := hc.mutation.DeletedAt(); ok {
+		_spec.SetField(holiday.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := hc.mutation.HolidayDate(); ok {
+		_spec.SetField(holiday.FieldHolidayDate, field.TypeTime, value)
+		_node.HolidayDate = value
+	}
+	if value, ok := hc.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := hc.mutation.HolidayType(); ok {
+		_spec.SetField(holiday.FieldHolidayType, field.TypeEnum, value)
+		_node.HolidayType = value
+	}
+	if value, ok := hc.mutation.Description(); ok {
+		_spec.SetField(holiday.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	return _node, _spec
+}
+
+// HolidayCreateBulk is the builder for creating many Holiday entities in bulk.
+type HolidayCreateBulk struct {
+	config
+	builders []*HolidayCreate
+}
+
+// Save creates the Holiday entities in the database.
+func (hcb *HolidayCreateBulk) Save(ctx context.Context) ([]*Holiday, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(hcb.builders))
+	nodes := make([]*Holiday, len(hcb.builders))
+	mutators := make([]Mutator, len(hcb.builders))
+	for i := range hcb.builders {
+		func(i int, root context.Context) {
+			builder := hcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HolidayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hcb *HolidayCreateBulk) SaveX(ctx context.Context) []*Holiday {
+	v, err := hcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hcb *HolidayCreateBulk) Exec(ctx context.Context) error {
+	_, err := hcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hcb *HolidayCreateBulk) ExecX(ctx context.Context) {
+	if err := hcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/holiday"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HolidayUpdate is the builder for updating Holiday entities.
+type HolidayUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *HolidayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (hu *HolidayUpdate) Where(ps ...predicate.Holiday) *HolidayUpdate {
+	hu.mutation.Where(ps...)
+	return hu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (hu *HolidayUpdate) SetModifiedAt(t time.Time) *HolidayUpdate {
+	hu.mutation.SetModifiedAt(t)
+	return hu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (hu *HolidayUpdate) SetDeletedAt(t time.Time) *HolidayUpdate {
+	hu.mutation.SetDeletedAt(t)
+	return hu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableDeletedAt(t *time.Time) *HolidayUpdate {
+	if t != nil {
+		hu.SetDeletedAt(*t)
+	}
+	return hu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (hu *HolidayUpdate) ClearDeletedAt() *HolidayUpdate {
+	hu.mutation.ClearDeletedAt()
+	return hu
+}
+
+// SetHolidayDate sets the "holiday_date" field.
+func (hu *HolidayUpdate) SetHolidayDate(t time.Time) *HolidayUpdate {
+	hu.mutation.SetHolidayDate(t)
+	return hu
+}
+
+// SetName sets the "name" field.
+func (hu *HolidayUpdate) SetName(s string) *HolidayUpdate {
+	hu.mutation.SetName(s)
+	return hu
+}
+
+// SetHolidayType sets the "holiday_type" field.
+func (hu *HolidayUpdate) SetHolidayType(ht holiday.HolidayType) *HolidayUpdate {
+	hu.mutation.SetHolidayType(ht)
+	return hu
+}
+
+// SetNillableHolidayType sets the "holiday_type" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableHolidayType(ht *holiday.HolidayType) *HolidayUpdate {
+	if ht != nil {
+		hu.SetHolidayType(*ht)
+	}
+	return hu
+}
+
+// SetDescription sets the "description" field.
+func (hu *HolidayUpdate) SetDescription(s string) *HolidayUpdate {
+	hu.mutation.SetDescription(s)
+	return hu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableDescription(s *string) *HolidayUpdate {
+	if s != nil {
+		hu.SetDescription(*s)
+	}
+	return hu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (hu *HolidayUpdate) ClearDescription() *HolidayUpdate {
+	hu.mutation.ClearDescription()
+	return hu
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hu *HolidayUpdate) Mutation() *HolidayMutation {
+	return hu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hu *HolidayUpdate) Save(ctx context.Context) (int, error) {
+	hu.defaults()
+	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hu *HolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := hu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hu *HolidayUpdate) Exec(ctx context.Context) error {
+	_, err := hu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hu *HolidayUpdate) ExecX(ctx context.Context) {
+	if err := hu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hu *HolidayUpdate) defaults() {
+	if _, ok := hu.mutation.ModifiedAt(); !ok {
+		v := holiday.UpdateDefaultModifiedAt()
+		hu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hu *HolidayUpdate) check() error {
+	if v, ok := hu.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if v, ok := hu.mutation.HolidayType(); ok {
+		if err := holiday.HolidayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_type", err: fmt.Errorf(`ent: validator failed for field "Holiday.holiday_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hu *HolidayUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayUpdate {
+	hu.modifiers = append(hu.modifiers, modifiers...)
+	return hu
+}
+
+func (hu *HolidayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeUint64))
+	if ps := hu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hu.mutation.ModifiedAt(); ok {
+		_spec.SetField(holiday.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := hu.mutation.DeletedAt(); ok {
+		_spec.SetField(holiday.FieldDeletedAt, field.TypeTime, value)
+	}
+	if hu.mutation.DeletedAtCleared() {
+		_spec.ClearField(holiday.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := hu.mutation.HolidayDate(); ok {
+		_spec.SetField(holiday.FieldHolidayDate, field.TypeTime, value)
+	}
+	if value, ok := hu.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.HolidayType(); ok {
+		_spec.SetField(holiday.FieldHolidayType, field.TypeEnum, value)
+	}
+	if value, ok := hu.mutation.Description(); ok {
+		_spec.SetField(holiday.FieldDescription, field.TypeString, value)
+	}
+	if hu.mutation.DescriptionCleared() {
+		_spec.ClearField(holiday.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(hu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hu.mutation.done = true
+	return n, nil
+}
+
+// HolidayUpdateOne is the builder for updating a single Holiday entity.
+type HolidayUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *HolidayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (huo *HolidayUpdateOne) SetModifiedAt(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetModifiedAt(t)
+	return huo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (huo *HolidayUpdateOne) SetDeletedAt(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetDeletedAt(t)
+	return huo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableDeletedAt(t *time.Time) *HolidayUpdateOne {
+	if t != nil {
+		huo.SetDeletedAt(*t)
+	}
+	return huo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (huo *HolidayUpdateOne) ClearDeletedAt() *HolidayUpdateOne {
+	huo.mutation.ClearDeletedAt()
+	return huo
+}
+
+// SetHolidayDate sets the "holiday_date" field.
+func (huo *HolidayUpdateOne) SetHolidayDate(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetHolidayDate(t)
+	return huo
+}
+
+// SetName sets the "name" field.
+func (huo *HolidayUpdateOne) SetName(s string) *HolidayUpdateOne {
+	huo.mutation.SetName(s)
+	return huo
+}
+
+// SetHolidayType sets the "holiday_type" field.
+func (huo *HolidayUpdateOne) SetHolidayType(ht holiday.HolidayType) *HolidayUpdateOne {
+	huo.mutation.SetHolidayType(ht)
+	return huo
+}
+
+// SetNillableHolidayType sets the "holiday_type" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableHolidayType(ht *holiday.HolidayType) *HolidayUpdateOne {
+	if ht != nil {
+		huo.SetHolidayType(*ht)
+	}
+	return huo
+}
+
+// SetDescription sets the "description" field.
+func (huo *HolidayUpdateOne) SetDescription(s string) *HolidayUpdateOne {
+	huo.mutation.SetDescription(s)
+	return huo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableDescription(s *string) *HolidayUpdateOne {
+	if s != nil {
+		huo.SetDescription(*s)
+	}
+	return huo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (huo *HolidayUpdateOne) ClearDescription() *HolidayUpdateOne {
+	huo.mutation.ClearDescription()
+	return huo
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (huo *HolidayUpdateOne) Mutation() *HolidayMutation {
+	return huo.mutation
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (huo *HolidayUpdateOne) Where(ps ...predicate.Holiday) *HolidayUpdateOne {
+	huo.mutation.Where(ps...)
+	return huo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (huo *HolidayUpdateOne) Select(field string, fields ...string) *HolidayUpdateOne {
+	huo.fields = append([]string{field}, fields...)
+	return huo
+}
+
+// Save executes the query and returns the updated Holiday entity.
+func (huo *HolidayUpdateOne) Save(ctx context.Context) (*Holiday, error) {
+	huo.defaults()
+	return withHooks(ctx, huo.sqlSave, huo.mutation, huo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (huo *HolidayUpdateOne) SaveX(ctx context.Context) *Holiday {
+	node, err := huo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (huo *HolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := huo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (huo *HolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := huo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (huo *HolidayUpdateOne) defaults() {
+	if _, ok := huo.mutation.ModifiedAt(); !ok {
+		v := holiday.UpdateDefaultModifiedAt()
+		huo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (huo *HolidayUpdateOne) check() error {
+	if v, ok := huo.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if v, ok := huo.mutation.HolidayType(); ok {
+		if err := holiday.HolidayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_type", err: fmt.Errorf(`ent: validator failed for field "Holiday.holiday_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (huo *HolidayUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayUpdateOne {
+	huo.modifiers = append(huo.modifiers, modifiers...)
+	return huo
+}
+
+func (huo *HolidayUpdateOne) sqlSave(ctx context.Context) (_node *Holiday, err error) {
+	if err := huo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeUint64))
+	id, ok := huo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holiday.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := huo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holiday.FieldID)
+		for _, f := range fields {
+			if !holiday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := huo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := huo.mutation.ModifiedAt(); ok {
+		_spec.SetField(holiday.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := huo.mutation.DeletedAt(); ok {
+		_spec.SetField(holiday.FieldDeletedAt, field.TypeTime, value)
+	}
+	if huo.mutation.DeletedAtCleared() {
+		_spec.ClearField(holiday.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := huo.mutation.HolidayDate(); ok {
+		_spec.SetField(holiday.FieldHolidayDate, field.TypeTime, value)
+	}
+	if value, ok := huo.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.HolidayType(); ok {
+		_spec.SetField(holiday.FieldHolidayType, field.TypeEnum, value)
+	}
+	if value, ok := huo.mutation.Description(); ok {
+		_spec.SetField(holiday.FieldDescription, field.TypeString, value)
+	}
+	if huo.mutation.DescriptionCleared() {
+		_spec.ClearField(holiday.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(huo.modifiers...)
+	_node = &Holiday{config: huo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, huo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	huo.mutation.done = true
+	return _node, nil
+}
@@ -20,6 +20,102 @@ func (f AttendanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceMutation", m)
 }
 
+// The AttendanceAnomalyFunc type is an adapter to allow the use of ordinary
+// function as AttendanceAnomaly mutator.
+type AttendanceAnomalyFunc func(context.Context, *ent.AttendanceAnomalyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendanceAnomalyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendanceAnomalyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceAnomalyMutation", m)
+}
+
+// The AttendanceCorrectionFunc type is an adapter to allow the use of ordinary
+// function as AttendanceCorrection mutator.
+type AttendanceCorrectionFunc func(context.Context, *ent.AttendanceCorrectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendanceCorrectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendanceCorrectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceCorrectionMutation", m)
+}
+
+// The AttendancePayPolicyFunc type is an adapter to allow the use of ordinary
+// function as AttendancePayPolicy mutator.
+type AttendancePayPolicyFunc func(context.Context, *ent.AttendancePayPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendancePayPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendancePayPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendancePayPolicyMutation", m)
+}
+
+// The AttendancePunchFunc type is an adapter to allow the use of ordinary
+// function as AttendancePunch mutator.
+type AttendancePunchFunc func(context.Context, *ent.AttendancePunchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendancePunchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendancePunchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendancePunchMutation", m)
+}
+
+// The BpjsContributionRateFunc type is an adapter to allow the use of ordinary
+// function as BpjsContributionRate mutator.
+type BpjsContributionRateFunc func(context.Context, *ent.BpjsContributionRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BpjsContributionRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BpjsContributionRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BpjsContributionRateMutation", m)
+}
+
+// The CalendarFeedFunc type is an adapter to allow the use of ordinary
+// function as CalendarFeed mutator.
+type CalendarFeedFunc func(context.Context, *ent.CalendarFeedMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CalendarFeedFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CalendarFeedMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CalendarFeedMutation", m)
+}
+
+// The DeviceFunc type is an adapter to allow the use of ordinary
+// function as Device mutator.
+type DeviceFunc func(context.Context, *ent.DeviceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
+}
+
+// The DevicePunchRejectionFunc type is an adapter to allow the use of ordinary
+// function as DevicePunchRejection mutator.
+type DevicePunchRejectionFunc func(context.Context, *ent.DevicePunchRejectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DevicePunchRejectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DevicePunchRejectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DevicePunchRejectionMutation", m)
+}
+
 // The EmployeeFunc type is an adapter to allow the use of ordinary
 // function as Employee mutator.
 type EmployeeFunc func(context.Context, *ent.EmployeeMutation) (ent.Value, error)
@@ -32,6 +128,138 @@ func (f EmployeeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmployeeMutation", m)
 }
 
+// The HolidayFunc type is an adapter to allow the use of ordinary
+// function as Holiday mutator.
+type HolidayFunc func(context.Context, *ent.HolidayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HolidayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HolidayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HolidayMutation", m)
+}
+
+// The KioskFunc type is an adapter to allow the use of ordinary
+// function as Kiosk mutator.
+type KioskFunc func(context.Context, *ent.KioskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KioskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KioskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KioskMutation", m)
+}
+
+// The LeaveBalanceFunc type is an adapter to allow the use of ordinary
+// function as LeaveBalance mutator.
+type LeaveBalanceFunc func(context.Context, *ent.LeaveBalanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveBalanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveBalanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveBalanceMutation", m)
+}
+
+// The LeaveRequestFunc type is an adapter to allow the use of ordinary
+// function as LeaveRequest mutator.
+type LeaveRequestFunc func(context.Context, *ent.LeaveRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveRequestMutation", m)
+}
+
+// The LeaveTypeFunc type is an adapter to allow the use of ordinary
+// function as LeaveType mutator.
+type LeaveTypeFunc func(context.Context, *ent.LeaveTypeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveTypeMutation", m)
+}
+
+// The OfficeLocationFunc type is an adapter to allow the use of ordinary
+// function as OfficeLocation mutator.
+type OfficeLocationFunc func(context.Context, *ent.OfficeLocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OfficeLocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OfficeLocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OfficeLocationMutation", m)
+}
+
+// The OvertimeFunc type is an adapter to allow the use of ordinary
+// function as Overtime mutator.
+type OvertimeFunc func(context.Context, *ent.OvertimeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OvertimeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OvertimeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OvertimeMutation", m)
+}
+
+// The PayrollPeriodFunc type is an adapter to allow the use of ordinary
+// function as PayrollPeriod mutator.
+type PayrollPeriodFunc func(context.Context, *ent.PayrollPeriodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayrollPeriodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayrollPeriodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayrollPeriodMutation", m)
+}
+
+// The PayrollPeriodEventFunc type is an adapter to allow the use of ordinary
+// function as PayrollPeriodEvent mutator.
+type PayrollPeriodEventFunc func(context.Context, *ent.PayrollPeriodEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayrollPeriodEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayrollPeriodEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayrollPeriodEventMutation", m)
+}
+
+// The PayrollRunFunc type is an adapter to allow the use of ordinary
+// function as PayrollRun mutator.
+type PayrollRunFunc func(context.Context, *ent.PayrollRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayrollRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayrollRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayrollRunMutation", m)
+}
+
+// The PayrollRunEventFunc type is an adapter to allow the use of ordinary
+// function as PayrollRunEvent mutator.
+type PayrollRunEventFunc func(context.Context, *ent.PayrollRunEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayrollRunEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayrollRunEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayrollRunEventMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -68,6 +296,54 @@ func (f SalaryCalculationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SalaryCalculationMutation", m)
 }
 
+// The SalaryCalculationItemFunc type is an adapter to allow the use of ordinary
+// function as SalaryCalculationItem mutator.
+type SalaryCalculationItemFunc func(context.Context, *ent.SalaryCalculationItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SalaryCalculationItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SalaryCalculationItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SalaryCalculationItemMutation", m)
+}
+
+// The SalaryComponentFunc type is an adapter to allow the use of ordinary
+// function as SalaryComponent mutator.
+type SalaryComponentFunc func(context.Context, *ent.SalaryComponentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SalaryComponentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SalaryComponentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SalaryComponentMutation", m)
+}
+
+// The SalaryComponentAssignmentFunc type is an adapter to allow the use of ordinary
+// function as SalaryComponentAssignment mutator.
+type SalaryComponentAssignmentFunc func(context.Context, *ent.SalaryComponentAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SalaryComponentAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SalaryComponentAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SalaryComponentAssignmentMutation", m)
+}
+
+// The ShiftAssignmentFunc type is an adapter to allow the use of ordinary
+// function as ShiftAssignment mutator.
+type ShiftAssignmentFunc func(context.Context, *ent.ShiftAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShiftAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShiftAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShiftAssignmentMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -80,6 +356,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The WorkScheduleFunc type is an adapter to allow the use of ordinary
+// function as WorkSchedule mutator.
+type WorkScheduleFunc func(context.Context, *ent.WorkScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkScheduleMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -7,12 +7,36 @@ import (
 	"fmt"
 	"mceasy/ent"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
 	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
 	"mceasy/ent/predicate"
 	"mceasy/ent/role"
 	"mceasy/ent/roleuser"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/ent/shiftassignment"
 	"mceasy/ent/user"
+	"mceasy/ent/workschedule"
 
 	"entgo.io/ent/dialect/sql"
 )
@@ -100,6 +124,222 @@ func (f TraverseAttendance) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.AttendanceQuery", q)
 }
 
+// The AttendanceAnomalyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttendanceAnomalyFunc func(context.Context, *ent.AttendanceAnomalyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttendanceAnomalyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttendanceAnomalyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttendanceAnomalyQuery", q)
+}
+
+// The TraverseAttendanceAnomaly type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttendanceAnomaly func(context.Context, *ent.AttendanceAnomalyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttendanceAnomaly) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttendanceAnomaly) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttendanceAnomalyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttendanceAnomalyQuery", q)
+}
+
+// The AttendanceCorrectionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttendanceCorrectionFunc func(context.Context, *ent.AttendanceCorrectionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttendanceCorrectionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttendanceCorrectionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttendanceCorrectionQuery", q)
+}
+
+// The TraverseAttendanceCorrection type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttendanceCorrection func(context.Context, *ent.AttendanceCorrectionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttendanceCorrection) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttendanceCorrection) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttendanceCorrectionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttendanceCorrectionQuery", q)
+}
+
+// The AttendancePayPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttendancePayPolicyFunc func(context.Context, *ent.AttendancePayPolicyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttendancePayPolicyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttendancePayPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttendancePayPolicyQuery", q)
+}
+
+// The TraverseAttendancePayPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttendancePayPolicy func(context.Context, *ent.AttendancePayPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttendancePayPolicy) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttendancePayPolicy) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttendancePayPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttendancePayPolicyQuery", q)
+}
+
+// The AttendancePunchFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttendancePunchFunc func(context.Context, *ent.AttendancePunchQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttendancePunchFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttendancePunchQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttendancePunchQuery", q)
+}
+
+// The TraverseAttendancePunch type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttendancePunch func(context.Context, *ent.AttendancePunchQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttendancePunch) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttendancePunch) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttendancePunchQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttendancePunchQuery", q)
+}
+
+// The BpjsContributionRateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BpjsContributionRateFunc func(context.Context, *ent.BpjsContributionRateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BpjsContributionRateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BpjsContributionRateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BpjsContributionRateQuery", q)
+}
+
+// The TraverseBpjsContributionRate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBpjsContributionRate func(context.Context, *ent.BpjsContributionRateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBpjsContributionRate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBpjsContributionRate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BpjsContributionRateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BpjsContributionRateQuery", q)
+}
+
+// The CalendarFeedFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CalendarFeedFunc func(context.Context, *ent.CalendarFeedQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CalendarFeedFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CalendarFeedQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CalendarFeedQuery", q)
+}
+
+// The TraverseCalendarFeed type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCalendarFeed func(context.Context, *ent.CalendarFeedQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCalendarFeed) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCalendarFeed) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CalendarFeedQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CalendarFeedQuery", q)
+}
+
+// The DeviceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DeviceFunc func(context.Context, *ent.DeviceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DeviceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DeviceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DeviceQuery", q)
+}
+
+// The TraverseDevice type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDevice func(context.Context, *ent.DeviceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDevice) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDevice) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeviceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DeviceQuery", q)
+}
+
+// The DevicePunchRejectionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DevicePunchRejectionFunc func(context.Context, *ent.DevicePunchRejectionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DevicePunchRejectionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DevicePunchRejectionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DevicePunchRejectionQuery", q)
+}
+
+// The TraverseDevicePunchRejection type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDevicePunchRejection func(context.Context, *ent.DevicePunchRejectionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDevicePunchRejection) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDevicePunchRejection) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DevicePunchRejectionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DevicePunchRejectionQuery", q)
+}
+
 // The EmployeeFunc type is an adapter to allow the use of ordinary function as a Querier.
 type EmployeeFunc func(context.Context, *ent.EmployeeQuery) (ent.Value, error)
 
@@ -127,6 +367,303 @@ func (f TraverseEmployee) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.EmployeeQuery", q)
 }
 
+// The HolidayFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HolidayFunc func(context.Context, *ent.HolidayQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f HolidayFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.HolidayQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.HolidayQuery", q)
+}
+
+// The TraverseHoliday type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHoliday func(context.Context, *ent.HolidayQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHoliday) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHoliday) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.HolidayQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.HolidayQuery", q)
+}
+
+// The KioskFunc type is an adapter to allow the use of ordinary function as a Querier.
+type KioskFunc func(context.Context, *ent.KioskQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f KioskFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.KioskQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.KioskQuery", q)
+}
+
+// The TraverseKiosk type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseKiosk func(context.Context, *ent.KioskQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseKiosk) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseKiosk) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.KioskQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.KioskQuery", q)
+}
+
+// The LeaveBalanceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LeaveBalanceFunc func(context.Context, *ent.LeaveBalanceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LeaveBalanceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LeaveBalanceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LeaveBalanceQuery", q)
+}
+
+// The TraverseLeaveBalance type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLeaveBalance func(context.Context, *ent.LeaveBalanceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLeaveBalance) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLeaveBalance) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaveBalanceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LeaveBalanceQuery", q)
+}
+
+// The LeaveRequestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LeaveRequestFunc func(context.Context, *ent.LeaveRequestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LeaveRequestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LeaveRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LeaveRequestQuery", q)
+}
+
+// The TraverseLeaveRequest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLeaveRequest func(context.Context, *ent.LeaveRequestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLeaveRequest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLeaveRequest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaveRequestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LeaveRequestQuery", q)
+}
+
+// The LeaveTypeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LeaveTypeFunc func(context.Context, *ent.LeaveTypeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LeaveTypeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LeaveTypeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LeaveTypeQuery", q)
+}
+
+// The TraverseLeaveType type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLeaveType func(context.Context, *ent.LeaveTypeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLeaveType) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLeaveType) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LeaveTypeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LeaveTypeQuery", q)
+}
+
+// The OfficeLocationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OfficeLocationFunc func(context.Context, *ent.OfficeLocationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OfficeLocationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OfficeLocationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OfficeLocationQuery", q)
+}
+
+// The TraverseOfficeLocation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOfficeLocation func(context.Context, *ent.OfficeLocationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOfficeLocation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOfficeLocation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OfficeLocationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OfficeLocationQuery", q)
+}
+
+// The OvertimeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OvertimeFunc func(context.Context, *ent.OvertimeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OvertimeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OvertimeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OvertimeQuery", q)
+}
+
+// The TraverseOvertime type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOvertime func(context.Context, *ent.OvertimeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOvertime) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOvertime) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OvertimeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OvertimeQuery", q)
+}
+
+// The PayrollPeriodFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PayrollPeriodFunc func(context.Context, *ent.PayrollPeriodQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PayrollPeriodFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PayrollPeriodQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PayrollPeriodQuery", q)
+}
+
+// The TraversePayrollPeriod type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePayrollPeriod func(context.Context, *ent.PayrollPeriodQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePayrollPeriod) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePayrollPeriod) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PayrollPeriodQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PayrollPeriodQuery", q)
+}
+
+// The PayrollPeriodEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PayrollPeriodEventFunc func(context.Context, *ent.PayrollPeriodEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PayrollPeriodEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PayrollPeriodEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PayrollPeriodEventQuery", q)
+}
+
+// The TraversePayrollPeriodEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePayrollPeriodEvent func(context.Context, *ent.PayrollPeriodEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePayrollPeriodEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePayrollPeriodEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PayrollPeriodEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PayrollPeriodEventQuery", q)
+}
+
+// The PayrollRunFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PayrollRunFunc func(context.Context, *ent.PayrollRunQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PayrollRunFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PayrollRunQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PayrollRunQuery", q)
+}
+
+// The TraversePayrollRun type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePayrollRun func(context.Context, *ent.PayrollRunQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePayrollRun) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePayrollRun) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PayrollRunQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PayrollRunQuery", q)
+}
+
+// The PayrollRunEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PayrollRunEventFunc func(context.Context, *ent.PayrollRunEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PayrollRunEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PayrollRunEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PayrollRunEventQuery", q)
+}
+
+// The TraversePayrollRunEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePayrollRunEvent func(context.Context, *ent.PayrollRunEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePayrollRunEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePayrollRunEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PayrollRunEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PayrollRunEventQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *ent.RoleQuery) (ent.Value, error)
 
@@ -208,6 +745,114 @@ func (f TraverseSalaryCalculation) Traverse(ctx context.Context, q ent.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *ent.SalaryCalculationQuery", q)
 }
 
+// The SalaryCalculationItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SalaryCalculationItemFunc func(context.Context, *ent.SalaryCalculationItemQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SalaryCalculationItemFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SalaryCalculationItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SalaryCalculationItemQuery", q)
+}
+
+// The TraverseSalaryCalculationItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSalaryCalculationItem func(context.Context, *ent.SalaryCalculationItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSalaryCalculationItem) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSalaryCalculationItem) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SalaryCalculationItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SalaryCalculationItemQuery", q)
+}
+
+// The SalaryComponentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SalaryComponentFunc func(context.Context, *ent.SalaryComponentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SalaryComponentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SalaryComponentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SalaryComponentQuery", q)
+}
+
+// The TraverseSalaryComponent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSalaryComponent func(context.Context, *ent.SalaryComponentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSalaryComponent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSalaryComponent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SalaryComponentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SalaryComponentQuery", q)
+}
+
+// The SalaryComponentAssignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SalaryComponentAssignmentFunc func(context.Context, *ent.SalaryComponentAssignmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SalaryComponentAssignmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SalaryComponentAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SalaryComponentAssignmentQuery", q)
+}
+
+// The TraverseSalaryComponentAssignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSalaryComponentAssignment func(context.Context, *ent.SalaryComponentAssignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSalaryComponentAssignment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSalaryComponentAssignment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SalaryComponentAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SalaryComponentAssignmentQuery", q)
+}
+
+// The ShiftAssignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ShiftAssignmentFunc func(context.Context, *ent.ShiftAssignmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ShiftAssignmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ShiftAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ShiftAssignmentQuery", q)
+}
+
+// The TraverseShiftAssignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseShiftAssignment func(context.Context, *ent.ShiftAssignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseShiftAssignment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseShiftAssignment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShiftAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ShiftAssignmentQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *ent.UserQuery) (ent.Value, error)
 
@@ -235,21 +880,96 @@ func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
 }
 
+// The WorkScheduleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WorkScheduleFunc func(context.Context, *ent.WorkScheduleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WorkScheduleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WorkScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WorkScheduleQuery", q)
+}
+
+// The TraverseWorkSchedule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWorkSchedule func(context.Context, *ent.WorkScheduleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWorkSchedule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWorkSchedule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WorkScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WorkScheduleQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
 	case *ent.AttendanceQuery:
 		return &query[*ent.AttendanceQuery, predicate.Attendance, attendance.OrderOption]{typ: ent.TypeAttendance, tq: q}, nil
+	case *ent.AttendanceAnomalyQuery:
+		return &query[*ent.AttendanceAnomalyQuery, predicate.AttendanceAnomaly, attendanceanomaly.OrderOption]{typ: ent.TypeAttendanceAnomaly, tq: q}, nil
+	case *ent.AttendanceCorrectionQuery:
+		return &query[*ent.AttendanceCorrectionQuery, predicate.AttendanceCorrection, attendancecorrection.OrderOption]{typ: ent.TypeAttendanceCorrection, tq: q}, nil
+	case *ent.AttendancePayPolicyQuery:
+		return &query[*ent.AttendancePayPolicyQuery, predicate.AttendancePayPolicy, attendancepaypolicy.OrderOption]{typ: ent.TypeAttendancePayPolicy, tq: q}, nil
+	case *ent.AttendancePunchQuery:
+		return &query[*ent.AttendancePunchQuery, predicate.AttendancePunch, attendancepunch.OrderOption]{typ: ent.TypeAttendancePunch, tq: q}, nil
+	case *ent.BpjsContributionRateQuery:
+		return &query[*ent.BpjsContributionRateQuery, predicate.BpjsContributionRate, bpjscontributionrate.OrderOption]{typ: ent.TypeBpjsContributionRate, tq: q}, nil
+	case *ent.CalendarFeedQuery:
+		return &query[*ent.CalendarFeedQuery, predicate.CalendarFeed, calendarfeed.OrderOption]{typ: ent.TypeCalendarFeed, tq: q}, nil
+	case *ent.DeviceQuery:
+		return &query[*ent.DeviceQuery, predicate.Device, device.OrderOption]{typ: ent.TypeDevice, tq: q}, nil
+	case *ent.DevicePunchRejectionQuery:
+		return &query[*ent.DevicePunchRejectionQuery, predicate.DevicePunchRejection, devicepunchrejection.OrderOption]{typ: ent.TypeDevicePunchRejection, tq: q}, nil
 	case *ent.EmployeeQuery:
 		return &query[*ent.EmployeeQuery, predicate.Employee, employee.OrderOption]{typ: ent.TypeEmployee, tq: q}, nil
+	case *ent.HolidayQuery:
+		return &query[*ent.HolidayQuery, predicate.Holiday, holiday.OrderOption]{typ: ent.TypeHoliday, tq: q}, nil
+	case *ent.KioskQuery:
+		return &query[*ent.KioskQuery, predicate.Kiosk, kiosk.OrderOption]{typ: ent.TypeKiosk, tq: q}, nil
+	case *ent.LeaveBalanceQuery:
+		return &query[*ent.LeaveBalanceQuery, predicate.LeaveBalance, leavebalance.OrderOption]{typ: ent.TypeLeaveBalance, tq: q}, nil
+	case *ent.LeaveRequestQuery:
+		return &query[*ent.LeaveRequestQuery, predicate.LeaveRequest, leaverequest.OrderOption]{typ: ent.TypeLeaveRequest, tq: q}, nil
+	case *ent.LeaveTypeQuery:
+		return &query[*ent.LeaveTypeQuery, predicate.LeaveType, leavetype.OrderOption]{typ: ent.TypeLeaveType, tq: q}, nil
+	case *ent.OfficeLocationQuery:
+		return &query[*ent.OfficeLocationQuery, predicate.OfficeLocation, officelocation.OrderOption]{typ: ent.TypeOfficeLocation, tq: q}, nil
+	case *ent.OvertimeQuery:
+		return &query[*ent.OvertimeQuery, predicate.Overtime, overtime.OrderOption]{typ: ent.TypeOvertime, tq: q}, nil
+	case *ent.PayrollPeriodQuery:
+		return &query[*ent.PayrollPeriodQuery, predicate.PayrollPeriod, payrollperiod.OrderOption]{typ: ent.TypePayrollPeriod, tq: q}, nil
+	case *ent.PayrollPeriodEventQuery:
+		return &query[*ent.PayrollPeriodEventQuery, predicate.PayrollPeriodEvent, payrollperiodevent.OrderOption]{typ: ent.TypePayrollPeriodEvent, tq: q}, nil
+	case *ent.PayrollRunQuery:
+		return &query[*ent.PayrollRunQuery, predicate.PayrollRun, payrollrun.OrderOption]{typ: ent.TypePayrollRun, tq: q}, nil
+	case *ent.PayrollRunEventQuery:
+		return &query[*ent.PayrollRunEventQuery, predicate.PayrollRunEvent, payrollrunevent.OrderOption]{typ: ent.TypePayrollRunEvent, tq: q}, nil
 	case *ent.RoleQuery:
 		return &query[*ent.RoleQuery, predicate.Role, role.OrderOption]{typ: ent.TypeRole, tq: q}, nil
 	case *ent.RoleUserQuery:
 		return &query[*ent.RoleUserQuery, predicate.RoleUser, roleuser.OrderOption]{typ: ent.TypeRoleUser, tq: q}, nil
 	case *ent.SalaryCalculationQuery:
 		return &query[*ent.SalaryCalculationQuery, predicate.SalaryCalculation, salarycalculation.OrderOption]{typ: ent.TypeSalaryCalculation, tq: q}, nil
+	case *ent.SalaryCalculationItemQuery:
+		return &query[*ent.SalaryCalculationItemQuery, predicate.SalaryCalculationItem, salarycalculationitem.OrderOption]{typ: ent.TypeSalaryCalculationItem, tq: q}, nil
+	case *ent.SalaryComponentQuery:
+		return &query[*ent.SalaryComponentQuery, predicate.SalaryComponent, salarycomponent.OrderOption]{typ: ent.TypeSalaryComponent, tq: q}, nil
+	case *ent.SalaryComponentAssignmentQuery:
+		return &query[*ent.SalaryComponentAssignmentQuery, predicate.SalaryComponentAssignment, salarycomponentassignment.OrderOption]{typ: ent.TypeSalaryComponentAssignment, tq: q}, nil
+	case *ent.ShiftAssignmentQuery:
+		return &query[*ent.ShiftAssignmentQuery, predicate.ShiftAssignment, shiftassignment.OrderOption]{typ: ent.TypeShiftAssignment, tq: q}, nil
 	case *ent.UserQuery:
 		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
+	case *ent.WorkScheduleQuery:
+		return &query[*ent.WorkScheduleQuery, predicate.WorkSchedule, workschedule.OrderOption]{typ: ent.TypeWorkSchedule, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
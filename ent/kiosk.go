@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Kiosk is the model entity for the Kiosk schema.
+type Kiosk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Base32 TOTP secret the rotating check-in codes are signed with
+	Secret string `json:"-"`
+	// SHA-256 of the token the kiosk tablet sends to fetch its codes, empty until a token is issued
+	DeviceTokenHash string `json:"-"`
+	// Office the kiosk is installed at, only employees of this office may check in on it
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KioskQuery when eager-loading is set.
+	Edges        KioskEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// KioskEdges holds the relations/edges for other nodes in the graph.
+type KioskEdges struct {
+	// OfficeLocation holds the value of the office_location edge.
+	OfficeLocation *OfficeLocation `json:"office_location,omitempty"`
+	// Attendances holds the value of the attendances edge.
+	Attendances []*Attendance `json:"attendances,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*AttendancePunch `json:"punches,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OfficeLocationOrErr returns the OfficeLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KioskEdges) OfficeLocationOrErr() (*OfficeLocation, error) {
+	if e.loadedTypes[0] {
+		if e.OfficeLocation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: officelocation.Label}
+		}
+		return e.OfficeLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "office_location"}
+}
+
+// AttendancesOrErr returns the Attendances value or an error if the edge
+// was not loaded in eager-loading.
+func (e KioskEdges) AttendancesOrErr() ([]*Attendance, error) {
+	if e.loadedTypes[1] {
+		return e.Attendances, nil
+	}
+	return nil, &NotLoadedError{edge: "attendances"}
+}
+
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e KioskEdges) PunchesOrErr() ([]*AttendancePunch, error) {
+	if e.loadedTypes[2] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Kiosk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case kiosk.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case kiosk.FieldID, kiosk.FieldOfficeLocationID:
+			values[i] = new(sql.NullInt64)
+		case kiosk.FieldName, kiosk.FieldSecret, kiosk.FieldDeviceTokenHash:
+			values[i] = new(sql.NullString)
+		case kiosk.FieldCreatedAt, kiosk.FieldModifiedAt, kiosk.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Kiosk fields.
+func (k *Kiosk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case kiosk.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			k.ID = uint64(value.Int64)
+		case kiosk.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				k.CreatedAt = value.Time
+			}
+		case kiosk.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				k.ModifiedAt = value.Time
+			}
+		case kiosk.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				k.DeletedAt = value.Time
+			}
+		case kiosk.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				k.Name = value.String
+			}
+		case kiosk.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				k.Secret = value.String
+			}
+		case kiosk.FieldDeviceTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_token_hash", values[i])
+			} else if value.Valid {
+				k.DeviceTokenHash = value.String
+			}
+		case kiosk.FieldOfficeLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field office_location_id", values[i])
+			} else if value.Valid {
+				k.OfficeLocationID = new(uint64)
+				*k.OfficeLocationID = uint64(value.Int64)
+			}
+		case kiosk.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				k.IsActive = value.Bool
+			}
+		default:
+			k.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Kiosk.
+// This includes values selected through modifiers, order, etc.
+func (k *Kiosk) Value(name string) (ent.Value, error) {
+	return k.selectValues.Get(name)
+}
+
+// QueryOfficeLocation queries the "office_location" edge of the Kiosk entity.
+func (k *Kiosk) QueryOfficeLocation() *OfficeLocationQuery {
+	return NewKioskClient(k.config).QueryOfficeLocation(k)
+}
+
+// QueryAttendances queries the "attendances" edge of the Kiosk entity.
+func (k *Kiosk) QueryAttendances() *AttendanceQuery {
+	return NewKioskClient(k.config).QueryAttendances(k)
+}
+
+// QueryPunches queries the "punches" edge of the Kiosk entity.
+func (k *Kiosk) QueryPunches() *AttendancePunchQuery {
+	return NewKioskClient(k.config).QueryPunches(k)
+}
+
+// Update returns a builder for updating this Kiosk.
+// Note that you need to call Kiosk.Unwrap() before calling this method if this Kiosk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (k *Kiosk) Update() *KioskUpdateOne {
+	return NewKioskClient(k.config).UpdateOne(k)
+}
+
+// Unwrap unwraps the Kiosk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (k *Kiosk) Unwrap() *Kiosk {
+	_tx, ok := k.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Kiosk is not a transactional entity")
+	}
+	k.config.driver = _tx.drv
+	return k
+}
+
+// String implements the fmt.Stringer.
+func (k *Kiosk) String() string {
+	var builder strings.Builder
+	builder.WriteString("Kiosk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", k.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(k.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(k.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(k.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(k.Name)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("device_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := k.OfficeLocationID; v != nil {
+		builder.WriteString("office_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", k.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Kiosks is a parsable slice of Kiosk.
+type Kiosks []*Kiosk
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kiosk
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldName, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldSecret, v))
+}
+
+// DeviceTokenHash applies equality check predicate on the "device_token_hash" field. It's identical to DeviceTokenHashEQ.
+func DeviceTokenHash(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldDeviceTokenHash, v))
+}
+
+// OfficeLocationID applies equality check predicate on the "office_location_id" field. It's identical to OfficeLocationIDEQ.
+func OfficeLocationID(v uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContainsFold(FieldName, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// DeviceTokenHashEQ applies the EQ predicate on the "device_token_hash" field.
+func DeviceTokenHashEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashNEQ applies the NEQ predicate on the "device_token_hash" field.
+func DeviceTokenHashNEQ(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashIn applies the In predicate on the "device_token_hash" field.
+func DeviceTokenHashIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldDeviceTokenHash, vs...))
+}
+
+// DeviceTokenHashNotIn applies the NotIn predicate on the "device_token_hash" field.
+func DeviceTokenHashNotIn(vs ...string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldDeviceTokenHash, vs...))
+}
+
+// DeviceTokenHashGT applies the GT predicate on the "device_token_hash" field.
+func DeviceTokenHashGT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGT(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashGTE applies the GTE predicate on the "device_token_hash" field.
+func DeviceTokenHashGTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldGTE(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashLT applies the LT predicate on the "device_token_hash" field.
+func DeviceTokenHashLT(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLT(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashLTE applies the LTE predicate on the "device_token_hash" field.
+func DeviceTokenHashLTE(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldLTE(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashContains applies the Contains predicate on the "device_token_hash" field.
+func DeviceTokenHashContains(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContains(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashHasPrefix applies the HasPrefix predicate on the "device_token_hash" field.
+func DeviceTokenHashHasPrefix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasPrefix(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashHasSuffix applies the HasSuffix predicate on the "device_token_hash" field.
+func DeviceTokenHashHasSuffix(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldHasSuffix(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashIsNil applies the IsNil predicate on the "device_token_hash" field.
+func DeviceTokenHashIsNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIsNull(FieldDeviceTokenHash))
+}
+
+// DeviceTokenHashNotNil applies the NotNil predicate on the "device_token_hash" field.
+func DeviceTokenHashNotNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotNull(FieldDeviceTokenHash))
+}
+
+// DeviceTokenHashEqualFold applies the EqualFold predicate on the "device_token_hash" field.
+func DeviceTokenHashEqualFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEqualFold(FieldDeviceTokenHash, v))
+}
+
+// DeviceTokenHashContainsFold applies the ContainsFold predicate on the "device_token_hash" field.
+func DeviceTokenHashContainsFold(v string) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldContainsFold(FieldDeviceTokenHash, v))
+}
+
+// OfficeLocationIDEQ applies the EQ predicate on the "office_location_id" field.
+func OfficeLocationIDEQ(v uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDNEQ applies the NEQ predicate on the "office_location_id" field.
+func OfficeLocationIDNEQ(v uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldOfficeLocationID, v))
+}
+
+// OfficeLocationIDIn applies the In predicate on the "office_location_id" field.
+func OfficeLocationIDIn(vs ...uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDNotIn applies the NotIn predicate on the "office_location_id" field.
+func OfficeLocationIDNotIn(vs ...uint64) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotIn(FieldOfficeLocationID, vs...))
+}
+
+// OfficeLocationIDIsNil applies the IsNil predicate on the "office_location_id" field.
+func OfficeLocationIDIsNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldIsNull(FieldOfficeLocationID))
+}
+
+// OfficeLocationIDNotNil applies the NotNil predicate on the "office_location_id" field.
+func OfficeLocationIDNotNil() predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNotNull(FieldOfficeLocationID))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.Kiosk {
+	return predicate.Kiosk(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// HasOfficeLocation applies the HasEdge predicate on the "office_location" edge.
+func HasOfficeLocation() predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OfficeLocationTable, OfficeLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOfficeLocationWith applies the HasEdge predicate on the "office_location" edge with a given conditions (other predicates).
+func HasOfficeLocationWith(preds ...predicate.OfficeLocation) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := newOfficeLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendances applies the HasEdge predicate on the "attendances" edge.
+func HasAttendances() predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendancesTable, AttendancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendancesWith applies the HasEdge predicate on the "attendances" edge with a given conditions (other predicates).
+func HasAttendancesWith(preds ...predicate.Attendance) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := newAttendancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.AttendancePunch) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Kiosk) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Kiosk) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Kiosk) predicate.Kiosk {
+	return predicate.Kiosk(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskCreate is the builder for creating a Kiosk entity.
+type KioskCreate struct {
+	config
+	mutation *KioskMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kc *KioskCreate) SetCreatedAt(t time.Time) *KioskCreate {
+	kc.mutation.SetCreatedAt(t)
+	return kc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableCreatedAt(t *time.Time) *KioskCreate {
+	if t != nil {
+		kc.SetCreatedAt(*t)
+	}
+	return kc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (kc *KioskCreate) SetModifiedAt(t time.Time) *KioskCreate {
+	kc.mutation.SetModifiedAt(t)
+	return kc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableModifiedAt(t *time.Time) *KioskCreate {
+	if t != nil {
+		kc.SetModifiedAt(*t)
+	}
+	return kc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (kc *KioskCreate) SetDeletedAt(t time.Time) *KioskCreate {
+	kc.mutation.SetDeletedAt(t)
+	return kc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableDeletedAt(t *time.Time) *KioskCreate {
+	if t != nil {
+		kc.SetDeletedAt(*t)
+	}
+	return kc
+}
+
+// SetName sets the "name" field.
+func (kc *KioskCreate) SetName(s string) *KioskCreate {
+	kc.mutation.SetName(s)
+	return kc
+}
+
+// SetSecret sets the "secret" field.
+func (kc *KioskCreate) SetSecret(s string) *KioskCreate {
+	kc.mutation.SetSecret(s)
+	return kc
+}
+
+// SetDeviceTokenHash sets the "device_token_hash" field.
+func (kc *KioskCreate) SetDeviceTokenHash(s string) *KioskCreate {
+	kc.mutation.SetDeviceTokenHash(s)
+	return kc
+}
+
+// SetNillableDeviceTokenHash sets the "device_token_hash" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableDeviceTokenHash(s *string) *KioskCreate {
+	if s != nil {
+		kc.SetDeviceTokenHash(*s)
+	}
+	return kc
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (kc *KioskCreate) SetOfficeLocationID(u uint64) *KioskCreate {
+	kc.mutation.SetOfficeLocationID(u)
+	return kc
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableOfficeLocationID(u *uint64) *KioskCreate {
+	if u != nil {
+		kc.SetOfficeLocationID(*u)
+	}
+	return kc
+}
+
+// SetIsActive sets the "is_active" field.
+func (kc *KioskCreate) SetIsActive(b bool) *KioskCreate {
+	kc.mutation.SetIsActive(b)
+	return kc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (kc *KioskCreate) SetNillableIsActive(b *bool) *KioskCreate {
+	if b != nil {
+		kc.SetIsActive(*b)
+	}
+	return kc
+}
+
+// SetID sets the "id" field.
+func (kc *KioskCreate) SetID(u uint64) *KioskCreate {
+	kc.mutation.SetID(u)
+	return kc
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (kc *KioskCreate) SetOfficeLocation(o *OfficeLocation) *KioskCreate {
+	return kc.SetOfficeLocationID(o.ID)
+}
+
+// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
+func (kc *KioskCreate) AddAttendanceIDs(ids ...uint64) *KioskCreate {
+	kc.mutation.AddAttendanceIDs(ids...)
+	return kc
+}
+
+// AddAttendances adds the "attendances" edges to the Attendance entity.
+func (kc *KioskCreate) AddAttendances(a ...*Attendance) *KioskCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kc.AddAttendanceIDs(ids...)
+}
+
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (kc *KioskCreate) AddPunchIDs(ids ...uint64) *KioskCreate {
+	kc.mutation.AddPunchIDs(ids...)
+	return kc
+}
+
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (kc *KioskCreate) AddPunches(a ...*AttendancePunch) *KioskCreate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kc.AddPunchIDs(ids...)
+}
+
+// Mutation returns the KioskMutation object of the builder.
+func (kc *KioskCreate) Mutation() *KioskMutation {
+	return kc.mutation
+}
+
+// Save creates the Kiosk in the database.
+func (kc *KioskCreate) Save(ctx context.Context) (*Kiosk, error) {
+	kc.defaults()
+	return withHooks(ctx, kc.sqlSave, kc.mutation, kc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kc *KioskCreate) SaveX(ctx context.Context) *Kiosk {
+	v, err := kc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kc *KioskCreate) Exec(ctx context.Context) error {
+	_, err := kc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kc *KioskCreate) ExecX(ctx context.Context) {
+	if err := kc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kc *KioskCreate) defaults() {
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		v := kiosk.DefaultCreatedAt()
+		kc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := kc.mutation.ModifiedAt(); !ok {
+		v := kiosk.DefaultModifiedAt()
+		kc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := kc.mutation.IsActive(); !ok {
+		v := kiosk.DefaultIsActive
+		kc.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kc *KioskCreate) check() error {
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Kiosk.created_at"`)}
+	}
+	if _, ok := kc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "Kiosk.modified_at"`)}
+	}
+	if _, ok := kc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Kiosk.name"`)}
+	}
+	if v, ok := kc.mutation.Name(); ok {
+		if err := kiosk.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Kiosk.name": %w`, err)}
+		}
+	}
+	if _, ok := kc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "Kiosk.secret"`)}
+	}
+	if v, ok := kc.mutation.Secret(); ok {
+		if err := kiosk.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Kiosk.secret": %w`, err)}
+		}
+	}
+	if v, ok := kc.mutation.DeviceTokenHash(); ok {
+		if err := kiosk.DeviceTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_token_hash", err: fmt.Errorf(`ent: validator failed for field "Kiosk.device_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := kc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Kiosk.is_active"`)}
+	}
+	return nil
+}
+
+func (kc *KioskCreate) sqlSave(ctx context.Context) (*Kiosk, error) {
+	if err := kc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	kc.mutation.id = &_node.ID
+	kc.mutation.done = true
+	return _node, nil
+}
+
+func (kc *KioskCreate) createSpec() (*Kiosk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Kiosk{config: kc.config}
+		_spec = sqlgraph.NewCreateSpec(kiosk.Table, sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64))
+	)
+	if id, ok := kc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := kc.mutation.CreatedAt(); ok {
+		_spec.SetField(kiosk.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := kc.mutation.ModifiedAt(); ok {
+		_spec.SetField(kiosk.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := kc.mutation.DeletedAt(); ok {
+		_spec.SetField(kiosk.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := kc.mutation.Name(); ok {
+		_spec.SetField(kiosk.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := kc.mutation.Secret(); ok {
+		_spec.SetField(kiosk.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := kc.mutation.DeviceTokenHash(); ok {
+		_spec.SetField(kiosk.FieldDeviceTokenHash, field.TypeString, value)
+		_node.DeviceTokenHash = value
+	}
+	if value, ok := kc.mutation.IsActive(); ok {
+		_spec.SetField(kiosk.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := kc.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosk.OfficeLocationTable,
+			Columns: []string{kiosk.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OfficeLocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := kc.mutation.AttendancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := kc.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KioskCreateBulk is the builder for creating many Kiosk entities in bulk.
+type KioskCreateBulk struct {
+	config
+	builders []*KioskCreate
+}
+
+// Save creates the Kiosk entities in the database.
+func (kcb *KioskCreateBulk) Save(ctx context.Context) ([]*Kiosk, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(kcb.builders))
+	nodes := make([]*Kiosk, len(kcb.builders))
+	mutators := make([]Mutator, len(kcb.builders))
+	for i := range kcb.builders {
+		func(i int, root context.Context) {
+			builder := kcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KioskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kcb *KioskCreateBulk) SaveX(ctx context.Context) []*Kiosk {
+	v, err := kcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kcb *KioskCreateBulk) Exec(ctx context.Context) error {
+	_, err := kcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kcb *KioskCreateBulk) ExecX(ctx context.Context) {
+	if err := kcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
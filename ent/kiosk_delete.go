@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskDelete is the builder for deleting a Kiosk entity.
+type KioskDelete struct {
+	config
+	hooks    []Hook
+	mutation *KioskMutation
+}
+
+// Where appends a list predicates to the KioskDelete builder.
+func (kd *KioskDelete) Where(ps ...predicate.Kiosk) *KioskDelete {
+	kd.mutation.Where(ps...)
+	return kd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kd *KioskDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kd.sqlExec, kd.mutation, kd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kd *KioskDelete) ExecX(ctx context.Context) int {
+	n, err := kd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kd *KioskDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(kiosk.Table, sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64))
+	if ps := kd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kd.mutation.done = true
+	return affected, err
+}
+
+// KioskDeleteOne is the builder for deleting a single Kiosk entity.
+type KioskDeleteOne struct {
+	kd *KioskDelete
+}
+
+// Where appends a list predicates to the KioskDelete builder.
+func (kdo *KioskDeleteOne) Where(ps ...predicate.Kiosk) *KioskDeleteOne {
+	kdo.kd.mutation.Where(ps...)
+	return kdo
+}
+
+// Exec executes the deletion query.
+func (kdo *KioskDeleteOne) Exec(ctx context.Context) error {
+	n, err := kdo.kd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{kiosk.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kdo *KioskDeleteOne) ExecX(ctx context.Context) {
+	if err := kdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
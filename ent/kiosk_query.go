@@ -0,0 +1,785 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskQuery is the builder for querying Kiosk entities.
+type KioskQuery struct {
+	config
+	ctx                *QueryContext
+	order              []kiosk.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Kiosk
+	withOfficeLocation *OfficeLocationQuery
+	withAttendances    *AttendanceQuery
+	withPunches        *AttendancePunchQuery
+	modifiers          []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KioskQuery builder.
+func (kq *KioskQuery) Where(ps ...predicate.Kiosk) *KioskQuery {
+	kq.predicates = append(kq.predicates, ps...)
+	return kq
+}
+
+// Limit the number of records to be returned by this query.
+func (kq *KioskQuery) Limit(limit int) *KioskQuery {
+	kq.ctx.Limit = &limit
+	return kq
+}
+
+// Offset to start from.
+func (kq *KioskQuery) Offset(offset int) *KioskQuery {
+	kq.ctx.Offset = &offset
+	return kq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kq *KioskQuery) Unique(unique bool) *KioskQuery {
+	kq.ctx.Unique = &unique
+	return kq
+}
+
+// Order specifies how the records should be ordered.
+func (kq *KioskQuery) Order(o ...kiosk.OrderOption) *KioskQuery {
+	kq.order = append(kq.order, o...)
+	return kq
+}
+
+// QueryOfficeLocation chains the current query on the "office_location" edge.
+func (kq *KioskQuery) QueryOfficeLocation() *OfficeLocationQuery {
+	query := (&OfficeLocationClient{config: kq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, selector),
+			sqlgraph.To(officelocation.Table, officelocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, kiosk.OfficeLocationTable, kiosk.OfficeLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendances chains the current query on the "attendances" edge.
+func (kq *KioskQuery) QueryAttendances() *AttendanceQuery {
+	query := (&AttendanceClient{config: kq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, selector),
+			sqlgraph.To(attendance.Table, attendance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, kiosk.AttendancesTable, kiosk.AttendancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPunches chains the current query on the "punches" edge.
+func (kq *KioskQuery) QueryPunches() *AttendancePunchQuery {
+	query := (&AttendancePunchClient{config: kq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosk.Table, kiosk.FieldID, selector),
+			sqlgraph.To(attendancepunch.Table, attendancepunch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, kiosk.PunchesTable, kiosk.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Kiosk entity from the query.
+// Returns a *NotFoundError when no Kiosk was found.
+func (kq *KioskQuery) First(ctx context.Context) (*Kiosk, error) {
+	nodes, err := kq.Limit(1).All(setContextOp(ctx, kq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{kiosk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kq *KioskQuery) FirstX(ctx context.Context) *Kiosk {
+	node, err := kq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Kiosk ID from the query.
+// Returns a *NotFoundError when no Kiosk ID was found.
+func (kq *KioskQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = kq.Limit(1).IDs(setContextOp(ctx, kq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{kiosk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kq *KioskQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := kq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Kiosk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Kiosk entity is found.
+// Returns a *NotFoundError when no Kiosk entities are found.
+func (kq *KioskQuery) Only(ctx context.Context) (*Kiosk, error) {
+	nodes, err := kq.Limit(2).All(setContextOp(ctx, kq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{kiosk.Label}
+	default:
+		return nil, &NotSingularError{kiosk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kq *KioskQuery) OnlyX(ctx context.Context) *Kiosk {
+	node, err := kq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Kiosk ID in the query.
+// Returns a *NotSingularError when more than one Kiosk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kq *KioskQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = kq.Limit(2).IDs(setContextOp(ctx, kq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{kiosk.Label}
+	default:
+		err = &NotSingularError{kiosk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kq *KioskQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := kq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Kiosks.
+func (kq *KioskQuery) All(ctx context.Context) ([]*Kiosk, error) {
+	ctx = setContextOp(ctx, kq.ctx, "All")
+	if err := kq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Kiosk, *KioskQuery]()
+	return withInterceptors[[]*Kiosk](ctx, kq, qr, kq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kq *KioskQuery) AllX(ctx context.Context) []*Kiosk {
+	nodes, err := kq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Kiosk IDs.
+func (kq *KioskQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if kq.ctx.Unique == nil && kq.path != nil {
+		kq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kq.ctx, "IDs")
+	if err = kq.Select(kiosk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kq *KioskQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := kq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kq *KioskQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kq.ctx, "Count")
+	if err := kq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kq, querierCount[*KioskQuery](), kq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kq *KioskQuery) CountX(ctx context.Context) int {
+	count, err := kq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kq *KioskQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kq.ctx, "Exist")
+	switch _, err := kq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kq *KioskQuery) ExistX(ctx context.Context) bool {
+	exist, err := kq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KioskQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kq *KioskQuery) Clone() *KioskQuery {
+	if kq == nil {
+		return nil
+	}
+	return &KioskQuery{
+		config:             kq.config,
+		ctx:                kq.ctx.Clone(),
+		order:              append([]kiosk.OrderOption{}, kq.order...),
+		inters:             append([]Interceptor{}, kq.inters...),
+		predicates:         append([]predicate.Kiosk{}, kq.predicates...),
+		withOfficeLocation: kq.withOfficeLocation.Clone(),
+		withAttendances:    kq.withAttendances.Clone(),
+		withPunches:        kq.withPunches.Clone(),
+		// clone intermediate query.
+		sql:  kq.sql.Clone(),
+		path: kq.path,
+	}
+}
+
+// WithOfficeLocation tells the query-builder to eager-load the nodes that are connected to
+// the "office_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (kq *KioskQuery) WithOfficeLocation(opts ...func(*OfficeLocationQuery)) *KioskQuery {
+	query := (&OfficeLocationClient{config: kq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kq.withOfficeLocation = query
+	return kq
+}
+
+// WithAttendances tells the query-builder to eager-load the nodes that are connected to
+// the "attendances" edge. The optional arguments are used to configure the query builder of the edge.
+func (kq *KioskQuery) WithAttendances(opts ...func(*AttendanceQuery)) *KioskQuery {
+	query := (&AttendanceClient{config: kq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kq.withAttendances = query
+	return kq
+}
+
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (kq *KioskQuery) WithPunches(opts ...func(*AttendancePunchQuery)) *KioskQuery {
+	query := (&AttendancePunchClient{config: kq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kq.withPunches = query
+	return kq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Kiosk.Query().
+//		GroupBy(kiosk.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kq *KioskQuery) GroupBy(field string, fields ...string) *KioskGroupBy {
+	kq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KioskGroupBy{build: kq}
+	grbuild.flds = &kq.ctx.Fields
+	grbuild.label = kiosk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Kiosk.Query().
+//		Select(kiosk.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (kq *KioskQuery) Select(fields ...string) *KioskSelect {
+	kq.ctx.Fields = append(kq.ctx.Fields, fields...)
+	sbuild := &KioskSelect{KioskQuery: kq}
+	sbuild.label = kiosk.Label
+	sbuild.flds, sbuild.scan = &kq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KioskSelect configured with the given aggregations.
+func (kq *KioskQuery) Aggregate(fns ...AggregateFunc) *KioskSelect {
+	return kq.Select().Aggregate(fns...)
+}
+
+func (kq *KioskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kq.ctx.Fields {
+		if !kiosk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kq.path != nil {
+		prev, err := kq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kq.sql = prev
+	}
+	return nil
+}
+
+func (kq *KioskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Kiosk, error) {
+	var (
+		nodes       = []*Kiosk{}
+		_spec       = kq.querySpec()
+		loadedTypes = [3]bool{
+			kq.withOfficeLocation != nil,
+			kq.withAttendances != nil,
+			kq.withPunches != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Kiosk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Kiosk{config: kq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(kq.modifiers) > 0 {
+		_spec.Modifiers = kq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := kq.withOfficeLocation; query != nil {
+		if err := kq.loadOfficeLocation(ctx, query, nodes, nil,
+			func(n *Kiosk, e *OfficeLocation) { n.Edges.OfficeLocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := kq.withAttendances; query != nil {
+		if err := kq.loadAttendances(ctx, query, nodes,
+			func(n *Kiosk) { n.Edges.Attendances = []*Attendance{} },
+			func(n *Kiosk, e *Attendance) { n.Edges.Attendances = append(n.Edges.Attendances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := kq.withPunches; query != nil {
+		if err := kq.loadPunches(ctx, query, nodes,
+			func(n *Kiosk) { n.Edges.Punches = []*AttendancePunch{} },
+			func(n *Kiosk, e *AttendancePunch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (kq *KioskQuery) loadOfficeLocation(ctx context.Context, query *OfficeLocationQuery, nodes []*Kiosk, init func(*Kiosk), assign func(*Kiosk, *OfficeLocation)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*Kiosk)
+	for i := range nodes {
+		if nodes[i].OfficeLocationID == nil {
+			continue
+		}
+		fk := *nodes[i].OfficeLocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(officelocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "office_location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (kq *KioskQuery) loadAttendances(ctx context.Context, query *AttendanceQuery, nodes []*Kiosk, init func(*Kiosk), assign func(*Kiosk, *Attendance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Kiosk)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendance.FieldCheckInKioskID)
+	}
+	query.Where(predicate.Attendance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(kiosk.AttendancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CheckInKioskID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "check_in_kiosk_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "check_in_kiosk_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (kq *KioskQuery) loadPunches(ctx context.Context, query *AttendancePunchQuery, nodes []*Kiosk, init func(*Kiosk), assign func(*Kiosk, *AttendancePunch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Kiosk)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancepunch.FieldKioskID)
+	}
+	query.Where(predicate.AttendancePunch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(kiosk.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.KioskID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "kiosk_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "kiosk_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (kq *KioskQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kq.querySpec()
+	if len(kq.modifiers) > 0 {
+		_spec.Modifiers = kq.modifiers
+	}
+	_spec.Node.Columns = kq.ctx.Fields
+	if len(kq.ctx.Fields) > 0 {
+		_spec.Unique = kq.ctx.Unique != nil && *kq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kq.driver, _spec)
+}
+
+func (kq *KioskQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(kiosk.Table, kiosk.Columns, sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64))
+	_spec.From = kq.sql
+	if unique := kq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kiosk.FieldID)
+		for i := range fields {
+			if fields[i] != kiosk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if kq.withOfficeLocation != nil {
+			_spec.Node.AddColumnOnce(kiosk.FieldOfficeLocationID)
+		}
+	}
+	if ps := kq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kq *KioskQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kq.driver.Dialect())
+	t1 := builder.Table(kiosk.Table)
+	columns := kq.ctx.Fields
+	if len(columns) == 0 {
+		columns = kiosk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kq.sql != nil {
+		selector = kq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kq.ctx.Unique != nil && *kq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range kq.modifiers {
+		m(selector)
+	}
+	for _, p := range kq.predicates {
+		p(selector)
+	}
+	for _, p := range kq.order {
+		p(selector)
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (kq *KioskQuery) Modify(modifiers ...func(s *sql.Selector)) *KioskSelect {
+	kq.modifiers = append(kq.modifiers, modifiers...)
+	return kq.Select()
+}
+
+// KioskGroupBy is the group-by builder for Kiosk entities.
+type KioskGroupBy struct {
+	selector
+	build *KioskQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kgb *KioskGroupBy) Aggregate(fns ...AggregateFunc) *KioskGroupBy {
+	kgb.fns = append(kgb.fns, fns...)
+	return kgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kgb *KioskGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kgb.build.ctx, "GroupBy")
+	if err := kgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KioskQuery, *KioskGroupBy](ctx, kgb.build, kgb, kgb.build.inters, v)
+}
+
+func (kgb *KioskGroupBy) sqlScan(ctx context.Context, root *KioskQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kgb.fns))
+	for _, fn := range kgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kgb.flds)+len(kgb.fns))
+		for _, f := range *kgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KioskSelect is the builder for selecting fields of Kiosk entities.
+type KioskSelect struct {
+	*KioskQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ks *KioskSelect) Aggregate(fns ...AggregateFunc) *KioskSelect {
+	ks.fns = append(ks.fns, fns...)
+	return ks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ks *KioskSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ks.ctx, "Select")
+	if err := ks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KioskQuery, *KioskSelect](ctx, ks.KioskQuery, ks, ks.inters, v)
+}
+
+func (ks *KioskSelect) sqlScan(ctx context.Context, root *KioskQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ks.fns))
+	for _, fn := range ks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ks *KioskSelect) Modify(modifiers ...func(s *sql.Selector)) *KioskSelect {
+	ks.modifiers = append(ks.modifiers, modifiers...)
+	return ks
+}
@@ -0,0 +1,895 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KioskUpdate is the builder for updating Kiosk entities.
+type KioskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *KioskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the KioskUpdate builder.
+func (ku *KioskUpdate) Where(ps ...predicate.Kiosk) *KioskUpdate {
+	ku.mutation.Where(ps...)
+	return ku
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ku *KioskUpdate) SetModifiedAt(t time.Time) *KioskUpdate {
+	ku.mutation.SetModifiedAt(t)
+	return ku
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ku *KioskUpdate) SetDeletedAt(t time.Time) *KioskUpdate {
+	ku.mutation.SetDeletedAt(t)
+	return ku
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ku *KioskUpdate) SetNillableDeletedAt(t *time.Time) *KioskUpdate {
+	if t != nil {
+		ku.SetDeletedAt(*t)
+	}
+	return ku
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ku *KioskUpdate) ClearDeletedAt() *KioskUpdate {
+	ku.mutation.ClearDeletedAt()
+	return ku
+}
+
+// SetName sets the "name" field.
+func (ku *KioskUpdate) SetName(s string) *KioskUpdate {
+	ku.mutation.SetName(s)
+	return ku
+}
+
+// SetSecret sets the "secret" field.
+func (ku *KioskUpdate) SetSecret(s string) *KioskUpdate {
+	ku.mutation.SetSecret(s)
+	return ku
+}
+
+// SetDeviceTokenHash sets the "device_token_hash" field.
+func (ku *KioskUpdate) SetDeviceTokenHash(s string) *KioskUpdate {
+	ku.mutation.SetDeviceTokenHash(s)
+	return ku
+}
+
+// SetNillableDeviceTokenHash sets the "device_token_hash" field if the given value is not nil.
+func (ku *KioskUpdate) SetNillableDeviceTokenHash(s *string) *KioskUpdate {
+	if s != nil {
+		ku.SetDeviceTokenHash(*s)
+	}
+	return ku
+}
+
+// ClearDeviceTokenHash clears the value of the "device_token_hash" field.
+func (ku *KioskUpdate) ClearDeviceTokenHash() *KioskUpdate {
+	ku.mutation.ClearDeviceTokenHash()
+	return ku
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (ku *KioskUpdate) SetOfficeLocationID(u uint64) *KioskUpdate {
+	ku.mutation.SetOfficeLocationID(u)
+	return ku
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (ku *KioskUpdate) SetNillableOfficeLocationID(u *uint64) *KioskUpdate {
+	if u != nil {
+		ku.SetOfficeLocationID(*u)
+	}
+	return ku
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (ku *KioskUpdate) ClearOfficeLocationID() *KioskUpdate {
+	ku.mutation.ClearOfficeLocationID()
+	return ku
+}
+
+// SetIsActive sets the "is_active" field.
+func (ku *KioskUpdate) SetIsActive(b bool) *KioskUpdate {
+	ku.mutation.SetIsActive(b)
+	return ku
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ku *KioskUpdate) SetNillableIsActive(b *bool) *KioskUpdate {
+	if b != nil {
+		ku.SetIsActive(*b)
+	}
+	return ku
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (ku *KioskUpdate) SetOfficeLocation(o *OfficeLocation) *KioskUpdate {
+	return ku.SetOfficeLocationID(o.ID)
+}
+
+// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
+func (ku *KioskUpdate) AddAttendanceIDs(ids ...uint64) *KioskUpdate {
+	ku.mutation.AddAttendanceIDs(ids...)
+	return ku
+}
+
+// AddAttendances adds the "attendances" edges to the Attendance entity.
+func (ku *KioskUpdate) AddAttendances(a ...*Attendance) *KioskUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ku.AddAttendanceIDs(ids...)
+}
+
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (ku *KioskUpdate) AddPunchIDs(ids ...uint64) *KioskUpdate {
+	ku.mutation.AddPunchIDs(ids...)
+	return ku
+}
+
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (ku *KioskUpdate) AddPunches(a ...*AttendancePunch) *KioskUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ku.AddPunchIDs(ids...)
+}
+
+// Mutation returns the KioskMutation object of the builder.
+func (ku *KioskUpdate) Mutation() *KioskMutation {
+	return ku.mutation
+}
+
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (ku *KioskUpdate) ClearOfficeLocation() *KioskUpdate {
+	ku.mutation.ClearOfficeLocation()
+	return ku
+}
+
+// ClearAttendances clears all "attendances" edges to the Attendance entity.
+func (ku *KioskUpdate) ClearAttendances() *KioskUpdate {
+	ku.mutation.ClearAttendances()
+	return ku
+}
+
+// RemoveAttendanceIDs removes the "attendances" edge to Attendance entities by IDs.
+func (ku *KioskUpdate) RemoveAttendanceIDs(ids ...uint64) *KioskUpdate {
+	ku.mutation.RemoveAttendanceIDs(ids...)
+	return ku
+}
+
+// RemoveAttendances removes "attendances" edges to Attendance entities.
+func (ku *KioskUpdate) RemoveAttendances(a ...*Attendance) *KioskUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ku.RemoveAttendanceIDs(ids...)
+}
+
+// ClearPunches clears all "punches" edges to the AttendancePunch entity.
+func (ku *KioskUpdate) ClearPunches() *KioskUpdate {
+	ku.mutation.ClearPunches()
+	return ku
+}
+
+// RemovePunchIDs removes the "punches" edge to AttendancePunch entities by IDs.
+func (ku *KioskUpdate) RemovePunchIDs(ids ...uint64) *KioskUpdate {
+	ku.mutation.RemovePunchIDs(ids...)
+	return ku
+}
+
+// RemovePunches removes "punches" edges to AttendancePunch entities.
+func (ku *KioskUpdate) RemovePunches(a ...*AttendancePunch) *KioskUpdate {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ku.RemovePunchIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ku *KioskUpdate) Save(ctx context.Context) (int, error) {
+	ku.defaults()
+	return withHooks(ctx, ku.sqlSave, ku.mutation, ku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ku *KioskUpdate) SaveX(ctx context.Context) int {
+	affected, err := ku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ku *KioskUpdate) Exec(ctx context.Context) error {
+	_, err := ku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ku *KioskUpdate) ExecX(ctx context.Context) {
+	if err := ku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ku *KioskUpdate) defaults() {
+	if _, ok := ku.mutation.ModifiedAt(); !ok {
+		v := kiosk.UpdateDefaultModifiedAt()
+		ku.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ku *KioskUpdate) check() error {
+	if v, ok := ku.mutation.Name(); ok {
+		if err := kiosk.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Kiosk.name": %w`, err)}
+		}
+	}
+	if v, ok := ku.mutation.Secret(); ok {
+		if err := kiosk.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Kiosk.secret": %w`, err)}
+		}
+	}
+	if v, ok := ku.mutation.DeviceTokenHash(); ok {
+		if err := kiosk.DeviceTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_token_hash", err: fmt.Errorf(`ent: validator failed for field "Kiosk.device_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ku *KioskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KioskUpdate {
+	ku.modifiers = append(ku.modifiers, modifiers...)
+	return ku
+}
+
+func (ku *KioskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kiosk.Table, kiosk.Columns, sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64))
+	if ps := ku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ku.mutation.ModifiedAt(); ok {
+		_spec.SetField(kiosk.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ku.mutation.DeletedAt(); ok {
+		_spec.SetField(kiosk.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ku.mutation.DeletedAtCleared() {
+		_spec.ClearField(kiosk.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ku.mutation.Name(); ok {
+		_spec.SetField(kiosk.FieldName, field.TypeString, value)
+	}
+	if value, ok := ku.mutation.Secret(); ok {
+		_spec.SetField(kiosk.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := ku.mutation.DeviceTokenHash(); ok {
+		_spec.SetField(kiosk.FieldDeviceTokenHash, field.TypeString, value)
+	}
+	if ku.mutation.DeviceTokenHashCleared() {
+		_spec.ClearField(kiosk.FieldDeviceTokenHash, field.TypeString)
+	}
+	if value, ok := ku.mutation.IsActive(); ok {
+		_spec.SetField(kiosk.FieldIsActive, field.TypeBool, value)
+	}
+	if ku.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosk.OfficeLocationTable,
+			Columns: []string{kiosk.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ku.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosk.OfficeLocationTable,
+			Columns: []string{kiosk.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ku.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ku.mutation.RemovedAttendancesIDs(); len(nodes) > 0 && !ku.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ku.mutation.AttendancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ku.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ku.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !ku.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ku.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kiosk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ku.mutation.done = true
+	return n, nil
+}
+
+// KioskUpdateOne is the builder for updating a single Kiosk entity.
+type KioskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *KioskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (kuo *KioskUpdateOne) SetModifiedAt(t time.Time) *KioskUpdateOne {
+	kuo.mutation.SetModifiedAt(t)
+	return kuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (kuo *KioskUpdateOne) SetDeletedAt(t time.Time) *KioskUpdateOne {
+	kuo.mutation.SetDeletedAt(t)
+	return kuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (kuo *KioskUpdateOne) SetNillableDeletedAt(t *time.Time) *KioskUpdateOne {
+	if t != nil {
+		kuo.SetDeletedAt(*t)
+	}
+	return kuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (kuo *KioskUpdateOne) ClearDeletedAt() *KioskUpdateOne {
+	kuo.mutation.ClearDeletedAt()
+	return kuo
+}
+
+// SetName sets the "name" field.
+func (kuo *KioskUpdateOne) SetName(s string) *KioskUpdateOne {
+	kuo.mutation.SetName(s)
+	return kuo
+}
+
+// SetSecret sets the "secret" field.
+func (kuo *KioskUpdateOne) SetSecret(s string) *KioskUpdateOne {
+	kuo.mutation.SetSecret(s)
+	return kuo
+}
+
+// SetDeviceTokenHash sets the "device_token_hash" field.
+func (kuo *KioskUpdateOne) SetDeviceTokenHash(s string) *KioskUpdateOne {
+	kuo.mutation.SetDeviceTokenHash(s)
+	return kuo
+}
+
+// SetNillableDeviceTokenHash sets the "device_token_hash" field if the given value is not nil.
+func (kuo *KioskUpdateOne) SetNillableDeviceTokenHash(s *string) *KioskUpdateOne {
+	if s != nil {
+		kuo.SetDeviceTokenHash(*s)
+	}
+	return kuo
+}
+
+// ClearDeviceTokenHash clears the value of the "device_token_hash" field.
+func (kuo *KioskUpdateOne) ClearDeviceTokenHash() *KioskUpdateOne {
+	kuo.mutation.ClearDeviceTokenHash()
+	return kuo
+}
+
+// SetOfficeLocationID sets the "office_location_id" field.
+func (kuo *KioskUpdateOne) SetOfficeLocationID(u uint64) *KioskUpdateOne {
+	kuo.mutation.SetOfficeLocationID(u)
+	return kuo
+}
+
+// SetNillableOfficeLocationID sets the "office_location_id" field if the given value is not nil.
+func (kuo *KioskUpdateOne) SetNillableOfficeLocationID(u *uint64) *KioskUpdateOne {
+	if u != nil {
+		kuo.SetOfficeLocationID(*u)
+	}
+	return kuo
+}
+
+// ClearOfficeLocationID clears the value of the "office_location_id" field.
+func (kuo *KioskUpdateOne) ClearOfficeLocationID() *KioskUpdateOne {
+	kuo.mutation.ClearOfficeLocationID()
+	return kuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (kuo *KioskUpdateOne) SetIsActive(b bool) *KioskUpdateOne {
+	kuo.mutation.SetIsActive(b)
+	return kuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (kuo *KioskUpdateOne) SetNillableIsActive(b *bool) *KioskUpdateOne {
+	if b != nil {
+		kuo.SetIsActive(*b)
+	}
+	return kuo
+}
+
+// SetOfficeLocation sets the "office_location" edge to the OfficeLocation entity.
+func (kuo *KioskUpdateOne) SetOfficeLocation(o *OfficeLocation) *KioskUpdateOne {
+	return kuo.SetOfficeLocationID(o.ID)
+}
+
+// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by IDs.
+func (kuo *KioskUpdateOne) AddAttendanceIDs(ids ...uint64) *KioskUpdateOne {
+	kuo.mutation.AddAttendanceIDs(ids...)
+	return kuo
+}
+
+// AddAttendances adds the "attendances" edges to the Attendance entity.
+func (kuo *KioskUpdateOne) AddAttendances(a ...*Attendance) *KioskUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kuo.AddAttendanceIDs(ids...)
+}
+
+// AddPunchIDs adds the "punches" edge to the AttendancePunch entity by IDs.
+func (kuo *KioskUpdateOne) AddPunchIDs(ids ...uint64) *KioskUpdateOne {
+	kuo.mutation.AddPunchIDs(ids...)
+	return kuo
+}
+
+// AddPunches adds the "punches" edges to the AttendancePunch entity.
+func (kuo *KioskUpdateOne) AddPunches(a ...*AttendancePunch) *KioskUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kuo.AddPunchIDs(ids...)
+}
+
+// Mutation returns the KioskMutation object of the builder.
+func (kuo *KioskUpdateOne) Mutation() *KioskMutation {
+	return kuo.mutation
+}
+
+// ClearOfficeLocation clears the "office_location" edge to the OfficeLocation entity.
+func (kuo *KioskUpdateOne) ClearOfficeLocation() *KioskUpdateOne {
+	kuo.mutation.ClearOfficeLocation()
+	return kuo
+}
+
+// ClearAttendances clears all "attendances" edges to the Attendance entity.
+func (kuo *KioskUpdateOne) ClearAttendances() *KioskUpdateOne {
+	kuo.mutation.ClearAttendances()
+	return kuo
+}
+
+// RemoveAttendanceIDs removes the "attendances" edge to Attendance entities by IDs.
+func (kuo *KioskUpdateOne) RemoveAttendanceIDs(ids ...uint64) *KioskUpdateOne {
+	kuo.mutation.RemoveAttendanceIDs(ids...)
+	return kuo
+}
+
+// RemoveAttendances removes "attendances" edges to Attendance entities.
+func (kuo *KioskUpdateOne) RemoveAttendances(a ...*Attendance) *KioskUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kuo.RemoveAttendanceIDs(ids...)
+}
+
+// ClearPunches clears all "punches" edges to the AttendancePunch entity.
+func (kuo *KioskUpdateOne) ClearPunches() *KioskUpdateOne {
+	kuo.mutation.ClearPunches()
+	return kuo
+}
+
+// RemovePunchIDs removes the "punches" edge to AttendancePunch entities by IDs.
+func (kuo *KioskUpdateOne) RemovePunchIDs(ids ...uint64) *KioskUpdateOne {
+	kuo.mutation.RemovePunchIDs(ids...)
+	return kuo
+}
+
+// RemovePunches removes "punches" edges to AttendancePunch entities.
+func (kuo *KioskUpdateOne) RemovePunches(a ...*AttendancePunch) *KioskUpdateOne {
+	ids := make([]uint64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return kuo.RemovePunchIDs(ids...)
+}
+
+// Where appends a list predicates to the KioskUpdate builder.
+func (kuo *KioskUpdateOne) Where(ps ...predicate.Kiosk) *KioskUpdateOne {
+	kuo.mutation.Where(ps...)
+	return kuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kuo *KioskUpdateOne) Select(field string, fields ...string) *KioskUpdateOne {
+	kuo.fields = append([]string{field}, fields...)
+	return kuo
+}
+
+// Save executes the query and returns the updated Kiosk entity.
+func (kuo *KioskUpdateOne) Save(ctx context.Context) (*Kiosk, error) {
+	kuo.defaults()
+	return withHooks(ctx, kuo.sqlSave, kuo.mutation, kuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kuo *KioskUpdateOne) SaveX(ctx context.Context) *Kiosk {
+	node, err := kuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kuo *KioskUpdateOne) Exec(ctx context.Context) error {
+	_, err := kuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kuo *KioskUpdateOne) ExecX(ctx context.Context) {
+	if err := kuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kuo *KioskUpdateOne) defaults() {
+	if _, ok := kuo.mutation.ModifiedAt(); !ok {
+		v := kiosk.UpdateDefaultModifiedAt()
+		kuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kuo *KioskUpdateOne) check() error {
+	if v, ok := kuo.mutation.Name(); ok {
+		if err := kiosk.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Kiosk.name": %w`, err)}
+		}
+	}
+	if v, ok := kuo.mutation.Secret(); ok {
+		if err := kiosk.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Kiosk.secret": %w`, err)}
+		}
+	}
+	if v, ok := kuo.mutation.DeviceTokenHash(); ok {
+		if err := kiosk.DeviceTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_token_hash", err: fmt.Errorf(`ent: validator failed for field "Kiosk.device_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (kuo *KioskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KioskUpdateOne {
+	kuo.modifiers = append(kuo.modifiers, modifiers...)
+	return kuo
+}
+
+func (kuo *KioskUpdateOne) sqlSave(ctx context.Context) (_node *Kiosk, err error) {
+	if err := kuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kiosk.Table, kiosk.Columns, sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64))
+	id, ok := kuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Kiosk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kiosk.FieldID)
+		for _, f := range fields {
+			if !kiosk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != kiosk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(kiosk.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := kuo.mutation.DeletedAt(); ok {
+		_spec.SetField(kiosk.FieldDeletedAt, field.TypeTime, value)
+	}
+	if kuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(kiosk.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := kuo.mutation.Name(); ok {
+		_spec.SetField(kiosk.FieldName, field.TypeString, value)
+	}
+	if value, ok := kuo.mutation.Secret(); ok {
+		_spec.SetField(kiosk.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := kuo.mutation.DeviceTokenHash(); ok {
+		_spec.SetField(kiosk.FieldDeviceTokenHash, field.TypeString, value)
+	}
+	if kuo.mutation.DeviceTokenHashCleared() {
+		_spec.ClearField(kiosk.FieldDeviceTokenHash, field.TypeString)
+	}
+	if value, ok := kuo.mutation.IsActive(); ok {
+		_spec.SetField(kiosk.FieldIsActive, field.TypeBool, value)
+	}
+	if kuo.mutation.OfficeLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosk.OfficeLocationTable,
+			Columns: []string{kiosk.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kuo.mutation.OfficeLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosk.OfficeLocationTable,
+			Columns: []string{kiosk.OfficeLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if kuo.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kuo.mutation.RemovedAttendancesIDs(); len(nodes) > 0 && !kuo.mutation.AttendancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kuo.mutation.AttendancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.AttendancesTable,
+			Columns: []string{kiosk.AttendancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if kuo.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kuo.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !kuo.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kuo.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   kiosk.PunchesTable,
+			Columns: []string{kiosk.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepunch.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(kuo.modifiers...)
+	_node = &Kiosk{config: kuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kiosk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kuo.mutation.done = true
+	return _node, nil
+}
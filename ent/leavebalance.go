@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leavetype"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaveBalance is the model entity for the LeaveBalance schema.
+type LeaveBalance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to employees table
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Foreign key to leave_types table
+	LeaveTypeID uint64 `json:"leave_type_id,omitempty"`
+	// Calendar year of the balance
+	Year int `json:"year,omitempty"`
+	// EntitledDays holds the value of the "entitled_days" field.
+	EntitledDays int `json:"entitled_days,omitempty"`
+	// Working days taken by approved leave requests
+	UsedDays int `json:"used_days,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaveBalanceQuery when eager-loading is set.
+	Edges        LeaveBalanceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeaveBalanceEdges holds the relations/edges for other nodes in the graph.
+type LeaveBalanceEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// LeaveType holds the value of the leave_type edge.
+	LeaveType *LeaveType `json:"leave_type,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveBalanceEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[0] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// LeaveTypeOrErr returns the LeaveType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveBalanceEdges) LeaveTypeOrErr() (*LeaveType, error) {
+	if e.loadedTypes[1] {
+		if e.LeaveType == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: leavetype.Label}
+		}
+		return e.LeaveType, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_type"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaveBalance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leavebalance.FieldID, leavebalance.FieldEmployeeID, leavebalance.FieldLeaveTypeID, leavebalance.FieldYear, leavebalance.FieldEntitledDays, leavebalance.FieldUsedDays:
+			values[i] = new(sql.NullInt64)
+		case leavebalance.FieldCreatedAt, leavebalance.FieldModifiedAt, leavebalance.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaveBalance fields.
+func (lb *LeaveBalance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leavebalance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lb.ID = uint64(value.Int64)
+		case leavebalance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lb.CreatedAt = value.Time
+			}
+		case leavebalance.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				lb.ModifiedAt = value.Time
+			}
+		case leavebalance.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				lb.DeletedAt = value.Time
+			}
+		case leavebalance.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				lb.EmployeeID = uint64(value.Int64)
+			}
+		case leavebalance.FieldLeaveTypeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leave_type_id", values[i])
+			} else if value.Valid {
+				lb.LeaveTypeID = uint64(value.Int64)
+			}
+		case leavebalance.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				lb.Year = int(value.Int64)
+			}
+		case leavebalance.FieldEntitledDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entitled_days", values[i])
+			} else if value.Valid {
+				lb.EntitledDays = int(value.Int64)
+			}
+		case leavebalance.FieldUsedDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_days", values[i])
+			} else if value.Valid {
+				lb.UsedDays = int(value.Int64)
+			}
+		default:
+			lb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaveBalance.
+// This includes values selected through modifiers, order, etc.
+func (lb *LeaveBalance) Value(name string) (ent.Value, error) {
+	return lb.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the LeaveBalance entity.
+func (lb *LeaveBalance) QueryEmployee() *EmployeeQuery {
+	return NewLeaveBalanceClient(lb.config).QueryEmployee(lb)
+}
+
+// QueryLeaveType queries the "leave_type" edge of the LeaveBalance entity.
+func (lb *LeaveBalance) QueryLeaveType() *LeaveTypeQuery {
+	return NewLeaveBalanceClient(lb.config).QueryLeaveType(lb)
+}
+
+// Update returns a builder for updating this LeaveBalance.
+// Note that you need to call LeaveBalance.Unwrap() before calling this method if this LeaveBalance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lb *LeaveBalance) Update() *LeaveBalanceUpdateOne {
+	return NewLeaveBalanceClient(lb.config).UpdateOne(lb)
+}
+
+// Unwrap unwraps the LeaveBalance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lb *LeaveBalance) Unwrap() *LeaveBalance {
+	_tx, ok := lb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaveBalance is not a transactional entity")
+	}
+	lb.config.driver = _tx.drv
+	return lb
+}
+
+// String implements the fmt.Stringer.
+func (lb *LeaveBalance) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaveBalance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(lb.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(lb.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", lb.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("leave_type_id=")
+	builder.WriteString(fmt.Sprintf("%v", lb.LeaveTypeID))
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", lb.Year))
+	builder.WriteString(", ")
+	builder.WriteString("entitled_days=")
+	builder.WriteString(fmt.Sprintf("%v", lb.EntitledDays))
+	builder.WriteString(", ")
+	builder.WriteString("used_days=")
+	builder.WriteString(fmt.Sprintf("%v", lb.UsedDays))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaveBalances is a parsable slice of LeaveBalance.
+type LeaveBalances []*LeaveBalance
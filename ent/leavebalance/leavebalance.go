@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leavebalance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the leavebalance type in the database.
+	Label = "leave_balance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldLeaveTypeID holds the string denoting the leave_type_id field in the database.
+	FieldLeaveTypeID = "leave_type_id"
+	// FieldYear holds the string denoting the year field in the database.
+	FieldYear = "year"
+	// FieldEntitledDays holds the string denoting the entitled_days field in the database.
+	FieldEntitledDays = "entitled_days"
+	// FieldUsedDays holds the string denoting the used_days field in the database.
+	FieldUsedDays = "used_days"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// EdgeLeaveType holds the string denoting the leave_type edge name in mutations.
+	EdgeLeaveType = "leave_type"
+	// Table holds the table name of the leavebalance in the database.
+	Table = "leave_balances"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "leave_balances"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+	// LeaveTypeTable is the table that holds the leave_type relation/edge.
+	LeaveTypeTable = "leave_balances"
+	// LeaveTypeInverseTable is the table name for the LeaveType entity.
+	// It exists in this package in order to avoid circular dependency with the "leavetype" package.
+	LeaveTypeInverseTable = "leave_types"
+	// LeaveTypeColumn is the table column denoting the leave_type relation/edge.
+	LeaveTypeColumn = "leave_type_id"
+)
+
+// Columns holds all SQL columns for leavebalance fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldEmployeeID,
+	FieldLeaveTypeID,
+	FieldYear,
+	FieldEntitledDays,
+	FieldUsedDays,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// DefaultEntitledDays holds the default value on creation for the "entitled_days" field.
+	DefaultEntitledDays int
+	// EntitledDaysValidator is a validator for the "entitled_days" field. It is called by the builders before save.
+	EntitledDaysValidator func(int) error
+	// DefaultUsedDays holds the default value on creation for the "used_days" field.
+	DefaultUsedDays int
+	// UsedDaysValidator is a validator for the "used_days" field. It is called by the builders before save.
+	UsedDaysValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the LeaveBalance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByLeaveTypeID orders the results by the leave_type_id field.
+func ByLeaveTypeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaveTypeID, opts...).ToFunc()
+}
+
+// ByYear orders the results by the year field.
+func ByYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYear, opts...).ToFunc()
+}
+
+// ByEntitledDays orders the results by the entitled_days field.
+func ByEntitledDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntitledDays, opts...).ToFunc()
+}
+
+// ByUsedDays orders the results by the used_days field.
+func ByUsedDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedDays, opts...).ToFunc()
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLeaveTypeField orders the results by leave_type field.
+func ByLeaveTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeaveTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
+func newLeaveTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeaveTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LeaveTypeTable, LeaveTypeColumn),
+	)
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leavebalance
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// LeaveTypeID applies equality check predicate on the "leave_type_id" field. It's identical to LeaveTypeIDEQ.
+func LeaveTypeID(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldLeaveTypeID, v))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldYear, v))
+}
+
+// EntitledDays applies equality check predicate on the "entitled_days" field. It's identical to EntitledDaysEQ.
+func EntitledDays(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldEntitledDays, v))
+}
+
+// UsedDays applies equality check predicate on the "used_days" field. It's identical to UsedDaysEQ.
+func UsedDays(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldUsedDays, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// LeaveTypeIDEQ applies the EQ predicate on the "leave_type_id" field.
+func LeaveTypeIDEQ(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldLeaveTypeID, v))
+}
+
+// LeaveTypeIDNEQ applies the NEQ predicate on the "leave_type_id" field.
+func LeaveTypeIDNEQ(v uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldLeaveTypeID, v))
+}
+
+// LeaveTypeIDIn applies the In predicate on the "leave_type_id" field.
+func LeaveTypeIDIn(vs ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldLeaveTypeID, vs...))
+}
+
+// LeaveTypeIDNotIn applies the NotIn predicate on the "leave_type_id" field.
+func LeaveTypeIDNotIn(vs ...uint64) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldLeaveTypeID, vs...))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldYear, v))
+}
+
+// EntitledDaysEQ applies the EQ predicate on the "entitled_days" field.
+func EntitledDaysEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldEntitledDays, v))
+}
+
+// EntitledDaysNEQ applies the NEQ predicate on the "entitled_days" field.
+func EntitledDaysNEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldEntitledDays, v))
+}
+
+// EntitledDaysIn applies the In predicate on the "entitled_days" field.
+func EntitledDaysIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldEntitledDays, vs...))
+}
+
+// EntitledDaysNotIn applies the NotIn predicate on the "entitled_days" field.
+func EntitledDaysNotIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldEntitledDays, vs...))
+}
+
+// EntitledDaysGT applies the GT predicate on the "entitled_days" field.
+func EntitledDaysGT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldEntitledDays, v))
+}
+
+// EntitledDaysGTE applies the GTE predicate on the "entitled_days" field.
+func EntitledDaysGTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldEntitledDays, v))
+}
+
+// EntitledDaysLT applies the LT predicate on the "entitled_days" field.
+func EntitledDaysLT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldEntitledDays, v))
+}
+
+// EntitledDaysLTE applies the LTE predicate on the "entitled_days" field.
+func EntitledDaysLTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldEntitledDays, v))
+}
+
+// UsedDaysEQ applies the EQ predicate on the "used_days" field.
+func UsedDaysEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldEQ(FieldUsedDays, v))
+}
+
+// UsedDaysNEQ applies the NEQ predicate on the "used_days" field.
+func UsedDaysNEQ(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNEQ(FieldUsedDays, v))
+}
+
+// UsedDaysIn applies the In predicate on the "used_days" field.
+func UsedDaysIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldIn(FieldUsedDays, vs...))
+}
+
+// UsedDaysNotIn applies the NotIn predicate on the "used_days" field.
+func UsedDaysNotIn(vs ...int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldNotIn(FieldUsedDays, vs...))
+}
+
+// UsedDaysGT applies the GT predicate on the "used_days" field.
+func UsedDaysGT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGT(FieldUsedDays, v))
+}
+
+// UsedDaysGTE applies the GTE predicate on the "used_days" field.
+func UsedDaysGTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldGTE(FieldUsedDays, v))
+}
+
+// UsedDaysLT applies the LT predicate on the "used_days" field.
+func UsedDaysLT(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLT(FieldUsedDays, v))
+}
+
+// UsedDaysLTE applies the LTE predicate on the "used_days" field.
+func UsedDaysLTE(v int) predicate.LeaveBalance {
+	return predicate.LeaveBalance(sql.FieldLTE(FieldUsedDays, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaveType applies the HasEdge predicate on the "leave_type" edge.
+func HasLeaveType() predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeaveTypeTable, LeaveTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveTypeWith applies the HasEdge predicate on the "leave_type" edge with a given conditions (other predicates).
+func HasLeaveTypeWith(preds ...predicate.LeaveType) predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		step := newLeaveTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaveBalance) predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaveBalance) predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaveBalance) predicate.LeaveBalance {
+	return predicate.LeaveBalance(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
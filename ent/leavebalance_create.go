@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leavetype"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveBalanceCreate is the builder for creating a LeaveBalance entity.
+type LeaveBalanceCreate struct {
+	config
+	mutation *LeaveBalanceMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lbc *LeaveBalanceCreate) SetCreatedAt(t time.Time) *LeaveBalanceCreate {
+	lbc.mutation.SetCreatedAt(t)
+	return lbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lbc *LeaveBalanceCreate) SetNillableCreatedAt(t *time.Time) *LeaveBalanceCreate {
+	if t != nil {
+		lbc.SetCreatedAt(*t)
+	}
+	return lbc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lbc *LeaveBalanceCreate) SetModifiedAt(t time.Time) *LeaveBalanceCreate {
+	lbc.mutation.SetModifiedAt(t)
+	return lbc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (lbc *LeaveBalanceCreate) SetNillableModifiedAt(t *time.Time) *LeaveBalanceCreate {
+	if t != nil {
+		lbc.SetModifiedAt(*t)
+	}
+	return lbc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lbc *LeaveBalanceCreate) SetDeletedAt(t time.Time) *LeaveBalanceCreate {
+	lbc.mutation.SetDeletedAt(t)
+	return lbc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lbc *LeaveBalanceCreate) SetNillableDeletedAt(t *time.Time) *LeaveBalanceCreate {
+	if t != nil {
+		lbc.SetDeletedAt(*t)
+	}
+	return lbc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lbc *LeaveBalanceCreate) SetEmployeeID(u uint64) *LeaveBalanceCreate {
+	lbc.mutation.SetEmployeeID(u)
+	return lbc
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lbc *LeaveBalanceCreate) SetLeaveTypeID(u uint64) *LeaveBalanceCreate {
+	lbc.mutation.SetLeaveTypeID(u)
+	return lbc
+}
+
+// SetYear sets the "year" field.
+func (lbc *LeaveBalanceCreate) SetYear(i int) *LeaveBalanceCreate {
+	lbc.mutation.SetYear(i)
+	return lbc
+}
+
+// SetEntitledDays sets the "entitled_days" field.
+func (lbc *LeaveBalanceCreate) SetEntitledDays(i int) *LeaveBalanceCreate {
+	lbc.mutation.SetEntitledDays(i)
+	return lbc
+}
+
+// SetNillableEntitledDays sets the "entitled_days" field if the given value is not nil.
+func (lbc *LeaveBalanceCreate) SetNillableEntitledDays(i *int) *LeaveBalanceCreate {
+	if i != nil {
+		lbc.SetEntitledDays(*i)
+	}
+	return lbc
+}
+
+// SetUsedDays sets the "used_days" field.
+func (lbc *LeaveBalanceCreate) SetUsedDays(i int) *LeaveBalanceCreate {
+	lbc.mutation.SetUsedDays(i)
+	return lbc
+}
+
+// SetNillableUsedDays sets the "used_days" field if the given value is not nil.
+func (lbc *LeaveBalanceCreate) SetNillableUsedDays(i *int) *LeaveBalanceCreate {
+	if i != nil {
+		lbc.SetUsedDays(*i)
+	}
+	return lbc
+}
+
+// SetID sets the "id" field.
+func (lbc *LeaveBalanceCreate) SetID(u uint64) *LeaveBalanceCreate {
+	lbc.mutation.SetID(u)
+	return lbc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lbc *LeaveBalanceCreate) SetEmployee(e *Employee) *LeaveBalanceCreate {
+	return lbc.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lbc *LeaveBalanceCreate) SetLeaveType(l *LeaveType) *LeaveBalanceCreate {
+	return lbc.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveBalanceMutation object of the builder.
+func (lbc *LeaveBalanceCreate) Mutation() *LeaveBalanceMutation {
+	return lbc.mutation
+}
+
+// Save creates the LeaveBalance in the database.
+func (lbc *LeaveBalanceCreate) Save(ctx context.Context) (*LeaveBalance, error) {
+	lbc.defaults()
+	return withHooks(ctx, lbc.sqlSave, lbc.mutation, lbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lbc *LeaveBalanceCreate) SaveX(ctx context.Context) *LeaveBalance {
+	v, err := lbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lbc *LeaveBalanceCreate) Exec(ctx context.Context) error {
+	_, err := lbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbc *LeaveBalanceCreate) ExecX(ctx context.Context) {
+	if err := lbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lbc *LeaveBalanceCreate) defaults() {
+	if _, ok := lbc.mutation.CreatedAt(); !ok {
+		v := leavebalance.DefaultCreatedAt()
+		lbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lbc.mutation.ModifiedAt(); !ok {
+		v := leavebalance.DefaultModifiedAt()
+		lbc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := lbc.mutation.EntitledDays(); !ok {
+		v := leavebalance.DefaultEntitledDays
+		lbc.mutation.SetEntitledDays(v)
+	}
+	if _, ok := lbc.mutation.UsedDays(); !ok {
+		v := leavebalance.DefaultUsedDays
+		lbc.mutation.SetUsedDays(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lbc *LeaveBalanceCreate) check() error {
+	if _, ok := lbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaveBalance.created_at"`)}
+	}
+	if _, ok := lbc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "LeaveBalance.modified_at"`)}
+	}
+	if _, ok := lbc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "LeaveBalance.employee_id"`)}
+	}
+	if _, ok := lbc.mutation.LeaveTypeID(); !ok {
+		return &ValidationError{Name: "leave_type_id", err: errors.New(`ent: missing required field "LeaveBalance.leave_type_id"`)}
+	}
+	if _, ok := lbc.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "LeaveBalance.year"`)}
+	}
+	if _, ok := lbc.mutation.EntitledDays(); !ok {
+		return &ValidationError{Name: "entitled_days", err: errors.New(`ent: missing required field "LeaveBalance.entitled_days"`)}
+	}
+	if v, ok := lbc.mutation.EntitledDays(); ok {
+		if err := leavebalance.EntitledDaysValidator(v); err != nil {
+			return &ValidationError{Name: "entitled_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.entitled_days": %w`, err)}
+		}
+	}
+	if _, ok := lbc.mutation.UsedDays(); !ok {
+		return &ValidationError{Name: "used_days", err: errors.New(`ent: missing required field "LeaveBalance.used_days"`)}
+	}
+	if v, ok := lbc.mutation.UsedDays(); ok {
+		if err := leavebalance.UsedDaysValidator(v); err != nil {
+			return &ValidationError{Name: "used_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.used_days": %w`, err)}
+		}
+	}
+	if _, ok := lbc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "LeaveBalance.employee"`)}
+	}
+	if _, ok := lbc.mutation.LeaveTypeID(); !ok {
+		return &ValidationError{Name: "leave_type", err: errors.New(`ent: missing required edge "LeaveBalance.leave_type"`)}
+	}
+	return nil
+}
+
+func (lbc *LeaveBalanceCreate) sqlSave(ctx context.Context) (*LeaveBalance, error) {
+	if err := lbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	lbc.mutation.id = &_node.ID
+	lbc.mutation.done = true
+	return _node, nil
+}
+
+func (lbc *LeaveBalanceCreate) createSpec() (*LeaveBalance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveBalance{config: lbc.config}
+		_spec = sqlgraph.NewCreateSpec(leavebalance.Table, sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64))
+	)
+	if id, ok := lbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lbc.mutation.CreatedAt(); ok {
+		_spec.SetField(leavebalance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lbc.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavebalance.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := lbc.mutation.DeletedAt(); ok {
+		_spec.SetField(leavebalance.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := lbc.mutation.Year(); ok {
+		_spec.SetField(leavebalance.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := lbc.mutation.EntitledDays(); ok {
+		_spec.SetField(leavebalance.FieldEntitledDays, field.TypeInt, value)
+		_node.EntitledDays = value
+	}
+	if value, ok := lbc.mutation.UsedDays(); ok {
+		_spec.SetField(leavebalance.FieldUsedDays, field.TypeInt, value)
+		_node.UsedDays = value
+	}
+	if nodes := lbc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.EmployeeTable,
+			Columns: []string{leavebalance.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lbc.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.LeaveTypeTable,
+			Columns: []string{leavebalance.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeaveTypeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaveBalanceCreateBulk is the builder for creating many LeaveBalance entities in bulk.
+type LeaveBalanceCreateBulk struct {
+	config
+	builders []*LeaveBalanceCreate
+}
+
+// Save creates the LeaveBalance entities in the database.
+func (lbcb *LeaveBalanceCreateBulk) Save(ctx context.Context) ([]*LeaveBalance, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lbcb.builders))
+	nodes := make([]*LeaveBalance, len(lbcb.builders))
+	mutators := make([]Mutator, len(lbcb.builders))
+	for i := range lbcb.builders {
+		func(i int, root context.Context) {
+			builder := lbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveBalanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lbcb *LeaveBalanceCreateBulk) SaveX(ctx context.Context) []*LeaveBalance {
+	v, err := lbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lbcb *LeaveBalanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := lbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbcb *LeaveBalanceCreateBulk) ExecX(ctx context.Context) {
+	if err := lbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
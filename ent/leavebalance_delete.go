@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveBalanceDelete is the builder for deleting a LeaveBalance entity.
+type LeaveBalanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *LeaveBalanceMutation
+}
+
+// Where appends a list predicates to the LeaveBalanceDelete builder.
+func (lbd *LeaveBalanceDelete) Where(ps ...predicate.LeaveBalance) *LeaveBalanceDelete {
+	lbd.mutation.Where(ps...)
+	return lbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lbd *LeaveBalanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lbd.sqlExec, lbd.mutation, lbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbd *LeaveBalanceDelete) ExecX(ctx context.Context) int {
+	n, err := lbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lbd *LeaveBalanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(leavebalance.Table, sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64))
+	if ps := lbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lbd.mutation.done = true
+	return affected, err
+}
+
+// LeaveBalanceDeleteOne is the builder for deleting a single LeaveBalance entity.
+type LeaveBalanceDeleteOne struct {
+	lbd *LeaveBalanceDelete
+}
+
+// Where appends a list predicates to the LeaveBalanceDelete builder.
+func (lbdo *LeaveBalanceDeleteOne) Where(ps ...predicate.LeaveBalance) *LeaveBalanceDeleteOne {
+	lbdo.lbd.mutation.Where(ps...)
+	return lbdo
+}
+
+// Exec executes the deletion query.
+func (lbdo *LeaveBalanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := lbdo.lbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{leavebalance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbdo *LeaveBalanceDeleteOne) ExecX(ctx context.Context) {
+	if err := lbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveBalanceQuery is the builder for querying LeaveBalance entities.
+type LeaveBalanceQuery struct {
+	config
+	ctx           *QueryContext
+	order         []leavebalance.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.LeaveBalance
+	withEmployee  *EmployeeQuery
+	withLeaveType *LeaveTypeQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaveBalanceQuery builder.
+func (lbq *LeaveBalanceQuery) Where(ps ...predicate.LeaveBalance) *LeaveBalanceQuery {
+	lbq.predicates = append(lbq.predicates, ps...)
+	return lbq
+}
+
+// Limit the number of records to be returned by this query.
+func (lbq *LeaveBalanceQuery) Limit(limit int) *LeaveBalanceQuery {
+	lbq.ctx.Limit = &limit
+	return lbq
+}
+
+// Offset to start from.
+func (lbq *LeaveBalanceQuery) Offset(offset int) *LeaveBalanceQuery {
+	lbq.ctx.Offset = &offset
+	return lbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lbq *LeaveBalanceQuery) Unique(unique bool) *LeaveBalanceQuery {
+	lbq.ctx.Unique = &unique
+	return lbq
+}
+
+// Order specifies how the records should be ordered.
+func (lbq *LeaveBalanceQuery) Order(o ...leavebalance.OrderOption) *LeaveBalanceQuery {
+	lbq.order = append(lbq.order, o...)
+	return lbq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (lbq *LeaveBalanceQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: lbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavebalance.Table, leavebalance.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leavebalance.EmployeeTable, leavebalance.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveType chains the current query on the "leave_type" edge.
+func (lbq *LeaveBalanceQuery) QueryLeaveType() *LeaveTypeQuery {
+	query := (&LeaveTypeClient{config: lbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavebalance.Table, leavebalance.FieldID, selector),
+			sqlgraph.To(leavetype.Table, leavetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leavebalance.LeaveTypeTable, leavebalance.LeaveTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LeaveBalance entity from the query.
+// Returns a *NotFoundError when no LeaveBalance was found.
+func (lbq *LeaveBalanceQuery) First(ctx context.Context) (*LeaveBalance, error) {
+	nodes, err := lbq.Limit(1).All(setContextOp(ctx, lbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leavebalance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) FirstX(ctx context.Context) *LeaveBalance {
+	node, err := lbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeaveBalance ID from the query.
+// Returns a *NotFoundError when no LeaveBalance ID was found.
+func (lbq *LeaveBalanceQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = lbq.Limit(1).IDs(setContextOp(ctx, lbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leavebalance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := lbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeaveBalance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LeaveBalance entity is found.
+// Returns a *NotFoundError when no LeaveBalance entities are found.
+func (lbq *LeaveBalanceQuery) Only(ctx context.Context) (*LeaveBalance, error) {
+	nodes, err := lbq.Limit(2).All(setContextOp(ctx, lbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leavebalance.Label}
+	default:
+		return nil, &NotSingularError{leavebalance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) OnlyX(ctx context.Context) *LeaveBalance {
+	node, err := lbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeaveBalance ID in the query.
+// Returns a *NotSingularError when more than one LeaveBalance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lbq *LeaveBalanceQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = lbq.Limit(2).IDs(setContextOp(ctx, lbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leavebalance.Label}
+	default:
+		err = &NotSingularError{leavebalance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := lbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeaveBalances.
+func (lbq *LeaveBalanceQuery) All(ctx context.Context) ([]*LeaveBalance, error) {
+	ctx = setContextOp(ctx, lbq.ctx, "All")
+	if err := lbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LeaveBalance, *LeaveBalanceQuery]()
+	return withInterceptors[[]*LeaveBalance](ctx, lbq, qr, lbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) AllX(ctx context.Context) []*LeaveBalance {
+	nodes, err := lbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeaveBalance IDs.
+func (lbq *LeaveBalanceQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if lbq.ctx.Unique == nil && lbq.path != nil {
+		lbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lbq.ctx, "IDs")
+	if err = lbq.Select(leavebalance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := lbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lbq *LeaveBalanceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lbq.ctx, "Count")
+	if err := lbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lbq, querierCount[*LeaveBalanceQuery](), lbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) CountX(ctx context.Context) int {
+	count, err := lbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lbq *LeaveBalanceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lbq.ctx, "Exist")
+	switch _, err := lbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lbq *LeaveBalanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := lbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaveBalanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lbq *LeaveBalanceQuery) Clone() *LeaveBalanceQuery {
+	if lbq == nil {
+		return nil
+	}
+	return &LeaveBalanceQuery{
+		config:        lbq.config,
+		ctx:           lbq.ctx.Clone(),
+		order:         append([]leavebalance.OrderOption{}, lbq.order...),
+		inters:        append([]Interceptor{}, lbq.inters...),
+		predicates:    append([]predicate.LeaveBalance{}, lbq.predicates...),
+		withEmployee:  lbq.withEmployee.Clone(),
+		withLeaveType: lbq.withLeaveType.Clone(),
+		// clone intermediate query.
+		sql:  lbq.sql.Clone(),
+		path: lbq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (lbq *LeaveBalanceQuery) WithEmployee(opts ...func(*EmployeeQuery)) *LeaveBalanceQuery {
+	query := (&EmployeeClient{config: lbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lbq.withEmployee = query
+	return lbq
+}
+
+// WithLeaveType tells the query-builder to eager-load the nodes that are connected to
+// the "leave_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (lbq *LeaveBalanceQuery) WithLeaveType(opts ...func(*LeaveTypeQuery)) *LeaveBalanceQuery {
+	query := (&LeaveTypeClient{config: lbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lbq.withLeaveType = query
+	return lbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeaveBalance.Query().
+//		GroupBy(leavebalance.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lbq *LeaveBalanceQuery) GroupBy(field string, fields ...string) *LeaveBalanceGroupBy {
+	lbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LeaveBalanceGroupBy{build: lbq}
+	grbuild.flds = &lbq.ctx.Fields
+	grbuild.label = leavebalance.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LeaveBalance.Query().
+//		Select(leavebalance.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (lbq *LeaveBalanceQuery) Select(fields ...string) *LeaveBalanceSelect {
+	lbq.ctx.Fields = append(lbq.ctx.Fields, fields...)
+	sbuild := &LeaveBalanceSelect{LeaveBalanceQuery: lbq}
+	sbuild.label = leavebalance.Label
+	sbuild.flds, sbuild.scan = &lbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LeaveBalanceSelect configured with the given aggregations.
+func (lbq *LeaveBalanceQuery) Aggregate(fns ...AggregateFunc) *LeaveBalanceSelect {
+	return lbq.Select().Aggregate(fns...)
+}
+
+func (lbq *LeaveBalanceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lbq.ctx.Fields {
+		if !leavebalance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lbq.path != nil {
+		prev, err := lbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lbq.sql = prev
+	}
+	return nil
+}
+
+func (lbq *LeaveBalanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LeaveBalance, error) {
+	var (
+		nodes       = []*LeaveBalance{}
+		_spec       = lbq.querySpec()
+		loadedTypes = [2]bool{
+			lbq.withEmployee != nil,
+			lbq.withLeaveType != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LeaveBalance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LeaveBalance{config: lbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(lbq.modifiers) > 0 {
+		_spec.Modifiers = lbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lbq.withEmployee; query != nil {
+		if err := lbq.loadEmployee(ctx, query, nodes, nil,
+			func(n *LeaveBalance, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lbq.withLeaveType; query != nil {
+		if err := lbq.loadLeaveType(ctx, query, nodes, nil,
+			func(n *LeaveBalance, e *LeaveType) { n.Edges.LeaveType = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lbq *LeaveBalanceQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*LeaveBalance, init func(*LeaveBalance), assign func(*LeaveBalance, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*LeaveBalance)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (lbq *LeaveBalanceQuery) loadLeaveType(ctx context.Context, query *LeaveTypeQuery, nodes []*LeaveBalance, init func(*LeaveBalance), assign func(*LeaveBalance, *LeaveType)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*LeaveBalance)
+	for i := range nodes {
+		fk := nodes[i].LeaveTypeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(leavetype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "leave_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lbq *LeaveBalanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lbq.querySpec()
+	if len(lbq.modifiers) > 0 {
+		_spec.Modifiers = lbq.modifiers
+	}
+	_spec.Node.Columns = lbq.ctx.Fields
+	if len(lbq.ctx.Fields) > 0 {
+		_spec.Unique = lbq.ctx.Unique != nil && *lbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lbq.driver, _spec)
+}
+
+func (lbq *LeaveBalanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(leavebalance.Table, leavebalance.Columns, sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64))
+	_spec.From = lbq.sql
+	if unique := lbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavebalance.FieldID)
+		for i := range fields {
+			if fields[i] != leavebalance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if lbq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(leavebalance.FieldEmployeeID)
+		}
+		if lbq.withLeaveType != nil {
+			_spec.Node.AddColumnOnce(leavebalance.FieldLeaveTypeID)
+		}
+	}
+	if ps := lbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lbq *LeaveBalanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lbq.driver.Dialect())
+	t1 := builder.Table(leavebalance.Table)
+	columns := lbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = leavebalance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lbq.sql != nil {
+		selector = lbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lbq.ctx.Unique != nil && *lbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range lbq.modifiers {
+		m(selector)
+	}
+	for _, p := range lbq.predicates {
+		p(selector)
+	}
+	for _, p := range lbq.order {
+		p(selector)
+	}
+	if offset := lbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lbq *LeaveBalanceQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaveBalanceSelect {
+	lbq.modifiers = append(lbq.modifiers, modifiers...)
+	return lbq.Select()
+}
+
+// LeaveBalanceGroupBy is the group-by builder for LeaveBalance entities.
+type LeaveBalanceGroupBy struct {
+	selector
+	build *LeaveBalanceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lbgb *LeaveBalanceGroupBy) Aggregate(fns ...AggregateFunc) *LeaveBalanceGroupBy {
+	lbgb.fns = append(lbgb.fns, fns...)
+	return lbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lbgb *LeaveBalanceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lbgb.build.ctx, "GroupBy")
+	if err := lbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveBalanceQuery, *LeaveBalanceGroupBy](ctx, lbgb.build, lbgb, lbgb.build.inters, v)
+}
+
+func (lbgb *LeaveBalanceGroupBy) sqlScan(ctx context.Context, root *LeaveBalanceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lbgb.fns))
+	for _, fn := range lbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lbgb.flds)+len(lbgb.fns))
+		for _, f := range *lbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LeaveBalanceSelect is the builder for selecting fields of LeaveBalance entities.
+type LeaveBalanceSelect struct {
+	*LeaveBalanceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lbs *LeaveBalanceSelect) Aggregate(fns ...AggregateFunc) *LeaveBalanceSelect {
+	lbs.fns = append(lbs.fns, fns...)
+	return lbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lbs *LeaveBalanceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lbs.ctx, "Select")
+	if err := lbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveBalanceQuery, *LeaveBalanceSelect](ctx, lbs.LeaveBalanceQuery, lbs, lbs.inters, v)
+}
+
+func (lbs *LeaveBalanceSelect) sqlScan(ctx context.Context, root *LeaveBalanceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lbs.fns))
+	for _, fn := range lbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lbs *LeaveBalanceSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaveBalanceSelect {
+	lbs.modifiers = append(lbs.modifiers, modifiers...)
+	return lbs
+}
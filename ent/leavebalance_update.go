@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveBalanceUpdate is the builder for updating LeaveBalance entities.
+type LeaveBalanceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveBalanceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveBalanceUpdate builder.
+func (lbu *LeaveBalanceUpdate) Where(ps ...predicate.LeaveBalance) *LeaveBalanceUpdate {
+	lbu.mutation.Where(ps...)
+	return lbu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lbu *LeaveBalanceUpdate) SetModifiedAt(t time.Time) *LeaveBalanceUpdate {
+	lbu.mutation.SetModifiedAt(t)
+	return lbu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lbu *LeaveBalanceUpdate) SetDeletedAt(t time.Time) *LeaveBalanceUpdate {
+	lbu.mutation.SetDeletedAt(t)
+	return lbu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lbu *LeaveBalanceUpdate) SetNillableDeletedAt(t *time.Time) *LeaveBalanceUpdate {
+	if t != nil {
+		lbu.SetDeletedAt(*t)
+	}
+	return lbu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (lbu *LeaveBalanceUpdate) ClearDeletedAt() *LeaveBalanceUpdate {
+	lbu.mutation.ClearDeletedAt()
+	return lbu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lbu *LeaveBalanceUpdate) SetEmployeeID(u uint64) *LeaveBalanceUpdate {
+	lbu.mutation.SetEmployeeID(u)
+	return lbu
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lbu *LeaveBalanceUpdate) SetLeaveTypeID(u uint64) *LeaveBalanceUpdate {
+	lbu.mutation.SetLeaveTypeID(u)
+	return lbu
+}
+
+// SetYear sets the "year" field.
+func (lbu *LeaveBalanceUpdate) SetYear(i int) *LeaveBalanceUpdate {
+	lbu.mutation.ResetYear()
+	lbu.mutation.SetYear(i)
+	return lbu
+}
+
+// AddYear adds i to the "year" field.
+func (lbu *LeaveBalanceUpdate) AddYear(i int) *LeaveBalanceUpdate {
+	lbu.mutation.AddYear(i)
+	return lbu
+}
+
+// SetEntitledDays sets the "entitled_days" field.
+func (lbu *LeaveBalanceUpdate) SetEntitledDays(i int) *LeaveBalanceUpdate {
+	lbu.mutation.ResetEntitledDays()
+	lbu.mutation.SetEntitledDays(i)
+	return lbu
+}
+
+// SetNillableEntitledDays sets the "entitled_days" field if the given value is not nil.
+func (lbu *LeaveBalanceUpdate) SetNillableEntitledDays(i *int) *LeaveBalanceUpdate {
+	if i != nil {
+		lbu.SetEntitledDays(*i)
+	}
+	return lbu
+}
+
+// AddEntitledDays adds i to the "entitled_days" field.
+func (lbu *LeaveBalanceUpdate) AddEntitledDays(i int) *LeaveBalanceUpdate {
+	lbu.mutation.AddEntitledDays(i)
+	return lbu
+}
+
+// SetUsedDays sets the "used_days" field.
+func (lbu *LeaveBalanceUpdate) SetUsedDays(i int) *LeaveBalanceUpdate {
+	lbu.mutation.ResetUsedDays()
+	lbu.mutation.SetUsedDays(i)
+	return lbu
+}
+
+// SetNillableUsedDays sets the "used_days" field if the given value is not nil.
+func (lbu *LeaveBalanceUpdate) SetNillableUsedDays(i *int) *LeaveBalanceUpdate {
+	if i != nil {
+		lbu.SetUsedDays(*i)
+	}
+	return lbu
+}
+
+// AddUsedDays adds i to the "used_days" field.
+func (lbu *LeaveBalanceUpdate) AddUsedDays(i int) *LeaveBalanceUpdate {
+	lbu.mutation.AddUsedDays(i)
+	return lbu
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lbu *LeaveBalanceUpdate) SetEmployee(e *Employee) *LeaveBalanceUpdate {
+	return lbu.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lbu *LeaveBalanceUpdate) SetLeaveType(l *LeaveType) *LeaveBalanceUpdate {
+	return lbu.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveBalanceMutation object of the builder.
+func (lbu *LeaveBalanceUpdate) Mutation() *LeaveBalanceMutation {
+	return lbu.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (lbu *LeaveBalanceUpdate) ClearEmployee() *LeaveBalanceUpdate {
+	lbu.mutation.ClearEmployee()
+	return lbu
+}
+
+// ClearLeaveType clears the "leave_type" edge to the LeaveType entity.
+func (lbu *LeaveBalanceUpdate) ClearLeaveType() *LeaveBalanceUpdate {
+	lbu.mutation.ClearLeaveType()
+	return lbu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lbu *LeaveBalanceUpdate) Save(ctx context.Context) (int, error) {
+	lbu.defaults()
+	return withHooks(ctx, lbu.sqlSave, lbu.mutation, lbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lbu *LeaveBalanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := lbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lbu *LeaveBalanceUpdate) Exec(ctx context.Context) error {
+	_, err := lbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbu *LeaveBalanceUpdate) ExecX(ctx context.Context) {
+	if err := lbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lbu *LeaveBalanceUpdate) defaults() {
+	if _, ok := lbu.mutation.ModifiedAt(); !ok {
+		v := leavebalance.UpdateDefaultModifiedAt()
+		lbu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lbu *LeaveBalanceUpdate) check() error {
+	if v, ok := lbu.mutation.EntitledDays(); ok {
+		if err := leavebalance.EntitledDaysValidator(v); err != nil {
+			return &ValidationError{Name: "entitled_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.entitled_days": %w`, err)}
+		}
+	}
+	if v, ok := lbu.mutation.UsedDays(); ok {
+		if err := leavebalance.UsedDaysValidator(v); err != nil {
+			return &ValidationError{Name: "used_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.used_days": %w`, err)}
+		}
+	}
+	if _, ok := lbu.mutation.EmployeeID(); lbu.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveBalance.employee"`)
+	}
+	if _, ok := lbu.mutation.LeaveTypeID(); lbu.mutation.LeaveTypeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveBalance.leave_type"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lbu *LeaveBalanceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveBalanceUpdate {
+	lbu.modifiers = append(lbu.modifiers, modifiers...)
+	return lbu
+}
+
+func (lbu *LeaveBalanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavebalance.Table, leavebalance.Columns, sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64))
+	if ps := lbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lbu.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavebalance.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := lbu.mutation.DeletedAt(); ok {
+		_spec.SetField(leavebalance.FieldDeletedAt, field.TypeTime, value)
+	}
+	if lbu.mutation.DeletedAtCleared() {
+		_spec.ClearField(leavebalance.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := lbu.mutation.Year(); ok {
+		_spec.SetField(leavebalance.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.AddedYear(); ok {
+		_spec.AddField(leavebalance.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.EntitledDays(); ok {
+		_spec.SetField(leavebalance.FieldEntitledDays, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.AddedEntitledDays(); ok {
+		_spec.AddField(leavebalance.FieldEntitledDays, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.UsedDays(); ok {
+		_spec.SetField(leavebalance.FieldUsedDays, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.AddedUsedDays(); ok {
+		_spec.AddField(leavebalance.FieldUsedDays, field.TypeInt, value)
+	}
+	if lbu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.EmployeeTable,
+			Columns: []string{leavebalance.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lbu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.EmployeeTable,
+			Columns: []string{leavebalance.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lbu.mutation.LeaveTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.LeaveTypeTable,
+			Columns: []string{leavebalance.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lbu.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.LeaveTypeTable,
+			Columns: []string{leavebalance.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(lbu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavebalance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lbu.mutation.done = true
+	return n, nil
+}
+
+// LeaveBalanceUpdateOne is the builder for updating a single LeaveBalance entity.
+type LeaveBalanceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveBalanceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lbuo *LeaveBalanceUpdateOne) SetModifiedAt(t time.Time) *LeaveBalanceUpdateOne {
+	lbuo.mutation.SetModifiedAt(t)
+	return lbuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lbuo *LeaveBalanceUpdateOne) SetDeletedAt(t time.Time) *LeaveBalanceUpdateOne {
+	lbuo.mutation.SetDeletedAt(t)
+	return lbuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lbuo *LeaveBalanceUpdateOne) SetNillableDeletedAt(t *time.Time) *LeaveBalanceUpdateOne {
+	if t != nil {
+		lbuo.SetDeletedAt(*t)
+	}
+	return lbuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (lbuo *LeaveBalanceUpdateOne) ClearDeletedAt() *LeaveBalanceUpdateOne {
+	lbuo.mutation.ClearDeletedAt()
+	return lbuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lbuo *LeaveBalanceUpdateOne) SetEmployeeID(u uint64) *LeaveBalanceUpdateOne {
+	lbuo.mutation.SetEmployeeID(u)
+	return lbuo
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lbuo *LeaveBalanceUpdateOne) SetLeaveTypeID(u uint64) *LeaveBalanceUpdateOne {
+	lbuo.mutation.SetLeaveTypeID(u)
+	return lbuo
+}
+
+// SetYear sets the "year" field.
+func (lbuo *LeaveBalanceUpdateOne) SetYear(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.ResetYear()
+	lbuo.mutation.SetYear(i)
+	return lbuo
+}
+
+// AddYear adds i to the "year" field.
+func (lbuo *LeaveBalanceUpdateOne) AddYear(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.AddYear(i)
+	return lbuo
+}
+
+// SetEntitledDays sets the "entitled_days" field.
+func (lbuo *LeaveBalanceUpdateOne) SetEntitledDays(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.ResetEntitledDays()
+	lbuo.mutation.SetEntitledDays(i)
+	return lbuo
+}
+
+// SetNillableEntitledDays sets the "entitled_days" field if the given value is not nil.
+func (lbuo *LeaveBalanceUpdateOne) SetNillableEntitledDays(i *int) *LeaveBalanceUpdateOne {
+	if i != nil {
+		lbuo.SetEntitledDays(*i)
+	}
+	return lbuo
+}
+
+// AddEntitledDays adds i to the "entitled_days" field.
+func (lbuo *LeaveBalanceUpdateOne) AddEntitledDays(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.AddEntitledDays(i)
+	return lbuo
+}
+
+// SetUsedDays sets the "used_days" field.
+func (lbuo *LeaveBalanceUpdateOne) SetUsedDays(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.ResetUsedDays()
+	lbuo.mutation.SetUsedDays(i)
+	return lbuo
+}
+
+// SetNillableUsedDays sets the "used_days" field if the given value is not nil.
+func (lbuo *LeaveBalanceUpdateOne) SetNillableUsedDays(i *int) *LeaveBalanceUpdateOne {
+	if i != nil {
+		lbuo.SetUsedDays(*i)
+	}
+	return lbuo
+}
+
+// AddUsedDays adds i to the "used_days" field.
+func (lbuo *LeaveBalanceUpdateOne) AddUsedDays(i int) *LeaveBalanceUpdateOne {
+	lbuo.mutation.AddUsedDays(i)
+	return lbuo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lbuo *LeaveBalanceUpdateOne) SetEmployee(e *Employee) *LeaveBalanceUpdateOne {
+	return lbuo.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lbuo *LeaveBalanceUpdateOne) SetLeaveType(l *LeaveType) *LeaveBalanceUpdateOne {
+	return lbuo.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveBalanceMutation object of the builder.
+func (lbuo *LeaveBalanceUpdateOne) Mutation() *LeaveBalanceMutation {
+	return lbuo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (lbuo *LeaveBalanceUpdateOne) ClearEmployee() *LeaveBalanceUpdateOne {
+	lbuo.mutation.ClearEmployee()
+	return lbuo
+}
+
+// ClearLeaveType clears the "leave_type" edge to the LeaveType entity.
+func (lbuo *LeaveBalanceUpdateOne) ClearLeaveType() *LeaveBalanceUpdateOne {
+	lbuo.mutation.ClearLeaveType()
+	return lbuo
+}
+
+// Where appends a list predicates to the LeaveBalanceUpdate builder.
+func (lbuo *LeaveBalanceUpdateOne) Where(ps ...predicate.LeaveBalance) *LeaveBalanceUpdateOne {
+	lbuo.mutation.Where(ps...)
+	return lbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lbuo *LeaveBalanceUpdateOne) Select(field string, fields ...string) *LeaveBalanceUpdateOne {
+	lbuo.fields = append([]string{field}, fields...)
+	return lbuo
+}
+
+// Save executes the query and returns the updated LeaveBalance entity.
+func (lbuo *LeaveBalanceUpdateOne) Save(ctx context.Context) (*LeaveBalance, error) {
+	lbuo.defaults()
+	return withHooks(ctx, lbuo.sqlSave, lbuo.mutation, lbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lbuo *LeaveBalanceUpdateOne) SaveX(ctx context.Context) *LeaveBalance {
+	node, err := lbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lbuo *LeaveBalanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := lbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lbuo *LeaveBalanceUpdateOne) ExecX(ctx context.Context) {
+	if err := lbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lbuo *LeaveBalanceUpdateOne) defaults() {
+	if _, ok := lbuo.mutation.ModifiedAt(); !ok {
+		v := leavebalance.UpdateDefaultModifiedAt()
+		lbuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lbuo *LeaveBalanceUpdateOne) check() error {
+	if v, ok := lbuo.mutation.EntitledDays(); ok {
+		if err := leavebalance.EntitledDaysValidator(v); err != nil {
+			return &ValidationError{Name: "entitled_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.entitled_days": %w`, err)}
+		}
+	}
+	if v, ok := lbuo.mutation.UsedDays(); ok {
+		if err := leavebalance.UsedDaysValidator(v); err != nil {
+			return &ValidationError{Name: "used_days", err: fmt.Errorf(`ent: validator failed for field "LeaveBalance.used_days": %w`, err)}
+		}
+	}
+	if _, ok := lbuo.mutation.EmployeeID(); lbuo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveBalance.employee"`)
+	}
+	if _, ok := lbuo.mutation.LeaveTypeID(); lbuo.mutation.LeaveTypeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveBalance.leave_type"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lbuo *LeaveBalanceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveBalanceUpdateOne {
+	lbuo.modifiers = append(lbuo.modifiers, modifiers...)
+	return lbuo
+}
+
+func (lbuo *LeaveBalanceUpdateOne) sqlSave(ctx context.Context) (_node *LeaveBalance, err error) {
+	if err := lbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavebalance.Table, leavebalance.Columns, sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64))
+	id, ok := lbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveBalance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavebalance.FieldID)
+		for _, f := range fields {
+			if !leavebalance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leavebalance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lbuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavebalance.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := lbuo.mutation.DeletedAt(); ok {
+		_spec.SetField(leavebalance.FieldDeletedAt, field.TypeTime, value)
+	}
+	if lbuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(leavebalance.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := lbuo.mutation.Year(); ok {
+		_spec.SetField(leavebalance.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.AddedYear(); ok {
+		_spec.AddField(leavebalance.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.EntitledDays(); ok {
+		_spec.SetField(leavebalance.FieldEntitledDays, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.AddedEntitledDays(); ok {
+		_spec.AddField(leavebalance.FieldEntitledDays, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.UsedDays(); ok {
+		_spec.SetField(leavebalance.FieldUsedDays, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.AddedUsedDays(); ok {
+		_spec.AddField(leavebalance.FieldUsedDays, field.TypeInt, value)
+	}
+	if lbuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.EmployeeTable,
+			Columns: []string{leavebalance.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lbuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.EmployeeTable,
+			Columns: []string{leavebalance.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lbuo.mutation.LeaveTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.LeaveTypeTable,
+			Columns: []string{leavebalance.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lbuo.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leavebalance.LeaveTypeTable,
+			Columns: []string{leavebalance.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(lbuo.modifiers...)
+	_node = &LeaveBalance{config: lbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavebalance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lbuo.mutation.done = true
+	return _node, nil
+}
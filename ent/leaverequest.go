@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaveRequest is the model entity for the LeaveRequest schema.
+type LeaveRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to employees table
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Foreign key to leave_types table
+	LeaveTypeID uint64 `json:"leave_type_id,omitempty"`
+	// First day of leave
+	StartDate time.Time `json:"start_date,omitempty"`
+	// Last day of leave
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Working days covered by the request
+	TotalDays int `json:"total_days,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status leaverequest.Status `json:"status,omitempty"`
+	// User who approved or rejected the request
+	ReviewedBy *uint64 `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes string `json:"review_notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaveRequestQuery when eager-loading is set.
+	Edges        LeaveRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeaveRequestEdges holds the relations/edges for other nodes in the graph.
+type LeaveRequestEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// LeaveType holds the value of the leave_type edge.
+	LeaveType *LeaveType `json:"leave_type,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveRequestEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[0] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// LeaveTypeOrErr returns the LeaveType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveRequestEdges) LeaveTypeOrErr() (*LeaveType, error) {
+	if e.loadedTypes[1] {
+		if e.LeaveType == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: leavetype.Label}
+		}
+		return e.LeaveType, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_type"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaveRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaverequest.FieldID, leaverequest.FieldEmployeeID, leaverequest.FieldLeaveTypeID, leaverequest.FieldTotalDays, leaverequest.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case leaverequest.FieldReason, leaverequest.FieldStatus, leaverequest.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case leaverequest.FieldCreatedAt, leaverequest.FieldModifiedAt, leaverequest.FieldDeletedAt, leaverequest.FieldStartDate, leaverequest.FieldEndDate, leaverequest.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaveRequest fields.
+func (lr *LeaveRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaverequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lr.ID = uint64(value.Int64)
+		case leaverequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lr.CreatedAt = value.Time
+			}
+		case leaverequest.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				lr.ModifiedAt = value.Time
+			}
+		case leaverequest.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				lr.DeletedAt = value.Time
+			}
+		case leaverequest.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				lr.EmployeeID = uint64(value.Int64)
+			}
+		case leaverequest.FieldLeaveTypeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leave_type_id", values[i])
+			} else if value.Valid {
+				lr.LeaveTypeID = uint64(value.Int64)
+			}
+		case leaverequest.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				lr.StartDate = value.Time
+			}
+		case leaverequest.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				lr.EndDate = value.Time
+			}
+		case leaverequest.FieldTotalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_days", values[i])
+			} else if value.Valid {
+				lr.TotalDays = int(value.Int64)
+			}
+		case leaverequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				lr.Reason = value.String
+			}
+		case leaverequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				lr.Status = leaverequest.Status(value.String)
+			}
+		case leaverequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				lr.ReviewedBy = new(uint64)
+				*lr.ReviewedBy = uint64(value.Int64)
+			}
+		case leaverequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				lr.ReviewedAt = new(time.Time)
+				*lr.ReviewedAt = value.Time
+			}
+		case leaverequest.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				lr.ReviewNotes = value.String
+			}
+		default:
+			lr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaveRequest.
+// This includes values selected through modifiers, order, etc.
+func (lr *LeaveRequest) Value(name string) (ent.Value, error) {
+	return lr.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the LeaveRequest entity.
+func (lr *LeaveRequest) QueryEmployee() *EmployeeQuery {
+	return NewLeaveRequestClient(lr.config).QueryEmployee(lr)
+}
+
+// QueryLeaveType queries the "leave_type" edge of the LeaveRequest entity.
+func (lr *LeaveRequest) QueryLeaveType() *LeaveTypeQuery {
+	return NewLeaveRequestClient(lr.config).QueryLeaveType(lr)
+}
+
+// Update returns a builder for updating this LeaveRequest.
+// Note that you need to call LeaveRequest.Unwrap() before calling this method if this LeaveRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lr *LeaveRequest) Update() *LeaveRequestUpdateOne {
+	return NewLeaveRequestClient(lr.config).UpdateOne(lr)
+}
+
+// Unwrap unwraps the LeaveRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lr *LeaveRequest) Unwrap() *LeaveRequest {
+	_tx, ok := lr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaveRequest is not a transactional entity")
+	}
+	lr.config.driver = _tx.drv
+	return lr
+}
+
+// String implements the fmt.Stringer.
+func (lr *LeaveRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaveRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(lr.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(lr.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", lr.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("leave_type_id=")
+	builder.WriteString(fmt.Sprintf("%v", lr.LeaveTypeID))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(lr.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(lr.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("total_days=")
+	builder.WriteString(fmt.Sprintf("%v", lr.TotalDays))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(lr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", lr.Status))
+	builder.WriteString(", ")
+	if v := lr.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := lr.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_notes=")
+	builder.WriteString(lr.ReviewNotes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaveRequests is a parsable slice of LeaveRequest.
+type LeaveRequests []*LeaveRequest
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestCreate is the builder for creating a LeaveRequest entity.
+type LeaveRequestCreate struct {
+	config
+	mutation *LeaveRequestMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lrc *LeaveRequestCreate) SetCreatedAt(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetCreatedAt(t)
+	return lrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableCreatedAt(t *time.Time) *LeaveRequestCreate {
+	if t != nil {
+		lrc.SetCreatedAt(*t)
+	}
+	return lrc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lrc *LeaveRequestCreate) SetModifiedAt(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetModifiedAt(t)
+	return lrc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableModifiedAt(t *time.Time) *LeaveRequestCreate {
+	if t != nil {
+		lrc.SetModifiedAt(*t)
+	}
+	return lrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lrc *LeaveRequestCreate) SetDeletedAt(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetDeletedAt(t)
+	return lrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableDeletedAt(t *time.Time) *LeaveRequestCreate {
+	if t != nil {
+		lrc.SetDeletedAt(*t)
+	}
+	return lrc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lrc *LeaveRequestCreate) SetEmployeeID(u uint64) *LeaveRequestCreate {
+	lrc.mutation.SetEmployeeID(u)
+	return lrc
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lrc *LeaveRequestCreate) SetLeaveTypeID(u uint64) *LeaveRequestCreate {
+	lrc.mutation.SetLeaveTypeID(u)
+	return lrc
+}
+
+// SetStartDate sets the "start_date" field.
+func (lrc *LeaveRequestCreate) SetStartDate(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetStartDate(t)
+	return lrc
+}
+
+// SetEndDate sets the "end_date" field.
+func (lrc *LeaveRequestCreate) SetEndDate(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetEndDate(t)
+	return lrc
+}
+
+// SetTotalDays sets the "total_days" field.
+func (lrc *LeaveRequestCreate) SetTotalDays(i int) *LeaveRequestCreate {
+	lrc.mutation.SetTotalDays(i)
+	return lrc
+}
+
+// SetNillableTotalDays sets the "total_days" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableTotalDays(i *int) *LeaveRequestCreate {
+	if i != nil {
+		lrc.SetTotalDays(*i)
+	}
+	return lrc
+}
+
+// SetReason sets the "reason" field.
+func (lrc *LeaveRequestCreate) SetReason(s string) *LeaveRequestCreate {
+	lrc.mutation.SetReason(s)
+	return lrc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableReason(s *string) *LeaveRequestCreate {
+	if s != nil {
+		lrc.SetReason(*s)
+	}
+	return lrc
+}
+
+// SetStatus sets the "status" field.
+func (lrc *LeaveRequestCreate) SetStatus(l leaverequest.Status) *LeaveRequestCreate {
+	lrc.mutation.SetStatus(l)
+	return lrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableStatus(l *leaverequest.Status) *LeaveRequestCreate {
+	if l != nil {
+		lrc.SetStatus(*l)
+	}
+	return lrc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (lrc *LeaveRequestCreate) SetReviewedBy(u uint64) *LeaveRequestCreate {
+	lrc.mutation.SetReviewedBy(u)
+	return lrc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableReviewedBy(u *uint64) *LeaveRequestCreate {
+	if u != nil {
+		lrc.SetReviewedBy(*u)
+	}
+	return lrc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (lrc *LeaveRequestCreate) SetReviewedAt(t time.Time) *LeaveRequestCreate {
+	lrc.mutation.SetReviewedAt(t)
+	return lrc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableReviewedAt(t *time.Time) *LeaveRequestCreate {
+	if t != nil {
+		lrc.SetReviewedAt(*t)
+	}
+	return lrc
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (lrc *LeaveRequestCreate) SetReviewNotes(s string) *LeaveRequestCreate {
+	lrc.mutation.SetReviewNotes(s)
+	return lrc
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (lrc *LeaveRequestCreate) SetNillableReviewNotes(s *string) *LeaveRequestCreate {
+	if s != nil {
+		lrc.SetReviewNotes(*s)
+	}
+	return lrc
+}
+
+// SetID sets the "id" field.
+func (lrc *LeaveRequestCreate) SetID(u uint64) *LeaveRequestCreate {
+	lrc.mutation.SetID(u)
+	return lrc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lrc *LeaveRequestCreate) SetEmployee(e *Employee) *LeaveRequestCreate {
+	return lrc.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lrc *LeaveRequestCreate) SetLeaveType(l *LeaveType) *LeaveRequestCreate {
+	return lrc.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (lrc *LeaveRequestCreate) Mutation() *LeaveRequestMutation {
+	return lrc.mutation
+}
+
+// Save creates the LeaveRequest in the database.
+func (lrc *LeaveRequestCreate) Save(ctx context.Context) (*LeaveRequest, error) {
+	lrc.defaults()
+	return withHooks(ctx, lrc.sqlSave, lrc.mutation, lrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lrc *LeaveRequestCreate) SaveX(ctx context.Context) *LeaveRequest {
+	v, err := lrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lrc *LeaveRequestCreate) Exec(ctx context.Context) error {
+	_, err := lrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrc *LeaveRequestCreate) ExecX(ctx context.Context) {
+	if err := lrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lrc *LeaveRequestCreate) defaults() {
+	if _, ok := lrc.mutation.CreatedAt(); !ok {
+		v := leaverequest.DefaultCreatedAt()
+		lrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lrc.mutation.ModifiedAt(); !ok {
+		v := leaverequest.DefaultModifiedAt()
+		lrc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := lrc.mutation.TotalDays(); !ok {
+		v := leaverequest.DefaultTotalDays
+		lrc.mutation.SetTotalDays(v)
+	}
+	if _, ok := lrc.mutation.Status(); !ok {
+		v := leaverequest.DefaultStatus
+		lrc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lrc *LeaveRequestCreate) check() error {
+	if _, ok := lrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaveRequest.created_at"`)}
+	}
+	if _, ok := lrc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "LeaveRequest.modified_at"`)}
+	}
+	if _, ok := lrc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "LeaveRequest.employee_id"`)}
+	}
+	if _, ok := lrc.mutation.LeaveTypeID(); !ok {
+		return &ValidationError{Name: "leave_type_id", err: errors.New(`ent: missing required field "LeaveRequest.leave_type_id"`)}
+	}
+	if _, ok := lrc.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "LeaveRequest.start_date"`)}
+	}
+	if _, ok := lrc.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "LeaveRequest.end_date"`)}
+	}
+	if _, ok := lrc.mutation.TotalDays(); !ok {
+		return &ValidationError{Name: "total_days", err: errors.New(`ent: missing required field "LeaveRequest.total_days"`)}
+	}
+	if _, ok := lrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LeaveRequest.status"`)}
+	}
+	if v, ok := lrc.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := lrc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "LeaveRequest.employee"`)}
+	}
+	if _, ok := lrc.mutation.LeaveTypeID(); !ok {
+		return &ValidationError{Name: "leave_type", err: errors.New(`ent: missing required edge "LeaveRequest.leave_type"`)}
+	}
+	return nil
+}
+
+func (lrc *LeaveRequestCreate) sqlSave(ctx context.Context) (*LeaveRequest, error) {
+	if err := lrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	lrc.mutation.id = &_node.ID
+	lrc.mutation.done = true
+	return _node, nil
+}
+
+func (lrc *LeaveRequestCreate) createSpec() (*LeaveRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveRequest{config: lrc.config}
+		_spec = sqlgraph.NewCreateSpec(leaverequest.Table, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64))
+	)
+	if id, ok := lrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lrc.mutation.CreatedAt(); ok {
+		_spec.SetField(leaverequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lrc.mutation.ModifiedAt(); ok {
+		_spec.SetField(leaverequest.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := lrc.mutation.DeletedAt(); ok {
+		_spec.SetField(leaverequest.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := lrc.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := lrc.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := lrc.mutation.TotalDays(); ok {
+		_spec.SetField(leaverequest.FieldTotalDays, field.TypeInt, value)
+		_node.TotalDays = value
+	}
+	if value, ok := lrc.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lrc.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := lrc.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaverequest.FieldReviewedBy, field.TypeUint64, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := lrc.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := lrc.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = value
+	}
+	if nodes := lrc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.EmployeeTable,
+			Columns: []string{leaverequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lrc.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.LeaveTypeTable,
+			Columns: []string{leaverequest.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeaveTypeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaveRequestCreateBulk is the builder for creating many LeaveRequest entities in bulk.
+type LeaveRequestCreateBulk struct {
+	config
+	builders []*LeaveRequestCreate
+}
+
+// Save creates the LeaveRequest entities in the database.
+func (lrcb *LeaveRequestCreateBulk) Save(ctx context.Context) ([]*LeaveRequest, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lrcb.builders))
+	nodes := make([]*LeaveRequest, len(lrcb.builders))
+	mutators := make([]Mutator, len(lrcb.builders))
+	for i := range lrcb.builders {
+		func(i int, root context.Context) {
+			builder := lrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lrcb *LeaveRequestCreateBulk) SaveX(ctx context.Context) []*LeaveRequest {
+	v, err := lrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lrcb *LeaveRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := lrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrcb *LeaveRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := lrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
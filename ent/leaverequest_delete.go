@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestDelete is the builder for deleting a LeaveRequest entity.
+type LeaveRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *LeaveRequestMutation
+}
+
+// Where appends a list predicates to the LeaveRequestDelete builder.
+func (lrd *LeaveRequestDelete) Where(ps ...predicate.LeaveRequest) *LeaveRequestDelete {
+	lrd.mutation.Where(ps...)
+	return lrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lrd *LeaveRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lrd.sqlExec, lrd.mutation, lrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrd *LeaveRequestDelete) ExecX(ctx context.Context) int {
+	n, err := lrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lrd *LeaveRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(leaverequest.Table, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64))
+	if ps := lrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lrd.mutation.done = true
+	return affected, err
+}
+
+// LeaveRequestDeleteOne is the builder for deleting a single LeaveRequest entity.
+type LeaveRequestDeleteOne struct {
+	lrd *LeaveRequestDelete
+}
+
+// Where appends a list predicates to the LeaveRequestDelete builder.
+func (lrdo *LeaveRequestDeleteOne) Where(ps ...predicate.LeaveRequest) *LeaveRequestDeleteOne {
+	lrdo.lrd.mutation.Where(ps...)
+	return lrdo
+}
+
+// Exec executes the deletion query.
+func (lrdo *LeaveRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := lrdo.lrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{leaverequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrdo *LeaveRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := lrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
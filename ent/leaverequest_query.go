@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/employee"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestQuery is the builder for querying LeaveRequest entities.
+type LeaveRequestQuery struct {
+	config
+	ctx           *QueryContext
+	order         []leaverequest.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.LeaveRequest
+	withEmployee  *EmployeeQuery
+	withLeaveType *LeaveTypeQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaveRequestQuery builder.
+func (lrq *LeaveRequestQuery) Where(ps ...predicate.LeaveRequest) *LeaveRequestQuery {
+	lrq.predicates = append(lrq.predicates, ps...)
+	return lrq
+}
+
+// Limit the number of records to be returned by this query.
+func (lrq *LeaveRequestQuery) Limit(limit int) *LeaveRequestQuery {
+	lrq.ctx.Limit = &limit
+	return lrq
+}
+
+// Offset to start from.
+func (lrq *LeaveRequestQuery) Offset(offset int) *LeaveRequestQuery {
+	lrq.ctx.Offset = &offset
+	return lrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lrq *LeaveRequestQuery) Unique(unique bool) *LeaveRequestQuery {
+	lrq.ctx.Unique = &unique
+	return lrq
+}
+
+// Order specifies how the records should be ordered.
+func (lrq *LeaveRequestQuery) Order(o ...leaverequest.OrderOption) *LeaveRequestQuery {
+	lrq.order = append(lrq.order, o...)
+	return lrq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (lrq *LeaveRequestQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: lrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.EmployeeTable, leaverequest.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveType chains the current query on the "leave_type" edge.
+func (lrq *LeaveRequestQuery) QueryLeaveType() *LeaveTypeQuery {
+	query := (&LeaveTypeClient{config: lrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, selector),
+			sqlgraph.To(leavetype.Table, leavetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.LeaveTypeTable, leaverequest.LeaveTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LeaveRequest entity from the query.
+// Returns a *NotFoundError when no LeaveRequest was found.
+func (lrq *LeaveRequestQuery) First(ctx context.Context) (*LeaveRequest, error) {
+	nodes, err := lrq.Limit(1).All(setContextOp(ctx, lrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leaverequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) FirstX(ctx context.Context) *LeaveRequest {
+	node, err := lrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeaveRequest ID from the query.
+// Returns a *NotFoundError when no LeaveRequest ID was found.
+func (lrq *LeaveRequestQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = lrq.Limit(1).IDs(setContextOp(ctx, lrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leaverequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := lrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeaveRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LeaveRequest entity is found.
+// Returns a *NotFoundError when no LeaveRequest entities are found.
+func (lrq *LeaveRequestQuery) Only(ctx context.Context) (*LeaveRequest, error) {
+	nodes, err := lrq.Limit(2).All(setContextOp(ctx, lrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leaverequest.Label}
+	default:
+		return nil, &NotSingularError{leaverequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) OnlyX(ctx context.Context) *LeaveRequest {
+	node, err := lrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeaveRequest ID in the query.
+// Returns a *NotSingularError when more than one LeaveRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lrq *LeaveRequestQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = lrq.Limit(2).IDs(setContextOp(ctx, lrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leaverequest.Label}
+	default:
+		err = &NotSingularError{leaverequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := lrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeaveRequests.
+func (lrq *LeaveRequestQuery) All(ctx context.Context) ([]*LeaveRequest, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "All")
+	if err := lrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LeaveRequest, *LeaveRequestQuery]()
+	return withInterceptors[[]*LeaveRequest](ctx, lrq, qr, lrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) AllX(ctx context.Context) []*LeaveRequest {
+	nodes, err := lrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeaveRequest IDs.
+func (lrq *LeaveRequestQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if lrq.ctx.Unique == nil && lrq.path != nil {
+		lrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lrq.ctx, "IDs")
+	if err = lrq.Select(leaverequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := lrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lrq *LeaveRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "Count")
+	if err := lrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lrq, querierCount[*LeaveRequestQuery](), lrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) CountX(ctx context.Context) int {
+	count, err := lrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lrq *LeaveRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "Exist")
+	switch _, err := lrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lrq *LeaveRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := lrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaveRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lrq *LeaveRequestQuery) Clone() *LeaveRequestQuery {
+	if lrq == nil {
+		return nil
+	}
+	return &LeaveRequestQuery{
+		config:        lrq.config,
+		ctx:           lrq.ctx.Clone(),
+		order:         append([]leaverequest.OrderOption{}, lrq.order...),
+		inters:        append([]Interceptor{}, lrq.inters...),
+		predicates:    append([]predicate.LeaveRequest{}, lrq.predicates...),
+		withEmployee:  lrq.withEmployee.Clone(),
+		withLeaveType: lrq.withLeaveType.Clone(),
+		// clone intermediate query.
+		sql:  lrq.sql.Clone(),
+		path: lrq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (lrq *LeaveRequestQuery) WithEmployee(opts ...func(*EmployeeQuery)) *LeaveRequestQuery {
+	query := (&EmployeeClient{config: lrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lrq.withEmployee = query
+	return lrq
+}
+
+// WithLeaveType tells the query-builder to eager-load the nodes that are connected to
+// the "leave_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (lrq *LeaveRequestQuery) WithLeaveType(opts ...func(*LeaveTypeQuery)) *LeaveRequestQuery {
+	query := (&LeaveTypeClient{config: lrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lrq.withLeaveType = query
+	return lrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeaveRequest.Query().
+//		GroupBy(leaverequest.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lrq *LeaveRequestQuery) GroupBy(field string, fields ...string) *LeaveRequestGroupBy {
+	lrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LeaveRequestGroupBy{build: lrq}
+	grbuild.flds = &lrq.ctx.Fields
+	grbuild.label = leaverequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LeaveRequest.Query().
+//		Select(leaverequest.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (lrq *LeaveRequestQuery) Select(fields ...string) *LeaveRequestSelect {
+	lrq.ctx.Fields = append(lrq.ctx.Fields, fields...)
+	sbuild := &LeaveRequestSelect{LeaveRequestQuery: lrq}
+	sbuild.label = leaverequest.Label
+	sbuild.flds, sbuild.scan = &lrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LeaveRequestSelect configured with the given aggregations.
+func (lrq *LeaveRequestQuery) Aggregate(fns ...AggregateFunc) *LeaveRequestSelect {
+	return lrq.Select().Aggregate(fns...)
+}
+
+func (lrq *LeaveRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lrq.ctx.Fields {
+		if !leaverequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lrq.path != nil {
+		prev, err := lrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lrq.sql = prev
+	}
+	return nil
+}
+
+func (lrq *LeaveRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LeaveRequest, error) {
+	var (
+		nodes       = []*LeaveRequest{}
+		_spec       = lrq.querySpec()
+		loadedTypes = [2]bool{
+			lrq.withEmployee != nil,
+			lrq.withLeaveType != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LeaveRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LeaveRequest{config: lrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(lrq.modifiers) > 0 {
+		_spec.Modifiers = lrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lrq.withEmployee; query != nil {
+		if err := lrq.loadEmployee(ctx, query, nodes, nil,
+			func(n *LeaveRequest, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lrq.withLeaveType; query != nil {
+		if err := lrq.loadLeaveType(ctx, query, nodes, nil,
+			func(n *LeaveRequest, e *LeaveType) { n.Edges.LeaveType = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lrq *LeaveRequestQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*LeaveRequest, init func(*LeaveRequest), assign func(*LeaveRequest, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*LeaveRequest)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (lrq *LeaveRequestQuery) loadLeaveType(ctx context.Context, query *LeaveTypeQuery, nodes []*LeaveRequest, init func(*LeaveRequest), assign func(*LeaveRequest, *LeaveType)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*LeaveRequest)
+	for i := range nodes {
+		fk := nodes[i].LeaveTypeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(leavetype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "leave_type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lrq *LeaveRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lrq.querySpec()
+	if len(lrq.modifiers) > 0 {
+		_spec.Modifiers = lrq.modifiers
+	}
+	_spec.Node.Columns = lrq.ctx.Fields
+	if len(lrq.ctx.Fields) > 0 {
+		_spec.Unique = lrq.ctx.Unique != nil && *lrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lrq.driver, _spec)
+}
+
+func (lrq *LeaveRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(leaverequest.Table, leaverequest.Columns, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64))
+	_spec.From = lrq.sql
+	if unique := lrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaverequest.FieldID)
+		for i := range fields {
+			if fields[i] != leaverequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if lrq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(leaverequest.FieldEmployeeID)
+		}
+		if lrq.withLeaveType != nil {
+			_spec.Node.AddColumnOnce(leaverequest.FieldLeaveTypeID)
+		}
+	}
+	if ps := lrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lrq *LeaveRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lrq.driver.Dialect())
+	t1 := builder.Table(leaverequest.Table)
+	columns := lrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = leaverequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lrq.sql != nil {
+		selector = lrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lrq.ctx.Unique != nil && *lrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range lrq.modifiers {
+		m(selector)
+	}
+	for _, p := range lrq.predicates {
+		p(selector)
+	}
+	for _, p := range lrq.order {
+		p(selector)
+	}
+	if offset := lrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lrq *LeaveRequestQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaveRequestSelect {
+	lrq.modifiers = append(lrq.modifiers, modifiers...)
+	return lrq.Select()
+}
+
+// LeaveRequestGroupBy is the group-by builder for LeaveRequest entities.
+type LeaveRequestGroupBy struct {
+	selector
+	build *LeaveRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lrgb *LeaveRequestGroupBy) Aggregate(fns ...AggregateFunc) *LeaveRequestGroupBy {
+	lrgb.fns = append(lrgb.fns, fns...)
+	return lrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lrgb *LeaveRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lrgb.build.ctx, "GroupBy")
+	if err := lrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveRequestQuery, *LeaveRequestGroupBy](ctx, lrgb.build, lrgb, lrgb.build.inters, v)
+}
+
+func (lrgb *LeaveRequestGroupBy) sqlScan(ctx context.Context, root *LeaveRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lrgb.fns))
+	for _, fn := range lrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lrgb.flds)+len(lrgb.fns))
+		for _, f := range *lrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LeaveRequestSelect is the builder for selecting fields of LeaveRequest entities.
+type LeaveRequestSelect struct {
+	*LeaveRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lrs *LeaveRequestSelect) Aggregate(fns ...AggregateFunc) *LeaveRequestSelect {
+	lrs.fns = append(lrs.fns, fns...)
+	return lrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lrs *LeaveRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lrs.ctx, "Select")
+	if err := lrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveRequestQuery, *LeaveRequestSelect](ctx, lrs.LeaveRequestQuery, lrs, lrs.inters, v)
+}
+
+func (lrs *LeaveRequestSelect) sqlScan(ctx context.Context, root *LeaveRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lrs.fns))
+	for _, fn := range lrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lrs *LeaveRequestSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaveRequestSelect {
+	lrs.modifiers = append(lrs.modifiers, modifiers...)
+	return lrs
+}
@@ -0,0 +1,856 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestUpdate is the builder for updating LeaveRequest entities.
+type LeaveRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveRequestUpdate builder.
+func (lru *LeaveRequestUpdate) Where(ps ...predicate.LeaveRequest) *LeaveRequestUpdate {
+	lru.mutation.Where(ps...)
+	return lru
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lru *LeaveRequestUpdate) SetModifiedAt(t time.Time) *LeaveRequestUpdate {
+	lru.mutation.SetModifiedAt(t)
+	return lru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lru *LeaveRequestUpdate) SetDeletedAt(t time.Time) *LeaveRequestUpdate {
+	lru.mutation.SetDeletedAt(t)
+	return lru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableDeletedAt(t *time.Time) *LeaveRequestUpdate {
+	if t != nil {
+		lru.SetDeletedAt(*t)
+	}
+	return lru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (lru *LeaveRequestUpdate) ClearDeletedAt() *LeaveRequestUpdate {
+	lru.mutation.ClearDeletedAt()
+	return lru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lru *LeaveRequestUpdate) SetEmployeeID(u uint64) *LeaveRequestUpdate {
+	lru.mutation.SetEmployeeID(u)
+	return lru
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lru *LeaveRequestUpdate) SetLeaveTypeID(u uint64) *LeaveRequestUpdate {
+	lru.mutation.SetLeaveTypeID(u)
+	return lru
+}
+
+// SetStartDate sets the "start_date" field.
+func (lru *LeaveRequestUpdate) SetStartDate(t time.Time) *LeaveRequestUpdate {
+	lru.mutation.SetStartDate(t)
+	return lru
+}
+
+// SetEndDate sets the "end_date" field.
+func (lru *LeaveRequestUpdate) SetEndDate(t time.Time) *LeaveRequestUpdate {
+	lru.mutation.SetEndDate(t)
+	return lru
+}
+
+// SetTotalDays sets the "total_days" field.
+func (lru *LeaveRequestUpdate) SetTotalDays(i int) *LeaveRequestUpdate {
+	lru.mutation.ResetTotalDays()
+	lru.mutation.SetTotalDays(i)
+	return lru
+}
+
+// SetNillableTotalDays sets the "total_days" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableTotalDays(i *int) *LeaveRequestUpdate {
+	if i != nil {
+		lru.SetTotalDays(*i)
+	}
+	return lru
+}
+
+// AddTotalDays adds i to the "total_days" field.
+func (lru *LeaveRequestUpdate) AddTotalDays(i int) *LeaveRequestUpdate {
+	lru.mutation.AddTotalDays(i)
+	return lru
+}
+
+// SetReason sets the "reason" field.
+func (lru *LeaveRequestUpdate) SetReason(s string) *LeaveRequestUpdate {
+	lru.mutation.SetReason(s)
+	return lru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableReason(s *string) *LeaveRequestUpdate {
+	if s != nil {
+		lru.SetReason(*s)
+	}
+	return lru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (lru *LeaveRequestUpdate) ClearReason() *LeaveRequestUpdate {
+	lru.mutation.ClearReason()
+	return lru
+}
+
+// SetStatus sets the "status" field.
+func (lru *LeaveRequestUpdate) SetStatus(l leaverequest.Status) *LeaveRequestUpdate {
+	lru.mutation.SetStatus(l)
+	return lru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableStatus(l *leaverequest.Status) *LeaveRequestUpdate {
+	if l != nil {
+		lru.SetStatus(*l)
+	}
+	return lru
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (lru *LeaveRequestUpdate) SetReviewedBy(u uint64) *LeaveRequestUpdate {
+	lru.mutation.ResetReviewedBy()
+	lru.mutation.SetReviewedBy(u)
+	return lru
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableReviewedBy(u *uint64) *LeaveRequestUpdate {
+	if u != nil {
+		lru.SetReviewedBy(*u)
+	}
+	return lru
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (lru *LeaveRequestUpdate) AddReviewedBy(u int64) *LeaveRequestUpdate {
+	lru.mutation.AddReviewedBy(u)
+	return lru
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (lru *LeaveRequestUpdate) ClearReviewedBy() *LeaveRequestUpdate {
+	lru.mutation.ClearReviewedBy()
+	return lru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (lru *LeaveRequestUpdate) SetReviewedAt(t time.Time) *LeaveRequestUpdate {
+	lru.mutation.SetReviewedAt(t)
+	return lru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableReviewedAt(t *time.Time) *LeaveRequestUpdate {
+	if t != nil {
+		lru.SetReviewedAt(*t)
+	}
+	return lru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (lru *LeaveRequestUpdate) ClearReviewedAt() *LeaveRequestUpdate {
+	lru.mutation.ClearReviewedAt()
+	return lru
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (lru *LeaveRequestUpdate) SetReviewNotes(s string) *LeaveRequestUpdate {
+	lru.mutation.SetReviewNotes(s)
+	return lru
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (lru *LeaveRequestUpdate) SetNillableReviewNotes(s *string) *LeaveRequestUpdate {
+	if s != nil {
+		lru.SetReviewNotes(*s)
+	}
+	return lru
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (lru *LeaveRequestUpdate) ClearReviewNotes() *LeaveRequestUpdate {
+	lru.mutation.ClearReviewNotes()
+	return lru
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lru *LeaveRequestUpdate) SetEmployee(e *Employee) *LeaveRequestUpdate {
+	return lru.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lru *LeaveRequestUpdate) SetLeaveType(l *LeaveType) *LeaveRequestUpdate {
+	return lru.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (lru *LeaveRequestUpdate) Mutation() *LeaveRequestMutation {
+	return lru.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (lru *LeaveRequestUpdate) ClearEmployee() *LeaveRequestUpdate {
+	lru.mutation.ClearEmployee()
+	return lru
+}
+
+// ClearLeaveType clears the "leave_type" edge to the LeaveType entity.
+func (lru *LeaveRequestUpdate) ClearLeaveType() *LeaveRequestUpdate {
+	lru.mutation.ClearLeaveType()
+	return lru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lru *LeaveRequestUpdate) Save(ctx context.Context) (int, error) {
+	lru.defaults()
+	return withHooks(ctx, lru.sqlSave, lru.mutation, lru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lru *LeaveRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := lru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lru *LeaveRequestUpdate) Exec(ctx context.Context) error {
+	_, err := lru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lru *LeaveRequestUpdate) ExecX(ctx context.Context) {
+	if err := lru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lru *LeaveRequestUpdate) defaults() {
+	if _, ok := lru.mutation.ModifiedAt(); !ok {
+		v := leaverequest.UpdateDefaultModifiedAt()
+		lru.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lru *LeaveRequestUpdate) check() error {
+	if v, ok := lru.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := lru.mutation.EmployeeID(); lru.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.employee"`)
+	}
+	if _, ok := lru.mutation.LeaveTypeID(); lru.mutation.LeaveTypeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.leave_type"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lru *LeaveRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveRequestUpdate {
+	lru.modifiers = append(lru.modifiers, modifiers...)
+	return lru
+}
+
+func (lru *LeaveRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaverequest.Table, leaverequest.Columns, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64))
+	if ps := lru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lru.mutation.ModifiedAt(); ok {
+		_spec.SetField(leaverequest.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := lru.mutation.DeletedAt(); ok {
+		_spec.SetField(leaverequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if lru.mutation.DeletedAtCleared() {
+		_spec.ClearField(leaverequest.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := lru.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := lru.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := lru.mutation.TotalDays(); ok {
+		_spec.SetField(leaverequest.FieldTotalDays, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.AddedTotalDays(); ok {
+		_spec.AddField(leaverequest.FieldTotalDays, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+	}
+	if lru.mutation.ReasonCleared() {
+		_spec.ClearField(leaverequest.FieldReason, field.TypeString)
+	}
+	if value, ok := lru.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := lru.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaverequest.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := lru.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(leaverequest.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if lru.mutation.ReviewedByCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := lru.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if lru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := lru.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if lru.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaverequest.FieldReviewNotes, field.TypeString)
+	}
+	if lru.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.EmployeeTable,
+			Columns: []string{leaverequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lru.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.EmployeeTable,
+			Columns: []string{leaverequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lru.mutation.LeaveTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.LeaveTypeTable,
+			Columns: []string{leaverequest.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lru.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.LeaveTypeTable,
+			Columns: []string{leaverequest.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(lru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaverequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lru.mutation.done = true
+	return n, nil
+}
+
+// LeaveRequestUpdateOne is the builder for updating a single LeaveRequest entity.
+type LeaveRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (lruo *LeaveRequestUpdateOne) SetModifiedAt(t time.Time) *LeaveRequestUpdateOne {
+	lruo.mutation.SetModifiedAt(t)
+	return lruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (lruo *LeaveRequestUpdateOne) SetDeletedAt(t time.Time) *LeaveRequestUpdateOne {
+	lruo.mutation.SetDeletedAt(t)
+	return lruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableDeletedAt(t *time.Time) *LeaveRequestUpdateOne {
+	if t != nil {
+		lruo.SetDeletedAt(*t)
+	}
+	return lruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (lruo *LeaveRequestUpdateOne) ClearDeletedAt() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearDeletedAt()
+	return lruo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (lruo *LeaveRequestUpdateOne) SetEmployeeID(u uint64) *LeaveRequestUpdateOne {
+	lruo.mutation.SetEmployeeID(u)
+	return lruo
+}
+
+// SetLeaveTypeID sets the "leave_type_id" field.
+func (lruo *LeaveRequestUpdateOne) SetLeaveTypeID(u uint64) *LeaveRequestUpdateOne {
+	lruo.mutation.SetLeaveTypeID(u)
+	return lruo
+}
+
+// SetStartDate sets the "start_date" field.
+func (lruo *LeaveRequestUpdateOne) SetStartDate(t time.Time) *LeaveRequestUpdateOne {
+	lruo.mutation.SetStartDate(t)
+	return lruo
+}
+
+// SetEndDate sets the "end_date" field.
+func (lruo *LeaveRequestUpdateOne) SetEndDate(t time.Time) *LeaveRequestUpdateOne {
+	lruo.mutation.SetEndDate(t)
+	return lruo
+}
+
+// SetTotalDays sets the "total_days" field.
+func (lruo *LeaveRequestUpdateOne) SetTotalDays(i int) *LeaveRequestUpdateOne {
+	lruo.mutation.ResetTotalDays()
+	lruo.mutation.SetTotalDays(i)
+	return lruo
+}
+
+// SetNillableTotalDays sets the "total_days" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableTotalDays(i *int) *LeaveRequestUpdateOne {
+	if i != nil {
+		lruo.SetTotalDays(*i)
+	}
+	return lruo
+}
+
+// AddTotalDays adds i to the "total_days" field.
+func (lruo *LeaveRequestUpdateOne) AddTotalDays(i int) *LeaveRequestUpdateOne {
+	lruo.mutation.AddTotalDays(i)
+	return lruo
+}
+
+// SetReason sets the "reason" field.
+func (lruo *LeaveRequestUpdateOne) SetReason(s string) *LeaveRequestUpdateOne {
+	lruo.mutation.SetReason(s)
+	return lruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableReason(s *string) *LeaveRequestUpdateOne {
+	if s != nil {
+		lruo.SetReason(*s)
+	}
+	return lruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (lruo *LeaveRequestUpdateOne) ClearReason() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearReason()
+	return lruo
+}
+
+// SetStatus sets the "status" field.
+func (lruo *LeaveRequestUpdateOne) SetStatus(l leaverequest.Status) *LeaveRequestUpdateOne {
+	lruo.mutation.SetStatus(l)
+	return lruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableStatus(l *leaverequest.Status) *LeaveRequestUpdateOne {
+	if l != nil {
+		lruo.SetStatus(*l)
+	}
+	return lruo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (lruo *LeaveRequestUpdateOne) SetReviewedBy(u uint64) *LeaveRequestUpdateOne {
+	lruo.mutation.ResetReviewedBy()
+	lruo.mutation.SetReviewedBy(u)
+	return lruo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableReviewedBy(u *uint64) *LeaveRequestUpdateOne {
+	if u != nil {
+		lruo.SetReviewedBy(*u)
+	}
+	return lruo
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (lruo *LeaveRequestUpdateOne) AddReviewedBy(u int64) *LeaveRequestUpdateOne {
+	lruo.mutation.AddReviewedBy(u)
+	return lruo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (lruo *LeaveRequestUpdateOne) ClearReviewedBy() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearReviewedBy()
+	return lruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (lruo *LeaveRequestUpdateOne) SetReviewedAt(t time.Time) *LeaveRequestUpdateOne {
+	lruo.mutation.SetReviewedAt(t)
+	return lruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableReviewedAt(t *time.Time) *LeaveRequestUpdateOne {
+	if t != nil {
+		lruo.SetReviewedAt(*t)
+	}
+	return lruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (lruo *LeaveRequestUpdateOne) ClearReviewedAt() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearReviewedAt()
+	return lruo
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (lruo *LeaveRequestUpdateOne) SetReviewNotes(s string) *LeaveRequestUpdateOne {
+	lruo.mutation.SetReviewNotes(s)
+	return lruo
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (lruo *LeaveRequestUpdateOne) SetNillableReviewNotes(s *string) *LeaveRequestUpdateOne {
+	if s != nil {
+		lruo.SetReviewNotes(*s)
+	}
+	return lruo
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (lruo *LeaveRequestUpdateOne) ClearReviewNotes() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearReviewNotes()
+	return lruo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (lruo *LeaveRequestUpdateOne) SetEmployee(e *Employee) *LeaveRequestUpdateOne {
+	return lruo.SetEmployeeID(e.ID)
+}
+
+// SetLeaveType sets the "leave_type" edge to the LeaveType entity.
+func (lruo *LeaveRequestUpdateOne) SetLeaveType(l *LeaveType) *LeaveRequestUpdateOne {
+	return lruo.SetLeaveTypeID(l.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (lruo *LeaveRequestUpdateOne) Mutation() *LeaveRequestMutation {
+	return lruo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (lruo *LeaveRequestUpdateOne) ClearEmployee() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearEmployee()
+	return lruo
+}
+
+// ClearLeaveType clears the "leave_type" edge to the LeaveType entity.
+func (lruo *LeaveRequestUpdateOne) ClearLeaveType() *LeaveRequestUpdateOne {
+	lruo.mutation.ClearLeaveType()
+	return lruo
+}
+
+// Where appends a list predicates to the LeaveRequestUpdate builder.
+func (lruo *LeaveRequestUpdateOne) Where(ps ...predicate.LeaveRequest) *LeaveRequestUpdateOne {
+	lruo.mutation.Where(ps...)
+	return lruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lruo *LeaveRequestUpdateOne) Select(field string, fields ...string) *LeaveRequestUpdateOne {
+	lruo.fields = append([]string{field}, fields...)
+	return lruo
+}
+
+// Save executes the query and returns the updated LeaveRequest entity.
+func (lruo *LeaveRequestUpdateOne) Save(ctx context.Context) (*LeaveRequest, error) {
+	lruo.defaults()
+	return withHooks(ctx, lruo.sqlSave, lruo.mutation, lruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lruo *LeaveRequestUpdateOne) SaveX(ctx context.Context) *LeaveRequest {
+	node, err := lruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lruo *LeaveRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := lruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lruo *LeaveRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := lruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lruo *LeaveRequestUpdateOne) defaults() {
+	if _, ok := lruo.mutation.ModifiedAt(); !ok {
+		v := leaverequest.UpdateDefaultModifiedAt()
+		lruo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lruo *LeaveRequestUpdateOne) check() error {
+	if v, ok := lruo.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := lruo.mutation.EmployeeID(); lruo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.employee"`)
+	}
+	if _, ok := lruo.mutation.LeaveTypeID(); lruo.mutation.LeaveTypeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.leave_type"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lruo *LeaveRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveRequestUpdateOne {
+	lruo.modifiers = append(lruo.modifiers, modifiers...)
+	return lruo
+}
+
+func (lruo *LeaveRequestUpdateOne) sqlSave(ctx context.Context) (_node *LeaveRequest, err error) {
+	if err := lruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaverequest.Table, leaverequest.Columns, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64))
+	id, ok := lruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaverequest.FieldID)
+		for _, f := range fields {
+			if !leaverequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leaverequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lruo.mutation.ModifiedAt(); ok {
+		_spec.SetField(leaverequest.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := lruo.mutation.DeletedAt(); ok {
+		_spec.SetField(leaverequest.FieldDeletedAt, field.TypeTime, value)
+	}
+	if lruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(leaverequest.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := lruo.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := lruo.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := lruo.mutation.TotalDays(); ok {
+		_spec.SetField(leaverequest.FieldTotalDays, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.AddedTotalDays(); ok {
+		_spec.AddField(leaverequest.FieldTotalDays, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+	}
+	if lruo.mutation.ReasonCleared() {
+		_spec.ClearField(leaverequest.FieldReason, field.TypeString)
+	}
+	if value, ok := lruo.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := lruo.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaverequest.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := lruo.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(leaverequest.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if lruo.mutation.ReviewedByCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := lruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if lruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := lruo.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if lruo.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaverequest.FieldReviewNotes, field.TypeString)
+	}
+	if lruo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.EmployeeTable,
+			Columns: []string{leaverequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lruo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.EmployeeTable,
+			Columns: []string{leaverequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lruo.mutation.LeaveTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.LeaveTypeTable,
+			Columns: []string{leaverequest.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lruo.mutation.LeaveTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.LeaveTypeTable,
+			Columns: []string{leaverequest.LeaveTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(lruo.modifiers...)
+	_node = &LeaveRequest{config: lruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaverequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/leavetype"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaveType is the model entity for the LeaveType schema.
+type LeaveType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Stable identifier like annual, sick, maternity, unpaid
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Paid leave is not deducted from salary
+	IsPaid bool `json:"is_paid,omitempty"`
+	// Days granted per year, 0 means the balance is not tracked
+	AnnualQuota int `json:"annual_quota,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaveTypeQuery when eager-loading is set.
+	Edges        LeaveTypeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeaveTypeEdges holds the relations/edges for other nodes in the graph.
+type LeaveTypeEdges struct {
+	// LeaveRequests holds the value of the leave_requests edge.
+	LeaveRequests []*LeaveRequest `json:"leave_requests,omitempty"`
+	// LeaveBalances holds the value of the leave_balances edge.
+	LeaveBalances []*LeaveBalance `json:"leave_balances,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LeaveRequestsOrErr returns the LeaveRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeaveTypeEdges) LeaveRequestsOrErr() ([]*LeaveRequest, error) {
+	if e.loadedTypes[0] {
+		return e.LeaveRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_requests"}
+}
+
+// LeaveBalancesOrErr returns the LeaveBalances value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeaveTypeEdges) LeaveBalancesOrErr() ([]*LeaveBalance, error) {
+	if e.loadedTypes[1] {
+		return e.LeaveBalances, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_balances"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaveType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leavetype.FieldIsPaid, leavetype.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case leavetype.FieldID, leavetype.FieldAnnualQuota:
+			values[i] = new(sql.NullInt64)
+		case leavetype.FieldCode, leavetype.FieldName, leavetype.FieldDescription:
+			values[i] = new(sql.NullString)
+		case leavetype.FieldCreatedAt, leavetype.FieldModifiedAt, leavetype.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaveType fields.
+func (lt *LeaveType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leavetype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lt.ID = uint64(value.Int64)
+		case leavetype.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lt.CreatedAt = value.Time
+			}
+		case leavetype.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				lt.ModifiedAt = value.Time
+			}
+		case leavetype.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				lt.DeletedAt = value.Time
+			}
+		case leavetype.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				lt.Code = value.String
+			}
+		case leavetype.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				lt.Name = value.String
+			}
+		case leavetype.FieldIsPaid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_paid", values[i])
+			} else if value.Valid {
+				lt.IsPaid = value.Bool
+			}
+		case leavetype.FieldAnnualQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_quota", values[i])
+			} else if value.Valid {
+				lt.AnnualQuota = int(value.Int64)
+			}
+		case leavetype.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				lt.IsActive = value.Bool
+			}
+		case leavetype.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				lt.Description = value.String
+			}
+		default:
+			lt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaveType.
+// This includes values selected through modifiers, order, etc.
+func (lt *LeaveType) Value(name string) (ent.Value, error) {
+	return lt.selectValues.Get(name)
+}
+
+// QueryLeaveRequests queries the "leave_requests" edge of the LeaveType entity.
+func (lt *LeaveType) QueryLeaveRequests() *LeaveRequestQuery {
+	return NewLeaveTypeClient(lt.config).QueryLeaveRequests(lt)
+}
+
+// QueryLeaveBalances queries the "leave_balances" edge of the LeaveType entity.
+func (lt *LeaveType) QueryLeaveBalances() *LeaveBalanceQuery {
+	return NewLeaveTypeClient(lt.config).QueryLeaveBalances(lt)
+}
+
+// Update returns a builder for updating this LeaveType.
+// Note that you need to call LeaveType.Unwrap() before calling this method if this LeaveType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lt *LeaveType) Update() *LeaveTypeUpdateOne {
+	return NewLeaveTypeClient(lt.config).UpdateOne(lt)
+}
+
+// Unwrap unwraps the LeaveType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lt *LeaveType) Unwrap() *LeaveType {
+	_tx, ok := lt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaveType is not a transactional entity")
+	}
+	lt.config.driver = _tx.drv
+	return lt
+}
+
+// String implements the fmt.Stringer.
+func (lt *LeaveType) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaveType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(lt.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(lt.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(lt.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(lt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("is_paid=")
+	builder.WriteString(fmt.Sprintf("%v", lt.IsPaid))
+	builder.WriteString(", ")
+	builder.WriteString("annual_quota=")
+	builder.WriteString(fmt.Sprintf("%v", lt.AnnualQuota))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", lt.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(lt.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaveTypes is a parsable slice of LeaveType.
+type LeaveTypes []*LeaveType
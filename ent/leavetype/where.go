@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leavetype
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldName, v))
+}
+
+// IsPaid applies equality check predicate on the "is_paid" field. It's identical to IsPaidEQ.
+func IsPaid(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldIsPaid, v))
+}
+
+// AnnualQuota applies equality check predicate on the "annual_quota" field. It's identical to AnnualQuotaEQ.
+func AnnualQuota(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldAnnualQuota, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldIsActive, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContainsFold(FieldName, v))
+}
+
+// IsPaidEQ applies the EQ predicate on the "is_paid" field.
+func IsPaidEQ(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldIsPaid, v))
+}
+
+// IsPaidNEQ applies the NEQ predicate on the "is_paid" field.
+func IsPaidNEQ(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldIsPaid, v))
+}
+
+// AnnualQuotaEQ applies the EQ predicate on the "annual_quota" field.
+func AnnualQuotaEQ(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldAnnualQuota, v))
+}
+
+// AnnualQuotaNEQ applies the NEQ predicate on the "annual_quota" field.
+func AnnualQuotaNEQ(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldAnnualQuota, v))
+}
+
+// AnnualQuotaIn applies the In predicate on the "annual_quota" field.
+func AnnualQuotaIn(vs ...int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldAnnualQuota, vs...))
+}
+
+// AnnualQuotaNotIn applies the NotIn predicate on the "annual_quota" field.
+func AnnualQuotaNotIn(vs ...int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldAnnualQuota, vs...))
+}
+
+// AnnualQuotaGT applies the GT predicate on the "annual_quota" field.
+func AnnualQuotaGT(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldAnnualQuota, v))
+}
+
+// AnnualQuotaGTE applies the GTE predicate on the "annual_quota" field.
+func AnnualQuotaGTE(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldAnnualQuota, v))
+}
+
+// AnnualQuotaLT applies the LT predicate on the "annual_quota" field.
+func AnnualQuotaLT(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldAnnualQuota, v))
+}
+
+// AnnualQuotaLTE applies the LTE predicate on the "annual_quota" field.
+func AnnualQuotaLTE(v int) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldAnnualQuota, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LeaveType {
+	return predicate.LeaveType(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// HasLeaveRequests applies the HasEdge predicate on the "leave_requests" edge.
+func HasLeaveRequests() predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveRequestsWith applies the HasEdge predicate on the "leave_requests" edge with a given conditions (other predicates).
+func HasLeaveRequestsWith(preds ...predicate.LeaveRequest) predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		step := newLeaveRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaveBalances applies the HasEdge predicate on the "leave_balances" edge.
+func HasLeaveBalances() predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaveBalancesTable, LeaveBalancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveBalancesWith applies the HasEdge predicate on the "leave_balances" edge with a given conditions (other predicates).
+func HasLeaveBalancesWith(preds ...predicate.LeaveBalance) predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		step := newLeaveBalancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaveType) predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaveType) predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaveType) predicate.LeaveType {
+	return predicate.LeaveType(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveTypeCreate is the builder for creating a LeaveType entity.
+type LeaveTypeCreate struct {
+	config
+	mutation *LeaveTypeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ltc *LeaveTypeCreate) SetCreatedAt(t time.Time) *LeaveTypeCreate {
+	ltc.mutation.SetCreatedAt(t)
+	return ltc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableCreatedAt(t *time.Time) *LeaveTypeCreate {
+	if t != nil {
+		ltc.SetCreatedAt(*t)
+	}
+	return ltc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ltc *LeaveTypeCreate) SetModifiedAt(t time.Time) *LeaveTypeCreate {
+	ltc.mutation.SetModifiedAt(t)
+	return ltc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableModifiedAt(t *time.Time) *LeaveTypeCreate {
+	if t != nil {
+		ltc.SetModifiedAt(*t)
+	}
+	return ltc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ltc *LeaveTypeCreate) SetDeletedAt(t time.Time) *LeaveTypeCreate {
+	ltc.mutation.SetDeletedAt(t)
+	return ltc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableDeletedAt(t *time.Time) *LeaveTypeCreate {
+	if t != nil {
+		ltc.SetDeletedAt(*t)
+	}
+	return ltc
+}
+
+// SetCode sets the "code" field.
+func (ltc *LeaveTypeCreate) SetCode(s string) *LeaveTypeCreate {
+	ltc.mutation.SetCode(s)
+	return ltc
+}
+
+// SetName sets the "name" field.
+func (ltc *LeaveTypeCreate) SetName(s string) *LeaveTypeCreate {
+	ltc.mutation.SetName(s)
+	return ltc
+}
+
+// SetIsPaid sets the "is_paid" field.
+func (ltc *LeaveTypeCreate) SetIsPaid(b bool) *LeaveTypeCreate {
+	ltc.mutation.SetIsPaid(b)
+	return ltc
+}
+
+// SetNillableIsPaid sets the "is_paid" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableIsPaid(b *bool) *LeaveTypeCreate {
+	if b != nil {
+		ltc.SetIsPaid(*b)
+	}
+	return ltc
+}
+
+// SetAnnualQuota sets the "annual_quota" field.
+func (ltc *LeaveTypeCreate) SetAnnualQuota(i int) *LeaveTypeCreate {
+	ltc.mutation.SetAnnualQuota(i)
+	return ltc
+}
+
+// SetNillableAnnualQuota sets the "annual_quota" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableAnnualQuota(i *int) *LeaveTypeCreate {
+	if i != nil {
+		ltc.SetAnnualQuota(*i)
+	}
+	return ltc
+}
+
+// SetIsActive sets the "is_active" field.
+func (ltc *LeaveTypeCreate) SetIsActive(b bool) *LeaveTypeCreate {
+	ltc.mutation.SetIsActive(b)
+	return ltc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableIsActive(b *bool) *LeaveTypeCreate {
+	if b != nil {
+		ltc.SetIsActive(*b)
+	}
+	return ltc
+}
+
+// SetDescription sets the "description" field.
+func (ltc *LeaveTypeCreate) SetDescription(s string) *LeaveTypeCreate {
+	ltc.mutation.SetDescription(s)
+	return ltc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ltc *LeaveTypeCreate) SetNillableDescription(s *string) *LeaveTypeCreate {
+	if s != nil {
+		ltc.SetDescription(*s)
+	}
+	return ltc
+}
+
+// SetID sets the "id" field.
+func (ltc *LeaveTypeCreate) SetID(u uint64) *LeaveTypeCreate {
+	ltc.mutation.SetID(u)
+	return ltc
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (ltc *LeaveTypeCreate) AddLeaveRequestIDs(ids ...uint64) *LeaveTypeCreate {
+	ltc.mutation.AddLeaveRequestIDs(ids...)
+	return ltc
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (ltc *LeaveTypeCreate) AddLeaveRequests(l ...*LeaveRequest) *LeaveTypeCreate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltc.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (ltc *LeaveTypeCreate) AddLeaveBalanceIDs(ids ...uint64) *LeaveTypeCreate {
+	ltc.mutation.AddLeaveBalanceIDs(ids...)
+	return ltc
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (ltc *LeaveTypeCreate) AddLeaveBalances(l ...*LeaveBalance) *LeaveTypeCreate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltc.AddLeaveBalanceIDs(ids...)
+}
+
+// Mutation returns the LeaveTypeMutation object of the builder.
+func (ltc *LeaveTypeCreate) Mutation() *LeaveTypeMutation {
+	return ltc.mutation
+}
+
+// Save creates the LeaveType in the database.
+func (ltc *LeaveTypeCreate) Save(ctx context.Context) (*LeaveType, error) {
+	ltc.defaults()
+	return withHooks(ctx, ltc.sqlSave, ltc.mutation, ltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ltc *LeaveTypeCreate) SaveX(ctx context.Context) *LeaveType {
+	v, err := ltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltc *LeaveTypeCreate) Exec(ctx context.Context) error {
+	_, err := ltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltc *LeaveTypeCreate) ExecX(ctx context.Context) {
+	if err := ltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltc *LeaveTypeCreate) defaults() {
+	if _, ok := ltc.mutation.CreatedAt(); !ok {
+		v := leavetype.DefaultCreatedAt()
+		ltc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ltc.mutation.ModifiedAt(); !ok {
+		v := leavetype.DefaultModifiedAt()
+		ltc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := ltc.mutation.IsPaid(); !ok {
+		v := leavetype.DefaultIsPaid
+		ltc.mutation.SetIsPaid(v)
+	}
+	if _, ok := ltc.mutation.AnnualQuota(); !ok {
+		v := leavetype.DefaultAnnualQuota
+		ltc.mutation.SetAnnualQuota(v)
+	}
+	if _, ok := ltc.mutation.IsActive(); !ok {
+		v := leavetype.DefaultIsActive
+		ltc.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltc *LeaveTypeCreate) check() error {
+	if _, ok := ltc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaveType.created_at"`)}
+	}
+	if _, ok := ltc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "LeaveType.modified_at"`)}
+	}
+	if _, ok := ltc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "LeaveType.code"`)}
+	}
+	if v, ok := ltc.mutation.Code(); ok {
+		if err := leavetype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "LeaveType.code": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LeaveType.name"`)}
+	}
+	if v, ok := ltc.mutation.Name(); ok {
+		if err := leavetype.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeaveType.name": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.IsPaid(); !ok {
+		return &ValidationError{Name: "is_paid", err: errors.New(`ent: missing required field "LeaveType.is_paid"`)}
+	}
+	if _, ok := ltc.mutation.AnnualQuota(); !ok {
+		return &ValidationError{Name: "annual_quota", err: errors.New(`ent: missing required field "LeaveType.annual_quota"`)}
+	}
+	if v, ok := ltc.mutation.AnnualQuota(); ok {
+		if err := leavetype.AnnualQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "annual_quota", err: fmt.Errorf(`ent: validator failed for field "LeaveType.annual_quota": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "LeaveType.is_active"`)}
+	}
+	return nil
+}
+
+func (ltc *LeaveTypeCreate) sqlSave(ctx context.Context) (*LeaveType, error) {
+	if err := ltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ltc.mutation.id = &_node.ID
+	ltc.mutation.done = true
+	return _node, nil
+}
+
+func (ltc *LeaveTypeCreate) createSpec() (*LeaveType, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveType{config: ltc.config}
+		_spec = sqlgraph.NewCreateSpec(leavetype.Table, sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64))
+	)
+	if id, ok := ltc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ltc.mutation.CreatedAt(); ok {
+		_spec.SetField(leavetype.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ltc.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavetype.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := ltc.mutation.DeletedAt(); ok {
+		_spec.SetField(leavetype.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := ltc.mutation.Code(); ok {
+		_spec.SetField(leavetype.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := ltc.mutation.Name(); ok {
+		_spec.SetField(leavetype.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ltc.mutation.IsPaid(); ok {
+		_spec.SetField(leavetype.FieldIsPaid, field.TypeBool, value)
+		_node.IsPaid = value
+	}
+	if value, ok := ltc.mutation.AnnualQuota(); ok {
+		_spec.SetField(leavetype.FieldAnnualQuota, field.TypeInt, value)
+		_node.AnnualQuota = value
+	}
+	if value, ok := ltc.mutation.IsActive(); ok {
+		_spec.SetField(leavetype.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := ltc.mutation.Description(); ok {
+		_spec.SetField(leavetype.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := ltc.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ltc.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaveTypeCreateBulk is the builder for creating many LeaveType entities in bulk.
+type LeaveTypeCreateBulk struct {
+	config
+	builders []*LeaveTypeCreate
+}
+
+// Save creates the LeaveType entities in the database.
+func (ltcb *LeaveTypeCreateBulk) Save(ctx context.Context) ([]*LeaveType, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ltcb.builders))
+	nodes := make([]*LeaveType, len(ltcb.builders))
+	mutators := make([]Mutator, len(ltcb.builders))
+	for i := range ltcb.builders {
+		func(i int, root context.Context) {
+			builder := ltcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltcb *LeaveTypeCreateBulk) SaveX(ctx context.Context) []*LeaveType {
+	v, err := ltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltcb *LeaveTypeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltcb *LeaveTypeCreateBulk) ExecX(ctx context.Context) {
+	if err := ltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
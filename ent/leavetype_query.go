@@ -0,0 +1,701 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveTypeQuery is the builder for querying LeaveType entities.
+type LeaveTypeQuery struct {
+	config
+	ctx               *QueryContext
+	order             []leavetype.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.LeaveType
+	withLeaveRequests *LeaveRequestQuery
+	withLeaveBalances *LeaveBalanceQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaveTypeQuery builder.
+func (ltq *LeaveTypeQuery) Where(ps ...predicate.LeaveType) *LeaveTypeQuery {
+	ltq.predicates = append(ltq.predicates, ps...)
+	return ltq
+}
+
+// Limit the number of records to be returned by this query.
+func (ltq *LeaveTypeQuery) Limit(limit int) *LeaveTypeQuery {
+	ltq.ctx.Limit = &limit
+	return ltq
+}
+
+// Offset to start from.
+func (ltq *LeaveTypeQuery) Offset(offset int) *LeaveTypeQuery {
+	ltq.ctx.Offset = &offset
+	return ltq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ltq *LeaveTypeQuery) Unique(unique bool) *LeaveTypeQuery {
+	ltq.ctx.Unique = &unique
+	return ltq
+}
+
+// Order specifies how the records should be ordered.
+func (ltq *LeaveTypeQuery) Order(o ...leavetype.OrderOption) *LeaveTypeQuery {
+	ltq.order = append(ltq.order, o...)
+	return ltq
+}
+
+// QueryLeaveRequests chains the current query on the "leave_requests" edge.
+func (ltq *LeaveTypeQuery) QueryLeaveRequests() *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavetype.Table, leavetype.FieldID, selector),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, leavetype.LeaveRequestsTable, leavetype.LeaveRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveBalances chains the current query on the "leave_balances" edge.
+func (ltq *LeaveTypeQuery) QueryLeaveBalances() *LeaveBalanceQuery {
+	query := (&LeaveBalanceClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leavetype.Table, leavetype.FieldID, selector),
+			sqlgraph.To(leavebalance.Table, leavebalance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, leavetype.LeaveBalancesTable, leavetype.LeaveBalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LeaveType entity from the query.
+// Returns a *NotFoundError when no LeaveType was found.
+func (ltq *LeaveTypeQuery) First(ctx context.Context) (*LeaveType, error) {
+	nodes, err := ltq.Limit(1).All(setContextOp(ctx, ltq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leavetype.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) FirstX(ctx context.Context) *LeaveType {
+	node, err := ltq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeaveType ID from the query.
+// Returns a *NotFoundError when no LeaveType ID was found.
+func (ltq *LeaveTypeQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ltq.Limit(1).IDs(setContextOp(ctx, ltq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leavetype.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := ltq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeaveType entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LeaveType entity is found.
+// Returns a *NotFoundError when no LeaveType entities are found.
+func (ltq *LeaveTypeQuery) Only(ctx context.Context) (*LeaveType, error) {
+	nodes, err := ltq.Limit(2).All(setContextOp(ctx, ltq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leavetype.Label}
+	default:
+		return nil, &NotSingularError{leavetype.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) OnlyX(ctx context.Context) *LeaveType {
+	node, err := ltq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeaveType ID in the query.
+// Returns a *NotSingularError when more than one LeaveType ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ltq *LeaveTypeQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ltq.Limit(2).IDs(setContextOp(ctx, ltq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leavetype.Label}
+	default:
+		err = &NotSingularError{leavetype.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := ltq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeaveTypes.
+func (ltq *LeaveTypeQuery) All(ctx context.Context) ([]*LeaveType, error) {
+	ctx = setContextOp(ctx, ltq.ctx, "All")
+	if err := ltq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LeaveType, *LeaveTypeQuery]()
+	return withInterceptors[[]*LeaveType](ctx, ltq, qr, ltq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) AllX(ctx context.Context) []*LeaveType {
+	nodes, err := ltq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeaveType IDs.
+func (ltq *LeaveTypeQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if ltq.ctx.Unique == nil && ltq.path != nil {
+		ltq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ltq.ctx, "IDs")
+	if err = ltq.Select(leavetype.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := ltq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ltq *LeaveTypeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ltq.ctx, "Count")
+	if err := ltq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ltq, querierCount[*LeaveTypeQuery](), ltq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) CountX(ctx context.Context) int {
+	count, err := ltq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ltq *LeaveTypeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ltq.ctx, "Exist")
+	switch _, err := ltq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ltq *LeaveTypeQuery) ExistX(ctx context.Context) bool {
+	exist, err := ltq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaveTypeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ltq *LeaveTypeQuery) Clone() *LeaveTypeQuery {
+	if ltq == nil {
+		return nil
+	}
+	return &LeaveTypeQuery{
+		config:            ltq.config,
+		ctx:               ltq.ctx.Clone(),
+		order:             append([]leavetype.OrderOption{}, ltq.order...),
+		inters:            append([]Interceptor{}, ltq.inters...),
+		predicates:        append([]predicate.LeaveType{}, ltq.predicates...),
+		withLeaveRequests: ltq.withLeaveRequests.Clone(),
+		withLeaveBalances: ltq.withLeaveBalances.Clone(),
+		// clone intermediate query.
+		sql:  ltq.sql.Clone(),
+		path: ltq.path,
+	}
+}
+
+// WithLeaveRequests tells the query-builder to eager-load the nodes that are connected to
+// the "leave_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LeaveTypeQuery) WithLeaveRequests(opts ...func(*LeaveRequestQuery)) *LeaveTypeQuery {
+	query := (&LeaveRequestClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withLeaveRequests = query
+	return ltq
+}
+
+// WithLeaveBalances tells the query-builder to eager-load the nodes that are connected to
+// the "leave_balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LeaveTypeQuery) WithLeaveBalances(opts ...func(*LeaveBalanceQuery)) *LeaveTypeQuery {
+	query := (&LeaveBalanceClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withLeaveBalances = query
+	return ltq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeaveType.Query().
+//		GroupBy(leavetype.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ltq *LeaveTypeQuery) GroupBy(field string, fields ...string) *LeaveTypeGroupBy {
+	ltq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LeaveTypeGroupBy{build: ltq}
+	grbuild.flds = &ltq.ctx.Fields
+	grbuild.label = leavetype.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LeaveType.Query().
+//		Select(leavetype.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ltq *LeaveTypeQuery) Select(fields ...string) *LeaveTypeSelect {
+	ltq.ctx.Fields = append(ltq.ctx.Fields, fields...)
+	sbuild := &LeaveTypeSelect{LeaveTypeQuery: ltq}
+	sbuild.label = leavetype.Label
+	sbuild.flds, sbuild.scan = &ltq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LeaveTypeSelect configured with the given aggregations.
+func (ltq *LeaveTypeQuery) Aggregate(fns ...AggregateFunc) *LeaveTypeSelect {
+	return ltq.Select().Aggregate(fns...)
+}
+
+func (ltq *LeaveTypeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ltq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ltq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ltq.ctx.Fields {
+		if !leavetype.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ltq.path != nil {
+		prev, err := ltq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ltq.sql = prev
+	}
+	return nil
+}
+
+func (ltq *LeaveTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LeaveType, error) {
+	var (
+		nodes       = []*LeaveType{}
+		_spec       = ltq.querySpec()
+		loadedTypes = [2]bool{
+			ltq.withLeaveRequests != nil,
+			ltq.withLeaveBalances != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LeaveType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LeaveType{config: ltq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ltq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ltq.withLeaveRequests; query != nil {
+		if err := ltq.loadLeaveRequests(ctx, query, nodes,
+			func(n *LeaveType) { n.Edges.LeaveRequests = []*LeaveRequest{} },
+			func(n *LeaveType, e *LeaveRequest) { n.Edges.LeaveRequests = append(n.Edges.LeaveRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ltq.withLeaveBalances; query != nil {
+		if err := ltq.loadLeaveBalances(ctx, query, nodes,
+			func(n *LeaveType) { n.Edges.LeaveBalances = []*LeaveBalance{} },
+			func(n *LeaveType, e *LeaveBalance) { n.Edges.LeaveBalances = append(n.Edges.LeaveBalances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ltq *LeaveTypeQuery) loadLeaveRequests(ctx context.Context, query *LeaveRequestQuery, nodes []*LeaveType, init func(*LeaveType), assign func(*LeaveType, *LeaveRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*LeaveType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leaverequest.FieldLeaveTypeID)
+	}
+	query.Where(predicate.LeaveRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(leavetype.LeaveRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeaveTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "leave_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ltq *LeaveTypeQuery) loadLeaveBalances(ctx context.Context, query *LeaveBalanceQuery, nodes []*LeaveType, init func(*LeaveType), assign func(*LeaveType, *LeaveBalance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*LeaveType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leavebalance.FieldLeaveTypeID)
+	}
+	query.Where(predicate.LeaveBalance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(leavetype.LeaveBalancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeaveTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "leave_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (ltq *LeaveTypeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ltq.querySpec()
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
+	_spec.Node.Columns = ltq.ctx.Fields
+	if len(ltq.ctx.Fields) > 0 {
+		_spec.Unique = ltq.ctx.Unique != nil && *ltq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ltq.driver, _spec)
+}
+
+func (ltq *LeaveTypeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(leavetype.Table, leavetype.Columns, sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64))
+	_spec.From = ltq.sql
+	if unique := ltq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ltq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ltq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavetype.FieldID)
+		for i := range fields {
+			if fields[i] != leavetype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ltq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ltq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ltq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ltq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ltq *LeaveTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ltq.driver.Dialect())
+	t1 := builder.Table(leavetype.Table)
+	columns := ltq.ctx.Fields
+	if len(columns) == 0 {
+		columns = leavetype.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ltq.sql != nil {
+		selector = ltq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ltq.ctx.Unique != nil && *ltq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ltq.modifiers {
+		m(selector)
+	}
+	for _, p := range ltq.predicates {
+		p(selector)
+	}
+	for _, p := range ltq.order {
+		p(selector)
+	}
+	if offset := ltq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ltq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ltq *LeaveTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaveTypeSelect {
+	ltq.modifiers = append(ltq.modifiers, modifiers...)
+	return ltq.Select()
+}
+
+// LeaveTypeGroupBy is the group-by builder for LeaveType entities.
+type LeaveTypeGroupBy struct {
+	selector
+	build *LeaveTypeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ltgb *LeaveTypeGroupBy) Aggregate(fns ...AggregateFunc) *LeaveTypeGroupBy {
+	ltgb.fns = append(ltgb.fns, fns...)
+	return ltgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ltgb *LeaveTypeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ltgb.build.ctx, "GroupBy")
+	if err := ltgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveTypeQuery, *LeaveTypeGroupBy](ctx, ltgb.build, ltgb, ltgb.build.inters, v)
+}
+
+func (ltgb *LeaveTypeGroupBy) sqlScan(ctx context.Context, root *LeaveTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ltgb.fns))
+	for _, fn := range ltgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ltgb.flds)+len(ltgb.fns))
+		for _, f := range *ltgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ltgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ltgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LeaveTypeSelect is the builder for selecting fields of LeaveType entities.
+type LeaveTypeSelect struct {
+	*LeaveTypeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lts *LeaveTypeSelect) Aggregate(fns ...AggregateFunc) *LeaveTypeSelect {
+	lts.fns = append(lts.fns, fns...)
+	return lts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lts *LeaveTypeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lts.ctx, "Select")
+	if err := lts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaveTypeQuery, *LeaveTypeSelect](ctx, lts.LeaveTypeQuery, lts, lts.inters, v)
+}
+
+func (lts *LeaveTypeSelect) sqlScan(ctx context.Context, root *LeaveTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lts.fns))
+	for _, fn := range lts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lts *LeaveTypeSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaveTypeSelect {
+	lts.modifiers = append(lts.modifiers, modifiers...)
+	return lts
+}
@@ -0,0 +1,862 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveTypeUpdate is the builder for updating LeaveType entities.
+type LeaveTypeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveTypeUpdate builder.
+func (ltu *LeaveTypeUpdate) Where(ps ...predicate.LeaveType) *LeaveTypeUpdate {
+	ltu.mutation.Where(ps...)
+	return ltu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ltu *LeaveTypeUpdate) SetModifiedAt(t time.Time) *LeaveTypeUpdate {
+	ltu.mutation.SetModifiedAt(t)
+	return ltu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ltu *LeaveTypeUpdate) SetDeletedAt(t time.Time) *LeaveTypeUpdate {
+	ltu.mutation.SetDeletedAt(t)
+	return ltu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltu *LeaveTypeUpdate) SetNillableDeletedAt(t *time.Time) *LeaveTypeUpdate {
+	if t != nil {
+		ltu.SetDeletedAt(*t)
+	}
+	return ltu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ltu *LeaveTypeUpdate) ClearDeletedAt() *LeaveTypeUpdate {
+	ltu.mutation.ClearDeletedAt()
+	return ltu
+}
+
+// SetCode sets the "code" field.
+func (ltu *LeaveTypeUpdate) SetCode(s string) *LeaveTypeUpdate {
+	ltu.mutation.SetCode(s)
+	return ltu
+}
+
+// SetName sets the "name" field.
+func (ltu *LeaveTypeUpdate) SetName(s string) *LeaveTypeUpdate {
+	ltu.mutation.SetName(s)
+	return ltu
+}
+
+// SetIsPaid sets the "is_paid" field.
+func (ltu *LeaveTypeUpdate) SetIsPaid(b bool) *LeaveTypeUpdate {
+	ltu.mutation.SetIsPaid(b)
+	return ltu
+}
+
+// SetNillableIsPaid sets the "is_paid" field if the given value is not nil.
+func (ltu *LeaveTypeUpdate) SetNillableIsPaid(b *bool) *LeaveTypeUpdate {
+	if b != nil {
+		ltu.SetIsPaid(*b)
+	}
+	return ltu
+}
+
+// SetAnnualQuota sets the "annual_quota" field.
+func (ltu *LeaveTypeUpdate) SetAnnualQuota(i int) *LeaveTypeUpdate {
+	ltu.mutation.ResetAnnualQuota()
+	ltu.mutation.SetAnnualQuota(i)
+	return ltu
+}
+
+// SetNillableAnnualQuota sets the "annual_quota" field if the given value is not nil.
+func (ltu *LeaveTypeUpdate) SetNillableAnnualQuota(i *int) *LeaveTypeUpdate {
+	if i != nil {
+		ltu.SetAnnualQuota(*i)
+	}
+	return ltu
+}
+
+// AddAnnualQuota adds i to the "annual_quota" field.
+func (ltu *LeaveTypeUpdate) AddAnnualQuota(i int) *LeaveTypeUpdate {
+	ltu.mutation.AddAnnualQuota(i)
+	return ltu
+}
+
+// SetIsActive sets the "is_active" field.
+func (ltu *LeaveTypeUpdate) SetIsActive(b bool) *LeaveTypeUpdate {
+	ltu.mutation.SetIsActive(b)
+	return ltu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ltu *LeaveTypeUpdate) SetNillableIsActive(b *bool) *LeaveTypeUpdate {
+	if b != nil {
+		ltu.SetIsActive(*b)
+	}
+	return ltu
+}
+
+// SetDescription sets the "description" field.
+func (ltu *LeaveTypeUpdate) SetDescription(s string) *LeaveTypeUpdate {
+	ltu.mutation.SetDescription(s)
+	return ltu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ltu *LeaveTypeUpdate) SetNillableDescription(s *string) *LeaveTypeUpdate {
+	if s != nil {
+		ltu.SetDescription(*s)
+	}
+	return ltu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ltu *LeaveTypeUpdate) ClearDescription() *LeaveTypeUpdate {
+	ltu.mutation.ClearDescription()
+	return ltu
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (ltu *LeaveTypeUpdate) AddLeaveRequestIDs(ids ...uint64) *LeaveTypeUpdate {
+	ltu.mutation.AddLeaveRequestIDs(ids...)
+	return ltu
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (ltu *LeaveTypeUpdate) AddLeaveRequests(l ...*LeaveRequest) *LeaveTypeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (ltu *LeaveTypeUpdate) AddLeaveBalanceIDs(ids ...uint64) *LeaveTypeUpdate {
+	ltu.mutation.AddLeaveBalanceIDs(ids...)
+	return ltu
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (ltu *LeaveTypeUpdate) AddLeaveBalances(l ...*LeaveBalance) *LeaveTypeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.AddLeaveBalanceIDs(ids...)
+}
+
+// Mutation returns the LeaveTypeMutation object of the builder.
+func (ltu *LeaveTypeUpdate) Mutation() *LeaveTypeMutation {
+	return ltu.mutation
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (ltu *LeaveTypeUpdate) ClearLeaveRequests() *LeaveTypeUpdate {
+	ltu.mutation.ClearLeaveRequests()
+	return ltu
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (ltu *LeaveTypeUpdate) RemoveLeaveRequestIDs(ids ...uint64) *LeaveTypeUpdate {
+	ltu.mutation.RemoveLeaveRequestIDs(ids...)
+	return ltu
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (ltu *LeaveTypeUpdate) RemoveLeaveRequests(l ...*LeaveRequest) *LeaveTypeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearLeaveBalances clears all "leave_balances" edges to the LeaveBalance entity.
+func (ltu *LeaveTypeUpdate) ClearLeaveBalances() *LeaveTypeUpdate {
+	ltu.mutation.ClearLeaveBalances()
+	return ltu
+}
+
+// RemoveLeaveBalanceIDs removes the "leave_balances" edge to LeaveBalance entities by IDs.
+func (ltu *LeaveTypeUpdate) RemoveLeaveBalanceIDs(ids ...uint64) *LeaveTypeUpdate {
+	ltu.mutation.RemoveLeaveBalanceIDs(ids...)
+	return ltu
+}
+
+// RemoveLeaveBalances removes "leave_balances" edges to LeaveBalance entities.
+func (ltu *LeaveTypeUpdate) RemoveLeaveBalances(l ...*LeaveBalance) *LeaveTypeUpdate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.RemoveLeaveBalanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ltu *LeaveTypeUpdate) Save(ctx context.Context) (int, error) {
+	ltu.defaults()
+	return withHooks(ctx, ltu.sqlSave, ltu.mutation, ltu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltu *LeaveTypeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ltu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ltu *LeaveTypeUpdate) Exec(ctx context.Context) error {
+	_, err := ltu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltu *LeaveTypeUpdate) ExecX(ctx context.Context) {
+	if err := ltu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltu *LeaveTypeUpdate) defaults() {
+	if _, ok := ltu.mutation.ModifiedAt(); !ok {
+		v := leavetype.UpdateDefaultModifiedAt()
+		ltu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltu *LeaveTypeUpdate) check() error {
+	if v, ok := ltu.mutation.Code(); ok {
+		if err := leavetype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "LeaveType.code": %w`, err)}
+		}
+	}
+	if v, ok := ltu.mutation.Name(); ok {
+		if err := leavetype.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeaveType.name": %w`, err)}
+		}
+	}
+	if v, ok := ltu.mutation.AnnualQuota(); ok {
+		if err := leavetype.AnnualQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "annual_quota", err: fmt.Errorf(`ent: validator failed for field "LeaveType.annual_quota": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ltu *LeaveTypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveTypeUpdate {
+	ltu.modifiers = append(ltu.modifiers, modifiers...)
+	return ltu
+}
+
+func (ltu *LeaveTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ltu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavetype.Table, leavetype.Columns, sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64))
+	if ps := ltu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ltu.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavetype.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ltu.mutation.DeletedAt(); ok {
+		_spec.SetField(leavetype.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ltu.mutation.DeletedAtCleared() {
+		_spec.ClearField(leavetype.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ltu.mutation.Code(); ok {
+		_spec.SetField(leavetype.FieldCode, field.TypeString, value)
+	}
+	if value, ok := ltu.mutation.Name(); ok {
+		_spec.SetField(leavetype.FieldName, field.TypeString, value)
+	}
+	if value, ok := ltu.mutation.IsPaid(); ok {
+		_spec.SetField(leavetype.FieldIsPaid, field.TypeBool, value)
+	}
+	if value, ok := ltu.mutation.AnnualQuota(); ok {
+		_spec.SetField(leavetype.FieldAnnualQuota, field.TypeInt, value)
+	}
+	if value, ok := ltu.mutation.AddedAnnualQuota(); ok {
+		_spec.AddField(leavetype.FieldAnnualQuota, field.TypeInt, value)
+	}
+	if value, ok := ltu.mutation.IsActive(); ok {
+		_spec.SetField(leavetype.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := ltu.mutation.Description(); ok {
+		_spec.SetField(leavetype.FieldDescription, field.TypeString, value)
+	}
+	if ltu.mutation.DescriptionCleared() {
+		_spec.ClearField(leavetype.FieldDescription, field.TypeString)
+	}
+	if ltu.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !ltu.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltu.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.RemovedLeaveBalancesIDs(); len(nodes) > 0 && !ltu.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ltu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ltu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavetype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ltu.mutation.done = true
+	return n, nil
+}
+
+// LeaveTypeUpdateOne is the builder for updating a single LeaveType entity.
+type LeaveTypeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ltuo *LeaveTypeUpdateOne) SetModifiedAt(t time.Time) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetModifiedAt(t)
+	return ltuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ltuo *LeaveTypeUpdateOne) SetDeletedAt(t time.Time) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetDeletedAt(t)
+	return ltuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltuo *LeaveTypeUpdateOne) SetNillableDeletedAt(t *time.Time) *LeaveTypeUpdateOne {
+	if t != nil {
+		ltuo.SetDeletedAt(*t)
+	}
+	return ltuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ltuo *LeaveTypeUpdateOne) ClearDeletedAt() *LeaveTypeUpdateOne {
+	ltuo.mutation.ClearDeletedAt()
+	return ltuo
+}
+
+// SetCode sets the "code" field.
+func (ltuo *LeaveTypeUpdateOne) SetCode(s string) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetCode(s)
+	return ltuo
+}
+
+// SetName sets the "name" field.
+func (ltuo *LeaveTypeUpdateOne) SetName(s string) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetName(s)
+	return ltuo
+}
+
+// SetIsPaid sets the "is_paid" field.
+func (ltuo *LeaveTypeUpdateOne) SetIsPaid(b bool) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetIsPaid(b)
+	return ltuo
+}
+
+// SetNillableIsPaid sets the "is_paid" field if the given value is not nil.
+func (ltuo *LeaveTypeUpdateOne) SetNillableIsPaid(b *bool) *LeaveTypeUpdateOne {
+	if b != nil {
+		ltuo.SetIsPaid(*b)
+	}
+	return ltuo
+}
+
+// SetAnnualQuota sets the "annual_quota" field.
+func (ltuo *LeaveTypeUpdateOne) SetAnnualQuota(i int) *LeaveTypeUpdateOne {
+	ltuo.mutation.ResetAnnualQuota()
+	ltuo.mutation.SetAnnualQuota(i)
+	return ltuo
+}
+
+// SetNillableAnnualQuota sets the "annual_quota" field if the given value is not nil.
+func (ltuo *LeaveTypeUpdateOne) SetNillableAnnualQuota(i *int) *LeaveTypeUpdateOne {
+	if i != nil {
+		ltuo.SetAnnualQuota(*i)
+	}
+	return ltuo
+}
+
+// AddAnnualQuota adds i to the "annual_quota" field.
+func (ltuo *LeaveTypeUpdateOne) AddAnnualQuota(i int) *LeaveTypeUpdateOne {
+	ltuo.mutation.AddAnnualQuota(i)
+	return ltuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (ltuo *LeaveTypeUpdateOne) SetIsActive(b bool) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetIsActive(b)
+	return ltuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ltuo *LeaveTypeUpdateOne) SetNillableIsActive(b *bool) *LeaveTypeUpdateOne {
+	if b != nil {
+		ltuo.SetIsActive(*b)
+	}
+	return ltuo
+}
+
+// SetDescription sets the "description" field.
+func (ltuo *LeaveTypeUpdateOne) SetDescription(s string) *LeaveTypeUpdateOne {
+	ltuo.mutation.SetDescription(s)
+	return ltuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ltuo *LeaveTypeUpdateOne) SetNillableDescription(s *string) *LeaveTypeUpdateOne {
+	if s != nil {
+		ltuo.SetDescription(*s)
+	}
+	return ltuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ltuo *LeaveTypeUpdateOne) ClearDescription() *LeaveTypeUpdateOne {
+	ltuo.mutation.ClearDescription()
+	return ltuo
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (ltuo *LeaveTypeUpdateOne) AddLeaveRequestIDs(ids ...uint64) *LeaveTypeUpdateOne {
+	ltuo.mutation.AddLeaveRequestIDs(ids...)
+	return ltuo
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (ltuo *LeaveTypeUpdateOne) AddLeaveRequests(l ...*LeaveRequest) *LeaveTypeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.AddLeaveRequestIDs(ids...)
+}
+
+// AddLeaveBalanceIDs adds the "leave_balances" edge to the LeaveBalance entity by IDs.
+func (ltuo *LeaveTypeUpdateOne) AddLeaveBalanceIDs(ids ...uint64) *LeaveTypeUpdateOne {
+	ltuo.mutation.AddLeaveBalanceIDs(ids...)
+	return ltuo
+}
+
+// AddLeaveBalances adds the "leave_balances" edges to the LeaveBalance entity.
+func (ltuo *LeaveTypeUpdateOne) AddLeaveBalances(l ...*LeaveBalance) *LeaveTypeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.AddLeaveBalanceIDs(ids...)
+}
+
+// Mutation returns the LeaveTypeMutation object of the builder.
+func (ltuo *LeaveTypeUpdateOne) Mutation() *LeaveTypeMutation {
+	return ltuo.mutation
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (ltuo *LeaveTypeUpdateOne) ClearLeaveRequests() *LeaveTypeUpdateOne {
+	ltuo.mutation.ClearLeaveRequests()
+	return ltuo
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (ltuo *LeaveTypeUpdateOne) RemoveLeaveRequestIDs(ids ...uint64) *LeaveTypeUpdateOne {
+	ltuo.mutation.RemoveLeaveRequestIDs(ids...)
+	return ltuo
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (ltuo *LeaveTypeUpdateOne) RemoveLeaveRequests(l ...*LeaveRequest) *LeaveTypeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearLeaveBalances clears all "leave_balances" edges to the LeaveBalance entity.
+func (ltuo *LeaveTypeUpdateOne) ClearLeaveBalances() *LeaveTypeUpdateOne {
+	ltuo.mutation.ClearLeaveBalances()
+	return ltuo
+}
+
+// RemoveLeaveBalanceIDs removes the "leave_balances" edge to LeaveBalance entities by IDs.
+func (ltuo *LeaveTypeUpdateOne) RemoveLeaveBalanceIDs(ids ...uint64) *LeaveTypeUpdateOne {
+	ltuo.mutation.RemoveLeaveBalanceIDs(ids...)
+	return ltuo
+}
+
+// RemoveLeaveBalances removes "leave_balances" edges to LeaveBalance entities.
+func (ltuo *LeaveTypeUpdateOne) RemoveLeaveBalances(l ...*LeaveBalance) *LeaveTypeUpdateOne {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.RemoveLeaveBalanceIDs(ids...)
+}
+
+// Where appends a list predicates to the LeaveTypeUpdate builder.
+func (ltuo *LeaveTypeUpdateOne) Where(ps ...predicate.LeaveType) *LeaveTypeUpdateOne {
+	ltuo.mutation.Where(ps...)
+	return ltuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ltuo *LeaveTypeUpdateOne) Select(field string, fields ...string) *LeaveTypeUpdateOne {
+	ltuo.fields = append([]string{field}, fields...)
+	return ltuo
+}
+
+// Save executes the query and returns the updated LeaveType entity.
+func (ltuo *LeaveTypeUpdateOne) Save(ctx context.Context) (*LeaveType, error) {
+	ltuo.defaults()
+	return withHooks(ctx, ltuo.sqlSave, ltuo.mutation, ltuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltuo *LeaveTypeUpdateOne) SaveX(ctx context.Context) *LeaveType {
+	node, err := ltuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ltuo *LeaveTypeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ltuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltuo *LeaveTypeUpdateOne) ExecX(ctx context.Context) {
+	if err := ltuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltuo *LeaveTypeUpdateOne) defaults() {
+	if _, ok := ltuo.mutation.ModifiedAt(); !ok {
+		v := leavetype.UpdateDefaultModifiedAt()
+		ltuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltuo *LeaveTypeUpdateOne) check() error {
+	if v, ok := ltuo.mutation.Code(); ok {
+		if err := leavetype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "LeaveType.code": %w`, err)}
+		}
+	}
+	if v, ok := ltuo.mutation.Name(); ok {
+		if err := leavetype.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeaveType.name": %w`, err)}
+		}
+	}
+	if v, ok := ltuo.mutation.AnnualQuota(); ok {
+		if err := leavetype.AnnualQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "annual_quota", err: fmt.Errorf(`ent: validator failed for field "LeaveType.annual_quota": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ltuo *LeaveTypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveTypeUpdateOne {
+	ltuo.modifiers = append(ltuo.modifiers, modifiers...)
+	return ltuo
+}
+
+func (ltuo *LeaveTypeUpdateOne) sqlSave(ctx context.Context) (_node *LeaveType, err error) {
+	if err := ltuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavetype.Table, leavetype.Columns, sqlgraph.NewFieldSpec(leavetype.FieldID, field.TypeUint64))
+	id, ok := ltuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveType.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ltuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavetype.FieldID)
+		for _, f := range fields {
+			if !leavetype.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leavetype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ltuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ltuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(leavetype.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ltuo.mutation.DeletedAt(); ok {
+		_spec.SetField(leavetype.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ltuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(leavetype.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ltuo.mutation.Code(); ok {
+		_spec.SetField(leavetype.FieldCode, field.TypeString, value)
+	}
+	if value, ok := ltuo.mutation.Name(); ok {
+		_spec.SetField(leavetype.FieldName, field.TypeString, value)
+	}
+	if value, ok := ltuo.mutation.IsPaid(); ok {
+		_spec.SetField(leavetype.FieldIsPaid, field.TypeBool, value)
+	}
+	if value, ok := ltuo.mutation.AnnualQuota(); ok {
+		_spec.SetField(leavetype.FieldAnnualQuota, field.TypeInt, value)
+	}
+	if value, ok := ltuo.mutation.AddedAnnualQuota(); ok {
+		_spec.AddField(leavetype.FieldAnnualQuota, field.TypeInt, value)
+	}
+	if value, ok := ltuo.mutation.IsActive(); ok {
+		_spec.SetField(leavetype.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := ltuo.mutation.Description(); ok {
+		_spec.SetField(leavetype.FieldDescription, field.TypeString, value)
+	}
+	if ltuo.mutation.DescriptionCleared() {
+		_spec.ClearField(leavetype.FieldDescription, field.TypeString)
+	}
+	if ltuo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !ltuo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveRequestsTable,
+			Columns: []string{leavetype.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltuo.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.RemovedLeaveBalancesIDs(); len(nodes) > 0 && !ltuo.mutation.LeaveBalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.LeaveBalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   leavetype.LeaveBalancesTable,
+			Columns: []string{leavetype.LeaveBalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leavebalance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ltuo.modifiers...)
+	_node = &LeaveType{config: ltuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ltuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavetype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ltuo.mutation.done = true
+	return _node, nil
+}
@@ -17,11 +17,23 @@ var (
 		{Name: "attendance_date", Type: field.TypeTime},
 		{Name: "check_in_time", Type: field.TypeTime, Nullable: true},
 		{Name: "check_out_time", Type: field.TypeTime, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"present", "absent", "late", "half_day"}, Default: "absent"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"present", "absent", "late", "half_day", "leave", "unpaid_leave", "remote", "business_trip", "sick"}, Default: "absent"},
+		{Name: "status_metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_weekend", Type: field.TypeBool, Default: false},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "marked_by_admin", Type: field.TypeBool, Default: false},
+		{Name: "check_in_latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "check_in_longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "check_in_distance_meters", Type: field.TypeFloat64, Nullable: true},
+		{Name: "check_out_latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "check_out_longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "check_out_distance_meters", Type: field.TypeFloat64, Nullable: true},
+		{Name: "location_flagged", Type: field.TypeBool, Default: false},
+		{Name: "auto_closed", Type: field.TypeBool, Default: false},
+		{Name: "worked_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "break_minutes", Type: field.TypeInt, Default: 0},
 		{Name: "employee_id", Type: field.TypeUint64},
+		{Name: "check_in_kiosk_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// AttendancesTable holds the schema information for the "attendances" table.
 	AttendancesTable = &schema.Table{
@@ -31,16 +43,22 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attendances_employees_attendances",
-				Columns:    []*schema.Column{AttendancesColumns[11]},
+				Columns:    []*schema.Column{AttendancesColumns[22]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "attendances_kiosks_attendances",
+				Columns:    []*schema.Column{AttendancesColumns[23]},
+				RefColumns: []*schema.Column{KiosksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "attendance_employee_id_attendance_date",
 				Unique:  true,
-				Columns: []*schema.Column{AttendancesColumns[11], AttendancesColumns[4]},
+				Columns: []*schema.Column{AttendancesColumns[22], AttendancesColumns[4]},
 			},
 			{
 				Name:    "attendance_attendance_date",
@@ -50,7 +68,7 @@ var (
 			{
 				Name:    "attendance_employee_id",
 				Unique:  false,
-				Columns: []*schema.Column{AttendancesColumns[11]},
+				Columns: []*schema.Column{AttendancesColumns[22]},
 			},
 			{
 				Name:    "attendance_status",
@@ -60,7 +78,361 @@ var (
 			{
 				Name:    "attendance_is_weekend",
 				Unique:  false,
-				Columns: []*schema.Column{AttendancesColumns[8]},
+				Columns: []*schema.Column{AttendancesColumns[9]},
+			},
+			{
+				Name:    "attendance_location_flagged",
+				Unique:  false,
+				Columns: []*schema.Column{AttendancesColumns[18]},
+			},
+			{
+				Name:    "attendance_auto_closed",
+				Unique:  false,
+				Columns: []*schema.Column{AttendancesColumns[19]},
+			},
+		},
+	}
+	// AttendanceAnomaliesColumns holds the columns for the "attendance_anomalies" table.
+	AttendanceAnomaliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attendance_date", Type: field.TypeTime},
+		{Name: "rule", Type: field.TypeEnum, Enums: []string{"missing_check_out", "long_day", "odd_hour_check_in", "early_check_out", "skipped_punch"}},
+		{Name: "details", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "resolved"}, Default: "open"},
+		{Name: "resolved_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attendance_id", Type: field.TypeUint64},
+		{Name: "employee_id", Type: field.TypeUint64},
+	}
+	// AttendanceAnomaliesTable holds the schema information for the "attendance_anomalies" table.
+	AttendanceAnomaliesTable = &schema.Table{
+		Name:       "attendance_anomalies",
+		Columns:    AttendanceAnomaliesColumns,
+		PrimaryKey: []*schema.Column{AttendanceAnomaliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_anomalies_attendances_anomalies",
+				Columns:    []*schema.Column{AttendanceAnomaliesColumns[11]},
+				RefColumns: []*schema.Column{AttendancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attendance_anomalies_employees_attendance_anomalies",
+				Columns:    []*schema.Column{AttendanceAnomaliesColumns[12]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendanceanomaly_attendance_id_rule",
+				Unique:  true,
+				Columns: []*schema.Column{AttendanceAnomaliesColumns[11], AttendanceAnomaliesColumns[5]},
+			},
+			{
+				Name:    "attendanceanomaly_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceAnomaliesColumns[12]},
+			},
+			{
+				Name:    "attendanceanomaly_attendance_date",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceAnomaliesColumns[4]},
+			},
+			{
+				Name:    "attendanceanomaly_status",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceAnomaliesColumns[7]},
+			},
+		},
+	}
+	// AttendanceCorrectionsColumns holds the columns for the "attendance_corrections" table.
+	AttendanceCorrectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "original_check_in_time", Type: field.TypeTime, Nullable: true},
+		{Name: "original_check_out_time", Type: field.TypeTime, Nullable: true},
+		{Name: "original_status", Type: field.TypeString, Size: 20},
+		{Name: "proposed_check_in_time", Type: field.TypeTime, Nullable: true},
+		{Name: "proposed_check_out_time", Type: field.TypeTime, Nullable: true},
+		{Name: "proposed_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attendance_id", Type: field.TypeUint64},
+		{Name: "employee_id", Type: field.TypeUint64},
+	}
+	// AttendanceCorrectionsTable holds the schema information for the "attendance_corrections" table.
+	AttendanceCorrectionsTable = &schema.Table{
+		Name:       "attendance_corrections",
+		Columns:    AttendanceCorrectionsColumns,
+		PrimaryKey: []*schema.Column{AttendanceCorrectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_corrections_attendances_corrections",
+				Columns:    []*schema.Column{AttendanceCorrectionsColumns[15]},
+				RefColumns: []*schema.Column{AttendancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attendance_corrections_employees_attendance_corrections",
+				Columns:    []*schema.Column{AttendanceCorrectionsColumns[16]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendancecorrection_attendance_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceCorrectionsColumns[15]},
+			},
+			{
+				Name:    "attendancecorrection_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceCorrectionsColumns[16]},
+			},
+			{
+				Name:    "attendancecorrection_status",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceCorrectionsColumns[11]},
+			},
+		},
+	}
+	// AttendancePayPoliciesColumns holds the columns for the "attendance_pay_policies" table.
+	AttendancePayPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "present_weight", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(4,3)", "postgres": "numeric(4,3)", "sqlite3": "numeric"}},
+		{Name: "late_weight", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(4,3)", "postgres": "numeric(4,3)", "sqlite3": "numeric"}},
+		{Name: "half_day_weight", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(4,3)", "postgres": "numeric(4,3)", "sqlite3": "numeric"}},
+		{Name: "late_penalty_amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "late_penalty_free_days", Type: field.TypeInt, Default: 0},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+	}
+	// AttendancePayPoliciesTable holds the schema information for the "attendance_pay_policies" table.
+	AttendancePayPoliciesTable = &schema.Table{
+		Name:       "attendance_pay_policies",
+		Columns:    AttendancePayPoliciesColumns,
+		PrimaryKey: []*schema.Column{AttendancePayPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendancepaypolicy_is_default",
+				Unique:  false,
+				Columns: []*schema.Column{AttendancePayPoliciesColumns[10]},
+			},
+		},
+	}
+	// AttendancePunchesColumns holds the columns for the "attendance_punches" table.
+	AttendancePunchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "punch_type", Type: field.TypeEnum, Enums: []string{"check_in", "check_out", "break_start", "break_end"}},
+		{Name: "punch_time", Type: field.TypeTime},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "distance_meters", Type: field.TypeFloat64, Nullable: true},
+		{Name: "attendance_id", Type: field.TypeUint64},
+		{Name: "kiosk_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// AttendancePunchesTable holds the schema information for the "attendance_punches" table.
+	AttendancePunchesTable = &schema.Table{
+		Name:       "attendance_punches",
+		Columns:    AttendancePunchesColumns,
+		PrimaryKey: []*schema.Column{AttendancePunchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_punches_attendances_punches",
+				Columns:    []*schema.Column{AttendancePunchesColumns[9]},
+				RefColumns: []*schema.Column{AttendancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attendance_punches_kiosks_punches",
+				Columns:    []*schema.Column{AttendancePunchesColumns[10]},
+				RefColumns: []*schema.Column{KiosksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendancepunch_attendance_id_punch_time",
+				Unique:  false,
+				Columns: []*schema.Column{AttendancePunchesColumns[9], AttendancePunchesColumns[5]},
+			},
+		},
+	}
+	// BpjsContributionRatesColumns holds the columns for the "bpjs_contribution_rates" table.
+	BpjsContributionRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "program", Type: field.TypeEnum, Enums: []string{"kesehatan", "jht", "jp", "jkk", "jkm"}},
+		{Name: "risk_class", Type: field.TypeInt, Default: 0},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "employer_rate", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(7,4)", "postgres": "numeric(7,4)", "sqlite3": "numeric"}},
+		{Name: "employee_rate", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(7,4)", "postgres": "numeric(7,4)", "sqlite3": "numeric"}},
+		{Name: "wage_cap", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// BpjsContributionRatesTable holds the schema information for the "bpjs_contribution_rates" table.
+	BpjsContributionRatesTable = &schema.Table{
+		Name:       "bpjs_contribution_rates",
+		Columns:    BpjsContributionRatesColumns,
+		PrimaryKey: []*schema.Column{BpjsContributionRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bpjscontributionrate_program_risk_class_effective_from",
+				Unique:  false,
+				Columns: []*schema.Column{BpjsContributionRatesColumns[4], BpjsContributionRatesColumns[5], BpjsContributionRatesColumns[6]},
+			},
+		},
+	}
+	// CalendarFeedsColumns holds the columns for the "calendar_feeds" table.
+	CalendarFeedsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"employee", "department", "company"}},
+		{Name: "department", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "token", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "last_accessed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "employee_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// CalendarFeedsTable holds the schema information for the "calendar_feeds" table.
+	CalendarFeedsTable = &schema.Table{
+		Name:       "calendar_feeds",
+		Columns:    CalendarFeedsColumns,
+		PrimaryKey: []*schema.Column{CalendarFeedsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "calendar_feeds_employees_calendar_feeds",
+				Columns:    []*schema.Column{CalendarFeedsColumns[10]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "calendarfeed_scope",
+				Unique:  false,
+				Columns: []*schema.Column{CalendarFeedsColumns[5]},
+			},
+			{
+				Name:    "calendarfeed_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{CalendarFeedsColumns[10]},
+			},
+			{
+				Name:    "calendarfeed_department",
+				Unique:  false,
+				Columns: []*schema.Column{CalendarFeedsColumns[6]},
+			},
+		},
+	}
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "serial_number", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "disabled"}, Default: "pending"},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "push_version", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "device_info", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "allowed_networks", Type: field.TypeJSON, Nullable: true},
+		{Name: "attlog_stamp", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_punch_at", Type: field.TypeTime, Nullable: true},
+		{Name: "clock_offset_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "office_location_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:       "devices",
+		Columns:    DevicesColumns,
+		PrimaryKey: []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_office_locations_devices",
+				Columns:    []*schema.Column{DevicesColumns[16]},
+				RefColumns: []*schema.Column{OfficeLocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "device_status",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[6]},
+			},
+			{
+				Name:    "device_office_location_id",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[16]},
+			},
+		},
+	}
+	// DevicePunchRejectionsColumns holds the columns for the "device_punch_rejections" table.
+	DevicePunchRejectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "device_pin", Type: field.TypeString, Size: 32},
+		{Name: "punch_time", Type: field.TypeTime},
+		{Name: "punch_state", Type: field.TypeInt, Default: 0},
+		{Name: "verify_mode", Type: field.TypeInt, Default: 0},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "resolved"}, Default: "open"},
+		{Name: "resolved_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "device_id", Type: field.TypeUint64},
+	}
+	// DevicePunchRejectionsTable holds the schema information for the "device_punch_rejections" table.
+	DevicePunchRejectionsTable = &schema.Table{
+		Name:       "device_punch_rejections",
+		Columns:    DevicePunchRejectionsColumns,
+		PrimaryKey: []*schema.Column{DevicePunchRejectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_punch_rejections_devices_punch_rejections",
+				Columns:    []*schema.Column{DevicePunchRejectionsColumns[13]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicepunchrejection_device_id_device_pin_punch_time",
+				Unique:  true,
+				Columns: []*schema.Column{DevicePunchRejectionsColumns[13], DevicePunchRejectionsColumns[4], DevicePunchRejectionsColumns[5]},
+			},
+			{
+				Name:    "devicepunchrejection_status",
+				Unique:  false,
+				Columns: []*schema.Column{DevicePunchRejectionsColumns[9]},
 			},
 		},
 	}
@@ -77,14 +449,28 @@ var (
 		{Name: "position", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "department", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "hire_date", Type: field.TypeTime},
-		{Name: "base_salary", Type: field.TypeFloat64, Default: 1e+07},
+		{Name: "base_salary", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "device_pin", Type: field.TypeString, Unique: true, Nullable: true, Size: 32},
+		{Name: "ptkp_status", Type: field.TypeEnum, Enums: []string{"TK/0", "TK/1", "TK/2", "TK/3", "K/0", "K/1", "K/2", "K/3"}, Default: "TK/0"},
+		{Name: "npwp", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "jkk_risk_class", Type: field.TypeInt, Default: 1},
+		{Name: "office_location_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// EmployeesTable holds the schema information for the "employees" table.
 	EmployeesTable = &schema.Table{
 		Name:       "employees",
 		Columns:    EmployeesColumns,
 		PrimaryKey: []*schema.Column{EmployeesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "employees_office_locations_employees",
+				Columns:    []*schema.Column{EmployeesColumns[18]},
+				RefColumns: []*schema.Column{OfficeLocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "employee_employee_id",
@@ -101,6 +487,405 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{EmployeesColumns[12]},
 			},
+			{
+				Name:    "employee_office_location_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmployeesColumns[18]},
+			},
+		},
+	}
+	// HolidaysColumns holds the columns for the "holidays" table.
+	HolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "holiday_date", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "holiday_type", Type: field.TypeEnum, Enums: []string{"national", "cuti_bersama", "company"}, Default: "national"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// HolidaysTable holds the schema information for the "holidays" table.
+	HolidaysTable = &schema.Table{
+		Name:       "holidays",
+		Columns:    HolidaysColumns,
+		PrimaryKey: []*schema.Column{HolidaysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "holiday_holiday_date",
+				Unique:  false,
+				Columns: []*schema.Column{HolidaysColumns[4]},
+			},
+			{
+				Name:    "holiday_holiday_type",
+				Unique:  false,
+				Columns: []*schema.Column{HolidaysColumns[6]},
+			},
+		},
+	}
+	// KiosksColumns holds the columns for the "kiosks" table.
+	KiosksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "secret", Type: field.TypeString, Size: 64},
+		{Name: "device_token_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "office_location_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// KiosksTable holds the schema information for the "kiosks" table.
+	KiosksTable = &schema.Table{
+		Name:       "kiosks",
+		Columns:    KiosksColumns,
+		PrimaryKey: []*schema.Column{KiosksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kiosks_office_locations_kiosks",
+				Columns:    []*schema.Column{KiosksColumns[8]},
+				RefColumns: []*schema.Column{OfficeLocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "kiosk_office_location_id",
+				Unique:  false,
+				Columns: []*schema.Column{KiosksColumns[8]},
+			},
+			{
+				Name:    "kiosk_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{KiosksColumns[7]},
+			},
+		},
+	}
+	// LeaveBalancesColumns holds the columns for the "leave_balances" table.
+	LeaveBalancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "entitled_days", Type: field.TypeInt, Default: 0},
+		{Name: "used_days", Type: field.TypeInt, Default: 0},
+		{Name: "employee_id", Type: field.TypeUint64},
+		{Name: "leave_type_id", Type: field.TypeUint64},
+	}
+	// LeaveBalancesTable holds the schema information for the "leave_balances" table.
+	LeaveBalancesTable = &schema.Table{
+		Name:       "leave_balances",
+		Columns:    LeaveBalancesColumns,
+		PrimaryKey: []*schema.Column{LeaveBalancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leave_balances_employees_leave_balances",
+				Columns:    []*schema.Column{LeaveBalancesColumns[7]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "leave_balances_leave_types_leave_balances",
+				Columns:    []*schema.Column{LeaveBalancesColumns[8]},
+				RefColumns: []*schema.Column{LeaveTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leavebalance_employee_id_leave_type_id_year",
+				Unique:  true,
+				Columns: []*schema.Column{LeaveBalancesColumns[7], LeaveBalancesColumns[8], LeaveBalancesColumns[4]},
+			},
+		},
+	}
+	// LeaveRequestsColumns holds the columns for the "leave_requests" table.
+	LeaveRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "total_days", Type: field.TypeInt, Default: 0},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "cancelled"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "employee_id", Type: field.TypeUint64},
+		{Name: "leave_type_id", Type: field.TypeUint64},
+	}
+	// LeaveRequestsTable holds the schema information for the "leave_requests" table.
+	LeaveRequestsTable = &schema.Table{
+		Name:       "leave_requests",
+		Columns:    LeaveRequestsColumns,
+		PrimaryKey: []*schema.Column{LeaveRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leave_requests_employees_leave_requests",
+				Columns:    []*schema.Column{LeaveRequestsColumns[12]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "leave_requests_leave_types_leave_requests",
+				Columns:    []*schema.Column{LeaveRequestsColumns[13]},
+				RefColumns: []*schema.Column{LeaveTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leaverequest_employee_id_start_date",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveRequestsColumns[12], LeaveRequestsColumns[4]},
+			},
+			{
+				Name:    "leaverequest_leave_type_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveRequestsColumns[13]},
+			},
+			{
+				Name:    "leaverequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveRequestsColumns[8]},
+			},
+		},
+	}
+	// LeaveTypesColumns holds the columns for the "leave_types" table.
+	LeaveTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "is_paid", Type: field.TypeBool, Default: true},
+		{Name: "annual_quota", Type: field.TypeInt, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// LeaveTypesTable holds the schema information for the "leave_types" table.
+	LeaveTypesTable = &schema.Table{
+		Name:       "leave_types",
+		Columns:    LeaveTypesColumns,
+		PrimaryKey: []*schema.Column{LeaveTypesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leavetype_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveTypesColumns[8]},
+			},
+		},
+	}
+	// OfficeLocationsColumns holds the columns for the "office_locations" table.
+	OfficeLocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "address", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "latitude", Type: field.TypeFloat64},
+		{Name: "longitude", Type: field.TypeFloat64},
+		{Name: "radius_meters", Type: field.TypeInt, Default: 100},
+		{Name: "enforcement", Type: field.TypeEnum, Enums: []string{"reject", "flag"}, Default: "reject"},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+	}
+	// OfficeLocationsTable holds the schema information for the "office_locations" table.
+	OfficeLocationsTable = &schema.Table{
+		Name:       "office_locations",
+		Columns:    OfficeLocationsColumns,
+		PrimaryKey: []*schema.Column{OfficeLocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "officelocation_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{OfficeLocationsColumns[11]},
+			},
+		},
+	}
+	// OvertimesColumns holds the columns for the "overtimes" table.
+	OvertimesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "overtime_date", Type: field.TypeTime},
+		{Name: "scheduled_end", Type: field.TypeTime, Nullable: true},
+		{Name: "actual_end", Type: field.TypeTime},
+		{Name: "minutes", Type: field.TypeInt},
+		{Name: "approved_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "is_rest_day", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUint64, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attendance_id", Type: field.TypeUint64, Unique: true},
+		{Name: "employee_id", Type: field.TypeUint64},
+	}
+	// OvertimesTable holds the schema information for the "overtimes" table.
+	OvertimesTable = &schema.Table{
+		Name:       "overtimes",
+		Columns:    OvertimesColumns,
+		PrimaryKey: []*schema.Column{OvertimesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "overtimes_attendances_overtime",
+				Columns:    []*schema.Column{OvertimesColumns[14]},
+				RefColumns: []*schema.Column{AttendancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "overtimes_employees_overtimes",
+				Columns:    []*schema.Column{OvertimesColumns[15]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "overtime_employee_id_overtime_date",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimesColumns[15], OvertimesColumns[4]},
+			},
+			{
+				Name:    "overtime_status",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimesColumns[10]},
+			},
+		},
+	}
+	// PayrollPeriodsColumns holds the columns for the "payroll_periods" table.
+	PayrollPeriodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "period_month", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "locked"}, Default: "open"},
+		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_by", Type: field.TypeUint64, Nullable: true},
+	}
+	// PayrollPeriodsTable holds the schema information for the "payroll_periods" table.
+	PayrollPeriodsTable = &schema.Table{
+		Name:       "payroll_periods",
+		Columns:    PayrollPeriodsColumns,
+		PrimaryKey: []*schema.Column{PayrollPeriodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payrollperiod_period_month",
+				Unique:  true,
+				Columns: []*schema.Column{PayrollPeriodsColumns[4]},
+			},
+			{
+				Name:    "payrollperiod_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayrollPeriodsColumns[5]},
+			},
+		},
+	}
+	// PayrollPeriodEventsColumns holds the columns for the "payroll_period_events" table.
+	PayrollPeriodEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"lock", "unlock"}},
+		{Name: "actor_id", Type: field.TypeUint64},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "payroll_period_id", Type: field.TypeUint64},
+	}
+	// PayrollPeriodEventsTable holds the schema information for the "payroll_period_events" table.
+	PayrollPeriodEventsTable = &schema.Table{
+		Name:       "payroll_period_events",
+		Columns:    PayrollPeriodEventsColumns,
+		PrimaryKey: []*schema.Column{PayrollPeriodEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payroll_period_events_payroll_periods_events",
+				Columns:    []*schema.Column{PayrollPeriodEventsColumns[7]},
+				RefColumns: []*schema.Column{PayrollPeriodsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payrollperiodevent_payroll_period_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PayrollPeriodEventsColumns[7], PayrollPeriodEventsColumns[1]},
+			},
+		},
+	}
+	// PayrollRunsColumns holds the columns for the "payroll_runs" table.
+	PayrollRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "period_month", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "calculated", "reviewed", "approved", "paid", "cancelled"}, Default: "draft"},
+		{Name: "active_month", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeUint64},
+	}
+	// PayrollRunsTable holds the schema information for the "payroll_runs" table.
+	PayrollRunsTable = &schema.Table{
+		Name:       "payroll_runs",
+		Columns:    PayrollRunsColumns,
+		PrimaryKey: []*schema.Column{PayrollRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payrollrun_period_month_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayrollRunsColumns[4], PayrollRunsColumns[5]},
+			},
+			{
+				Name:    "payrollrun_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayrollRunsColumns[5]},
+			},
+			{
+				Name:    "payrollrun_active_month",
+				Unique:  true,
+				Columns: []*schema.Column{PayrollRunsColumns[6]},
+			},
+		},
+	}
+	// PayrollRunEventsColumns holds the columns for the "payroll_run_events" table.
+	PayrollRunEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "calculate", "review", "approve", "pay", "cancel"}},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"draft", "calculated", "reviewed", "approved", "paid", "cancelled"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"draft", "calculated", "reviewed", "approved", "paid", "cancelled"}},
+		{Name: "actor_id", Type: field.TypeUint64},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "payroll_run_id", Type: field.TypeUint64},
+	}
+	// PayrollRunEventsTable holds the schema information for the "payroll_run_events" table.
+	PayrollRunEventsTable = &schema.Table{
+		Name:       "payroll_run_events",
+		Columns:    PayrollRunEventsColumns,
+		PrimaryKey: []*schema.Column{PayrollRunEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payroll_run_events_payroll_runs_events",
+				Columns:    []*schema.Column{PayrollRunEventsColumns[9]},
+				RefColumns: []*schema.Column{PayrollRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payrollrunevent_payroll_run_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PayrollRunEventsColumns[9], PayrollRunEventsColumns[1]},
+			},
 		},
 	}
 	// RolesColumns holds the columns for the "roles" table.
@@ -140,14 +925,23 @@ var (
 		{Name: "modified_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "calculation_month", Type: field.TypeTime},
-		{Name: "base_salary", Type: field.TypeFloat64},
+		{Name: "base_salary", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
 		{Name: "total_working_days", Type: field.TypeInt},
 		{Name: "absent_days", Type: field.TypeInt, Default: 0},
 		{Name: "present_days", Type: field.TypeInt, Default: 0},
-		{Name: "final_salary", Type: field.TypeFloat64},
-		{Name: "deduction_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "paid_days", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(6,3)", "postgres": "numeric(6,3)", "sqlite3": "numeric"}},
+		{Name: "late_days", Type: field.TypeInt, Default: 0},
+		{Name: "late_penalty", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "pay_policy", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "worked_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "overtime_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "overtime_pay", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "taxable_income", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "final_salary", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "deduction_amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
 		{Name: "calculation_formula", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "employee_id", Type: field.TypeUint64},
+		{Name: "payroll_run_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// SalaryCalculationsTable holds the schema information for the "salary_calculations" table.
 	SalaryCalculationsTable = &schema.Table{
@@ -157,16 +951,22 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "salary_calculations_employees_salary_calculations",
-				Columns:    []*schema.Column{SalaryCalculationsColumns[12]},
+				Columns:    []*schema.Column{SalaryCalculationsColumns[20]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "salary_calculations_payroll_runs_salary_calculations",
+				Columns:    []*schema.Column{SalaryCalculationsColumns[21]},
+				RefColumns: []*schema.Column{PayrollRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "salarycalculation_employee_id_calculation_month",
 				Unique:  true,
-				Columns: []*schema.Column{SalaryCalculationsColumns[12], SalaryCalculationsColumns[4]},
+				Columns: []*schema.Column{SalaryCalculationsColumns[20], SalaryCalculationsColumns[4]},
 			},
 			{
 				Name:    "salarycalculation_calculation_month",
@@ -176,7 +976,184 @@ var (
 			{
 				Name:    "salarycalculation_employee_id",
 				Unique:  false,
-				Columns: []*schema.Column{SalaryCalculationsColumns[12]},
+				Columns: []*schema.Column{SalaryCalculationsColumns[20]},
+			},
+			{
+				Name:    "salarycalculation_payroll_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryCalculationsColumns[21]},
+			},
+		},
+	}
+	// SalaryCalculationItemsColumns holds the columns for the "salary_calculation_items" table.
+	SalaryCalculationItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "code", Type: field.TypeString, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"earning", "deduction", "employer"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "basis", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "salary_calculation_id", Type: field.TypeUint64},
+		{Name: "salary_component_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// SalaryCalculationItemsTable holds the schema information for the "salary_calculation_items" table.
+	SalaryCalculationItemsTable = &schema.Table{
+		Name:       "salary_calculation_items",
+		Columns:    SalaryCalculationItemsColumns,
+		PrimaryKey: []*schema.Column{SalaryCalculationItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "salary_calculation_items_salary_calculations_items",
+				Columns:    []*schema.Column{SalaryCalculationItemsColumns[9]},
+				RefColumns: []*schema.Column{SalaryCalculationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "salary_calculation_items_salary_components_calculation_items",
+				Columns:    []*schema.Column{SalaryCalculationItemsColumns[10]},
+				RefColumns: []*schema.Column{SalaryComponentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "salarycalculationitem_salary_calculation_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryCalculationItemsColumns[9]},
+			},
+			{
+				Name:    "salarycalculationitem_salary_component_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryCalculationItemsColumns[10]},
+			},
+		},
+	}
+	// SalaryComponentsColumns holds the columns for the "salary_components" table.
+	SalaryComponentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"earning", "deduction"}},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"fixed", "percentage_of_base", "per_present_day"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "percentage", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "decimal(7,4)", "postgres": "numeric(7,4)", "sqlite3": "numeric"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+	}
+	// SalaryComponentsTable holds the schema information for the "salary_components" table.
+	SalaryComponentsTable = &schema.Table{
+		Name:       "salary_components",
+		Columns:    SalaryComponentsColumns,
+		PrimaryKey: []*schema.Column{SalaryComponentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "salarycomponent_kind",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryComponentsColumns[6]},
+			},
+			{
+				Name:    "salarycomponent_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryComponentsColumns[11]},
+			},
+		},
+	}
+	// SalaryComponentAssignmentsColumns holds the columns for the "salary_component_assignments" table.
+	SalaryComponentAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(15,2)", "postgres": "numeric(15,2)", "sqlite3": "numeric"}},
+		{Name: "percentage", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(7,4)", "postgres": "numeric(7,4)", "sqlite3": "numeric"}},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "employee_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "salary_component_id", Type: field.TypeUint64},
+	}
+	// SalaryComponentAssignmentsTable holds the schema information for the "salary_component_assignments" table.
+	SalaryComponentAssignmentsTable = &schema.Table{
+		Name:       "salary_component_assignments",
+		Columns:    SalaryComponentAssignmentsColumns,
+		PrimaryKey: []*schema.Column{SalaryComponentAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "salary_component_assignments_employees_salary_component_assignments",
+				Columns:    []*schema.Column{SalaryComponentAssignmentsColumns[8]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "salary_component_assignments_salary_components_assignments",
+				Columns:    []*schema.Column{SalaryComponentAssignmentsColumns[9]},
+				RefColumns: []*schema.Column{SalaryComponentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "salarycomponentassignment_salary_component_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryComponentAssignmentsColumns[9]},
+			},
+			{
+				Name:    "salarycomponentassignment_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryComponentAssignmentsColumns[8]},
+			},
+			{
+				Name:    "salarycomponentassignment_department",
+				Unique:  false,
+				Columns: []*schema.Column{SalaryComponentAssignmentsColumns[4]},
+			},
+		},
+	}
+	// ShiftAssignmentsColumns holds the columns for the "shift_assignments" table.
+	ShiftAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "effective_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "employee_id", Type: field.TypeUint64},
+		{Name: "work_schedule_id", Type: field.TypeUint64},
+	}
+	// ShiftAssignmentsTable holds the schema information for the "shift_assignments" table.
+	ShiftAssignmentsTable = &schema.Table{
+		Name:       "shift_assignments",
+		Columns:    ShiftAssignmentsColumns,
+		PrimaryKey: []*schema.Column{ShiftAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shift_assignments_employees_shift_assignments",
+				Columns:    []*schema.Column{ShiftAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "shift_assignments_work_schedules_shift_assignments",
+				Columns:    []*schema.Column{ShiftAssignmentsColumns[7]},
+				RefColumns: []*schema.Column{WorkSchedulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shiftassignment_employee_id_effective_date",
+				Unique:  false,
+				Columns: []*schema.Column{ShiftAssignmentsColumns[6], ShiftAssignmentsColumns[4]},
+			},
+			{
+				Name:    "shiftassignment_work_schedule_id",
+				Unique:  false,
+				Columns: []*schema.Column{ShiftAssignmentsColumns[7]},
 			},
 		},
 	}
@@ -198,18 +1175,111 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WorkSchedulesColumns holds the columns for the "work_schedules" table.
+	WorkSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "modified_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "start_time", Type: field.TypeString, Size: 5},
+		{Name: "end_time", Type: field.TypeString, Size: 5},
+		{Name: "working_days", Type: field.TypeJSON},
+		{Name: "late_threshold_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "half_day_threshold_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "attendance_pay_policy_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// WorkSchedulesTable holds the schema information for the "work_schedules" table.
+	WorkSchedulesTable = &schema.Table{
+		Name:       "work_schedules",
+		Columns:    WorkSchedulesColumns,
+		PrimaryKey: []*schema.Column{WorkSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "work_schedules_attendance_pay_policies_work_schedules",
+				Columns:    []*schema.Column{WorkSchedulesColumns[11]},
+				RefColumns: []*schema.Column{AttendancePayPoliciesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workschedule_is_default",
+				Unique:  false,
+				Columns: []*schema.Column{WorkSchedulesColumns[10]},
+			},
+			{
+				Name:    "workschedule_attendance_pay_policy_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkSchedulesColumns[11]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttendancesTable,
+		AttendanceAnomaliesTable,
+		AttendanceCorrectionsTable,
+		AttendancePayPoliciesTable,
+		AttendancePunchesTable,
+		BpjsContributionRatesTable,
+		CalendarFeedsTable,
+		DevicesTable,
+		DevicePunchRejectionsTable,
 		EmployeesTable,
+		HolidaysTable,
+		KiosksTable,
+		LeaveBalancesTable,
+		LeaveRequestsTable,
+		LeaveTypesTable,
+		OfficeLocationsTable,
+		OvertimesTable,
+		PayrollPeriodsTable,
+		PayrollPeriodEventsTable,
+		PayrollRunsTable,
+		PayrollRunEventsTable,
 		RolesTable,
 		RoleUsersTable,
 		SalaryCalculationsTable,
+		SalaryCalculationItemsTable,
+		SalaryComponentsTable,
+		SalaryComponentAssignmentsTable,
+		ShiftAssignmentsTable,
 		UsersTable,
+		WorkSchedulesTable,
 	}
 )
 
 func init() {
 	AttendancesTable.ForeignKeys[0].RefTable = EmployeesTable
+	AttendancesTable.ForeignKeys[1].RefTable = KiosksTable
+	AttendanceAnomaliesTable.ForeignKeys[0].RefTable = AttendancesTable
+	AttendanceAnomaliesTable.ForeignKeys[1].RefTable = EmployeesTable
+	AttendanceCorrectionsTable.ForeignKeys[0].RefTable = AttendancesTable
+	AttendanceCorrectionsTable.ForeignKeys[1].RefTable = EmployeesTable
+	AttendancePunchesTable.ForeignKeys[0].RefTable = AttendancesTable
+	AttendancePunchesTable.ForeignKeys[1].RefTable = KiosksTable
+	CalendarFeedsTable.ForeignKeys[0].RefTable = EmployeesTable
+	DevicesTable.ForeignKeys[0].RefTable = OfficeLocationsTable
+	DevicePunchRejectionsTable.ForeignKeys[0].RefTable = DevicesTable
+	EmployeesTable.ForeignKeys[0].RefTable = OfficeLocationsTable
+	KiosksTable.ForeignKeys[0].RefTable = OfficeLocationsTable
+	LeaveBalancesTable.ForeignKeys[0].RefTable = EmployeesTable
+	LeaveBalancesTable.ForeignKeys[1].RefTable = LeaveTypesTable
+	LeaveRequestsTable.ForeignKeys[0].RefTable = EmployeesTable
+	LeaveRequestsTable.ForeignKeys[1].RefTable = LeaveTypesTable
+	OvertimesTable.ForeignKeys[0].RefTable = AttendancesTable
+	OvertimesTable.ForeignKeys[1].RefTable = EmployeesTable
+	PayrollPeriodEventsTable.ForeignKeys[0].RefTable = PayrollPeriodsTable
+	PayrollRunEventsTable.ForeignKeys[0].RefTable = PayrollRunsTable
 	SalaryCalculationsTable.ForeignKeys[0].RefTable = EmployeesTable
+	SalaryCalculationsTable.ForeignKeys[1].RefTable = PayrollRunsTable
+	SalaryCalculationItemsTable.ForeignKeys[0].RefTable = SalaryCalculationsTable
+	SalaryCalculationItemsTable.ForeignKeys[1].RefTable = SalaryComponentsTable
+	SalaryComponentAssignmentsTable.ForeignKeys[0].RefTable = EmployeesTable
+	SalaryComponentAssignmentsTable.ForeignKeys[1].RefTable = SalaryComponentsTable
+	ShiftAssignmentsTable.ForeignKeys[0].RefTable = EmployeesTable
+	ShiftAssignmentsTable.ForeignKeys[1].RefTable = WorkSchedulesTable
+	WorkSchedulesTable.ForeignKeys[0].RefTable = AttendancePayPoliciesTable
 }
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/officelocation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OfficeLocation is the model entity for the OfficeLocation schema.
+type OfficeLocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude float64 `json:"longitude,omitempty"`
+	// Maximum distance from the coordinates for a check-in or check-out
+	RadiusMeters int `json:"radius_meters,omitempty"`
+	// reject refuses punches outside the radius, flag stores them for review
+	Enforcement officelocation.Enforcement `json:"enforcement,omitempty"`
+	// IANA timezone of the office, e.g. Asia/Makassar, the application timezone applies when empty
+	Timezone string `json:"timezone,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OfficeLocationQuery when eager-loading is set.
+	Edges        OfficeLocationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OfficeLocationEdges holds the relations/edges for other nodes in the graph.
+type OfficeLocationEdges struct {
+	// Employees holds the value of the employees edge.
+	Employees []*Employee `json:"employees,omitempty"`
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// Kiosks holds the value of the kiosks edge.
+	Kiosks []*Kiosk `json:"kiosks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// EmployeesOrErr returns the Employees value or an error if the edge
+// was not loaded in eager-loading.
+func (e OfficeLocationEdges) EmployeesOrErr() ([]*Employee, error) {
+	if e.loadedTypes[0] {
+		return e.Employees, nil
+	}
+	return nil, &NotLoadedError{edge: "employees"}
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e OfficeLocationEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[1] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// KiosksOrErr returns the Kiosks value or an error if the edge
+// was not loaded in eager-loading.
+func (e OfficeLocationEdges) KiosksOrErr() ([]*Kiosk, error) {
+	if e.loadedTypes[2] {
+		return e.Kiosks, nil
+	}
+	return nil, &NotLoadedError{edge: "kiosks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OfficeLocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case officelocation.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case officelocation.FieldLatitude, officelocation.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case officelocation.FieldID, officelocation.FieldRadiusMeters:
+			values[i] = new(sql.NullInt64)
+		case officelocation.FieldName, officelocation.FieldAddress, officelocation.FieldEnforcement, officelocation.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case officelocation.FieldCreatedAt, officelocation.FieldModifiedAt, officelocation.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OfficeLocation fields.
+func (ol *OfficeLocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case officelocation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ol.ID = uint64(value.Int64)
+		case officelocation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ol.CreatedAt = value.Time
+			}
+		case officelocation.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				ol.ModifiedAt = value.Time
+			}
+		case officelocation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ol.DeletedAt = value.Time
+			}
+		case officelocation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ol.Name = value.String
+			}
+		case officelocation.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				ol.Address = value.String
+			}
+		case officelocation.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				ol.Latitude = value.Float64
+			}
+		case officelocation.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				ol.Longitude = value.Float64
+			}
+		case officelocation.FieldRadiusMeters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field radius_meters", values[i])
+			} else if value.Valid {
+				ol.RadiusMeters = int(value.Int64)
+			}
+		case officelocation.FieldEnforcement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enforcement", values[i])
+			} else if value.Valid {
+				ol.Enforcement = officelocation.Enforcement(value.String)
+			}
+		case officelocation.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				ol.Timezone = value.String
+			}
+		case officelocation.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				ol.IsActive = value.Bool
+			}
+		default:
+			ol.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OfficeLocation.
+// This includes values selected through modifiers, order, etc.
+func (ol *OfficeLocation) Value(name string) (ent.Value, error) {
+	return ol.selectValues.Get(name)
+}
+
+// QueryEmployees queries the "employees" edge of the OfficeLocation entity.
+func (ol *OfficeLocation) QueryEmployees() *EmployeeQuery {
+	return NewOfficeLocationClient(ol.config).QueryEmployees(ol)
+}
+
+// QueryDevices queries the "devices" edge of the OfficeLocation entity.
+func (ol *OfficeLocation) QueryDevices() *DeviceQuery {
+	return NewOfficeLocationClient(ol.config).QueryDevices(ol)
+}
+
+// QueryKiosks queries the "kiosks" edge of the OfficeLocation entity.
+func (ol *OfficeLocation) QueryKiosks() *KioskQuery {
+	return NewOfficeLocationClient(ol.config).QueryKiosks(ol)
+}
+
+// Update returns a builder for updating this OfficeLocation.
+// Note that you need to call OfficeLocation.Unwrap() before calling this method if this OfficeLocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ol *OfficeLocation) Update() *OfficeLocationUpdateOne {
+	return NewOfficeLocationClient(ol.config).UpdateOne(ol)
+}
+
+// Unwrap unwraps the OfficeLocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ol *OfficeLocation) Unwrap() *OfficeLocation {
+	_tx, ok := ol.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OfficeLocation is not a transactional entity")
+	}
+	ol.config.driver = _tx.drv
+	return ol
+}
+
+// String implements the fmt.Stringer.
+func (ol *OfficeLocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("OfficeLocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ol.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ol.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(ol.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ol.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ol.Name)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(ol.Address)
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", ol.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", ol.Longitude))
+	builder.WriteString(", ")
+	builder.WriteString("radius_meters=")
+	builder.WriteString(fmt.Sprintf("%v", ol.RadiusMeters))
+	builder.WriteString(", ")
+	builder.WriteString("enforcement=")
+	builder.WriteString(fmt.Sprintf("%v", ol.Enforcement))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(ol.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", ol.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OfficeLocations is a parsable slice of OfficeLocation.
+type OfficeLocations []*OfficeLocation
@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package officelocation
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldName, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldAddress, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldLongitude, v))
+}
+
+// RadiusMeters applies equality check predicate on the "radius_meters" field. It's identical to RadiusMetersEQ.
+func RadiusMeters(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldRadiusMeters, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldTimezone, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContainsFold(FieldName, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldLongitude, v))
+}
+
+// RadiusMetersEQ applies the EQ predicate on the "radius_meters" field.
+func RadiusMetersEQ(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldRadiusMeters, v))
+}
+
+// RadiusMetersNEQ applies the NEQ predicate on the "radius_meters" field.
+func RadiusMetersNEQ(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldRadiusMeters, v))
+}
+
+// RadiusMetersIn applies the In predicate on the "radius_meters" field.
+func RadiusMetersIn(vs ...int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldRadiusMeters, vs...))
+}
+
+// RadiusMetersNotIn applies the NotIn predicate on the "radius_meters" field.
+func RadiusMetersNotIn(vs ...int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldRadiusMeters, vs...))
+}
+
+// RadiusMetersGT applies the GT predicate on the "radius_meters" field.
+func RadiusMetersGT(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldRadiusMeters, v))
+}
+
+// RadiusMetersGTE applies the GTE predicate on the "radius_meters" field.
+func RadiusMetersGTE(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldRadiusMeters, v))
+}
+
+// RadiusMetersLT applies the LT predicate on the "radius_meters" field.
+func RadiusMetersLT(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldRadiusMeters, v))
+}
+
+// RadiusMetersLTE applies the LTE predicate on the "radius_meters" field.
+func RadiusMetersLTE(v int) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldRadiusMeters, v))
+}
+
+// EnforcementEQ applies the EQ predicate on the "enforcement" field.
+func EnforcementEQ(v Enforcement) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldEnforcement, v))
+}
+
+// EnforcementNEQ applies the NEQ predicate on the "enforcement" field.
+func EnforcementNEQ(v Enforcement) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldEnforcement, v))
+}
+
+// EnforcementIn applies the In predicate on the "enforcement" field.
+func EnforcementIn(vs ...Enforcement) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldEnforcement, vs...))
+}
+
+// EnforcementNotIn applies the NotIn predicate on the "enforcement" field.
+func EnforcementNotIn(vs ...Enforcement) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldEnforcement, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.OfficeLocation {
+	return predicate.OfficeLocation(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// HasEmployees applies the HasEdge predicate on the "employees" edge.
+func HasEmployees() predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmployeesTable, EmployeesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeesWith applies the HasEdge predicate on the "employees" edge with a given conditions (other predicates).
+func HasEmployeesWith(preds ...predicate.Employee) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := newEmployeesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDevices applies the HasEdge predicate on the "devices" edge.
+func HasDevices() predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDevicesWith applies the HasEdge predicate on the "devices" edge with a given conditions (other predicates).
+func HasDevicesWith(preds ...predicate.Device) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := newDevicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKiosks applies the HasEdge predicate on the "kiosks" edge.
+func HasKiosks() predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, KiosksTable, KiosksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKiosksWith applies the HasEdge predicate on the "kiosks" edge with a given conditions (other predicates).
+func HasKiosksWith(preds ...predicate.Kiosk) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		step := newKiosksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OfficeLocation) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OfficeLocation) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OfficeLocation) predicate.OfficeLocation {
+	return predicate.OfficeLocation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OfficeLocationCreate is the builder for creating a OfficeLocation entity.
+type OfficeLocationCreate struct {
+	config
+	mutation *OfficeLocationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (olc *OfficeLocationCreate) SetCreatedAt(t time.Time) *OfficeLocationCreate {
+	olc.mutation.SetCreatedAt(t)
+	return olc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableCreatedAt(t *time.Time) *OfficeLocationCreate {
+	if t != nil {
+		olc.SetCreatedAt(*t)
+	}
+	return olc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (olc *OfficeLocationCreate) SetModifiedAt(t time.Time) *OfficeLocationCreate {
+	olc.mutation.SetModifiedAt(t)
+	return olc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableModifiedAt(t *time.Time) *OfficeLocationCreate {
+	if t != nil {
+		olc.SetModifiedAt(*t)
+	}
+	return olc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (olc *OfficeLocationCreate) SetDeletedAt(t time.Time) *OfficeLocationCreate {
+	olc.mutation.SetDeletedAt(t)
+	return olc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableDeletedAt(t *time.Time) *OfficeLocationCreate {
+	if t != nil {
+		olc.SetDeletedAt(*t)
+	}
+	return olc
+}
+
+// SetName sets the "name" field.
+func (olc *OfficeLocationCreate) SetName(s string) *OfficeLocationCreate {
+	olc.mutation.SetName(s)
+	return olc
+}
+
+// SetAddress sets the "address" field.
+func (olc *OfficeLocationCreate) SetAddress(s string) *OfficeLocationCreate {
+	olc.mutation.SetAddress(s)
+	return olc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableAddress(s *string) *OfficeLocationCreate {
+	if s != nil {
+		olc.SetAddress(*s)
+	}
+	return olc
+}
+
+// SetLatitude sets the "latitude" field.
+func (olc *OfficeLocationCreate) SetLatitude(f float64) *OfficeLocationCreate {
+	olc.mutation.SetLatitude(f)
+	return olc
+}
+
+// SetLongitude sets the "longitude" field.
+func (olc *OfficeLocationCreate) SetLongitude(f float64) *OfficeLocationCreate {
+	olc.mutation.SetLongitude(f)
+	return olc
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (olc *OfficeLocationCreate) SetRadiusMeters(i int) *OfficeLocationCreate {
+	olc.mutation.SetRadiusMeters(i)
+	return olc
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableRadiusMeters(i *int) *OfficeLocationCreate {
+	if i != nil {
+		olc.SetRadiusMeters(*i)
+	}
+	return olc
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (olc *OfficeLocationCreate) SetEnforcement(o officelocation.Enforcement) *OfficeLocationCreate {
+	olc.mutation.SetEnforcement(o)
+	return olc
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableEnforcement(o *officelocation.Enforcement) *OfficeLocationCreate {
+	if o != nil {
+		olc.SetEnforcement(*o)
+	}
+	return olc
+}
+
+// SetTimezone sets the "timezone" field.
+func (olc *OfficeLocationCreate) SetTimezone(s string) *OfficeLocationCreate {
+	olc.mutation.SetTimezone(s)
+	return olc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableTimezone(s *string) *OfficeLocationCreate {
+	if s != nil {
+		olc.SetTimezone(*s)
+	}
+	return olc
+}
+
+// SetIsActive sets the "is_active" field.
+func (olc *OfficeLocationCreate) SetIsActive(b bool) *OfficeLocationCreate {
+	olc.mutation.SetIsActive(b)
+	return olc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (olc *OfficeLocationCreate) SetNillableIsActive(b *bool) *OfficeLocationCreate {
+	if b != nil {
+		olc.SetIsActive(*b)
+	}
+	return olc
+}
+
+// SetID sets the "id" field.
+func (olc *OfficeLocationCreate) SetID(u uint64) *OfficeLocationCreate {
+	olc.mutation.SetID(u)
+	return olc
+}
+
+// AddEmployeeIDs adds the "employees" edge to the Employee entity by IDs.
+func (olc *OfficeLocationCreate) AddEmployeeIDs(ids ...uint64) *OfficeLocationCreate {
+	olc.mutation.AddEmployeeIDs(ids...)
+	return olc
+}
+
+// AddEmployees adds the "employees" edges to the Employee entity.
+func (olc *OfficeLocationCreate) AddEmployees(e ...*Employee) *OfficeLocationCreate {
+	ids := make([]uint64, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return olc.AddEmployeeIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (olc *OfficeLocationCreate) AddDeviceIDs(ids ...uint64) *OfficeLocationCreate {
+	olc.mutation.AddDeviceIDs(ids...)
+	return olc
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (olc *OfficeLocationCreate) AddDevices(d ...*Device) *OfficeLocationCreate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return olc.AddDeviceIDs(ids...)
+}
+
+// AddKioskIDs adds the "kiosks" edge to the Kiosk entity by IDs.
+func (olc *OfficeLocationCreate) AddKioskIDs(ids ...uint64) *OfficeLocationCreate {
+	olc.mutation.AddKioskIDs(ids...)
+	return olc
+}
+
+// AddKiosks adds the "kiosks" edges to the Kiosk entity.
+func (olc *OfficeLocationCreate) AddKiosks(k ...*Kiosk) *OfficeLocationCreate {
+	ids := make([]uint64, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return olc.AddKioskIDs(ids...)
+}
+
+// Mutation returns the OfficeLocationMutation object of the builder.
+func (olc *OfficeLocationCreate) Mutation() *OfficeLocationMutation {
+	return olc.mutation
+}
+
+// Save creates the OfficeLocation in the database.
+func (olc *OfficeLocationCreate) Save(ctx context.Context) (*OfficeLocation, error) {
+	olc.defaults()
+	return withHooks(ctx, olc.sqlSave, olc.mutation, olc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (olc *OfficeLocationCreate) SaveX(ctx context.Context) *OfficeLocation {
+	v, err := olc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (olc *OfficeLocationCreate) Exec(ctx context.Context) error {
+	_, err := olc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olc *OfficeLocationCreate) ExecX(ctx context.Context) {
+	if err := olc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (olc *OfficeLocationCreate) defaults() {
+	if _, ok := olc.mutation.CreatedAt(); !ok {
+		v := officelocation.DefaultCreatedAt()
+		olc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := olc.mutation.ModifiedAt(); !ok {
+		v := officelocation.DefaultModifiedAt()
+		olc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := olc.mutation.RadiusMeters(); !ok {
+		v := officelocation.DefaultRadiusMeters
+		olc.mutation.SetRadiusMeters(v)
+	}
+	if _, ok := olc.mutation.Enforcement(); !ok {
+		v := officelocation.DefaultEnforcement
+		olc.mutation.SetEnforcement(v)
+	}
+	if _, ok := olc.mutation.IsActive(); !ok {
+		v := officelocation.DefaultIsActive
+		olc.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (olc *OfficeLocationCreate) check() error {
+	if _, ok := olc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OfficeLocation.created_at"`)}
+	}
+	if _, ok := olc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "OfficeLocation.modified_at"`)}
+	}
+	if _, ok := olc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OfficeLocation.name"`)}
+	}
+	if v, ok := olc.mutation.Name(); ok {
+		if err := officelocation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.name": %w`, err)}
+		}
+	}
+	if _, ok := olc.mutation.Latitude(); !ok {
+		return &ValidationError{Name: "latitude", err: errors.New(`ent: missing required field "OfficeLocation.latitude"`)}
+	}
+	if v, ok := olc.mutation.Latitude(); ok {
+		if err := officelocation.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.latitude": %w`, err)}
+		}
+	}
+	if _, ok := olc.mutation.Longitude(); !ok {
+		return &ValidationError{Name: "longitude", err: errors.New(`ent: missing required field "OfficeLocation.longitude"`)}
+	}
+	if v, ok := olc.mutation.Longitude(); ok {
+		if err := officelocation.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.longitude": %w`, err)}
+		}
+	}
+	if _, ok := olc.mutation.RadiusMeters(); !ok {
+		return &ValidationError{Name: "radius_meters", err: errors.New(`ent: missing required field "OfficeLocation.radius_meters"`)}
+	}
+	if v, ok := olc.mutation.RadiusMeters(); ok {
+		if err := officelocation.RadiusMetersValidator(v); err != nil {
+			return &ValidationError{Name: "radius_meters", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.radius_meters": %w`, err)}
+		}
+	}
+	if _, ok := olc.mutation.Enforcement(); !ok {
+		return &ValidationError{Name: "enforcement", err: errors.New(`ent: missing required field "OfficeLocation.enforcement"`)}
+	}
+	if v, ok := olc.mutation.Enforcement(); ok {
+		if err := officelocation.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := olc.mutation.Timezone(); ok {
+		if err := officelocation.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.timezone": %w`, err)}
+		}
+	}
+	if _, ok := olc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "OfficeLocation.is_active"`)}
+	}
+	return nil
+}
+
+func (olc *OfficeLocationCreate) sqlSave(ctx context.Context) (*OfficeLocation, error) {
+	if err := olc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := olc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, olc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	olc.mutation.id = &_node.ID
+	olc.mutation.done = true
+	return _node, nil
+}
+
+func (olc *OfficeLocationCreate) createSpec() (*OfficeLocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OfficeLocation{config: olc.config}
+		_spec = sqlgraph.NewCreateSpec(officelocation.Table, sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64))
+	)
+	if id, ok := olc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := olc.mutation.CreatedAt(); ok {
+		_spec.SetField(officelocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := olc.mutation.ModifiedAt(); ok {
+		_spec.SetField(officelocation.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := olc.mutation.DeletedAt(); ok {
+		_spec.SetField(officelocation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := olc.mutation.Name(); ok {
+		_spec.SetField(officelocation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := olc.mutation.Address(); ok {
+		_spec.SetField(officelocation.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := olc.mutation.Latitude(); ok {
+		_spec.SetField(officelocation.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := olc.mutation.Longitude(); ok {
+		_spec.SetField(officelocation.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	if value, ok := olc.mutation.RadiusMeters(); ok {
+		_spec.SetField(officelocation.FieldRadiusMeters, field.TypeInt, value)
+		_node.RadiusMeters = value
+	}
+	if value, ok := olc.mutation.Enforcement(); ok {
+		_spec.SetField(officelocation.FieldEnforcement, field.TypeEnum, value)
+		_node.Enforcement = value
+	}
+	if value, ok := olc.mutation.Timezone(); ok {
+		_spec.SetField(officelocation.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := olc.mutation.IsActive(); ok {
+		_spec.SetField(officelocation.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := olc.mutation.EmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := olc.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := olc.mutation.KiosksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OfficeLocationCreateBulk is the builder for creating many OfficeLocation entities in bulk.
+type OfficeLocationCreateBulk struct {
+	config
+	builders []*OfficeLocationCreate
+}
+
+// Save creates the OfficeLocation entities in the database.
+func (olcb *OfficeLocationCreateBulk) Save(ctx context.Context) ([]*OfficeLocation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(olcb.builders))
+	nodes := make([]*OfficeLocation, len(olcb.builders))
+	mutators := make([]Mutator, len(olcb.builders))
+	for i := range olcb.builders {
+		func(i int, root context.Context) {
+			builder := olcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OfficeLocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, olcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, olcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, olcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (olcb *OfficeLocationCreateBulk) SaveX(ctx context.Context) []*OfficeLocation {
+	v, err := olcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (olcb *OfficeLocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := olcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olcb *OfficeLocationCreateBulk) ExecX(ctx context.Context) {
+	if err := olcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OfficeLocationDelete is the builder for deleting a OfficeLocation entity.
+type OfficeLocationDelete struct {
+	config
+	hooks    []Hook
+	mutation *OfficeLocationMutation
+}
+
+// Where appends a list predicates to the OfficeLocationDelete builder.
+func (old *OfficeLocationDelete) Where(ps ...predicate.OfficeLocation) *OfficeLocationDelete {
+	old.mutation.Where(ps...)
+	return old
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (old *OfficeLocationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, old.sqlExec, old.mutation, old.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (old *OfficeLocationDelete) ExecX(ctx context.Context) int {
+	n, err := old.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (old *OfficeLocationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(officelocation.Table, sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64))
+	if ps := old.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, old.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	old.mutation.done = true
+	return affected, err
+}
+
+// OfficeLocationDeleteOne is the builder for deleting a single OfficeLocation entity.
+type OfficeLocationDeleteOne struct {
+	old *OfficeLocationDelete
+}
+
+// Where appends a list predicates to the OfficeLocationDelete builder.
+func (oldo *OfficeLocationDeleteOne) Where(ps ...predicate.OfficeLocation) *OfficeLocationDeleteOne {
+	oldo.old.mutation.Where(ps...)
+	return oldo
+}
+
+// Exec executes the deletion query.
+func (oldo *OfficeLocationDeleteOne) Exec(ctx context.Context) error {
+	n, err := oldo.old.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{officelocation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oldo *OfficeLocationDeleteOne) ExecX(ctx context.Context) {
+	if err := oldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,784 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/device"
+	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OfficeLocationQuery is the builder for querying OfficeLocation entities.
+type OfficeLocationQuery struct {
+	config
+	ctx           *QueryContext
+	order         []officelocation.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.OfficeLocation
+	withEmployees *EmployeeQuery
+	withDevices   *DeviceQuery
+	withKiosks    *KioskQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OfficeLocationQuery builder.
+func (olq *OfficeLocationQuery) Where(ps ...predicate.OfficeLocation) *OfficeLocationQuery {
+	olq.predicates = append(olq.predicates, ps...)
+	return olq
+}
+
+// Limit the number of records to be returned by this query.
+func (olq *OfficeLocationQuery) Limit(limit int) *OfficeLocationQuery {
+	olq.ctx.Limit = &limit
+	return olq
+}
+
+// Offset to start from.
+func (olq *OfficeLocationQuery) Offset(offset int) *OfficeLocationQuery {
+	olq.ctx.Offset = &offset
+	return olq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (olq *OfficeLocationQuery) Unique(unique bool) *OfficeLocationQuery {
+	olq.ctx.Unique = &unique
+	return olq
+}
+
+// Order specifies how the records should be ordered.
+func (olq *OfficeLocationQuery) Order(o ...officelocation.OrderOption) *OfficeLocationQuery {
+	olq.order = append(olq.order, o...)
+	return olq
+}
+
+// QueryEmployees chains the current query on the "employees" edge.
+func (olq *OfficeLocationQuery) QueryEmployees() *EmployeeQuery {
+	query := (&EmployeeClient{config: olq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := olq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := olq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.EmployeesTable, officelocation.EmployeesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(olq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDevices chains the current query on the "devices" edge.
+func (olq *OfficeLocationQuery) QueryDevices() *DeviceQuery {
+	query := (&DeviceClient{config: olq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := olq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := olq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.DevicesTable, officelocation.DevicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(olq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryKiosks chains the current query on the "kiosks" edge.
+func (olq *OfficeLocationQuery) QueryKiosks() *KioskQuery {
+	query := (&KioskClient{config: olq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := olq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := olq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(officelocation.Table, officelocation.FieldID, selector),
+			sqlgraph.To(kiosk.Table, kiosk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, officelocation.KiosksTable, officelocation.KiosksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(olq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OfficeLocation entity from the query.
+// Returns a *NotFoundError when no OfficeLocation was found.
+func (olq *OfficeLocationQuery) First(ctx context.Context) (*OfficeLocation, error) {
+	nodes, err := olq.Limit(1).All(setContextOp(ctx, olq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{officelocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (olq *OfficeLocationQuery) FirstX(ctx context.Context) *OfficeLocation {
+	node, err := olq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OfficeLocation ID from the query.
+// Returns a *NotFoundError when no OfficeLocation ID was found.
+func (olq *OfficeLocationQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = olq.Limit(1).IDs(setContextOp(ctx, olq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{officelocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (olq *OfficeLocationQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := olq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OfficeLocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OfficeLocation entity is found.
+// Returns a *NotFoundError when no OfficeLocation entities are found.
+func (olq *OfficeLocationQuery) Only(ctx context.Context) (*OfficeLocation, error) {
+	nodes, err := olq.Limit(2).All(setContextOp(ctx, olq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{officelocation.Label}
+	default:
+		return nil, &NotSingularError{officelocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (olq *OfficeLocationQuery) OnlyX(ctx context.Context) *OfficeLocation {
+	node, err := olq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OfficeLocation ID in the query.
+// Returns a *NotSingularError when more than one OfficeLocation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (olq *OfficeLocationQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = olq.Limit(2).IDs(setContextOp(ctx, olq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{officelocation.Label}
+	default:
+		err = &NotSingularError{officelocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (olq *OfficeLocationQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := olq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OfficeLocations.
+func (olq *OfficeLocationQuery) All(ctx context.Context) ([]*OfficeLocation, error) {
+	ctx = setContextOp(ctx, olq.ctx, "All")
+	if err := olq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OfficeLocation, *OfficeLocationQuery]()
+	return withInterceptors[[]*OfficeLocation](ctx, olq, qr, olq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (olq *OfficeLocationQuery) AllX(ctx context.Context) []*OfficeLocation {
+	nodes, err := olq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OfficeLocation IDs.
+func (olq *OfficeLocationQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if olq.ctx.Unique == nil && olq.path != nil {
+		olq.Unique(true)
+	}
+	ctx = setContextOp(ctx, olq.ctx, "IDs")
+	if err = olq.Select(officelocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (olq *OfficeLocationQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := olq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (olq *OfficeLocationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, olq.ctx, "Count")
+	if err := olq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, olq, querierCount[*OfficeLocationQuery](), olq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (olq *OfficeLocationQuery) CountX(ctx context.Context) int {
+	count, err := olq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (olq *OfficeLocationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, olq.ctx, "Exist")
+	switch _, err := olq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (olq *OfficeLocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := olq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OfficeLocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (olq *OfficeLocationQuery) Clone() *OfficeLocationQuery {
+	if olq == nil {
+		return nil
+	}
+	return &OfficeLocationQuery{
+		config:        olq.config,
+		ctx:           olq.ctx.Clone(),
+		order:         append([]officelocation.OrderOption{}, olq.order...),
+		inters:        append([]Interceptor{}, olq.inters...),
+		predicates:    append([]predicate.OfficeLocation{}, olq.predicates...),
+		withEmployees: olq.withEmployees.Clone(),
+		withDevices:   olq.withDevices.Clone(),
+		withKiosks:    olq.withKiosks.Clone(),
+		// clone intermediate query.
+		sql:  olq.sql.Clone(),
+		path: olq.path,
+	}
+}
+
+// WithEmployees tells the query-builder to eager-load the nodes that are connected to
+// the "employees" edge. The optional arguments are used to configure the query builder of the edge.
+func (olq *OfficeLocationQuery) WithEmployees(opts ...func(*EmployeeQuery)) *OfficeLocationQuery {
+	query := (&EmployeeClient{config: olq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	olq.withEmployees = query
+	return olq
+}
+
+// WithDevices tells the query-builder to eager-load the nodes that are connected to
+// the "devices" edge. The optional arguments are used to configure the query builder of the edge.
+func (olq *OfficeLocationQuery) WithDevices(opts ...func(*DeviceQuery)) *OfficeLocationQuery {
+	query := (&DeviceClient{config: olq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	olq.withDevices = query
+	return olq
+}
+
+// WithKiosks tells the query-builder to eager-load the nodes that are connected to
+// the "kiosks" edge. The optional arguments are used to configure the query builder of the edge.
+func (olq *OfficeLocationQuery) WithKiosks(opts ...func(*KioskQuery)) *OfficeLocationQuery {
+	query := (&KioskClient{config: olq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	olq.withKiosks = query
+	return olq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OfficeLocation.Query().
+//		GroupBy(officelocation.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (olq *OfficeLocationQuery) GroupBy(field string, fields ...string) *OfficeLocationGroupBy {
+	olq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OfficeLocationGroupBy{build: olq}
+	grbuild.flds = &olq.ctx.Fields
+	grbuild.label = officelocation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.OfficeLocation.Query().
+//		Select(officelocation.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (olq *OfficeLocationQuery) Select(fields ...string) *OfficeLocationSelect {
+	olq.ctx.Fields = append(olq.ctx.Fields, fields...)
+	sbuild := &OfficeLocationSelect{OfficeLocationQuery: olq}
+	sbuild.label = officelocation.Label
+	sbuild.flds, sbuild.scan = &olq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OfficeLocationSelect configured with the given aggregations.
+func (olq *OfficeLocationQuery) Aggregate(fns ...AggregateFunc) *OfficeLocationSelect {
+	return olq.Select().Aggregate(fns...)
+}
+
+func (olq *OfficeLocationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range olq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, olq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range olq.ctx.Fields {
+		if !officelocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if olq.path != nil {
+		prev, err := olq.path(ctx)
+		if err != nil {
+			return err
+		}
+		olq.sql = prev
+	}
+	return nil
+}
+
+func (olq *OfficeLocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OfficeLocation, error) {
+	var (
+		nodes       = []*OfficeLocation{}
+		_spec       = olq.querySpec()
+		loadedTypes = [3]bool{
+			olq.withEmployees != nil,
+			olq.withDevices != nil,
+			olq.withKiosks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OfficeLocation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OfficeLocation{config: olq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(olq.modifiers) > 0 {
+		_spec.Modifiers = olq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, olq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := olq.withEmployees; query != nil {
+		if err := olq.loadEmployees(ctx, query, nodes,
+			func(n *OfficeLocation) { n.Edges.Employees = []*Employee{} },
+			func(n *OfficeLocation, e *Employee) { n.Edges.Employees = append(n.Edges.Employees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := olq.withDevices; query != nil {
+		if err := olq.loadDevices(ctx, query, nodes,
+			func(n *OfficeLocation) { n.Edges.Devices = []*Device{} },
+			func(n *OfficeLocation, e *Device) { n.Edges.Devices = append(n.Edges.Devices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := olq.withKiosks; query != nil {
+		if err := olq.loadKiosks(ctx, query, nodes,
+			func(n *OfficeLocation) { n.Edges.Kiosks = []*Kiosk{} },
+			func(n *OfficeLocation, e *Kiosk) { n.Edges.Kiosks = append(n.Edges.Kiosks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (olq *OfficeLocationQuery) loadEmployees(ctx context.Context, query *EmployeeQuery, nodes []*OfficeLocation, init func(*OfficeLocation), assign func(*OfficeLocation, *Employee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*OfficeLocation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(employee.FieldOfficeLocationID)
+	}
+	query.Where(predicate.Employee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(officelocation.EmployeesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OfficeLocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "office_location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "office_location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (olq *OfficeLocationQuery) loadDevices(ctx context.Context, query *DeviceQuery, nodes []*OfficeLocation, init func(*OfficeLocation), assign func(*OfficeLocation, *Device)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*OfficeLocation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(device.FieldOfficeLocationID)
+	}
+	query.Where(predicate.Device(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(officelocation.DevicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OfficeLocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "office_location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "office_location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (olq *OfficeLocationQuery) loadKiosks(ctx context.Context, query *KioskQuery, nodes []*OfficeLocation, init func(*OfficeLocation), assign func(*OfficeLocation, *Kiosk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*OfficeLocation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(kiosk.FieldOfficeLocationID)
+	}
+	query.Where(predicate.Kiosk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(officelocation.KiosksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OfficeLocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "office_location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "office_location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (olq *OfficeLocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := olq.querySpec()
+	if len(olq.modifiers) > 0 {
+		_spec.Modifiers = olq.modifiers
+	}
+	_spec.Node.Columns = olq.ctx.Fields
+	if len(olq.ctx.Fields) > 0 {
+		_spec.Unique = olq.ctx.Unique != nil && *olq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, olq.driver, _spec)
+}
+
+func (olq *OfficeLocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(officelocation.Table, officelocation.Columns, sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64))
+	_spec.From = olq.sql
+	if unique := olq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if olq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := olq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, officelocation.FieldID)
+		for i := range fields {
+			if fields[i] != officelocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := olq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := olq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := olq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := olq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (olq *OfficeLocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(olq.driver.Dialect())
+	t1 := builder.Table(officelocation.Table)
+	columns := olq.ctx.Fields
+	if len(columns) == 0 {
+		columns = officelocation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if olq.sql != nil {
+		selector = olq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if olq.ctx.Unique != nil && *olq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range olq.modifiers {
+		m(selector)
+	}
+	for _, p := range olq.predicates {
+		p(selector)
+	}
+	for _, p := range olq.order {
+		p(selector)
+	}
+	if offset := olq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := olq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (olq *OfficeLocationQuery) Modify(modifiers ...func(s *sql.Selector)) *OfficeLocationSelect {
+	olq.modifiers = append(olq.modifiers, modifiers...)
+	return olq.Select()
+}
+
+// OfficeLocationGroupBy is the group-by builder for OfficeLocation entities.
+type OfficeLocationGroupBy struct {
+	selector
+	build *OfficeLocationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (olgb *OfficeLocationGroupBy) Aggregate(fns ...AggregateFunc) *OfficeLocationGroupBy {
+	olgb.fns = append(olgb.fns, fns...)
+	return olgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (olgb *OfficeLocationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, olgb.build.ctx, "GroupBy")
+	if err := olgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OfficeLocationQuery, *OfficeLocationGroupBy](ctx, olgb.build, olgb, olgb.build.inters, v)
+}
+
+func (olgb *OfficeLocationGroupBy) sqlScan(ctx context.Context, root *OfficeLocationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(olgb.fns))
+	for _, fn := range olgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*olgb.flds)+len(olgb.fns))
+		for _, f := range *olgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*olgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := olgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OfficeLocationSelect is the builder for selecting fields of OfficeLocation entities.
+type OfficeLocationSelect struct {
+	*OfficeLocationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ols *OfficeLocationSelect) Aggregate(fns ...AggregateFunc) *OfficeLocationSelect {
+	ols.fns = append(ols.fns, fns...)
+	return ols
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ols *OfficeLocationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ols.ctx, "Select")
+	if err := ols.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OfficeLocationQuery, *OfficeLocationSelect](ctx, ols.OfficeLocationQuery, ols, ols.inters, v)
+}
+
+func (ols *OfficeLocationSelect) sqlScan(ctx context.Context, root *OfficeLocationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ols.fns))
+	for _, fn := range ols.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ols.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ols.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ols *OfficeLocationSelect) Modify(modifiers ...func(s *sql.Selector)) *OfficeLocationSelect {
+	ols.modifiers = append(ols.modifiers, modifiers...)
+	return ols
+}
@@ -0,0 +1,1165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/device"
+	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OfficeLocationUpdate is the builder for updating OfficeLocation entities.
+type OfficeLocationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OfficeLocationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OfficeLocationUpdate builder.
+func (olu *OfficeLocationUpdate) Where(ps ...predicate.OfficeLocation) *OfficeLocationUpdate {
+	olu.mutation.Where(ps...)
+	return olu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (olu *OfficeLocationUpdate) SetModifiedAt(t time.Time) *OfficeLocationUpdate {
+	olu.mutation.SetModifiedAt(t)
+	return olu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (olu *OfficeLocationUpdate) SetDeletedAt(t time.Time) *OfficeLocationUpdate {
+	olu.mutation.SetDeletedAt(t)
+	return olu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableDeletedAt(t *time.Time) *OfficeLocationUpdate {
+	if t != nil {
+		olu.SetDeletedAt(*t)
+	}
+	return olu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (olu *OfficeLocationUpdate) ClearDeletedAt() *OfficeLocationUpdate {
+	olu.mutation.ClearDeletedAt()
+	return olu
+}
+
+// SetName sets the "name" field.
+func (olu *OfficeLocationUpdate) SetName(s string) *OfficeLocationUpdate {
+	olu.mutation.SetName(s)
+	return olu
+}
+
+// SetAddress sets the "address" field.
+func (olu *OfficeLocationUpdate) SetAddress(s string) *OfficeLocationUpdate {
+	olu.mutation.SetAddress(s)
+	return olu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableAddress(s *string) *OfficeLocationUpdate {
+	if s != nil {
+		olu.SetAddress(*s)
+	}
+	return olu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (olu *OfficeLocationUpdate) ClearAddress() *OfficeLocationUpdate {
+	olu.mutation.ClearAddress()
+	return olu
+}
+
+// SetLatitude sets the "latitude" field.
+func (olu *OfficeLocationUpdate) SetLatitude(f float64) *OfficeLocationUpdate {
+	olu.mutation.ResetLatitude()
+	olu.mutation.SetLatitude(f)
+	return olu
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (olu *OfficeLocationUpdate) AddLatitude(f float64) *OfficeLocationUpdate {
+	olu.mutation.AddLatitude(f)
+	return olu
+}
+
+// SetLongitude sets the "longitude" field.
+func (olu *OfficeLocationUpdate) SetLongitude(f float64) *OfficeLocationUpdate {
+	olu.mutation.ResetLongitude()
+	olu.mutation.SetLongitude(f)
+	return olu
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (olu *OfficeLocationUpdate) AddLongitude(f float64) *OfficeLocationUpdate {
+	olu.mutation.AddLongitude(f)
+	return olu
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (olu *OfficeLocationUpdate) SetRadiusMeters(i int) *OfficeLocationUpdate {
+	olu.mutation.ResetRadiusMeters()
+	olu.mutation.SetRadiusMeters(i)
+	return olu
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableRadiusMeters(i *int) *OfficeLocationUpdate {
+	if i != nil {
+		olu.SetRadiusMeters(*i)
+	}
+	return olu
+}
+
+// AddRadiusMeters adds i to the "radius_meters" field.
+func (olu *OfficeLocationUpdate) AddRadiusMeters(i int) *OfficeLocationUpdate {
+	olu.mutation.AddRadiusMeters(i)
+	return olu
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (olu *OfficeLocationUpdate) SetEnforcement(o officelocation.Enforcement) *OfficeLocationUpdate {
+	olu.mutation.SetEnforcement(o)
+	return olu
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableEnforcement(o *officelocation.Enforcement) *OfficeLocationUpdate {
+	if o != nil {
+		olu.SetEnforcement(*o)
+	}
+	return olu
+}
+
+// SetTimezone sets the "timezone" field.
+func (olu *OfficeLocationUpdate) SetTimezone(s string) *OfficeLocationUpdate {
+	olu.mutation.SetTimezone(s)
+	return olu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableTimezone(s *string) *OfficeLocationUpdate {
+	if s != nil {
+		olu.SetTimezone(*s)
+	}
+	return olu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (olu *OfficeLocationUpdate) ClearTimezone() *OfficeLocationUpdate {
+	olu.mutation.ClearTimezone()
+	return olu
+}
+
+// SetIsActive sets the "is_active" field.
+func (olu *OfficeLocationUpdate) SetIsActive(b bool) *OfficeLocationUpdate {
+	olu.mutation.SetIsActive(b)
+	return olu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (olu *OfficeLocationUpdate) SetNillableIsActive(b *bool) *OfficeLocationUpdate {
+	if b != nil {
+		olu.SetIsActive(*b)
+	}
+	return olu
+}
+
+// AddEmployeeIDs adds the "employees" edge to the Employee entity by IDs.
+func (olu *OfficeLocationUpdate) AddEmployeeIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.AddEmployeeIDs(ids...)
+	return olu
+}
+
+// AddEmployees adds the "employees" edges to the Employee entity.
+func (olu *OfficeLocationUpdate) AddEmployees(e ...*Employee) *OfficeLocationUpdate {
+	ids := make([]uint64, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return olu.AddEmployeeIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (olu *OfficeLocationUpdate) AddDeviceIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.AddDeviceIDs(ids...)
+	return olu
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (olu *OfficeLocationUpdate) AddDevices(d ...*Device) *OfficeLocationUpdate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return olu.AddDeviceIDs(ids...)
+}
+
+// AddKioskIDs adds the "kiosks" edge to the Kiosk entity by IDs.
+func (olu *OfficeLocationUpdate) AddKioskIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.AddKioskIDs(ids...)
+	return olu
+}
+
+// AddKiosks adds the "kiosks" edges to the Kiosk entity.
+func (olu *OfficeLocationUpdate) AddKiosks(k ...*Kiosk) *OfficeLocationUpdate {
+	ids := make([]uint64, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return olu.AddKioskIDs(ids...)
+}
+
+// Mutation returns the OfficeLocationMutation object of the builder.
+func (olu *OfficeLocationUpdate) Mutation() *OfficeLocationMutation {
+	return olu.mutation
+}
+
+// ClearEmployees clears all "employees" edges to the Employee entity.
+func (olu *OfficeLocationUpdate) ClearEmployees() *OfficeLocationUpdate {
+	olu.mutation.ClearEmployees()
+	return olu
+}
+
+// RemoveEmployeeIDs removes the "employees" edge to Employee entities by IDs.
+func (olu *OfficeLocationUpdate) RemoveEmployeeIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.RemoveEmployeeIDs(ids...)
+	return olu
+}
+
+// RemoveEmployees removes "employees" edges to Employee entities.
+func (olu *OfficeLocationUpdate) RemoveEmployees(e ...*Employee) *OfficeLocationUpdate {
+	ids := make([]uint64, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return olu.RemoveEmployeeIDs(ids...)
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (olu *OfficeLocationUpdate) ClearDevices() *OfficeLocationUpdate {
+	olu.mutation.ClearDevices()
+	return olu
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (olu *OfficeLocationUpdate) RemoveDeviceIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.RemoveDeviceIDs(ids...)
+	return olu
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (olu *OfficeLocationUpdate) RemoveDevices(d ...*Device) *OfficeLocationUpdate {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return olu.RemoveDeviceIDs(ids...)
+}
+
+// ClearKiosks clears all "kiosks" edges to the Kiosk entity.
+func (olu *OfficeLocationUpdate) ClearKiosks() *OfficeLocationUpdate {
+	olu.mutation.ClearKiosks()
+	return olu
+}
+
+// RemoveKioskIDs removes the "kiosks" edge to Kiosk entities by IDs.
+func (olu *OfficeLocationUpdate) RemoveKioskIDs(ids ...uint64) *OfficeLocationUpdate {
+	olu.mutation.RemoveKioskIDs(ids...)
+	return olu
+}
+
+// RemoveKiosks removes "kiosks" edges to Kiosk entities.
+func (olu *OfficeLocationUpdate) RemoveKiosks(k ...*Kiosk) *OfficeLocationUpdate {
+	ids := make([]uint64, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return olu.RemoveKioskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (olu *OfficeLocationUpdate) Save(ctx context.Context) (int, error) {
+	olu.defaults()
+	return withHooks(ctx, olu.sqlSave, olu.mutation, olu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (olu *OfficeLocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := olu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (olu *OfficeLocationUpdate) Exec(ctx context.Context) error {
+	_, err := olu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olu *OfficeLocationUpdate) ExecX(ctx context.Context) {
+	if err := olu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (olu *OfficeLocationUpdate) defaults() {
+	if _, ok := olu.mutation.ModifiedAt(); !ok {
+		v := officelocation.UpdateDefaultModifiedAt()
+		olu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (olu *OfficeLocationUpdate) check() error {
+	if v, ok := olu.mutation.Name(); ok {
+		if err := officelocation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.name": %w`, err)}
+		}
+	}
+	if v, ok := olu.mutation.Latitude(); ok {
+		if err := officelocation.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.latitude": %w`, err)}
+		}
+	}
+	if v, ok := olu.mutation.Longitude(); ok {
+		if err := officelocation.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.longitude": %w`, err)}
+		}
+	}
+	if v, ok := olu.mutation.RadiusMeters(); ok {
+		if err := officelocation.RadiusMetersValidator(v); err != nil {
+			return &ValidationError{Name: "radius_meters", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.radius_meters": %w`, err)}
+		}
+	}
+	if v, ok := olu.mutation.Enforcement(); ok {
+		if err := officelocation.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := olu.mutation.Timezone(); ok {
+		if err := officelocation.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (olu *OfficeLocationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OfficeLocationUpdate {
+	olu.modifiers = append(olu.modifiers, modifiers...)
+	return olu
+}
+
+func (olu *OfficeLocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := olu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(officelocation.Table, officelocation.Columns, sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64))
+	if ps := olu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := olu.mutation.ModifiedAt(); ok {
+		_spec.SetField(officelocation.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := olu.mutation.DeletedAt(); ok {
+		_spec.SetField(officelocation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if olu.mutation.DeletedAtCleared() {
+		_spec.ClearField(officelocation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := olu.mutation.Name(); ok {
+		_spec.SetField(officelocation.FieldName, field.TypeString, value)
+	}
+	if value, ok := olu.mutation.Address(); ok {
+		_spec.SetField(officelocation.FieldAddress, field.TypeString, value)
+	}
+	if olu.mutation.AddressCleared() {
+		_spec.ClearField(officelocation.FieldAddress, field.TypeString)
+	}
+	if value, ok := olu.mutation.Latitude(); ok {
+		_spec.SetField(officelocation.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := olu.mutation.AddedLatitude(); ok {
+		_spec.AddField(officelocation.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := olu.mutation.Longitude(); ok {
+		_spec.SetField(officelocation.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := olu.mutation.AddedLongitude(); ok {
+		_spec.AddField(officelocation.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := olu.mutation.RadiusMeters(); ok {
+		_spec.SetField(officelocation.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := olu.mutation.AddedRadiusMeters(); ok {
+		_spec.AddField(officelocation.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := olu.mutation.Enforcement(); ok {
+		_spec.SetField(officelocation.FieldEnforcement, field.TypeEnum, value)
+	}
+	if value, ok := olu.mutation.Timezone(); ok {
+		_spec.SetField(officelocation.FieldTimezone, field.TypeString, value)
+	}
+	if olu.mutation.TimezoneCleared() {
+		_spec.ClearField(officelocation.FieldTimezone, field.TypeString)
+	}
+	if value, ok := olu.mutation.IsActive(); ok {
+		_spec.SetField(officelocation.FieldIsActive, field.TypeBool, value)
+	}
+	if olu.mutation.EmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.RemovedEmployeesIDs(); len(nodes) > 0 && !olu.mutation.EmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.EmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if olu.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !olu.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if olu.mutation.KiosksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.RemovedKiosksIDs(); len(nodes) > 0 && !olu.mutation.KiosksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := olu.mutation.KiosksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(olu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, olu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{officelocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	olu.mutation.done = true
+	return n, nil
+}
+
+// OfficeLocationUpdateOne is the builder for updating a single OfficeLocation entity.
+type OfficeLocationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OfficeLocationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (oluo *OfficeLocationUpdateOne) SetModifiedAt(t time.Time) *OfficeLocationUpdateOne {
+	oluo.mutation.SetModifiedAt(t)
+	return oluo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (oluo *OfficeLocationUpdateOne) SetDeletedAt(t time.Time) *OfficeLocationUpdateOne {
+	oluo.mutation.SetDeletedAt(t)
+	return oluo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableDeletedAt(t *time.Time) *OfficeLocationUpdateOne {
+	if t != nil {
+		oluo.SetDeletedAt(*t)
+	}
+	return oluo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (oluo *OfficeLocationUpdateOne) ClearDeletedAt() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearDeletedAt()
+	return oluo
+}
+
+// SetName sets the "name" field.
+func (oluo *OfficeLocationUpdateOne) SetName(s string) *OfficeLocationUpdateOne {
+	oluo.mutation.SetName(s)
+	return oluo
+}
+
+// SetAddress sets the "address" field.
+func (oluo *OfficeLocationUpdateOne) SetAddress(s string) *OfficeLocationUpdateOne {
+	oluo.mutation.SetAddress(s)
+	return oluo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableAddress(s *string) *OfficeLocationUpdateOne {
+	if s != nil {
+		oluo.SetAddress(*s)
+	}
+	return oluo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (oluo *OfficeLocationUpdateOne) ClearAddress() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearAddress()
+	return oluo
+}
+
+// SetLatitude sets the "latitude" field.
+func (oluo *OfficeLocationUpdateOne) SetLatitude(f float64) *OfficeLocationUpdateOne {
+	oluo.mutation.ResetLatitude()
+	oluo.mutation.SetLatitude(f)
+	return oluo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (oluo *OfficeLocationUpdateOne) AddLatitude(f float64) *OfficeLocationUpdateOne {
+	oluo.mutation.AddLatitude(f)
+	return oluo
+}
+
+// SetLongitude sets the "longitude" field.
+func (oluo *OfficeLocationUpdateOne) SetLongitude(f float64) *OfficeLocationUpdateOne {
+	oluo.mutation.ResetLongitude()
+	oluo.mutation.SetLongitude(f)
+	return oluo
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (oluo *OfficeLocationUpdateOne) AddLongitude(f float64) *OfficeLocationUpdateOne {
+	oluo.mutation.AddLongitude(f)
+	return oluo
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (oluo *OfficeLocationUpdateOne) SetRadiusMeters(i int) *OfficeLocationUpdateOne {
+	oluo.mutation.ResetRadiusMeters()
+	oluo.mutation.SetRadiusMeters(i)
+	return oluo
+}
+
+// SetNillableRadiusMeters sets the "radius_meters" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableRadiusMeters(i *int) *OfficeLocationUpdateOne {
+	if i != nil {
+		oluo.SetRadiusMeters(*i)
+	}
+	return oluo
+}
+
+// AddRadiusMeters adds i to the "radius_meters" field.
+func (oluo *OfficeLocationUpdateOne) AddRadiusMeters(i int) *OfficeLocationUpdateOne {
+	oluo.mutation.AddRadiusMeters(i)
+	return oluo
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (oluo *OfficeLocationUpdateOne) SetEnforcement(o officelocation.Enforcement) *OfficeLocationUpdateOne {
+	oluo.mutation.SetEnforcement(o)
+	return oluo
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableEnforcement(o *officelocation.Enforcement) *OfficeLocationUpdateOne {
+	if o != nil {
+		oluo.SetEnforcement(*o)
+	}
+	return oluo
+}
+
+// SetTimezone sets the "timezone" field.
+func (oluo *OfficeLocationUpdateOne) SetTimezone(s string) *OfficeLocationUpdateOne {
+	oluo.mutation.SetTimezone(s)
+	return oluo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableTimezone(s *string) *OfficeLocationUpdateOne {
+	if s != nil {
+		oluo.SetTimezone(*s)
+	}
+	return oluo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (oluo *OfficeLocationUpdateOne) ClearTimezone() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearTimezone()
+	return oluo
+}
+
+// SetIsActive sets the "is_active" field.
+func (oluo *OfficeLocationUpdateOne) SetIsActive(b bool) *OfficeLocationUpdateOne {
+	oluo.mutation.SetIsActive(b)
+	return oluo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (oluo *OfficeLocationUpdateOne) SetNillableIsActive(b *bool) *OfficeLocationUpdateOne {
+	if b != nil {
+		oluo.SetIsActive(*b)
+	}
+	return oluo
+}
+
+// AddEmployeeIDs adds the "employees" edge to the Employee entity by IDs.
+func (oluo *OfficeLocationUpdateOne) AddEmployeeIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.AddEmployeeIDs(ids...)
+	return oluo
+}
+
+// AddEmployees adds the "employees" edges to the Employee entity.
+func (oluo *OfficeLocationUpdateOne) AddEmployees(e ...*Employee) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return oluo.AddEmployeeIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (oluo *OfficeLocationUpdateOne) AddDeviceIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.AddDeviceIDs(ids...)
+	return oluo
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (oluo *OfficeLocationUpdateOne) AddDevices(d ...*Device) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return oluo.AddDeviceIDs(ids...)
+}
+
+// AddKioskIDs adds the "kiosks" edge to the Kiosk entity by IDs.
+func (oluo *OfficeLocationUpdateOne) AddKioskIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.AddKioskIDs(ids...)
+	return oluo
+}
+
+// AddKiosks adds the "kiosks" edges to the Kiosk entity.
+func (oluo *OfficeLocationUpdateOne) AddKiosks(k ...*Kiosk) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return oluo.AddKioskIDs(ids...)
+}
+
+// Mutation returns the OfficeLocationMutation object of the builder.
+func (oluo *OfficeLocationUpdateOne) Mutation() *OfficeLocationMutation {
+	return oluo.mutation
+}
+
+// ClearEmployees clears all "employees" edges to the Employee entity.
+func (oluo *OfficeLocationUpdateOne) ClearEmployees() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearEmployees()
+	return oluo
+}
+
+// RemoveEmployeeIDs removes the "employees" edge to Employee entities by IDs.
+func (oluo *OfficeLocationUpdateOne) RemoveEmployeeIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.RemoveEmployeeIDs(ids...)
+	return oluo
+}
+
+// RemoveEmployees removes "employees" edges to Employee entities.
+func (oluo *OfficeLocationUpdateOne) RemoveEmployees(e ...*Employee) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return oluo.RemoveEmployeeIDs(ids...)
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (oluo *OfficeLocationUpdateOne) ClearDevices() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearDevices()
+	return oluo
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (oluo *OfficeLocationUpdateOne) RemoveDeviceIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.RemoveDeviceIDs(ids...)
+	return oluo
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (oluo *OfficeLocationUpdateOne) RemoveDevices(d ...*Device) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return oluo.RemoveDeviceIDs(ids...)
+}
+
+// ClearKiosks clears all "kiosks" edges to the Kiosk entity.
+func (oluo *OfficeLocationUpdateOne) ClearKiosks() *OfficeLocationUpdateOne {
+	oluo.mutation.ClearKiosks()
+	return oluo
+}
+
+// RemoveKioskIDs removes the "kiosks" edge to Kiosk entities by IDs.
+func (oluo *OfficeLocationUpdateOne) RemoveKioskIDs(ids ...uint64) *OfficeLocationUpdateOne {
+	oluo.mutation.RemoveKioskIDs(ids...)
+	return oluo
+}
+
+// RemoveKiosks removes "kiosks" edges to Kiosk entities.
+func (oluo *OfficeLocationUpdateOne) RemoveKiosks(k ...*Kiosk) *OfficeLocationUpdateOne {
+	ids := make([]uint64, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return oluo.RemoveKioskIDs(ids...)
+}
+
+// Where appends a list predicates to the OfficeLocationUpdate builder.
+func (oluo *OfficeLocationUpdateOne) Where(ps ...predicate.OfficeLocation) *OfficeLocationUpdateOne {
+	oluo.mutation.Where(ps...)
+	return oluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oluo *OfficeLocationUpdateOne) Select(field string, fields ...string) *OfficeLocationUpdateOne {
+	oluo.fields = append([]string{field}, fields...)
+	return oluo
+}
+
+// Save executes the query and returns the updated OfficeLocation entity.
+func (oluo *OfficeLocationUpdateOne) Save(ctx context.Context) (*OfficeLocation, error) {
+	oluo.defaults()
+	return withHooks(ctx, oluo.sqlSave, oluo.mutation, oluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oluo *OfficeLocationUpdateOne) SaveX(ctx context.Context) *OfficeLocation {
+	node, err := oluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oluo *OfficeLocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := oluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oluo *OfficeLocationUpdateOne) ExecX(ctx context.Context) {
+	if err := oluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oluo *OfficeLocationUpdateOne) defaults() {
+	if _, ok := oluo.mutation.ModifiedAt(); !ok {
+		v := officelocation.UpdateDefaultModifiedAt()
+		oluo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oluo *OfficeLocationUpdateOne) check() error {
+	if v, ok := oluo.mutation.Name(); ok {
+		if err := officelocation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.name": %w`, err)}
+		}
+	}
+	if v, ok := oluo.mutation.Latitude(); ok {
+		if err := officelocation.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.latitude": %w`, err)}
+		}
+	}
+	if v, ok := oluo.mutation.Longitude(); ok {
+		if err := officelocation.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.longitude": %w`, err)}
+		}
+	}
+	if v, ok := oluo.mutation.RadiusMeters(); ok {
+		if err := officelocation.RadiusMetersValidator(v); err != nil {
+			return &ValidationError{Name: "radius_meters", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.radius_meters": %w`, err)}
+		}
+	}
+	if v, ok := oluo.mutation.Enforcement(); ok {
+		if err := officelocation.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := oluo.mutation.Timezone(); ok {
+		if err := officelocation.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "OfficeLocation.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oluo *OfficeLocationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OfficeLocationUpdateOne {
+	oluo.modifiers = append(oluo.modifiers, modifiers...)
+	return oluo
+}
+
+func (oluo *OfficeLocationUpdateOne) sqlSave(ctx context.Context) (_node *OfficeLocation, err error) {
+	if err := oluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(officelocation.Table, officelocation.Columns, sqlgraph.NewFieldSpec(officelocation.FieldID, field.TypeUint64))
+	id, ok := oluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OfficeLocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, officelocation.FieldID)
+		for _, f := range fields {
+			if !officelocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != officelocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oluo.mutation.ModifiedAt(); ok {
+		_spec.SetField(officelocation.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := oluo.mutation.DeletedAt(); ok {
+		_spec.SetField(officelocation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if oluo.mutation.DeletedAtCleared() {
+		_spec.ClearField(officelocation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := oluo.mutation.Name(); ok {
+		_spec.SetField(officelocation.FieldName, field.TypeString, value)
+	}
+	if value, ok := oluo.mutation.Address(); ok {
+		_spec.SetField(officelocation.FieldAddress, field.TypeString, value)
+	}
+	if oluo.mutation.AddressCleared() {
+		_spec.ClearField(officelocation.FieldAddress, field.TypeString)
+	}
+	if value, ok := oluo.mutation.Latitude(); ok {
+		_spec.SetField(officelocation.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := oluo.mutation.AddedLatitude(); ok {
+		_spec.AddField(officelocation.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := oluo.mutation.Longitude(); ok {
+		_spec.SetField(officelocation.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := oluo.mutation.AddedLongitude(); ok {
+		_spec.AddField(officelocation.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := oluo.mutation.RadiusMeters(); ok {
+		_spec.SetField(officelocation.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := oluo.mutation.AddedRadiusMeters(); ok {
+		_spec.AddField(officelocation.FieldRadiusMeters, field.TypeInt, value)
+	}
+	if value, ok := oluo.mutation.Enforcement(); ok {
+		_spec.SetField(officelocation.FieldEnforcement, field.TypeEnum, value)
+	}
+	if value, ok := oluo.mutation.Timezone(); ok {
+		_spec.SetField(officelocation.FieldTimezone, field.TypeString, value)
+	}
+	if oluo.mutation.TimezoneCleared() {
+		_spec.ClearField(officelocation.FieldTimezone, field.TypeString)
+	}
+	if value, ok := oluo.mutation.IsActive(); ok {
+		_spec.SetField(officelocation.FieldIsActive, field.TypeBool, value)
+	}
+	if oluo.mutation.EmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.RemovedEmployeesIDs(); len(nodes) > 0 && !oluo.mutation.EmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.EmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.EmployeesTable,
+			Columns: []string{officelocation.EmployeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oluo.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !oluo.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.DevicesTable,
+			Columns: []string{officelocation.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oluo.mutation.KiosksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.RemovedKiosksIDs(); len(nodes) > 0 && !oluo.mutation.KiosksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oluo.mutation.KiosksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   officelocation.KiosksTable,
+			Columns: []string{officelocation.KiosksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosk.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(oluo.modifiers...)
+	_node = &OfficeLocation{config: oluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{officelocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/employee"
+	"mceasy/ent/overtime"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Overtime is the model entity for the Overtime schema.
+type Overtime struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to employees table
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Attendance the overtime was detected on, one overtime per day
+	AttendanceID uint64 `json:"attendance_id,omitempty"`
+	// Shift date of the attendance
+	OvertimeDate time.Time `json:"overtime_date,omitempty"`
+	// Scheduled end of the shift, empty on rest days and holidays
+	ScheduledEnd *time.Time `json:"scheduled_end,omitempty"`
+	// Last check-out of the day
+	ActualEnd time.Time `json:"actual_end,omitempty"`
+	// Detected overtime minutes, all worked minutes on rest days and holidays
+	Minutes int `json:"minutes,omitempty"`
+	// Minutes approved for payment, may be lower than detected
+	ApprovedMinutes *int `json:"approved_minutes,omitempty"`
+	// Rest day or public holiday, paid with the rest day multipliers
+	IsRestDay bool `json:"is_rest_day,omitempty"`
+	// Status holds the value of the "status" field.
+	Status overtime.Status `json:"status,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *uint64 `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes string `json:"review_notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OvertimeQuery when eager-loading is set.
+	Edges        OvertimeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OvertimeEdges holds the relations/edges for other nodes in the graph.
+type OvertimeEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// Attendance holds the value of the attendance edge.
+	Attendance *Attendance `json:"attendance,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[0] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// AttendanceOrErr returns the Attendance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeEdges) AttendanceOrErr() (*Attendance, error) {
+	if e.loadedTypes[1] {
+		if e.Attendance == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: attendance.Label}
+		}
+		return e.Attendance, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Overtime) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case overtime.FieldIsRestDay:
+			values[i] = new(sql.NullBool)
+		case overtime.FieldID, overtime.FieldEmployeeID, overtime.FieldAttendanceID, overtime.FieldMinutes, overtime.FieldApprovedMinutes, overtime.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case overtime.FieldStatus, overtime.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case overtime.FieldCreatedAt, overtime.FieldModifiedAt, overtime.FieldDeletedAt, overtime.FieldOvertimeDate, overtime.FieldScheduledEnd, overtime.FieldActualEnd, overtime.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Overtime fields.
+func (o *Overtime) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case overtime.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = uint64(value.Int64)
+		case overtime.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		case overtime.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				o.ModifiedAt = value.Time
+			}
+		case overtime.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				o.DeletedAt = value.Time
+			}
+		case overtime.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				o.EmployeeID = uint64(value.Int64)
+			}
+		case overtime.FieldAttendanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_id", values[i])
+			} else if value.Valid {
+				o.AttendanceID = uint64(value.Int64)
+			}
+		case overtime.FieldOvertimeDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field overtime_date", values[i])
+			} else if value.Valid {
+				o.OvertimeDate = value.Time
+			}
+		case overtime.FieldScheduledEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_end", values[i])
+			} else if value.Valid {
+				o.ScheduledEnd = new(time.Time)
+				*o.ScheduledEnd = value.Time
+			}
+		case overtime.FieldActualEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field actual_end", values[i])
+			} else if value.Valid {
+				o.ActualEnd = value.Time
+			}
+		case overtime.FieldMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minutes", values[i])
+			} else if value.Valid {
+				o.Minutes = int(value.Int64)
+			}
+		case overtime.FieldApprovedMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_minutes", values[i])
+			} else if value.Valid {
+				o.ApprovedMinutes = new(int)
+				*o.ApprovedMinutes = int(value.Int64)
+			}
+		case overtime.FieldIsRestDay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_rest_day", values[i])
+			} else if value.Valid {
+				o.IsRestDay = value.Bool
+			}
+		case overtime.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				o.Status = overtime.Status(value.String)
+			}
+		case overtime.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				o.ReviewedBy = new(uint64)
+				*o.ReviewedBy = uint64(value.Int64)
+			}
+		case overtime.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				o.ReviewedAt = new(time.Time)
+				*o.ReviewedAt = value.Time
+			}
+		case overtime.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				o.ReviewNotes = value.String
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Overtime.
+// This includes values selected through modifiers, order, etc.
+func (o *Overtime) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the Overtime entity.
+func (o *Overtime) QueryEmployee() *EmployeeQuery {
+	return NewOvertimeClient(o.config).QueryEmployee(o)
+}
+
+// QueryAttendance queries the "attendance" edge of the Overtime entity.
+func (o *Overtime) QueryAttendance() *AttendanceQuery {
+	return NewOvertimeClient(o.config).QueryAttendance(o)
+}
+
+// Update returns a builder for updating this Overtime.
+// Note that you need to call Overtime.Unwrap() before calling this method if this Overtime
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Overtime) Update() *OvertimeUpdateOne {
+	return NewOvertimeClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Overtime entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Overtime) Unwrap() *Overtime {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Overtime is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Overtime) String() string {
+	var builder strings.Builder
+	builder.WriteString("Overtime(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(o.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(o.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", o.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_id=")
+	builder.WriteString(fmt.Sprintf("%v", o.AttendanceID))
+	builder.WriteString(", ")
+	builder.WriteString("overtime_date=")
+	builder.WriteString(o.OvertimeDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := o.ScheduledEnd; v != nil {
+		builder.WriteString("scheduled_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actual_end=")
+	builder.WriteString(o.ActualEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("minutes=")
+	builder.WriteString(fmt.Sprintf("%v", o.Minutes))
+	builder.WriteString(", ")
+	if v := o.ApprovedMinutes; v != nil {
+		builder.WriteString("approved_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_rest_day=")
+	builder.WriteString(fmt.Sprintf("%v", o.IsRestDay))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", o.Status))
+	builder.WriteString(", ")
+	if v := o.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := o.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_notes=")
+	builder.WriteString(o.ReviewNotes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Overtimes is a parsable slice of Overtime.
+type Overtimes []*Overtime
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package overtime
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the overtime type in the database.
+	Label = "overtime"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldAttendanceID holds the string denoting the attendance_id field in the database.
+	FieldAttendanceID = "attendance_id"
+	// FieldOvertimeDate holds the string denoting the overtime_date field in the database.
+	FieldOvertimeDate = "overtime_date"
+	// FieldScheduledEnd holds the string denoting the scheduled_end field in the database.
+	FieldScheduledEnd = "scheduled_end"
+	// FieldActualEnd holds the string denoting the actual_end field in the database.
+	FieldActualEnd = "actual_end"
+	// FieldMinutes holds the string denoting the minutes field in the database.
+	FieldMinutes = "minutes"
+	// FieldApprovedMinutes holds the string denoting the approved_minutes field in the database.
+	FieldApprovedMinutes = "approved_minutes"
+	// FieldIsRestDay holds the string denoting the is_rest_day field in the database.
+	FieldIsRestDay = "is_rest_day"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewNotes holds the string denoting the review_notes field in the database.
+	FieldReviewNotes = "review_notes"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// EdgeAttendance holds the string denoting the attendance edge name in mutations.
+	EdgeAttendance = "attendance"
+	// Table holds the table name of the overtime in the database.
+	Table = "overtimes"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "overtimes"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+	// AttendanceTable is the table that holds the attendance relation/edge.
+	AttendanceTable = "overtimes"
+	// AttendanceInverseTable is the table name for the Attendance entity.
+	// It exists in this package in order to avoid circular dependency with the "attendance" package.
+	AttendanceInverseTable = "attendances"
+	// AttendanceColumn is the table column denoting the attendance relation/edge.
+	AttendanceColumn = "attendance_id"
+)
+
+// Columns holds all SQL columns for overtime fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldEmployeeID,
+	FieldAttendanceID,
+	FieldOvertimeDate,
+	FieldScheduledEnd,
+	FieldActualEnd,
+	FieldMinutes,
+	FieldApprovedMinutes,
+	FieldIsRestDay,
+	FieldStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldReviewNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// MinutesValidator is a validator for the "minutes" field. It is called by the builders before save.
+	MinutesValidator func(int) error
+	// DefaultIsRestDay holds the default value on creation for the "is_rest_day" field.
+	DefaultIsRestDay bool
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("overtime: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Overtime queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByAttendanceID orders the results by the attendance_id field.
+func ByAttendanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceID, opts...).ToFunc()
+}
+
+// ByOvertimeDate orders the results by the overtime_date field.
+func ByOvertimeDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOvertimeDate, opts...).ToFunc()
+}
+
+// ByScheduledEnd orders the results by the scheduled_end field.
+func ByScheduledEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledEnd, opts...).ToFunc()
+}
+
+// ByActualEnd orders the results by the actual_end field.
+func ByActualEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActualEnd, opts...).ToFunc()
+}
+
+// ByMinutes orders the results by the minutes field.
+func ByMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinutes, opts...).ToFunc()
+}
+
+// ByApprovedMinutes orders the results by the approved_minutes field.
+func ByApprovedMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedMinutes, opts...).ToFunc()
+}
+
+// ByIsRestDay orders the results by the is_rest_day field.
+func ByIsRestDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsRestDay, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewNotes orders the results by the review_notes field.
+func ByReviewNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNotes, opts...).ToFunc()
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttendanceField orders the results by attendance field.
+func ByAttendanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
+func newAttendanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, AttendanceTable, AttendanceColumn),
+	)
+}
@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package overtime
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// AttendanceID applies equality check predicate on the "attendance_id" field. It's identical to AttendanceIDEQ.
+func AttendanceID(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// OvertimeDate applies equality check predicate on the "overtime_date" field. It's identical to OvertimeDateEQ.
+func OvertimeDate(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldOvertimeDate, v))
+}
+
+// ScheduledEnd applies equality check predicate on the "scheduled_end" field. It's identical to ScheduledEndEQ.
+func ScheduledEnd(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldScheduledEnd, v))
+}
+
+// ActualEnd applies equality check predicate on the "actual_end" field. It's identical to ActualEndEQ.
+func ActualEnd(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldActualEnd, v))
+}
+
+// Minutes applies equality check predicate on the "minutes" field. It's identical to MinutesEQ.
+func Minutes(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldMinutes, v))
+}
+
+// ApprovedMinutes applies equality check predicate on the "approved_minutes" field. It's identical to ApprovedMinutesEQ.
+func ApprovedMinutes(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldApprovedMinutes, v))
+}
+
+// IsRestDay applies equality check predicate on the "is_rest_day" field. It's identical to IsRestDayEQ.
+func IsRestDay(v bool) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldIsRestDay, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// AttendanceIDEQ applies the EQ predicate on the "attendance_id" field.
+func AttendanceIDEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDNEQ applies the NEQ predicate on the "attendance_id" field.
+func AttendanceIDNEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldAttendanceID, v))
+}
+
+// AttendanceIDIn applies the In predicate on the "attendance_id" field.
+func AttendanceIDIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldAttendanceID, vs...))
+}
+
+// AttendanceIDNotIn applies the NotIn predicate on the "attendance_id" field.
+func AttendanceIDNotIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldAttendanceID, vs...))
+}
+
+// OvertimeDateEQ applies the EQ predicate on the "overtime_date" field.
+func OvertimeDateEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldOvertimeDate, v))
+}
+
+// OvertimeDateNEQ applies the NEQ predicate on the "overtime_date" field.
+func OvertimeDateNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldOvertimeDate, v))
+}
+
+// OvertimeDateIn applies the In predicate on the "overtime_date" field.
+func OvertimeDateIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldOvertimeDate, vs...))
+}
+
+// OvertimeDateNotIn applies the NotIn predicate on the "overtime_date" field.
+func OvertimeDateNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldOvertimeDate, vs...))
+}
+
+// OvertimeDateGT applies the GT predicate on the "overtime_date" field.
+func OvertimeDateGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldOvertimeDate, v))
+}
+
+// OvertimeDateGTE applies the GTE predicate on the "overtime_date" field.
+func OvertimeDateGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldOvertimeDate, v))
+}
+
+// OvertimeDateLT applies the LT predicate on the "overtime_date" field.
+func OvertimeDateLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldOvertimeDate, v))
+}
+
+// OvertimeDateLTE applies the LTE predicate on the "overtime_date" field.
+func OvertimeDateLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldOvertimeDate, v))
+}
+
+// ScheduledEndEQ applies the EQ predicate on the "scheduled_end" field.
+func ScheduledEndEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldScheduledEnd, v))
+}
+
+// ScheduledEndNEQ applies the NEQ predicate on the "scheduled_end" field.
+func ScheduledEndNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldScheduledEnd, v))
+}
+
+// ScheduledEndIn applies the In predicate on the "scheduled_end" field.
+func ScheduledEndIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldScheduledEnd, vs...))
+}
+
+// ScheduledEndNotIn applies the NotIn predicate on the "scheduled_end" field.
+func ScheduledEndNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldScheduledEnd, vs...))
+}
+
+// ScheduledEndGT applies the GT predicate on the "scheduled_end" field.
+func ScheduledEndGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldScheduledEnd, v))
+}
+
+// ScheduledEndGTE applies the GTE predicate on the "scheduled_end" field.
+func ScheduledEndGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldScheduledEnd, v))
+}
+
+// ScheduledEndLT applies the LT predicate on the "scheduled_end" field.
+func ScheduledEndLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldScheduledEnd, v))
+}
+
+// ScheduledEndLTE applies the LTE predicate on the "scheduled_end" field.
+func ScheduledEndLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldScheduledEnd, v))
+}
+
+// ScheduledEndIsNil applies the IsNil predicate on the "scheduled_end" field.
+func ScheduledEndIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldScheduledEnd))
+}
+
+// ScheduledEndNotNil applies the NotNil predicate on the "scheduled_end" field.
+func ScheduledEndNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldScheduledEnd))
+}
+
+// ActualEndEQ applies the EQ predicate on the "actual_end" field.
+func ActualEndEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldActualEnd, v))
+}
+
+// ActualEndNEQ applies the NEQ predicate on the "actual_end" field.
+func ActualEndNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldActualEnd, v))
+}
+
+// ActualEndIn applies the In predicate on the "actual_end" field.
+func ActualEndIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldActualEnd, vs...))
+}
+
+// ActualEndNotIn applies the NotIn predicate on the "actual_end" field.
+func ActualEndNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldActualEnd, vs...))
+}
+
+// ActualEndGT applies the GT predicate on the "actual_end" field.
+func ActualEndGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldActualEnd, v))
+}
+
+// ActualEndGTE applies the GTE predicate on the "actual_end" field.
+func ActualEndGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldActualEnd, v))
+}
+
+// ActualEndLT applies the LT predicate on the "actual_end" field.
+func ActualEndLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldActualEnd, v))
+}
+
+// ActualEndLTE applies the LTE predicate on the "actual_end" field.
+func ActualEndLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldActualEnd, v))
+}
+
+// MinutesEQ applies the EQ predicate on the "minutes" field.
+func MinutesEQ(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldMinutes, v))
+}
+
+// MinutesNEQ applies the NEQ predicate on the "minutes" field.
+func MinutesNEQ(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldMinutes, v))
+}
+
+// MinutesIn applies the In predicate on the "minutes" field.
+func MinutesIn(vs ...int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldMinutes, vs...))
+}
+
+// MinutesNotIn applies the NotIn predicate on the "minutes" field.
+func MinutesNotIn(vs ...int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldMinutes, vs...))
+}
+
+// MinutesGT applies the GT predicate on the "minutes" field.
+func MinutesGT(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldMinutes, v))
+}
+
+// MinutesGTE applies the GTE predicate on the "minutes" field.
+func MinutesGTE(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldMinutes, v))
+}
+
+// MinutesLT applies the LT predicate on the "minutes" field.
+func MinutesLT(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldMinutes, v))
+}
+
+// MinutesLTE applies the LTE predicate on the "minutes" field.
+func MinutesLTE(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldMinutes, v))
+}
+
+// ApprovedMinutesEQ applies the EQ predicate on the "approved_minutes" field.
+func ApprovedMinutesEQ(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesNEQ applies the NEQ predicate on the "approved_minutes" field.
+func ApprovedMinutesNEQ(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesIn applies the In predicate on the "approved_minutes" field.
+func ApprovedMinutesIn(vs ...int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldApprovedMinutes, vs...))
+}
+
+// ApprovedMinutesNotIn applies the NotIn predicate on the "approved_minutes" field.
+func ApprovedMinutesNotIn(vs ...int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldApprovedMinutes, vs...))
+}
+
+// ApprovedMinutesGT applies the GT predicate on the "approved_minutes" field.
+func ApprovedMinutesGT(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesGTE applies the GTE predicate on the "approved_minutes" field.
+func ApprovedMinutesGTE(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesLT applies the LT predicate on the "approved_minutes" field.
+func ApprovedMinutesLT(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesLTE applies the LTE predicate on the "approved_minutes" field.
+func ApprovedMinutesLTE(v int) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldApprovedMinutes, v))
+}
+
+// ApprovedMinutesIsNil applies the IsNil predicate on the "approved_minutes" field.
+func ApprovedMinutesIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldApprovedMinutes))
+}
+
+// ApprovedMinutesNotNil applies the NotNil predicate on the "approved_minutes" field.
+func ApprovedMinutesNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldApprovedMinutes))
+}
+
+// IsRestDayEQ applies the EQ predicate on the "is_rest_day" field.
+func IsRestDayEQ(v bool) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldIsRestDay, v))
+}
+
+// IsRestDayNEQ applies the NEQ predicate on the "is_rest_day" field.
+func IsRestDayNEQ(v bool) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldIsRestDay, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uint64) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.Overtime {
+	return predicate.Overtime(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.Overtime {
+	return predicate.Overtime(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendance applies the HasEdge predicate on the "attendance" edge.
+func HasAttendance() predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttendanceTable, AttendanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceWith applies the HasEdge predicate on the "attendance" edge with a given conditions (other predicates).
+func HasAttendanceWith(preds ...predicate.Attendance) predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		step := newAttendanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Overtime) predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Overtime) predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Overtime) predicate.Overtime {
+	return predicate.Overtime(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/employee"
+	"mceasy/ent/overtime"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeCreate is the builder for creating a Overtime entity.
+type OvertimeCreate struct {
+	config
+	mutation *OvertimeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OvertimeCreate) SetCreatedAt(t time.Time) *OvertimeCreate {
+	oc.mutation.SetCreatedAt(t)
+	return oc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableCreatedAt(t *time.Time) *OvertimeCreate {
+	if t != nil {
+		oc.SetCreatedAt(*t)
+	}
+	return oc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (oc *OvertimeCreate) SetModifiedAt(t time.Time) *OvertimeCreate {
+	oc.mutation.SetModifiedAt(t)
+	return oc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableModifiedAt(t *time.Time) *OvertimeCreate {
+	if t != nil {
+		oc.SetModifiedAt(*t)
+	}
+	return oc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (oc *OvertimeCreate) SetDeletedAt(t time.Time) *OvertimeCreate {
+	oc.mutation.SetDeletedAt(t)
+	return oc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableDeletedAt(t *time.Time) *OvertimeCreate {
+	if t != nil {
+		oc.SetDeletedAt(*t)
+	}
+	return oc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (oc *OvertimeCreate) SetEmployeeID(u uint64) *OvertimeCreate {
+	oc.mutation.SetEmployeeID(u)
+	return oc
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (oc *OvertimeCreate) SetAttendanceID(u uint64) *OvertimeCreate {
+	oc.mutation.SetAttendanceID(u)
+	return oc
+}
+
+// SetOvertimeDate sets the "overtime_date" field.
+func (oc *OvertimeCreate) SetOvertimeDate(t time.Time) *OvertimeCreate {
+	oc.mutation.SetOvertimeDate(t)
+	return oc
+}
+
+// SetScheduledEnd sets the "scheduled_end" field.
+func (oc *OvertimeCreate) SetScheduledEnd(t time.Time) *OvertimeCreate {
+	oc.mutation.SetScheduledEnd(t)
+	return oc
+}
+
+// SetNillableScheduledEnd sets the "scheduled_end" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableScheduledEnd(t *time.Time) *OvertimeCreate {
+	if t != nil {
+		oc.SetScheduledEnd(*t)
+	}
+	return oc
+}
+
+// SetActualEnd sets the "actual_end" field.
+func (oc *OvertimeCreate) SetActualEnd(t time.Time) *OvertimeCreate {
+	oc.mutation.SetActualEnd(t)
+	return oc
+}
+
+// SetMinutes sets the "minutes" field.
+func (oc *OvertimeCreate) SetMinutes(i int) *OvertimeCreate {
+	oc.mutation.SetMinutes(i)
+	return oc
+}
+
+// SetApprovedMinutes sets the "approved_minutes" field.
+func (oc *OvertimeCreate) SetApprovedMinutes(i int) *OvertimeCreate {
+	oc.mutation.SetApprovedMinutes(i)
+	return oc
+}
+
+// SetNillableApprovedMinutes sets the "approved_minutes" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableApprovedMinutes(i *int) *OvertimeCreate {
+	if i != nil {
+		oc.SetApprovedMinutes(*i)
+	}
+	return oc
+}
+
+// SetIsRestDay sets the "is_rest_day" field.
+func (oc *OvertimeCreate) SetIsRestDay(b bool) *OvertimeCreate {
+	oc.mutation.SetIsRestDay(b)
+	return oc
+}
+
+// SetNillableIsRestDay sets the "is_rest_day" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableIsRestDay(b *bool) *OvertimeCreate {
+	if b != nil {
+		oc.SetIsRestDay(*b)
+	}
+	return oc
+}
+
+// SetStatus sets the "status" field.
+func (oc *OvertimeCreate) SetStatus(o overtime.Status) *OvertimeCreate {
+	oc.mutation.SetStatus(o)
+	return oc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableStatus(o *overtime.Status) *OvertimeCreate {
+	if o != nil {
+		oc.SetStatus(*o)
+	}
+	return oc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (oc *OvertimeCreate) SetReviewedBy(u uint64) *OvertimeCreate {
+	oc.mutation.SetReviewedBy(u)
+	return oc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableReviewedBy(u *uint64) *OvertimeCreate {
+	if u != nil {
+		oc.SetReviewedBy(*u)
+	}
+	return oc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (oc *OvertimeCreate) SetReviewedAt(t time.Time) *OvertimeCreate {
+	oc.mutation.SetReviewedAt(t)
+	return oc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableReviewedAt(t *time.Time) *OvertimeCreate {
+	if t != nil {
+		oc.SetReviewedAt(*t)
+	}
+	return oc
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (oc *OvertimeCreate) SetReviewNotes(s string) *OvertimeCreate {
+	oc.mutation.SetReviewNotes(s)
+	return oc
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (oc *OvertimeCreate) SetNillableReviewNotes(s *string) *OvertimeCreate {
+	if s != nil {
+		oc.SetReviewNotes(*s)
+	}
+	return oc
+}
+
+// SetID sets the "id" field.
+func (oc *OvertimeCreate) SetID(u uint64) *OvertimeCreate {
+	oc.mutation.SetID(u)
+	return oc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (oc *OvertimeCreate) SetEmployee(e *Employee) *OvertimeCreate {
+	return oc.SetEmployeeID(e.ID)
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (oc *OvertimeCreate) SetAttendance(a *Attendance) *OvertimeCreate {
+	return oc.SetAttendanceID(a.ID)
+}
+
+// Mutation returns the OvertimeMutation object of the builder.
+func (oc *OvertimeCreate) Mutation() *OvertimeMutation {
+	return oc.mutation
+}
+
+// Save creates the Overtime in the database.
+func (oc *OvertimeCreate) Save(ctx context.Context) (*Overtime, error) {
+	oc.defaults()
+	return withHooks(ctx, oc.sqlSave, oc.mutation, oc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OvertimeCreate) SaveX(ctx context.Context) *Overtime {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OvertimeCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OvertimeCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oc *OvertimeCreate) defaults() {
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		v := overtime.DefaultCreatedAt()
+		oc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oc.mutation.ModifiedAt(); !ok {
+		v := overtime.DefaultModifiedAt()
+		oc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := oc.mutation.IsRestDay(); !ok {
+		v := overtime.DefaultIsRestDay
+		oc.mutation.SetIsRestDay(v)
+	}
+	if _, ok := oc.mutation.Status(); !ok {
+		v := overtime.DefaultStatus
+		oc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OvertimeCreate) check() error {
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Overtime.created_at"`)}
+	}
+	if _, ok := oc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "Overtime.modified_at"`)}
+	}
+	if _, ok := oc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "Overtime.employee_id"`)}
+	}
+	if _, ok := oc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance_id", err: errors.New(`ent: missing required field "Overtime.attendance_id"`)}
+	}
+	if _, ok := oc.mutation.OvertimeDate(); !ok {
+		return &ValidationError{Name: "overtime_date", err: errors.New(`ent: missing required field "Overtime.overtime_date"`)}
+	}
+	if _, ok := oc.mutation.ActualEnd(); !ok {
+		return &ValidationError{Name: "actual_end", err: errors.New(`ent: missing required field "Overtime.actual_end"`)}
+	}
+	if _, ok := oc.mutation.Minutes(); !ok {
+		return &ValidationError{Name: "minutes", err: errors.New(`ent: missing required field "Overtime.minutes"`)}
+	}
+	if v, ok := oc.mutation.Minutes(); ok {
+		if err := overtime.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "Overtime.minutes": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.IsRestDay(); !ok {
+		return &ValidationError{Name: "is_rest_day", err: errors.New(`ent: missing required field "Overtime.is_rest_day"`)}
+	}
+	if _, ok := oc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Overtime.status"`)}
+	}
+	if v, ok := oc.mutation.Status(); ok {
+		if err := overtime.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Overtime.status": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "Overtime.employee"`)}
+	}
+	if _, ok := oc.mutation.AttendanceID(); !ok {
+		return &ValidationError{Name: "attendance", err: errors.New(`ent: missing required edge "Overtime.attendance"`)}
+	}
+	return nil
+}
+
+func (oc *OvertimeCreate) sqlSave(ctx context.Context) (*Overtime, error) {
+	if err := oc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	oc.mutation.id = &_node.ID
+	oc.mutation.done = true
+	return _node, nil
+}
+
+func (oc *OvertimeCreate) createSpec() (*Overtime, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Overtime{config: oc.config}
+		_spec = sqlgraph.NewCreateSpec(overtime.Table, sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64))
+	)
+	if id, ok := oc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.SetField(overtime.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oc.mutation.ModifiedAt(); ok {
+		_spec.SetField(overtime.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := oc.mutation.DeletedAt(); ok {
+		_spec.SetField(overtime.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := oc.mutation.OvertimeDate(); ok {
+		_spec.SetField(overtime.FieldOvertimeDate, field.TypeTime, value)
+		_node.OvertimeDate = value
+	}
+	if value, ok := oc.mutation.ScheduledEnd(); ok {
+		_spec.SetField(overtime.FieldScheduledEnd, field.TypeTime, value)
+		_node.ScheduledEnd = &value
+	}
+	if value, ok := oc.mutation.ActualEnd(); ok {
+		_spec.SetField(overtime.FieldActualEnd, field.TypeTime, value)
+		_node.ActualEnd = value
+	}
+	if value, ok := oc.mutation.Minutes(); ok {
+		_spec.SetField(overtime.FieldMinutes, field.TypeInt, value)
+		_node.Minutes = value
+	}
+	if value, ok := oc.mutation.ApprovedMinutes(); ok {
+		_spec.SetField(overtime.FieldApprovedMinutes, field.TypeInt, value)
+		_node.ApprovedMinutes = &value
+	}
+	if value, ok := oc.mutation.IsRestDay(); ok {
+		_spec.SetField(overtime.FieldIsRestDay, field.TypeBool, value)
+		_node.IsRestDay = value
+	}
+	if value, ok := oc.mutation.Status(); ok {
+		_spec.SetField(overtime.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := oc.mutation.ReviewedBy(); ok {
+		_spec.SetField(overtime.FieldReviewedBy, field.TypeUint64, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := oc.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtime.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := oc.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtime.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = value
+	}
+	if nodes := oc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtime.EmployeeTable,
+			Columns: []string{overtime.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   overtime.AttendanceTable,
+			Columns: []string{overtime.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OvertimeCreateBulk is the builder for creating many Overtime entities in bulk.
+type OvertimeCreateBulk struct {
+	config
+	builders []*OvertimeCreate
+}
+
+// Save creates the Overtime entities in the database.
+func (ocb *OvertimeCreateBulk) Save(ctx context.Context) ([]*Overtime, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Overtime, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OvertimeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OvertimeCreateBulk) SaveX(ctx context.Context) []*Overtime {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OvertimeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OvertimeCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,956 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendance"
+	"mceasy/ent/employee"
+	"mceasy/ent/overtime"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeUpdate is the builder for updating Overtime entities.
+type OvertimeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OvertimeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OvertimeUpdate builder.
+func (ou *OvertimeUpdate) Where(ps ...predicate.Overtime) *OvertimeUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ou *OvertimeUpdate) SetModifiedAt(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetModifiedAt(t)
+	return ou
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ou *OvertimeUpdate) SetDeletedAt(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetDeletedAt(t)
+	return ou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableDeletedAt(t *time.Time) *OvertimeUpdate {
+	if t != nil {
+		ou.SetDeletedAt(*t)
+	}
+	return ou
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ou *OvertimeUpdate) ClearDeletedAt() *OvertimeUpdate {
+	ou.mutation.ClearDeletedAt()
+	return ou
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ou *OvertimeUpdate) SetEmployeeID(u uint64) *OvertimeUpdate {
+	ou.mutation.SetEmployeeID(u)
+	return ou
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (ou *OvertimeUpdate) SetAttendanceID(u uint64) *OvertimeUpdate {
+	ou.mutation.SetAttendanceID(u)
+	return ou
+}
+
+// SetOvertimeDate sets the "overtime_date" field.
+func (ou *OvertimeUpdate) SetOvertimeDate(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetOvertimeDate(t)
+	return ou
+}
+
+// SetScheduledEnd sets the "scheduled_end" field.
+func (ou *OvertimeUpdate) SetScheduledEnd(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetScheduledEnd(t)
+	return ou
+}
+
+// SetNillableScheduledEnd sets the "scheduled_end" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableScheduledEnd(t *time.Time) *OvertimeUpdate {
+	if t != nil {
+		ou.SetScheduledEnd(*t)
+	}
+	return ou
+}
+
+// ClearScheduledEnd clears the value of the "scheduled_end" field.
+func (ou *OvertimeUpdate) ClearScheduledEnd() *OvertimeUpdate {
+	ou.mutation.ClearScheduledEnd()
+	return ou
+}
+
+// SetActualEnd sets the "actual_end" field.
+func (ou *OvertimeUpdate) SetActualEnd(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetActualEnd(t)
+	return ou
+}
+
+// SetMinutes sets the "minutes" field.
+func (ou *OvertimeUpdate) SetMinutes(i int) *OvertimeUpdate {
+	ou.mutation.ResetMinutes()
+	ou.mutation.SetMinutes(i)
+	return ou
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (ou *OvertimeUpdate) AddMinutes(i int) *OvertimeUpdate {
+	ou.mutation.AddMinutes(i)
+	return ou
+}
+
+// SetApprovedMinutes sets the "approved_minutes" field.
+func (ou *OvertimeUpdate) SetApprovedMinutes(i int) *OvertimeUpdate {
+	ou.mutation.ResetApprovedMinutes()
+	ou.mutation.SetApprovedMinutes(i)
+	return ou
+}
+
+// SetNillableApprovedMinutes sets the "approved_minutes" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableApprovedMinutes(i *int) *OvertimeUpdate {
+	if i != nil {
+		ou.SetApprovedMinutes(*i)
+	}
+	return ou
+}
+
+// AddApprovedMinutes adds i to the "approved_minutes" field.
+func (ou *OvertimeUpdate) AddApprovedMinutes(i int) *OvertimeUpdate {
+	ou.mutation.AddApprovedMinutes(i)
+	return ou
+}
+
+// ClearApprovedMinutes clears the value of the "approved_minutes" field.
+func (ou *OvertimeUpdate) ClearApprovedMinutes() *OvertimeUpdate {
+	ou.mutation.ClearApprovedMinutes()
+	return ou
+}
+
+// SetIsRestDay sets the "is_rest_day" field.
+func (ou *OvertimeUpdate) SetIsRestDay(b bool) *OvertimeUpdate {
+	ou.mutation.SetIsRestDay(b)
+	return ou
+}
+
+// SetNillableIsRestDay sets the "is_rest_day" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableIsRestDay(b *bool) *OvertimeUpdate {
+	if b != nil {
+		ou.SetIsRestDay(*b)
+	}
+	return ou
+}
+
+// SetStatus sets the "status" field.
+func (ou *OvertimeUpdate) SetStatus(o overtime.Status) *OvertimeUpdate {
+	ou.mutation.SetStatus(o)
+	return ou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableStatus(o *overtime.Status) *OvertimeUpdate {
+	if o != nil {
+		ou.SetStatus(*o)
+	}
+	return ou
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (ou *OvertimeUpdate) SetReviewedBy(u uint64) *OvertimeUpdate {
+	ou.mutation.ResetReviewedBy()
+	ou.mutation.SetReviewedBy(u)
+	return ou
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableReviewedBy(u *uint64) *OvertimeUpdate {
+	if u != nil {
+		ou.SetReviewedBy(*u)
+	}
+	return ou
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (ou *OvertimeUpdate) AddReviewedBy(u int64) *OvertimeUpdate {
+	ou.mutation.AddReviewedBy(u)
+	return ou
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (ou *OvertimeUpdate) ClearReviewedBy() *OvertimeUpdate {
+	ou.mutation.ClearReviewedBy()
+	return ou
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ou *OvertimeUpdate) SetReviewedAt(t time.Time) *OvertimeUpdate {
+	ou.mutation.SetReviewedAt(t)
+	return ou
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableReviewedAt(t *time.Time) *OvertimeUpdate {
+	if t != nil {
+		ou.SetReviewedAt(*t)
+	}
+	return ou
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ou *OvertimeUpdate) ClearReviewedAt() *OvertimeUpdate {
+	ou.mutation.ClearReviewedAt()
+	return ou
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (ou *OvertimeUpdate) SetReviewNotes(s string) *OvertimeUpdate {
+	ou.mutation.SetReviewNotes(s)
+	return ou
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (ou *OvertimeUpdate) SetNillableReviewNotes(s *string) *OvertimeUpdate {
+	if s != nil {
+		ou.SetReviewNotes(*s)
+	}
+	return ou
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (ou *OvertimeUpdate) ClearReviewNotes() *OvertimeUpdate {
+	ou.mutation.ClearReviewNotes()
+	return ou
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ou *OvertimeUpdate) SetEmployee(e *Employee) *OvertimeUpdate {
+	return ou.SetEmployeeID(e.ID)
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (ou *OvertimeUpdate) SetAttendance(a *Attendance) *OvertimeUpdate {
+	return ou.SetAttendanceID(a.ID)
+}
+
+// Mutation returns the OvertimeMutation object of the builder.
+func (ou *OvertimeUpdate) Mutation() *OvertimeMutation {
+	return ou.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ou *OvertimeUpdate) ClearEmployee() *OvertimeUpdate {
+	ou.mutation.ClearEmployee()
+	return ou
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (ou *OvertimeUpdate) ClearAttendance() *OvertimeUpdate {
+	ou.mutation.ClearAttendance()
+	return ou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OvertimeUpdate) Save(ctx context.Context) (int, error) {
+	ou.defaults()
+	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OvertimeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OvertimeUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OvertimeUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ou *OvertimeUpdate) defaults() {
+	if _, ok := ou.mutation.ModifiedAt(); !ok {
+		v := overtime.UpdateDefaultModifiedAt()
+		ou.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ou *OvertimeUpdate) check() error {
+	if v, ok := ou.mutation.Minutes(); ok {
+		if err := overtime.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "Overtime.minutes": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.Status(); ok {
+		if err := overtime.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Overtime.status": %w`, err)}
+		}
+	}
+	if _, ok := ou.mutation.EmployeeID(); ou.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Overtime.employee"`)
+	}
+	if _, ok := ou.mutation.AttendanceID(); ou.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Overtime.attendance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ou *OvertimeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OvertimeUpdate {
+	ou.modifiers = append(ou.modifiers, modifiers...)
+	return ou
+}
+
+func (ou *OvertimeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtime.Table, overtime.Columns, sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64))
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.ModifiedAt(); ok {
+		_spec.SetField(overtime.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ou.mutation.DeletedAt(); ok {
+		_spec.SetField(overtime.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ou.mutation.DeletedAtCleared() {
+		_spec.ClearField(overtime.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ou.mutation.OvertimeDate(); ok {
+		_spec.SetField(overtime.FieldOvertimeDate, field.TypeTime, value)
+	}
+	if value, ok := ou.mutation.ScheduledEnd(); ok {
+		_spec.SetField(overtime.FieldScheduledEnd, field.TypeTime, value)
+	}
+	if ou.mutation.ScheduledEndCleared() {
+		_spec.ClearField(overtime.FieldScheduledEnd, field.TypeTime)
+	}
+	if value, ok := ou.mutation.ActualEnd(); ok {
+		_spec.SetField(overtime.FieldActualEnd, field.TypeTime, value)
+	}
+	if value, ok := ou.mutation.Minutes(); ok {
+		_spec.SetField(overtime.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedMinutes(); ok {
+		_spec.AddField(overtime.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.ApprovedMinutes(); ok {
+		_spec.SetField(overtime.FieldApprovedMinutes, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedApprovedMinutes(); ok {
+		_spec.AddField(overtime.FieldApprovedMinutes, field.TypeInt, value)
+	}
+	if ou.mutation.ApprovedMinutesCleared() {
+		_spec.ClearField(overtime.FieldApprovedMinutes, field.TypeInt)
+	}
+	if value, ok := ou.mutation.IsRestDay(); ok {
+		_spec.SetField(overtime.FieldIsRestDay, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.Status(); ok {
+		_spec.SetField(overtime.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ou.mutation.ReviewedBy(); ok {
+		_spec.SetField(overtime.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := ou.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(overtime.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if ou.mutation.ReviewedByCleared() {
+		_spec.ClearField(overtime.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := ou.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtime.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ou.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtime.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := ou.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtime.FieldReviewNotes, field.TypeString, value)
+	}
+	if ou.mutation.ReviewNotesCleared() {
+		_spec.ClearField(overtime.FieldReviewNotes, field.TypeString)
+	}
+	if ou.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtime.EmployeeTable,
+			Columns: []string{overtime.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtime.EmployeeTable,
+			Columns: []string{overtime.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   overtime.AttendanceTable,
+			Columns: []string{overtime.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   overtime.AttendanceTable,
+			Columns: []string{overtime.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ou.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtime.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ou.mutation.done = true
+	return n, nil
+}
+
+// OvertimeUpdateOne is the builder for updating a single Overtime entity.
+type OvertimeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OvertimeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ouo *OvertimeUpdateOne) SetModifiedAt(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetModifiedAt(t)
+	return ouo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ouo *OvertimeUpdateOne) SetDeletedAt(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetDeletedAt(t)
+	return ouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableDeletedAt(t *time.Time) *OvertimeUpdateOne {
+	if t != nil {
+		ouo.SetDeletedAt(*t)
+	}
+	return ouo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ouo *OvertimeUpdateOne) ClearDeletedAt() *OvertimeUpdateOne {
+	ouo.mutation.ClearDeletedAt()
+	return ouo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ouo *OvertimeUpdateOne) SetEmployeeID(u uint64) *OvertimeUpdateOne {
+	ouo.mutation.SetEmployeeID(u)
+	return ouo
+}
+
+// SetAttendanceID sets the "attendance_id" field.
+func (ouo *OvertimeUpdateOne) SetAttendanceID(u uint64) *OvertimeUpdateOne {
+	ouo.mutation.SetAttendanceID(u)
+	return ouo
+}
+
+// SetOvertimeDate sets the "overtime_date" field.
+func (ouo *OvertimeUpdateOne) SetOvertimeDate(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetOvertimeDate(t)
+	return ouo
+}
+
+// SetScheduledEnd sets the "scheduled_end" field.
+func (ouo *OvertimeUpdateOne) SetScheduledEnd(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetScheduledEnd(t)
+	return ouo
+}
+
+// SetNillableScheduledEnd sets the "scheduled_end" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableScheduledEnd(t *time.Time) *OvertimeUpdateOne {
+	if t != nil {
+		ouo.SetScheduledEnd(*t)
+	}
+	return ouo
+}
+
+// ClearScheduledEnd clears the value of the "scheduled_end" field.
+func (ouo *OvertimeUpdateOne) ClearScheduledEnd() *OvertimeUpdateOne {
+	ouo.mutation.ClearScheduledEnd()
+	return ouo
+}
+
+// SetActualEnd sets the "actual_end" field.
+func (ouo *OvertimeUpdateOne) SetActualEnd(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetActualEnd(t)
+	return ouo
+}
+
+// SetMinutes sets the "minutes" field.
+func (ouo *OvertimeUpdateOne) SetMinutes(i int) *OvertimeUpdateOne {
+	ouo.mutation.ResetMinutes()
+	ouo.mutation.SetMinutes(i)
+	return ouo
+}
+
+// AddMinutes adds i to the "minutes" field.
+func (ouo *OvertimeUpdateOne) AddMinutes(i int) *OvertimeUpdateOne {
+	ouo.mutation.AddMinutes(i)
+	return ouo
+}
+
+// SetApprovedMinutes sets the "approved_minutes" field.
+func (ouo *OvertimeUpdateOne) SetApprovedMinutes(i int) *OvertimeUpdateOne {
+	ouo.mutation.ResetApprovedMinutes()
+	ouo.mutation.SetApprovedMinutes(i)
+	return ouo
+}
+
+// SetNillableApprovedMinutes sets the "approved_minutes" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableApprovedMinutes(i *int) *OvertimeUpdateOne {
+	if i != nil {
+		ouo.SetApprovedMinutes(*i)
+	}
+	return ouo
+}
+
+// AddApprovedMinutes adds i to the "approved_minutes" field.
+func (ouo *OvertimeUpdateOne) AddApprovedMinutes(i int) *OvertimeUpdateOne {
+	ouo.mutation.AddApprovedMinutes(i)
+	return ouo
+}
+
+// ClearApprovedMinutes clears the value of the "approved_minutes" field.
+func (ouo *OvertimeUpdateOne) ClearApprovedMinutes() *OvertimeUpdateOne {
+	ouo.mutation.ClearApprovedMinutes()
+	return ouo
+}
+
+// SetIsRestDay sets the "is_rest_day" field.
+func (ouo *OvertimeUpdateOne) SetIsRestDay(b bool) *OvertimeUpdateOne {
+	ouo.mutation.SetIsRestDay(b)
+	return ouo
+}
+
+// SetNillableIsRestDay sets the "is_rest_day" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableIsRestDay(b *bool) *OvertimeUpdateOne {
+	if b != nil {
+		ouo.SetIsRestDay(*b)
+	}
+	return ouo
+}
+
+// SetStatus sets the "status" field.
+func (ouo *OvertimeUpdateOne) SetStatus(o overtime.Status) *OvertimeUpdateOne {
+	ouo.mutation.SetStatus(o)
+	return ouo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableStatus(o *overtime.Status) *OvertimeUpdateOne {
+	if o != nil {
+		ouo.SetStatus(*o)
+	}
+	return ouo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (ouo *OvertimeUpdateOne) SetReviewedBy(u uint64) *OvertimeUpdateOne {
+	ouo.mutation.ResetReviewedBy()
+	ouo.mutation.SetReviewedBy(u)
+	return ouo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableReviewedBy(u *uint64) *OvertimeUpdateOne {
+	if u != nil {
+		ouo.SetReviewedBy(*u)
+	}
+	return ouo
+}
+
+// AddReviewedBy adds u to the "reviewed_by" field.
+func (ouo *OvertimeUpdateOne) AddReviewedBy(u int64) *OvertimeUpdateOne {
+	ouo.mutation.AddReviewedBy(u)
+	return ouo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (ouo *OvertimeUpdateOne) ClearReviewedBy() *OvertimeUpdateOne {
+	ouo.mutation.ClearReviewedBy()
+	return ouo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ouo *OvertimeUpdateOne) SetReviewedAt(t time.Time) *OvertimeUpdateOne {
+	ouo.mutation.SetReviewedAt(t)
+	return ouo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableReviewedAt(t *time.Time) *OvertimeUpdateOne {
+	if t != nil {
+		ouo.SetReviewedAt(*t)
+	}
+	return ouo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ouo *OvertimeUpdateOne) ClearReviewedAt() *OvertimeUpdateOne {
+	ouo.mutation.ClearReviewedAt()
+	return ouo
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (ouo *OvertimeUpdateOne) SetReviewNotes(s string) *OvertimeUpdateOne {
+	ouo.mutation.SetReviewNotes(s)
+	return ouo
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (ouo *OvertimeUpdateOne) SetNillableReviewNotes(s *string) *OvertimeUpdateOne {
+	if s != nil {
+		ouo.SetReviewNotes(*s)
+	}
+	return ouo
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (ouo *OvertimeUpdateOne) ClearReviewNotes() *OvertimeUpdateOne {
+	ouo.mutation.ClearReviewNotes()
+	return ouo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ouo *OvertimeUpdateOne) SetEmployee(e *Employee) *OvertimeUpdateOne {
+	return ouo.SetEmployeeID(e.ID)
+}
+
+// SetAttendance sets the "attendance" edge to the Attendance entity.
+func (ouo *OvertimeUpdateOne) SetAttendance(a *Attendance) *OvertimeUpdateOne {
+	return ouo.SetAttendanceID(a.ID)
+}
+
+// Mutation returns the OvertimeMutation object of the builder.
+func (ouo *OvertimeUpdateOne) Mutation() *OvertimeMutation {
+	return ouo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ouo *OvertimeUpdateOne) ClearEmployee() *OvertimeUpdateOne {
+	ouo.mutation.ClearEmployee()
+	return ouo
+}
+
+// ClearAttendance clears the "attendance" edge to the Attendance entity.
+func (ouo *OvertimeUpdateOne) ClearAttendance() *OvertimeUpdateOne {
+	ouo.mutation.ClearAttendance()
+	return ouo
+}
+
+// Where appends a list predicates to the OvertimeUpdate builder.
+func (ouo *OvertimeUpdateOne) Where(ps ...predicate.Overtime) *OvertimeUpdateOne {
+	ouo.mutation.Where(ps...)
+	return ouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OvertimeUpdateOne) Select(field string, fields ...string) *OvertimeUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Overtime entity.
+func (ouo *OvertimeUpdateOne) Save(ctx context.Context) (*Overtime, error) {
+	ouo.defaults()
+	return withHooks(ctx, ouo.sqlSave, ouo.mutation, ouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OvertimeUpdateOne) SaveX(ctx context.Context) *Overtime {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OvertimeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OvertimeUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ouo *OvertimeUpdateOne) defaults() {
+	if _, ok := ouo.mutation.ModifiedAt(); !ok {
+		v := overtime.UpdateDefaultModifiedAt()
+		ouo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ouo *OvertimeUpdateOne) check() error {
+	if v, ok := ouo.mutation.Minutes(); ok {
+		if err := overtime.MinutesValidator(v); err != nil {
+			return &ValidationError{Name: "minutes", err: fmt.Errorf(`ent: validator failed for field "Overtime.minutes": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.Status(); ok {
+		if err := overtime.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Overtime.status": %w`, err)}
+		}
+	}
+	if _, ok := ouo.mutation.EmployeeID(); ouo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Overtime.employee"`)
+	}
+	if _, ok := ouo.mutation.AttendanceID(); ouo.mutation.AttendanceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Overtime.attendance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ouo *OvertimeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OvertimeUpdateOne {
+	ouo.modifiers = append(ouo.modifiers, modifiers...)
+	return ouo
+}
+
+func (ouo *OvertimeUpdateOne) sqlSave(ctx context.Context) (_node *Overtime, err error) {
+	if err := ouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtime.Table, overtime.Columns, sqlgraph.NewFieldSpec(overtime.FieldID, field.TypeUint64))
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Overtime.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, overtime.FieldID)
+		for _, f := range fields {
+			if !overtime.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != overtime.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.ModifiedAt(); ok {
+		_spec.SetField(overtime.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ouo.mutation.DeletedAt(); ok {
+		_spec.SetField(overtime.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.DeletedAtCleared() {
+		_spec.ClearField(overtime.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.OvertimeDate(); ok {
+		_spec.SetField(overtime.FieldOvertimeDate, field.TypeTime, value)
+	}
+	if value, ok := ouo.mutation.ScheduledEnd(); ok {
+		_spec.SetField(overtime.FieldScheduledEnd, field.TypeTime, value)
+	}
+	if ouo.mutation.ScheduledEndCleared() {
+		_spec.ClearField(overtime.FieldScheduledEnd, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.ActualEnd(); ok {
+		_spec.SetField(overtime.FieldActualEnd, field.TypeTime, value)
+	}
+	if value, ok := ouo.mutation.Minutes(); ok {
+		_spec.SetField(overtime.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedMinutes(); ok {
+		_spec.AddField(overtime.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.ApprovedMinutes(); ok {
+		_spec.SetField(overtime.FieldApprovedMinutes, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedApprovedMinutes(); ok {
+		_spec.AddField(overtime.FieldApprovedMinutes, field.TypeInt, value)
+	}
+	if ouo.mutation.ApprovedMinutesCleared() {
+		_spec.ClearField(overtime.FieldApprovedMinutes, field.TypeInt)
+	}
+	if value, ok := ouo.mutation.IsRestDay(); ok {
+		_spec.SetField(overtime.FieldIsRestDay, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.Status(); ok {
+		_spec.SetField(overtime.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ouo.mutation.ReviewedBy(); ok {
+		_spec.SetField(overtime.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if value, ok := ouo.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(overtime.FieldReviewedBy, field.TypeUint64, value)
+	}
+	if ouo.mutation.ReviewedByCleared() {
+		_spec.ClearField(overtime.FieldReviewedBy, field.TypeUint64)
+	}
+	if value, ok := ouo.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtime.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtime.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtime.FieldReviewNotes, field.TypeString, value)
+	}
+	if ouo.mutation.ReviewNotesCleared() {
+		_spec.ClearField(overtime.FieldReviewNotes, field.TypeString)
+	}
+	if ouo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtime.EmployeeTable,
+			Columns: []string{overtime.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtime.EmployeeTable,
+			Columns: []string{overtime.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.AttendanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   overtime.AttendanceTable,
+			Columns: []string{overtime.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.AttendanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   overtime.AttendanceTable,
+			Columns: []string{overtime.AttendanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ouo.modifiers...)
+	_node = &Overtime{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtime.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ouo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayrollPeriod is the model entity for the PayrollPeriod schema.
+type PayrollPeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// First day of the month (YYYY-MM-01), months without a row are open
+	PeriodMonth time.Time `json:"period_month,omitempty"`
+	// Attendance and salaries of a locked month cannot be changed
+	Status payrollperiod.Status `json:"status,omitempty"`
+	// LockedAt holds the value of the "locked_at" field.
+	LockedAt *time.Time `json:"locked_at,omitempty"`
+	// User who locked the period after payroll was paid
+	LockedBy *uint64 `json:"locked_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayrollPeriodQuery when eager-loading is set.
+	Edges        PayrollPeriodEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayrollPeriodEdges holds the relations/edges for other nodes in the graph.
+type PayrollPeriodEdges struct {
+	// Events holds the value of the events edge.
+	Events []*PayrollPeriodEvent `json:"events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e PayrollPeriodEdges) EventsOrErr() ([]*PayrollPeriodEvent, error) {
+	if e.loadedTypes[0] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayrollPeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrollperiod.FieldID, payrollperiod.FieldLockedBy:
+			values[i] = new(sql.NullInt64)
+		case payrollperiod.FieldStatus:
+			values[i] = new(sql.NullString)
+		case payrollperiod.FieldCreatedAt, payrollperiod.FieldModifiedAt, payrollperiod.FieldDeletedAt, payrollperiod.FieldPeriodMonth, payrollperiod.FieldLockedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayrollPeriod fields.
+func (pp *PayrollPeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrollperiod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pp.ID = uint64(value.Int64)
+		case payrollperiod.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pp.CreatedAt = value.Time
+			}
+		case payrollperiod.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				pp.ModifiedAt = value.Time
+			}
+		case payrollperiod.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pp.DeletedAt = value.Time
+			}
+		case payrollperiod.FieldPeriodMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_month", values[i])
+			} else if value.Valid {
+				pp.PeriodMonth = value.Time
+			}
+		case payrollperiod.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pp.Status = payrollperiod.Status(value.String)
+			}
+		case payrollperiod.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				pp.LockedAt = new(time.Time)
+				*pp.LockedAt = value.Time
+			}
+		case payrollperiod.FieldLockedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by", values[i])
+			} else if value.Valid {
+				pp.LockedBy = new(uint64)
+				*pp.LockedBy = uint64(value.Int64)
+			}
+		default:
+			pp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayrollPeriod.
+// This includes values selected through modifiers, order, etc.
+func (pp *PayrollPeriod) Value(name string) (ent.Value, error) {
+	return pp.selectValues.Get(name)
+}
+
+// QueryEvents queries the "events" edge of the PayrollPeriod entity.
+func (pp *PayrollPeriod) QueryEvents() *PayrollPeriodEventQuery {
+	return NewPayrollPeriodClient(pp.config).QueryEvents(pp)
+}
+
+// Update returns a builder for updating this PayrollPeriod.
+// Note that you need to call PayrollPeriod.Unwrap() before calling this method if this PayrollPeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *PayrollPeriod) Update() *PayrollPeriodUpdateOne {
+	return NewPayrollPeriodClient(pp.config).UpdateOne(pp)
+}
+
+// Unwrap unwraps the PayrollPeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *PayrollPeriod) Unwrap() *PayrollPeriod {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayrollPeriod is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *PayrollPeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayrollPeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(pp.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(pp.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_month=")
+	builder.WriteString(pp.PeriodMonth.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Status))
+	builder.WriteString(", ")
+	if v := pp.LockedAt; v != nil {
+		builder.WriteString("locked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pp.LockedBy; v != nil {
+		builder.WriteString("locked_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayrollPeriods is a parsable slice of PayrollPeriod.
+type PayrollPeriods []*PayrollPeriod
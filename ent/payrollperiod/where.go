@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollperiod
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PeriodMonth applies equality check predicate on the "period_month" field. It's identical to PeriodMonthEQ.
+func PeriodMonth(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PeriodMonthEQ applies the EQ predicate on the "period_month" field.
+func PeriodMonthEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthNEQ applies the NEQ predicate on the "period_month" field.
+func PeriodMonthNEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthIn applies the In predicate on the "period_month" field.
+func PeriodMonthIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthNotIn applies the NotIn predicate on the "period_month" field.
+func PeriodMonthNotIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthGT applies the GT predicate on the "period_month" field.
+func PeriodMonthGT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthGTE applies the GTE predicate on the "period_month" field.
+func PeriodMonthGTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLT applies the LT predicate on the "period_month" field.
+func PeriodMonthLT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLTE applies the LTE predicate on the "period_month" field.
+func PeriodMonthLTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldPeriodMonth, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// LockedAtIsNil applies the IsNil predicate on the "locked_at" field.
+func LockedAtIsNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIsNull(FieldLockedAt))
+}
+
+// LockedAtNotNil applies the NotNil predicate on the "locked_at" field.
+func LockedAtNotNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotNull(FieldLockedAt))
+}
+
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNEQ(FieldLockedBy, v))
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIn(FieldLockedBy, vs...))
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotIn(FieldLockedBy, vs...))
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGT(FieldLockedBy, v))
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldGTE(FieldLockedBy, v))
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLT(FieldLockedBy, v))
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v uint64) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldLTE(FieldLockedBy, v))
+}
+
+// LockedByIsNil applies the IsNil predicate on the "locked_by" field.
+func LockedByIsNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldIsNull(FieldLockedBy))
+}
+
+// LockedByNotNil applies the NotNil predicate on the "locked_by" field.
+func LockedByNotNil() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(sql.FieldNotNull(FieldLockedBy))
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.PayrollPeriodEvent) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayrollPeriod) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayrollPeriod) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayrollPeriod) predicate.PayrollPeriod {
+	return predicate.PayrollPeriod(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
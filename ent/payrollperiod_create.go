@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodCreate is the builder for creating a PayrollPeriod entity.
+type PayrollPeriodCreate struct {
+	config
+	mutation *PayrollPeriodMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppc *PayrollPeriodCreate) SetCreatedAt(t time.Time) *PayrollPeriodCreate {
+	ppc.mutation.SetCreatedAt(t)
+	return ppc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableCreatedAt(t *time.Time) *PayrollPeriodCreate {
+	if t != nil {
+		ppc.SetCreatedAt(*t)
+	}
+	return ppc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppc *PayrollPeriodCreate) SetModifiedAt(t time.Time) *PayrollPeriodCreate {
+	ppc.mutation.SetModifiedAt(t)
+	return ppc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableModifiedAt(t *time.Time) *PayrollPeriodCreate {
+	if t != nil {
+		ppc.SetModifiedAt(*t)
+	}
+	return ppc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppc *PayrollPeriodCreate) SetDeletedAt(t time.Time) *PayrollPeriodCreate {
+	ppc.mutation.SetDeletedAt(t)
+	return ppc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableDeletedAt(t *time.Time) *PayrollPeriodCreate {
+	if t != nil {
+		ppc.SetDeletedAt(*t)
+	}
+	return ppc
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (ppc *PayrollPeriodCreate) SetPeriodMonth(t time.Time) *PayrollPeriodCreate {
+	ppc.mutation.SetPeriodMonth(t)
+	return ppc
+}
+
+// SetStatus sets the "status" field.
+func (ppc *PayrollPeriodCreate) SetStatus(pa payrollperiod.Status) *PayrollPeriodCreate {
+	ppc.mutation.SetStatus(pa)
+	return ppc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableStatus(pa *payrollperiod.Status) *PayrollPeriodCreate {
+	if pa != nil {
+		ppc.SetStatus(*pa)
+	}
+	return ppc
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (ppc *PayrollPeriodCreate) SetLockedAt(t time.Time) *PayrollPeriodCreate {
+	ppc.mutation.SetLockedAt(t)
+	return ppc
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableLockedAt(t *time.Time) *PayrollPeriodCreate {
+	if t != nil {
+		ppc.SetLockedAt(*t)
+	}
+	return ppc
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ppc *PayrollPeriodCreate) SetLockedBy(u uint64) *PayrollPeriodCreate {
+	ppc.mutation.SetLockedBy(u)
+	return ppc
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ppc *PayrollPeriodCreate) SetNillableLockedBy(u *uint64) *PayrollPeriodCreate {
+	if u != nil {
+		ppc.SetLockedBy(*u)
+	}
+	return ppc
+}
+
+// SetID sets the "id" field.
+func (ppc *PayrollPeriodCreate) SetID(u uint64) *PayrollPeriodCreate {
+	ppc.mutation.SetID(u)
+	return ppc
+}
+
+// AddEventIDs adds the "events" edge to the PayrollPeriodEvent entity by IDs.
+func (ppc *PayrollPeriodCreate) AddEventIDs(ids ...uint64) *PayrollPeriodCreate {
+	ppc.mutation.AddEventIDs(ids...)
+	return ppc
+}
+
+// AddEvents adds the "events" edges to the PayrollPeriodEvent entity.
+func (ppc *PayrollPeriodCreate) AddEvents(p ...*PayrollPeriodEvent) *PayrollPeriodCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppc.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollPeriodMutation object of the builder.
+func (ppc *PayrollPeriodCreate) Mutation() *PayrollPeriodMutation {
+	return ppc.mutation
+}
+
+// Save creates the PayrollPeriod in the database.
+func (ppc *PayrollPeriodCreate) Save(ctx context.Context) (*PayrollPeriod, error) {
+	ppc.defaults()
+	return withHooks(ctx, ppc.sqlSave, ppc.mutation, ppc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *PayrollPeriodCreate) SaveX(ctx context.Context) *PayrollPeriod {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *PayrollPeriodCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *PayrollPeriodCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *PayrollPeriodCreate) defaults() {
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		v := payrollperiod.DefaultCreatedAt()
+		ppc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ppc.mutation.ModifiedAt(); !ok {
+		v := payrollperiod.DefaultModifiedAt()
+		ppc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := ppc.mutation.Status(); !ok {
+		v := payrollperiod.DefaultStatus
+		ppc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *PayrollPeriodCreate) check() error {
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayrollPeriod.created_at"`)}
+	}
+	if _, ok := ppc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "PayrollPeriod.modified_at"`)}
+	}
+	if _, ok := ppc.mutation.PeriodMonth(); !ok {
+		return &ValidationError{Name: "period_month", err: errors.New(`ent: missing required field "PayrollPeriod.period_month"`)}
+	}
+	if _, ok := ppc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PayrollPeriod.status"`)}
+	}
+	if v, ok := ppc.mutation.Status(); ok {
+		if err := payrollperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollPeriod.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ppc *PayrollPeriodCreate) sqlSave(ctx context.Context) (*PayrollPeriod, error) {
+	if err := ppc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ppc.mutation.id = &_node.ID
+	ppc.mutation.done = true
+	return _node, nil
+}
+
+func (ppc *PayrollPeriodCreate) createSpec() (*PayrollPeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayrollPeriod{config: ppc.config}
+		_spec = sqlgraph.NewCreateSpec(payrollperiod.Table, sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64))
+	)
+	if id, ok := ppc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ppc.mutation.CreatedAt(); ok {
+		_spec.SetField(payrollperiod.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ppc.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiod.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := ppc.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiod.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := ppc.mutation.PeriodMonth(); ok {
+		_spec.SetField(payrollperiod.FieldPeriodMonth, field.TypeTime, value)
+		_node.PeriodMonth = value
+	}
+	if value, ok := ppc.mutation.Status(); ok {
+		_spec.SetField(payrollperiod.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ppc.mutation.LockedAt(); ok {
+		_spec.SetField(payrollperiod.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = &value
+	}
+	if value, ok := ppc.mutation.LockedBy(); ok {
+		_spec.SetField(payrollperiod.FieldLockedBy, field.TypeUint64, value)
+		_node.LockedBy = &value
+	}
+	if nodes := ppc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayrollPeriodCreateBulk is the builder for creating many PayrollPeriod entities in bulk.
+type PayrollPeriodCreateBulk struct {
+	config
+	builders []*PayrollPeriodCreate
+}
+
+// Save creates the PayrollPeriod entities in the database.
+func (ppcb *PayrollPeriodCreateBulk) Save(ctx context.Context) ([]*PayrollPeriod, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*PayrollPeriod, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayrollPeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *PayrollPeriodCreateBulk) SaveX(ctx context.Context) []*PayrollPeriod {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *PayrollPeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *PayrollPeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
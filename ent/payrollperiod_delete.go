@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodDelete is the builder for deleting a PayrollPeriod entity.
+type PayrollPeriodDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayrollPeriodMutation
+}
+
+// Where appends a list predicates to the PayrollPeriodDelete builder.
+func (ppd *PayrollPeriodDelete) Where(ps ...predicate.PayrollPeriod) *PayrollPeriodDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *PayrollPeriodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppd.sqlExec, ppd.mutation, ppd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *PayrollPeriodDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *PayrollPeriodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payrollperiod.Table, sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64))
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ppd.mutation.done = true
+	return affected, err
+}
+
+// PayrollPeriodDeleteOne is the builder for deleting a single PayrollPeriod entity.
+type PayrollPeriodDeleteOne struct {
+	ppd *PayrollPeriodDelete
+}
+
+// Where appends a list predicates to the PayrollPeriodDelete builder.
+func (ppdo *PayrollPeriodDeleteOne) Where(ps ...predicate.PayrollPeriod) *PayrollPeriodDeleteOne {
+	ppdo.ppd.mutation.Where(ps...)
+	return ppdo
+}
+
+// Exec executes the deletion query.
+func (ppdo *PayrollPeriodDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payrollperiod.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *PayrollPeriodDeleteOne) ExecX(ctx context.Context) {
+	if err := ppdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
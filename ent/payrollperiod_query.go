@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodQuery is the builder for querying PayrollPeriod entities.
+type PayrollPeriodQuery struct {
+	config
+	ctx        *QueryContext
+	order      []payrollperiod.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PayrollPeriod
+	withEvents *PayrollPeriodEventQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PayrollPeriodQuery builder.
+func (ppq *PayrollPeriodQuery) Where(ps ...predicate.PayrollPeriod) *PayrollPeriodQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppq *PayrollPeriodQuery) Limit(limit int) *PayrollPeriodQuery {
+	ppq.ctx.Limit = &limit
+	return ppq
+}
+
+// Offset to start from.
+func (ppq *PayrollPeriodQuery) Offset(offset int) *PayrollPeriodQuery {
+	ppq.ctx.Offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *PayrollPeriodQuery) Unique(unique bool) *PayrollPeriodQuery {
+	ppq.ctx.Unique = &unique
+	return ppq
+}
+
+// Order specifies how the records should be ordered.
+func (ppq *PayrollPeriodQuery) Order(o ...payrollperiod.OrderOption) *PayrollPeriodQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (ppq *PayrollPeriodQuery) QueryEvents() *PayrollPeriodEventQuery {
+	query := (&PayrollPeriodEventClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollperiod.Table, payrollperiod.FieldID, selector),
+			sqlgraph.To(payrollperiodevent.Table, payrollperiodevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollperiod.EventsTable, payrollperiod.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PayrollPeriod entity from the query.
+// Returns a *NotFoundError when no PayrollPeriod was found.
+func (ppq *PayrollPeriodQuery) First(ctx context.Context) (*PayrollPeriod, error) {
+	nodes, err := ppq.Limit(1).All(setContextOp(ctx, ppq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{payrollperiod.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) FirstX(ctx context.Context) *PayrollPeriod {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PayrollPeriod ID from the query.
+// Returns a *NotFoundError when no PayrollPeriod ID was found.
+func (ppq *PayrollPeriodQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ppq.Limit(1).IDs(setContextOp(ctx, ppq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{payrollperiod.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PayrollPeriod entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PayrollPeriod entity is found.
+// Returns a *NotFoundError when no PayrollPeriod entities are found.
+func (ppq *PayrollPeriodQuery) Only(ctx context.Context) (*PayrollPeriod, error) {
+	nodes, err := ppq.Limit(2).All(setContextOp(ctx, ppq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{payrollperiod.Label}
+	default:
+		return nil, &NotSingularError{payrollperiod.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) OnlyX(ctx context.Context) *PayrollPeriod {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PayrollPeriod ID in the query.
+// Returns a *NotSingularError when more than one PayrollPeriod ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *PayrollPeriodQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ppq.Limit(2).IDs(setContextOp(ctx, ppq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{payrollperiod.Label}
+	default:
+		err = &NotSingularError{payrollperiod.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PayrollPeriods.
+func (ppq *PayrollPeriodQuery) All(ctx context.Context) ([]*PayrollPeriod, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "All")
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PayrollPeriod, *PayrollPeriodQuery]()
+	return withInterceptors[[]*PayrollPeriod](ctx, ppq, qr, ppq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) AllX(ctx context.Context) []*PayrollPeriod {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PayrollPeriod IDs.
+func (ppq *PayrollPeriodQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if ppq.ctx.Unique == nil && ppq.path != nil {
+		ppq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppq.ctx, "IDs")
+	if err = ppq.Select(payrollperiod.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *PayrollPeriodQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "Count")
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppq, querierCount[*PayrollPeriodQuery](), ppq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *PayrollPeriodQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "Exist")
+	switch _, err := ppq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *PayrollPeriodQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PayrollPeriodQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *PayrollPeriodQuery) Clone() *PayrollPeriodQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &PayrollPeriodQuery{
+		config:     ppq.config,
+		ctx:        ppq.ctx.Clone(),
+		order:      append([]payrollperiod.OrderOption{}, ppq.order...),
+		inters:     append([]Interceptor{}, ppq.inters...),
+		predicates: append([]predicate.PayrollPeriod{}, ppq.predicates...),
+		withEvents: ppq.withEvents.Clone(),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *PayrollPeriodQuery) WithEvents(opts ...func(*PayrollPeriodEventQuery)) *PayrollPeriodQuery {
+	query := (&PayrollPeriodEventClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withEvents = query
+	return ppq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PayrollPeriod.Query().
+//		GroupBy(payrollperiod.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *PayrollPeriodQuery) GroupBy(field string, fields ...string) *PayrollPeriodGroupBy {
+	ppq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PayrollPeriodGroupBy{build: ppq}
+	grbuild.flds = &ppq.ctx.Fields
+	grbuild.label = payrollperiod.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PayrollPeriod.Query().
+//		Select(payrollperiod.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ppq *PayrollPeriodQuery) Select(fields ...string) *PayrollPeriodSelect {
+	ppq.ctx.Fields = append(ppq.ctx.Fields, fields...)
+	sbuild := &PayrollPeriodSelect{PayrollPeriodQuery: ppq}
+	sbuild.label = payrollperiod.Label
+	sbuild.flds, sbuild.scan = &ppq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PayrollPeriodSelect configured with the given aggregations.
+func (ppq *PayrollPeriodQuery) Aggregate(fns ...AggregateFunc) *PayrollPeriodSelect {
+	return ppq.Select().Aggregate(fns...)
+}
+
+func (ppq *PayrollPeriodQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppq.ctx.Fields {
+		if !payrollperiod.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *PayrollPeriodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PayrollPeriod, error) {
+	var (
+		nodes       = []*PayrollPeriod{}
+		_spec       = ppq.querySpec()
+		loadedTypes = [1]bool{
+			ppq.withEvents != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PayrollPeriod).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PayrollPeriod{config: ppq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ppq.withEvents; query != nil {
+		if err := ppq.loadEvents(ctx, query, nodes,
+			func(n *PayrollPeriod) { n.Edges.Events = []*PayrollPeriodEvent{} },
+			func(n *PayrollPeriod, e *PayrollPeriodEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ppq *PayrollPeriodQuery) loadEvents(ctx context.Context, query *PayrollPeriodEventQuery, nodes []*PayrollPeriod, init func(*PayrollPeriod), assign func(*PayrollPeriod, *PayrollPeriodEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*PayrollPeriod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payrollperiodevent.FieldPayrollPeriodID)
+	}
+	query.Where(predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(payrollperiod.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PayrollPeriodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payroll_period_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (ppq *PayrollPeriodQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	_spec.Node.Columns = ppq.ctx.Fields
+	if len(ppq.ctx.Fields) > 0 {
+		_spec.Unique = ppq.ctx.Unique != nil && *ppq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *PayrollPeriodQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(payrollperiod.Table, payrollperiod.Columns, sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64))
+	_spec.From = ppq.sql
+	if unique := ppq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollperiod.FieldID)
+		for i := range fields {
+			if fields[i] != payrollperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *PayrollPeriodQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(payrollperiod.Table)
+	columns := ppq.ctx.Fields
+	if len(columns) == 0 {
+		columns = payrollperiod.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ppq.modifiers {
+		m(selector)
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ppq *PayrollPeriodQuery) Modify(modifiers ...func(s *sql.Selector)) *PayrollPeriodSelect {
+	ppq.modifiers = append(ppq.modifiers, modifiers...)
+	return ppq.Select()
+}
+
+// PayrollPeriodGroupBy is the group-by builder for PayrollPeriod entities.
+type PayrollPeriodGroupBy struct {
+	selector
+	build *PayrollPeriodQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *PayrollPeriodGroupBy) Aggregate(fns ...AggregateFunc) *PayrollPeriodGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppgb *PayrollPeriodGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppgb.build.ctx, "GroupBy")
+	if err := ppgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollPeriodQuery, *PayrollPeriodGroupBy](ctx, ppgb.build, ppgb, ppgb.build.inters, v)
+}
+
+func (ppgb *PayrollPeriodGroupBy) sqlScan(ctx context.Context, root *PayrollPeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppgb.flds)+len(ppgb.fns))
+		for _, f := range *ppgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PayrollPeriodSelect is the builder for selecting fields of PayrollPeriod entities.
+type PayrollPeriodSelect struct {
+	*PayrollPeriodQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pps *PayrollPeriodSelect) Aggregate(fns ...AggregateFunc) *PayrollPeriodSelect {
+	pps.fns = append(pps.fns, fns...)
+	return pps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *PayrollPeriodSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pps.ctx, "Select")
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollPeriodQuery, *PayrollPeriodSelect](ctx, pps.PayrollPeriodQuery, pps, pps.inters, v)
+}
+
+func (pps *PayrollPeriodSelect) sqlScan(ctx context.Context, root *PayrollPeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pps.fns))
+	for _, fn := range pps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pps *PayrollPeriodSelect) Modify(modifiers ...func(s *sql.Selector)) *PayrollPeriodSelect {
+	pps.modifiers = append(pps.modifiers, modifiers...)
+	return pps
+}
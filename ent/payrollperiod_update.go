@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodUpdate is the builder for updating PayrollPeriod entities.
+type PayrollPeriodUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PayrollPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PayrollPeriodUpdate builder.
+func (ppu *PayrollPeriodUpdate) Where(ps ...predicate.PayrollPeriod) *PayrollPeriodUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppu *PayrollPeriodUpdate) SetModifiedAt(t time.Time) *PayrollPeriodUpdate {
+	ppu.mutation.SetModifiedAt(t)
+	return ppu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppu *PayrollPeriodUpdate) SetDeletedAt(t time.Time) *PayrollPeriodUpdate {
+	ppu.mutation.SetDeletedAt(t)
+	return ppu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppu *PayrollPeriodUpdate) SetNillableDeletedAt(t *time.Time) *PayrollPeriodUpdate {
+	if t != nil {
+		ppu.SetDeletedAt(*t)
+	}
+	return ppu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ppu *PayrollPeriodUpdate) ClearDeletedAt() *PayrollPeriodUpdate {
+	ppu.mutation.ClearDeletedAt()
+	return ppu
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (ppu *PayrollPeriodUpdate) SetPeriodMonth(t time.Time) *PayrollPeriodUpdate {
+	ppu.mutation.SetPeriodMonth(t)
+	return ppu
+}
+
+// SetStatus sets the "status" field.
+func (ppu *PayrollPeriodUpdate) SetStatus(pa payrollperiod.Status) *PayrollPeriodUpdate {
+	ppu.mutation.SetStatus(pa)
+	return ppu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppu *PayrollPeriodUpdate) SetNillableStatus(pa *payrollperiod.Status) *PayrollPeriodUpdate {
+	if pa != nil {
+		ppu.SetStatus(*pa)
+	}
+	return ppu
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (ppu *PayrollPeriodUpdate) SetLockedAt(t time.Time) *PayrollPeriodUpdate {
+	ppu.mutation.SetLockedAt(t)
+	return ppu
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (ppu *PayrollPeriodUpdate) SetNillableLockedAt(t *time.Time) *PayrollPeriodUpdate {
+	if t != nil {
+		ppu.SetLockedAt(*t)
+	}
+	return ppu
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (ppu *PayrollPeriodUpdate) ClearLockedAt() *PayrollPeriodUpdate {
+	ppu.mutation.ClearLockedAt()
+	return ppu
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ppu *PayrollPeriodUpdate) SetLockedBy(u uint64) *PayrollPeriodUpdate {
+	ppu.mutation.ResetLockedBy()
+	ppu.mutation.SetLockedBy(u)
+	return ppu
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ppu *PayrollPeriodUpdate) SetNillableLockedBy(u *uint64) *PayrollPeriodUpdate {
+	if u != nil {
+		ppu.SetLockedBy(*u)
+	}
+	return ppu
+}
+
+// AddLockedBy adds u to the "locked_by" field.
+func (ppu *PayrollPeriodUpdate) AddLockedBy(u int64) *PayrollPeriodUpdate {
+	ppu.mutation.AddLockedBy(u)
+	return ppu
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (ppu *PayrollPeriodUpdate) ClearLockedBy() *PayrollPeriodUpdate {
+	ppu.mutation.ClearLockedBy()
+	return ppu
+}
+
+// AddEventIDs adds the "events" edge to the PayrollPeriodEvent entity by IDs.
+func (ppu *PayrollPeriodUpdate) AddEventIDs(ids ...uint64) *PayrollPeriodUpdate {
+	ppu.mutation.AddEventIDs(ids...)
+	return ppu
+}
+
+// AddEvents adds the "events" edges to the PayrollPeriodEvent entity.
+func (ppu *PayrollPeriodUpdate) AddEvents(p ...*PayrollPeriodEvent) *PayrollPeriodUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollPeriodMutation object of the builder.
+func (ppu *PayrollPeriodUpdate) Mutation() *PayrollPeriodMutation {
+	return ppu.mutation
+}
+
+// ClearEvents clears all "events" edges to the PayrollPeriodEvent entity.
+func (ppu *PayrollPeriodUpdate) ClearEvents() *PayrollPeriodUpdate {
+	ppu.mutation.ClearEvents()
+	return ppu
+}
+
+// RemoveEventIDs removes the "events" edge to PayrollPeriodEvent entities by IDs.
+func (ppu *PayrollPeriodUpdate) RemoveEventIDs(ids ...uint64) *PayrollPeriodUpdate {
+	ppu.mutation.RemoveEventIDs(ids...)
+	return ppu
+}
+
+// RemoveEvents removes "events" edges to PayrollPeriodEvent entities.
+func (ppu *PayrollPeriodUpdate) RemoveEvents(p ...*PayrollPeriodEvent) *PayrollPeriodUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.RemoveEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *PayrollPeriodUpdate) Save(ctx context.Context) (int, error) {
+	ppu.defaults()
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *PayrollPeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *PayrollPeriodUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *PayrollPeriodUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppu *PayrollPeriodUpdate) defaults() {
+	if _, ok := ppu.mutation.ModifiedAt(); !ok {
+		v := payrollperiod.UpdateDefaultModifiedAt()
+		ppu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *PayrollPeriodUpdate) check() error {
+	if v, ok := ppu.mutation.Status(); ok {
+		if err := payrollperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollPeriod.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ppu *PayrollPeriodUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollPeriodUpdate {
+	ppu.modifiers = append(ppu.modifiers, modifiers...)
+	return ppu
+}
+
+func (ppu *PayrollPeriodUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollperiod.Table, payrollperiod.Columns, sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64))
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiod.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ppu.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiod.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ppu.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollperiod.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.PeriodMonth(); ok {
+		_spec.SetField(payrollperiod.FieldPeriodMonth, field.TypeTime, value)
+	}
+	if value, ok := ppu.mutation.Status(); ok {
+		_spec.SetField(payrollperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ppu.mutation.LockedAt(); ok {
+		_spec.SetField(payrollperiod.FieldLockedAt, field.TypeTime, value)
+	}
+	if ppu.mutation.LockedAtCleared() {
+		_spec.ClearField(payrollperiod.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.LockedBy(); ok {
+		_spec.SetField(payrollperiod.FieldLockedBy, field.TypeUint64, value)
+	}
+	if value, ok := ppu.mutation.AddedLockedBy(); ok {
+		_spec.AddField(payrollperiod.FieldLockedBy, field.TypeUint64, value)
+	}
+	if ppu.mutation.LockedByCleared() {
+		_spec.ClearField(payrollperiod.FieldLockedBy, field.TypeUint64)
+	}
+	if ppu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ppu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ppu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// PayrollPeriodUpdateOne is the builder for updating a single PayrollPeriod entity.
+type PayrollPeriodUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PayrollPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppuo *PayrollPeriodUpdateOne) SetModifiedAt(t time.Time) *PayrollPeriodUpdateOne {
+	ppuo.mutation.SetModifiedAt(t)
+	return ppuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppuo *PayrollPeriodUpdateOne) SetDeletedAt(t time.Time) *PayrollPeriodUpdateOne {
+	ppuo.mutation.SetDeletedAt(t)
+	return ppuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppuo *PayrollPeriodUpdateOne) SetNillableDeletedAt(t *time.Time) *PayrollPeriodUpdateOne {
+	if t != nil {
+		ppuo.SetDeletedAt(*t)
+	}
+	return ppuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ppuo *PayrollPeriodUpdateOne) ClearDeletedAt() *PayrollPeriodUpdateOne {
+	ppuo.mutation.ClearDeletedAt()
+	return ppuo
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (ppuo *PayrollPeriodUpdateOne) SetPeriodMonth(t time.Time) *PayrollPeriodUpdateOne {
+	ppuo.mutation.SetPeriodMonth(t)
+	return ppuo
+}
+
+// SetStatus sets the "status" field.
+func (ppuo *PayrollPeriodUpdateOne) SetStatus(pa payrollperiod.Status) *PayrollPeriodUpdateOne {
+	ppuo.mutation.SetStatus(pa)
+	return ppuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppuo *PayrollPeriodUpdateOne) SetNillableStatus(pa *payrollperiod.Status) *PayrollPeriodUpdateOne {
+	if pa != nil {
+		ppuo.SetStatus(*pa)
+	}
+	return ppuo
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (ppuo *PayrollPeriodUpdateOne) SetLockedAt(t time.Time) *PayrollPeriodUpdateOne {
+	ppuo.mutation.SetLockedAt(t)
+	return ppuo
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (ppuo *PayrollPeriodUpdateOne) SetNillableLockedAt(t *time.Time) *PayrollPeriodUpdateOne {
+	if t != nil {
+		ppuo.SetLockedAt(*t)
+	}
+	return ppuo
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (ppuo *PayrollPeriodUpdateOne) ClearLockedAt() *PayrollPeriodUpdateOne {
+	ppuo.mutation.ClearLockedAt()
+	return ppuo
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ppuo *PayrollPeriodUpdateOne) SetLockedBy(u uint64) *PayrollPeriodUpdateOne {
+	ppuo.mutation.ResetLockedBy()
+	ppuo.mutation.SetLockedBy(u)
+	return ppuo
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ppuo *PayrollPeriodUpdateOne) SetNillableLockedBy(u *uint64) *PayrollPeriodUpdateOne {
+	if u != nil {
+		ppuo.SetLockedBy(*u)
+	}
+	return ppuo
+}
+
+// AddLockedBy adds u to the "locked_by" field.
+func (ppuo *PayrollPeriodUpdateOne) AddLockedBy(u int64) *PayrollPeriodUpdateOne {
+	ppuo.mutation.AddLockedBy(u)
+	return ppuo
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (ppuo *PayrollPeriodUpdateOne) ClearLockedBy() *PayrollPeriodUpdateOne {
+	ppuo.mutation.ClearLockedBy()
+	return ppuo
+}
+
+// AddEventIDs adds the "events" edge to the PayrollPeriodEvent entity by IDs.
+func (ppuo *PayrollPeriodUpdateOne) AddEventIDs(ids ...uint64) *PayrollPeriodUpdateOne {
+	ppuo.mutation.AddEventIDs(ids...)
+	return ppuo
+}
+
+// AddEvents adds the "events" edges to the PayrollPeriodEvent entity.
+func (ppuo *PayrollPeriodUpdateOne) AddEvents(p ...*PayrollPeriodEvent) *PayrollPeriodUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollPeriodMutation object of the builder.
+func (ppuo *PayrollPeriodUpdateOne) Mutation() *PayrollPeriodMutation {
+	return ppuo.mutation
+}
+
+// ClearEvents clears all "events" edges to the PayrollPeriodEvent entity.
+func (ppuo *PayrollPeriodUpdateOne) ClearEvents() *PayrollPeriodUpdateOne {
+	ppuo.mutation.ClearEvents()
+	return ppuo
+}
+
+// RemoveEventIDs removes the "events" edge to PayrollPeriodEvent entities by IDs.
+func (ppuo *PayrollPeriodUpdateOne) RemoveEventIDs(ids ...uint64) *PayrollPeriodUpdateOne {
+	ppuo.mutation.RemoveEventIDs(ids...)
+	return ppuo
+}
+
+// RemoveEvents removes "events" edges to PayrollPeriodEvent entities.
+func (ppuo *PayrollPeriodUpdateOne) RemoveEvents(p ...*PayrollPeriodEvent) *PayrollPeriodUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveEventIDs(ids...)
+}
+
+// Where appends a list predicates to the PayrollPeriodUpdate builder.
+func (ppuo *PayrollPeriodUpdateOne) Where(ps ...predicate.PayrollPeriod) *PayrollPeriodUpdateOne {
+	ppuo.mutation.Where(ps...)
+	return ppuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *PayrollPeriodUpdateOne) Select(field string, fields ...string) *PayrollPeriodUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated PayrollPeriod entity.
+func (ppuo *PayrollPeriodUpdateOne) Save(ctx context.Context) (*PayrollPeriod, error) {
+	ppuo.defaults()
+	return withHooks(ctx, ppuo.sqlSave, ppuo.mutation, ppuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *PayrollPeriodUpdateOne) SaveX(ctx context.Context) *PayrollPeriod {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *PayrollPeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *PayrollPeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppuo *PayrollPeriodUpdateOne) defaults() {
+	if _, ok := ppuo.mutation.ModifiedAt(); !ok {
+		v := payrollperiod.UpdateDefaultModifiedAt()
+		ppuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *PayrollPeriodUpdateOne) check() error {
+	if v, ok := ppuo.mutation.Status(); ok {
+		if err := payrollperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollPeriod.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ppuo *PayrollPeriodUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollPeriodUpdateOne {
+	ppuo.modifiers = append(ppuo.modifiers, modifiers...)
+	return ppuo
+}
+
+func (ppuo *PayrollPeriodUpdateOne) sqlSave(ctx context.Context) (_node *PayrollPeriod, err error) {
+	if err := ppuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollperiod.Table, payrollperiod.Columns, sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64))
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayrollPeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollperiod.FieldID)
+		for _, f := range fields {
+			if !payrollperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrollperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiod.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ppuo.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiod.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ppuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollperiod.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.PeriodMonth(); ok {
+		_spec.SetField(payrollperiod.FieldPeriodMonth, field.TypeTime, value)
+	}
+	if value, ok := ppuo.mutation.Status(); ok {
+		_spec.SetField(payrollperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ppuo.mutation.LockedAt(); ok {
+		_spec.SetField(payrollperiod.FieldLockedAt, field.TypeTime, value)
+	}
+	if ppuo.mutation.LockedAtCleared() {
+		_spec.ClearField(payrollperiod.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.LockedBy(); ok {
+		_spec.SetField(payrollperiod.FieldLockedBy, field.TypeUint64, value)
+	}
+	if value, ok := ppuo.mutation.AddedLockedBy(); ok {
+		_spec.AddField(payrollperiod.FieldLockedBy, field.TypeUint64, value)
+	}
+	if ppuo.mutation.LockedByCleared() {
+		_spec.ClearField(payrollperiod.FieldLockedBy, field.TypeUint64)
+	}
+	if ppuo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ppuo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollperiod.EventsTable,
+			Columns: []string{payrollperiod.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ppuo.modifiers...)
+	_node = &PayrollPeriod{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayrollPeriodEvent is the model entity for the PayrollPeriodEvent schema.
+type PayrollPeriodEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to payroll_periods table
+	PayrollPeriodID uint64 `json:"payroll_period_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action payrollperiodevent.Action `json:"action,omitempty"`
+	// User who locked or unlocked the period
+	ActorID uint64 `json:"actor_id,omitempty"`
+	// Why the period was unlocked, required for unlocks
+	Reason string `json:"reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayrollPeriodEventQuery when eager-loading is set.
+	Edges        PayrollPeriodEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayrollPeriodEventEdges holds the relations/edges for other nodes in the graph.
+type PayrollPeriodEventEdges struct {
+	// PayrollPeriod holds the value of the payroll_period edge.
+	PayrollPeriod *PayrollPeriod `json:"payroll_period,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PayrollPeriodOrErr returns the PayrollPeriod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayrollPeriodEventEdges) PayrollPeriodOrErr() (*PayrollPeriod, error) {
+	if e.loadedTypes[0] {
+		if e.PayrollPeriod == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: payrollperiod.Label}
+		}
+		return e.PayrollPeriod, nil
+	}
+	return nil, &NotLoadedError{edge: "payroll_period"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayrollPeriodEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrollperiodevent.FieldID, payrollperiodevent.FieldPayrollPeriodID, payrollperiodevent.FieldActorID:
+			values[i] = new(sql.NullInt64)
+		case payrollperiodevent.FieldAction, payrollperiodevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case payrollperiodevent.FieldCreatedAt, payrollperiodevent.FieldModifiedAt, payrollperiodevent.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayrollPeriodEvent fields.
+func (ppe *PayrollPeriodEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrollperiodevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ppe.ID = uint64(value.Int64)
+		case payrollperiodevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ppe.CreatedAt = value.Time
+			}
+		case payrollperiodevent.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				ppe.ModifiedAt = value.Time
+			}
+		case payrollperiodevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ppe.DeletedAt = value.Time
+			}
+		case payrollperiodevent.FieldPayrollPeriodID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payroll_period_id", values[i])
+			} else if value.Valid {
+				ppe.PayrollPeriodID = uint64(value.Int64)
+			}
+		case payrollperiodevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ppe.Action = payrollperiodevent.Action(value.String)
+			}
+		case payrollperiodevent.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				ppe.ActorID = uint64(value.Int64)
+			}
+		case payrollperiodevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ppe.Reason = value.String
+			}
+		default:
+			ppe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayrollPeriodEvent.
+// This includes values selected through modifiers, order, etc.
+func (ppe *PayrollPeriodEvent) Value(name string) (ent.Value, error) {
+	return ppe.selectValues.Get(name)
+}
+
+// QueryPayrollPeriod queries the "payroll_period" edge of the PayrollPeriodEvent entity.
+func (ppe *PayrollPeriodEvent) QueryPayrollPeriod() *PayrollPeriodQuery {
+	return NewPayrollPeriodEventClient(ppe.config).QueryPayrollPeriod(ppe)
+}
+
+// Update returns a builder for updating this PayrollPeriodEvent.
+// Note that you need to call PayrollPeriodEvent.Unwrap() before calling this method if this PayrollPeriodEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ppe *PayrollPeriodEvent) Update() *PayrollPeriodEventUpdateOne {
+	return NewPayrollPeriodEventClient(ppe.config).UpdateOne(ppe)
+}
+
+// Unwrap unwraps the PayrollPeriodEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ppe *PayrollPeriodEvent) Unwrap() *PayrollPeriodEvent {
+	_tx, ok := ppe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayrollPeriodEvent is not a transactional entity")
+	}
+	ppe.config.driver = _tx.drv
+	return ppe
+}
+
+// String implements the fmt.Stringer.
+func (ppe *PayrollPeriodEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayrollPeriodEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ppe.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ppe.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(ppe.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ppe.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payroll_period_id=")
+	builder.WriteString(fmt.Sprintf("%v", ppe.PayrollPeriodID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ppe.Action))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", ppe.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ppe.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayrollPeriodEvents is a parsable slice of PayrollPeriodEvent.
+type PayrollPeriodEvents []*PayrollPeriodEvent
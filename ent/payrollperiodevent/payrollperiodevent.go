@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollperiodevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the payrollperiodevent type in the database.
+	Label = "payroll_period_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPayrollPeriodID holds the string denoting the payroll_period_id field in the database.
+	FieldPayrollPeriodID = "payroll_period_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// EdgePayrollPeriod holds the string denoting the payroll_period edge name in mutations.
+	EdgePayrollPeriod = "payroll_period"
+	// Table holds the table name of the payrollperiodevent in the database.
+	Table = "payroll_period_events"
+	// PayrollPeriodTable is the table that holds the payroll_period relation/edge.
+	PayrollPeriodTable = "payroll_period_events"
+	// PayrollPeriodInverseTable is the table name for the PayrollPeriod entity.
+	// It exists in this package in order to avoid circular dependency with the "payrollperiod" package.
+	PayrollPeriodInverseTable = "payroll_periods"
+	// PayrollPeriodColumn is the table column denoting the payroll_period relation/edge.
+	PayrollPeriodColumn = "payroll_period_id"
+)
+
+// Columns holds all SQL columns for payrollperiodevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldPayrollPeriodID,
+	FieldAction,
+	FieldActorID,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionLock   Action = "lock"
+	ActionUnlock Action = "unlock"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionLock, ActionUnlock:
+		return nil
+	default:
+		return fmt.Errorf("payrollperiodevent: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the PayrollPeriodEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByPayrollPeriodID orders the results by the payroll_period_id field.
+func ByPayrollPeriodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayrollPeriodID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByPayrollPeriodField orders the results by payroll_period field.
+func ByPayrollPeriodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayrollPeriodStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPayrollPeriodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayrollPeriodInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PayrollPeriodTable, PayrollPeriodColumn),
+	)
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollperiodevent
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PayrollPeriodID applies equality check predicate on the "payroll_period_id" field. It's identical to PayrollPeriodIDEQ.
+func PayrollPeriodID(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldPayrollPeriodID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PayrollPeriodIDEQ applies the EQ predicate on the "payroll_period_id" field.
+func PayrollPeriodIDEQ(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldPayrollPeriodID, v))
+}
+
+// PayrollPeriodIDNEQ applies the NEQ predicate on the "payroll_period_id" field.
+func PayrollPeriodIDNEQ(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldPayrollPeriodID, v))
+}
+
+// PayrollPeriodIDIn applies the In predicate on the "payroll_period_id" field.
+func PayrollPeriodIDIn(vs ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldPayrollPeriodID, vs...))
+}
+
+// PayrollPeriodIDNotIn applies the NotIn predicate on the "payroll_period_id" field.
+func PayrollPeriodIDNotIn(vs ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldPayrollPeriodID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uint64) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldActorID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasPayrollPeriod applies the HasEdge predicate on the "payroll_period" edge.
+func HasPayrollPeriod() predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PayrollPeriodTable, PayrollPeriodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayrollPeriodWith applies the HasEdge predicate on the "payroll_period" edge with a given conditions (other predicates).
+func HasPayrollPeriodWith(preds ...predicate.PayrollPeriod) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		step := newPayrollPeriodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayrollPeriodEvent) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayrollPeriodEvent) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayrollPeriodEvent) predicate.PayrollPeriodEvent {
+	return predicate.PayrollPeriodEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
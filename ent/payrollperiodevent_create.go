@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodEventCreate is the builder for creating a PayrollPeriodEvent entity.
+type PayrollPeriodEventCreate struct {
+	config
+	mutation *PayrollPeriodEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppec *PayrollPeriodEventCreate) SetCreatedAt(t time.Time) *PayrollPeriodEventCreate {
+	ppec.mutation.SetCreatedAt(t)
+	return ppec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppec *PayrollPeriodEventCreate) SetNillableCreatedAt(t *time.Time) *PayrollPeriodEventCreate {
+	if t != nil {
+		ppec.SetCreatedAt(*t)
+	}
+	return ppec
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppec *PayrollPeriodEventCreate) SetModifiedAt(t time.Time) *PayrollPeriodEventCreate {
+	ppec.mutation.SetModifiedAt(t)
+	return ppec
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (ppec *PayrollPeriodEventCreate) SetNillableModifiedAt(t *time.Time) *PayrollPeriodEventCreate {
+	if t != nil {
+		ppec.SetModifiedAt(*t)
+	}
+	return ppec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppec *PayrollPeriodEventCreate) SetDeletedAt(t time.Time) *PayrollPeriodEventCreate {
+	ppec.mutation.SetDeletedAt(t)
+	return ppec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppec *PayrollPeriodEventCreate) SetNillableDeletedAt(t *time.Time) *PayrollPeriodEventCreate {
+	if t != nil {
+		ppec.SetDeletedAt(*t)
+	}
+	return ppec
+}
+
+// SetPayrollPeriodID sets the "payroll_period_id" field.
+func (ppec *PayrollPeriodEventCreate) SetPayrollPeriodID(u uint64) *PayrollPeriodEventCreate {
+	ppec.mutation.SetPayrollPeriodID(u)
+	return ppec
+}
+
+// SetAction sets the "action" field.
+func (ppec *PayrollPeriodEventCreate) SetAction(pa payrollperiodevent.Action) *PayrollPeriodEventCreate {
+	ppec.mutation.SetAction(pa)
+	return ppec
+}
+
+// SetActorID sets the "actor_id" field.
+func (ppec *PayrollPeriodEventCreate) SetActorID(u uint64) *PayrollPeriodEventCreate {
+	ppec.mutation.SetActorID(u)
+	return ppec
+}
+
+// SetReason sets the "reason" field.
+func (ppec *PayrollPeriodEventCreate) SetReason(s string) *PayrollPeriodEventCreate {
+	ppec.mutation.SetReason(s)
+	return ppec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ppec *PayrollPeriodEventCreate) SetNillableReason(s *string) *PayrollPeriodEventCreate {
+	if s != nil {
+		ppec.SetReason(*s)
+	}
+	return ppec
+}
+
+// SetID sets the "id" field.
+func (ppec *PayrollPeriodEventCreate) SetID(u uint64) *PayrollPeriodEventCreate {
+	ppec.mutation.SetID(u)
+	return ppec
+}
+
+// SetPayrollPeriod sets the "payroll_period" edge to the PayrollPeriod entity.
+func (ppec *PayrollPeriodEventCreate) SetPayrollPeriod(p *PayrollPeriod) *PayrollPeriodEventCreate {
+	return ppec.SetPayrollPeriodID(p.ID)
+}
+
+// Mutation returns the PayrollPeriodEventMutation object of the builder.
+func (ppec *PayrollPeriodEventCreate) Mutation() *PayrollPeriodEventMutation {
+	return ppec.mutation
+}
+
+// Save creates the PayrollPeriodEvent in the database.
+func (ppec *PayrollPeriodEventCreate) Save(ctx context.Context) (*PayrollPeriodEvent, error) {
+	ppec.defaults()
+	return withHooks(ctx, ppec.sqlSave, ppec.mutation, ppec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppec *PayrollPeriodEventCreate) SaveX(ctx context.Context) *PayrollPeriodEvent {
+	v, err := ppec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppec *PayrollPeriodEventCreate) Exec(ctx context.Context) error {
+	_, err := ppec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppec *PayrollPeriodEventCreate) ExecX(ctx context.Context) {
+	if err := ppec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppec *PayrollPeriodEventCreate) defaults() {
+	if _, ok := ppec.mutation.CreatedAt(); !ok {
+		v := payrollperiodevent.DefaultCreatedAt()
+		ppec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ppec.mutation.ModifiedAt(); !ok {
+		v := payrollperiodevent.DefaultModifiedAt()
+		ppec.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppec *PayrollPeriodEventCreate) check() error {
+	if _, ok := ppec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayrollPeriodEvent.created_at"`)}
+	}
+	if _, ok := ppec.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "PayrollPeriodEvent.modified_at"`)}
+	}
+	if _, ok := ppec.mutation.PayrollPeriodID(); !ok {
+		return &ValidationError{Name: "payroll_period_id", err: errors.New(`ent: missing required field "PayrollPeriodEvent.payroll_period_id"`)}
+	}
+	if _, ok := ppec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PayrollPeriodEvent.action"`)}
+	}
+	if v, ok := ppec.mutation.Action(); ok {
+		if err := payrollperiodevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PayrollPeriodEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := ppec.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "PayrollPeriodEvent.actor_id"`)}
+	}
+	if _, ok := ppec.mutation.PayrollPeriodID(); !ok {
+		return &ValidationError{Name: "payroll_period", err: errors.New(`ent: missing required edge "PayrollPeriodEvent.payroll_period"`)}
+	}
+	return nil
+}
+
+func (ppec *PayrollPeriodEventCreate) sqlSave(ctx context.Context) (*PayrollPeriodEvent, error) {
+	if err := ppec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ppec.mutation.id = &_node.ID
+	ppec.mutation.done = true
+	return _node, nil
+}
+
+func (ppec *PayrollPeriodEventCreate) createSpec() (*PayrollPeriodEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayrollPeriodEvent{config: ppec.config}
+		_spec = sqlgraph.NewCreateSpec(payrollperiodevent.Table, sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64))
+	)
+	if id, ok := ppec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ppec.mutation.CreatedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ppec.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := ppec.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := ppec.mutation.Action(); ok {
+		_spec.SetField(payrollperiodevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := ppec.mutation.ActorID(); ok {
+		_spec.SetField(payrollperiodevent.FieldActorID, field.TypeUint64, value)
+		_node.ActorID = value
+	}
+	if value, ok := ppec.mutation.Reason(); ok {
+		_spec.SetField(payrollperiodevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := ppec.mutation.PayrollPeriodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollperiodevent.PayrollPeriodTable,
+			Columns: []string{payrollperiodevent.PayrollPeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PayrollPeriodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayrollPeriodEventCreateBulk is the builder for creating many PayrollPeriodEvent entities in bulk.
+type PayrollPeriodEventCreateBulk struct {
+	config
+	builders []*PayrollPeriodEventCreate
+}
+
+// Save creates the PayrollPeriodEvent entities in the database.
+func (ppecb *PayrollPeriodEventCreateBulk) Save(ctx context.Context) ([]*PayrollPeriodEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ppecb.builders))
+	nodes := make([]*PayrollPeriodEvent, len(ppecb.builders))
+	mutators := make([]Mutator, len(ppecb.builders))
+	for i := range ppecb.builders {
+		func(i int, root context.Context) {
+			builder := ppecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayrollPeriodEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppecb *PayrollPeriodEventCreateBulk) SaveX(ctx context.Context) []*PayrollPeriodEvent {
+	v, err := ppecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppecb *PayrollPeriodEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppecb *PayrollPeriodEventCreateBulk) ExecX(ctx context.Context) {
+	if err := ppecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
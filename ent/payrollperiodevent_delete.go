@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodEventDelete is the builder for deleting a PayrollPeriodEvent entity.
+type PayrollPeriodEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayrollPeriodEventMutation
+}
+
+// Where appends a list predicates to the PayrollPeriodEventDelete builder.
+func (pped *PayrollPeriodEventDelete) Where(ps ...predicate.PayrollPeriodEvent) *PayrollPeriodEventDelete {
+	pped.mutation.Where(ps...)
+	return pped
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pped *PayrollPeriodEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pped.sqlExec, pped.mutation, pped.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pped *PayrollPeriodEventDelete) ExecX(ctx context.Context) int {
+	n, err := pped.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pped *PayrollPeriodEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payrollperiodevent.Table, sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64))
+	if ps := pped.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pped.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pped.mutation.done = true
+	return affected, err
+}
+
+// PayrollPeriodEventDeleteOne is the builder for deleting a single PayrollPeriodEvent entity.
+type PayrollPeriodEventDeleteOne struct {
+	pped *PayrollPeriodEventDelete
+}
+
+// Where appends a list predicates to the PayrollPeriodEventDelete builder.
+func (ppedo *PayrollPeriodEventDeleteOne) Where(ps ...predicate.PayrollPeriodEvent) *PayrollPeriodEventDeleteOne {
+	ppedo.pped.mutation.Where(ps...)
+	return ppedo
+}
+
+// Exec executes the deletion query.
+func (ppedo *PayrollPeriodEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppedo.pped.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payrollperiodevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppedo *PayrollPeriodEventDeleteOne) ExecX(ctx context.Context) {
+	if err := ppedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodEventQuery is the builder for querying PayrollPeriodEvent entities.
+type PayrollPeriodEventQuery struct {
+	config
+	ctx               *QueryContext
+	order             []payrollperiodevent.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.PayrollPeriodEvent
+	withPayrollPeriod *PayrollPeriodQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PayrollPeriodEventQuery builder.
+func (ppeq *PayrollPeriodEventQuery) Where(ps ...predicate.PayrollPeriodEvent) *PayrollPeriodEventQuery {
+	ppeq.predicates = append(ppeq.predicates, ps...)
+	return ppeq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppeq *PayrollPeriodEventQuery) Limit(limit int) *PayrollPeriodEventQuery {
+	ppeq.ctx.Limit = &limit
+	return ppeq
+}
+
+// Offset to start from.
+func (ppeq *PayrollPeriodEventQuery) Offset(offset int) *PayrollPeriodEventQuery {
+	ppeq.ctx.Offset = &offset
+	return ppeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppeq *PayrollPeriodEventQuery) Unique(unique bool) *PayrollPeriodEventQuery {
+	ppeq.ctx.Unique = &unique
+	return ppeq
+}
+
+// Order specifies how the records should be ordered.
+func (ppeq *PayrollPeriodEventQuery) Order(o ...payrollperiodevent.OrderOption) *PayrollPeriodEventQuery {
+	ppeq.order = append(ppeq.order, o...)
+	return ppeq
+}
+
+// QueryPayrollPeriod chains the current query on the "payroll_period" edge.
+func (ppeq *PayrollPeriodEventQuery) QueryPayrollPeriod() *PayrollPeriodQuery {
+	query := (&PayrollPeriodClient{config: ppeq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppeq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppeq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollperiodevent.Table, payrollperiodevent.FieldID, selector),
+			sqlgraph.To(payrollperiod.Table, payrollperiod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payrollperiodevent.PayrollPeriodTable, payrollperiodevent.PayrollPeriodColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppeq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PayrollPeriodEvent entity from the query.
+// Returns a *NotFoundError when no PayrollPeriodEvent was found.
+func (ppeq *PayrollPeriodEventQuery) First(ctx context.Context) (*PayrollPeriodEvent, error) {
+	nodes, err := ppeq.Limit(1).All(setContextOp(ctx, ppeq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{payrollperiodevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) FirstX(ctx context.Context) *PayrollPeriodEvent {
+	node, err := ppeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PayrollPeriodEvent ID from the query.
+// Returns a *NotFoundError when no PayrollPeriodEvent ID was found.
+func (ppeq *PayrollPeriodEventQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ppeq.Limit(1).IDs(setContextOp(ctx, ppeq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{payrollperiodevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := ppeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PayrollPeriodEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PayrollPeriodEvent entity is found.
+// Returns a *NotFoundError when no PayrollPeriodEvent entities are found.
+func (ppeq *PayrollPeriodEventQuery) Only(ctx context.Context) (*PayrollPeriodEvent, error) {
+	nodes, err := ppeq.Limit(2).All(setContextOp(ctx, ppeq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{payrollperiodevent.Label}
+	default:
+		return nil, &NotSingularError{payrollperiodevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) OnlyX(ctx context.Context) *PayrollPeriodEvent {
+	node, err := ppeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PayrollPeriodEvent ID in the query.
+// Returns a *NotSingularError when more than one PayrollPeriodEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppeq *PayrollPeriodEventQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = ppeq.Limit(2).IDs(setContextOp(ctx, ppeq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{payrollperiodevent.Label}
+	default:
+		err = &NotSingularError{payrollperiodevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := ppeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PayrollPeriodEvents.
+func (ppeq *PayrollPeriodEventQuery) All(ctx context.Context) ([]*PayrollPeriodEvent, error) {
+	ctx = setContextOp(ctx, ppeq.ctx, "All")
+	if err := ppeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PayrollPeriodEvent, *PayrollPeriodEventQuery]()
+	return withInterceptors[[]*PayrollPeriodEvent](ctx, ppeq, qr, ppeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) AllX(ctx context.Context) []*PayrollPeriodEvent {
+	nodes, err := ppeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PayrollPeriodEvent IDs.
+func (ppeq *PayrollPeriodEventQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if ppeq.ctx.Unique == nil && ppeq.path != nil {
+		ppeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppeq.ctx, "IDs")
+	if err = ppeq.Select(payrollperiodevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := ppeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppeq *PayrollPeriodEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppeq.ctx, "Count")
+	if err := ppeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppeq, querierCount[*PayrollPeriodEventQuery](), ppeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) CountX(ctx context.Context) int {
+	count, err := ppeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppeq *PayrollPeriodEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppeq.ctx, "Exist")
+	switch _, err := ppeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppeq *PayrollPeriodEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PayrollPeriodEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppeq *PayrollPeriodEventQuery) Clone() *PayrollPeriodEventQuery {
+	if ppeq == nil {
+		return nil
+	}
+	return &PayrollPeriodEventQuery{
+		config:            ppeq.config,
+		ctx:               ppeq.ctx.Clone(),
+		order:             append([]payrollperiodevent.OrderOption{}, ppeq.order...),
+		inters:            append([]Interceptor{}, ppeq.inters...),
+		predicates:        append([]predicate.PayrollPeriodEvent{}, ppeq.predicates...),
+		withPayrollPeriod: ppeq.withPayrollPeriod.Clone(),
+		// clone intermediate query.
+		sql:  ppeq.sql.Clone(),
+		path: ppeq.path,
+	}
+}
+
+// WithPayrollPeriod tells the query-builder to eager-load the nodes that are connected to
+// the "payroll_period" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppeq *PayrollPeriodEventQuery) WithPayrollPeriod(opts ...func(*PayrollPeriodQuery)) *PayrollPeriodEventQuery {
+	query := (&PayrollPeriodClient{config: ppeq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppeq.withPayrollPeriod = query
+	return ppeq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PayrollPeriodEvent.Query().
+//		GroupBy(payrollperiodevent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppeq *PayrollPeriodEventQuery) GroupBy(field string, fields ...string) *PayrollPeriodEventGroupBy {
+	ppeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PayrollPeriodEventGroupBy{build: ppeq}
+	grbuild.flds = &ppeq.ctx.Fields
+	grbuild.label = payrollperiodevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PayrollPeriodEvent.Query().
+//		Select(payrollperiodevent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ppeq *PayrollPeriodEventQuery) Select(fields ...string) *PayrollPeriodEventSelect {
+	ppeq.ctx.Fields = append(ppeq.ctx.Fields, fields...)
+	sbuild := &PayrollPeriodEventSelect{PayrollPeriodEventQuery: ppeq}
+	sbuild.label = payrollperiodevent.Label
+	sbuild.flds, sbuild.scan = &ppeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PayrollPeriodEventSelect configured with the given aggregations.
+func (ppeq *PayrollPeriodEventQuery) Aggregate(fns ...AggregateFunc) *PayrollPeriodEventSelect {
+	return ppeq.Select().Aggregate(fns...)
+}
+
+func (ppeq *PayrollPeriodEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppeq.ctx.Fields {
+		if !payrollperiodevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppeq.path != nil {
+		prev, err := ppeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppeq.sql = prev
+	}
+	return nil
+}
+
+func (ppeq *PayrollPeriodEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PayrollPeriodEvent, error) {
+	var (
+		nodes       = []*PayrollPeriodEvent{}
+		_spec       = ppeq.querySpec()
+		loadedTypes = [1]bool{
+			ppeq.withPayrollPeriod != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PayrollPeriodEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PayrollPeriodEvent{config: ppeq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ppeq.modifiers) > 0 {
+		_spec.Modifiers = ppeq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ppeq.withPayrollPeriod; query != nil {
+		if err := ppeq.loadPayrollPeriod(ctx, query, nodes, nil,
+			func(n *PayrollPeriodEvent, e *PayrollPeriod) { n.Edges.PayrollPeriod = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ppeq *PayrollPeriodEventQuery) loadPayrollPeriod(ctx context.Context, query *PayrollPeriodQuery, nodes []*PayrollPeriodEvent, init func(*PayrollPeriodEvent), assign func(*PayrollPeriodEvent, *PayrollPeriod)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*PayrollPeriodEvent)
+	for i := range nodes {
+		fk := nodes[i].PayrollPeriodID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(payrollperiod.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payroll_period_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ppeq *PayrollPeriodEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppeq.querySpec()
+	if len(ppeq.modifiers) > 0 {
+		_spec.Modifiers = ppeq.modifiers
+	}
+	_spec.Node.Columns = ppeq.ctx.Fields
+	if len(ppeq.ctx.Fields) > 0 {
+		_spec.Unique = ppeq.ctx.Unique != nil && *ppeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppeq.driver, _spec)
+}
+
+func (ppeq *PayrollPeriodEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(payrollperiodevent.Table, payrollperiodevent.Columns, sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64))
+	_spec.From = ppeq.sql
+	if unique := ppeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollperiodevent.FieldID)
+		for i := range fields {
+			if fields[i] != payrollperiodevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ppeq.withPayrollPeriod != nil {
+			_spec.Node.AddColumnOnce(payrollperiodevent.FieldPayrollPeriodID)
+		}
+	}
+	if ps := ppeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppeq *PayrollPeriodEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppeq.driver.Dialect())
+	t1 := builder.Table(payrollperiodevent.Table)
+	columns := ppeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = payrollperiodevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppeq.sql != nil {
+		selector = ppeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppeq.ctx.Unique != nil && *ppeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ppeq.modifiers {
+		m(selector)
+	}
+	for _, p := range ppeq.predicates {
+		p(selector)
+	}
+	for _, p := range ppeq.order {
+		p(selector)
+	}
+	if offset := ppeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ppeq *PayrollPeriodEventQuery) Modify(modifiers ...func(s *sql.Selector)) *PayrollPeriodEventSelect {
+	ppeq.modifiers = append(ppeq.modifiers, modifiers...)
+	return ppeq.Select()
+}
+
+// PayrollPeriodEventGroupBy is the group-by builder for PayrollPeriodEvent entities.
+type PayrollPeriodEventGroupBy struct {
+	selector
+	build *PayrollPeriodEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppegb *PayrollPeriodEventGroupBy) Aggregate(fns ...AggregateFunc) *PayrollPeriodEventGroupBy {
+	ppegb.fns = append(ppegb.fns, fns...)
+	return ppegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppegb *PayrollPeriodEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppegb.build.ctx, "GroupBy")
+	if err := ppegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollPeriodEventQuery, *PayrollPeriodEventGroupBy](ctx, ppegb.build, ppegb, ppegb.build.inters, v)
+}
+
+func (ppegb *PayrollPeriodEventGroupBy) sqlScan(ctx context.Context, root *PayrollPeriodEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppegb.fns))
+	for _, fn := range ppegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppegb.flds)+len(ppegb.fns))
+		for _, f := range *ppegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PayrollPeriodEventSelect is the builder for selecting fields of PayrollPeriodEvent entities.
+type PayrollPeriodEventSelect struct {
+	*PayrollPeriodEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ppes *PayrollPeriodEventSelect) Aggregate(fns ...AggregateFunc) *PayrollPeriodEventSelect {
+	ppes.fns = append(ppes.fns, fns...)
+	return ppes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppes *PayrollPeriodEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppes.ctx, "Select")
+	if err := ppes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollPeriodEventQuery, *PayrollPeriodEventSelect](ctx, ppes.PayrollPeriodEventQuery, ppes, ppes.inters, v)
+}
+
+func (ppes *PayrollPeriodEventSelect) sqlScan(ctx context.Context, root *PayrollPeriodEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ppes.fns))
+	for _, fn := range ppes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ppes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ppes *PayrollPeriodEventSelect) Modify(modifiers ...func(s *sql.Selector)) *PayrollPeriodEventSelect {
+	ppes.modifiers = append(ppes.modifiers, modifiers...)
+	return ppes
+}
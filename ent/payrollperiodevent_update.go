@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollPeriodEventUpdate is the builder for updating PayrollPeriodEvent entities.
+type PayrollPeriodEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PayrollPeriodEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PayrollPeriodEventUpdate builder.
+func (ppeu *PayrollPeriodEventUpdate) Where(ps ...predicate.PayrollPeriodEvent) *PayrollPeriodEventUpdate {
+	ppeu.mutation.Where(ps...)
+	return ppeu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppeu *PayrollPeriodEventUpdate) SetModifiedAt(t time.Time) *PayrollPeriodEventUpdate {
+	ppeu.mutation.SetModifiedAt(t)
+	return ppeu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppeu *PayrollPeriodEventUpdate) SetDeletedAt(t time.Time) *PayrollPeriodEventUpdate {
+	ppeu.mutation.SetDeletedAt(t)
+	return ppeu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppeu *PayrollPeriodEventUpdate) SetNillableDeletedAt(t *time.Time) *PayrollPeriodEventUpdate {
+	if t != nil {
+		ppeu.SetDeletedAt(*t)
+	}
+	return ppeu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ppeu *PayrollPeriodEventUpdate) ClearDeletedAt() *PayrollPeriodEventUpdate {
+	ppeu.mutation.ClearDeletedAt()
+	return ppeu
+}
+
+// SetPayrollPeriodID sets the "payroll_period_id" field.
+func (ppeu *PayrollPeriodEventUpdate) SetPayrollPeriodID(u uint64) *PayrollPeriodEventUpdate {
+	ppeu.mutation.SetPayrollPeriodID(u)
+	return ppeu
+}
+
+// SetPayrollPeriod sets the "payroll_period" edge to the PayrollPeriod entity.
+func (ppeu *PayrollPeriodEventUpdate) SetPayrollPeriod(p *PayrollPeriod) *PayrollPeriodEventUpdate {
+	return ppeu.SetPayrollPeriodID(p.ID)
+}
+
+// Mutation returns the PayrollPeriodEventMutation object of the builder.
+func (ppeu *PayrollPeriodEventUpdate) Mutation() *PayrollPeriodEventMutation {
+	return ppeu.mutation
+}
+
+// ClearPayrollPeriod clears the "payroll_period" edge to the PayrollPeriod entity.
+func (ppeu *PayrollPeriodEventUpdate) ClearPayrollPeriod() *PayrollPeriodEventUpdate {
+	ppeu.mutation.ClearPayrollPeriod()
+	return ppeu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppeu *PayrollPeriodEventUpdate) Save(ctx context.Context) (int, error) {
+	ppeu.defaults()
+	return withHooks(ctx, ppeu.sqlSave, ppeu.mutation, ppeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppeu *PayrollPeriodEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppeu *PayrollPeriodEventUpdate) Exec(ctx context.Context) error {
+	_, err := ppeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppeu *PayrollPeriodEventUpdate) ExecX(ctx context.Context) {
+	if err := ppeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppeu *PayrollPeriodEventUpdate) defaults() {
+	if _, ok := ppeu.mutation.ModifiedAt(); !ok {
+		v := payrollperiodevent.UpdateDefaultModifiedAt()
+		ppeu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppeu *PayrollPeriodEventUpdate) check() error {
+	if _, ok := ppeu.mutation.PayrollPeriodID(); ppeu.mutation.PayrollPeriodCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PayrollPeriodEvent.payroll_period"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ppeu *PayrollPeriodEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollPeriodEventUpdate {
+	ppeu.modifiers = append(ppeu.modifiers, modifiers...)
+	return ppeu
+}
+
+func (ppeu *PayrollPeriodEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollperiodevent.Table, payrollperiodevent.Columns, sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64))
+	if ps := ppeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppeu.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ppeu.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ppeu.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollperiodevent.FieldDeletedAt, field.TypeTime)
+	}
+	if ppeu.mutation.ReasonCleared() {
+		_spec.ClearField(payrollperiodevent.FieldReason, field.TypeString)
+	}
+	if ppeu.mutation.PayrollPeriodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollperiodevent.PayrollPeriodTable,
+			Columns: []string{payrollperiodevent.PayrollPeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppeu.mutation.PayrollPeriodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollperiodevent.PayrollPeriodTable,
+			Columns: []string{payrollperiodevent.PayrollPeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ppeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ppeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollperiodevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppeu.mutation.done = true
+	return n, nil
+}
+
+// PayrollPeriodEventUpdateOne is the builder for updating a single PayrollPeriodEvent entity.
+type PayrollPeriodEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PayrollPeriodEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (ppeuo *PayrollPeriodEventUpdateOne) SetModifiedAt(t time.Time) *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.SetModifiedAt(t)
+	return ppeuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ppeuo *PayrollPeriodEventUpdateOne) SetDeletedAt(t time.Time) *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.SetDeletedAt(t)
+	return ppeuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ppeuo *PayrollPeriodEventUpdateOne) SetNillableDeletedAt(t *time.Time) *PayrollPeriodEventUpdateOne {
+	if t != nil {
+		ppeuo.SetDeletedAt(*t)
+	}
+	return ppeuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ppeuo *PayrollPeriodEventUpdateOne) ClearDeletedAt() *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.ClearDeletedAt()
+	return ppeuo
+}
+
+// SetPayrollPeriodID sets the "payroll_period_id" field.
+func (ppeuo *PayrollPeriodEventUpdateOne) SetPayrollPeriodID(u uint64) *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.SetPayrollPeriodID(u)
+	return ppeuo
+}
+
+// SetPayrollPeriod sets the "payroll_period" edge to the PayrollPeriod entity.
+func (ppeuo *PayrollPeriodEventUpdateOne) SetPayrollPeriod(p *PayrollPeriod) *PayrollPeriodEventUpdateOne {
+	return ppeuo.SetPayrollPeriodID(p.ID)
+}
+
+// Mutation returns the PayrollPeriodEventMutation object of the builder.
+func (ppeuo *PayrollPeriodEventUpdateOne) Mutation() *PayrollPeriodEventMutation {
+	return ppeuo.mutation
+}
+
+// ClearPayrollPeriod clears the "payroll_period" edge to the PayrollPeriod entity.
+func (ppeuo *PayrollPeriodEventUpdateOne) ClearPayrollPeriod() *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.ClearPayrollPeriod()
+	return ppeuo
+}
+
+// Where appends a list predicates to the PayrollPeriodEventUpdate builder.
+func (ppeuo *PayrollPeriodEventUpdateOne) Where(ps ...predicate.PayrollPeriodEvent) *PayrollPeriodEventUpdateOne {
+	ppeuo.mutation.Where(ps...)
+	return ppeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppeuo *PayrollPeriodEventUpdateOne) Select(field string, fields ...string) *PayrollPeriodEventUpdateOne {
+	ppeuo.fields = append([]string{field}, fields...)
+	return ppeuo
+}
+
+// Save executes the query and returns the updated PayrollPeriodEvent entity.
+func (ppeuo *PayrollPeriodEventUpdateOne) Save(ctx context.Context) (*PayrollPeriodEvent, error) {
+	ppeuo.defaults()
+	return withHooks(ctx, ppeuo.sqlSave, ppeuo.mutation, ppeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppeuo *PayrollPeriodEventUpdateOne) SaveX(ctx context.Context) *PayrollPeriodEvent {
+	node, err := ppeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppeuo *PayrollPeriodEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppeuo *PayrollPeriodEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ppeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppeuo *PayrollPeriodEventUpdateOne) defaults() {
+	if _, ok := ppeuo.mutation.ModifiedAt(); !ok {
+		v := payrollperiodevent.UpdateDefaultModifiedAt()
+		ppeuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppeuo *PayrollPeriodEventUpdateOne) check() error {
+	if _, ok := ppeuo.mutation.PayrollPeriodID(); ppeuo.mutation.PayrollPeriodCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PayrollPeriodEvent.payroll_period"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ppeuo *PayrollPeriodEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollPeriodEventUpdateOne {
+	ppeuo.modifiers = append(ppeuo.modifiers, modifiers...)
+	return ppeuo
+}
+
+func (ppeuo *PayrollPeriodEventUpdateOne) sqlSave(ctx context.Context) (_node *PayrollPeriodEvent, err error) {
+	if err := ppeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollperiodevent.Table, payrollperiodevent.Columns, sqlgraph.NewFieldSpec(payrollperiodevent.FieldID, field.TypeUint64))
+	id, ok := ppeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayrollPeriodEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollperiodevent.FieldID)
+		for _, f := range fields {
+			if !payrollperiodevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrollperiodevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppeuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := ppeuo.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollperiodevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ppeuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollperiodevent.FieldDeletedAt, field.TypeTime)
+	}
+	if ppeuo.mutation.ReasonCleared() {
+		_spec.ClearField(payrollperiodevent.FieldReason, field.TypeString)
+	}
+	if ppeuo.mutation.PayrollPeriodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollperiodevent.PayrollPeriodTable,
+			Columns: []string{payrollperiodevent.PayrollPeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppeuo.mutation.PayrollPeriodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollperiodevent.PayrollPeriodTable,
+			Columns: []string{payrollperiodevent.PayrollPeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollperiod.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ppeuo.modifiers...)
+	_node = &PayrollPeriodEvent{config: ppeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollperiodevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppeuo.mutation.done = true
+	return _node, nil
+}
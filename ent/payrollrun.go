@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayrollRun is the model entity for the PayrollRun schema.
+type PayrollRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// First day of the month (YYYY-MM-01) the run pays
+	PeriodMonth time.Time `json:"period_month,omitempty"`
+	// Salary calculations of an approved or paid run cannot be changed
+	Status payrollrun.Status `json:"status,omitempty"`
+	// Period month while the run is not cancelled, its unique index keeps one active run per month
+	ActiveMonth *time.Time `json:"active_month,omitempty"`
+	// User who created the run
+	CreatedBy uint64 `json:"created_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayrollRunQuery when eager-loading is set.
+	Edges        PayrollRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayrollRunEdges holds the relations/edges for other nodes in the graph.
+type PayrollRunEdges struct {
+	// SalaryCalculations holds the value of the salary_calculations edge.
+	SalaryCalculations []*SalaryCalculation `json:"salary_calculations,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*PayrollRunEvent `json:"events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SalaryCalculationsOrErr returns the SalaryCalculations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PayrollRunEdges) SalaryCalculationsOrErr() ([]*SalaryCalculation, error) {
+	if e.loadedTypes[0] {
+		return e.SalaryCalculations, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_calculations"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e PayrollRunEdges) EventsOrErr() ([]*PayrollRunEvent, error) {
+	if e.loadedTypes[1] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayrollRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrollrun.FieldID, payrollrun.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case payrollrun.FieldStatus:
+			values[i] = new(sql.NullString)
+		case payrollrun.FieldCreatedAt, payrollrun.FieldModifiedAt, payrollrun.FieldDeletedAt, payrollrun.FieldPeriodMonth, payrollrun.FieldActiveMonth:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayrollRun fields.
+func (pr *PayrollRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrollrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = uint64(value.Int64)
+		case payrollrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case payrollrun.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				pr.ModifiedAt = value.Time
+			}
+		case payrollrun.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = value.Time
+			}
+		case payrollrun.FieldPeriodMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_month", values[i])
+			} else if value.Valid {
+				pr.PeriodMonth = value.Time
+			}
+		case payrollrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = payrollrun.Status(value.String)
+			}
+		case payrollrun.FieldActiveMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field active_month", values[i])
+			} else if value.Valid {
+				pr.ActiveMonth = new(time.Time)
+				*pr.ActiveMonth = value.Time
+			}
+		case payrollrun.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pr.CreatedBy = uint64(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayrollRun.
+// This includes values selected through modifiers, order, etc.
+func (pr *PayrollRun) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QuerySalaryCalculations queries the "salary_calculations" edge of the PayrollRun entity.
+func (pr *PayrollRun) QuerySalaryCalculations() *SalaryCalculationQuery {
+	return NewPayrollRunClient(pr.config).QuerySalaryCalculations(pr)
+}
+
+// QueryEvents queries the "events" edge of the PayrollRun entity.
+func (pr *PayrollRun) QueryEvents() *PayrollRunEventQuery {
+	return NewPayrollRunClient(pr.config).QueryEvents(pr)
+}
+
+// Update returns a builder for updating this PayrollRun.
+// Note that you need to call PayrollRun.Unwrap() before calling this method if this PayrollRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PayrollRun) Update() *PayrollRunUpdateOne {
+	return NewPayrollRunClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PayrollRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PayrollRun) Unwrap() *PayrollRun {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayrollRun is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PayrollRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayrollRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(pr.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(pr.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_month=")
+	builder.WriteString(pr.PeriodMonth.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Status))
+	builder.WriteString(", ")
+	if v := pr.ActiveMonth; v != nil {
+		builder.WriteString("active_month=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CreatedBy))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayrollRuns is a parsable slice of PayrollRun.
+type PayrollRuns []*PayrollRun
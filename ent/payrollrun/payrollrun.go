@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollrun
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the payrollrun type in the database.
+	Label = "payroll_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPeriodMonth holds the string denoting the period_month field in the database.
+	FieldPeriodMonth = "period_month"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldActiveMonth holds the string denoting the active_month field in the database.
+	FieldActiveMonth = "active_month"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// EdgeSalaryCalculations holds the string denoting the salary_calculations edge name in mutations.
+	EdgeSalaryCalculations = "salary_calculations"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// Table holds the table name of the payrollrun in the database.
+	Table = "payroll_runs"
+	// SalaryCalculationsTable is the table that holds the salary_calculations relation/edge.
+	SalaryCalculationsTable = "salary_calculations"
+	// SalaryCalculationsInverseTable is the table name for the SalaryCalculation entity.
+	// It exists in this package in order to avoid circular dependency with the "salarycalculation" package.
+	SalaryCalculationsInverseTable = "salary_calculations"
+	// SalaryCalculationsColumn is the table column denoting the salary_calculations relation/edge.
+	SalaryCalculationsColumn = "payroll_run_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "payroll_run_events"
+	// EventsInverseTable is the table name for the PayrollRunEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "payrollrunevent" package.
+	EventsInverseTable = "payroll_run_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "payroll_run_id"
+)
+
+// Columns holds all SQL columns for payrollrun fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldPeriodMonth,
+	FieldStatus,
+	FieldActiveMonth,
+	FieldCreatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusDraft is the default value of the Status enum.
+const DefaultStatus = StatusDraft
+
+// Status values.
+const (
+	StatusDraft      Status = "draft"
+	StatusCalculated Status = "calculated"
+	StatusReviewed   Status = "reviewed"
+	StatusApproved   Status = "approved"
+	StatusPaid       Status = "paid"
+	StatusCancelled  Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDraft, StatusCalculated, StatusReviewed, StatusApproved, StatusPaid, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("payrollrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PayrollRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByPeriodMonth orders the results by the period_month field.
+func ByPeriodMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodMonth, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByActiveMonth orders the results by the active_month field.
+func ByActiveMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActiveMonth, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// BySalaryCalculationsCount orders the results by salary_calculations count.
+func BySalaryCalculationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSalaryCalculationsStep(), opts...)
+	}
+}
+
+// BySalaryCalculations orders the results by salary_calculations terms.
+func BySalaryCalculations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryCalculationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSalaryCalculationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryCalculationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SalaryCalculationsTable, SalaryCalculationsColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
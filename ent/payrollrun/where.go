@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollrun
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PeriodMonth applies equality check predicate on the "period_month" field. It's identical to PeriodMonthEQ.
+func PeriodMonth(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// ActiveMonth applies equality check predicate on the "active_month" field. It's identical to ActiveMonthEQ.
+func ActiveMonth(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldActiveMonth, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PeriodMonthEQ applies the EQ predicate on the "period_month" field.
+func PeriodMonthEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthNEQ applies the NEQ predicate on the "period_month" field.
+func PeriodMonthNEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldPeriodMonth, v))
+}
+
+// PeriodMonthIn applies the In predicate on the "period_month" field.
+func PeriodMonthIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthNotIn applies the NotIn predicate on the "period_month" field.
+func PeriodMonthNotIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldPeriodMonth, vs...))
+}
+
+// PeriodMonthGT applies the GT predicate on the "period_month" field.
+func PeriodMonthGT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthGTE applies the GTE predicate on the "period_month" field.
+func PeriodMonthGTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLT applies the LT predicate on the "period_month" field.
+func PeriodMonthLT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldPeriodMonth, v))
+}
+
+// PeriodMonthLTE applies the LTE predicate on the "period_month" field.
+func PeriodMonthLTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldPeriodMonth, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ActiveMonthEQ applies the EQ predicate on the "active_month" field.
+func ActiveMonthEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldActiveMonth, v))
+}
+
+// ActiveMonthNEQ applies the NEQ predicate on the "active_month" field.
+func ActiveMonthNEQ(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldActiveMonth, v))
+}
+
+// ActiveMonthIn applies the In predicate on the "active_month" field.
+func ActiveMonthIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldActiveMonth, vs...))
+}
+
+// ActiveMonthNotIn applies the NotIn predicate on the "active_month" field.
+func ActiveMonthNotIn(vs ...time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldActiveMonth, vs...))
+}
+
+// ActiveMonthGT applies the GT predicate on the "active_month" field.
+func ActiveMonthGT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldActiveMonth, v))
+}
+
+// ActiveMonthGTE applies the GTE predicate on the "active_month" field.
+func ActiveMonthGTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldActiveMonth, v))
+}
+
+// ActiveMonthLT applies the LT predicate on the "active_month" field.
+func ActiveMonthLT(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldActiveMonth, v))
+}
+
+// ActiveMonthLTE applies the LTE predicate on the "active_month" field.
+func ActiveMonthLTE(v time.Time) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldActiveMonth, v))
+}
+
+// ActiveMonthIsNil applies the IsNil predicate on the "active_month" field.
+func ActiveMonthIsNil() predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIsNull(FieldActiveMonth))
+}
+
+// ActiveMonthNotNil applies the NotNil predicate on the "active_month" field.
+func ActiveMonthNotNil() predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotNull(FieldActiveMonth))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint64) predicate.PayrollRun {
+	return predicate.PayrollRun(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// HasSalaryCalculations applies the HasEdge predicate on the "salary_calculations" edge.
+func HasSalaryCalculations() predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SalaryCalculationsTable, SalaryCalculationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryCalculationsWith applies the HasEdge predicate on the "salary_calculations" edge with a given conditions (other predicates).
+func HasSalaryCalculationsWith(preds ...predicate.SalaryCalculation) predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		step := newSalaryCalculationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.PayrollRunEvent) predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayrollRun) predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayrollRun) predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayrollRun) predicate.PayrollRun {
+	return predicate.PayrollRun(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/salarycalculation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunCreate is the builder for creating a PayrollRun entity.
+type PayrollRunCreate struct {
+	config
+	mutation *PayrollRunMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PayrollRunCreate) SetCreatedAt(t time.Time) *PayrollRunCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PayrollRunCreate) SetNillableCreatedAt(t *time.Time) *PayrollRunCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (prc *PayrollRunCreate) SetModifiedAt(t time.Time) *PayrollRunCreate {
+	prc.mutation.SetModifiedAt(t)
+	return prc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (prc *PayrollRunCreate) SetNillableModifiedAt(t *time.Time) *PayrollRunCreate {
+	if t != nil {
+		prc.SetModifiedAt(*t)
+	}
+	return prc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (prc *PayrollRunCreate) SetDeletedAt(t time.Time) *PayrollRunCreate {
+	prc.mutation.SetDeletedAt(t)
+	return prc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (prc *PayrollRunCreate) SetNillableDeletedAt(t *time.Time) *PayrollRunCreate {
+	if t != nil {
+		prc.SetDeletedAt(*t)
+	}
+	return prc
+}
+
+// SetPeriodMonth sets the "period_month" field.
+func (prc *PayrollRunCreate) SetPeriodMonth(t time.Time) *PayrollRunCreate {
+	prc.mutation.SetPeriodMonth(t)
+	return prc
+}
+
+// SetStatus sets the "status" field.
+func (prc *PayrollRunCreate) SetStatus(pa payrollrun.Status) *PayrollRunCreate {
+	prc.mutation.SetStatus(pa)
+	return prc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (prc *PayrollRunCreate) SetNillableStatus(pa *payrollrun.Status) *PayrollRunCreate {
+	if pa != nil {
+		prc.SetStatus(*pa)
+	}
+	return prc
+}
+
+// SetActiveMonth sets the "active_month" field.
+func (prc *PayrollRunCreate) SetActiveMonth(t time.Time) *PayrollRunCreate {
+	prc.mutation.SetActiveMonth(t)
+	return prc
+}
+
+// SetNillableActiveMonth sets the "active_month" field if the given value is not nil.
+func (prc *PayrollRunCreate) SetNillableActiveMonth(t *time.Time) *PayrollRunCreate {
+	if t != nil {
+		prc.SetActiveMonth(*t)
+	}
+	return prc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (prc *PayrollRunCreate) SetCreatedBy(u uint64) *PayrollRunCreate {
+	prc.mutation.SetCreatedBy(u)
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PayrollRunCreate) SetID(u uint64) *PayrollRunCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// AddSalaryCalculationIDs adds the "salary_calculations" edge to the SalaryCalculation entity by IDs.
+func (prc *PayrollRunCreate) AddSalaryCalculationIDs(ids ...uint64) *PayrollRunCreate {
+	prc.mutation.AddSalaryCalculationIDs(ids...)
+	return prc
+}
+
+// AddSalaryCalculations adds the "salary_calculations" edges to the SalaryCalculation entity.
+func (prc *PayrollRunCreate) AddSalaryCalculations(s ...*SalaryCalculation) *PayrollRunCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return prc.AddSalaryCalculationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the PayrollRunEvent entity by IDs.
+func (prc *PayrollRunCreate) AddEventIDs(ids ...uint64) *PayrollRunCreate {
+	prc.mutation.AddEventIDs(ids...)
+	return prc
+}
+
+// AddEvents adds the "events" edges to the PayrollRunEvent entity.
+func (prc *PayrollRunCreate) AddEvents(p ...*PayrollRunEvent) *PayrollRunCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return prc.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollRunMutation object of the builder.
+func (prc *PayrollRunCreate) Mutation() *PayrollRunMutation {
+	return prc.mutation
+}
+
+// Save creates the PayrollRun in the database.
+func (prc *PayrollRunCreate) Save(ctx context.Context) (*PayrollRun, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PayrollRunCreate) SaveX(ctx context.Context) *PayrollRun {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PayrollRunCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PayrollRunCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PayrollRunCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := payrollrun.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.ModifiedAt(); !ok {
+		v := payrollrun.DefaultModifiedAt()
+		prc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := prc.mutation.Status(); !ok {
+		v := payrollrun.DefaultStatus
+		prc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PayrollRunCreate) check() error {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayrollRun.created_at"`)}
+	}
+	if _, ok := prc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "PayrollRun.modified_at"`)}
+	}
+	if _, ok := prc.mutation.PeriodMonth(); !ok {
+		return &ValidationError{Name: "period_month", err: errors.New(`ent: missing required field "PayrollRun.period_month"`)}
+	}
+	if _, ok := prc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PayrollRun.status"`)}
+	}
+	if v, ok := prc.mutation.Status(); ok {
+		if err := payrollrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollRun.status": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "PayrollRun.created_by"`)}
+	}
+	return nil
+}
+
+func (prc *PayrollRunCreate) sqlSave(ctx context.Context) (*PayrollRun, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PayrollRunCreate) createSpec() (*PayrollRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayrollRun{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(payrollrun.Table, sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64))
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(payrollrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrun.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := prc.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := prc.mutation.PeriodMonth(); ok {
+		_spec.SetField(payrollrun.FieldPeriodMonth, field.TypeTime, value)
+		_node.PeriodMonth = value
+	}
+	if value, ok := prc.mutation.Status(); ok {
+		_spec.SetField(payrollrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := prc.mutation.ActiveMonth(); ok {
+		_spec.SetField(payrollrun.FieldActiveMonth, field.TypeTime, value)
+		_node.ActiveMonth = &value
+	}
+	if value, ok := prc.mutation.CreatedBy(); ok {
+		_spec.SetField(payrollrun.FieldCreatedBy, field.TypeUint64, value)
+		_node.CreatedBy = value
+	}
+	if nodes := prc.mutation.SalaryCalculationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := prc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayrollRunCreateBulk is the builder for creating many PayrollRun entities in bulk.
+type PayrollRunCreateBulk struct {
+	config
+	builders []*PayrollRunCreate
+}
+
+// Save creates the PayrollRun entities in the database.
+func (prcb *PayrollRunCreateBulk) Save(ctx context.Context) ([]*PayrollRun, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PayrollRun, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayrollRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PayrollRunCreateBulk) SaveX(ctx context.Context) []*PayrollRun {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PayrollRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PayrollRunCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunQuery is the builder for querying PayrollRun entities.
+type PayrollRunQuery struct {
+	config
+	ctx                    *QueryContext
+	order                  []payrollrun.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.PayrollRun
+	withSalaryCalculations *SalaryCalculationQuery
+	withEvents             *PayrollRunEventQuery
+	modifiers              []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PayrollRunQuery builder.
+func (prq *PayrollRunQuery) Where(ps ...predicate.PayrollRun) *PayrollRunQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit the number of records to be returned by this query.
+func (prq *PayrollRunQuery) Limit(limit int) *PayrollRunQuery {
+	prq.ctx.Limit = &limit
+	return prq
+}
+
+// Offset to start from.
+func (prq *PayrollRunQuery) Offset(offset int) *PayrollRunQuery {
+	prq.ctx.Offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *PayrollRunQuery) Unique(unique bool) *PayrollRunQuery {
+	prq.ctx.Unique = &unique
+	return prq
+}
+
+// Order specifies how the records should be ordered.
+func (prq *PayrollRunQuery) Order(o ...payrollrun.OrderOption) *PayrollRunQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// QuerySalaryCalculations chains the current query on the "salary_calculations" edge.
+func (prq *PayrollRunQuery) QuerySalaryCalculations() *SalaryCalculationQuery {
+	query := (&SalaryCalculationClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrun.Table, payrollrun.FieldID, selector),
+			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollrun.SalaryCalculationsTable, payrollrun.SalaryCalculationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (prq *PayrollRunQuery) QueryEvents() *PayrollRunEventQuery {
+	query := (&PayrollRunEventClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrun.Table, payrollrun.FieldID, selector),
+			sqlgraph.To(payrollrunevent.Table, payrollrunevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payrollrun.EventsTable, payrollrun.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PayrollRun entity from the query.
+// Returns a *NotFoundError when no PayrollRun was found.
+func (prq *PayrollRunQuery) First(ctx context.Context) (*PayrollRun, error) {
+	nodes, err := prq.Limit(1).All(setContextOp(ctx, prq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{payrollrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *PayrollRunQuery) FirstX(ctx context.Context) *PayrollRun {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PayrollRun ID from the query.
+// Returns a *NotFoundError when no PayrollRun ID was found.
+func (prq *PayrollRunQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = prq.Limit(1).IDs(setContextOp(ctx, prq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{payrollrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *PayrollRunQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PayrollRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PayrollRun entity is found.
+// Returns a *NotFoundError when no PayrollRun entities are found.
+func (prq *PayrollRunQuery) Only(ctx context.Context) (*PayrollRun, error) {
+	nodes, err := prq.Limit(2).All(setContextOp(ctx, prq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{payrollrun.Label}
+	default:
+		return nil, &NotSingularError{payrollrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *PayrollRunQuery) OnlyX(ctx context.Context) *PayrollRun {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PayrollRun ID in the query.
+// Returns a *NotSingularError when more than one PayrollRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *PayrollRunQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = prq.Limit(2).IDs(setContextOp(ctx, prq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{payrollrun.Label}
+	default:
+		err = &NotSingularError{payrollrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *PayrollRunQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PayrollRuns.
+func (prq *PayrollRunQuery) All(ctx context.Context) ([]*PayrollRun, error) {
+	ctx = setContextOp(ctx, prq.ctx, "All")
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PayrollRun, *PayrollRunQuery]()
+	return withInterceptors[[]*PayrollRun](ctx, prq, qr, prq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *PayrollRunQuery) AllX(ctx context.Context) []*PayrollRun {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PayrollRun IDs.
+func (prq *PayrollRunQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if prq.ctx.Unique == nil && prq.path != nil {
+		prq.Unique(true)
+	}
+	ctx = setContextOp(ctx, prq.ctx, "IDs")
+	if err = prq.Select(payrollrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *PayrollRunQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *PayrollRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, prq.ctx, "Count")
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, prq, querierCount[*PayrollRunQuery](), prq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *PayrollRunQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *PayrollRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, prq.ctx, "Exist")
+	switch _, err := prq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *PayrollRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PayrollRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *PayrollRunQuery) Clone() *PayrollRunQuery {
+	if prq == nil {
+		return nil
+	}
+	return &PayrollRunQuery{
+		config:                 prq.config,
+		ctx:                    prq.ctx.Clone(),
+		order:                  append([]payrollrun.OrderOption{}, prq.order...),
+		inters:                 append([]Interceptor{}, prq.inters...),
+		predicates:             append([]predicate.PayrollRun{}, prq.predicates...),
+		withSalaryCalculations: prq.withSalaryCalculations.Clone(),
+		withEvents:             prq.withEvents.Clone(),
+		// clone intermediate query.
+		sql:  prq.sql.Clone(),
+		path: prq.path,
+	}
+}
+
+// WithSalaryCalculations tells the query-builder to eager-load the nodes that are connected to
+// the "salary_calculations" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PayrollRunQuery) WithSalaryCalculations(opts ...func(*SalaryCalculationQuery)) *PayrollRunQuery {
+	query := (&SalaryCalculationClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withSalaryCalculations = query
+	return prq
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PayrollRunQuery) WithEvents(opts ...func(*PayrollRunEventQuery)) *PayrollRunQuery {
+	query := (&PayrollRunEventClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withEvents = query
+	return prq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PayrollRun.Query().
+//		GroupBy(payrollrun.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prq *PayrollRunQuery) GroupBy(field string, fields ...string) *PayrollRunGroupBy {
+	prq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PayrollRunGroupBy{build: prq}
+	grbuild.flds = &prq.ctx.Fields
+	grbuild.label = payrollrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PayrollRun.Query().
+//		Select(payrollrun.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (prq *PayrollRunQuery) Select(fields ...string) *PayrollRunSelect {
+	prq.ctx.Fields = append(prq.ctx.Fields, fields...)
+	sbuild := &PayrollRunSelect{PayrollRunQuery: prq}
+	sbuild.label = payrollrun.Label
+	sbuild.flds, sbuild.scan = &prq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PayrollRunSelect configured with the given aggregations.
+func (prq *PayrollRunQuery) Aggregate(fns ...AggregateFunc) *PayrollRunSelect {
+	return prq.Select().Aggregate(fns...)
+}
+
+func (prq *PayrollRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range prq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, prq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range prq.ctx.Fields {
+		if !payrollrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *PayrollRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PayrollRun, error) {
+	var (
+		nodes       = []*PayrollRun{}
+		_spec       = prq.querySpec()
+		loadedTypes = [2]bool{
+			prq.withSalaryCalculations != nil,
+			prq.withEvents != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PayrollRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PayrollRun{config: prq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := prq.withSalaryCalculations; query != nil {
+		if err := prq.loadSalaryCalculations(ctx, query, nodes,
+			func(n *PayrollRun) { n.Edges.SalaryCalculations = []*SalaryCalculation{} },
+			func(n *PayrollRun, e *SalaryCalculation) {
+				n.Edges.SalaryCalculations = append(n.Edges.SalaryCalculations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := prq.withEvents; query != nil {
+		if err := prq.loadEvents(ctx, query, nodes,
+			func(n *PayrollRun) { n.Edges.Events = []*PayrollRunEvent{} },
+			func(n *PayrollRun, e *PayrollRunEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (prq *PayrollRunQuery) loadSalaryCalculations(ctx context.Context, query *SalaryCalculationQuery, nodes []*PayrollRun, init func(*PayrollRun), assign func(*PayrollRun, *SalaryCalculation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*PayrollRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarycalculation.FieldPayrollRunID)
+	}
+	query.Where(predicate.SalaryCalculation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(payrollrun.SalaryCalculationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PayrollRunID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payroll_run_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payroll_run_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (prq *PayrollRunQuery) loadEvents(ctx context.Context, query *PayrollRunEventQuery, nodes []*PayrollRun, init func(*PayrollRun), assign func(*PayrollRun, *PayrollRunEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*PayrollRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payrollrunevent.FieldPayrollRunID)
+	}
+	query.Where(predicate.PayrollRunEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(payrollrun.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PayrollRunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payroll_run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (prq *PayrollRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	_spec.Node.Columns = prq.ctx.Fields
+	if len(prq.ctx.Fields) > 0 {
+		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *PayrollRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(payrollrun.Table, payrollrun.Columns, sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64))
+	_spec.From = prq.sql
+	if unique := prq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if prq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := prq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollrun.FieldID)
+		for i := range fields {
+			if fields[i] != payrollrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *PayrollRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(payrollrun.Table)
+	columns := prq.ctx.Fields
+	if len(columns) == 0 {
+		columns = payrollrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.ctx.Unique != nil && *prq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range prq.modifiers {
+		m(selector)
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prq *PayrollRunQuery) Modify(modifiers ...func(s *sql.Selector)) *PayrollRunSelect {
+	prq.modifiers = append(prq.modifiers, modifiers...)
+	return prq.Select()
+}
+
+// PayrollRunGroupBy is the group-by builder for PayrollRun entities.
+type PayrollRunGroupBy struct {
+	selector
+	build *PayrollRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *PayrollRunGroupBy) Aggregate(fns ...AggregateFunc) *PayrollRunGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prgb *PayrollRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prgb.build.ctx, "GroupBy")
+	if err := prgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollRunQuery, *PayrollRunGroupBy](ctx, prgb.build, prgb, prgb.build.inters, v)
+}
+
+func (prgb *PayrollRunGroupBy) sqlScan(ctx context.Context, root *PayrollRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*prgb.flds)+len(prgb.fns))
+		for _, f := range *prgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*prgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PayrollRunSelect is the builder for selecting fields of PayrollRun entities.
+type PayrollRunSelect struct {
+	*PayrollRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (prs *PayrollRunSelect) Aggregate(fns ...AggregateFunc) *PayrollRunSelect {
+	prs.fns = append(prs.fns, fns...)
+	return prs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *PayrollRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prs.ctx, "Select")
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollRunQuery, *PayrollRunSelect](ctx, prs.PayrollRunQuery, prs, prs.inters, v)
+}
+
+func (prs *PayrollRunSelect) sqlScan(ctx context.Context, root *PayrollRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(prs.fns))
+	for _, fn := range prs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*prs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prs *PayrollRunSelect) Modify(modifiers ...func(s *sql.Selector)) *PayrollRunSelect {
+	prs.modifiers = append(prs.modifiers, modifiers...)
+	return prs
+}
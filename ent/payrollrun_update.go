@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunUpdate is the builder for updating PayrollRun entities.
+type PayrollRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PayrollRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PayrollRunUpdate builder.
+func (pru *PayrollRunUpdate) Where(ps ...predicate.PayrollRun) *PayrollRunUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (pru *PayrollRunUpdate) SetModifiedAt(t time.Time) *PayrollRunUpdate {
+	pru.mutation.SetModifiedAt(t)
+	return pru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pru *PayrollRunUpdate) SetDeletedAt(t time.Time) *PayrollRunUpdate {
+	pru.mutation.SetDeletedAt(t)
+	return pru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pru *PayrollRunUpdate) SetNillableDeletedAt(t *time.Time) *PayrollRunUpdate {
+	if t != nil {
+		pru.SetDeletedAt(*t)
+	}
+	return pru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pru *PayrollRunUpdate) ClearDeletedAt() *PayrollRunUpdate {
+	pru.mutation.ClearDeletedAt()
+	return pru
+}
+
+// SetStatus sets the "status" field.
+func (pru *PayrollRunUpdate) SetStatus(pa payrollrun.Status) *PayrollRunUpdate {
+	pru.mutation.SetStatus(pa)
+	return pru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pru *PayrollRunUpdate) SetNillableStatus(pa *payrollrun.Status) *PayrollRunUpdate {
+	if pa != nil {
+		pru.SetStatus(*pa)
+	}
+	return pru
+}
+
+// SetActiveMonth sets the "active_month" field.
+func (pru *PayrollRunUpdate) SetActiveMonth(t time.Time) *PayrollRunUpdate {
+	pru.mutation.SetActiveMonth(t)
+	return pru
+}
+
+// SetNillableActiveMonth sets the "active_month" field if the given value is not nil.
+func (pru *PayrollRunUpdate) SetNillableActiveMonth(t *time.Time) *PayrollRunUpdate {
+	if t != nil {
+		pru.SetActiveMonth(*t)
+	}
+	return pru
+}
+
+// ClearActiveMonth clears the value of the "active_month" field.
+func (pru *PayrollRunUpdate) ClearActiveMonth() *PayrollRunUpdate {
+	pru.mutation.ClearActiveMonth()
+	return pru
+}
+
+// AddSalaryCalculationIDs adds the "salary_calculations" edge to the SalaryCalculation entity by IDs.
+func (pru *PayrollRunUpdate) AddSalaryCalculationIDs(ids ...uint64) *PayrollRunUpdate {
+	pru.mutation.AddSalaryCalculationIDs(ids...)
+	return pru
+}
+
+// AddSalaryCalculations adds the "salary_calculations" edges to the SalaryCalculation entity.
+func (pru *PayrollRunUpdate) AddSalaryCalculations(s ...*SalaryCalculation) *PayrollRunUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pru.AddSalaryCalculationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the PayrollRunEvent entity by IDs.
+func (pru *PayrollRunUpdate) AddEventIDs(ids ...uint64) *PayrollRunUpdate {
+	pru.mutation.AddEventIDs(ids...)
+	return pru
+}
+
+// AddEvents adds the "events" edges to the PayrollRunEvent entity.
+func (pru *PayrollRunUpdate) AddEvents(p ...*PayrollRunEvent) *PayrollRunUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pru.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollRunMutation object of the builder.
+func (pru *PayrollRunUpdate) Mutation() *PayrollRunMutation {
+	return pru.mutation
+}
+
+// ClearSalaryCalculations clears all "salary_calculations" edges to the SalaryCalculation entity.
+func (pru *PayrollRunUpdate) ClearSalaryCalculations() *PayrollRunUpdate {
+	pru.mutation.ClearSalaryCalculations()
+	return pru
+}
+
+// RemoveSalaryCalculationIDs removes the "salary_calculations" edge to SalaryCalculation entities by IDs.
+func (pru *PayrollRunUpdate) RemoveSalaryCalculationIDs(ids ...uint64) *PayrollRunUpdate {
+	pru.mutation.RemoveSalaryCalculationIDs(ids...)
+	return pru
+}
+
+// RemoveSalaryCalculations removes "salary_calculations" edges to SalaryCalculation entities.
+func (pru *PayrollRunUpdate) RemoveSalaryCalculations(s ...*SalaryCalculation) *PayrollRunUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pru.RemoveSalaryCalculationIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the PayrollRunEvent entity.
+func (pru *PayrollRunUpdate) ClearEvents() *PayrollRunUpdate {
+	pru.mutation.ClearEvents()
+	return pru
+}
+
+// RemoveEventIDs removes the "events" edge to PayrollRunEvent entities by IDs.
+func (pru *PayrollRunUpdate) RemoveEventIDs(ids ...uint64) *PayrollRunUpdate {
+	pru.mutation.RemoveEventIDs(ids...)
+	return pru
+}
+
+// RemoveEvents removes "events" edges to PayrollRunEvent entities.
+func (pru *PayrollRunUpdate) RemoveEvents(p ...*PayrollRunEvent) *PayrollRunUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pru.RemoveEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PayrollRunUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PayrollRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PayrollRunUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PayrollRunUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PayrollRunUpdate) defaults() {
+	if _, ok := pru.mutation.ModifiedAt(); !ok {
+		v := payrollrun.UpdateDefaultModifiedAt()
+		pru.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PayrollRunUpdate) check() error {
+	if v, ok := pru.mutation.Status(); ok {
+		if err := payrollrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pru *PayrollRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollRunUpdate {
+	pru.modifiers = append(pru.modifiers, modifiers...)
+	return pru
+}
+
+func (pru *PayrollRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollrun.Table, payrollrun.Columns, sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrun.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pru.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.Status(); ok {
+		_spec.SetField(payrollrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.ActiveMonth(); ok {
+		_spec.SetField(payrollrun.FieldActiveMonth, field.TypeTime, value)
+	}
+	if pru.mutation.ActiveMonthCleared() {
+		_spec.ClearField(payrollrun.FieldActiveMonth, field.TypeTime)
+	}
+	if pru.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.RemovedSalaryCalculationsIDs(); len(nodes) > 0 && !pru.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.SalaryCalculationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.RemovedEventsIDs(); len(nodes) > 0 && !pru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PayrollRunUpdateOne is the builder for updating a single PayrollRun entity.
+type PayrollRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PayrollRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (pruo *PayrollRunUpdateOne) SetModifiedAt(t time.Time) *PayrollRunUpdateOne {
+	pruo.mutation.SetModifiedAt(t)
+	return pruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pruo *PayrollRunUpdateOne) SetDeletedAt(t time.Time) *PayrollRunUpdateOne {
+	pruo.mutation.SetDeletedAt(t)
+	return pruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pruo *PayrollRunUpdateOne) SetNillableDeletedAt(t *time.Time) *PayrollRunUpdateOne {
+	if t != nil {
+		pruo.SetDeletedAt(*t)
+	}
+	return pruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pruo *PayrollRunUpdateOne) ClearDeletedAt() *PayrollRunUpdateOne {
+	pruo.mutation.ClearDeletedAt()
+	return pruo
+}
+
+// SetStatus sets the "status" field.
+func (pruo *PayrollRunUpdateOne) SetStatus(pa payrollrun.Status) *PayrollRunUpdateOne {
+	pruo.mutation.SetStatus(pa)
+	return pruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pruo *PayrollRunUpdateOne) SetNillableStatus(pa *payrollrun.Status) *PayrollRunUpdateOne {
+	if pa != nil {
+		pruo.SetStatus(*pa)
+	}
+	return pruo
+}
+
+// SetActiveMonth sets the "active_month" field.
+func (pruo *PayrollRunUpdateOne) SetActiveMonth(t time.Time) *PayrollRunUpdateOne {
+	pruo.mutation.SetActiveMonth(t)
+	return pruo
+}
+
+// SetNillableActiveMonth sets the "active_month" field if the given value is not nil.
+func (pruo *PayrollRunUpdateOne) SetNillableActiveMonth(t *time.Time) *PayrollRunUpdateOne {
+	if t != nil {
+		pruo.SetActiveMonth(*t)
+	}
+	return pruo
+}
+
+// ClearActiveMonth clears the value of the "active_month" field.
+func (pruo *PayrollRunUpdateOne) ClearActiveMonth() *PayrollRunUpdateOne {
+	pruo.mutation.ClearActiveMonth()
+	return pruo
+}
+
+// AddSalaryCalculationIDs adds the "salary_calculations" edge to the SalaryCalculation entity by IDs.
+func (pruo *PayrollRunUpdateOne) AddSalaryCalculationIDs(ids ...uint64) *PayrollRunUpdateOne {
+	pruo.mutation.AddSalaryCalculationIDs(ids...)
+	return pruo
+}
+
+// AddSalaryCalculations adds the "salary_calculations" edges to the SalaryCalculation entity.
+func (pruo *PayrollRunUpdateOne) AddSalaryCalculations(s ...*SalaryCalculation) *PayrollRunUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pruo.AddSalaryCalculationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the PayrollRunEvent entity by IDs.
+func (pruo *PayrollRunUpdateOne) AddEventIDs(ids ...uint64) *PayrollRunUpdateOne {
+	pruo.mutation.AddEventIDs(ids...)
+	return pruo
+}
+
+// AddEvents adds the "events" edges to the PayrollRunEvent entity.
+func (pruo *PayrollRunUpdateOne) AddEvents(p ...*PayrollRunEvent) *PayrollRunUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pruo.AddEventIDs(ids...)
+}
+
+// Mutation returns the PayrollRunMutation object of the builder.
+func (pruo *PayrollRunUpdateOne) Mutation() *PayrollRunMutation {
+	return pruo.mutation
+}
+
+// ClearSalaryCalculations clears all "salary_calculations" edges to the SalaryCalculation entity.
+func (pruo *PayrollRunUpdateOne) ClearSalaryCalculations() *PayrollRunUpdateOne {
+	pruo.mutation.ClearSalaryCalculations()
+	return pruo
+}
+
+// RemoveSalaryCalculationIDs removes the "salary_calculations" edge to SalaryCalculation entities by IDs.
+func (pruo *PayrollRunUpdateOne) RemoveSalaryCalculationIDs(ids ...uint64) *PayrollRunUpdateOne {
+	pruo.mutation.RemoveSalaryCalculationIDs(ids...)
+	return pruo
+}
+
+// RemoveSalaryCalculations removes "salary_calculations" edges to SalaryCalculation entities.
+func (pruo *PayrollRunUpdateOne) RemoveSalaryCalculations(s ...*SalaryCalculation) *PayrollRunUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pruo.RemoveSalaryCalculationIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the PayrollRunEvent entity.
+func (pruo *PayrollRunUpdateOne) ClearEvents() *PayrollRunUpdateOne {
+	pruo.mutation.ClearEvents()
+	return pruo
+}
+
+// RemoveEventIDs removes the "events" edge to PayrollRunEvent entities by IDs.
+func (pruo *PayrollRunUpdateOne) RemoveEventIDs(ids ...uint64) *PayrollRunUpdateOne {
+	pruo.mutation.RemoveEventIDs(ids...)
+	return pruo
+}
+
+// RemoveEvents removes "events" edges to PayrollRunEvent entities.
+func (pruo *PayrollRunUpdateOne) RemoveEvents(p ...*PayrollRunEvent) *PayrollRunUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pruo.RemoveEventIDs(ids...)
+}
+
+// Where appends a list predicates to the PayrollRunUpdate builder.
+func (pruo *PayrollRunUpdateOne) Where(ps ...predicate.PayrollRun) *PayrollRunUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PayrollRunUpdateOne) Select(field string, fields ...string) *PayrollRunUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PayrollRun entity.
+func (pruo *PayrollRunUpdateOne) Save(ctx context.Context) (*PayrollRun, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PayrollRunUpdateOne) SaveX(ctx context.Context) *PayrollRun {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PayrollRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PayrollRunUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PayrollRunUpdateOne) defaults() {
+	if _, ok := pruo.mutation.ModifiedAt(); !ok {
+		v := payrollrun.UpdateDefaultModifiedAt()
+		pruo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PayrollRunUpdateOne) check() error {
+	if v, ok := pruo.mutation.Status(); ok {
+		if err := payrollrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PayrollRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pruo *PayrollRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollRunUpdateOne {
+	pruo.modifiers = append(pruo.modifiers, modifiers...)
+	return pruo
+}
+
+func (pruo *PayrollRunUpdateOne) sqlSave(ctx context.Context) (_node *PayrollRun, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollrun.Table, payrollrun.Columns, sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayrollRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollrun.FieldID)
+		for _, f := range fields {
+			if !payrollrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrollrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrun.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.Status(); ok {
+		_spec.SetField(payrollrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pruo.mutation.ActiveMonth(); ok {
+		_spec.SetField(payrollrun.FieldActiveMonth, field.TypeTime, value)
+	}
+	if pruo.mutation.ActiveMonthCleared() {
+		_spec.ClearField(payrollrun.FieldActiveMonth, field.TypeTime)
+	}
+	if pruo.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.RemovedSalaryCalculationsIDs(); len(nodes) > 0 && !pruo.mutation.SalaryCalculationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.SalaryCalculationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.SalaryCalculationsTable,
+			Columns: []string{payrollrun.SalaryCalculationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !pruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payrollrun.EventsTable,
+			Columns: []string{payrollrun.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pruo.modifiers...)
+	_node = &PayrollRun{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
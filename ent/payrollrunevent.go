@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayrollRunEvent is the model entity for the PayrollRunEvent schema.
+type PayrollRunEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to payroll_runs table
+	PayrollRunID uint64 `json:"payroll_run_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action payrollrunevent.Action `json:"action,omitempty"`
+	// Status of the run before the transition, empty when it was created
+	FromStatus *payrollrunevent.FromStatus `json:"from_status,omitempty"`
+	// Status of the run after the transition
+	ToStatus payrollrunevent.ToStatus `json:"to_status,omitempty"`
+	// User who made the transition
+	ActorID uint64 `json:"actor_id,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayrollRunEventQuery when eager-loading is set.
+	Edges        PayrollRunEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayrollRunEventEdges holds the relations/edges for other nodes in the graph.
+type PayrollRunEventEdges struct {
+	// PayrollRun holds the value of the payroll_run edge.
+	PayrollRun *PayrollRun `json:"payroll_run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PayrollRunOrErr returns the PayrollRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayrollRunEventEdges) PayrollRunOrErr() (*PayrollRun, error) {
+	if e.loadedTypes[0] {
+		if e.PayrollRun == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: payrollrun.Label}
+		}
+		return e.PayrollRun, nil
+	}
+	return nil, &NotLoadedError{edge: "payroll_run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayrollRunEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrollrunevent.FieldID, payrollrunevent.FieldPayrollRunID, payrollrunevent.FieldActorID:
+			values[i] = new(sql.NullInt64)
+		case payrollrunevent.FieldAction, payrollrunevent.FieldFromStatus, payrollrunevent.FieldToStatus, payrollrunevent.FieldNotes:
+			values[i] = new(sql.NullString)
+		case payrollrunevent.FieldCreatedAt, payrollrunevent.FieldModifiedAt, payrollrunevent.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayrollRunEvent fields.
+func (pre *PayrollRunEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrollrunevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pre.ID = uint64(value.Int64)
+		case payrollrunevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pre.CreatedAt = value.Time
+			}
+		case payrollrunevent.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				pre.ModifiedAt = value.Time
+			}
+		case payrollrunevent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pre.DeletedAt = value.Time
+			}
+		case payrollrunevent.FieldPayrollRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payroll_run_id", values[i])
+			} else if value.Valid {
+				pre.PayrollRunID = uint64(value.Int64)
+			}
+		case payrollrunevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				pre.Action = payrollrunevent.Action(value.String)
+			}
+		case payrollrunevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				pre.FromStatus = new(payrollrunevent.FromStatus)
+				*pre.FromStatus = payrollrunevent.FromStatus(value.String)
+			}
+		case payrollrunevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				pre.ToStatus = payrollrunevent.ToStatus(value.String)
+			}
+		case payrollrunevent.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				pre.ActorID = uint64(value.Int64)
+			}
+		case payrollrunevent.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				pre.Notes = value.String
+			}
+		default:
+			pre.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayrollRunEvent.
+// This includes values selected through modifiers, order, etc.
+func (pre *PayrollRunEvent) Value(name string) (ent.Value, error) {
+	return pre.selectValues.Get(name)
+}
+
+// QueryPayrollRun queries the "payroll_run" edge of the PayrollRunEvent entity.
+func (pre *PayrollRunEvent) QueryPayrollRun() *PayrollRunQuery {
+	return NewPayrollRunEventClient(pre.config).QueryPayrollRun(pre)
+}
+
+// Update returns a builder for updating this PayrollRunEvent.
+// Note that you need to call PayrollRunEvent.Unwrap() before calling this method if this PayrollRunEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pre *PayrollRunEvent) Update() *PayrollRunEventUpdateOne {
+	return NewPayrollRunEventClient(pre.config).UpdateOne(pre)
+}
+
+// Unwrap unwraps the PayrollRunEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pre *PayrollRunEvent) Unwrap() *PayrollRunEvent {
+	_tx, ok := pre.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayrollRunEvent is not a transactional entity")
+	}
+	pre.config.driver = _tx.drv
+	return pre
+}
+
+// String implements the fmt.Stringer.
+func (pre *PayrollRunEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayrollRunEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pre.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pre.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(pre.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(pre.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payroll_run_id=")
+	builder.WriteString(fmt.Sprintf("%v", pre.PayrollRunID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", pre.Action))
+	builder.WriteString(", ")
+	if v := pre.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", pre.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", pre.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(pre.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayrollRunEvents is a parsable slice of PayrollRunEvent.
+type PayrollRunEvents []*PayrollRunEvent
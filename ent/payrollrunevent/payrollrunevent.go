@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollrunevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the payrollrunevent type in the database.
+	Label = "payroll_run_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldPayrollRunID holds the string denoting the payroll_run_id field in the database.
+	FieldPayrollRunID = "payroll_run_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// EdgePayrollRun holds the string denoting the payroll_run edge name in mutations.
+	EdgePayrollRun = "payroll_run"
+	// Table holds the table name of the payrollrunevent in the database.
+	Table = "payroll_run_events"
+	// PayrollRunTable is the table that holds the payroll_run relation/edge.
+	PayrollRunTable = "payroll_run_events"
+	// PayrollRunInverseTable is the table name for the PayrollRun entity.
+	// It exists in this package in order to avoid circular dependency with the "payrollrun" package.
+	PayrollRunInverseTable = "payroll_runs"
+	// PayrollRunColumn is the table column denoting the payroll_run relation/edge.
+	PayrollRunColumn = "payroll_run_id"
+)
+
+// Columns holds all SQL columns for payrollrunevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldPayrollRunID,
+	FieldAction,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldActorID,
+	FieldNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionCreate    Action = "create"
+	ActionCalculate Action = "calculate"
+	ActionReview    Action = "review"
+	ActionApprove   Action = "approve"
+	ActionPay       Action = "pay"
+	ActionCancel    Action = "cancel"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionCreate, ActionCalculate, ActionReview, ActionApprove, ActionPay, ActionCancel:
+		return nil
+	default:
+		return fmt.Errorf("payrollrunevent: invalid enum value for action field: %q", a)
+	}
+}
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusDraft      FromStatus = "draft"
+	FromStatusCalculated FromStatus = "calculated"
+	FromStatusReviewed   FromStatus = "reviewed"
+	FromStatusApproved   FromStatus = "approved"
+	FromStatusPaid       FromStatus = "paid"
+	FromStatusCancelled  FromStatus = "cancelled"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusDraft, FromStatusCalculated, FromStatusReviewed, FromStatusApproved, FromStatusPaid, FromStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("payrollrunevent: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusDraft      ToStatus = "draft"
+	ToStatusCalculated ToStatus = "calculated"
+	ToStatusReviewed   ToStatus = "reviewed"
+	ToStatusApproved   ToStatus = "approved"
+	ToStatusPaid       ToStatus = "paid"
+	ToStatusCancelled  ToStatus = "cancelled"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusDraft, ToStatusCalculated, ToStatusReviewed, ToStatusApproved, ToStatusPaid, ToStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("payrollrunevent: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the PayrollRunEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByPayrollRunID orders the results by the payroll_run_id field.
+func ByPayrollRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayrollRunID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByPayrollRunField orders the results by payroll_run field.
+func ByPayrollRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayrollRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPayrollRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayrollRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PayrollRunTable, PayrollRunColumn),
+	)
+}
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollrunevent
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PayrollRunID applies equality check predicate on the "payroll_run_id" field. It's identical to PayrollRunIDEQ.
+func PayrollRunID(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldPayrollRunID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PayrollRunIDEQ applies the EQ predicate on the "payroll_run_id" field.
+func PayrollRunIDEQ(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldPayrollRunID, v))
+}
+
+// PayrollRunIDNEQ applies the NEQ predicate on the "payroll_run_id" field.
+func PayrollRunIDNEQ(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldPayrollRunID, v))
+}
+
+// PayrollRunIDIn applies the In predicate on the "payroll_run_id" field.
+func PayrollRunIDIn(vs ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldPayrollRunID, vs...))
+}
+
+// PayrollRunIDNotIn applies the NotIn predicate on the "payroll_run_id" field.
+func PayrollRunIDNotIn(vs ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldPayrollRunID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v FromStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v FromStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...FromStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...FromStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotNull(FieldFromStatus))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v ToStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v ToStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...ToStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...ToStatus) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uint64) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldActorID, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// HasPayrollRun applies the HasEdge predicate on the "payroll_run" edge.
+func HasPayrollRun() predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PayrollRunTable, PayrollRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayrollRunWith applies the HasEdge predicate on the "payroll_run" edge with a given conditions (other predicates).
+func HasPayrollRunWith(preds ...predicate.PayrollRun) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(func(s *sql.Selector) {
+		step := newPayrollRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayrollRunEvent) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayrollRunEvent) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayrollRunEvent) predicate.PayrollRunEvent {
+	return predicate.PayrollRunEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
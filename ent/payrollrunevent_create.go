@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunEventCreate is the builder for creating a PayrollRunEvent entity.
+type PayrollRunEventCreate struct {
+	config
+	mutation *PayrollRunEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prec *PayrollRunEventCreate) SetCreatedAt(t time.Time) *PayrollRunEventCreate {
+	prec.mutation.SetCreatedAt(t)
+	return prec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prec *PayrollRunEventCreate) SetNillableCreatedAt(t *time.Time) *PayrollRunEventCreate {
+	if t != nil {
+		prec.SetCreatedAt(*t)
+	}
+	return prec
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (prec *PayrollRunEventCreate) SetModifiedAt(t time.Time) *PayrollRunEventCreate {
+	prec.mutation.SetModifiedAt(t)
+	return prec
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (prec *PayrollRunEventCreate) SetNillableModifiedAt(t *time.Time) *PayrollRunEventCreate {
+	if t != nil {
+		prec.SetModifiedAt(*t)
+	}
+	return prec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (prec *PayrollRunEventCreate) SetDeletedAt(t time.Time) *PayrollRunEventCreate {
+	prec.mutation.SetDeletedAt(t)
+	return prec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (prec *PayrollRunEventCreate) SetNillableDeletedAt(t *time.Time) *PayrollRunEventCreate {
+	if t != nil {
+		prec.SetDeletedAt(*t)
+	}
+	return prec
+}
+
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (prec *PayrollRunEventCreate) SetPayrollRunID(u uint64) *PayrollRunEventCreate {
+	prec.mutation.SetPayrollRunID(u)
+	return prec
+}
+
+// SetAction sets the "action" field.
+func (prec *PayrollRunEventCreate) SetAction(pa payrollrunevent.Action) *PayrollRunEventCreate {
+	prec.mutation.SetAction(pa)
+	return prec
+}
+
+// SetFromStatus sets the "from_status" field.
+func (prec *PayrollRunEventCreate) SetFromStatus(ps payrollrunevent.FromStatus) *PayrollRunEventCreate {
+	prec.mutation.SetFromStatus(ps)
+	return prec
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (prec *PayrollRunEventCreate) SetNillableFromStatus(ps *payrollrunevent.FromStatus) *PayrollRunEventCreate {
+	if ps != nil {
+		prec.SetFromStatus(*ps)
+	}
+	return prec
+}
+
+// SetToStatus sets the "to_status" field.
+func (prec *PayrollRunEventCreate) SetToStatus(ps payrollrunevent.ToStatus) *PayrollRunEventCreate {
+	prec.mutation.SetToStatus(ps)
+	return prec
+}
+
+// SetActorID sets the "actor_id" field.
+func (prec *PayrollRunEventCreate) SetActorID(u uint64) *PayrollRunEventCreate {
+	prec.mutation.SetActorID(u)
+	return prec
+}
+
+// SetNotes sets the "notes" field.
+func (prec *PayrollRunEventCreate) SetNotes(s string) *PayrollRunEventCreate {
+	prec.mutation.SetNotes(s)
+	return prec
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (prec *PayrollRunEventCreate) SetNillableNotes(s *string) *PayrollRunEventCreate {
+	if s != nil {
+		prec.SetNotes(*s)
+	}
+	return prec
+}
+
+// SetID sets the "id" field.
+func (prec *PayrollRunEventCreate) SetID(u uint64) *PayrollRunEventCreate {
+	prec.mutation.SetID(u)
+	return prec
+}
+
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (prec *PayrollRunEventCreate) SetPayrollRun(p *PayrollRun) *PayrollRunEventCreate {
+	return prec.SetPayrollRunID(p.ID)
+}
+
+// Mutation returns the PayrollRunEventMutation object of the builder.
+func (prec *PayrollRunEventCreate) Mutation() *PayrollRunEventMutation {
+	return prec.mutation
+}
+
+// Save creates the PayrollRunEvent in the database.
+func (prec *PayrollRunEventCreate) Save(ctx context.Context) (*PayrollRunEvent, error) {
+	prec.defaults()
+	return withHooks(ctx, prec.sqlSave, prec.mutation, prec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prec *PayrollRunEventCreate) SaveX(ctx context.Context) *PayrollRunEvent {
+	v, err := prec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prec *PayrollRunEventCreate) Exec(ctx context.Context) error {
+	_, err := prec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prec *PayrollRunEventCreate) ExecX(ctx context.Context) {
+	if err := prec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prec *PayrollRunEventCreate) defaults() {
+	if _, ok := prec.mutation.CreatedAt(); !ok {
+		v := payrollrunevent.DefaultCreatedAt()
+		prec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prec.mutation.ModifiedAt(); !ok {
+		v := payrollrunevent.DefaultModifiedAt()
+		prec.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prec *PayrollRunEventCreate) check() error {
+	if _, ok := prec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayrollRunEvent.created_at"`)}
+	}
+	if _, ok := prec.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "PayrollRunEvent.modified_at"`)}
+	}
+	if _, ok := prec.mutation.PayrollRunID(); !ok {
+		return &ValidationError{Name: "payroll_run_id", err: errors.New(`ent: missing required field "PayrollRunEvent.payroll_run_id"`)}
+	}
+	if _, ok := prec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PayrollRunEvent.action"`)}
+	}
+	if v, ok := prec.mutation.Action(); ok {
+		if err := payrollrunevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PayrollRunEvent.action": %w`, err)}
+		}
+	}
+	if v, ok := prec.mutation.FromStatus(); ok {
+		if err := payrollrunevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "PayrollRunEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := prec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "PayrollRunEvent.to_status"`)}
+	}
+	if v, ok := prec.mutation.ToStatus(); ok {
+		if err := payrollrunevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "PayrollRunEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := prec.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "PayrollRunEvent.actor_id"`)}
+	}
+	if _, ok := prec.mutation.PayrollRunID(); !ok {
+		return &ValidationError{Name: "payroll_run", err: errors.New(`ent: missing required edge "PayrollRunEvent.payroll_run"`)}
+	}
+	return nil
+}
+
+func (prec *PayrollRunEventCreate) sqlSave(ctx context.Context) (*PayrollRunEvent, error) {
+	if err := prec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	prec.mutation.id = &_node.ID
+	prec.mutation.done = true
+	return _node, nil
+}
+
+func (prec *PayrollRunEventCreate) createSpec() (*PayrollRunEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayrollRunEvent{config: prec.config}
+		_spec = sqlgraph.NewCreateSpec(payrollrunevent.Table, sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64))
+	)
+	if id, ok := prec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := prec.mutation.CreatedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prec.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := prec.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := prec.mutation.Action(); ok {
+		_spec.SetField(payrollrunevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := prec.mutation.FromStatus(); ok {
+		_spec.SetField(payrollrunevent.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := prec.mutation.ToStatus(); ok {
+		_spec.SetField(payrollrunevent.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := prec.mutation.ActorID(); ok {
+		_spec.SetField(payrollrunevent.FieldActorID, field.TypeUint64, value)
+		_node.ActorID = value
+	}
+	if value, ok := prec.mutation.Notes(); ok {
+		_spec.SetField(payrollrunevent.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if nodes := prec.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollrunevent.PayrollRunTable,
+			Columns: []string{payrollrunevent.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PayrollRunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayrollRunEventCreateBulk is the builder for creating many PayrollRunEvent entities in bulk.
+type PayrollRunEventCreateBulk struct {
+	config
+	builders []*PayrollRunEventCreate
+}
+
+// Save creates the PayrollRunEvent entities in the database.
+func (precb *PayrollRunEventCreateBulk) Save(ctx context.Context) ([]*PayrollRunEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(precb.builders))
+	nodes := make([]*PayrollRunEvent, len(precb.builders))
+	mutators := make([]Mutator, len(precb.builders))
+	for i := range precb.builders {
+		func(i int, root context.Context) {
+			builder := precb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayrollRunEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, precb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, precb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, precb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (precb *PayrollRunEventCreateBulk) SaveX(ctx context.Context) []*PayrollRunEvent {
+	v, err := precb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (precb *PayrollRunEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := precb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (precb *PayrollRunEventCreateBulk) ExecX(ctx context.Context) {
+	if err := precb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
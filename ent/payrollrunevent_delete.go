@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunEventDelete is the builder for deleting a PayrollRunEvent entity.
+type PayrollRunEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayrollRunEventMutation
+}
+
+// Where appends a list predicates to the PayrollRunEventDelete builder.
+func (pred *PayrollRunEventDelete) Where(ps ...predicate.PayrollRunEvent) *PayrollRunEventDelete {
+	pred.mutation.Where(ps...)
+	return pred
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pred *PayrollRunEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pred.sqlExec, pred.mutation, pred.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pred *PayrollRunEventDelete) ExecX(ctx context.Context) int {
+	n, err := pred.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pred *PayrollRunEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payrollrunevent.Table, sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64))
+	if ps := pred.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pred.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pred.mutation.done = true
+	return affected, err
+}
+
+// PayrollRunEventDeleteOne is the builder for deleting a single PayrollRunEvent entity.
+type PayrollRunEventDeleteOne struct {
+	pred *PayrollRunEventDelete
+}
+
+// Where appends a list predicates to the PayrollRunEventDelete builder.
+func (predo *PayrollRunEventDeleteOne) Where(ps ...predicate.PayrollRunEvent) *PayrollRunEventDeleteOne {
+	predo.pred.mutation.Where(ps...)
+	return predo
+}
+
+// Exec executes the deletion query.
+func (predo *PayrollRunEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := predo.pred.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payrollrunevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (predo *PayrollRunEventDeleteOne) ExecX(ctx context.Context) {
+	if err := predo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunEventQuery is the builder for querying PayrollRunEvent entities.
+type PayrollRunEventQuery struct {
+	config
+	ctx            *QueryContext
+	order          []payrollrunevent.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.PayrollRunEvent
+	withPayrollRun *PayrollRunQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PayrollRunEventQuery builder.
+func (preq *PayrollRunEventQuery) Where(ps ...predicate.PayrollRunEvent) *PayrollRunEventQuery {
+	preq.predicates = append(preq.predicates, ps...)
+	return preq
+}
+
+// Limit the number of records to be returned by this query.
+func (preq *PayrollRunEventQuery) Limit(limit int) *PayrollRunEventQuery {
+	preq.ctx.Limit = &limit
+	return preq
+}
+
+// Offset to start from.
+func (preq *PayrollRunEventQuery) Offset(offset int) *PayrollRunEventQuery {
+	preq.ctx.Offset = &offset
+	return preq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (preq *PayrollRunEventQuery) Unique(unique bool) *PayrollRunEventQuery {
+	preq.ctx.Unique = &unique
+	return preq
+}
+
+// Order specifies how the records should be ordered.
+func (preq *PayrollRunEventQuery) Order(o ...payrollrunevent.OrderOption) *PayrollRunEventQuery {
+	preq.order = append(preq.order, o...)
+	return preq
+}
+
+// QueryPayrollRun chains the current query on the "payroll_run" edge.
+func (preq *PayrollRunEventQuery) QueryPayrollRun() *PayrollRunQuery {
+	query := (&PayrollRunClient{config: preq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := preq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := preq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payrollrunevent.Table, payrollrunevent.FieldID, selector),
+			sqlgraph.To(payrollrun.Table, payrollrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payrollrunevent.PayrollRunTable, payrollrunevent.PayrollRunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(preq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PayrollRunEvent entity from the query.
+// Returns a *NotFoundError when no PayrollRunEvent was found.
+func (preq *PayrollRunEventQuery) First(ctx context.Context) (*PayrollRunEvent, error) {
+	nodes, err := preq.Limit(1).All(setContextOp(ctx, preq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{payrollrunevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) FirstX(ctx context.Context) *PayrollRunEvent {
+	node, err := preq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PayrollRunEvent ID from the query.
+// Returns a *NotFoundError when no PayrollRunEvent ID was found.
+func (preq *PayrollRunEventQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = preq.Limit(1).IDs(setContextOp(ctx, preq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{payrollrunevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := preq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PayrollRunEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PayrollRunEvent entity is found.
+// Returns a *NotFoundError when no PayrollRunEvent entities are found.
+func (preq *PayrollRunEventQuery) Only(ctx context.Context) (*PayrollRunEvent, error) {
+	nodes, err := preq.Limit(2).All(setContextOp(ctx, preq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{payrollrunevent.Label}
+	default:
+		return nil, &NotSingularError{payrollrunevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) OnlyX(ctx context.Context) *PayrollRunEvent {
+	node, err := preq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PayrollRunEvent ID in the query.
+// Returns a *NotSingularError when more than one PayrollRunEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (preq *PayrollRunEventQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = preq.Limit(2).IDs(setContextOp(ctx, preq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{payrollrunevent.Label}
+	default:
+		err = &NotSingularError{payrollrunevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := preq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PayrollRunEvents.
+func (preq *PayrollRunEventQuery) All(ctx context.Context) ([]*PayrollRunEvent, error) {
+	ctx = setContextOp(ctx, preq.ctx, "All")
+	if err := preq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PayrollRunEvent, *PayrollRunEventQuery]()
+	return withInterceptors[[]*PayrollRunEvent](ctx, preq, qr, preq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) AllX(ctx context.Context) []*PayrollRunEvent {
+	nodes, err := preq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PayrollRunEvent IDs.
+func (preq *PayrollRunEventQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if preq.ctx.Unique == nil && preq.path != nil {
+		preq.Unique(true)
+	}
+	ctx = setContextOp(ctx, preq.ctx, "IDs")
+	if err = preq.Select(payrollrunevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := preq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (preq *PayrollRunEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, preq.ctx, "Count")
+	if err := preq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, preq, querierCount[*PayrollRunEventQuery](), preq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) CountX(ctx context.Context) int {
+	count, err := preq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (preq *PayrollRunEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, preq.ctx, "Exist")
+	switch _, err := preq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (preq *PayrollRunEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := preq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PayrollRunEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (preq *PayrollRunEventQuery) Clone() *PayrollRunEventQuery {
+	if preq == nil {
+		return nil
+	}
+	return &PayrollRunEventQuery{
+		config:         preq.config,
+		ctx:            preq.ctx.Clone(),
+		order:          append([]payrollrunevent.OrderOption{}, preq.order...),
+		inters:         append([]Interceptor{}, preq.inters...),
+		predicates:     append([]predicate.PayrollRunEvent{}, preq.predicates...),
+		withPayrollRun: preq.withPayrollRun.Clone(),
+		// clone intermediate query.
+		sql:  preq.sql.Clone(),
+		path: preq.path,
+	}
+}
+
+// WithPayrollRun tells the query-builder to eager-load the nodes that are connected to
+// the "payroll_run" edge. The optional arguments are used to configure the query builder of the edge.
+func (preq *PayrollRunEventQuery) WithPayrollRun(opts ...func(*PayrollRunQuery)) *PayrollRunEventQuery {
+	query := (&PayrollRunClient{config: preq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	preq.withPayrollRun = query
+	return preq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PayrollRunEvent.Query().
+//		GroupBy(payrollrunevent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (preq *PayrollRunEventQuery) GroupBy(field string, fields ...string) *PayrollRunEventGroupBy {
+	preq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PayrollRunEventGroupBy{build: preq}
+	grbuild.flds = &preq.ctx.Fields
+	grbuild.label = payrollrunevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PayrollRunEvent.Query().
+//		Select(payrollrunevent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (preq *PayrollRunEventQuery) Select(fields ...string) *PayrollRunEventSelect {
+	preq.ctx.Fields = append(preq.ctx.Fields, fields...)
+	sbuild := &PayrollRunEventSelect{PayrollRunEventQuery: preq}
+	sbuild.label = payrollrunevent.Label
+	sbuild.flds, sbuild.scan = &preq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PayrollRunEventSelect configured with the given aggregations.
+func (preq *PayrollRunEventQuery) Aggregate(fns ...AggregateFunc) *PayrollRunEventSelect {
+	return preq.Select().Aggregate(fns...)
+}
+
+func (preq *PayrollRunEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range preq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, preq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range preq.ctx.Fields {
+		if !payrollrunevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if preq.path != nil {
+		prev, err := preq.path(ctx)
+		if err != nil {
+			return err
+		}
+		preq.sql = prev
+	}
+	return nil
+}
+
+func (preq *PayrollRunEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PayrollRunEvent, error) {
+	var (
+		nodes       = []*PayrollRunEvent{}
+		_spec       = preq.querySpec()
+		loadedTypes = [1]bool{
+			preq.withPayrollRun != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PayrollRunEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PayrollRunEvent{config: preq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(preq.modifiers) > 0 {
+		_spec.Modifiers = preq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, preq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := preq.withPayrollRun; query != nil {
+		if err := preq.loadPayrollRun(ctx, query, nodes, nil,
+			func(n *PayrollRunEvent, e *PayrollRun) { n.Edges.PayrollRun = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (preq *PayrollRunEventQuery) loadPayrollRun(ctx context.Context, query *PayrollRunQuery, nodes []*PayrollRunEvent, init func(*PayrollRunEvent), assign func(*PayrollRunEvent, *PayrollRun)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*PayrollRunEvent)
+	for i := range nodes {
+		fk := nodes[i].PayrollRunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(payrollrun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payroll_run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (preq *PayrollRunEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := preq.querySpec()
+	if len(preq.modifiers) > 0 {
+		_spec.Modifiers = preq.modifiers
+	}
+	_spec.Node.Columns = preq.ctx.Fields
+	if len(preq.ctx.Fields) > 0 {
+		_spec.Unique = preq.ctx.Unique != nil && *preq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, preq.driver, _spec)
+}
+
+func (preq *PayrollRunEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(payrollrunevent.Table, payrollrunevent.Columns, sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64))
+	_spec.From = preq.sql
+	if unique := preq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if preq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := preq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollrunevent.FieldID)
+		for i := range fields {
+			if fields[i] != payrollrunevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if preq.withPayrollRun != nil {
+			_spec.Node.AddColumnOnce(payrollrunevent.FieldPayrollRunID)
+		}
+	}
+	if ps := preq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := preq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (preq *PayrollRunEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(preq.driver.Dialect())
+	t1 := builder.Table(payrollrunevent.Table)
+	columns := preq.ctx.Fields
+	if len(columns) == 0 {
+		columns = payrollrunevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if preq.sql != nil {
+		selector = preq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if preq.ctx.Unique != nil && *preq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range preq.modifiers {
+		m(selector)
+	}
+	for _, p := range preq.predicates {
+		p(selector)
+	}
+	for _, p := range preq.order {
+		p(selector)
+	}
+	if offset := preq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := preq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (preq *PayrollRunEventQuery) Modify(modifiers ...func(s *sql.Selector)) *PayrollRunEventSelect {
+	preq.modifiers = append(preq.modifiers, modifiers...)
+	return preq.Select()
+}
+
+// PayrollRunEventGroupBy is the group-by builder for PayrollRunEvent entities.
+type PayrollRunEventGroupBy struct {
+	selector
+	build *PayrollRunEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pregb *PayrollRunEventGroupBy) Aggregate(fns ...AggregateFunc) *PayrollRunEventGroupBy {
+	pregb.fns = append(pregb.fns, fns...)
+	return pregb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pregb *PayrollRunEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pregb.build.ctx, "GroupBy")
+	if err := pregb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollRunEventQuery, *PayrollRunEventGroupBy](ctx, pregb.build, pregb, pregb.build.inters, v)
+}
+
+func (pregb *PayrollRunEventGroupBy) sqlScan(ctx context.Context, root *PayrollRunEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pregb.fns))
+	for _, fn := range pregb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pregb.flds)+len(pregb.fns))
+		for _, f := range *pregb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pregb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pregb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PayrollRunEventSelect is the builder for selecting fields of PayrollRunEvent entities.
+type PayrollRunEventSelect struct {
+	*PayrollRunEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pres *PayrollRunEventSelect) Aggregate(fns ...AggregateFunc) *PayrollRunEventSelect {
+	pres.fns = append(pres.fns, fns...)
+	return pres
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pres *PayrollRunEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pres.ctx, "Select")
+	if err := pres.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayrollRunEventQuery, *PayrollRunEventSelect](ctx, pres.PayrollRunEventQuery, pres, pres.inters, v)
+}
+
+func (pres *PayrollRunEventSelect) sqlScan(ctx context.Context, root *PayrollRunEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pres.fns))
+	for _, fn := range pres.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pres.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pres.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pres *PayrollRunEventSelect) Modify(modifiers ...func(s *sql.Selector)) *PayrollRunEventSelect {
+	pres.modifiers = append(pres.modifiers, modifiers...)
+	return pres
+}
@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollRunEventUpdate is the builder for updating PayrollRunEvent entities.
+type PayrollRunEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PayrollRunEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PayrollRunEventUpdate builder.
+func (preu *PayrollRunEventUpdate) Where(ps ...predicate.PayrollRunEvent) *PayrollRunEventUpdate {
+	preu.mutation.Where(ps...)
+	return preu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (preu *PayrollRunEventUpdate) SetModifiedAt(t time.Time) *PayrollRunEventUpdate {
+	preu.mutation.SetModifiedAt(t)
+	return preu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (preu *PayrollRunEventUpdate) SetDeletedAt(t time.Time) *PayrollRunEventUpdate {
+	preu.mutation.SetDeletedAt(t)
+	return preu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (preu *PayrollRunEventUpdate) SetNillableDeletedAt(t *time.Time) *PayrollRunEventUpdate {
+	if t != nil {
+		preu.SetDeletedAt(*t)
+	}
+	return preu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (preu *PayrollRunEventUpdate) ClearDeletedAt() *PayrollRunEventUpdate {
+	preu.mutation.ClearDeletedAt()
+	return preu
+}
+
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (preu *PayrollRunEventUpdate) SetPayrollRunID(u uint64) *PayrollRunEventUpdate {
+	preu.mutation.SetPayrollRunID(u)
+	return preu
+}
+
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (preu *PayrollRunEventUpdate) SetPayrollRun(p *PayrollRun) *PayrollRunEventUpdate {
+	return preu.SetPayrollRunID(p.ID)
+}
+
+// Mutation returns the PayrollRunEventMutation object of the builder.
+func (preu *PayrollRunEventUpdate) Mutation() *PayrollRunEventMutation {
+	return preu.mutation
+}
+
+// ClearPayrollRun clears the "payroll_run" edge to the PayrollRun entity.
+func (preu *PayrollRunEventUpdate) ClearPayrollRun() *PayrollRunEventUpdate {
+	preu.mutation.ClearPayrollRun()
+	return preu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (preu *PayrollRunEventUpdate) Save(ctx context.Context) (int, error) {
+	preu.defaults()
+	return withHooks(ctx, preu.sqlSave, preu.mutation, preu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preu *PayrollRunEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := preu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (preu *PayrollRunEventUpdate) Exec(ctx context.Context) error {
+	_, err := preu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preu *PayrollRunEventUpdate) ExecX(ctx context.Context) {
+	if err := preu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (preu *PayrollRunEventUpdate) defaults() {
+	if _, ok := preu.mutation.ModifiedAt(); !ok {
+		v := payrollrunevent.UpdateDefaultModifiedAt()
+		preu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preu *PayrollRunEventUpdate) check() error {
+	if _, ok := preu.mutation.PayrollRunID(); preu.mutation.PayrollRunCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PayrollRunEvent.payroll_run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (preu *PayrollRunEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollRunEventUpdate {
+	preu.modifiers = append(preu.modifiers, modifiers...)
+	return preu
+}
+
+func (preu *PayrollRunEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := preu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollrunevent.Table, payrollrunevent.Columns, sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64))
+	if ps := preu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preu.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := preu.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if preu.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollrunevent.FieldDeletedAt, field.TypeTime)
+	}
+	if preu.mutation.FromStatusCleared() {
+		_spec.ClearField(payrollrunevent.FieldFromStatus, field.TypeEnum)
+	}
+	if preu.mutation.NotesCleared() {
+		_spec.ClearField(payrollrunevent.FieldNotes, field.TypeString)
+	}
+	if preu.mutation.PayrollRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollrunevent.PayrollRunTable,
+			Columns: []string{payrollrunevent.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := preu.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollrunevent.PayrollRunTable,
+			Columns: []string{payrollrunevent.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(preu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, preu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollrunevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	preu.mutation.done = true
+	return n, nil
+}
+
+// PayrollRunEventUpdateOne is the builder for updating a single PayrollRunEvent entity.
+type PayrollRunEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PayrollRunEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (preuo *PayrollRunEventUpdateOne) SetModifiedAt(t time.Time) *PayrollRunEventUpdateOne {
+	preuo.mutation.SetModifiedAt(t)
+	return preuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (preuo *PayrollRunEventUpdateOne) SetDeletedAt(t time.Time) *PayrollRunEventUpdateOne {
+	preuo.mutation.SetDeletedAt(t)
+	return preuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (preuo *PayrollRunEventUpdateOne) SetNillableDeletedAt(t *time.Time) *PayrollRunEventUpdateOne {
+	if t != nil {
+		preuo.SetDeletedAt(*t)
+	}
+	return preuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (preuo *PayrollRunEventUpdateOne) ClearDeletedAt() *PayrollRunEventUpdateOne {
+	preuo.mutation.ClearDeletedAt()
+	return preuo
+}
+
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (preuo *PayrollRunEventUpdateOne) SetPayrollRunID(u uint64) *PayrollRunEventUpdateOne {
+	preuo.mutation.SetPayrollRunID(u)
+	return preuo
+}
+
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (preuo *PayrollRunEventUpdateOne) SetPayrollRun(p *PayrollRun) *PayrollRunEventUpdateOne {
+	return preuo.SetPayrollRunID(p.ID)
+}
+
+// Mutation returns the PayrollRunEventMutation object of the builder.
+func (preuo *PayrollRunEventUpdateOne) Mutation() *PayrollRunEventMutation {
+	return preuo.mutation
+}
+
+// ClearPayrollRun clears the "payroll_run" edge to the PayrollRun entity.
+func (preuo *PayrollRunEventUpdateOne) ClearPayrollRun() *PayrollRunEventUpdateOne {
+	preuo.mutation.ClearPayrollRun()
+	return preuo
+}
+
+// Where appends a list predicates to the PayrollRunEventUpdate builder.
+func (preuo *PayrollRunEventUpdateOne) Where(ps ...predicate.PayrollRunEvent) *PayrollRunEventUpdateOne {
+	preuo.mutation.Where(ps...)
+	return preuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (preuo *PayrollRunEventUpdateOne) Select(field string, fields ...string) *PayrollRunEventUpdateOne {
+	preuo.fields = append([]string{field}, fields...)
+	return preuo
+}
+
+// Save executes the query and returns the updated PayrollRunEvent entity.
+func (preuo *PayrollRunEventUpdateOne) Save(ctx context.Context) (*PayrollRunEvent, error) {
+	preuo.defaults()
+	return withHooks(ctx, preuo.sqlSave, preuo.mutation, preuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (preuo *PayrollRunEventUpdateOne) SaveX(ctx context.Context) *PayrollRunEvent {
+	node, err := preuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (preuo *PayrollRunEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := preuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (preuo *PayrollRunEventUpdateOne) ExecX(ctx context.Context) {
+	if err := preuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (preuo *PayrollRunEventUpdateOne) defaults() {
+	if _, ok := preuo.mutation.ModifiedAt(); !ok {
+		v := payrollrunevent.UpdateDefaultModifiedAt()
+		preuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (preuo *PayrollRunEventUpdateOne) check() error {
+	if _, ok := preuo.mutation.PayrollRunID(); preuo.mutation.PayrollRunCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PayrollRunEvent.payroll_run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (preuo *PayrollRunEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayrollRunEventUpdateOne {
+	preuo.modifiers = append(preuo.modifiers, modifiers...)
+	return preuo
+}
+
+func (preuo *PayrollRunEventUpdateOne) sqlSave(ctx context.Context) (_node *PayrollRunEvent, err error) {
+	if err := preuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollrunevent.Table, payrollrunevent.Columns, sqlgraph.NewFieldSpec(payrollrunevent.FieldID, field.TypeUint64))
+	id, ok := preuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayrollRunEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := preuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollrunevent.FieldID)
+		for _, f := range fields {
+			if !payrollrunevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrollrunevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := preuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := preuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := preuo.mutation.DeletedAt(); ok {
+		_spec.SetField(payrollrunevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if preuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(payrollrunevent.FieldDeletedAt, field.TypeTime)
+	}
+	if preuo.mutation.FromStatusCleared() {
+		_spec.ClearField(payrollrunevent.FieldFromStatus, field.TypeEnum)
+	}
+	if preuo.mutation.NotesCleared() {
+		_spec.ClearField(payrollrunevent.FieldNotes, field.TypeString)
+	}
+	if preuo.mutation.PayrollRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollrunevent.PayrollRunTable,
+			Columns: []string{payrollrunevent.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := preuo.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payrollrunevent.PayrollRunTable,
+			Columns: []string{payrollrunevent.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(preuo.modifiers...)
+	_node = &PayrollRunEvent{config: preuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, preuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollrunevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	preuo.mutation.done = true
+	return _node, nil
+}
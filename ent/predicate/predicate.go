@@ -9,9 +9,66 @@ import (
 // Attendance is the predicate function for attendance builders.
 type Attendance func(*sql.Selector)
 
+// AttendanceAnomaly is the predicate function for attendanceanomaly builders.
+type AttendanceAnomaly func(*sql.Selector)
+
+// AttendanceCorrection is the predicate function for attendancecorrection builders.
+type AttendanceCorrection func(*sql.Selector)
+
+// AttendancePayPolicy is the predicate function for attendancepaypolicy builders.
+type AttendancePayPolicy func(*sql.Selector)
+
+// AttendancePunch is the predicate function for attendancepunch builders.
+type AttendancePunch func(*sql.Selector)
+
+// BpjsContributionRate is the predicate function for bpjscontributionrate builders.
+type BpjsContributionRate func(*sql.Selector)
+
+// CalendarFeed is the predicate function for calendarfeed builders.
+type CalendarFeed func(*sql.Selector)
+
+// Device is the predicate function for device builders.
+type Device func(*sql.Selector)
+
+// DevicePunchRejection is the predicate function for devicepunchrejection builders.
+type DevicePunchRejection func(*sql.Selector)
+
 // Employee is the predicate function for employee builders.
 type Employee func(*sql.Selector)
 
+// Holiday is the predicate function for holiday builders.
+type Holiday func(*sql.Selector)
+
+// Kiosk is the predicate function for kiosk builders.
+type Kiosk func(*sql.Selector)
+
+// LeaveBalance is the predicate function for leavebalance builders.
+type LeaveBalance func(*sql.Selector)
+
+// LeaveRequest is the predicate function for leaverequest builders.
+type LeaveRequest func(*sql.Selector)
+
+// LeaveType is the predicate function for leavetype builders.
+type LeaveType func(*sql.Selector)
+
+// OfficeLocation is the predicate function for officelocation builders.
+type OfficeLocation func(*sql.Selector)
+
+// Overtime is the predicate function for overtime builders.
+type Overtime func(*sql.Selector)
+
+// PayrollPeriod is the predicate function for payrollperiod builders.
+type PayrollPeriod func(*sql.Selector)
+
+// PayrollPeriodEvent is the predicate function for payrollperiodevent builders.
+type PayrollPeriodEvent func(*sql.Selector)
+
+// PayrollRun is the predicate function for payrollrun builders.
+type PayrollRun func(*sql.Selector)
+
+// PayrollRunEvent is the predicate function for payrollrunevent builders.
+type PayrollRunEvent func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -21,5 +78,20 @@ type RoleUser func(*sql.Selector)
 // SalaryCalculation is the predicate function for salarycalculation builders.
 type SalaryCalculation func(*sql.Selector)
 
+// SalaryCalculationItem is the predicate function for salarycalculationitem builders.
+type SalaryCalculationItem func(*sql.Selector)
+
+// SalaryComponent is the predicate function for salarycomponent builders.
+type SalaryComponent func(*sql.Selector)
+
+// SalaryComponentAssignment is the predicate function for salarycomponentassignment builders.
+type SalaryComponentAssignment func(*sql.Selector)
+
+// ShiftAssignment is the predicate function for shiftassignment builders.
+type ShiftAssignment func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WorkSchedule is the predicate function for workschedule builders.
+type WorkSchedule func(*sql.Selector)
@@ -4,13 +4,39 @@ package ent
 
 import (
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/device"
+	"mceasy/ent/devicepunchrejection"
 	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
 	"mceasy/ent/role"
 	"mceasy/ent/roleuser"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
 	"mceasy/ent/schema"
+	"mceasy/ent/shiftassignment"
 	"mceasy/ent/user"
+	"mceasy/ent/workschedule"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -33,13 +59,339 @@ func init() {
 	// attendance.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
 	attendance.UpdateDefaultModifiedAt = attendanceDescModifiedAt.UpdateDefault.(func() time.Time)
 	// attendanceDescIsWeekend is the schema descriptor for is_weekend field.
-	attendanceDescIsWeekend := attendanceFields[6].Descriptor()
+	attendanceDescIsWeekend := attendanceFields[7].Descriptor()
 	// attendance.DefaultIsWeekend holds the default value on creation for the is_weekend field.
 	attendance.DefaultIsWeekend = attendanceDescIsWeekend.Default.(bool)
 	// attendanceDescMarkedByAdmin is the schema descriptor for marked_by_admin field.
-	attendanceDescMarkedByAdmin := attendanceFields[8].Descriptor()
+	attendanceDescMarkedByAdmin := attendanceFields[9].Descriptor()
 	// attendance.DefaultMarkedByAdmin holds the default value on creation for the marked_by_admin field.
 	attendance.DefaultMarkedByAdmin = attendanceDescMarkedByAdmin.Default.(bool)
+	// attendanceDescLocationFlagged is the schema descriptor for location_flagged field.
+	attendanceDescLocationFlagged := attendanceFields[16].Descriptor()
+	// attendance.DefaultLocationFlagged holds the default value on creation for the location_flagged field.
+	attendance.DefaultLocationFlagged = attendanceDescLocationFlagged.Default.(bool)
+	// attendanceDescAutoClosed is the schema descriptor for auto_closed field.
+	attendanceDescAutoClosed := attendanceFields[17].Descriptor()
+	// attendance.DefaultAutoClosed holds the default value on creation for the auto_closed field.
+	attendance.DefaultAutoClosed = attendanceDescAutoClosed.Default.(bool)
+	// attendanceDescWorkedMinutes is the schema descriptor for worked_minutes field.
+	attendanceDescWorkedMinutes := attendanceFields[18].Descriptor()
+	// attendance.DefaultWorkedMinutes holds the default value on creation for the worked_minutes field.
+	attendance.DefaultWorkedMinutes = attendanceDescWorkedMinutes.Default.(int)
+	// attendanceDescBreakMinutes is the schema descriptor for break_minutes field.
+	attendanceDescBreakMinutes := attendanceFields[19].Descriptor()
+	// attendance.DefaultBreakMinutes holds the default value on creation for the break_minutes field.
+	attendance.DefaultBreakMinutes = attendanceDescBreakMinutes.Default.(int)
+	attendanceanomalyMixin := schema.AttendanceAnomaly{}.Mixin()
+	attendanceanomalyMixinFields0 := attendanceanomalyMixin[0].Fields()
+	_ = attendanceanomalyMixinFields0
+	attendanceanomalyFields := schema.AttendanceAnomaly{}.Fields()
+	_ = attendanceanomalyFields
+	// attendanceanomalyDescCreatedAt is the schema descriptor for created_at field.
+	attendanceanomalyDescCreatedAt := attendanceanomalyMixinFields0[0].Descriptor()
+	// attendanceanomaly.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendanceanomaly.DefaultCreatedAt = attendanceanomalyDescCreatedAt.Default.(func() time.Time)
+	// attendanceanomalyDescModifiedAt is the schema descriptor for modified_at field.
+	attendanceanomalyDescModifiedAt := attendanceanomalyMixinFields0[1].Descriptor()
+	// attendanceanomaly.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	attendanceanomaly.DefaultModifiedAt = attendanceanomalyDescModifiedAt.Default.(func() time.Time)
+	// attendanceanomaly.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	attendanceanomaly.UpdateDefaultModifiedAt = attendanceanomalyDescModifiedAt.UpdateDefault.(func() time.Time)
+	// attendanceanomalyDescDetails is the schema descriptor for details field.
+	attendanceanomalyDescDetails := attendanceanomalyFields[5].Descriptor()
+	// attendanceanomaly.DetailsValidator is a validator for the "details" field. It is called by the builders before save.
+	attendanceanomaly.DetailsValidator = attendanceanomalyDescDetails.Validators[0].(func(string) error)
+	attendancecorrectionMixin := schema.AttendanceCorrection{}.Mixin()
+	attendancecorrectionMixinFields0 := attendancecorrectionMixin[0].Fields()
+	_ = attendancecorrectionMixinFields0
+	attendancecorrectionFields := schema.AttendanceCorrection{}.Fields()
+	_ = attendancecorrectionFields
+	// attendancecorrectionDescCreatedAt is the schema descriptor for created_at field.
+	attendancecorrectionDescCreatedAt := attendancecorrectionMixinFields0[0].Descriptor()
+	// attendancecorrection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendancecorrection.DefaultCreatedAt = attendancecorrectionDescCreatedAt.Default.(func() time.Time)
+	// attendancecorrectionDescModifiedAt is the schema descriptor for modified_at field.
+	attendancecorrectionDescModifiedAt := attendancecorrectionMixinFields0[1].Descriptor()
+	// attendancecorrection.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	attendancecorrection.DefaultModifiedAt = attendancecorrectionDescModifiedAt.Default.(func() time.Time)
+	// attendancecorrection.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	attendancecorrection.UpdateDefaultModifiedAt = attendancecorrectionDescModifiedAt.UpdateDefault.(func() time.Time)
+	// attendancecorrectionDescOriginalStatus is the schema descriptor for original_status field.
+	attendancecorrectionDescOriginalStatus := attendancecorrectionFields[5].Descriptor()
+	// attendancecorrection.OriginalStatusValidator is a validator for the "original_status" field. It is called by the builders before save.
+	attendancecorrection.OriginalStatusValidator = attendancecorrectionDescOriginalStatus.Validators[0].(func(string) error)
+	// attendancecorrectionDescProposedStatus is the schema descriptor for proposed_status field.
+	attendancecorrectionDescProposedStatus := attendancecorrectionFields[8].Descriptor()
+	// attendancecorrection.ProposedStatusValidator is a validator for the "proposed_status" field. It is called by the builders before save.
+	attendancecorrection.ProposedStatusValidator = attendancecorrectionDescProposedStatus.Validators[0].(func(string) error)
+	// attendancecorrectionDescReason is the schema descriptor for reason field.
+	attendancecorrectionDescReason := attendancecorrectionFields[9].Descriptor()
+	// attendancecorrection.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	attendancecorrection.ReasonValidator = attendancecorrectionDescReason.Validators[0].(func(string) error)
+	attendancepaypolicyMixin := schema.AttendancePayPolicy{}.Mixin()
+	attendancepaypolicyMixinFields0 := attendancepaypolicyMixin[0].Fields()
+	_ = attendancepaypolicyMixinFields0
+	attendancepaypolicyFields := schema.AttendancePayPolicy{}.Fields()
+	_ = attendancepaypolicyFields
+	// attendancepaypolicyDescCreatedAt is the schema descriptor for created_at field.
+	attendancepaypolicyDescCreatedAt := attendancepaypolicyMixinFields0[0].Descriptor()
+	// attendancepaypolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendancepaypolicy.DefaultCreatedAt = attendancepaypolicyDescCreatedAt.Default.(func() time.Time)
+	// attendancepaypolicyDescModifiedAt is the schema descriptor for modified_at field.
+	attendancepaypolicyDescModifiedAt := attendancepaypolicyMixinFields0[1].Descriptor()
+	// attendancepaypolicy.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	attendancepaypolicy.DefaultModifiedAt = attendancepaypolicyDescModifiedAt.Default.(func() time.Time)
+	// attendancepaypolicy.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	attendancepaypolicy.UpdateDefaultModifiedAt = attendancepaypolicyDescModifiedAt.UpdateDefault.(func() time.Time)
+	// attendancepaypolicyDescName is the schema descriptor for name field.
+	attendancepaypolicyDescName := attendancepaypolicyFields[1].Descriptor()
+	// attendancepaypolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attendancepaypolicy.NameValidator = func() func(string) error {
+		validators := attendancepaypolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attendancepaypolicyDescPresentWeight is the schema descriptor for present_weight field.
+	attendancepaypolicyDescPresentWeight := attendancepaypolicyFields[2].Descriptor()
+	// attendancepaypolicy.DefaultPresentWeight holds the default value on creation for the present_weight field.
+	attendancepaypolicy.DefaultPresentWeight = attendancepaypolicyDescPresentWeight.Default.(decimal.Decimal)
+	// attendancepaypolicyDescLateWeight is the schema descriptor for late_weight field.
+	attendancepaypolicyDescLateWeight := attendancepaypolicyFields[3].Descriptor()
+	// attendancepaypolicy.DefaultLateWeight holds the default value on creation for the late_weight field.
+	attendancepaypolicy.DefaultLateWeight = attendancepaypolicyDescLateWeight.Default.(decimal.Decimal)
+	// attendancepaypolicyDescHalfDayWeight is the schema descriptor for half_day_weight field.
+	attendancepaypolicyDescHalfDayWeight := attendancepaypolicyFields[4].Descriptor()
+	// attendancepaypolicy.DefaultHalfDayWeight holds the default value on creation for the half_day_weight field.
+	attendancepaypolicy.DefaultHalfDayWeight = attendancepaypolicyDescHalfDayWeight.Default.(decimal.Decimal)
+	// attendancepaypolicyDescLatePenaltyAmount is the schema descriptor for late_penalty_amount field.
+	attendancepaypolicyDescLatePenaltyAmount := attendancepaypolicyFields[5].Descriptor()
+	// attendancepaypolicy.DefaultLatePenaltyAmount holds the default value on creation for the late_penalty_amount field.
+	attendancepaypolicy.DefaultLatePenaltyAmount = attendancepaypolicyDescLatePenaltyAmount.Default.(decimal.Decimal)
+	// attendancepaypolicyDescLatePenaltyFreeDays is the schema descriptor for late_penalty_free_days field.
+	attendancepaypolicyDescLatePenaltyFreeDays := attendancepaypolicyFields[6].Descriptor()
+	// attendancepaypolicy.DefaultLatePenaltyFreeDays holds the default value on creation for the late_penalty_free_days field.
+	attendancepaypolicy.DefaultLatePenaltyFreeDays = attendancepaypolicyDescLatePenaltyFreeDays.Default.(int)
+	// attendancepaypolicy.LatePenaltyFreeDaysValidator is a validator for the "late_penalty_free_days" field. It is called by the builders before save.
+	attendancepaypolicy.LatePenaltyFreeDaysValidator = attendancepaypolicyDescLatePenaltyFreeDays.Validators[0].(func(int) error)
+	// attendancepaypolicyDescIsDefault is the schema descriptor for is_default field.
+	attendancepaypolicyDescIsDefault := attendancepaypolicyFields[7].Descriptor()
+	// attendancepaypolicy.DefaultIsDefault holds the default value on creation for the is_default field.
+	attendancepaypolicy.DefaultIsDefault = attendancepaypolicyDescIsDefault.Default.(bool)
+	attendancepunchMixin := schema.AttendancePunch{}.Mixin()
+	attendancepunchMixinFields0 := attendancepunchMixin[0].Fields()
+	_ = attendancepunchMixinFields0
+	attendancepunchFields := schema.AttendancePunch{}.Fields()
+	_ = attendancepunchFields
+	// attendancepunchDescCreatedAt is the schema descriptor for created_at field.
+	attendancepunchDescCreatedAt := attendancepunchMixinFields0[0].Descriptor()
+	// attendancepunch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendancepunch.DefaultCreatedAt = attendancepunchDescCreatedAt.Default.(func() time.Time)
+	// attendancepunchDescModifiedAt is the schema descriptor for modified_at field.
+	attendancepunchDescModifiedAt := attendancepunchMixinFields0[1].Descriptor()
+	// attendancepunch.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	attendancepunch.DefaultModifiedAt = attendancepunchDescModifiedAt.Default.(func() time.Time)
+	// attendancepunch.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	attendancepunch.UpdateDefaultModifiedAt = attendancepunchDescModifiedAt.UpdateDefault.(func() time.Time)
+	bpjscontributionrateMixin := schema.BpjsContributionRate{}.Mixin()
+	bpjscontributionrateMixinFields0 := bpjscontributionrateMixin[0].Fields()
+	_ = bpjscontributionrateMixinFields0
+	bpjscontributionrateFields := schema.BpjsContributionRate{}.Fields()
+	_ = bpjscontributionrateFields
+	// bpjscontributionrateDescCreatedAt is the schema descriptor for created_at field.
+	bpjscontributionrateDescCreatedAt := bpjscontributionrateMixinFields0[0].Descriptor()
+	// bpjscontributionrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bpjscontributionrate.DefaultCreatedAt = bpjscontributionrateDescCreatedAt.Default.(func() time.Time)
+	// bpjscontributionrateDescModifiedAt is the schema descriptor for modified_at field.
+	bpjscontributionrateDescModifiedAt := bpjscontributionrateMixinFields0[1].Descriptor()
+	// bpjscontributionrate.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	bpjscontributionrate.DefaultModifiedAt = bpjscontributionrateDescModifiedAt.Default.(func() time.Time)
+	// bpjscontributionrate.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	bpjscontributionrate.UpdateDefaultModifiedAt = bpjscontributionrateDescModifiedAt.UpdateDefault.(func() time.Time)
+	// bpjscontributionrateDescRiskClass is the schema descriptor for risk_class field.
+	bpjscontributionrateDescRiskClass := bpjscontributionrateFields[2].Descriptor()
+	// bpjscontributionrate.DefaultRiskClass holds the default value on creation for the risk_class field.
+	bpjscontributionrate.DefaultRiskClass = bpjscontributionrateDescRiskClass.Default.(int)
+	// bpjscontributionrate.RiskClassValidator is a validator for the "risk_class" field. It is called by the builders before save.
+	bpjscontributionrate.RiskClassValidator = bpjscontributionrateDescRiskClass.Validators[0].(func(int) error)
+	// bpjscontributionrateDescEmployerRate is the schema descriptor for employer_rate field.
+	bpjscontributionrateDescEmployerRate := bpjscontributionrateFields[4].Descriptor()
+	// bpjscontributionrate.DefaultEmployerRate holds the default value on creation for the employer_rate field.
+	bpjscontributionrate.DefaultEmployerRate = bpjscontributionrateDescEmployerRate.Default.(decimal.Decimal)
+	// bpjscontributionrateDescEmployeeRate is the schema descriptor for employee_rate field.
+	bpjscontributionrateDescEmployeeRate := bpjscontributionrateFields[5].Descriptor()
+	// bpjscontributionrate.DefaultEmployeeRate holds the default value on creation for the employee_rate field.
+	bpjscontributionrate.DefaultEmployeeRate = bpjscontributionrateDescEmployeeRate.Default.(decimal.Decimal)
+	calendarfeedMixin := schema.CalendarFeed{}.Mixin()
+	calendarfeedMixinFields0 := calendarfeedMixin[0].Fields()
+	_ = calendarfeedMixinFields0
+	calendarfeedFields := schema.CalendarFeed{}.Fields()
+	_ = calendarfeedFields
+	// calendarfeedDescCreatedAt is the schema descriptor for created_at field.
+	calendarfeedDescCreatedAt := calendarfeedMixinFields0[0].Descriptor()
+	// calendarfeed.DefaultCreatedAt holds the default value on creation for the created_at field.
+	calendarfeed.DefaultCreatedAt = calendarfeedDescCreatedAt.Default.(func() time.Time)
+	// calendarfeedDescModifiedAt is the schema descriptor for modified_at field.
+	calendarfeedDescModifiedAt := calendarfeedMixinFields0[1].Descriptor()
+	// calendarfeed.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	calendarfeed.DefaultModifiedAt = calendarfeedDescModifiedAt.Default.(func() time.Time)
+	// calendarfeed.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	calendarfeed.UpdateDefaultModifiedAt = calendarfeedDescModifiedAt.UpdateDefault.(func() time.Time)
+	// calendarfeedDescName is the schema descriptor for name field.
+	calendarfeedDescName := calendarfeedFields[1].Descriptor()
+	// calendarfeed.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	calendarfeed.NameValidator = func() func(string) error {
+		validators := calendarfeedDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// calendarfeedDescDepartment is the schema descriptor for department field.
+	calendarfeedDescDepartment := calendarfeedFields[4].Descriptor()
+	// calendarfeed.DepartmentValidator is a validator for the "department" field. It is called by the builders before save.
+	calendarfeed.DepartmentValidator = calendarfeedDescDepartment.Validators[0].(func(string) error)
+	// calendarfeedDescToken is the schema descriptor for token field.
+	calendarfeedDescToken := calendarfeedFields[5].Descriptor()
+	// calendarfeed.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	calendarfeed.TokenValidator = func() func(string) error {
+		validators := calendarfeedDescToken.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token string) error {
+			for _, fn := range fns {
+				if err := fn(token); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// calendarfeedDescIsActive is the schema descriptor for is_active field.
+	calendarfeedDescIsActive := calendarfeedFields[6].Descriptor()
+	// calendarfeed.DefaultIsActive holds the default value on creation for the is_active field.
+	calendarfeed.DefaultIsActive = calendarfeedDescIsActive.Default.(bool)
+	deviceMixin := schema.Device{}.Mixin()
+	deviceMixinFields0 := deviceMixin[0].Fields()
+	_ = deviceMixinFields0
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescCreatedAt is the schema descriptor for created_at field.
+	deviceDescCreatedAt := deviceMixinFields0[0].Descriptor()
+	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
+	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
+	// deviceDescModifiedAt is the schema descriptor for modified_at field.
+	deviceDescModifiedAt := deviceMixinFields0[1].Descriptor()
+	// device.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	device.DefaultModifiedAt = deviceDescModifiedAt.Default.(func() time.Time)
+	// device.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	device.UpdateDefaultModifiedAt = deviceDescModifiedAt.UpdateDefault.(func() time.Time)
+	// deviceDescSerialNumber is the schema descriptor for serial_number field.
+	deviceDescSerialNumber := deviceFields[1].Descriptor()
+	// device.SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	device.SerialNumberValidator = func() func(string) error {
+		validators := deviceDescSerialNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(serial_number string) error {
+			for _, fn := range fns {
+				if err := fn(serial_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deviceDescName is the schema descriptor for name field.
+	deviceDescName := deviceFields[2].Descriptor()
+	// device.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	device.NameValidator = deviceDescName.Validators[0].(func(string) error)
+	// deviceDescTimezone is the schema descriptor for timezone field.
+	deviceDescTimezone := deviceFields[5].Descriptor()
+	// device.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	device.TimezoneValidator = deviceDescTimezone.Validators[0].(func(string) error)
+	// deviceDescPushVersion is the schema descriptor for push_version field.
+	deviceDescPushVersion := deviceFields[6].Descriptor()
+	// device.PushVersionValidator is a validator for the "push_version" field. It is called by the builders before save.
+	device.PushVersionValidator = deviceDescPushVersion.Validators[0].(func(string) error)
+	// deviceDescDeviceInfo is the schema descriptor for device_info field.
+	deviceDescDeviceInfo := deviceFields[7].Descriptor()
+	// device.DeviceInfoValidator is a validator for the "device_info" field. It is called by the builders before save.
+	device.DeviceInfoValidator = deviceDescDeviceInfo.Validators[0].(func(string) error)
+	// deviceDescIPAddress is the schema descriptor for ip_address field.
+	deviceDescIPAddress := deviceFields[8].Descriptor()
+	// device.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	device.IPAddressValidator = deviceDescIPAddress.Validators[0].(func(string) error)
+	// deviceDescAttlogStamp is the schema descriptor for attlog_stamp field.
+	deviceDescAttlogStamp := deviceFields[10].Descriptor()
+	// device.AttlogStampValidator is a validator for the "attlog_stamp" field. It is called by the builders before save.
+	device.AttlogStampValidator = deviceDescAttlogStamp.Validators[0].(func(string) error)
+	devicepunchrejectionMixin := schema.DevicePunchRejection{}.Mixin()
+	devicepunchrejectionMixinFields0 := devicepunchrejectionMixin[0].Fields()
+	_ = devicepunchrejectionMixinFields0
+	devicepunchrejectionFields := schema.DevicePunchRejection{}.Fields()
+	_ = devicepunchrejectionFields
+	// devicepunchrejectionDescCreatedAt is the schema descriptor for created_at field.
+	devicepunchrejectionDescCreatedAt := devicepunchrejectionMixinFields0[0].Descriptor()
+	// devicepunchrejection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicepunchrejection.DefaultCreatedAt = devicepunchrejectionDescCreatedAt.Default.(func() time.Time)
+	// devicepunchrejectionDescModifiedAt is the schema descriptor for modified_at field.
+	devicepunchrejectionDescModifiedAt := devicepunchrejectionMixinFields0[1].Descriptor()
+	// devicepunchrejection.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	devicepunchrejection.DefaultModifiedAt = devicepunchrejectionDescModifiedAt.Default.(func() time.Time)
+	// devicepunchrejection.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	devicepunchrejection.UpdateDefaultModifiedAt = devicepunchrejectionDescModifiedAt.UpdateDefault.(func() time.Time)
+	// devicepunchrejectionDescDevicePin is the schema descriptor for device_pin field.
+	devicepunchrejectionDescDevicePin := devicepunchrejectionFields[2].Descriptor()
+	// devicepunchrejection.DevicePinValidator is a validator for the "device_pin" field. It is called by the builders before save.
+	devicepunchrejection.DevicePinValidator = func() func(string) error {
+		validators := devicepunchrejectionDescDevicePin.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(device_pin string) error {
+			for _, fn := range fns {
+				if err := fn(device_pin); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// devicepunchrejectionDescPunchState is the schema descriptor for punch_state field.
+	devicepunchrejectionDescPunchState := devicepunchrejectionFields[4].Descriptor()
+	// devicepunchrejection.DefaultPunchState holds the default value on creation for the punch_state field.
+	devicepunchrejection.DefaultPunchState = devicepunchrejectionDescPunchState.Default.(int)
+	// devicepunchrejectionDescVerifyMode is the schema descriptor for verify_mode field.
+	devicepunchrejectionDescVerifyMode := devicepunchrejectionFields[5].Descriptor()
+	// devicepunchrejection.DefaultVerifyMode holds the default value on creation for the verify_mode field.
+	devicepunchrejection.DefaultVerifyMode = devicepunchrejectionDescVerifyMode.Default.(int)
+	// devicepunchrejectionDescReason is the schema descriptor for reason field.
+	devicepunchrejectionDescReason := devicepunchrejectionFields[6].Descriptor()
+	// devicepunchrejection.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	devicepunchrejection.ReasonValidator = devicepunchrejectionDescReason.Validators[0].(func(string) error)
 	employeeMixin := schema.Employee{}.Mixin()
 	employeeMixinFields0 := employeeMixin[0].Fields()
 	_ = employeeMixinFields0
@@ -110,11 +462,398 @@ func init() {
 	// employeeDescBaseSalary is the schema descriptor for base_salary field.
 	employeeDescBaseSalary := employeeFields[8].Descriptor()
 	// employee.DefaultBaseSalary holds the default value on creation for the base_salary field.
-	employee.DefaultBaseSalary = employeeDescBaseSalary.Default.(float64)
+	employee.DefaultBaseSalary = employeeDescBaseSalary.Default.(decimal.Decimal)
 	// employeeDescIsActive is the schema descriptor for is_active field.
 	employeeDescIsActive := employeeFields[9].Descriptor()
 	// employee.DefaultIsActive holds the default value on creation for the is_active field.
 	employee.DefaultIsActive = employeeDescIsActive.Default.(bool)
+	// employeeDescTimezone is the schema descriptor for timezone field.
+	employeeDescTimezone := employeeFields[11].Descriptor()
+	// employee.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	employee.TimezoneValidator = employeeDescTimezone.Validators[0].(func(string) error)
+	// employeeDescDevicePin is the schema descriptor for device_pin field.
+	employeeDescDevicePin := employeeFields[12].Descriptor()
+	// employee.DevicePinValidator is a validator for the "device_pin" field. It is called by the builders before save.
+	employee.DevicePinValidator = employeeDescDevicePin.Validators[0].(func(string) error)
+	// employeeDescNpwp is the schema descriptor for npwp field.
+	employeeDescNpwp := employeeFields[14].Descriptor()
+	// employee.NpwpValidator is a validator for the "npwp" field. It is called by the builders before save.
+	employee.NpwpValidator = employeeDescNpwp.Validators[0].(func(string) error)
+	// employeeDescJkkRiskClass is the schema descriptor for jkk_risk_class field.
+	employeeDescJkkRiskClass := employeeFields[15].Descriptor()
+	// employee.DefaultJkkRiskClass holds the default value on creation for the jkk_risk_class field.
+	employee.DefaultJkkRiskClass = employeeDescJkkRiskClass.Default.(int)
+	// employee.JkkRiskClassValidator is a validator for the "jkk_risk_class" field. It is called by the builders before save.
+	employee.JkkRiskClassValidator = employeeDescJkkRiskClass.Validators[0].(func(int) error)
+	holidayMixin := schema.Holiday{}.Mixin()
+	holidayMixinFields0 := holidayMixin[0].Fields()
+	_ = holidayMixinFields0
+	holidayFields := schema.Holiday{}.Fields()
+	_ = holidayFields
+	// holidayDescCreatedAt is the schema descriptor for created_at field.
+	holidayDescCreatedAt := holidayMixinFields0[0].Descriptor()
+	// holiday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	holiday.DefaultCreatedAt = holidayDescCreatedAt.Default.(func() time.Time)
+	// holidayDescModifiedAt is the schema descriptor for modified_at field.
+	holidayDescModifiedAt := holidayMixinFields0[1].Descriptor()
+	// holiday.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	holiday.DefaultModifiedAt = holidayDescModifiedAt.Default.(func() time.Time)
+	// holiday.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	holiday.UpdateDefaultModifiedAt = holidayDescModifiedAt.UpdateDefault.(func() time.Time)
+	// holidayDescName is the schema descriptor for name field.
+	holidayDescName := holidayFields[2].Descriptor()
+	// holiday.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holiday.NameValidator = func() func(string) error {
+		validators := holidayDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	kioskMixin := schema.Kiosk{}.Mixin()
+	kioskMixinFields0 := kioskMixin[0].Fields()
+	_ = kioskMixinFields0
+	kioskFields := schema.Kiosk{}.Fields()
+	_ = kioskFields
+	// kioskDescCreatedAt is the schema descriptor for created_at field.
+	kioskDescCreatedAt := kioskMixinFields0[0].Descriptor()
+	// kiosk.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kiosk.DefaultCreatedAt = kioskDescCreatedAt.Default.(func() time.Time)
+	// kioskDescModifiedAt is the schema descriptor for modified_at field.
+	kioskDescModifiedAt := kioskMixinFields0[1].Descriptor()
+	// kiosk.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	kiosk.DefaultModifiedAt = kioskDescModifiedAt.Default.(func() time.Time)
+	// kiosk.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	kiosk.UpdateDefaultModifiedAt = kioskDescModifiedAt.UpdateDefault.(func() time.Time)
+	// kioskDescName is the schema descriptor for name field.
+	kioskDescName := kioskFields[1].Descriptor()
+	// kiosk.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	kiosk.NameValidator = func() func(string) error {
+		validators := kioskDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// kioskDescSecret is the schema descriptor for secret field.
+	kioskDescSecret := kioskFields[2].Descriptor()
+	// kiosk.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	kiosk.SecretValidator = func() func(string) error {
+		validators := kioskDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// kioskDescDeviceTokenHash is the schema descriptor for device_token_hash field.
+	kioskDescDeviceTokenHash := kioskFields[3].Descriptor()
+	// kiosk.DeviceTokenHashValidator is a validator for the "device_token_hash" field. It is called by the builders before save.
+	kiosk.DeviceTokenHashValidator = kioskDescDeviceTokenHash.Validators[0].(func(string) error)
+	// kioskDescIsActive is the schema descriptor for is_active field.
+	kioskDescIsActive := kioskFields[5].Descriptor()
+	// kiosk.DefaultIsActive holds the default value on creation for the is_active field.
+	kiosk.DefaultIsActive = kioskDescIsActive.Default.(bool)
+	leavebalanceMixin := schema.LeaveBalance{}.Mixin()
+	leavebalanceMixinFields0 := leavebalanceMixin[0].Fields()
+	_ = leavebalanceMixinFields0
+	leavebalanceFields := schema.LeaveBalance{}.Fields()
+	_ = leavebalanceFields
+	// leavebalanceDescCreatedAt is the schema descriptor for created_at field.
+	leavebalanceDescCreatedAt := leavebalanceMixinFields0[0].Descriptor()
+	// leavebalance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leavebalance.DefaultCreatedAt = leavebalanceDescCreatedAt.Default.(func() time.Time)
+	// leavebalanceDescModifiedAt is the schema descriptor for modified_at field.
+	leavebalanceDescModifiedAt := leavebalanceMixinFields0[1].Descriptor()
+	// leavebalance.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	leavebalance.DefaultModifiedAt = leavebalanceDescModifiedAt.Default.(func() time.Time)
+	// leavebalance.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	leavebalance.UpdateDefaultModifiedAt = leavebalanceDescModifiedAt.UpdateDefault.(func() time.Time)
+	// leavebalanceDescEntitledDays is the schema descriptor for entitled_days field.
+	leavebalanceDescEntitledDays := leavebalanceFields[4].Descriptor()
+	// leavebalance.DefaultEntitledDays holds the default value on creation for the entitled_days field.
+	leavebalance.DefaultEntitledDays = leavebalanceDescEntitledDays.Default.(int)
+	// leavebalance.EntitledDaysValidator is a validator for the "entitled_days" field. It is called by the builders before save.
+	leavebalance.EntitledDaysValidator = leavebalanceDescEntitledDays.Validators[0].(func(int) error)
+	// leavebalanceDescUsedDays is the schema descriptor for used_days field.
+	leavebalanceDescUsedDays := leavebalanceFields[5].Descriptor()
+	// leavebalance.DefaultUsedDays holds the default value on creation for the used_days field.
+	leavebalance.DefaultUsedDays = leavebalanceDescUsedDays.Default.(int)
+	// leavebalance.UsedDaysValidator is a validator for the "used_days" field. It is called by the builders before save.
+	leavebalance.UsedDaysValidator = leavebalanceDescUsedDays.Validators[0].(func(int) error)
+	leaverequestMixin := schema.LeaveRequest{}.Mixin()
+	leaverequestMixinFields0 := leaverequestMixin[0].Fields()
+	_ = leaverequestMixinFields0
+	leaverequestFields := schema.LeaveRequest{}.Fields()
+	_ = leaverequestFields
+	// leaverequestDescCreatedAt is the schema descriptor for created_at field.
+	leaverequestDescCreatedAt := leaverequestMixinFields0[0].Descriptor()
+	// leaverequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leaverequest.DefaultCreatedAt = leaverequestDescCreatedAt.Default.(func() time.Time)
+	// leaverequestDescModifiedAt is the schema descriptor for modified_at field.
+	leaverequestDescModifiedAt := leaverequestMixinFields0[1].Descriptor()
+	// leaverequest.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	leaverequest.DefaultModifiedAt = leaverequestDescModifiedAt.Default.(func() time.Time)
+	// leaverequest.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	leaverequest.UpdateDefaultModifiedAt = leaverequestDescModifiedAt.UpdateDefault.(func() time.Time)
+	// leaverequestDescTotalDays is the schema descriptor for total_days field.
+	leaverequestDescTotalDays := leaverequestFields[5].Descriptor()
+	// leaverequest.DefaultTotalDays holds the default value on creation for the total_days field.
+	leaverequest.DefaultTotalDays = leaverequestDescTotalDays.Default.(int)
+	leavetypeMixin := schema.LeaveType{}.Mixin()
+	leavetypeMixinFields0 := leavetypeMixin[0].Fields()
+	_ = leavetypeMixinFields0
+	leavetypeFields := schema.LeaveType{}.Fields()
+	_ = leavetypeFields
+	// leavetypeDescCreatedAt is the schema descriptor for created_at field.
+	leavetypeDescCreatedAt := leavetypeMixinFields0[0].Descriptor()
+	// leavetype.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leavetype.DefaultCreatedAt = leavetypeDescCreatedAt.Default.(func() time.Time)
+	// leavetypeDescModifiedAt is the schema descriptor for modified_at field.
+	leavetypeDescModifiedAt := leavetypeMixinFields0[1].Descriptor()
+	// leavetype.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	leavetype.DefaultModifiedAt = leavetypeDescModifiedAt.Default.(func() time.Time)
+	// leavetype.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	leavetype.UpdateDefaultModifiedAt = leavetypeDescModifiedAt.UpdateDefault.(func() time.Time)
+	// leavetypeDescCode is the schema descriptor for code field.
+	leavetypeDescCode := leavetypeFields[1].Descriptor()
+	// leavetype.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	leavetype.CodeValidator = func() func(string) error {
+		validators := leavetypeDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// leavetypeDescName is the schema descriptor for name field.
+	leavetypeDescName := leavetypeFields[2].Descriptor()
+	// leavetype.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	leavetype.NameValidator = func() func(string) error {
+		validators := leavetypeDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// leavetypeDescIsPaid is the schema descriptor for is_paid field.
+	leavetypeDescIsPaid := leavetypeFields[3].Descriptor()
+	// leavetype.DefaultIsPaid holds the default value on creation for the is_paid field.
+	leavetype.DefaultIsPaid = leavetypeDescIsPaid.Default.(bool)
+	// leavetypeDescAnnualQuota is the schema descriptor for annual_quota field.
+	leavetypeDescAnnualQuota := leavetypeFields[4].Descriptor()
+	// leavetype.DefaultAnnualQuota holds the default value on creation for the annual_quota field.
+	leavetype.DefaultAnnualQuota = leavetypeDescAnnualQuota.Default.(int)
+	// leavetype.AnnualQuotaValidator is a validator for the "annual_quota" field. It is called by the builders before save.
+	leavetype.AnnualQuotaValidator = leavetypeDescAnnualQuota.Validators[0].(func(int) error)
+	// leavetypeDescIsActive is the schema descriptor for is_active field.
+	leavetypeDescIsActive := leavetypeFields[5].Descriptor()
+	// leavetype.DefaultIsActive holds the default value on creation for the is_active field.
+	leavetype.DefaultIsActive = leavetypeDescIsActive.Default.(bool)
+	officelocationMixin := schema.OfficeLocation{}.Mixin()
+	officelocationMixinFields0 := officelocationMixin[0].Fields()
+	_ = officelocationMixinFields0
+	officelocationFields := schema.OfficeLocation{}.Fields()
+	_ = officelocationFields
+	// officelocationDescCreatedAt is the schema descriptor for created_at field.
+	officelocationDescCreatedAt := officelocationMixinFields0[0].Descriptor()
+	// officelocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	officelocation.DefaultCreatedAt = officelocationDescCreatedAt.Default.(func() time.Time)
+	// officelocationDescModifiedAt is the schema descriptor for modified_at field.
+	officelocationDescModifiedAt := officelocationMixinFields0[1].Descriptor()
+	// officelocation.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	officelocation.DefaultModifiedAt = officelocationDescModifiedAt.Default.(func() time.Time)
+	// officelocation.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	officelocation.UpdateDefaultModifiedAt = officelocationDescModifiedAt.UpdateDefault.(func() time.Time)
+	// officelocationDescName is the schema descriptor for name field.
+	officelocationDescName := officelocationFields[1].Descriptor()
+	// officelocation.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	officelocation.NameValidator = func() func(string) error {
+		validators := officelocationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// officelocationDescLatitude is the schema descriptor for latitude field.
+	officelocationDescLatitude := officelocationFields[3].Descriptor()
+	// officelocation.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	officelocation.LatitudeValidator = func() func(float64) error {
+		validators := officelocationDescLatitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(latitude float64) error {
+			for _, fn := range fns {
+				if err := fn(latitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// officelocationDescLongitude is the schema descriptor for longitude field.
+	officelocationDescLongitude := officelocationFields[4].Descriptor()
+	// officelocation.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	officelocation.LongitudeValidator = func() func(float64) error {
+		validators := officelocationDescLongitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(longitude float64) error {
+			for _, fn := range fns {
+				if err := fn(longitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// officelocationDescRadiusMeters is the schema descriptor for radius_meters field.
+	officelocationDescRadiusMeters := officelocationFields[5].Descriptor()
+	// officelocation.DefaultRadiusMeters holds the default value on creation for the radius_meters field.
+	officelocation.DefaultRadiusMeters = officelocationDescRadiusMeters.Default.(int)
+	// officelocation.RadiusMetersValidator is a validator for the "radius_meters" field. It is called by the builders before save.
+	officelocation.RadiusMetersValidator = officelocationDescRadiusMeters.Validators[0].(func(int) error)
+	// officelocationDescTimezone is the schema descriptor for timezone field.
+	officelocationDescTimezone := officelocationFields[7].Descriptor()
+	// officelocation.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	officelocation.TimezoneValidator = officelocationDescTimezone.Validators[0].(func(string) error)
+	// officelocationDescIsActive is the schema descriptor for is_active field.
+	officelocationDescIsActive := officelocationFields[8].Descriptor()
+	// officelocation.DefaultIsActive holds the default value on creation for the is_active field.
+	officelocation.DefaultIsActive = officelocationDescIsActive.Default.(bool)
+	overtimeMixin := schema.Overtime{}.Mixin()
+	overtimeMixinFields0 := overtimeMixin[0].Fields()
+	_ = overtimeMixinFields0
+	overtimeFields := schema.Overtime{}.Fields()
+	_ = overtimeFields
+	// overtimeDescCreatedAt is the schema descriptor for created_at field.
+	overtimeDescCreatedAt := overtimeMixinFields0[0].Descriptor()
+	// overtime.DefaultCreatedAt holds the default value on creation for the created_at field.
+	overtime.DefaultCreatedAt = overtimeDescCreatedAt.Default.(func() time.Time)
+	// overtimeDescModifiedAt is the schema descriptor for modified_at field.
+	overtimeDescModifiedAt := overtimeMixinFields0[1].Descriptor()
+	// overtime.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	overtime.DefaultModifiedAt = overtimeDescModifiedAt.Default.(func() time.Time)
+	// overtime.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	overtime.UpdateDefaultModifiedAt = overtimeDescModifiedAt.UpdateDefault.(func() time.Time)
+	// overtimeDescMinutes is the schema descriptor for minutes field.
+	overtimeDescMinutes := overtimeFields[6].Descriptor()
+	// overtime.MinutesValidator is a validator for the "minutes" field. It is called by the builders before save.
+	overtime.MinutesValidator = overtimeDescMinutes.Validators[0].(func(int) error)
+	// overtimeDescIsRestDay is the schema descriptor for is_rest_day field.
+	overtimeDescIsRestDay := overtimeFields[8].Descriptor()
+	// overtime.DefaultIsRestDay holds the default value on creation for the is_rest_day field.
+	overtime.DefaultIsRestDay = overtimeDescIsRestDay.Default.(bool)
+	payrollperiodMixin := schema.PayrollPeriod{}.Mixin()
+	payrollperiodMixinFields0 := payrollperiodMixin[0].Fields()
+	_ = payrollperiodMixinFields0
+	payrollperiodFields := schema.PayrollPeriod{}.Fields()
+	_ = payrollperiodFields
+	// payrollperiodDescCreatedAt is the schema descriptor for created_at field.
+	payrollperiodDescCreatedAt := payrollperiodMixinFields0[0].Descriptor()
+	// payrollperiod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrollperiod.DefaultCreatedAt = payrollperiodDescCreatedAt.Default.(func() time.Time)
+	// payrollperiodDescModifiedAt is the schema descriptor for modified_at field.
+	payrollperiodDescModifiedAt := payrollperiodMixinFields0[1].Descriptor()
+	// payrollperiod.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	payrollperiod.DefaultModifiedAt = payrollperiodDescModifiedAt.Default.(func() time.Time)
+	// payrollperiod.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	payrollperiod.UpdateDefaultModifiedAt = payrollperiodDescModifiedAt.UpdateDefault.(func() time.Time)
+	payrollperiodeventMixin := schema.PayrollPeriodEvent{}.Mixin()
+	payrollperiodeventMixinFields0 := payrollperiodeventMixin[0].Fields()
+	_ = payrollperiodeventMixinFields0
+	payrollperiodeventFields := schema.PayrollPeriodEvent{}.Fields()
+	_ = payrollperiodeventFields
+	// payrollperiodeventDescCreatedAt is the schema descriptor for created_at field.
+	payrollperiodeventDescCreatedAt := payrollperiodeventMixinFields0[0].Descriptor()
+	// payrollperiodevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrollperiodevent.DefaultCreatedAt = payrollperiodeventDescCreatedAt.Default.(func() time.Time)
+	// payrollperiodeventDescModifiedAt is the schema descriptor for modified_at field.
+	payrollperiodeventDescModifiedAt := payrollperiodeventMixinFields0[1].Descriptor()
+	// payrollperiodevent.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	payrollperiodevent.DefaultModifiedAt = payrollperiodeventDescModifiedAt.Default.(func() time.Time)
+	// payrollperiodevent.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	payrollperiodevent.UpdateDefaultModifiedAt = payrollperiodeventDescModifiedAt.UpdateDefault.(func() time.Time)
+	payrollrunMixin := schema.PayrollRun{}.Mixin()
+	payrollrunMixinFields0 := payrollrunMixin[0].Fields()
+	_ = payrollrunMixinFields0
+	payrollrunFields := schema.PayrollRun{}.Fields()
+	_ = payrollrunFields
+	// payrollrunDescCreatedAt is the schema descriptor for created_at field.
+	payrollrunDescCreatedAt := payrollrunMixinFields0[0].Descriptor()
+	// payrollrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrollrun.DefaultCreatedAt = payrollrunDescCreatedAt.Default.(func() time.Time)
+	// payrollrunDescModifiedAt is the schema descriptor for modified_at field.
+	payrollrunDescModifiedAt := payrollrunMixinFields0[1].Descriptor()
+	// payrollrun.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	payrollrun.DefaultModifiedAt = payrollrunDescModifiedAt.Default.(func() time.Time)
+	// payrollrun.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	payrollrun.UpdateDefaultModifiedAt = payrollrunDescModifiedAt.UpdateDefault.(func() time.Time)
+	payrollruneventMixin := schema.PayrollRunEvent{}.Mixin()
+	payrollruneventMixinFields0 := payrollruneventMixin[0].Fields()
+	_ = payrollruneventMixinFields0
+	payrollruneventFields := schema.PayrollRunEvent{}.Fields()
+	_ = payrollruneventFields
+	// payrollruneventDescCreatedAt is the schema descriptor for created_at field.
+	payrollruneventDescCreatedAt := payrollruneventMixinFields0[0].Descriptor()
+	// payrollrunevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrollrunevent.DefaultCreatedAt = payrollruneventDescCreatedAt.Default.(func() time.Time)
+	// payrollruneventDescModifiedAt is the schema descriptor for modified_at field.
+	payrollruneventDescModifiedAt := payrollruneventMixinFields0[1].Descriptor()
+	// payrollrunevent.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	payrollrunevent.DefaultModifiedAt = payrollruneventDescModifiedAt.Default.(func() time.Time)
+	// payrollrunevent.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	payrollrunevent.UpdateDefaultModifiedAt = payrollruneventDescModifiedAt.UpdateDefault.(func() time.Time)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
@@ -161,17 +900,197 @@ func init() {
 	// salarycalculation.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
 	salarycalculation.UpdateDefaultModifiedAt = salarycalculationDescModifiedAt.UpdateDefault.(func() time.Time)
 	// salarycalculationDescAbsentDays is the schema descriptor for absent_days field.
-	salarycalculationDescAbsentDays := salarycalculationFields[5].Descriptor()
+	salarycalculationDescAbsentDays := salarycalculationFields[6].Descriptor()
 	// salarycalculation.DefaultAbsentDays holds the default value on creation for the absent_days field.
 	salarycalculation.DefaultAbsentDays = salarycalculationDescAbsentDays.Default.(int)
 	// salarycalculationDescPresentDays is the schema descriptor for present_days field.
-	salarycalculationDescPresentDays := salarycalculationFields[6].Descriptor()
+	salarycalculationDescPresentDays := salarycalculationFields[7].Descriptor()
 	// salarycalculation.DefaultPresentDays holds the default value on creation for the present_days field.
 	salarycalculation.DefaultPresentDays = salarycalculationDescPresentDays.Default.(int)
+	// salarycalculationDescPaidDays is the schema descriptor for paid_days field.
+	salarycalculationDescPaidDays := salarycalculationFields[8].Descriptor()
+	// salarycalculation.DefaultPaidDays holds the default value on creation for the paid_days field.
+	salarycalculation.DefaultPaidDays = salarycalculationDescPaidDays.Default.(decimal.Decimal)
+	// salarycalculationDescLateDays is the schema descriptor for late_days field.
+	salarycalculationDescLateDays := salarycalculationFields[9].Descriptor()
+	// salarycalculation.DefaultLateDays holds the default value on creation for the late_days field.
+	salarycalculation.DefaultLateDays = salarycalculationDescLateDays.Default.(int)
+	// salarycalculationDescLatePenalty is the schema descriptor for late_penalty field.
+	salarycalculationDescLatePenalty := salarycalculationFields[10].Descriptor()
+	// salarycalculation.DefaultLatePenalty holds the default value on creation for the late_penalty field.
+	salarycalculation.DefaultLatePenalty = salarycalculationDescLatePenalty.Default.(decimal.Decimal)
+	// salarycalculationDescWorkedMinutes is the schema descriptor for worked_minutes field.
+	salarycalculationDescWorkedMinutes := salarycalculationFields[12].Descriptor()
+	// salarycalculation.DefaultWorkedMinutes holds the default value on creation for the worked_minutes field.
+	salarycalculation.DefaultWorkedMinutes = salarycalculationDescWorkedMinutes.Default.(int)
+	// salarycalculationDescOvertimeMinutes is the schema descriptor for overtime_minutes field.
+	salarycalculationDescOvertimeMinutes := salarycalculationFields[13].Descriptor()
+	// salarycalculation.DefaultOvertimeMinutes holds the default value on creation for the overtime_minutes field.
+	salarycalculation.DefaultOvertimeMinutes = salarycalculationDescOvertimeMinutes.Default.(int)
+	// salarycalculationDescOvertimePay is the schema descriptor for overtime_pay field.
+	salarycalculationDescOvertimePay := salarycalculationFields[14].Descriptor()
+	// salarycalculation.DefaultOvertimePay holds the default value on creation for the overtime_pay field.
+	salarycalculation.DefaultOvertimePay = salarycalculationDescOvertimePay.Default.(decimal.Decimal)
+	// salarycalculationDescTaxableIncome is the schema descriptor for taxable_income field.
+	salarycalculationDescTaxableIncome := salarycalculationFields[15].Descriptor()
+	// salarycalculation.DefaultTaxableIncome holds the default value on creation for the taxable_income field.
+	salarycalculation.DefaultTaxableIncome = salarycalculationDescTaxableIncome.Default.(decimal.Decimal)
 	// salarycalculationDescDeductionAmount is the schema descriptor for deduction_amount field.
-	salarycalculationDescDeductionAmount := salarycalculationFields[8].Descriptor()
+	salarycalculationDescDeductionAmount := salarycalculationFields[17].Descriptor()
 	// salarycalculation.DefaultDeductionAmount holds the default value on creation for the deduction_amount field.
-	salarycalculation.DefaultDeductionAmount = salarycalculationDescDeductionAmount.Default.(float64)
+	salarycalculation.DefaultDeductionAmount = salarycalculationDescDeductionAmount.Default.(decimal.Decimal)
+	salarycalculationitemMixin := schema.SalaryCalculationItem{}.Mixin()
+	salarycalculationitemMixinFields0 := salarycalculationitemMixin[0].Fields()
+	_ = salarycalculationitemMixinFields0
+	salarycalculationitemFields := schema.SalaryCalculationItem{}.Fields()
+	_ = salarycalculationitemFields
+	// salarycalculationitemDescCreatedAt is the schema descriptor for created_at field.
+	salarycalculationitemDescCreatedAt := salarycalculationitemMixinFields0[0].Descriptor()
+	// salarycalculationitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	salarycalculationitem.DefaultCreatedAt = salarycalculationitemDescCreatedAt.Default.(func() time.Time)
+	// salarycalculationitemDescModifiedAt is the schema descriptor for modified_at field.
+	salarycalculationitemDescModifiedAt := salarycalculationitemMixinFields0[1].Descriptor()
+	// salarycalculationitem.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	salarycalculationitem.DefaultModifiedAt = salarycalculationitemDescModifiedAt.Default.(func() time.Time)
+	// salarycalculationitem.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	salarycalculationitem.UpdateDefaultModifiedAt = salarycalculationitemDescModifiedAt.UpdateDefault.(func() time.Time)
+	// salarycalculationitemDescCode is the schema descriptor for code field.
+	salarycalculationitemDescCode := salarycalculationitemFields[3].Descriptor()
+	// salarycalculationitem.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	salarycalculationitem.CodeValidator = func() func(string) error {
+		validators := salarycalculationitemDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// salarycalculationitemDescName is the schema descriptor for name field.
+	salarycalculationitemDescName := salarycalculationitemFields[4].Descriptor()
+	// salarycalculationitem.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	salarycalculationitem.NameValidator = func() func(string) error {
+		validators := salarycalculationitemDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	salarycomponentMixin := schema.SalaryComponent{}.Mixin()
+	salarycomponentMixinFields0 := salarycomponentMixin[0].Fields()
+	_ = salarycomponentMixinFields0
+	salarycomponentFields := schema.SalaryComponent{}.Fields()
+	_ = salarycomponentFields
+	// salarycomponentDescCreatedAt is the schema descriptor for created_at field.
+	salarycomponentDescCreatedAt := salarycomponentMixinFields0[0].Descriptor()
+	// salarycomponent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	salarycomponent.DefaultCreatedAt = salarycomponentDescCreatedAt.Default.(func() time.Time)
+	// salarycomponentDescModifiedAt is the schema descriptor for modified_at field.
+	salarycomponentDescModifiedAt := salarycomponentMixinFields0[1].Descriptor()
+	// salarycomponent.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	salarycomponent.DefaultModifiedAt = salarycomponentDescModifiedAt.Default.(func() time.Time)
+	// salarycomponent.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	salarycomponent.UpdateDefaultModifiedAt = salarycomponentDescModifiedAt.UpdateDefault.(func() time.Time)
+	// salarycomponentDescCode is the schema descriptor for code field.
+	salarycomponentDescCode := salarycomponentFields[1].Descriptor()
+	// salarycomponent.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	salarycomponent.CodeValidator = func() func(string) error {
+		validators := salarycomponentDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// salarycomponentDescName is the schema descriptor for name field.
+	salarycomponentDescName := salarycomponentFields[2].Descriptor()
+	// salarycomponent.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	salarycomponent.NameValidator = func() func(string) error {
+		validators := salarycomponentDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// salarycomponentDescAmount is the schema descriptor for amount field.
+	salarycomponentDescAmount := salarycomponentFields[5].Descriptor()
+	// salarycomponent.DefaultAmount holds the default value on creation for the amount field.
+	salarycomponent.DefaultAmount = salarycomponentDescAmount.Default.(decimal.Decimal)
+	// salarycomponentDescPercentage is the schema descriptor for percentage field.
+	salarycomponentDescPercentage := salarycomponentFields[6].Descriptor()
+	// salarycomponent.DefaultPercentage holds the default value on creation for the percentage field.
+	salarycomponent.DefaultPercentage = salarycomponentDescPercentage.Default.(decimal.Decimal)
+	// salarycomponentDescIsActive is the schema descriptor for is_active field.
+	salarycomponentDescIsActive := salarycomponentFields[8].Descriptor()
+	// salarycomponent.DefaultIsActive holds the default value on creation for the is_active field.
+	salarycomponent.DefaultIsActive = salarycomponentDescIsActive.Default.(bool)
+	salarycomponentassignmentMixin := schema.SalaryComponentAssignment{}.Mixin()
+	salarycomponentassignmentMixinFields0 := salarycomponentassignmentMixin[0].Fields()
+	_ = salarycomponentassignmentMixinFields0
+	salarycomponentassignmentFields := schema.SalaryComponentAssignment{}.Fields()
+	_ = salarycomponentassignmentFields
+	// salarycomponentassignmentDescCreatedAt is the schema descriptor for created_at field.
+	salarycomponentassignmentDescCreatedAt := salarycomponentassignmentMixinFields0[0].Descriptor()
+	// salarycomponentassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	salarycomponentassignment.DefaultCreatedAt = salarycomponentassignmentDescCreatedAt.Default.(func() time.Time)
+	// salarycomponentassignmentDescModifiedAt is the schema descriptor for modified_at field.
+	salarycomponentassignmentDescModifiedAt := salarycomponentassignmentMixinFields0[1].Descriptor()
+	// salarycomponentassignment.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	salarycomponentassignment.DefaultModifiedAt = salarycomponentassignmentDescModifiedAt.Default.(func() time.Time)
+	// salarycomponentassignment.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	salarycomponentassignment.UpdateDefaultModifiedAt = salarycomponentassignmentDescModifiedAt.UpdateDefault.(func() time.Time)
+	// salarycomponentassignmentDescDepartment is the schema descriptor for department field.
+	salarycomponentassignmentDescDepartment := salarycomponentassignmentFields[3].Descriptor()
+	// salarycomponentassignment.DepartmentValidator is a validator for the "department" field. It is called by the builders before save.
+	salarycomponentassignment.DepartmentValidator = salarycomponentassignmentDescDepartment.Validators[0].(func(string) error)
+	// salarycomponentassignmentDescIsActive is the schema descriptor for is_active field.
+	salarycomponentassignmentDescIsActive := salarycomponentassignmentFields[6].Descriptor()
+	// salarycomponentassignment.DefaultIsActive holds the default value on creation for the is_active field.
+	salarycomponentassignment.DefaultIsActive = salarycomponentassignmentDescIsActive.Default.(bool)
+	shiftassignmentMixin := schema.ShiftAssignment{}.Mixin()
+	shiftassignmentMixinFields0 := shiftassignmentMixin[0].Fields()
+	_ = shiftassignmentMixinFields0
+	shiftassignmentFields := schema.ShiftAssignment{}.Fields()
+	_ = shiftassignmentFields
+	// shiftassignmentDescCreatedAt is the schema descriptor for created_at field.
+	shiftassignmentDescCreatedAt := shiftassignmentMixinFields0[0].Descriptor()
+	// shiftassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shiftassignment.DefaultCreatedAt = shiftassignmentDescCreatedAt.Default.(func() time.Time)
+	// shiftassignmentDescModifiedAt is the schema descriptor for modified_at field.
+	shiftassignmentDescModifiedAt := shiftassignmentMixinFields0[1].Descriptor()
+	// shiftassignment.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	shiftassignment.DefaultModifiedAt = shiftassignmentDescModifiedAt.Default.(func() time.Time)
+	// shiftassignment.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	shiftassignment.UpdateDefaultModifiedAt = shiftassignmentDescModifiedAt.UpdateDefault.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -203,4 +1122,89 @@ func init() {
 	userDescPassword := userFields[4].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	workscheduleMixin := schema.WorkSchedule{}.Mixin()
+	workscheduleMixinFields0 := workscheduleMixin[0].Fields()
+	_ = workscheduleMixinFields0
+	workscheduleFields := schema.WorkSchedule{}.Fields()
+	_ = workscheduleFields
+	// workscheduleDescCreatedAt is the schema descriptor for created_at field.
+	workscheduleDescCreatedAt := workscheduleMixinFields0[0].Descriptor()
+	// workschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workschedule.DefaultCreatedAt = workscheduleDescCreatedAt.Default.(func() time.Time)
+	// workscheduleDescModifiedAt is the schema descriptor for modified_at field.
+	workscheduleDescModifiedAt := workscheduleMixinFields0[1].Descriptor()
+	// workschedule.DefaultModifiedAt holds the default value on creation for the modified_at field.
+	workschedule.DefaultModifiedAt = workscheduleDescModifiedAt.Default.(func() time.Time)
+	// workschedule.UpdateDefaultModifiedAt holds the default value on update for the modified_at field.
+	workschedule.UpdateDefaultModifiedAt = workscheduleDescModifiedAt.UpdateDefault.(func() time.Time)
+	// workscheduleDescName is the schema descriptor for name field.
+	workscheduleDescName := workscheduleFields[1].Descriptor()
+	// workschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workschedule.NameValidator = func() func(string) error {
+		validators := workscheduleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workscheduleDescStartTime is the schema descriptor for start_time field.
+	workscheduleDescStartTime := workscheduleFields[2].Descriptor()
+	// workschedule.StartTimeValidator is a validator for the "start_time" field. It is called by the builders before save.
+	workschedule.StartTimeValidator = func() func(string) error {
+		validators := workscheduleDescStartTime.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(start_time string) error {
+			for _, fn := range fns {
+				if err := fn(start_time); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workscheduleDescEndTime is the schema descriptor for end_time field.
+	workscheduleDescEndTime := workscheduleFields[3].Descriptor()
+	// workschedule.EndTimeValidator is a validator for the "end_time" field. It is called by the builders before save.
+	workschedule.EndTimeValidator = func() func(string) error {
+		validators := workscheduleDescEndTime.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(end_time string) error {
+			for _, fn := range fns {
+				if err := fn(end_time); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workscheduleDescLateThresholdMinutes is the schema descriptor for late_threshold_minutes field.
+	workscheduleDescLateThresholdMinutes := workscheduleFields[5].Descriptor()
+	// workschedule.DefaultLateThresholdMinutes holds the default value on creation for the late_threshold_minutes field.
+	workschedule.DefaultLateThresholdMinutes = workscheduleDescLateThresholdMinutes.Default.(int)
+	// workschedule.LateThresholdMinutesValidator is a validator for the "late_threshold_minutes" field. It is called by the builders before save.
+	workschedule.LateThresholdMinutesValidator = workscheduleDescLateThresholdMinutes.Validators[0].(func(int) error)
+	// workscheduleDescHalfDayThresholdMinutes is the schema descriptor for half_day_threshold_minutes field.
+	workscheduleDescHalfDayThresholdMinutes := workscheduleFields[6].Descriptor()
+	// workschedule.DefaultHalfDayThresholdMinutes holds the default value on creation for the half_day_threshold_minutes field.
+	workschedule.DefaultHalfDayThresholdMinutes = workscheduleDescHalfDayThresholdMinutes.Default.(int)
+	// workschedule.HalfDayThresholdMinutesValidator is a validator for the "half_day_threshold_minutes" field. It is called by the builders before save.
+	workschedule.HalfDayThresholdMinutesValidator = workscheduleDescHalfDayThresholdMinutes.Validators[0].(func(int) error)
+	// workscheduleDescIsDefault is the schema descriptor for is_default field.
+	workscheduleDescIsDefault := workscheduleFields[7].Descriptor()
+	// workschedule.DefaultIsDefault holds the default value on creation for the is_default field.
+	workschedule.DefaultIsDefault = workscheduleDescIsDefault.Default.(bool)
 }
@@ -5,12 +5,14 @@ package ent
 import (
 	"fmt"
 	"mceasy/ent/employee"
+	"mceasy/ent/payrollrun"
 	"mceasy/ent/salarycalculation"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 // SalaryCalculation is the model entity for the SalaryCalculation schema.
@@ -26,20 +28,38 @@ type SalaryCalculation struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Foreign key to employees table
 	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Payroll run that owns the calculation, empty until a run is created for the month
+	PayrollRunID *uint64 `json:"payroll_run_id,omitempty"`
 	// First day of the month for calculation (YYYY-MM-01)
 	CalculationMonth time.Time `json:"calculation_month,omitempty"`
 	// Base salary for the month
-	BaseSalary float64 `json:"base_salary,omitempty"`
+	BaseSalary decimal.Decimal `json:"base_salary,omitempty"`
 	// Total working days in the month (excluding weekends)
 	TotalWorkingDays int `json:"total_working_days,omitempty"`
 	// Number of absent working days
 	AbsentDays int `json:"absent_days,omitempty"`
 	// Number of present working days
 	PresentDays int `json:"present_days,omitempty"`
+	// Present days weighted by the attendance pay policy, the salary is prorated on them
+	PaidDays decimal.Decimal `json:"paid_days,omitempty"`
+	// Number of late working days
+	LateDays int `json:"late_days,omitempty"`
+	// Late penalty deducted under the attendance pay policy
+	LatePenalty decimal.Decimal `json:"late_penalty,omitempty"`
+	// Attendance pay policies applied in the month (for audit purposes)
+	PayPolicy string `json:"pay_policy,omitempty"`
+	// Minutes worked in the month from attendance punches, excluding breaks
+	WorkedMinutes int `json:"worked_minutes,omitempty"`
+	// Approved overtime minutes in the month
+	OvertimeMinutes int `json:"overtime_minutes,omitempty"`
+	// Overtime earnings per Kepmenaker 102/2004, paid on top of the prorated base salary
+	OvertimePay decimal.Decimal `json:"overtime_pay,omitempty"`
+	// Gross earnings of the month subject to PPh 21, summed by the December true-up
+	TaxableIncome decimal.Decimal `json:"taxable_income,omitempty"`
 	// Final calculated salary after deductions
-	FinalSalary float64 `json:"final_salary,omitempty"`
+	FinalSalary decimal.Decimal `json:"final_salary,omitempty"`
 	// Total deduction amount
-	DeductionAmount float64 `json:"deduction_amount,omitempty"`
+	DeductionAmount decimal.Decimal `json:"deduction_amount,omitempty"`
 	// Formula used for calculation (for audit purposes)
 	CalculationFormula string `json:"calculation_formula,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -52,9 +72,13 @@ type SalaryCalculation struct {
 type SalaryCalculationEdges struct {
 	// Employee holds the value of the employee edge.
 	Employee *Employee `json:"employee,omitempty"`
+	// PayrollRun holds the value of the payroll_run edge.
+	PayrollRun *PayrollRun `json:"payroll_run,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*SalaryCalculationItem `json:"items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // EmployeeOrErr returns the Employee value or an error if the edge
@@ -70,16 +94,38 @@ func (e SalaryCalculationEdges) EmployeeOrErr() (*Employee, error) {
 	return nil, &NotLoadedError{edge: "employee"}
 }
 
+// PayrollRunOrErr returns the PayrollRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryCalculationEdges) PayrollRunOrErr() (*PayrollRun, error) {
+	if e.loadedTypes[1] {
+		if e.PayrollRun == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: payrollrun.Label}
+		}
+		return e.PayrollRun, nil
+	}
+	return nil, &NotLoadedError{edge: "payroll_run"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e SalaryCalculationEdges) ItemsOrErr() ([]*SalaryCalculationItem, error) {
+	if e.loadedTypes[2] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SalaryCalculation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case salarycalculation.FieldBaseSalary, salarycalculation.FieldFinalSalary, salarycalculation.FieldDeductionAmount:
-			values[i] = new(sql.NullFloat64)
-		case salarycalculation.FieldID, salarycalculation.FieldEmployeeID, salarycalculation.FieldTotalWorkingDays, salarycalculation.FieldAbsentDays, salarycalculation.FieldPresentDays:
+		case salarycalculation.FieldBaseSalary, salarycalculation.FieldPaidDays, salarycalculation.FieldLatePenalty, salarycalculation.FieldOvertimePay, salarycalculation.FieldTaxableIncome, salarycalculation.FieldFinalSalary, salarycalculation.FieldDeductionAmount:
+			values[i] = new(decimal.Decimal)
+		case salarycalculation.FieldID, salarycalculation.FieldEmployeeID, salarycalculation.FieldPayrollRunID, salarycalculation.FieldTotalWorkingDays, salarycalculation.FieldAbsentDays, salarycalculation.FieldPresentDays, salarycalculation.FieldLateDays, salarycalculation.FieldWorkedMinutes, salarycalculation.FieldOvertimeMinutes:
 			values[i] = new(sql.NullInt64)
-		case salarycalculation.FieldCalculationFormula:
+		case salarycalculation.FieldPayPolicy, salarycalculation.FieldCalculationFormula:
 			values[i] = new(sql.NullString)
 		case salarycalculation.FieldCreatedAt, salarycalculation.FieldModifiedAt, salarycalculation.FieldDeletedAt, salarycalculation.FieldCalculationMonth:
 			values[i] = new(sql.NullTime)
@@ -128,6 +174,13 @@ func (sc *SalaryCalculation) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				sc.EmployeeID = uint64(value.Int64)
 			}
+		case salarycalculation.FieldPayrollRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payroll_run_id", values[i])
+			} else if value.Valid {
+				sc.PayrollRunID = new(uint64)
+				*sc.PayrollRunID = uint64(value.Int64)
+			}
 		case salarycalculation.FieldCalculationMonth:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field calculation_month", values[i])
@@ -135,10 +188,10 @@ func (sc *SalaryCalculation) assignValues(columns []string, values []any) error
 				sc.CalculationMonth = value.Time
 			}
 		case salarycalculation.FieldBaseSalary:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field base_salary", values[i])
-			} else if value.Valid {
-				sc.BaseSalary = value.Float64
+			} else if value != nil {
+				sc.BaseSalary = *value
 			}
 		case salarycalculation.FieldTotalWorkingDays:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -158,17 +211,65 @@ func (sc *SalaryCalculation) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				sc.PresentDays = int(value.Int64)
 			}
+		case salarycalculation.FieldPaidDays:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_days", values[i])
+			} else if value != nil {
+				sc.PaidDays = *value
+			}
+		case salarycalculation.FieldLateDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_days", values[i])
+			} else if value.Valid {
+				sc.LateDays = int(value.Int64)
+			}
+		case salarycalculation.FieldLatePenalty:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field late_penalty", values[i])
+			} else if value != nil {
+				sc.LatePenalty = *value
+			}
+		case salarycalculation.FieldPayPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pay_policy", values[i])
+			} else if value.Valid {
+				sc.PayPolicy = value.String
+			}
+		case salarycalculation.FieldWorkedMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field worked_minutes", values[i])
+			} else if value.Valid {
+				sc.WorkedMinutes = int(value.Int64)
+			}
+		case salarycalculation.FieldOvertimeMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field overtime_minutes", values[i])
+			} else if value.Valid {
+				sc.OvertimeMinutes = int(value.Int64)
+			}
+		case salarycalculation.FieldOvertimePay:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field overtime_pay", values[i])
+			} else if value != nil {
+				sc.OvertimePay = *value
+			}
+		case salarycalculation.FieldTaxableIncome:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field taxable_income", values[i])
+			} else if value != nil {
+				sc.TaxableIncome = *value
+			}
 		case salarycalculation.FieldFinalSalary:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field final_salary", values[i])
-			} else if value.Valid {
-				sc.FinalSalary = value.Float64
+			} else if value != nil {
+				sc.FinalSalary = *value
 			}
 		case salarycalculation.FieldDeductionAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field deduction_amount", values[i])
-			} else if value.Valid {
-				sc.DeductionAmount = value.Float64
+			} else if value != nil {
+				sc.DeductionAmount = *value
 			}
 		case salarycalculation.FieldCalculationFormula:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -194,6 +295,16 @@ func (sc *SalaryCalculation) QueryEmployee() *EmployeeQuery {
 	return NewSalaryCalculationClient(sc.config).QueryEmployee(sc)
 }
 
+// QueryPayrollRun queries the "payroll_run" edge of the SalaryCalculation entity.
+func (sc *SalaryCalculation) QueryPayrollRun() *PayrollRunQuery {
+	return NewSalaryCalculationClient(sc.config).QueryPayrollRun(sc)
+}
+
+// QueryItems queries the "items" edge of the SalaryCalculation entity.
+func (sc *SalaryCalculation) QueryItems() *SalaryCalculationItemQuery {
+	return NewSalaryCalculationClient(sc.config).QueryItems(sc)
+}
+
 // Update returns a builder for updating this SalaryCalculation.
 // Note that you need to call SalaryCalculation.Unwrap() before calling this method if this SalaryCalculation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -229,6 +340,11 @@ func (sc *SalaryCalculation) String() string {
 	builder.WriteString("employee_id=")
 	builder.WriteString(fmt.Sprintf("%v", sc.EmployeeID))
 	builder.WriteString(", ")
+	if v := sc.PayrollRunID; v != nil {
+		builder.WriteString("payroll_run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("calculation_month=")
 	builder.WriteString(sc.CalculationMonth.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -244,6 +360,30 @@ func (sc *SalaryCalculation) String() string {
 	builder.WriteString("present_days=")
 	builder.WriteString(fmt.Sprintf("%v", sc.PresentDays))
 	builder.WriteString(", ")
+	builder.WriteString("paid_days=")
+	builder.WriteString(fmt.Sprintf("%v", sc.PaidDays))
+	builder.WriteString(", ")
+	builder.WriteString("late_days=")
+	builder.WriteString(fmt.Sprintf("%v", sc.LateDays))
+	builder.WriteString(", ")
+	builder.WriteString("late_penalty=")
+	builder.WriteString(fmt.Sprintf("%v", sc.LatePenalty))
+	builder.WriteString(", ")
+	builder.WriteString("pay_policy=")
+	builder.WriteString(sc.PayPolicy)
+	builder.WriteString(", ")
+	builder.WriteString("worked_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", sc.WorkedMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("overtime_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", sc.OvertimeMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("overtime_pay=")
+	builder.WriteString(fmt.Sprintf("%v", sc.OvertimePay))
+	builder.WriteString(", ")
+	builder.WriteString("taxable_income=")
+	builder.WriteString(fmt.Sprintf("%v", sc.TaxableIncome))
+	builder.WriteString(", ")
 	builder.WriteString("final_salary=")
 	builder.WriteString(fmt.Sprintf("%v", sc.FinalSalary))
 	builder.WriteString(", ")
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -22,6 +23,8 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldEmployeeID holds the string denoting the employee_id field in the database.
 	FieldEmployeeID = "employee_id"
+	// FieldPayrollRunID holds the string denoting the payroll_run_id field in the database.
+	FieldPayrollRunID = "payroll_run_id"
 	// FieldCalculationMonth holds the string denoting the calculation_month field in the database.
 	FieldCalculationMonth = "calculation_month"
 	// FieldBaseSalary holds the string denoting the base_salary field in the database.
@@ -32,6 +35,22 @@ const (
 	FieldAbsentDays = "absent_days"
 	// FieldPresentDays holds the string denoting the present_days field in the database.
 	FieldPresentDays = "present_days"
+	// FieldPaidDays holds the string denoting the paid_days field in the database.
+	FieldPaidDays = "paid_days"
+	// FieldLateDays holds the string denoting the late_days field in the database.
+	FieldLateDays = "late_days"
+	// FieldLatePenalty holds the string denoting the late_penalty field in the database.
+	FieldLatePenalty = "late_penalty"
+	// FieldPayPolicy holds the string denoting the pay_policy field in the database.
+	FieldPayPolicy = "pay_policy"
+	// FieldWorkedMinutes holds the string denoting the worked_minutes field in the database.
+	FieldWorkedMinutes = "worked_minutes"
+	// FieldOvertimeMinutes holds the string denoting the overtime_minutes field in the database.
+	FieldOvertimeMinutes = "overtime_minutes"
+	// FieldOvertimePay holds the string denoting the overtime_pay field in the database.
+	FieldOvertimePay = "overtime_pay"
+	// FieldTaxableIncome holds the string denoting the taxable_income field in the database.
+	FieldTaxableIncome = "taxable_income"
 	// FieldFinalSalary holds the string denoting the final_salary field in the database.
 	FieldFinalSalary = "final_salary"
 	// FieldDeductionAmount holds the string denoting the deduction_amount field in the database.
@@ -40,6 +59,10 @@ const (
 	FieldCalculationFormula = "calculation_formula"
 	// EdgeEmployee holds the string denoting the employee edge name in mutations.
 	EdgeEmployee = "employee"
+	// EdgePayrollRun holds the string denoting the payroll_run edge name in mutations.
+	EdgePayrollRun = "payroll_run"
+	// EdgeItems holds the string denoting the items edge name in mutations.
+	EdgeItems = "items"
 	// Table holds the table name of the salarycalculation in the database.
 	Table = "salary_calculations"
 	// EmployeeTable is the table that holds the employee relation/edge.
@@ -49,6 +72,20 @@ const (
 	EmployeeInverseTable = "employees"
 	// EmployeeColumn is the table column denoting the employee relation/edge.
 	EmployeeColumn = "employee_id"
+	// PayrollRunTable is the table that holds the payroll_run relation/edge.
+	PayrollRunTable = "salary_calculations"
+	// PayrollRunInverseTable is the table name for the PayrollRun entity.
+	// It exists in this package in order to avoid circular dependency with the "payrollrun" package.
+	PayrollRunInverseTable = "payroll_runs"
+	// PayrollRunColumn is the table column denoting the payroll_run relation/edge.
+	PayrollRunColumn = "payroll_run_id"
+	// ItemsTable is the table that holds the items relation/edge.
+	ItemsTable = "salary_calculation_items"
+	// ItemsInverseTable is the table name for the SalaryCalculationItem entity.
+	// It exists in this package in order to avoid circular dependency with the "salarycalculationitem" package.
+	ItemsInverseTable = "salary_calculation_items"
+	// ItemsColumn is the table column denoting the items relation/edge.
+	ItemsColumn = "salary_calculation_id"
 )
 
 // Columns holds all SQL columns for salarycalculation fields.
@@ -58,11 +95,20 @@ var Columns = []string{
 	FieldModifiedAt,
 	FieldDeletedAt,
 	FieldEmployeeID,
+	FieldPayrollRunID,
 	FieldCalculationMonth,
 	FieldBaseSalary,
 	FieldTotalWorkingDays,
 	FieldAbsentDays,
 	FieldPresentDays,
+	FieldPaidDays,
+	FieldLateDays,
+	FieldLatePenalty,
+	FieldPayPolicy,
+	FieldWorkedMinutes,
+	FieldOvertimeMinutes,
+	FieldOvertimePay,
+	FieldTaxableIncome,
 	FieldFinalSalary,
 	FieldDeductionAmount,
 	FieldCalculationFormula,
@@ -89,8 +135,22 @@ var (
 	DefaultAbsentDays int
 	// DefaultPresentDays holds the default value on creation for the "present_days" field.
 	DefaultPresentDays int
+	// DefaultPaidDays holds the default value on creation for the "paid_days" field.
+	DefaultPaidDays decimal.Decimal
+	// DefaultLateDays holds the default value on creation for the "late_days" field.
+	DefaultLateDays int
+	// DefaultLatePenalty holds the default value on creation for the "late_penalty" field.
+	DefaultLatePenalty decimal.Decimal
+	// DefaultWorkedMinutes holds the default value on creation for the "worked_minutes" field.
+	DefaultWorkedMinutes int
+	// DefaultOvertimeMinutes holds the default value on creation for the "overtime_minutes" field.
+	DefaultOvertimeMinutes int
+	// DefaultOvertimePay holds the default value on creation for the "overtime_pay" field.
+	DefaultOvertimePay decimal.Decimal
+	// DefaultTaxableIncome holds the default value on creation for the "taxable_income" field.
+	DefaultTaxableIncome decimal.Decimal
 	// DefaultDeductionAmount holds the default value on creation for the "deduction_amount" field.
-	DefaultDeductionAmount float64
+	DefaultDeductionAmount decimal.Decimal
 )
 
 // OrderOption defines the ordering options for the SalaryCalculation queries.
@@ -121,6 +181,11 @@ func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
 }
 
+// ByPayrollRunID orders the results by the payroll_run_id field.
+func ByPayrollRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayrollRunID, opts...).ToFunc()
+}
+
 // ByCalculationMonth orders the results by the calculation_month field.
 func ByCalculationMonth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCalculationMonth, opts...).ToFunc()
@@ -146,6 +211,46 @@ func ByPresentDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPresentDays, opts...).ToFunc()
 }
 
+// ByPaidDays orders the results by the paid_days field.
+func ByPaidDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidDays, opts...).ToFunc()
+}
+
+// ByLateDays orders the results by the late_days field.
+func ByLateDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateDays, opts...).ToFunc()
+}
+
+// ByLatePenalty orders the results by the late_penalty field.
+func ByLatePenalty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatePenalty, opts...).ToFunc()
+}
+
+// ByPayPolicy orders the results by the pay_policy field.
+func ByPayPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayPolicy, opts...).ToFunc()
+}
+
+// ByWorkedMinutes orders the results by the worked_minutes field.
+func ByWorkedMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkedMinutes, opts...).ToFunc()
+}
+
+// ByOvertimeMinutes orders the results by the overtime_minutes field.
+func ByOvertimeMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOvertimeMinutes, opts...).ToFunc()
+}
+
+// ByOvertimePay orders the results by the overtime_pay field.
+func ByOvertimePay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOvertimePay, opts...).ToFunc()
+}
+
+// ByTaxableIncome orders the results by the taxable_income field.
+func ByTaxableIncome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxableIncome, opts...).ToFunc()
+}
+
 // ByFinalSalary orders the results by the final_salary field.
 func ByFinalSalary(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFinalSalary, opts...).ToFunc()
@@ -167,6 +272,27 @@ func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPayrollRunField orders the results by payroll_run field.
+func ByPayrollRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayrollRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemsCount orders the results by items count.
+func ByItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemsStep(), opts...)
+	}
+}
+
+// ByItems orders the results by items terms.
+func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEmployeeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +300,17 @@ func newEmployeeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
 	)
 }
+func newPayrollRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayrollRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PayrollRunTable, PayrollRunColumn),
+	)
+}
+func newItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -75,13 +76,18 @@ func EmployeeID(v uint64) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldEmployeeID, v))
 }
 
+// PayrollRunID applies equality check predicate on the "payroll_run_id" field. It's identical to PayrollRunIDEQ.
+func PayrollRunID(v uint64) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPayrollRunID, v))
+}
+
 // CalculationMonth applies equality check predicate on the "calculation_month" field. It's identical to CalculationMonthEQ.
 func CalculationMonth(v time.Time) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldCalculationMonth, v))
 }
 
 // BaseSalary applies equality check predicate on the "base_salary" field. It's identical to BaseSalaryEQ.
-func BaseSalary(v float64) predicate.SalaryCalculation {
+func BaseSalary(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldBaseSalary, v))
 }
 
@@ -100,13 +106,53 @@ func PresentDays(v int) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldPresentDays, v))
 }
 
+// PaidDays applies equality check predicate on the "paid_days" field. It's identical to PaidDaysEQ.
+func PaidDays(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPaidDays, v))
+}
+
+// LateDays applies equality check predicate on the "late_days" field. It's identical to LateDaysEQ.
+func LateDays(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldLateDays, v))
+}
+
+// LatePenalty applies equality check predicate on the "late_penalty" field. It's identical to LatePenaltyEQ.
+func LatePenalty(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldLatePenalty, v))
+}
+
+// PayPolicy applies equality check predicate on the "pay_policy" field. It's identical to PayPolicyEQ.
+func PayPolicy(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPayPolicy, v))
+}
+
+// WorkedMinutes applies equality check predicate on the "worked_minutes" field. It's identical to WorkedMinutesEQ.
+func WorkedMinutes(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldWorkedMinutes, v))
+}
+
+// OvertimeMinutes applies equality check predicate on the "overtime_minutes" field. It's identical to OvertimeMinutesEQ.
+func OvertimeMinutes(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldOvertimeMinutes, v))
+}
+
+// OvertimePay applies equality check predicate on the "overtime_pay" field. It's identical to OvertimePayEQ.
+func OvertimePay(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldOvertimePay, v))
+}
+
+// TaxableIncome applies equality check predicate on the "taxable_income" field. It's identical to TaxableIncomeEQ.
+func TaxableIncome(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldTaxableIncome, v))
+}
+
 // FinalSalary applies equality check predicate on the "final_salary" field. It's identical to FinalSalaryEQ.
-func FinalSalary(v float64) predicate.SalaryCalculation {
+func FinalSalary(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldFinalSalary, v))
 }
 
 // DeductionAmount applies equality check predicate on the "deduction_amount" field. It's identical to DeductionAmountEQ.
-func DeductionAmount(v float64) predicate.SalaryCalculation {
+func DeductionAmount(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldDeductionAmount, v))
 }
 
@@ -265,6 +311,36 @@ func EmployeeIDNotIn(vs ...uint64) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNotIn(FieldEmployeeID, vs...))
 }
 
+// PayrollRunIDEQ applies the EQ predicate on the "payroll_run_id" field.
+func PayrollRunIDEQ(v uint64) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPayrollRunID, v))
+}
+
+// PayrollRunIDNEQ applies the NEQ predicate on the "payroll_run_id" field.
+func PayrollRunIDNEQ(v uint64) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldPayrollRunID, v))
+}
+
+// PayrollRunIDIn applies the In predicate on the "payroll_run_id" field.
+func PayrollRunIDIn(vs ...uint64) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldPayrollRunID, vs...))
+}
+
+// PayrollRunIDNotIn applies the NotIn predicate on the "payroll_run_id" field.
+func PayrollRunIDNotIn(vs ...uint64) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldPayrollRunID, vs...))
+}
+
+// PayrollRunIDIsNil applies the IsNil predicate on the "payroll_run_id" field.
+func PayrollRunIDIsNil() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIsNull(FieldPayrollRunID))
+}
+
+// PayrollRunIDNotNil applies the NotNil predicate on the "payroll_run_id" field.
+func PayrollRunIDNotNil() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotNull(FieldPayrollRunID))
+}
+
 // CalculationMonthEQ applies the EQ predicate on the "calculation_month" field.
 func CalculationMonthEQ(v time.Time) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldCalculationMonth, v))
@@ -306,42 +382,42 @@ func CalculationMonthLTE(v time.Time) predicate.SalaryCalculation {
 }
 
 // BaseSalaryEQ applies the EQ predicate on the "base_salary" field.
-func BaseSalaryEQ(v float64) predicate.SalaryCalculation {
+func BaseSalaryEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldBaseSalary, v))
 }
 
 // BaseSalaryNEQ applies the NEQ predicate on the "base_salary" field.
-func BaseSalaryNEQ(v float64) predicate.SalaryCalculation {
+func BaseSalaryNEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNEQ(FieldBaseSalary, v))
 }
 
 // BaseSalaryIn applies the In predicate on the "base_salary" field.
-func BaseSalaryIn(vs ...float64) predicate.SalaryCalculation {
+func BaseSalaryIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldIn(FieldBaseSalary, vs...))
 }
 
 // BaseSalaryNotIn applies the NotIn predicate on the "base_salary" field.
-func BaseSalaryNotIn(vs ...float64) predicate.SalaryCalculation {
+func BaseSalaryNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNotIn(FieldBaseSalary, vs...))
 }
 
 // BaseSalaryGT applies the GT predicate on the "base_salary" field.
-func BaseSalaryGT(v float64) predicate.SalaryCalculation {
+func BaseSalaryGT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGT(FieldBaseSalary, v))
 }
 
 // BaseSalaryGTE applies the GTE predicate on the "base_salary" field.
-func BaseSalaryGTE(v float64) predicate.SalaryCalculation {
+func BaseSalaryGTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGTE(FieldBaseSalary, v))
 }
 
 // BaseSalaryLT applies the LT predicate on the "base_salary" field.
-func BaseSalaryLT(v float64) predicate.SalaryCalculation {
+func BaseSalaryLT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLT(FieldBaseSalary, v))
 }
 
 // BaseSalaryLTE applies the LTE predicate on the "base_salary" field.
-func BaseSalaryLTE(v float64) predicate.SalaryCalculation {
+func BaseSalaryLTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLTE(FieldBaseSalary, v))
 }
 
@@ -465,83 +541,438 @@ func PresentDaysLTE(v int) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLTE(FieldPresentDays, v))
 }
 
+// PaidDaysEQ applies the EQ predicate on the "paid_days" field.
+func PaidDaysEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPaidDays, v))
+}
+
+// PaidDaysNEQ applies the NEQ predicate on the "paid_days" field.
+func PaidDaysNEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldPaidDays, v))
+}
+
+// PaidDaysIn applies the In predicate on the "paid_days" field.
+func PaidDaysIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldPaidDays, vs...))
+}
+
+// PaidDaysNotIn applies the NotIn predicate on the "paid_days" field.
+func PaidDaysNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldPaidDays, vs...))
+}
+
+// PaidDaysGT applies the GT predicate on the "paid_days" field.
+func PaidDaysGT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldPaidDays, v))
+}
+
+// PaidDaysGTE applies the GTE predicate on the "paid_days" field.
+func PaidDaysGTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldPaidDays, v))
+}
+
+// PaidDaysLT applies the LT predicate on the "paid_days" field.
+func PaidDaysLT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldPaidDays, v))
+}
+
+// PaidDaysLTE applies the LTE predicate on the "paid_days" field.
+func PaidDaysLTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldPaidDays, v))
+}
+
+// LateDaysEQ applies the EQ predicate on the "late_days" field.
+func LateDaysEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldLateDays, v))
+}
+
+// LateDaysNEQ applies the NEQ predicate on the "late_days" field.
+func LateDaysNEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldLateDays, v))
+}
+
+// LateDaysIn applies the In predicate on the "late_days" field.
+func LateDaysIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldLateDays, vs...))
+}
+
+// LateDaysNotIn applies the NotIn predicate on the "late_days" field.
+func LateDaysNotIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldLateDays, vs...))
+}
+
+// LateDaysGT applies the GT predicate on the "late_days" field.
+func LateDaysGT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldLateDays, v))
+}
+
+// LateDaysGTE applies the GTE predicate on the "late_days" field.
+func LateDaysGTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldLateDays, v))
+}
+
+// LateDaysLT applies the LT predicate on the "late_days" field.
+func LateDaysLT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldLateDays, v))
+}
+
+// LateDaysLTE applies the LTE predicate on the "late_days" field.
+func LateDaysLTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldLateDays, v))
+}
+
+// LatePenaltyEQ applies the EQ predicate on the "late_penalty" field.
+func LatePenaltyEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldLatePenalty, v))
+}
+
+// LatePenaltyNEQ applies the NEQ predicate on the "late_penalty" field.
+func LatePenaltyNEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldLatePenalty, v))
+}
+
+// LatePenaltyIn applies the In predicate on the "late_penalty" field.
+func LatePenaltyIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldLatePenalty, vs...))
+}
+
+// LatePenaltyNotIn applies the NotIn predicate on the "late_penalty" field.
+func LatePenaltyNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldLatePenalty, vs...))
+}
+
+// LatePenaltyGT applies the GT predicate on the "late_penalty" field.
+func LatePenaltyGT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldLatePenalty, v))
+}
+
+// LatePenaltyGTE applies the GTE predicate on the "late_penalty" field.
+func LatePenaltyGTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldLatePenalty, v))
+}
+
+// LatePenaltyLT applies the LT predicate on the "late_penalty" field.
+func LatePenaltyLT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldLatePenalty, v))
+}
+
+// LatePenaltyLTE applies the LTE predicate on the "late_penalty" field.
+func LatePenaltyLTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldLatePenalty, v))
+}
+
+// PayPolicyEQ applies the EQ predicate on the "pay_policy" field.
+func PayPolicyEQ(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldPayPolicy, v))
+}
+
+// PayPolicyNEQ applies the NEQ predicate on the "pay_policy" field.
+func PayPolicyNEQ(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldPayPolicy, v))
+}
+
+// PayPolicyIn applies the In predicate on the "pay_policy" field.
+func PayPolicyIn(vs ...string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldPayPolicy, vs...))
+}
+
+// PayPolicyNotIn applies the NotIn predicate on the "pay_policy" field.
+func PayPolicyNotIn(vs ...string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldPayPolicy, vs...))
+}
+
+// PayPolicyGT applies the GT predicate on the "pay_policy" field.
+func PayPolicyGT(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldPayPolicy, v))
+}
+
+// PayPolicyGTE applies the GTE predicate on the "pay_policy" field.
+func PayPolicyGTE(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldPayPolicy, v))
+}
+
+// PayPolicyLT applies the LT predicate on the "pay_policy" field.
+func PayPolicyLT(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldPayPolicy, v))
+}
+
+// PayPolicyLTE applies the LTE predicate on the "pay_policy" field.
+func PayPolicyLTE(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldPayPolicy, v))
+}
+
+// PayPolicyContains applies the Contains predicate on the "pay_policy" field.
+func PayPolicyContains(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldContains(FieldPayPolicy, v))
+}
+
+// PayPolicyHasPrefix applies the HasPrefix predicate on the "pay_policy" field.
+func PayPolicyHasPrefix(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldHasPrefix(FieldPayPolicy, v))
+}
+
+// PayPolicyHasSuffix applies the HasSuffix predicate on the "pay_policy" field.
+func PayPolicyHasSuffix(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldHasSuffix(FieldPayPolicy, v))
+}
+
+// PayPolicyIsNil applies the IsNil predicate on the "pay_policy" field.
+func PayPolicyIsNil() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIsNull(FieldPayPolicy))
+}
+
+// PayPolicyNotNil applies the NotNil predicate on the "pay_policy" field.
+func PayPolicyNotNil() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotNull(FieldPayPolicy))
+}
+
+// PayPolicyEqualFold applies the EqualFold predicate on the "pay_policy" field.
+func PayPolicyEqualFold(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEqualFold(FieldPayPolicy, v))
+}
+
+// PayPolicyContainsFold applies the ContainsFold predicate on the "pay_policy" field.
+func PayPolicyContainsFold(v string) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldContainsFold(FieldPayPolicy, v))
+}
+
+// WorkedMinutesEQ applies the EQ predicate on the "worked_minutes" field.
+func WorkedMinutesEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesNEQ applies the NEQ predicate on the "worked_minutes" field.
+func WorkedMinutesNEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesIn applies the In predicate on the "worked_minutes" field.
+func WorkedMinutesIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldWorkedMinutes, vs...))
+}
+
+// WorkedMinutesNotIn applies the NotIn predicate on the "worked_minutes" field.
+func WorkedMinutesNotIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldWorkedMinutes, vs...))
+}
+
+// WorkedMinutesGT applies the GT predicate on the "worked_minutes" field.
+func WorkedMinutesGT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesGTE applies the GTE predicate on the "worked_minutes" field.
+func WorkedMinutesGTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesLT applies the LT predicate on the "worked_minutes" field.
+func WorkedMinutesLT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldWorkedMinutes, v))
+}
+
+// WorkedMinutesLTE applies the LTE predicate on the "worked_minutes" field.
+func WorkedMinutesLTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldWorkedMinutes, v))
+}
+
+// OvertimeMinutesEQ applies the EQ predicate on the "overtime_minutes" field.
+func OvertimeMinutesEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldOvertimeMinutes, v))
+}
+
+// OvertimeMinutesNEQ applies the NEQ predicate on the "overtime_minutes" field.
+func OvertimeMinutesNEQ(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldOvertimeMinutes, v))
+}
+
+// OvertimeMinutesIn applies the In predicate on the "overtime_minutes" field.
+func OvertimeMinutesIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldOvertimeMinutes, vs...))
+}
+
+// OvertimeMinutesNotIn applies the NotIn predicate on the "overtime_minutes" field.
+func OvertimeMinutesNotIn(vs ...int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldOvertimeMinutes, vs...))
+}
+
+// OvertimeMinutesGT applies the GT predicate on the "overtime_minutes" field.
+func OvertimeMinutesGT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldOvertimeMinutes, v))
+}
+
+// OvertimeMinutesGTE applies the GTE predicate on the "overtime_minutes" field.
+func OvertimeMinutesGTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldOvertimeMinutes, v))
+}
+
+// OvertimeMinutesLT applies the LT predicate on the "overtime_minutes" field.
+func OvertimeMinutesLT(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldOvertimeMinutes, v))
+}
+
+// OvertimeMinutesLTE applies the LTE predicate on the "overtime_minutes" field.
+func OvertimeMinutesLTE(v int) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldOvertimeMinutes, v))
+}
+
+// OvertimePayEQ applies the EQ predicate on the "overtime_pay" field.
+func OvertimePayEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldOvertimePay, v))
+}
+
+// OvertimePayNEQ applies the NEQ predicate on the "overtime_pay" field.
+func OvertimePayNEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldOvertimePay, v))
+}
+
+// OvertimePayIn applies the In predicate on the "overtime_pay" field.
+func OvertimePayIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldOvertimePay, vs...))
+}
+
+// OvertimePayNotIn applies the NotIn predicate on the "overtime_pay" field.
+func OvertimePayNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldOvertimePay, vs...))
+}
+
+// OvertimePayGT applies the GT predicate on the "overtime_pay" field.
+func OvertimePayGT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldOvertimePay, v))
+}
+
+// OvertimePayGTE applies the GTE predicate on the "overtime_pay" field.
+func OvertimePayGTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldOvertimePay, v))
+}
+
+// OvertimePayLT applies the LT predicate on the "overtime_pay" field.
+func OvertimePayLT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldOvertimePay, v))
+}
+
+// OvertimePayLTE applies the LTE predicate on the "overtime_pay" field.
+func OvertimePayLTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldOvertimePay, v))
+}
+
+// TaxableIncomeEQ applies the EQ predicate on the "taxable_income" field.
+func TaxableIncomeEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldEQ(FieldTaxableIncome, v))
+}
+
+// TaxableIncomeNEQ applies the NEQ predicate on the "taxable_income" field.
+func TaxableIncomeNEQ(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNEQ(FieldTaxableIncome, v))
+}
+
+// TaxableIncomeIn applies the In predicate on the "taxable_income" field.
+func TaxableIncomeIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldIn(FieldTaxableIncome, vs...))
+}
+
+// TaxableIncomeNotIn applies the NotIn predicate on the "taxable_income" field.
+func TaxableIncomeNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldNotIn(FieldTaxableIncome, vs...))
+}
+
+// TaxableIncomeGT applies the GT predicate on the "taxable_income" field.
+func TaxableIncomeGT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGT(FieldTaxableIncome, v))
+}
+
+// TaxableIncomeGTE applies the GTE predicate on the "taxable_income" field.
+func TaxableIncomeGTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldGTE(FieldTaxableIncome, v))
+}
+
+// TaxableIncomeLT applies the LT predicate on the "taxable_income" field.
+func TaxableIncomeLT(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLT(FieldTaxableIncome, v))
+}
+
+// TaxableIncomeLTE applies the LTE predicate on the "taxable_income" field.
+func TaxableIncomeLTE(v decimal.Decimal) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(sql.FieldLTE(FieldTaxableIncome, v))
+}
+
 // FinalSalaryEQ applies the EQ predicate on the "final_salary" field.
-func FinalSalaryEQ(v float64) predicate.SalaryCalculation {
+func FinalSalaryEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldFinalSalary, v))
 }
 
 // FinalSalaryNEQ applies the NEQ predicate on the "final_salary" field.
-func FinalSalaryNEQ(v float64) predicate.SalaryCalculation {
+func FinalSalaryNEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNEQ(FieldFinalSalary, v))
 }
 
 // FinalSalaryIn applies the In predicate on the "final_salary" field.
-func FinalSalaryIn(vs ...float64) predicate.SalaryCalculation {
+func FinalSalaryIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldIn(FieldFinalSalary, vs...))
 }
 
 // FinalSalaryNotIn applies the NotIn predicate on the "final_salary" field.
-func FinalSalaryNotIn(vs ...float64) predicate.SalaryCalculation {
+func FinalSalaryNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNotIn(FieldFinalSalary, vs...))
 }
 
 // FinalSalaryGT applies the GT predicate on the "final_salary" field.
-func FinalSalaryGT(v float64) predicate.SalaryCalculation {
+func FinalSalaryGT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGT(FieldFinalSalary, v))
 }
 
 // FinalSalaryGTE applies the GTE predicate on the "final_salary" field.
-func FinalSalaryGTE(v float64) predicate.SalaryCalculation {
+func FinalSalaryGTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGTE(FieldFinalSalary, v))
 }
 
 // FinalSalaryLT applies the LT predicate on the "final_salary" field.
-func FinalSalaryLT(v float64) predicate.SalaryCalculation {
+func FinalSalaryLT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLT(FieldFinalSalary, v))
 }
 
 // FinalSalaryLTE applies the LTE predicate on the "final_salary" field.
-func FinalSalaryLTE(v float64) predicate.SalaryCalculation {
+func FinalSalaryLTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLTE(FieldFinalSalary, v))
 }
 
 // DeductionAmountEQ applies the EQ predicate on the "deduction_amount" field.
-func DeductionAmountEQ(v float64) predicate.SalaryCalculation {
+func DeductionAmountEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldEQ(FieldDeductionAmount, v))
 }
 
 // DeductionAmountNEQ applies the NEQ predicate on the "deduction_amount" field.
-func DeductionAmountNEQ(v float64) predicate.SalaryCalculation {
+func DeductionAmountNEQ(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNEQ(FieldDeductionAmount, v))
 }
 
 // DeductionAmountIn applies the In predicate on the "deduction_amount" field.
-func DeductionAmountIn(vs ...float64) predicate.SalaryCalculation {
+func DeductionAmountIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldIn(FieldDeductionAmount, vs...))
 }
 
 // DeductionAmountNotIn applies the NotIn predicate on the "deduction_amount" field.
-func DeductionAmountNotIn(vs ...float64) predicate.SalaryCalculation {
+func DeductionAmountNotIn(vs ...decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldNotIn(FieldDeductionAmount, vs...))
 }
 
 // DeductionAmountGT applies the GT predicate on the "deduction_amount" field.
-func DeductionAmountGT(v float64) predicate.SalaryCalculation {
+func DeductionAmountGT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGT(FieldDeductionAmount, v))
 }
 
 // DeductionAmountGTE applies the GTE predicate on the "deduction_amount" field.
-func DeductionAmountGTE(v float64) predicate.SalaryCalculation {
+func DeductionAmountGTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldGTE(FieldDeductionAmount, v))
 }
 
 // DeductionAmountLT applies the LT predicate on the "deduction_amount" field.
-func DeductionAmountLT(v float64) predicate.SalaryCalculation {
+func DeductionAmountLT(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLT(FieldDeductionAmount, v))
 }
 
 // DeductionAmountLTE applies the LTE predicate on the "deduction_amount" field.
-func DeductionAmountLTE(v float64) predicate.SalaryCalculation {
+func DeductionAmountLTE(v decimal.Decimal) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(sql.FieldLTE(FieldDeductionAmount, v))
 }
 
@@ -643,6 +1074,52 @@ func HasEmployeeWith(preds ...predicate.Employee) predicate.SalaryCalculation {
 	})
 }
 
+// HasPayrollRun applies the HasEdge predicate on the "payroll_run" edge.
+func HasPayrollRun() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PayrollRunTable, PayrollRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayrollRunWith applies the HasEdge predicate on the "payroll_run" edge with a given conditions (other predicates).
+func HasPayrollRunWith(preds ...predicate.PayrollRun) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(func(s *sql.Selector) {
+		step := newPayrollRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.SalaryCalculationItem) predicate.SalaryCalculation {
+	return predicate.SalaryCalculation(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SalaryCalculation) predicate.SalaryCalculation {
 	return predicate.SalaryCalculation(func(s *sql.Selector) {
@@ -7,11 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/employee"
+	"mceasy/ent/payrollrun"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // SalaryCalculationCreate is the builder for creating a SalaryCalculation entity.
@@ -69,6 +72,20 @@ func (scc *SalaryCalculationCreate) SetEmployeeID(u uint64) *SalaryCalculationCr
 	return scc
 }
 
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (scc *SalaryCalculationCreate) SetPayrollRunID(u uint64) *SalaryCalculationCreate {
+	scc.mutation.SetPayrollRunID(u)
+	return scc
+}
+
+// SetNillablePayrollRunID sets the "payroll_run_id" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillablePayrollRunID(u *uint64) *SalaryCalculationCreate {
+	if u != nil {
+		scc.SetPayrollRunID(*u)
+	}
+	return scc
+}
+
 // SetCalculationMonth sets the "calculation_month" field.
 func (scc *SalaryCalculationCreate) SetCalculationMonth(t time.Time) *SalaryCalculationCreate {
 	scc.mutation.SetCalculationMonth(t)
@@ -76,8 +93,8 @@ func (scc *SalaryCalculationCreate) SetCalculationMonth(t time.Time) *SalaryCalc
 }
 
 // SetBaseSalary sets the "base_salary" field.
-func (scc *SalaryCalculationCreate) SetBaseSalary(f float64) *SalaryCalculationCreate {
-	scc.mutation.SetBaseSalary(f)
+func (scc *SalaryCalculationCreate) SetBaseSalary(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetBaseSalary(d)
 	return scc
 }
 
@@ -115,22 +132,134 @@ func (scc *SalaryCalculationCreate) SetNillablePresentDays(i *int) *SalaryCalcul
 	return scc
 }
 
+// SetPaidDays sets the "paid_days" field.
+func (scc *SalaryCalculationCreate) SetPaidDays(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetPaidDays(d)
+	return scc
+}
+
+// SetNillablePaidDays sets the "paid_days" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillablePaidDays(d *decimal.Decimal) *SalaryCalculationCreate {
+	if d != nil {
+		scc.SetPaidDays(*d)
+	}
+	return scc
+}
+
+// SetLateDays sets the "late_days" field.
+func (scc *SalaryCalculationCreate) SetLateDays(i int) *SalaryCalculationCreate {
+	scc.mutation.SetLateDays(i)
+	return scc
+}
+
+// SetNillableLateDays sets the "late_days" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableLateDays(i *int) *SalaryCalculationCreate {
+	if i != nil {
+		scc.SetLateDays(*i)
+	}
+	return scc
+}
+
+// SetLatePenalty sets the "late_penalty" field.
+func (scc *SalaryCalculationCreate) SetLatePenalty(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetLatePenalty(d)
+	return scc
+}
+
+// SetNillableLatePenalty sets the "late_penalty" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableLatePenalty(d *decimal.Decimal) *SalaryCalculationCreate {
+	if d != nil {
+		scc.SetLatePenalty(*d)
+	}
+	return scc
+}
+
+// SetPayPolicy sets the "pay_policy" field.
+func (scc *SalaryCalculationCreate) SetPayPolicy(s string) *SalaryCalculationCreate {
+	scc.mutation.SetPayPolicy(s)
+	return scc
+}
+
+// SetNillablePayPolicy sets the "pay_policy" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillablePayPolicy(s *string) *SalaryCalculationCreate {
+	if s != nil {
+		scc.SetPayPolicy(*s)
+	}
+	return scc
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (scc *SalaryCalculationCreate) SetWorkedMinutes(i int) *SalaryCalculationCreate {
+	scc.mutation.SetWorkedMinutes(i)
+	return scc
+}
+
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableWorkedMinutes(i *int) *SalaryCalculationCreate {
+	if i != nil {
+		scc.SetWorkedMinutes(*i)
+	}
+	return scc
+}
+
+// SetOvertimeMinutes sets the "overtime_minutes" field.
+func (scc *SalaryCalculationCreate) SetOvertimeMinutes(i int) *SalaryCalculationCreate {
+	scc.mutation.SetOvertimeMinutes(i)
+	return scc
+}
+
+// SetNillableOvertimeMinutes sets the "overtime_minutes" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableOvertimeMinutes(i *int) *SalaryCalculationCreate {
+	if i != nil {
+		scc.SetOvertimeMinutes(*i)
+	}
+	return scc
+}
+
+// SetOvertimePay sets the "overtime_pay" field.
+func (scc *SalaryCalculationCreate) SetOvertimePay(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetOvertimePay(d)
+	return scc
+}
+
+// SetNillableOvertimePay sets the "overtime_pay" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableOvertimePay(d *decimal.Decimal) *SalaryCalculationCreate {
+	if d != nil {
+		scc.SetOvertimePay(*d)
+	}
+	return scc
+}
+
+// SetTaxableIncome sets the "taxable_income" field.
+func (scc *SalaryCalculationCreate) SetTaxableIncome(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetTaxableIncome(d)
+	return scc
+}
+
+// SetNillableTaxableIncome sets the "taxable_income" field if the given value is not nil.
+func (scc *SalaryCalculationCreate) SetNillableTaxableIncome(d *decimal.Decimal) *SalaryCalculationCreate {
+	if d != nil {
+		scc.SetTaxableIncome(*d)
+	}
+	return scc
+}
+
 // SetFinalSalary sets the "final_salary" field.
-func (scc *SalaryCalculationCreate) SetFinalSalary(f float64) *SalaryCalculationCreate {
-	scc.mutation.SetFinalSalary(f)
+func (scc *SalaryCalculationCreate) SetFinalSalary(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetFinalSalary(d)
 	return scc
 }
 
 // SetDeductionAmount sets the "deduction_amount" field.
-func (scc *SalaryCalculationCreate) SetDeductionAmount(f float64) *SalaryCalculationCreate {
-	scc.mutation.SetDeductionAmount(f)
+func (scc *SalaryCalculationCreate) SetDeductionAmount(d decimal.Decimal) *SalaryCalculationCreate {
+	scc.mutation.SetDeductionAmount(d)
 	return scc
 }
 
 // SetNillableDeductionAmount sets the "deduction_amount" field if the given value is not nil.
-func (scc *SalaryCalculationCreate) SetNillableDeductionAmount(f *float64) *SalaryCalculationCreate {
-	if f != nil {
-		scc.SetDeductionAmount(*f)
+func (scc *SalaryCalculationCreate) SetNillableDeductionAmount(d *decimal.Decimal) *SalaryCalculationCreate {
+	if d != nil {
+		scc.SetDeductionAmount(*d)
 	}
 	return scc
 }
@@ -160,6 +289,26 @@ func (scc *SalaryCalculationCreate) SetEmployee(e *Employee) *SalaryCalculationC
 	return scc.SetEmployeeID(e.ID)
 }
 
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (scc *SalaryCalculationCreate) SetPayrollRun(p *PayrollRun) *SalaryCalculationCreate {
+	return scc.SetPayrollRunID(p.ID)
+}
+
+// AddItemIDs adds the "items" edge to the SalaryCalculationItem entity by IDs.
+func (scc *SalaryCalculationCreate) AddItemIDs(ids ...uint64) *SalaryCalculationCreate {
+	scc.mutation.AddItemIDs(ids...)
+	return scc
+}
+
+// AddItems adds the "items" edges to the SalaryCalculationItem entity.
+func (scc *SalaryCalculationCreate) AddItems(s ...*SalaryCalculationItem) *SalaryCalculationCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scc.AddItemIDs(ids...)
+}
+
 // Mutation returns the SalaryCalculationMutation object of the builder.
 func (scc *SalaryCalculationCreate) Mutation() *SalaryCalculationMutation {
 	return scc.mutation
@@ -211,6 +360,34 @@ func (scc *SalaryCalculationCreate) defaults() {
 		v := salarycalculation.DefaultPresentDays
 		scc.mutation.SetPresentDays(v)
 	}
+	if _, ok := scc.mutation.PaidDays(); !ok {
+		v := salarycalculation.DefaultPaidDays
+		scc.mutation.SetPaidDays(v)
+	}
+	if _, ok := scc.mutation.LateDays(); !ok {
+		v := salarycalculation.DefaultLateDays
+		scc.mutation.SetLateDays(v)
+	}
+	if _, ok := scc.mutation.LatePenalty(); !ok {
+		v := salarycalculation.DefaultLatePenalty
+		scc.mutation.SetLatePenalty(v)
+	}
+	if _, ok := scc.mutation.WorkedMinutes(); !ok {
+		v := salarycalculation.DefaultWorkedMinutes
+		scc.mutation.SetWorkedMinutes(v)
+	}
+	if _, ok := scc.mutation.OvertimeMinutes(); !ok {
+		v := salarycalculation.DefaultOvertimeMinutes
+		scc.mutation.SetOvertimeMinutes(v)
+	}
+	if _, ok := scc.mutation.OvertimePay(); !ok {
+		v := salarycalculation.DefaultOvertimePay
+		scc.mutation.SetOvertimePay(v)
+	}
+	if _, ok := scc.mutation.TaxableIncome(); !ok {
+		v := salarycalculation.DefaultTaxableIncome
+		scc.mutation.SetTaxableIncome(v)
+	}
 	if _, ok := scc.mutation.DeductionAmount(); !ok {
 		v := salarycalculation.DefaultDeductionAmount
 		scc.mutation.SetDeductionAmount(v)
@@ -243,6 +420,27 @@ func (scc *SalaryCalculationCreate) check() error {
 	if _, ok := scc.mutation.PresentDays(); !ok {
 		return &ValidationError{Name: "present_days", err: errors.New(`ent: missing required field "SalaryCalculation.present_days"`)}
 	}
+	if _, ok := scc.mutation.PaidDays(); !ok {
+		return &ValidationError{Name: "paid_days", err: errors.New(`ent: missing required field "SalaryCalculation.paid_days"`)}
+	}
+	if _, ok := scc.mutation.LateDays(); !ok {
+		return &ValidationError{Name: "late_days", err: errors.New(`ent: missing required field "SalaryCalculation.late_days"`)}
+	}
+	if _, ok := scc.mutation.LatePenalty(); !ok {
+		return &ValidationError{Name: "late_penalty", err: errors.New(`ent: missing required field "SalaryCalculation.late_penalty"`)}
+	}
+	if _, ok := scc.mutation.WorkedMinutes(); !ok {
+		return &ValidationError{Name: "worked_minutes", err: errors.New(`ent: missing required field "SalaryCalculation.worked_minutes"`)}
+	}
+	if _, ok := scc.mutation.OvertimeMinutes(); !ok {
+		return &ValidationError{Name: "overtime_minutes", err: errors.New(`ent: missing required field "SalaryCalculation.overtime_minutes"`)}
+	}
+	if _, ok := scc.mutation.OvertimePay(); !ok {
+		return &ValidationError{Name: "overtime_pay", err: errors.New(`ent: missing required field "SalaryCalculation.overtime_pay"`)}
+	}
+	if _, ok := scc.mutation.TaxableIncome(); !ok {
+		return &ValidationError{Name: "taxable_income", err: errors.New(`ent: missing required field "SalaryCalculation.taxable_income"`)}
+	}
 	if _, ok := scc.mutation.FinalSalary(); !ok {
 		return &ValidationError{Name: "final_salary", err: errors.New(`ent: missing required field "SalaryCalculation.final_salary"`)}
 	}
@@ -301,7 +499,7 @@ func (scc *SalaryCalculationCreate) createSpec() (*SalaryCalculation, *sqlgraph.
 		_node.CalculationMonth = value
 	}
 	if value, ok := scc.mutation.BaseSalary(); ok {
-		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeFloat64, value)
+		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeOther, value)
 		_node.BaseSalary = value
 	}
 	if value, ok := scc.mutation.TotalWorkingDays(); ok {
@@ -316,12 +514,44 @@ func (scc *SalaryCalculationCreate) createSpec() (*SalaryCalculation, *sqlgraph.
 		_spec.SetField(salarycalculation.FieldPresentDays, field.TypeInt, value)
 		_node.PresentDays = value
 	}
+	if value, ok := scc.mutation.PaidDays(); ok {
+		_spec.SetField(salarycalculation.FieldPaidDays, field.TypeOther, value)
+		_node.PaidDays = value
+	}
+	if value, ok := scc.mutation.LateDays(); ok {
+		_spec.SetField(salarycalculation.FieldLateDays, field.TypeInt, value)
+		_node.LateDays = value
+	}
+	if value, ok := scc.mutation.LatePenalty(); ok {
+		_spec.SetField(salarycalculation.FieldLatePenalty, field.TypeOther, value)
+		_node.LatePenalty = value
+	}
+	if value, ok := scc.mutation.PayPolicy(); ok {
+		_spec.SetField(salarycalculation.FieldPayPolicy, field.TypeString, value)
+		_node.PayPolicy = value
+	}
+	if value, ok := scc.mutation.WorkedMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldWorkedMinutes, field.TypeInt, value)
+		_node.WorkedMinutes = value
+	}
+	if value, ok := scc.mutation.OvertimeMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimeMinutes, field.TypeInt, value)
+		_node.OvertimeMinutes = value
+	}
+	if value, ok := scc.mutation.OvertimePay(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimePay, field.TypeOther, value)
+		_node.OvertimePay = value
+	}
+	if value, ok := scc.mutation.TaxableIncome(); ok {
+		_spec.SetField(salarycalculation.FieldTaxableIncome, field.TypeOther, value)
+		_node.TaxableIncome = value
+	}
 	if value, ok := scc.mutation.FinalSalary(); ok {
-		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeFloat64, value)
+		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeOther, value)
 		_node.FinalSalary = value
 	}
 	if value, ok := scc.mutation.DeductionAmount(); ok {
-		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeFloat64, value)
+		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeOther, value)
 		_node.DeductionAmount = value
 	}
 	if value, ok := scc.mutation.CalculationFormula(); ok {
@@ -345,6 +575,39 @@ func (scc *SalaryCalculationCreate) createSpec() (*SalaryCalculation, *sqlgraph.
 		_node.EmployeeID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := scc.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculation.PayrollRunTable,
+			Columns: []string{salarycalculation.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PayrollRunID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
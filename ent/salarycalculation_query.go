@@ -4,11 +4,14 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"mceasy/ent/employee"
+	"mceasy/ent/payrollrun"
 	"mceasy/ent/predicate"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -18,12 +21,14 @@ import (
 // SalaryCalculationQuery is the builder for querying SalaryCalculation entities.
 type SalaryCalculationQuery struct {
 	config
-	ctx          *QueryContext
-	order        []salarycalculation.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.SalaryCalculation
-	withEmployee *EmployeeQuery
-	modifiers    []func(*sql.Selector)
+	ctx            *QueryContext
+	order          []salarycalculation.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.SalaryCalculation
+	withEmployee   *EmployeeQuery
+	withPayrollRun *PayrollRunQuery
+	withItems      *SalaryCalculationItemQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +87,50 @@ func (scq *SalaryCalculationQuery) QueryEmployee() *EmployeeQuery {
 	return query
 }
 
+// QueryPayrollRun chains the current query on the "payroll_run" edge.
+func (scq *SalaryCalculationQuery) QueryPayrollRun() *PayrollRunQuery {
+	query := (&PayrollRunClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, selector),
+			sqlgraph.To(payrollrun.Table, payrollrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculation.PayrollRunTable, salarycalculation.PayrollRunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (scq *SalaryCalculationQuery) QueryItems() *SalaryCalculationItemQuery {
+	query := (&SalaryCalculationItemClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculation.Table, salarycalculation.FieldID, selector),
+			sqlgraph.To(salarycalculationitem.Table, salarycalculationitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycalculation.ItemsTable, salarycalculation.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SalaryCalculation entity from the query.
 // Returns a *NotFoundError when no SalaryCalculation was found.
 func (scq *SalaryCalculationQuery) First(ctx context.Context) (*SalaryCalculation, error) {
@@ -269,12 +318,14 @@ func (scq *SalaryCalculationQuery) Clone() *SalaryCalculationQuery {
 		return nil
 	}
 	return &SalaryCalculationQuery{
-		config:       scq.config,
-		ctx:          scq.ctx.Clone(),
-		order:        append([]salarycalculation.OrderOption{}, scq.order...),
-		inters:       append([]Interceptor{}, scq.inters...),
-		predicates:   append([]predicate.SalaryCalculation{}, scq.predicates...),
-		withEmployee: scq.withEmployee.Clone(),
+		config:         scq.config,
+		ctx:            scq.ctx.Clone(),
+		order:          append([]salarycalculation.OrderOption{}, scq.order...),
+		inters:         append([]Interceptor{}, scq.inters...),
+		predicates:     append([]predicate.SalaryCalculation{}, scq.predicates...),
+		withEmployee:   scq.withEmployee.Clone(),
+		withPayrollRun: scq.withPayrollRun.Clone(),
+		withItems:      scq.withItems.Clone(),
 		// clone intermediate query.
 		sql:  scq.sql.Clone(),
 		path: scq.path,
@@ -292,6 +343,28 @@ func (scq *SalaryCalculationQuery) WithEmployee(opts ...func(*EmployeeQuery)) *S
 	return scq
 }
 
+// WithPayrollRun tells the query-builder to eager-load the nodes that are connected to
+// the "payroll_run" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SalaryCalculationQuery) WithPayrollRun(opts ...func(*PayrollRunQuery)) *SalaryCalculationQuery {
+	query := (&PayrollRunClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withPayrollRun = query
+	return scq
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SalaryCalculationQuery) WithItems(opts ...func(*SalaryCalculationItemQuery)) *SalaryCalculationQuery {
+	query := (&SalaryCalculationItemClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withItems = query
+	return scq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +443,10 @@ func (scq *SalaryCalculationQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 	var (
 		nodes       = []*SalaryCalculation{}
 		_spec       = scq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			scq.withEmployee != nil,
+			scq.withPayrollRun != nil,
+			scq.withItems != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +476,19 @@ func (scq *SalaryCalculationQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := scq.withPayrollRun; query != nil {
+		if err := scq.loadPayrollRun(ctx, query, nodes, nil,
+			func(n *SalaryCalculation, e *PayrollRun) { n.Edges.PayrollRun = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withItems; query != nil {
+		if err := scq.loadItems(ctx, query, nodes,
+			func(n *SalaryCalculation) { n.Edges.Items = []*SalaryCalculationItem{} },
+			func(n *SalaryCalculation, e *SalaryCalculationItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +521,68 @@ func (scq *SalaryCalculationQuery) loadEmployee(ctx context.Context, query *Empl
 	}
 	return nil
 }
+func (scq *SalaryCalculationQuery) loadPayrollRun(ctx context.Context, query *PayrollRunQuery, nodes []*SalaryCalculation, init func(*SalaryCalculation), assign func(*SalaryCalculation, *PayrollRun)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*SalaryCalculation)
+	for i := range nodes {
+		if nodes[i].PayrollRunID == nil {
+			continue
+		}
+		fk := *nodes[i].PayrollRunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(payrollrun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payroll_run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scq *SalaryCalculationQuery) loadItems(ctx context.Context, query *SalaryCalculationItemQuery, nodes []*SalaryCalculation, init func(*SalaryCalculation), assign func(*SalaryCalculation, *SalaryCalculationItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*SalaryCalculation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarycalculationitem.FieldSalaryCalculationID)
+	}
+	query.Where(predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(salarycalculation.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SalaryCalculationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "salary_calculation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (scq *SalaryCalculationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := scq.querySpec()
@@ -465,6 +615,9 @@ func (scq *SalaryCalculationQuery) querySpec() *sqlgraph.QuerySpec {
 		if scq.withEmployee != nil {
 			_spec.Node.AddColumnOnce(salarycalculation.FieldEmployeeID)
 		}
+		if scq.withPayrollRun != nil {
+			_spec.Node.AddColumnOnce(salarycalculation.FieldPayrollRunID)
+		}
 	}
 	if ps := scq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
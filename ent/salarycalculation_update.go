@@ -7,13 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"mceasy/ent/employee"
+	"mceasy/ent/payrollrun"
 	"mceasy/ent/predicate"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // SalaryCalculationUpdate is the builder for updating SalaryCalculation entities.
@@ -62,6 +65,26 @@ func (scu *SalaryCalculationUpdate) SetEmployeeID(u uint64) *SalaryCalculationUp
 	return scu
 }
 
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (scu *SalaryCalculationUpdate) SetPayrollRunID(u uint64) *SalaryCalculationUpdate {
+	scu.mutation.SetPayrollRunID(u)
+	return scu
+}
+
+// SetNillablePayrollRunID sets the "payroll_run_id" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillablePayrollRunID(u *uint64) *SalaryCalculationUpdate {
+	if u != nil {
+		scu.SetPayrollRunID(*u)
+	}
+	return scu
+}
+
+// ClearPayrollRunID clears the value of the "payroll_run_id" field.
+func (scu *SalaryCalculationUpdate) ClearPayrollRunID() *SalaryCalculationUpdate {
+	scu.mutation.ClearPayrollRunID()
+	return scu
+}
+
 // SetCalculationMonth sets the "calculation_month" field.
 func (scu *SalaryCalculationUpdate) SetCalculationMonth(t time.Time) *SalaryCalculationUpdate {
 	scu.mutation.SetCalculationMonth(t)
@@ -69,15 +92,8 @@ func (scu *SalaryCalculationUpdate) SetCalculationMonth(t time.Time) *SalaryCalc
 }
 
 // SetBaseSalary sets the "base_salary" field.
-func (scu *SalaryCalculationUpdate) SetBaseSalary(f float64) *SalaryCalculationUpdate {
-	scu.mutation.ResetBaseSalary()
-	scu.mutation.SetBaseSalary(f)
-	return scu
-}
-
-// AddBaseSalary adds f to the "base_salary" field.
-func (scu *SalaryCalculationUpdate) AddBaseSalary(f float64) *SalaryCalculationUpdate {
-	scu.mutation.AddBaseSalary(f)
+func (scu *SalaryCalculationUpdate) SetBaseSalary(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetBaseSalary(d)
 	return scu
 }
 
@@ -136,37 +152,162 @@ func (scu *SalaryCalculationUpdate) AddPresentDays(i int) *SalaryCalculationUpda
 	return scu
 }
 
-// SetFinalSalary sets the "final_salary" field.
-func (scu *SalaryCalculationUpdate) SetFinalSalary(f float64) *SalaryCalculationUpdate {
-	scu.mutation.ResetFinalSalary()
-	scu.mutation.SetFinalSalary(f)
+// SetPaidDays sets the "paid_days" field.
+func (scu *SalaryCalculationUpdate) SetPaidDays(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetPaidDays(d)
 	return scu
 }
 
-// AddFinalSalary adds f to the "final_salary" field.
-func (scu *SalaryCalculationUpdate) AddFinalSalary(f float64) *SalaryCalculationUpdate {
-	scu.mutation.AddFinalSalary(f)
+// SetNillablePaidDays sets the "paid_days" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillablePaidDays(d *decimal.Decimal) *SalaryCalculationUpdate {
+	if d != nil {
+		scu.SetPaidDays(*d)
+	}
 	return scu
 }
 
-// SetDeductionAmount sets the "deduction_amount" field.
-func (scu *SalaryCalculationUpdate) SetDeductionAmount(f float64) *SalaryCalculationUpdate {
-	scu.mutation.ResetDeductionAmount()
-	scu.mutation.SetDeductionAmount(f)
+// SetLateDays sets the "late_days" field.
+func (scu *SalaryCalculationUpdate) SetLateDays(i int) *SalaryCalculationUpdate {
+	scu.mutation.ResetLateDays()
+	scu.mutation.SetLateDays(i)
 	return scu
 }
 
-// SetNillableDeductionAmount sets the "deduction_amount" field if the given value is not nil.
-func (scu *SalaryCalculationUpdate) SetNillableDeductionAmount(f *float64) *SalaryCalculationUpdate {
-	if f != nil {
-		scu.SetDeductionAmount(*f)
+// SetNillableLateDays sets the "late_days" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableLateDays(i *int) *SalaryCalculationUpdate {
+	if i != nil {
+		scu.SetLateDays(*i)
+	}
+	return scu
+}
+
+// AddLateDays adds i to the "late_days" field.
+func (scu *SalaryCalculationUpdate) AddLateDays(i int) *SalaryCalculationUpdate {
+	scu.mutation.AddLateDays(i)
+	return scu
+}
+
+// SetLatePenalty sets the "late_penalty" field.
+func (scu *SalaryCalculationUpdate) SetLatePenalty(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetLatePenalty(d)
+	return scu
+}
+
+// SetNillableLatePenalty sets the "late_penalty" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableLatePenalty(d *decimal.Decimal) *SalaryCalculationUpdate {
+	if d != nil {
+		scu.SetLatePenalty(*d)
+	}
+	return scu
+}
+
+// SetPayPolicy sets the "pay_policy" field.
+func (scu *SalaryCalculationUpdate) SetPayPolicy(s string) *SalaryCalculationUpdate {
+	scu.mutation.SetPayPolicy(s)
+	return scu
+}
+
+// SetNillablePayPolicy sets the "pay_policy" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillablePayPolicy(s *string) *SalaryCalculationUpdate {
+	if s != nil {
+		scu.SetPayPolicy(*s)
+	}
+	return scu
+}
+
+// ClearPayPolicy clears the value of the "pay_policy" field.
+func (scu *SalaryCalculationUpdate) ClearPayPolicy() *SalaryCalculationUpdate {
+	scu.mutation.ClearPayPolicy()
+	return scu
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (scu *SalaryCalculationUpdate) SetWorkedMinutes(i int) *SalaryCalculationUpdate {
+	scu.mutation.ResetWorkedMinutes()
+	scu.mutation.SetWorkedMinutes(i)
+	return scu
+}
+
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableWorkedMinutes(i *int) *SalaryCalculationUpdate {
+	if i != nil {
+		scu.SetWorkedMinutes(*i)
+	}
+	return scu
+}
+
+// AddWorkedMinutes adds i to the "worked_minutes" field.
+func (scu *SalaryCalculationUpdate) AddWorkedMinutes(i int) *SalaryCalculationUpdate {
+	scu.mutation.AddWorkedMinutes(i)
+	return scu
+}
+
+// SetOvertimeMinutes sets the "overtime_minutes" field.
+func (scu *SalaryCalculationUpdate) SetOvertimeMinutes(i int) *SalaryCalculationUpdate {
+	scu.mutation.ResetOvertimeMinutes()
+	scu.mutation.SetOvertimeMinutes(i)
+	return scu
+}
+
+// SetNillableOvertimeMinutes sets the "overtime_minutes" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableOvertimeMinutes(i *int) *SalaryCalculationUpdate {
+	if i != nil {
+		scu.SetOvertimeMinutes(*i)
+	}
+	return scu
+}
+
+// AddOvertimeMinutes adds i to the "overtime_minutes" field.
+func (scu *SalaryCalculationUpdate) AddOvertimeMinutes(i int) *SalaryCalculationUpdate {
+	scu.mutation.AddOvertimeMinutes(i)
+	return scu
+}
+
+// SetOvertimePay sets the "overtime_pay" field.
+func (scu *SalaryCalculationUpdate) SetOvertimePay(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetOvertimePay(d)
+	return scu
+}
+
+// SetNillableOvertimePay sets the "overtime_pay" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableOvertimePay(d *decimal.Decimal) *SalaryCalculationUpdate {
+	if d != nil {
+		scu.SetOvertimePay(*d)
 	}
 	return scu
 }
 
-// AddDeductionAmount adds f to the "deduction_amount" field.
-func (scu *SalaryCalculationUpdate) AddDeductionAmount(f float64) *SalaryCalculationUpdate {
-	scu.mutation.AddDeductionAmount(f)
+// SetTaxableIncome sets the "taxable_income" field.
+func (scu *SalaryCalculationUpdate) SetTaxableIncome(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetTaxableIncome(d)
+	return scu
+}
+
+// SetNillableTaxableIncome sets the "taxable_income" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableTaxableIncome(d *decimal.Decimal) *SalaryCalculationUpdate {
+	if d != nil {
+		scu.SetTaxableIncome(*d)
+	}
+	return scu
+}
+
+// SetFinalSalary sets the "final_salary" field.
+func (scu *SalaryCalculationUpdate) SetFinalSalary(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetFinalSalary(d)
+	return scu
+}
+
+// SetDeductionAmount sets the "deduction_amount" field.
+func (scu *SalaryCalculationUpdate) SetDeductionAmount(d decimal.Decimal) *SalaryCalculationUpdate {
+	scu.mutation.SetDeductionAmount(d)
+	return scu
+}
+
+// SetNillableDeductionAmount sets the "deduction_amount" field if the given value is not nil.
+func (scu *SalaryCalculationUpdate) SetNillableDeductionAmount(d *decimal.Decimal) *SalaryCalculationUpdate {
+	if d != nil {
+		scu.SetDeductionAmount(*d)
+	}
 	return scu
 }
 
@@ -195,6 +336,26 @@ func (scu *SalaryCalculationUpdate) SetEmployee(e *Employee) *SalaryCalculationU
 	return scu.SetEmployeeID(e.ID)
 }
 
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (scu *SalaryCalculationUpdate) SetPayrollRun(p *PayrollRun) *SalaryCalculationUpdate {
+	return scu.SetPayrollRunID(p.ID)
+}
+
+// AddItemIDs adds the "items" edge to the SalaryCalculationItem entity by IDs.
+func (scu *SalaryCalculationUpdate) AddItemIDs(ids ...uint64) *SalaryCalculationUpdate {
+	scu.mutation.AddItemIDs(ids...)
+	return scu
+}
+
+// AddItems adds the "items" edges to the SalaryCalculationItem entity.
+func (scu *SalaryCalculationUpdate) AddItems(s ...*SalaryCalculationItem) *SalaryCalculationUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.AddItemIDs(ids...)
+}
+
 // Mutation returns the SalaryCalculationMutation object of the builder.
 func (scu *SalaryCalculationUpdate) Mutation() *SalaryCalculationMutation {
 	return scu.mutation
@@ -206,6 +367,33 @@ func (scu *SalaryCalculationUpdate) ClearEmployee() *SalaryCalculationUpdate {
 	return scu
 }
 
+// ClearPayrollRun clears the "payroll_run" edge to the PayrollRun entity.
+func (scu *SalaryCalculationUpdate) ClearPayrollRun() *SalaryCalculationUpdate {
+	scu.mutation.ClearPayrollRun()
+	return scu
+}
+
+// ClearItems clears all "items" edges to the SalaryCalculationItem entity.
+func (scu *SalaryCalculationUpdate) ClearItems() *SalaryCalculationUpdate {
+	scu.mutation.ClearItems()
+	return scu
+}
+
+// RemoveItemIDs removes the "items" edge to SalaryCalculationItem entities by IDs.
+func (scu *SalaryCalculationUpdate) RemoveItemIDs(ids ...uint64) *SalaryCalculationUpdate {
+	scu.mutation.RemoveItemIDs(ids...)
+	return scu
+}
+
+// RemoveItems removes "items" edges to SalaryCalculationItem entities.
+func (scu *SalaryCalculationUpdate) RemoveItems(s ...*SalaryCalculationItem) *SalaryCalculationUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.RemoveItemIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (scu *SalaryCalculationUpdate) Save(ctx context.Context) (int, error) {
 	scu.defaults()
@@ -281,10 +469,7 @@ func (scu *SalaryCalculationUpdate) sqlSave(ctx context.Context) (n int, err err
 		_spec.SetField(salarycalculation.FieldCalculationMonth, field.TypeTime, value)
 	}
 	if value, ok := scu.mutation.BaseSalary(); ok {
-		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeFloat64, value)
-	}
-	if value, ok := scu.mutation.AddedBaseSalary(); ok {
-		_spec.AddField(salarycalculation.FieldBaseSalary, field.TypeFloat64, value)
+		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeOther, value)
 	}
 	if value, ok := scu.mutation.TotalWorkingDays(); ok {
 		_spec.SetField(salarycalculation.FieldTotalWorkingDays, field.TypeInt, value)
@@ -304,17 +489,47 @@ func (scu *SalaryCalculationUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := scu.mutation.AddedPresentDays(); ok {
 		_spec.AddField(salarycalculation.FieldPresentDays, field.TypeInt, value)
 	}
-	if value, ok := scu.mutation.FinalSalary(); ok {
-		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeFloat64, value)
+	if value, ok := scu.mutation.PaidDays(); ok {
+		_spec.SetField(salarycalculation.FieldPaidDays, field.TypeOther, value)
 	}
-	if value, ok := scu.mutation.AddedFinalSalary(); ok {
-		_spec.AddField(salarycalculation.FieldFinalSalary, field.TypeFloat64, value)
+	if value, ok := scu.mutation.LateDays(); ok {
+		_spec.SetField(salarycalculation.FieldLateDays, field.TypeInt, value)
 	}
-	if value, ok := scu.mutation.DeductionAmount(); ok {
-		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeFloat64, value)
+	if value, ok := scu.mutation.AddedLateDays(); ok {
+		_spec.AddField(salarycalculation.FieldLateDays, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.LatePenalty(); ok {
+		_spec.SetField(salarycalculation.FieldLatePenalty, field.TypeOther, value)
+	}
+	if value, ok := scu.mutation.PayPolicy(); ok {
+		_spec.SetField(salarycalculation.FieldPayPolicy, field.TypeString, value)
+	}
+	if scu.mutation.PayPolicyCleared() {
+		_spec.ClearField(salarycalculation.FieldPayPolicy, field.TypeString)
+	}
+	if value, ok := scu.mutation.WorkedMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.AddedWorkedMinutes(); ok {
+		_spec.AddField(salarycalculation.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.OvertimeMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.AddedOvertimeMinutes(); ok {
+		_spec.AddField(salarycalculation.FieldOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.OvertimePay(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimePay, field.TypeOther, value)
 	}
-	if value, ok := scu.mutation.AddedDeductionAmount(); ok {
-		_spec.AddField(salarycalculation.FieldDeductionAmount, field.TypeFloat64, value)
+	if value, ok := scu.mutation.TaxableIncome(); ok {
+		_spec.SetField(salarycalculation.FieldTaxableIncome, field.TypeOther, value)
+	}
+	if value, ok := scu.mutation.FinalSalary(); ok {
+		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeOther, value)
+	}
+	if value, ok := scu.mutation.DeductionAmount(); ok {
+		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeOther, value)
 	}
 	if value, ok := scu.mutation.CalculationFormula(); ok {
 		_spec.SetField(salarycalculation.FieldCalculationFormula, field.TypeString, value)
@@ -351,6 +566,80 @@ func (scu *SalaryCalculationUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if scu.mutation.PayrollRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculation.PayrollRunTable,
+			Columns: []string{salarycalculation.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculation.PayrollRunTable,
+			Columns: []string{salarycalculation.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !scu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(scu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -405,6 +694,26 @@ func (scuo *SalaryCalculationUpdateOne) SetEmployeeID(u uint64) *SalaryCalculati
 	return scuo
 }
 
+// SetPayrollRunID sets the "payroll_run_id" field.
+func (scuo *SalaryCalculationUpdateOne) SetPayrollRunID(u uint64) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetPayrollRunID(u)
+	return scuo
+}
+
+// SetNillablePayrollRunID sets the "payroll_run_id" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillablePayrollRunID(u *uint64) *SalaryCalculationUpdateOne {
+	if u != nil {
+		scuo.SetPayrollRunID(*u)
+	}
+	return scuo
+}
+
+// ClearPayrollRunID clears the value of the "payroll_run_id" field.
+func (scuo *SalaryCalculationUpdateOne) ClearPayrollRunID() *SalaryCalculationUpdateOne {
+	scuo.mutation.ClearPayrollRunID()
+	return scuo
+}
+
 // SetCalculationMonth sets the "calculation_month" field.
 func (scuo *SalaryCalculationUpdateOne) SetCalculationMonth(t time.Time) *SalaryCalculationUpdateOne {
 	scuo.mutation.SetCalculationMonth(t)
@@ -412,15 +721,8 @@ func (scuo *SalaryCalculationUpdateOne) SetCalculationMonth(t time.Time) *Salary
 }
 
 // SetBaseSalary sets the "base_salary" field.
-func (scuo *SalaryCalculationUpdateOne) SetBaseSalary(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.ResetBaseSalary()
-	scuo.mutation.SetBaseSalary(f)
-	return scuo
-}
-
-// AddBaseSalary adds f to the "base_salary" field.
-func (scuo *SalaryCalculationUpdateOne) AddBaseSalary(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.AddBaseSalary(f)
+func (scuo *SalaryCalculationUpdateOne) SetBaseSalary(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetBaseSalary(d)
 	return scuo
 }
 
@@ -479,37 +781,162 @@ func (scuo *SalaryCalculationUpdateOne) AddPresentDays(i int) *SalaryCalculation
 	return scuo
 }
 
-// SetFinalSalary sets the "final_salary" field.
-func (scuo *SalaryCalculationUpdateOne) SetFinalSalary(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.ResetFinalSalary()
-	scuo.mutation.SetFinalSalary(f)
+// SetPaidDays sets the "paid_days" field.
+func (scuo *SalaryCalculationUpdateOne) SetPaidDays(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetPaidDays(d)
 	return scuo
 }
 
-// AddFinalSalary adds f to the "final_salary" field.
-func (scuo *SalaryCalculationUpdateOne) AddFinalSalary(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.AddFinalSalary(f)
+// SetNillablePaidDays sets the "paid_days" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillablePaidDays(d *decimal.Decimal) *SalaryCalculationUpdateOne {
+	if d != nil {
+		scuo.SetPaidDays(*d)
+	}
 	return scuo
 }
 
-// SetDeductionAmount sets the "deduction_amount" field.
-func (scuo *SalaryCalculationUpdateOne) SetDeductionAmount(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.ResetDeductionAmount()
-	scuo.mutation.SetDeductionAmount(f)
+// SetLateDays sets the "late_days" field.
+func (scuo *SalaryCalculationUpdateOne) SetLateDays(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.ResetLateDays()
+	scuo.mutation.SetLateDays(i)
 	return scuo
 }
 
-// SetNillableDeductionAmount sets the "deduction_amount" field if the given value is not nil.
-func (scuo *SalaryCalculationUpdateOne) SetNillableDeductionAmount(f *float64) *SalaryCalculationUpdateOne {
-	if f != nil {
-		scuo.SetDeductionAmount(*f)
+// SetNillableLateDays sets the "late_days" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableLateDays(i *int) *SalaryCalculationUpdateOne {
+	if i != nil {
+		scuo.SetLateDays(*i)
+	}
+	return scuo
+}
+
+// AddLateDays adds i to the "late_days" field.
+func (scuo *SalaryCalculationUpdateOne) AddLateDays(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.AddLateDays(i)
+	return scuo
+}
+
+// SetLatePenalty sets the "late_penalty" field.
+func (scuo *SalaryCalculationUpdateOne) SetLatePenalty(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetLatePenalty(d)
+	return scuo
+}
+
+// SetNillableLatePenalty sets the "late_penalty" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableLatePenalty(d *decimal.Decimal) *SalaryCalculationUpdateOne {
+	if d != nil {
+		scuo.SetLatePenalty(*d)
+	}
+	return scuo
+}
+
+// SetPayPolicy sets the "pay_policy" field.
+func (scuo *SalaryCalculationUpdateOne) SetPayPolicy(s string) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetPayPolicy(s)
+	return scuo
+}
+
+// SetNillablePayPolicy sets the "pay_policy" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillablePayPolicy(s *string) *SalaryCalculationUpdateOne {
+	if s != nil {
+		scuo.SetPayPolicy(*s)
+	}
+	return scuo
+}
+
+// ClearPayPolicy clears the value of the "pay_policy" field.
+func (scuo *SalaryCalculationUpdateOne) ClearPayPolicy() *SalaryCalculationUpdateOne {
+	scuo.mutation.ClearPayPolicy()
+	return scuo
+}
+
+// SetWorkedMinutes sets the "worked_minutes" field.
+func (scuo *SalaryCalculationUpdateOne) SetWorkedMinutes(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.ResetWorkedMinutes()
+	scuo.mutation.SetWorkedMinutes(i)
+	return scuo
+}
+
+// SetNillableWorkedMinutes sets the "worked_minutes" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableWorkedMinutes(i *int) *SalaryCalculationUpdateOne {
+	if i != nil {
+		scuo.SetWorkedMinutes(*i)
+	}
+	return scuo
+}
+
+// AddWorkedMinutes adds i to the "worked_minutes" field.
+func (scuo *SalaryCalculationUpdateOne) AddWorkedMinutes(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.AddWorkedMinutes(i)
+	return scuo
+}
+
+// SetOvertimeMinutes sets the "overtime_minutes" field.
+func (scuo *SalaryCalculationUpdateOne) SetOvertimeMinutes(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.ResetOvertimeMinutes()
+	scuo.mutation.SetOvertimeMinutes(i)
+	return scuo
+}
+
+// SetNillableOvertimeMinutes sets the "overtime_minutes" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableOvertimeMinutes(i *int) *SalaryCalculationUpdateOne {
+	if i != nil {
+		scuo.SetOvertimeMinutes(*i)
+	}
+	return scuo
+}
+
+// AddOvertimeMinutes adds i to the "overtime_minutes" field.
+func (scuo *SalaryCalculationUpdateOne) AddOvertimeMinutes(i int) *SalaryCalculationUpdateOne {
+	scuo.mutation.AddOvertimeMinutes(i)
+	return scuo
+}
+
+// SetOvertimePay sets the "overtime_pay" field.
+func (scuo *SalaryCalculationUpdateOne) SetOvertimePay(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetOvertimePay(d)
+	return scuo
+}
+
+// SetNillableOvertimePay sets the "overtime_pay" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableOvertimePay(d *decimal.Decimal) *SalaryCalculationUpdateOne {
+	if d != nil {
+		scuo.SetOvertimePay(*d)
 	}
 	return scuo
 }
 
-// AddDeductionAmount adds f to the "deduction_amount" field.
-func (scuo *SalaryCalculationUpdateOne) AddDeductionAmount(f float64) *SalaryCalculationUpdateOne {
-	scuo.mutation.AddDeductionAmount(f)
+// SetTaxableIncome sets the "taxable_income" field.
+func (scuo *SalaryCalculationUpdateOne) SetTaxableIncome(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetTaxableIncome(d)
+	return scuo
+}
+
+// SetNillableTaxableIncome sets the "taxable_income" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableTaxableIncome(d *decimal.Decimal) *SalaryCalculationUpdateOne {
+	if d != nil {
+		scuo.SetTaxableIncome(*d)
+	}
+	return scuo
+}
+
+// SetFinalSalary sets the "final_salary" field.
+func (scuo *SalaryCalculationUpdateOne) SetFinalSalary(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetFinalSalary(d)
+	return scuo
+}
+
+// SetDeductionAmount sets the "deduction_amount" field.
+func (scuo *SalaryCalculationUpdateOne) SetDeductionAmount(d decimal.Decimal) *SalaryCalculationUpdateOne {
+	scuo.mutation.SetDeductionAmount(d)
+	return scuo
+}
+
+// SetNillableDeductionAmount sets the "deduction_amount" field if the given value is not nil.
+func (scuo *SalaryCalculationUpdateOne) SetNillableDeductionAmount(d *decimal.Decimal) *SalaryCalculationUpdateOne {
+	if d != nil {
+		scuo.SetDeductionAmount(*d)
+	}
 	return scuo
 }
 
@@ -538,6 +965,26 @@ func (scuo *SalaryCalculationUpdateOne) SetEmployee(e *Employee) *SalaryCalculat
 	return scuo.SetEmployeeID(e.ID)
 }
 
+// SetPayrollRun sets the "payroll_run" edge to the PayrollRun entity.
+func (scuo *SalaryCalculationUpdateOne) SetPayrollRun(p *PayrollRun) *SalaryCalculationUpdateOne {
+	return scuo.SetPayrollRunID(p.ID)
+}
+
+// AddItemIDs adds the "items" edge to the SalaryCalculationItem entity by IDs.
+func (scuo *SalaryCalculationUpdateOne) AddItemIDs(ids ...uint64) *SalaryCalculationUpdateOne {
+	scuo.mutation.AddItemIDs(ids...)
+	return scuo
+}
+
+// AddItems adds the "items" edges to the SalaryCalculationItem entity.
+func (scuo *SalaryCalculationUpdateOne) AddItems(s ...*SalaryCalculationItem) *SalaryCalculationUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.AddItemIDs(ids...)
+}
+
 // Mutation returns the SalaryCalculationMutation object of the builder.
 func (scuo *SalaryCalculationUpdateOne) Mutation() *SalaryCalculationMutation {
 	return scuo.mutation
@@ -549,6 +996,33 @@ func (scuo *SalaryCalculationUpdateOne) ClearEmployee() *SalaryCalculationUpdate
 	return scuo
 }
 
+// ClearPayrollRun clears the "payroll_run" edge to the PayrollRun entity.
+func (scuo *SalaryCalculationUpdateOne) ClearPayrollRun() *SalaryCalculationUpdateOne {
+	scuo.mutation.ClearPayrollRun()
+	return scuo
+}
+
+// ClearItems clears all "items" edges to the SalaryCalculationItem entity.
+func (scuo *SalaryCalculationUpdateOne) ClearItems() *SalaryCalculationUpdateOne {
+	scuo.mutation.ClearItems()
+	return scuo
+}
+
+// RemoveItemIDs removes the "items" edge to SalaryCalculationItem entities by IDs.
+func (scuo *SalaryCalculationUpdateOne) RemoveItemIDs(ids ...uint64) *SalaryCalculationUpdateOne {
+	scuo.mutation.RemoveItemIDs(ids...)
+	return scuo
+}
+
+// RemoveItems removes "items" edges to SalaryCalculationItem entities.
+func (scuo *SalaryCalculationUpdateOne) RemoveItems(s ...*SalaryCalculationItem) *SalaryCalculationUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.RemoveItemIDs(ids...)
+}
+
 // Where appends a list predicates to the SalaryCalculationUpdate builder.
 func (scuo *SalaryCalculationUpdateOne) Where(ps ...predicate.SalaryCalculation) *SalaryCalculationUpdateOne {
 	scuo.mutation.Where(ps...)
@@ -654,10 +1128,7 @@ func (scuo *SalaryCalculationUpdateOne) sqlSave(ctx context.Context) (_node *Sal
 		_spec.SetField(salarycalculation.FieldCalculationMonth, field.TypeTime, value)
 	}
 	if value, ok := scuo.mutation.BaseSalary(); ok {
-		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeFloat64, value)
-	}
-	if value, ok := scuo.mutation.AddedBaseSalary(); ok {
-		_spec.AddField(salarycalculation.FieldBaseSalary, field.TypeFloat64, value)
+		_spec.SetField(salarycalculation.FieldBaseSalary, field.TypeOther, value)
 	}
 	if value, ok := scuo.mutation.TotalWorkingDays(); ok {
 		_spec.SetField(salarycalculation.FieldTotalWorkingDays, field.TypeInt, value)
@@ -677,17 +1148,47 @@ func (scuo *SalaryCalculationUpdateOne) sqlSave(ctx context.Context) (_node *Sal
 	if value, ok := scuo.mutation.AddedPresentDays(); ok {
 		_spec.AddField(salarycalculation.FieldPresentDays, field.TypeInt, value)
 	}
-	if value, ok := scuo.mutation.FinalSalary(); ok {
-		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeFloat64, value)
+	if value, ok := scuo.mutation.PaidDays(); ok {
+		_spec.SetField(salarycalculation.FieldPaidDays, field.TypeOther, value)
 	}
-	if value, ok := scuo.mutation.AddedFinalSalary(); ok {
-		_spec.AddField(salarycalculation.FieldFinalSalary, field.TypeFloat64, value)
+	if value, ok := scuo.mutation.LateDays(); ok {
+		_spec.SetField(salarycalculation.FieldLateDays, field.TypeInt, value)
 	}
-	if value, ok := scuo.mutation.DeductionAmount(); ok {
-		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeFloat64, value)
+	if value, ok := scuo.mutation.AddedLateDays(); ok {
+		_spec.AddField(salarycalculation.FieldLateDays, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.LatePenalty(); ok {
+		_spec.SetField(salarycalculation.FieldLatePenalty, field.TypeOther, value)
+	}
+	if value, ok := scuo.mutation.PayPolicy(); ok {
+		_spec.SetField(salarycalculation.FieldPayPolicy, field.TypeString, value)
+	}
+	if scuo.mutation.PayPolicyCleared() {
+		_spec.ClearField(salarycalculation.FieldPayPolicy, field.TypeString)
+	}
+	if value, ok := scuo.mutation.WorkedMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.AddedWorkedMinutes(); ok {
+		_spec.AddField(salarycalculation.FieldWorkedMinutes, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.OvertimeMinutes(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.AddedOvertimeMinutes(); ok {
+		_spec.AddField(salarycalculation.FieldOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.OvertimePay(); ok {
+		_spec.SetField(salarycalculation.FieldOvertimePay, field.TypeOther, value)
 	}
-	if value, ok := scuo.mutation.AddedDeductionAmount(); ok {
-		_spec.AddField(salarycalculation.FieldDeductionAmount, field.TypeFloat64, value)
+	if value, ok := scuo.mutation.TaxableIncome(); ok {
+		_spec.SetField(salarycalculation.FieldTaxableIncome, field.TypeOther, value)
+	}
+	if value, ok := scuo.mutation.FinalSalary(); ok {
+		_spec.SetField(salarycalculation.FieldFinalSalary, field.TypeOther, value)
+	}
+	if value, ok := scuo.mutation.DeductionAmount(); ok {
+		_spec.SetField(salarycalculation.FieldDeductionAmount, field.TypeOther, value)
 	}
 	if value, ok := scuo.mutation.CalculationFormula(); ok {
 		_spec.SetField(salarycalculation.FieldCalculationFormula, field.TypeString, value)
@@ -724,6 +1225,80 @@ func (scuo *SalaryCalculationUpdateOne) sqlSave(ctx context.Context) (_node *Sal
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if scuo.mutation.PayrollRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculation.PayrollRunTable,
+			Columns: []string{salarycalculation.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.PayrollRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculation.PayrollRunTable,
+			Columns: []string{salarycalculation.PayrollRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payrollrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !scuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycalculation.ItemsTable,
+			Columns: []string{salarycalculation.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(scuo.modifiers...)
 	_node = &SalaryCalculation{config: scuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryCalculationItem is the model entity for the SalaryCalculationItem schema.
+type SalaryCalculationItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to salary_calculations table
+	SalaryCalculationID uint64 `json:"salary_calculation_id,omitempty"`
+	// Component the line was calculated from, empty for the prorated base salary, overtime and late penalty
+	SalaryComponentID *uint64 `json:"salary_component_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Earnings and deductions add up to the final salary, employer lines are paid on top of it
+	Kind salarycalculationitem.Kind `json:"kind,omitempty"`
+	// Amount of the line rounded to whole rupiah
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// How the amount was calculated (for audit purposes)
+	Basis string `json:"basis,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SalaryCalculationItemQuery when eager-loading is set.
+	Edges        SalaryCalculationItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SalaryCalculationItemEdges holds the relations/edges for other nodes in the graph.
+type SalaryCalculationItemEdges struct {
+	// SalaryCalculation holds the value of the salary_calculation edge.
+	SalaryCalculation *SalaryCalculation `json:"salary_calculation,omitempty"`
+	// SalaryComponent holds the value of the salary_component edge.
+	SalaryComponent *SalaryComponent `json:"salary_component,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SalaryCalculationOrErr returns the SalaryCalculation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryCalculationItemEdges) SalaryCalculationOrErr() (*SalaryCalculation, error) {
+	if e.loadedTypes[0] {
+		if e.SalaryCalculation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: salarycalculation.Label}
+		}
+		return e.SalaryCalculation, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_calculation"}
+}
+
+// SalaryComponentOrErr returns the SalaryComponent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryCalculationItemEdges) SalaryComponentOrErr() (*SalaryComponent, error) {
+	if e.loadedTypes[1] {
+		if e.SalaryComponent == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: salarycomponent.Label}
+		}
+		return e.SalaryComponent, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_component"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SalaryCalculationItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case salarycalculationitem.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case salarycalculationitem.FieldID, salarycalculationitem.FieldSalaryCalculationID, salarycalculationitem.FieldSalaryComponentID:
+			values[i] = new(sql.NullInt64)
+		case salarycalculationitem.FieldCode, salarycalculationitem.FieldName, salarycalculationitem.FieldKind, salarycalculationitem.FieldBasis:
+			values[i] = new(sql.NullString)
+		case salarycalculationitem.FieldCreatedAt, salarycalculationitem.FieldModifiedAt, salarycalculationitem.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SalaryCalculationItem fields.
+func (sci *SalaryCalculationItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case salarycalculationitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sci.ID = uint64(value.Int64)
+		case salarycalculationitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sci.CreatedAt = value.Time
+			}
+		case salarycalculationitem.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				sci.ModifiedAt = value.Time
+			}
+		case salarycalculationitem.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sci.DeletedAt = value.Time
+			}
+		case salarycalculationitem.FieldSalaryCalculationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field salary_calculation_id", values[i])
+			} else if value.Valid {
+				sci.SalaryCalculationID = uint64(value.Int64)
+			}
+		case salarycalculationitem.FieldSalaryComponentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field salary_component_id", values[i])
+			} else if value.Valid {
+				sci.SalaryComponentID = new(uint64)
+				*sci.SalaryComponentID = uint64(value.Int64)
+			}
+		case salarycalculationitem.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				sci.Code = value.String
+			}
+		case salarycalculationitem.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sci.Name = value.String
+			}
+		case salarycalculationitem.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				sci.Kind = salarycalculationitem.Kind(value.String)
+			}
+		case salarycalculationitem.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				sci.Amount = *value
+			}
+		case salarycalculationitem.FieldBasis:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field basis", values[i])
+			} else if value.Valid {
+				sci.Basis = value.String
+			}
+		default:
+			sci.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SalaryCalculationItem.
+// This includes values selected through modifiers, order, etc.
+func (sci *SalaryCalculationItem) Value(name string) (ent.Value, error) {
+	return sci.selectValues.Get(name)
+}
+
+// QuerySalaryCalculation queries the "salary_calculation" edge of the SalaryCalculationItem entity.
+func (sci *SalaryCalculationItem) QuerySalaryCalculation() *SalaryCalculationQuery {
+	return NewSalaryCalculationItemClient(sci.config).QuerySalaryCalculation(sci)
+}
+
+// QuerySalaryComponent queries the "salary_component" edge of the SalaryCalculationItem entity.
+func (sci *SalaryCalculationItem) QuerySalaryComponent() *SalaryComponentQuery {
+	return NewSalaryCalculationItemClient(sci.config).QuerySalaryComponent(sci)
+}
+
+// Update returns a builder for updating this SalaryCalculationItem.
+// Note that you need to call SalaryCalculationItem.Unwrap() before calling this method if this SalaryCalculationItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sci *SalaryCalculationItem) Update() *SalaryCalculationItemUpdateOne {
+	return NewSalaryCalculationItemClient(sci.config).UpdateOne(sci)
+}
+
+// Unwrap unwraps the SalaryCalculationItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sci *SalaryCalculationItem) Unwrap() *SalaryCalculationItem {
+	_tx, ok := sci.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SalaryCalculationItem is not a transactional entity")
+	}
+	sci.config.driver = _tx.drv
+	return sci
+}
+
+// String implements the fmt.Stringer.
+func (sci *SalaryCalculationItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("SalaryCalculationItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sci.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sci.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(sci.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(sci.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("salary_calculation_id=")
+	builder.WriteString(fmt.Sprintf("%v", sci.SalaryCalculationID))
+	builder.WriteString(", ")
+	if v := sci.SalaryComponentID; v != nil {
+		builder.WriteString("salary_component_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(sci.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sci.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", sci.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", sci.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("basis=")
+	builder.WriteString(sci.Basis)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SalaryCalculationItems is a parsable slice of SalaryCalculationItem.
+type SalaryCalculationItems []*SalaryCalculationItem
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package salarycalculationitem
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the salarycalculationitem type in the database.
+	Label = "salary_calculation_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSalaryCalculationID holds the string denoting the salary_calculation_id field in the database.
+	FieldSalaryCalculationID = "salary_calculation_id"
+	// FieldSalaryComponentID holds the string denoting the salary_component_id field in the database.
+	FieldSalaryComponentID = "salary_component_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldBasis holds the string denoting the basis field in the database.
+	FieldBasis = "basis"
+	// EdgeSalaryCalculation holds the string denoting the salary_calculation edge name in mutations.
+	EdgeSalaryCalculation = "salary_calculation"
+	// EdgeSalaryComponent holds the string denoting the salary_component edge name in mutations.
+	EdgeSalaryComponent = "salary_component"
+	// Table holds the table name of the salarycalculationitem in the database.
+	Table = "salary_calculation_items"
+	// SalaryCalculationTable is the table that holds the salary_calculation relation/edge.
+	SalaryCalculationTable = "salary_calculation_items"
+	// SalaryCalculationInverseTable is the table name for the SalaryCalculation entity.
+	// It exists in this package in order to avoid circular dependency with the "salarycalculation" package.
+	SalaryCalculationInverseTable = "salary_calculations"
+	// SalaryCalculationColumn is the table column denoting the salary_calculation relation/edge.
+	SalaryCalculationColumn = "salary_calculation_id"
+	// SalaryComponentTable is the table that holds the salary_component relation/edge.
+	SalaryComponentTable = "salary_calculation_items"
+	// SalaryComponentInverseTable is the table name for the SalaryComponent entity.
+	// It exists in this package in order to avoid circular dependency with the "salarycomponent" package.
+	SalaryComponentInverseTable = "salary_components"
+	// SalaryComponentColumn is the table column denoting the salary_component relation/edge.
+	SalaryComponentColumn = "salary_component_id"
+)
+
+// Columns holds all SQL columns for salarycalculationitem fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldSalaryCalculationID,
+	FieldSalaryComponentID,
+	FieldCode,
+	FieldName,
+	FieldKind,
+	FieldAmount,
+	FieldBasis,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindEarning   Kind = "earning"
+	KindDeduction Kind = "deduction"
+	KindEmployer  Kind = "employer"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindEarning, KindDeduction, KindEmployer:
+		return nil
+	default:
+		return fmt.Errorf("salarycalculationitem: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the SalaryCalculationItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySalaryCalculationID orders the results by the salary_calculation_id field.
+func BySalaryCalculationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalaryCalculationID, opts...).ToFunc()
+}
+
+// BySalaryComponentID orders the results by the salary_component_id field.
+func BySalaryComponentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalaryComponentID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByBasis orders the results by the basis field.
+func ByBasis(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBasis, opts...).ToFunc()
+}
+
+// BySalaryCalculationField orders the results by salary_calculation field.
+func BySalaryCalculationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryCalculationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySalaryComponentField orders the results by salary_component field.
+func BySalaryComponentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryComponentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSalaryCalculationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryCalculationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SalaryCalculationTable, SalaryCalculationColumn),
+	)
+}
+func newSalaryComponentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryComponentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SalaryComponentTable, SalaryComponentColumn),
+	)
+}
@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package salarycalculationitem
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SalaryCalculationID applies equality check predicate on the "salary_calculation_id" field. It's identical to SalaryCalculationIDEQ.
+func SalaryCalculationID(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldSalaryCalculationID, v))
+}
+
+// SalaryComponentID applies equality check predicate on the "salary_component_id" field. It's identical to SalaryComponentIDEQ.
+func SalaryComponentID(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldSalaryComponentID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldName, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// Basis applies equality check predicate on the "basis" field. It's identical to BasisEQ.
+func Basis(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldBasis, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SalaryCalculationIDEQ applies the EQ predicate on the "salary_calculation_id" field.
+func SalaryCalculationIDEQ(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldSalaryCalculationID, v))
+}
+
+// SalaryCalculationIDNEQ applies the NEQ predicate on the "salary_calculation_id" field.
+func SalaryCalculationIDNEQ(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldSalaryCalculationID, v))
+}
+
+// SalaryCalculationIDIn applies the In predicate on the "salary_calculation_id" field.
+func SalaryCalculationIDIn(vs ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldSalaryCalculationID, vs...))
+}
+
+// SalaryCalculationIDNotIn applies the NotIn predicate on the "salary_calculation_id" field.
+func SalaryCalculationIDNotIn(vs ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldSalaryCalculationID, vs...))
+}
+
+// SalaryComponentIDEQ applies the EQ predicate on the "salary_component_id" field.
+func SalaryComponentIDEQ(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldSalaryComponentID, v))
+}
+
+// SalaryComponentIDNEQ applies the NEQ predicate on the "salary_component_id" field.
+func SalaryComponentIDNEQ(v uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldSalaryComponentID, v))
+}
+
+// SalaryComponentIDIn applies the In predicate on the "salary_component_id" field.
+func SalaryComponentIDIn(vs ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldSalaryComponentID, vs...))
+}
+
+// SalaryComponentIDNotIn applies the NotIn predicate on the "salary_component_id" field.
+func SalaryComponentIDNotIn(vs ...uint64) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldSalaryComponentID, vs...))
+}
+
+// SalaryComponentIDIsNil applies the IsNil predicate on the "salary_component_id" field.
+func SalaryComponentIDIsNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIsNull(FieldSalaryComponentID))
+}
+
+// SalaryComponentIDNotNil applies the NotNil predicate on the "salary_component_id" field.
+func SalaryComponentIDNotNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotNull(FieldSalaryComponentID))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldAmount, v))
+}
+
+// BasisEQ applies the EQ predicate on the "basis" field.
+func BasisEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEQ(FieldBasis, v))
+}
+
+// BasisNEQ applies the NEQ predicate on the "basis" field.
+func BasisNEQ(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNEQ(FieldBasis, v))
+}
+
+// BasisIn applies the In predicate on the "basis" field.
+func BasisIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIn(FieldBasis, vs...))
+}
+
+// BasisNotIn applies the NotIn predicate on the "basis" field.
+func BasisNotIn(vs ...string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotIn(FieldBasis, vs...))
+}
+
+// BasisGT applies the GT predicate on the "basis" field.
+func BasisGT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGT(FieldBasis, v))
+}
+
+// BasisGTE applies the GTE predicate on the "basis" field.
+func BasisGTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldGTE(FieldBasis, v))
+}
+
+// BasisLT applies the LT predicate on the "basis" field.
+func BasisLT(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLT(FieldBasis, v))
+}
+
+// BasisLTE applies the LTE predicate on the "basis" field.
+func BasisLTE(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldLTE(FieldBasis, v))
+}
+
+// BasisContains applies the Contains predicate on the "basis" field.
+func BasisContains(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContains(FieldBasis, v))
+}
+
+// BasisHasPrefix applies the HasPrefix predicate on the "basis" field.
+func BasisHasPrefix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasPrefix(FieldBasis, v))
+}
+
+// BasisHasSuffix applies the HasSuffix predicate on the "basis" field.
+func BasisHasSuffix(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldHasSuffix(FieldBasis, v))
+}
+
+// BasisIsNil applies the IsNil predicate on the "basis" field.
+func BasisIsNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldIsNull(FieldBasis))
+}
+
+// BasisNotNil applies the NotNil predicate on the "basis" field.
+func BasisNotNil() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldNotNull(FieldBasis))
+}
+
+// BasisEqualFold applies the EqualFold predicate on the "basis" field.
+func BasisEqualFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldEqualFold(FieldBasis, v))
+}
+
+// BasisContainsFold applies the ContainsFold predicate on the "basis" field.
+func BasisContainsFold(v string) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(sql.FieldContainsFold(FieldBasis, v))
+}
+
+// HasSalaryCalculation applies the HasEdge predicate on the "salary_calculation" edge.
+func HasSalaryCalculation() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SalaryCalculationTable, SalaryCalculationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryCalculationWith applies the HasEdge predicate on the "salary_calculation" edge with a given conditions (other predicates).
+func HasSalaryCalculationWith(preds ...predicate.SalaryCalculation) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		step := newSalaryCalculationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSalaryComponent applies the HasEdge predicate on the "salary_component" edge.
+func HasSalaryComponent() predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SalaryComponentTable, SalaryComponentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryComponentWith applies the HasEdge predicate on the "salary_component" edge with a given conditions (other predicates).
+func HasSalaryComponentWith(preds ...predicate.SalaryComponent) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		step := newSalaryComponentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SalaryCalculationItem) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SalaryCalculationItem) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SalaryCalculationItem) predicate.SalaryCalculationItem {
+	return predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
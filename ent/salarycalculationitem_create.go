@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryCalculationItemCreate is the builder for creating a SalaryCalculationItem entity.
+type SalaryCalculationItemCreate struct {
+	config
+	mutation *SalaryCalculationItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scic *SalaryCalculationItemCreate) SetCreatedAt(t time.Time) *SalaryCalculationItemCreate {
+	scic.mutation.SetCreatedAt(t)
+	return scic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scic *SalaryCalculationItemCreate) SetNillableCreatedAt(t *time.Time) *SalaryCalculationItemCreate {
+	if t != nil {
+		scic.SetCreatedAt(*t)
+	}
+	return scic
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scic *SalaryCalculationItemCreate) SetModifiedAt(t time.Time) *SalaryCalculationItemCreate {
+	scic.mutation.SetModifiedAt(t)
+	return scic
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (scic *SalaryCalculationItemCreate) SetNillableModifiedAt(t *time.Time) *SalaryCalculationItemCreate {
+	if t != nil {
+		scic.SetModifiedAt(*t)
+	}
+	return scic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scic *SalaryCalculationItemCreate) SetDeletedAt(t time.Time) *SalaryCalculationItemCreate {
+	scic.mutation.SetDeletedAt(t)
+	return scic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scic *SalaryCalculationItemCreate) SetNillableDeletedAt(t *time.Time) *SalaryCalculationItemCreate {
+	if t != nil {
+		scic.SetDeletedAt(*t)
+	}
+	return scic
+}
+
+// SetSalaryCalculationID sets the "salary_calculation_id" field.
+func (scic *SalaryCalculationItemCreate) SetSalaryCalculationID(u uint64) *SalaryCalculationItemCreate {
+	scic.mutation.SetSalaryCalculationID(u)
+	return scic
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (scic *SalaryCalculationItemCreate) SetSalaryComponentID(u uint64) *SalaryCalculationItemCreate {
+	scic.mutation.SetSalaryComponentID(u)
+	return scic
+}
+
+// SetNillableSalaryComponentID sets the "salary_component_id" field if the given value is not nil.
+func (scic *SalaryCalculationItemCreate) SetNillableSalaryComponentID(u *uint64) *SalaryCalculationItemCreate {
+	if u != nil {
+		scic.SetSalaryComponentID(*u)
+	}
+	return scic
+}
+
+// SetCode sets the "code" field.
+func (scic *SalaryCalculationItemCreate) SetCode(s string) *SalaryCalculationItemCreate {
+	scic.mutation.SetCode(s)
+	return scic
+}
+
+// SetName sets the "name" field.
+func (scic *SalaryCalculationItemCreate) SetName(s string) *SalaryCalculationItemCreate {
+	scic.mutation.SetName(s)
+	return scic
+}
+
+// SetKind sets the "kind" field.
+func (scic *SalaryCalculationItemCreate) SetKind(s salarycalculationitem.Kind) *SalaryCalculationItemCreate {
+	scic.mutation.SetKind(s)
+	return scic
+}
+
+// SetAmount sets the "amount" field.
+func (scic *SalaryCalculationItemCreate) SetAmount(d decimal.Decimal) *SalaryCalculationItemCreate {
+	scic.mutation.SetAmount(d)
+	return scic
+}
+
+// SetBasis sets the "basis" field.
+func (scic *SalaryCalculationItemCreate) SetBasis(s string) *SalaryCalculationItemCreate {
+	scic.mutation.SetBasis(s)
+	return scic
+}
+
+// SetNillableBasis sets the "basis" field if the given value is not nil.
+func (scic *SalaryCalculationItemCreate) SetNillableBasis(s *string) *SalaryCalculationItemCreate {
+	if s != nil {
+		scic.SetBasis(*s)
+	}
+	return scic
+}
+
+// SetID sets the "id" field.
+func (scic *SalaryCalculationItemCreate) SetID(u uint64) *SalaryCalculationItemCreate {
+	scic.mutation.SetID(u)
+	return scic
+}
+
+// SetSalaryCalculation sets the "salary_calculation" edge to the SalaryCalculation entity.
+func (scic *SalaryCalculationItemCreate) SetSalaryCalculation(s *SalaryCalculation) *SalaryCalculationItemCreate {
+	return scic.SetSalaryCalculationID(s.ID)
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (scic *SalaryCalculationItemCreate) SetSalaryComponent(s *SalaryComponent) *SalaryCalculationItemCreate {
+	return scic.SetSalaryComponentID(s.ID)
+}
+
+// Mutation returns the SalaryCalculationItemMutation object of the builder.
+func (scic *SalaryCalculationItemCreate) Mutation() *SalaryCalculationItemMutation {
+	return scic.mutation
+}
+
+// Save creates the SalaryCalculationItem in the database.
+func (scic *SalaryCalculationItemCreate) Save(ctx context.Context) (*SalaryCalculationItem, error) {
+	scic.defaults()
+	return withHooks(ctx, scic.sqlSave, scic.mutation, scic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scic *SalaryCalculationItemCreate) SaveX(ctx context.Context) *SalaryCalculationItem {
+	v, err := scic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scic *SalaryCalculationItemCreate) Exec(ctx context.Context) error {
+	_, err := scic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scic *SalaryCalculationItemCreate) ExecX(ctx context.Context) {
+	if err := scic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scic *SalaryCalculationItemCreate) defaults() {
+	if _, ok := scic.mutation.CreatedAt(); !ok {
+		v := salarycalculationitem.DefaultCreatedAt()
+		scic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scic.mutation.ModifiedAt(); !ok {
+		v := salarycalculationitem.DefaultModifiedAt()
+		scic.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scic *SalaryCalculationItemCreate) check() error {
+	if _, ok := scic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SalaryCalculationItem.created_at"`)}
+	}
+	if _, ok := scic.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "SalaryCalculationItem.modified_at"`)}
+	}
+	if _, ok := scic.mutation.SalaryCalculationID(); !ok {
+		return &ValidationError{Name: "salary_calculation_id", err: errors.New(`ent: missing required field "SalaryCalculationItem.salary_calculation_id"`)}
+	}
+	if _, ok := scic.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "SalaryCalculationItem.code"`)}
+	}
+	if v, ok := scic.mutation.Code(); ok {
+		if err := salarycalculationitem.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.code": %w`, err)}
+		}
+	}
+	if _, ok := scic.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SalaryCalculationItem.name"`)}
+	}
+	if v, ok := scic.mutation.Name(); ok {
+		if err := salarycalculationitem.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.name": %w`, err)}
+		}
+	}
+	if _, ok := scic.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SalaryCalculationItem.kind"`)}
+	}
+	if v, ok := scic.mutation.Kind(); ok {
+		if err := salarycalculationitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := scic.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SalaryCalculationItem.amount"`)}
+	}
+	if _, ok := scic.mutation.SalaryCalculationID(); !ok {
+		return &ValidationError{Name: "salary_calculation", err: errors.New(`ent: missing required edge "SalaryCalculationItem.salary_calculation"`)}
+	}
+	return nil
+}
+
+func (scic *SalaryCalculationItemCreate) sqlSave(ctx context.Context) (*SalaryCalculationItem, error) {
+	if err := scic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	scic.mutation.id = &_node.ID
+	scic.mutation.done = true
+	return _node, nil
+}
+
+func (scic *SalaryCalculationItemCreate) createSpec() (*SalaryCalculationItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SalaryCalculationItem{config: scic.config}
+		_spec = sqlgraph.NewCreateSpec(salarycalculationitem.Table, sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64))
+	)
+	if id, ok := scic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scic.mutation.CreatedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scic.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := scic.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := scic.mutation.Code(); ok {
+		_spec.SetField(salarycalculationitem.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := scic.mutation.Name(); ok {
+		_spec.SetField(salarycalculationitem.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := scic.mutation.Kind(); ok {
+		_spec.SetField(salarycalculationitem.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := scic.mutation.Amount(); ok {
+		_spec.SetField(salarycalculationitem.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := scic.mutation.Basis(); ok {
+		_spec.SetField(salarycalculationitem.FieldBasis, field.TypeString, value)
+		_node.Basis = value
+	}
+	if nodes := scic.mutation.SalaryCalculationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryCalculationTable,
+			Columns: []string{salarycalculationitem.SalaryCalculationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SalaryCalculationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scic.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryComponentTable,
+			Columns: []string{salarycalculationitem.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SalaryComponentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SalaryCalculationItemCreateBulk is the builder for creating many SalaryCalculationItem entities in bulk.
+type SalaryCalculationItemCreateBulk struct {
+	config
+	builders []*SalaryCalculationItemCreate
+}
+
+// Save creates the SalaryCalculationItem entities in the database.
+func (scicb *SalaryCalculationItemCreateBulk) Save(ctx context.Context) ([]*SalaryCalculationItem, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scicb.builders))
+	nodes := make([]*SalaryCalculationItem, len(scicb.builders))
+	mutators := make([]Mutator, len(scicb.builders))
+	for i := range scicb.builders {
+		func(i int, root context.Context) {
+			builder := scicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SalaryCalculationItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scicb *SalaryCalculationItemCreateBulk) SaveX(ctx context.Context) []*SalaryCalculationItem {
+	v, err := scicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scicb *SalaryCalculationItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := scicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scicb *SalaryCalculationItemCreateBulk) ExecX(ctx context.Context) {
+	if err := scicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculationitem"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryCalculationItemDelete is the builder for deleting a SalaryCalculationItem entity.
+type SalaryCalculationItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *SalaryCalculationItemMutation
+}
+
+// Where appends a list predicates to the SalaryCalculationItemDelete builder.
+func (scid *SalaryCalculationItemDelete) Where(ps ...predicate.SalaryCalculationItem) *SalaryCalculationItemDelete {
+	scid.mutation.Where(ps...)
+	return scid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scid *SalaryCalculationItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scid.sqlExec, scid.mutation, scid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scid *SalaryCalculationItemDelete) ExecX(ctx context.Context) int {
+	n, err := scid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scid *SalaryCalculationItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(salarycalculationitem.Table, sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64))
+	if ps := scid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scid.mutation.done = true
+	return affected, err
+}
+
+// SalaryCalculationItemDeleteOne is the builder for deleting a single SalaryCalculationItem entity.
+type SalaryCalculationItemDeleteOne struct {
+	scid *SalaryCalculationItemDelete
+}
+
+// Where appends a list predicates to the SalaryCalculationItemDelete builder.
+func (scido *SalaryCalculationItemDeleteOne) Where(ps ...predicate.SalaryCalculationItem) *SalaryCalculationItemDeleteOne {
+	scido.scid.mutation.Where(ps...)
+	return scido
+}
+
+// Exec executes the deletion query.
+func (scido *SalaryCalculationItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := scido.scid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{salarycalculationitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scido *SalaryCalculationItemDeleteOne) ExecX(ctx context.Context) {
+	if err := scido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryCalculationItemQuery is the builder for querying SalaryCalculationItem entities.
+type SalaryCalculationItemQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []salarycalculationitem.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.SalaryCalculationItem
+	withSalaryCalculation *SalaryCalculationQuery
+	withSalaryComponent   *SalaryComponentQuery
+	modifiers             []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SalaryCalculationItemQuery builder.
+func (sciq *SalaryCalculationItemQuery) Where(ps ...predicate.SalaryCalculationItem) *SalaryCalculationItemQuery {
+	sciq.predicates = append(sciq.predicates, ps...)
+	return sciq
+}
+
+// Limit the number of records to be returned by this query.
+func (sciq *SalaryCalculationItemQuery) Limit(limit int) *SalaryCalculationItemQuery {
+	sciq.ctx.Limit = &limit
+	return sciq
+}
+
+// Offset to start from.
+func (sciq *SalaryCalculationItemQuery) Offset(offset int) *SalaryCalculationItemQuery {
+	sciq.ctx.Offset = &offset
+	return sciq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sciq *SalaryCalculationItemQuery) Unique(unique bool) *SalaryCalculationItemQuery {
+	sciq.ctx.Unique = &unique
+	return sciq
+}
+
+// Order specifies how the records should be ordered.
+func (sciq *SalaryCalculationItemQuery) Order(o ...salarycalculationitem.OrderOption) *SalaryCalculationItemQuery {
+	sciq.order = append(sciq.order, o...)
+	return sciq
+}
+
+// QuerySalaryCalculation chains the current query on the "salary_calculation" edge.
+func (sciq *SalaryCalculationItemQuery) QuerySalaryCalculation() *SalaryCalculationQuery {
+	query := (&SalaryCalculationClient{config: sciq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculationitem.Table, salarycalculationitem.FieldID, selector),
+			sqlgraph.To(salarycalculation.Table, salarycalculation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculationitem.SalaryCalculationTable, salarycalculationitem.SalaryCalculationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySalaryComponent chains the current query on the "salary_component" edge.
+func (sciq *SalaryCalculationItemQuery) QuerySalaryComponent() *SalaryComponentQuery {
+	query := (&SalaryComponentClient{config: sciq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycalculationitem.Table, salarycalculationitem.FieldID, selector),
+			sqlgraph.To(salarycomponent.Table, salarycomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycalculationitem.SalaryComponentTable, salarycalculationitem.SalaryComponentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SalaryCalculationItem entity from the query.
+// Returns a *NotFoundError when no SalaryCalculationItem was found.
+func (sciq *SalaryCalculationItemQuery) First(ctx context.Context) (*SalaryCalculationItem, error) {
+	nodes, err := sciq.Limit(1).All(setContextOp(ctx, sciq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{salarycalculationitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) FirstX(ctx context.Context) *SalaryCalculationItem {
+	node, err := sciq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SalaryCalculationItem ID from the query.
+// Returns a *NotFoundError when no SalaryCalculationItem ID was found.
+func (sciq *SalaryCalculationItemQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = sciq.Limit(1).IDs(setContextOp(ctx, sciq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{salarycalculationitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := sciq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SalaryCalculationItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SalaryCalculationItem entity is found.
+// Returns a *NotFoundError when no SalaryCalculationItem entities are found.
+func (sciq *SalaryCalculationItemQuery) Only(ctx context.Context) (*SalaryCalculationItem, error) {
+	nodes, err := sciq.Limit(2).All(setContextOp(ctx, sciq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{salarycalculationitem.Label}
+	default:
+		return nil, &NotSingularError{salarycalculationitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) OnlyX(ctx context.Context) *SalaryCalculationItem {
+	node, err := sciq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SalaryCalculationItem ID in the query.
+// Returns a *NotSingularError when more than one SalaryCalculationItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sciq *SalaryCalculationItemQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = sciq.Limit(2).IDs(setContextOp(ctx, sciq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{salarycalculationitem.Label}
+	default:
+		err = &NotSingularError{salarycalculationitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := sciq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SalaryCalculationItems.
+func (sciq *SalaryCalculationItemQuery) All(ctx context.Context) ([]*SalaryCalculationItem, error) {
+	ctx = setContextOp(ctx, sciq.ctx, "All")
+	if err := sciq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SalaryCalculationItem, *SalaryCalculationItemQuery]()
+	return withInterceptors[[]*SalaryCalculationItem](ctx, sciq, qr, sciq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) AllX(ctx context.Context) []*SalaryCalculationItem {
+	nodes, err := sciq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SalaryCalculationItem IDs.
+func (sciq *SalaryCalculationItemQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if sciq.ctx.Unique == nil && sciq.path != nil {
+		sciq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sciq.ctx, "IDs")
+	if err = sciq.Select(salarycalculationitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := sciq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sciq *SalaryCalculationItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sciq.ctx, "Count")
+	if err := sciq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sciq, querierCount[*SalaryCalculationItemQuery](), sciq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) CountX(ctx context.Context) int {
+	count, err := sciq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sciq *SalaryCalculationItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sciq.ctx, "Exist")
+	switch _, err := sciq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sciq *SalaryCalculationItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := sciq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SalaryCalculationItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sciq *SalaryCalculationItemQuery) Clone() *SalaryCalculationItemQuery {
+	if sciq == nil {
+		return nil
+	}
+	return &SalaryCalculationItemQuery{
+		config:                sciq.config,
+		ctx:                   sciq.ctx.Clone(),
+		order:                 append([]salarycalculationitem.OrderOption{}, sciq.order...),
+		inters:                append([]Interceptor{}, sciq.inters...),
+		predicates:            append([]predicate.SalaryCalculationItem{}, sciq.predicates...),
+		withSalaryCalculation: sciq.withSalaryCalculation.Clone(),
+		withSalaryComponent:   sciq.withSalaryComponent.Clone(),
+		// clone intermediate query.
+		sql:  sciq.sql.Clone(),
+		path: sciq.path,
+	}
+}
+
+// WithSalaryCalculation tells the query-builder to eager-load the nodes that are connected to
+// the "salary_calculation" edge. The optional arguments are used to configure the query builder of the edge.
+func (sciq *SalaryCalculationItemQuery) WithSalaryCalculation(opts ...func(*SalaryCalculationQuery)) *SalaryCalculationItemQuery {
+	query := (&SalaryCalculationClient{config: sciq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sciq.withSalaryCalculation = query
+	return sciq
+}
+
+// WithSalaryComponent tells the query-builder to eager-load the nodes that are connected to
+// the "salary_component" edge. The optional arguments are used to configure the query builder of the edge.
+func (sciq *SalaryCalculationItemQuery) WithSalaryComponent(opts ...func(*SalaryComponentQuery)) *SalaryCalculationItemQuery {
+	query := (&SalaryComponentClient{config: sciq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sciq.withSalaryComponent = query
+	return sciq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SalaryCalculationItem.Query().
+//		GroupBy(salarycalculationitem.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sciq *SalaryCalculationItemQuery) GroupBy(field string, fields ...string) *SalaryCalculationItemGroupBy {
+	sciq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SalaryCalculationItemGroupBy{build: sciq}
+	grbuild.flds = &sciq.ctx.Fields
+	grbuild.label = salarycalculationitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SalaryCalculationItem.Query().
+//		Select(salarycalculationitem.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (sciq *SalaryCalculationItemQuery) Select(fields ...string) *SalaryCalculationItemSelect {
+	sciq.ctx.Fields = append(sciq.ctx.Fields, fields...)
+	sbuild := &SalaryCalculationItemSelect{SalaryCalculationItemQuery: sciq}
+	sbuild.label = salarycalculationitem.Label
+	sbuild.flds, sbuild.scan = &sciq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SalaryCalculationItemSelect configured with the given aggregations.
+func (sciq *SalaryCalculationItemQuery) Aggregate(fns ...AggregateFunc) *SalaryCalculationItemSelect {
+	return sciq.Select().Aggregate(fns...)
+}
+
+func (sciq *SalaryCalculationItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sciq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sciq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sciq.ctx.Fields {
+		if !salarycalculationitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sciq.path != nil {
+		prev, err := sciq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sciq.sql = prev
+	}
+	return nil
+}
+
+func (sciq *SalaryCalculationItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SalaryCalculationItem, error) {
+	var (
+		nodes       = []*SalaryCalculationItem{}
+		_spec       = sciq.querySpec()
+		loadedTypes = [2]bool{
+			sciq.withSalaryCalculation != nil,
+			sciq.withSalaryComponent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SalaryCalculationItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SalaryCalculationItem{config: sciq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sciq.modifiers) > 0 {
+		_spec.Modifiers = sciq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sciq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sciq.withSalaryCalculation; query != nil {
+		if err := sciq.loadSalaryCalculation(ctx, query, nodes, nil,
+			func(n *SalaryCalculationItem, e *SalaryCalculation) { n.Edges.SalaryCalculation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sciq.withSalaryComponent; query != nil {
+		if err := sciq.loadSalaryComponent(ctx, query, nodes, nil,
+			func(n *SalaryCalculationItem, e *SalaryComponent) { n.Edges.SalaryComponent = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sciq *SalaryCalculationItemQuery) loadSalaryCalculation(ctx context.Context, query *SalaryCalculationQuery, nodes []*SalaryCalculationItem, init func(*SalaryCalculationItem), assign func(*SalaryCalculationItem, *SalaryCalculation)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*SalaryCalculationItem)
+	for i := range nodes {
+		fk := nodes[i].SalaryCalculationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(salarycalculation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "salary_calculation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (sciq *SalaryCalculationItemQuery) loadSalaryComponent(ctx context.Context, query *SalaryComponentQuery, nodes []*SalaryCalculationItem, init func(*SalaryCalculationItem), assign func(*SalaryCalculationItem, *SalaryComponent)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*SalaryCalculationItem)
+	for i := range nodes {
+		if nodes[i].SalaryComponentID == nil {
+			continue
+		}
+		fk := *nodes[i].SalaryComponentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(salarycomponent.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "salary_component_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sciq *SalaryCalculationItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sciq.querySpec()
+	if len(sciq.modifiers) > 0 {
+		_spec.Modifiers = sciq.modifiers
+	}
+	_spec.Node.Columns = sciq.ctx.Fields
+	if len(sciq.ctx.Fields) > 0 {
+		_spec.Unique = sciq.ctx.Unique != nil && *sciq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sciq.driver, _spec)
+}
+
+func (sciq *SalaryCalculationItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(salarycalculationitem.Table, salarycalculationitem.Columns, sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64))
+	_spec.From = sciq.sql
+	if unique := sciq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sciq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sciq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycalculationitem.FieldID)
+		for i := range fields {
+			if fields[i] != salarycalculationitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sciq.withSalaryCalculation != nil {
+			_spec.Node.AddColumnOnce(salarycalculationitem.FieldSalaryCalculationID)
+		}
+		if sciq.withSalaryComponent != nil {
+			_spec.Node.AddColumnOnce(salarycalculationitem.FieldSalaryComponentID)
+		}
+	}
+	if ps := sciq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sciq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sciq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sciq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sciq *SalaryCalculationItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sciq.driver.Dialect())
+	t1 := builder.Table(salarycalculationitem.Table)
+	columns := sciq.ctx.Fields
+	if len(columns) == 0 {
+		columns = salarycalculationitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sciq.sql != nil {
+		selector = sciq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sciq.ctx.Unique != nil && *sciq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sciq.modifiers {
+		m(selector)
+	}
+	for _, p := range sciq.predicates {
+		p(selector)
+	}
+	for _, p := range sciq.order {
+		p(selector)
+	}
+	if offset := sciq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sciq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sciq *SalaryCalculationItemQuery) Modify(modifiers ...func(s *sql.Selector)) *SalaryCalculationItemSelect {
+	sciq.modifiers = append(sciq.modifiers, modifiers...)
+	return sciq.Select()
+}
+
+// SalaryCalculationItemGroupBy is the group-by builder for SalaryCalculationItem entities.
+type SalaryCalculationItemGroupBy struct {
+	selector
+	build *SalaryCalculationItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scigb *SalaryCalculationItemGroupBy) Aggregate(fns ...AggregateFunc) *SalaryCalculationItemGroupBy {
+	scigb.fns = append(scigb.fns, fns...)
+	return scigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scigb *SalaryCalculationItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scigb.build.ctx, "GroupBy")
+	if err := scigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryCalculationItemQuery, *SalaryCalculationItemGroupBy](ctx, scigb.build, scigb, scigb.build.inters, v)
+}
+
+func (scigb *SalaryCalculationItemGroupBy) sqlScan(ctx context.Context, root *SalaryCalculationItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scigb.fns))
+	for _, fn := range scigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scigb.flds)+len(scigb.fns))
+		for _, f := range *scigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SalaryCalculationItemSelect is the builder for selecting fields of SalaryCalculationItem entities.
+type SalaryCalculationItemSelect struct {
+	*SalaryCalculationItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scis *SalaryCalculationItemSelect) Aggregate(fns ...AggregateFunc) *SalaryCalculationItemSelect {
+	scis.fns = append(scis.fns, fns...)
+	return scis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scis *SalaryCalculationItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scis.ctx, "Select")
+	if err := scis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryCalculationItemQuery, *SalaryCalculationItemSelect](ctx, scis.SalaryCalculationItemQuery, scis, scis.inters, v)
+}
+
+func (scis *SalaryCalculationItemSelect) sqlScan(ctx context.Context, root *SalaryCalculationItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scis.fns))
+	for _, fn := range scis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scis *SalaryCalculationItemSelect) Modify(modifiers ...func(s *sql.Selector)) *SalaryCalculationItemSelect {
+	scis.modifiers = append(scis.modifiers, modifiers...)
+	return scis
+}
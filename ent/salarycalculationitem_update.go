@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryCalculationItemUpdate is the builder for updating SalaryCalculationItem entities.
+type SalaryCalculationItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SalaryCalculationItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SalaryCalculationItemUpdate builder.
+func (sciu *SalaryCalculationItemUpdate) Where(ps ...predicate.SalaryCalculationItem) *SalaryCalculationItemUpdate {
+	sciu.mutation.Where(ps...)
+	return sciu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (sciu *SalaryCalculationItemUpdate) SetModifiedAt(t time.Time) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetModifiedAt(t)
+	return sciu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sciu *SalaryCalculationItemUpdate) SetDeletedAt(t time.Time) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetDeletedAt(t)
+	return sciu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sciu *SalaryCalculationItemUpdate) SetNillableDeletedAt(t *time.Time) *SalaryCalculationItemUpdate {
+	if t != nil {
+		sciu.SetDeletedAt(*t)
+	}
+	return sciu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sciu *SalaryCalculationItemUpdate) ClearDeletedAt() *SalaryCalculationItemUpdate {
+	sciu.mutation.ClearDeletedAt()
+	return sciu
+}
+
+// SetSalaryCalculationID sets the "salary_calculation_id" field.
+func (sciu *SalaryCalculationItemUpdate) SetSalaryCalculationID(u uint64) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetSalaryCalculationID(u)
+	return sciu
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (sciu *SalaryCalculationItemUpdate) SetSalaryComponentID(u uint64) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetSalaryComponentID(u)
+	return sciu
+}
+
+// SetNillableSalaryComponentID sets the "salary_component_id" field if the given value is not nil.
+func (sciu *SalaryCalculationItemUpdate) SetNillableSalaryComponentID(u *uint64) *SalaryCalculationItemUpdate {
+	if u != nil {
+		sciu.SetSalaryComponentID(*u)
+	}
+	return sciu
+}
+
+// ClearSalaryComponentID clears the value of the "salary_component_id" field.
+func (sciu *SalaryCalculationItemUpdate) ClearSalaryComponentID() *SalaryCalculationItemUpdate {
+	sciu.mutation.ClearSalaryComponentID()
+	return sciu
+}
+
+// SetCode sets the "code" field.
+func (sciu *SalaryCalculationItemUpdate) SetCode(s string) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetCode(s)
+	return sciu
+}
+
+// SetName sets the "name" field.
+func (sciu *SalaryCalculationItemUpdate) SetName(s string) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetName(s)
+	return sciu
+}
+
+// SetKind sets the "kind" field.
+func (sciu *SalaryCalculationItemUpdate) SetKind(s salarycalculationitem.Kind) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetKind(s)
+	return sciu
+}
+
+// SetAmount sets the "amount" field.
+func (sciu *SalaryCalculationItemUpdate) SetAmount(d decimal.Decimal) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetAmount(d)
+	return sciu
+}
+
+// SetBasis sets the "basis" field.
+func (sciu *SalaryCalculationItemUpdate) SetBasis(s string) *SalaryCalculationItemUpdate {
+	sciu.mutation.SetBasis(s)
+	return sciu
+}
+
+// SetNillableBasis sets the "basis" field if the given value is not nil.
+func (sciu *SalaryCalculationItemUpdate) SetNillableBasis(s *string) *SalaryCalculationItemUpdate {
+	if s != nil {
+		sciu.SetBasis(*s)
+	}
+	return sciu
+}
+
+// ClearBasis clears the value of the "basis" field.
+func (sciu *SalaryCalculationItemUpdate) ClearBasis() *SalaryCalculationItemUpdate {
+	sciu.mutation.ClearBasis()
+	return sciu
+}
+
+// SetSalaryCalculation sets the "salary_calculation" edge to the SalaryCalculation entity.
+func (sciu *SalaryCalculationItemUpdate) SetSalaryCalculation(s *SalaryCalculation) *SalaryCalculationItemUpdate {
+	return sciu.SetSalaryCalculationID(s.ID)
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (sciu *SalaryCalculationItemUpdate) SetSalaryComponent(s *SalaryComponent) *SalaryCalculationItemUpdate {
+	return sciu.SetSalaryComponentID(s.ID)
+}
+
+// Mutation returns the SalaryCalculationItemMutation object of the builder.
+func (sciu *SalaryCalculationItemUpdate) Mutation() *SalaryCalculationItemMutation {
+	return sciu.mutation
+}
+
+// ClearSalaryCalculation clears the "salary_calculation" edge to the SalaryCalculation entity.
+func (sciu *SalaryCalculationItemUpdate) ClearSalaryCalculation() *SalaryCalculationItemUpdate {
+	sciu.mutation.ClearSalaryCalculation()
+	return sciu
+}
+
+// ClearSalaryComponent clears the "salary_component" edge to the SalaryComponent entity.
+func (sciu *SalaryCalculationItemUpdate) ClearSalaryComponent() *SalaryCalculationItemUpdate {
+	sciu.mutation.ClearSalaryComponent()
+	return sciu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sciu *SalaryCalculationItemUpdate) Save(ctx context.Context) (int, error) {
+	sciu.defaults()
+	return withHooks(ctx, sciu.sqlSave, sciu.mutation, sciu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sciu *SalaryCalculationItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := sciu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sciu *SalaryCalculationItemUpdate) Exec(ctx context.Context) error {
+	_, err := sciu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sciu *SalaryCalculationItemUpdate) ExecX(ctx context.Context) {
+	if err := sciu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sciu *SalaryCalculationItemUpdate) defaults() {
+	if _, ok := sciu.mutation.ModifiedAt(); !ok {
+		v := salarycalculationitem.UpdateDefaultModifiedAt()
+		sciu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sciu *SalaryCalculationItemUpdate) check() error {
+	if v, ok := sciu.mutation.Code(); ok {
+		if err := salarycalculationitem.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.code": %w`, err)}
+		}
+	}
+	if v, ok := sciu.mutation.Name(); ok {
+		if err := salarycalculationitem.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.name": %w`, err)}
+		}
+	}
+	if v, ok := sciu.mutation.Kind(); ok {
+		if err := salarycalculationitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := sciu.mutation.SalaryCalculationID(); sciu.mutation.SalaryCalculationCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SalaryCalculationItem.salary_calculation"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sciu *SalaryCalculationItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryCalculationItemUpdate {
+	sciu.modifiers = append(sciu.modifiers, modifiers...)
+	return sciu
+}
+
+func (sciu *SalaryCalculationItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sciu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycalculationitem.Table, salarycalculationitem.Columns, sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64))
+	if ps := sciu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sciu.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := sciu.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sciu.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycalculationitem.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sciu.mutation.Code(); ok {
+		_spec.SetField(salarycalculationitem.FieldCode, field.TypeString, value)
+	}
+	if value, ok := sciu.mutation.Name(); ok {
+		_spec.SetField(salarycalculationitem.FieldName, field.TypeString, value)
+	}
+	if value, ok := sciu.mutation.Kind(); ok {
+		_spec.SetField(salarycalculationitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sciu.mutation.Amount(); ok {
+		_spec.SetField(salarycalculationitem.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := sciu.mutation.Basis(); ok {
+		_spec.SetField(salarycalculationitem.FieldBasis, field.TypeString, value)
+	}
+	if sciu.mutation.BasisCleared() {
+		_spec.ClearField(salarycalculationitem.FieldBasis, field.TypeString)
+	}
+	if sciu.mutation.SalaryCalculationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryCalculationTable,
+			Columns: []string{salarycalculationitem.SalaryCalculationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sciu.mutation.SalaryCalculationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryCalculationTable,
+			Columns: []string{salarycalculationitem.SalaryCalculationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sciu.mutation.SalaryComponentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryComponentTable,
+			Columns: []string{salarycalculationitem.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sciu.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryComponentTable,
+			Columns: []string{salarycalculationitem.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sciu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sciu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycalculationitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sciu.mutation.done = true
+	return n, nil
+}
+
+// SalaryCalculationItemUpdateOne is the builder for updating a single SalaryCalculationItem entity.
+type SalaryCalculationItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SalaryCalculationItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetModifiedAt(t time.Time) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetModifiedAt(t)
+	return sciuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetDeletedAt(t time.Time) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetDeletedAt(t)
+	return sciuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sciuo *SalaryCalculationItemUpdateOne) SetNillableDeletedAt(t *time.Time) *SalaryCalculationItemUpdateOne {
+	if t != nil {
+		sciuo.SetDeletedAt(*t)
+	}
+	return sciuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sciuo *SalaryCalculationItemUpdateOne) ClearDeletedAt() *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.ClearDeletedAt()
+	return sciuo
+}
+
+// SetSalaryCalculationID sets the "salary_calculation_id" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetSalaryCalculationID(u uint64) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetSalaryCalculationID(u)
+	return sciuo
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetSalaryComponentID(u uint64) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetSalaryComponentID(u)
+	return sciuo
+}
+
+// SetNillableSalaryComponentID sets the "salary_component_id" field if the given value is not nil.
+func (sciuo *SalaryCalculationItemUpdateOne) SetNillableSalaryComponentID(u *uint64) *SalaryCalculationItemUpdateOne {
+	if u != nil {
+		sciuo.SetSalaryComponentID(*u)
+	}
+	return sciuo
+}
+
+// ClearSalaryComponentID clears the value of the "salary_component_id" field.
+func (sciuo *SalaryCalculationItemUpdateOne) ClearSalaryComponentID() *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.ClearSalaryComponentID()
+	return sciuo
+}
+
+// SetCode sets the "code" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetCode(s string) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetCode(s)
+	return sciuo
+}
+
+// SetName sets the "name" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetName(s string) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetName(s)
+	return sciuo
+}
+
+// SetKind sets the "kind" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetKind(s salarycalculationitem.Kind) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetKind(s)
+	return sciuo
+}
+
+// SetAmount sets the "amount" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetAmount(d decimal.Decimal) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetAmount(d)
+	return sciuo
+}
+
+// SetBasis sets the "basis" field.
+func (sciuo *SalaryCalculationItemUpdateOne) SetBasis(s string) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.SetBasis(s)
+	return sciuo
+}
+
+// SetNillableBasis sets the "basis" field if the given value is not nil.
+func (sciuo *SalaryCalculationItemUpdateOne) SetNillableBasis(s *string) *SalaryCalculationItemUpdateOne {
+	if s != nil {
+		sciuo.SetBasis(*s)
+	}
+	return sciuo
+}
+
+// ClearBasis clears the value of the "basis" field.
+func (sciuo *SalaryCalculationItemUpdateOne) ClearBasis() *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.ClearBasis()
+	return sciuo
+}
+
+// SetSalaryCalculation sets the "salary_calculation" edge to the SalaryCalculation entity.
+func (sciuo *SalaryCalculationItemUpdateOne) SetSalaryCalculation(s *SalaryCalculation) *SalaryCalculationItemUpdateOne {
+	return sciuo.SetSalaryCalculationID(s.ID)
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (sciuo *SalaryCalculationItemUpdateOne) SetSalaryComponent(s *SalaryComponent) *SalaryCalculationItemUpdateOne {
+	return sciuo.SetSalaryComponentID(s.ID)
+}
+
+// Mutation returns the SalaryCalculationItemMutation object of the builder.
+func (sciuo *SalaryCalculationItemUpdateOne) Mutation() *SalaryCalculationItemMutation {
+	return sciuo.mutation
+}
+
+// ClearSalaryCalculation clears the "salary_calculation" edge to the SalaryCalculation entity.
+func (sciuo *SalaryCalculationItemUpdateOne) ClearSalaryCalculation() *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.ClearSalaryCalculation()
+	return sciuo
+}
+
+// ClearSalaryComponent clears the "salary_component" edge to the SalaryComponent entity.
+func (sciuo *SalaryCalculationItemUpdateOne) ClearSalaryComponent() *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.ClearSalaryComponent()
+	return sciuo
+}
+
+// Where appends a list predicates to the SalaryCalculationItemUpdate builder.
+func (sciuo *SalaryCalculationItemUpdateOne) Where(ps ...predicate.SalaryCalculationItem) *SalaryCalculationItemUpdateOne {
+	sciuo.mutation.Where(ps...)
+	return sciuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sciuo *SalaryCalculationItemUpdateOne) Select(field string, fields ...string) *SalaryCalculationItemUpdateOne {
+	sciuo.fields = append([]string{field}, fields...)
+	return sciuo
+}
+
+// Save executes the query and returns the updated SalaryCalculationItem entity.
+func (sciuo *SalaryCalculationItemUpdateOne) Save(ctx context.Context) (*SalaryCalculationItem, error) {
+	sciuo.defaults()
+	return withHooks(ctx, sciuo.sqlSave, sciuo.mutation, sciuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sciuo *SalaryCalculationItemUpdateOne) SaveX(ctx context.Context) *SalaryCalculationItem {
+	node, err := sciuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sciuo *SalaryCalculationItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := sciuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sciuo *SalaryCalculationItemUpdateOne) ExecX(ctx context.Context) {
+	if err := sciuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sciuo *SalaryCalculationItemUpdateOne) defaults() {
+	if _, ok := sciuo.mutation.ModifiedAt(); !ok {
+		v := salarycalculationitem.UpdateDefaultModifiedAt()
+		sciuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sciuo *SalaryCalculationItemUpdateOne) check() error {
+	if v, ok := sciuo.mutation.Code(); ok {
+		if err := salarycalculationitem.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.code": %w`, err)}
+		}
+	}
+	if v, ok := sciuo.mutation.Name(); ok {
+		if err := salarycalculationitem.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.name": %w`, err)}
+		}
+	}
+	if v, ok := sciuo.mutation.Kind(); ok {
+		if err := salarycalculationitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryCalculationItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := sciuo.mutation.SalaryCalculationID(); sciuo.mutation.SalaryCalculationCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SalaryCalculationItem.salary_calculation"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sciuo *SalaryCalculationItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryCalculationItemUpdateOne {
+	sciuo.modifiers = append(sciuo.modifiers, modifiers...)
+	return sciuo
+}
+
+func (sciuo *SalaryCalculationItemUpdateOne) sqlSave(ctx context.Context) (_node *SalaryCalculationItem, err error) {
+	if err := sciuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycalculationitem.Table, salarycalculationitem.Columns, sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64))
+	id, ok := sciuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SalaryCalculationItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sciuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycalculationitem.FieldID)
+		for _, f := range fields {
+			if !salarycalculationitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != salarycalculationitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sciuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sciuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := sciuo.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycalculationitem.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sciuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycalculationitem.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sciuo.mutation.Code(); ok {
+		_spec.SetField(salarycalculationitem.FieldCode, field.TypeString, value)
+	}
+	if value, ok := sciuo.mutation.Name(); ok {
+		_spec.SetField(salarycalculationitem.FieldName, field.TypeString, value)
+	}
+	if value, ok := sciuo.mutation.Kind(); ok {
+		_spec.SetField(salarycalculationitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sciuo.mutation.Amount(); ok {
+		_spec.SetField(salarycalculationitem.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := sciuo.mutation.Basis(); ok {
+		_spec.SetField(salarycalculationitem.FieldBasis, field.TypeString, value)
+	}
+	if sciuo.mutation.BasisCleared() {
+		_spec.ClearField(salarycalculationitem.FieldBasis, field.TypeString)
+	}
+	if sciuo.mutation.SalaryCalculationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryCalculationTable,
+			Columns: []string{salarycalculationitem.SalaryCalculationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sciuo.mutation.SalaryCalculationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryCalculationTable,
+			Columns: []string{salarycalculationitem.SalaryCalculationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculation.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sciuo.mutation.SalaryComponentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryComponentTable,
+			Columns: []string{salarycalculationitem.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sciuo.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycalculationitem.SalaryComponentTable,
+			Columns: []string{salarycalculationitem.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sciuo.modifiers...)
+	_node = &SalaryCalculationItem{config: sciuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sciuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycalculationitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sciuo.mutation.done = true
+	return _node, nil
+}
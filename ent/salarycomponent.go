@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/salarycomponent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponent is the model entity for the SalaryComponent schema.
+type SalaryComponent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Stable identifier of the component on payslips and exports, e.g. transport_allowance
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Earnings are added to the salary, deductions are subtracted from it
+	Kind salarycomponent.Kind `json:"kind,omitempty"`
+	// How the amount of the component is calculated every month
+	Method salarycomponent.Method `json:"method,omitempty"`
+	// Monthly amount of a fixed component, or the amount per present day
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Percentage of the base salary of a percentage_of_base component
+	Percentage decimal.Decimal `json:"percentage,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Inactive components are skipped by salary calculations
+	IsActive bool `json:"is_active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SalaryComponentQuery when eager-loading is set.
+	Edges        SalaryComponentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SalaryComponentEdges holds the relations/edges for other nodes in the graph.
+type SalaryComponentEdges struct {
+	// Assignments holds the value of the assignments edge.
+	Assignments []*SalaryComponentAssignment `json:"assignments,omitempty"`
+	// CalculationItems holds the value of the calculation_items edge.
+	CalculationItems []*SalaryCalculationItem `json:"calculation_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e SalaryComponentEdges) AssignmentsOrErr() ([]*SalaryComponentAssignment, error) {
+	if e.loadedTypes[0] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// CalculationItemsOrErr returns the CalculationItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e SalaryComponentEdges) CalculationItemsOrErr() ([]*SalaryCalculationItem, error) {
+	if e.loadedTypes[1] {
+		return e.CalculationItems, nil
+	}
+	return nil, &NotLoadedError{edge: "calculation_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SalaryComponent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case salarycomponent.FieldAmount, salarycomponent.FieldPercentage:
+			values[i] = new(decimal.Decimal)
+		case salarycomponent.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case salarycomponent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case salarycomponent.FieldCode, salarycomponent.FieldName, salarycomponent.FieldKind, salarycomponent.FieldMethod, salarycomponent.FieldDescription:
+			values[i] = new(sql.NullString)
+		case salarycomponent.FieldCreatedAt, salarycomponent.FieldModifiedAt, salarycomponent.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SalaryComponent fields.
+func (sc *SalaryComponent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case salarycomponent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = uint64(value.Int64)
+		case salarycomponent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case salarycomponent.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				sc.ModifiedAt = value.Time
+			}
+		case salarycomponent.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sc.DeletedAt = value.Time
+			}
+		case salarycomponent.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				sc.Code = value.String
+			}
+		case salarycomponent.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sc.Name = value.String
+			}
+		case salarycomponent.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				sc.Kind = salarycomponent.Kind(value.String)
+			}
+		case salarycomponent.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				sc.Method = salarycomponent.Method(value.String)
+			}
+		case salarycomponent.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				sc.Amount = *value
+			}
+		case salarycomponent.FieldPercentage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value != nil {
+				sc.Percentage = *value
+			}
+		case salarycomponent.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sc.Description = value.String
+			}
+		case salarycomponent.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				sc.IsActive = value.Bool
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SalaryComponent.
+// This includes values selected through modifiers, order, etc.
+func (sc *SalaryComponent) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryAssignments queries the "assignments" edge of the SalaryComponent entity.
+func (sc *SalaryComponent) QueryAssignments() *SalaryComponentAssignmentQuery {
+	return NewSalaryComponentClient(sc.config).QueryAssignments(sc)
+}
+
+// QueryCalculationItems queries the "calculation_items" edge of the SalaryComponent entity.
+func (sc *SalaryComponent) QueryCalculationItems() *SalaryCalculationItemQuery {
+	return NewSalaryComponentClient(sc.config).QueryCalculationItems(sc)
+}
+
+// Update returns a builder for updating this SalaryComponent.
+// Note that you need to call SalaryComponent.Unwrap() before calling this method if this SalaryComponent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SalaryComponent) Update() *SalaryComponentUpdateOne {
+	return NewSalaryComponentClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SalaryComponent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SalaryComponent) Unwrap() *SalaryComponent {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SalaryComponent is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SalaryComponent) String() string {
+	var builder strings.Builder
+	builder.WriteString("SalaryComponent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(sc.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(sc.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(sc.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Method))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("percentage=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Percentage))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sc.Description)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", sc.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SalaryComponents is a parsable slice of SalaryComponent.
+type SalaryComponents []*SalaryComponent
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentCreate is the builder for creating a SalaryComponent entity.
+type SalaryComponentCreate struct {
+	config
+	mutation *SalaryComponentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SalaryComponentCreate) SetCreatedAt(t time.Time) *SalaryComponentCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableCreatedAt(t *time.Time) *SalaryComponentCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scc *SalaryComponentCreate) SetModifiedAt(t time.Time) *SalaryComponentCreate {
+	scc.mutation.SetModifiedAt(t)
+	return scc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableModifiedAt(t *time.Time) *SalaryComponentCreate {
+	if t != nil {
+		scc.SetModifiedAt(*t)
+	}
+	return scc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scc *SalaryComponentCreate) SetDeletedAt(t time.Time) *SalaryComponentCreate {
+	scc.mutation.SetDeletedAt(t)
+	return scc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableDeletedAt(t *time.Time) *SalaryComponentCreate {
+	if t != nil {
+		scc.SetDeletedAt(*t)
+	}
+	return scc
+}
+
+// SetCode sets the "code" field.
+func (scc *SalaryComponentCreate) SetCode(s string) *SalaryComponentCreate {
+	scc.mutation.SetCode(s)
+	return scc
+}
+
+// SetName sets the "name" field.
+func (scc *SalaryComponentCreate) SetName(s string) *SalaryComponentCreate {
+	scc.mutation.SetName(s)
+	return scc
+}
+
+// SetKind sets the "kind" field.
+func (scc *SalaryComponentCreate) SetKind(s salarycomponent.Kind) *SalaryComponentCreate {
+	scc.mutation.SetKind(s)
+	return scc
+}
+
+// SetMethod sets the "method" field.
+func (scc *SalaryComponentCreate) SetMethod(s salarycomponent.Method) *SalaryComponentCreate {
+	scc.mutation.SetMethod(s)
+	return scc
+}
+
+// SetAmount sets the "amount" field.
+func (scc *SalaryComponentCreate) SetAmount(d decimal.Decimal) *SalaryComponentCreate {
+	scc.mutation.SetAmount(d)
+	return scc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableAmount(d *decimal.Decimal) *SalaryComponentCreate {
+	if d != nil {
+		scc.SetAmount(*d)
+	}
+	return scc
+}
+
+// SetPercentage sets the "percentage" field.
+func (scc *SalaryComponentCreate) SetPercentage(d decimal.Decimal) *SalaryComponentCreate {
+	scc.mutation.SetPercentage(d)
+	return scc
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentCreate {
+	if d != nil {
+		scc.SetPercentage(*d)
+	}
+	return scc
+}
+
+// SetDescription sets the "description" field.
+func (scc *SalaryComponentCreate) SetDescription(s string) *SalaryComponentCreate {
+	scc.mutation.SetDescription(s)
+	return scc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableDescription(s *string) *SalaryComponentCreate {
+	if s != nil {
+		scc.SetDescription(*s)
+	}
+	return scc
+}
+
+// SetIsActive sets the "is_active" field.
+func (scc *SalaryComponentCreate) SetIsActive(b bool) *SalaryComponentCreate {
+	scc.mutation.SetIsActive(b)
+	return scc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scc *SalaryComponentCreate) SetNillableIsActive(b *bool) *SalaryComponentCreate {
+	if b != nil {
+		scc.SetIsActive(*b)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SalaryComponentCreate) SetID(u uint64) *SalaryComponentCreate {
+	scc.mutation.SetID(u)
+	return scc
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (scc *SalaryComponentCreate) AddAssignmentIDs(ids ...uint64) *SalaryComponentCreate {
+	scc.mutation.AddAssignmentIDs(ids...)
+	return scc
+}
+
+// AddAssignments adds the "assignments" edges to the SalaryComponentAssignment entity.
+func (scc *SalaryComponentCreate) AddAssignments(s ...*SalaryComponentAssignment) *SalaryComponentCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scc.AddAssignmentIDs(ids...)
+}
+
+// AddCalculationItemIDs adds the "calculation_items" edge to the SalaryCalculationItem entity by IDs.
+func (scc *SalaryComponentCreate) AddCalculationItemIDs(ids ...uint64) *SalaryComponentCreate {
+	scc.mutation.AddCalculationItemIDs(ids...)
+	return scc
+}
+
+// AddCalculationItems adds the "calculation_items" edges to the SalaryCalculationItem entity.
+func (scc *SalaryComponentCreate) AddCalculationItems(s ...*SalaryCalculationItem) *SalaryComponentCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scc.AddCalculationItemIDs(ids...)
+}
+
+// Mutation returns the SalaryComponentMutation object of the builder.
+func (scc *SalaryComponentCreate) Mutation() *SalaryComponentMutation {
+	return scc.mutation
+}
+
+// Save creates the SalaryComponent in the database.
+func (scc *SalaryComponentCreate) Save(ctx context.Context) (*SalaryComponent, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SalaryComponentCreate) SaveX(ctx context.Context) *SalaryComponent {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SalaryComponentCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SalaryComponentCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SalaryComponentCreate) defaults() {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := salarycomponent.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scc.mutation.ModifiedAt(); !ok {
+		v := salarycomponent.DefaultModifiedAt()
+		scc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := scc.mutation.Amount(); !ok {
+		v := salarycomponent.DefaultAmount
+		scc.mutation.SetAmount(v)
+	}
+	if _, ok := scc.mutation.Percentage(); !ok {
+		v := salarycomponent.DefaultPercentage
+		scc.mutation.SetPercentage(v)
+	}
+	if _, ok := scc.mutation.IsActive(); !ok {
+		v := salarycomponent.DefaultIsActive
+		scc.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SalaryComponentCreate) check() error {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SalaryComponent.created_at"`)}
+	}
+	if _, ok := scc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "SalaryComponent.modified_at"`)}
+	}
+	if _, ok := scc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "SalaryComponent.code"`)}
+	}
+	if v, ok := scc.mutation.Code(); ok {
+		if err := salarycomponent.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.code": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SalaryComponent.name"`)}
+	}
+	if v, ok := scc.mutation.Name(); ok {
+		if err := salarycomponent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.name": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SalaryComponent.kind"`)}
+	}
+	if v, ok := scc.mutation.Kind(); ok {
+		if err := salarycomponent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.kind": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "SalaryComponent.method"`)}
+	}
+	if v, ok := scc.mutation.Method(); ok {
+		if err := salarycomponent.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.method": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SalaryComponent.amount"`)}
+	}
+	if _, ok := scc.mutation.Percentage(); !ok {
+		return &ValidationError{Name: "percentage", err: errors.New(`ent: missing required field "SalaryComponent.percentage"`)}
+	}
+	if _, ok := scc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SalaryComponent.is_active"`)}
+	}
+	return nil
+}
+
+func (scc *SalaryComponentCreate) sqlSave(ctx context.Context) (*SalaryComponent, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SalaryComponentCreate) createSpec() (*SalaryComponent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SalaryComponent{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(salarycomponent.Table, sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64))
+	)
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(salarycomponent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scc.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponent.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := scc.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponent.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := scc.mutation.Code(); ok {
+		_spec.SetField(salarycomponent.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := scc.mutation.Name(); ok {
+		_spec.SetField(salarycomponent.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := scc.mutation.Kind(); ok {
+		_spec.SetField(salarycomponent.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := scc.mutation.Method(); ok {
+		_spec.SetField(salarycomponent.FieldMethod, field.TypeEnum, value)
+		_node.Method = value
+	}
+	if value, ok := scc.mutation.Amount(); ok {
+		_spec.SetField(salarycomponent.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := scc.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponent.FieldPercentage, field.TypeOther, value)
+		_node.Percentage = value
+	}
+	if value, ok := scc.mutation.Description(); ok {
+		_spec.SetField(salarycomponent.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := scc.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponent.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := scc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.CalculationItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SalaryComponentCreateBulk is the builder for creating many SalaryComponent entities in bulk.
+type SalaryComponentCreateBulk struct {
+	config
+	builders []*SalaryComponentCreate
+}
+
+// Save creates the SalaryComponent entities in the database.
+func (sccb *SalaryComponentCreateBulk) Save(ctx context.Context) ([]*SalaryComponent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SalaryComponent, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SalaryComponentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SalaryComponentCreateBulk) SaveX(ctx context.Context) []*SalaryComponent {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SalaryComponentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SalaryComponentCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycomponent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryComponentDelete is the builder for deleting a SalaryComponent entity.
+type SalaryComponentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SalaryComponentMutation
+}
+
+// Where appends a list predicates to the SalaryComponentDelete builder.
+func (scd *SalaryComponentDelete) Where(ps ...predicate.SalaryComponent) *SalaryComponentDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *SalaryComponentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *SalaryComponentDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *SalaryComponentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(salarycomponent.Table, sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64))
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// SalaryComponentDeleteOne is the builder for deleting a single SalaryComponent entity.
+type SalaryComponentDeleteOne struct {
+	scd *SalaryComponentDelete
+}
+
+// Where appends a list predicates to the SalaryComponentDelete builder.
+func (scdo *SalaryComponentDeleteOne) Where(ps ...predicate.SalaryComponent) *SalaryComponentDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *SalaryComponentDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{salarycomponent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *SalaryComponentDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
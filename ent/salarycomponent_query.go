@@ -0,0 +1,708 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryComponentQuery is the builder for querying SalaryComponent entities.
+type SalaryComponentQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []salarycomponent.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.SalaryComponent
+	withAssignments      *SalaryComponentAssignmentQuery
+	withCalculationItems *SalaryCalculationItemQuery
+	modifiers            []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SalaryComponentQuery builder.
+func (scq *SalaryComponentQuery) Where(ps ...predicate.SalaryComponent) *SalaryComponentQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *SalaryComponentQuery) Limit(limit int) *SalaryComponentQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *SalaryComponentQuery) Offset(offset int) *SalaryComponentQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *SalaryComponentQuery) Unique(unique bool) *SalaryComponentQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *SalaryComponentQuery) Order(o ...salarycomponent.OrderOption) *SalaryComponentQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QueryAssignments chains the current query on the "assignments" edge.
+func (scq *SalaryComponentQuery) QueryAssignments() *SalaryComponentAssignmentQuery {
+	query := (&SalaryComponentAssignmentClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponent.Table, salarycomponent.FieldID, selector),
+			sqlgraph.To(salarycomponentassignment.Table, salarycomponentassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycomponent.AssignmentsTable, salarycomponent.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCalculationItems chains the current query on the "calculation_items" edge.
+func (scq *SalaryComponentQuery) QueryCalculationItems() *SalaryCalculationItemQuery {
+	query := (&SalaryCalculationItemClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponent.Table, salarycomponent.FieldID, selector),
+			sqlgraph.To(salarycalculationitem.Table, salarycalculationitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarycomponent.CalculationItemsTable, salarycomponent.CalculationItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SalaryComponent entity from the query.
+// Returns a *NotFoundError when no SalaryComponent was found.
+func (scq *SalaryComponentQuery) First(ctx context.Context) (*SalaryComponent, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{salarycomponent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *SalaryComponentQuery) FirstX(ctx context.Context) *SalaryComponent {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SalaryComponent ID from the query.
+// Returns a *NotFoundError when no SalaryComponent ID was found.
+func (scq *SalaryComponentQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{salarycomponent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *SalaryComponentQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SalaryComponent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SalaryComponent entity is found.
+// Returns a *NotFoundError when no SalaryComponent entities are found.
+func (scq *SalaryComponentQuery) Only(ctx context.Context) (*SalaryComponent, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{salarycomponent.Label}
+	default:
+		return nil, &NotSingularError{salarycomponent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *SalaryComponentQuery) OnlyX(ctx context.Context) *SalaryComponent {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SalaryComponent ID in the query.
+// Returns a *NotSingularError when more than one SalaryComponent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *SalaryComponentQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{salarycomponent.Label}
+	default:
+		err = &NotSingularError{salarycomponent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *SalaryComponentQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SalaryComponents.
+func (scq *SalaryComponentQuery) All(ctx context.Context) ([]*SalaryComponent, error) {
+	ctx = setContextOp(ctx, scq.ctx, "All")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SalaryComponent, *SalaryComponentQuery]()
+	return withInterceptors[[]*SalaryComponent](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *SalaryComponentQuery) AllX(ctx context.Context) []*SalaryComponent {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SalaryComponent IDs.
+func (scq *SalaryComponentQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, "IDs")
+	if err = scq.Select(salarycomponent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *SalaryComponentQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *SalaryComponentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Count")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*SalaryComponentQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *SalaryComponentQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *SalaryComponentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Exist")
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *SalaryComponentQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SalaryComponentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *SalaryComponentQuery) Clone() *SalaryComponentQuery {
+	if scq == nil {
+		return nil
+	}
+	return &SalaryComponentQuery{
+		config:               scq.config,
+		ctx:                  scq.ctx.Clone(),
+		order:                append([]salarycomponent.OrderOption{}, scq.order...),
+		inters:               append([]Interceptor{}, scq.inters...),
+		predicates:           append([]predicate.SalaryComponent{}, scq.predicates...),
+		withAssignments:      scq.withAssignments.Clone(),
+		withCalculationItems: scq.withCalculationItems.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SalaryComponentQuery) WithAssignments(opts ...func(*SalaryComponentAssignmentQuery)) *SalaryComponentQuery {
+	query := (&SalaryComponentAssignmentClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withAssignments = query
+	return scq
+}
+
+// WithCalculationItems tells the query-builder to eager-load the nodes that are connected to
+// the "calculation_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SalaryComponentQuery) WithCalculationItems(opts ...func(*SalaryCalculationItemQuery)) *SalaryComponentQuery {
+	query := (&SalaryCalculationItemClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withCalculationItems = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SalaryComponent.Query().
+//		GroupBy(salarycomponent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scq *SalaryComponentQuery) GroupBy(field string, fields ...string) *SalaryComponentGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SalaryComponentGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = salarycomponent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SalaryComponent.Query().
+//		Select(salarycomponent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (scq *SalaryComponentQuery) Select(fields ...string) *SalaryComponentSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &SalaryComponentSelect{SalaryComponentQuery: scq}
+	sbuild.label = salarycomponent.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SalaryComponentSelect configured with the given aggregations.
+func (scq *SalaryComponentQuery) Aggregate(fns ...AggregateFunc) *SalaryComponentSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *SalaryComponentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !salarycomponent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *SalaryComponentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SalaryComponent, error) {
+	var (
+		nodes       = []*SalaryComponent{}
+		_spec       = scq.querySpec()
+		loadedTypes = [2]bool{
+			scq.withAssignments != nil,
+			scq.withCalculationItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SalaryComponent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SalaryComponent{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withAssignments; query != nil {
+		if err := scq.loadAssignments(ctx, query, nodes,
+			func(n *SalaryComponent) { n.Edges.Assignments = []*SalaryComponentAssignment{} },
+			func(n *SalaryComponent, e *SalaryComponentAssignment) {
+				n.Edges.Assignments = append(n.Edges.Assignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withCalculationItems; query != nil {
+		if err := scq.loadCalculationItems(ctx, query, nodes,
+			func(n *SalaryComponent) { n.Edges.CalculationItems = []*SalaryCalculationItem{} },
+			func(n *SalaryComponent, e *SalaryCalculationItem) {
+				n.Edges.CalculationItems = append(n.Edges.CalculationItems, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *SalaryComponentQuery) loadAssignments(ctx context.Context, query *SalaryComponentAssignmentQuery, nodes []*SalaryComponent, init func(*SalaryComponent), assign func(*SalaryComponent, *SalaryComponentAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*SalaryComponent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarycomponentassignment.FieldSalaryComponentID)
+	}
+	query.Where(predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(salarycomponent.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SalaryComponentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "salary_component_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (scq *SalaryComponentQuery) loadCalculationItems(ctx context.Context, query *SalaryCalculationItemQuery, nodes []*SalaryComponent, init func(*SalaryComponent), assign func(*SalaryComponent, *SalaryCalculationItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*SalaryComponent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarycalculationitem.FieldSalaryComponentID)
+	}
+	query.Where(predicate.SalaryCalculationItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(salarycomponent.CalculationItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SalaryComponentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "salary_component_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "salary_component_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (scq *SalaryComponentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *SalaryComponentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(salarycomponent.Table, salarycomponent.Columns, sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycomponent.FieldID)
+		for i := range fields {
+			if fields[i] != salarycomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *SalaryComponentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(salarycomponent.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = salarycomponent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range scq.modifiers {
+		m(selector)
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scq *SalaryComponentQuery) Modify(modifiers ...func(s *sql.Selector)) *SalaryComponentSelect {
+	scq.modifiers = append(scq.modifiers, modifiers...)
+	return scq.Select()
+}
+
+// SalaryComponentGroupBy is the group-by builder for SalaryComponent entities.
+type SalaryComponentGroupBy struct {
+	selector
+	build *SalaryComponentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *SalaryComponentGroupBy) Aggregate(fns ...AggregateFunc) *SalaryComponentGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *SalaryComponentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, "GroupBy")
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryComponentQuery, *SalaryComponentGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *SalaryComponentGroupBy) sqlScan(ctx context.Context, root *SalaryComponentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SalaryComponentSelect is the builder for selecting fields of SalaryComponent entities.
+type SalaryComponentSelect struct {
+	*SalaryComponentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *SalaryComponentSelect) Aggregate(fns ...AggregateFunc) *SalaryComponentSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *SalaryComponentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, "Select")
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryComponentQuery, *SalaryComponentSelect](ctx, scs.SalaryComponentQuery, scs, scs.inters, v)
+}
+
+func (scs *SalaryComponentSelect) sqlScan(ctx context.Context, root *SalaryComponentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scs *SalaryComponentSelect) Modify(modifiers ...func(s *sql.Selector)) *SalaryComponentSelect {
+	scs.modifiers = append(scs.modifiers, modifiers...)
+	return scs
+}
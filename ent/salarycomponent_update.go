@@ -0,0 +1,889 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentUpdate is the builder for updating SalaryComponent entities.
+type SalaryComponentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SalaryComponentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SalaryComponentUpdate builder.
+func (scu *SalaryComponentUpdate) Where(ps ...predicate.SalaryComponent) *SalaryComponentUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scu *SalaryComponentUpdate) SetModifiedAt(t time.Time) *SalaryComponentUpdate {
+	scu.mutation.SetModifiedAt(t)
+	return scu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scu *SalaryComponentUpdate) SetDeletedAt(t time.Time) *SalaryComponentUpdate {
+	scu.mutation.SetDeletedAt(t)
+	return scu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scu *SalaryComponentUpdate) SetNillableDeletedAt(t *time.Time) *SalaryComponentUpdate {
+	if t != nil {
+		scu.SetDeletedAt(*t)
+	}
+	return scu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (scu *SalaryComponentUpdate) ClearDeletedAt() *SalaryComponentUpdate {
+	scu.mutation.ClearDeletedAt()
+	return scu
+}
+
+// SetCode sets the "code" field.
+func (scu *SalaryComponentUpdate) SetCode(s string) *SalaryComponentUpdate {
+	scu.mutation.SetCode(s)
+	return scu
+}
+
+// SetName sets the "name" field.
+func (scu *SalaryComponentUpdate) SetName(s string) *SalaryComponentUpdate {
+	scu.mutation.SetName(s)
+	return scu
+}
+
+// SetKind sets the "kind" field.
+func (scu *SalaryComponentUpdate) SetKind(s salarycomponent.Kind) *SalaryComponentUpdate {
+	scu.mutation.SetKind(s)
+	return scu
+}
+
+// SetMethod sets the "method" field.
+func (scu *SalaryComponentUpdate) SetMethod(s salarycomponent.Method) *SalaryComponentUpdate {
+	scu.mutation.SetMethod(s)
+	return scu
+}
+
+// SetAmount sets the "amount" field.
+func (scu *SalaryComponentUpdate) SetAmount(d decimal.Decimal) *SalaryComponentUpdate {
+	scu.mutation.SetAmount(d)
+	return scu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scu *SalaryComponentUpdate) SetNillableAmount(d *decimal.Decimal) *SalaryComponentUpdate {
+	if d != nil {
+		scu.SetAmount(*d)
+	}
+	return scu
+}
+
+// SetPercentage sets the "percentage" field.
+func (scu *SalaryComponentUpdate) SetPercentage(d decimal.Decimal) *SalaryComponentUpdate {
+	scu.mutation.SetPercentage(d)
+	return scu
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scu *SalaryComponentUpdate) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentUpdate {
+	if d != nil {
+		scu.SetPercentage(*d)
+	}
+	return scu
+}
+
+// SetDescription sets the "description" field.
+func (scu *SalaryComponentUpdate) SetDescription(s string) *SalaryComponentUpdate {
+	scu.mutation.SetDescription(s)
+	return scu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scu *SalaryComponentUpdate) SetNillableDescription(s *string) *SalaryComponentUpdate {
+	if s != nil {
+		scu.SetDescription(*s)
+	}
+	return scu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scu *SalaryComponentUpdate) ClearDescription() *SalaryComponentUpdate {
+	scu.mutation.ClearDescription()
+	return scu
+}
+
+// SetIsActive sets the "is_active" field.
+func (scu *SalaryComponentUpdate) SetIsActive(b bool) *SalaryComponentUpdate {
+	scu.mutation.SetIsActive(b)
+	return scu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scu *SalaryComponentUpdate) SetNillableIsActive(b *bool) *SalaryComponentUpdate {
+	if b != nil {
+		scu.SetIsActive(*b)
+	}
+	return scu
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (scu *SalaryComponentUpdate) AddAssignmentIDs(ids ...uint64) *SalaryComponentUpdate {
+	scu.mutation.AddAssignmentIDs(ids...)
+	return scu
+}
+
+// AddAssignments adds the "assignments" edges to the SalaryComponentAssignment entity.
+func (scu *SalaryComponentUpdate) AddAssignments(s ...*SalaryComponentAssignment) *SalaryComponentUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.AddAssignmentIDs(ids...)
+}
+
+// AddCalculationItemIDs adds the "calculation_items" edge to the SalaryCalculationItem entity by IDs.
+func (scu *SalaryComponentUpdate) AddCalculationItemIDs(ids ...uint64) *SalaryComponentUpdate {
+	scu.mutation.AddCalculationItemIDs(ids...)
+	return scu
+}
+
+// AddCalculationItems adds the "calculation_items" edges to the SalaryCalculationItem entity.
+func (scu *SalaryComponentUpdate) AddCalculationItems(s ...*SalaryCalculationItem) *SalaryComponentUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.AddCalculationItemIDs(ids...)
+}
+
+// Mutation returns the SalaryComponentMutation object of the builder.
+func (scu *SalaryComponentUpdate) Mutation() *SalaryComponentMutation {
+	return scu.mutation
+}
+
+// ClearAssignments clears all "assignments" edges to the SalaryComponentAssignment entity.
+func (scu *SalaryComponentUpdate) ClearAssignments() *SalaryComponentUpdate {
+	scu.mutation.ClearAssignments()
+	return scu
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to SalaryComponentAssignment entities by IDs.
+func (scu *SalaryComponentUpdate) RemoveAssignmentIDs(ids ...uint64) *SalaryComponentUpdate {
+	scu.mutation.RemoveAssignmentIDs(ids...)
+	return scu
+}
+
+// RemoveAssignments removes "assignments" edges to SalaryComponentAssignment entities.
+func (scu *SalaryComponentUpdate) RemoveAssignments(s ...*SalaryComponentAssignment) *SalaryComponentUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.RemoveAssignmentIDs(ids...)
+}
+
+// ClearCalculationItems clears all "calculation_items" edges to the SalaryCalculationItem entity.
+func (scu *SalaryComponentUpdate) ClearCalculationItems() *SalaryComponentUpdate {
+	scu.mutation.ClearCalculationItems()
+	return scu
+}
+
+// RemoveCalculationItemIDs removes the "calculation_items" edge to SalaryCalculationItem entities by IDs.
+func (scu *SalaryComponentUpdate) RemoveCalculationItemIDs(ids ...uint64) *SalaryComponentUpdate {
+	scu.mutation.RemoveCalculationItemIDs(ids...)
+	return scu
+}
+
+// RemoveCalculationItems removes "calculation_items" edges to SalaryCalculationItem entities.
+func (scu *SalaryComponentUpdate) RemoveCalculationItems(s ...*SalaryCalculationItem) *SalaryComponentUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.RemoveCalculationItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SalaryComponentUpdate) Save(ctx context.Context) (int, error) {
+	scu.defaults()
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SalaryComponentUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SalaryComponentUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SalaryComponentUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scu *SalaryComponentUpdate) defaults() {
+	if _, ok := scu.mutation.ModifiedAt(); !ok {
+		v := salarycomponent.UpdateDefaultModifiedAt()
+		scu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SalaryComponentUpdate) check() error {
+	if v, ok := scu.mutation.Code(); ok {
+		if err := salarycomponent.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.code": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.Name(); ok {
+		if err := salarycomponent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.name": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.Kind(); ok {
+		if err := salarycomponent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.kind": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.Method(); ok {
+		if err := salarycomponent.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scu *SalaryComponentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryComponentUpdate {
+	scu.modifiers = append(scu.modifiers, modifiers...)
+	return scu
+}
+
+func (scu *SalaryComponentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycomponent.Table, salarycomponent.Columns, sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := scu.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if scu.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycomponent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := scu.mutation.Code(); ok {
+		_spec.SetField(salarycomponent.FieldCode, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Name(); ok {
+		_spec.SetField(salarycomponent.FieldName, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Kind(); ok {
+		_spec.SetField(salarycomponent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.Method(); ok {
+		_spec.SetField(salarycomponent.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.Amount(); ok {
+		_spec.SetField(salarycomponent.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := scu.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponent.FieldPercentage, field.TypeOther, value)
+	}
+	if value, ok := scu.mutation.Description(); ok {
+		_spec.SetField(salarycomponent.FieldDescription, field.TypeString, value)
+	}
+	if scu.mutation.DescriptionCleared() {
+		_spec.ClearField(salarycomponent.FieldDescription, field.TypeString)
+	}
+	if value, ok := scu.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponent.FieldIsActive, field.TypeBool, value)
+	}
+	if scu.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !scu.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.CalculationItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RemovedCalculationItemsIDs(); len(nodes) > 0 && !scu.mutation.CalculationItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.CalculationItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SalaryComponentUpdateOne is the builder for updating a single SalaryComponent entity.
+type SalaryComponentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SalaryComponentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scuo *SalaryComponentUpdateOne) SetModifiedAt(t time.Time) *SalaryComponentUpdateOne {
+	scuo.mutation.SetModifiedAt(t)
+	return scuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scuo *SalaryComponentUpdateOne) SetDeletedAt(t time.Time) *SalaryComponentUpdateOne {
+	scuo.mutation.SetDeletedAt(t)
+	return scuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scuo *SalaryComponentUpdateOne) SetNillableDeletedAt(t *time.Time) *SalaryComponentUpdateOne {
+	if t != nil {
+		scuo.SetDeletedAt(*t)
+	}
+	return scuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (scuo *SalaryComponentUpdateOne) ClearDeletedAt() *SalaryComponentUpdateOne {
+	scuo.mutation.ClearDeletedAt()
+	return scuo
+}
+
+// SetCode sets the "code" field.
+func (scuo *SalaryComponentUpdateOne) SetCode(s string) *SalaryComponentUpdateOne {
+	scuo.mutation.SetCode(s)
+	return scuo
+}
+
+// SetName sets the "name" field.
+func (scuo *SalaryComponentUpdateOne) SetName(s string) *SalaryComponentUpdateOne {
+	scuo.mutation.SetName(s)
+	return scuo
+}
+
+// SetKind sets the "kind" field.
+func (scuo *SalaryComponentUpdateOne) SetKind(s salarycomponent.Kind) *SalaryComponentUpdateOne {
+	scuo.mutation.SetKind(s)
+	return scuo
+}
+
+// SetMethod sets the "method" field.
+func (scuo *SalaryComponentUpdateOne) SetMethod(s salarycomponent.Method) *SalaryComponentUpdateOne {
+	scuo.mutation.SetMethod(s)
+	return scuo
+}
+
+// SetAmount sets the "amount" field.
+func (scuo *SalaryComponentUpdateOne) SetAmount(d decimal.Decimal) *SalaryComponentUpdateOne {
+	scuo.mutation.SetAmount(d)
+	return scuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scuo *SalaryComponentUpdateOne) SetNillableAmount(d *decimal.Decimal) *SalaryComponentUpdateOne {
+	if d != nil {
+		scuo.SetAmount(*d)
+	}
+	return scuo
+}
+
+// SetPercentage sets the "percentage" field.
+func (scuo *SalaryComponentUpdateOne) SetPercentage(d decimal.Decimal) *SalaryComponentUpdateOne {
+	scuo.mutation.SetPercentage(d)
+	return scuo
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scuo *SalaryComponentUpdateOne) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentUpdateOne {
+	if d != nil {
+		scuo.SetPercentage(*d)
+	}
+	return scuo
+}
+
+// SetDescription sets the "description" field.
+func (scuo *SalaryComponentUpdateOne) SetDescription(s string) *SalaryComponentUpdateOne {
+	scuo.mutation.SetDescription(s)
+	return scuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scuo *SalaryComponentUpdateOne) SetNillableDescription(s *string) *SalaryComponentUpdateOne {
+	if s != nil {
+		scuo.SetDescription(*s)
+	}
+	return scuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scuo *SalaryComponentUpdateOne) ClearDescription() *SalaryComponentUpdateOne {
+	scuo.mutation.ClearDescription()
+	return scuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (scuo *SalaryComponentUpdateOne) SetIsActive(b bool) *SalaryComponentUpdateOne {
+	scuo.mutation.SetIsActive(b)
+	return scuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scuo *SalaryComponentUpdateOne) SetNillableIsActive(b *bool) *SalaryComponentUpdateOne {
+	if b != nil {
+		scuo.SetIsActive(*b)
+	}
+	return scuo
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the SalaryComponentAssignment entity by IDs.
+func (scuo *SalaryComponentUpdateOne) AddAssignmentIDs(ids ...uint64) *SalaryComponentUpdateOne {
+	scuo.mutation.AddAssignmentIDs(ids...)
+	return scuo
+}
+
+// AddAssignments adds the "assignments" edges to the SalaryComponentAssignment entity.
+func (scuo *SalaryComponentUpdateOne) AddAssignments(s ...*SalaryComponentAssignment) *SalaryComponentUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.AddAssignmentIDs(ids...)
+}
+
+// AddCalculationItemIDs adds the "calculation_items" edge to the SalaryCalculationItem entity by IDs.
+func (scuo *SalaryComponentUpdateOne) AddCalculationItemIDs(ids ...uint64) *SalaryComponentUpdateOne {
+	scuo.mutation.AddCalculationItemIDs(ids...)
+	return scuo
+}
+
+// AddCalculationItems adds the "calculation_items" edges to the SalaryCalculationItem entity.
+func (scuo *SalaryComponentUpdateOne) AddCalculationItems(s ...*SalaryCalculationItem) *SalaryComponentUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.AddCalculationItemIDs(ids...)
+}
+
+// Mutation returns the SalaryComponentMutation object of the builder.
+func (scuo *SalaryComponentUpdateOne) Mutation() *SalaryComponentMutation {
+	return scuo.mutation
+}
+
+// ClearAssignments clears all "assignments" edges to the SalaryComponentAssignment entity.
+func (scuo *SalaryComponentUpdateOne) ClearAssignments() *SalaryComponentUpdateOne {
+	scuo.mutation.ClearAssignments()
+	return scuo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to SalaryComponentAssignment entities by IDs.
+func (scuo *SalaryComponentUpdateOne) RemoveAssignmentIDs(ids ...uint64) *SalaryComponentUpdateOne {
+	scuo.mutation.RemoveAssignmentIDs(ids...)
+	return scuo
+}
+
+// RemoveAssignments removes "assignments" edges to SalaryComponentAssignment entities.
+func (scuo *SalaryComponentUpdateOne) RemoveAssignments(s ...*SalaryComponentAssignment) *SalaryComponentUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.RemoveAssignmentIDs(ids...)
+}
+
+// ClearCalculationItems clears all "calculation_items" edges to the SalaryCalculationItem entity.
+func (scuo *SalaryComponentUpdateOne) ClearCalculationItems() *SalaryComponentUpdateOne {
+	scuo.mutation.ClearCalculationItems()
+	return scuo
+}
+
+// RemoveCalculationItemIDs removes the "calculation_items" edge to SalaryCalculationItem entities by IDs.
+func (scuo *SalaryComponentUpdateOne) RemoveCalculationItemIDs(ids ...uint64) *SalaryComponentUpdateOne {
+	scuo.mutation.RemoveCalculationItemIDs(ids...)
+	return scuo
+}
+
+// RemoveCalculationItems removes "calculation_items" edges to SalaryCalculationItem entities.
+func (scuo *SalaryComponentUpdateOne) RemoveCalculationItems(s ...*SalaryCalculationItem) *SalaryComponentUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.RemoveCalculationItemIDs(ids...)
+}
+
+// Where appends a list predicates to the SalaryComponentUpdate builder.
+func (scuo *SalaryComponentUpdateOne) Where(ps ...predicate.SalaryComponent) *SalaryComponentUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SalaryComponentUpdateOne) Select(field string, fields ...string) *SalaryComponentUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SalaryComponent entity.
+func (scuo *SalaryComponentUpdateOne) Save(ctx context.Context) (*SalaryComponent, error) {
+	scuo.defaults()
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SalaryComponentUpdateOne) SaveX(ctx context.Context) *SalaryComponent {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SalaryComponentUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SalaryComponentUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scuo *SalaryComponentUpdateOne) defaults() {
+	if _, ok := scuo.mutation.ModifiedAt(); !ok {
+		v := salarycomponent.UpdateDefaultModifiedAt()
+		scuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SalaryComponentUpdateOne) check() error {
+	if v, ok := scuo.mutation.Code(); ok {
+		if err := salarycomponent.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.code": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.Name(); ok {
+		if err := salarycomponent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.name": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.Kind(); ok {
+		if err := salarycomponent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.kind": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.Method(); ok {
+		if err := salarycomponent.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "SalaryComponent.method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scuo *SalaryComponentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryComponentUpdateOne {
+	scuo.modifiers = append(scuo.modifiers, modifiers...)
+	return scuo
+}
+
+func (scuo *SalaryComponentUpdateOne) sqlSave(ctx context.Context) (_node *SalaryComponent, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycomponent.Table, salarycomponent.Columns, sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SalaryComponent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycomponent.FieldID)
+		for _, f := range fields {
+			if !salarycomponent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != salarycomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponent.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := scuo.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if scuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycomponent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := scuo.mutation.Code(); ok {
+		_spec.SetField(salarycomponent.FieldCode, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Name(); ok {
+		_spec.SetField(salarycomponent.FieldName, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Kind(); ok {
+		_spec.SetField(salarycomponent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.Method(); ok {
+		_spec.SetField(salarycomponent.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.Amount(); ok {
+		_spec.SetField(salarycomponent.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := scuo.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponent.FieldPercentage, field.TypeOther, value)
+	}
+	if value, ok := scuo.mutation.Description(); ok {
+		_spec.SetField(salarycomponent.FieldDescription, field.TypeString, value)
+	}
+	if scuo.mutation.DescriptionCleared() {
+		_spec.ClearField(salarycomponent.FieldDescription, field.TypeString)
+	}
+	if value, ok := scuo.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponent.FieldIsActive, field.TypeBool, value)
+	}
+	if scuo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !scuo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.AssignmentsTable,
+			Columns: []string{salarycomponent.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.CalculationItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RemovedCalculationItemsIDs(); len(nodes) > 0 && !scuo.mutation.CalculationItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.CalculationItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarycomponent.CalculationItemsTable,
+			Columns: []string{salarycomponent.CalculationItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycalculationitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scuo.modifiers...)
+	_node = &SalaryComponent{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentAssignment is the model entity for the SalaryComponentAssignment schema.
+type SalaryComponentAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to salary_components table
+	SalaryComponentID uint64 `json:"salary_component_id,omitempty"`
+	// Employee the component is paid to or deducted from, empty for a department assignment
+	EmployeeID *uint64 `json:"employee_id,omitempty"`
+	// Department whose employees get the component, an employee assignment of the same component wins
+	Department string `json:"department,omitempty"`
+	// Overrides the amount of the component for this assignment
+	Amount *decimal.Decimal `json:"amount,omitempty"`
+	// Overrides the percentage of the component for this assignment
+	Percentage *decimal.Decimal `json:"percentage,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SalaryComponentAssignmentQuery when eager-loading is set.
+	Edges        SalaryComponentAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SalaryComponentAssignmentEdges holds the relations/edges for other nodes in the graph.
+type SalaryComponentAssignmentEdges struct {
+	// SalaryComponent holds the value of the salary_component edge.
+	SalaryComponent *SalaryComponent `json:"salary_component,omitempty"`
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SalaryComponentOrErr returns the SalaryComponent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryComponentAssignmentEdges) SalaryComponentOrErr() (*SalaryComponent, error) {
+	if e.loadedTypes[0] {
+		if e.SalaryComponent == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: salarycomponent.Label}
+		}
+		return e.SalaryComponent, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_component"}
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryComponentAssignmentEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[1] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SalaryComponentAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case salarycomponentassignment.FieldAmount, salarycomponentassignment.FieldPercentage:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case salarycomponentassignment.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case salarycomponentassignment.FieldID, salarycomponentassignment.FieldSalaryComponentID, salarycomponentassignment.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case salarycomponentassignment.FieldDepartment:
+			values[i] = new(sql.NullString)
+		case salarycomponentassignment.FieldCreatedAt, salarycomponentassignment.FieldModifiedAt, salarycomponentassignment.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SalaryComponentAssignment fields.
+func (sca *SalaryComponentAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case salarycomponentassignment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sca.ID = uint64(value.Int64)
+		case salarycomponentassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sca.CreatedAt = value.Time
+			}
+		case salarycomponentassignment.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				sca.ModifiedAt = value.Time
+			}
+		case salarycomponentassignment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sca.DeletedAt = value.Time
+			}
+		case salarycomponentassignment.FieldSalaryComponentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field salary_component_id", values[i])
+			} else if value.Valid {
+				sca.SalaryComponentID = uint64(value.Int64)
+			}
+		case salarycomponentassignment.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				sca.EmployeeID = new(uint64)
+				*sca.EmployeeID = uint64(value.Int64)
+			}
+		case salarycomponentassignment.FieldDepartment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field department", values[i])
+			} else if value.Valid {
+				sca.Department = value.String
+			}
+		case salarycomponentassignment.FieldAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				sca.Amount = new(decimal.Decimal)
+				*sca.Amount = *value.S.(*decimal.Decimal)
+			}
+		case salarycomponentassignment.FieldPercentage:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value.Valid {
+				sca.Percentage = new(decimal.Decimal)
+				*sca.Percentage = *value.S.(*decimal.Decimal)
+			}
+		case salarycomponentassignment.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				sca.IsActive = value.Bool
+			}
+		default:
+			sca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SalaryComponentAssignment.
+// This includes values selected through modifiers, order, etc.
+func (sca *SalaryComponentAssignment) Value(name string) (ent.Value, error) {
+	return sca.selectValues.Get(name)
+}
+
+// QuerySalaryComponent queries the "salary_component" edge of the SalaryComponentAssignment entity.
+func (sca *SalaryComponentAssignment) QuerySalaryComponent() *SalaryComponentQuery {
+	return NewSalaryComponentAssignmentClient(sca.config).QuerySalaryComponent(sca)
+}
+
+// QueryEmployee queries the "employee" edge of the SalaryComponentAssignment entity.
+func (sca *SalaryComponentAssignment) QueryEmployee() *EmployeeQuery {
+	return NewSalaryComponentAssignmentClient(sca.config).QueryEmployee(sca)
+}
+
+// Update returns a builder for updating this SalaryComponentAssignment.
+// Note that you need to call SalaryComponentAssignment.Unwrap() before calling this method if this SalaryComponentAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sca *SalaryComponentAssignment) Update() *SalaryComponentAssignmentUpdateOne {
+	return NewSalaryComponentAssignmentClient(sca.config).UpdateOne(sca)
+}
+
+// Unwrap unwraps the SalaryComponentAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sca *SalaryComponentAssignment) Unwrap() *SalaryComponentAssignment {
+	_tx, ok := sca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SalaryComponentAssignment is not a transactional entity")
+	}
+	sca.config.driver = _tx.drv
+	return sca
+}
+
+// String implements the fmt.Stringer.
+func (sca *SalaryComponentAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("SalaryComponentAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sca.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sca.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(sca.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(sca.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("salary_component_id=")
+	builder.WriteString(fmt.Sprintf("%v", sca.SalaryComponentID))
+	builder.WriteString(", ")
+	if v := sca.EmployeeID; v != nil {
+		builder.WriteString("employee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("department=")
+	builder.WriteString(sca.Department)
+	builder.WriteString(", ")
+	if v := sca.Amount; v != nil {
+		builder.WriteString("amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sca.Percentage; v != nil {
+		builder.WriteString("percentage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", sca.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SalaryComponentAssignments is a parsable slice of SalaryComponentAssignment.
+type SalaryComponentAssignments []*SalaryComponentAssignment
@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package salarycomponentassignment
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SalaryComponentID applies equality check predicate on the "salary_component_id" field. It's identical to SalaryComponentIDEQ.
+func SalaryComponentID(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldSalaryComponentID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// Department applies equality check predicate on the "department" field. It's identical to DepartmentEQ.
+func Department(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldDepartment, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldAmount, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldPercentage, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SalaryComponentIDEQ applies the EQ predicate on the "salary_component_id" field.
+func SalaryComponentIDEQ(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldSalaryComponentID, v))
+}
+
+// SalaryComponentIDNEQ applies the NEQ predicate on the "salary_component_id" field.
+func SalaryComponentIDNEQ(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldSalaryComponentID, v))
+}
+
+// SalaryComponentIDIn applies the In predicate on the "salary_component_id" field.
+func SalaryComponentIDIn(vs ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldSalaryComponentID, vs...))
+}
+
+// SalaryComponentIDNotIn applies the NotIn predicate on the "salary_component_id" field.
+func SalaryComponentIDNotIn(vs ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldSalaryComponentID, vs...))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDIsNil applies the IsNil predicate on the "employee_id" field.
+func EmployeeIDIsNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIsNull(FieldEmployeeID))
+}
+
+// EmployeeIDNotNil applies the NotNil predicate on the "employee_id" field.
+func EmployeeIDNotNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotNull(FieldEmployeeID))
+}
+
+// DepartmentEQ applies the EQ predicate on the "department" field.
+func DepartmentEQ(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldDepartment, v))
+}
+
+// DepartmentNEQ applies the NEQ predicate on the "department" field.
+func DepartmentNEQ(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldDepartment, v))
+}
+
+// DepartmentIn applies the In predicate on the "department" field.
+func DepartmentIn(vs ...string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldDepartment, vs...))
+}
+
+// DepartmentNotIn applies the NotIn predicate on the "department" field.
+func DepartmentNotIn(vs ...string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldDepartment, vs...))
+}
+
+// DepartmentGT applies the GT predicate on the "department" field.
+func DepartmentGT(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldDepartment, v))
+}
+
+// DepartmentGTE applies the GTE predicate on the "department" field.
+func DepartmentGTE(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldDepartment, v))
+}
+
+// DepartmentLT applies the LT predicate on the "department" field.
+func DepartmentLT(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldDepartment, v))
+}
+
+// DepartmentLTE applies the LTE predicate on the "department" field.
+func DepartmentLTE(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldDepartment, v))
+}
+
+// DepartmentContains applies the Contains predicate on the "department" field.
+func DepartmentContains(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldContains(FieldDepartment, v))
+}
+
+// DepartmentHasPrefix applies the HasPrefix predicate on the "department" field.
+func DepartmentHasPrefix(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldHasPrefix(FieldDepartment, v))
+}
+
+// DepartmentHasSuffix applies the HasSuffix predicate on the "department" field.
+func DepartmentHasSuffix(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldHasSuffix(FieldDepartment, v))
+}
+
+// DepartmentIsNil applies the IsNil predicate on the "department" field.
+func DepartmentIsNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIsNull(FieldDepartment))
+}
+
+// DepartmentNotNil applies the NotNil predicate on the "department" field.
+func DepartmentNotNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotNull(FieldDepartment))
+}
+
+// DepartmentEqualFold applies the EqualFold predicate on the "department" field.
+func DepartmentEqualFold(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEqualFold(FieldDepartment, v))
+}
+
+// DepartmentContainsFold applies the ContainsFold predicate on the "department" field.
+func DepartmentContainsFold(v string) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldContainsFold(FieldDepartment, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountIsNil applies the IsNil predicate on the "amount" field.
+func AmountIsNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIsNull(FieldAmount))
+}
+
+// AmountNotNil applies the NotNil predicate on the "amount" field.
+func AmountNotNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotNull(FieldAmount))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v decimal.Decimal) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldLTE(FieldPercentage, v))
+}
+
+// PercentageIsNil applies the IsNil predicate on the "percentage" field.
+func PercentageIsNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldIsNull(FieldPercentage))
+}
+
+// PercentageNotNil applies the NotNil predicate on the "percentage" field.
+func PercentageNotNil() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNotNull(FieldPercentage))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// HasSalaryComponent applies the HasEdge predicate on the "salary_component" edge.
+func HasSalaryComponent() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SalaryComponentTable, SalaryComponentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryComponentWith applies the HasEdge predicate on the "salary_component" edge with a given conditions (other predicates).
+func HasSalaryComponentWith(preds ...predicate.SalaryComponent) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		step := newSalaryComponentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SalaryComponentAssignment) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SalaryComponentAssignment) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SalaryComponentAssignment) predicate.SalaryComponentAssignment {
+	return predicate.SalaryComponentAssignment(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
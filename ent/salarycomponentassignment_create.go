@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentAssignmentCreate is the builder for creating a SalaryComponentAssignment entity.
+type SalaryComponentAssignmentCreate struct {
+	config
+	mutation *SalaryComponentAssignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scac *SalaryComponentAssignmentCreate) SetCreatedAt(t time.Time) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetCreatedAt(t)
+	return scac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableCreatedAt(t *time.Time) *SalaryComponentAssignmentCreate {
+	if t != nil {
+		scac.SetCreatedAt(*t)
+	}
+	return scac
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scac *SalaryComponentAssignmentCreate) SetModifiedAt(t time.Time) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetModifiedAt(t)
+	return scac
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableModifiedAt(t *time.Time) *SalaryComponentAssignmentCreate {
+	if t != nil {
+		scac.SetModifiedAt(*t)
+	}
+	return scac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scac *SalaryComponentAssignmentCreate) SetDeletedAt(t time.Time) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetDeletedAt(t)
+	return scac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableDeletedAt(t *time.Time) *SalaryComponentAssignmentCreate {
+	if t != nil {
+		scac.SetDeletedAt(*t)
+	}
+	return scac
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (scac *SalaryComponentAssignmentCreate) SetSalaryComponentID(u uint64) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetSalaryComponentID(u)
+	return scac
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (scac *SalaryComponentAssignmentCreate) SetEmployeeID(u uint64) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetEmployeeID(u)
+	return scac
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableEmployeeID(u *uint64) *SalaryComponentAssignmentCreate {
+	if u != nil {
+		scac.SetEmployeeID(*u)
+	}
+	return scac
+}
+
+// SetDepartment sets the "department" field.
+func (scac *SalaryComponentAssignmentCreate) SetDepartment(s string) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetDepartment(s)
+	return scac
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableDepartment(s *string) *SalaryComponentAssignmentCreate {
+	if s != nil {
+		scac.SetDepartment(*s)
+	}
+	return scac
+}
+
+// SetAmount sets the "amount" field.
+func (scac *SalaryComponentAssignmentCreate) SetAmount(d decimal.Decimal) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetAmount(d)
+	return scac
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableAmount(d *decimal.Decimal) *SalaryComponentAssignmentCreate {
+	if d != nil {
+		scac.SetAmount(*d)
+	}
+	return scac
+}
+
+// SetPercentage sets the "percentage" field.
+func (scac *SalaryComponentAssignmentCreate) SetPercentage(d decimal.Decimal) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetPercentage(d)
+	return scac
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentAssignmentCreate {
+	if d != nil {
+		scac.SetPercentage(*d)
+	}
+	return scac
+}
+
+// SetIsActive sets the "is_active" field.
+func (scac *SalaryComponentAssignmentCreate) SetIsActive(b bool) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetIsActive(b)
+	return scac
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scac *SalaryComponentAssignmentCreate) SetNillableIsActive(b *bool) *SalaryComponentAssignmentCreate {
+	if b != nil {
+		scac.SetIsActive(*b)
+	}
+	return scac
+}
+
+// SetID sets the "id" field.
+func (scac *SalaryComponentAssignmentCreate) SetID(u uint64) *SalaryComponentAssignmentCreate {
+	scac.mutation.SetID(u)
+	return scac
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (scac *SalaryComponentAssignmentCreate) SetSalaryComponent(s *SalaryComponent) *SalaryComponentAssignmentCreate {
+	return scac.SetSalaryComponentID(s.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (scac *SalaryComponentAssignmentCreate) SetEmployee(e *Employee) *SalaryComponentAssignmentCreate {
+	return scac.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the SalaryComponentAssignmentMutation object of the builder.
+func (scac *SalaryComponentAssignmentCreate) Mutation() *SalaryComponentAssignmentMutation {
+	return scac.mutation
+}
+
+// Save creates the SalaryComponentAssignment in the database.
+func (scac *SalaryComponentAssignmentCreate) Save(ctx context.Context) (*SalaryComponentAssignment, error) {
+	scac.defaults()
+	return withHooks(ctx, scac.sqlSave, scac.mutation, scac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scac *SalaryComponentAssignmentCreate) SaveX(ctx context.Context) *SalaryComponentAssignment {
+	v, err := scac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scac *SalaryComponentAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := scac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scac *SalaryComponentAssignmentCreate) ExecX(ctx context.Context) {
+	if err := scac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scac *SalaryComponentAssignmentCreate) defaults() {
+	if _, ok := scac.mutation.CreatedAt(); !ok {
+		v := salarycomponentassignment.DefaultCreatedAt()
+		scac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scac.mutation.ModifiedAt(); !ok {
+		v := salarycomponentassignment.DefaultModifiedAt()
+		scac.mutation.SetModifiedAt(v)
+	}
+	if _, ok := scac.mutation.IsActive(); !ok {
+		v := salarycomponentassignment.DefaultIsActive
+		scac.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scac *SalaryComponentAssignmentCreate) check() error {
+	if _, ok := scac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SalaryComponentAssignment.created_at"`)}
+	}
+	if _, ok := scac.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "SalaryComponentAssignment.modified_at"`)}
+	}
+	if _, ok := scac.mutation.SalaryComponentID(); !ok {
+		return &ValidationError{Name: "salary_component_id", err: errors.New(`ent: missing required field "SalaryComponentAssignment.salary_component_id"`)}
+	}
+	if v, ok := scac.mutation.Department(); ok {
+		if err := salarycomponentassignment.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "SalaryComponentAssignment.department": %w`, err)}
+		}
+	}
+	if _, ok := scac.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SalaryComponentAssignment.is_active"`)}
+	}
+	if _, ok := scac.mutation.SalaryComponentID(); !ok {
+		return &ValidationError{Name: "salary_component", err: errors.New(`ent: missing required edge "SalaryComponentAssignment.salary_component"`)}
+	}
+	return nil
+}
+
+func (scac *SalaryComponentAssignmentCreate) sqlSave(ctx context.Context) (*SalaryComponentAssignment, error) {
+	if err := scac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	scac.mutation.id = &_node.ID
+	scac.mutation.done = true
+	return _node, nil
+}
+
+func (scac *SalaryComponentAssignmentCreate) createSpec() (*SalaryComponentAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SalaryComponentAssignment{config: scac.config}
+		_spec = sqlgraph.NewCreateSpec(salarycomponentassignment.Table, sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64))
+	)
+	if id, ok := scac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scac.mutation.CreatedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scac.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := scac.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := scac.mutation.Department(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDepartment, field.TypeString, value)
+		_node.Department = value
+	}
+	if value, ok := scac.mutation.Amount(); ok {
+		_spec.SetField(salarycomponentassignment.FieldAmount, field.TypeOther, value)
+		_node.Amount = &value
+	}
+	if value, ok := scac.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponentassignment.FieldPercentage, field.TypeOther, value)
+		_node.Percentage = &value
+	}
+	if value, ok := scac.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponentassignment.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := scac.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.SalaryComponentTable,
+			Columns: []string{salarycomponentassignment.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SalaryComponentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scac.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.EmployeeTable,
+			Columns: []string{salarycomponentassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SalaryComponentAssignmentCreateBulk is the builder for creating many SalaryComponentAssignment entities in bulk.
+type SalaryComponentAssignmentCreateBulk struct {
+	config
+	builders []*SalaryComponentAssignmentCreate
+}
+
+// Save creates the SalaryComponentAssignment entities in the database.
+func (scacb *SalaryComponentAssignmentCreateBulk) Save(ctx context.Context) ([]*SalaryComponentAssignment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scacb.builders))
+	nodes := make([]*SalaryComponentAssignment, len(scacb.builders))
+	mutators := make([]Mutator, len(scacb.builders))
+	for i := range scacb.builders {
+		func(i int, root context.Context) {
+			builder := scacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SalaryComponentAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scacb *SalaryComponentAssignmentCreateBulk) SaveX(ctx context.Context) []*SalaryComponentAssignment {
+	v, err := scacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scacb *SalaryComponentAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := scacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scacb *SalaryComponentAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := scacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycomponentassignment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryComponentAssignmentDelete is the builder for deleting a SalaryComponentAssignment entity.
+type SalaryComponentAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SalaryComponentAssignmentMutation
+}
+
+// Where appends a list predicates to the SalaryComponentAssignmentDelete builder.
+func (scad *SalaryComponentAssignmentDelete) Where(ps ...predicate.SalaryComponentAssignment) *SalaryComponentAssignmentDelete {
+	scad.mutation.Where(ps...)
+	return scad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scad *SalaryComponentAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scad.sqlExec, scad.mutation, scad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scad *SalaryComponentAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := scad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scad *SalaryComponentAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(salarycomponentassignment.Table, sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64))
+	if ps := scad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scad.mutation.done = true
+	return affected, err
+}
+
+// SalaryComponentAssignmentDeleteOne is the builder for deleting a single SalaryComponentAssignment entity.
+type SalaryComponentAssignmentDeleteOne struct {
+	scad *SalaryComponentAssignmentDelete
+}
+
+// Where appends a list predicates to the SalaryComponentAssignmentDelete builder.
+func (scado *SalaryComponentAssignmentDeleteOne) Where(ps ...predicate.SalaryComponentAssignment) *SalaryComponentAssignmentDeleteOne {
+	scado.scad.mutation.Where(ps...)
+	return scado
+}
+
+// Exec executes the deletion query.
+func (scado *SalaryComponentAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := scado.scad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{salarycomponentassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scado *SalaryComponentAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := scado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
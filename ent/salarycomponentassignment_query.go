@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SalaryComponentAssignmentQuery is the builder for querying SalaryComponentAssignment entities.
+type SalaryComponentAssignmentQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []salarycomponentassignment.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.SalaryComponentAssignment
+	withSalaryComponent *SalaryComponentQuery
+	withEmployee        *EmployeeQuery
+	modifiers           []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SalaryComponentAssignmentQuery builder.
+func (scaq *SalaryComponentAssignmentQuery) Where(ps ...predicate.SalaryComponentAssignment) *SalaryComponentAssignmentQuery {
+	scaq.predicates = append(scaq.predicates, ps...)
+	return scaq
+}
+
+// Limit the number of records to be returned by this query.
+func (scaq *SalaryComponentAssignmentQuery) Limit(limit int) *SalaryComponentAssignmentQuery {
+	scaq.ctx.Limit = &limit
+	return scaq
+}
+
+// Offset to start from.
+func (scaq *SalaryComponentAssignmentQuery) Offset(offset int) *SalaryComponentAssignmentQuery {
+	scaq.ctx.Offset = &offset
+	return scaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scaq *SalaryComponentAssignmentQuery) Unique(unique bool) *SalaryComponentAssignmentQuery {
+	scaq.ctx.Unique = &unique
+	return scaq
+}
+
+// Order specifies how the records should be ordered.
+func (scaq *SalaryComponentAssignmentQuery) Order(o ...salarycomponentassignment.OrderOption) *SalaryComponentAssignmentQuery {
+	scaq.order = append(scaq.order, o...)
+	return scaq
+}
+
+// QuerySalaryComponent chains the current query on the "salary_component" edge.
+func (scaq *SalaryComponentAssignmentQuery) QuerySalaryComponent() *SalaryComponentQuery {
+	query := (&SalaryComponentClient{config: scaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponentassignment.Table, salarycomponentassignment.FieldID, selector),
+			sqlgraph.To(salarycomponent.Table, salarycomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycomponentassignment.SalaryComponentTable, salarycomponentassignment.SalaryComponentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (scaq *SalaryComponentAssignmentQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: scaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarycomponentassignment.Table, salarycomponentassignment.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarycomponentassignment.EmployeeTable, salarycomponentassignment.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SalaryComponentAssignment entity from the query.
+// Returns a *NotFoundError when no SalaryComponentAssignment was found.
+func (scaq *SalaryComponentAssignmentQuery) First(ctx context.Context) (*SalaryComponentAssignment, error) {
+	nodes, err := scaq.Limit(1).All(setContextOp(ctx, scaq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{salarycomponentassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) FirstX(ctx context.Context) *SalaryComponentAssignment {
+	node, err := scaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SalaryComponentAssignment ID from the query.
+// Returns a *NotFoundError when no SalaryComponentAssignment ID was found.
+func (scaq *SalaryComponentAssignmentQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = scaq.Limit(1).IDs(setContextOp(ctx, scaq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{salarycomponentassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := scaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SalaryComponentAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SalaryComponentAssignment entity is found.
+// Returns a *NotFoundError when no SalaryComponentAssignment entities are found.
+func (scaq *SalaryComponentAssignmentQuery) Only(ctx context.Context) (*SalaryComponentAssignment, error) {
+	nodes, err := scaq.Limit(2).All(setContextOp(ctx, scaq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{salarycomponentassignment.Label}
+	default:
+		return nil, &NotSingularError{salarycomponentassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) OnlyX(ctx context.Context) *SalaryComponentAssignment {
+	node, err := scaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SalaryComponentAssignment ID in the query.
+// Returns a *NotSingularError when more than one SalaryComponentAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scaq *SalaryComponentAssignmentQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = scaq.Limit(2).IDs(setContextOp(ctx, scaq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{salarycomponentassignment.Label}
+	default:
+		err = &NotSingularError{salarycomponentassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := scaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SalaryComponentAssignments.
+func (scaq *SalaryComponentAssignmentQuery) All(ctx context.Context) ([]*SalaryComponentAssignment, error) {
+	ctx = setContextOp(ctx, scaq.ctx, "All")
+	if err := scaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SalaryComponentAssignment, *SalaryComponentAssignmentQuery]()
+	return withInterceptors[[]*SalaryComponentAssignment](ctx, scaq, qr, scaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) AllX(ctx context.Context) []*SalaryComponentAssignment {
+	nodes, err := scaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SalaryComponentAssignment IDs.
+func (scaq *SalaryComponentAssignmentQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if scaq.ctx.Unique == nil && scaq.path != nil {
+		scaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scaq.ctx, "IDs")
+	if err = scaq.Select(salarycomponentassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := scaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scaq *SalaryComponentAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scaq.ctx, "Count")
+	if err := scaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scaq, querierCount[*SalaryComponentAssignmentQuery](), scaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := scaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scaq *SalaryComponentAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scaq.ctx, "Exist")
+	switch _, err := scaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scaq *SalaryComponentAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := scaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SalaryComponentAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scaq *SalaryComponentAssignmentQuery) Clone() *SalaryComponentAssignmentQuery {
+	if scaq == nil {
+		return nil
+	}
+	return &SalaryComponentAssignmentQuery{
+		config:              scaq.config,
+		ctx:                 scaq.ctx.Clone(),
+		order:               append([]salarycomponentassignment.OrderOption{}, scaq.order...),
+		inters:              append([]Interceptor{}, scaq.inters...),
+		predicates:          append([]predicate.SalaryComponentAssignment{}, scaq.predicates...),
+		withSalaryComponent: scaq.withSalaryComponent.Clone(),
+		withEmployee:        scaq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  scaq.sql.Clone(),
+		path: scaq.path,
+	}
+}
+
+// WithSalaryComponent tells the query-builder to eager-load the nodes that are connected to
+// the "salary_component" edge. The optional arguments are used to configure the query builder of the edge.
+func (scaq *SalaryComponentAssignmentQuery) WithSalaryComponent(opts ...func(*SalaryComponentQuery)) *SalaryComponentAssignmentQuery {
+	query := (&SalaryComponentClient{config: scaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scaq.withSalaryComponent = query
+	return scaq
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (scaq *SalaryComponentAssignmentQuery) WithEmployee(opts ...func(*EmployeeQuery)) *SalaryComponentAssignmentQuery {
+	query := (&EmployeeClient{config: scaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scaq.withEmployee = query
+	return scaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SalaryComponentAssignment.Query().
+//		GroupBy(salarycomponentassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scaq *SalaryComponentAssignmentQuery) GroupBy(field string, fields ...string) *SalaryComponentAssignmentGroupBy {
+	scaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SalaryComponentAssignmentGroupBy{build: scaq}
+	grbuild.flds = &scaq.ctx.Fields
+	grbuild.label = salarycomponentassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SalaryComponentAssignment.Query().
+//		Select(salarycomponentassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (scaq *SalaryComponentAssignmentQuery) Select(fields ...string) *SalaryComponentAssignmentSelect {
+	scaq.ctx.Fields = append(scaq.ctx.Fields, fields...)
+	sbuild := &SalaryComponentAssignmentSelect{SalaryComponentAssignmentQuery: scaq}
+	sbuild.label = salarycomponentassignment.Label
+	sbuild.flds, sbuild.scan = &scaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SalaryComponentAssignmentSelect configured with the given aggregations.
+func (scaq *SalaryComponentAssignmentQuery) Aggregate(fns ...AggregateFunc) *SalaryComponentAssignmentSelect {
+	return scaq.Select().Aggregate(fns...)
+}
+
+func (scaq *SalaryComponentAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scaq.ctx.Fields {
+		if !salarycomponentassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scaq.path != nil {
+		prev, err := scaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scaq.sql = prev
+	}
+	return nil
+}
+
+func (scaq *SalaryComponentAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SalaryComponentAssignment, error) {
+	var (
+		nodes       = []*SalaryComponentAssignment{}
+		_spec       = scaq.querySpec()
+		loadedTypes = [2]bool{
+			scaq.withSalaryComponent != nil,
+			scaq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SalaryComponentAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SalaryComponentAssignment{config: scaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(scaq.modifiers) > 0 {
+		_spec.Modifiers = scaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scaq.withSalaryComponent; query != nil {
+		if err := scaq.loadSalaryComponent(ctx, query, nodes, nil,
+			func(n *SalaryComponentAssignment, e *SalaryComponent) { n.Edges.SalaryComponent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scaq.withEmployee; query != nil {
+		if err := scaq.loadEmployee(ctx, query, nodes, nil,
+			func(n *SalaryComponentAssignment, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scaq *SalaryComponentAssignmentQuery) loadSalaryComponent(ctx context.Context, query *SalaryComponentQuery, nodes []*SalaryComponentAssignment, init func(*SalaryComponentAssignment), assign func(*SalaryComponentAssignment, *SalaryComponent)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*SalaryComponentAssignment)
+	for i := range nodes {
+		fk := nodes[i].SalaryComponentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(salarycomponent.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "salary_component_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scaq *SalaryComponentAssignmentQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*SalaryComponentAssignment, init func(*SalaryComponentAssignment), assign func(*SalaryComponentAssignment, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*SalaryComponentAssignment)
+	for i := range nodes {
+		if nodes[i].EmployeeID == nil {
+			continue
+		}
+		fk := *nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (scaq *SalaryComponentAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scaq.querySpec()
+	if len(scaq.modifiers) > 0 {
+		_spec.Modifiers = scaq.modifiers
+	}
+	_spec.Node.Columns = scaq.ctx.Fields
+	if len(scaq.ctx.Fields) > 0 {
+		_spec.Unique = scaq.ctx.Unique != nil && *scaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scaq.driver, _spec)
+}
+
+func (scaq *SalaryComponentAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(salarycomponentassignment.Table, salarycomponentassignment.Columns, sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64))
+	_spec.From = scaq.sql
+	if unique := scaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycomponentassignment.FieldID)
+		for i := range fields {
+			if fields[i] != salarycomponentassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if scaq.withSalaryComponent != nil {
+			_spec.Node.AddColumnOnce(salarycomponentassignment.FieldSalaryComponentID)
+		}
+		if scaq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(salarycomponentassignment.FieldEmployeeID)
+		}
+	}
+	if ps := scaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scaq *SalaryComponentAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scaq.driver.Dialect())
+	t1 := builder.Table(salarycomponentassignment.Table)
+	columns := scaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = salarycomponentassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scaq.sql != nil {
+		selector = scaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scaq.ctx.Unique != nil && *scaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range scaq.modifiers {
+		m(selector)
+	}
+	for _, p := range scaq.predicates {
+		p(selector)
+	}
+	for _, p := range scaq.order {
+		p(selector)
+	}
+	if offset := scaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scaq *SalaryComponentAssignmentQuery) Modify(modifiers ...func(s *sql.Selector)) *SalaryComponentAssignmentSelect {
+	scaq.modifiers = append(scaq.modifiers, modifiers...)
+	return scaq.Select()
+}
+
+// SalaryComponentAssignmentGroupBy is the group-by builder for SalaryComponentAssignment entities.
+type SalaryComponentAssignmentGroupBy struct {
+	selector
+	build *SalaryComponentAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scagb *SalaryComponentAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *SalaryComponentAssignmentGroupBy {
+	scagb.fns = append(scagb.fns, fns...)
+	return scagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scagb *SalaryComponentAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scagb.build.ctx, "GroupBy")
+	if err := scagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryComponentAssignmentQuery, *SalaryComponentAssignmentGroupBy](ctx, scagb.build, scagb, scagb.build.inters, v)
+}
+
+func (scagb *SalaryComponentAssignmentGroupBy) sqlScan(ctx context.Context, root *SalaryComponentAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scagb.fns))
+	for _, fn := range scagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scagb.flds)+len(scagb.fns))
+		for _, f := range *scagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SalaryComponentAssignmentSelect is the builder for selecting fields of SalaryComponentAssignment entities.
+type SalaryComponentAssignmentSelect struct {
+	*SalaryComponentAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scas *SalaryComponentAssignmentSelect) Aggregate(fns ...AggregateFunc) *SalaryComponentAssignmentSelect {
+	scas.fns = append(scas.fns, fns...)
+	return scas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scas *SalaryComponentAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scas.ctx, "Select")
+	if err := scas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryComponentAssignmentQuery, *SalaryComponentAssignmentSelect](ctx, scas.SalaryComponentAssignmentQuery, scas, scas.inters, v)
+}
+
+func (scas *SalaryComponentAssignmentSelect) sqlScan(ctx context.Context, root *SalaryComponentAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scas.fns))
+	for _, fn := range scas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scas *SalaryComponentAssignmentSelect) Modify(modifiers ...func(s *sql.Selector)) *SalaryComponentAssignmentSelect {
+	scas.modifiers = append(scas.modifiers, modifiers...)
+	return scas
+}
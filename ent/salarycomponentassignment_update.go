@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentAssignmentUpdate is the builder for updating SalaryComponentAssignment entities.
+type SalaryComponentAssignmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SalaryComponentAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SalaryComponentAssignmentUpdate builder.
+func (scau *SalaryComponentAssignmentUpdate) Where(ps ...predicate.SalaryComponentAssignment) *SalaryComponentAssignmentUpdate {
+	scau.mutation.Where(ps...)
+	return scau
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scau *SalaryComponentAssignmentUpdate) SetModifiedAt(t time.Time) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetModifiedAt(t)
+	return scau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scau *SalaryComponentAssignmentUpdate) SetDeletedAt(t time.Time) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetDeletedAt(t)
+	return scau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillableDeletedAt(t *time.Time) *SalaryComponentAssignmentUpdate {
+	if t != nil {
+		scau.SetDeletedAt(*t)
+	}
+	return scau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (scau *SalaryComponentAssignmentUpdate) ClearDeletedAt() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearDeletedAt()
+	return scau
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (scau *SalaryComponentAssignmentUpdate) SetSalaryComponentID(u uint64) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetSalaryComponentID(u)
+	return scau
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (scau *SalaryComponentAssignmentUpdate) SetEmployeeID(u uint64) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetEmployeeID(u)
+	return scau
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillableEmployeeID(u *uint64) *SalaryComponentAssignmentUpdate {
+	if u != nil {
+		scau.SetEmployeeID(*u)
+	}
+	return scau
+}
+
+// ClearEmployeeID clears the value of the "employee_id" field.
+func (scau *SalaryComponentAssignmentUpdate) ClearEmployeeID() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearEmployeeID()
+	return scau
+}
+
+// SetDepartment sets the "department" field.
+func (scau *SalaryComponentAssignmentUpdate) SetDepartment(s string) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetDepartment(s)
+	return scau
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillableDepartment(s *string) *SalaryComponentAssignmentUpdate {
+	if s != nil {
+		scau.SetDepartment(*s)
+	}
+	return scau
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (scau *SalaryComponentAssignmentUpdate) ClearDepartment() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearDepartment()
+	return scau
+}
+
+// SetAmount sets the "amount" field.
+func (scau *SalaryComponentAssignmentUpdate) SetAmount(d decimal.Decimal) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetAmount(d)
+	return scau
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillableAmount(d *decimal.Decimal) *SalaryComponentAssignmentUpdate {
+	if d != nil {
+		scau.SetAmount(*d)
+	}
+	return scau
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (scau *SalaryComponentAssignmentUpdate) ClearAmount() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearAmount()
+	return scau
+}
+
+// SetPercentage sets the "percentage" field.
+func (scau *SalaryComponentAssignmentUpdate) SetPercentage(d decimal.Decimal) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetPercentage(d)
+	return scau
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentAssignmentUpdate {
+	if d != nil {
+		scau.SetPercentage(*d)
+	}
+	return scau
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (scau *SalaryComponentAssignmentUpdate) ClearPercentage() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearPercentage()
+	return scau
+}
+
+// SetIsActive sets the "is_active" field.
+func (scau *SalaryComponentAssignmentUpdate) SetIsActive(b bool) *SalaryComponentAssignmentUpdate {
+	scau.mutation.SetIsActive(b)
+	return scau
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scau *SalaryComponentAssignmentUpdate) SetNillableIsActive(b *bool) *SalaryComponentAssignmentUpdate {
+	if b != nil {
+		scau.SetIsActive(*b)
+	}
+	return scau
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (scau *SalaryComponentAssignmentUpdate) SetSalaryComponent(s *SalaryComponent) *SalaryComponentAssignmentUpdate {
+	return scau.SetSalaryComponentID(s.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (scau *SalaryComponentAssignmentUpdate) SetEmployee(e *Employee) *SalaryComponentAssignmentUpdate {
+	return scau.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the SalaryComponentAssignmentMutation object of the builder.
+func (scau *SalaryComponentAssignmentUpdate) Mutation() *SalaryComponentAssignmentMutation {
+	return scau.mutation
+}
+
+// ClearSalaryComponent clears the "salary_component" edge to the SalaryComponent entity.
+func (scau *SalaryComponentAssignmentUpdate) ClearSalaryComponent() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearSalaryComponent()
+	return scau
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (scau *SalaryComponentAssignmentUpdate) ClearEmployee() *SalaryComponentAssignmentUpdate {
+	scau.mutation.ClearEmployee()
+	return scau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scau *SalaryComponentAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	scau.defaults()
+	return withHooks(ctx, scau.sqlSave, scau.mutation, scau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scau *SalaryComponentAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := scau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scau *SalaryComponentAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := scau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scau *SalaryComponentAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := scau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scau *SalaryComponentAssignmentUpdate) defaults() {
+	if _, ok := scau.mutation.ModifiedAt(); !ok {
+		v := salarycomponentassignment.UpdateDefaultModifiedAt()
+		scau.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scau *SalaryComponentAssignmentUpdate) check() error {
+	if v, ok := scau.mutation.Department(); ok {
+		if err := salarycomponentassignment.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "SalaryComponentAssignment.department": %w`, err)}
+		}
+	}
+	if _, ok := scau.mutation.SalaryComponentID(); scau.mutation.SalaryComponentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SalaryComponentAssignment.salary_component"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scau *SalaryComponentAssignmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryComponentAssignmentUpdate {
+	scau.modifiers = append(scau.modifiers, modifiers...)
+	return scau
+}
+
+func (scau *SalaryComponentAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycomponentassignment.Table, salarycomponentassignment.Columns, sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64))
+	if ps := scau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scau.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := scau.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if scau.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := scau.mutation.Department(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDepartment, field.TypeString, value)
+	}
+	if scau.mutation.DepartmentCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldDepartment, field.TypeString)
+	}
+	if value, ok := scau.mutation.Amount(); ok {
+		_spec.SetField(salarycomponentassignment.FieldAmount, field.TypeOther, value)
+	}
+	if scau.mutation.AmountCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldAmount, field.TypeOther)
+	}
+	if value, ok := scau.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponentassignment.FieldPercentage, field.TypeOther, value)
+	}
+	if scau.mutation.PercentageCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldPercentage, field.TypeOther)
+	}
+	if value, ok := scau.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponentassignment.FieldIsActive, field.TypeBool, value)
+	}
+	if scau.mutation.SalaryComponentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.SalaryComponentTable,
+			Columns: []string{salarycomponentassignment.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scau.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.SalaryComponentTable,
+			Columns: []string{salarycomponentassignment.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scau.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.EmployeeTable,
+			Columns: []string{salarycomponentassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scau.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.EmployeeTable,
+			Columns: []string{salarycomponentassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, scau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycomponentassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scau.mutation.done = true
+	return n, nil
+}
+
+// SalaryComponentAssignmentUpdateOne is the builder for updating a single SalaryComponentAssignment entity.
+type SalaryComponentAssignmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SalaryComponentAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetModifiedAt(t time.Time) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetModifiedAt(t)
+	return scauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetDeletedAt(t time.Time) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetDeletedAt(t)
+	return scauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillableDeletedAt(t *time.Time) *SalaryComponentAssignmentUpdateOne {
+	if t != nil {
+		scauo.SetDeletedAt(*t)
+	}
+	return scauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearDeletedAt() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearDeletedAt()
+	return scauo
+}
+
+// SetSalaryComponentID sets the "salary_component_id" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetSalaryComponentID(u uint64) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetSalaryComponentID(u)
+	return scauo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetEmployeeID(u uint64) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetEmployeeID(u)
+	return scauo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillableEmployeeID(u *uint64) *SalaryComponentAssignmentUpdateOne {
+	if u != nil {
+		scauo.SetEmployeeID(*u)
+	}
+	return scauo
+}
+
+// ClearEmployeeID clears the value of the "employee_id" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearEmployeeID() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearEmployeeID()
+	return scauo
+}
+
+// SetDepartment sets the "department" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetDepartment(s string) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetDepartment(s)
+	return scauo
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillableDepartment(s *string) *SalaryComponentAssignmentUpdateOne {
+	if s != nil {
+		scauo.SetDepartment(*s)
+	}
+	return scauo
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearDepartment() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearDepartment()
+	return scauo
+}
+
+// SetAmount sets the "amount" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetAmount(d decimal.Decimal) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetAmount(d)
+	return scauo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillableAmount(d *decimal.Decimal) *SalaryComponentAssignmentUpdateOne {
+	if d != nil {
+		scauo.SetAmount(*d)
+	}
+	return scauo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearAmount() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearAmount()
+	return scauo
+}
+
+// SetPercentage sets the "percentage" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetPercentage(d decimal.Decimal) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetPercentage(d)
+	return scauo
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillablePercentage(d *decimal.Decimal) *SalaryComponentAssignmentUpdateOne {
+	if d != nil {
+		scauo.SetPercentage(*d)
+	}
+	return scauo
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearPercentage() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearPercentage()
+	return scauo
+}
+
+// SetIsActive sets the "is_active" field.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetIsActive(b bool) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.SetIsActive(b)
+	return scauo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetNillableIsActive(b *bool) *SalaryComponentAssignmentUpdateOne {
+	if b != nil {
+		scauo.SetIsActive(*b)
+	}
+	return scauo
+}
+
+// SetSalaryComponent sets the "salary_component" edge to the SalaryComponent entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetSalaryComponent(s *SalaryComponent) *SalaryComponentAssignmentUpdateOne {
+	return scauo.SetSalaryComponentID(s.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) SetEmployee(e *Employee) *SalaryComponentAssignmentUpdateOne {
+	return scauo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the SalaryComponentAssignmentMutation object of the builder.
+func (scauo *SalaryComponentAssignmentUpdateOne) Mutation() *SalaryComponentAssignmentMutation {
+	return scauo.mutation
+}
+
+// ClearSalaryComponent clears the "salary_component" edge to the SalaryComponent entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearSalaryComponent() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearSalaryComponent()
+	return scauo
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) ClearEmployee() *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.ClearEmployee()
+	return scauo
+}
+
+// Where appends a list predicates to the SalaryComponentAssignmentUpdate builder.
+func (scauo *SalaryComponentAssignmentUpdateOne) Where(ps ...predicate.SalaryComponentAssignment) *SalaryComponentAssignmentUpdateOne {
+	scauo.mutation.Where(ps...)
+	return scauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scauo *SalaryComponentAssignmentUpdateOne) Select(field string, fields ...string) *SalaryComponentAssignmentUpdateOne {
+	scauo.fields = append([]string{field}, fields...)
+	return scauo
+}
+
+// Save executes the query and returns the updated SalaryComponentAssignment entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) Save(ctx context.Context) (*SalaryComponentAssignment, error) {
+	scauo.defaults()
+	return withHooks(ctx, scauo.sqlSave, scauo.mutation, scauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scauo *SalaryComponentAssignmentUpdateOne) SaveX(ctx context.Context) *SalaryComponentAssignment {
+	node, err := scauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scauo *SalaryComponentAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := scauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scauo *SalaryComponentAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := scauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scauo *SalaryComponentAssignmentUpdateOne) defaults() {
+	if _, ok := scauo.mutation.ModifiedAt(); !ok {
+		v := salarycomponentassignment.UpdateDefaultModifiedAt()
+		scauo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scauo *SalaryComponentAssignmentUpdateOne) check() error {
+	if v, ok := scauo.mutation.Department(); ok {
+		if err := salarycomponentassignment.DepartmentValidator(v); err != nil {
+			return &ValidationError{Name: "department", err: fmt.Errorf(`ent: validator failed for field "SalaryComponentAssignment.department": %w`, err)}
+		}
+	}
+	if _, ok := scauo.mutation.SalaryComponentID(); scauo.mutation.SalaryComponentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SalaryComponentAssignment.salary_component"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scauo *SalaryComponentAssignmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SalaryComponentAssignmentUpdateOne {
+	scauo.modifiers = append(scauo.modifiers, modifiers...)
+	return scauo
+}
+
+func (scauo *SalaryComponentAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *SalaryComponentAssignment, err error) {
+	if err := scauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarycomponentassignment.Table, salarycomponentassignment.Columns, sqlgraph.NewFieldSpec(salarycomponentassignment.FieldID, field.TypeUint64))
+	id, ok := scauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SalaryComponentAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarycomponentassignment.FieldID)
+		for _, f := range fields {
+			if !salarycomponentassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != salarycomponentassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scauo.mutation.ModifiedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := scauo.mutation.DeletedAt(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if scauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := scauo.mutation.Department(); ok {
+		_spec.SetField(salarycomponentassignment.FieldDepartment, field.TypeString, value)
+	}
+	if scauo.mutation.DepartmentCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldDepartment, field.TypeString)
+	}
+	if value, ok := scauo.mutation.Amount(); ok {
+		_spec.SetField(salarycomponentassignment.FieldAmount, field.TypeOther, value)
+	}
+	if scauo.mutation.AmountCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldAmount, field.TypeOther)
+	}
+	if value, ok := scauo.mutation.Percentage(); ok {
+		_spec.SetField(salarycomponentassignment.FieldPercentage, field.TypeOther, value)
+	}
+	if scauo.mutation.PercentageCleared() {
+		_spec.ClearField(salarycomponentassignment.FieldPercentage, field.TypeOther)
+	}
+	if value, ok := scauo.mutation.IsActive(); ok {
+		_spec.SetField(salarycomponentassignment.FieldIsActive, field.TypeBool, value)
+	}
+	if scauo.mutation.SalaryComponentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.SalaryComponentTable,
+			Columns: []string{salarycomponentassignment.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scauo.mutation.SalaryComponentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.SalaryComponentTable,
+			Columns: []string{salarycomponentassignment.SalaryComponentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarycomponent.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scauo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.EmployeeTable,
+			Columns: []string{salarycomponentassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scauo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarycomponentassignment.EmployeeTable,
+			Columns: []string{salarycomponentassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scauo.modifiers...)
+	_node = &SalaryComponentAssignment{config: scauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarycomponentassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scauo.mutation.done = true
+	return _node, nil
+}
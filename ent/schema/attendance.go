@@ -23,23 +23,28 @@ func (Attendance) Fields() []ent.Field {
 			Comment("Foreign key to employees table"),
 
 		field.Time("attendance_date").
-			Comment("Date of attendance"),
+			Comment("Date of the shift, overnight shifts keep the date they started on"),
 
 		field.Time("check_in_time").
 			Optional().
-			Comment("Actual check-in time"),
+			Comment("First in punch of the day"),
 
 		field.Time("check_out_time").
 			Optional().
-			Comment("Actual check-out time"),
+			Comment("Last out punch of the day, empty while the employee is still in"),
 
 		field.Enum("status").
-			Values("present", "absent", "late", "half_day").
-			Default("absent"),
+			Values("present", "absent", "late", "half_day", "leave", "unpaid_leave", "remote", "business_trip", "sick").
+			Default("absent").
+			Comment("leave and unpaid_leave are written by approved leave requests, remote and business_trip are worked away from the office"),
+
+		field.JSON("status_metadata", map[string]string{}).
+			Optional().
+			Comment("Details of the status, e.g. the destination of a business trip or the sick note of a sick day"),
 
 		field.Bool("is_weekend").
 			Default(false).
-			Comment("True for Saturday and Sunday"),
+			Comment("True when the date is a rest day in the employee's work schedule"),
 
 		field.Text("notes").
 			Optional().
@@ -48,6 +53,53 @@ func (Attendance) Fields() []ent.Field {
 		field.Bool("marked_by_admin").
 			Default(false).
 			Comment("True if manually marked by admin"),
+
+		field.Float("check_in_latitude").
+			Optional().
+			Nillable(),
+
+		field.Float("check_in_longitude").
+			Optional().
+			Nillable(),
+
+		field.Float("check_in_distance_meters").
+			Optional().
+			Nillable().
+			Comment("Distance between the check-in and the assigned office"),
+
+		field.Float("check_out_latitude").
+			Optional().
+			Nillable(),
+
+		field.Float("check_out_longitude").
+			Optional().
+			Nillable(),
+
+		field.Float("check_out_distance_meters").
+			Optional().
+			Nillable().
+			Comment("Distance between the check-out and the assigned office"),
+
+		field.Bool("location_flagged").
+			Default(false).
+			Comment("True when a punch was outside the office radius and needs review"),
+
+		field.Bool("auto_closed").
+			Default(false).
+			Comment("True when the check-out was recorded by the auto check-out job instead of the employee"),
+
+		field.Int("worked_minutes").
+			Default(0).
+			Comment("Minutes worked derived from the punches, excluding breaks"),
+
+		field.Int("break_minutes").
+			Default(0).
+			Comment("Minutes spent on breaks derived from the punches"),
+
+		field.Uint64("check_in_kiosk_id").
+			Optional().
+			Nillable().
+			Comment("Kiosk whose rotating code was scanned for the first check-in"),
 	}
 }
 
@@ -59,6 +111,15 @@ func (Attendance) Edges() []ent.Edge {
 			Field("employee_id").
 			Unique().
 			Required(),
+		edge.To("corrections", AttendanceCorrection.Type),
+		edge.To("anomalies", AttendanceAnomaly.Type),
+		edge.To("punches", AttendancePunch.Type),
+		edge.To("overtime", Overtime.Type).
+			Unique(),
+		edge.From("check_in_kiosk", Kiosk.Type).
+			Ref("attendances").
+			Field("check_in_kiosk_id").
+			Unique(),
 	}
 }
 
@@ -77,5 +138,7 @@ func (Attendance) Indexes() []ent.Index {
 		index.Fields("employee_id"),
 		index.Fields("status"),
 		index.Fields("is_weekend"),
+		index.Fields("location_flagged"),
+		index.Fields("auto_closed"),
 	}
 }
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendanceAnomaly holds the schema definition for the AttendanceAnomaly entity.
+type AttendanceAnomaly struct {
+	ent.Schema
+}
+
+// Fields of the AttendanceAnomaly.
+func (AttendanceAnomaly) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("attendance_id").
+			Comment("Foreign key to attendances table"),
+
+		field.Uint64("employee_id").
+			Comment("Employee whose attendance looks wrong"),
+
+		field.Time("attendance_date").
+			Comment("Date of the attendance, copied for filtering by payroll month"),
+
+		field.Enum("rule").
+			Values("missing_check_out", "long_day", "odd_hour_check_in", "early_check_out", "skipped_punch").
+			Comment("Detection rule that found the anomaly"),
+
+		field.Text("details").
+			NotEmpty().
+			Comment("What the rule found, e.g. the length of the day"),
+
+		field.Enum("status").
+			Values("open", "resolved").
+			Default("open"),
+
+		field.Uint64("resolved_by").
+			Optional().
+			Nillable().
+			Comment("HR user who resolved the anomaly, empty when the detector found it fixed"),
+
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+
+		field.Text("resolution_notes").
+			Optional(),
+	}
+}
+
+// Edges of the AttendanceAnomaly.
+func (AttendanceAnomaly) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attendance", Attendance.Type).
+			Ref("anomalies").
+			Field("attendance_id").
+			Unique().
+			Required(),
+		edge.From("employee", Employee.Type).
+			Ref("attendance_anomalies").
+			Field("employee_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (AttendanceAnomaly) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the AttendanceAnomaly.
+func (AttendanceAnomaly) Indexes() []ent.Index {
+	return []ent.Index{
+		// A rule reports an attendance record once, running the detector again does not duplicate findings
+		index.Fields("attendance_id", "rule").
+			Unique(),
+		index.Fields("employee_id"),
+		index.Fields("attendance_date"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendanceCorrection holds the schema definition for the AttendanceCorrection entity.
+type AttendanceCorrection struct {
+	ent.Schema
+}
+
+// Fields of the AttendanceCorrection.
+func (AttendanceCorrection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("attendance_id").
+			Comment("Foreign key to attendances table"),
+
+		field.Uint64("employee_id").
+			Comment("Employee who requested the correction"),
+
+		field.Time("original_check_in_time").
+			Optional().
+			Nillable().
+			Comment("Check-in time when the correction was requested"),
+
+		field.Time("original_check_out_time").
+			Optional().
+			Nillable().
+			Comment("Check-out time when the correction was requested"),
+
+		field.String("original_status").
+			MaxLen(20).
+			Comment("Attendance status when the correction was requested"),
+
+		field.Time("proposed_check_in_time").
+			Optional().
+			Nillable(),
+
+		field.Time("proposed_check_out_time").
+			Optional().
+			Nillable(),
+
+		field.String("proposed_status").
+			MaxLen(20).
+			Optional(),
+
+		field.Text("reason").
+			NotEmpty(),
+
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending"),
+
+		field.Uint64("reviewed_by").
+			Optional().
+			Nillable().
+			Comment("Manager who approved or rejected the correction"),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		field.Text("review_notes").
+			Optional(),
+	}
+}
+
+// Edges of the AttendanceCorrection.
+func (AttendanceCorrection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attendance", Attendance.Type).
+			Ref("corrections").
+			Field("attendance_id").
+			Unique().
+			Required(),
+		edge.From("employee", Employee.Type).
+			Ref("attendance_corrections").
+			Field("employee_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (AttendanceCorrection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the AttendanceCorrection.
+func (AttendanceCorrection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attendance_id"),
+		index.Fields("employee_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// AttendancePayPolicy holds the schema definition for the AttendancePayPolicy entity.
+type AttendancePayPolicy struct {
+	ent.Schema
+}
+
+// Fields of the AttendancePayPolicy.
+func (AttendancePayPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Unique(),
+
+		field.Other("present_weight", decimal.Decimal{}).
+			SchemaType(weightSchemaType).
+			Default(decimal.NewFromInt(1)).
+			Comment("Share of a day's pay earned by a present day"),
+
+		field.Other("late_weight", decimal.Decimal{}).
+			SchemaType(weightSchemaType).
+			Default(decimal.NewFromInt(1)).
+			Comment("Share of a day's pay earned by a late day"),
+
+		field.Other("half_day_weight", decimal.Decimal{}).
+			SchemaType(weightSchemaType).
+			Default(decimal.New(5, -1)).
+			Comment("Share of a day's pay earned by a half day"),
+
+		field.Other("late_penalty_amount", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
+			Comment("Fixed deduction for every late day beyond the free late days, 0 disables"),
+
+		field.Int("late_penalty_free_days").
+			Default(0).
+			NonNegative().
+			Comment("Late days per month tolerated before the late penalty applies"),
+
+		field.Bool("is_default").
+			Default(false).
+			Comment("Applied to work schedules without an attendance pay policy"),
+	}
+}
+
+// Edges of the AttendancePayPolicy.
+func (AttendancePayPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("work_schedules", WorkSchedule.Type),
+	}
+}
+
+// Mixin for shared fields
+func (AttendancePayPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the AttendancePayPolicy.
+func (AttendancePayPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_default"),
+	}
+}
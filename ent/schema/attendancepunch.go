@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendancePunch holds the schema definition for the AttendancePunch entity.
+type AttendancePunch struct {
+	ent.Schema
+}
+
+// Fields of the AttendancePunch.
+func (AttendancePunch) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("attendance_id").
+			Comment("Daily attendance the punch belongs to"),
+
+		field.Enum("punch_type").
+			Values("check_in", "check_out", "break_start", "break_end"),
+
+		field.Time("punch_time"),
+
+		field.Float("latitude").
+			Optional().
+			Nillable(),
+
+		field.Float("longitude").
+			Optional().
+			Nillable(),
+
+		field.Float("distance_meters").
+			Optional().
+			Nillable().
+			Comment("Distance between the punch and the assigned office"),
+
+		field.Uint64("kiosk_id").
+			Optional().
+			Nillable().
+			Comment("Kiosk whose rotating code was scanned for the punch"),
+	}
+}
+
+// Edges of the AttendancePunch.
+func (AttendancePunch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attendance", Attendance.Type).
+			Ref("punches").
+			Field("attendance_id").
+			Unique().
+			Required(),
+		edge.From("kiosk", Kiosk.Type).
+			Ref("punches").
+			Field("kiosk_id").
+			Unique(),
+	}
+}
+
+// Mixin for shared fields
+func (AttendancePunch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the AttendancePunch.
+func (AttendancePunch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attendance_id", "punch_time"),
+	}
+}
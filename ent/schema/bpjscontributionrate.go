@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// BpjsContributionRate holds the schema definition for the BpjsContributionRate entity.
+type BpjsContributionRate struct {
+	ent.Schema
+}
+
+// Fields of the BpjsContributionRate.
+func (BpjsContributionRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Enum("program").
+			Values("kesehatan", "jht", "jp", "jkk", "jkm").
+			Comment("BPJS Kesehatan or one of the BPJS Ketenagakerjaan programs"),
+
+		field.Int("risk_class").
+			Range(0, 5).
+			Default(0).
+			Comment("JKK risk class the rate applies to, 0 for the other programs"),
+
+		field.Time("effective_from").
+			Comment("First day the rate applies, it applies until a later row of the program takes over"),
+
+		field.Other("employer_rate", decimal.Decimal{}).
+			SchemaType(percentageSchemaType).
+			Default(decimal.Zero).
+			Comment("Percentage of the wage paid by the employer"),
+
+		field.Other("employee_rate", decimal.Decimal{}).
+			SchemaType(percentageSchemaType).
+			Default(decimal.Zero).
+			Comment("Percentage of the wage deducted from the salary"),
+
+		field.Other("wage_cap", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Highest monthly wage contributions are calculated from, no cap when empty"),
+
+		field.Text("notes").
+			Optional(),
+	}
+}
+
+// Mixin for shared fields
+func (BpjsContributionRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the BpjsContributionRate.
+func (BpjsContributionRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("program", "risk_class", "effective_from"),
+	}
+}
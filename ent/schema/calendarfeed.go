@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CalendarFeed holds the schema definition for the CalendarFeed entity.
+type CalendarFeed struct {
+	ent.Schema
+}
+
+// Fields of the CalendarFeed.
+func (CalendarFeed) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.Enum("scope").
+			Values("employee", "department", "company").
+			Comment("Whose attendance and leave the feed shows, holidays are shown in every feed"),
+
+		field.Uint64("employee_id").
+			Optional().
+			Nillable().
+			Comment("Employee of an employee feed"),
+
+		field.String("department").
+			MaxLen(100).
+			Optional().
+			Comment("Department of a department feed"),
+
+		field.String("token").
+			MaxLen(64).
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("Random token in the feed URL, calendar apps cannot send credentials"),
+
+		field.Bool("is_active").
+			Default(true),
+
+		field.Time("last_accessed_at").
+			Optional().
+			Nillable().
+			Comment("Last time a calendar app fetched the feed"),
+	}
+}
+
+// Edges of the CalendarFeed.
+func (CalendarFeed) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("calendar_feeds").
+			Field("employee_id").
+			Unique(),
+	}
+}
+
+// Mixin for shared fields
+func (CalendarFeed) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the CalendarFeed.
+func (CalendarFeed) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope"),
+		index.Fields("employee_id"),
+		index.Fields("department"),
+	}
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Device holds the schema definition for the Device entity.
+type Device struct {
+	ent.Schema
+}
+
+// Fields of the Device.
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("serial_number").
+			MaxLen(64).
+			NotEmpty().
+			Unique().
+			Comment("Serial number the terminal identifies itself with on every request"),
+
+		field.String("name").
+			MaxLen(100).
+			Optional(),
+
+		field.Enum("status").
+			Values("pending", "active", "disabled").
+			Default("pending").
+			Comment("pending terminals registered themselves and wait for approval, only active terminals may push punches"),
+
+		field.Uint64("office_location_id").
+			Optional().
+			Nillable().
+			Comment("Office the terminal is installed at"),
+
+		field.String("timezone").
+			MaxLen(64).
+			Optional().
+			Comment("IANA timezone of the terminal clock, the office timezone applies when empty"),
+
+		field.String("push_version").
+			MaxLen(32).
+			Optional().
+			Comment("Push protocol version reported by the terminal"),
+
+		field.String("device_info").
+			MaxLen(255).
+			Optional().
+			Comment("Firmware, user and punch counts reported by the terminal"),
+
+		field.String("ip_address").
+			MaxLen(45).
+			Optional().
+			Comment("Address of the last request of the terminal"),
+
+		field.JSON("allowed_networks", []string{}).
+			Optional().
+			Comment("IP addresses or CIDR ranges the terminal may push from, an active terminal without any is refused"),
+
+		field.String("attlog_stamp").
+			MaxLen(32).
+			Optional().
+			Comment("Stamp of the last ATTLOG push, the terminal resumes uploading after it"),
+
+		field.Time("last_seen_at").
+			Optional().
+			Nillable(),
+
+		field.Time("last_punch_at").
+			Optional().
+			Nillable().
+			Comment("Terminal clock time of the newest punch pushed"),
+
+		field.Int("clock_offset_seconds").
+			Optional().
+			Nillable().
+			Comment("Terminal clock minus server time, measured on real-time pushes of a single punch"),
+	}
+}
+
+// Edges of the Device.
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("office_location", OfficeLocation.Type).
+			Ref("devices").
+			Field("office_location_id").
+			Unique(),
+		edge.To("punch_rejections", DevicePunchRejection.Type),
+	}
+}
+
+// Mixin for shared fields
+func (Device) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the Device.
+func (Device) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("office_location_id"),
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DevicePunchRejection holds the schema definition for the DevicePunchRejection entity.
+type DevicePunchRejection struct {
+	ent.Schema
+}
+
+// Fields of the DevicePunchRejection.
+func (DevicePunchRejection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("device_id").
+			Comment("Terminal that pushed the punch"),
+
+		field.String("device_pin").
+			MaxLen(32).
+			NotEmpty().
+			Comment("PIN the punch was recorded for on the terminal"),
+
+		field.Time("punch_time").
+			Comment("Punch time as read from the terminal clock"),
+
+		field.Int("punch_state").
+			Default(0).
+			Comment("In/out state the terminal recorded"),
+
+		field.Int("verify_mode").
+			Default(0),
+
+		field.Text("reason").
+			NotEmpty().
+			Comment("Why the punch was not recorded, e.g. an unknown PIN or a locked payroll period"),
+
+		field.Enum("status").
+			Values("open", "resolved").
+			Default("open"),
+
+		field.Uint64("resolved_by").
+			Optional().
+			Nillable().
+			Comment("HR user who reviewed the punch"),
+
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+
+		field.Text("resolution_notes").
+			Optional(),
+	}
+}
+
+// Edges of the DevicePunchRejection.
+func (DevicePunchRejection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("device", Device.Type).
+			Ref("punch_rejections").
+			Field("device_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (DevicePunchRejection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the DevicePunchRejection.
+func (DevicePunchRejection) Indexes() []ent.Index {
+	return []ent.Index{
+		// Terminals push a punch again when its upload was not acknowledged, it is stored once
+		index.Fields("device_id", "device_pin", "punch_time").
+			Unique(),
+		index.Fields("status"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
 )
 
 // Employee holds the schema definition for the Employee entity.
@@ -49,12 +50,54 @@ func (Employee) Fields() []ent.Field {
 		field.Time("hire_date").
 			Comment("Employee hire date"),
 
-		field.Float("base_salary").
-			Default(10000000.00).
+		field.Other("base_salary", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.NewFromInt(10000000)).
 			Comment("Base salary in IDR"),
 
 		field.Bool("is_active").
 			Default(true),
+
+		field.Uint64("office_location_id").
+			Optional().
+			Nillable().
+			Comment("Office whose geofence applies to check-in and check-out, no geofence when empty"),
+
+		field.String("timezone").
+			MaxLen(64).
+			Optional().
+			Comment("IANA timezone of the employee, overrides the office timezone when set"),
+
+		field.String("device_pin").
+			MaxLen(32).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("User PIN enrolled on the fingerprint terminals, maps device punch logs to the employee"),
+
+		field.Enum("ptkp_status").
+			NamedValues(
+				"TK0", "TK/0",
+				"TK1", "TK/1",
+				"TK2", "TK/2",
+				"TK3", "TK/3",
+				"K0", "K/0",
+				"K1", "K/1",
+				"K2", "K/2",
+				"K3", "K/3",
+			).
+			Default("TK/0").
+			Comment("PTKP status for PPh 21, single (TK) or married (K) with the number of dependents"),
+
+		field.String("npwp").
+			MaxLen(16).
+			Optional().
+			Comment("Tax ID (NPWP or NIK), PPh 21 is withheld 20% higher without one"),
+
+		field.Int("jkk_risk_class").
+			Range(1, 5).
+			Default(1).
+			Comment("BPJS JKK work accident risk class of the workplace, 1 (very low) to 5 (very high)"),
 	}
 }
 
@@ -63,6 +106,18 @@ func (Employee) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("attendances", Attendance.Type),
 		edge.To("salary_calculations", SalaryCalculation.Type),
+		edge.To("shift_assignments", ShiftAssignment.Type),
+		edge.To("leave_requests", LeaveRequest.Type),
+		edge.To("leave_balances", LeaveBalance.Type),
+		edge.To("attendance_corrections", AttendanceCorrection.Type),
+		edge.To("attendance_anomalies", AttendanceAnomaly.Type),
+		edge.To("overtimes", Overtime.Type),
+		edge.To("calendar_feeds", CalendarFeed.Type),
+		edge.To("salary_component_assignments", SalaryComponentAssignment.Type),
+		edge.From("office_location", OfficeLocation.Type).
+			Ref("employees").
+			Field("office_location_id").
+			Unique(),
 	}
 }
 
@@ -79,5 +134,6 @@ func (Employee) Indexes() []ent.Index {
 		index.Fields("employee_id"),
 		index.Fields("email"),
 		index.Fields("is_active"),
+		index.Fields("office_location_id"),
 	}
 }
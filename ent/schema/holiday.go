@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Holiday holds the schema definition for the Holiday entity.
+type Holiday struct {
+	ent.Schema
+}
+
+// Fields of the Holiday.
+func (Holiday) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Time("holiday_date").
+			Comment("Date of the holiday"),
+
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+
+		field.Enum("holiday_type").
+			Values("national", "cuti_bersama", "company").
+			Default("national").
+			Comment("National public holiday, collective leave (cuti bersama) or company holiday"),
+
+		field.Text("description").
+			Optional(),
+	}
+}
+
+// Edges of the Holiday.
+func (Holiday) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin for shared fields
+func (Holiday) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the Holiday.
+func (Holiday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("holiday_date"),
+		index.Fields("holiday_type"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Kiosk holds the schema definition for the Kiosk entity.
+type Kiosk struct {
+	ent.Schema
+}
+
+// Fields of the Kiosk.
+func (Kiosk) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.String("secret").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("Base32 TOTP secret the rotating check-in codes are signed with"),
+
+		field.String("device_token_hash").
+			MaxLen(64).
+			Optional().
+			Sensitive().
+			Comment("SHA-256 of the token the kiosk tablet sends to fetch its codes, empty until a token is issued"),
+
+		field.Uint64("office_location_id").
+			Optional().
+			Nillable().
+			Comment("Office the kiosk is installed at, only employees of this office may check in on it"),
+
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the Kiosk.
+func (Kiosk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("office_location", OfficeLocation.Type).
+			Ref("kiosks").
+			Field("office_location_id").
+			Unique(),
+		edge.To("attendances", Attendance.Type),
+		edge.To("punches", AttendancePunch.Type),
+	}
+}
+
+// Mixin for shared fields
+func (Kiosk) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the Kiosk.
+func (Kiosk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("office_location_id"),
+		index.Fields("is_active"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeaveBalance holds the schema definition for the LeaveBalance entity.
+type LeaveBalance struct {
+	ent.Schema
+}
+
+// Fields of the LeaveBalance.
+func (LeaveBalance) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("employee_id").
+			Comment("Foreign key to employees table"),
+
+		field.Uint64("leave_type_id").
+			Comment("Foreign key to leave_types table"),
+
+		field.Int("year").
+			Comment("Calendar year of the balance"),
+
+		field.Int("entitled_days").
+			Default(0).
+			NonNegative(),
+
+		field.Int("used_days").
+			Default(0).
+			NonNegative().
+			Comment("Working days taken by approved leave requests"),
+	}
+}
+
+// Edges of the LeaveBalance.
+func (LeaveBalance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("leave_balances").
+			Field("employee_id").
+			Unique().
+			Required(),
+		edge.From("leave_type", LeaveType.Type).
+			Ref("leave_balances").
+			Field("leave_type_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (LeaveBalance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the LeaveBalance.
+func (LeaveBalance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "leave_type_id", "year").Unique(),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeaveRequest holds the schema definition for the LeaveRequest entity.
+type LeaveRequest struct {
+	ent.Schema
+}
+
+// Fields of the LeaveRequest.
+func (LeaveRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("employee_id").
+			Comment("Foreign key to employees table"),
+
+		field.Uint64("leave_type_id").
+			Comment("Foreign key to leave_types table"),
+
+		field.Time("start_date").
+			Comment("First day of leave"),
+
+		field.Time("end_date").
+			Comment("Last day of leave"),
+
+		field.Int("total_days").
+			Default(0).
+			Comment("Working days covered by the request"),
+
+		field.Text("reason").
+			Optional(),
+
+		field.Enum("status").
+			Values("pending", "approved", "rejected", "cancelled").
+			Default("pending"),
+
+		field.Uint64("reviewed_by").
+			Optional().
+			Nillable().
+			Comment("User who approved or rejected the request"),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		field.Text("review_notes").
+			Optional(),
+	}
+}
+
+// Edges of the LeaveRequest.
+func (LeaveRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("leave_requests").
+			Field("employee_id").
+			Unique().
+			Required(),
+		edge.From("leave_type", LeaveType.Type).
+			Ref("leave_requests").
+			Field("leave_type_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (LeaveRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the LeaveRequest.
+func (LeaveRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "start_date"),
+		index.Fields("leave_type_id"),
+		index.Fields("status"),
+	}
+}
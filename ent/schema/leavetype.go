@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeaveType holds the schema definition for the LeaveType entity.
+type LeaveType struct {
+	ent.Schema
+}
+
+// Fields of the LeaveType.
+func (LeaveType) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("code").
+			MaxLen(50).
+			NotEmpty().
+			Unique().
+			Comment("Stable identifier like annual, sick, maternity, unpaid"),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.Bool("is_paid").
+			Default(true).
+			Comment("Paid leave is not deducted from salary"),
+
+		field.Int("annual_quota").
+			Default(0).
+			NonNegative().
+			Comment("Days granted per year, 0 means the balance is not tracked"),
+
+		field.Bool("is_active").
+			Default(true),
+
+		field.Text("description").
+			Optional(),
+	}
+}
+
+// Edges of the LeaveType.
+func (LeaveType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("leave_requests", LeaveRequest.Type),
+		edge.To("leave_balances", LeaveBalance.Type),
+	}
+}
+
+// Mixin for shared fields
+func (LeaveType) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the LeaveType.
+func (LeaveType) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent/dialect"
+)
+
+// moneySchemaType stores rupiah amounts as DECIMAL(15,2) like the migrations, the Go side uses decimal.Decimal
+// so sums and prorations do not drift like float64 does
+var moneySchemaType = map[string]string{
+	dialect.MySQL:    "decimal(15,2)",
+	dialect.SQLite:   "numeric",
+	dialect.Postgres: "numeric(15,2)",
+}
+
+// percentageSchemaType stores percentages such as 5.5 for 5.5% with four decimals, enough for statutory rates
+var percentageSchemaType = map[string]string{
+	dialect.MySQL:    "decimal(7,4)",
+	dialect.SQLite:   "numeric",
+	dialect.Postgres: "numeric(7,4)",
+}
+
+// weightSchemaType stores pay weights such as 0.5 for half a day's pay with three decimals
+var weightSchemaType = map[string]string{
+	dialect.MySQL:    "decimal(4,3)",
+	dialect.SQLite:   "numeric",
+	dialect.Postgres: "numeric(4,3)",
+}
+
+// daysSchemaType stores weighted day counts such as 19.5 paid days with three decimals
+var daysSchemaType = map[string]string{
+	dialect.MySQL:    "decimal(6,3)",
+	dialect.SQLite:   "numeric",
+	dialect.Postgres: "numeric(6,3)",
+}
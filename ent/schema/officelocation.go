@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OfficeLocation holds the schema definition for the OfficeLocation entity.
+type OfficeLocation struct {
+	ent.Schema
+}
+
+// Fields of the OfficeLocation.
+func (OfficeLocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Unique(),
+
+		field.Text("address").
+			Optional(),
+
+		field.Float("latitude").
+			Min(-90).
+			Max(90),
+
+		field.Float("longitude").
+			Min(-180).
+			Max(180),
+
+		field.Int("radius_meters").
+			Positive().
+			Default(100).
+			Comment("Maximum distance from the coordinates for a check-in or check-out"),
+
+		field.Enum("enforcement").
+			Values("reject", "flag").
+			Default("reject").
+			Comment("reject refuses punches outside the radius, flag stores them for review"),
+
+		field.String("timezone").
+			MaxLen(64).
+			Optional().
+			Comment("IANA timezone of the office, e.g. Asia/Makassar, the application timezone applies when empty"),
+
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the OfficeLocation.
+func (OfficeLocation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("employees", Employee.Type),
+		edge.To("devices", Device.Type),
+		edge.To("kiosks", Kiosk.Type),
+	}
+}
+
+// Mixin for shared fields
+func (OfficeLocation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the OfficeLocation.
+func (OfficeLocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active"),
+	}
+}
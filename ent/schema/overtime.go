@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Overtime holds the schema definition for the Overtime entity.
+type Overtime struct {
+	ent.Schema
+}
+
+// Fields of the Overtime.
+func (Overtime) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("employee_id").
+			Comment("Foreign key to employees table"),
+
+		field.Uint64("attendance_id").
+			Unique().
+			Comment("Attendance the overtime was detected on, one overtime per day"),
+
+		field.Time("overtime_date").
+			Comment("Shift date of the attendance"),
+
+		field.Time("scheduled_end").
+			Optional().
+			Nillable().
+			Comment("Scheduled end of the shift, empty on rest days and holidays"),
+
+		field.Time("actual_end").
+			Comment("Last check-out of the day"),
+
+		field.Int("minutes").
+			NonNegative().
+			Comment("Detected overtime minutes, all worked minutes on rest days and holidays"),
+
+		field.Int("approved_minutes").
+			Optional().
+			Nillable().
+			Comment("Minutes approved for payment, may be lower than detected"),
+
+		field.Bool("is_rest_day").
+			Default(false).
+			Comment("Rest day or public holiday, paid with the rest day multipliers"),
+
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending"),
+
+		field.Uint64("reviewed_by").
+			Optional().
+			Nillable(),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		field.Text("review_notes").
+			Optional(),
+	}
+}
+
+// Edges of the Overtime.
+func (Overtime) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("overtimes").
+			Field("employee_id").
+			Unique().
+			Required(),
+		edge.From("attendance", Attendance.Type).
+			Ref("overtime").
+			Field("attendance_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (Overtime) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the Overtime.
+func (Overtime) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "overtime_date"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PayrollPeriod holds the schema definition for the PayrollPeriod entity.
+type PayrollPeriod struct {
+	ent.Schema
+}
+
+// Fields of the PayrollPeriod.
+func (PayrollPeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Time("period_month").
+			Comment("First day of the month (YYYY-MM-01), months without a row are open"),
+
+		field.Enum("status").
+			Values("open", "locked").
+			Default("open").
+			Comment("Attendance and salaries of a locked month cannot be changed"),
+
+		field.Time("locked_at").
+			Optional().
+			Nillable(),
+
+		field.Uint64("locked_by").
+			Optional().
+			Nillable().
+			Comment("User who locked the period after payroll was paid"),
+	}
+}
+
+// Edges of the PayrollPeriod.
+func (PayrollPeriod) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("events", PayrollPeriodEvent.Type),
+	}
+}
+
+// Mixin for shared fields
+func (PayrollPeriod) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the PayrollPeriod.
+func (PayrollPeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period_month").Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PayrollPeriodEvent holds the schema definition for the PayrollPeriodEvent entity.
+type PayrollPeriodEvent struct {
+	ent.Schema
+}
+
+// Fields of the PayrollPeriodEvent.
+func (PayrollPeriodEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("payroll_period_id").
+			Comment("Foreign key to payroll_periods table"),
+
+		field.Enum("action").
+			Values("lock", "unlock").
+			Immutable(),
+
+		field.Uint64("actor_id").
+			Immutable().
+			Comment("User who locked or unlocked the period"),
+
+		field.Text("reason").
+			Optional().
+			Immutable().
+			Comment("Why the period was unlocked, required for unlocks"),
+	}
+}
+
+// Edges of the PayrollPeriodEvent.
+func (PayrollPeriodEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payroll_period", PayrollPeriod.Type).
+			Ref("events").
+			Field("payroll_period_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (PayrollPeriodEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the PayrollPeriodEvent.
+func (PayrollPeriodEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("payroll_period_id", "created_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PayrollRun holds the schema definition for the PayrollRun entity.
+type PayrollRun struct {
+	ent.Schema
+}
+
+// Fields of the PayrollRun.
+func (PayrollRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Time("period_month").
+			Immutable().
+			Comment("First day of the month (YYYY-MM-01) the run pays"),
+
+		field.Enum("status").
+			Values("draft", "calculated", "reviewed", "approved", "paid", "cancelled").
+			Default("draft").
+			Comment("Salary calculations of an approved or paid run cannot be changed"),
+
+		field.Time("active_month").
+			Optional().
+			Nillable().
+			Comment("Period month while the run is not cancelled, its unique index keeps one active run per month"),
+
+		field.Uint64("created_by").
+			Immutable().
+			Comment("User who created the run"),
+	}
+}
+
+// Edges of the PayrollRun.
+func (PayrollRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("salary_calculations", SalaryCalculation.Type),
+		edge.To("events", PayrollRunEvent.Type),
+	}
+}
+
+// Mixin for shared fields
+func (PayrollRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the PayrollRun.
+func (PayrollRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period_month", "status"),
+		index.Fields("status"),
+		index.Fields("active_month").Unique(),
+	}
+}
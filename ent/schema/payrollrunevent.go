@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PayrollRunEvent holds the schema definition for the PayrollRunEvent entity.
+type PayrollRunEvent struct {
+	ent.Schema
+}
+
+// Fields of the PayrollRunEvent.
+func (PayrollRunEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("payroll_run_id").
+			Comment("Foreign key to payroll_runs table"),
+
+		field.Enum("action").
+			Values("create", "calculate", "review", "approve", "pay", "cancel").
+			Immutable(),
+
+		field.Enum("from_status").
+			Values("draft", "calculated", "reviewed", "approved", "paid", "cancelled").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Status of the run before the transition, empty when it was created"),
+
+		field.Enum("to_status").
+			Values("draft", "calculated", "reviewed", "approved", "paid", "cancelled").
+			Immutable().
+			Comment("Status of the run after the transition"),
+
+		field.Uint64("actor_id").
+			Immutable().
+			Comment("User who made the transition"),
+
+		field.Text("notes").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the PayrollRunEvent.
+func (PayrollRunEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payroll_run", PayrollRun.Type).
+			Ref("events").
+			Field("payroll_run_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (PayrollRunEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the PayrollRunEvent.
+func (PayrollRunEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("payroll_run_id", "created_at"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
 )
 
 // SalaryCalculation holds the schema definition for the SalaryCalculation entity.
@@ -22,10 +23,16 @@ func (SalaryCalculation) Fields() []ent.Field {
 		field.Uint64("employee_id").
 			Comment("Foreign key to employees table"),
 
+		field.Uint64("payroll_run_id").
+			Optional().
+			Nillable().
+			Comment("Payroll run that owns the calculation, empty until a run is created for the month"),
+
 		field.Time("calculation_month").
 			Comment("First day of the month for calculation (YYYY-MM-01)"),
 
-		field.Float("base_salary").
+		field.Other("base_salary", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
 			Comment("Base salary for the month"),
 
 		field.Int("total_working_days").
@@ -39,11 +46,49 @@ func (SalaryCalculation) Fields() []ent.Field {
 			Default(0).
 			Comment("Number of present working days"),
 
-		field.Float("final_salary").
+		field.Other("paid_days", decimal.Decimal{}).
+			SchemaType(daysSchemaType).
+			Default(decimal.Zero).
+			Comment("Present days weighted by the attendance pay policy, the salary is prorated on them"),
+
+		field.Int("late_days").
+			Default(0).
+			Comment("Number of late working days"),
+
+		field.Other("late_penalty", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
+			Comment("Late penalty deducted under the attendance pay policy"),
+
+		field.Text("pay_policy").
+			Optional().
+			Comment("Attendance pay policies applied in the month (for audit purposes)"),
+
+		field.Int("worked_minutes").
+			Default(0).
+			Comment("Minutes worked in the month from attendance punches, excluding breaks"),
+
+		field.Int("overtime_minutes").
+			Default(0).
+			Comment("Approved overtime minutes in the month"),
+
+		field.Other("overtime_pay", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
+			Comment("Overtime earnings per Kepmenaker 102/2004, paid on top of the prorated base salary"),
+
+		field.Other("taxable_income", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
+			Comment("Gross earnings of the month subject to PPh 21, summed by the December true-up"),
+
+		field.Other("final_salary", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
 			Comment("Final calculated salary after deductions"),
 
-		field.Float("deduction_amount").
-			Default(0.00).
+		field.Other("deduction_amount", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
 			Comment("Total deduction amount"),
 
 		field.Text("calculation_formula").
@@ -60,6 +105,11 @@ func (SalaryCalculation) Edges() []ent.Edge {
 			Field("employee_id").
 			Unique().
 			Required(),
+		edge.From("payroll_run", PayrollRun.Type).
+			Ref("salary_calculations").
+			Field("payroll_run_id").
+			Unique(),
+		edge.To("items", SalaryCalculationItem.Type),
 	}
 }
 
@@ -76,5 +126,6 @@ func (SalaryCalculation) Indexes() []ent.Index {
 		index.Fields("employee_id", "calculation_month").Unique(),
 		index.Fields("calculation_month"),
 		index.Fields("employee_id"),
+		index.Fields("payroll_run_id"),
 	}
 }
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryCalculationItem holds the schema definition for the SalaryCalculationItem entity.
+type SalaryCalculationItem struct {
+	ent.Schema
+}
+
+// Fields of the SalaryCalculationItem.
+func (SalaryCalculationItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("salary_calculation_id").
+			Comment("Foreign key to salary_calculations table"),
+
+		field.Uint64("salary_component_id").
+			Optional().
+			Nillable().
+			Comment("Component the line was calculated from, empty for the prorated base salary, overtime and late penalty"),
+
+		field.String("code").
+			MaxLen(50).
+			NotEmpty(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.Enum("kind").
+			Values("earning", "deduction", "employer").
+			Comment("Earnings and deductions add up to the final salary, employer lines are paid on top of it"),
+
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Comment("Amount of the line rounded to whole rupiah"),
+
+		field.Text("basis").
+			Optional().
+			Comment("How the amount was calculated (for audit purposes)"),
+	}
+}
+
+// Edges of the SalaryCalculationItem.
+func (SalaryCalculationItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("salary_calculation", SalaryCalculation.Type).
+			Ref("items").
+			Field("salary_calculation_id").
+			Unique().
+			Required(),
+		edge.From("salary_component", SalaryComponent.Type).
+			Ref("calculation_items").
+			Field("salary_component_id").
+			Unique(),
+	}
+}
+
+// Mixin for shared fields
+func (SalaryCalculationItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the SalaryCalculationItem.
+func (SalaryCalculationItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("salary_calculation_id"),
+		index.Fields("salary_component_id"),
+	}
+}
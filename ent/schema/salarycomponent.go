@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponent holds the schema definition for the SalaryComponent entity.
+type SalaryComponent struct {
+	ent.Schema
+}
+
+// Fields of the SalaryComponent.
+func (SalaryComponent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("code").
+			MaxLen(50).
+			NotEmpty().
+			Unique().
+			Comment("Stable identifier of the component on payslips and exports, e.g. transport_allowance"),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		field.Enum("kind").
+			Values("earning", "deduction").
+			Comment("Earnings are added to the salary, deductions are subtracted from it"),
+
+		field.Enum("method").
+			Values("fixed", "percentage_of_base", "per_present_day").
+			Comment("How the amount of the component is calculated every month"),
+
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Default(decimal.Zero).
+			Comment("Monthly amount of a fixed component, or the amount per present day"),
+
+		field.Other("percentage", decimal.Decimal{}).
+			SchemaType(percentageSchemaType).
+			Default(decimal.Zero).
+			Comment("Percentage of the base salary of a percentage_of_base component"),
+
+		field.Text("description").
+			Optional(),
+
+		field.Bool("is_active").
+			Default(true).
+			Comment("Inactive components are skipped by salary calculations"),
+	}
+}
+
+// Edges of the SalaryComponent.
+func (SalaryComponent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("assignments", SalaryComponentAssignment.Type),
+		edge.To("calculation_items", SalaryCalculationItem.Type),
+	}
+}
+
+// Mixin for shared fields
+func (SalaryComponent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the SalaryComponent.
+func (SalaryComponent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind"),
+		index.Fields("is_active"),
+	}
+}
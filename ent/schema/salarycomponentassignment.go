@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// SalaryComponentAssignment holds the schema definition for the SalaryComponentAssignment entity.
+type SalaryComponentAssignment struct {
+	ent.Schema
+}
+
+// Fields of the SalaryComponentAssignment.
+func (SalaryComponentAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("salary_component_id").
+			Comment("Foreign key to salary_components table"),
+
+		field.Uint64("employee_id").
+			Optional().
+			Nillable().
+			Comment("Employee the component is paid to or deducted from, empty for a department assignment"),
+
+		field.String("department").
+			MaxLen(100).
+			Optional().
+			Comment("Department whose employees get the component, an employee assignment of the same component wins"),
+
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Overrides the amount of the component for this assignment"),
+
+		field.Other("percentage", decimal.Decimal{}).
+			SchemaType(percentageSchemaType).
+			Optional().
+			Nillable().
+			Comment("Overrides the percentage of the component for this assignment"),
+
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the SalaryComponentAssignment.
+func (SalaryComponentAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("salary_component", SalaryComponent.Type).
+			Ref("assignments").
+			Field("salary_component_id").
+			Unique().
+			Required(),
+		edge.From("employee", Employee.Type).
+			Ref("salary_component_assignments").
+			Field("employee_id").
+			Unique(),
+	}
+}
+
+// Mixin for shared fields
+func (SalaryComponentAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the SalaryComponentAssignment.
+func (SalaryComponentAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("salary_component_id"),
+		index.Fields("employee_id"),
+		index.Fields("department"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShiftAssignment holds the schema definition for the ShiftAssignment entity.
+type ShiftAssignment struct {
+	ent.Schema
+}
+
+// Fields of the ShiftAssignment.
+func (ShiftAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.Uint64("employee_id").
+			Comment("Foreign key to employees table"),
+
+		field.Uint64("work_schedule_id").
+			Comment("Foreign key to work_schedules table"),
+
+		field.Time("effective_date").
+			Comment("First date the schedule applies to the employee"),
+
+		field.Time("end_date").
+			Optional().
+			Nillable().
+			Comment("Last date the schedule applies, open ended when empty"),
+	}
+}
+
+// Edges of the ShiftAssignment.
+func (ShiftAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("shift_assignments").
+			Field("employee_id").
+			Unique().
+			Required(),
+		edge.From("work_schedule", WorkSchedule.Type).
+			Ref("shift_assignments").
+			Field("work_schedule_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin for shared fields
+func (ShiftAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the ShiftAssignment.
+func (ShiftAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "effective_date"),
+		index.Fields("work_schedule_id"),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkSchedule holds the schema definition for the WorkSchedule entity.
+type WorkSchedule struct {
+	ent.Schema
+}
+
+// Fields of the WorkSchedule.
+func (WorkSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").
+			Unique().
+			Immutable(),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Unique(),
+
+		field.String("start_time").
+			MaxLen(5).
+			NotEmpty().
+			Comment("Shift start in HH:MM"),
+
+		field.String("end_time").
+			MaxLen(5).
+			NotEmpty().
+			Comment("Shift end in HH:MM"),
+
+		field.JSON("working_days", []int{}).
+			Comment("Working weekdays, 0 = Sunday through 6 = Saturday"),
+
+		field.Int("late_threshold_minutes").
+			Default(0).
+			NonNegative().
+			Comment("Grace period after start before a check-in counts as late"),
+
+		field.Int("half_day_threshold_minutes").
+			Default(0).
+			NonNegative().
+			Comment("Minutes after start before a check-in counts as half day, 0 disables"),
+
+		field.Bool("is_default").
+			Default(false).
+			Comment("Applied to employees without an active shift assignment"),
+
+		field.Uint64("attendance_pay_policy_id").
+			Optional().
+			Nillable().
+			Comment("Pay weights of the attendance statuses, the default policy applies when empty"),
+	}
+}
+
+// Edges of the WorkSchedule.
+func (WorkSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("shift_assignments", ShiftAssignment.Type),
+		edge.From("attendance_pay_policy", AttendancePayPolicy.Type).
+			Ref("work_schedules").
+			Field("attendance_pay_policy_id").
+			Unique(),
+	}
+}
+
+// Mixin for shared fields
+func (WorkSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseFieldMixin{},
+	}
+}
+
+// Indexes of the WorkSchedule.
+func (WorkSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_default"),
+		index.Fields("attendance_pay_policy_id"),
+	}
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ShiftAssignment is the model entity for the ShiftAssignment schema.
+type ShiftAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Foreign key to employees table
+	EmployeeID uint64 `json:"employee_id,omitempty"`
+	// Foreign key to work_schedules table
+	WorkScheduleID uint64 `json:"work_schedule_id,omitempty"`
+	// First date the schedule applies to the employee
+	EffectiveDate time.Time `json:"effective_date,omitempty"`
+	// Last date the schedule applies, open ended when empty
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShiftAssignmentQuery when eager-loading is set.
+	Edges        ShiftAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShiftAssignmentEdges holds the relations/edges for other nodes in the graph.
+type ShiftAssignmentEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// WorkSchedule holds the value of the work_schedule edge.
+	WorkSchedule *WorkSchedule `json:"work_schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftAssignmentEdges) EmployeeOrErr() (*Employee, error) {
+	if e.loadedTypes[0] {
+		if e.Employee == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: employee.Label}
+		}
+		return e.Employee, nil
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// WorkScheduleOrErr returns the WorkSchedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftAssignmentEdges) WorkScheduleOrErr() (*WorkSchedule, error) {
+	if e.loadedTypes[1] {
+		if e.WorkSchedule == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: workschedule.Label}
+		}
+		return e.WorkSchedule, nil
+	}
+	return nil, &NotLoadedError{edge: "work_schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShiftAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shiftassignment.FieldID, shiftassignment.FieldEmployeeID, shiftassignment.FieldWorkScheduleID:
+			values[i] = new(sql.NullInt64)
+		case shiftassignment.FieldCreatedAt, shiftassignment.FieldModifiedAt, shiftassignment.FieldDeletedAt, shiftassignment.FieldEffectiveDate, shiftassignment.FieldEndDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShiftAssignment fields.
+func (sa *ShiftAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shiftassignment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = uint64(value.Int64)
+		case shiftassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case shiftassignment.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				sa.ModifiedAt = value.Time
+			}
+		case shiftassignment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sa.DeletedAt = value.Time
+			}
+		case shiftassignment.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				sa.EmployeeID = uint64(value.Int64)
+			}
+		case shiftassignment.FieldWorkScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field work_schedule_id", values[i])
+			} else if value.Valid {
+				sa.WorkScheduleID = uint64(value.Int64)
+			}
+		case shiftassignment.FieldEffectiveDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_date", values[i])
+			} else if value.Valid {
+				sa.EffectiveDate = value.Time
+			}
+		case shiftassignment.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				sa.EndDate = new(time.Time)
+				*sa.EndDate = value.Time
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShiftAssignment.
+// This includes values selected through modifiers, order, etc.
+func (sa *ShiftAssignment) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the ShiftAssignment entity.
+func (sa *ShiftAssignment) QueryEmployee() *EmployeeQuery {
+	return NewShiftAssignmentClient(sa.config).QueryEmployee(sa)
+}
+
+// QueryWorkSchedule queries the "work_schedule" edge of the ShiftAssignment entity.
+func (sa *ShiftAssignment) QueryWorkSchedule() *WorkScheduleQuery {
+	return NewShiftAssignmentClient(sa.config).QueryWorkSchedule(sa)
+}
+
+// Update returns a builder for updating this ShiftAssignment.
+// Note that you need to call ShiftAssignment.Unwrap() before calling this method if this ShiftAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ShiftAssignment) Update() *ShiftAssignmentUpdateOne {
+	return NewShiftAssignmentClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ShiftAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ShiftAssignment) Unwrap() *ShiftAssignment {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShiftAssignment is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ShiftAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShiftAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(sa.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(sa.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("work_schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.WorkScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("effective_date=")
+	builder.WriteString(sa.EffectiveDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sa.EndDate; v != nil {
+		builder.WriteString("end_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShiftAssignments is a parsable slice of ShiftAssignment.
+type ShiftAssignments []*ShiftAssignment
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shiftassignment
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// WorkScheduleID applies equality check predicate on the "work_schedule_id" field. It's identical to WorkScheduleIDEQ.
+func WorkScheduleID(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldWorkScheduleID, v))
+}
+
+// EffectiveDate applies equality check predicate on the "effective_date" field. It's identical to EffectiveDateEQ.
+func EffectiveDate(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEndDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// WorkScheduleIDEQ applies the EQ predicate on the "work_schedule_id" field.
+func WorkScheduleIDEQ(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldWorkScheduleID, v))
+}
+
+// WorkScheduleIDNEQ applies the NEQ predicate on the "work_schedule_id" field.
+func WorkScheduleIDNEQ(v uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldWorkScheduleID, v))
+}
+
+// WorkScheduleIDIn applies the In predicate on the "work_schedule_id" field.
+func WorkScheduleIDIn(vs ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldWorkScheduleID, vs...))
+}
+
+// WorkScheduleIDNotIn applies the NotIn predicate on the "work_schedule_id" field.
+func WorkScheduleIDNotIn(vs ...uint64) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldWorkScheduleID, vs...))
+}
+
+// EffectiveDateEQ applies the EQ predicate on the "effective_date" field.
+func EffectiveDateEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateNEQ applies the NEQ predicate on the "effective_date" field.
+func EffectiveDateNEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateIn applies the In predicate on the "effective_date" field.
+func EffectiveDateIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateNotIn applies the NotIn predicate on the "effective_date" field.
+func EffectiveDateNotIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateGT applies the GT predicate on the "effective_date" field.
+func EffectiveDateGT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateGTE applies the GTE predicate on the "effective_date" field.
+func EffectiveDateGTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLT applies the LT predicate on the "effective_date" field.
+func EffectiveDateLT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLTE applies the LTE predicate on the "effective_date" field.
+func EffectiveDateLTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldEffectiveDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldIsNull(FieldEndDate))
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(sql.FieldNotNull(FieldEndDate))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkSchedule applies the HasEdge predicate on the "work_schedule" edge.
+func HasWorkSchedule() predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkScheduleTable, WorkScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkScheduleWith applies the HasEdge predicate on the "work_schedule" edge with a given conditions (other predicates).
+func HasWorkScheduleWith(preds ...predicate.WorkSchedule) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		step := newWorkScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShiftAssignment) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShiftAssignment) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShiftAssignment) predicate.ShiftAssignment {
+	return predicate.ShiftAssignment(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
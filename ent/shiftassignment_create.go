@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftAssignmentCreate is the builder for creating a ShiftAssignment entity.
+type ShiftAssignmentCreate struct {
+	config
+	mutation *ShiftAssignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ShiftAssignmentCreate) SetCreatedAt(t time.Time) *ShiftAssignmentCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ShiftAssignmentCreate) SetNillableCreatedAt(t *time.Time) *ShiftAssignmentCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (sac *ShiftAssignmentCreate) SetModifiedAt(t time.Time) *ShiftAssignmentCreate {
+	sac.mutation.SetModifiedAt(t)
+	return sac
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (sac *ShiftAssignmentCreate) SetNillableModifiedAt(t *time.Time) *ShiftAssignmentCreate {
+	if t != nil {
+		sac.SetModifiedAt(*t)
+	}
+	return sac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sac *ShiftAssignmentCreate) SetDeletedAt(t time.Time) *ShiftAssignmentCreate {
+	sac.mutation.SetDeletedAt(t)
+	return sac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sac *ShiftAssignmentCreate) SetNillableDeletedAt(t *time.Time) *ShiftAssignmentCreate {
+	if t != nil {
+		sac.SetDeletedAt(*t)
+	}
+	return sac
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (sac *ShiftAssignmentCreate) SetEmployeeID(u uint64) *ShiftAssignmentCreate {
+	sac.mutation.SetEmployeeID(u)
+	return sac
+}
+
+// SetWorkScheduleID sets the "work_schedule_id" field.
+func (sac *ShiftAssignmentCreate) SetWorkScheduleID(u uint64) *ShiftAssignmentCreate {
+	sac.mutation.SetWorkScheduleID(u)
+	return sac
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (sac *ShiftAssignmentCreate) SetEffectiveDate(t time.Time) *ShiftAssignmentCreate {
+	sac.mutation.SetEffectiveDate(t)
+	return sac
+}
+
+// SetEndDate sets the "end_date" field.
+func (sac *ShiftAssignmentCreate) SetEndDate(t time.Time) *ShiftAssignmentCreate {
+	sac.mutation.SetEndDate(t)
+	return sac
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (sac *ShiftAssignmentCreate) SetNillableEndDate(t *time.Time) *ShiftAssignmentCreate {
+	if t != nil {
+		sac.SetEndDate(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *ShiftAssignmentCreate) SetID(u uint64) *ShiftAssignmentCreate {
+	sac.mutation.SetID(u)
+	return sac
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (sac *ShiftAssignmentCreate) SetEmployee(e *Employee) *ShiftAssignmentCreate {
+	return sac.SetEmployeeID(e.ID)
+}
+
+// SetWorkSchedule sets the "work_schedule" edge to the WorkSchedule entity.
+func (sac *ShiftAssignmentCreate) SetWorkSchedule(w *WorkSchedule) *ShiftAssignmentCreate {
+	return sac.SetWorkScheduleID(w.ID)
+}
+
+// Mutation returns the ShiftAssignmentMutation object of the builder.
+func (sac *ShiftAssignmentCreate) Mutation() *ShiftAssignmentMutation {
+	return sac.mutation
+}
+
+// Save creates the ShiftAssignment in the database.
+func (sac *ShiftAssignmentCreate) Save(ctx context.Context) (*ShiftAssignment, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ShiftAssignmentCreate) SaveX(ctx context.Context) *ShiftAssignment {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ShiftAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ShiftAssignmentCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ShiftAssignmentCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := shiftassignment.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.ModifiedAt(); !ok {
+		v := shiftassignment.DefaultModifiedAt()
+		sac.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ShiftAssignmentCreate) check() error {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShiftAssignment.created_at"`)}
+	}
+	if _, ok := sac.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "ShiftAssignment.modified_at"`)}
+	}
+	if _, ok := sac.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "ShiftAssignment.employee_id"`)}
+	}
+	if _, ok := sac.mutation.WorkScheduleID(); !ok {
+		return &ValidationError{Name: "work_schedule_id", err: errors.New(`ent: missing required field "ShiftAssignment.work_schedule_id"`)}
+	}
+	if _, ok := sac.mutation.EffectiveDate(); !ok {
+		return &ValidationError{Name: "effective_date", err: errors.New(`ent: missing required field "ShiftAssignment.effective_date"`)}
+	}
+	if _, ok := sac.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "ShiftAssignment.employee"`)}
+	}
+	if _, ok := sac.mutation.WorkScheduleID(); !ok {
+		return &ValidationError{Name: "work_schedule", err: errors.New(`ent: missing required edge "ShiftAssignment.work_schedule"`)}
+	}
+	return nil
+}
+
+func (sac *ShiftAssignmentCreate) sqlSave(ctx context.Context) (*ShiftAssignment, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ShiftAssignmentCreate) createSpec() (*ShiftAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShiftAssignment{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(shiftassignment.Table, sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(shiftassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.ModifiedAt(); ok {
+		_spec.SetField(shiftassignment.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := sac.mutation.DeletedAt(); ok {
+		_spec.SetField(shiftassignment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := sac.mutation.EffectiveDate(); ok {
+		_spec.SetField(shiftassignment.FieldEffectiveDate, field.TypeTime, value)
+		_node.EffectiveDate = value
+	}
+	if value, ok := sac.mutation.EndDate(); ok {
+		_spec.SetField(shiftassignment.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if nodes := sac.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.EmployeeTable,
+			Columns: []string{shiftassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.WorkScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.WorkScheduleTable,
+			Columns: []string{shiftassignment.WorkScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShiftAssignmentCreateBulk is the builder for creating many ShiftAssignment entities in bulk.
+type ShiftAssignmentCreateBulk struct {
+	config
+	builders []*ShiftAssignmentCreate
+}
+
+// Save creates the ShiftAssignment entities in the database.
+func (sacb *ShiftAssignmentCreateBulk) Save(ctx context.Context) ([]*ShiftAssignment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ShiftAssignment, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShiftAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ShiftAssignmentCreateBulk) SaveX(ctx context.Context) []*ShiftAssignment {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ShiftAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ShiftAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"mceasy/ent/predicate"
+	"mceasy/ent/shiftassignment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftAssignmentDelete is the builder for deleting a ShiftAssignment entity.
+type ShiftAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShiftAssignmentMutation
+}
+
+// Where appends a list predicates to the ShiftAssignmentDelete builder.
+func (sad *ShiftAssignmentDelete) Where(ps ...predicate.ShiftAssignment) *ShiftAssignmentDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *ShiftAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *ShiftAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *ShiftAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shiftassignment.Table, sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// ShiftAssignmentDeleteOne is the builder for deleting a single ShiftAssignment entity.
+type ShiftAssignmentDeleteOne struct {
+	sad *ShiftAssignmentDelete
+}
+
+// Where appends a list predicates to the ShiftAssignmentDelete builder.
+func (sado *ShiftAssignmentDeleteOne) Where(ps ...predicate.ShiftAssignment) *ShiftAssignmentDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *ShiftAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shiftassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *ShiftAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
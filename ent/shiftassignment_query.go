@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftAssignmentQuery is the builder for querying ShiftAssignment entities.
+type ShiftAssignmentQuery struct {
+	config
+	ctx              *QueryContext
+	order            []shiftassignment.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ShiftAssignment
+	withEmployee     *EmployeeQuery
+	withWorkSchedule *WorkScheduleQuery
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShiftAssignmentQuery builder.
+func (saq *ShiftAssignmentQuery) Where(ps ...predicate.ShiftAssignment) *ShiftAssignmentQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *ShiftAssignmentQuery) Limit(limit int) *ShiftAssignmentQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *ShiftAssignmentQuery) Offset(offset int) *ShiftAssignmentQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *ShiftAssignmentQuery) Unique(unique bool) *ShiftAssignmentQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *ShiftAssignmentQuery) Order(o ...shiftassignment.OrderOption) *ShiftAssignmentQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (saq *ShiftAssignmentQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftassignment.Table, shiftassignment.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftassignment.EmployeeTable, shiftassignment.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkSchedule chains the current query on the "work_schedule" edge.
+func (saq *ShiftAssignmentQuery) QueryWorkSchedule() *WorkScheduleQuery {
+	query := (&WorkScheduleClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftassignment.Table, shiftassignment.FieldID, selector),
+			sqlgraph.To(workschedule.Table, workschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftassignment.WorkScheduleTable, shiftassignment.WorkScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShiftAssignment entity from the query.
+// Returns a *NotFoundError when no ShiftAssignment was found.
+func (saq *ShiftAssignmentQuery) First(ctx context.Context) (*ShiftAssignment, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shiftassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) FirstX(ctx context.Context) *ShiftAssignment {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShiftAssignment ID from the query.
+// Returns a *NotFoundError when no ShiftAssignment ID was found.
+func (saq *ShiftAssignmentQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shiftassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShiftAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShiftAssignment entity is found.
+// Returns a *NotFoundError when no ShiftAssignment entities are found.
+func (saq *ShiftAssignmentQuery) Only(ctx context.Context) (*ShiftAssignment, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shiftassignment.Label}
+	default:
+		return nil, &NotSingularError{shiftassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) OnlyX(ctx context.Context) *ShiftAssignment {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShiftAssignment ID in the query.
+// Returns a *NotSingularError when more than one ShiftAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *ShiftAssignmentQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shiftassignment.Label}
+	default:
+		err = &NotSingularError{shiftassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShiftAssignments.
+func (saq *ShiftAssignmentQuery) All(ctx context.Context) ([]*ShiftAssignment, error) {
+	ctx = setContextOp(ctx, saq.ctx, "All")
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShiftAssignment, *ShiftAssignmentQuery]()
+	return withInterceptors[[]*ShiftAssignment](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) AllX(ctx context.Context) []*ShiftAssignment {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShiftAssignment IDs.
+func (saq *ShiftAssignmentQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, "IDs")
+	if err = saq.Select(shiftassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *ShiftAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, "Count")
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*ShiftAssignmentQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *ShiftAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, "Exist")
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *ShiftAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShiftAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *ShiftAssignmentQuery) Clone() *ShiftAssignmentQuery {
+	if saq == nil {
+		return nil
+	}
+	return &ShiftAssignmentQuery{
+		config:           saq.config,
+		ctx:              saq.ctx.Clone(),
+		order:            append([]shiftassignment.OrderOption{}, saq.order...),
+		inters:           append([]Interceptor{}, saq.inters...),
+		predicates:       append([]predicate.ShiftAssignment{}, saq.predicates...),
+		withEmployee:     saq.withEmployee.Clone(),
+		withWorkSchedule: saq.withWorkSchedule.Clone(),
+		// clone intermediate query.
+		sql:  saq.sql.Clone(),
+		path: saq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ShiftAssignmentQuery) WithEmployee(opts ...func(*EmployeeQuery)) *ShiftAssignmentQuery {
+	query := (&EmployeeClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withEmployee = query
+	return saq
+}
+
+// WithWorkSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "work_schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ShiftAssignmentQuery) WithWorkSchedule(opts ...func(*WorkScheduleQuery)) *ShiftAssignmentQuery {
+	query := (&WorkScheduleClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withWorkSchedule = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShiftAssignment.Query().
+//		GroupBy(shiftassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *ShiftAssignmentQuery) GroupBy(field string, fields ...string) *ShiftAssignmentGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShiftAssignmentGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = shiftassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ShiftAssignment.Query().
+//		Select(shiftassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (saq *ShiftAssignmentQuery) Select(fields ...string) *ShiftAssignmentSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &ShiftAssignmentSelect{ShiftAssignmentQuery: saq}
+	sbuild.label = shiftassignment.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShiftAssignmentSelect configured with the given aggregations.
+func (saq *ShiftAssignmentQuery) Aggregate(fns ...AggregateFunc) *ShiftAssignmentSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *ShiftAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !shiftassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *ShiftAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShiftAssignment, error) {
+	var (
+		nodes       = []*ShiftAssignment{}
+		_spec       = saq.querySpec()
+		loadedTypes = [2]bool{
+			saq.withEmployee != nil,
+			saq.withWorkSchedule != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShiftAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShiftAssignment{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withEmployee; query != nil {
+		if err := saq.loadEmployee(ctx, query, nodes, nil,
+			func(n *ShiftAssignment, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := saq.withWorkSchedule; query != nil {
+		if err := saq.loadWorkSchedule(ctx, query, nodes, nil,
+			func(n *ShiftAssignment, e *WorkSchedule) { n.Edges.WorkSchedule = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *ShiftAssignmentQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*ShiftAssignment, init func(*ShiftAssignment), assign func(*ShiftAssignment, *Employee)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*ShiftAssignment)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (saq *ShiftAssignmentQuery) loadWorkSchedule(ctx context.Context, query *WorkScheduleQuery, nodes []*ShiftAssignment, init func(*ShiftAssignment), assign func(*ShiftAssignment, *WorkSchedule)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*ShiftAssignment)
+	for i := range nodes {
+		fk := nodes[i].WorkScheduleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workschedule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "work_schedule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *ShiftAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *ShiftAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shiftassignment.Table, shiftassignment.Columns, sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftassignment.FieldID)
+		for i := range fields {
+			if fields[i] != shiftassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if saq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(shiftassignment.FieldEmployeeID)
+		}
+		if saq.withWorkSchedule != nil {
+			_spec.Node.AddColumnOnce(shiftassignment.FieldWorkScheduleID)
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *ShiftAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(shiftassignment.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shiftassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range saq.modifiers {
+		m(selector)
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (saq *ShiftAssignmentQuery) Modify(modifiers ...func(s *sql.Selector)) *ShiftAssignmentSelect {
+	saq.modifiers = append(saq.modifiers, modifiers...)
+	return saq.Select()
+}
+
+// ShiftAssignmentGroupBy is the group-by builder for ShiftAssignment entities.
+type ShiftAssignmentGroupBy struct {
+	selector
+	build *ShiftAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *ShiftAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *ShiftAssignmentGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *ShiftAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, "GroupBy")
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftAssignmentQuery, *ShiftAssignmentGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *ShiftAssignmentGroupBy) sqlScan(ctx context.Context, root *ShiftAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShiftAssignmentSelect is the builder for selecting fields of ShiftAssignment entities.
+type ShiftAssignmentSelect struct {
+	*ShiftAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *ShiftAssignmentSelect) Aggregate(fns ...AggregateFunc) *ShiftAssignmentSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *ShiftAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, "Select")
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftAssignmentQuery, *ShiftAssignmentSelect](ctx, sas.ShiftAssignmentQuery, sas, sas.inters, v)
+}
+
+func (sas *ShiftAssignmentSelect) sqlScan(ctx context.Context, root *ShiftAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sas *ShiftAssignmentSelect) Modify(modifiers ...func(s *sql.Selector)) *ShiftAssignmentSelect {
+	sas.modifiers = append(sas.modifiers, modifiers...)
+	return sas
+}
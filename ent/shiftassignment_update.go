@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/employee"
+	"mceasy/ent/predicate"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftAssignmentUpdate is the builder for updating ShiftAssignment entities.
+type ShiftAssignmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ShiftAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ShiftAssignmentUpdate builder.
+func (sau *ShiftAssignmentUpdate) Where(ps ...predicate.ShiftAssignment) *ShiftAssignmentUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (sau *ShiftAssignmentUpdate) SetModifiedAt(t time.Time) *ShiftAssignmentUpdate {
+	sau.mutation.SetModifiedAt(t)
+	return sau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sau *ShiftAssignmentUpdate) SetDeletedAt(t time.Time) *ShiftAssignmentUpdate {
+	sau.mutation.SetDeletedAt(t)
+	return sau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sau *ShiftAssignmentUpdate) SetNillableDeletedAt(t *time.Time) *ShiftAssignmentUpdate {
+	if t != nil {
+		sau.SetDeletedAt(*t)
+	}
+	return sau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sau *ShiftAssignmentUpdate) ClearDeletedAt() *ShiftAssignmentUpdate {
+	sau.mutation.ClearDeletedAt()
+	return sau
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (sau *ShiftAssignmentUpdate) SetEmployeeID(u uint64) *ShiftAssignmentUpdate {
+	sau.mutation.SetEmployeeID(u)
+	return sau
+}
+
+// SetWorkScheduleID sets the "work_schedule_id" field.
+func (sau *ShiftAssignmentUpdate) SetWorkScheduleID(u uint64) *ShiftAssignmentUpdate {
+	sau.mutation.SetWorkScheduleID(u)
+	return sau
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (sau *ShiftAssignmentUpdate) SetEffectiveDate(t time.Time) *ShiftAssignmentUpdate {
+	sau.mutation.SetEffectiveDate(t)
+	return sau
+}
+
+// SetEndDate sets the "end_date" field.
+func (sau *ShiftAssignmentUpdate) SetEndDate(t time.Time) *ShiftAssignmentUpdate {
+	sau.mutation.SetEndDate(t)
+	return sau
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (sau *ShiftAssignmentUpdate) SetNillableEndDate(t *time.Time) *ShiftAssignmentUpdate {
+	if t != nil {
+		sau.SetEndDate(*t)
+	}
+	return sau
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (sau *ShiftAssignmentUpdate) ClearEndDate() *ShiftAssignmentUpdate {
+	sau.mutation.ClearEndDate()
+	return sau
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (sau *ShiftAssignmentUpdate) SetEmployee(e *Employee) *ShiftAssignmentUpdate {
+	return sau.SetEmployeeID(e.ID)
+}
+
+// SetWorkSchedule sets the "work_schedule" edge to the WorkSchedule entity.
+func (sau *ShiftAssignmentUpdate) SetWorkSchedule(w *WorkSchedule) *ShiftAssignmentUpdate {
+	return sau.SetWorkScheduleID(w.ID)
+}
+
+// Mutation returns the ShiftAssignmentMutation object of the builder.
+func (sau *ShiftAssignmentUpdate) Mutation() *ShiftAssignmentMutation {
+	return sau.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (sau *ShiftAssignmentUpdate) ClearEmployee() *ShiftAssignmentUpdate {
+	sau.mutation.ClearEmployee()
+	return sau
+}
+
+// ClearWorkSchedule clears the "work_schedule" edge to the WorkSchedule entity.
+func (sau *ShiftAssignmentUpdate) ClearWorkSchedule() *ShiftAssignmentUpdate {
+	sau.mutation.ClearWorkSchedule()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ShiftAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ShiftAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ShiftAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ShiftAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *ShiftAssignmentUpdate) defaults() {
+	if _, ok := sau.mutation.ModifiedAt(); !ok {
+		v := shiftassignment.UpdateDefaultModifiedAt()
+		sau.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ShiftAssignmentUpdate) check() error {
+	if _, ok := sau.mutation.EmployeeID(); sau.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShiftAssignment.employee"`)
+	}
+	if _, ok := sau.mutation.WorkScheduleID(); sau.mutation.WorkScheduleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShiftAssignment.work_schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sau *ShiftAssignmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShiftAssignmentUpdate {
+	sau.modifiers = append(sau.modifiers, modifiers...)
+	return sau
+}
+
+func (sau *ShiftAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftassignment.Table, shiftassignment.Columns, sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.ModifiedAt(); ok {
+		_spec.SetField(shiftassignment.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := sau.mutation.DeletedAt(); ok {
+		_spec.SetField(shiftassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sau.mutation.DeletedAtCleared() {
+		_spec.ClearField(shiftassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.EffectiveDate(); ok {
+		_spec.SetField(shiftassignment.FieldEffectiveDate, field.TypeTime, value)
+	}
+	if value, ok := sau.mutation.EndDate(); ok {
+		_spec.SetField(shiftassignment.FieldEndDate, field.TypeTime, value)
+	}
+	if sau.mutation.EndDateCleared() {
+		_spec.ClearField(shiftassignment.FieldEndDate, field.TypeTime)
+	}
+	if sau.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.EmployeeTable,
+			Columns: []string{shiftassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.EmployeeTable,
+			Columns: []string{shiftassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sau.mutation.WorkScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.WorkScheduleTable,
+			Columns: []string{shiftassignment.WorkScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.WorkScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.WorkScheduleTable,
+			Columns: []string{shiftassignment.WorkScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ShiftAssignmentUpdateOne is the builder for updating a single ShiftAssignment entity.
+type ShiftAssignmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ShiftAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (sauo *ShiftAssignmentUpdateOne) SetModifiedAt(t time.Time) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetModifiedAt(t)
+	return sauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sauo *ShiftAssignmentUpdateOne) SetDeletedAt(t time.Time) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetDeletedAt(t)
+	return sauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sauo *ShiftAssignmentUpdateOne) SetNillableDeletedAt(t *time.Time) *ShiftAssignmentUpdateOne {
+	if t != nil {
+		sauo.SetDeletedAt(*t)
+	}
+	return sauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sauo *ShiftAssignmentUpdateOne) ClearDeletedAt() *ShiftAssignmentUpdateOne {
+	sauo.mutation.ClearDeletedAt()
+	return sauo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (sauo *ShiftAssignmentUpdateOne) SetEmployeeID(u uint64) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetEmployeeID(u)
+	return sauo
+}
+
+// SetWorkScheduleID sets the "work_schedule_id" field.
+func (sauo *ShiftAssignmentUpdateOne) SetWorkScheduleID(u uint64) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetWorkScheduleID(u)
+	return sauo
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (sauo *ShiftAssignmentUpdateOne) SetEffectiveDate(t time.Time) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetEffectiveDate(t)
+	return sauo
+}
+
+// SetEndDate sets the "end_date" field.
+func (sauo *ShiftAssignmentUpdateOne) SetEndDate(t time.Time) *ShiftAssignmentUpdateOne {
+	sauo.mutation.SetEndDate(t)
+	return sauo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (sauo *ShiftAssignmentUpdateOne) SetNillableEndDate(t *time.Time) *ShiftAssignmentUpdateOne {
+	if t != nil {
+		sauo.SetEndDate(*t)
+	}
+	return sauo
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (sauo *ShiftAssignmentUpdateOne) ClearEndDate() *ShiftAssignmentUpdateOne {
+	sauo.mutation.ClearEndDate()
+	return sauo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (sauo *ShiftAssignmentUpdateOne) SetEmployee(e *Employee) *ShiftAssignmentUpdateOne {
+	return sauo.SetEmployeeID(e.ID)
+}
+
+// SetWorkSchedule sets the "work_schedule" edge to the WorkSchedule entity.
+func (sauo *ShiftAssignmentUpdateOne) SetWorkSchedule(w *WorkSchedule) *ShiftAssignmentUpdateOne {
+	return sauo.SetWorkScheduleID(w.ID)
+}
+
+// Mutation returns the ShiftAssignmentMutation object of the builder.
+func (sauo *ShiftAssignmentUpdateOne) Mutation() *ShiftAssignmentMutation {
+	return sauo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (sauo *ShiftAssignmentUpdateOne) ClearEmployee() *ShiftAssignmentUpdateOne {
+	sauo.mutation.ClearEmployee()
+	return sauo
+}
+
+// ClearWorkSchedule clears the "work_schedule" edge to the WorkSchedule entity.
+func (sauo *ShiftAssignmentUpdateOne) ClearWorkSchedule() *ShiftAssignmentUpdateOne {
+	sauo.mutation.ClearWorkSchedule()
+	return sauo
+}
+
+// Where appends a list predicates to the ShiftAssignmentUpdate builder.
+func (sauo *ShiftAssignmentUpdateOne) Where(ps ...predicate.ShiftAssignment) *ShiftAssignmentUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ShiftAssignmentUpdateOne) Select(field string, fields ...string) *ShiftAssignmentUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ShiftAssignment entity.
+func (sauo *ShiftAssignmentUpdateOne) Save(ctx context.Context) (*ShiftAssignment, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ShiftAssignmentUpdateOne) SaveX(ctx context.Context) *ShiftAssignment {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ShiftAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ShiftAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *ShiftAssignmentUpdateOne) defaults() {
+	if _, ok := sauo.mutation.ModifiedAt(); !ok {
+		v := shiftassignment.UpdateDefaultModifiedAt()
+		sauo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ShiftAssignmentUpdateOne) check() error {
+	if _, ok := sauo.mutation.EmployeeID(); sauo.mutation.EmployeeCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShiftAssignment.employee"`)
+	}
+	if _, ok := sauo.mutation.WorkScheduleID(); sauo.mutation.WorkScheduleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShiftAssignment.work_schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sauo *ShiftAssignmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShiftAssignmentUpdateOne {
+	sauo.modifiers = append(sauo.modifiers, modifiers...)
+	return sauo
+}
+
+func (sauo *ShiftAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *ShiftAssignment, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftassignment.Table, shiftassignment.Columns, sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShiftAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftassignment.FieldID)
+		for _, f := range fields {
+			if !shiftassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shiftassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.ModifiedAt(); ok {
+		_spec.SetField(shiftassignment.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := sauo.mutation.DeletedAt(); ok {
+		_spec.SetField(shiftassignment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(shiftassignment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.EffectiveDate(); ok {
+		_spec.SetField(shiftassignment.FieldEffectiveDate, field.TypeTime, value)
+	}
+	if value, ok := sauo.mutation.EndDate(); ok {
+		_spec.SetField(shiftassignment.FieldEndDate, field.TypeTime, value)
+	}
+	if sauo.mutation.EndDateCleared() {
+		_spec.ClearField(shiftassignment.FieldEndDate, field.TypeTime)
+	}
+	if sauo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.EmployeeTable,
+			Columns: []string{shiftassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.EmployeeTable,
+			Columns: []string{shiftassignment.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sauo.mutation.WorkScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.WorkScheduleTable,
+			Columns: []string{shiftassignment.WorkScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.WorkScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftassignment.WorkScheduleTable,
+			Columns: []string{shiftassignment.WorkScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sauo.modifiers...)
+	_node = &ShiftAssignment{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
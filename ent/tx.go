@@ -16,16 +16,64 @@ type Tx struct {
 	config
 	// Attendance is the client for interacting with the Attendance builders.
 	Attendance *AttendanceClient
+	// AttendanceAnomaly is the client for interacting with the AttendanceAnomaly builders.
+	AttendanceAnomaly *AttendanceAnomalyClient
+	// AttendanceCorrection is the client for interacting with the AttendanceCorrection builders.
+	AttendanceCorrection *AttendanceCorrectionClient
+	// AttendancePayPolicy is the client for interacting with the AttendancePayPolicy builders.
+	AttendancePayPolicy *AttendancePayPolicyClient
+	// AttendancePunch is the client for interacting with the AttendancePunch builders.
+	AttendancePunch *AttendancePunchClient
+	// BpjsContributionRate is the client for interacting with the BpjsContributionRate builders.
+	BpjsContributionRate *BpjsContributionRateClient
+	// CalendarFeed is the client for interacting with the CalendarFeed builders.
+	CalendarFeed *CalendarFeedClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// DevicePunchRejection is the client for interacting with the DevicePunchRejection builders.
+	DevicePunchRejection *DevicePunchRejectionClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// Kiosk is the client for interacting with the Kiosk builders.
+	Kiosk *KioskClient
+	// LeaveBalance is the client for interacting with the LeaveBalance builders.
+	LeaveBalance *LeaveBalanceClient
+	// LeaveRequest is the client for interacting with the LeaveRequest builders.
+	LeaveRequest *LeaveRequestClient
+	// LeaveType is the client for interacting with the LeaveType builders.
+	LeaveType *LeaveTypeClient
+	// OfficeLocation is the client for interacting with the OfficeLocation builders.
+	OfficeLocation *OfficeLocationClient
+	// Overtime is the client for interacting with the Overtime builders.
+	Overtime *OvertimeClient
+	// PayrollPeriod is the client for interacting with the PayrollPeriod builders.
+	PayrollPeriod *PayrollPeriodClient
+	// PayrollPeriodEvent is the client for interacting with the PayrollPeriodEvent builders.
+	PayrollPeriodEvent *PayrollPeriodEventClient
+	// PayrollRun is the client for interacting with the PayrollRun builders.
+	PayrollRun *PayrollRunClient
+	// PayrollRunEvent is the client for interacting with the PayrollRunEvent builders.
+	PayrollRunEvent *PayrollRunEventClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleUser is the client for interacting with the RoleUser builders.
 	RoleUser *RoleUserClient
 	// SalaryCalculation is the client for interacting with the SalaryCalculation builders.
 	SalaryCalculation *SalaryCalculationClient
+	// SalaryCalculationItem is the client for interacting with the SalaryCalculationItem builders.
+	SalaryCalculationItem *SalaryCalculationItemClient
+	// SalaryComponent is the client for interacting with the SalaryComponent builders.
+	SalaryComponent *SalaryComponentClient
+	// SalaryComponentAssignment is the client for interacting with the SalaryComponentAssignment builders.
+	SalaryComponentAssignment *SalaryComponentAssignmentClient
+	// ShiftAssignment is the client for interacting with the ShiftAssignment builders.
+	ShiftAssignment *ShiftAssignmentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WorkSchedule is the client for interacting with the WorkSchedule builders.
+	WorkSchedule *WorkScheduleClient
 
 	// lazily loaded.
 	client     *Client
@@ -158,11 +206,35 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Attendance = NewAttendanceClient(tx.config)
+	tx.AttendanceAnomaly = NewAttendanceAnomalyClient(tx.config)
+	tx.AttendanceCorrection = NewAttendanceCorrectionClient(tx.config)
+	tx.AttendancePayPolicy = NewAttendancePayPolicyClient(tx.config)
+	tx.AttendancePunch = NewAttendancePunchClient(tx.config)
+	tx.BpjsContributionRate = NewBpjsContributionRateClient(tx.config)
+	tx.CalendarFeed = NewCalendarFeedClient(tx.config)
+	tx.Device = NewDeviceClient(tx.config)
+	tx.DevicePunchRejection = NewDevicePunchRejectionClient(tx.config)
 	tx.Employee = NewEmployeeClient(tx.config)
+	tx.Holiday = NewHolidayClient(tx.config)
+	tx.Kiosk = NewKioskClient(tx.config)
+	tx.LeaveBalance = NewLeaveBalanceClient(tx.config)
+	tx.LeaveRequest = NewLeaveRequestClient(tx.config)
+	tx.LeaveType = NewLeaveTypeClient(tx.config)
+	tx.OfficeLocation = NewOfficeLocationClient(tx.config)
+	tx.Overtime = NewOvertimeClient(tx.config)
+	tx.PayrollPeriod = NewPayrollPeriodClient(tx.config)
+	tx.PayrollPeriodEvent = NewPayrollPeriodEventClient(tx.config)
+	tx.PayrollRun = NewPayrollRunClient(tx.config)
+	tx.PayrollRunEvent = NewPayrollRunEventClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RoleUser = NewRoleUserClient(tx.config)
 	tx.SalaryCalculation = NewSalaryCalculationClient(tx.config)
+	tx.SalaryCalculationItem = NewSalaryCalculationItemClient(tx.config)
+	tx.SalaryComponent = NewSalaryComponentClient(tx.config)
+	tx.SalaryComponentAssignment = NewSalaryComponentAssignmentClient(tx.config)
+	tx.ShiftAssignment = NewShiftAssignmentClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WorkSchedule = NewWorkScheduleClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
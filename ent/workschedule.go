@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/workschedule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WorkSchedule is the model entity for the WorkSchedule schema.
+type WorkSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ModifiedAt holds the value of the "modified_at" field.
+	ModifiedAt time.Time `json:"modified_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Shift start in HH:MM
+	StartTime string `json:"start_time,omitempty"`
+	// Shift end in HH:MM
+	EndTime string `json:"end_time,omitempty"`
+	// Working weekdays, 0 = Sunday through 6 = Saturday
+	WorkingDays []int `json:"working_days,omitempty"`
+	// Grace period after start before a check-in counts as late
+	LateThresholdMinutes int `json:"late_threshold_minutes,omitempty"`
+	// Minutes after start before a check-in counts as half day, 0 disables
+	HalfDayThresholdMinutes int `json:"half_day_threshold_minutes,omitempty"`
+	// Applied to employees without an active shift assignment
+	IsDefault bool `json:"is_default,omitempty"`
+	// Pay weights of the attendance statuses, the default policy applies when empty
+	AttendancePayPolicyID *uint64 `json:"attendance_pay_policy_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkScheduleQuery when eager-loading is set.
+	Edges        WorkScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkScheduleEdges holds the relations/edges for other nodes in the graph.
+type WorkScheduleEdges struct {
+	// ShiftAssignments holds the value of the shift_assignments edge.
+	ShiftAssignments []*ShiftAssignment `json:"shift_assignments,omitempty"`
+	// AttendancePayPolicy holds the value of the attendance_pay_policy edge.
+	AttendancePayPolicy *AttendancePayPolicy `json:"attendance_pay_policy,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ShiftAssignmentsOrErr returns the ShiftAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkScheduleEdges) ShiftAssignmentsOrErr() ([]*ShiftAssignment, error) {
+	if e.loadedTypes[0] {
+		return e.ShiftAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "shift_assignments"}
+}
+
+// AttendancePayPolicyOrErr returns the AttendancePayPolicy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkScheduleEdges) AttendancePayPolicyOrErr() (*AttendancePayPolicy, error) {
+	if e.loadedTypes[1] {
+		if e.AttendancePayPolicy == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: attendancepaypolicy.Label}
+		}
+		return e.AttendancePayPolicy, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_pay_policy"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workschedule.FieldWorkingDays:
+			values[i] = new([]byte)
+		case workschedule.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case workschedule.FieldID, workschedule.FieldLateThresholdMinutes, workschedule.FieldHalfDayThresholdMinutes, workschedule.FieldAttendancePayPolicyID:
+			values[i] = new(sql.NullInt64)
+		case workschedule.FieldName, workschedule.FieldStartTime, workschedule.FieldEndTime:
+			values[i] = new(sql.NullString)
+		case workschedule.FieldCreatedAt, workschedule.FieldModifiedAt, workschedule.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkSchedule fields.
+func (ws *WorkSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workschedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ws.ID = uint64(value.Int64)
+		case workschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		case workschedule.FieldModifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified_at", values[i])
+			} else if value.Valid {
+				ws.ModifiedAt = value.Time
+			}
+		case workschedule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ws.DeletedAt = value.Time
+			}
+		case workschedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ws.Name = value.String
+			}
+		case workschedule.FieldStartTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				ws.StartTime = value.String
+			}
+		case workschedule.FieldEndTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				ws.EndTime = value.String
+			}
+		case workschedule.FieldWorkingDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field working_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ws.WorkingDays); err != nil {
+					return fmt.Errorf("unmarshal field working_days: %w", err)
+				}
+			}
+		case workschedule.FieldLateThresholdMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_threshold_minutes", values[i])
+			} else if value.Valid {
+				ws.LateThresholdMinutes = int(value.Int64)
+			}
+		case workschedule.FieldHalfDayThresholdMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field half_day_threshold_minutes", values[i])
+			} else if value.Valid {
+				ws.HalfDayThresholdMinutes = int(value.Int64)
+			}
+		case workschedule.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				ws.IsDefault = value.Bool
+			}
+		case workschedule.FieldAttendancePayPolicyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_pay_policy_id", values[i])
+			} else if value.Valid {
+				ws.AttendancePayPolicyID = new(uint64)
+				*ws.AttendancePayPolicyID = uint64(value.Int64)
+			}
+		default:
+			ws.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkSchedule.
+// This includes values selected through modifiers, order, etc.
+func (ws *WorkSchedule) Value(name string) (ent.Value, error) {
+	return ws.selectValues.Get(name)
+}
+
+// QueryShiftAssignments queries the "shift_assignments" edge of the WorkSchedule entity.
+func (ws *WorkSchedule) QueryShiftAssignments() *ShiftAssignmentQuery {
+	return NewWorkScheduleClient(ws.config).QueryShiftAssignments(ws)
+}
+
+// QueryAttendancePayPolicy queries the "attendance_pay_policy" edge of the WorkSchedule entity.
+func (ws *WorkSchedule) QueryAttendancePayPolicy() *AttendancePayPolicyQuery {
+	return NewWorkScheduleClient(ws.config).QueryAttendancePayPolicy(ws)
+}
+
+// Update returns a builder for updating this WorkSchedule.
+// Note that you need to call WorkSchedule.Unwrap() before calling this method if this WorkSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WorkSchedule) Update() *WorkScheduleUpdateOne {
+	return NewWorkScheduleClient(ws.config).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WorkSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WorkSchedule) Unwrap() *WorkSchedule {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkSchedule is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WorkSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("modified_at=")
+	builder.WriteString(ws.ModifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ws.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ws.Name)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(ws.StartTime)
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(ws.EndTime)
+	builder.WriteString(", ")
+	builder.WriteString("working_days=")
+	builder.WriteString(fmt.Sprintf("%v", ws.WorkingDays))
+	builder.WriteString(", ")
+	builder.WriteString("late_threshold_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", ws.LateThresholdMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("half_day_threshold_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", ws.HalfDayThresholdMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", ws.IsDefault))
+	builder.WriteString(", ")
+	if v := ws.AttendancePayPolicyID; v != nil {
+		builder.WriteString("attendance_pay_policy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkSchedules is a parsable slice of WorkSchedule.
+type WorkSchedules []*WorkSchedule
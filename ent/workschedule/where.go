@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workschedule
+
+import (
+	"mceasy/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModifiedAt applies equality check predicate on the "modified_at" field. It's identical to ModifiedAtEQ.
+func ModifiedAt(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldEndTime, v))
+}
+
+// LateThresholdMinutes applies equality check predicate on the "late_threshold_minutes" field. It's identical to LateThresholdMinutesEQ.
+func LateThresholdMinutes(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldLateThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutes applies equality check predicate on the "half_day_threshold_minutes" field. It's identical to HalfDayThresholdMinutesEQ.
+func HalfDayThresholdMinutes(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldHalfDayThresholdMinutes, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// AttendancePayPolicyID applies equality check predicate on the "attendance_pay_policy_id" field. It's identical to AttendancePayPolicyIDEQ.
+func AttendancePayPolicyID(v uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldAttendancePayPolicyID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ModifiedAtEQ applies the EQ predicate on the "modified_at" field.
+func ModifiedAtEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtNEQ applies the NEQ predicate on the "modified_at" field.
+func ModifiedAtNEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldModifiedAt, v))
+}
+
+// ModifiedAtIn applies the In predicate on the "modified_at" field.
+func ModifiedAtIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtNotIn applies the NotIn predicate on the "modified_at" field.
+func ModifiedAtNotIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldModifiedAt, vs...))
+}
+
+// ModifiedAtGT applies the GT predicate on the "modified_at" field.
+func ModifiedAtGT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldModifiedAt, v))
+}
+
+// ModifiedAtGTE applies the GTE predicate on the "modified_at" field.
+func ModifiedAtGTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldModifiedAt, v))
+}
+
+// ModifiedAtLT applies the LT predicate on the "modified_at" field.
+func ModifiedAtLT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldModifiedAt, v))
+}
+
+// ModifiedAtLTE applies the LTE predicate on the "modified_at" field.
+func ModifiedAtLTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldModifiedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContainsFold(FieldName, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldStartTime, v))
+}
+
+// StartTimeContains applies the Contains predicate on the "start_time" field.
+func StartTimeContains(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContains(FieldStartTime, v))
+}
+
+// StartTimeHasPrefix applies the HasPrefix predicate on the "start_time" field.
+func StartTimeHasPrefix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasPrefix(FieldStartTime, v))
+}
+
+// StartTimeHasSuffix applies the HasSuffix predicate on the "start_time" field.
+func StartTimeHasSuffix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasSuffix(FieldStartTime, v))
+}
+
+// StartTimeEqualFold applies the EqualFold predicate on the "start_time" field.
+func StartTimeEqualFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEqualFold(FieldStartTime, v))
+}
+
+// StartTimeContainsFold applies the ContainsFold predicate on the "start_time" field.
+func StartTimeContainsFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContainsFold(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeContains applies the Contains predicate on the "end_time" field.
+func EndTimeContains(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContains(FieldEndTime, v))
+}
+
+// EndTimeHasPrefix applies the HasPrefix predicate on the "end_time" field.
+func EndTimeHasPrefix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasPrefix(FieldEndTime, v))
+}
+
+// EndTimeHasSuffix applies the HasSuffix predicate on the "end_time" field.
+func EndTimeHasSuffix(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldHasSuffix(FieldEndTime, v))
+}
+
+// EndTimeEqualFold applies the EqualFold predicate on the "end_time" field.
+func EndTimeEqualFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEqualFold(FieldEndTime, v))
+}
+
+// EndTimeContainsFold applies the ContainsFold predicate on the "end_time" field.
+func EndTimeContainsFold(v string) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldContainsFold(FieldEndTime, v))
+}
+
+// LateThresholdMinutesEQ applies the EQ predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesEQ(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldLateThresholdMinutes, v))
+}
+
+// LateThresholdMinutesNEQ applies the NEQ predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesNEQ(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldLateThresholdMinutes, v))
+}
+
+// LateThresholdMinutesIn applies the In predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesIn(vs ...int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldLateThresholdMinutes, vs...))
+}
+
+// LateThresholdMinutesNotIn applies the NotIn predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesNotIn(vs ...int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldLateThresholdMinutes, vs...))
+}
+
+// LateThresholdMinutesGT applies the GT predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesGT(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldLateThresholdMinutes, v))
+}
+
+// LateThresholdMinutesGTE applies the GTE predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesGTE(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldLateThresholdMinutes, v))
+}
+
+// LateThresholdMinutesLT applies the LT predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesLT(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldLateThresholdMinutes, v))
+}
+
+// LateThresholdMinutesLTE applies the LTE predicate on the "late_threshold_minutes" field.
+func LateThresholdMinutesLTE(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldLateThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesEQ applies the EQ predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesEQ(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldHalfDayThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesNEQ applies the NEQ predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesNEQ(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldHalfDayThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesIn applies the In predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesIn(vs ...int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldHalfDayThresholdMinutes, vs...))
+}
+
+// HalfDayThresholdMinutesNotIn applies the NotIn predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesNotIn(vs ...int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldHalfDayThresholdMinutes, vs...))
+}
+
+// HalfDayThresholdMinutesGT applies the GT predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesGT(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGT(FieldHalfDayThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesGTE applies the GTE predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesGTE(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldGTE(FieldHalfDayThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesLT applies the LT predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesLT(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLT(FieldHalfDayThresholdMinutes, v))
+}
+
+// HalfDayThresholdMinutesLTE applies the LTE predicate on the "half_day_threshold_minutes" field.
+func HalfDayThresholdMinutesLTE(v int) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldLTE(FieldHalfDayThresholdMinutes, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// AttendancePayPolicyIDEQ applies the EQ predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDEQ(v uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldEQ(FieldAttendancePayPolicyID, v))
+}
+
+// AttendancePayPolicyIDNEQ applies the NEQ predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDNEQ(v uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNEQ(FieldAttendancePayPolicyID, v))
+}
+
+// AttendancePayPolicyIDIn applies the In predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDIn(vs ...uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIn(FieldAttendancePayPolicyID, vs...))
+}
+
+// AttendancePayPolicyIDNotIn applies the NotIn predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDNotIn(vs ...uint64) predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotIn(FieldAttendancePayPolicyID, vs...))
+}
+
+// AttendancePayPolicyIDIsNil applies the IsNil predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDIsNil() predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldIsNull(FieldAttendancePayPolicyID))
+}
+
+// AttendancePayPolicyIDNotNil applies the NotNil predicate on the "attendance_pay_policy_id" field.
+func AttendancePayPolicyIDNotNil() predicate.WorkSchedule {
+	return predicate.WorkSchedule(sql.FieldNotNull(FieldAttendancePayPolicyID))
+}
+
+// HasShiftAssignments applies the HasEdge predicate on the "shift_assignments" edge.
+func HasShiftAssignments() predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShiftAssignmentsTable, ShiftAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShiftAssignmentsWith applies the HasEdge predicate on the "shift_assignments" edge with a given conditions (other predicates).
+func HasShiftAssignmentsWith(preds ...predicate.ShiftAssignment) predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		step := newShiftAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendancePayPolicy applies the HasEdge predicate on the "attendance_pay_policy" edge.
+func HasAttendancePayPolicy() predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendancePayPolicyTable, AttendancePayPolicyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendancePayPolicyWith applies the HasEdge predicate on the "attendance_pay_policy" edge with a given conditions (other predicates).
+func HasAttendancePayPolicyWith(preds ...predicate.AttendancePayPolicy) predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		step := newAttendancePayPolicyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkSchedule) predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkSchedule) predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkSchedule) predicate.WorkSchedule {
+	return predicate.WorkSchedule(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workschedule type in the database.
+	Label = "work_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldModifiedAt holds the string denoting the modified_at field in the database.
+	FieldModifiedAt = "modified_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldWorkingDays holds the string denoting the working_days field in the database.
+	FieldWorkingDays = "working_days"
+	// FieldLateThresholdMinutes holds the string denoting the late_threshold_minutes field in the database.
+	FieldLateThresholdMinutes = "late_threshold_minutes"
+	// FieldHalfDayThresholdMinutes holds the string denoting the half_day_threshold_minutes field in the database.
+	FieldHalfDayThresholdMinutes = "half_day_threshold_minutes"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// FieldAttendancePayPolicyID holds the string denoting the attendance_pay_policy_id field in the database.
+	FieldAttendancePayPolicyID = "attendance_pay_policy_id"
+	// EdgeShiftAssignments holds the string denoting the shift_assignments edge name in mutations.
+	EdgeShiftAssignments = "shift_assignments"
+	// EdgeAttendancePayPolicy holds the string denoting the attendance_pay_policy edge name in mutations.
+	EdgeAttendancePayPolicy = "attendance_pay_policy"
+	// Table holds the table name of the workschedule in the database.
+	Table = "work_schedules"
+	// ShiftAssignmentsTable is the table that holds the shift_assignments relation/edge.
+	ShiftAssignmentsTable = "shift_assignments"
+	// ShiftAssignmentsInverseTable is the table name for the ShiftAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "shiftassignment" package.
+	ShiftAssignmentsInverseTable = "shift_assignments"
+	// ShiftAssignmentsColumn is the table column denoting the shift_assignments relation/edge.
+	ShiftAssignmentsColumn = "work_schedule_id"
+	// AttendancePayPolicyTable is the table that holds the attendance_pay_policy relation/edge.
+	AttendancePayPolicyTable = "work_schedules"
+	// AttendancePayPolicyInverseTable is the table name for the AttendancePayPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancepaypolicy" package.
+	AttendancePayPolicyInverseTable = "attendance_pay_policies"
+	// AttendancePayPolicyColumn is the table column denoting the attendance_pay_policy relation/edge.
+	AttendancePayPolicyColumn = "attendance_pay_policy_id"
+)
+
+// Columns holds all SQL columns for workschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldModifiedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldStartTime,
+	FieldEndTime,
+	FieldWorkingDays,
+	FieldLateThresholdMinutes,
+	FieldHalfDayThresholdMinutes,
+	FieldIsDefault,
+	FieldAttendancePayPolicyID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultModifiedAt holds the default value on creation for the "modified_at" field.
+	DefaultModifiedAt func() time.Time
+	// UpdateDefaultModifiedAt holds the default value on update for the "modified_at" field.
+	UpdateDefaultModifiedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// StartTimeValidator is a validator for the "start_time" field. It is called by the builders before save.
+	StartTimeValidator func(string) error
+	// EndTimeValidator is a validator for the "end_time" field. It is called by the builders before save.
+	EndTimeValidator func(string) error
+	// DefaultLateThresholdMinutes holds the default value on creation for the "late_threshold_minutes" field.
+	DefaultLateThresholdMinutes int
+	// LateThresholdMinutesValidator is a validator for the "late_threshold_minutes" field. It is called by the builders before save.
+	LateThresholdMinutesValidator func(int) error
+	// DefaultHalfDayThresholdMinutes holds the default value on creation for the "half_day_threshold_minutes" field.
+	DefaultHalfDayThresholdMinutes int
+	// HalfDayThresholdMinutesValidator is a validator for the "half_day_threshold_minutes" field. It is called by the builders before save.
+	HalfDayThresholdMinutesValidator func(int) error
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+)
+
+// OrderOption defines the ordering options for the WorkSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModifiedAt orders the results by the modified_at field.
+func ByModifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModifiedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByLateThresholdMinutes orders the results by the late_threshold_minutes field.
+func ByLateThresholdMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateThresholdMinutes, opts...).ToFunc()
+}
+
+// ByHalfDayThresholdMinutes orders the results by the half_day_threshold_minutes field.
+func ByHalfDayThresholdMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHalfDayThresholdMinutes, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByAttendancePayPolicyID orders the results by the attendance_pay_policy_id field.
+func ByAttendancePayPolicyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendancePayPolicyID, opts...).ToFunc()
+}
+
+// ByShiftAssignmentsCount orders the results by shift_assignments count.
+func ByShiftAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShiftAssignmentsStep(), opts...)
+	}
+}
+
+// ByShiftAssignments orders the results by shift_assignments terms.
+func ByShiftAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendancePayPolicyField orders the results by attendance_pay_policy field.
+func ByAttendancePayPolicyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendancePayPolicyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShiftAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShiftAssignmentsTable, ShiftAssignmentsColumn),
+	)
+}
+func newAttendancePayPolicyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendancePayPolicyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendancePayPolicyTable, AttendancePayPolicyColumn),
+	)
+}
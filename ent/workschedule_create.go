@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkScheduleCreate is the builder for creating a WorkSchedule entity.
+type WorkScheduleCreate struct {
+	config
+	mutation *WorkScheduleMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WorkScheduleCreate) SetCreatedAt(t time.Time) *WorkScheduleCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableCreatedAt(t *time.Time) *WorkScheduleCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (wsc *WorkScheduleCreate) SetModifiedAt(t time.Time) *WorkScheduleCreate {
+	wsc.mutation.SetModifiedAt(t)
+	return wsc
+}
+
+// SetNillableModifiedAt sets the "modified_at" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableModifiedAt(t *time.Time) *WorkScheduleCreate {
+	if t != nil {
+		wsc.SetModifiedAt(*t)
+	}
+	return wsc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wsc *WorkScheduleCreate) SetDeletedAt(t time.Time) *WorkScheduleCreate {
+	wsc.mutation.SetDeletedAt(t)
+	return wsc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableDeletedAt(t *time.Time) *WorkScheduleCreate {
+	if t != nil {
+		wsc.SetDeletedAt(*t)
+	}
+	return wsc
+}
+
+// SetName sets the "name" field.
+func (wsc *WorkScheduleCreate) SetName(s string) *WorkScheduleCreate {
+	wsc.mutation.SetName(s)
+	return wsc
+}
+
+// SetStartTime sets the "start_time" field.
+func (wsc *WorkScheduleCreate) SetStartTime(s string) *WorkScheduleCreate {
+	wsc.mutation.SetStartTime(s)
+	return wsc
+}
+
+// SetEndTime sets the "end_time" field.
+func (wsc *WorkScheduleCreate) SetEndTime(s string) *WorkScheduleCreate {
+	wsc.mutation.SetEndTime(s)
+	return wsc
+}
+
+// SetWorkingDays sets the "working_days" field.
+func (wsc *WorkScheduleCreate) SetWorkingDays(i []int) *WorkScheduleCreate {
+	wsc.mutation.SetWorkingDays(i)
+	return wsc
+}
+
+// SetLateThresholdMinutes sets the "late_threshold_minutes" field.
+func (wsc *WorkScheduleCreate) SetLateThresholdMinutes(i int) *WorkScheduleCreate {
+	wsc.mutation.SetLateThresholdMinutes(i)
+	return wsc
+}
+
+// SetNillableLateThresholdMinutes sets the "late_threshold_minutes" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableLateThresholdMinutes(i *int) *WorkScheduleCreate {
+	if i != nil {
+		wsc.SetLateThresholdMinutes(*i)
+	}
+	return wsc
+}
+
+// SetHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field.
+func (wsc *WorkScheduleCreate) SetHalfDayThresholdMinutes(i int) *WorkScheduleCreate {
+	wsc.mutation.SetHalfDayThresholdMinutes(i)
+	return wsc
+}
+
+// SetNillableHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableHalfDayThresholdMinutes(i *int) *WorkScheduleCreate {
+	if i != nil {
+		wsc.SetHalfDayThresholdMinutes(*i)
+	}
+	return wsc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wsc *WorkScheduleCreate) SetIsDefault(b bool) *WorkScheduleCreate {
+	wsc.mutation.SetIsDefault(b)
+	return wsc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableIsDefault(b *bool) *WorkScheduleCreate {
+	if b != nil {
+		wsc.SetIsDefault(*b)
+	}
+	return wsc
+}
+
+// SetAttendancePayPolicyID sets the "attendance_pay_policy_id" field.
+func (wsc *WorkScheduleCreate) SetAttendancePayPolicyID(u uint64) *WorkScheduleCreate {
+	wsc.mutation.SetAttendancePayPolicyID(u)
+	return wsc
+}
+
+// SetNillableAttendancePayPolicyID sets the "attendance_pay_policy_id" field if the given value is not nil.
+func (wsc *WorkScheduleCreate) SetNillableAttendancePayPolicyID(u *uint64) *WorkScheduleCreate {
+	if u != nil {
+		wsc.SetAttendancePayPolicyID(*u)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WorkScheduleCreate) SetID(u uint64) *WorkScheduleCreate {
+	wsc.mutation.SetID(u)
+	return wsc
+}
+
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (wsc *WorkScheduleCreate) AddShiftAssignmentIDs(ids ...uint64) *WorkScheduleCreate {
+	wsc.mutation.AddShiftAssignmentIDs(ids...)
+	return wsc
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (wsc *WorkScheduleCreate) AddShiftAssignments(s ...*ShiftAssignment) *WorkScheduleCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wsc.AddShiftAssignmentIDs(ids...)
+}
+
+// SetAttendancePayPolicy sets the "attendance_pay_policy" edge to the AttendancePayPolicy entity.
+func (wsc *WorkScheduleCreate) SetAttendancePayPolicy(a *AttendancePayPolicy) *WorkScheduleCreate {
+	return wsc.SetAttendancePayPolicyID(a.ID)
+}
+
+// Mutation returns the WorkScheduleMutation object of the builder.
+func (wsc *WorkScheduleCreate) Mutation() *WorkScheduleMutation {
+	return wsc.mutation
+}
+
+// Save creates the WorkSchedule in the database.
+func (wsc *WorkScheduleCreate) Save(ctx context.Context) (*WorkSchedule, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WorkScheduleCreate) SaveX(ctx context.Context) *WorkSchedule {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WorkScheduleCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WorkScheduleCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WorkScheduleCreate) defaults() {
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := workschedule.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.ModifiedAt(); !ok {
+		v := workschedule.DefaultModifiedAt()
+		wsc.mutation.SetModifiedAt(v)
+	}
+	if _, ok := wsc.mutation.LateThresholdMinutes(); !ok {
+		v := workschedule.DefaultLateThresholdMinutes
+		wsc.mutation.SetLateThresholdMinutes(v)
+	}
+	if _, ok := wsc.mutation.HalfDayThresholdMinutes(); !ok {
+		v := workschedule.DefaultHalfDayThresholdMinutes
+		wsc.mutation.SetHalfDayThresholdMinutes(v)
+	}
+	if _, ok := wsc.mutation.IsDefault(); !ok {
+		v := workschedule.DefaultIsDefault
+		wsc.mutation.SetIsDefault(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WorkScheduleCreate) check() error {
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WorkSchedule.created_at"`)}
+	}
+	if _, ok := wsc.mutation.ModifiedAt(); !ok {
+		return &ValidationError{Name: "modified_at", err: errors.New(`ent: missing required field "WorkSchedule.modified_at"`)}
+	}
+	if _, ok := wsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WorkSchedule.name"`)}
+	}
+	if v, ok := wsc.mutation.Name(); ok {
+		if err := workschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.name": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "WorkSchedule.start_time"`)}
+	}
+	if v, ok := wsc.mutation.StartTime(); ok {
+		if err := workschedule.StartTimeValidator(v); err != nil {
+			return &ValidationError{Name: "start_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.start_time": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "WorkSchedule.end_time"`)}
+	}
+	if v, ok := wsc.mutation.EndTime(); ok {
+		if err := workschedule.EndTimeValidator(v); err != nil {
+			return &ValidationError{Name: "end_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.end_time": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.WorkingDays(); !ok {
+		return &ValidationError{Name: "working_days", err: errors.New(`ent: missing required field "WorkSchedule.working_days"`)}
+	}
+	if _, ok := wsc.mutation.LateThresholdMinutes(); !ok {
+		return &ValidationError{Name: "late_threshold_minutes", err: errors.New(`ent: missing required field "WorkSchedule.late_threshold_minutes"`)}
+	}
+	if v, ok := wsc.mutation.LateThresholdMinutes(); ok {
+		if err := workschedule.LateThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "late_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.late_threshold_minutes": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.HalfDayThresholdMinutes(); !ok {
+		return &ValidationError{Name: "half_day_threshold_minutes", err: errors.New(`ent: missing required field "WorkSchedule.half_day_threshold_minutes"`)}
+	}
+	if v, ok := wsc.mutation.HalfDayThresholdMinutes(); ok {
+		if err := workschedule.HalfDayThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "half_day_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.half_day_threshold_minutes": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "WorkSchedule.is_default"`)}
+	}
+	return nil
+}
+
+func (wsc *WorkScheduleCreate) sqlSave(ctx context.Context) (*WorkSchedule, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WorkScheduleCreate) createSpec() (*WorkSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkSchedule{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(workschedule.Table, sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64))
+	)
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.SetField(workschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wsc.mutation.ModifiedAt(); ok {
+		_spec.SetField(workschedule.FieldModifiedAt, field.TypeTime, value)
+		_node.ModifiedAt = value
+	}
+	if value, ok := wsc.mutation.DeletedAt(); ok {
+		_spec.SetField(workschedule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := wsc.mutation.Name(); ok {
+		_spec.SetField(workschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wsc.mutation.StartTime(); ok {
+		_spec.SetField(workschedule.FieldStartTime, field.TypeString, value)
+		_node.StartTime = value
+	}
+	if value, ok := wsc.mutation.EndTime(); ok {
+		_spec.SetField(workschedule.FieldEndTime, field.TypeString, value)
+		_node.EndTime = value
+	}
+	if value, ok := wsc.mutation.WorkingDays(); ok {
+		_spec.SetField(workschedule.FieldWorkingDays, field.TypeJSON, value)
+		_node.WorkingDays = value
+	}
+	if value, ok := wsc.mutation.LateThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldLateThresholdMinutes, field.TypeInt, value)
+		_node.LateThresholdMinutes = value
+	}
+	if value, ok := wsc.mutation.HalfDayThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldHalfDayThresholdMinutes, field.TypeInt, value)
+		_node.HalfDayThresholdMinutes = value
+	}
+	if value, ok := wsc.mutation.IsDefault(); ok {
+		_spec.SetField(workschedule.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if nodes := wsc.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wsc.mutation.AttendancePayPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workschedule.AttendancePayPolicyTable,
+			Columns: []string{workschedule.AttendancePayPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendancePayPolicyID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkScheduleCreateBulk is the builder for creating many WorkSchedule entities in bulk.
+type WorkScheduleCreateBulk struct {
+	config
+	builders []*WorkScheduleCreate
+}
+
+// Save creates the WorkSchedule entities in the database.
+func (wscb *WorkScheduleCreateBulk) Save(ctx context.Context) ([]*WorkSchedule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WorkSchedule, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WorkScheduleCreateBulk) SaveX(ctx context.Context) []*WorkSchedule {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WorkScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WorkScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
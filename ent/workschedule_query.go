@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/predicate"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkScheduleQuery is the builder for querying WorkSchedule entities.
+type WorkScheduleQuery struct {
+	config
+	ctx                     *QueryContext
+	order                   []workschedule.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.WorkSchedule
+	withShiftAssignments    *ShiftAssignmentQuery
+	withAttendancePayPolicy *AttendancePayPolicyQuery
+	modifiers               []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkScheduleQuery builder.
+func (wsq *WorkScheduleQuery) Where(ps ...predicate.WorkSchedule) *WorkScheduleQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit the number of records to be returned by this query.
+func (wsq *WorkScheduleQuery) Limit(limit int) *WorkScheduleQuery {
+	wsq.ctx.Limit = &limit
+	return wsq
+}
+
+// Offset to start from.
+func (wsq *WorkScheduleQuery) Offset(offset int) *WorkScheduleQuery {
+	wsq.ctx.Offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WorkScheduleQuery) Unique(unique bool) *WorkScheduleQuery {
+	wsq.ctx.Unique = &unique
+	return wsq
+}
+
+// Order specifies how the records should be ordered.
+func (wsq *WorkScheduleQuery) Order(o ...workschedule.OrderOption) *WorkScheduleQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// QueryShiftAssignments chains the current query on the "shift_assignments" edge.
+func (wsq *WorkScheduleQuery) QueryShiftAssignments() *ShiftAssignmentQuery {
+	query := (&ShiftAssignmentClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workschedule.Table, workschedule.FieldID, selector),
+			sqlgraph.To(shiftassignment.Table, shiftassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workschedule.ShiftAssignmentsTable, workschedule.ShiftAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendancePayPolicy chains the current query on the "attendance_pay_policy" edge.
+func (wsq *WorkScheduleQuery) QueryAttendancePayPolicy() *AttendancePayPolicyQuery {
+	query := (&AttendancePayPolicyClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workschedule.Table, workschedule.FieldID, selector),
+			sqlgraph.To(attendancepaypolicy.Table, attendancepaypolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workschedule.AttendancePayPolicyTable, workschedule.AttendancePayPolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkSchedule entity from the query.
+// Returns a *NotFoundError when no WorkSchedule was found.
+func (wsq *WorkScheduleQuery) First(ctx context.Context) (*WorkSchedule, error) {
+	nodes, err := wsq.Limit(1).All(setContextOp(ctx, wsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) FirstX(ctx context.Context) *WorkSchedule {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkSchedule ID from the query.
+// Returns a *NotFoundError when no WorkSchedule ID was found.
+func (wsq *WorkScheduleQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = wsq.Limit(1).IDs(setContextOp(ctx, wsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkSchedule entity is found.
+// Returns a *NotFoundError when no WorkSchedule entities are found.
+func (wsq *WorkScheduleQuery) Only(ctx context.Context) (*WorkSchedule, error) {
+	nodes, err := wsq.Limit(2).All(setContextOp(ctx, wsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workschedule.Label}
+	default:
+		return nil, &NotSingularError{workschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) OnlyX(ctx context.Context) *WorkSchedule {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkSchedule ID in the query.
+// Returns a *NotSingularError when more than one WorkSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WorkScheduleQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = wsq.Limit(2).IDs(setContextOp(ctx, wsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workschedule.Label}
+	default:
+		err = &NotSingularError{workschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkSchedules.
+func (wsq *WorkScheduleQuery) All(ctx context.Context) ([]*WorkSchedule, error) {
+	ctx = setContextOp(ctx, wsq.ctx, "All")
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkSchedule, *WorkScheduleQuery]()
+	return withInterceptors[[]*WorkSchedule](ctx, wsq, qr, wsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) AllX(ctx context.Context) []*WorkSchedule {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkSchedule IDs.
+func (wsq *WorkScheduleQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if wsq.ctx.Unique == nil && wsq.path != nil {
+		wsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wsq.ctx, "IDs")
+	if err = wsq.Select(workschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WorkScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wsq.ctx, "Count")
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wsq, querierCount[*WorkScheduleQuery](), wsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WorkScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wsq.ctx, "Exist")
+	switch _, err := wsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WorkScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WorkScheduleQuery) Clone() *WorkScheduleQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WorkScheduleQuery{
+		config:                  wsq.config,
+		ctx:                     wsq.ctx.Clone(),
+		order:                   append([]workschedule.OrderOption{}, wsq.order...),
+		inters:                  append([]Interceptor{}, wsq.inters...),
+		predicates:              append([]predicate.WorkSchedule{}, wsq.predicates...),
+		withShiftAssignments:    wsq.withShiftAssignments.Clone(),
+		withAttendancePayPolicy: wsq.withAttendancePayPolicy.Clone(),
+		// clone intermediate query.
+		sql:  wsq.sql.Clone(),
+		path: wsq.path,
+	}
+}
+
+// WithShiftAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "shift_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkScheduleQuery) WithShiftAssignments(opts ...func(*ShiftAssignmentQuery)) *WorkScheduleQuery {
+	query := (&ShiftAssignmentClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withShiftAssignments = query
+	return wsq
+}
+
+// WithAttendancePayPolicy tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_pay_policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WorkScheduleQuery) WithAttendancePayPolicy(opts ...func(*AttendancePayPolicyQuery)) *WorkScheduleQuery {
+	query := (&AttendancePayPolicyClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withAttendancePayPolicy = query
+	return wsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkSchedule.Query().
+//		GroupBy(workschedule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsq *WorkScheduleQuery) GroupBy(field string, fields ...string) *WorkScheduleGroupBy {
+	wsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkScheduleGroupBy{build: wsq}
+	grbuild.flds = &wsq.ctx.Fields
+	grbuild.label = workschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WorkSchedule.Query().
+//		Select(workschedule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wsq *WorkScheduleQuery) Select(fields ...string) *WorkScheduleSelect {
+	wsq.ctx.Fields = append(wsq.ctx.Fields, fields...)
+	sbuild := &WorkScheduleSelect{WorkScheduleQuery: wsq}
+	sbuild.label = workschedule.Label
+	sbuild.flds, sbuild.scan = &wsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkScheduleSelect configured with the given aggregations.
+func (wsq *WorkScheduleQuery) Aggregate(fns ...AggregateFunc) *WorkScheduleSelect {
+	return wsq.Select().Aggregate(fns...)
+}
+
+func (wsq *WorkScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wsq.ctx.Fields {
+		if !workschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WorkScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkSchedule, error) {
+	var (
+		nodes       = []*WorkSchedule{}
+		_spec       = wsq.querySpec()
+		loadedTypes = [2]bool{
+			wsq.withShiftAssignments != nil,
+			wsq.withAttendancePayPolicy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkSchedule{config: wsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wsq.withShiftAssignments; query != nil {
+		if err := wsq.loadShiftAssignments(ctx, query, nodes,
+			func(n *WorkSchedule) { n.Edges.ShiftAssignments = []*ShiftAssignment{} },
+			func(n *WorkSchedule, e *ShiftAssignment) {
+				n.Edges.ShiftAssignments = append(n.Edges.ShiftAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := wsq.withAttendancePayPolicy; query != nil {
+		if err := wsq.loadAttendancePayPolicy(ctx, query, nodes, nil,
+			func(n *WorkSchedule, e *AttendancePayPolicy) { n.Edges.AttendancePayPolicy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wsq *WorkScheduleQuery) loadShiftAssignments(ctx context.Context, query *ShiftAssignmentQuery, nodes []*WorkSchedule, init func(*WorkSchedule), assign func(*WorkSchedule, *ShiftAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*WorkSchedule)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shiftassignment.FieldWorkScheduleID)
+	}
+	query.Where(predicate.ShiftAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workschedule.ShiftAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkScheduleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "work_schedule_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wsq *WorkScheduleQuery) loadAttendancePayPolicy(ctx context.Context, query *AttendancePayPolicyQuery, nodes []*WorkSchedule, init func(*WorkSchedule), assign func(*WorkSchedule, *AttendancePayPolicy)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*WorkSchedule)
+	for i := range nodes {
+		if nodes[i].AttendancePayPolicyID == nil {
+			continue
+		}
+		fk := *nodes[i].AttendancePayPolicyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(attendancepaypolicy.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attendance_pay_policy_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wsq *WorkScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	_spec.Node.Columns = wsq.ctx.Fields
+	if len(wsq.ctx.Fields) > 0 {
+		_spec.Unique = wsq.ctx.Unique != nil && *wsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WorkScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workschedule.Table, workschedule.Columns, sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64))
+	_spec.From = wsq.sql
+	if unique := wsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workschedule.FieldID)
+		for i := range fields {
+			if fields[i] != workschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wsq.withAttendancePayPolicy != nil {
+			_spec.Node.AddColumnOnce(workschedule.FieldAttendancePayPolicyID)
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WorkScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(workschedule.Table)
+	columns := wsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = workschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.ctx.Unique != nil && *wsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wsq.modifiers {
+		m(selector)
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wsq *WorkScheduleQuery) Modify(modifiers ...func(s *sql.Selector)) *WorkScheduleSelect {
+	wsq.modifiers = append(wsq.modifiers, modifiers...)
+	return wsq.Select()
+}
+
+// WorkScheduleGroupBy is the group-by builder for WorkSchedule entities.
+type WorkScheduleGroupBy struct {
+	selector
+	build *WorkScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WorkScheduleGroupBy) Aggregate(fns ...AggregateFunc) *WorkScheduleGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wsgb *WorkScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wsgb.build.ctx, "GroupBy")
+	if err := wsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkScheduleQuery, *WorkScheduleGroupBy](ctx, wsgb.build, wsgb, wsgb.build.inters, v)
+}
+
+func (wsgb *WorkScheduleGroupBy) sqlScan(ctx context.Context, root *WorkScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wsgb.flds)+len(wsgb.fns))
+		for _, f := range *wsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkScheduleSelect is the builder for selecting fields of WorkSchedule entities.
+type WorkScheduleSelect struct {
+	*WorkScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wss *WorkScheduleSelect) Aggregate(fns ...AggregateFunc) *WorkScheduleSelect {
+	wss.fns = append(wss.fns, fns...)
+	return wss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WorkScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wss.ctx, "Select")
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkScheduleQuery, *WorkScheduleSelect](ctx, wss.WorkScheduleQuery, wss, wss.inters, v)
+}
+
+func (wss *WorkScheduleSelect) sqlScan(ctx context.Context, root *WorkScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wss.fns))
+	for _, fn := range wss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wss *WorkScheduleSelect) Modify(modifiers ...func(s *sql.Selector)) *WorkScheduleSelect {
+	wss.modifiers = append(wss.modifiers, modifiers...)
+	return wss
+}
@@ -0,0 +1,867 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/predicate"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkScheduleUpdate is the builder for updating WorkSchedule entities.
+type WorkScheduleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkScheduleUpdate builder.
+func (wsu *WorkScheduleUpdate) Where(ps ...predicate.WorkSchedule) *WorkScheduleUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (wsu *WorkScheduleUpdate) SetModifiedAt(t time.Time) *WorkScheduleUpdate {
+	wsu.mutation.SetModifiedAt(t)
+	return wsu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wsu *WorkScheduleUpdate) SetDeletedAt(t time.Time) *WorkScheduleUpdate {
+	wsu.mutation.SetDeletedAt(t)
+	return wsu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wsu *WorkScheduleUpdate) SetNillableDeletedAt(t *time.Time) *WorkScheduleUpdate {
+	if t != nil {
+		wsu.SetDeletedAt(*t)
+	}
+	return wsu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wsu *WorkScheduleUpdate) ClearDeletedAt() *WorkScheduleUpdate {
+	wsu.mutation.ClearDeletedAt()
+	return wsu
+}
+
+// SetName sets the "name" field.
+func (wsu *WorkScheduleUpdate) SetName(s string) *WorkScheduleUpdate {
+	wsu.mutation.SetName(s)
+	return wsu
+}
+
+// SetStartTime sets the "start_time" field.
+func (wsu *WorkScheduleUpdate) SetStartTime(s string) *WorkScheduleUpdate {
+	wsu.mutation.SetStartTime(s)
+	return wsu
+}
+
+// SetEndTime sets the "end_time" field.
+func (wsu *WorkScheduleUpdate) SetEndTime(s string) *WorkScheduleUpdate {
+	wsu.mutation.SetEndTime(s)
+	return wsu
+}
+
+// SetWorkingDays sets the "working_days" field.
+func (wsu *WorkScheduleUpdate) SetWorkingDays(i []int) *WorkScheduleUpdate {
+	wsu.mutation.SetWorkingDays(i)
+	return wsu
+}
+
+// AppendWorkingDays appends i to the "working_days" field.
+func (wsu *WorkScheduleUpdate) AppendWorkingDays(i []int) *WorkScheduleUpdate {
+	wsu.mutation.AppendWorkingDays(i)
+	return wsu
+}
+
+// SetLateThresholdMinutes sets the "late_threshold_minutes" field.
+func (wsu *WorkScheduleUpdate) SetLateThresholdMinutes(i int) *WorkScheduleUpdate {
+	wsu.mutation.ResetLateThresholdMinutes()
+	wsu.mutation.SetLateThresholdMinutes(i)
+	return wsu
+}
+
+// SetNillableLateThresholdMinutes sets the "late_threshold_minutes" field if the given value is not nil.
+func (wsu *WorkScheduleUpdate) SetNillableLateThresholdMinutes(i *int) *WorkScheduleUpdate {
+	if i != nil {
+		wsu.SetLateThresholdMinutes(*i)
+	}
+	return wsu
+}
+
+// AddLateThresholdMinutes adds i to the "late_threshold_minutes" field.
+func (wsu *WorkScheduleUpdate) AddLateThresholdMinutes(i int) *WorkScheduleUpdate {
+	wsu.mutation.AddLateThresholdMinutes(i)
+	return wsu
+}
+
+// SetHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field.
+func (wsu *WorkScheduleUpdate) SetHalfDayThresholdMinutes(i int) *WorkScheduleUpdate {
+	wsu.mutation.ResetHalfDayThresholdMinutes()
+	wsu.mutation.SetHalfDayThresholdMinutes(i)
+	return wsu
+}
+
+// SetNillableHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field if the given value is not nil.
+func (wsu *WorkScheduleUpdate) SetNillableHalfDayThresholdMinutes(i *int) *WorkScheduleUpdate {
+	if i != nil {
+		wsu.SetHalfDayThresholdMinutes(*i)
+	}
+	return wsu
+}
+
+// AddHalfDayThresholdMinutes adds i to the "half_day_threshold_minutes" field.
+func (wsu *WorkScheduleUpdate) AddHalfDayThresholdMinutes(i int) *WorkScheduleUpdate {
+	wsu.mutation.AddHalfDayThresholdMinutes(i)
+	return wsu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wsu *WorkScheduleUpdate) SetIsDefault(b bool) *WorkScheduleUpdate {
+	wsu.mutation.SetIsDefault(b)
+	return wsu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wsu *WorkScheduleUpdate) SetNillableIsDefault(b *bool) *WorkScheduleUpdate {
+	if b != nil {
+		wsu.SetIsDefault(*b)
+	}
+	return wsu
+}
+
+// SetAttendancePayPolicyID sets the "attendance_pay_policy_id" field.
+func (wsu *WorkScheduleUpdate) SetAttendancePayPolicyID(u uint64) *WorkScheduleUpdate {
+	wsu.mutation.SetAttendancePayPolicyID(u)
+	return wsu
+}
+
+// SetNillableAttendancePayPolicyID sets the "attendance_pay_policy_id" field if the given value is not nil.
+func (wsu *WorkScheduleUpdate) SetNillableAttendancePayPolicyID(u *uint64) *WorkScheduleUpdate {
+	if u != nil {
+		wsu.SetAttendancePayPolicyID(*u)
+	}
+	return wsu
+}
+
+// ClearAttendancePayPolicyID clears the value of the "attendance_pay_policy_id" field.
+func (wsu *WorkScheduleUpdate) ClearAttendancePayPolicyID() *WorkScheduleUpdate {
+	wsu.mutation.ClearAttendancePayPolicyID()
+	return wsu
+}
+
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (wsu *WorkScheduleUpdate) AddShiftAssignmentIDs(ids ...uint64) *WorkScheduleUpdate {
+	wsu.mutation.AddShiftAssignmentIDs(ids...)
+	return wsu
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (wsu *WorkScheduleUpdate) AddShiftAssignments(s ...*ShiftAssignment) *WorkScheduleUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wsu.AddShiftAssignmentIDs(ids...)
+}
+
+// SetAttendancePayPolicy sets the "attendance_pay_policy" edge to the AttendancePayPolicy entity.
+func (wsu *WorkScheduleUpdate) SetAttendancePayPolicy(a *AttendancePayPolicy) *WorkScheduleUpdate {
+	return wsu.SetAttendancePayPolicyID(a.ID)
+}
+
+// Mutation returns the WorkScheduleMutation object of the builder.
+func (wsu *WorkScheduleUpdate) Mutation() *WorkScheduleMutation {
+	return wsu.mutation
+}
+
+// ClearShiftAssignments clears all "shift_assignments" edges to the ShiftAssignment entity.
+func (wsu *WorkScheduleUpdate) ClearShiftAssignments() *WorkScheduleUpdate {
+	wsu.mutation.ClearShiftAssignments()
+	return wsu
+}
+
+// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to ShiftAssignment entities by IDs.
+func (wsu *WorkScheduleUpdate) RemoveShiftAssignmentIDs(ids ...uint64) *WorkScheduleUpdate {
+	wsu.mutation.RemoveShiftAssignmentIDs(ids...)
+	return wsu
+}
+
+// RemoveShiftAssignments removes "shift_assignments" edges to ShiftAssignment entities.
+func (wsu *WorkScheduleUpdate) RemoveShiftAssignments(s ...*ShiftAssignment) *WorkScheduleUpdate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wsu.RemoveShiftAssignmentIDs(ids...)
+}
+
+// ClearAttendancePayPolicy clears the "attendance_pay_policy" edge to the AttendancePayPolicy entity.
+func (wsu *WorkScheduleUpdate) ClearAttendancePayPolicy() *WorkScheduleUpdate {
+	wsu.mutation.ClearAttendancePayPolicy()
+	return wsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WorkScheduleUpdate) Save(ctx context.Context) (int, error) {
+	wsu.defaults()
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WorkScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WorkScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WorkScheduleUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WorkScheduleUpdate) defaults() {
+	if _, ok := wsu.mutation.ModifiedAt(); !ok {
+		v := workschedule.UpdateDefaultModifiedAt()
+		wsu.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WorkScheduleUpdate) check() error {
+	if v, ok := wsu.mutation.Name(); ok {
+		if err := workschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.StartTime(); ok {
+		if err := workschedule.StartTimeValidator(v); err != nil {
+			return &ValidationError{Name: "start_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.start_time": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.EndTime(); ok {
+		if err := workschedule.EndTimeValidator(v); err != nil {
+			return &ValidationError{Name: "end_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.end_time": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.LateThresholdMinutes(); ok {
+		if err := workschedule.LateThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "late_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.late_threshold_minutes": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.HalfDayThresholdMinutes(); ok {
+		if err := workschedule.HalfDayThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "half_day_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.half_day_threshold_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsu *WorkScheduleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkScheduleUpdate {
+	wsu.modifiers = append(wsu.modifiers, modifiers...)
+	return wsu
+}
+
+func (wsu *WorkScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workschedule.Table, workschedule.Columns, sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.ModifiedAt(); ok {
+		_spec.SetField(workschedule.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := wsu.mutation.DeletedAt(); ok {
+		_spec.SetField(workschedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wsu.mutation.DeletedAtCleared() {
+		_spec.ClearField(workschedule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wsu.mutation.Name(); ok {
+		_spec.SetField(workschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.StartTime(); ok {
+		_spec.SetField(workschedule.FieldStartTime, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.EndTime(); ok {
+		_spec.SetField(workschedule.FieldEndTime, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.WorkingDays(); ok {
+		_spec.SetField(workschedule.FieldWorkingDays, field.TypeJSON, value)
+	}
+	if value, ok := wsu.mutation.AppendedWorkingDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workschedule.FieldWorkingDays, value)
+		})
+	}
+	if value, ok := wsu.mutation.LateThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldLateThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedLateThresholdMinutes(); ok {
+		_spec.AddField(workschedule.FieldLateThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.HalfDayThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldHalfDayThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedHalfDayThresholdMinutes(); ok {
+		_spec.AddField(workschedule.FieldHalfDayThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.IsDefault(); ok {
+		_spec.SetField(workschedule.FieldIsDefault, field.TypeBool, value)
+	}
+	if wsu.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedShiftAssignmentsIDs(); len(nodes) > 0 && !wsu.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsu.mutation.AttendancePayPolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workschedule.AttendancePayPolicyTable,
+			Columns: []string{workschedule.AttendancePayPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.AttendancePayPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workschedule.AttendancePayPolicyTable,
+			Columns: []string{workschedule.AttendancePayPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WorkScheduleUpdateOne is the builder for updating a single WorkSchedule entity.
+type WorkScheduleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModifiedAt sets the "modified_at" field.
+func (wsuo *WorkScheduleUpdateOne) SetModifiedAt(t time.Time) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetModifiedAt(t)
+	return wsuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wsuo *WorkScheduleUpdateOne) SetDeletedAt(t time.Time) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetDeletedAt(t)
+	return wsuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wsuo *WorkScheduleUpdateOne) SetNillableDeletedAt(t *time.Time) *WorkScheduleUpdateOne {
+	if t != nil {
+		wsuo.SetDeletedAt(*t)
+	}
+	return wsuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wsuo *WorkScheduleUpdateOne) ClearDeletedAt() *WorkScheduleUpdateOne {
+	wsuo.mutation.ClearDeletedAt()
+	return wsuo
+}
+
+// SetName sets the "name" field.
+func (wsuo *WorkScheduleUpdateOne) SetName(s string) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetName(s)
+	return wsuo
+}
+
+// SetStartTime sets the "start_time" field.
+func (wsuo *WorkScheduleUpdateOne) SetStartTime(s string) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetStartTime(s)
+	return wsuo
+}
+
+// SetEndTime sets the "end_time" field.
+func (wsuo *WorkScheduleUpdateOne) SetEndTime(s string) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetEndTime(s)
+	return wsuo
+}
+
+// SetWorkingDays sets the "working_days" field.
+func (wsuo *WorkScheduleUpdateOne) SetWorkingDays(i []int) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetWorkingDays(i)
+	return wsuo
+}
+
+// AppendWorkingDays appends i to the "working_days" field.
+func (wsuo *WorkScheduleUpdateOne) AppendWorkingDays(i []int) *WorkScheduleUpdateOne {
+	wsuo.mutation.AppendWorkingDays(i)
+	return wsuo
+}
+
+// SetLateThresholdMinutes sets the "late_threshold_minutes" field.
+func (wsuo *WorkScheduleUpdateOne) SetLateThresholdMinutes(i int) *WorkScheduleUpdateOne {
+	wsuo.mutation.ResetLateThresholdMinutes()
+	wsuo.mutation.SetLateThresholdMinutes(i)
+	return wsuo
+}
+
+// SetNillableLateThresholdMinutes sets the "late_threshold_minutes" field if the given value is not nil.
+func (wsuo *WorkScheduleUpdateOne) SetNillableLateThresholdMinutes(i *int) *WorkScheduleUpdateOne {
+	if i != nil {
+		wsuo.SetLateThresholdMinutes(*i)
+	}
+	return wsuo
+}
+
+// AddLateThresholdMinutes adds i to the "late_threshold_minutes" field.
+func (wsuo *WorkScheduleUpdateOne) AddLateThresholdMinutes(i int) *WorkScheduleUpdateOne {
+	wsuo.mutation.AddLateThresholdMinutes(i)
+	return wsuo
+}
+
+// SetHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field.
+func (wsuo *WorkScheduleUpdateOne) SetHalfDayThresholdMinutes(i int) *WorkScheduleUpdateOne {
+	wsuo.mutation.ResetHalfDayThresholdMinutes()
+	wsuo.mutation.SetHalfDayThresholdMinutes(i)
+	return wsuo
+}
+
+// SetNillableHalfDayThresholdMinutes sets the "half_day_threshold_minutes" field if the given value is not nil.
+func (wsuo *WorkScheduleUpdateOne) SetNillableHalfDayThresholdMinutes(i *int) *WorkScheduleUpdateOne {
+	if i != nil {
+		wsuo.SetHalfDayThresholdMinutes(*i)
+	}
+	return wsuo
+}
+
+// AddHalfDayThresholdMinutes adds i to the "half_day_threshold_minutes" field.
+func (wsuo *WorkScheduleUpdateOne) AddHalfDayThresholdMinutes(i int) *WorkScheduleUpdateOne {
+	wsuo.mutation.AddHalfDayThresholdMinutes(i)
+	return wsuo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (wsuo *WorkScheduleUpdateOne) SetIsDefault(b bool) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetIsDefault(b)
+	return wsuo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (wsuo *WorkScheduleUpdateOne) SetNillableIsDefault(b *bool) *WorkScheduleUpdateOne {
+	if b != nil {
+		wsuo.SetIsDefault(*b)
+	}
+	return wsuo
+}
+
+// SetAttendancePayPolicyID sets the "attendance_pay_policy_id" field.
+func (wsuo *WorkScheduleUpdateOne) SetAttendancePayPolicyID(u uint64) *WorkScheduleUpdateOne {
+	wsuo.mutation.SetAttendancePayPolicyID(u)
+	return wsuo
+}
+
+// SetNillableAttendancePayPolicyID sets the "attendance_pay_policy_id" field if the given value is not nil.
+func (wsuo *WorkScheduleUpdateOne) SetNillableAttendancePayPolicyID(u *uint64) *WorkScheduleUpdateOne {
+	if u != nil {
+		wsuo.SetAttendancePayPolicyID(*u)
+	}
+	return wsuo
+}
+
+// ClearAttendancePayPolicyID clears the value of the "attendance_pay_policy_id" field.
+func (wsuo *WorkScheduleUpdateOne) ClearAttendancePayPolicyID() *WorkScheduleUpdateOne {
+	wsuo.mutation.ClearAttendancePayPolicyID()
+	return wsuo
+}
+
+// AddShiftAssignmentIDs adds the "shift_assignments" edge to the ShiftAssignment entity by IDs.
+func (wsuo *WorkScheduleUpdateOne) AddShiftAssignmentIDs(ids ...uint64) *WorkScheduleUpdateOne {
+	wsuo.mutation.AddShiftAssignmentIDs(ids...)
+	return wsuo
+}
+
+// AddShiftAssignments adds the "shift_assignments" edges to the ShiftAssignment entity.
+func (wsuo *WorkScheduleUpdateOne) AddShiftAssignments(s ...*ShiftAssignment) *WorkScheduleUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wsuo.AddShiftAssignmentIDs(ids...)
+}
+
+// SetAttendancePayPolicy sets the "attendance_pay_policy" edge to the AttendancePayPolicy entity.
+func (wsuo *WorkScheduleUpdateOne) SetAttendancePayPolicy(a *AttendancePayPolicy) *WorkScheduleUpdateOne {
+	return wsuo.SetAttendancePayPolicyID(a.ID)
+}
+
+// Mutation returns the WorkScheduleMutation object of the builder.
+func (wsuo *WorkScheduleUpdateOne) Mutation() *WorkScheduleMutation {
+	return wsuo.mutation
+}
+
+// ClearShiftAssignments clears all "shift_assignments" edges to the ShiftAssignment entity.
+func (wsuo *WorkScheduleUpdateOne) ClearShiftAssignments() *WorkScheduleUpdateOne {
+	wsuo.mutation.ClearShiftAssignments()
+	return wsuo
+}
+
+// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to ShiftAssignment entities by IDs.
+func (wsuo *WorkScheduleUpdateOne) RemoveShiftAssignmentIDs(ids ...uint64) *WorkScheduleUpdateOne {
+	wsuo.mutation.RemoveShiftAssignmentIDs(ids...)
+	return wsuo
+}
+
+// RemoveShiftAssignments removes "shift_assignments" edges to ShiftAssignment entities.
+func (wsuo *WorkScheduleUpdateOne) RemoveShiftAssignments(s ...*ShiftAssignment) *WorkScheduleUpdateOne {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wsuo.RemoveShiftAssignmentIDs(ids...)
+}
+
+// ClearAttendancePayPolicy clears the "attendance_pay_policy" edge to the AttendancePayPolicy entity.
+func (wsuo *WorkScheduleUpdateOne) ClearAttendancePayPolicy() *WorkScheduleUpdateOne {
+	wsuo.mutation.ClearAttendancePayPolicy()
+	return wsuo
+}
+
+// Where appends a list predicates to the WorkScheduleUpdate builder.
+func (wsuo *WorkScheduleUpdateOne) Where(ps ...predicate.WorkSchedule) *WorkScheduleUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WorkScheduleUpdateOne) Select(field string, fields ...string) *WorkScheduleUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WorkSchedule entity.
+func (wsuo *WorkScheduleUpdateOne) Save(ctx context.Context) (*WorkSchedule, error) {
+	wsuo.defaults()
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WorkScheduleUpdateOne) SaveX(ctx context.Context) *WorkSchedule {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WorkScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WorkScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WorkScheduleUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.ModifiedAt(); !ok {
+		v := workschedule.UpdateDefaultModifiedAt()
+		wsuo.mutation.SetModifiedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WorkScheduleUpdateOne) check() error {
+	if v, ok := wsuo.mutation.Name(); ok {
+		if err := workschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.StartTime(); ok {
+		if err := workschedule.StartTimeValidator(v); err != nil {
+			return &ValidationError{Name: "start_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.start_time": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.EndTime(); ok {
+		if err := workschedule.EndTimeValidator(v); err != nil {
+			return &ValidationError{Name: "end_time", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.end_time": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.LateThresholdMinutes(); ok {
+		if err := workschedule.LateThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "late_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.late_threshold_minutes": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.HalfDayThresholdMinutes(); ok {
+		if err := workschedule.HalfDayThresholdMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "half_day_threshold_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.half_day_threshold_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsuo *WorkScheduleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkScheduleUpdateOne {
+	wsuo.modifiers = append(wsuo.modifiers, modifiers...)
+	return wsuo
+}
+
+func (wsuo *WorkScheduleUpdateOne) sqlSave(ctx context.Context) (_node *WorkSchedule, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workschedule.Table, workschedule.Columns, sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeUint64))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workschedule.FieldID)
+		for _, f := range fields {
+			if !workschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.ModifiedAt(); ok {
+		_spec.SetField(workschedule.FieldModifiedAt, field.TypeTime, value)
+	}
+	if value, ok := wsuo.mutation.DeletedAt(); ok {
+		_spec.SetField(workschedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wsuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(workschedule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wsuo.mutation.Name(); ok {
+		_spec.SetField(workschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.StartTime(); ok {
+		_spec.SetField(workschedule.FieldStartTime, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.EndTime(); ok {
+		_spec.SetField(workschedule.FieldEndTime, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.WorkingDays(); ok {
+		_spec.SetField(workschedule.FieldWorkingDays, field.TypeJSON, value)
+	}
+	if value, ok := wsuo.mutation.AppendedWorkingDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workschedule.FieldWorkingDays, value)
+		})
+	}
+	if value, ok := wsuo.mutation.LateThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldLateThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedLateThresholdMinutes(); ok {
+		_spec.AddField(workschedule.FieldLateThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.HalfDayThresholdMinutes(); ok {
+		_spec.SetField(workschedule.FieldHalfDayThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedHalfDayThresholdMinutes(); ok {
+		_spec.AddField(workschedule.FieldHalfDayThresholdMinutes, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.IsDefault(); ok {
+		_spec.SetField(workschedule.FieldIsDefault, field.TypeBool, value)
+	}
+	if wsuo.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedShiftAssignmentsIDs(); len(nodes) > 0 && !wsuo.mutation.ShiftAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.ShiftAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.ShiftAssignmentsTable,
+			Columns: []string{workschedule.ShiftAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftassignment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsuo.mutation.AttendancePayPolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workschedule.AttendancePayPolicyTable,
+			Columns: []string{workschedule.AttendancePayPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.AttendancePayPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workschedule.AttendancePayPolicyTable,
+			Columns: []string{workschedule.AttendancePayPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancepaypolicy.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsuo.modifiers...)
+	_node = &WorkSchedule{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -56,3 +56,22 @@ func (e *BusinessLogicError) Dig() *BusinessLogicError {
 	}
 	return e
 }
+
+// AsBusinessLogicError finds the BusinessLogicError in the chain of err, without logging it like Unwrap does
+func AsBusinessLogicError(err error) (*BusinessLogicError, bool) {
+	for err != nil {
+		if errStruct, ok := err.(*BusinessLogicError); ok {
+			return errStruct, true
+		}
+		err = errors.Unwrap(err)
+	}
+	return nil, false
+}
+
+// Detail Returns the cause of the error for the client, falling back to the reason of the error code
+func (e *BusinessLogicError) Detail() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return BusinessLogicReason(e.ErrorCode).Message
+}
@@ -22,6 +22,8 @@ const (
 	InvalidValue      = 10009
 	InvalidArgument   = 10010
 	OutboundFailed    = 10011
+	PeriodLocked      = 10012
+	PayrollApproved   = 10013
 
 	//
 	ErpDataAlreadyExist  = 20001
@@ -51,6 +53,8 @@ var businessLogicReason = map[int]ErrorLogic{
 	InvalidValue:      {ErrCode: InvalidValue, HttpCode: http.StatusInternalServerError, Message: "invalid value"},
 	InvalidArgument:   {ErrCode: InvalidArgument, HttpCode: http.StatusUnprocessableEntity, Message: "invalid argument"},
 	OutboundFailed:    {ErrCode: OutboundFailed, HttpCode: http.StatusInternalServerError, Message: "call outbound failed"},
+	PeriodLocked:      {ErrCode: PeriodLocked, HttpCode: http.StatusConflict, Message: "period is locked"},
+	PayrollApproved:   {ErrCode: PayrollApproved, HttpCode: http.StatusConflict, Message: "payroll run is approved"},
 
 	//
 	ErpDataAlreadyExist:  {ErrCode: DataAlreadyExist, HttpCode: http.StatusInternalServerError, Message: "data is already exist"},
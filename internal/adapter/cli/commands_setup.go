@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/attendance"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RunCommand runs a subcommand given on the command line instead of starting the server
+func RunCommand(ctx context.Context, args []string, connDb *ent.Client, redisClient *redis.Client) error {
+	switch args[0] {
+	case "import-punches":
+		return importPunches(ctx, args[1:], connDb, redisClient)
+	default:
+		return fmt.Errorf("unknown command %q, available commands: import-punches", args[0])
+	}
+}
+
+// importPunches imports punch logs of the fingerprint terminals and prints the import report as JSON.
+//
+//	mceasy import-punches [-dry-run] <file>...
+//
+// A file named - is read from stdin.
+func importPunches(ctx context.Context, args []string, connDb *ent.Client, redisClient *redis.Client) error {
+	flags := flag.NewFlagSet("import-punches", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "preview the import without storing it")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: import-punches [-dry-run] <file>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("no punch log given")
+	}
+
+	attendanceService := attendance.InitializedAttendanceService(connDb, redisClient)
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	for _, path := range flags.Args() {
+		var file io.ReadCloser = os.Stdin
+		if path != "-" {
+			opened, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("failed to open punch log: %w", err)
+			}
+			file = opened
+		}
+
+		result, err := attendanceService.ImportPunchLog(ctx, file, *dryRun)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to import %s: %w", path, err)
+		}
+
+		if err := encoder.Encode(map[string]interface{}{"file": path, "result": result}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package iclock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	attendanceDto "mceasy/internal/applications/attendance/dto"
+	attendanceService "mceasy/internal/applications/attendance/service"
+	"mceasy/internal/applications/device/dto"
+	deviceService "mceasy/internal/applications/device/service"
+	"mceasy/internal/component/biometric"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// errMissingSerialNumber is returned for requests without a usable serial number
+var errMissingSerialNumber = errors.New("missing or invalid SN")
+
+// maxPushSize limits a single upload of a terminal, terminals send their backlog in batches far below it
+const maxPushSize = 8 << 20
+
+// Gateway implements the ADMS push protocol ("iclock") that fingerprint terminals use to upload punches.
+// Terminals identify themselves by serial number, only active terminals registered in the device table that
+// connect from one of their allowed networks are served and their ATTLOG records are recorded as punches
+// through the attendance service.
+type Gateway struct {
+	deviceService     deviceService.DeviceService
+	attendanceService attendanceService.AttendanceService
+	now               func() time.Time
+}
+
+// NewGateway creates a new terminal gateway instance
+func NewGateway(deviceService deviceService.DeviceService, attendanceService attendanceService.AttendanceService) *Gateway {
+	return &Gateway{
+		deviceService:     deviceService,
+		attendanceService: attendanceService,
+		now:               time.Now,
+	}
+}
+
+// RegisterGatewayRoutes registers the push protocol routes, terminals call them on the server root
+func RegisterGatewayRoutes(e *echo.Echo, gateway *Gateway) {
+	e.GET("/iclock/cdata", gateway.Handshake)
+	e.POST("/iclock/cdata", gateway.ReceiveData)
+	e.GET("/iclock/getrequest", gateway.GetRequest)
+	e.POST("/iclock/devicecmd", gateway.DeviceCommand)
+}
+
+// Handshake answers the first request of a terminal with the options it should push with
+func (g *Gateway) Handshake(ctx echo.Context) error {
+	device, err := g.authenticate(ctx)
+	if err != nil {
+		return g.refuse(ctx, err)
+	}
+
+	attLogStamp := device.AttLogStamp
+	if attLogStamp == "" {
+		attLogStamp = "None"
+	}
+	_, offset := g.now().In(clockLocation(device)).Zone()
+
+	options := []string{
+		"GET OPTION FROM: " + device.SerialNumber,
+		"ATTLOGStamp=" + attLogStamp,
+		// Operation logs and photos are not used, a high stamp keeps the terminal from sending them
+		"OPERLOGStamp=9999",
+		"ATTPHOTOStamp=None",
+		"ErrorDelay=30",
+		"Delay=10",
+		"TransTimes=00:00;14:05",
+		"TransInterval=1",
+		"TransFlag=TransData AttLog",
+		fmt.Sprintf("TimeZone=%d", offset/3600),
+		"Realtime=1",
+		"Encrypt=None",
+	}
+
+	return ctx.String(http.StatusOK, strings.Join(options, "\n"))
+}
+
+// ReceiveData accepts the records a terminal uploads, ATTLOG records are recorded as punches and every other
+// table is acknowledged without being stored. Only the punches that were recorded are acknowledged.
+func (g *Gateway) ReceiveData(ctx echo.Context) error {
+	device, err := g.authenticate(ctx)
+	if err != nil {
+		return g.refuse(ctx, err)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxPushSize))
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "ERROR: "+err.Error())
+	}
+
+	if !strings.EqualFold(ctx.QueryParam("table"), "ATTLOG") {
+		return ctx.String(http.StatusOK, fmt.Sprintf("OK: %d", countLines(body)))
+	}
+
+	attLog, err := biometric.ParseAttLog(bytes.NewReader(body))
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "ERROR: "+err.Error())
+	}
+	for _, lineErr := range attLog.Errors {
+		log.Warnf("iclock %s: skipped ATTLOG %s", device.SerialNumber, lineErr.Error())
+	}
+
+	receivedAt := g.now()
+	result, err := g.attendanceService.RecordDevicePunches(ctx.Request().Context(), attLog.Punches, deviceLocation(device))
+	if err != nil {
+		// The terminal sends the records again when they are not acknowledged
+		log.Errorf("iclock %s: failed to record punches: %v", device.SerialNumber, err)
+		return ctx.String(http.StatusInternalServerError, "ERROR: failed to record punches")
+	}
+
+	// Punches that were not recorded are stored for review and left out of the acknowledgement, the upload is
+	// refused when they cannot be stored so the terminal sends it again
+	rejections := punchRejections(attLog.Punches, result, clockLocation(device))
+	for _, rejection := range rejections {
+		log.Warnf("iclock %s: rejected punch of PIN %s at %s: %s", device.SerialNumber, rejection.DevicePIN, rejection.PunchTime.Format("2006-01-02 15:04:05"), rejection.Reason)
+	}
+	if err := g.deviceService.RecordPunchRejections(ctx.Request().Context(), device.ID, rejections); err != nil {
+		log.Errorf("iclock %s: %v", device.SerialNumber, err)
+		return ctx.String(http.StatusInternalServerError, "ERROR: failed to record rejected punches")
+	}
+
+	push := &dto.DevicePush{
+		Stamp:      ctx.QueryParam("Stamp"),
+		Punches:    len(attLog.Punches),
+		ReceivedAt: receivedAt,
+	}
+	loc := clockLocation(device)
+	for _, punch := range attLog.Punches {
+		if punchTime := terminalTime(punch, loc); punchTime.After(push.NewestPunch) {
+			push.NewestPunch = punchTime
+		}
+	}
+	if err := g.deviceService.RecordAttLogPush(ctx.Request().Context(), device.ID, push); err != nil {
+		log.Errorf("iclock %s: %v", device.SerialNumber, err)
+	}
+
+	return ctx.String(http.StatusOK, fmt.Sprintf("OK: %d", len(attLog.Punches)-len(rejections)))
+}
+
+// GetRequest is polled by terminals for pending commands, there are none so it only records the contact
+func (g *Gateway) GetRequest(ctx echo.Context) error {
+	if _, err := g.authenticate(ctx); err != nil {
+		return g.refuse(ctx, err)
+	}
+
+	return ctx.String(http.StatusOK, "OK")
+}
+
+// DeviceCommand receives the results of commands executed by a terminal
+func (g *Gateway) DeviceCommand(ctx echo.Context) error {
+	if _, err := g.authenticate(ctx); err != nil {
+		return g.refuse(ctx, err)
+	}
+
+	return ctx.String(http.StatusOK, "OK")
+}
+
+// authenticate identifies the terminal by its serial number and records that it was seen
+func (g *Gateway) authenticate(ctx echo.Context) (*dto.DeviceResponse, error) {
+	serialNumber := strings.TrimSpace(ctx.QueryParam("SN"))
+	if serialNumber == "" || len(serialNumber) > 64 {
+		return nil, errMissingSerialNumber
+	}
+
+	return g.deviceService.AuthenticateDevice(ctx.Request().Context(), &dto.DeviceContact{
+		SerialNumber: serialNumber,
+		PushVersion:  ctx.QueryParam("pushver"),
+		DeviceInfo:   ctx.QueryParam("INFO"),
+		IPAddress:    ctx.RealIP(),
+		SeenAt:       g.now(),
+	})
+}
+
+// refuse answers a request that is not served, terminals retry after the error delay
+func (g *Gateway) refuse(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, errMissingSerialNumber):
+		return ctx.String(http.StatusBadRequest, "ERROR: "+err.Error())
+	case errors.Is(err, deviceService.ErrDevicePending), errors.Is(err, deviceService.ErrDeviceDisabled):
+		return ctx.String(http.StatusForbidden, "ERROR: "+err.Error())
+	case errors.Is(err, deviceService.ErrDeviceAddressNotAllowed):
+		log.Warnf("iclock %s: refused request from %s", ctx.QueryParam("SN"), ctx.RealIP())
+		return ctx.String(http.StatusForbidden, "ERROR: "+err.Error())
+	case errors.Is(err, deviceService.ErrTooManyPendingDevices):
+		return ctx.String(http.StatusTooManyRequests, "ERROR: "+err.Error())
+	default:
+		log.Errorf("iclock %s: %v", ctx.QueryParam("SN"), err)
+		return ctx.String(http.StatusInternalServerError, "ERROR: device lookup failed")
+	}
+}
+
+// deviceLocation is the timezone of the terminal clock, nil when neither the terminal nor its office has one
+// so punches are read in each employee's timezone
+func deviceLocation(device *dto.DeviceResponse) *time.Location {
+	if device.Timezone == "" && device.OfficeTimezone == "" {
+		return nil
+	}
+
+	return calendar.ResolveLocation(device.Timezone, device.OfficeTimezone)
+}
+
+// clockLocation is the timezone the terminal clock is assumed to run in for clock information
+func clockLocation(device *dto.DeviceResponse) *time.Location {
+	if loc := deviceLocation(device); loc != nil {
+		return loc
+	}
+
+	return helper.ApplicationLocation()
+}
+
+// punchRejections collects the punches of an upload that were not recorded, those of unknown PINs and those of
+// rejected days, with the reason they were rejected. Punch times are read from the terminal clock in loc.
+func punchRejections(punches []biometric.Punch, result *attendanceDto.PunchImportResult, loc *time.Location) []dto.PunchRejection {
+	reasons := make(map[int]string)
+	for _, punchErr := range result.Errors {
+		if punchErr.Line > 0 {
+			reasons[punchErr.Line] = punchErr.Message
+		}
+	}
+	for _, day := range result.Days {
+		if day.Action != "rejected" {
+			continue
+		}
+		for _, line := range day.Lines {
+			reasons[line] = day.Error
+		}
+	}
+
+	var rejections []dto.PunchRejection
+	for _, punch := range punches {
+		reason, ok := reasons[punch.Line]
+		if !ok {
+			continue
+		}
+		rejections = append(rejections, dto.PunchRejection{
+			DevicePIN:  punch.PIN,
+			PunchTime:  terminalTime(punch, loc),
+			PunchState: int(punch.State),
+			VerifyMode: punch.VerifyMode,
+			Reason:     reason,
+		})
+	}
+
+	return rejections
+}
+
+// terminalTime reads the wall clock time of a punch in the timezone of the terminal clock
+func terminalTime(punch biometric.Punch, loc *time.Location) time.Time {
+	wall := punch.Time
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+}
+
+func countLines(body []byte) int {
+	count := 0
+	for _, line := range strings.Split(string(body), "\n") {
+		if strings.TrimSpace(line) != "" {
+			count++
+		}
+	}
+	return count
+}
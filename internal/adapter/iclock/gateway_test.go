@@ -0,0 +1,161 @@
+package iclock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	attendanceDto "mceasy/internal/applications/attendance/dto"
+	attendanceService "mceasy/internal/applications/attendance/service"
+	"mceasy/internal/applications/device/dto"
+	deviceService "mceasy/internal/applications/device/service"
+	"mceasy/internal/component/biometric"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeviceService struct {
+	deviceService.DeviceService
+	devices    map[string]*dto.DeviceResponse
+	pushes     []*dto.DevicePush
+	rejections []dto.PunchRejection
+	rejectErr  error
+}
+
+func (f *fakeDeviceService) AuthenticateDevice(_ context.Context, contact *dto.DeviceContact) (*dto.DeviceResponse, error) {
+	device, ok := f.devices[contact.SerialNumber]
+	if !ok {
+		return nil, deviceService.ErrDevicePending
+	}
+	return device, nil
+}
+
+func (f *fakeDeviceService) RecordAttLogPush(_ context.Context, _ uint64, push *dto.DevicePush) error {
+	f.pushes = append(f.pushes, push)
+	return nil
+}
+
+func (f *fakeDeviceService) RecordPunchRejections(_ context.Context, _ uint64, rejections []dto.PunchRejection) error {
+	if f.rejectErr != nil {
+		return f.rejectErr
+	}
+	f.rejections = append(f.rejections, rejections...)
+	return nil
+}
+
+type fakeAttendanceService struct {
+	attendanceService.AttendanceService
+	punches []biometric.Punch
+	loc     *time.Location
+	result  *attendanceDto.PunchImportResult
+}
+
+func (f *fakeAttendanceService) RecordDevicePunches(_ context.Context, punches []biometric.Punch, loc *time.Location) (*attendanceDto.PunchImportResult, error) {
+	f.punches = append(f.punches, punches...)
+	f.loc = loc
+	if f.result != nil {
+		return f.result, nil
+	}
+	return &attendanceDto.PunchImportResult{}, nil
+}
+
+func TestGateway(t *testing.T) {
+	devices := &fakeDeviceService{devices: map[string]*dto.DeviceResponse{
+		"CKJX201960001": {ID: 1, SerialNumber: "CKJX201960001", Status: "active", Timezone: "Asia/Makassar", AttLogStamp: "812345"},
+	}}
+	attendance := &fakeAttendanceService{}
+
+	gateway := NewGateway(devices, attendance)
+	gateway.now = func() time.Time { return time.Date(2025, time.August, 11, 0, 55, 10, 0, time.UTC) }
+
+	e := echo.New()
+	RegisterGatewayRoutes(e, gateway)
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("handshake returns the push options", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/iclock/cdata?SN=CKJX201960001&options=all&pushver=2.4.1", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "GET OPTION FROM: CKJX201960001")
+		assert.Contains(t, rec.Body.String(), "ATTLOGStamp=812345")
+		assert.Contains(t, rec.Body.String(), "TimeZone=8")
+	})
+
+	t.Run("unknown terminals are refused", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/iclock/cdata?SN=UNKNOWN&options=all", "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = serve(http.MethodGet, "/iclock/getrequest", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("attendance records are recorded as punches", func(t *testing.T) {
+		body := "1001\t2025-08-11 08:55:02\t0\t1\t0\t0\t0\n"
+		rec := serve(http.MethodPost, "/iclock/cdata?SN=CKJX201960001&table=ATTLOG&Stamp=812400", body)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "OK: 1", rec.Body.String())
+
+		require.Len(t, attendance.punches, 1)
+		assert.Equal(t, "1001", attendance.punches[0].PIN)
+		assert.Equal(t, biometric.StateCheckIn, attendance.punches[0].State)
+		assert.Equal(t, 1, attendance.punches[0].VerifyMode)
+		assert.Equal(t, "Asia/Makassar", attendance.loc.String())
+
+		// 08:55:02 WITA is 00:55:02 UTC, eight seconds before the push was received
+		require.Len(t, devices.pushes, 1)
+		assert.Equal(t, "812400", devices.pushes[0].Stamp)
+		assert.Equal(t, -8*time.Second, devices.pushes[0].NewestPunch.Sub(devices.pushes[0].ReceivedAt))
+	})
+
+	t.Run("rejected punches are stored and not acknowledged", func(t *testing.T) {
+		attendance.result = &attendanceDto.PunchImportResult{
+			Errors: []attendanceDto.PunchImportError{{Line: 2, DevicePIN: "9999", Message: "no employee has device PIN 9999"}},
+			Days: []attendanceDto.PunchImportDay{
+				{EmployeeID: 1, Lines: []int{1}, Action: "create"},
+				{EmployeeID: 2, Lines: []int{3}, Action: "rejected", Error: "payroll period 2025-07 is locked"},
+			},
+		}
+		t.Cleanup(func() {
+			attendance.result = nil
+			devices.rejectErr = nil
+		})
+
+		body := "1001\t2025-08-11 17:05:00\t1\t1\t0\t0\t0\n" +
+			"9999\t2025-08-11 17:06:00\t1\t1\t0\t0\t0\n" +
+			"1002\t2025-07-31 17:00:00\t1\t1\t0\t0\t0\n"
+		rec := serve(http.MethodPost, "/iclock/cdata?SN=CKJX201960001&table=ATTLOG&Stamp=812500", body)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "OK: 1", rec.Body.String())
+
+		require.Len(t, devices.rejections, 2)
+		assert.Equal(t, "9999", devices.rejections[0].DevicePIN)
+		assert.Equal(t, "no employee has device PIN 9999", devices.rejections[0].Reason)
+		assert.Equal(t, "1002", devices.rejections[1].DevicePIN)
+		assert.Equal(t, time.Date(2025, time.July, 31, 17, 0, 0, 0, time.FixedZone("WITA", 8*60*60)).Unix(), devices.rejections[1].PunchTime.Unix())
+
+		// The terminal sends the upload again when the rejected punches cannot be stored
+		devices.rejectErr = errors.New("database is down")
+		rec = serve(http.MethodPost, "/iclock/cdata?SN=CKJX201960001&table=ATTLOG&Stamp=812500", body)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Len(t, devices.pushes, 2)
+	})
+
+	t.Run("other tables are acknowledged", func(t *testing.T) {
+		recorded := len(attendance.punches)
+		rec := serve(http.MethodPost, "/iclock/cdata?SN=CKJX201960001&table=OPERLOG&Stamp=9999", "OPLOG 4\t0\t2025-08-11 08:00:00\t0\t0\t0\t0\n")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "OK: 1", rec.Body.String())
+		assert.Len(t, attendance.punches, recorded)
+	})
+}
@@ -0,0 +1,63 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/attendance"
+	"mceasy/internal/component/scheduler"
+	"mceasy/internal/helper"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/gommon/log"
+	"github.com/spf13/viper"
+)
+
+func SetupScheduledJobs(jobScheduler scheduler.Scheduler, connDb *ent.Client, redisClient *redis.Client) {
+
+	// Auto-absent: employees without attendance once their shift is over
+	if viper.GetBool("scheduler.autoAbsent.enabled") {
+		attendanceService := attendance.InitializedAttendanceService(connDb, redisClient)
+		jobScheduler.Every("auto-mark-absent", viper.GetDuration("scheduler.autoAbsent.interval"), func(ctx context.Context) error {
+			result, err := attendanceService.AutoMarkAbsentEmployees(ctx, time.Now().In(helper.ApplicationLocation()))
+			if err != nil {
+				return err
+			}
+			if result.MarkedAbsent > 0 {
+				log.Infof("auto-mark-absent marked %d employees absent", result.MarkedAbsent)
+			}
+			return nil
+		})
+	}
+
+	// Auto check-out: open records closed at the shift end or cut-off once the grace period is over
+	if viper.GetBool("scheduler.autoCheckOut.enabled") {
+		attendanceService := attendance.InitializedAttendanceService(connDb, redisClient)
+		jobScheduler.Every("auto-check-out", viper.GetDuration("scheduler.autoCheckOut.interval"), func(ctx context.Context) error {
+			result, err := attendanceService.AutoCheckOut(ctx, time.Now().In(helper.ApplicationLocation()))
+			if err != nil {
+				return err
+			}
+			if result.Closed > 0 {
+				log.Infof("auto-check-out closed %d attendance records", result.Closed)
+			}
+			return nil
+		})
+	}
+
+	// Anomaly detection: records HR should look at before payroll, e.g. a check-in without check-out
+	if viper.GetBool("scheduler.anomalyDetection.enabled") {
+		attendanceService := attendance.InitializedAttendanceService(connDb, redisClient)
+		jobScheduler.Every("attendance-anomaly-detection", viper.GetDuration("scheduler.anomalyDetection.interval"), func(ctx context.Context) error {
+			result, err := attendanceService.DetectAnomalies(ctx, time.Now().In(helper.ApplicationLocation()))
+			if err != nil {
+				return err
+			}
+			if result.Detected > 0 || result.AutoResolved > 0 {
+				log.Infof("attendance-anomaly-detection found %d anomalies and resolved %d", result.Detected, result.AutoResolved)
+			}
+			return nil
+		})
+	}
+}
@@ -2,20 +2,41 @@ package rest
 
 import (
 	"mceasy/ent"
+	"mceasy/internal/adapter/iclock"
 	"mceasy/internal/applications/attendance"
 	attendanceController "mceasy/internal/applications/attendance/controller"
 	"mceasy/internal/applications/auth"
 	authController "mceasy/internal/applications/auth/controller"
+	"mceasy/internal/applications/calendarfeed"
+	calendarFeedController "mceasy/internal/applications/calendarfeed/controller"
 	"mceasy/internal/applications/dashboard"
 	dashboardController "mceasy/internal/applications/dashboard/controller"
+	"mceasy/internal/applications/device"
+	deviceController "mceasy/internal/applications/device/controller"
 	"mceasy/internal/applications/employee"
 	employeeController "mceasy/internal/applications/employee/controller"
 	"mceasy/internal/applications/health"
 	"mceasy/internal/applications/health/controller"
+	"mceasy/internal/applications/holiday"
+	holidayController "mceasy/internal/applications/holiday/controller"
+	"mceasy/internal/applications/kiosk"
+	kioskController "mceasy/internal/applications/kiosk/controller"
+	"mceasy/internal/applications/leave"
+	leaveController "mceasy/internal/applications/leave/controller"
+	"mceasy/internal/applications/officelocation"
+	officeLocationController "mceasy/internal/applications/officelocation/controller"
+	"mceasy/internal/applications/overtime"
+	overtimeController "mceasy/internal/applications/overtime/controller"
+	"mceasy/internal/applications/payrollperiod"
+	payrollPeriodController "mceasy/internal/applications/payrollperiod/controller"
 	"mceasy/internal/applications/salary"
 	salaryController "mceasy/internal/applications/salary/controller"
+	"mceasy/internal/applications/salarycomponent"
+	salaryComponentController "mceasy/internal/applications/salarycomponent/controller"
 	"mceasy/internal/applications/user"
 	userController "mceasy/internal/applications/user/controller"
+	"mceasy/internal/applications/workschedule"
+	workScheduleController "mceasy/internal/applications/workschedule/controller"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/labstack/echo/v4"
@@ -52,16 +73,67 @@ func SetupRouteHandler(e *echo.Echo, connDb *ent.Client, redisClient *redis.Clie
 	employeeCtrl := employeeController.NewEmployeeController(employeeService)
 	employeeController.RegisterEmployeeRoutes(api, employeeCtrl)
 
+	// Holiday service
+	holidayService := holiday.InitializedHolidayService(connDb, redisClient)
+	holidayCtrl := holidayController.NewHolidayController(holidayService)
+	holidayController.RegisterHolidayRoutes(api, holidayCtrl)
+
+	// Work schedule service
+	workScheduleService := workschedule.InitializedWorkScheduleService(connDb, redisClient)
+	workScheduleCtrl := workScheduleController.NewWorkScheduleController(workScheduleService)
+	workScheduleController.RegisterWorkScheduleRoutes(api, workScheduleCtrl)
+
+	// Office location service
+	officeLocationService := officelocation.InitializedOfficeLocationService(connDb, redisClient)
+	officeLocationCtrl := officeLocationController.NewOfficeLocationController(officeLocationService)
+	officeLocationController.RegisterOfficeLocationRoutes(api, officeLocationCtrl)
+
+	// Leave service
+	leaveService := leave.InitializedLeaveService(connDb, redisClient)
+	leaveCtrl := leaveController.NewLeaveController(leaveService)
+	leaveController.RegisterLeaveRoutes(api, leaveCtrl)
+
 	// Attendance service
 	attendanceService := attendance.InitializedAttendanceService(connDb, redisClient)
 	attendanceCtrl := attendanceController.NewAttendanceController(attendanceService)
 	attendanceController.RegisterAttendanceRoutes(api, attendanceCtrl)
 
+	// Device service, attendance terminals push punches through the gateway on the server root
+	deviceService := device.InitializedDeviceService(connDb, redisClient)
+	deviceCtrl := deviceController.NewDeviceController(deviceService)
+	deviceController.RegisterDeviceRoutes(api, deviceCtrl)
+	iclock.RegisterGatewayRoutes(e, iclock.NewGateway(deviceService, attendanceService))
+
+	// Kiosk service, employees check in through the attendance routes with the code a kiosk shows
+	kioskService := kiosk.InitializedKioskService(connDb, redisClient)
+	kioskCtrl := kioskController.NewKioskController(kioskService)
+	kioskController.RegisterKioskRoutes(api, kioskCtrl)
+
+	// Overtime service
+	overtimeService := overtime.InitializedOvertimeService(connDb, redisClient)
+	overtimeCtrl := overtimeController.NewOvertimeController(overtimeService)
+	overtimeController.RegisterOvertimeRoutes(api, overtimeCtrl)
+
 	// Salary service
 	salaryService := salary.InitializedSalaryService(connDb, redisClient)
 	salaryCtrl := salaryController.NewSalaryController(salaryService)
 	salaryController.RegisterSalaryRoutes(api, salaryCtrl)
 
+	// Payroll period service, locked months refuse attendance and salary changes
+	payrollPeriodService := payrollperiod.InitializedPayrollPeriodService(connDb, redisClient)
+	payrollPeriodCtrl := payrollPeriodController.NewPayrollPeriodController(payrollPeriodService)
+	payrollPeriodController.RegisterPayrollPeriodRoutes(api, payrollPeriodCtrl)
+
+	// Salary component service, allowances and deductions itemized on salary calculations
+	salaryComponentService := salarycomponent.InitializedSalaryComponentService(connDb, redisClient)
+	salaryComponentCtrl := salaryComponentController.NewSalaryComponentController(salaryComponentService)
+	salaryComponentController.RegisterSalaryComponentRoutes(api, salaryComponentCtrl)
+
+	// Calendar feed service, calendar apps subscribe to the .ics feeds by token
+	calendarFeedService := calendarfeed.InitializedCalendarFeedService(connDb, redisClient)
+	calendarFeedCtrl := calendarFeedController.NewCalendarFeedController(calendarFeedService)
+	calendarFeedController.RegisterCalendarFeedRoutes(api, calendarFeedCtrl)
+
 	// Dashboard routes (core requirement + bonus features)
 	dashboardCtrl := dashboard.InitializedDashboardController(connDb, redisClient)
 	dashboardController.RegisterDashboardRoutes(api, dashboardCtrl)
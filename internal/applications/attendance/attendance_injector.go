@@ -9,6 +9,8 @@ import (
 	"mceasy/internal/applications/attendance/repository"
 	"mceasy/internal/applications/attendance/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 
 	"github.com/go-redis/redis/v8"
@@ -17,11 +19,19 @@ import (
 
 var providerAttendance = wire.NewSet(
 	repository.NewAttendanceRepository,
+	repository.NewAttendanceCorrectionRepository,
+	repository.NewAttendanceAnomalyRepository,
+	calendar.NewWorkingDayCalendar,
+	periodlock.NewPeriodLock,
 	transaction.NewTrx,
 	service.NewAttendanceService,
 	cache.NewCache,
 
 	wire.Bind(new(repository.AttendanceRepository), new(*repository.AttendanceRepositoryImpl)),
+	wire.Bind(new(repository.AttendanceCorrectionRepository), new(*repository.AttendanceCorrectionRepositoryImpl)),
+	wire.Bind(new(repository.AttendanceAnomalyRepository), new(*repository.AttendanceAnomalyRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
+	wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)),
 	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
 	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
 	wire.Bind(new(service.AttendanceService), new(*service.AttendanceServiceImpl)),
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/helper"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RunAutoAbsent runs the auto-absent job immediately
+// @Summary Run auto-absent
+// @Description Mark employees absent whose shift of yesterday or today has ended without attendance or approved leave
+// @Tags attendance-admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.AutoAbsentResult
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/auto-absent [post]
+func (c *AttendanceController) RunAutoAbsent(ctx echo.Context) error {
+	result, err := c.attendanceService.AutoMarkAbsentEmployees(ctx.Request().Context(), time.Now().In(helper.ApplicationLocation()))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to mark absent employees",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// BackfillAbsences marks missing attendance as absent for a date range
+// @Summary Backfill absences
+// @Description Mark employees absent on past working days without attendance or approved leave, existing records are kept
+// @Tags attendance-admin
+// @Accept json
+// @Produce json
+// @Param backfill body dto.BackfillAbsenceRequest true "Date range, at most 92 days"
+// @Success 200 {object} dto.AutoAbsentResult
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/auto-absent/backfill [post]
+func (c *AttendanceController) BackfillAbsences(ctx echo.Context) error {
+	var req dto.BackfillAbsenceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	result, err := c.attendanceService.BackfillAbsences(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to backfill absences",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/attendance/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DetectAnomalies runs the anomaly detector over a date range immediately
+// @Summary Detect attendance anomalies
+// @Description Scan attendance of past dates with the configured anomaly rules, findings already reported are kept
+// @Tags attendance-anomalies
+// @Accept json
+// @Produce json
+// @Param detection body dto.DetectAttendanceAnomaliesRequest true "Date range, at most 92 days"
+// @Success 200 {object} dto.AnomalyDetectionResult
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/anomalies/detect [post]
+func (c *AttendanceController) DetectAnomalies(ctx echo.Context) error {
+	var req dto.DetectAttendanceAnomaliesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	result, err := c.attendanceService.DetectAnomaliesBetween(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to detect attendance anomalies",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ListAnomalies retrieves attendance anomalies with pagination and filtering
+// @Summary List attendance anomalies
+// @Description Get list of attendance anomalies found by the detector with pagination and filtering
+// @Tags attendance-anomalies
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param employee_id query int false "Employee ID filter"
+// @Param rule query string false "Rule filter" Enums(missing_check_out, long_day, odd_hour_check_in, early_check_out, skipped_punch)
+// @Param status query string false "Status filter" Enums(open, resolved)
+// @Param start_date query string false "Start date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Success 200 {object} dto.AttendanceAnomalyListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/anomalies [get]
+func (c *AttendanceController) ListAnomalies(ctx echo.Context) error {
+	var params dto.AttendanceAnomalyQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	anomalies, err := c.attendanceService.ListAnomalies(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list attendance anomalies",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, anomalies)
+}
+
+// GetAnomaly retrieves an attendance anomaly by ID
+// @Summary Get attendance anomaly by ID
+// @Description Get attendance anomaly details including the rule that found it
+// @Tags attendance-anomalies
+// @Accept json
+// @Produce json
+// @Param anomaly_id path int true "Anomaly ID"
+// @Success 200 {object} dto.AttendanceAnomalyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /attendance/anomalies/{anomaly_id} [get]
+func (c *AttendanceController) GetAnomaly(ctx echo.Context) error {
+	idStr := ctx.Param("anomaly_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid anomaly ID",
+			"message": "Anomaly ID must be a valid number",
+		})
+	}
+
+	anomaly, err := c.attendanceService.GetAnomalyByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Attendance anomaly not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, anomaly)
+}
+
+// ResolveAnomaly marks an attendance anomaly as handled
+// @Summary Resolve attendance anomaly
+// @Description Record that HR handled the anomaly, e.g. after correcting the attendance or accepting it as is
+// @Tags attendance-anomalies
+// @Accept json
+// @Produce json
+// @Param anomaly_id path int true "Anomaly ID"
+// @Param resolution body dto.ResolveAttendanceAnomalyRequest true "Resolution data"
+// @Success 200 {object} dto.AttendanceAnomalyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/anomalies/{anomaly_id}/resolve [post]
+func (c *AttendanceController) ResolveAnomaly(ctx echo.Context) error {
+	idStr := ctx.Param("anomaly_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid anomaly ID",
+			"message": "Anomaly ID must be a valid number",
+		})
+	}
+
+	var req dto.ResolveAttendanceAnomalyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	anomaly, err := c.attendanceService.ResolveAnomaly(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to resolve attendance anomaly",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, anomaly)
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"mceasy/internal/helper"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RunAutoCheckOut runs the auto check-out job immediately
+// @Summary Run auto check-out
+// @Description Check out employees who forgot to, at the shift end or the configured cut-off once the grace period is over. Closed records are flagged auto_closed and disputed by updating the check-out time.
+// @Tags attendance-admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.AutoCheckOutResult
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/auto-checkout [post]
+func (c *AttendanceController) RunAutoCheckOut(ctx echo.Context) error {
+	result, err := c.attendanceService.AutoCheckOut(ctx.Request().Context(), time.Now().In(helper.ApplicationLocation()))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to check out employees automatically",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
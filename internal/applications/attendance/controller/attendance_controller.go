@@ -1,12 +1,17 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
+	"mceasy/exceptions"
 	"mceasy/internal/applications/attendance/dto"
 	"mceasy/internal/applications/attendance/service"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
+	"mceasy/internal/helper/response"
 
 	"github.com/labstack/echo/v4"
 )
@@ -50,8 +55,17 @@ func (c *AttendanceController) MarkAttendance(ctx echo.Context) error {
 		})
 	}
 
+	// Times without a zone are wall clock times of the employee's timezone
+	loc, err := c.attendanceService.GetEmployeeLocation(ctx.Request().Context(), req.EmployeeID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to resolve employee timezone",
+			"message": err.Error(),
+		})
+	}
+
 	// Convert flexible input to standard DTO
-	standardReq, err := req.ToMarkAttendanceRequest()
+	standardReq, err := req.ToMarkAttendanceRequest(loc)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error":   "Invalid date/time format",
@@ -60,6 +74,9 @@ func (c *AttendanceController) MarkAttendance(ctx echo.Context) error {
 	}
 
 	attendance, err := c.attendanceService.MarkAttendance(ctx.Request().Context(), standardReq)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to mark attendance", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to mark attendance",
@@ -70,6 +87,53 @@ func (c *AttendanceController) MarkAttendance(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, attendance)
 }
 
+// BulkMarkAttendance marks attendance for several employees on one date
+// @Summary Bulk mark attendance
+// @Description Mark attendance for several employees with the same validation as a single marking. Atomic requests store all rows or none, otherwise valid rows are stored and failed rows reported.
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Param attendance body dto.BulkMarkAttendanceRequest true "Bulk attendance data"
+// @Success 201 {object} dto.BulkMarkAttendanceResponse
+// @Success 207 {object} dto.BulkMarkAttendanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} dto.BulkMarkAttendanceResponse
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/bulk [post]
+func (c *AttendanceController) BulkMarkAttendance(ctx echo.Context) error {
+	var req dto.BulkMarkAttendanceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	result, err := c.attendanceService.BulkMarkAttendance(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to mark attendance",
+			"message": err.Error(),
+		})
+	}
+
+	switch {
+	case result.Failed == 0:
+		return ctx.JSON(http.StatusCreated, result)
+	case result.Atomic:
+		return ctx.JSON(http.StatusUnprocessableEntity, result)
+	default:
+		return ctx.JSON(http.StatusMultiStatus, result)
+	}
+}
+
 // GetAttendance retrieves an attendance record by ID
 // @Summary Get attendance by ID
 // @Description Get attendance record details by ID
@@ -140,8 +204,25 @@ func (c *AttendanceController) UpdateAttendance(ctx echo.Context) error {
 		})
 	}
 
+	// Times are wall clock times on the attendance date in the employee's timezone
+	existing, err := c.attendanceService.GetAttendanceByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Attendance not found",
+			"message": err.Error(),
+		})
+	}
+
+	loc, err := c.attendanceService.GetEmployeeLocation(ctx.Request().Context(), existing.EmployeeID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to resolve employee timezone",
+			"message": err.Error(),
+		})
+	}
+
 	// Convert flexible input to standard DTO
-	standardReq, err := req.ToUpdateAttendanceRequest()
+	standardReq, err := req.ToUpdateAttendanceRequest(existing.AttendanceDate, loc)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error":   "Invalid time format",
@@ -150,6 +231,9 @@ func (c *AttendanceController) UpdateAttendance(ctx echo.Context) error {
 	}
 
 	attendance, err := c.attendanceService.UpdateAttendance(ctx.Request().Context(), id, standardReq)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to update attendance", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to update attendance",
@@ -183,6 +267,9 @@ func (c *AttendanceController) DeleteAttendance(ctx echo.Context) error {
 	}
 
 	err = c.attendanceService.DeleteAttendance(ctx.Request().Context(), id)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to delete attendance", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to delete attendance",
@@ -208,6 +295,8 @@ func (c *AttendanceController) DeleteAttendance(ctx echo.Context) error {
 // @Param end_date query string false "End date filter (YYYY-MM-DD)"
 // @Param status query string false "Status filter" Enums(present, absent, late, half_day)
 // @Param include_weekend query bool false "Include weekend records"
+// @Param location_flagged query bool false "Only punches flagged outside the office radius"
+// @Param auto_closed query bool false "Only records checked out by the auto check-out job"
 // @Success 200 {object} dto.AttendanceListResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -277,7 +366,7 @@ func (c *AttendanceController) GetDailyAttendanceSummary(ctx echo.Context) error
 	var err error
 
 	if dateStr == "" {
-		date = time.Now()
+		date = calendar.Today(helper.ApplicationLocation())
 	} else {
 		date, err = time.Parse("2006-01-02", dateStr)
 		if err != nil {
@@ -301,11 +390,12 @@ func (c *AttendanceController) GetDailyAttendanceSummary(ctx echo.Context) error
 
 // CheckInEmployee marks an employee as present with check-in time
 // @Summary Employee check-in
-// @Description Mark employee check-in for today
+// @Description Mark employee check-in for the current shift, validated against the geofence of the assigned office
 // @Tags attendance
 // @Accept json
 // @Produce json
 // @Param employee_id path int true "Employee ID"
+// @Param punch body dto.PunchRequest false "Check-in coordinates"
 // @Success 200 {object} dto.AttendanceResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -320,8 +410,92 @@ func (c *AttendanceController) CheckInEmployee(ctx echo.Context) error {
 		})
 	}
 
+	var punch dto.PunchRequest
+	if err := ctx.Bind(&punch); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&punch); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	checkInTime := time.Now()
+	attendance, err := c.attendanceService.CheckInEmployee(ctx.Request().Context(), employeeID, checkInTime, &punch)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to check in employee", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to check in employee",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, attendance)
+}
+
+// KioskCheckIn checks an employee in with the code scanned from a kiosk
+// @Summary Employee kiosk check-in
+// @Description Check an employee in with the payload of the rotating QR code shown by a kiosk. The code must still be shown and not used yet and the kiosk must be installed at the employee's office, the geofence does not apply. Check-ins are refused for a while after too many wrong codes.
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Param employee_id path int true "Employee ID"
+// @Param code body dto.KioskCheckInRequest true "Scanned kiosk code"
+// @Success 200 {object} dto.AttendanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/kiosk-checkin/{employee_id} [post]
+func (c *AttendanceController) KioskCheckIn(ctx echo.Context) error {
+	employeeIDStr := ctx.Param("employee_id")
+	employeeID, err := strconv.ParseUint(employeeIDStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid number",
+		})
+	}
+
+	var req dto.KioskCheckInRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
 	checkInTime := time.Now()
-	attendance, err := c.attendanceService.CheckInEmployee(ctx.Request().Context(), employeeID, checkInTime)
+	attendance, err := c.attendanceService.KioskCheckIn(ctx.Request().Context(), employeeID, checkInTime, &req)
+	if errors.Is(err, service.ErrKioskCodeInvalid) || errors.Is(err, service.ErrKioskNotAllowed) {
+		return ctx.JSON(http.StatusForbidden, map[string]interface{}{
+			"error":   "Kiosk check-in refused",
+			"message": err.Error(),
+		})
+	}
+	if errors.Is(err, service.ErrKioskTooManyAttempts) {
+		return ctx.JSON(http.StatusTooManyRequests, map[string]interface{}{
+			"error":   "Kiosk check-in refused",
+			"message": err.Error(),
+		})
+	}
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to check in employee", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to check in employee",
@@ -334,11 +508,12 @@ func (c *AttendanceController) CheckInEmployee(ctx echo.Context) error {
 
 // CheckOutEmployee updates check-out time for an employee
 // @Summary Employee check-out
-// @Description Mark employee check-out for today
+// @Description Mark employee check-out on the open attendance record, including overnight shifts, validated against the geofence of the assigned office
 // @Tags attendance
 // @Accept json
 // @Produce json
 // @Param employee_id path int true "Employee ID"
+// @Param punch body dto.PunchRequest false "Check-out coordinates"
 // @Success 200 {object} dto.AttendanceResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -353,8 +528,26 @@ func (c *AttendanceController) CheckOutEmployee(ctx echo.Context) error {
 		})
 	}
 
+	var punch dto.PunchRequest
+	if err := ctx.Bind(&punch); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&punch); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
 	checkOutTime := time.Now()
-	attendance, err := c.attendanceService.CheckOutEmployee(ctx.Request().Context(), employeeID, checkOutTime)
+	attendance, err := c.attendanceService.CheckOutEmployee(ctx.Request().Context(), employeeID, checkOutTime, &punch)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to check out employee", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to check out employee",
@@ -365,6 +558,108 @@ func (c *AttendanceController) CheckOutEmployee(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, attendance)
 }
 
+// StartBreak records the start of a break for an employee
+// @Summary Employee break start
+// @Description Record a break start punch on the open attendance record
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Param employee_id path int true "Employee ID"
+// @Success 200 {object} dto.AttendanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/break-start/{employee_id} [post]
+func (c *AttendanceController) StartBreak(ctx echo.Context) error {
+	employeeIDStr := ctx.Param("employee_id")
+	employeeID, err := strconv.ParseUint(employeeIDStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid number",
+		})
+	}
+
+	attendance, err := c.attendanceService.StartBreak(ctx.Request().Context(), employeeID, time.Now())
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to start break", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to start break",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, attendance)
+}
+
+// EndBreak records the end of a break for an employee
+// @Summary Employee break end
+// @Description Record a break end punch on the open attendance record
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Param employee_id path int true "Employee ID"
+// @Success 200 {object} dto.AttendanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/break-end/{employee_id} [post]
+func (c *AttendanceController) EndBreak(ctx echo.Context) error {
+	employeeIDStr := ctx.Param("employee_id")
+	employeeID, err := strconv.ParseUint(employeeIDStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid number",
+		})
+	}
+
+	attendance, err := c.attendanceService.EndBreak(ctx.Request().Context(), employeeID, time.Now())
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to end break", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to end break",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, attendance)
+}
+
+// GetAttendancePunches retrieves the punches of an attendance record
+// @Summary Get attendance punches
+// @Description Get check-in, check-out and break punches of an attendance record in chronological order
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Param id path int true "Attendance ID"
+// @Success 200 {array} dto.AttendancePunchResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /attendance/{id}/punches [get]
+func (c *AttendanceController) GetAttendancePunches(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid attendance ID",
+			"message": "Attendance ID must be a valid number",
+		})
+	}
+
+	punches, err := c.attendanceService.GetAttendancePunches(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Attendance record not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, punches)
+}
+
 // GetEmployeeAttendanceByDate retrieves attendance for an employee on a specific date
 // @Summary Get employee attendance by date
 // @Description Get attendance record for a specific employee and date
@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestCorrection submits a correction request for an attendance record
+// @Summary Request attendance correction
+// @Description Submit a proposed change to an attendance record with a reason, to be approved or rejected by a manager
+// @Tags attendance-corrections
+// @Accept json
+// @Produce json
+// @Param id path int true "Attendance ID"
+// @Param correction body dto.CreateAttendanceCorrectionRequest true "Correction data"
+// @Success 201 {object} dto.AttendanceCorrectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/{id}/corrections [post]
+func (c *AttendanceController) RequestCorrection(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid attendance ID",
+			"message": "Attendance ID must be a valid number",
+		})
+	}
+
+	var req dto.CreateAttendanceCorrectionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	correction, err := c.attendanceService.RequestCorrection(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to request attendance correction",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, correction)
+}
+
+// GetCorrection retrieves an attendance correction by ID
+// @Summary Get attendance correction by ID
+// @Description Get attendance correction details including original and proposed values
+// @Tags attendance-corrections
+// @Accept json
+// @Produce json
+// @Param correction_id path int true "Correction ID"
+// @Success 200 {object} dto.AttendanceCorrectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /attendance/corrections/{correction_id} [get]
+func (c *AttendanceController) GetCorrection(ctx echo.Context) error {
+	idStr := ctx.Param("correction_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid correction ID",
+			"message": "Correction ID must be a valid number",
+		})
+	}
+
+	correction, err := c.attendanceService.GetCorrectionByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Attendance correction not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, correction)
+}
+
+// ListCorrections retrieves attendance corrections with pagination and filtering
+// @Summary List attendance corrections
+// @Description Get list of attendance corrections with pagination and filtering
+// @Tags attendance-corrections
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param employee_id query int false "Employee ID filter"
+// @Param attendance_id query int false "Attendance ID filter"
+// @Param status query string false "Status filter" Enums(pending, approved, rejected)
+// @Success 200 {object} dto.AttendanceCorrectionListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/corrections [get]
+func (c *AttendanceController) ListCorrections(ctx echo.Context) error {
+	var params dto.AttendanceCorrectionQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	corrections, err := c.attendanceService.ListCorrections(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list attendance corrections",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, corrections)
+}
+
+// ApproveCorrection approves a pending attendance correction
+// @Summary Approve attendance correction
+// @Description Apply the proposed values to the attendance record and record the approver
+// @Tags attendance-corrections
+// @Accept json
+// @Produce json
+// @Param correction_id path int true "Correction ID"
+// @Param review body dto.ReviewAttendanceCorrectionRequest true "Review data"
+// @Success 200 {object} dto.AttendanceCorrectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/corrections/{correction_id}/approve [post]
+func (c *AttendanceController) ApproveCorrection(ctx echo.Context) error {
+	idStr := ctx.Param("correction_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid correction ID",
+			"message": "Correction ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewAttendanceCorrectionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	correction, err := c.attendanceService.ApproveCorrection(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to approve attendance correction", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to approve attendance correction",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, correction)
+}
+
+// RejectCorrection rejects a pending attendance correction
+// @Summary Reject attendance correction
+// @Description Reject the correction and leave the attendance record unchanged
+// @Tags attendance-corrections
+// @Accept json
+// @Produce json
+// @Param correction_id path int true "Correction ID"
+// @Param review body dto.ReviewAttendanceCorrectionRequest true "Review data"
+// @Success 200 {object} dto.AttendanceCorrectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/corrections/{correction_id}/reject [post]
+func (c *AttendanceController) RejectCorrection(ctx echo.Context) error {
+	idStr := ctx.Param("correction_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid correction ID",
+			"message": "Correction ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewAttendanceCorrectionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	correction, err := c.attendanceService.RejectCorrection(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to reject attendance correction", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to reject attendance correction",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, correction)
+}
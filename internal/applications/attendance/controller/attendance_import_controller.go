@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxPunchLogSize limits an uploaded punch log, a year of logs of a large office stays well below it
+const maxPunchLogSize = 32 << 20
+
+// ImportPunchLog imports a punch log exported by the fingerprint terminals
+// @Summary Import fingerprint terminal punch log
+// @Description Upload a CSV or TSV punch log with user PIN, timestamp, verify mode and in/out state. Punches are matched to employees by device PIN, deduplicated and collapsed into daily attendance. With dry_run nothing is stored and the response previews the changes.
+// @Tags attendance
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Punch log"
+// @Param dry_run formData bool false "Preview the import without storing it"
+// @Success 200 {object} dto.PunchImportResult
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance/import [post]
+func (c *AttendanceController) ImportPunchLog(ctx echo.Context) error {
+	dryRun := false
+	if dryRunStr := ctx.FormValue("dry_run"); dryRunStr != "" {
+		parsed, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Invalid dry_run",
+				"message": "dry_run must be true or false",
+			})
+		}
+		dryRun = parsed
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Punch log file is required",
+			"message": err.Error(),
+		})
+	}
+	if fileHeader.Size > maxPunchLogSize {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Punch log file is too large",
+			"message": "Split the log into files of at most 32 MB",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Failed to read punch log file",
+			"message": err.Error(),
+		})
+	}
+	defer file.Close()
+
+	result, err := c.attendanceService.ImportPunchLog(ctx.Request().Context(), file, dryRun)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to import punch log",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
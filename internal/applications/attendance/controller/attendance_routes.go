@@ -8,10 +8,13 @@ import (
 func RegisterAttendanceRoutes(e *echo.Group, controller *AttendanceController) {
 	// Basic CRUD operations
 	e.POST("/attendance", controller.MarkAttendance)
+	e.POST("/attendance/bulk", controller.BulkMarkAttendance)
+	e.POST("/attendance/import", controller.ImportPunchLog)
 	e.GET("/attendance", controller.ListAttendance)
 	e.GET("/attendance/:id", controller.GetAttendance)
 	e.PUT("/attendance/:id", controller.UpdateAttendance)
 	e.DELETE("/attendance/:id", controller.DeleteAttendance)
+	e.GET("/attendance/:id/punches", controller.GetAttendancePunches)
 
 	// Special attendance operations
 	e.GET("/attendance/today", controller.GetTodayAttendance)
@@ -19,6 +22,29 @@ func RegisterAttendanceRoutes(e *echo.Group, controller *AttendanceController) {
 
 	// Employee-specific attendance operations
 	e.POST("/attendance/checkin/:employee_id", controller.CheckInEmployee)
+	e.POST("/attendance/kiosk-checkin/:employee_id", controller.KioskCheckIn)
 	e.POST("/attendance/checkout/:employee_id", controller.CheckOutEmployee)
+	e.POST("/attendance/break-start/:employee_id", controller.StartBreak)
+	e.POST("/attendance/break-end/:employee_id", controller.EndBreak)
 	e.GET("/attendance/employee/:employee_id", controller.GetEmployeeAttendanceByDate)
+
+	// Attendance correction workflow
+	e.POST("/attendance/:id/corrections", controller.RequestCorrection)
+	e.GET("/attendance/corrections", controller.ListCorrections)
+	e.GET("/attendance/corrections/:correction_id", controller.GetCorrection)
+	e.POST("/attendance/corrections/:correction_id/approve", controller.ApproveCorrection)
+	e.POST("/attendance/corrections/:correction_id/reject", controller.RejectCorrection)
+
+	// Anomalies found by the detector, resolved by HR before payroll
+	e.GET("/attendance/anomalies", controller.ListAnomalies)
+	e.POST("/attendance/anomalies/detect", controller.DetectAnomalies)
+	e.GET("/attendance/anomalies/:anomaly_id", controller.GetAnomaly)
+	e.POST("/attendance/anomalies/:anomaly_id/resolve", controller.ResolveAnomaly)
+
+	// Auto-absent administration
+	e.POST("/attendance/auto-absent", controller.RunAutoAbsent)
+	e.POST("/attendance/auto-absent/backfill", controller.BackfillAbsences)
+
+	// Auto check-out administration
+	e.POST("/attendance/auto-checkout", controller.RunAutoCheckOut)
 }
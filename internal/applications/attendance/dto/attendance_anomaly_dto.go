@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+)
+
+// DetectAttendanceAnomaliesRequest represents a manual run of the anomaly detector over past dates
+type DetectAttendanceAnomaliesRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+// AnomalyDetectionResult reports what a run of the anomaly detector found
+type AnomalyDetectionResult struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Scanned   int       `json:"scanned"`
+	Detected  int       `json:"detected"`
+	// AutoResolved counts open anomalies whose attendance was corrected since the last run
+	AutoResolved int `json:"auto_resolved"`
+	// LockedDates lists dates skipped because their payroll period is closed
+	LockedDates []time.Time `json:"locked_dates,omitempty"`
+}
+
+// ResolveAttendanceAnomalyRequest represents HR marking an anomaly as handled
+type ResolveAttendanceAnomalyRequest struct {
+	ResolvedBy      uint64 `json:"resolved_by" validate:"required"`
+	ResolutionNotes string `json:"resolution_notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// AttendanceAnomalyResponse represents the attendance anomaly response structure
+type AttendanceAnomalyResponse struct {
+	ID              uint64     `json:"id"`
+	AttendanceID    uint64     `json:"attendance_id"`
+	EmployeeID      uint64     `json:"employee_id"`
+	EmployeeName    string     `json:"employee_name,omitempty"`
+	EmployeeCode    string     `json:"employee_code,omitempty"`
+	AttendanceDate  time.Time  `json:"attendance_date"`
+	Rule            string     `json:"rule"`
+	Details         string     `json:"details"`
+	Status          string     `json:"status"`
+	ResolvedBy      *uint64    `json:"resolved_by,omitempty"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+	ResolutionNotes string     `json:"resolution_notes,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ModifiedAt      time.Time  `json:"modified_at"`
+}
+
+// AttendanceAnomalyListResponse represents the response for attendance anomaly list
+type AttendanceAnomalyListResponse struct {
+	Anomalies []AttendanceAnomalyResponse `json:"anomalies"`
+	Total     int                         `json:"total"`
+	Page      int                         `json:"page"`
+	Limit     int                         `json:"limit"`
+}
+
+// AttendanceAnomalyQueryParams represents query parameters for attendance anomaly list
+type AttendanceAnomalyQueryParams struct {
+	Page       int       `query:"page" validate:"omitempty,min=1"`
+	Limit      int       `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID uint64    `query:"employee_id" validate:"omitempty,min=1"`
+	Rule       string    `query:"rule" validate:"omitempty,oneof=missing_check_out long_day odd_hour_check_in early_check_out skipped_punch"`
+	Status     string    `query:"status" validate:"omitempty,oneof=open resolved"`
+	StartDate  time.Time `query:"start_date" validate:"omitempty"`
+	EndDate    time.Time `query:"end_date" validate:"omitempty"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+// CreateAttendanceCorrectionRequest represents an employee's proposed change to an attendance record
+type CreateAttendanceCorrectionRequest struct {
+	EmployeeID   uint64 `json:"employee_id" validate:"required"`
+	CheckInTime  string `json:"check_in_time,omitempty"`
+	CheckOutTime string `json:"check_out_time,omitempty"`
+	Status       string `json:"status,omitempty" validate:"omitempty,oneof=present absent late half_day remote business_trip sick"`
+	Reason       string `json:"reason" validate:"required,min=5,max=500"`
+}
+
+// ProposedTimes parses the proposed check-in and check-out times on the attendance date in loc
+func (r *CreateAttendanceCorrectionRequest) ProposedTimes(attendanceDate time.Time, loc *time.Location) (checkInTime, checkOutTime time.Time, err error) {
+	if r.CheckInTime != "" {
+		checkInTime, err = parseFlexibleTime(r.CheckInTime, attendanceDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid check-in time: %w", err)
+		}
+	}
+
+	if r.CheckOutTime != "" {
+		checkOutTime, err = parseFlexibleTime(r.CheckOutTime, attendanceDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid check-out time: %w", err)
+		}
+	}
+
+	return checkInTime, checkOutTime, nil
+}
+
+// ReviewAttendanceCorrectionRequest represents a manager's decision on a correction
+type ReviewAttendanceCorrectionRequest struct {
+	ReviewedBy  uint64 `json:"reviewed_by" validate:"required"`
+	ReviewNotes string `json:"review_notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// AttendanceCorrectionResponse represents the attendance correction response structure
+type AttendanceCorrectionResponse struct {
+	ID                   uint64     `json:"id"`
+	AttendanceID         uint64     `json:"attendance_id"`
+	EmployeeID           uint64     `json:"employee_id"`
+	EmployeeName         string     `json:"employee_name,omitempty"`
+	AttendanceDate       time.Time  `json:"attendance_date,omitempty"`
+	OriginalCheckInTime  *time.Time `json:"original_check_in_time,omitempty"`
+	OriginalCheckOutTime *time.Time `json:"original_check_out_time,omitempty"`
+	OriginalStatus       string     `json:"original_status"`
+	ProposedCheckInTime  *time.Time `json:"proposed_check_in_time,omitempty"`
+	ProposedCheckOutTime *time.Time `json:"proposed_check_out_time,omitempty"`
+	ProposedStatus       string     `json:"proposed_status,omitempty"`
+	Reason               string     `json:"reason"`
+	Status               string     `json:"status"`
+	ReviewedBy           *uint64    `json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes          string     `json:"review_notes,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	ModifiedAt           time.Time  `json:"modified_at"`
+}
+
+// AttendanceCorrectionListResponse represents the response for attendance correction list
+type AttendanceCorrectionListResponse struct {
+	Corrections []AttendanceCorrectionResponse `json:"corrections"`
+	Total       int                            `json:"total"`
+	Page        int                            `json:"page"`
+	Limit       int                            `json:"limit"`
+}
+
+// AttendanceCorrectionQueryParams represents query parameters for attendance correction list
+type AttendanceCorrectionQueryParams struct {
+	Page         int    `query:"page" validate:"omitempty,min=1"`
+	Limit        int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID   uint64 `query:"employee_id" validate:"omitempty,min=1"`
+	AttendanceID uint64 `query:"attendance_id" validate:"omitempty,min=1"`
+	Status       string `query:"status" validate:"omitempty,oneof=pending approved rejected"`
+}
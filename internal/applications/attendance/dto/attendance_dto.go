@@ -11,35 +11,159 @@ type MarkAttendanceRequest struct {
 	AttendanceDate time.Time `json:"attendance_date" validate:"required"`
 	CheckInTime    time.Time `json:"check_in_time,omitempty"`
 	CheckOutTime   time.Time `json:"check_out_time,omitempty"`
-	Status         string    `json:"status" validate:"required,oneof=present absent late half_day"`
+	Status         string    `json:"status" validate:"required,oneof=present absent late half_day remote business_trip sick"`
 	Notes          string    `json:"notes,omitempty" validate:"omitempty,max=500"`
 	MarkedByAdmin  bool      `json:"marked_by_admin,omitempty"`
+
+	StatusMetadata *AttendanceStatusMetadata `json:"status_metadata,omitempty" validate:"omitempty"`
+
+	// Set by check-in and check-out after the geofence validation
+	CheckInLocation  *PunchLocation `json:"-"`
+	CheckOutLocation *PunchLocation `json:"-"`
 }
 
 // UpdateAttendanceRequest represents the request to update attendance
 type UpdateAttendanceRequest struct {
 	CheckInTime   time.Time `json:"check_in_time,omitempty"`
 	CheckOutTime  time.Time `json:"check_out_time,omitempty"`
-	Status        string    `json:"status,omitempty" validate:"omitempty,oneof=present absent late half_day"`
+	Status        string    `json:"status,omitempty" validate:"omitempty,oneof=present absent late half_day remote business_trip sick"`
 	Notes         string    `json:"notes,omitempty" validate:"omitempty,max=500"`
 	MarkedByAdmin *bool     `json:"marked_by_admin,omitempty"`
+
+	// StatusMetadata replaces the stored metadata, it is cleared when the status changes without new metadata
+	StatusMetadata *AttendanceStatusMetadata `json:"status_metadata,omitempty" validate:"omitempty"`
+
+	// Set when a new check-out replaces one recorded by the auto check-out job
+	AutoClosed *bool `json:"-"`
+
+	// Set by check-in and check-out after the geofence validation
+	CheckInLocation  *PunchLocation `json:"-"`
+	CheckOutLocation *PunchLocation `json:"-"`
+}
+
+// PunchRequest represents the coordinates sent with a check-in or check-out
+type PunchRequest struct {
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+
+	// Set by kiosk check-in once the kiosk code was verified, the kiosk stands in for the coordinates
+	KioskID *uint64 `json:"-"`
+}
+
+// KioskCheckInRequest is the payload of the QR code shown by a kiosk, posted as is by the employee's phone
+type KioskCheckInRequest struct {
+	KioskID uint64 `json:"kiosk_id" validate:"required,min=1"`
+	Code    string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// HasCoordinates reports whether both latitude and longitude were sent
+func (p *PunchRequest) HasCoordinates() bool {
+	return p != nil && p.Latitude != nil && p.Longitude != nil
+}
+
+// PunchLocation holds where a check-in or check-out happened and how far it was from the assigned office
+type PunchLocation struct {
+	Latitude       *float64
+	Longitude      *float64
+	DistanceMeters *float64
+	OutsideRadius  bool
+	KioskID        *uint64
+}
+
+// AttendanceStatusMetadata holds the details of an away or sick day, each field belongs to one status
+type AttendanceStatusMetadata struct {
+	// remote
+	WorkLocation string `json:"work_location,omitempty" validate:"omitempty,max=255"`
+	// business_trip
+	Destination string `json:"destination,omitempty" validate:"omitempty,max=255"`
+	Purpose     string `json:"purpose,omitempty" validate:"omitempty,max=255"`
+	// sick
+	SickNoteURL string `json:"sick_note_url,omitempty" validate:"omitempty,url,max=500"`
+}
+
+// ToMap flattens the metadata to the stored JSON map, empty fields are left out
+func (m *AttendanceStatusMetadata) ToMap() map[string]string {
+	out := map[string]string{}
+	if m == nil {
+		return out
+	}
+	if m.WorkLocation != "" {
+		out["work_location"] = m.WorkLocation
+	}
+	if m.Destination != "" {
+		out["destination"] = m.Destination
+	}
+	if m.Purpose != "" {
+		out["purpose"] = m.Purpose
+	}
+	if m.SickNoteURL != "" {
+		out["sick_note_url"] = m.SickNoteURL
+	}
+	return out
+}
+
+// NewAttendanceStatusMetadata reads the stored JSON map back, nil when nothing was stored
+func NewAttendanceStatusMetadata(values map[string]string) *AttendanceStatusMetadata {
+	if len(values) == 0 {
+		return nil
+	}
+	return &AttendanceStatusMetadata{
+		WorkLocation: values["work_location"],
+		Destination:  values["destination"],
+		Purpose:      values["purpose"],
+		SickNoteURL:  values["sick_note_url"],
+	}
 }
 
 // AttendanceResponse represents the attendance response structure
 type AttendanceResponse struct {
+	ID                     uint64                    `json:"id"`
+	EmployeeID             uint64                    `json:"employee_id"`
+	EmployeeName           string                    `json:"employee_name"`
+	EmployeeCode           string                    `json:"employee_code"`
+	AttendanceDate         time.Time                 `json:"attendance_date"`
+	CheckInTime            time.Time                 `json:"check_in_time,omitempty"`
+	CheckOutTime           time.Time                 `json:"check_out_time,omitempty"`
+	WorkedHours            float64                   `json:"worked_hours"`
+	WorkedMinutes          int                       `json:"worked_minutes"`
+	BreakMinutes           int                       `json:"break_minutes"`
+	Status                 string                    `json:"status"`
+	IsWeekend              bool                      `json:"is_weekend"`
+	Notes                  string                    `json:"notes,omitempty"`
+	MarkedByAdmin          bool                      `json:"marked_by_admin"`
+	CheckInLatitude        *float64                  `json:"check_in_latitude,omitempty"`
+	CheckInLongitude       *float64                  `json:"check_in_longitude,omitempty"`
+	CheckInDistanceMeters  *float64                  `json:"check_in_distance_meters,omitempty"`
+	CheckOutLatitude       *float64                  `json:"check_out_latitude,omitempty"`
+	CheckOutLongitude      *float64                  `json:"check_out_longitude,omitempty"`
+	CheckOutDistanceMeters *float64                  `json:"check_out_distance_meters,omitempty"`
+	LocationFlagged        bool                      `json:"location_flagged"`
+	AutoClosed             bool                      `json:"auto_closed"`
+	CheckInKioskID         *uint64                   `json:"check_in_kiosk_id,omitempty"`
+	StatusMetadata         *AttendanceStatusMetadata `json:"status_metadata,omitempty"`
+	CreatedAt              time.Time                 `json:"created_at"`
+	ModifiedAt             time.Time                 `json:"modified_at"`
+}
+
+// AttendancePunchResponse represents a single punch of a daily attendance
+type AttendancePunchResponse struct {
 	ID             uint64    `json:"id"`
-	EmployeeID     uint64    `json:"employee_id"`
-	EmployeeName   string    `json:"employee_name"`
-	EmployeeCode   string    `json:"employee_code"`
-	AttendanceDate time.Time `json:"attendance_date"`
-	CheckInTime    time.Time `json:"check_in_time,omitempty"`
-	CheckOutTime   time.Time `json:"check_out_time,omitempty"`
-	Status         string    `json:"status"`
-	IsWeekend      bool      `json:"is_weekend"`
-	Notes          string    `json:"notes,omitempty"`
-	MarkedByAdmin  bool      `json:"marked_by_admin"`
+	AttendanceID   uint64    `json:"attendance_id"`
+	PunchType      string    `json:"punch_type"`
+	PunchTime      time.Time `json:"punch_time"`
+	Latitude       *float64  `json:"latitude,omitempty"`
+	Longitude      *float64  `json:"longitude,omitempty"`
+	DistanceMeters *float64  `json:"distance_meters,omitempty"`
+	KioskID        *uint64   `json:"kiosk_id,omitempty"`
 	CreatedAt      time.Time `json:"created_at"`
-	ModifiedAt     time.Time `json:"modified_at"`
+}
+
+// WorkedTimeSummary holds the totals derived from the punches of a day
+type WorkedTimeSummary struct {
+	FirstIn       time.Time
+	LastOut       time.Time
+	WorkedMinutes int
+	BreakMinutes  int
 }
 
 // AttendanceListResponse represents the response for attendance list
@@ -52,40 +176,124 @@ type AttendanceListResponse struct {
 
 // AttendanceQueryParams represents query parameters for attendance list
 type AttendanceQueryParams struct {
-	Page           int       `query:"page" validate:"omitempty,min=1"`
-	Limit          int       `query:"limit" validate:"omitempty,min=1,max=100"`
-	EmployeeID     uint64    `query:"employee_id" validate:"omitempty"`
-	StartDate      time.Time `query:"start_date" validate:"omitempty"`
-	EndDate        time.Time `query:"end_date" validate:"omitempty"`
-	Status         string    `query:"status" validate:"omitempty,oneof=present absent late half_day"`
-	IncludeWeekend *bool     `query:"include_weekend"`
+	Page            int       `query:"page" validate:"omitempty,min=1"`
+	Limit           int       `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID      uint64    `query:"employee_id" validate:"omitempty"`
+	StartDate       time.Time `query:"start_date" validate:"omitempty"`
+	EndDate         time.Time `query:"end_date" validate:"omitempty"`
+	Status          string    `query:"status" validate:"omitempty,oneof=present absent late half_day leave unpaid_leave remote business_trip sick"`
+	IncludeWeekend  *bool     `query:"include_weekend"`
+	LocationFlagged *bool     `query:"location_flagged"`
+	AutoClosed      *bool     `query:"auto_closed"`
 }
 
 // DailyAttendanceSummary represents daily attendance summary
 type DailyAttendanceSummary struct {
-	Date           time.Time `json:"date"`
-	TotalEmployees int       `json:"total_employees"`
-	PresentCount   int       `json:"present_count"`
-	AbsentCount    int       `json:"absent_count"`
-	LateCount      int       `json:"late_count"`
-	HalfDayCount   int       `json:"half_day_count"`
-	PresentPercent float64   `json:"present_percent"`
-	AbsentPercent  float64   `json:"absent_percent"`
+	Date              time.Time `json:"date"`
+	TotalEmployees    int       `json:"total_employees"`
+	PresentCount      int       `json:"present_count"`
+	AbsentCount       int       `json:"absent_count"`
+	LateCount         int       `json:"late_count"`
+	HalfDayCount      int       `json:"half_day_count"`
+	LeaveCount        int       `json:"leave_count"`
+	RemoteCount       int       `json:"remote_count"`
+	BusinessTripCount int       `json:"business_trip_count"`
+	SickCount         int       `json:"sick_count"`
+	PresentPercent    float64   `json:"present_percent"`
+	AbsentPercent     float64   `json:"absent_percent"`
 }
 
 // BulkMarkAttendanceRequest represents bulk attendance marking
 type BulkMarkAttendanceRequest struct {
-	AttendanceDate time.Time            `json:"attendance_date" validate:"required"`
-	Attendances    []BulkAttendanceItem `json:"attendances" validate:"required,dive"`
+	AttendanceDate string               `json:"attendance_date" validate:"required,datetime=2006-01-02"`
+	Attendances    []BulkAttendanceItem `json:"attendances" validate:"required,min=1,max=500,dive"`
+	// Atomic marks all rows or none, otherwise every valid row is kept
+	Atomic bool `json:"atomic,omitempty"`
 }
 
 // BulkAttendanceItem represents individual attendance in bulk request
 type BulkAttendanceItem struct {
-	EmployeeID   uint64    `json:"employee_id" validate:"required"`
-	CheckInTime  time.Time `json:"check_in_time,omitempty"`
-	CheckOutTime time.Time `json:"check_out_time,omitempty"`
-	Status       string    `json:"status" validate:"required,oneof=present absent late half_day"`
-	Notes        string    `json:"notes,omitempty" validate:"omitempty,max=500"`
+	EmployeeID   uint64 `json:"employee_id" validate:"required"`
+	CheckInTime  string `json:"check_in_time,omitempty"`
+	CheckOutTime string `json:"check_out_time,omitempty"`
+	Status       string `json:"status" validate:"required,oneof=present absent late half_day remote business_trip sick"`
+	Notes        string `json:"notes,omitempty" validate:"omitempty,max=500"`
+
+	StatusMetadata *AttendanceStatusMetadata `json:"status_metadata,omitempty" validate:"omitempty"`
+}
+
+// ToMarkAttendanceRequest converts a bulk row to the standard DTO, wall clock times are read in loc
+func (i *BulkAttendanceItem) ToMarkAttendanceRequest(attendanceDate string, loc *time.Location) (*MarkAttendanceRequest, error) {
+	flexible := &MarkAttendanceRequestFlexible{
+		EmployeeID:     i.EmployeeID,
+		AttendanceDate: attendanceDate,
+		CheckInTime:    i.CheckInTime,
+		CheckOutTime:   i.CheckOutTime,
+		Status:         i.Status,
+		Notes:          i.Notes,
+		MarkedByAdmin:  true,
+		StatusMetadata: i.StatusMetadata,
+	}
+
+	return flexible.ToMarkAttendanceRequest(loc)
+}
+
+// BulkMarkAttendanceResponse reports the outcome of every row of a bulk attendance marking
+type BulkMarkAttendanceResponse struct {
+	AttendanceDate time.Time              `json:"attendance_date"`
+	Atomic         bool                   `json:"atomic"`
+	Total          int                    `json:"total"`
+	Succeeded      int                    `json:"succeeded"`
+	Failed         int                    `json:"failed"`
+	Results        []BulkAttendanceResult `json:"results"`
+}
+
+// BulkAttendanceResult reports the outcome of one row of a bulk attendance marking
+type BulkAttendanceResult struct {
+	Index      int                 `json:"index"`
+	EmployeeID uint64              `json:"employee_id"`
+	Success    bool                `json:"success"`
+	Error      string              `json:"error,omitempty"`
+	Attendance *AttendanceResponse `json:"attendance,omitempty"`
+}
+
+// BackfillAbsenceRequest represents a date range in which missing attendance is marked absent
+type BackfillAbsenceRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+// AutoAbsentResult reports the employees marked absent by the auto-absent job or a backfill
+type AutoAbsentResult struct {
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	MarkedAbsent int             `json:"marked_absent"`
+	Days         []AutoAbsentDay `json:"days"`
+}
+
+// AutoAbsentDay reports the employees marked absent on one date
+type AutoAbsentDay struct {
+	Date         time.Time `json:"date"`
+	MarkedAbsent int       `json:"marked_absent"`
+	Holiday      string    `json:"holiday,omitempty"`
+	PeriodLocked bool      `json:"period_locked,omitempty"`
+}
+
+// AutoCheckOutResult reports the attendance records closed by the auto check-out job
+type AutoCheckOutResult struct {
+	Closed  int                  `json:"closed"`
+	Records []AutoCheckOutRecord `json:"records"`
+	// PeriodLocked counts records left open because the payroll of their month was closed
+	PeriodLocked int `json:"period_locked,omitempty"`
+}
+
+// AutoCheckOutRecord reports one attendance record closed by the auto check-out job
+type AutoCheckOutRecord struct {
+	AttendanceID   uint64    `json:"attendance_id"`
+	EmployeeID     uint64    `json:"employee_id"`
+	EmployeeName   string    `json:"employee_name,omitempty"`
+	AttendanceDate time.Time `json:"attendance_date"`
+	CheckOutTime   time.Time `json:"check_out_time"`
 }
 
 // MarkAttendanceRequestFlexible represents a flexible input for attendance marking
@@ -94,13 +302,15 @@ type MarkAttendanceRequestFlexible struct {
 	AttendanceDate string `json:"attendance_date" validate:"required"`
 	CheckInTime    string `json:"check_in_time,omitempty"`
 	CheckOutTime   string `json:"check_out_time,omitempty"`
-	Status         string `json:"status" validate:"required,oneof=present absent late half_day"`
+	Status         string `json:"status" validate:"required,oneof=present absent late half_day remote business_trip sick"`
 	Notes          string `json:"notes,omitempty" validate:"omitempty,max=500"`
 	MarkedByAdmin  bool   `json:"marked_by_admin,omitempty"`
+
+	StatusMetadata *AttendanceStatusMetadata `json:"status_metadata,omitempty" validate:"omitempty"`
 }
 
-// ToMarkAttendanceRequest converts flexible input to standard DTO
-func (f *MarkAttendanceRequestFlexible) ToMarkAttendanceRequest() (*MarkAttendanceRequest, error) {
+// ToMarkAttendanceRequest converts flexible input to standard DTO, wall clock times are read in loc
+func (f *MarkAttendanceRequestFlexible) ToMarkAttendanceRequest(loc *time.Location) (*MarkAttendanceRequest, error) {
 	// Parse attendance date
 	attendanceDate, err := parseFlexibleDate(f.AttendanceDate)
 	if err != nil {
@@ -110,7 +320,7 @@ func (f *MarkAttendanceRequestFlexible) ToMarkAttendanceRequest() (*MarkAttendan
 	// Parse check-in time if provided
 	var checkInTime time.Time
 	if f.CheckInTime != "" {
-		checkInTime, err = parseFlexibleTime(f.CheckInTime, attendanceDate)
+		checkInTime, err = parseFlexibleTime(f.CheckInTime, attendanceDate, loc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid check-in time: %w", err)
 		}
@@ -119,7 +329,7 @@ func (f *MarkAttendanceRequestFlexible) ToMarkAttendanceRequest() (*MarkAttendan
 	// Parse check-out time if provided
 	var checkOutTime time.Time
 	if f.CheckOutTime != "" {
-		checkOutTime, err = parseFlexibleTime(f.CheckOutTime, attendanceDate)
+		checkOutTime, err = parseFlexibleTime(f.CheckOutTime, attendanceDate, loc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid check-out time: %w", err)
 		}
@@ -133,6 +343,7 @@ func (f *MarkAttendanceRequestFlexible) ToMarkAttendanceRequest() (*MarkAttendan
 		Status:         f.Status,
 		Notes:          f.Notes,
 		MarkedByAdmin:  f.MarkedByAdmin,
+		StatusMetadata: f.StatusMetadata,
 	}, nil
 }
 
@@ -156,8 +367,8 @@ func parseFlexibleDate(dateStr string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", dateStr)
 }
 
-// parseFlexibleTime parses time string in various formats
-func parseFlexibleTime(timeStr string, baseDate time.Time) (time.Time, error) {
+// parseFlexibleTime parses a wall clock time in various formats on the base date in loc and returns it in UTC
+func parseFlexibleTime(timeStr string, baseDate time.Time, loc *time.Location) (time.Time, error) {
 	if timeStr == "" {
 		return time.Time{}, nil
 	}
@@ -175,8 +386,8 @@ func parseFlexibleTime(timeStr string, baseDate time.Time) (time.Time, error) {
 			// Combine with the base date
 			return time.Date(
 				baseDate.Year(), baseDate.Month(), baseDate.Day(),
-				t.Hour(), t.Minute(), t.Second(), 0, time.UTC,
-			), nil
+				t.Hour(), t.Minute(), t.Second(), 0, loc,
+			).UTC(), nil
 		}
 	}
 
@@ -187,21 +398,23 @@ func parseFlexibleTime(timeStr string, baseDate time.Time) (time.Time, error) {
 type UpdateAttendanceRequestFlexible struct {
 	CheckInTime   string `json:"check_in_time,omitempty"`
 	CheckOutTime  string `json:"check_out_time,omitempty"`
-	Status        string `json:"status,omitempty" validate:"omitempty,oneof=present absent late half_day"`
+	Status        string `json:"status,omitempty" validate:"omitempty,oneof=present absent late half_day remote business_trip sick"`
 	Notes         string `json:"notes,omitempty" validate:"omitempty,max=500"`
 	MarkedByAdmin *bool  `json:"marked_by_admin,omitempty"`
+
+	StatusMetadata *AttendanceStatusMetadata `json:"status_metadata,omitempty" validate:"omitempty"`
 }
 
-// ToUpdateAttendanceRequest converts flexible input to standard DTO
-func (f *UpdateAttendanceRequestFlexible) ToUpdateAttendanceRequest() (*UpdateAttendanceRequest, error) {
-	// We need a base date for time parsing - use today as default
-	baseDate := time.Now()
+// ToUpdateAttendanceRequest converts flexible input to standard DTO, wall clock times are read
+// on the attendance date in loc
+func (f *UpdateAttendanceRequestFlexible) ToUpdateAttendanceRequest(attendanceDate time.Time, loc *time.Location) (*UpdateAttendanceRequest, error) {
+	baseDate := attendanceDate
 
 	// Parse check-in time if provided
 	var checkInTime time.Time
 	if f.CheckInTime != "" {
 		var err error
-		checkInTime, err = parseFlexibleTime(f.CheckInTime, baseDate)
+		checkInTime, err = parseFlexibleTime(f.CheckInTime, baseDate, loc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid check-in time: %w", err)
 		}
@@ -211,17 +424,18 @@ func (f *UpdateAttendanceRequestFlexible) ToUpdateAttendanceRequest() (*UpdateAt
 	var checkOutTime time.Time
 	if f.CheckOutTime != "" {
 		var err error
-		checkOutTime, err = parseFlexibleTime(f.CheckOutTime, baseDate)
+		checkOutTime, err = parseFlexibleTime(f.CheckOutTime, baseDate, loc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid check-out time: %w", err)
 		}
 	}
 
 	return &UpdateAttendanceRequest{
-		CheckInTime:   checkInTime,
-		CheckOutTime:  checkOutTime,
-		Status:        f.Status,
-		Notes:         f.Notes,
-		MarkedByAdmin: f.MarkedByAdmin,
+		CheckInTime:    checkInTime,
+		CheckOutTime:   checkOutTime,
+		Status:         f.Status,
+		Notes:          f.Notes,
+		MarkedByAdmin:  f.MarkedByAdmin,
+		StatusMetadata: f.StatusMetadata,
 	}, nil
 }
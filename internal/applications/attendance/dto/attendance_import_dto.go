@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// PunchImportResult reports what an import of a fingerprint terminal log did, or would do on a dry run
+type PunchImportResult struct {
+	DryRun           bool               `json:"dry_run"`
+	Lines            int                `json:"lines"`
+	Punches          int                `json:"punches"`
+	DuplicatePunches int                `json:"duplicate_punches"`
+	ImportedDays     int                `json:"imported_days"`
+	RejectedDays     int                `json:"rejected_days"`
+	Days             []PunchImportDay   `json:"days"`
+	Errors           []PunchImportError `json:"errors"`
+}
+
+// PunchImportDay is the daily attendance an employee's punches collapse into
+type PunchImportDay struct {
+	EmployeeID     uint64    `json:"employee_id"`
+	EmployeeName   string    `json:"employee_name"`
+	DevicePIN      string    `json:"device_pin"`
+	AttendanceDate time.Time `json:"attendance_date"`
+	// Lines are the log lines of the day's punches that were not recorded before, a rejected day rejects all of them
+	Lines []int `json:"lines"`
+	// Action is create for a new record, merge when punches are added to an existing one, unchanged when
+	// every punch was imported before and rejected when none of the new punches could be stored
+	Action        string             `json:"action"`
+	AttendanceID  uint64             `json:"attendance_id,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	CheckInTime   time.Time          `json:"check_in_time,omitempty"`
+	CheckOutTime  time.Time          `json:"check_out_time,omitempty"`
+	WorkedMinutes int                `json:"worked_minutes"`
+	BreakMinutes  int                `json:"break_minutes"`
+	NewPunches    []PunchImportPunch `json:"new_punches"`
+	// SkippedPunches break the sequence of the day, e.g. a second check-in, they are flagged as an anomaly
+	SkippedPunches []PunchImportPunch `json:"skipped_punches"`
+	Error          string             `json:"error,omitempty"`
+}
+
+// PunchImportPunch is a punch of the log that is added to the daily attendance, or skipped for the reason given
+type PunchImportPunch struct {
+	Line      int       `json:"line"`
+	PunchType string    `json:"punch_type"`
+	PunchTime time.Time `json:"punch_time"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+// PunchImportError reports a line of the log that was not imported
+type PunchImportError struct {
+	Line      int    `json:"line,omitempty"`
+	DevicePIN string `json:"device_pin,omitempty"`
+	Message   string `json:"message"`
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// AttendanceAnomalyRepository defines the interface for attendance anomaly data operations
+type AttendanceAnomalyRepository interface {
+	GetScanCandidates(ctx context.Context, startDate, endDate time.Time) ([]*ent.Attendance, error)
+	GetByAttendance(ctx context.Context, attendanceID uint64) ([]*ent.AttendanceAnomaly, error)
+	Create(ctx context.Context, record *ent.Attendance, rule attendanceanomaly.Rule, details string) (*ent.AttendanceAnomaly, error)
+	GetByID(ctx context.Context, id uint64) (*ent.AttendanceAnomaly, error)
+	List(ctx context.Context, params *dto.AttendanceAnomalyQueryParams) ([]*ent.AttendanceAnomaly, int, error)
+	Resolve(ctx context.Context, id uint64, resolvedBy *uint64, notes string) (*ent.AttendanceAnomaly, error)
+	Reopen(ctx context.Context, id uint64, details string) (*ent.AttendanceAnomaly, error)
+}
+
+// AttendanceAnomalyRepositoryImpl implements the AttendanceAnomalyRepository interface
+type AttendanceAnomalyRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewAttendanceAnomalyRepository creates a new attendance anomaly repository instance
+func NewAttendanceAnomalyRepository(client *ent.Client) *AttendanceAnomalyRepositoryImpl {
+	return &AttendanceAnomalyRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, so an import flags its skipped punches together with the day
+func (r *AttendanceAnomalyRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// GetScanCandidates retrieves the attendance records with a check-in in the date range, the only ones
+// the detection rules can apply to
+func (r *AttendanceAnomalyRepositoryImpl) GetScanCandidates(ctx context.Context, startDate, endDate time.Time) ([]*ent.Attendance, error) {
+	return r.client.Attendance.
+		Query().
+		Where(attendance.AttendanceDateGTE(startDate)).
+		Where(attendance.AttendanceDateLTE(endDate)).
+		Where(attendance.CheckInTimeNotNil()).
+		Where(attendance.DeletedAtIsNil()).
+		Order(ent.Asc(attendance.FieldAttendanceDate), ent.Asc(attendance.FieldID)).
+		All(ctx)
+}
+
+// GetByAttendance retrieves the anomalies already reported for an attendance record
+func (r *AttendanceAnomalyRepositoryImpl) GetByAttendance(ctx context.Context, attendanceID uint64) ([]*ent.AttendanceAnomaly, error) {
+	return r.db(ctx).AttendanceAnomaly.
+		Query().
+		Where(attendanceanomaly.AttendanceID(attendanceID)).
+		Where(attendanceanomaly.DeletedAtIsNil()).
+		All(ctx)
+}
+
+// Create stores an anomaly found by a detection rule
+func (r *AttendanceAnomalyRepositoryImpl) Create(ctx context.Context, record *ent.Attendance, rule attendanceanomaly.Rule, details string) (*ent.AttendanceAnomaly, error) {
+	return r.db(ctx).AttendanceAnomaly.Create().
+		SetAttendanceID(record.ID).
+		SetEmployeeID(record.EmployeeID).
+		SetAttendanceDate(record.AttendanceDate).
+		SetRule(rule).
+		SetDetails(details).
+		Save(ctx)
+}
+
+// GetByID retrieves an attendance anomaly by ID
+func (r *AttendanceAnomalyRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.AttendanceAnomaly, error) {
+	return r.db(ctx).AttendanceAnomaly.
+		Query().
+		Where(attendanceanomaly.ID(id)).
+		Where(attendanceanomaly.DeletedAtIsNil()).
+		WithEmployee().
+		First(ctx)
+}
+
+// List retrieves attendance anomalies with pagination and filtering
+func (r *AttendanceAnomalyRepositoryImpl) List(ctx context.Context, params *dto.AttendanceAnomalyQueryParams) ([]*ent.AttendanceAnomaly, int, error) {
+	query := r.client.AttendanceAnomaly.
+		Query().
+		Where(attendanceanomaly.DeletedAtIsNil()).
+		WithEmployee()
+
+	// Apply filters
+	if params.EmployeeID > 0 {
+		query = query.Where(attendanceanomaly.EmployeeID(params.EmployeeID))
+	}
+
+	if params.Rule != "" {
+		query = query.Where(attendanceanomaly.RuleEQ(attendanceanomaly.Rule(params.Rule)))
+	}
+
+	if params.Status != "" {
+		query = query.Where(attendanceanomaly.StatusEQ(attendanceanomaly.Status(params.Status)))
+	}
+
+	if !params.StartDate.IsZero() {
+		query = query.Where(attendanceanomaly.AttendanceDateGTE(params.StartDate))
+	}
+
+	if !params.EndDate.IsZero() {
+		query = query.Where(attendanceanomaly.AttendanceDateLTE(params.EndDate))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendance anomalies: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by most recent attendance first
+	query = query.Order(ent.Desc(attendanceanomaly.FieldAttendanceDate), ent.Desc(attendanceanomaly.FieldID))
+
+	anomalies, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance anomalies: %w", err)
+	}
+
+	return anomalies, total, nil
+}
+
+// Resolve closes an anomaly, resolvedBy is nil when the detector found the attendance corrected
+func (r *AttendanceAnomalyRepositoryImpl) Resolve(ctx context.Context, id uint64, resolvedBy *uint64, notes string) (*ent.AttendanceAnomaly, error) {
+	query := r.db(ctx).AttendanceAnomaly.UpdateOneID(id).
+		SetStatus(attendanceanomaly.StatusResolved).
+		SetNillableResolvedBy(resolvedBy).
+		SetResolvedAt(time.Now())
+
+	if notes != "" {
+		query = query.SetResolutionNotes(notes)
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// Reopen replaces the details of an anomaly found again and opens it for review, clearing an earlier resolution
+func (r *AttendanceAnomalyRepositoryImpl) Reopen(ctx context.Context, id uint64, details string) (*ent.AttendanceAnomaly, error) {
+	err := r.db(ctx).AttendanceAnomaly.UpdateOneID(id).
+		SetDetails(details).
+		SetStatus(attendanceanomaly.StatusOpen).
+		ClearResolvedBy().
+		ClearResolvedAt().
+		ClearResolutionNotes().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
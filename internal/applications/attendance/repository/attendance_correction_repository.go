@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// AttendanceCorrectionRepository defines the interface for attendance correction data operations
+type AttendanceCorrectionRepository interface {
+	Create(ctx context.Context, original *ent.Attendance, req *dto.CreateAttendanceCorrectionRequest, proposedCheckIn, proposedCheckOut time.Time) (*ent.AttendanceCorrection, error)
+	GetByID(ctx context.Context, id uint64) (*ent.AttendanceCorrection, error)
+	List(ctx context.Context, params *dto.AttendanceCorrectionQueryParams) ([]*ent.AttendanceCorrection, int, error)
+	HasPending(ctx context.Context, attendanceID uint64) (bool, error)
+	Review(ctx context.Context, id uint64, status attendancecorrection.Status, req *dto.ReviewAttendanceCorrectionRequest) (bool, error)
+}
+
+// AttendanceCorrectionRepositoryImpl implements the AttendanceCorrectionRepository interface
+type AttendanceCorrectionRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewAttendanceCorrectionRepository creates a new attendance correction repository instance
+func NewAttendanceCorrectionRepository(client *ent.Client) *AttendanceCorrectionRepositoryImpl {
+	return &AttendanceCorrectionRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, an approved correction and its attendance change commit together
+func (r *AttendanceCorrectionRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// Create stores a correction request together with a snapshot of the current attendance values
+func (r *AttendanceCorrectionRepositoryImpl) Create(ctx context.Context, original *ent.Attendance, req *dto.CreateAttendanceCorrectionRequest, proposedCheckIn, proposedCheckOut time.Time) (*ent.AttendanceCorrection, error) {
+	query := r.db(ctx).AttendanceCorrection.Create().
+		SetAttendanceID(original.ID).
+		SetEmployeeID(req.EmployeeID).
+		SetOriginalStatus(string(original.Status)).
+		SetReason(req.Reason)
+
+	if !original.CheckInTime.IsZero() {
+		query = query.SetOriginalCheckInTime(original.CheckInTime)
+	}
+	if !original.CheckOutTime.IsZero() {
+		query = query.SetOriginalCheckOutTime(original.CheckOutTime)
+	}
+	if !proposedCheckIn.IsZero() {
+		query = query.SetProposedCheckInTime(proposedCheckIn)
+	}
+	if !proposedCheckOut.IsZero() {
+		query = query.SetProposedCheckOutTime(proposedCheckOut)
+	}
+	if req.Status != "" {
+		query = query.SetProposedStatus(req.Status)
+	}
+
+	created, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, created.ID)
+}
+
+// GetByID retrieves an attendance correction by ID
+func (r *AttendanceCorrectionRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.AttendanceCorrection, error) {
+	return r.db(ctx).AttendanceCorrection.
+		Query().
+		Where(attendancecorrection.ID(id)).
+		Where(attendancecorrection.DeletedAtIsNil()).
+		WithAttendance().
+		WithEmployee().
+		First(ctx)
+}
+
+// List retrieves attendance corrections with pagination and filtering
+func (r *AttendanceCorrectionRepositoryImpl) List(ctx context.Context, params *dto.AttendanceCorrectionQueryParams) ([]*ent.AttendanceCorrection, int, error) {
+	query := r.db(ctx).AttendanceCorrection.
+		Query().
+		Where(attendancecorrection.DeletedAtIsNil()).
+		WithAttendance().
+		WithEmployee()
+
+	// Apply filters
+	if params.EmployeeID > 0 {
+		query = query.Where(attendancecorrection.EmployeeID(params.EmployeeID))
+	}
+
+	if params.AttendanceID > 0 {
+		query = query.Where(attendancecorrection.AttendanceID(params.AttendanceID))
+	}
+
+	if params.Status != "" {
+		query = query.Where(attendancecorrection.StatusEQ(attendancecorrection.Status(params.Status)))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendance corrections: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by newest first
+	query = query.Order(ent.Desc(attendancecorrection.FieldCreatedAt), ent.Desc(attendancecorrection.FieldID))
+
+	corrections, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance corrections: %w", err)
+	}
+
+	return corrections, total, nil
+}
+
+// HasPending checks if the attendance record already has a correction waiting for review
+func (r *AttendanceCorrectionRepositoryImpl) HasPending(ctx context.Context, attendanceID uint64) (bool, error) {
+	return r.db(ctx).AttendanceCorrection.
+		Query().
+		Where(attendancecorrection.AttendanceID(attendanceID)).
+		Where(attendancecorrection.StatusEQ(attendancecorrection.StatusPending)).
+		Where(attendancecorrection.DeletedAtIsNil()).
+		Exist(ctx)
+}
+
+// Review records the approval or rejection of a correction. The correction is claimed only while it is still
+// pending, false is returned when a concurrent review got to it first.
+func (r *AttendanceCorrectionRepositoryImpl) Review(ctx context.Context, id uint64, status attendancecorrection.Status, req *dto.ReviewAttendanceCorrectionRequest) (bool, error) {
+	query := r.db(ctx).AttendanceCorrection.Update().
+		Where(attendancecorrection.ID(id)).
+		Where(attendancecorrection.StatusEQ(attendancecorrection.StatusPending)).
+		Where(attendancecorrection.DeletedAtIsNil()).
+		SetStatus(status).
+		SetReviewedBy(req.ReviewedBy).
+		SetReviewedAt(time.Now())
+
+	if req.ReviewNotes != "" {
+		query = query.SetReviewNotes(req.ReviewNotes)
+	}
+
+	claimed, err := query.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return claimed > 0, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendanceCorrectionRepositoryImpl(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Forgot Checkout").
+		SetEmail("forgot.checkout@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+	checkIn := time.Date(2025, time.August, 4, 8, 55, 0, 0, time.UTC)
+	original, err := client.Attendance.Create().
+		SetEmployeeID(emp.ID).
+		SetAttendanceDate(monday).
+		SetCheckInTime(checkIn).
+		SetStatus(attendance.StatusPresent).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewAttendanceCorrectionRepository(client)
+
+	proposedCheckOut := time.Date(2025, time.August, 4, 17, 30, 0, 0, time.UTC)
+	correction, err := repo.Create(ctx, original, &dto.CreateAttendanceCorrectionRequest{
+		EmployeeID:   emp.ID,
+		CheckOutTime: "17:30",
+		Reason:       "Forgot to check out",
+	}, time.Time{}, proposedCheckOut)
+	require.NoError(t, err)
+
+	t.Run("create snapshots original values", func(t *testing.T) {
+		assert.Equal(t, attendancecorrection.StatusPending, correction.Status)
+		assert.Equal(t, "present", correction.OriginalStatus)
+		require.NotNil(t, correction.OriginalCheckInTime)
+		assert.True(t, checkIn.Equal(*correction.OriginalCheckInTime))
+		assert.Nil(t, correction.OriginalCheckOutTime)
+		assert.Nil(t, correction.ProposedCheckInTime)
+		require.NotNil(t, correction.ProposedCheckOutTime)
+		assert.True(t, proposedCheckOut.Equal(*correction.ProposedCheckOutTime))
+		assert.Equal(t, emp.FullName, correction.Edges.Employee.FullName)
+	})
+
+	t.Run("pending correction is detected", func(t *testing.T) {
+		pending, err := repo.HasPending(ctx, original.ID)
+		assert.NoError(t, err)
+		assert.True(t, pending)
+	})
+
+	t.Run("review records reviewer and clears pending", func(t *testing.T) {
+		review := &dto.ReviewAttendanceCorrectionRequest{
+			ReviewedBy:  99,
+			ReviewNotes: "Confirmed with security log",
+		}
+		claimed, err := repo.Review(ctx, correction.ID, attendancecorrection.StatusApproved, review)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		reviewed, err := repo.GetByID(ctx, correction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, attendancecorrection.StatusApproved, reviewed.Status)
+		require.NotNil(t, reviewed.ReviewedBy)
+		assert.Equal(t, uint64(99), *reviewed.ReviewedBy)
+		assert.NotNil(t, reviewed.ReviewedAt)
+
+		pending, err := repo.HasPending(ctx, original.ID)
+		assert.NoError(t, err)
+		assert.False(t, pending)
+
+		// A second review of the same correction claims nothing
+		claimed, err = repo.Review(ctx, correction.ID, attendancecorrection.StatusRejected, review)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("list filters by status", func(t *testing.T) {
+		corrections, total, err := repo.List(ctx, &dto.AttendanceCorrectionQueryParams{
+			Page:   1,
+			Limit:  10,
+			Status: "approved",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, corrections, 1)
+	})
+}
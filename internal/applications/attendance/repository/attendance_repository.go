@@ -7,8 +7,14 @@ import (
 
 	"mceasy/ent"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepunch"
 	"mceasy/ent/employee"
+	"mceasy/ent/kiosk"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/overtime"
 	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/calendar"
 )
 
 // AttendanceRepository defines the interface for attendance data operations
@@ -16,27 +22,52 @@ type AttendanceRepository interface {
 	MarkAttendance(ctx context.Context, req *dto.MarkAttendanceRequest) (*ent.Attendance, error)
 	GetByID(ctx context.Context, id uint64) (*ent.Attendance, error)
 	GetByEmployeeAndDate(ctx context.Context, employeeID uint64, date time.Time) (*ent.Attendance, error)
+	GetOpenAttendance(ctx context.Context, employeeID uint64, date time.Time) (*ent.Attendance, error)
+	GetEmployeeOfficeLocation(ctx context.Context, employeeID uint64) (*ent.OfficeLocation, error)
+	GetEmployeesByDevicePINs(ctx context.Context, pins []string) ([]*ent.Employee, error)
+	GetKioskByID(ctx context.Context, id uint64) (*ent.Kiosk, error)
 	Update(ctx context.Context, id uint64, req *dto.UpdateAttendanceRequest) (*ent.Attendance, error)
 	Delete(ctx context.Context, id uint64) error
 	List(ctx context.Context, params *dto.AttendanceQueryParams) ([]*ent.Attendance, int, error)
 	GetTodayAttendance(ctx context.Context) ([]*ent.Attendance, error)
 	GetDailyAttendanceSummary(ctx context.Context, date time.Time) (*dto.DailyAttendanceSummary, error)
-	BulkMarkAttendance(ctx context.Context, req *dto.BulkMarkAttendanceRequest) error
 	GetAttendanceByDateRange(ctx context.Context, employeeID uint64, startDate, endDate time.Time) ([]*ent.Attendance, error)
+	CreatePunch(ctx context.Context, attendanceID uint64, punchType attendancepunch.PunchType, punchTime time.Time, location *dto.PunchLocation) (*ent.AttendancePunch, error)
+	GetPunches(ctx context.Context, attendanceID uint64) ([]*ent.AttendancePunch, error)
+	UpdatePunchTime(ctx context.Context, id uint64, punchTime time.Time) error
+	UpdateWorkedTime(ctx context.Context, id uint64, summary *dto.WorkedTimeSummary) (*ent.Attendance, error)
+	SaveDetectedOvertime(ctx context.Context, record *ent.Attendance, scheduledEnd *time.Time, minutes int, restDay bool) error
+	DeletePendingOvertime(ctx context.Context, attendanceID uint64) error
+	GetAbsenceCandidates(ctx context.Context, date time.Time) ([]*ent.Employee, error)
+	MarkAbsent(ctx context.Context, employeeID uint64, date time.Time, notes string) (bool, error)
+	GetUnclosedAttendances(ctx context.Context, endDate time.Time) ([]*ent.Attendance, error)
+	MarkAutoClosed(ctx context.Context, id uint64, notes string) error
 }
 
 // AttendanceRepositoryImpl implements the AttendanceRepository interface
 type AttendanceRepositoryImpl struct {
-	client *ent.Client
+	client   *ent.Client
+	calendar calendar.WorkingDayCalendar
 }
 
 // NewAttendanceRepository creates a new attendance repository instance
-func NewAttendanceRepository(client *ent.Client) *AttendanceRepositoryImpl {
+func NewAttendanceRepository(client *ent.Client, calendar calendar.WorkingDayCalendar) *AttendanceRepositoryImpl {
 	return &AttendanceRepositoryImpl{
-		client: client,
+		client:   client,
+		calendar: calendar,
 	}
 }
 
+// db returns the client of the transaction carried by ctx, so a bulk operation can run several
+// repository calls atomically
+func (r *AttendanceRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
 // MarkAttendance creates or updates attendance record
 func (r *AttendanceRepositoryImpl) MarkAttendance(ctx context.Context, req *dto.MarkAttendanceRequest) (*ent.Attendance, error) {
 	// Check if attendance already exists for this employee and date
@@ -50,14 +81,22 @@ func (r *AttendanceRepositoryImpl) MarkAttendance(ctx context.Context, req *dto.
 			Status:        req.Status,
 			Notes:         req.Notes,
 			MarkedByAdmin: &req.MarkedByAdmin,
+
+			StatusMetadata:   req.StatusMetadata,
+			CheckInLocation:  req.CheckInLocation,
+			CheckOutLocation: req.CheckOutLocation,
 		}
 		return r.Update(ctx, existing.ID, updateReq)
 	}
 
-	// Create new attendance record
-	isWeekend := isWeekendDay(req.AttendanceDate)
+	// Create new attendance record, rest days follow the employee's work schedule
+	schedule, err := r.calendar.GetEmployeeSchedule(ctx, req.EmployeeID, req.AttendanceDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve work schedule: %w", err)
+	}
+	isWeekend := !schedule.IsWorkingWeekday(req.AttendanceDate)
 
-	query := r.client.Attendance.Create().
+	query := r.db(ctx).Attendance.Create().
 		SetEmployeeID(req.EmployeeID).
 		SetAttendanceDate(req.AttendanceDate).
 		SetStatus(attendance.Status(req.Status)).
@@ -73,13 +112,33 @@ func (r *AttendanceRepositoryImpl) MarkAttendance(ctx context.Context, req *dto.
 	if req.Notes != "" {
 		query = query.SetNotes(req.Notes)
 	}
+	if metadata := req.StatusMetadata.ToMap(); len(metadata) > 0 {
+		query = query.SetStatusMetadata(metadata)
+	}
+	if loc := req.CheckInLocation; loc != nil {
+		query = query.
+			SetNillableCheckInLatitude(loc.Latitude).
+			SetNillableCheckInLongitude(loc.Longitude).
+			SetNillableCheckInDistanceMeters(loc.DistanceMeters).
+			SetNillableCheckInKioskID(loc.KioskID)
+	}
+	if loc := req.CheckOutLocation; loc != nil {
+		query = query.
+			SetNillableCheckOutLatitude(loc.Latitude).
+			SetNillableCheckOutLongitude(loc.Longitude).
+			SetNillableCheckOutDistanceMeters(loc.DistanceMeters)
+	}
+	if req.CheckInLocation != nil && req.CheckInLocation.OutsideRadius ||
+		req.CheckOutLocation != nil && req.CheckOutLocation.OutsideRadius {
+		query = query.SetLocationFlagged(true)
+	}
 
 	return query.Save(ctx)
 }
 
 // GetByID retrieves an attendance record by ID
 func (r *AttendanceRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.Attendance, error) {
-	return r.client.Attendance.
+	return r.db(ctx).Attendance.
 		Query().
 		Where(attendance.ID(id)).
 		Where(attendance.DeletedAtIsNil()).
@@ -89,10 +148,10 @@ func (r *AttendanceRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent
 
 // GetByEmployeeAndDate retrieves attendance by employee ID and date
 func (r *AttendanceRepositoryImpl) GetByEmployeeAndDate(ctx context.Context, employeeID uint64, date time.Time) (*ent.Attendance, error) {
-	// Normalize date to start of day
-	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	// Normalize date to start of day, attendance dates are stored as UTC midnight
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
 
-	return r.client.Attendance.
+	return r.db(ctx).Attendance.
 		Query().
 		Where(attendance.EmployeeID(employeeID)).
 		Where(attendance.AttendanceDate(startOfDay)).
@@ -101,9 +160,29 @@ func (r *AttendanceRepositoryImpl) GetByEmployeeAndDate(ctx context.Context, emp
 		First(ctx)
 }
 
+// GetOpenAttendance retrieves the latest attendance of the employee that has a check-in but no check-out yet.
+// Records from the previous day are included so overnight shifts can be checked out after midnight.
+// The date is the employee's current date in the employee's timezone.
+func (r *AttendanceRepositoryImpl) GetOpenAttendance(ctx context.Context, employeeID uint64, date time.Time) (*ent.Attendance, error) {
+	endOfRange := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	startOfRange := endOfRange.AddDate(0, 0, -1)
+
+	return r.db(ctx).Attendance.
+		Query().
+		Where(attendance.EmployeeID(employeeID)).
+		Where(attendance.AttendanceDateGTE(startOfRange)).
+		Where(attendance.AttendanceDateLTE(endOfRange)).
+		Where(attendance.CheckInTimeNotNil()).
+		Where(attendance.CheckOutTimeIsNil()).
+		Where(attendance.DeletedAtIsNil()).
+		WithEmployee().
+		Order(ent.Desc(attendance.FieldAttendanceDate)).
+		First(ctx)
+}
+
 // Update updates an attendance record
 func (r *AttendanceRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateAttendanceRequest) (*ent.Attendance, error) {
-	query := r.client.Attendance.UpdateOneID(id)
+	query := r.db(ctx).Attendance.UpdateOneID(id)
 
 	if !req.CheckInTime.IsZero() {
 		query = query.SetCheckInTime(req.CheckInTime)
@@ -120,13 +199,76 @@ func (r *AttendanceRepositoryImpl) Update(ctx context.Context, id uint64, req *d
 	if req.MarkedByAdmin != nil {
 		query = query.SetMarkedByAdmin(*req.MarkedByAdmin)
 	}
+	if req.AutoClosed != nil {
+		query = query.SetAutoClosed(*req.AutoClosed)
+	}
+	if req.StatusMetadata != nil {
+		if metadata := req.StatusMetadata.ToMap(); len(metadata) > 0 {
+			query = query.SetStatusMetadata(metadata)
+		} else {
+			query = query.ClearStatusMetadata()
+		}
+	}
+	if loc := req.CheckInLocation; loc != nil {
+		query = query.
+			SetNillableCheckInLatitude(loc.Latitude).
+			SetNillableCheckInLongitude(loc.Longitude).
+			SetNillableCheckInDistanceMeters(loc.DistanceMeters).
+			SetNillableCheckInKioskID(loc.KioskID)
+	}
+	if loc := req.CheckOutLocation; loc != nil {
+		query = query.
+			SetNillableCheckOutLatitude(loc.Latitude).
+			SetNillableCheckOutLongitude(loc.Longitude).
+			SetNillableCheckOutDistanceMeters(loc.DistanceMeters)
+	}
+	// A flag stays until the record is reviewed, a later punch inside the radius does not clear it
+	if req.CheckInLocation != nil && req.CheckInLocation.OutsideRadius ||
+		req.CheckOutLocation != nil && req.CheckOutLocation.OutsideRadius {
+		query = query.SetLocationFlagged(true)
+	}
 
 	return query.Save(ctx)
 }
 
+// GetEmployeeOfficeLocation retrieves the active office location assigned to the employee, nil when there is none
+func (r *AttendanceRepositoryImpl) GetEmployeeOfficeLocation(ctx context.Context, employeeID uint64) (*ent.OfficeLocation, error) {
+	location, err := r.db(ctx).OfficeLocation.
+		Query().
+		Where(officelocation.HasEmployeesWith(employee.ID(employeeID))).
+		Where(officelocation.IsActive(true)).
+		Where(officelocation.DeletedAtIsNil()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return location, err
+}
+
+// GetEmployeesByDevicePINs retrieves the employees enrolled on the fingerprint terminals with the given PINs,
+// loaded with their office so punches can be read in the employee's timezone
+func (r *AttendanceRepositoryImpl) GetEmployeesByDevicePINs(ctx context.Context, pins []string) ([]*ent.Employee, error) {
+	return r.db(ctx).Employee.
+		Query().
+		Where(employee.DevicePinIn(pins...)).
+		Where(employee.DeletedAtIsNil()).
+		WithOfficeLocation().
+		All(ctx)
+}
+
+// GetKioskByID retrieves a check-in kiosk by ID
+func (r *AttendanceRepositoryImpl) GetKioskByID(ctx context.Context, id uint64) (*ent.Kiosk, error) {
+	return r.db(ctx).Kiosk.
+		Query().
+		Where(kiosk.ID(id)).
+		Where(kiosk.DeletedAtIsNil()).
+		First(ctx)
+}
+
 // Delete soft deletes an attendance record
 func (r *AttendanceRepositoryImpl) Delete(ctx context.Context, id uint64) error {
-	return r.client.Attendance.
+	return r.db(ctx).Attendance.
 		UpdateOneID(id).
 		SetDeletedAt(time.Now()).
 		Exec(ctx)
@@ -134,7 +276,7 @@ func (r *AttendanceRepositoryImpl) Delete(ctx context.Context, id uint64) error
 
 // List retrieves attendance records with pagination and filtering
 func (r *AttendanceRepositoryImpl) List(ctx context.Context, params *dto.AttendanceQueryParams) ([]*ent.Attendance, int, error) {
-	query := r.client.Attendance.
+	query := r.db(ctx).Attendance.
 		Query().
 		Where(attendance.DeletedAtIsNil()).
 		WithEmployee()
@@ -160,6 +302,13 @@ func (r *AttendanceRepositoryImpl) List(ctx context.Context, params *dto.Attenda
 		query = query.Where(attendance.IsWeekendEQ(false))
 	}
 
+	if params.LocationFlagged != nil {
+		query = query.Where(attendance.LocationFlagged(*params.LocationFlagged))
+	}
+	if params.AutoClosed != nil {
+		query = query.Where(attendance.AutoClosed(*params.AutoClosed))
+	}
+
 	// Get total count
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -183,26 +332,46 @@ func (r *AttendanceRepositoryImpl) List(ctx context.Context, params *dto.Attenda
 	return attendances, total, nil
 }
 
-// GetTodayAttendance retrieves today's attendance records
+// GetTodayAttendance retrieves today's attendance records, today being the current date in each employee's timezone
 func (r *AttendanceRepositoryImpl) GetTodayAttendance(ctx context.Context) ([]*ent.Attendance, error) {
-	today := time.Now()
-	startOfDay := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	now := time.Now()
+	utcToday := calendar.DateIn(now, time.UTC)
 
-	return r.client.Attendance.
+	// Any timezone is at most one day ahead of or behind UTC
+	attendances, err := r.db(ctx).Attendance.
 		Query().
-		Where(attendance.AttendanceDate(startOfDay)).
+		Where(attendance.AttendanceDateGTE(utcToday.AddDate(0, 0, -1))).
+		Where(attendance.AttendanceDateLTE(utcToday.AddDate(0, 0, 1))).
 		Where(attendance.DeletedAtIsNil()).
-		WithEmployee().
+		WithEmployee(func(q *ent.EmployeeQuery) {
+			q.WithOfficeLocation()
+		}).
 		Order(ent.Asc(attendance.FieldEmployeeID)).
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todayAttendances := make([]*ent.Attendance, 0, len(attendances))
+	for _, record := range attendances {
+		if record.Edges.Employee == nil {
+			continue
+		}
+		today := calendar.DateIn(now, calendar.EmployeeLocation(record.Edges.Employee))
+		if calendar.DateKey(record.AttendanceDate) == calendar.DateKey(today) {
+			todayAttendances = append(todayAttendances, record)
+		}
+	}
+
+	return todayAttendances, nil
 }
 
 // GetDailyAttendanceSummary calculates daily attendance summary
 func (r *AttendanceRepositoryImpl) GetDailyAttendanceSummary(ctx context.Context, date time.Time) (*dto.DailyAttendanceSummary, error) {
-	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
 
 	// Get total active employees
-	totalEmployees, err := r.client.Employee.
+	totalEmployees, err := r.db(ctx).Employee.
 		Query().
 		Where(employee.IsActiveEQ(true)).
 		Where(employee.DeletedAtIsNil()).
@@ -212,7 +381,7 @@ func (r *AttendanceRepositoryImpl) GetDailyAttendanceSummary(ctx context.Context
 	}
 
 	// Get attendance counts by status
-	attendanceRecords, err := r.client.Attendance.
+	attendanceRecords, err := r.db(ctx).Attendance.
 		Query().
 		Where(attendance.AttendanceDate(startOfDay)).
 		Where(attendance.DeletedAtIsNil()).
@@ -237,61 +406,250 @@ func (r *AttendanceRepositoryImpl) GetDailyAttendanceSummary(ctx context.Context
 			summary.LateCount++
 		case attendance.StatusHalfDay:
 			summary.HalfDayCount++
+		case attendance.StatusLeave, attendance.StatusUnpaidLeave:
+			summary.LeaveCount++
+		case attendance.StatusRemote:
+			summary.RemoteCount++
+		case attendance.StatusBusinessTrip:
+			summary.BusinessTripCount++
+		case attendance.StatusSick:
+			summary.SickCount++
 		}
 	}
 
-	// Calculate percentages
+	// Calculate percentages, working remotely or on a business trip counts as present
 	if totalEmployees > 0 {
-		summary.PresentPercent = float64(summary.PresentCount) / float64(totalEmployees) * 100
+		present := summary.PresentCount + summary.RemoteCount + summary.BusinessTripCount
+		summary.PresentPercent = float64(present) / float64(totalEmployees) * 100
 		summary.AbsentPercent = float64(summary.AbsentCount) / float64(totalEmployees) * 100
 	}
 
 	return summary, nil
 }
 
-// BulkMarkAttendance marks attendance for multiple employees
-func (r *AttendanceRepositoryImpl) BulkMarkAttendance(ctx context.Context, req *dto.BulkMarkAttendanceRequest) error {
+// GetAttendanceByDateRange retrieves attendance records for an employee within a date range
+func (r *AttendanceRepositoryImpl) GetAttendanceByDateRange(ctx context.Context, employeeID uint64, startDate, endDate time.Time) ([]*ent.Attendance, error) {
+	return r.db(ctx).Attendance.
+		Query().
+		Where(attendance.EmployeeID(employeeID)).
+		Where(attendance.AttendanceDateGTE(startDate)).
+		Where(attendance.AttendanceDateLTE(endDate)).
+		Where(attendance.DeletedAtIsNil()).
+		WithEmployee().
+		Order(ent.Asc(attendance.FieldAttendanceDate)).
+		All(ctx)
+}
+
+// CreatePunch records a punch on the daily attendance. The attendance keeps the location of the last check-out
+// and is flagged for review when the punch was outside the office radius.
+func (r *AttendanceRepositoryImpl) CreatePunch(ctx context.Context, attendanceID uint64, punchType attendancepunch.PunchType, punchTime time.Time, location *dto.PunchLocation) (*ent.AttendancePunch, error) {
+	// Join the transaction of a bulk operation instead of committing on our own
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return r.createPunch(ctx, tx.Client(), attendanceID, punchType, punchTime, location)
+	}
+
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	for _, item := range req.Attendances {
-		markReq := &dto.MarkAttendanceRequest{
-			EmployeeID:     item.EmployeeID,
-			AttendanceDate: req.AttendanceDate,
-			CheckInTime:    item.CheckInTime,
-			CheckOutTime:   item.CheckOutTime,
-			Status:         item.Status,
-			Notes:          item.Notes,
-			MarkedByAdmin:  true,
+	punch, err := r.createPunch(ctx, tx.Client(), attendanceID, punchType, punchTime, location)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return punch, nil
+}
+
+func (r *AttendanceRepositoryImpl) createPunch(ctx context.Context, client *ent.Client, attendanceID uint64, punchType attendancepunch.PunchType, punchTime time.Time, location *dto.PunchLocation) (*ent.AttendancePunch, error) {
+	query := client.AttendancePunch.Create().
+		SetAttendanceID(attendanceID).
+		SetPunchType(punchType).
+		SetPunchTime(punchTime)
+
+	if location != nil {
+		query = query.
+			SetNillableLatitude(location.Latitude).
+			SetNillableLongitude(location.Longitude).
+			SetNillableDistanceMeters(location.DistanceMeters).
+			SetNillableKioskID(location.KioskID)
+	}
+
+	punch, err := query.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create punch: %w", err)
+	}
+
+	if location != nil {
+		update := client.Attendance.UpdateOneID(attendanceID)
+		if punchType == attendancepunch.PunchTypeCheckOut {
+			update = update.
+				SetNillableCheckOutLatitude(location.Latitude).
+				SetNillableCheckOutLongitude(location.Longitude).
+				SetNillableCheckOutDistanceMeters(location.DistanceMeters)
 		}
+		if location.OutsideRadius {
+			update = update.SetLocationFlagged(true)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update punch location: %w", err)
+		}
+	}
 
-		_, err := r.MarkAttendance(ctx, markReq)
-		if err != nil {
-			return fmt.Errorf("failed to mark attendance for employee %d: %w", item.EmployeeID, err)
+	return punch, nil
+}
+
+// GetPunches retrieves the punches of a daily attendance in chronological order
+func (r *AttendanceRepositoryImpl) GetPunches(ctx context.Context, attendanceID uint64) ([]*ent.AttendancePunch, error) {
+	return r.db(ctx).AttendancePunch.
+		Query().
+		Where(attendancepunch.AttendanceID(attendanceID)).
+		Where(attendancepunch.DeletedAtIsNil()).
+		Order(ent.Asc(attendancepunch.FieldPunchTime), ent.Asc(attendancepunch.FieldID)).
+		All(ctx)
+}
+
+// UpdatePunchTime moves a punch to another time
+func (r *AttendanceRepositoryImpl) UpdatePunchTime(ctx context.Context, id uint64, punchTime time.Time) error {
+	return r.db(ctx).AttendancePunch.
+		UpdateOneID(id).
+		SetPunchTime(punchTime).
+		Exec(ctx)
+}
+
+// UpdateWorkedTime stores first-in, last-out and the worked and break minutes derived from the punches
+func (r *AttendanceRepositoryImpl) UpdateWorkedTime(ctx context.Context, id uint64, summary *dto.WorkedTimeSummary) (*ent.Attendance, error) {
+	query := r.db(ctx).Attendance.UpdateOneID(id).
+		SetWorkedMinutes(summary.WorkedMinutes).
+		SetBreakMinutes(summary.BreakMinutes)
+
+	if !summary.FirstIn.IsZero() {
+		query = query.SetCheckInTime(summary.FirstIn)
+
+		// The day stays open until the last punch is a check-out
+		if summary.LastOut.IsZero() {
+			query = query.ClearCheckOutTime()
+		} else {
+			query = query.SetCheckOutTime(summary.LastOut)
 		}
 	}
 
-	return tx.Commit()
+	return query.Save(ctx)
 }
 
-// GetAttendanceByDateRange retrieves attendance records for an employee within a date range
-func (r *AttendanceRepositoryImpl) GetAttendanceByDateRange(ctx context.Context, employeeID uint64, startDate, endDate time.Time) ([]*ent.Attendance, error) {
-	return r.client.Attendance.
+// SaveDetectedOvertime creates or refreshes the pending overtime of an attendance, reviewed overtime is left untouched
+func (r *AttendanceRepositoryImpl) SaveDetectedOvertime(ctx context.Context, record *ent.Attendance, scheduledEnd *time.Time, minutes int, restDay bool) error {
+	existing, err := r.db(ctx).Overtime.
+		Query().
+		Where(overtime.AttendanceID(record.ID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	if existing == nil {
+		return r.db(ctx).Overtime.Create().
+			SetEmployeeID(record.EmployeeID).
+			SetAttendanceID(record.ID).
+			SetOvertimeDate(record.AttendanceDate).
+			SetNillableScheduledEnd(scheduledEnd).
+			SetActualEnd(record.CheckOutTime).
+			SetMinutes(minutes).
+			SetIsRestDay(restDay).
+			Exec(ctx)
+	}
+
+	if existing.Status != overtime.StatusPending {
+		return nil
+	}
+
+	query := r.db(ctx).Overtime.UpdateOneID(existing.ID).
+		SetActualEnd(record.CheckOutTime).
+		SetMinutes(minutes).
+		SetIsRestDay(restDay)
+
+	if scheduledEnd != nil {
+		query = query.SetScheduledEnd(*scheduledEnd)
+	} else {
+		query = query.ClearScheduledEnd()
+	}
+
+	return query.Exec(ctx)
+}
+
+// DeletePendingOvertime removes overtime that is no longer detected on the attendance before it was reviewed
+func (r *AttendanceRepositoryImpl) DeletePendingOvertime(ctx context.Context, attendanceID uint64) error {
+	_, err := r.db(ctx).Overtime.
+		Delete().
+		Where(overtime.AttendanceID(attendanceID)).
+		Where(overtime.StatusEQ(overtime.StatusPending)).
+		Exec(ctx)
+
+	return err
+}
+
+// GetAbsenceCandidates retrieves active employees without attendance or approved leave on a date
+func (r *AttendanceRepositoryImpl) GetAbsenceCandidates(ctx context.Context, date time.Time) ([]*ent.Employee, error) {
+	return r.db(ctx).Employee.
+		Query().
+		Where(employee.IsActive(true)).
+		Where(employee.DeletedAtIsNil()).
+		Where(employee.HireDateLTE(date)).
+		// Deleted attendance is left alone as well, an admin removed it on purpose
+		Where(employee.Not(employee.HasAttendancesWith(attendance.AttendanceDate(date)))).
+		Where(employee.Not(employee.HasLeaveRequestsWith(
+			leaverequest.StatusEQ(leaverequest.StatusApproved),
+			leaverequest.StartDateLTE(date),
+			leaverequest.EndDateGTE(date),
+			leaverequest.DeletedAtIsNil(),
+		))).
+		Order(ent.Asc(employee.FieldID)).
+		All(ctx)
+}
+
+// MarkAbsent creates a system absent record, reporting false when the day was recorded in the meantime
+func (r *AttendanceRepositoryImpl) MarkAbsent(ctx context.Context, employeeID uint64, date time.Time, notes string) (bool, error) {
+	err := r.db(ctx).Attendance.Create().
+		SetEmployeeID(employeeID).
+		SetAttendanceDate(date).
+		SetStatus(attendance.StatusAbsent).
+		SetMarkedByAdmin(true).
+		SetNotes(notes).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetUnclosedAttendances retrieves the records up to the end date that were checked in but never checked out,
+// oldest first and loaded with the employee
+func (r *AttendanceRepositoryImpl) GetUnclosedAttendances(ctx context.Context, endDate time.Time) ([]*ent.Attendance, error) {
+	return r.db(ctx).Attendance.
 		Query().
-		Where(attendance.EmployeeID(employeeID)).
-		Where(attendance.AttendanceDateGTE(startDate)).
 		Where(attendance.AttendanceDateLTE(endDate)).
+		Where(attendance.CheckInTimeNotNil()).
+		Where(attendance.CheckOutTimeIsNil()).
 		Where(attendance.DeletedAtIsNil()).
 		WithEmployee().
-		Order(ent.Asc(attendance.FieldAttendanceDate)).
+		Order(ent.Asc(attendance.FieldAttendanceDate), ent.Asc(attendance.FieldID)).
 		All(ctx)
 }
 
-// isWeekendDay checks if the given date is a weekend (Saturday or Sunday)
-func isWeekendDay(date time.Time) bool {
-	weekday := date.Weekday()
-	return weekday == time.Saturday || weekday == time.Sunday
+// MarkAutoClosed flags a record whose check-out is recorded by the auto check-out job
+func (r *AttendanceRepositoryImpl) MarkAutoClosed(ctx context.Context, id uint64, notes string) error {
+	return r.db(ctx).Attendance.
+		UpdateOneID(id).
+		SetAutoClosed(true).
+		SetNotes(notes).
+		Exec(ctx)
 }
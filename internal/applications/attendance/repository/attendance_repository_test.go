@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/overtime"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/calendar"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendanceRepositoryImpl_PunchLocation(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	office, err := client.OfficeLocation.Create().
+		SetName("Jakarta HQ").
+		SetLatitude(-6.1754).
+		SetLongitude(106.8272).
+		SetRadiusMeters(150).
+		Save(ctx)
+	require.NoError(t, err)
+
+	onSite, err := client.Employee.Create().
+		SetFullName("On Site").
+		SetEmail("on.site@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetOfficeLocationID(office.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	remote, err := client.Employee.Create().
+		SetFullName("No Office").
+		SetEmail("no.office@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewAttendanceRepository(client, calendar.NewWorkingDayCalendar(client))
+
+	t.Run("office location of assigned employee", func(t *testing.T) {
+		found, err := repo.GetEmployeeOfficeLocation(ctx, onSite.ID)
+		assert.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, office.ID, found.ID)
+	})
+
+	t.Run("no office location without assignment", func(t *testing.T) {
+		found, err := repo.GetEmployeeOfficeLocation(ctx, remote.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("punch outside radius flags the record", func(t *testing.T) {
+		latitude, longitude, distance := -6.1900, 106.8230, 1680.5
+		monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+
+		created, err := repo.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     onSite.ID,
+			AttendanceDate: monday,
+			CheckInTime:    time.Date(2025, time.August, 4, 8, 50, 0, 0, time.UTC),
+			Status:         "present",
+			CheckInLocation: &dto.PunchLocation{
+				Latitude:       &latitude,
+				Longitude:      &longitude,
+				DistanceMeters: &distance,
+				OutsideRadius:  true,
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, created.LocationFlagged)
+		require.NotNil(t, created.CheckInDistanceMeters)
+		assert.Equal(t, distance, *created.CheckInDistanceMeters)
+
+		// A later check-out inside the radius keeps the flag
+		inside := 12.0
+		updated, err := repo.Update(ctx, created.ID, &dto.UpdateAttendanceRequest{
+			CheckOutTime: time.Date(2025, time.August, 4, 17, 5, 0, 0, time.UTC),
+			CheckOutLocation: &dto.PunchLocation{
+				Latitude:       &latitude,
+				Longitude:      &longitude,
+				DistanceMeters: &inside,
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, updated.LocationFlagged)
+		require.NotNil(t, updated.CheckOutDistanceMeters)
+		assert.Equal(t, inside, *updated.CheckOutDistanceMeters)
+	})
+}
+
+func TestAttendanceRepositoryImpl_SaveDetectedOvertime(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Late Shift").
+		SetEmail("late.shift@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+	scheduledEnd := time.Date(2025, time.August, 4, 17, 0, 0, 0, time.UTC)
+
+	record, err := client.Attendance.Create().
+		SetEmployeeID(emp.ID).
+		SetAttendanceDate(monday).
+		SetCheckInTime(time.Date(2025, time.August, 4, 9, 0, 0, 0, time.UTC)).
+		SetCheckOutTime(time.Date(2025, time.August, 4, 19, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewAttendanceRepository(client, calendar.NewWorkingDayCalendar(client))
+
+	require.NoError(t, repo.SaveDetectedOvertime(ctx, record, &scheduledEnd, 120, false))
+
+	// A later check-out while pending updates the detected minutes
+	record.CheckOutTime = time.Date(2025, time.August, 4, 20, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SaveDetectedOvertime(ctx, record, &scheduledEnd, 180, false))
+
+	detected, err := client.Overtime.Query().Where(overtime.AttendanceID(record.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 180, detected.Minutes)
+	assert.Equal(t, overtime.StatusPending, detected.Status)
+
+	t.Run("reviewed overtime is not changed", func(t *testing.T) {
+		_, err := client.Overtime.UpdateOneID(detected.ID).SetStatus(overtime.StatusApproved).Save(ctx)
+		require.NoError(t, err)
+
+		assert.NoError(t, repo.SaveDetectedOvertime(ctx, record, &scheduledEnd, 30, false))
+		assert.NoError(t, repo.DeletePendingOvertime(ctx, record.ID))
+
+		reviewed, err := client.Overtime.Get(ctx, detected.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 180, reviewed.Minutes)
+	})
+}
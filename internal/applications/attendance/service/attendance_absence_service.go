@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/helper"
+)
+
+const (
+	// autoAbsentNotes explains system absent records to admins reviewing attendance
+	autoAbsentNotes = "Marked absent automatically, no attendance recorded for the shift"
+
+	// maxBackfillDays limits a single backfill request to about one quarter
+	maxBackfillDays = 92
+)
+
+// AutoMarkAbsentEmployees marks employees absent once their shift has ended without any attendance.
+// Yesterday is checked as well, so overnight shifts and runs missed during downtime are caught up.
+// The date of now in its own location decides which day is today, shift ends follow each employee's timezone.
+func (s *AttendanceServiceImpl) AutoMarkAbsentEmployees(ctx context.Context, now time.Time) (*dto.AutoAbsentResult, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	return s.markAbsentBetween(ctx, today.AddDate(0, 0, -1), today, now)
+}
+
+// BackfillAbsences marks missing attendance as absent for a past date range, e.g. after the job was down
+func (s *AttendanceServiceImpl) BackfillAbsences(ctx context.Context, req *dto.BackfillAbsenceRequest) (*dto.AutoAbsentResult, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date format: %s, expected YYYY-MM-DD", req.StartDate)
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date format: %s, expected YYYY-MM-DD", req.EndDate)
+	}
+
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("end date cannot be before start date")
+	}
+
+	if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > maxBackfillDays {
+		return nil, fmt.Errorf("cannot backfill more than %d days at once, got %d", maxBackfillDays, days)
+	}
+
+	return s.markAbsentBetween(ctx, startDate, endDate, time.Now().In(helper.ApplicationLocation()))
+}
+
+// markAbsentBetween marks missing attendance as absent for every date in the range, dates are UTC midnight as stored
+func (s *AttendanceServiceImpl) markAbsentBetween(ctx context.Context, startDate, endDate, now time.Time) (*dto.AutoAbsentResult, error) {
+	result := &dto.AutoAbsentResult{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Days:      []dto.AutoAbsentDay{},
+	}
+
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		day, err := s.markAbsentOnDate(ctx, date, now)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Days = append(result.Days, *day)
+		result.MarkedAbsent += day.MarkedAbsent
+	}
+
+	return result, nil
+}
+
+// markAbsentOnDate marks employees absent whose shift on the date is over without attendance or approved leave.
+// Existing records are never touched, so running it again for the same date is a no-op.
+func (s *AttendanceServiceImpl) markAbsentOnDate(ctx context.Context, date, now time.Time) (*dto.AutoAbsentDay, error) {
+	day := &dto.AutoAbsentDay{Date: date}
+
+	// Attendance of a month whose payroll was closed is final, it is skipped instead of failing the run
+	locked, err := s.periodLock.IsLocked(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check payroll period: %w", err)
+	}
+	if locked {
+		day.PeriodLocked = true
+		return day, nil
+	}
+
+	// Nobody can be absent on a public holiday or cuti bersama
+	holiday, err := s.calendar.GetHoliday(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check holiday calendar: %w", err)
+	}
+	if holiday != nil {
+		day.Holiday = holiday.Name
+		return day, nil
+	}
+
+	candidates, err := s.attendanceRepo.GetAbsenceCandidates(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employees without attendance on %s: %w", date.Format("2006-01-02"), err)
+	}
+
+	for _, emp := range candidates {
+		schedule, err := s.calendar.GetEmployeeSchedule(ctx, emp.ID, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve work schedule of employee %d: %w", emp.ID, err)
+		}
+
+		if !schedule.IsWorkingWeekday(date) {
+			continue
+		}
+
+		// The employee can still check in until the shift is over in the employee's timezone
+		if now.Before(schedule.EndOn(date)) {
+			continue
+		}
+
+		marked, err := s.attendanceRepo.MarkAbsent(ctx, emp.ID, date, autoAbsentNotes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark employee %d absent: %w", emp.ID, err)
+		}
+		if marked {
+			day.MarkedAbsent++
+		}
+	}
+
+	return day, nil
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
+	"mceasy/internal/vars"
+)
+
+// anomalyAutoResolvedNotes explains anomalies closed by the detector instead of HR
+const anomalyAutoResolvedNotes = "Resolved automatically, the attendance no longer matches the rule"
+
+// anomalyFinding is one rule that matched an attendance record
+type anomalyFinding struct {
+	rule    attendanceanomaly.Rule
+	details string
+}
+
+// DetectAnomalies scans the attendance of yesterday and today with the configured rules.
+// The date of now in its own location decides which day is today, like the auto-absent job.
+func (s *AttendanceServiceImpl) DetectAnomalies(ctx context.Context, now time.Time) (*dto.AnomalyDetectionResult, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	return s.detectAnomaliesBetween(ctx, today.AddDate(0, 0, -1), today, now, vars.GetAttendanceAnomalyRules())
+}
+
+// DetectAnomaliesBetween scans a past date range with the configured rules, e.g. before closing payroll
+func (s *AttendanceServiceImpl) DetectAnomaliesBetween(ctx context.Context, req *dto.DetectAttendanceAnomaliesRequest) (*dto.AnomalyDetectionResult, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date format: %s, expected YYYY-MM-DD", req.StartDate)
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date format: %s, expected YYYY-MM-DD", req.EndDate)
+	}
+
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("end date cannot be before start date")
+	}
+
+	if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > maxBackfillDays {
+		return nil, fmt.Errorf("cannot scan more than %d days at once, got %d", maxBackfillDays, days)
+	}
+
+	return s.detectAnomaliesBetween(ctx, startDate, endDate, time.Now().In(helper.ApplicationLocation()), vars.GetAttendanceAnomalyRules())
+}
+
+// detectAnomaliesBetween reports new anomalies for every date in the range and resolves open ones whose
+// attendance was corrected since. An anomaly is reported once per rule and record, also after it was resolved.
+func (s *AttendanceServiceImpl) detectAnomaliesBetween(ctx context.Context, startDate, endDate, now time.Time, rules vars.AttendanceAnomalyRules) (*dto.AnomalyDetectionResult, error) {
+	result := &dto.AnomalyDetectionResult{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		// Attendance of a month whose payroll was closed is final, nothing can be resolved there anymore
+		locked, err := s.periodLock.IsLocked(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check payroll period: %w", err)
+		}
+		if locked {
+			result.LockedDates = append(result.LockedDates, date)
+			continue
+		}
+
+		holiday, err := s.calendar.GetHoliday(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check holiday calendar: %w", err)
+		}
+
+		records, err := s.anomalyRepo.GetScanCandidates(ctx, date, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attendance of %s: %w", date.Format("2006-01-02"), err)
+		}
+
+		for _, record := range records {
+			schedule, err := s.calendar.GetEmployeeSchedule(ctx, record.EmployeeID, record.AttendanceDate)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve work schedule of employee %d: %w", record.EmployeeID, err)
+			}
+
+			workingDay := holiday == nil && schedule.IsWorkingWeekday(record.AttendanceDate)
+			detected, resolved, err := s.applyAnomalyFindings(ctx, record, evaluateAnomalyRules(schedule, record, workingDay, now, rules))
+			if err != nil {
+				return nil, err
+			}
+
+			result.Scanned++
+			result.Detected += detected
+			result.AutoResolved += resolved
+		}
+	}
+
+	return result, nil
+}
+
+// applyAnomalyFindings stores the findings not reported yet and resolves open anomalies the rules no longer find
+func (s *AttendanceServiceImpl) applyAnomalyFindings(ctx context.Context, record *ent.Attendance, findings []anomalyFinding) (detected, resolved int, err error) {
+	existing, err := s.anomalyRepo.GetByAttendance(ctx, record.ID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get anomalies of attendance %d: %w", record.ID, err)
+	}
+
+	reported := make(map[attendanceanomaly.Rule]*ent.AttendanceAnomaly, len(existing))
+	for _, anomaly := range existing {
+		reported[anomaly.Rule] = anomaly
+	}
+
+	found := make(map[attendanceanomaly.Rule]bool, len(findings))
+	for _, finding := range findings {
+		found[finding.rule] = true
+		if reported[finding.rule] != nil {
+			continue
+		}
+
+		if _, err := s.anomalyRepo.Create(ctx, record, finding.rule, finding.details); err != nil {
+			return 0, 0, fmt.Errorf("failed to store %s anomaly of attendance %d: %w", finding.rule, record.ID, err)
+		}
+		detected++
+	}
+
+	for rule, anomaly := range reported {
+		if found[rule] || anomaly.Status != attendanceanomaly.StatusOpen {
+			continue
+		}
+		// Skipped punches are flagged by imports, no rule here can tell they were dealt with
+		if rule == attendanceanomaly.RuleSkippedPunch {
+			continue
+		}
+
+		if _, err := s.anomalyRepo.Resolve(ctx, anomaly.ID, nil, anomalyAutoResolvedNotes); err != nil {
+			return 0, 0, fmt.Errorf("failed to resolve anomaly %d: %w", anomaly.ID, err)
+		}
+		resolved++
+	}
+
+	return detected, resolved, nil
+}
+
+// evaluateAnomalyRules checks an attendance record against the enabled rules. Check-ins before and check-outs
+// after the shift only matter on working days, overtime on a rest day or holiday has no shift to compare with.
+func evaluateAnomalyRules(schedule *calendar.Schedule, record *ent.Attendance, workingDay bool, now time.Time, rules vars.AttendanceAnomalyRules) []anomalyFinding {
+	var findings []anomalyFinding
+	if record.CheckInTime.IsZero() {
+		return findings
+	}
+
+	shiftStart := schedule.StartOn(record.AttendanceDate)
+	shiftEnd := schedule.EndOn(record.AttendanceDate)
+	clock := func(t time.Time) string {
+		return t.In(shiftStart.Location()).Format("15:04")
+	}
+
+	if rules.MissingCheckOut && record.CheckOutTime.IsZero() && now.After(shiftEnd.Add(rules.MissingCheckOutGrace)) {
+		findings = append(findings, anomalyFinding{
+			rule:    attendanceanomaly.RuleMissingCheckOut,
+			details: fmt.Sprintf("Checked in at %s but never checked out, the shift ended at %s", clock(record.CheckInTime), clock(shiftEnd)),
+		})
+	}
+
+	if rules.LongDay && !record.CheckOutTime.IsZero() {
+		if length := record.CheckOutTime.Sub(record.CheckInTime); length > rules.LongDayThreshold {
+			findings = append(findings, anomalyFinding{
+				rule: attendanceanomaly.RuleLongDay,
+				details: fmt.Sprintf("Checked in at %s and out at %s, %s apart",
+					clock(record.CheckInTime), clock(record.CheckOutTime), formatHoursMinutes(length)),
+			})
+		}
+	}
+
+	if !workingDay {
+		return findings
+	}
+
+	if rules.OddHourCheckIn && record.CheckInTime.Before(shiftStart.Add(-rules.OddHourCheckInLead)) {
+		findings = append(findings, anomalyFinding{
+			rule: attendanceanomaly.RuleOddHourCheckIn,
+			details: fmt.Sprintf("Checked in at %s, %s before the shift starts at %s",
+				clock(record.CheckInTime), formatHoursMinutes(shiftStart.Sub(record.CheckInTime)), clock(shiftStart)),
+		})
+	}
+
+	// A half day is expected to end early
+	if rules.EarlyCheckOut && !record.CheckOutTime.IsZero() && record.Status != "half_day" &&
+		record.CheckOutTime.Before(shiftEnd.Add(-rules.EarlyCheckOutTolerance)) {
+		findings = append(findings, anomalyFinding{
+			rule: attendanceanomaly.RuleEarlyCheckOut,
+			details: fmt.Sprintf("Checked out at %s, %s before the shift ends at %s",
+				clock(record.CheckOutTime), formatHoursMinutes(shiftEnd.Sub(record.CheckOutTime)), clock(shiftEnd)),
+		})
+	}
+
+	return findings
+}
+
+// formatHoursMinutes formats a duration as hours and minutes, e.g. 16h30m
+func formatHoursMinutes(d time.Duration) string {
+	minutes := int(d.Round(time.Minute).Minutes())
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
+
+// GetAnomalyByID retrieves an attendance anomaly by ID
+func (s *AttendanceServiceImpl) GetAnomalyByID(ctx context.Context, id uint64) (*dto.AttendanceAnomalyResponse, error) {
+	anomaly, err := s.anomalyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance anomaly not found: %w", err)
+	}
+
+	return s.mapToAttendanceAnomalyResponse(anomaly), nil
+}
+
+// ListAnomalies retrieves attendance anomalies with pagination and filtering
+func (s *AttendanceServiceImpl) ListAnomalies(ctx context.Context, params *dto.AttendanceAnomalyQueryParams) (*dto.AttendanceAnomalyListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	anomalies, total, err := s.anomalyRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendance anomalies: %w", err)
+	}
+
+	anomalyResponses := make([]dto.AttendanceAnomalyResponse, len(anomalies))
+	for i, anomaly := range anomalies {
+		anomalyResponses[i] = *s.mapToAttendanceAnomalyResponse(anomaly)
+	}
+
+	return &dto.AttendanceAnomalyListResponse{
+		Anomalies: anomalyResponses,
+		Total:     total,
+		Page:      params.Page,
+		Limit:     params.Limit,
+	}, nil
+}
+
+// ResolveAnomaly records that HR handled an anomaly, e.g. by correcting the attendance or accepting it as is
+func (s *AttendanceServiceImpl) ResolveAnomaly(ctx context.Context, id uint64, req *dto.ResolveAttendanceAnomalyRequest) (*dto.AttendanceAnomalyResponse, error) {
+	anomaly, err := s.anomalyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance anomaly not found: %w", err)
+	}
+
+	if anomaly.Status != attendanceanomaly.StatusOpen {
+		return nil, fmt.Errorf("attendance anomaly %d is already %s", id, anomaly.Status)
+	}
+
+	resolved, err := s.anomalyRepo.Resolve(ctx, id, &req.ResolvedBy, req.ResolutionNotes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve attendance anomaly: %w", err)
+	}
+
+	return s.mapToAttendanceAnomalyResponse(resolved), nil
+}
+
+// mapToAttendanceAnomalyResponse maps an ent.AttendanceAnomaly to dto.AttendanceAnomalyResponse
+func (s *AttendanceServiceImpl) mapToAttendanceAnomalyResponse(anomaly *ent.AttendanceAnomaly) *dto.AttendanceAnomalyResponse {
+	response := &dto.AttendanceAnomalyResponse{
+		ID:              anomaly.ID,
+		AttendanceID:    anomaly.AttendanceID,
+		EmployeeID:      anomaly.EmployeeID,
+		AttendanceDate:  anomaly.AttendanceDate,
+		Rule:            string(anomaly.Rule),
+		Details:         anomaly.Details,
+		Status:          string(anomaly.Status),
+		ResolvedBy:      anomaly.ResolvedBy,
+		ResolvedAt:      anomaly.ResolvedAt,
+		ResolutionNotes: anomaly.ResolutionNotes,
+		CreatedAt:       anomaly.CreatedAt,
+		ModifiedAt:      anomaly.ModifiedAt,
+	}
+
+	// Add employee information if available
+	if anomaly.Edges.Employee != nil {
+		response.EmployeeName = anomaly.Edges.Employee.FullName
+		response.EmployeeCode = anomaly.Edges.Employee.EmployeeID
+	}
+
+	return response
+}
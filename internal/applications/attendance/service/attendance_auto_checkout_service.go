@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/vars"
+)
+
+// AutoCheckOut closes attendance records the employee forgot to check out of, at the scheduled shift end or the
+// configured cut-off, once the grace period after it has passed. Closed records are flagged as auto-closed and
+// keep a note, the employee or a manager disputes the check-out by updating it.
+func (s *AttendanceServiceImpl) AutoCheckOut(ctx context.Context, now time.Time) (*dto.AutoCheckOutResult, error) {
+	return s.autoCheckOut(ctx, now, vars.GetAutoCheckOutPolicy())
+}
+
+// autoCheckOut closes every open record whose check-out time plus grace is before now
+func (s *AttendanceServiceImpl) autoCheckOut(ctx context.Context, now time.Time, policy vars.AutoCheckOutPolicy) (*dto.AutoCheckOutResult, error) {
+	result := &dto.AutoCheckOutResult{
+		Records: []dto.AutoCheckOutRecord{},
+	}
+
+	// The date of employees east of now's location can already be tomorrow
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	records, err := s.attendanceRepo.GetUnclosedAttendances(ctx, today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendance without check-out: %w", err)
+	}
+
+	for _, record := range records {
+		// Attendance of a month whose payroll was closed is final, it is skipped instead of failing the run
+		locked, err := s.periodLock.IsLocked(ctx, record.AttendanceDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check payroll period: %w", err)
+		}
+		if locked {
+			result.PeriodLocked++
+			continue
+		}
+
+		schedule, err := s.calendar.GetEmployeeSchedule(ctx, record.EmployeeID, record.AttendanceDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve work schedule of employee %d: %w", record.EmployeeID, err)
+		}
+
+		checkOutTime, closedAt, err := autoCheckOutTime(schedule, record.AttendanceDate, policy)
+		if err != nil {
+			return nil, err
+		}
+
+		// The employee can still check out during the grace period
+		if now.Before(checkOutTime.Add(policy.Grace)) {
+			continue
+		}
+
+		closed, err := s.closeAttendance(ctx, record, checkOutTime, closedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check out employee %d automatically: %w", record.EmployeeID, err)
+		}
+
+		row := dto.AutoCheckOutRecord{
+			AttendanceID:   closed.ID,
+			EmployeeID:     closed.EmployeeID,
+			AttendanceDate: closed.AttendanceDate,
+			CheckOutTime:   closed.CheckOutTime,
+		}
+		if record.Edges.Employee != nil {
+			row.EmployeeName = record.Edges.Employee.FullName
+		}
+		result.Records = append(result.Records, row)
+		result.Closed++
+	}
+
+	return result, nil
+}
+
+// autoCheckOutTime returns when a forgotten check-out is recorded for the shift starting on the date and what
+// that moment is called in the note left on the record
+func autoCheckOutTime(schedule *calendar.Schedule, date time.Time, policy vars.AutoCheckOutPolicy) (time.Time, string, error) {
+	shiftEnd := schedule.EndOn(date)
+	if policy.CloseAt != vars.AutoCheckOutAtCutoff {
+		return shiftEnd, "shift end", nil
+	}
+
+	hour, minute, err := calendar.ParseClock(policy.Cutoff)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid auto check-out cut-off %q: %w", policy.Cutoff, err)
+	}
+
+	cutoff := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, shiftEnd.Location())
+	// An overnight shift runs past midnight, the cut-off of its day is on the next calendar day
+	if !cutoff.After(schedule.StartOn(date)) {
+		cutoff = cutoff.AddDate(0, 0, 1)
+	}
+
+	return cutoff, "cut-off", nil
+}
+
+// closeAttendance records the check-out punch and flags the record as auto-closed in one transaction
+func (s *AttendanceServiceImpl) closeAttendance(ctx context.Context, record *ent.Attendance, checkOutTime time.Time, closedAt string) (*ent.Attendance, error) {
+	var closed *ent.Attendance
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		punches, err := s.attendanceRepo.GetPunches(txCtx, record.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get punches: %w", err)
+		}
+
+		// The note reads in the timezone of the schedule the check-out time was taken from
+		loc := checkOutTime.Location()
+
+		// A punch after the check-out time, e.g. a check-in for overtime after the shift, moves the check-out to it
+		if last := len(punches) - 1; last >= 0 && punches[last].PunchTime.After(checkOutTime) {
+			checkOutTime = punches[last].PunchTime
+		}
+
+		note := fmt.Sprintf("Checked out automatically at the %s (%s), update the check-out time to dispute",
+			closedAt, checkOutTime.In(loc).Format("2006-01-02 15:04 MST"))
+		if record.Notes != "" {
+			note = record.Notes + "\n" + note
+		}
+
+		if err := s.attendanceRepo.MarkAutoClosed(txCtx, record.ID, note); err != nil {
+			return fmt.Errorf("failed to flag attendance as auto-closed: %w", err)
+		}
+
+		// Records without punches, e.g. marked before punches were kept, get their check-in punch as well
+		if err := s.alignPunches(txCtx, record.ID, record.CheckInTime, checkOutTime, nil, nil); err != nil {
+			return err
+		}
+
+		closed, err = s.syncWorkedTime(txCtx, record.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closed, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// errBulkRowFailed aborts the transaction of an atomic bulk marking once a row failed
+var errBulkRowFailed = errors.New("bulk attendance row failed")
+
+// BulkMarkAttendance marks attendance for several employees on one date with the same validation as
+// MarkAttendance. An atomic request stores all rows or none, otherwise every row is stored on its own and
+// failed rows are only reported.
+func (s *AttendanceServiceImpl) BulkMarkAttendance(ctx context.Context, req *dto.BulkMarkAttendanceRequest) (*dto.BulkMarkAttendanceResponse, error) {
+	attendanceDate, err := time.Parse("2006-01-02", req.AttendanceDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attendance date: %w", err)
+	}
+
+	result := &dto.BulkMarkAttendanceResponse{
+		AttendanceDate: attendanceDate,
+		Atomic:         req.Atomic,
+		Total:          len(req.Attendances),
+		Results:        make([]dto.BulkAttendanceResult, 0, len(req.Attendances)),
+	}
+
+	if !req.Atomic {
+		s.markBulkRows(ctx, req, result, false)
+		return result, nil
+	}
+
+	err = s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		s.markBulkRows(ent.NewTxContext(ctx, tx), req, result, true)
+		if result.Failed > 0 {
+			return errBulkRowFailed
+		}
+		return nil
+	})
+	if errors.Is(err, errBulkRowFailed) {
+		// Nothing was stored, rows that went through are reported as rolled back
+		for i := range result.Results {
+			if result.Results[i].Success {
+				result.Results[i].Success = false
+				result.Results[i].Error = "rolled back because another row failed"
+				result.Results[i].Attendance = nil
+			}
+		}
+		result.Succeeded = 0
+		result.Failed = result.Total
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark attendance: %w", err)
+	}
+
+	return result, nil
+}
+
+// markBulkRows marks every row of a bulk request and records its outcome. Outside an atomic request each
+// row gets its own transaction, so a row is never stored halfway.
+func (s *AttendanceServiceImpl) markBulkRows(ctx context.Context, req *dto.BulkMarkAttendanceRequest, result *dto.BulkMarkAttendanceResponse, atomic bool) {
+	seen := make(map[uint64]bool, len(req.Attendances))
+
+	for i := range req.Attendances {
+		item := &req.Attendances[i]
+		row := dto.BulkAttendanceResult{Index: i, EmployeeID: item.EmployeeID}
+
+		var attendance *dto.AttendanceResponse
+		var err error
+		switch {
+		case seen[item.EmployeeID]:
+			// A later row would silently overwrite the earlier one
+			err = fmt.Errorf("employee %d appears more than once", item.EmployeeID)
+		case atomic:
+			attendance, err = s.markBulkRow(ctx, req.AttendanceDate, item)
+		default:
+			err = s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+				var rowErr error
+				attendance, rowErr = s.markBulkRow(ent.NewTxContext(ctx, tx), req.AttendanceDate, item)
+				return rowErr
+			})
+		}
+		seen[item.EmployeeID] = true
+
+		if err != nil {
+			row.Error = errorMessage(err)
+			result.Failed++
+		} else {
+			row.Success = true
+			row.Attendance = attendance
+			result.Succeeded++
+		}
+		result.Results = append(result.Results, row)
+	}
+}
+
+// markBulkRow reads the wall clock times of a row in the employee's timezone and marks it
+func (s *AttendanceServiceImpl) markBulkRow(ctx context.Context, attendanceDate string, item *dto.BulkAttendanceItem) (*dto.AttendanceResponse, error) {
+	loc, err := s.GetEmployeeLocation(ctx, item.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	markReq, err := item.ToMarkAttendanceRequest(attendanceDate, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.MarkAttendance(ctx, markReq)
+}
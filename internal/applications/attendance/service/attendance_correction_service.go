@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendancecorrection"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// RequestCorrection submits an employee's proposed change to an attendance record for review
+func (s *AttendanceServiceImpl) RequestCorrection(ctx context.Context, attendanceID uint64, req *dto.CreateAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error) {
+	attendance, err := s.attendanceRepo.GetByID(ctx, attendanceID)
+	if err != nil {
+		return nil, fmt.Errorf("attendance record not found: %w", err)
+	}
+
+	// Employees can only correct their own attendance
+	if attendance.EmployeeID != req.EmployeeID {
+		return nil, fmt.Errorf("attendance record %d does not belong to employee %d", attendanceID, req.EmployeeID)
+	}
+
+	// Proposed times are wall clock times in the employee's timezone
+	loc, err := s.GetEmployeeLocation(ctx, attendance.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkInTime, checkOutTime, err := req.ProposedTimes(attendance.AttendanceDate, loc)
+	if err != nil {
+		return nil, err
+	}
+	if checkInTime.IsZero() && checkOutTime.IsZero() && req.Status == "" {
+		return nil, fmt.Errorf("correction must propose a check-in time, check-out time or status")
+	}
+
+	// Validate the proposed times the same way an update would
+	effectiveCheckIn := checkInTime
+	if effectiveCheckIn.IsZero() {
+		effectiveCheckIn = attendance.CheckInTime
+	}
+	if !effectiveCheckIn.IsZero() && !checkOutTime.IsZero() {
+		schedule, err := s.calendar.GetEmployeeSchedule(ctx, attendance.EmployeeID, attendance.AttendanceDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve work schedule: %w", err)
+		}
+
+		checkOutTime = rollOverCheckOut(schedule, effectiveCheckIn, checkOutTime)
+		if checkOutTime.Before(effectiveCheckIn) {
+			return nil, fmt.Errorf("check-out time cannot be before check-in time")
+		}
+	}
+
+	// Only one correction per attendance record can wait for review
+	pending, err := s.correctionRepo.HasPending(ctx, attendanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending corrections: %w", err)
+	}
+	if pending {
+		return nil, fmt.Errorf("attendance record %d already has a pending correction", attendanceID)
+	}
+
+	correction, err := s.correctionRepo.Create(ctx, attendance, req, checkInTime, checkOutTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attendance correction: %w", err)
+	}
+
+	return s.mapToAttendanceCorrectionResponse(correction), nil
+}
+
+// GetCorrectionByID retrieves an attendance correction by ID
+func (s *AttendanceServiceImpl) GetCorrectionByID(ctx context.Context, id uint64) (*dto.AttendanceCorrectionResponse, error) {
+	correction, err := s.correctionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance correction not found: %w", err)
+	}
+
+	return s.mapToAttendanceCorrectionResponse(correction), nil
+}
+
+// ListCorrections retrieves attendance corrections with pagination and filtering
+func (s *AttendanceServiceImpl) ListCorrections(ctx context.Context, params *dto.AttendanceCorrectionQueryParams) (*dto.AttendanceCorrectionListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	corrections, total, err := s.correctionRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendance corrections: %w", err)
+	}
+
+	correctionResponses := make([]dto.AttendanceCorrectionResponse, len(corrections))
+	for i, correction := range corrections {
+		correctionResponses[i] = *s.mapToAttendanceCorrectionResponse(correction)
+	}
+
+	return &dto.AttendanceCorrectionListResponse{
+		Corrections: correctionResponses,
+		Total:       total,
+		Page:        params.Page,
+		Limit:       params.Limit,
+	}, nil
+}
+
+// ApproveCorrection applies the proposed values to the attendance record and records the approver in one transaction
+func (s *AttendanceServiceImpl) ApproveCorrection(ctx context.Context, id uint64, req *dto.ReviewAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error) {
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		correction, err := s.getPendingCorrection(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		// Claim the correction first so a concurrent approval cannot apply its values as well
+		if err := s.claimCorrection(txCtx, id, attendancecorrection.StatusApproved, req); err != nil {
+			return err
+		}
+
+		attendance, err := s.attendanceRepo.GetByID(txCtx, correction.AttendanceID)
+		if err != nil {
+			return fmt.Errorf("attendance record not found: %w", err)
+		}
+
+		// The proposal was made against a snapshot, refuse to overwrite changes made since then
+		if !matchesOriginal(correction, attendance) {
+			return fmt.Errorf("attendance record %d changed after correction %d was requested", attendance.ID, id)
+		}
+
+		update := &dto.UpdateAttendanceRequest{
+			Status: correction.ProposedStatus,
+			Notes:  fmt.Sprintf("Corrected by attendance correction #%d", correction.ID),
+		}
+		if correction.ProposedCheckInTime != nil {
+			update.CheckInTime = *correction.ProposedCheckInTime
+		}
+		if correction.ProposedCheckOutTime != nil {
+			update.CheckOutTime = *correction.ProposedCheckOutTime
+		}
+
+		_, err = s.UpdateAttendance(txCtx, attendance.ID, update)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCorrectionByID(ctx, id)
+}
+
+// RejectCorrection rejects a pending correction and leaves the attendance record untouched
+func (s *AttendanceServiceImpl) RejectCorrection(ctx context.Context, id uint64, req *dto.ReviewAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error) {
+	if _, err := s.getPendingCorrection(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.claimCorrection(ctx, id, attendancecorrection.StatusRejected, req); err != nil {
+		return nil, err
+	}
+
+	return s.GetCorrectionByID(ctx, id)
+}
+
+// claimCorrection records the review of a correction, an InvalidArgument business error is returned when the
+// correction was reviewed in the meantime
+func (s *AttendanceServiceImpl) claimCorrection(ctx context.Context, id uint64, status attendancecorrection.Status, req *dto.ReviewAttendanceCorrectionRequest) error {
+	claimed, err := s.correctionRepo.Review(ctx, id, status, req)
+	if err != nil {
+		return fmt.Errorf("failed to review attendance correction: %w", err)
+	}
+	if !claimed {
+		return exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("attendance correction %d is no longer pending", id))
+	}
+
+	return nil
+}
+
+// getPendingCorrection retrieves a correction and ensures it is still waiting for review
+func (s *AttendanceServiceImpl) getPendingCorrection(ctx context.Context, id uint64) (*ent.AttendanceCorrection, error) {
+	correction, err := s.correctionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance correction not found: %w", err)
+	}
+
+	if correction.Status != attendancecorrection.StatusPending {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("attendance correction %d is already %s", id, correction.Status))
+	}
+
+	return correction, nil
+}
+
+// matchesOriginal checks whether the attendance record still holds the values captured when the correction was requested
+func matchesOriginal(correction *ent.AttendanceCorrection, attendance *ent.Attendance) bool {
+	return string(attendance.Status) == correction.OriginalStatus &&
+		sameTime(correction.OriginalCheckInTime, attendance.CheckInTime) &&
+		sameTime(correction.OriginalCheckOutTime, attendance.CheckOutTime)
+}
+
+// sameTime compares an optional snapshot time with a stored time, treating nil as zero
+func sameTime(snapshot *time.Time, current time.Time) bool {
+	if snapshot == nil {
+		return current.IsZero()
+	}
+	return snapshot.Equal(current)
+}
+
+// mapToAttendanceCorrectionResponse maps an ent.AttendanceCorrection to dto.AttendanceCorrectionResponse
+func (s *AttendanceServiceImpl) mapToAttendanceCorrectionResponse(correction *ent.AttendanceCorrection) *dto.AttendanceCorrectionResponse {
+	response := &dto.AttendanceCorrectionResponse{
+		ID:                   correction.ID,
+		AttendanceID:         correction.AttendanceID,
+		EmployeeID:           correction.EmployeeID,
+		OriginalCheckInTime:  correction.OriginalCheckInTime,
+		OriginalCheckOutTime: correction.OriginalCheckOutTime,
+		OriginalStatus:       correction.OriginalStatus,
+		ProposedCheckInTime:  correction.ProposedCheckInTime,
+		ProposedCheckOutTime: correction.ProposedCheckOutTime,
+		ProposedStatus:       correction.ProposedStatus,
+		Reason:               correction.Reason,
+		Status:               string(correction.Status),
+		ReviewedBy:           correction.ReviewedBy,
+		ReviewedAt:           correction.ReviewedAt,
+		ReviewNotes:          correction.ReviewNotes,
+		CreatedAt:            correction.CreatedAt,
+		ModifiedAt:           correction.ModifiedAt,
+	}
+
+	// Add attendance and employee information if available
+	if correction.Edges.Attendance != nil {
+		response.AttendanceDate = correction.Edges.Attendance.AttendanceDate
+	}
+	if correction.Edges.Employee != nil {
+		response.EmployeeName = correction.Edges.Employee.FullName
+	}
+
+	return response
+}
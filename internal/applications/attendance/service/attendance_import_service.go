@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancepunch"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/biometric"
+	"mceasy/internal/component/calendar"
+)
+
+const (
+	// duplicatePunchWindow treats repeated scans with the same state within a minute as one punch
+	duplicatePunchWindow = time.Minute
+
+	// importNotes explains imported records to admins reviewing attendance
+	importNotes = "Imported from fingerprint terminal log"
+)
+
+// importedDay collects the punches of one employee on one shift date
+type importedDay struct {
+	employee *ent.Employee
+	pin      string
+	date     time.Time
+	punches  []devicePunch
+}
+
+// devicePunch is a punch of the log with its wall clock time read in the employee's timezone
+type devicePunch struct {
+	line  int
+	time  time.Time
+	state biometric.PunchState
+}
+
+// ImportPunchLog imports a CSV or TSV log of the fingerprint terminals. Punches are matched to employees by
+// device PIN, read in the employee's timezone, deduplicated and collapsed into daily attendance, punches that
+// were imported before are skipped. Each day is stored on its own, a dry run only reports what would change.
+func (s *AttendanceServiceImpl) ImportPunchLog(ctx context.Context, log io.Reader, dryRun bool) (*dto.PunchImportResult, error) {
+	parsed, err := biometric.ParseLog(log)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importDevicePunches(ctx, parsed, nil, dryRun)
+}
+
+// RecordDevicePunches collapses punches pushed by a terminal into daily attendance the same way as an imported
+// log. loc is the timezone of the terminal's clock, nil reads the punches in each employee's timezone.
+func (s *AttendanceServiceImpl) RecordDevicePunches(ctx context.Context, punches []biometric.Punch, loc *time.Location) (*dto.PunchImportResult, error) {
+	return s.importDevicePunches(ctx, &biometric.PunchLog{Lines: len(punches), Punches: punches}, loc, false)
+}
+
+func (s *AttendanceServiceImpl) importDevicePunches(ctx context.Context, parsed *biometric.PunchLog, loc *time.Location, dryRun bool) (*dto.PunchImportResult, error) {
+	punches, duplicates := biometric.Dedupe(parsed.Punches, duplicatePunchWindow)
+	result := &dto.PunchImportResult{
+		DryRun:           dryRun,
+		Lines:            parsed.Lines,
+		Punches:          len(parsed.Punches),
+		DuplicatePunches: duplicates,
+		Days:             []dto.PunchImportDay{},
+		Errors:           []dto.PunchImportError{},
+	}
+	for _, lineErr := range parsed.Errors {
+		result.Errors = append(result.Errors, dto.PunchImportError{Line: lineErr.Line, Message: lineErr.Message})
+	}
+
+	days, err := s.groupDevicePunches(ctx, punches, loc, result)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, day := range days {
+		report := s.importDay(ctx, day, dryRun)
+		switch report.Action {
+		case "create", "merge":
+			result.ImportedDays++
+		case "rejected":
+			result.RejectedDays++
+		}
+		result.Days = append(result.Days, report)
+	}
+
+	return result, nil
+}
+
+// groupDevicePunches maps the punches to employees and groups them by shift date, punches of unknown PINs are
+// reported as errors. Wall clock times are read in loc, or in the employee's timezone when loc is nil.
+func (s *AttendanceServiceImpl) groupDevicePunches(ctx context.Context, punches []biometric.Punch, loc *time.Location, result *dto.PunchImportResult) ([]*importedDay, error) {
+	pins := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, punch := range punches {
+		if !seen[punch.PIN] {
+			seen[punch.PIN] = true
+			pins = append(pins, punch.PIN)
+		}
+	}
+
+	employees, err := s.attendanceRepo.GetEmployeesByDevicePINs(ctx, pins)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employees by device PIN: %w", err)
+	}
+	byPIN := make(map[string]*ent.Employee, len(employees))
+	for _, emp := range employees {
+		byPIN[*emp.DevicePin] = emp
+	}
+
+	var days []*importedDay
+	byKey := make(map[string]*importedDay)
+	for _, punch := range punches {
+		emp, ok := byPIN[punch.PIN]
+		if !ok {
+			result.Errors = append(result.Errors, dto.PunchImportError{
+				Line:      punch.Line,
+				DevicePIN: punch.PIN,
+				Message:   fmt.Sprintf("no employee has device PIN %s", punch.PIN),
+			})
+			continue
+		}
+
+		// Terminals log the wall clock time of the office
+		punchLoc := loc
+		if punchLoc == nil {
+			punchLoc = calendar.EmployeeLocation(emp)
+		}
+		wall := punch.Time
+		punchTime := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, punchLoc).UTC()
+
+		date, err := s.calendar.GetEmployeeShiftDate(ctx, emp.ID, punchTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve shift date: %w", err)
+		}
+
+		key := fmt.Sprintf("%d/%s", emp.ID, date.Format("2006-01-02"))
+		day, ok := byKey[key]
+		if !ok {
+			day = &importedDay{employee: emp, pin: punch.PIN, date: date}
+			byKey[key] = day
+			days = append(days, day)
+		}
+		day.punches = append(day.punches, devicePunch{line: punch.Line, time: punchTime, state: punch.State})
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		if !days[i].date.Equal(days[j].date) {
+			return days[i].date.Before(days[j].date)
+		}
+		return days[i].employee.ID < days[j].employee.ID
+	})
+
+	return days, nil
+}
+
+// importDay merges the punches of a day into the employee's attendance and stores them unless it is a dry run
+func (s *AttendanceServiceImpl) importDay(ctx context.Context, day *importedDay, dryRun bool) dto.PunchImportDay {
+	report := dto.PunchImportDay{
+		EmployeeID:     day.employee.ID,
+		EmployeeName:   day.employee.FullName,
+		DevicePIN:      day.pin,
+		AttendanceDate: day.date,
+		NewPunches:     []dto.PunchImportPunch{},
+		SkippedPunches: []dto.PunchImportPunch{},
+	}
+	for _, punch := range day.punches {
+		report.Lines = append(report.Lines, punch.line)
+	}
+	reject := func(err error) dto.PunchImportDay {
+		report.Action = "rejected"
+		report.Error = errorMessage(err)
+		return report
+	}
+
+	existing, err := s.attendanceRepo.GetByEmployeeAndDate(ctx, day.employee.ID, day.date)
+	if err != nil && !ent.IsNotFound(err) {
+		return reject(fmt.Errorf("failed to get attendance: %w", err))
+	}
+
+	var recorded []*ent.AttendancePunch
+	if existing != nil {
+		report.AttendanceID = existing.ID
+		recorded, err = s.attendanceRepo.GetPunches(ctx, existing.ID)
+		if err != nil {
+			return reject(fmt.Errorf("failed to get punches: %w", err))
+		}
+	}
+
+	merged, added, skipped := mergeDevicePunches(recorded, day.punches)
+	report.Lines = report.Lines[:0]
+	for _, punch := range added {
+		report.Lines = append(report.Lines, punch.line)
+	}
+	for _, punch := range skipped {
+		report.Lines = append(report.Lines, punch.line)
+	}
+	sort.Ints(report.Lines)
+	for _, punch := range added {
+		report.NewPunches = append(report.NewPunches, dto.PunchImportPunch{
+			Line:      punch.line,
+			PunchType: string(punch.punchType),
+			PunchTime: punch.time,
+		})
+	}
+	for _, punch := range skipped {
+		report.SkippedPunches = append(report.SkippedPunches, dto.PunchImportPunch{
+			Line:      punch.line,
+			PunchType: string(punch.punchType),
+			PunchTime: punch.time,
+			Reason:    punch.reason,
+		})
+	}
+
+	// A day whose new punches were all skipped has nothing to import
+	if len(added) == 0 && len(skipped) > 0 {
+		return reject(fmt.Errorf("line %d: %s", skipped[0].line, skipped[0].reason))
+	}
+
+	summary := summarizePunches(merged)
+	report.CheckInTime = summary.FirstIn
+	report.CheckOutTime = summary.LastOut
+	report.WorkedMinutes = summary.WorkedMinutes
+	report.BreakMinutes = summary.BreakMinutes
+
+	switch {
+	case len(added) == 0:
+		report.Action = "unchanged"
+	case existing == nil:
+		report.Action = "create"
+	default:
+		report.Action = "merge"
+	}
+	if existing != nil {
+		report.Status = string(existing.Status)
+	}
+	if report.Action == "unchanged" {
+		return report
+	}
+
+	// Punches of a month whose payroll was closed are reported but not imported
+	if err := s.periodLock.EnsureOpen(ctx, day.date); err != nil {
+		return reject(err)
+	}
+
+	// Punches replace a system absent record, other statuses were set on purpose
+	status := ""
+	if existing == nil || existing.Status == attendance.StatusAbsent {
+		status, err = s.importedStatus(ctx, day, summary.FirstIn)
+		if err != nil {
+			return reject(err)
+		}
+		report.Status = status
+	}
+
+	if dryRun {
+		return report
+	}
+
+	err = s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		attendanceID := report.AttendanceID
+		if existing == nil {
+			record, err := s.attendanceRepo.MarkAttendance(txCtx, &dto.MarkAttendanceRequest{
+				EmployeeID:     day.employee.ID,
+				AttendanceDate: day.date,
+				Status:         status,
+				Notes:          importNotes,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to mark attendance: %w", err)
+			}
+			attendanceID = record.ID
+		} else if status != "" {
+			if _, err := s.attendanceRepo.Update(txCtx, existing.ID, &dto.UpdateAttendanceRequest{Status: status, Notes: importNotes}); err != nil {
+				return fmt.Errorf("failed to update attendance: %w", err)
+			}
+		}
+
+		for _, punch := range added {
+			if _, err := s.attendanceRepo.CreatePunch(txCtx, attendanceID, punch.punchType, punch.time, nil); err != nil {
+				return fmt.Errorf("failed to record punch of line %d: %w", punch.line, err)
+			}
+		}
+
+		record, err := s.syncWorkedTime(txCtx, attendanceID)
+		if err != nil {
+			return err
+		}
+		report.AttendanceID = attendanceID
+
+		if len(skipped) > 0 {
+			return s.flagSkippedPunches(txCtx, record, skippedPunchDetails(skipped))
+		}
+		return nil
+	})
+	if err != nil {
+		return reject(err)
+	}
+
+	return report
+}
+
+// flagSkippedPunches raises a skipped punch anomaly on the day so HR can add the punch by hand if it was real.
+// A day has one such anomaly, punches skipped by a later import are added to it and reopen it.
+func (s *AttendanceServiceImpl) flagSkippedPunches(ctx context.Context, record *ent.Attendance, details string) error {
+	anomalies, err := s.anomalyRepo.GetByAttendance(ctx, record.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get anomalies of attendance %d: %w", record.ID, err)
+	}
+
+	for _, anomaly := range anomalies {
+		if anomaly.Rule != attendanceanomaly.RuleSkippedPunch {
+			continue
+		}
+		if _, err := s.anomalyRepo.Reopen(ctx, anomaly.ID, anomaly.Details+"\n"+details); err != nil {
+			return fmt.Errorf("failed to reopen anomaly %d: %w", anomaly.ID, err)
+		}
+		return nil
+	}
+
+	if _, err := s.anomalyRepo.Create(ctx, record, attendanceanomaly.RuleSkippedPunch, details); err != nil {
+		return fmt.Errorf("failed to store skipped punch anomaly of attendance %d: %w", record.ID, err)
+	}
+	return nil
+}
+
+// importedStatus derives the status of an imported day from the first check-in, like MarkAttendance does
+func (s *AttendanceServiceImpl) importedStatus(ctx context.Context, day *importedDay, firstIn time.Time) (string, error) {
+	if firstIn.IsZero() {
+		return "present", nil
+	}
+
+	schedule, err := s.calendar.GetEmployeeSchedule(ctx, day.employee.ID, day.date)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve work schedule: %w", err)
+	}
+	if !schedule.IsWorkingWeekday(day.date) {
+		return "present", nil
+	}
+
+	return arrivalStatus(schedule, day.date, firstIn), nil
+}
+
+// addedPunch is a punch of the log that is not recorded yet
+type addedPunch struct {
+	line      int
+	punchType attendancepunch.PunchType
+	time      time.Time
+}
+
+// skippedPunch is a punch of the log that does not fit the sequence of the day
+type skippedPunch struct {
+	addedPunch
+	reason string
+}
+
+// mergeDevicePunches adds the punches of the log to the punches already recorded for the day, skipping those
+// recorded before. A punch that breaks the sequence of the merged day, e.g. a second check-in, is skipped and
+// returned so it can be reviewed, the valid punches around it are still added.
+func mergeDevicePunches(recorded []*ent.AttendancePunch, incoming []devicePunch) ([]*ent.AttendancePunch, []addedPunch, []skippedPunch) {
+	known := make(map[int64]bool, len(recorded))
+	for _, punch := range recorded {
+		known[punch.PunchTime.Truncate(time.Second).Unix()] = true
+	}
+
+	type entry struct {
+		recorded *ent.AttendancePunch
+		incoming *devicePunch
+		time     time.Time
+	}
+	entries := make([]entry, 0, len(recorded)+len(incoming))
+	for _, punch := range recorded {
+		entries = append(entries, entry{recorded: punch, time: punch.PunchTime})
+	}
+	for i := range incoming {
+		if known[incoming[i].time.Unix()] {
+			continue
+		}
+		entries = append(entries, entry{incoming: &incoming[i], time: incoming[i].time})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].time.Before(entries[j].time)
+	})
+
+	var merged []*ent.AttendancePunch
+	var added []addedPunch
+	var skipped []skippedPunch
+	for _, e := range entries {
+		if e.recorded != nil {
+			merged = append(merged, e.recorded)
+			continue
+		}
+
+		var last *ent.AttendancePunch
+		if len(merged) > 0 {
+			last = merged[len(merged)-1]
+		}
+		punch := addedPunch{line: e.incoming.line, punchType: devicePunchType(e.incoming.state, last), time: e.time}
+		if err := checkPunchSequence(merged, punch.punchType, e.time); err != nil {
+			skipped = append(skipped, skippedPunch{addedPunch: punch, reason: err.Error()})
+			continue
+		}
+
+		merged = append(merged, &ent.AttendancePunch{PunchType: punch.punchType, PunchTime: e.time})
+		added = append(added, punch)
+	}
+
+	return merged, added, skipped
+}
+
+// skippedPunchDetails describes the skipped punches of a day for the anomaly HR reviews
+func skippedPunchDetails(skipped []skippedPunch) string {
+	details := make([]string, 0, len(skipped))
+	for _, punch := range skipped {
+		details = append(details, fmt.Sprintf("Skipped %s at %s from line %d: %s",
+			punch.punchType, punch.time.Format("2006-01-02 15:04:05"), punch.line, punch.reason))
+	}
+
+	return strings.Join(details, "\n")
+}
+
+// devicePunchType maps the in/out state of a terminal to a punch type. Without a state punches alternate
+// between check-in and check-out.
+func devicePunchType(state biometric.PunchState, last *ent.AttendancePunch) attendancepunch.PunchType {
+	switch state {
+	case biometric.StateCheckIn, biometric.StateOvertimeIn:
+		return attendancepunch.PunchTypeCheckIn
+	case biometric.StateCheckOut, biometric.StateOvertimeOut:
+		return attendancepunch.PunchTypeCheckOut
+	case biometric.StateBreakOut:
+		return attendancepunch.PunchTypeBreakStart
+	case biometric.StateBreakIn:
+		return attendancepunch.PunchTypeBreakEnd
+	}
+
+	if last == nil || last.PunchType == attendancepunch.PunchTypeCheckOut {
+		return attendancepunch.PunchTypeCheckIn
+	}
+	return attendancepunch.PunchTypeCheckOut
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/component/totp"
+)
+
+var (
+	// ErrKioskCodeInvalid is returned for kiosk codes that are wrong or no longer shown
+	ErrKioskCodeInvalid = errors.New("kiosk code is invalid or expired")
+
+	// ErrKioskNotAllowed is returned when the employee may not check in on the kiosk
+	ErrKioskNotAllowed = errors.New("employee may not check in on this kiosk")
+
+	// ErrKioskTooManyAttempts is returned while a kiosk or an employee is locked out after too many wrong codes
+	ErrKioskTooManyAttempts = errors.New("too many invalid kiosk codes, try again later")
+)
+
+const (
+	// kioskCodeSkew is how many previous codes are still accepted, a code scanned right before it rotated
+	// reaches the server after the kiosk moved on
+	kioskCodeSkew = 1
+
+	// kioskCodeWindow is how long a code is accepted, a used code is refused for as long
+	kioskCodeWindow = (kioskCodeSkew + 1) * totp.Period
+
+	// kioskFailureWindow is how long wrong codes are counted, the counter starts with the first wrong code
+	kioskFailureWindow = 15 * time.Minute
+
+	// maxKioskFailuresPerEmployee and maxKioskFailuresPerKiosk are the wrong codes accepted within
+	// kioskFailureWindow before check-ins are refused. A six digit code is guessed in a million tries, the
+	// limits keep guessing out of reach while a mistyped code is retried.
+	maxKioskFailuresPerEmployee = 5
+	maxKioskFailuresPerKiosk    = 50
+)
+
+// KioskCheckIn checks an employee in with the rotating code scanned from a kiosk. The code must be the one the
+// kiosk shows at check-in time or the one before and not used yet, and the kiosk must be active and installed
+// at the employee's office. The kiosk is recorded on the attendance and the punch. Wrong codes are counted per
+// kiosk and per employee, check-ins are refused once either made too many.
+func (s *AttendanceServiceImpl) KioskCheckIn(ctx context.Context, employeeID uint64, checkInTime time.Time, req *dto.KioskCheckInRequest) (*dto.AttendanceResponse, error) {
+	kiosk, err := s.attendanceRepo.GetKioskByID(ctx, req.KioskID)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: kiosk %d not found", ErrKioskNotAllowed, req.KioskID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kiosk: %w", err)
+	}
+	if !kiosk.IsActive {
+		return nil, fmt.Errorf("%w: kiosk %s is not active", ErrKioskNotAllowed, kiosk.Name)
+	}
+
+	if err := s.ensureKioskAttemptsLeft(ctx, kiosk.ID, employeeID); err != nil {
+		return nil, err
+	}
+
+	valid, err := totp.Verify(kiosk.Secret, req.Code, checkInTime, kioskCodeSkew)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify kiosk code: %w", err)
+	}
+	if !valid {
+		if err := s.recordKioskFailure(ctx, kiosk.ID, employeeID); err != nil {
+			return nil, err
+		}
+		return nil, ErrKioskCodeInvalid
+	}
+
+	// A kiosk without an office accepts everyone, e.g. a tablet at a shared reception
+	if kiosk.OfficeLocationID != nil {
+		office, err := s.attendanceRepo.GetEmployeeOfficeLocation(ctx, employeeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve office location: %w", err)
+		}
+		if office == nil || office.ID != *kiosk.OfficeLocationID {
+			return nil, fmt.Errorf("%w: kiosk %s is not at the office of employee %d", ErrKioskNotAllowed, kiosk.Name, employeeID)
+		}
+	}
+
+	// A code is accepted once, a code passed on to somebody who is not at the kiosk is refused after it
+	// was scanned there
+	unused, err := s.cache.SetNX(ctx, kioskCodeKey(kiosk.ID, req.Code), employeeID, kioskCodeWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim kiosk code: %w", err)
+	}
+	if !unused {
+		return nil, fmt.Errorf("%w: the code was already used, scan the next one", ErrKioskCodeInvalid)
+	}
+
+	return s.CheckInEmployee(ctx, employeeID, checkInTime, &dto.PunchRequest{KioskID: &kiosk.ID})
+}
+
+// ensureKioskAttemptsLeft refuses a check-in while the kiosk or the employee made too many wrong codes
+func (s *AttendanceServiceImpl) ensureKioskAttemptsLeft(ctx context.Context, kioskID, employeeID uint64) error {
+	limits := map[string]int{
+		kioskFailuresKey("kiosk", kioskID):       maxKioskFailuresPerKiosk,
+		kioskFailuresKey("employee", employeeID): maxKioskFailuresPerEmployee,
+	}
+	for key, limit := range limits {
+		raw, err := s.cache.GetRawString(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get kiosk failures: %w", err)
+		}
+		if raw == "" {
+			continue
+		}
+		failures, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse kiosk failures: %w", err)
+		}
+		if failures >= limit {
+			return ErrKioskTooManyAttempts
+		}
+	}
+
+	return nil
+}
+
+// recordKioskFailure counts a wrong code for the kiosk and the employee
+func (s *AttendanceServiceImpl) recordKioskFailure(ctx context.Context, kioskID, employeeID uint64) error {
+	for _, key := range []string{kioskFailuresKey("kiosk", kioskID), kioskFailuresKey("employee", employeeID)} {
+		if _, err := s.cache.Increment(ctx, key, kioskFailureWindow); err != nil {
+			return fmt.Errorf("failed to count kiosk failure: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// kioskFailuresKey is the cache key counting wrong kiosk codes of a kiosk or an employee
+func kioskFailuresKey(scope string, id uint64) string {
+	return fmt.Sprintf("attendance:kiosk-failures:%s:%d", scope, id)
+}
+
+// kioskCodeKey is the cache key marking a kiosk code as used
+func kioskCodeKey(kioskID uint64, code string) string {
+	return fmt.Sprintf("attendance:kiosk-code:%d:%s", kioskID, code)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendancepunch"
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// minimumOvertimeMinutes is the shortest stay after the scheduled end that is recorded as overtime
+const minimumOvertimeMinutes = 30
+
+// StartBreak records the start of a break on the employee's open attendance record
+func (s *AttendanceServiceImpl) StartBreak(ctx context.Context, employeeID uint64, breakTime time.Time) (*dto.AttendanceResponse, error) {
+	attendance, err := s.getOpenAttendance(ctx, employeeID, breakTime)
+	if err != nil {
+		return nil, fmt.Errorf("no open check-in record found for employee %d", employeeID)
+	}
+
+	return s.recordPunch(ctx, attendance, attendancepunch.PunchTypeBreakStart, breakTime, nil)
+}
+
+// EndBreak records the end of a break on the employee's open attendance record
+func (s *AttendanceServiceImpl) EndBreak(ctx context.Context, employeeID uint64, breakTime time.Time) (*dto.AttendanceResponse, error) {
+	attendance, err := s.getOpenAttendance(ctx, employeeID, breakTime)
+	if err != nil {
+		return nil, fmt.Errorf("no open check-in record found for employee %d", employeeID)
+	}
+
+	return s.recordPunch(ctx, attendance, attendancepunch.PunchTypeBreakEnd, breakTime, nil)
+}
+
+// GetAttendancePunches retrieves the punches of an attendance record in chronological order
+func (s *AttendanceServiceImpl) GetAttendancePunches(ctx context.Context, attendanceID uint64) ([]dto.AttendancePunchResponse, error) {
+	// Check if attendance exists
+	_, err := s.attendanceRepo.GetByID(ctx, attendanceID)
+	if err != nil {
+		return nil, fmt.Errorf("attendance record not found: %w", err)
+	}
+
+	punches, err := s.attendanceRepo.GetPunches(ctx, attendanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get punches: %w", err)
+	}
+
+	punchResponses := make([]dto.AttendancePunchResponse, len(punches))
+	for i, punch := range punches {
+		punchResponses[i] = dto.AttendancePunchResponse{
+			ID:             punch.ID,
+			AttendanceID:   punch.AttendanceID,
+			PunchType:      string(punch.PunchType),
+			PunchTime:      punch.PunchTime,
+			Latitude:       punch.Latitude,
+			Longitude:      punch.Longitude,
+			DistanceMeters: punch.DistanceMeters,
+			KioskID:        punch.KioskID,
+			CreatedAt:      punch.CreatedAt,
+		}
+	}
+
+	return punchResponses, nil
+}
+
+// recordPunch appends a punch to the day after checking it follows the previous punch, then refreshes the totals
+func (s *AttendanceServiceImpl) recordPunch(ctx context.Context, record *ent.Attendance, punchType attendancepunch.PunchType, punchTime time.Time, location *dto.PunchLocation) (*dto.AttendanceResponse, error) {
+	if err := s.periodLock.EnsureOpen(ctx, record.AttendanceDate); err != nil {
+		return nil, err
+	}
+
+	punches, err := s.attendanceRepo.GetPunches(ctx, record.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get punches: %w", err)
+	}
+
+	if err := checkPunchSequence(punches, punchType, punchTime); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.attendanceRepo.CreatePunch(ctx, record.ID, punchType, punchTime, location); err != nil {
+		return nil, fmt.Errorf("failed to record punch: %w", err)
+	}
+
+	attendance, err := s.syncWorkedTime(ctx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mapToAttendanceResponse(attendance), nil
+}
+
+// alignPunches keeps the punches in line with check-in and check-out times set directly on the record,
+// moving the first check-in and the last check-out punch or adding them when missing
+func (s *AttendanceServiceImpl) alignPunches(ctx context.Context, attendanceID uint64, checkInTime, checkOutTime time.Time, checkInLocation, checkOutLocation *dto.PunchLocation) error {
+	if checkInTime.IsZero() && checkOutTime.IsZero() {
+		return nil
+	}
+
+	punches, err := s.attendanceRepo.GetPunches(ctx, attendanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get punches: %w", err)
+	}
+
+	if !checkInTime.IsZero() {
+		if len(punches) > 0 && punches[0].PunchType == attendancepunch.PunchTypeCheckIn {
+			if !punches[0].PunchTime.Equal(checkInTime) {
+				if err := s.attendanceRepo.UpdatePunchTime(ctx, punches[0].ID, checkInTime); err != nil {
+					return fmt.Errorf("failed to move check-in punch: %w", err)
+				}
+			}
+		} else if _, err := s.attendanceRepo.CreatePunch(ctx, attendanceID, attendancepunch.PunchTypeCheckIn, checkInTime, checkInLocation); err != nil {
+			return fmt.Errorf("failed to record check-in punch: %w", err)
+		}
+	}
+
+	if !checkOutTime.IsZero() {
+		if last := len(punches) - 1; last >= 0 && punches[last].PunchType == attendancepunch.PunchTypeCheckOut {
+			if !punches[last].PunchTime.Equal(checkOutTime) {
+				if err := s.attendanceRepo.UpdatePunchTime(ctx, punches[last].ID, checkOutTime); err != nil {
+					return fmt.Errorf("failed to move check-out punch: %w", err)
+				}
+			}
+		} else if _, err := s.attendanceRepo.CreatePunch(ctx, attendanceID, attendancepunch.PunchTypeCheckOut, checkOutTime, checkOutLocation); err != nil {
+			return fmt.Errorf("failed to record check-out punch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// syncWorkedTime derives first-in, last-out, worked and break minutes from the punches and stores them on the record
+func (s *AttendanceServiceImpl) syncWorkedTime(ctx context.Context, attendanceID uint64) (*ent.Attendance, error) {
+	punches, err := s.attendanceRepo.GetPunches(ctx, attendanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get punches: %w", err)
+	}
+
+	attendance, err := s.attendanceRepo.UpdateWorkedTime(ctx, attendanceID, summarizePunches(punches))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update worked time: %w", err)
+	}
+
+	if err := s.detectOvertime(ctx, attendance); err != nil {
+		return nil, err
+	}
+
+	return attendance, nil
+}
+
+// detectOvertime compares the last check-out with the scheduled end of the shift and keeps a pending overtime
+// for approval. On rest days and public holidays every worked minute is overtime.
+func (s *AttendanceServiceImpl) detectOvertime(ctx context.Context, attendance *ent.Attendance) error {
+	if attendance.CheckOutTime.IsZero() {
+		return nil
+	}
+
+	// The employee did not check out, time up to an auto-closed check-out is not overtime
+	if attendance.AutoClosed {
+		if err := s.attendanceRepo.DeletePendingOvertime(ctx, attendance.ID); err != nil {
+			return fmt.Errorf("failed to remove pending overtime: %w", err)
+		}
+		return nil
+	}
+
+	workingDay, err := s.calendar.IsEmployeeWorkingDay(ctx, attendance.EmployeeID, attendance.AttendanceDate)
+	if err != nil {
+		return fmt.Errorf("failed to check working day: %w", err)
+	}
+
+	var scheduledEnd *time.Time
+	minutes := attendance.WorkedMinutes
+	if workingDay {
+		schedule, err := s.calendar.GetEmployeeSchedule(ctx, attendance.EmployeeID, attendance.AttendanceDate)
+		if err != nil {
+			return fmt.Errorf("failed to resolve work schedule: %w", err)
+		}
+
+		end := schedule.EndOn(attendance.AttendanceDate)
+		scheduledEnd = &end
+		minutes = int(attendance.CheckOutTime.Sub(end) / time.Minute)
+	}
+
+	if minutes < minimumOvertimeMinutes {
+		if err := s.attendanceRepo.DeletePendingOvertime(ctx, attendance.ID); err != nil {
+			return fmt.Errorf("failed to remove pending overtime: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.attendanceRepo.SaveDetectedOvertime(ctx, attendance, scheduledEnd, minutes, !workingDay); err != nil {
+		return fmt.Errorf("failed to save detected overtime: %w", err)
+	}
+
+	return nil
+}
+
+// checkPunchSequence validates that a punch can follow the punches already recorded for the day
+func checkPunchSequence(punches []*ent.AttendancePunch, punchType attendancepunch.PunchType, punchTime time.Time) error {
+	var last *ent.AttendancePunch
+	if len(punches) > 0 {
+		last = punches[len(punches)-1]
+		if punchTime.Before(last.PunchTime) {
+			return fmt.Errorf("%s cannot be before the previous punch at %s", punchType, last.PunchTime.Format("15:04:05"))
+		}
+	}
+
+	switch punchType {
+	case attendancepunch.PunchTypeCheckIn:
+		if last != nil && last.PunchType != attendancepunch.PunchTypeCheckOut {
+			return fmt.Errorf("employee is already checked in")
+		}
+	case attendancepunch.PunchTypeBreakStart:
+		if last == nil || last.PunchType == attendancepunch.PunchTypeCheckOut {
+			return fmt.Errorf("employee is not checked in")
+		}
+		if last.PunchType == attendancepunch.PunchTypeBreakStart {
+			return fmt.Errorf("employee is already on a break")
+		}
+	case attendancepunch.PunchTypeBreakEnd:
+		if last == nil || last.PunchType != attendancepunch.PunchTypeBreakStart {
+			return fmt.Errorf("employee is not on a break")
+		}
+	case attendancepunch.PunchTypeCheckOut:
+		if last == nil || last.PunchType == attendancepunch.PunchTypeCheckOut {
+			return fmt.Errorf("employee is not checked in")
+		}
+	}
+
+	return nil
+}
+
+// summarizePunches walks the punches in chronological order and adds up work and break segments.
+// Checking out during a break ends the break, the last check-out is only set once the day is closed.
+func summarizePunches(punches []*ent.AttendancePunch) *dto.WorkedTimeSummary {
+	summary := &dto.WorkedTimeSummary{}
+
+	var workStart, breakStart time.Time
+	var worked, onBreak time.Duration
+
+	for _, punch := range punches {
+		switch punch.PunchType {
+		case attendancepunch.PunchTypeCheckIn:
+			if summary.FirstIn.IsZero() {
+				summary.FirstIn = punch.PunchTime
+			}
+			summary.LastOut = time.Time{}
+			workStart = punch.PunchTime
+		case attendancepunch.PunchTypeBreakStart:
+			if !workStart.IsZero() {
+				worked += punch.PunchTime.Sub(workStart)
+				workStart = time.Time{}
+			}
+			breakStart = punch.PunchTime
+		case attendancepunch.PunchTypeBreakEnd:
+			if !breakStart.IsZero() {
+				onBreak += punch.PunchTime.Sub(breakStart)
+				breakStart = time.Time{}
+			}
+			workStart = punch.PunchTime
+		case attendancepunch.PunchTypeCheckOut:
+			if !workStart.IsZero() {
+				worked += punch.PunchTime.Sub(workStart)
+				workStart = time.Time{}
+			}
+			if !breakStart.IsZero() {
+				onBreak += punch.PunchTime.Sub(breakStart)
+				breakStart = time.Time{}
+			}
+			summary.LastOut = punch.PunchTime
+		}
+	}
+
+	summary.WorkedMinutes = int(worked / time.Minute)
+	summary.BreakMinutes = int(onBreak / time.Minute)
+
+	return summary
+}
@@ -3,33 +3,65 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
+	"math"
 	"time"
 
 	"mceasy/ent"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/officelocation"
+	"mceasy/exceptions"
 	"mceasy/internal/applications/attendance/dto"
 	"mceasy/internal/applications/attendance/repository"
+	"mceasy/internal/component/biometric"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 )
 
 // AttendanceService defines the interface for attendance business logic
 type AttendanceService interface {
 	MarkAttendance(ctx context.Context, req *dto.MarkAttendanceRequest) (*dto.AttendanceResponse, error)
+	BulkMarkAttendance(ctx context.Context, req *dto.BulkMarkAttendanceRequest) (*dto.BulkMarkAttendanceResponse, error)
+	ImportPunchLog(ctx context.Context, log io.Reader, dryRun bool) (*dto.PunchImportResult, error)
+	RecordDevicePunches(ctx context.Context, punches []biometric.Punch, loc *time.Location) (*dto.PunchImportResult, error)
 	GetAttendanceByID(ctx context.Context, id uint64) (*dto.AttendanceResponse, error)
 	GetAttendanceByEmployeeAndDate(ctx context.Context, employeeID uint64, date time.Time) (*dto.AttendanceResponse, error)
 	UpdateAttendance(ctx context.Context, id uint64, req *dto.UpdateAttendanceRequest) (*dto.AttendanceResponse, error)
 	DeleteAttendance(ctx context.Context, id uint64) error
 	ListAttendance(ctx context.Context, params *dto.AttendanceQueryParams) (*dto.AttendanceListResponse, error)
 	GetTodayAttendance(ctx context.Context) ([]dto.AttendanceResponse, error)
+	GetEmployeeLocation(ctx context.Context, employeeID uint64) (*time.Location, error)
 	GetDailyAttendanceSummary(ctx context.Context, date time.Time) (*dto.DailyAttendanceSummary, error)
-	CheckInEmployee(ctx context.Context, employeeID uint64, checkInTime time.Time) (*dto.AttendanceResponse, error)
-	CheckOutEmployee(ctx context.Context, employeeID uint64, checkOutTime time.Time) (*dto.AttendanceResponse, error)
-	AutoMarkAbsentEmployees(ctx context.Context) error
+	CheckInEmployee(ctx context.Context, employeeID uint64, checkInTime time.Time, punch *dto.PunchRequest) (*dto.AttendanceResponse, error)
+	CheckOutEmployee(ctx context.Context, employeeID uint64, checkOutTime time.Time, punch *dto.PunchRequest) (*dto.AttendanceResponse, error)
+	KioskCheckIn(ctx context.Context, employeeID uint64, checkInTime time.Time, req *dto.KioskCheckInRequest) (*dto.AttendanceResponse, error)
+	StartBreak(ctx context.Context, employeeID uint64, breakTime time.Time) (*dto.AttendanceResponse, error)
+	EndBreak(ctx context.Context, employeeID uint64, breakTime time.Time) (*dto.AttendanceResponse, error)
+	GetAttendancePunches(ctx context.Context, attendanceID uint64) ([]dto.AttendancePunchResponse, error)
+	AutoMarkAbsentEmployees(ctx context.Context, now time.Time) (*dto.AutoAbsentResult, error)
+	BackfillAbsences(ctx context.Context, req *dto.BackfillAbsenceRequest) (*dto.AutoAbsentResult, error)
+	RequestCorrection(ctx context.Context, attendanceID uint64, req *dto.CreateAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error)
+	GetCorrectionByID(ctx context.Context, id uint64) (*dto.AttendanceCorrectionResponse, error)
+	ListCorrections(ctx context.Context, params *dto.AttendanceCorrectionQueryParams) (*dto.AttendanceCorrectionListResponse, error)
+	ApproveCorrection(ctx context.Context, id uint64, req *dto.ReviewAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error)
+	RejectCorrection(ctx context.Context, id uint64, req *dto.ReviewAttendanceCorrectionRequest) (*dto.AttendanceCorrectionResponse, error)
+	DetectAnomalies(ctx context.Context, now time.Time) (*dto.AnomalyDetectionResult, error)
+	DetectAnomaliesBetween(ctx context.Context, req *dto.DetectAttendanceAnomaliesRequest) (*dto.AnomalyDetectionResult, error)
+	AutoCheckOut(ctx context.Context, now time.Time) (*dto.AutoCheckOutResult, error)
+	GetAnomalyByID(ctx context.Context, id uint64) (*dto.AttendanceAnomalyResponse, error)
+	ListAnomalies(ctx context.Context, params *dto.AttendanceAnomalyQueryParams) (*dto.AttendanceAnomalyListResponse, error)
+	ResolveAnomaly(ctx context.Context, id uint64, req *dto.ResolveAttendanceAnomalyRequest) (*dto.AttendanceAnomalyResponse, error)
 }
 
 // AttendanceServiceImpl implements the AttendanceService interface
 type AttendanceServiceImpl struct {
 	attendanceRepo repository.AttendanceRepository
+	correctionRepo repository.AttendanceCorrectionRepository
+	anomalyRepo    repository.AttendanceAnomalyRepository
+	calendar       calendar.WorkingDayCalendar
+	periodLock     periodlock.PeriodLock
 	cache          cache.Cache
 	trx            transaction.Trx
 }
@@ -37,11 +69,19 @@ type AttendanceServiceImpl struct {
 // NewAttendanceService creates a new attendance service instance
 func NewAttendanceService(
 	attendanceRepo repository.AttendanceRepository,
+	correctionRepo repository.AttendanceCorrectionRepository,
+	anomalyRepo repository.AttendanceAnomalyRepository,
+	calendar calendar.WorkingDayCalendar,
+	periodLock periodlock.PeriodLock,
 	cache cache.Cache,
 	trx transaction.Trx,
 ) *AttendanceServiceImpl {
 	return &AttendanceServiceImpl{
 		attendanceRepo: attendanceRepo,
+		correctionRepo: correctionRepo,
+		anomalyRepo:    anomalyRepo,
+		calendar:       calendar,
+		periodLock:     periodLock,
 		cache:          cache,
 		trx:            trx,
 	}
@@ -49,29 +89,64 @@ func NewAttendanceService(
 
 // MarkAttendance marks attendance for an employee
 func (s *AttendanceServiceImpl) MarkAttendance(ctx context.Context, req *dto.MarkAttendanceRequest) (*dto.AttendanceResponse, error) {
-	// Validate attendance date (not future date)
-	if req.AttendanceDate.After(time.Now()) {
+	// Attendance dates are calendar dates stored as UTC midnight
+	req.AttendanceDate = time.Date(req.AttendanceDate.Year(), req.AttendanceDate.Month(), req.AttendanceDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	if err := validateStatusMetadata(req.Status, req.StatusMetadata); err != nil {
+		return nil, err
+	}
+	// Marking a day replaces its details, metadata of an earlier status does not carry over
+	if req.StatusMetadata == nil {
+		req.StatusMetadata = &dto.AttendanceStatusMetadata{}
+	}
+
+	// Validate attendance date (not future date in the employee's timezone)
+	today, err := s.employeeDate(ctx, req.EmployeeID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if req.AttendanceDate.After(today) {
 		return nil, fmt.Errorf("cannot mark attendance for future dates")
 	}
 
-	// Check if it's a weekend and adjust status if needed
-	if isWeekend(req.AttendanceDate) && req.Status == "present" {
-		return nil, fmt.Errorf("cannot mark present on weekends")
+	// Attendance of a month whose payroll was closed is final
+	if err := s.periodLock.EnsureOpen(ctx, req.AttendanceDate); err != nil {
+		return nil, err
+	}
+
+	// Rest days and shift times follow the employee's assigned work schedule
+	schedule, err := s.calendar.GetEmployeeSchedule(ctx, req.EmployeeID, req.AttendanceDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve work schedule: %w", err)
+	}
+
+	// Check if it's a rest day and adjust status if needed
+	if !schedule.IsWorkingWeekday(req.AttendanceDate) && (req.Status == "present" || req.Status == "remote") {
+		return nil, fmt.Errorf("cannot mark %s on rest days of work schedule %s", req.Status, schedule.Name)
+	}
+
+	// Nobody can be absent on a public holiday or cuti bersama
+	if req.Status == "absent" {
+		holiday, err := s.calendar.GetHoliday(ctx, req.AttendanceDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check holiday calendar: %w", err)
+		}
+		if holiday != nil {
+			return nil, fmt.Errorf("cannot mark absent on holiday %s", holiday.Name)
+		}
 	}
 
 	// Validate check-in and check-out times
 	if !req.CheckInTime.IsZero() && !req.CheckOutTime.IsZero() {
+		req.CheckOutTime = rollOverCheckOut(schedule, req.CheckInTime, req.CheckOutTime)
 		if req.CheckOutTime.Before(req.CheckInTime) {
 			return nil, fmt.Errorf("check-out time cannot be before check-in time")
 		}
 	}
 
-	// Determine if employee is late (after 9:00 AM)
+	// Determine if employee is late or only worked half a day
 	if req.Status == "present" && !req.CheckInTime.IsZero() {
-		nineAM := time.Date(req.AttendanceDate.Year(), req.AttendanceDate.Month(), req.AttendanceDate.Day(), 9, 0, 0, 0, req.AttendanceDate.Location())
-		if req.CheckInTime.After(nineAM) {
-			req.Status = "late"
-		}
+		req.Status = arrivalStatus(schedule, req.AttendanceDate, req.CheckInTime)
 	}
 
 	attendance, err := s.attendanceRepo.MarkAttendance(ctx, req)
@@ -79,6 +154,16 @@ func (s *AttendanceServiceImpl) MarkAttendance(ctx context.Context, req *dto.Mar
 		return nil, fmt.Errorf("failed to mark attendance: %w", err)
 	}
 
+	// Check-in and check-out are the first and last punches of the day
+	if err := s.alignPunches(ctx, attendance.ID, req.CheckInTime, req.CheckOutTime, req.CheckInLocation, req.CheckOutLocation); err != nil {
+		return nil, err
+	}
+
+	attendance, err = s.syncWorkedTime(ctx, attendance.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.mapToAttendanceResponse(attendance), nil
 }
 
@@ -105,34 +190,80 @@ func (s *AttendanceServiceImpl) GetAttendanceByEmployeeAndDate(ctx context.Conte
 // UpdateAttendance updates an attendance record
 func (s *AttendanceServiceImpl) UpdateAttendance(ctx context.Context, id uint64, req *dto.UpdateAttendanceRequest) (*dto.AttendanceResponse, error) {
 	// Check if attendance exists
-	_, err := s.attendanceRepo.GetByID(ctx, id)
+	existing, err := s.attendanceRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("attendance record not found: %w", err)
 	}
 
-	// Validate check-in and check-out times
-	if !req.CheckInTime.IsZero() && !req.CheckOutTime.IsZero() {
-		if req.CheckOutTime.Before(req.CheckInTime) {
+	if err := s.periodLock.EnsureOpen(ctx, existing.AttendanceDate); err != nil {
+		return nil, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = string(existing.Status)
+	}
+	if err := validateStatusMetadata(status, req.StatusMetadata); err != nil {
+		return nil, err
+	}
+	// The details of the previous status no longer apply once the status changes
+	if status != string(existing.Status) && req.StatusMetadata == nil {
+		req.StatusMetadata = &dto.AttendanceStatusMetadata{}
+	}
+
+	// Validate check-in and check-out times against the stored check-in when only check-out is sent
+	checkInTime := req.CheckInTime
+	if checkInTime.IsZero() {
+		checkInTime = existing.CheckInTime
+	}
+	if !checkInTime.IsZero() && !req.CheckOutTime.IsZero() {
+		schedule, err := s.calendar.GetEmployeeSchedule(ctx, existing.EmployeeID, existing.AttendanceDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve work schedule: %w", err)
+		}
+
+		req.CheckOutTime = rollOverCheckOut(schedule, checkInTime, req.CheckOutTime)
+		if req.CheckOutTime.Before(checkInTime) {
 			return nil, fmt.Errorf("check-out time cannot be before check-in time")
 		}
 	}
 
+	// A check-out sent for an auto-closed record is the dispute of the automatic one
+	if existing.AutoClosed && !req.CheckOutTime.IsZero() {
+		autoClosed := false
+		req.AutoClosed = &autoClosed
+	}
+
 	attendance, err := s.attendanceRepo.Update(ctx, id, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update attendance: %w", err)
 	}
 
+	// Check-in and check-out are the first and last punches of the day
+	if err := s.alignPunches(ctx, attendance.ID, req.CheckInTime, req.CheckOutTime, req.CheckInLocation, req.CheckOutLocation); err != nil {
+		return nil, err
+	}
+
+	attendance, err = s.syncWorkedTime(ctx, attendance.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.mapToAttendanceResponse(attendance), nil
 }
 
 // DeleteAttendance soft deletes an attendance record
 func (s *AttendanceServiceImpl) DeleteAttendance(ctx context.Context, id uint64) error {
 	// Check if attendance exists
-	_, err := s.attendanceRepo.GetByID(ctx, id)
+	existing, err := s.attendanceRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("attendance record not found: %w", err)
 	}
 
+	if err := s.periodLock.EnsureOpen(ctx, existing.AttendanceDate); err != nil {
+		return err
+	}
+
 	err = s.attendanceRepo.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete attendance: %w", err)
@@ -194,81 +325,216 @@ func (s *AttendanceServiceImpl) GetDailyAttendanceSummary(ctx context.Context, d
 	return summary, nil
 }
 
-// CheckInEmployee marks an employee as present with check-in time
-func (s *AttendanceServiceImpl) CheckInEmployee(ctx context.Context, employeeID uint64, checkInTime time.Time) (*dto.AttendanceResponse, error) {
-	today := time.Now()
-	attendanceDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+// CheckInEmployee marks an employee as present with check-in time and location
+func (s *AttendanceServiceImpl) CheckInEmployee(ctx context.Context, employeeID uint64, checkInTime time.Time, punch *dto.PunchRequest) (*dto.AttendanceResponse, error) {
+	// Attendance is tied to the shift date, which is the previous day for a late punch on an overnight shift
+	attendanceDate, err := s.calendar.GetEmployeeShiftDate(ctx, employeeID, checkInTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve shift date: %w", err)
+	}
+
+	// Checking in again after checking out, e.g. on a split shift, adds a punch to the same day
+	existing, _ := s.attendanceRepo.GetByEmployeeAndDate(ctx, employeeID, attendanceDate)
 
-	// Determine status based on check-in time
-	status := "present"
-	nineAM := time.Date(today.Year(), today.Month(), today.Day(), 9, 0, 0, 0, today.Location())
-	if checkInTime.After(nineAM) {
-		status = "late"
+	// A day marked in advance as remote or business trip keeps its status and is not held to the office geofence
+	var location *dto.PunchLocation
+	if existing != nil && isAwayStatus(string(existing.Status)) {
+		location = awayPunchLocation(punch)
+	} else {
+		location, err = s.validatePunchLocation(ctx, employeeID, "check-in", punch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if existing != nil && !existing.CheckInTime.IsZero() {
+		return s.recordPunch(ctx, existing, attendancepunch.PunchTypeCheckIn, checkInTime, location)
 	}
 
+	if existing != nil && isAwayStatus(string(existing.Status)) {
+		return s.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:      employeeID,
+			AttendanceDate:  attendanceDate,
+			CheckInTime:     checkInTime,
+			Status:          string(existing.Status),
+			Notes:           existing.Notes,
+			MarkedByAdmin:   existing.MarkedByAdmin,
+			StatusMetadata:  dto.NewAttendanceStatusMetadata(existing.StatusMetadata),
+			CheckInLocation: location,
+		})
+	}
+
+	// Late and half day are decided against the employee's work schedule in MarkAttendance
 	req := &dto.MarkAttendanceRequest{
-		EmployeeID:     employeeID,
-		AttendanceDate: attendanceDate,
-		CheckInTime:    checkInTime,
-		Status:         status,
-		MarkedByAdmin:  false,
+		EmployeeID:      employeeID,
+		AttendanceDate:  attendanceDate,
+		CheckInTime:     checkInTime,
+		Status:          "present",
+		MarkedByAdmin:   false,
+		CheckInLocation: location,
 	}
 
 	return s.MarkAttendance(ctx, req)
 }
 
-// CheckOutEmployee updates check-out time for an employee
-func (s *AttendanceServiceImpl) CheckOutEmployee(ctx context.Context, employeeID uint64, checkOutTime time.Time) (*dto.AttendanceResponse, error) {
-	today := time.Now()
-	attendanceDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+// CheckOutEmployee updates check-out time and location on the employee's open attendance record
+func (s *AttendanceServiceImpl) CheckOutEmployee(ctx context.Context, employeeID uint64, checkOutTime time.Time, punch *dto.PunchRequest) (*dto.AttendanceResponse, error) {
+	// Get the open attendance record, which may have started on the previous day for overnight shifts
+	attendance, err := s.getOpenAttendance(ctx, employeeID, checkOutTime)
+	if err != nil {
+		return nil, fmt.Errorf("no open check-in record found for employee %d", employeeID)
+	}
+
+	var location *dto.PunchLocation
+	if isAwayStatus(string(attendance.Status)) {
+		location = awayPunchLocation(punch)
+	} else {
+		location, err = s.validatePunchLocation(ctx, employeeID, "check-out", punch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.recordPunch(ctx, attendance, attendancepunch.PunchTypeCheckOut, checkOutTime, location)
+}
+
+// validatePunchLocation checks a check-in or check-out against the geofence of the employee's office.
+// Punches outside the radius are rejected or flagged for review depending on the office enforcement.
+func (s *AttendanceServiceImpl) validatePunchLocation(ctx context.Context, employeeID uint64, punchType string, punch *dto.PunchRequest) (*dto.PunchLocation, error) {
+	// The code of a kiosk can only be read in front of it, the kiosk binding was verified instead of the geofence
+	if punch != nil && punch.KioskID != nil {
+		return &dto.PunchLocation{KioskID: punch.KioskID}, nil
+	}
+
+	office, err := s.attendanceRepo.GetEmployeeOfficeLocation(ctx, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve office location: %w", err)
+	}
+
+	// Without an assigned office the coordinates are only recorded
+	if office == nil {
+		if !punch.HasCoordinates() {
+			return nil, nil
+		}
+		return &dto.PunchLocation{Latitude: punch.Latitude, Longitude: punch.Longitude}, nil
+	}
+
+	if !punch.HasCoordinates() {
+		if office.Enforcement == officelocation.EnforcementReject {
+			return nil, fmt.Errorf("location is required for %s at office %s", punchType, office.Name)
+		}
+		return &dto.PunchLocation{OutsideRadius: true}, nil
+	}
+
+	distance := math.Round(haversineDistance(*punch.Latitude, *punch.Longitude, office.Latitude, office.Longitude)*100) / 100
+	outside := distance > float64(office.RadiusMeters)
+	if outside && office.Enforcement == officelocation.EnforcementReject {
+		return nil, fmt.Errorf("%s location is %.0f meters from office %s, outside the allowed radius of %d meters",
+			punchType, distance, office.Name, office.RadiusMeters)
+	}
+
+	return &dto.PunchLocation{
+		Latitude:       punch.Latitude,
+		Longitude:      punch.Longitude,
+		DistanceMeters: &distance,
+		OutsideRadius:  outside,
+	}, nil
+}
+
+// haversineDistance calculates the great-circle distance in meters between two coordinates
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadiusMeters = 6371000
+
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLon := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLon/2)*math.Sin(dLon/2)
 
-	// Get existing attendance record
-	attendance, err := s.attendanceRepo.GetByEmployeeAndDate(ctx, employeeID, attendanceDate)
+	return 2 * earthRadiusMeters * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// GetEmployeeLocation resolves the timezone attendance of the employee is recorded in
+func (s *AttendanceServiceImpl) GetEmployeeLocation(ctx context.Context, employeeID uint64) (*time.Location, error) {
+	loc, err := s.calendar.GetEmployeeLocation(ctx, employeeID)
 	if err != nil {
-		return nil, fmt.Errorf("no check-in record found for today")
+		return nil, fmt.Errorf("failed to resolve employee timezone: %w", err)
 	}
 
-	req := &dto.UpdateAttendanceRequest{
-		CheckOutTime: checkOutTime,
+	return loc, nil
+}
+
+// employeeDate returns the calendar date of an instant in the employee's timezone
+func (s *AttendanceServiceImpl) employeeDate(ctx context.Context, employeeID uint64, at time.Time) (time.Time, error) {
+	loc, err := s.GetEmployeeLocation(ctx, employeeID)
+	if err != nil {
+		return time.Time{}, err
 	}
 
-	return s.UpdateAttendance(ctx, attendance.ID, req)
+	return calendar.DateIn(at, loc), nil
 }
 
-// AutoMarkAbsentEmployees automatically marks employees as absent if not present by 9:00 AM
-func (s *AttendanceServiceImpl) AutoMarkAbsentEmployees(ctx context.Context) error {
-	today := time.Now()
+// getOpenAttendance retrieves the attendance a punch at the given moment closes or pauses
+func (s *AttendanceServiceImpl) getOpenAttendance(ctx context.Context, employeeID uint64, at time.Time) (*ent.Attendance, error) {
+	date, err := s.employeeDate(ctx, employeeID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attendanceRepo.GetOpenAttendance(ctx, employeeID, date)
+}
 
-	// Only run on weekdays
-	if isWeekend(today) {
-		return nil
+// arrivalStatus decides between present, late and half day from the check-in time and the work schedule
+func arrivalStatus(schedule *calendar.Schedule, attendanceDate, checkInTime time.Time) string {
+	if halfDayAfter, ok := schedule.HalfDayAfter(attendanceDate); ok && checkInTime.After(halfDayAfter) {
+		return "half_day"
 	}
+	if checkInTime.After(schedule.LateAfter(attendanceDate)) {
+		return "late"
+	}
+	return "present"
+}
 
-	// Only run after 9:00 AM
-	nineAM := time.Date(today.Year(), today.Month(), today.Day(), 9, 0, 0, 0, today.Location())
-	if today.Before(nineAM) {
-		return nil
+// rollOverCheckOut moves a check-out that falls before the check-in on an overnight shift to the next day,
+// which happens when only the wall clock time of the check-out was provided
+func rollOverCheckOut(schedule *calendar.Schedule, checkInTime, checkOutTime time.Time) time.Time {
+	if schedule.IsOvernight() && checkOutTime.Before(checkInTime) && checkInTime.Sub(checkOutTime) < 24*time.Hour {
+		return checkOutTime.AddDate(0, 0, 1)
 	}
+	return checkOutTime
+}
 
-	// This would typically be called by a scheduled job
-	// Implementation would get all active employees and mark absent those without attendance records
-	return nil
+// workedHours converts the worked minutes derived from the punches to hours
+func workedHours(workedMinutes int) float64 {
+	return math.Round(float64(workedMinutes)/60*100) / 100
 }
 
 // mapToAttendanceResponse maps an ent.Attendance to dto.AttendanceResponse
 func (s *AttendanceServiceImpl) mapToAttendanceResponse(attendance *ent.Attendance) *dto.AttendanceResponse {
 	response := &dto.AttendanceResponse{
-		ID:             attendance.ID,
-		EmployeeID:     attendance.EmployeeID,
-		AttendanceDate: attendance.AttendanceDate,
-		CheckInTime:    attendance.CheckInTime,
-		CheckOutTime:   attendance.CheckOutTime,
-		Status:         string(attendance.Status),
-		IsWeekend:      attendance.IsWeekend,
-		Notes:          attendance.Notes,
-		MarkedByAdmin:  attendance.MarkedByAdmin,
-		CreatedAt:      attendance.CreatedAt,
-		ModifiedAt:     attendance.ModifiedAt,
+		ID:                     attendance.ID,
+		EmployeeID:             attendance.EmployeeID,
+		AttendanceDate:         attendance.AttendanceDate,
+		CheckInTime:            attendance.CheckInTime,
+		CheckOutTime:           attendance.CheckOutTime,
+		WorkedHours:            workedHours(attendance.WorkedMinutes),
+		WorkedMinutes:          attendance.WorkedMinutes,
+		BreakMinutes:           attendance.BreakMinutes,
+		Status:                 string(attendance.Status),
+		IsWeekend:              attendance.IsWeekend,
+		Notes:                  attendance.Notes,
+		MarkedByAdmin:          attendance.MarkedByAdmin,
+		CheckInLatitude:        attendance.CheckInLatitude,
+		CheckInLongitude:       attendance.CheckInLongitude,
+		CheckInDistanceMeters:  attendance.CheckInDistanceMeters,
+		CheckOutLatitude:       attendance.CheckOutLatitude,
+		CheckOutLongitude:      attendance.CheckOutLongitude,
+		CheckOutDistanceMeters: attendance.CheckOutDistanceMeters,
+		LocationFlagged:        attendance.LocationFlagged,
+		AutoClosed:             attendance.AutoClosed,
+		CheckInKioskID:         attendance.CheckInKioskID,
+		StatusMetadata:         dto.NewAttendanceStatusMetadata(attendance.StatusMetadata),
+		CreatedAt:              attendance.CreatedAt,
+		ModifiedAt:             attendance.ModifiedAt,
 	}
 
 	// Add employee information if available
@@ -280,10 +546,12 @@ func (s *AttendanceServiceImpl) mapToAttendanceResponse(attendance *ent.Attendan
 	return response
 }
 
-// isWeekend checks if the given date is a weekend
-func isWeekend(date time.Time) bool {
-	weekday := date.Weekday()
-	return weekday == time.Saturday || weekday == time.Sunday
+// errorMessage describes an error for a result row, business errors by their cause instead of their category
+func errorMessage(err error) string {
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return bizErr.Detail()
+	}
+	return err.Error()
 }
 
 // parseDate parses date string in format "2006-01-02" or "2006-01-02T15:04:05Z"
@@ -0,0 +1,1124 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
+	"mceasy/ent/attendancepunch"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/officelocation"
+	"mceasy/ent/payrollperiod"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/attendance/dto"
+	"mceasy/internal/applications/attendance/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/totp"
+	"mceasy/internal/component/transaction"
+	"mceasy/internal/vars"
+	"mceasy/test"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis/v8"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaversineDistance(t *testing.T) {
+	// Monas to Bundaran HI in central Jakarta is roughly 2.2 km
+	distance := haversineDistance(-6.1754, 106.8272, -6.1950, 106.8230)
+	assert.InDelta(t, 2229, distance, 25)
+
+	assert.Zero(t, haversineDistance(-6.1754, 106.8272, -6.1754, 106.8272))
+}
+
+func TestSummarizePunches(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, time.August, 4, hour, minute, 0, 0, time.UTC)
+	}
+	punch := func(punchType attendancepunch.PunchType, punchTime time.Time) *ent.AttendancePunch {
+		return &ent.AttendancePunch{PunchType: punchType, PunchTime: punchTime}
+	}
+
+	t.Run("lunch break is excluded from worked minutes", func(t *testing.T) {
+		summary := summarizePunches([]*ent.AttendancePunch{
+			punch(attendancepunch.PunchTypeCheckIn, at(8, 0)),
+			punch(attendancepunch.PunchTypeBreakStart, at(12, 0)),
+			punch(attendancepunch.PunchTypeBreakEnd, at(13, 0)),
+			punch(attendancepunch.PunchTypeCheckOut, at(17, 0)),
+		})
+		assert.Equal(t, at(8, 0), summary.FirstIn)
+		assert.Equal(t, at(17, 0), summary.LastOut)
+		assert.Equal(t, 480, summary.WorkedMinutes)
+		assert.Equal(t, 60, summary.BreakMinutes)
+	})
+
+	t.Run("split shift adds both segments", func(t *testing.T) {
+		summary := summarizePunches([]*ent.AttendancePunch{
+			punch(attendancepunch.PunchTypeCheckIn, at(7, 0)),
+			punch(attendancepunch.PunchTypeCheckOut, at(11, 0)),
+			punch(attendancepunch.PunchTypeCheckIn, at(16, 0)),
+			punch(attendancepunch.PunchTypeCheckOut, at(20, 30)),
+		})
+		assert.Equal(t, at(7, 0), summary.FirstIn)
+		assert.Equal(t, at(20, 30), summary.LastOut)
+		assert.Equal(t, 510, summary.WorkedMinutes)
+		assert.Zero(t, summary.BreakMinutes)
+	})
+
+	t.Run("day stays open after checking in again", func(t *testing.T) {
+		summary := summarizePunches([]*ent.AttendancePunch{
+			punch(attendancepunch.PunchTypeCheckIn, at(7, 0)),
+			punch(attendancepunch.PunchTypeCheckOut, at(11, 0)),
+			punch(attendancepunch.PunchTypeCheckIn, at(16, 0)),
+		})
+		assert.True(t, summary.LastOut.IsZero())
+		assert.Equal(t, 240, summary.WorkedMinutes)
+	})
+
+	t.Run("checking out during a break ends the break", func(t *testing.T) {
+		summary := summarizePunches([]*ent.AttendancePunch{
+			punch(attendancepunch.PunchTypeCheckIn, at(8, 0)),
+			punch(attendancepunch.PunchTypeBreakStart, at(12, 0)),
+			punch(attendancepunch.PunchTypeCheckOut, at(12, 30)),
+		})
+		assert.Equal(t, 240, summary.WorkedMinutes)
+		assert.Equal(t, 30, summary.BreakMinutes)
+	})
+}
+
+func TestCheckPunchSequence(t *testing.T) {
+	checkIn := &ent.AttendancePunch{
+		PunchType: attendancepunch.PunchTypeCheckIn,
+		PunchTime: time.Date(2025, time.August, 4, 8, 0, 0, 0, time.UTC),
+	}
+	later := checkIn.PunchTime.Add(time.Hour)
+
+	assert.NoError(t, checkPunchSequence(nil, attendancepunch.PunchTypeCheckIn, later))
+	assert.Error(t, checkPunchSequence(nil, attendancepunch.PunchTypeCheckOut, later))
+	assert.Error(t, checkPunchSequence([]*ent.AttendancePunch{checkIn}, attendancepunch.PunchTypeCheckIn, later))
+	assert.Error(t, checkPunchSequence([]*ent.AttendancePunch{checkIn}, attendancepunch.PunchTypeBreakEnd, later))
+	assert.NoError(t, checkPunchSequence([]*ent.AttendancePunch{checkIn}, attendancepunch.PunchTypeBreakStart, later))
+	assert.Error(t, checkPunchSequence([]*ent.AttendancePunch{checkIn}, attendancepunch.PunchTypeCheckOut, checkIn.PunchTime.Add(-time.Minute)))
+}
+
+func TestAttendanceServiceImpl_AutoMarkAbsentEmployees(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	hireDate := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newEmployee := func(name, email string) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(email).
+			SetHireDate(hireDate).
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+
+	monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+
+	absentee := newEmployee("No Show", "no.show@example.com")
+	present := newEmployee("On Time", "on.time@example.com")
+	onLeave := newEmployee("On Leave", "on.leave@example.com")
+
+	_, err := client.Attendance.Create().
+		SetEmployeeID(present.ID).
+		SetAttendanceDate(monday).
+		SetCheckInTime(time.Date(2025, time.August, 4, 8, 55, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	annual, err := client.LeaveType.Create().
+		SetCode("annual").
+		SetName("Annual Leave").
+		SetAnnualQuota(12).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.LeaveRequest.Create().
+		SetEmployeeID(onLeave.ID).
+		SetLeaveTypeID(annual.ID).
+		SetStartDate(monday).
+		SetEndDate(monday.AddDate(0, 0, 2)).
+		SetStatus(leaverequest.StatusApproved).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// 17 August 2025 is Sunday, the following Monday is cuti bersama
+	_, err = client.Holiday.Create().
+		SetHolidayDate(time.Date(2025, time.August, 18, 0, 0, 0, 0, time.UTC)).
+		SetName("Cuti Bersama Hari Kemerdekaan").
+		Save(ctx)
+	require.NoError(t, err)
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		nil,
+	)
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	t.Run("nobody is absent before the shift ends", func(t *testing.T) {
+		result, err := attendanceService.AutoMarkAbsentEmployees(ctx, time.Date(2025, time.August, 4, 10, 0, 0, 0, jakarta))
+		require.NoError(t, err)
+		assert.Zero(t, result.MarkedAbsent)
+	})
+
+	t.Run("only employees without attendance or leave are absent", func(t *testing.T) {
+		result, err := attendanceService.AutoMarkAbsentEmployees(ctx, time.Date(2025, time.August, 4, 18, 0, 0, 0, jakarta))
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.MarkedAbsent)
+
+		record, err := client.Attendance.Query().
+			Where(attendance.EmployeeID(absentee.ID), attendance.AttendanceDate(monday)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, attendance.StatusAbsent, record.Status)
+		assert.True(t, record.MarkedByAdmin)
+	})
+
+	t.Run("running again does not mark anyone twice", func(t *testing.T) {
+		result, err := attendanceService.AutoMarkAbsentEmployees(ctx, time.Date(2025, time.August, 4, 18, 15, 0, 0, jakarta))
+		require.NoError(t, err)
+		assert.Zero(t, result.MarkedAbsent)
+	})
+
+	t.Run("backfill skips weekends and holidays", func(t *testing.T) {
+		result, err := attendanceService.BackfillAbsences(ctx, &dto.BackfillAbsenceRequest{
+			StartDate: "2025-08-15",
+			EndDate:   "2025-08-18",
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Days, 4)
+
+		// Friday marks all three employees, the weekend and the holiday nobody
+		assert.Equal(t, 3, result.Days[0].MarkedAbsent)
+		assert.Zero(t, result.Days[1].MarkedAbsent)
+		assert.Zero(t, result.Days[2].MarkedAbsent)
+		assert.Equal(t, "Cuti Bersama Hari Kemerdekaan", result.Days[3].Holiday)
+		assert.Equal(t, 3, result.MarkedAbsent)
+	})
+
+	t.Run("backfill range is limited", func(t *testing.T) {
+		_, err := attendanceService.BackfillAbsences(ctx, &dto.BackfillAbsenceRequest{
+			StartDate: "2025-01-01",
+			EndDate:   "2025-08-18",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestAttendanceServiceImpl_BulkMarkAttendance(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	newEmployee := func(name, email string) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(email).
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+
+	first := newEmployee("Bulk First", "bulk.first@example.com")
+	second := newEmployee("Bulk Second", "bulk.second@example.com")
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	countOn := func(date time.Time) int {
+		count, err := client.Attendance.Query().Where(attendance.AttendanceDate(date)).Count(ctx)
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("best effort keeps valid rows and reports failed ones", func(t *testing.T) {
+		result, err := attendanceService.BulkMarkAttendance(ctx, &dto.BulkMarkAttendanceRequest{
+			AttendanceDate: "2025-08-11",
+			Attendances: []dto.BulkAttendanceItem{
+				{EmployeeID: first.ID, CheckInTime: "08:55", CheckOutTime: "17:05", Status: "present"},
+				{EmployeeID: second.ID, CheckInTime: "09:30", Status: "present"},
+				{EmployeeID: first.ID, Status: "absent"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Succeeded)
+		assert.Equal(t, 1, result.Failed)
+
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, "present", result.Results[0].Attendance.Status)
+		assert.Equal(t, "late", result.Results[1].Attendance.Status)
+		assert.False(t, result.Results[2].Success)
+		assert.Contains(t, result.Results[2].Error, "more than once")
+		assert.Equal(t, 2, countOn(time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("atomic request stores nothing when a row fails", func(t *testing.T) {
+		result, err := attendanceService.BulkMarkAttendance(ctx, &dto.BulkMarkAttendanceRequest{
+			AttendanceDate: "2025-08-12",
+			Atomic:         true,
+			Attendances: []dto.BulkAttendanceItem{
+				{EmployeeID: first.ID, CheckInTime: "08:55", CheckOutTime: "17:05", Status: "present"},
+				{EmployeeID: second.ID, CheckInTime: "09:00", CheckOutTime: "08:00", Status: "present"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, result.Succeeded)
+		assert.Equal(t, 2, result.Failed)
+		assert.Contains(t, result.Results[0].Error, "rolled back")
+		assert.Contains(t, result.Results[1].Error, "check-out time cannot be before check-in time")
+		assert.Zero(t, countOn(time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("atomic request stores every row", func(t *testing.T) {
+		result, err := attendanceService.BulkMarkAttendance(ctx, &dto.BulkMarkAttendanceRequest{
+			AttendanceDate: "2025-08-13",
+			Atomic:         true,
+			Attendances: []dto.BulkAttendanceItem{
+				{EmployeeID: first.ID, CheckInTime: "08:55", CheckOutTime: "17:05", Status: "present"},
+				{EmployeeID: second.ID, Status: "absent"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Succeeded)
+		assert.Equal(t, 2, countOn(time.Date(2025, time.August, 13, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("weekend rows are rejected", func(t *testing.T) {
+		result, err := attendanceService.BulkMarkAttendance(ctx, &dto.BulkMarkAttendanceRequest{
+			AttendanceDate: "2025-08-16",
+			Attendances: []dto.BulkAttendanceItem{
+				{EmployeeID: first.ID, CheckInTime: "08:55", Status: "present"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Failed)
+		assert.Contains(t, result.Results[0].Error, "rest days")
+	})
+}
+
+func TestAttendanceServiceImpl_ImportPunchLog(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	newEmployee := func(name, email, pin string) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(email).
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			SetDevicePin(pin).
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+
+	withStates := newEmployee("With States", "with.states@example.com", "1001")
+	withoutStates := newEmployee("Without States", "without.states@example.com", "1002")
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	monday := time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC)
+	content := "1001\t2025-08-11 08:55:00\t1\t0\n" +
+		"1001\t2025-08-11 08:55:30\t1\t0\n" +
+		"1001\t2025-08-11 12:00:00\t1\t2\n" +
+		"1001\t2025-08-11 13:00:00\t1\t3\n" +
+		"1001\t2025-08-11 17:05:00\t1\t1\n" +
+		"1002\t2025-08-11 09:30:00\n" +
+		"1002\t2025-08-11 17:00:00\n" +
+		"9999\t2025-08-11 08:00:00\t1\t0\n" +
+		"1001\tnot a time\t1\t0\n"
+
+	t.Run("dry run previews the days without storing them", func(t *testing.T) {
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader(content), true)
+		require.NoError(t, err)
+		assert.Equal(t, 9, result.Lines)
+		assert.Equal(t, 1, result.DuplicatePunches)
+		assert.Equal(t, 2, result.ImportedDays)
+		require.Len(t, result.Errors, 2)
+
+		require.Len(t, result.Days, 2)
+		assert.Equal(t, "create", result.Days[0].Action)
+		assert.Equal(t, "present", result.Days[0].Status)
+		assert.Equal(t, 430, result.Days[0].WorkedMinutes)
+		assert.Equal(t, 60, result.Days[0].BreakMinutes)
+		assert.Equal(t, "late", result.Days[1].Status)
+		assert.Equal(t, "check_out", result.Days[1].NewPunches[1].PunchType)
+
+		count, err := client.Attendance.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("import collapses punches into daily attendance", func(t *testing.T) {
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader(content), false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.ImportedDays)
+
+		record, err := client.Attendance.Query().
+			Where(attendance.EmployeeID(withStates.ID), attendance.AttendanceDate(monday)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 430, record.WorkedMinutes)
+		assert.Equal(t, time.Date(2025, time.August, 11, 17, 5, 0, 0, time.UTC), record.CheckOutTime.UTC())
+
+		punches, err := client.AttendancePunch.Query().Where(attendancepunch.AttendanceID(record.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 4, punches)
+	})
+
+	t.Run("importing the same log again changes nothing", func(t *testing.T) {
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader(content), false)
+		require.NoError(t, err)
+		assert.Zero(t, result.ImportedDays)
+		assert.Equal(t, "unchanged", result.Days[0].Action)
+	})
+
+	t.Run("punches replace a system absent record", func(t *testing.T) {
+		tuesday := monday.AddDate(0, 0, 1)
+		_, err := client.Attendance.Create().
+			SetEmployeeID(withoutStates.ID).
+			SetAttendanceDate(tuesday).
+			SetStatus(attendance.StatusAbsent).
+			Save(ctx)
+		require.NoError(t, err)
+
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader("1002,2025-08-12 08:50:00\n1002,2025-08-12 17:00:00\n"), false)
+		require.NoError(t, err)
+		require.Len(t, result.Days, 1)
+		assert.Equal(t, "merge", result.Days[0].Action)
+
+		record, err := client.Attendance.Query().
+			Where(attendance.EmployeeID(withoutStates.ID), attendance.AttendanceDate(tuesday)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, attendance.StatusPresent, record.Status)
+	})
+
+	t.Run("punches out of sequence reject the day", func(t *testing.T) {
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader("1001,2025-08-13 08:50:00,1,1\n"), true)
+		require.NoError(t, err)
+		require.Len(t, result.Days, 1)
+		assert.Equal(t, "rejected", result.Days[0].Action)
+		assert.Equal(t, []int{1}, result.Days[0].Lines)
+		assert.Equal(t, 1, result.RejectedDays)
+	})
+
+	t.Run("a punch out of sequence is skipped and flagged, the rest of the day is imported", func(t *testing.T) {
+		thursday := monday.AddDate(0, 0, 3)
+		log := "1001,2025-08-14 08:50:00,1,0\n" +
+			"1001,2025-08-14 09:10:00,1,0\n" +
+			"1001,2025-08-14 12:00:00,1,2\n" +
+			"1001,2025-08-14 13:00:00,1,3\n" +
+			"1001,2025-08-14 17:00:00,1,1\n"
+
+		result, err := attendanceService.ImportPunchLog(ctx, strings.NewReader(log), false)
+		require.NoError(t, err)
+		require.Len(t, result.Days, 1)
+		assert.Equal(t, "create", result.Days[0].Action)
+		assert.Len(t, result.Days[0].NewPunches, 4)
+		require.Len(t, result.Days[0].SkippedPunches, 1)
+		assert.Equal(t, 2, result.Days[0].SkippedPunches[0].Line)
+		assert.Equal(t, "employee is already checked in", result.Days[0].SkippedPunches[0].Reason)
+
+		record, err := client.Attendance.Query().
+			Where(attendance.EmployeeID(withStates.ID), attendance.AttendanceDate(thursday)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 430, record.WorkedMinutes)
+
+		anomaly, err := client.AttendanceAnomaly.Query().
+			Where(attendanceanomaly.AttendanceID(record.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, attendanceanomaly.RuleSkippedPunch, anomaly.Rule)
+		assert.Equal(t, attendanceanomaly.StatusOpen, anomaly.Status)
+		assert.Contains(t, anomaly.Details, "line 2")
+	})
+}
+
+func TestAttendanceServiceImpl_KioskCheckIn(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	newOffice := func(name string) *ent.OfficeLocation {
+		office, err := client.OfficeLocation.Create().
+			SetName(name).
+			SetLatitude(-6.2).
+			SetLongitude(106.8).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+		return office
+	}
+	headOffice := newOffice("Head Office")
+	branchOffice := newOffice("Branch Office")
+
+	newEmployee := func(name, email string, office *ent.OfficeLocation) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(email).
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetOfficeLocationID(office.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+	headEmployee := newEmployee("Head Employee", "head.employee@example.com", headOffice)
+	colleague := newEmployee("Colleague", "colleague@example.com", headOffice)
+	guesser := newEmployee("Guesser", "guesser@example.com", headOffice)
+	branchEmployee := newEmployee("Branch Employee", "branch.employee@example.com", branchOffice)
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	kiosk, err := client.Kiosk.Create().
+		SetName("Reception").
+		SetSecret(secret).
+		SetOfficeLocationID(headOffice.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	mockRedis := miniredis.NewMiniRedis()
+	require.NoError(t, mockRedis.Start())
+	t.Cleanup(mockRedis.Close)
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		cache.NewCache(redis.NewClient(&redis.Options{Addr: mockRedis.Addr()})),
+		transaction.NewTrx(client),
+	)
+
+	shownAt := time.Date(2025, time.August, 11, 8, 55, 10, 0, time.UTC)
+	code, err := totp.Code(secret, shownAt)
+	require.NoError(t, err)
+	req := &dto.KioskCheckInRequest{KioskID: kiosk.ID, Code: code}
+
+	t.Run("expired code is refused", func(t *testing.T) {
+		_, err := attendanceService.KioskCheckIn(ctx, headEmployee.ID, shownAt.Add(2*totp.Period), req)
+		assert.ErrorIs(t, err, ErrKioskCodeInvalid)
+	})
+
+	t.Run("employee of another office is refused", func(t *testing.T) {
+		_, err := attendanceService.KioskCheckIn(ctx, branchEmployee.ID, shownAt.Add(5*time.Second), req)
+		assert.ErrorIs(t, err, ErrKioskNotAllowed)
+	})
+
+	t.Run("code scanned right before it rotated checks in and records the kiosk", func(t *testing.T) {
+		checkInTime := totp.ExpiresAt(shownAt).Add(3 * time.Second)
+		result, err := attendanceService.KioskCheckIn(ctx, headEmployee.ID, checkInTime, req)
+		require.NoError(t, err)
+		assert.Equal(t, "present", result.Status)
+		require.NotNil(t, result.CheckInKioskID)
+		assert.Equal(t, kiosk.ID, *result.CheckInKioskID)
+		assert.False(t, result.LocationFlagged)
+
+		punches, err := attendanceService.GetAttendancePunches(ctx, result.ID)
+		require.NoError(t, err)
+		require.Len(t, punches, 1)
+		require.NotNil(t, punches[0].KioskID)
+		assert.Equal(t, kiosk.ID, *punches[0].KioskID)
+	})
+
+	t.Run("used code is refused", func(t *testing.T) {
+		_, err := attendanceService.KioskCheckIn(ctx, colleague.ID, shownAt.Add(10*time.Second), req)
+		assert.ErrorIs(t, err, ErrKioskCodeInvalid)
+
+		// The next code shown by the kiosk checks in
+		nextShownAt := totp.ExpiresAt(shownAt).Add(5 * time.Second)
+		nextCode, err := totp.Code(secret, nextShownAt)
+		require.NoError(t, err)
+		_, err = attendanceService.KioskCheckIn(ctx, colleague.ID, nextShownAt, &dto.KioskCheckInRequest{KioskID: kiosk.ID, Code: nextCode})
+		require.NoError(t, err)
+	})
+
+	t.Run("employee guessing codes is locked out", func(t *testing.T) {
+		guessAt := shownAt.Add(2 * time.Hour)
+		shown, err := totp.Code(secret, guessAt)
+		require.NoError(t, err)
+		wrong := &dto.KioskCheckInRequest{KioskID: kiosk.ID, Code: "000000"}
+		if shown == wrong.Code {
+			wrong.Code = "000001"
+		}
+
+		for i := 0; i < maxKioskFailuresPerEmployee; i++ {
+			_, err := attendanceService.KioskCheckIn(ctx, guesser.ID, guessAt, wrong)
+			assert.ErrorIs(t, err, ErrKioskCodeInvalid)
+		}
+
+		// Even the right code is refused until the failures expire
+		_, err = attendanceService.KioskCheckIn(ctx, guesser.ID, guessAt, &dto.KioskCheckInRequest{KioskID: kiosk.ID, Code: shown})
+		assert.ErrorIs(t, err, ErrKioskTooManyAttempts)
+
+		mockRedis.FastForward(kioskFailureWindow)
+		_, err = attendanceService.KioskCheckIn(ctx, guesser.ID, guessAt, &dto.KioskCheckInRequest{KioskID: kiosk.ID, Code: shown})
+		require.NoError(t, err)
+	})
+
+	t.Run("inactive kiosk is refused", func(t *testing.T) {
+		require.NoError(t, client.Kiosk.UpdateOneID(kiosk.ID).SetIsActive(false).Exec(ctx))
+		_, err := attendanceService.KioskCheckIn(ctx, headEmployee.ID, shownAt, req)
+		assert.ErrorIs(t, err, ErrKioskNotAllowed)
+	})
+}
+
+func TestAttendanceServiceImpl_PeriodLock(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Locked Period").
+		SetEmail("locked.period@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	// Marked before payroll of August was closed
+	marked, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+		EmployeeID:     emp.ID,
+		AttendanceDate: time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC),
+		CheckInTime:    time.Date(2025, time.August, 11, 8, 55, 0, 0, time.UTC),
+		CheckOutTime:   time.Date(2025, time.August, 11, 17, 5, 0, 0, time.UTC),
+		Status:         "present",
+	})
+	require.NoError(t, err)
+
+	period, err := client.PayrollPeriod.Create().
+		SetPeriodMonth(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedAt(time.Date(2025, time.September, 3, 10, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	assertLocked := func(t *testing.T, err error) {
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok, "expected a business logic error, got %v", err)
+		assert.Equal(t, exceptions.PeriodLocked, bizErr.ErrorCode)
+	}
+
+	t.Run("locked month refuses new, updated and deleted attendance", func(t *testing.T) {
+		_, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     emp.ID,
+			AttendanceDate: time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC),
+			Status:         "absent",
+		})
+		assertLocked(t, err)
+
+		_, err = attendanceService.UpdateAttendance(ctx, marked.ID, &dto.UpdateAttendanceRequest{Notes: "late correction"})
+		assertLocked(t, err)
+
+		assertLocked(t, attendanceService.DeleteAttendance(ctx, marked.ID))
+	})
+
+	t.Run("other months stay open", func(t *testing.T) {
+		_, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     emp.ID,
+			AttendanceDate: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
+			Status:         "absent",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unlocked month accepts changes again", func(t *testing.T) {
+		require.NoError(t, client.PayrollPeriod.UpdateOneID(period.ID).SetStatus(payrollperiod.StatusOpen).Exec(ctx))
+
+		updated, err := attendanceService.UpdateAttendance(ctx, marked.ID, &dto.UpdateAttendanceRequest{Notes: "late correction"})
+		require.NoError(t, err)
+		assert.Equal(t, "late correction", updated.Notes)
+	})
+}
+
+func TestAttendanceServiceImpl_ReviewCorrection(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Forgot Checkout").
+		SetEmail("forgot.checkout@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	marked, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+		EmployeeID:     emp.ID,
+		AttendanceDate: time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC),
+		CheckInTime:    time.Date(2025, time.August, 11, 8, 55, 0, 0, time.UTC),
+		CheckOutTime:   time.Date(2025, time.August, 11, 17, 5, 0, 0, time.UTC),
+		Status:         "present",
+	})
+	require.NoError(t, err)
+
+	requestCorrection := func(checkOut string) uint64 {
+		correction, err := attendanceService.RequestCorrection(ctx, marked.ID, &dto.CreateAttendanceCorrectionRequest{
+			EmployeeID:   emp.ID,
+			CheckOutTime: checkOut,
+			Reason:       "Stayed for the release",
+		})
+		require.NoError(t, err)
+		return correction.ID
+	}
+	assertNotPending := func(t *testing.T, err error) {
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok, "expected a business logic error, got %v", err)
+		assert.Equal(t, exceptions.InvalidArgument, bizErr.ErrorCode)
+	}
+	review := &dto.ReviewAttendanceCorrectionRequest{ReviewedBy: 9}
+
+	t.Run("a failed approval leaves the correction pending", func(t *testing.T) {
+		correctionID := requestCorrection("18:00")
+
+		// The record changed after the correction was requested
+		_, err := attendanceService.UpdateAttendance(ctx, marked.ID, &dto.UpdateAttendanceRequest{
+			CheckOutTime: time.Date(2025, time.August, 11, 17, 30, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		_, err = attendanceService.ApproveCorrection(ctx, correctionID, review)
+		require.Error(t, err)
+
+		correction, err := attendanceService.GetCorrectionByID(ctx, correctionID)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", correction.Status)
+
+		rejected, err := attendanceService.RejectCorrection(ctx, correctionID, review)
+		require.NoError(t, err)
+		assert.Equal(t, "rejected", rejected.Status)
+
+		_, err = attendanceService.RejectCorrection(ctx, correctionID, review)
+		assertNotPending(t, err)
+	})
+
+	t.Run("a correction is applied once", func(t *testing.T) {
+		correctionID := requestCorrection("18:00")
+
+		approved, err := attendanceService.ApproveCorrection(ctx, correctionID, review)
+		require.NoError(t, err)
+		assert.Equal(t, "approved", approved.Status)
+
+		record, err := client.Attendance.Get(ctx, marked.ID)
+		require.NoError(t, err)
+		assert.True(t, time.Date(2025, time.August, 11, 18, 0, 0, 0, time.UTC).Equal(record.CheckOutTime))
+
+		_, err = attendanceService.ApproveCorrection(ctx, correctionID, review)
+		assertNotPending(t, err)
+	})
+}
+
+func TestAttendanceServiceImpl_AwayAndSickStatuses(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	office, err := client.OfficeLocation.Create().
+		SetName("Head Office").
+		SetLatitude(-6.2).
+		SetLongitude(106.8).
+		SetEnforcement(officelocation.EnforcementReject).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	emp, err := client.Employee.Create().
+		SetFullName("Hybrid Worker").
+		SetEmail("hybrid.worker@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetOfficeLocationID(office.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	t.Run("metadata of another status is rejected", func(t *testing.T) {
+		_, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     emp.ID,
+			AttendanceDate: time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC),
+			Status:         "business_trip",
+			StatusMetadata: &dto.AttendanceStatusMetadata{SickNoteURL: "https://files.example.com/note.pdf"},
+		})
+		assert.ErrorContains(t, err, "sick note can only be set on a sick attendance")
+	})
+
+	t.Run("business trip keeps its destination", func(t *testing.T) {
+		result, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     emp.ID,
+			AttendanceDate: time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC),
+			Status:         "business_trip",
+			StatusMetadata: &dto.AttendanceStatusMetadata{Destination: "Surabaya", Purpose: "Client visit"},
+			MarkedByAdmin:  true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "business_trip", result.Status)
+		require.NotNil(t, result.StatusMetadata)
+		assert.Equal(t, "Surabaya", result.StatusMetadata.Destination)
+		assert.Equal(t, "Client visit", result.StatusMetadata.Purpose)
+	})
+
+	t.Run("check-in on a remote day keeps the status outside the geofence", func(t *testing.T) {
+		_, err := attendanceService.MarkAttendance(ctx, &dto.MarkAttendanceRequest{
+			EmployeeID:     emp.ID,
+			AttendanceDate: time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC),
+			Status:         "remote",
+			StatusMetadata: &dto.AttendanceStatusMetadata{WorkLocation: "Home, Yogyakarta"},
+			MarkedByAdmin:  true,
+		})
+		require.NoError(t, err)
+
+		// Yogyakarta is far outside the radius of the office, which rejects such punches
+		latitude, longitude := -7.7956, 110.3695
+		result, err := attendanceService.CheckInEmployee(ctx, emp.ID, time.Date(2025, time.August, 11, 9, 45, 0, 0, time.UTC),
+			&dto.PunchRequest{Latitude: &latitude, Longitude: &longitude})
+		require.NoError(t, err)
+		assert.Equal(t, "remote", result.Status)
+		assert.False(t, result.LocationFlagged)
+		require.NotNil(t, result.CheckInLatitude)
+		assert.Equal(t, latitude, *result.CheckInLatitude)
+		require.NotNil(t, result.StatusMetadata)
+		assert.Equal(t, "Home, Yogyakarta", result.StatusMetadata.WorkLocation)
+
+		result, err = attendanceService.CheckOutEmployee(ctx, emp.ID, time.Date(2025, time.August, 11, 17, 0, 0, 0, time.UTC),
+			&dto.PunchRequest{Latitude: &latitude, Longitude: &longitude})
+		require.NoError(t, err)
+		assert.Equal(t, "remote", result.Status)
+		assert.Equal(t, 435, result.WorkedMinutes)
+	})
+
+	t.Run("changing the status drops the previous metadata", func(t *testing.T) {
+		marked, err := attendanceService.GetAttendanceByEmployeeAndDate(ctx, emp.ID, time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		result, err := attendanceService.UpdateAttendance(ctx, marked.ID, &dto.UpdateAttendanceRequest{Status: "sick"})
+		require.NoError(t, err)
+		assert.Equal(t, "sick", result.Status)
+		assert.Nil(t, result.StatusMetadata)
+	})
+}
+
+func TestAttendanceServiceImpl_DetectAnomalies(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	// Monday 11 August 2025 on the default 09:00 to 17:00 schedule
+	monday := time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.August, day, hour, minute, 0, 0, time.UTC)
+	}
+	record := func(name string, checkIn, checkOut time.Time) *ent.Attendance {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(strings.ToLower(strings.ReplaceAll(name, " ", ".")) + "@example.com").
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+
+		query := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(monday).
+			SetCheckInTime(checkIn).
+			SetStatus(attendance.StatusPresent)
+		if !checkOut.IsZero() {
+			query = query.SetCheckOutTime(checkOut)
+		}
+		created, err := query.Save(ctx)
+		require.NoError(t, err)
+		return created
+	}
+	forgotCheckOut := record("Forgot Checkout", at(11, 8, 55), time.Time{})
+	longDay := record("Long Day", at(11, 6, 30), at(11, 23, 0))
+	nightOwl := record("Night Owl", at(11, 3, 0), at(11, 17, 0))
+	leftEarly := record("Left Early", at(11, 9, 0), at(11, 15, 0))
+	record("Regular Day", at(11, 8, 50), at(11, 17, 10))
+
+	rules := vars.GetAttendanceAnomalyRules()
+	rulesOf := func(t *testing.T, attendanceID uint64) []string {
+		anomalies, err := client.AttendanceAnomaly.Query().
+			Where(attendanceanomaly.AttendanceID(attendanceID)).
+			Where(attendanceanomaly.StatusEQ(attendanceanomaly.StatusOpen)).
+			All(ctx)
+		require.NoError(t, err)
+
+		found := []string{}
+		for _, anomaly := range anomalies {
+			found = append(found, string(anomaly.Rule))
+		}
+		return found
+	}
+
+	t.Run("missing check-out waits for the grace after the shift", func(t *testing.T) {
+		result, err := attendanceService.detectAnomaliesBetween(ctx, monday, monday, at(11, 19, 0), rules)
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Scanned)
+		assert.Equal(t, 3, result.Detected)
+		assert.Empty(t, rulesOf(t, forgotCheckOut.ID))
+	})
+
+	t.Run("rules report each suspicious record", func(t *testing.T) {
+		result, err := attendanceService.detectAnomaliesBetween(ctx, monday, monday, at(12, 8, 0), rules)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Detected)
+
+		assert.Equal(t, []string{"missing_check_out"}, rulesOf(t, forgotCheckOut.ID))
+		assert.Equal(t, []string{"long_day"}, rulesOf(t, longDay.ID))
+		assert.Equal(t, []string{"odd_hour_check_in"}, rulesOf(t, nightOwl.ID))
+		assert.Equal(t, []string{"early_check_out"}, rulesOf(t, leftEarly.ID))
+
+		list, err := attendanceService.ListAnomalies(ctx, &dto.AttendanceAnomalyQueryParams{Rule: "odd_hour_check_in"})
+		require.NoError(t, err)
+		require.Len(t, list.Anomalies, 1)
+		assert.Equal(t, "Night Owl", list.Anomalies[0].EmployeeName)
+		assert.Equal(t, "Checked in at 03:00, 6h00m before the shift starts at 09:00", list.Anomalies[0].Details)
+	})
+
+	t.Run("scanning again does not report anything twice", func(t *testing.T) {
+		result, err := attendanceService.detectAnomaliesBetween(ctx, monday, monday, at(12, 9, 0), rules)
+		require.NoError(t, err)
+		assert.Zero(t, result.Detected)
+		assert.Zero(t, result.AutoResolved)
+	})
+
+	t.Run("corrected attendance resolves the anomaly", func(t *testing.T) {
+		require.NoError(t, client.Attendance.UpdateOneID(forgotCheckOut.ID).SetCheckOutTime(at(11, 17, 5)).Exec(ctx))
+
+		result, err := attendanceService.detectAnomaliesBetween(ctx, monday, monday, at(12, 10, 0), rules)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.AutoResolved)
+		assert.Empty(t, rulesOf(t, forgotCheckOut.ID))
+	})
+
+	t.Run("HR resolves an anomaly once", func(t *testing.T) {
+		list, err := attendanceService.ListAnomalies(ctx, &dto.AttendanceAnomalyQueryParams{Rule: "early_check_out", Status: "open"})
+		require.NoError(t, err)
+		require.Len(t, list.Anomalies, 1)
+
+		req := &dto.ResolveAttendanceAnomalyRequest{ResolvedBy: 1, ResolutionNotes: "Doctor's appointment, approved by manager"}
+		resolved, err := attendanceService.ResolveAnomaly(ctx, list.Anomalies[0].ID, req)
+		require.NoError(t, err)
+		assert.Equal(t, "resolved", resolved.Status)
+		require.NotNil(t, resolved.ResolvedBy)
+		assert.Equal(t, uint64(1), *resolved.ResolvedBy)
+
+		_, err = attendanceService.ResolveAnomaly(ctx, list.Anomalies[0].ID, req)
+		assert.ErrorContains(t, err, "already resolved")
+
+		// A resolved finding is not reported again while the attendance still matches the rule
+		result, err := attendanceService.detectAnomaliesBetween(ctx, monday, monday, at(12, 11, 0), rules)
+		require.NoError(t, err)
+		assert.Zero(t, result.Detected)
+	})
+
+	t.Run("disabled rules are skipped", func(t *testing.T) {
+		disabled := rules
+		disabled.LongDay = false
+		findings := evaluateAnomalyRules(calendar.DefaultSchedule(), longDay, true, at(12, 8, 0), disabled)
+		assert.Empty(t, findings)
+	})
+}
+
+func TestAttendanceServiceImpl_AutoCheckOut(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	workingCalendar := calendar.NewWorkingDayCalendar(client)
+	attendanceService := NewAttendanceService(
+		repository.NewAttendanceRepository(client, workingCalendar),
+		repository.NewAttendanceCorrectionRepository(client),
+		repository.NewAttendanceAnomalyRepository(client),
+		workingCalendar,
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	// Monday 11 August 2025 on the default 09:00 to 17:00 schedule
+	monday := time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.August, day, hour, minute, 0, 0, time.UTC)
+	}
+	openRecord := func(name string, checkIn time.Time) *ent.Attendance {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(strings.ToLower(strings.ReplaceAll(name, " ", ".")) + "@example.com").
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+
+		created, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(monday).
+			SetCheckInTime(checkIn).
+			SetStatus(attendance.StatusPresent).
+			SetNotes("Client visit").
+			Save(ctx)
+		require.NoError(t, err)
+		return created
+	}
+	shiftEnd := vars.AutoCheckOutPolicy{CloseAt: vars.AutoCheckOutAtShiftEnd, Cutoff: "23:59", Grace: 2 * time.Hour}
+	forgot := openRecord("Forgot Checkout", at(11, 8, 55))
+
+	t.Run("waits for the grace after the shift end", func(t *testing.T) {
+		result, err := attendanceService.autoCheckOut(ctx, at(11, 18, 0), shiftEnd)
+		require.NoError(t, err)
+		assert.Zero(t, result.Closed)
+	})
+
+	t.Run("closes at the shift end without overtime", func(t *testing.T) {
+		result, err := attendanceService.autoCheckOut(ctx, at(11, 23, 0), shiftEnd)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Closed)
+		require.Len(t, result.Records, 1)
+		assert.Equal(t, "Forgot Checkout", result.Records[0].EmployeeName)
+
+		closed, err := client.Attendance.Get(ctx, forgot.ID)
+		require.NoError(t, err)
+		assert.True(t, closed.AutoClosed)
+		assert.True(t, closed.CheckOutTime.Equal(at(11, 17, 0)))
+		assert.Contains(t, closed.Notes, "Client visit")
+		assert.Contains(t, closed.Notes, "Checked out automatically at the shift end")
+
+		punches, err := client.AttendancePunch.Query().Where(attendancepunch.AttendanceID(forgot.ID)).All(ctx)
+		require.NoError(t, err)
+		assert.Len(t, punches, 2)
+
+		overtimes, err := client.Overtime.Query().All(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, overtimes)
+
+		// A closed record is not picked up again
+		result, err = attendanceService.autoCheckOut(ctx, at(12, 23, 0), shiftEnd)
+		require.NoError(t, err)
+		assert.Zero(t, result.Closed)
+	})
+
+	t.Run("closes at the policy cut-off", func(t *testing.T) {
+		lateShift := openRecord("Late Shift", at(11, 9, 5))
+		cutoff := vars.AutoCheckOutPolicy{CloseAt: vars.AutoCheckOutAtCutoff, Cutoff: "20:00", Grace: time.Hour}
+
+		result, err := attendanceService.autoCheckOut(ctx, at(11, 20, 30), cutoff)
+		require.NoError(t, err)
+		assert.Zero(t, result.Closed)
+
+		result, err = attendanceService.autoCheckOut(ctx, at(11, 21, 0), cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Closed)
+
+		closed, err := client.Attendance.Get(ctx, lateShift.ID)
+		require.NoError(t, err)
+		assert.True(t, closed.AutoClosed)
+		assert.True(t, closed.CheckOutTime.Equal(at(11, 20, 0)))
+		assert.Contains(t, closed.Notes, "cut-off")
+	})
+
+	t.Run("updating the check-out disputes the auto-close", func(t *testing.T) {
+		updated, err := attendanceService.UpdateAttendance(ctx, forgot.ID, &dto.UpdateAttendanceRequest{
+			CheckOutTime: at(11, 18, 30),
+		})
+		require.NoError(t, err)
+		assert.False(t, updated.AutoClosed)
+		assert.True(t, updated.CheckOutTime.Equal(at(11, 18, 30)))
+
+		// The real check-out after the shift is overtime again
+		overtimes, err := client.Overtime.Query().All(ctx)
+		require.NoError(t, err)
+		assert.Len(t, overtimes, 1)
+	})
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"fmt"
+
+	"mceasy/internal/applications/attendance/dto"
+)
+
+// isAwayStatus reports whether the employee works away from the office on a day with the status. Punches of
+// such a day are recorded without checking the geofence of the office.
+func isAwayStatus(status string) bool {
+	return status == "remote" || status == "business_trip"
+}
+
+// validateStatusMetadata rejects details that belong to another status, e.g. a sick note on a business trip
+func validateStatusMetadata(status string, metadata *dto.AttendanceStatusMetadata) error {
+	if metadata == nil {
+		return nil
+	}
+
+	if metadata.WorkLocation != "" && status != "remote" {
+		return fmt.Errorf("work location can only be set on a remote attendance, got status %s", status)
+	}
+	if (metadata.Destination != "" || metadata.Purpose != "") && status != "business_trip" {
+		return fmt.Errorf("destination and purpose can only be set on a business trip attendance, got status %s", status)
+	}
+	if metadata.SickNoteURL != "" && status != "sick" {
+		return fmt.Errorf("sick note can only be set on a sick attendance, got status %s", status)
+	}
+
+	return nil
+}
+
+// awayPunchLocation records the coordinates of a punch on a remote or business trip day without comparing
+// them to the office, so the punch is never rejected or flagged
+func awayPunchLocation(punch *dto.PunchRequest) *dto.PunchLocation {
+	if punch != nil && punch.KioskID != nil {
+		return &dto.PunchLocation{KioskID: punch.KioskID}
+	}
+	if !punch.HasCoordinates() {
+		return nil
+	}
+
+	return &dto.PunchLocation{Latitude: punch.Latitude, Longitude: punch.Longitude}
+}
@@ -13,19 +13,25 @@ import (
 	"mceasy/internal/applications/attendance/repository"
 	"mceasy/internal/applications/attendance/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 )
 
 // Injectors from attendance_injector.go:
 
 func InitializedAttendanceService(dbClient *ent.Client, redisClient *redis.Client) *service.AttendanceServiceImpl {
-	attendanceRepositoryImpl := repository.NewAttendanceRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	attendanceRepositoryImpl := repository.NewAttendanceRepository(dbClient, workingDayCalendarImpl)
+	attendanceCorrectionRepositoryImpl := repository.NewAttendanceCorrectionRepository(dbClient)
+	attendanceAnomalyRepositoryImpl := repository.NewAttendanceAnomalyRepository(dbClient)
+	periodLockImpl := periodlock.NewPeriodLock(dbClient)
 	cacheImpl := cache.NewCache(redisClient)
 	trxImpl := transaction.NewTrx(dbClient)
-	attendanceServiceImpl := service.NewAttendanceService(attendanceRepositoryImpl, cacheImpl, trxImpl)
+	attendanceServiceImpl := service.NewAttendanceService(attendanceRepositoryImpl, attendanceCorrectionRepositoryImpl, attendanceAnomalyRepositoryImpl, workingDayCalendarImpl, periodLockImpl, cacheImpl, trxImpl)
 	return attendanceServiceImpl
 }
 
 // attendance_injector.go:
 
-var providerAttendance = wire.NewSet(repository.NewAttendanceRepository, transaction.NewTrx, service.NewAttendanceService, cache.NewCache, wire.Bind(new(repository.AttendanceRepository), new(*repository.AttendanceRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.AttendanceService), new(*service.AttendanceServiceImpl)))
+var providerAttendance = wire.NewSet(repository.NewAttendanceRepository, repository.NewAttendanceCorrectionRepository, repository.NewAttendanceAnomalyRepository, calendar.NewWorkingDayCalendar, periodlock.NewPeriodLock, transaction.NewTrx, service.NewAttendanceService, cache.NewCache, wire.Bind(new(repository.AttendanceRepository), new(*repository.AttendanceRepositoryImpl)), wire.Bind(new(repository.AttendanceCorrectionRepository), new(*repository.AttendanceCorrectionRepositoryImpl)), wire.Bind(new(repository.AttendanceAnomalyRepository), new(*repository.AttendanceAnomalyRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.AttendanceService), new(*service.AttendanceServiceImpl)))
@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package calendarfeed
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/calendarfeed/repository"
+	"mceasy/internal/applications/calendarfeed/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerCalendarFeed = wire.NewSet(
+	repository.NewCalendarFeedRepository,
+	transaction.NewTrx,
+	service.NewCalendarFeedService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.CalendarFeedRepository), new(*repository.CalendarFeedRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.CalendarFeedService), new(*service.CalendarFeedServiceImpl)),
+)
+
+func InitializedCalendarFeedService(dbClient *ent.Client, redisClient *redis.Client) *service.CalendarFeedServiceImpl {
+	wire.Build(providerCalendarFeed)
+	return nil
+}
@@ -0,0 +1,281 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"mceasy/internal/applications/calendarfeed/dto"
+	"mceasy/internal/applications/calendarfeed/service"
+	"mceasy/internal/component/ical"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CalendarFeedController handles HTTP requests for calendar feed operations
+type CalendarFeedController struct {
+	calendarFeedService service.CalendarFeedService
+}
+
+// NewCalendarFeedController creates a new calendar feed controller instance
+func NewCalendarFeedController(calendarFeedService service.CalendarFeedService) *CalendarFeedController {
+	return &CalendarFeedController{
+		calendarFeedService: calendarFeedService,
+	}
+}
+
+// CreateCalendarFeed publishes a calendar feed
+// @Summary Create a calendar feed
+// @Description Publish a read-only iCalendar feed of an employee, a department or the whole company under a random token
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param feed body dto.CreateCalendarFeedRequest true "Calendar feed data"
+// @Success 201 {object} dto.CalendarFeedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds [post]
+func (c *CalendarFeedController) CreateCalendarFeed(ctx echo.Context) error {
+	var req dto.CreateCalendarFeedRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	feed, err := c.calendarFeedService.CreateCalendarFeed(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create calendar feed",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, feed)
+}
+
+// GetCalendarFeed retrieves a calendar feed by ID
+// @Summary Get calendar feed by ID
+// @Description Get calendar feed details including its feed path
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param id path int true "Calendar feed ID"
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /calendar-feeds/{id} [get]
+func (c *CalendarFeedController) GetCalendarFeed(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid calendar feed ID",
+			"message": "Calendar feed ID must be a valid number",
+		})
+	}
+
+	feed, err := c.calendarFeedService.GetCalendarFeedByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Calendar feed not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, feed)
+}
+
+// UpdateCalendarFeed updates a calendar feed
+// @Summary Update calendar feed
+// @Description Rename a calendar feed or switch it off, an inactive feed is not served
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param id path int true "Calendar feed ID"
+// @Param feed body dto.UpdateCalendarFeedRequest true "Calendar feed data"
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds/{id} [put]
+func (c *CalendarFeedController) UpdateCalendarFeed(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid calendar feed ID",
+			"message": "Calendar feed ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateCalendarFeedRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	feed, err := c.calendarFeedService.UpdateCalendarFeed(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update calendar feed",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, feed)
+}
+
+// DeleteCalendarFeed deletes a calendar feed
+// @Summary Delete calendar feed
+// @Description Soft delete a calendar feed, subscribed calendar apps get not found afterwards
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param id path int true "Calendar feed ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds/{id} [delete]
+func (c *CalendarFeedController) DeleteCalendarFeed(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid calendar feed ID",
+			"message": "Calendar feed ID must be a valid number",
+		})
+	}
+
+	err = c.calendarFeedService.DeleteCalendarFeed(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete calendar feed",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Calendar feed deleted successfully",
+	})
+}
+
+// ListCalendarFeeds retrieves calendar feeds with pagination and filtering
+// @Summary List calendar feeds
+// @Description Get list of calendar feeds with pagination and filtering
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param scope query string false "Scope filter" Enums(employee, department, company)
+// @Param employee_id query int false "Employee ID filter"
+// @Param department query string false "Department filter"
+// @Param is_active query bool false "Active status filter"
+// @Success 200 {object} dto.CalendarFeedListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds [get]
+func (c *CalendarFeedController) ListCalendarFeeds(ctx echo.Context) error {
+	var params dto.CalendarFeedQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	feeds, err := c.calendarFeedService.ListCalendarFeeds(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list calendar feeds",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, feeds)
+}
+
+// RotateCalendarFeedToken replaces the token of a calendar feed
+// @Summary Rotate calendar feed token
+// @Description Replace the token in the feed URL, e.g. after the URL was shared, subscribers need the new URL
+// @Tags calendar-feeds
+// @Accept json
+// @Produce json
+// @Param id path int true "Calendar feed ID"
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds/{id}/rotate-token [post]
+func (c *CalendarFeedController) RotateCalendarFeedToken(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid calendar feed ID",
+			"message": "Calendar feed ID must be a valid number",
+		})
+	}
+
+	feed, err := c.calendarFeedService.RotateCalendarFeedToken(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to rotate calendar feed token",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, feed)
+}
+
+// GetCalendarFeedICS serves the iCalendar document of a feed to calendar apps
+// @Summary Get iCalendar feed
+// @Description Read-only iCalendar feed with holidays, approved leave and days away from the office, calendar apps subscribe to it by URL
+// @Tags calendar-feeds
+// @Produce text/calendar
+// @Param token path string true "Feed token, with or without the .ics extension"
+// @Success 200 {string} string
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar-feeds/ics/{token} [get]
+func (c *CalendarFeedController) GetCalendarFeedICS(ctx echo.Context) error {
+	token := strings.TrimSuffix(ctx.Param("token"), ".ics")
+
+	document, err := c.calendarFeedService.RenderCalendarFeed(ctx.Request().Context(), token, time.Now())
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Calendar feed not available",
+			"message": err.Error(),
+		})
+	}
+
+	// The token is the credential, intermediaries must not keep a copy of the document
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+
+	return ctx.Blob(http.StatusOK, ical.ContentType, document)
+}
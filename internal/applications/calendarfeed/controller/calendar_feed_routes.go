@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterCalendarFeedRoutes registers all calendar feed routes
+func RegisterCalendarFeedRoutes(e *echo.Group, controller *CalendarFeedController) {
+	e.POST("/calendar-feeds", controller.CreateCalendarFeed)
+	e.GET("/calendar-feeds", controller.ListCalendarFeeds)
+	e.GET("/calendar-feeds/:id", controller.GetCalendarFeed)
+	e.PUT("/calendar-feeds/:id", controller.UpdateCalendarFeed)
+	e.DELETE("/calendar-feeds/:id", controller.DeleteCalendarFeed)
+	e.POST("/calendar-feeds/:id/rotate-token", controller.RotateCalendarFeedToken)
+
+	// Subscribed to by calendar apps, the token in the path is the only credential
+	e.GET("/calendar-feeds/ics/:token", controller.GetCalendarFeedICS)
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateCalendarFeedRequest represents the request to publish an iCalendar feed. Employee feeds need the
+// employee, department feeds the department.
+type CreateCalendarFeedRequest struct {
+	Name       string  `json:"name" validate:"required,max=100"`
+	Scope      string  `json:"scope" validate:"required,oneof=employee department company"`
+	EmployeeID *uint64 `json:"employee_id,omitempty" validate:"omitempty,min=1"`
+	Department string  `json:"department,omitempty" validate:"omitempty,max=100"`
+	IsActive   *bool   `json:"is_active,omitempty"`
+
+	// Generated by the service, never accepted from the client
+	Token string `json:"-"`
+}
+
+// UpdateCalendarFeedRequest represents the request to update a calendar feed, its scope cannot change
+type UpdateCalendarFeedRequest struct {
+	Name     string `json:"name,omitempty" validate:"omitempty,max=100"`
+	IsActive *bool  `json:"is_active,omitempty"`
+}
+
+// CalendarFeedResponse represents the calendar feed response structure. The token is part of the feed URL
+// calendar apps subscribe to, anyone with it can read the feed until it is rotated.
+type CalendarFeedResponse struct {
+	ID             uint64     `json:"id"`
+	Name           string     `json:"name"`
+	Scope          string     `json:"scope"`
+	EmployeeID     *uint64    `json:"employee_id,omitempty"`
+	EmployeeName   string     `json:"employee_name,omitempty"`
+	Department     string     `json:"department,omitempty"`
+	Token          string     `json:"token"`
+	FeedPath       string     `json:"feed_path"`
+	IsActive       bool       `json:"is_active"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ModifiedAt     time.Time  `json:"modified_at"`
+}
+
+// CalendarFeedListResponse represents the response for calendar feed list
+type CalendarFeedListResponse struct {
+	Feeds []CalendarFeedResponse `json:"feeds"`
+	Total int                    `json:"total"`
+	Page  int                    `json:"page"`
+	Limit int                    `json:"limit"`
+}
+
+// CalendarFeedQueryParams represents query parameters for calendar feed list
+type CalendarFeedQueryParams struct {
+	Page       int    `query:"page" validate:"omitempty,min=1"`
+	Limit      int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Scope      string `query:"scope" validate:"omitempty,oneof=employee department company"`
+	EmployeeID uint64 `query:"employee_id" validate:"omitempty,min=1"`
+	Department string `query:"department" validate:"omitempty,max=100"`
+	IsActive   *bool  `query:"is_active"`
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/calendarfeed"
+	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/predicate"
+	"mceasy/internal/applications/calendarfeed/dto"
+)
+
+// CalendarFeedRepository defines the interface for calendar feed data operations
+type CalendarFeedRepository interface {
+	Create(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*ent.CalendarFeed, error)
+	GetByID(ctx context.Context, id uint64) (*ent.CalendarFeed, error)
+	GetByToken(ctx context.Context, token string) (*ent.CalendarFeed, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateCalendarFeedRequest) (*ent.CalendarFeed, error)
+	UpdateToken(ctx context.Context, id uint64, token string) (*ent.CalendarFeed, error)
+	MarkAccessed(ctx context.Context, id uint64, accessedAt time.Time) error
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.CalendarFeedQueryParams) ([]*ent.CalendarFeed, int, error)
+	GetEmployee(ctx context.Context, id uint64) (*ent.Employee, error)
+	GetHolidays(ctx context.Context, startDate, endDate time.Time) ([]*ent.Holiday, error)
+	GetApprovedLeaves(ctx context.Context, feed *ent.CalendarFeed, startDate, endDate time.Time) ([]*ent.LeaveRequest, error)
+	GetAttendances(ctx context.Context, feed *ent.CalendarFeed, startDate, endDate time.Time, statuses []attendance.Status) ([]*ent.Attendance, error)
+}
+
+// CalendarFeedRepositoryImpl implements the CalendarFeedRepository interface
+type CalendarFeedRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewCalendarFeedRepository creates a new calendar feed repository instance
+func NewCalendarFeedRepository(client *ent.Client) *CalendarFeedRepositoryImpl {
+	return &CalendarFeedRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create publishes a new calendar feed
+func (r *CalendarFeedRepositoryImpl) Create(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*ent.CalendarFeed, error) {
+	query := r.client.CalendarFeed.Create().
+		SetName(req.Name).
+		SetScope(calendarfeed.Scope(req.Scope)).
+		SetToken(req.Token)
+
+	if req.EmployeeID != nil {
+		query = query.SetEmployeeID(*req.EmployeeID)
+	}
+	if req.Department != "" {
+		query = query.SetDepartment(req.Department)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	record, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, record.ID)
+}
+
+// GetByID retrieves a calendar feed by ID
+func (r *CalendarFeedRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.CalendarFeed, error) {
+	return r.client.CalendarFeed.
+		Query().
+		Where(calendarfeed.ID(id)).
+		Where(calendarfeed.DeletedAtIsNil()).
+		WithEmployee().
+		First(ctx)
+}
+
+// GetByToken retrieves the active calendar feed published under a token
+func (r *CalendarFeedRepositoryImpl) GetByToken(ctx context.Context, token string) (*ent.CalendarFeed, error) {
+	return r.client.CalendarFeed.
+		Query().
+		Where(calendarfeed.Token(token)).
+		Where(calendarfeed.IsActiveEQ(true)).
+		Where(calendarfeed.DeletedAtIsNil()).
+		WithEmployee().
+		First(ctx)
+}
+
+// Update updates a calendar feed
+func (r *CalendarFeedRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateCalendarFeedRequest) (*ent.CalendarFeed, error) {
+	query := r.client.CalendarFeed.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// UpdateToken replaces the token the feed is published under
+func (r *CalendarFeedRepositoryImpl) UpdateToken(ctx context.Context, id uint64, token string) (*ent.CalendarFeed, error) {
+	err := r.client.CalendarFeed.
+		UpdateOneID(id).
+		SetToken(token).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// MarkAccessed records when a calendar app last fetched the feed
+func (r *CalendarFeedRepositoryImpl) MarkAccessed(ctx context.Context, id uint64, accessedAt time.Time) error {
+	return r.client.CalendarFeed.
+		UpdateOneID(id).
+		SetLastAccessedAt(accessedAt).
+		Exec(ctx)
+}
+
+// Delete soft deletes a calendar feed
+func (r *CalendarFeedRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.CalendarFeed.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves calendar feeds with pagination and filtering
+func (r *CalendarFeedRepositoryImpl) List(ctx context.Context, params *dto.CalendarFeedQueryParams) ([]*ent.CalendarFeed, int, error) {
+	query := r.client.CalendarFeed.
+		Query().
+		Where(calendarfeed.DeletedAtIsNil()).
+		WithEmployee()
+
+	// Apply filters
+	if params.Scope != "" {
+		query = query.Where(calendarfeed.ScopeEQ(calendarfeed.Scope(params.Scope)))
+	}
+
+	if params.EmployeeID > 0 {
+		query = query.Where(calendarfeed.EmployeeID(params.EmployeeID))
+	}
+
+	if params.Department != "" {
+		query = query.Where(calendarfeed.DepartmentEqualFold(params.Department))
+	}
+
+	if params.IsActive != nil {
+		query = query.Where(calendarfeed.IsActiveEQ(*params.IsActive))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count calendar feeds: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by name asc
+	query = query.Order(ent.Asc(calendarfeed.FieldName))
+
+	feeds, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch calendar feeds: %w", err)
+	}
+
+	return feeds, total, nil
+}
+
+// GetEmployee retrieves the employee an employee feed is published for
+func (r *CalendarFeedRepositoryImpl) GetEmployee(ctx context.Context, id uint64) (*ent.Employee, error) {
+	return r.client.Employee.
+		Query().
+		Where(employee.ID(id)).
+		Where(employee.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// GetHolidays retrieves the holidays in the date range, they are shown in every feed
+func (r *CalendarFeedRepositoryImpl) GetHolidays(ctx context.Context, startDate, endDate time.Time) ([]*ent.Holiday, error) {
+	return r.client.Holiday.
+		Query().
+		Where(holiday.HolidayDateGTE(startDate)).
+		Where(holiday.HolidayDateLTE(endDate)).
+		Where(holiday.DeletedAtIsNil()).
+		Order(ent.Asc(holiday.FieldHolidayDate), ent.Asc(holiday.FieldID)).
+		All(ctx)
+}
+
+// GetApprovedLeaves retrieves the approved leave requests of the employees of the feed overlapping the date range
+func (r *CalendarFeedRepositoryImpl) GetApprovedLeaves(ctx context.Context, feed *ent.CalendarFeed, startDate, endDate time.Time) ([]*ent.LeaveRequest, error) {
+	return r.client.LeaveRequest.
+		Query().
+		Where(leaverequest.StatusEQ(leaverequest.StatusApproved)).
+		Where(leaverequest.StartDateLTE(endDate)).
+		Where(leaverequest.EndDateGTE(startDate)).
+		Where(leaverequest.DeletedAtIsNil()).
+		Where(leaverequest.HasEmployeeWith(feedEmployees(feed)...)).
+		WithEmployee().
+		WithLeaveType().
+		Order(ent.Asc(leaverequest.FieldStartDate), ent.Asc(leaverequest.FieldID)).
+		All(ctx)
+}
+
+// GetAttendances retrieves the attendance records with one of the statuses of the employees of the feed in the date range
+func (r *CalendarFeedRepositoryImpl) GetAttendances(ctx context.Context, feed *ent.CalendarFeed, startDate, endDate time.Time, statuses []attendance.Status) ([]*ent.Attendance, error) {
+	return r.client.Attendance.
+		Query().
+		Where(attendance.AttendanceDateGTE(startDate)).
+		Where(attendance.AttendanceDateLTE(endDate)).
+		Where(attendance.StatusIn(statuses...)).
+		Where(attendance.DeletedAtIsNil()).
+		Where(attendance.HasEmployeeWith(feedEmployees(feed)...)).
+		WithEmployee().
+		Order(ent.Asc(attendance.FieldAttendanceDate), ent.Asc(attendance.FieldID)).
+		All(ctx)
+}
+
+// feedEmployees selects the employees whose attendance and leave the feed shows. Department and company feeds
+// only show active employees, an employee feed keeps showing its employee.
+func feedEmployees(feed *ent.CalendarFeed) []predicate.Employee {
+	predicates := []predicate.Employee{employee.DeletedAtIsNil()}
+
+	switch feed.Scope {
+	case calendarfeed.ScopeEmployee:
+		var employeeID uint64
+		if feed.EmployeeID != nil {
+			employeeID = *feed.EmployeeID
+		}
+		predicates = append(predicates, employee.ID(employeeID))
+	case calendarfeed.ScopeDepartment:
+		predicates = append(predicates, employee.DepartmentEqualFold(feed.Department), employee.IsActiveEQ(true))
+	default:
+		predicates = append(predicates, employee.IsActiveEQ(true))
+	}
+
+	return predicates
+}
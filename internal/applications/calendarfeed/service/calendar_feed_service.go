@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/calendarfeed"
+	"mceasy/internal/applications/calendarfeed/dto"
+	"mceasy/internal/applications/calendarfeed/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/ical"
+	"mceasy/internal/component/transaction"
+	"mceasy/internal/helper"
+	"mceasy/internal/vars"
+
+	"github.com/labstack/gommon/log"
+)
+
+// tokenSize is the number of random bytes of a feed token, 256 bits cannot be guessed
+const tokenSize = 32
+
+// feedStatuses are the attendance statuses shown as days in the feeds, days in the office are left out
+var feedStatuses = []attendance.Status{
+	attendance.StatusAbsent,
+	attendance.StatusHalfDay,
+	attendance.StatusLeave,
+	attendance.StatusUnpaidLeave,
+	attendance.StatusRemote,
+	attendance.StatusBusinessTrip,
+	attendance.StatusSick,
+}
+
+// statusLabels are the names of the attendance statuses in event summaries
+var statusLabels = map[attendance.Status]string{
+	attendance.StatusAbsent:       "Absent",
+	attendance.StatusHalfDay:      "Half day",
+	attendance.StatusLeave:        "Leave",
+	attendance.StatusUnpaidLeave:  "Unpaid leave",
+	attendance.StatusRemote:       "Remote",
+	attendance.StatusBusinessTrip: "Business trip",
+	attendance.StatusSick:         "Sick",
+}
+
+// CalendarFeedService defines the interface for calendar feed business logic
+type CalendarFeedService interface {
+	CreateCalendarFeed(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*dto.CalendarFeedResponse, error)
+	GetCalendarFeedByID(ctx context.Context, id uint64) (*dto.CalendarFeedResponse, error)
+	UpdateCalendarFeed(ctx context.Context, id uint64, req *dto.UpdateCalendarFeedRequest) (*dto.CalendarFeedResponse, error)
+	DeleteCalendarFeed(ctx context.Context, id uint64) error
+	ListCalendarFeeds(ctx context.Context, params *dto.CalendarFeedQueryParams) (*dto.CalendarFeedListResponse, error)
+	RotateCalendarFeedToken(ctx context.Context, id uint64) (*dto.CalendarFeedResponse, error)
+	RenderCalendarFeed(ctx context.Context, token string, now time.Time) ([]byte, error)
+}
+
+// CalendarFeedServiceImpl implements the CalendarFeedService interface
+type CalendarFeedServiceImpl struct {
+	feedRepo repository.CalendarFeedRepository
+	cache    cache.Cache
+	trx      transaction.Trx
+}
+
+// NewCalendarFeedService creates a new calendar feed service instance
+func NewCalendarFeedService(
+	feedRepo repository.CalendarFeedRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *CalendarFeedServiceImpl {
+	return &CalendarFeedServiceImpl{
+		feedRepo: feedRepo,
+		cache:    cache,
+		trx:      trx,
+	}
+}
+
+// CreateCalendarFeed publishes a calendar feed under a fresh token
+func (s *CalendarFeedServiceImpl) CreateCalendarFeed(ctx context.Context, req *dto.CreateCalendarFeedRequest) (*dto.CalendarFeedResponse, error) {
+	// Only the field of the scope is kept, a company feed with an employee would read as an employee feed
+	switch calendarfeed.Scope(req.Scope) {
+	case calendarfeed.ScopeEmployee:
+		if req.EmployeeID == nil {
+			return nil, fmt.Errorf("employee_id is required for an employee feed")
+		}
+		if _, err := s.feedRepo.GetEmployee(ctx, *req.EmployeeID); err != nil {
+			return nil, fmt.Errorf("employee not found: %w", err)
+		}
+		req.Department = ""
+	case calendarfeed.ScopeDepartment:
+		req.Department = strings.TrimSpace(req.Department)
+		if req.Department == "" {
+			return nil, fmt.Errorf("department is required for a department feed")
+		}
+		req.EmployeeID = nil
+	default:
+		req.EmployeeID = nil
+		req.Department = ""
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	req.Token = token
+
+	record, err := s.feedRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return s.mapToCalendarFeedResponse(record), nil
+}
+
+// GetCalendarFeedByID retrieves a calendar feed by ID
+func (s *CalendarFeedServiceImpl) GetCalendarFeedByID(ctx context.Context, id uint64) (*dto.CalendarFeedResponse, error) {
+	record, err := s.feedRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("calendar feed not found: %w", err)
+	}
+
+	return s.mapToCalendarFeedResponse(record), nil
+}
+
+// UpdateCalendarFeed updates a calendar feed
+func (s *CalendarFeedServiceImpl) UpdateCalendarFeed(ctx context.Context, id uint64, req *dto.UpdateCalendarFeedRequest) (*dto.CalendarFeedResponse, error) {
+	// Check if calendar feed exists
+	_, err := s.feedRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("calendar feed not found: %w", err)
+	}
+
+	record, err := s.feedRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update calendar feed: %w", err)
+	}
+
+	return s.mapToCalendarFeedResponse(record), nil
+}
+
+// DeleteCalendarFeed soft deletes a calendar feed, calendar apps subscribed to it get not found afterwards
+func (s *CalendarFeedServiceImpl) DeleteCalendarFeed(ctx context.Context, id uint64) error {
+	// Check if calendar feed exists
+	_, err := s.feedRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("calendar feed not found: %w", err)
+	}
+
+	err = s.feedRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	return nil
+}
+
+// ListCalendarFeeds retrieves calendar feeds with pagination and filtering
+func (s *CalendarFeedServiceImpl) ListCalendarFeeds(ctx context.Context, params *dto.CalendarFeedQueryParams) (*dto.CalendarFeedListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	feeds, total, err := s.feedRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+
+	feedResponses := make([]dto.CalendarFeedResponse, len(feeds))
+	for i, record := range feeds {
+		feedResponses[i] = *s.mapToCalendarFeedResponse(record)
+	}
+
+	return &dto.CalendarFeedListResponse{
+		Feeds: feedResponses,
+		Total: total,
+		Page:  params.Page,
+		Limit: params.Limit,
+	}, nil
+}
+
+// RotateCalendarFeedToken replaces the token of a feed, the URL shared before stops working and subscribers
+// need the new one
+func (s *CalendarFeedServiceImpl) RotateCalendarFeedToken(ctx context.Context, id uint64) (*dto.CalendarFeedResponse, error) {
+	// Check if calendar feed exists
+	_, err := s.feedRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("calendar feed not found: %w", err)
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.feedRepo.UpdateToken(ctx, id, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate calendar feed token: %w", err)
+	}
+
+	return s.mapToCalendarFeedResponse(record), nil
+}
+
+// RenderCalendarFeed renders the iCalendar document of the active feed published under the token. It holds the
+// holidays, the approved leave and the days away from the office of the employees of the feed, from the
+// configured number of days before today until the configured number of days after.
+func (s *CalendarFeedServiceImpl) RenderCalendarFeed(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	feed, err := s.feedRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("calendar feed not found: %w", err)
+	}
+
+	window := vars.GetCalendarFeedWindow()
+	local := now.In(helper.ApplicationLocation())
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	startDate := today.AddDate(0, 0, -window.PastDays)
+	endDate := today.AddDate(0, 0, window.FutureDays)
+
+	events, err := s.feedEvents(ctx, feed, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failing to record the access must not break the subscription of the calendar app
+	if err := s.feedRepo.MarkAccessed(ctx, feed.ID, now); err != nil {
+		log.Warnf("failed to record access of calendar feed %d: %v", feed.ID, err)
+	}
+
+	calendar := &ical.Calendar{
+		ProdID:          fmt.Sprintf("-//%s//Attendance Calendar//EN", vars.ApplicationName()),
+		Name:            feed.Name,
+		RefreshInterval: window.RefreshInterval,
+		Events:          events,
+	}
+
+	return calendar.Encode(now), nil
+}
+
+// feedEvents collects the events of a feed in the date range
+func (s *CalendarFeedServiceImpl) feedEvents(ctx context.Context, feed *ent.CalendarFeed, startDate, endDate time.Time) ([]ical.Event, error) {
+	domain := strings.ToLower(vars.ApplicationName())
+	events := []ical.Event{}
+
+	holidays, err := s.feedRepo.GetHolidays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+	for _, record := range holidays {
+		events = append(events, ical.Event{
+			UID:         fmt.Sprintf("holiday-%d@%s", record.ID, domain),
+			Summary:     record.Name,
+			Description: record.Description,
+			Categories:  []string{"holiday", string(record.HolidayType)},
+			Start:       record.HolidayDate,
+			End:         record.HolidayDate.AddDate(0, 0, 1),
+		})
+	}
+
+	leaves, err := s.feedRepo.GetApprovedLeaves(ctx, feed, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approved leave: %w", err)
+	}
+	// Approving leave marks its working days as leave in the attendance, those days are shown by the leave event
+	onLeave := make(map[string]bool)
+	for _, record := range leaves {
+		leaveType := "Leave"
+		if record.Edges.LeaveType != nil {
+			leaveType = record.Edges.LeaveType.Name
+		}
+		events = append(events, ical.Event{
+			UID:         fmt.Sprintf("leave-%d@%s", record.ID, domain),
+			Summary:     fmt.Sprintf("%s: %s", employeeName(record.Edges.Employee), leaveType),
+			Description: record.Reason,
+			Categories:  []string{"leave"},
+			Start:       record.StartDate,
+			End:         record.EndDate.AddDate(0, 0, 1),
+		})
+
+		for day := record.StartDate; !day.After(record.EndDate); day = day.AddDate(0, 0, 1) {
+			onLeave[employeeDayKey(record.EmployeeID, day)] = true
+		}
+	}
+
+	attendances, err := s.feedRepo.GetAttendances(ctx, feed, startDate, endDate, feedStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendance: %w", err)
+	}
+	for _, record := range attendances {
+		isLeave := record.Status == attendance.StatusLeave || record.Status == attendance.StatusUnpaidLeave
+		if isLeave && onLeave[employeeDayKey(record.EmployeeID, record.AttendanceDate)] {
+			continue
+		}
+
+		events = append(events, ical.Event{
+			UID:         fmt.Sprintf("attendance-%d@%s", record.ID, domain),
+			Summary:     fmt.Sprintf("%s: %s", employeeName(record.Edges.Employee), statusLabels[record.Status]),
+			Description: record.Notes,
+			Categories:  []string{"attendance", string(record.Status)},
+			Start:       record.AttendanceDate,
+			End:         record.AttendanceDate.AddDate(0, 0, 1),
+		})
+	}
+
+	return events, nil
+}
+
+// mapToCalendarFeedResponse maps an ent.CalendarFeed to dto.CalendarFeedResponse
+func (s *CalendarFeedServiceImpl) mapToCalendarFeedResponse(record *ent.CalendarFeed) *dto.CalendarFeedResponse {
+	response := &dto.CalendarFeedResponse{
+		ID:             record.ID,
+		Name:           record.Name,
+		Scope:          string(record.Scope),
+		EmployeeID:     record.EmployeeID,
+		Department:     record.Department,
+		Token:          record.Token,
+		FeedPath:       fmt.Sprintf("/calendar-feeds/ics/%s.ics", record.Token),
+		IsActive:       record.IsActive,
+		LastAccessedAt: record.LastAccessedAt,
+		CreatedAt:      record.CreatedAt,
+		ModifiedAt:     record.ModifiedAt,
+	}
+
+	if record.Edges.Employee != nil {
+		response.EmployeeName = record.Edges.Employee.FullName
+	}
+
+	return response
+}
+
+// generateToken returns a random URL safe feed token
+func generateToken() (string, error) {
+	token := make([]byte, tokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// employeeName returns the name of the employee of an event, the edge is always loaded by the repository
+func employeeName(record *ent.Employee) string {
+	if record == nil {
+		return "Employee"
+	}
+
+	return record.FullName
+}
+
+// employeeDayKey identifies a day of an employee
+func employeeDayKey(employeeID uint64, day time.Time) string {
+	return fmt.Sprintf("%d-%s", employeeID, day.Format("2006-01-02"))
+}
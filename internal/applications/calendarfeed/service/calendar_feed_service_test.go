@@ -0,0 +1,164 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/leaverequest"
+	"mceasy/internal/applications/calendarfeed/dto"
+	"mceasy/internal/applications/calendarfeed/repository"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFeedServiceImpl_RenderCalendarFeed(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	feedService := NewCalendarFeedService(repository.NewCalendarFeedRepository(client), nil, transaction.NewTrx(client))
+
+	day := func(d int) time.Time {
+		return time.Date(2025, time.August, d, 0, 0, 0, 0, time.UTC)
+	}
+	now := time.Date(2025, time.August, 11, 8, 0, 0, 0, time.UTC)
+	newEmployee := func(name, department string) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(strings.ToLower(strings.ReplaceAll(name, " ", ".")) + "@example.com").
+			SetDepartment(department).
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+	budi := newEmployee("Budi Santoso", "Engineering")
+	sari := newEmployee("Sari Dewi", "Finance")
+
+	_, err := client.Holiday.Create().
+		SetHolidayDate(day(17)).
+		SetName("Hari Kemerdekaan").
+		Save(ctx)
+	require.NoError(t, err)
+
+	annual, err := client.LeaveType.Create().
+		SetCode("annual").
+		SetName("Annual Leave").
+		SetAnnualQuota(12).
+		Save(ctx)
+	require.NoError(t, err)
+	leave, err := client.LeaveRequest.Create().
+		SetEmployeeID(budi.ID).
+		SetLeaveTypeID(annual.ID).
+		SetStartDate(day(13)).
+		SetEndDate(day(14)).
+		SetTotalDays(2).
+		SetStatus(leaverequest.StatusApproved).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Approving the leave marked its days in the attendance, they are shown by the leave event only
+	for _, d := range []int{13, 14} {
+		_, err = client.Attendance.Create().
+			SetEmployeeID(budi.ID).
+			SetAttendanceDate(day(d)).
+			SetStatus(attendance.StatusLeave).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	remote, err := client.Attendance.Create().
+		SetEmployeeID(budi.ID).
+		SetAttendanceDate(day(11)).
+		SetStatus(attendance.StatusRemote).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Attendance.Create().
+		SetEmployeeID(budi.ID).
+		SetAttendanceDate(day(8)).
+		SetStatus(attendance.StatusPresent).
+		Save(ctx)
+	require.NoError(t, err)
+	sick, err := client.Attendance.Create().
+		SetEmployeeID(sari.ID).
+		SetAttendanceDate(day(12)).
+		SetStatus(attendance.StatusSick).
+		Save(ctx)
+	require.NoError(t, err)
+
+	render := func(t *testing.T, req *dto.CreateCalendarFeedRequest) string {
+		feed, err := feedService.CreateCalendarFeed(ctx, req)
+		require.NoError(t, err)
+		assert.NotEmpty(t, feed.Token)
+		assert.Equal(t, "/calendar-feeds/ics/"+feed.Token+".ics", feed.FeedPath)
+
+		document, err := feedService.RenderCalendarFeed(ctx, feed.Token, now)
+		require.NoError(t, err)
+		return string(document)
+	}
+
+	t.Run("department feed shows its employees only", func(t *testing.T) {
+		document := render(t, &dto.CreateCalendarFeedRequest{Name: "Engineering", Scope: "department", Department: "engineering"})
+
+		assert.Contains(t, document, "X-WR-CALNAME:Engineering\r\n")
+		assert.Contains(t, document, "SUMMARY:Hari Kemerdekaan\r\n")
+		assert.Contains(t, document, "SUMMARY:Budi Santoso: Annual Leave\r\n")
+		assert.Contains(t, document, "DTSTART;VALUE=DATE:20250813\r\nDTEND;VALUE=DATE:20250815\r\n")
+		assert.Contains(t, document, "SUMMARY:Budi Santoso: Remote\r\n")
+		assert.NotContains(t, document, "Sari Dewi")
+		assert.NotContains(t, document, "Budi Santoso: Leave")
+		// Days in the office are not events
+		assert.Equal(t, 3, strings.Count(document, "BEGIN:VEVENT"))
+	})
+
+	t.Run("company feed shows every employee", func(t *testing.T) {
+		document := render(t, &dto.CreateCalendarFeedRequest{Name: "Company", Scope: "company"})
+
+		assert.Contains(t, document, fmt.Sprintf("UID:attendance-%d@", remote.ID))
+		assert.Contains(t, document, fmt.Sprintf("UID:attendance-%d@", sick.ID))
+		assert.Contains(t, document, fmt.Sprintf("UID:leave-%d@", leave.ID))
+		assert.Contains(t, document, "SUMMARY:Sari Dewi: Sick\r\n")
+		assert.Equal(t, 4, strings.Count(document, "BEGIN:VEVENT"))
+	})
+
+	t.Run("employee feed needs its employee", func(t *testing.T) {
+		_, err := feedService.CreateCalendarFeed(ctx, &dto.CreateCalendarFeedRequest{Name: "Sari", Scope: "employee"})
+		assert.ErrorContains(t, err, "employee_id is required")
+
+		document := render(t, &dto.CreateCalendarFeedRequest{Name: "Sari", Scope: "employee", EmployeeID: &sari.ID})
+		assert.Contains(t, document, "SUMMARY:Sari Dewi: Sick\r\n")
+		assert.NotContains(t, document, "Budi Santoso")
+	})
+
+	t.Run("rotated and inactive feeds are not served", func(t *testing.T) {
+		feed, err := feedService.CreateCalendarFeed(ctx, &dto.CreateCalendarFeedRequest{Name: "Rotated", Scope: "company"})
+		require.NoError(t, err)
+
+		rotated, err := feedService.RotateCalendarFeedToken(ctx, feed.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, feed.Token, rotated.Token)
+
+		_, err = feedService.RenderCalendarFeed(ctx, feed.Token, now)
+		assert.Error(t, err)
+
+		_, err = feedService.RenderCalendarFeed(ctx, rotated.Token, now)
+		require.NoError(t, err)
+		accessed, err := feedService.GetCalendarFeedByID(ctx, feed.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, accessed.LastAccessedAt)
+
+		inactive := false
+		_, err = feedService.UpdateCalendarFeed(ctx, feed.ID, &dto.UpdateCalendarFeedRequest{IsActive: &inactive})
+		require.NoError(t, err)
+		_, err = feedService.RenderCalendarFeed(ctx, rotated.Token, now)
+		assert.Error(t, err)
+	})
+}
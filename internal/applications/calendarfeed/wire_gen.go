@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package calendarfeed
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/calendarfeed/repository"
+	"mceasy/internal/applications/calendarfeed/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from calendarfeed_injector.go:
+
+func InitializedCalendarFeedService(dbClient *ent.Client, redisClient *redis.Client) *service.CalendarFeedServiceImpl {
+	calendarFeedRepositoryImpl := repository.NewCalendarFeedRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	calendarFeedServiceImpl := service.NewCalendarFeedService(calendarFeedRepositoryImpl, cacheImpl, trxImpl)
+	return calendarFeedServiceImpl
+}
+
+// calendarfeed_injector.go:
+
+var providerCalendarFeed = wire.NewSet(repository.NewCalendarFeedRepository, transaction.NewTrx, service.NewCalendarFeedService, cache.NewCache, wire.Bind(new(repository.CalendarFeedRepository), new(*repository.CalendarFeedRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.CalendarFeedService), new(*service.CalendarFeedServiceImpl)))
@@ -7,6 +7,8 @@ import (
 
 	"mceasy/internal/applications/dashboard/dto"
 	"mceasy/internal/applications/dashboard/service"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
 
 	"github.com/labstack/echo/v4"
 )
@@ -193,7 +195,7 @@ func (c *DashboardController) GetAttendanceAlerts(ctx echo.Context) error {
 // @Failure 500 {object} map[string]interface{}
 // @Router /dashboard/weekly [get]
 func (c *DashboardController) GetWeeklySummary(ctx echo.Context) error {
-	startDate := time.Now()
+	startDate := calendar.Today(helper.ApplicationLocation())
 
 	// Parse start_date if provided
 	if startDateStr := ctx.QueryParam("start_date"); startDateStr != "" {
@@ -228,7 +230,7 @@ func (c *DashboardController) GetWeeklySummary(ctx echo.Context) error {
 // @Failure 500 {object} map[string]interface{}
 // @Router /dashboard/monthly [get]
 func (c *DashboardController) GetMonthlyStats(ctx echo.Context) error {
-	month := time.Now()
+	month := calendar.Today(helper.ApplicationLocation())
 
 	// Parse month if provided
 	if monthStr := ctx.QueryParam("month"); monthStr != "" {
@@ -263,7 +265,7 @@ func (c *DashboardController) GetMonthlyStats(ctx echo.Context) error {
 // @Failure 500 {object} map[string]interface{}
 // @Router /dashboard/salary/overview [get]
 func (c *DashboardController) GetSalaryOverview(ctx echo.Context) error {
-	month := time.Now()
+	month := calendar.Today(helper.ApplicationLocation())
 
 	// Parse month if provided
 	if monthStr := ctx.QueryParam("month"); monthStr != "" {
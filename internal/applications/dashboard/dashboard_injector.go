@@ -10,6 +10,7 @@ import (
 	"mceasy/internal/applications/dashboard/repository"
 	"mceasy/internal/applications/dashboard/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
@@ -17,10 +18,12 @@ import (
 
 var providerDashboard = wire.NewSet(
 	repository.NewDashboardRepository,
+	calendar.NewWorkingDayCalendar,
 	service.NewDashboardService,
 	cache.NewCache,
 
 	wire.Bind(new(repository.DashboardRepository), new(*repository.DashboardRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
 	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
 	wire.Bind(new(service.DashboardService), new(*service.DashboardServiceImpl)),
 )
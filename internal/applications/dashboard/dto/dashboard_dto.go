@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // TodayAttendanceSummary represents today's attendance summary (core requirement)
@@ -12,6 +14,10 @@ type TodayAttendanceSummary struct {
 	AbsentCount       int       `json:"absent_count"`
 	LateCount         int       `json:"late_count"`
 	HalfDayCount      int       `json:"half_day_count"`
+	LeaveCount        int       `json:"leave_count"`
+	RemoteCount       int       `json:"remote_count"`
+	BusinessTripCount int       `json:"business_trip_count"`
+	SickCount         int       `json:"sick_count"`
 	PresentPercentage float64   `json:"present_percentage"`
 	AbsentPercentage  float64   `json:"absent_percentage"`
 	NotMarkedCount    int       `json:"not_marked_count"`
@@ -28,13 +34,13 @@ type DashboardOverview struct {
 
 // MonthlyStatsSummary represents current month statistics
 type MonthlyStatsSummary struct {
-	Month                          time.Time `json:"month"`
-	TotalWorkingDays               int       `json:"total_working_days"`
-	WorkingDaysElapsed             int       `json:"working_days_elapsed"`
-	AverageAttendanceRate          float64   `json:"average_attendance_rate"`
-	TotalSalaryCalculated          float64   `json:"total_salary_calculated"`
-	TotalSalaryPending             float64   `json:"total_salary_pending"`
-	EmployeesWithPerfectAttendance int       `json:"employees_with_perfect_attendance"`
+	Month                          time.Time       `json:"month"`
+	TotalWorkingDays               int             `json:"total_working_days"`
+	WorkingDaysElapsed             int             `json:"working_days_elapsed"`
+	AverageAttendanceRate          float64         `json:"average_attendance_rate"`
+	TotalSalaryCalculated          decimal.Decimal `json:"total_salary_calculated"`
+	TotalSalaryPending             decimal.Decimal `json:"total_salary_pending"`
+	EmployeesWithPerfectAttendance int             `json:"employees_with_perfect_attendance"`
 }
 
 // AttendanceTrendData represents attendance trends over time
@@ -57,15 +63,15 @@ type AttendanceTrendPoint struct {
 
 // SalaryOverviewData represents salary-related statistics
 type SalaryOverviewData struct {
-	CurrentMonth          time.Time `json:"current_month"`
-	TotalBaseSalary       float64   `json:"total_base_salary"`
-	TotalCalculatedSalary float64   `json:"total_calculated_salary"`
-	TotalDeductions       float64   `json:"total_deductions"`
-	EmployeesCalculated   int       `json:"employees_calculated"`
-	EmployeesPending      int       `json:"employees_pending"`
-	AverageDeductionRate  float64   `json:"average_deduction_rate"`
-	HighestSalary         float64   `json:"highest_salary"`
-	LowestSalary          float64   `json:"lowest_salary"`
+	CurrentMonth          time.Time       `json:"current_month"`
+	TotalBaseSalary       decimal.Decimal `json:"total_base_salary"`
+	TotalCalculatedSalary decimal.Decimal `json:"total_calculated_salary"`
+	TotalDeductions       decimal.Decimal `json:"total_deductions"`
+	EmployeesCalculated   int             `json:"employees_calculated"`
+	EmployeesPending      int             `json:"employees_pending"`
+	AverageDeductionRate  float64         `json:"average_deduction_rate"`
+	HighestSalary         decimal.Decimal `json:"highest_salary"`
+	LowestSalary          decimal.Decimal `json:"lowest_salary"`
 }
 
 // SystemStatsData represents overall system statistics
@@ -90,8 +96,10 @@ type DashboardQueryParams struct {
 type AttendanceAlerts struct {
 	LateEmployeesToday     []EmployeeAlert `json:"late_employees_today"`
 	AbsentEmployeesToday   []EmployeeAlert `json:"absent_employees_today"`
+	SickEmployeesToday     []EmployeeAlert `json:"sick_employees_today"`
 	PerfectAttendanceMonth []EmployeeAlert `json:"perfect_attendance_month"`
 	FrequentLateEmployees  []EmployeeAlert `json:"frequent_late_employees"`
+	OpenAnomalies          []EmployeeAlert `json:"open_anomalies"`
 }
 
 // EmployeeAlert represents an individual employee alert
@@ -133,10 +141,10 @@ type SalaryTrendData struct {
 
 // SalaryTrendPoint represents a single point in salary trend
 type SalaryTrendPoint struct {
-	Month                time.Time `json:"month"`
-	TotalBaseSalary      float64   `json:"total_base_salary"`
-	TotalFinalSalary     float64   `json:"total_final_salary"`
-	TotalDeductions      float64   `json:"total_deductions"`
-	AverageDeductionRate float64   `json:"average_deduction_rate"`
-	EmployeesCount       int       `json:"employees_count"`
+	Month                time.Time       `json:"month"`
+	TotalBaseSalary      decimal.Decimal `json:"total_base_salary"`
+	TotalFinalSalary     decimal.Decimal `json:"total_final_salary"`
+	TotalDeductions      decimal.Decimal `json:"total_deductions"`
+	AverageDeductionRate float64         `json:"average_deduction_rate"`
+	EmployeesCount       int             `json:"employees_count"`
 }
@@ -7,9 +7,14 @@ import (
 
 	"mceasy/ent"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendanceanomaly"
 	"mceasy/ent/employee"
 	"mceasy/ent/salarycalculation"
 	"mceasy/internal/applications/dashboard/dto"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
+
+	"github.com/shopspring/decimal"
 )
 
 // DashboardRepository defines the interface for dashboard data operations
@@ -27,43 +32,36 @@ type DashboardRepository interface {
 
 // DashboardRepositoryImpl implements the DashboardRepository interface
 type DashboardRepositoryImpl struct {
-	client *ent.Client
+	client   *ent.Client
+	calendar calendar.WorkingDayCalendar
 }
 
 // NewDashboardRepository creates a new dashboard repository instance
-func NewDashboardRepository(client *ent.Client) *DashboardRepositoryImpl {
+func NewDashboardRepository(client *ent.Client, calendar calendar.WorkingDayCalendar) *DashboardRepositoryImpl {
 	return &DashboardRepositoryImpl{
-		client: client,
+		client:   client,
+		calendar: calendar,
 	}
 }
 
-// GetTodayAttendanceSummary retrieves today's attendance summary (core requirement)
+// GetTodayAttendanceSummary retrieves today's attendance summary (core requirement), today being the current
+// date in each employee's timezone
 func (r *DashboardRepositoryImpl) GetTodayAttendanceSummary(ctx context.Context) (*dto.TodayAttendanceSummary, error) {
-	today := time.Now()
-	startOfDay := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
-
-	// Get total active employees
-	totalEmployees, err := r.client.Employee.
-		Query().
-		Where(employee.DeletedAtIsNil()).
-		Where(employee.IsActiveEQ(true)).
-		Count(ctx)
+	days, err := r.getEmployeeDays(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count total employees: %w", err)
 	}
+	totalEmployees := len(days)
 
 	// Get today's attendance records
-	attendanceRecords, err := r.client.Attendance.
-		Query().
-		Where(attendance.AttendanceDate(startOfDay)).
-		Where(attendance.DeletedAtIsNil()).
-		All(ctx)
+	attendanceRecords, err := r.getTodayAttendance(ctx, days)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch today's attendance: %w", err)
 	}
 
 	// Count attendance by status
-	var presentCount, absentCount, lateCount, halfDayCount int
+	var presentCount, absentCount, lateCount, halfDayCount, leaveCount int
+	var remoteCount, businessTripCount, sickCount int
 	for _, record := range attendanceRecords {
 		switch record.Status {
 		case attendance.StatusPresent:
@@ -74,6 +72,14 @@ func (r *DashboardRepositoryImpl) GetTodayAttendanceSummary(ctx context.Context)
 			lateCount++
 		case attendance.StatusHalfDay:
 			halfDayCount++
+		case attendance.StatusLeave, attendance.StatusUnpaidLeave:
+			leaveCount++
+		case attendance.StatusRemote:
+			remoteCount++
+		case attendance.StatusBusinessTrip:
+			businessTripCount++
+		case attendance.StatusSick:
+			sickCount++
 		}
 	}
 
@@ -85,24 +91,106 @@ func (r *DashboardRepositoryImpl) GetTodayAttendanceSummary(ctx context.Context)
 	absentPercentage := 0.0
 	if totalEmployees > 0 {
 		// Consider present + late + half day as "present" for percentage calculation
-		actualPresentCount := presentCount + lateCount + halfDayCount
+		// Working remotely or on a business trip is still working, sick and leave days are neither present nor absent
+		actualPresentCount := presentCount + lateCount + halfDayCount + remoteCount + businessTripCount
 		presentPercentage = float64(actualPresentCount) / float64(totalEmployees) * 100
 		absentPercentage = float64(absentCount+notMarkedCount) / float64(totalEmployees) * 100
 	}
 
 	return &dto.TodayAttendanceSummary{
-		Date:              startOfDay,
+		Date:              days.latest(),
 		TotalEmployees:    totalEmployees,
 		PresentCount:      presentCount,
 		AbsentCount:       absentCount,
 		LateCount:         lateCount,
 		HalfDayCount:      halfDayCount,
+		LeaveCount:        leaveCount,
+		RemoteCount:       remoteCount,
+		BusinessTripCount: businessTripCount,
+		SickCount:         sickCount,
 		PresentPercentage: presentPercentage,
 		AbsentPercentage:  absentPercentage,
 		NotMarkedCount:    notMarkedCount,
 	}, nil
 }
 
+// employeeDays maps each active employee to the current date in the employee's timezone, employees in
+// different timezones can be on different dates
+type employeeDays map[uint64]time.Time
+
+// latest returns the date of the employees furthest ahead, the application date when there are no employees
+func (d employeeDays) latest() time.Time {
+	if len(d) == 0 {
+		return calendar.Today(helper.ApplicationLocation())
+	}
+
+	var latest time.Time
+	for _, day := range d {
+		if day.After(latest) {
+			latest = day
+		}
+	}
+
+	return latest
+}
+
+// earliest returns the date of the employees furthest behind, the application date when there are no employees
+func (d employeeDays) earliest() time.Time {
+	earliest := d.latest()
+	for _, day := range d {
+		if day.Before(earliest) {
+			earliest = day
+		}
+	}
+
+	return earliest
+}
+
+// getEmployeeDays resolves the current date of every active employee, like the attendance repository's
+// GetTodayAttendance
+func (r *DashboardRepositoryImpl) getEmployeeDays(ctx context.Context) (employeeDays, error) {
+	employees, err := r.client.Employee.
+		Query().
+		Where(employee.DeletedAtIsNil()).
+		Where(employee.IsActiveEQ(true)).
+		WithOfficeLocation().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	days := make(employeeDays, len(employees))
+	for _, emp := range employees {
+		days[emp.ID] = calendar.DateIn(now, calendar.EmployeeLocation(emp))
+	}
+
+	return days, nil
+}
+
+// getTodayAttendance retrieves the attendance of the active employees on their current date with the employee
+func (r *DashboardRepositoryImpl) getTodayAttendance(ctx context.Context, days employeeDays) ([]*ent.Attendance, error) {
+	attendances, err := r.client.Attendance.
+		Query().
+		Where(attendance.AttendanceDateGTE(days.earliest())).
+		Where(attendance.AttendanceDateLTE(days.latest())).
+		Where(attendance.DeletedAtIsNil()).
+		WithEmployee().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todayAttendances := make([]*ent.Attendance, 0, len(attendances))
+	for _, record := range attendances {
+		if today, ok := days[record.EmployeeID]; ok && calendar.DateKey(record.AttendanceDate) == calendar.DateKey(today) {
+			todayAttendances = append(todayAttendances, record)
+		}
+	}
+
+	return todayAttendances, nil
+}
+
 // GetDashboardOverview retrieves complete dashboard overview
 func (r *DashboardRepositoryImpl) GetDashboardOverview(ctx context.Context) (*dto.DashboardOverview, error) {
 	// Get today's attendance summary
@@ -111,15 +199,20 @@ func (r *DashboardRepositoryImpl) GetDashboardOverview(ctx context.Context) (*dt
 		return nil, fmt.Errorf("failed to get today's attendance: %w", err)
 	}
 
-	// Get current month stats
-	currentMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.Now().Location())
+	// Get current month stats, the month and the trends end at the date of the employees furthest ahead
+	days, err := r.getEmployeeDays(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve today's date: %w", err)
+	}
+	today := days.latest()
+	currentMonth := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
 	monthlyStats, err := r.GetMonthlyStats(ctx, currentMonth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get monthly stats: %w", err)
 	}
 
 	// Get last 7 days attendance trends
-	endDate := time.Now()
+	endDate := today
 	startDate := endDate.AddDate(0, 0, -7)
 	recentTrends, err := r.GetAttendanceTrends(ctx, startDate, endDate, "daily")
 	if err != nil {
@@ -168,12 +261,13 @@ func (r *DashboardRepositoryImpl) GetAttendanceTrends(ctx context.Context, start
 	// Generate data points based on period type
 	switch periodType {
 	case "daily":
-		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
-			// Skip weekends
-			if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
-				continue
-			}
+		// Skip weekends and holidays
+		workingDays, err := r.calendar.GetWorkingDays(ctx, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get working days: %w", err)
+		}
 
+		for _, d := range workingDays {
 			point, err := r.getAttendancePointForDate(ctx, d, totalEmployees)
 			if err != nil {
 				continue // Skip on error, don't fail entire request
@@ -228,7 +322,7 @@ func (r *DashboardRepositoryImpl) getAttendancePointForDate(ctx context.Context,
 	var presentCount, absentCount, lateCount int
 	for _, record := range attendanceRecords {
 		switch record.Status {
-		case attendance.StatusPresent, attendance.StatusHalfDay:
+		case attendance.StatusPresent, attendance.StatusHalfDay, attendance.StatusRemote, attendance.StatusBusinessTrip:
 			presentCount++
 		case attendance.StatusAbsent:
 			absentCount++
@@ -268,7 +362,7 @@ func (r *DashboardRepositoryImpl) getAttendancePointForWeek(ctx context.Context,
 	var presentCount, absentCount, lateCount int
 	for _, record := range attendanceRecords {
 		switch record.Status {
-		case attendance.StatusPresent, attendance.StatusHalfDay:
+		case attendance.StatusPresent, attendance.StatusHalfDay, attendance.StatusRemote, attendance.StatusBusinessTrip:
 			presentCount++
 		case attendance.StatusAbsent:
 			absentCount++
@@ -278,7 +372,10 @@ func (r *DashboardRepositoryImpl) getAttendancePointForWeek(ctx context.Context,
 	}
 
 	attendanceRate := 0.0
-	workingDays := r.countWorkingDays(startDate, endDate)
+	workingDays, err := r.calendar.CountWorkingDays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
 	totalPossibleAttendance := totalEmployees * workingDays
 	if totalPossibleAttendance > 0 {
 		attendanceRate = float64(presentCount+lateCount) / float64(totalPossibleAttendance) * 100
@@ -302,17 +399,6 @@ func (r *DashboardRepositoryImpl) getAttendancePointForMonth(ctx context.Context
 	return r.getAttendancePointForWeek(ctx, startOfMonth, endOfMonth, totalEmployees)
 }
 
-// countWorkingDays counts working days between two dates (excluding weekends)
-func (r *DashboardRepositoryImpl) countWorkingDays(startDate, endDate time.Time) int {
-	count := 0
-	for d := startDate; !d.After(endDate); d = d.AddDate(0, 0, 1) {
-		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
-			count++
-		}
-	}
-	return count
-}
-
 // GetSalaryTrends retrieves salary trends over multiple months
 func (r *DashboardRepositoryImpl) GetSalaryTrends(ctx context.Context, startDate, endDate time.Time) (*dto.SalaryTrendData, error) {
 	var dataPoints []dto.SalaryTrendPoint
@@ -330,19 +416,16 @@ func (r *DashboardRepositoryImpl) GetSalaryTrends(ctx context.Context, startDate
 			continue
 		}
 
-		var totalBaseSalary, totalFinalSalary, totalDeductions float64
+		var totalBaseSalary, totalFinalSalary, totalDeductions decimal.Decimal
 		employeesCount := len(calculations)
 
 		for _, calc := range calculations {
-			totalBaseSalary += calc.BaseSalary
-			totalFinalSalary += calc.FinalSalary
-			totalDeductions += calc.DeductionAmount
+			totalBaseSalary = totalBaseSalary.Add(calc.BaseSalary)
+			totalFinalSalary = totalFinalSalary.Add(calc.FinalSalary)
+			totalDeductions = totalDeductions.Add(calc.DeductionAmount)
 		}
 
-		averageDeductionRate := 0.0
-		if totalBaseSalary > 0 {
-			averageDeductionRate = (totalDeductions / totalBaseSalary) * 100
-		}
+		averageDeductionRate := deductionRate(totalDeductions, totalBaseSalary)
 
 		dataPoints = append(dataPoints, dto.SalaryTrendPoint{
 			Month:                month,
@@ -364,21 +447,18 @@ func (r *DashboardRepositoryImpl) GetSalaryTrends(ctx context.Context, startDate
 
 // GetAttendanceAlerts retrieves attendance-related alerts
 func (r *DashboardRepositoryImpl) GetAttendanceAlerts(ctx context.Context) (*dto.AttendanceAlerts, error) {
-	today := time.Now()
-	startOfDay := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	days, err := r.getEmployeeDays(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve today's date: %w", err)
+	}
 
-	// Get today's attendance records with employee info
-	todayAttendance, err := r.client.Attendance.
-		Query().
-		Where(attendance.AttendanceDate(startOfDay)).
-		Where(attendance.DeletedAtIsNil()).
-		WithEmployee().
-		All(ctx)
+	// Get today's attendance records with employee info, today being the current date of each employee
+	todayAttendance, err := r.getTodayAttendance(ctx, days)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch today's attendance: %w", err)
 	}
 
-	var lateToday, absentToday []dto.EmployeeAlert
+	var lateToday, absentToday, sickToday []dto.EmployeeAlert
 
 	for _, record := range todayAttendance {
 		if record.Edges.Employee == nil {
@@ -391,7 +471,7 @@ func (r *DashboardRepositoryImpl) GetAttendanceAlerts(ctx context.Context) (*dto
 			EmployeeName: emp.FullName,
 			EmployeeCode: emp.EmployeeID,
 			Department:   emp.Department,
-			Date:         startOfDay,
+			Date:         record.AttendanceDate,
 		}
 
 		switch record.Status {
@@ -403,6 +483,14 @@ func (r *DashboardRepositoryImpl) GetAttendanceAlerts(ctx context.Context) (*dto
 			alert.Message = "Absent today"
 			alert.Severity = "critical"
 			absentToday = append(absentToday, alert)
+		case attendance.StatusSick:
+			alert.Message = "Sick today"
+			alert.Severity = "info"
+			if record.StatusMetadata["sick_note_url"] == "" {
+				alert.Message = "Sick today without a sick note"
+				alert.Severity = "warning"
+			}
+			sickToday = append(sickToday, alert)
 		}
 	}
 
@@ -418,14 +506,60 @@ func (r *DashboardRepositoryImpl) GetAttendanceAlerts(ctx context.Context) (*dto
 		frequentLate = []dto.EmployeeAlert{} // Don't fail on this
 	}
 
+	openAnomalies, err := r.getOpenAnomalies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &dto.AttendanceAlerts{
 		LateEmployeesToday:     lateToday,
 		AbsentEmployeesToday:   absentToday,
+		SickEmployeesToday:     sickToday,
 		PerfectAttendanceMonth: perfectAttendance,
 		FrequentLateEmployees:  frequentLate,
+		OpenAnomalies:          openAnomalies,
 	}, nil
 }
 
+// getOpenAnomalies lists the attendance anomalies HR has not resolved yet, missing check-outs and long days
+// change the worked hours payroll is based on and are raised as warnings
+func (r *DashboardRepositoryImpl) getOpenAnomalies(ctx context.Context) ([]dto.EmployeeAlert, error) {
+	anomalies, err := r.client.AttendanceAnomaly.
+		Query().
+		Where(attendanceanomaly.StatusEQ(attendanceanomaly.StatusOpen)).
+		Where(attendanceanomaly.DeletedAtIsNil()).
+		WithEmployee().
+		Order(ent.Desc(attendanceanomaly.FieldAttendanceDate), ent.Desc(attendanceanomaly.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open attendance anomalies: %w", err)
+	}
+
+	alerts := []dto.EmployeeAlert{}
+	for _, anomaly := range anomalies {
+		if anomaly.Edges.Employee == nil {
+			continue
+		}
+
+		emp := anomaly.Edges.Employee
+		alert := dto.EmployeeAlert{
+			EmployeeID:   emp.ID,
+			EmployeeName: emp.FullName,
+			EmployeeCode: emp.EmployeeID,
+			Department:   emp.Department,
+			Date:         anomaly.AttendanceDate,
+			Message:      anomaly.Details,
+			Severity:     "info",
+		}
+		if anomaly.Rule == attendanceanomaly.RuleMissingCheckOut || anomaly.Rule == attendanceanomaly.RuleLongDay {
+			alert.Severity = "warning"
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
 // getPerfectAttendanceEmployees gets employees with perfect attendance this month
 func (r *DashboardRepositoryImpl) getPerfectAttendanceEmployees(ctx context.Context) ([]dto.EmployeeAlert, error) {
 	// currentMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.Now().Location())
@@ -447,13 +581,14 @@ func (r *DashboardRepositoryImpl) getFrequentLateEmployees(ctx context.Context)
 func (r *DashboardRepositoryImpl) GetWeeklySummary(ctx context.Context, startDate time.Time) (*dto.WeeklySummary, error) {
 	endDate := startDate.AddDate(0, 0, 6)
 
-	var dailySummaries []dto.TodayAttendanceSummary
-	for d := startDate; !d.After(endDate); d = d.AddDate(0, 0, 1) {
-		// Skip weekends
-		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
-			continue
-		}
+	// Skip weekends and holidays
+	workingDays, err := r.calendar.GetWorkingDays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working days: %w", err)
+	}
 
+	var dailySummaries []dto.TodayAttendanceSummary
+	for _, d := range workingDays {
 		summary, err := r.getDailySummaryForDate(ctx, d)
 		if err != nil {
 			continue
@@ -512,7 +647,8 @@ func (r *DashboardRepositoryImpl) getDailySummaryForDate(ctx context.Context, da
 		return nil, err
 	}
 
-	var presentCount, absentCount, lateCount, halfDayCount int
+	var presentCount, absentCount, lateCount, halfDayCount, leaveCount int
+	var remoteCount, businessTripCount, sickCount int
 	for _, record := range attendanceRecords {
 		switch record.Status {
 		case attendance.StatusPresent:
@@ -523,6 +659,14 @@ func (r *DashboardRepositoryImpl) getDailySummaryForDate(ctx context.Context, da
 			lateCount++
 		case attendance.StatusHalfDay:
 			halfDayCount++
+		case attendance.StatusLeave, attendance.StatusUnpaidLeave:
+			leaveCount++
+		case attendance.StatusRemote:
+			remoteCount++
+		case attendance.StatusBusinessTrip:
+			businessTripCount++
+		case attendance.StatusSick:
+			sickCount++
 		}
 	}
 
@@ -531,7 +675,8 @@ func (r *DashboardRepositoryImpl) getDailySummaryForDate(ctx context.Context, da
 	presentPercentage := 0.0
 	absentPercentage := 0.0
 	if totalEmployees > 0 {
-		actualPresentCount := presentCount + lateCount + halfDayCount
+		// Working remotely or on a business trip is still working, sick and leave days are neither present nor absent
+		actualPresentCount := presentCount + lateCount + halfDayCount + remoteCount + businessTripCount
 		presentPercentage = float64(actualPresentCount) / float64(totalEmployees) * 100
 		absentPercentage = float64(absentCount+notMarkedCount) / float64(totalEmployees) * 100
 	}
@@ -543,6 +688,10 @@ func (r *DashboardRepositoryImpl) getDailySummaryForDate(ctx context.Context, da
 		AbsentCount:       absentCount,
 		LateCount:         lateCount,
 		HalfDayCount:      halfDayCount,
+		LeaveCount:        leaveCount,
+		RemoteCount:       remoteCount,
+		BusinessTripCount: businessTripCount,
+		SickCount:         sickCount,
 		PresentPercentage: presentPercentage,
 		AbsentPercentage:  absentPercentage,
 		NotMarkedCount:    notMarkedCount,
@@ -553,12 +702,34 @@ func (r *DashboardRepositoryImpl) getDailySummaryForDate(ctx context.Context, da
 func (r *DashboardRepositoryImpl) GetMonthlyStats(ctx context.Context, month time.Time) (*dto.MonthlyStatsSummary, error) {
 	startOfMonth := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
 	endOfMonth := startOfMonth.AddDate(0, 1, -1)
-	now := time.Now()
 
-	totalWorkingDays := r.countWorkingDays(startOfMonth, endOfMonth)
-	workingDaysElapsed := r.countWorkingDays(startOfMonth, now)
-	if workingDaysElapsed > totalWorkingDays {
-		workingDaysElapsed = totalWorkingDays
+	days, err := r.getEmployeeDays(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count employees: %w", err)
+	}
+	totalEmployees := len(days)
+
+	totalWorkingDays, err := r.calendar.CountWorkingDays(ctx, startOfMonth, endOfMonth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count working days: %w", err)
+	}
+	workingDaysElapsed, err := r.countWorkingDaysElapsed(ctx, startOfMonth, endOfMonth, days.latest())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count working days: %w", err)
+	}
+
+	// Each employee could attend the working days up to the employee's own current date
+	totalPossibleAttendance := 0
+	elapsedByDate := make(map[string]int)
+	for _, today := range days {
+		elapsed, ok := elapsedByDate[calendar.DateKey(today)]
+		if !ok {
+			if elapsed, err = r.countWorkingDaysElapsed(ctx, startOfMonth, endOfMonth, today); err != nil {
+				return nil, fmt.Errorf("failed to count working days: %w", err)
+			}
+			elapsedByDate[calendar.DateKey(today)] = elapsed
+		}
+		totalPossibleAttendance += elapsed
 	}
 
 	// Get salary calculations for this month
@@ -571,23 +742,14 @@ func (r *DashboardRepositoryImpl) GetMonthlyStats(ctx context.Context, month tim
 		return nil, fmt.Errorf("failed to fetch salary calculations: %w", err)
 	}
 
-	totalEmployees, err := r.client.Employee.
-		Query().
-		Where(employee.DeletedAtIsNil()).
-		Where(employee.IsActiveEQ(true)).
-		Count(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count employees: %w", err)
-	}
-
-	var totalSalaryCalculated float64
+	var totalSalaryCalculated decimal.Decimal
 	for _, calc := range salaryCalculations {
-		totalSalaryCalculated += calc.FinalSalary
+		totalSalaryCalculated = totalSalaryCalculated.Add(calc.FinalSalary)
 	}
 
 	calculatedEmployees := len(salaryCalculations)
 	pendingEmployees := totalEmployees - calculatedEmployees
-	totalSalaryPending := float64(pendingEmployees) * 10000000 // Base salary
+	totalSalaryPending := decimal.NewFromInt(int64(pendingEmployees) * 10000000) // Base salary
 
 	// Calculate average attendance rate for the month
 	attendanceRecords, err := r.client.Attendance.
@@ -603,13 +765,13 @@ func (r *DashboardRepositoryImpl) GetMonthlyStats(ctx context.Context, month tim
 
 	var presentCount int
 	for _, record := range attendanceRecords {
-		if record.Status == attendance.StatusPresent || record.Status == attendance.StatusLate || record.Status == attendance.StatusHalfDay {
+		switch record.Status {
+		case attendance.StatusPresent, attendance.StatusLate, attendance.StatusHalfDay, attendance.StatusRemote, attendance.StatusBusinessTrip:
 			presentCount++
 		}
 	}
 
 	averageAttendanceRate := 0.0
-	totalPossibleAttendance := totalEmployees * workingDaysElapsed
 	if totalPossibleAttendance > 0 {
 		averageAttendanceRate = float64(presentCount) / float64(totalPossibleAttendance) * 100
 	}
@@ -625,6 +787,18 @@ func (r *DashboardRepositoryImpl) GetMonthlyStats(ctx context.Context, month tim
 	}, nil
 }
 
+// countWorkingDaysElapsed counts the working days of a month up to and including today
+func (r *DashboardRepositoryImpl) countWorkingDaysElapsed(ctx context.Context, startOfMonth, endOfMonth, today time.Time) (int, error) {
+	if today.Before(startOfMonth) {
+		return 0, nil
+	}
+	if today.After(endOfMonth) {
+		today = endOfMonth
+	}
+
+	return r.calendar.CountWorkingDays(ctx, startOfMonth, today)
+}
+
 // GetSalaryOverview retrieves salary overview for a month
 func (r *DashboardRepositoryImpl) GetSalaryOverview(ctx context.Context, month time.Time) (*dto.SalaryOverviewData, error) {
 	startOfMonth := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
@@ -647,8 +821,8 @@ func (r *DashboardRepositoryImpl) GetSalaryOverview(ctx context.Context, month t
 		return nil, fmt.Errorf("failed to count employees: %w", err)
 	}
 
-	var totalBaseSalary, totalCalculatedSalary, totalDeductions float64
-	var highestSalary, lowestSalary float64
+	var totalBaseSalary, totalCalculatedSalary, totalDeductions decimal.Decimal
+	var highestSalary, lowestSalary decimal.Decimal
 	employeesCalculated := len(calculations)
 	employeesPending := totalEmployees - employeesCalculated
 
@@ -657,23 +831,20 @@ func (r *DashboardRepositoryImpl) GetSalaryOverview(ctx context.Context, month t
 		lowestSalary = calculations[0].FinalSalary
 
 		for _, calc := range calculations {
-			totalBaseSalary += calc.BaseSalary
-			totalCalculatedSalary += calc.FinalSalary
-			totalDeductions += calc.DeductionAmount
+			totalBaseSalary = totalBaseSalary.Add(calc.BaseSalary)
+			totalCalculatedSalary = totalCalculatedSalary.Add(calc.FinalSalary)
+			totalDeductions = totalDeductions.Add(calc.DeductionAmount)
 
-			if calc.FinalSalary > highestSalary {
+			if calc.FinalSalary.GreaterThan(highestSalary) {
 				highestSalary = calc.FinalSalary
 			}
-			if calc.FinalSalary < lowestSalary {
+			if calc.FinalSalary.LessThan(lowestSalary) {
 				lowestSalary = calc.FinalSalary
 			}
 		}
 	}
 
-	averageDeductionRate := 0.0
-	if totalBaseSalary > 0 {
-		averageDeductionRate = (totalDeductions / totalBaseSalary) * 100
-	}
+	averageDeductionRate := deductionRate(totalDeductions, totalBaseSalary)
 
 	return &dto.SalaryOverviewData{
 		CurrentMonth:          startOfMonth,
@@ -732,3 +903,13 @@ func (r *DashboardRepositoryImpl) GetSystemStats(ctx context.Context) (*dto.Syst
 		LastUpdated:             time.Now(),
 	}, nil
 }
+
+// deductionRate returns the deductions as a percentage of the base salaries, the amounts are summed exactly and only
+// the rate is a float
+func deductionRate(totalDeductions, totalBaseSalary decimal.Decimal) float64 {
+	if !totalBaseSalary.IsPositive() {
+		return 0
+	}
+
+	return totalDeductions.Div(totalBaseSalary).Mul(decimal.NewFromInt(100)).InexactFloat64()
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/internal/component/calendar"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardRepositoryImpl_TodayPerEmployeeTimezone(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	// UTC+14 and UTC-12 are always on different dates
+	newEmployee := func(name, timezone string) *ent.Employee {
+		emp, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(name + "@example.com").
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone(timezone).
+			Save(ctx)
+		require.NoError(t, err)
+		return emp
+	}
+	ahead := newEmployee("ahead", "Pacific/Kiritimati")
+	behind := newEmployee("behind", "Etc/GMT+12")
+
+	now := time.Now()
+	aheadToday := calendar.DateIn(now, calendar.ResolveLocation(ahead.Timezone, ""))
+	behindToday := calendar.DateIn(now, calendar.ResolveLocation(behind.Timezone, ""))
+	require.True(t, aheadToday.After(behindToday))
+
+	mark := func(emp *ent.Employee, date time.Time, status attendance.Status) {
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(date).
+			SetStatus(status).
+			SetCheckInTime(now).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	// Each employee's own today counts, the other employee's today does not
+	mark(ahead, aheadToday, attendance.StatusLate)
+	mark(ahead, behindToday, attendance.StatusAbsent)
+	mark(behind, behindToday, attendance.StatusPresent)
+
+	dashboardRepo := NewDashboardRepository(client, calendar.NewWorkingDayCalendar(client))
+
+	t.Run("summary counts each employee on the employee's date", func(t *testing.T) {
+		summary, err := dashboardRepo.GetTodayAttendanceSummary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.TotalEmployees)
+		assert.Equal(t, 1, summary.LateCount)
+		assert.Equal(t, 1, summary.PresentCount)
+		assert.Equal(t, 0, summary.AbsentCount)
+		assert.Equal(t, 0, summary.NotMarkedCount)
+		assert.Equal(t, aheadToday, summary.Date)
+	})
+
+	t.Run("alerts are raised for each employee's date", func(t *testing.T) {
+		alerts, err := dashboardRepo.GetAttendanceAlerts(ctx)
+		require.NoError(t, err)
+		require.Len(t, alerts.LateEmployeesToday, 1)
+		assert.Equal(t, ahead.ID, alerts.LateEmployeesToday[0].EmployeeID)
+		assert.Equal(t, calendar.DateKey(aheadToday), calendar.DateKey(alerts.LateEmployeesToday[0].Date))
+		assert.Empty(t, alerts.AbsentEmployeesToday)
+	})
+}
@@ -8,6 +8,8 @@ import (
 	"mceasy/internal/applications/dashboard/dto"
 	"mceasy/internal/applications/dashboard/repository"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/helper"
 )
 
 // DashboardService defines the interface for dashboard business logic
@@ -43,7 +45,7 @@ func NewDashboardService(
 // GetTodayAttendanceSummary retrieves today's attendance summary with caching
 func (s *DashboardServiceImpl) GetTodayAttendanceSummary(ctx context.Context) (*dto.TodayAttendanceSummary, error) {
 	// Use cache for today's summary (cache for 5 minutes)
-	cacheKey := fmt.Sprintf("dashboard:today_summary:%s", time.Now().Format("2006-01-02"))
+	cacheKey := fmt.Sprintf("dashboard:today_summary:%s", calendar.Today(helper.ApplicationLocation()).Format("2006-01-02"))
 
 	var result dto.TodayAttendanceSummary
 	cachedData, err := s.cache.Get(ctx, cacheKey, &result)
@@ -95,10 +97,10 @@ func (s *DashboardServiceImpl) GetAttendanceTrends(ctx context.Context, params d
 
 	// Set default date range if not provided (last 30 days)
 	if startDate.IsZero() {
-		startDate = time.Now().AddDate(0, 0, -30)
+		startDate = calendar.Today(helper.ApplicationLocation()).AddDate(0, 0, -30)
 	}
 	if endDate.IsZero() {
-		endDate = time.Now()
+		endDate = calendar.Today(helper.ApplicationLocation())
 	}
 
 	// Set default period type
@@ -154,10 +156,10 @@ func (s *DashboardServiceImpl) GetSalaryTrends(ctx context.Context, params dto.D
 
 	// Set default date range if not provided (last 12 months)
 	if startDate.IsZero() {
-		startDate = time.Now().AddDate(-1, 0, 0)
+		startDate = calendar.Today(helper.ApplicationLocation()).AddDate(-1, 0, 0)
 	}
 	if endDate.IsZero() {
-		endDate = time.Now()
+		endDate = calendar.Today(helper.ApplicationLocation())
 	}
 
 	// Validate date range
@@ -198,7 +200,7 @@ func (s *DashboardServiceImpl) GetSalaryTrends(ctx context.Context, params dto.D
 // GetAttendanceAlerts retrieves attendance alerts with caching
 func (s *DashboardServiceImpl) GetAttendanceAlerts(ctx context.Context) (*dto.AttendanceAlerts, error) {
 	// Use cache for alerts (cache for 15 minutes)
-	cacheKey := fmt.Sprintf("dashboard:alerts:%s", time.Now().Format("2006-01-02"))
+	cacheKey := fmt.Sprintf("dashboard:alerts:%s", calendar.Today(helper.ApplicationLocation()).Format("2006-01-02"))
 
 	var result dto.AttendanceAlerts
 	cachedData, err := s.cache.Get(ctx, cacheKey, &result)
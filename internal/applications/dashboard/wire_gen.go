@@ -14,19 +14,22 @@ import (
 	"mceasy/internal/applications/dashboard/repository"
 	"mceasy/internal/applications/dashboard/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
 )
 
 // Injectors from dashboard_injector.go:
 
 func InitializedDashboardService(dbClient *ent.Client, redisClient *redis.Client) service.DashboardService {
-	dashboardRepositoryImpl := repository.NewDashboardRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	dashboardRepositoryImpl := repository.NewDashboardRepository(dbClient, workingDayCalendarImpl)
 	cacheImpl := cache.NewCache(redisClient)
 	dashboardServiceImpl := service.NewDashboardService(dashboardRepositoryImpl, cacheImpl)
 	return dashboardServiceImpl
 }
 
 func InitializedDashboardController(dbClient *ent.Client, redisClient *redis.Client) *controller.DashboardController {
-	dashboardRepositoryImpl := repository.NewDashboardRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	dashboardRepositoryImpl := repository.NewDashboardRepository(dbClient, workingDayCalendarImpl)
 	cacheImpl := cache.NewCache(redisClient)
 	dashboardServiceImpl := service.NewDashboardService(dashboardRepositoryImpl, cacheImpl)
 	dashboardController := controller.NewDashboardController(dashboardServiceImpl)
@@ -35,4 +38,4 @@ func InitializedDashboardController(dbClient *ent.Client, redisClient *redis.Cli
 
 // dashboard_injector.go:
 
-var providerDashboard = wire.NewSet(repository.NewDashboardRepository, service.NewDashboardService, cache.NewCache, wire.Bind(new(repository.DashboardRepository), new(*repository.DashboardRepositoryImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.DashboardService), new(*service.DashboardServiceImpl)))
+var providerDashboard = wire.NewSet(repository.NewDashboardRepository, calendar.NewWorkingDayCalendar, service.NewDashboardService, cache.NewCache, wire.Bind(new(repository.DashboardRepository), new(*repository.DashboardRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.DashboardService), new(*service.DashboardServiceImpl)))
@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/device/dto"
+	"mceasy/internal/applications/device/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DeviceController handles HTTP requests for device operations
+type DeviceController struct {
+	deviceService service.DeviceService
+}
+
+// NewDeviceController creates a new device controller instance
+func NewDeviceController(deviceService service.DeviceService) *DeviceController {
+	return &DeviceController{
+		deviceService: deviceService,
+	}
+}
+
+// CreateDevice registers an attendance terminal
+// @Summary Register a device
+// @Description Register an attendance terminal by serial number ahead of its first connection, it is active unless another status is given
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param device body dto.CreateDeviceRequest true "Device data"
+// @Success 201 {object} dto.DeviceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices [post]
+func (c *DeviceController) CreateDevice(ctx echo.Context) error {
+	var req dto.CreateDeviceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	device, err := c.deviceService.CreateDevice(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create device",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, device)
+}
+
+// GetDevice retrieves an device by ID
+// @Summary Get device by ID
+// @Description Get device details by ID
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param id path int true "Device ID"
+// @Success 200 {object} dto.DeviceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /devices/{id} [get]
+func (c *DeviceController) GetDevice(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid device ID",
+			"message": "Device ID must be a valid number",
+		})
+	}
+
+	device, err := c.deviceService.GetDeviceByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Device not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, device)
+}
+
+// UpdateDevice updates an device
+// @Summary Update device
+// @Description Update an attendance terminal, set the status to active to approve a terminal that registered itself
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param id path int true "Device ID"
+// @Param device body dto.UpdateDeviceRequest true "Device data"
+// @Success 200 {object} dto.DeviceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices/{id} [put]
+func (c *DeviceController) UpdateDevice(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid device ID",
+			"message": "Device ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateDeviceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	device, err := c.deviceService.UpdateDevice(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update device",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, device)
+}
+
+// DeleteDevice deletes an device
+// @Summary Delete device
+// @Description Soft delete an attendance terminal, its pushes are refused afterwards
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param id path int true "Device ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices/{id} [delete]
+func (c *DeviceController) DeleteDevice(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid device ID",
+			"message": "Device ID must be a valid number",
+		})
+	}
+
+	err = c.deviceService.DeleteDevice(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete device",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Device deleted successfully",
+	})
+}
+
+// ListDevices retrieves devices with pagination and filtering
+// @Summary List devices
+// @Description Get list of devices with pagination and filtering
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by serial number or name"
+// @Param status query string false "Status filter" Enums(pending, active, disabled)
+// @Param office_location_id query int false "Office location filter"
+// @Success 200 {object} dto.DeviceListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices [get]
+func (c *DeviceController) ListDevices(ctx echo.Context) error {
+	var params dto.DeviceQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	devices, err := c.deviceService.ListDevices(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list devices",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, devices)
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterDeviceRoutes registers all device routes
+func RegisterDeviceRoutes(e *echo.Group, controller *DeviceController) {
+	e.POST("/devices", controller.CreateDevice)
+	e.GET("/devices", controller.ListDevices)
+	e.GET("/devices/:id", controller.GetDevice)
+	e.PUT("/devices/:id", controller.UpdateDevice)
+	e.DELETE("/devices/:id", controller.DeleteDevice)
+
+	e.GET("/devices/punch-rejections", controller.ListPunchRejections)
+	e.POST("/devices/punch-rejections/:rejection_id/resolve", controller.ResolvePunchRejection)
+}
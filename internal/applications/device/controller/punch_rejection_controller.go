@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/device/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListPunchRejections retrieves the punches terminals pushed that could not be recorded
+// @Summary List rejected punches
+// @Description Get punches pushed by terminals that were not recorded as attendance, e.g. of an unknown PIN or a locked payroll period
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param device_id query int false "Device filter"
+// @Param device_pin query string false "Device PIN filter"
+// @Param status query string false "Status filter" Enums(open, resolved)
+// @Success 200 {object} dto.PunchRejectionListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices/punch-rejections [get]
+func (c *DeviceController) ListPunchRejections(ctx echo.Context) error {
+	var params dto.PunchRejectionQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	rejections, err := c.deviceService.ListPunchRejections(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list rejected punches",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, rejections)
+}
+
+// ResolvePunchRejection marks a rejected punch as reviewed
+// @Summary Resolve rejected punch
+// @Description Record that HR reviewed a rejected punch, e.g. after entering the attendance by hand or discarding it
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param rejection_id path int true "Rejected punch ID"
+// @Param resolution body dto.ResolvePunchRejectionRequest true "Resolution data"
+// @Success 200 {object} dto.PunchRejectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /devices/punch-rejections/{rejection_id}/resolve [post]
+func (c *DeviceController) ResolvePunchRejection(ctx echo.Context) error {
+	idStr := ctx.Param("rejection_id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid rejected punch ID",
+			"message": "Rejected punch ID must be a valid number",
+		})
+	}
+
+	var req dto.ResolvePunchRejectionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	rejection, err := c.deviceService.ResolvePunchRejection(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to resolve rejected punch",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, rejection)
+}
@@ -0,0 +1,34 @@
+//go:build wireinject
+// +build wireinject
+
+package device
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/device/repository"
+	"mceasy/internal/applications/device/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerDevice = wire.NewSet(
+	repository.NewDeviceRepository,
+	repository.NewPunchRejectionRepository,
+	transaction.NewTrx,
+	service.NewDeviceService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.DeviceRepository), new(*repository.DeviceRepositoryImpl)),
+	wire.Bind(new(repository.PunchRejectionRepository), new(*repository.PunchRejectionRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.DeviceService), new(*service.DeviceServiceImpl)),
+)
+
+func InitializedDeviceService(dbClient *ent.Client, redisClient *redis.Client) *service.DeviceServiceImpl {
+	wire.Build(providerDevice)
+	return nil
+}
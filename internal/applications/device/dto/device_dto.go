@@ -0,0 +1,132 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateDeviceRequest represents the request to register an attendance terminal
+type CreateDeviceRequest struct {
+	SerialNumber     string  `json:"serial_number" validate:"required,max=64"`
+	Name             string  `json:"name,omitempty" validate:"omitempty,max=100"`
+	Status           string  `json:"status,omitempty" validate:"omitempty,oneof=pending active disabled"`
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	Timezone         string  `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	// AllowedNetworks are the IP addresses or CIDR ranges the terminal may push from
+	AllowedNetworks []string `json:"allowed_networks,omitempty" validate:"omitempty,dive,ip|cidr"`
+}
+
+// UpdateDeviceRequest represents the request to update an attendance terminal
+type UpdateDeviceRequest struct {
+	Name             string  `json:"name,omitempty" validate:"omitempty,max=100"`
+	Status           string  `json:"status,omitempty" validate:"omitempty,oneof=pending active disabled"`
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	Timezone         *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	// AllowedNetworks replaces the networks the terminal may push from when set, an empty list clears them
+	AllowedNetworks []string `json:"allowed_networks,omitempty" validate:"omitempty,dive,ip|cidr"`
+}
+
+// DeviceResponse represents the attendance terminal response structure
+type DeviceResponse struct {
+	ID                 uint64     `json:"id"`
+	SerialNumber       string     `json:"serial_number"`
+	Name               string     `json:"name,omitempty"`
+	Status             string     `json:"status"`
+	OfficeLocationID   *uint64    `json:"office_location_id,omitempty"`
+	Timezone           string     `json:"timezone,omitempty"`
+	OfficeTimezone     string     `json:"office_timezone,omitempty"`
+	PushVersion        string     `json:"push_version,omitempty"`
+	DeviceInfo         string     `json:"device_info,omitempty"`
+	IPAddress          string     `json:"ip_address,omitempty"`
+	AllowedNetworks    []string   `json:"allowed_networks,omitempty"`
+	AttLogStamp        string     `json:"attlog_stamp,omitempty"`
+	LastSeenAt         *time.Time `json:"last_seen_at,omitempty"`
+	LastPunchAt        *time.Time `json:"last_punch_at,omitempty"`
+	ClockOffsetSeconds *int       `json:"clock_offset_seconds,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	ModifiedAt         time.Time  `json:"modified_at"`
+}
+
+// DeviceListResponse represents the response for attendance terminal list
+type DeviceListResponse struct {
+	Devices []DeviceResponse `json:"devices"`
+	Total   int              `json:"total"`
+	Page    int              `json:"page"`
+	Limit   int              `json:"limit"`
+}
+
+// DeviceQueryParams represents query parameters for attendance terminal list
+type DeviceQueryParams struct {
+	Page             int    `query:"page" validate:"omitempty,min=1"`
+	Limit            int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Search           string `query:"search" validate:"omitempty,max=100"`
+	Status           string `query:"status" validate:"omitempty,oneof=pending active disabled"`
+	OfficeLocationID uint64 `query:"office_location_id" validate:"omitempty"`
+}
+
+// DeviceContact is what a terminal tells about itself on every request of the push protocol
+type DeviceContact struct {
+	SerialNumber string
+	PushVersion  string
+	DeviceInfo   string
+	IPAddress    string
+	SeenAt       time.Time
+}
+
+// DevicePush describes an ATTLOG upload of a terminal
+type DevicePush struct {
+	Stamp string
+	// NewestPunch is the newest punch of the upload as read from the terminal clock
+	NewestPunch time.Time
+	Punches     int
+	ReceivedAt  time.Time
+}
+
+// PunchRejection is a punch pushed by a terminal that could not be recorded as attendance
+type PunchRejection struct {
+	DevicePIN string
+	// PunchTime is the punch time as read from the terminal clock
+	PunchTime  time.Time
+	PunchState int
+	VerifyMode int
+	Reason     string
+}
+
+// ResolvePunchRejectionRequest represents HR marking a rejected punch as reviewed, e.g. after entering it by hand
+type ResolvePunchRejectionRequest struct {
+	ResolvedBy      uint64 `json:"resolved_by" validate:"required"`
+	ResolutionNotes string `json:"resolution_notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// PunchRejectionResponse represents the rejected punch response structure
+type PunchRejectionResponse struct {
+	ID                 uint64     `json:"id"`
+	DeviceID           uint64     `json:"device_id"`
+	DeviceSerialNumber string     `json:"device_serial_number,omitempty"`
+	DevicePIN          string     `json:"device_pin"`
+	PunchTime          time.Time  `json:"punch_time"`
+	PunchState         int        `json:"punch_state"`
+	VerifyMode         int        `json:"verify_mode"`
+	Reason             string     `json:"reason"`
+	Status             string     `json:"status"`
+	ResolvedBy         *uint64    `json:"resolved_by,omitempty"`
+	ResolvedAt         *time.Time `json:"resolved_at,omitempty"`
+	ResolutionNotes    string     `json:"resolution_notes,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// PunchRejectionListResponse represents the response for rejected punch list
+type PunchRejectionListResponse struct {
+	Rejections []PunchRejectionResponse `json:"rejections"`
+	Total      int                      `json:"total"`
+	Page       int                      `json:"page"`
+	Limit      int                      `json:"limit"`
+}
+
+// PunchRejectionQueryParams represents query parameters for rejected punch list
+type PunchRejectionQueryParams struct {
+	Page      int    `query:"page" validate:"omitempty,min=1"`
+	Limit     int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	DeviceID  uint64 `query:"device_id" validate:"omitempty"`
+	DevicePIN string `query:"device_pin" validate:"omitempty,max=32"`
+	Status    string `query:"status" validate:"omitempty,oneof=open resolved"`
+}
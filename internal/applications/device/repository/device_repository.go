@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/device"
+	"mceasy/internal/applications/device/dto"
+)
+
+// DeviceRepository defines the interface for attendance terminal data operations
+type DeviceRepository interface {
+	Create(ctx context.Context, req *dto.CreateDeviceRequest) (*ent.Device, error)
+	GetByID(ctx context.Context, id uint64) (*ent.Device, error)
+	GetBySerialNumber(ctx context.Context, serialNumber string) (*ent.Device, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateDeviceRequest) (*ent.Device, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.DeviceQueryParams) ([]*ent.Device, int, error)
+	CountPending(ctx context.Context) (int, error)
+	RecordContact(ctx context.Context, id uint64, contact *dto.DeviceContact) (*ent.Device, error)
+	RecordPush(ctx context.Context, id uint64, stamp string, lastPunchAt *time.Time, clockOffsetSeconds *int) error
+}
+
+// DeviceRepositoryImpl implements the DeviceRepository interface
+type DeviceRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewDeviceRepository creates a new attendance terminal repository instance
+func NewDeviceRepository(client *ent.Client) *DeviceRepositoryImpl {
+	return &DeviceRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create registers a new attendance terminal
+func (r *DeviceRepositoryImpl) Create(ctx context.Context, req *dto.CreateDeviceRequest) (*ent.Device, error) {
+	query := r.client.Device.Create().
+		SetSerialNumber(req.SerialNumber)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.Status != "" {
+		query = query.SetStatus(device.Status(req.Status))
+	}
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
+	if req.Timezone != "" {
+		query = query.SetTimezone(req.Timezone)
+	}
+	if len(req.AllowedNetworks) > 0 {
+		query = query.SetAllowedNetworks(req.AllowedNetworks)
+	}
+
+	created, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, created.ID)
+}
+
+// GetByID retrieves an attendance terminal by ID with its office
+func (r *DeviceRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.Device, error) {
+	return r.client.Device.
+		Query().
+		Where(device.ID(id)).
+		Where(device.DeletedAtIsNil()).
+		WithOfficeLocation().
+		First(ctx)
+}
+
+// GetBySerialNumber retrieves an attendance terminal by serial number with its office. Deleted terminals are
+// included, their serial number stays taken.
+func (r *DeviceRepositoryImpl) GetBySerialNumber(ctx context.Context, serialNumber string) (*ent.Device, error) {
+	return r.client.Device.
+		Query().
+		Where(device.SerialNumber(serialNumber)).
+		WithOfficeLocation().
+		First(ctx)
+}
+
+// Update updates an attendance terminal
+func (r *DeviceRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateDeviceRequest) (*ent.Device, error) {
+	query := r.client.Device.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.Status != "" {
+		query = query.SetStatus(device.Status(req.Status))
+	}
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
+	// An empty timezone clears it so the office timezone applies again
+	if req.Timezone != nil {
+		query = query.SetTimezone(*req.Timezone)
+	}
+	if req.AllowedNetworks != nil {
+		if len(req.AllowedNetworks) == 0 {
+			query = query.ClearAllowedNetworks()
+		} else {
+			query = query.SetAllowedNetworks(req.AllowedNetworks)
+		}
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// Delete soft deletes an attendance terminal
+func (r *DeviceRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.Device.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves attendance terminals with pagination and filtering
+func (r *DeviceRepositoryImpl) List(ctx context.Context, params *dto.DeviceQueryParams) ([]*ent.Device, int, error) {
+	query := r.client.Device.
+		Query().
+		Where(device.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Search != "" {
+		query = query.Where(
+			device.Or(
+				device.SerialNumberContainsFold(params.Search),
+				device.NameContainsFold(params.Search),
+			),
+		)
+	}
+
+	if params.Status != "" {
+		query = query.Where(device.StatusEQ(device.Status(params.Status)))
+	}
+
+	if params.OfficeLocationID > 0 {
+		query = query.Where(device.OfficeLocationID(params.OfficeLocationID))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count devices: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by serial number asc
+	query = query.Order(ent.Asc(device.FieldSerialNumber))
+
+	devices, err := query.WithOfficeLocation().All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+
+	return devices, total, nil
+}
+
+// CountPending counts the terminals that registered themselves and wait for approval
+func (r *DeviceRepositoryImpl) CountPending(ctx context.Context) (int, error) {
+	return r.client.Device.
+		Query().
+		Where(device.StatusEQ(device.StatusPending)).
+		Where(device.DeletedAtIsNil()).
+		Count(ctx)
+}
+
+// RecordContact stores when and from where a terminal was last seen and what it reported about itself
+func (r *DeviceRepositoryImpl) RecordContact(ctx context.Context, id uint64, contact *dto.DeviceContact) (*ent.Device, error) {
+	query := r.client.Device.UpdateOneID(id).
+		SetLastSeenAt(contact.SeenAt)
+
+	if contact.IPAddress != "" {
+		query = query.SetIPAddress(contact.IPAddress)
+	}
+	if contact.PushVersion != "" {
+		query = query.SetPushVersion(contact.PushVersion)
+	}
+	if contact.DeviceInfo != "" {
+		query = query.SetDeviceInfo(contact.DeviceInfo)
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.client.Device.
+		Query().
+		Where(device.ID(id)).
+		WithOfficeLocation().
+		First(ctx)
+}
+
+// RecordPush stores the stamp of an ATTLOG upload and the clock information derived from it
+func (r *DeviceRepositoryImpl) RecordPush(ctx context.Context, id uint64, stamp string, lastPunchAt *time.Time, clockOffsetSeconds *int) error {
+	query := r.client.Device.UpdateOneID(id).
+		SetNillableLastPunchAt(lastPunchAt).
+		SetNillableClockOffsetSeconds(clockOffsetSeconds)
+
+	if stamp != "" {
+		query = query.SetAttlogStamp(stamp)
+	}
+
+	return query.Exec(ctx)
+}
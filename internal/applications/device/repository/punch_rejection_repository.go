@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/devicepunchrejection"
+	"mceasy/internal/applications/device/dto"
+)
+
+// PunchRejectionRepository defines the interface for rejected terminal punch data operations
+type PunchRejectionRepository interface {
+	CreateBulk(ctx context.Context, deviceID uint64, rejections []dto.PunchRejection) (int, error)
+	GetByID(ctx context.Context, id uint64) (*ent.DevicePunchRejection, error)
+	List(ctx context.Context, params *dto.PunchRejectionQueryParams) ([]*ent.DevicePunchRejection, int, error)
+	Resolve(ctx context.Context, id uint64, req *dto.ResolvePunchRejectionRequest) (*ent.DevicePunchRejection, error)
+}
+
+// PunchRejectionRepositoryImpl implements the PunchRejectionRepository interface
+type PunchRejectionRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewPunchRejectionRepository creates a new rejected terminal punch repository instance
+func NewPunchRejectionRepository(client *ent.Client) *PunchRejectionRepositoryImpl {
+	return &PunchRejectionRepositoryImpl{
+		client: client,
+	}
+}
+
+// CreateBulk stores the rejected punches of a terminal upload, punches stored by an earlier upload are skipped.
+// It returns how many punches were stored.
+func (r *PunchRejectionRepositoryImpl) CreateBulk(ctx context.Context, deviceID uint64, rejections []dto.PunchRejection) (int, error) {
+	builders := make([]*ent.DevicePunchRejectionCreate, 0, len(rejections))
+	for _, rejection := range rejections {
+		exists, err := r.client.DevicePunchRejection.
+			Query().
+			Where(devicepunchrejection.DeviceID(deviceID)).
+			Where(devicepunchrejection.DevicePin(rejection.DevicePIN)).
+			Where(devicepunchrejection.PunchTime(rejection.PunchTime)).
+			Exist(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check rejected punch: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		builders = append(builders, r.client.DevicePunchRejection.Create().
+			SetDeviceID(deviceID).
+			SetDevicePin(rejection.DevicePIN).
+			SetPunchTime(rejection.PunchTime).
+			SetPunchState(rejection.PunchState).
+			SetVerifyMode(rejection.VerifyMode).
+			SetReason(rejection.Reason))
+	}
+	if len(builders) == 0 {
+		return 0, nil
+	}
+
+	if err := r.client.DevicePunchRejection.CreateBulk(builders...).Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(builders), nil
+}
+
+// GetByID retrieves a rejected punch by ID
+func (r *PunchRejectionRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.DevicePunchRejection, error) {
+	return r.client.DevicePunchRejection.
+		Query().
+		Where(devicepunchrejection.ID(id)).
+		Where(devicepunchrejection.DeletedAtIsNil()).
+		WithDevice().
+		First(ctx)
+}
+
+// List retrieves rejected punches with pagination and filtering, newest punches first
+func (r *PunchRejectionRepositoryImpl) List(ctx context.Context, params *dto.PunchRejectionQueryParams) ([]*ent.DevicePunchRejection, int, error) {
+	query := r.client.DevicePunchRejection.
+		Query().
+		Where(devicepunchrejection.DeletedAtIsNil())
+
+	// Apply filters
+	if params.DeviceID > 0 {
+		query = query.Where(devicepunchrejection.DeviceID(params.DeviceID))
+	}
+
+	if params.DevicePIN != "" {
+		query = query.Where(devicepunchrejection.DevicePin(params.DevicePIN))
+	}
+
+	if params.Status != "" {
+		query = query.Where(devicepunchrejection.StatusEQ(devicepunchrejection.Status(params.Status)))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count rejected punches: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	query = query.Order(ent.Desc(devicepunchrejection.FieldPunchTime), ent.Desc(devicepunchrejection.FieldID))
+
+	rejections, err := query.WithDevice().All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch rejected punches: %w", err)
+	}
+
+	return rejections, total, nil
+}
+
+// Resolve marks a rejected punch as reviewed
+func (r *PunchRejectionRepositoryImpl) Resolve(ctx context.Context, id uint64, req *dto.ResolvePunchRejectionRequest) (*ent.DevicePunchRejection, error) {
+	query := r.client.DevicePunchRejection.UpdateOneID(id).
+		SetStatus(devicepunchrejection.StatusResolved).
+		SetResolvedBy(req.ResolvedBy).
+		SetResolvedAt(time.Now())
+
+	if req.ResolutionNotes != "" {
+		query = query.SetResolutionNotes(req.ResolutionNotes)
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
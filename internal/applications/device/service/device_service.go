@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"mceasy/ent"
+	"mceasy/ent/device"
+	"mceasy/internal/applications/device/dto"
+	"mceasy/internal/applications/device/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+var (
+	// ErrDevicePending is returned for terminals that registered themselves and wait for approval
+	ErrDevicePending = errors.New("device is waiting for approval")
+
+	// ErrDeviceDisabled is returned for terminals that were disabled or deleted
+	ErrDeviceDisabled = errors.New("device is disabled")
+
+	// ErrDeviceAddressNotAllowed is returned for active terminals connecting from outside their allowed networks
+	ErrDeviceAddressNotAllowed = errors.New("device address is not allowed")
+
+	// ErrTooManyPendingDevices is returned for unknown terminals while too many terminals wait for approval
+	ErrTooManyPendingDevices = errors.New("too many devices are waiting for approval")
+)
+
+const (
+	// maxDeviceInfoLength is the length of the device_info column
+	maxDeviceInfoLength = 255
+
+	// maxPendingDevices limits how many unknown terminals may register themselves before an admin reviews them
+	maxPendingDevices = 20
+
+	// maxRealTimePushDelay is the longest a real-time push may take to count for the clock offset
+	maxRealTimePushDelay = 12 * time.Hour
+)
+
+// DeviceService defines the interface for attendance terminal business logic
+type DeviceService interface {
+	CreateDevice(ctx context.Context, req *dto.CreateDeviceRequest) (*dto.DeviceResponse, error)
+	GetDeviceByID(ctx context.Context, id uint64) (*dto.DeviceResponse, error)
+	UpdateDevice(ctx context.Context, id uint64, req *dto.UpdateDeviceRequest) (*dto.DeviceResponse, error)
+	DeleteDevice(ctx context.Context, id uint64) error
+	ListDevices(ctx context.Context, params *dto.DeviceQueryParams) (*dto.DeviceListResponse, error)
+	AuthenticateDevice(ctx context.Context, contact *dto.DeviceContact) (*dto.DeviceResponse, error)
+	RecordAttLogPush(ctx context.Context, id uint64, push *dto.DevicePush) error
+	RecordPunchRejections(ctx context.Context, id uint64, rejections []dto.PunchRejection) error
+	ListPunchRejections(ctx context.Context, params *dto.PunchRejectionQueryParams) (*dto.PunchRejectionListResponse, error)
+	ResolvePunchRejection(ctx context.Context, id uint64, req *dto.ResolvePunchRejectionRequest) (*dto.PunchRejectionResponse, error)
+}
+
+// DeviceServiceImpl implements the DeviceService interface
+type DeviceServiceImpl struct {
+	deviceRepo         repository.DeviceRepository
+	punchRejectionRepo repository.PunchRejectionRepository
+	cache              cache.Cache
+	trx                transaction.Trx
+}
+
+// NewDeviceService creates a new attendance terminal service instance
+func NewDeviceService(
+	deviceRepo repository.DeviceRepository,
+	punchRejectionRepo repository.PunchRejectionRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *DeviceServiceImpl {
+	return &DeviceServiceImpl{
+		deviceRepo:         deviceRepo,
+		punchRejectionRepo: punchRejectionRepo,
+		cache:              cache,
+		trx:                trx,
+	}
+}
+
+// CreateDevice registers an attendance terminal ahead of its first connection
+func (s *DeviceServiceImpl) CreateDevice(ctx context.Context, req *dto.CreateDeviceRequest) (*dto.DeviceResponse, error) {
+	// Serial numbers identify terminals on the push protocol
+	existing, _ := s.deviceRepo.GetBySerialNumber(ctx, req.SerialNumber)
+	if existing != nil {
+		return nil, fmt.Errorf("device with serial number %s already exists", req.SerialNumber)
+	}
+
+	// A terminal registered by an admin is trusted right away
+	if req.Status == "" {
+		req.Status = string(device.StatusActive)
+	}
+
+	record, err := s.deviceRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create device: %w", err)
+	}
+
+	return s.mapToDeviceResponse(record), nil
+}
+
+// GetDeviceByID retrieves an attendance terminal by ID
+func (s *DeviceServiceImpl) GetDeviceByID(ctx context.Context, id uint64) (*dto.DeviceResponse, error) {
+	record, err := s.deviceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("device not found: %w", err)
+	}
+
+	return s.mapToDeviceResponse(record), nil
+}
+
+// UpdateDevice updates an attendance terminal, approving a pending terminal sets it active
+func (s *DeviceServiceImpl) UpdateDevice(ctx context.Context, id uint64, req *dto.UpdateDeviceRequest) (*dto.DeviceResponse, error) {
+	// Check if device exists
+	existing, err := s.deviceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("device not found: %w", err)
+	}
+
+	// An approved terminal may push from the address it registered from unless other networks are given
+	if existing.Status == device.StatusPending && req.Status == string(device.StatusActive) &&
+		req.AllowedNetworks == nil && len(existing.AllowedNetworks) == 0 && existing.IPAddress != "" {
+		req.AllowedNetworks = []string{existing.IPAddress}
+	}
+
+	record, err := s.deviceRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update device: %w", err)
+	}
+
+	return s.mapToDeviceResponse(record), nil
+}
+
+// DeleteDevice soft deletes an attendance terminal, its pushes are refused afterwards
+func (s *DeviceServiceImpl) DeleteDevice(ctx context.Context, id uint64) error {
+	// Check if device exists
+	_, err := s.deviceRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("device not found: %w", err)
+	}
+
+	err = s.deviceRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+
+	return nil
+}
+
+// ListDevices retrieves attendance terminals with pagination and filtering
+func (s *DeviceServiceImpl) ListDevices(ctx context.Context, params *dto.DeviceQueryParams) (*dto.DeviceListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	devices, total, err := s.deviceRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	deviceResponses := make([]dto.DeviceResponse, len(devices))
+	for i, record := range devices {
+		deviceResponses[i] = *s.mapToDeviceResponse(record)
+	}
+
+	return &dto.DeviceListResponse{
+		Devices: deviceResponses,
+		Total:   total,
+		Page:    params.Page,
+		Limit:   params.Limit,
+	}, nil
+}
+
+// AuthenticateDevice identifies a terminal by serial number and records that it was seen. Unknown terminals
+// register themselves as pending up to maxPendingDevices, only active terminals connecting from one of their
+// allowed networks are accepted.
+func (s *DeviceServiceImpl) AuthenticateDevice(ctx context.Context, contact *dto.DeviceContact) (*dto.DeviceResponse, error) {
+	contact.DeviceInfo = truncateDeviceInfo(contact.DeviceInfo)
+
+	record, err := s.deviceRepo.GetBySerialNumber(ctx, contact.SerialNumber)
+	if ent.IsNotFound(err) {
+		record, err = s.registerPendingDevice(ctx, contact.SerialNumber)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+
+	if !record.DeletedAt.IsZero() {
+		return nil, ErrDeviceDisabled
+	}
+
+	// The serial number is printed on the terminal, so it alone does not prove a request comes from it. A
+	// refused request leaves the terminal record as it was.
+	if record.Status == device.StatusActive && !addressAllowed(record.AllowedNetworks, contact.IPAddress) {
+		return nil, ErrDeviceAddressNotAllowed
+	}
+
+	record, err = s.deviceRepo.RecordContact(ctx, record.ID, contact)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record device contact: %w", err)
+	}
+
+	switch record.Status {
+	case device.StatusPending:
+		return nil, ErrDevicePending
+	case device.StatusDisabled:
+		return nil, ErrDeviceDisabled
+	}
+
+	return s.mapToDeviceResponse(record), nil
+}
+
+// registerPendingDevice registers an unknown terminal to wait for approval
+func (s *DeviceServiceImpl) registerPendingDevice(ctx context.Context, serialNumber string) (*ent.Device, error) {
+	pending, err := s.deviceRepo.CountPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pending devices: %w", err)
+	}
+	if pending >= maxPendingDevices {
+		return nil, ErrTooManyPendingDevices
+	}
+
+	record, err := s.deviceRepo.Create(ctx, &dto.CreateDeviceRequest{
+		SerialNumber: serialNumber,
+		Status:       string(device.StatusPending),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register device: %w", err)
+	}
+
+	return record, nil
+}
+
+// truncateDeviceInfo fits what a terminal reports about itself into the device_info column without splitting
+// a character
+func truncateDeviceInfo(info string) string {
+	info = strings.ToValidUTF8(info, "")
+	if len(info) <= maxDeviceInfoLength {
+		return info
+	}
+
+	cut := maxDeviceInfoLength
+	for cut > 0 && !utf8.RuneStart(info[cut]) {
+		cut--
+	}
+	return info[:cut]
+}
+
+// addressAllowed reports whether an address is one of the allowed IP addresses or within one of the allowed
+// CIDR ranges
+func addressAllowed(networks []string, address string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, network := range networks {
+		if strings.Contains(network, "/") {
+			if prefix, err := netip.ParsePrefix(network); err == nil && prefix.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if allowed, err := netip.ParseAddr(network); err == nil && allowed.Unmap() == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RecordAttLogPush stores the stamp of an ATTLOG upload and the terminal clock information. Terminals push a
+// single punch right after it happened, so its delay approximates how far the terminal clock is off.
+func (s *DeviceServiceImpl) RecordAttLogPush(ctx context.Context, id uint64, push *dto.DevicePush) error {
+	var lastPunchAt *time.Time
+	var clockOffset *int
+	if !push.NewestPunch.IsZero() {
+		lastPunchAt = &push.NewestPunch
+
+		offset := push.NewestPunch.Sub(push.ReceivedAt)
+		if push.Punches == 1 && offset > -maxRealTimePushDelay && offset < maxRealTimePushDelay {
+			seconds := int(offset / time.Second)
+			clockOffset = &seconds
+		}
+	}
+
+	if err := s.deviceRepo.RecordPush(ctx, id, push.Stamp, lastPunchAt, clockOffset); err != nil {
+		return fmt.Errorf("failed to record device push: %w", err)
+	}
+
+	return nil
+}
+
+// mapToDeviceResponse maps an ent.Device to dto.DeviceResponse
+func (s *DeviceServiceImpl) mapToDeviceResponse(record *ent.Device) *dto.DeviceResponse {
+	response := &dto.DeviceResponse{
+		ID:                 record.ID,
+		SerialNumber:       record.SerialNumber,
+		Name:               record.Name,
+		Status:             string(record.Status),
+		OfficeLocationID:   record.OfficeLocationID,
+		Timezone:           record.Timezone,
+		PushVersion:        record.PushVersion,
+		DeviceInfo:         record.DeviceInfo,
+		IPAddress:          record.IPAddress,
+		AllowedNetworks:    record.AllowedNetworks,
+		AttLogStamp:        record.AttlogStamp,
+		LastSeenAt:         record.LastSeenAt,
+		LastPunchAt:        record.LastPunchAt,
+		ClockOffsetSeconds: record.ClockOffsetSeconds,
+		CreatedAt:          record.CreatedAt,
+		ModifiedAt:         record.ModifiedAt,
+	}
+
+	if record.Edges.OfficeLocation != nil {
+		response.OfficeTimezone = record.Edges.OfficeLocation.Timezone
+	}
+
+	return response
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"mceasy/internal/applications/device/dto"
+	"mceasy/internal/applications/device/repository"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceServiceImpl_AuthenticateDevice(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	deviceService := NewDeviceService(
+		repository.NewDeviceRepository(client),
+		repository.NewPunchRejectionRepository(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	seenAt := time.Date(2025, time.August, 11, 8, 0, 0, 0, time.UTC)
+	contact := func(serialNumber, address string) *dto.DeviceContact {
+		return &dto.DeviceContact{SerialNumber: serialNumber, IPAddress: address, SeenAt: seenAt}
+	}
+
+	t.Run("approved terminals push from their allowed networks only", func(t *testing.T) {
+		_, err := deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "10.1.2.30"))
+		require.ErrorIs(t, err, ErrDevicePending)
+
+		registered, err := deviceService.ListDevices(ctx, &dto.DeviceQueryParams{Search: "CKJX201960001"})
+		require.NoError(t, err)
+		require.Len(t, registered.Devices, 1)
+
+		// Approving the terminal allows the address it registered from
+		approved, err := deviceService.UpdateDevice(ctx, registered.Devices[0].ID, &dto.UpdateDeviceRequest{Status: "active"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.1.2.30"}, approved.AllowedNetworks)
+
+		_, err = deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "10.1.2.30"))
+		require.NoError(t, err)
+
+		// Somebody else sending the serial number printed on the terminal is refused
+		_, err = deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "203.0.113.9"))
+		require.ErrorIs(t, err, ErrDeviceAddressNotAllowed)
+
+		_, err = deviceService.UpdateDevice(ctx, approved.ID, &dto.UpdateDeviceRequest{AllowedNetworks: []string{"10.1.2.0/24", "203.0.113.9"}})
+		require.NoError(t, err)
+		_, err = deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "10.1.2.31"))
+		require.NoError(t, err)
+		_, err = deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "203.0.113.9"))
+		require.NoError(t, err)
+
+		// Without any allowed network the terminal is refused
+		_, err = deviceService.UpdateDevice(ctx, approved.ID, &dto.UpdateDeviceRequest{AllowedNetworks: []string{}})
+		require.NoError(t, err)
+		_, err = deviceService.AuthenticateDevice(ctx, contact("CKJX201960001", "10.1.2.30"))
+		require.ErrorIs(t, err, ErrDeviceAddressNotAllowed)
+	})
+
+	t.Run("device info is truncated on a character boundary", func(t *testing.T) {
+		_, err := deviceService.CreateDevice(ctx, &dto.CreateDeviceRequest{SerialNumber: "CKJX201960002", AllowedNetworks: []string{"10.1.2.30"}})
+		require.NoError(t, err)
+
+		info := contact("CKJX201960002", "10.1.2.30")
+		info.DeviceInfo = "Ver 6.60" + strings.Repeat("ü", 200)
+		device, err := deviceService.AuthenticateDevice(ctx, info)
+		require.NoError(t, err)
+		assert.True(t, utf8.ValidString(device.DeviceInfo))
+		assert.Equal(t, 254, len(device.DeviceInfo))
+	})
+
+	t.Run("unknown terminals stop registering once too many wait for approval", func(t *testing.T) {
+		for i := 0; i < maxPendingDevices; i++ {
+			_, err := deviceService.AuthenticateDevice(ctx, contact(fmt.Sprintf("PENDING%02d", i), "198.51.100.7"))
+			require.ErrorIs(t, err, ErrDevicePending)
+		}
+
+		_, err := deviceService.AuthenticateDevice(ctx, contact("PENDING99", "198.51.100.7"))
+		require.ErrorIs(t, err, ErrTooManyPendingDevices)
+
+		// Terminals that already registered keep being recognised
+		_, err = deviceService.AuthenticateDevice(ctx, contact("PENDING00", "198.51.100.7"))
+		require.ErrorIs(t, err, ErrDevicePending)
+	})
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/device/dto"
+)
+
+// RecordPunchRejections stores the punches of a terminal upload that could not be recorded so HR can review them
+func (s *DeviceServiceImpl) RecordPunchRejections(ctx context.Context, id uint64, rejections []dto.PunchRejection) error {
+	if len(rejections) == 0 {
+		return nil
+	}
+
+	if _, err := s.punchRejectionRepo.CreateBulk(ctx, id, rejections); err != nil {
+		return fmt.Errorf("failed to store rejected punches: %w", err)
+	}
+
+	return nil
+}
+
+// ListPunchRejections retrieves rejected terminal punches with pagination and filtering
+func (s *DeviceServiceImpl) ListPunchRejections(ctx context.Context, params *dto.PunchRejectionQueryParams) (*dto.PunchRejectionListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	rejections, total, err := s.punchRejectionRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rejected punches: %w", err)
+	}
+
+	responses := make([]dto.PunchRejectionResponse, len(rejections))
+	for i, rejection := range rejections {
+		responses[i] = *s.mapToPunchRejectionResponse(rejection)
+	}
+
+	return &dto.PunchRejectionListResponse{
+		Rejections: responses,
+		Total:      total,
+		Page:       params.Page,
+		Limit:      params.Limit,
+	}, nil
+}
+
+// ResolvePunchRejection marks a rejected punch as reviewed, e.g. after HR entered the attendance by hand
+func (s *DeviceServiceImpl) ResolvePunchRejection(ctx context.Context, id uint64, req *dto.ResolvePunchRejectionRequest) (*dto.PunchRejectionResponse, error) {
+	if _, err := s.punchRejectionRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("rejected punch not found: %w", err)
+	}
+
+	rejection, err := s.punchRejectionRepo.Resolve(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve rejected punch: %w", err)
+	}
+
+	return s.mapToPunchRejectionResponse(rejection), nil
+}
+
+// mapToPunchRejectionResponse maps an ent.DevicePunchRejection to dto.PunchRejectionResponse
+func (s *DeviceServiceImpl) mapToPunchRejectionResponse(record *ent.DevicePunchRejection) *dto.PunchRejectionResponse {
+	response := &dto.PunchRejectionResponse{
+		ID:              record.ID,
+		DeviceID:        record.DeviceID,
+		DevicePIN:       record.DevicePin,
+		PunchTime:       record.PunchTime,
+		PunchState:      record.PunchState,
+		VerifyMode:      record.VerifyMode,
+		Reason:          record.Reason,
+		Status:          string(record.Status),
+		ResolvedBy:      record.ResolvedBy,
+		ResolvedAt:      record.ResolvedAt,
+		ResolutionNotes: record.ResolutionNotes,
+		CreatedAt:       record.CreatedAt,
+	}
+
+	if record.Edges.Device != nil {
+		response.DeviceSerialNumber = record.Edges.Device.SerialNumber
+	}
+
+	return response
+}
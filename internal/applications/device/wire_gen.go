@@ -0,0 +1,32 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package device
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/device/repository"
+	"mceasy/internal/applications/device/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from device_injector.go:
+
+func InitializedDeviceService(dbClient *ent.Client, redisClient *redis.Client) *service.DeviceServiceImpl {
+	deviceRepositoryImpl := repository.NewDeviceRepository(dbClient)
+	punchRejectionRepositoryImpl := repository.NewPunchRejectionRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	deviceServiceImpl := service.NewDeviceService(deviceRepositoryImpl, punchRejectionRepositoryImpl, cacheImpl, trxImpl)
+	return deviceServiceImpl
+}
+
+// device_injector.go:
+
+var providerDevice = wire.NewSet(repository.NewDeviceRepository, repository.NewPunchRejectionRepository, transaction.NewTrx, service.NewDeviceService, cache.NewCache, wire.Bind(new(repository.DeviceRepository), new(*repository.DeviceRepositoryImpl)), wire.Bind(new(repository.PunchRejectionRepository), new(*repository.PunchRejectionRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.DeviceService), new(*service.DeviceServiceImpl)))
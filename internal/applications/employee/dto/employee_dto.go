@@ -2,45 +2,65 @@ package dto
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // CreateEmployeeRequest represents the request to create a new employee
 type CreateEmployeeRequest struct {
-	FullName   string    `json:"full_name" validate:"required,min=2,max=255"`
-	Email      string    `json:"email" validate:"required,email,max=255"`
-	Phone      string    `json:"phone,omitempty" validate:"omitempty,max=20"`
-	Position   string    `json:"position,omitempty" validate:"omitempty,max=100"`
-	Department string    `json:"department,omitempty" validate:"omitempty,max=100"`
-	HireDate   time.Time `json:"hire_date" validate:"required"`
-	BaseSalary float64   `json:"base_salary,omitempty" validate:"omitempty,min=0"`
+	FullName         string          `json:"full_name" validate:"required,min=2,max=255"`
+	Email            string          `json:"email" validate:"required,email,max=255"`
+	Phone            string          `json:"phone,omitempty" validate:"omitempty,max=20"`
+	Position         string          `json:"position,omitempty" validate:"omitempty,max=100"`
+	Department       string          `json:"department,omitempty" validate:"omitempty,max=100"`
+	HireDate         time.Time       `json:"hire_date" validate:"required"`
+	BaseSalary       decimal.Decimal `json:"base_salary,omitempty" validate:"omitempty,min=0"`
+	OfficeLocationID *uint64         `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	Timezone         string          `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	DevicePIN        string          `json:"device_pin,omitempty" validate:"omitempty,max=32"`
+	PTKPStatus       string          `json:"ptkp_status,omitempty" validate:"omitempty,oneof=TK/0 TK/1 TK/2 TK/3 K/0 K/1 K/2 K/3"`
+	NPWP             string          `json:"npwp,omitempty" validate:"omitempty,numeric,min=15,max=16"`
+	JKKRiskClass     int             `json:"jkk_risk_class,omitempty" validate:"omitempty,min=1,max=5"`
 }
 
 // UpdateEmployeeRequest represents the request to update an employee
 type UpdateEmployeeRequest struct {
-	FullName   string    `json:"full_name,omitempty" validate:"omitempty,min=2,max=255"`
-	Email      string    `json:"email,omitempty" validate:"omitempty,email,max=255"`
-	Phone      string    `json:"phone,omitempty" validate:"omitempty,max=20"`
-	Position   string    `json:"position,omitempty" validate:"omitempty,max=100"`
-	Department string    `json:"department,omitempty" validate:"omitempty,max=100"`
-	HireDate   time.Time `json:"hire_date,omitempty"`
-	BaseSalary float64   `json:"base_salary,omitempty" validate:"omitempty,min=0"`
-	IsActive   *bool     `json:"is_active,omitempty"`
+	FullName         string          `json:"full_name,omitempty" validate:"omitempty,min=2,max=255"`
+	Email            string          `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Phone            string          `json:"phone,omitempty" validate:"omitempty,max=20"`
+	Position         string          `json:"position,omitempty" validate:"omitempty,max=100"`
+	Department       string          `json:"department,omitempty" validate:"omitempty,max=100"`
+	HireDate         time.Time       `json:"hire_date,omitempty"`
+	BaseSalary       decimal.Decimal `json:"base_salary,omitempty" validate:"omitempty,min=0"`
+	IsActive         *bool           `json:"is_active,omitempty"`
+	OfficeLocationID *uint64         `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	Timezone         *string         `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	DevicePIN        *string         `json:"device_pin,omitempty" validate:"omitempty,max=32"`
+	PTKPStatus       string          `json:"ptkp_status,omitempty" validate:"omitempty,oneof=TK/0 TK/1 TK/2 TK/3 K/0 K/1 K/2 K/3"`
+	NPWP             *string         `json:"npwp,omitempty" validate:"omitempty,numeric,min=15,max=16"`
+	JKKRiskClass     int             `json:"jkk_risk_class,omitempty" validate:"omitempty,min=1,max=5"`
 }
 
 // EmployeeResponse represents the employee response structure
 type EmployeeResponse struct {
-	ID         uint64    `json:"id"`
-	EmployeeID string    `json:"employee_id"`
-	FullName   string    `json:"full_name"`
-	Email      string    `json:"email"`
-	Phone      string    `json:"phone,omitempty"`
-	Position   string    `json:"position,omitempty"`
-	Department string    `json:"department,omitempty"`
-	HireDate   time.Time `json:"hire_date"`
-	BaseSalary float64   `json:"base_salary"`
-	IsActive   bool      `json:"is_active"`
-	CreatedAt  time.Time `json:"created_at"`
-	ModifiedAt time.Time `json:"modified_at"`
+	ID               uint64          `json:"id"`
+	EmployeeID       string          `json:"employee_id"`
+	FullName         string          `json:"full_name"`
+	Email            string          `json:"email"`
+	Phone            string          `json:"phone,omitempty"`
+	Position         string          `json:"position,omitempty"`
+	Department       string          `json:"department,omitempty"`
+	HireDate         time.Time       `json:"hire_date"`
+	BaseSalary       decimal.Decimal `json:"base_salary"`
+	IsActive         bool            `json:"is_active"`
+	OfficeLocationID *uint64         `json:"office_location_id,omitempty"`
+	Timezone         string          `json:"timezone,omitempty"`
+	DevicePIN        *string         `json:"device_pin,omitempty"`
+	PTKPStatus       string          `json:"ptkp_status"`
+	NPWP             string          `json:"npwp,omitempty"`
+	JKKRiskClass     int             `json:"jkk_risk_class"`
+	CreatedAt        time.Time       `json:"created_at"`
+	ModifiedAt       time.Time       `json:"modified_at"`
 }
 
 // EmployeeListResponse represents the response for employee list
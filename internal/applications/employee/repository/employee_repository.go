@@ -15,6 +15,7 @@ type EmployeeRepository interface {
 	Create(ctx context.Context, req *dto.CreateEmployeeRequest) (*ent.Employee, error)
 	GetByID(ctx context.Context, id uint64) (*ent.Employee, error)
 	GetByEmployeeID(ctx context.Context, employeeID string) (*ent.Employee, error)
+	GetByDevicePIN(ctx context.Context, pin string) (*ent.Employee, error)
 	Update(ctx context.Context, id uint64, req *dto.UpdateEmployeeRequest) (*ent.Employee, error)
 	Delete(ctx context.Context, id uint64) error
 	List(ctx context.Context, params *dto.EmployeeQueryParams) ([]*ent.Employee, int, error)
@@ -49,9 +50,27 @@ func (r *EmployeeRepositoryImpl) Create(ctx context.Context, req *dto.CreateEmpl
 	if req.Department != "" {
 		query = query.SetDepartment(req.Department)
 	}
-	if req.BaseSalary > 0 {
+	if req.BaseSalary.IsPositive() {
 		query = query.SetBaseSalary(req.BaseSalary)
 	}
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
+	if req.Timezone != "" {
+		query = query.SetTimezone(req.Timezone)
+	}
+	if req.DevicePIN != "" {
+		query = query.SetDevicePin(req.DevicePIN)
+	}
+	if req.PTKPStatus != "" {
+		query = query.SetPtkpStatus(employee.PtkpStatus(req.PTKPStatus))
+	}
+	if req.NPWP != "" {
+		query = query.SetNpwp(req.NPWP)
+	}
+	if req.JKKRiskClass > 0 {
+		query = query.SetJkkRiskClass(req.JKKRiskClass)
+	}
 
 	return query.Save(ctx)
 }
@@ -74,6 +93,15 @@ func (r *EmployeeRepositoryImpl) GetByEmployeeID(ctx context.Context, employeeID
 		First(ctx)
 }
 
+// GetByDevicePIN retrieves an employee by the user PIN enrolled on the fingerprint terminals
+func (r *EmployeeRepositoryImpl) GetByDevicePIN(ctx context.Context, pin string) (*ent.Employee, error) {
+	return r.client.Employee.
+		Query().
+		Where(employee.DevicePin(pin)).
+		Where(employee.DeletedAtIsNil()).
+		First(ctx)
+}
+
 // Update updates an employee
 func (r *EmployeeRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateEmployeeRequest) (*ent.Employee, error) {
 	query := r.client.Employee.UpdateOneID(id)
@@ -96,12 +124,37 @@ func (r *EmployeeRepositoryImpl) Update(ctx context.Context, id uint64, req *dto
 	if !req.HireDate.IsZero() {
 		query = query.SetHireDate(req.HireDate)
 	}
-	if req.BaseSalary > 0 {
+	if req.BaseSalary.IsPositive() {
 		query = query.SetBaseSalary(req.BaseSalary)
 	}
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
 	if req.IsActive != nil {
 		query = query.SetIsActive(*req.IsActive)
 	}
+	// An empty timezone clears the override so the office timezone applies again
+	if req.Timezone != nil {
+		query = query.SetTimezone(*req.Timezone)
+	}
+	// An empty device PIN unlinks the employee from the terminals
+	if req.DevicePIN != nil {
+		if *req.DevicePIN == "" {
+			query = query.ClearDevicePin()
+		} else {
+			query = query.SetDevicePin(*req.DevicePIN)
+		}
+	}
+	if req.PTKPStatus != "" {
+		query = query.SetPtkpStatus(employee.PtkpStatus(req.PTKPStatus))
+	}
+	// An empty NPWP clears it, the tax is withheld with the non-NPWP surcharge again
+	if req.NPWP != nil {
+		query = query.SetNpwp(*req.NPWP)
+	}
+	if req.JKKRiskClass > 0 {
+		query = query.SetJkkRiskClass(req.JKKRiskClass)
+	}
 
 	return query.Save(ctx)
 }
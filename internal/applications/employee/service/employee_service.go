@@ -9,6 +9,8 @@ import (
 	"mceasy/internal/applications/employee/repository"
 	"mceasy/internal/component/cache"
 	"mceasy/internal/component/transaction"
+
+	"github.com/shopspring/decimal"
 )
 
 // EmployeeService defines the interface for employee business logic
@@ -49,9 +51,17 @@ func (s *EmployeeServiceImpl) CreateEmployee(ctx context.Context, req *dto.Creat
 		return nil, fmt.Errorf("employee with email %s already exists", req.Email)
 	}
 
+	// Punch logs of the terminals are matched by PIN, so a PIN belongs to one employee
+	if req.DevicePIN != "" {
+		existingEmployee, _ := s.employeeRepo.GetByDevicePIN(ctx, req.DevicePIN)
+		if existingEmployee != nil {
+			return nil, fmt.Errorf("device PIN %s is already assigned to employee %d", req.DevicePIN, existingEmployee.ID)
+		}
+	}
+
 	// Set default base salary if not provided
-	if req.BaseSalary == 0 {
-		req.BaseSalary = 10000000.00 // Default IDR 10,000,000
+	if req.BaseSalary.IsZero() {
+		req.BaseSalary = decimal.NewFromInt(10000000) // Default IDR 10,000,000
 	}
 
 	employee, err := s.employeeRepo.Create(ctx, req)
@@ -98,6 +108,13 @@ func (s *EmployeeServiceImpl) UpdateEmployee(ctx context.Context, id uint64, req
 		}
 	}
 
+	if req.DevicePIN != nil && *req.DevicePIN != "" {
+		existingEmployee, _ := s.employeeRepo.GetByDevicePIN(ctx, *req.DevicePIN)
+		if existingEmployee != nil && existingEmployee.ID != id {
+			return nil, fmt.Errorf("device PIN %s is already assigned to employee %d", *req.DevicePIN, existingEmployee.ID)
+		}
+	}
+
 	employee, err := s.employeeRepo.Update(ctx, id, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update employee: %w", err)
@@ -153,17 +170,23 @@ func (s *EmployeeServiceImpl) ListEmployees(ctx context.Context, params *dto.Emp
 // mapToEmployeeResponse maps an ent.Employee to dto.EmployeeResponse
 func (s *EmployeeServiceImpl) mapToEmployeeResponse(employee *ent.Employee) *dto.EmployeeResponse {
 	return &dto.EmployeeResponse{
-		ID:         employee.ID,
-		EmployeeID: employee.EmployeeID,
-		FullName:   employee.FullName,
-		Email:      employee.Email,
-		Phone:      employee.Phone,
-		Position:   employee.Position,
-		Department: employee.Department,
-		HireDate:   employee.HireDate,
-		BaseSalary: employee.BaseSalary,
-		IsActive:   employee.IsActive,
-		CreatedAt:  employee.CreatedAt,
-		ModifiedAt: employee.ModifiedAt,
+		ID:               employee.ID,
+		EmployeeID:       employee.EmployeeID,
+		FullName:         employee.FullName,
+		Email:            employee.Email,
+		Phone:            employee.Phone,
+		Position:         employee.Position,
+		Department:       employee.Department,
+		HireDate:         employee.HireDate,
+		BaseSalary:       employee.BaseSalary,
+		IsActive:         employee.IsActive,
+		OfficeLocationID: employee.OfficeLocationID,
+		Timezone:         employee.Timezone,
+		DevicePIN:        employee.DevicePin,
+		PTKPStatus:       string(employee.PtkpStatus),
+		NPWP:             employee.Npwp,
+		JKKRiskClass:     employee.JkkRiskClass,
+		CreatedAt:        employee.CreatedAt,
+		ModifiedAt:       employee.ModifiedAt,
 	}
 }
@@ -0,0 +1,270 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"mceasy/internal/applications/holiday/dto"
+	"mceasy/internal/applications/holiday/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HolidayController handles HTTP requests for holiday operations
+type HolidayController struct {
+	holidayService service.HolidayService
+}
+
+// NewHolidayController creates a new holiday controller instance
+func NewHolidayController(holidayService service.HolidayService) *HolidayController {
+	return &HolidayController{
+		holidayService: holidayService,
+	}
+}
+
+// CreateHoliday registers a new holiday
+// @Summary Create a new holiday
+// @Description Register a public holiday, cuti bersama or company holiday
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param holiday body dto.CreateHolidayRequest true "Holiday data"
+// @Success 201 {object} dto.HolidayResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /holidays [post]
+func (c *HolidayController) CreateHoliday(ctx echo.Context) error {
+	var req dto.CreateHolidayRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	holiday, err := c.holidayService.CreateHoliday(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create holiday",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, holiday)
+}
+
+// GetHoliday retrieves a holiday by ID
+// @Summary Get holiday by ID
+// @Description Get holiday details by ID
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param id path int true "Holiday ID"
+// @Success 200 {object} dto.HolidayResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /holidays/{id} [get]
+func (c *HolidayController) GetHoliday(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid holiday ID",
+			"message": "Holiday ID must be a valid number",
+		})
+	}
+
+	holiday, err := c.holidayService.GetHolidayByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Holiday not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, holiday)
+}
+
+// UpdateHoliday updates a holiday
+// @Summary Update holiday
+// @Description Update holiday details
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param id path int true "Holiday ID"
+// @Param holiday body dto.UpdateHolidayRequest true "Holiday data"
+// @Success 200 {object} dto.HolidayResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /holidays/{id} [put]
+func (c *HolidayController) UpdateHoliday(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid holiday ID",
+			"message": "Holiday ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateHolidayRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	holiday, err := c.holidayService.UpdateHoliday(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update holiday",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, holiday)
+}
+
+// DeleteHoliday deletes a holiday
+// @Summary Delete holiday
+// @Description Soft delete a holiday
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param id path int true "Holiday ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /holidays/{id} [delete]
+func (c *HolidayController) DeleteHoliday(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid holiday ID",
+			"message": "Holiday ID must be a valid number",
+		})
+	}
+
+	err = c.holidayService.DeleteHoliday(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete holiday",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Holiday deleted successfully",
+	})
+}
+
+// ListHolidays retrieves holidays with pagination and filtering
+// @Summary List holidays
+// @Description Get list of holidays with pagination and filtering
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param year query int false "Year filter"
+// @Param start_date query string false "Start date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Param holiday_type query string false "Holiday type filter" Enums(national, cuti_bersama, company)
+// @Success 200 {object} dto.HolidayListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /holidays [get]
+func (c *HolidayController) ListHolidays(ctx echo.Context) error {
+	var params dto.HolidayQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	holidays, err := c.holidayService.ListHolidays(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list holidays",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, holidays)
+}
+
+// GetWorkingDays retrieves working day information for a date range
+// @Summary Get working days
+// @Description Count working days (excluding weekends and holidays) within a date range
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Param start_date query string true "Start date (YYYY-MM-DD)"
+// @Param end_date query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.WorkingDaysResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /holidays/working-days [get]
+func (c *HolidayController) GetWorkingDays(ctx echo.Context) error {
+	startDateStr := ctx.QueryParam("start_date")
+	endDateStr := ctx.QueryParam("end_date")
+
+	if startDateStr == "" || endDateStr == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Missing required parameters",
+			"message": "start_date and end_date parameters are required",
+		})
+	}
+
+	startDate, err := time.Parse("2006-01-02", startDateStr)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid start date format",
+			"message": "Start date must be in YYYY-MM-DD format",
+		})
+	}
+
+	endDate, err := time.Parse("2006-01-02", endDateStr)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid end date format",
+			"message": "End date must be in YYYY-MM-DD format",
+		})
+	}
+
+	workingDays, err := c.holidayService.GetWorkingDays(ctx.Request().Context(), startDate, endDate)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to get working days",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, workingDays)
+}
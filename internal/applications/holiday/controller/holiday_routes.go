@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterHolidayRoutes registers all holiday routes
+func RegisterHolidayRoutes(e *echo.Group, controller *HolidayController) {
+	// Working day calendar
+	e.GET("/holidays/working-days", controller.GetWorkingDays)
+
+	// Holiday management routes
+	e.POST("/holidays", controller.CreateHoliday)
+	e.GET("/holidays", controller.ListHolidays)
+	e.GET("/holidays/:id", controller.GetHoliday)
+	e.PUT("/holidays/:id", controller.UpdateHoliday)
+	e.DELETE("/holidays/:id", controller.DeleteHoliday)
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateHolidayRequest represents the request to register a holiday
+type CreateHolidayRequest struct {
+	HolidayDate string `json:"holiday_date" validate:"required,datetime=2006-01-02"`
+	Name        string `json:"name" validate:"required,min=2,max=255"`
+	HolidayType string `json:"holiday_type,omitempty" validate:"omitempty,oneof=national cuti_bersama company"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// UpdateHolidayRequest represents the request to update a holiday
+type UpdateHolidayRequest struct {
+	HolidayDate string `json:"holiday_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Name        string `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	HolidayType string `json:"holiday_type,omitempty" validate:"omitempty,oneof=national cuti_bersama company"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// HolidayResponse represents the holiday response structure
+type HolidayResponse struct {
+	ID          uint64    `json:"id"`
+	HolidayDate time.Time `json:"holiday_date"`
+	Name        string    `json:"name"`
+	HolidayType string    `json:"holiday_type"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	ModifiedAt  time.Time `json:"modified_at"`
+}
+
+// HolidayListResponse represents the response for holiday list
+type HolidayListResponse struct {
+	Holidays []HolidayResponse `json:"holidays"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	Limit    int               `json:"limit"`
+}
+
+// HolidayQueryParams represents query parameters for holiday list
+type HolidayQueryParams struct {
+	Page        int    `query:"page" validate:"omitempty,min=1"`
+	Limit       int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Year        int    `query:"year" validate:"omitempty,min=1900,max=9999"`
+	StartDate   string `query:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate     string `query:"end_date" validate:"omitempty,datetime=2006-01-02"`
+	HolidayType string `query:"holiday_type" validate:"omitempty,oneof=national cuti_bersama company"`
+}
+
+// WorkingDaysResponse represents working day information for a date range
+type WorkingDaysResponse struct {
+	StartDate        time.Time         `json:"start_date"`
+	EndDate          time.Time         `json:"end_date"`
+	TotalWorkingDays int               `json:"total_working_days"`
+	Holidays         []HolidayResponse `json:"holidays"`
+}
@@ -0,0 +1,36 @@
+//go:generate wire
+//go:build wireinject
+// +build wireinject
+
+package holiday
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/holiday/repository"
+	"mceasy/internal/applications/holiday/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerHoliday = wire.NewSet(
+	repository.NewHolidayRepository,
+	calendar.NewWorkingDayCalendar,
+	transaction.NewTrx,
+	service.NewHolidayService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.HolidayRepository), new(*repository.HolidayRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.HolidayService), new(*service.HolidayServiceImpl)),
+)
+
+func InitializedHolidayService(dbClient *ent.Client, redisClient *redis.Client) *service.HolidayServiceImpl {
+	wire.Build(providerHoliday)
+	return nil
+}
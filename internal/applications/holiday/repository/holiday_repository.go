@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/holiday"
+	"mceasy/internal/applications/holiday/dto"
+)
+
+// HolidayRepository defines the interface for holiday data operations
+type HolidayRepository interface {
+	Create(ctx context.Context, holidayDate time.Time, req *dto.CreateHolidayRequest) (*ent.Holiday, error)
+	GetByID(ctx context.Context, id uint64) (*ent.Holiday, error)
+	Update(ctx context.Context, id uint64, holidayDate time.Time, req *dto.UpdateHolidayRequest) (*ent.Holiday, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.HolidayQueryParams, startDate, endDate time.Time) ([]*ent.Holiday, int, error)
+}
+
+// HolidayRepositoryImpl implements the HolidayRepository interface
+type HolidayRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewHolidayRepository creates a new holiday repository instance
+func NewHolidayRepository(client *ent.Client) *HolidayRepositoryImpl {
+	return &HolidayRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create creates a new holiday
+func (r *HolidayRepositoryImpl) Create(ctx context.Context, holidayDate time.Time, req *dto.CreateHolidayRequest) (*ent.Holiday, error) {
+	query := r.client.Holiday.Create().
+		SetHolidayDate(holidayDate).
+		SetName(req.Name)
+
+	if req.HolidayType != "" {
+		query = query.SetHolidayType(holiday.HolidayType(req.HolidayType))
+	}
+	if req.Description != "" {
+		query = query.SetDescription(req.Description)
+	}
+
+	return query.Save(ctx)
+}
+
+// GetByID retrieves a holiday by ID
+func (r *HolidayRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.Holiday, error) {
+	return r.client.Holiday.
+		Query().
+		Where(holiday.ID(id)).
+		Where(holiday.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// Update updates a holiday, a zero holidayDate keeps the current date
+func (r *HolidayRepositoryImpl) Update(ctx context.Context, id uint64, holidayDate time.Time, req *dto.UpdateHolidayRequest) (*ent.Holiday, error) {
+	query := r.client.Holiday.UpdateOneID(id)
+
+	if !holidayDate.IsZero() {
+		query = query.SetHolidayDate(holidayDate)
+	}
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.HolidayType != "" {
+		query = query.SetHolidayType(holiday.HolidayType(req.HolidayType))
+	}
+	if req.Description != "" {
+		query = query.SetDescription(req.Description)
+	}
+
+	return query.Save(ctx)
+}
+
+// Delete soft deletes a holiday
+func (r *HolidayRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.Holiday.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves holidays with pagination and filtering
+func (r *HolidayRepositoryImpl) List(ctx context.Context, params *dto.HolidayQueryParams, startDate, endDate time.Time) ([]*ent.Holiday, int, error) {
+	query := r.client.Holiday.
+		Query().
+		Where(holiday.DeletedAtIsNil())
+
+	// Apply filters
+	if !startDate.IsZero() {
+		query = query.Where(holiday.HolidayDateGTE(startDate))
+	}
+
+	if !endDate.IsZero() {
+		query = query.Where(holiday.HolidayDateLTE(endDate))
+	}
+
+	if params.HolidayType != "" {
+		query = query.Where(holiday.HolidayTypeEQ(holiday.HolidayType(params.HolidayType)))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count holidays: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by holiday_date asc
+	query = query.Order(ent.Asc(holiday.FieldHolidayDate))
+
+	holidays, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+
+	return holidays, total, nil
+}
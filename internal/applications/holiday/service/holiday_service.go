@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/holiday/dto"
+	"mceasy/internal/applications/holiday/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+)
+
+// HolidayService defines the interface for holiday business logic
+type HolidayService interface {
+	CreateHoliday(ctx context.Context, req *dto.CreateHolidayRequest) (*dto.HolidayResponse, error)
+	GetHolidayByID(ctx context.Context, id uint64) (*dto.HolidayResponse, error)
+	UpdateHoliday(ctx context.Context, id uint64, req *dto.UpdateHolidayRequest) (*dto.HolidayResponse, error)
+	DeleteHoliday(ctx context.Context, id uint64) error
+	ListHolidays(ctx context.Context, params *dto.HolidayQueryParams) (*dto.HolidayListResponse, error)
+	GetWorkingDays(ctx context.Context, startDate, endDate time.Time) (*dto.WorkingDaysResponse, error)
+}
+
+// HolidayServiceImpl implements the HolidayService interface
+type HolidayServiceImpl struct {
+	holidayRepo repository.HolidayRepository
+	calendar    calendar.WorkingDayCalendar
+	cache       cache.Cache
+	trx         transaction.Trx
+}
+
+// NewHolidayService creates a new holiday service instance
+func NewHolidayService(
+	holidayRepo repository.HolidayRepository,
+	calendar calendar.WorkingDayCalendar,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *HolidayServiceImpl {
+	return &HolidayServiceImpl{
+		holidayRepo: holidayRepo,
+		calendar:    calendar,
+		cache:       cache,
+		trx:         trx,
+	}
+}
+
+// CreateHoliday registers a new holiday
+func (s *HolidayServiceImpl) CreateHoliday(ctx context.Context, req *dto.CreateHolidayRequest) (*dto.HolidayResponse, error) {
+	holidayDate, err := parseHolidayDate(req.HolidayDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one holiday can be registered per date
+	existing, err := s.calendar.GetHoliday(ctx, holidayDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing holiday: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("holiday %s already registered on %s", existing.Name, req.HolidayDate)
+	}
+
+	holiday, err := s.holidayRepo.Create(ctx, holidayDate, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create holiday: %w", err)
+	}
+
+	return s.mapToHolidayResponse(holiday), nil
+}
+
+// GetHolidayByID retrieves a holiday by ID
+func (s *HolidayServiceImpl) GetHolidayByID(ctx context.Context, id uint64) (*dto.HolidayResponse, error) {
+	holiday, err := s.holidayRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("holiday not found: %w", err)
+	}
+
+	return s.mapToHolidayResponse(holiday), nil
+}
+
+// UpdateHoliday updates a holiday
+func (s *HolidayServiceImpl) UpdateHoliday(ctx context.Context, id uint64, req *dto.UpdateHolidayRequest) (*dto.HolidayResponse, error) {
+	// Check if holiday exists
+	_, err := s.holidayRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("holiday not found: %w", err)
+	}
+
+	var holidayDate time.Time
+	if req.HolidayDate != "" {
+		holidayDate, err = parseHolidayDate(req.HolidayDate)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := s.calendar.GetHoliday(ctx, holidayDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing holiday: %w", err)
+		}
+		if existing != nil && existing.ID != id {
+			return nil, fmt.Errorf("holiday %s already registered on %s", existing.Name, req.HolidayDate)
+		}
+	}
+
+	holiday, err := s.holidayRepo.Update(ctx, id, holidayDate, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update holiday: %w", err)
+	}
+
+	return s.mapToHolidayResponse(holiday), nil
+}
+
+// DeleteHoliday soft deletes a holiday
+func (s *HolidayServiceImpl) DeleteHoliday(ctx context.Context, id uint64) error {
+	// Check if holiday exists
+	_, err := s.holidayRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("holiday not found: %w", err)
+	}
+
+	err = s.holidayRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+
+	return nil
+}
+
+// ListHolidays retrieves holidays with pagination and filtering
+func (s *HolidayServiceImpl) ListHolidays(ctx context.Context, params *dto.HolidayQueryParams) (*dto.HolidayListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	var startDate, endDate time.Time
+	var err error
+
+	if params.Year > 0 {
+		startDate = time.Date(params.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		endDate = time.Date(params.Year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	if params.StartDate != "" {
+		if startDate, err = parseHolidayDate(params.StartDate); err != nil {
+			return nil, err
+		}
+	}
+	if params.EndDate != "" {
+		if endDate, err = parseHolidayDate(params.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	holidays, total, err := s.holidayRepo.List(ctx, params, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+
+	holidayResponses := make([]dto.HolidayResponse, len(holidays))
+	for i, holiday := range holidays {
+		holidayResponses[i] = *s.mapToHolidayResponse(holiday)
+	}
+
+	return &dto.HolidayListResponse{
+		Holidays: holidayResponses,
+		Total:    total,
+		Page:     params.Page,
+		Limit:    params.Limit,
+	}, nil
+}
+
+// GetWorkingDays returns the working day count and holidays within a date range
+func (s *HolidayServiceImpl) GetWorkingDays(ctx context.Context, startDate, endDate time.Time) (*dto.WorkingDaysResponse, error) {
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("end date cannot be before start date")
+	}
+
+	totalWorkingDays, err := s.calendar.CountWorkingDays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count working days: %w", err)
+	}
+
+	holidays, err := s.calendar.GetHolidays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	holidayResponses := make([]dto.HolidayResponse, len(holidays))
+	for i, holiday := range holidays {
+		holidayResponses[i] = *s.mapToHolidayResponse(holiday)
+	}
+
+	return &dto.WorkingDaysResponse{
+		StartDate:        startDate,
+		EndDate:          endDate,
+		TotalWorkingDays: totalWorkingDays,
+		Holidays:         holidayResponses,
+	}, nil
+}
+
+// mapToHolidayResponse maps an ent.Holiday to dto.HolidayResponse
+func (s *HolidayServiceImpl) mapToHolidayResponse(holiday *ent.Holiday) *dto.HolidayResponse {
+	return &dto.HolidayResponse{
+		ID:          holiday.ID,
+		HolidayDate: holiday.HolidayDate,
+		Name:        holiday.Name,
+		HolidayType: string(holiday.HolidayType),
+		Description: holiday.Description,
+		CreatedAt:   holiday.CreatedAt,
+		ModifiedAt:  holiday.ModifiedAt,
+	}
+}
+
+// parseHolidayDate parses date string in format "2006-01-02"
+func parseHolidayDate(dateStr string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", dateStr)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,33 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package holiday
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/holiday/repository"
+	"mceasy/internal/applications/holiday/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from holiday_injector.go:
+
+func InitializedHolidayService(dbClient *ent.Client, redisClient *redis.Client) *service.HolidayServiceImpl {
+	holidayRepositoryImpl := repository.NewHolidayRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	holidayServiceImpl := service.NewHolidayService(holidayRepositoryImpl, workingDayCalendarImpl, cacheImpl, trxImpl)
+	return holidayServiceImpl
+}
+
+// holiday_injector.go:
+
+var providerHoliday = wire.NewSet(repository.NewHolidayRepository, calendar.NewWorkingDayCalendar, transaction.NewTrx, service.NewHolidayService, cache.NewCache, wire.Bind(new(repository.HolidayRepository), new(*repository.HolidayRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.HolidayService), new(*service.HolidayServiceImpl)))
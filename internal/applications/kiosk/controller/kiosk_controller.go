@@ -0,0 +1,336 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/kiosk/dto"
+	"mceasy/internal/applications/kiosk/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// KioskTokenHeader carries the device token of the kiosk tablet when it polls its codes
+const KioskTokenHeader = "X-Kiosk-Token"
+
+// KioskController handles HTTP requests for kiosk operations
+type KioskController struct {
+	kioskService service.KioskService
+}
+
+// NewKioskController creates a new kiosk controller instance
+func NewKioskController(kioskService service.KioskService) *KioskController {
+	return &KioskController{
+		kioskService: kioskService,
+	}
+}
+
+// CreateKiosk registers a check-in kiosk
+// @Summary Register a kiosk
+// @Description Register a check-in kiosk, a secret for its rotating codes is generated
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param kiosk body dto.CreateKioskRequest true "Kiosk data"
+// @Success 201 {object} dto.KioskResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks [post]
+func (c *KioskController) CreateKiosk(ctx echo.Context) error {
+	var req dto.CreateKioskRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	kiosk, err := c.kioskService.CreateKiosk(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create kiosk",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, kiosk)
+}
+
+// GetKiosk retrieves a kiosk by ID
+// @Summary Get kiosk by ID
+// @Description Get kiosk details by ID
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Success 200 {object} dto.KioskResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /kiosks/{id} [get]
+func (c *KioskController) GetKiosk(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	kiosk, err := c.kioskService.GetKioskByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Kiosk not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, kiosk)
+}
+
+// UpdateKiosk updates a kiosk
+// @Summary Update kiosk
+// @Description Update a check-in kiosk
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Param kiosk body dto.UpdateKioskRequest true "Kiosk data"
+// @Success 200 {object} dto.KioskResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks/{id} [put]
+func (c *KioskController) UpdateKiosk(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateKioskRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	kiosk, err := c.kioskService.UpdateKiosk(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update kiosk",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, kiosk)
+}
+
+// DeleteKiosk deletes a kiosk
+// @Summary Delete kiosk
+// @Description Soft delete a check-in kiosk, its codes are refused afterwards
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks/{id} [delete]
+func (c *KioskController) DeleteKiosk(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	err = c.kioskService.DeleteKiosk(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete kiosk",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Kiosk deleted successfully",
+	})
+}
+
+// ListKiosks retrieves kiosks with pagination and filtering
+// @Summary List kiosks
+// @Description Get list of kiosks with pagination and filtering
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by name"
+// @Param office_location_id query int false "Office location filter"
+// @Param is_active query bool false "Active status filter"
+// @Success 200 {object} dto.KioskListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks [get]
+func (c *KioskController) ListKiosks(ctx echo.Context) error {
+	var params dto.KioskQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	kiosks, err := c.kioskService.ListKiosks(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list kiosks",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, kiosks)
+}
+
+// RotateKioskSecret replaces the secret of a kiosk
+// @Summary Rotate kiosk secret
+// @Description Replace the secret the rotating codes of a kiosk are signed with, e.g. after codes were shared
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Success 200 {object} dto.KioskResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks/{id}/rotate-secret [post]
+func (c *KioskController) RotateKioskSecret(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	kiosk, err := c.kioskService.RotateKioskSecret(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to rotate kiosk secret",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, kiosk)
+}
+
+// IssueKioskDeviceToken issues a new device token for a kiosk tablet
+// @Summary Issue kiosk device token
+// @Description Issue the token the kiosk tablet fetches its codes with, the previous token stops working. The token is only returned once.
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Success 200 {object} dto.KioskResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /kiosks/{id}/device-token [post]
+func (c *KioskController) IssueKioskDeviceToken(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	kiosk, err := c.kioskService.IssueKioskDeviceToken(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to issue kiosk device token",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, kiosk)
+}
+
+// GetKioskCode retrieves the code a kiosk shows right now
+// @Summary Get current kiosk code
+// @Description Get the rotating code the kiosk tablet shows as a QR code, the tablet polls it before expires_at with its device token
+// @Tags kiosks
+// @Accept json
+// @Produce json
+// @Param id path int true "Kiosk ID"
+// @Param X-Kiosk-Token header string true "Device token of the kiosk"
+// @Success 200 {object} dto.KioskCodeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /kiosks/{id}/code [get]
+func (c *KioskController) GetKioskCode(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid kiosk ID",
+			"message": "Kiosk ID must be a valid number",
+		})
+	}
+
+	code, err := c.kioskService.GetKioskCode(ctx.Request().Context(), id, ctx.Request().Header.Get(KioskTokenHeader))
+	if errors.Is(err, service.ErrKioskTokenMissing) {
+		return ctx.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error":   "Kiosk device token required",
+			"message": err.Error(),
+		})
+	}
+	if errors.Is(err, service.ErrKioskTokenInvalid) {
+		return ctx.JSON(http.StatusForbidden, map[string]interface{}{
+			"error":   "Kiosk device token rejected",
+			"message": err.Error(),
+		})
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Kiosk code not available",
+			"message": err.Error(),
+		})
+	}
+
+	// Codes rotate, intermediaries must not serve a stale one
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	return ctx.JSON(http.StatusOK, code)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"mceasy/internal/applications/kiosk/dto"
+	"mceasy/internal/applications/kiosk/repository"
+	"mceasy/internal/applications/kiosk/service"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKioskController_GetKioskCode(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	kioskService := service.NewKioskService(repository.NewKioskRepository(client), nil, transaction.NewTrx(client))
+
+	e := echo.New()
+	RegisterKioskRoutes(e.Group(""), NewKioskController(kioskService))
+
+	kiosk, err := kioskService.CreateKiosk(ctx, &dto.CreateKioskRequest{Name: "Lobby"})
+	require.NoError(t, err)
+	require.NotEmpty(t, kiosk.DeviceToken)
+
+	getCode := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/kiosks/%d/code", kiosk.ID), nil)
+		if token != "" {
+			req.Header.Set(KioskTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("a request without the device token is refused", func(t *testing.T) {
+		rec := getCode("")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.NotContains(t, rec.Body.String(), `"code"`)
+	})
+
+	t.Run("a request with another token is refused", func(t *testing.T) {
+		rec := getCode("not-the-kiosk-token")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("the kiosk tablet gets its code", func(t *testing.T) {
+		rec := getCode(kiosk.DeviceToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code"`)
+		assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("a reissued token replaces the previous one", func(t *testing.T) {
+		reissued, err := kioskService.IssueKioskDeviceToken(ctx, kiosk.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, getCode(kiosk.DeviceToken).Code)
+		assert.Equal(t, http.StatusOK, getCode(reissued.DeviceToken).Code)
+	})
+}
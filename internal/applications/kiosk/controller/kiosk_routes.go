@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterKioskRoutes registers all kiosk routes
+func RegisterKioskRoutes(e *echo.Group, controller *KioskController) {
+	e.POST("/kiosks", controller.CreateKiosk)
+	e.GET("/kiosks", controller.ListKiosks)
+	e.GET("/kiosks/:id", controller.GetKiosk)
+	e.PUT("/kiosks/:id", controller.UpdateKiosk)
+	e.DELETE("/kiosks/:id", controller.DeleteKiosk)
+	e.POST("/kiosks/:id/rotate-secret", controller.RotateKioskSecret)
+	e.POST("/kiosks/:id/device-token", controller.IssueKioskDeviceToken)
+	e.GET("/kiosks/:id/code", controller.GetKioskCode)
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateKioskRequest represents the request to register a check-in kiosk
+type CreateKioskRequest struct {
+	Name             string  `json:"name" validate:"required,max=100"`
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	IsActive         *bool   `json:"is_active,omitempty"`
+
+	// Generated by the service, never accepted from the client
+	Secret          string `json:"-"`
+	DeviceTokenHash string `json:"-"`
+}
+
+// UpdateKioskRequest represents the request to update a check-in kiosk
+type UpdateKioskRequest struct {
+	Name             string  `json:"name,omitempty" validate:"omitempty,max=100"`
+	OfficeLocationID *uint64 `json:"office_location_id,omitempty" validate:"omitempty,min=1"`
+	IsActive         *bool   `json:"is_active,omitempty"`
+}
+
+// KioskResponse represents the check-in kiosk response structure, the secret is never returned. The device
+// token is only returned when it is issued, it is configured on the kiosk tablet and cannot be read again.
+type KioskResponse struct {
+	ID               uint64    `json:"id"`
+	Name             string    `json:"name"`
+	OfficeLocationID *uint64   `json:"office_location_id,omitempty"`
+	IsActive         bool      `json:"is_active"`
+	DeviceToken      string    `json:"device_token,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	ModifiedAt       time.Time `json:"modified_at"`
+}
+
+// KioskListResponse represents the response for check-in kiosk list
+type KioskListResponse struct {
+	Kiosks []KioskResponse `json:"kiosks"`
+	Total  int             `json:"total"`
+	Page   int             `json:"page"`
+	Limit  int             `json:"limit"`
+}
+
+// KioskQueryParams represents query parameters for check-in kiosk list
+type KioskQueryParams struct {
+	Page             int    `query:"page" validate:"omitempty,min=1"`
+	Limit            int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Search           string `query:"search" validate:"omitempty,max=100"`
+	OfficeLocationID uint64 `query:"office_location_id" validate:"omitempty"`
+	IsActive         *bool  `query:"is_active"`
+}
+
+// KioskCodeResponse is the code a kiosk currently shows. Payload is encoded in the QR code and is posted as is
+// by the employee's phone to the kiosk check-in endpoint.
+type KioskCodeResponse struct {
+	KioskID   uint64    `json:"kiosk_id"`
+	Code      string    `json:"code"`
+	Payload   string    `json:"payload"`
+	Period    int       `json:"period_seconds"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// KioskCodePayload is the content of the QR code
+type KioskCodePayload struct {
+	KioskID uint64 `json:"kiosk_id"`
+	Code    string `json:"code"`
+}
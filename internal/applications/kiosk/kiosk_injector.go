@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package kiosk
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/kiosk/repository"
+	"mceasy/internal/applications/kiosk/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerKiosk = wire.NewSet(
+	repository.NewKioskRepository,
+	transaction.NewTrx,
+	service.NewKioskService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.KioskRepository), new(*repository.KioskRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.KioskService), new(*service.KioskServiceImpl)),
+)
+
+func InitializedKioskService(dbClient *ent.Client, redisClient *redis.Client) *service.KioskServiceImpl {
+	wire.Build(providerKiosk)
+	return nil
+}
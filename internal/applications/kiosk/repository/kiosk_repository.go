@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/kiosk"
+	"mceasy/internal/applications/kiosk/dto"
+)
+
+// KioskRepository defines the interface for check-in kiosk data operations
+type KioskRepository interface {
+	Create(ctx context.Context, req *dto.CreateKioskRequest) (*ent.Kiosk, error)
+	GetByID(ctx context.Context, id uint64) (*ent.Kiosk, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateKioskRequest) (*ent.Kiosk, error)
+	UpdateSecret(ctx context.Context, id uint64, secret string) (*ent.Kiosk, error)
+	UpdateDeviceTokenHash(ctx context.Context, id uint64, tokenHash string) (*ent.Kiosk, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.KioskQueryParams) ([]*ent.Kiosk, int, error)
+}
+
+// KioskRepositoryImpl implements the KioskRepository interface
+type KioskRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewKioskRepository creates a new check-in kiosk repository instance
+func NewKioskRepository(client *ent.Client) *KioskRepositoryImpl {
+	return &KioskRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create registers a new check-in kiosk
+func (r *KioskRepositoryImpl) Create(ctx context.Context, req *dto.CreateKioskRequest) (*ent.Kiosk, error) {
+	query := r.client.Kiosk.Create().
+		SetName(req.Name).
+		SetSecret(req.Secret).
+		SetDeviceTokenHash(req.DeviceTokenHash)
+
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	return query.Save(ctx)
+}
+
+// GetByID retrieves a check-in kiosk by ID
+func (r *KioskRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.Kiosk, error) {
+	return r.client.Kiosk.
+		Query().
+		Where(kiosk.ID(id)).
+		Where(kiosk.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// Update updates a check-in kiosk
+func (r *KioskRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateKioskRequest) (*ent.Kiosk, error) {
+	query := r.client.Kiosk.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.OfficeLocationID != nil {
+		query = query.SetOfficeLocationID(*req.OfficeLocationID)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	return query.Save(ctx)
+}
+
+// UpdateSecret replaces the secret the check-in codes of a kiosk are signed with
+func (r *KioskRepositoryImpl) UpdateSecret(ctx context.Context, id uint64, secret string) (*ent.Kiosk, error) {
+	return r.client.Kiosk.
+		UpdateOneID(id).
+		SetSecret(secret).
+		Save(ctx)
+}
+
+// UpdateDeviceTokenHash replaces the token the kiosk tablet fetches its codes with
+func (r *KioskRepositoryImpl) UpdateDeviceTokenHash(ctx context.Context, id uint64, tokenHash string) (*ent.Kiosk, error) {
+	return r.client.Kiosk.
+		UpdateOneID(id).
+		SetDeviceTokenHash(tokenHash).
+		Save(ctx)
+}
+
+// Delete soft deletes a check-in kiosk
+func (r *KioskRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.Kiosk.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves check-in kiosks with pagination and filtering
+func (r *KioskRepositoryImpl) List(ctx context.Context, params *dto.KioskQueryParams) ([]*ent.Kiosk, int, error) {
+	query := r.client.Kiosk.
+		Query().
+		Where(kiosk.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Search != "" {
+		query = query.Where(kiosk.NameContainsFold(params.Search))
+	}
+
+	if params.OfficeLocationID > 0 {
+		query = query.Where(kiosk.OfficeLocationID(params.OfficeLocationID))
+	}
+
+	if params.IsActive != nil {
+		query = query.Where(kiosk.IsActiveEQ(*params.IsActive))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count kiosks: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by name asc
+	query = query.Order(ent.Asc(kiosk.FieldName))
+
+	kiosks, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch kiosks: %w", err)
+	}
+
+	return kiosks, total, nil
+}
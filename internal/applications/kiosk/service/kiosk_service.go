@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/kiosk/dto"
+	"mceasy/internal/applications/kiosk/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/totp"
+	"mceasy/internal/component/transaction"
+)
+
+var (
+	// ErrKioskTokenMissing is returned when a kiosk code is requested without the kiosk's device token
+	ErrKioskTokenMissing = errors.New("kiosk device token is required")
+
+	// ErrKioskTokenInvalid is returned when the device token does not belong to the kiosk
+	ErrKioskTokenInvalid = errors.New("kiosk device token is invalid")
+)
+
+// deviceTokenSize is the number of random bytes of a kiosk device token
+const deviceTokenSize = 32
+
+// KioskService defines the interface for check-in kiosk business logic
+type KioskService interface {
+	CreateKiosk(ctx context.Context, req *dto.CreateKioskRequest) (*dto.KioskResponse, error)
+	GetKioskByID(ctx context.Context, id uint64) (*dto.KioskResponse, error)
+	UpdateKiosk(ctx context.Context, id uint64, req *dto.UpdateKioskRequest) (*dto.KioskResponse, error)
+	DeleteKiosk(ctx context.Context, id uint64) error
+	ListKiosks(ctx context.Context, params *dto.KioskQueryParams) (*dto.KioskListResponse, error)
+	RotateKioskSecret(ctx context.Context, id uint64) (*dto.KioskResponse, error)
+	IssueKioskDeviceToken(ctx context.Context, id uint64) (*dto.KioskResponse, error)
+	GetKioskCode(ctx context.Context, id uint64, deviceToken string) (*dto.KioskCodeResponse, error)
+}
+
+// KioskServiceImpl implements the KioskService interface
+type KioskServiceImpl struct {
+	kioskRepo repository.KioskRepository
+	cache     cache.Cache
+	trx       transaction.Trx
+}
+
+// NewKioskService creates a new check-in kiosk service instance
+func NewKioskService(
+	kioskRepo repository.KioskRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *KioskServiceImpl {
+	return &KioskServiceImpl{
+		kioskRepo: kioskRepo,
+		cache:     cache,
+		trx:       trx,
+	}
+}
+
+// CreateKiosk registers a check-in kiosk with a fresh secret and device token
+func (s *KioskServiceImpl) CreateKiosk(ctx context.Context, req *dto.CreateKioskRequest) (*dto.KioskResponse, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	req.Secret = secret
+
+	token, err := generateDeviceToken()
+	if err != nil {
+		return nil, err
+	}
+	req.DeviceTokenHash = hashDeviceToken(token)
+
+	record, err := s.kioskRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kiosk: %w", err)
+	}
+
+	response := s.mapToKioskResponse(record)
+	response.DeviceToken = token
+	return response, nil
+}
+
+// GetKioskByID retrieves a check-in kiosk by ID
+func (s *KioskServiceImpl) GetKioskByID(ctx context.Context, id uint64) (*dto.KioskResponse, error) {
+	record, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("kiosk not found: %w", err)
+	}
+
+	return s.mapToKioskResponse(record), nil
+}
+
+// UpdateKiosk updates a check-in kiosk
+func (s *KioskServiceImpl) UpdateKiosk(ctx context.Context, id uint64, req *dto.UpdateKioskRequest) (*dto.KioskResponse, error) {
+	// Check if kiosk exists
+	_, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("kiosk not found: %w", err)
+	}
+
+	record, err := s.kioskRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update kiosk: %w", err)
+	}
+
+	return s.mapToKioskResponse(record), nil
+}
+
+// DeleteKiosk soft deletes a check-in kiosk, its codes are refused afterwards
+func (s *KioskServiceImpl) DeleteKiosk(ctx context.Context, id uint64) error {
+	// Check if kiosk exists
+	_, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("kiosk not found: %w", err)
+	}
+
+	err = s.kioskRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete kiosk: %w", err)
+	}
+
+	return nil
+}
+
+// ListKiosks retrieves check-in kiosks with pagination and filtering
+func (s *KioskServiceImpl) ListKiosks(ctx context.Context, params *dto.KioskQueryParams) (*dto.KioskListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	kiosks, total, err := s.kioskRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kiosks: %w", err)
+	}
+
+	kioskResponses := make([]dto.KioskResponse, len(kiosks))
+	for i, record := range kiosks {
+		kioskResponses[i] = *s.mapToKioskResponse(record)
+	}
+
+	return &dto.KioskListResponse{
+		Kiosks: kioskResponses,
+		Total:  total,
+		Page:   params.Page,
+		Limit:  params.Limit,
+	}, nil
+}
+
+// RotateKioskSecret replaces the secret of a kiosk, codes photographed before are worthless afterwards
+func (s *KioskServiceImpl) RotateKioskSecret(ctx context.Context, id uint64) (*dto.KioskResponse, error) {
+	// Check if kiosk exists
+	_, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("kiosk not found: %w", err)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.kioskRepo.UpdateSecret(ctx, id, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate kiosk secret: %w", err)
+	}
+
+	return s.mapToKioskResponse(record), nil
+}
+
+// IssueKioskDeviceToken replaces the device token of a kiosk, e.g. when its tablet is replaced. The previous
+// token stops working right away.
+func (s *KioskServiceImpl) IssueKioskDeviceToken(ctx context.Context, id uint64) (*dto.KioskResponse, error) {
+	// Check if kiosk exists
+	_, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("kiosk not found: %w", err)
+	}
+
+	token, err := generateDeviceToken()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.kioskRepo.UpdateDeviceTokenHash(ctx, id, hashDeviceToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue kiosk device token: %w", err)
+	}
+
+	response := s.mapToKioskResponse(record)
+	response.DeviceToken = token
+	return response, nil
+}
+
+// GetKioskCode returns the code the kiosk shows right now. Only the kiosk tablet holding the device token may
+// poll it, anyone else could check in without being at the kiosk. The secret never leaves the server.
+func (s *KioskServiceImpl) GetKioskCode(ctx context.Context, id uint64, deviceToken string) (*dto.KioskCodeResponse, error) {
+	if deviceToken == "" {
+		return nil, ErrKioskTokenMissing
+	}
+
+	record, err := s.kioskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("kiosk not found: %w", err)
+	}
+	// A kiosk without a token serves no codes until one is issued
+	if record.DeviceTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(hashDeviceToken(deviceToken)), []byte(record.DeviceTokenHash)) != 1 {
+		return nil, ErrKioskTokenInvalid
+	}
+	if !record.IsActive {
+		return nil, fmt.Errorf("kiosk %s is not active", record.Name)
+	}
+
+	now := time.Now()
+	code, err := totp.Code(record.Secret, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate kiosk code: %w", err)
+	}
+
+	payload, err := json.Marshal(dto.KioskCodePayload{KioskID: record.ID, Code: code})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kiosk code: %w", err)
+	}
+
+	return &dto.KioskCodeResponse{
+		KioskID:   record.ID,
+		Code:      code,
+		Payload:   string(payload),
+		Period:    int(totp.Period / time.Second),
+		ExpiresAt: totp.ExpiresAt(now),
+	}, nil
+}
+
+// generateDeviceToken returns a random hex encoded kiosk device token
+func generateDeviceToken() (string, error) {
+	token := make([]byte, deviceTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate kiosk device token: %w", err)
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// hashDeviceToken is what is stored of a device token, a leaked table does not reveal working tokens
+func hashDeviceToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// mapToKioskResponse maps an ent.Kiosk to dto.KioskResponse
+func (s *KioskServiceImpl) mapToKioskResponse(record *ent.Kiosk) *dto.KioskResponse {
+	return &dto.KioskResponse{
+		ID:               record.ID,
+		Name:             record.Name,
+		OfficeLocationID: record.OfficeLocationID,
+		IsActive:         record.IsActive,
+		CreatedAt:        record.CreatedAt,
+		ModifiedAt:       record.ModifiedAt,
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package kiosk
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/kiosk/repository"
+	"mceasy/internal/applications/kiosk/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from kiosk_injector.go:
+
+func InitializedKioskService(dbClient *ent.Client, redisClient *redis.Client) *service.KioskServiceImpl {
+	kioskRepositoryImpl := repository.NewKioskRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	kioskServiceImpl := service.NewKioskService(kioskRepositoryImpl, cacheImpl, trxImpl)
+	return kioskServiceImpl
+}
+
+// kiosk_injector.go:
+
+var providerKiosk = wire.NewSet(repository.NewKioskRepository, transaction.NewTrx, service.NewKioskService, cache.NewCache, wire.Bind(new(repository.KioskRepository), new(*repository.KioskRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.KioskService), new(*service.KioskServiceImpl)))
@@ -0,0 +1,453 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/leave/dto"
+	"mceasy/internal/applications/leave/service"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LeaveController handles HTTP requests for leave operations
+type LeaveController struct {
+	leaveService service.LeaveService
+}
+
+// NewLeaveController creates a new leave controller instance
+func NewLeaveController(leaveService service.LeaveService) *LeaveController {
+	return &LeaveController{
+		leaveService: leaveService,
+	}
+}
+
+// CreateLeaveType creates a new leave type
+// @Summary Create a new leave type
+// @Description Create a configurable leave type such as annual, sick, maternity or unpaid
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param leave_type body dto.CreateLeaveTypeRequest true "Leave type data"
+// @Success 201 {object} dto.LeaveTypeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/types [post]
+func (c *LeaveController) CreateLeaveType(ctx echo.Context) error {
+	var req dto.CreateLeaveTypeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveType, err := c.leaveService.CreateLeaveType(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create leave type",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, leaveType)
+}
+
+// GetLeaveType retrieves a leave type by ID
+// @Summary Get leave type by ID
+// @Description Get leave type details by ID
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave type ID"
+// @Success 200 {object} dto.LeaveTypeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /leave/types/{id} [get]
+func (c *LeaveController) GetLeaveType(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave type ID",
+			"message": "Leave type ID must be a valid number",
+		})
+	}
+
+	leaveType, err := c.leaveService.GetLeaveTypeByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Leave type not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveType)
+}
+
+// UpdateLeaveType updates a leave type
+// @Summary Update leave type
+// @Description Update leave type details
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave type ID"
+// @Param leave_type body dto.UpdateLeaveTypeRequest true "Leave type data"
+// @Success 200 {object} dto.LeaveTypeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/types/{id} [put]
+func (c *LeaveController) UpdateLeaveType(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave type ID",
+			"message": "Leave type ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateLeaveTypeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveType, err := c.leaveService.UpdateLeaveType(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update leave type",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveType)
+}
+
+// ListLeaveTypes retrieves all leave types
+// @Summary List leave types
+// @Description Get all configured leave types
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.LeaveTypeResponse
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/types [get]
+func (c *LeaveController) ListLeaveTypes(ctx echo.Context) error {
+	leaveTypes, err := c.leaveService.ListLeaveTypes(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list leave types",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveTypes)
+}
+
+// CreateLeaveRequest submits a leave request
+// @Summary Request leave
+// @Description Submit a leave request for an employee, only working days are counted
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param leave_request body dto.CreateLeaveRequest true "Leave request data"
+// @Success 201 {object} dto.LeaveRequestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/requests [post]
+func (c *LeaveController) CreateLeaveRequest(ctx echo.Context) error {
+	var req dto.CreateLeaveRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveRequest, err := c.leaveService.CreateLeaveRequest(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create leave request",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, leaveRequest)
+}
+
+// GetLeaveRequest retrieves a leave request by ID
+// @Summary Get leave request by ID
+// @Description Get leave request details by ID
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave request ID"
+// @Success 200 {object} dto.LeaveRequestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /leave/requests/{id} [get]
+func (c *LeaveController) GetLeaveRequest(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave request ID",
+			"message": "Leave request ID must be a valid number",
+		})
+	}
+
+	leaveRequest, err := c.leaveService.GetLeaveRequestByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Leave request not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveRequest)
+}
+
+// ListLeaveRequests retrieves leave requests with pagination and filtering
+// @Summary List leave requests
+// @Description Get list of leave requests with pagination and filtering
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param employee_id query int false "Employee ID filter"
+// @Param leave_type_id query int false "Leave type ID filter"
+// @Param status query string false "Status filter" Enums(pending, approved, rejected, cancelled)
+// @Success 200 {object} dto.LeaveRequestListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/requests [get]
+func (c *LeaveController) ListLeaveRequests(ctx echo.Context) error {
+	var params dto.LeaveRequestQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveRequests, err := c.leaveService.ListLeaveRequests(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list leave requests",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveRequests)
+}
+
+// ApproveLeaveRequest approves a pending leave request
+// @Summary Approve leave request
+// @Description Approve a pending leave request, consuming the balance and recording leave in attendance
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave request ID"
+// @Param review body dto.ReviewLeaveRequest true "Review data"
+// @Success 200 {object} dto.LeaveRequestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/requests/{id}/approve [post]
+func (c *LeaveController) ApproveLeaveRequest(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave request ID",
+			"message": "Leave request ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewLeaveRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveRequest, err := c.leaveService.ApproveLeaveRequest(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to approve leave request", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to approve leave request",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveRequest)
+}
+
+// RejectLeaveRequest rejects a pending leave request
+// @Summary Reject leave request
+// @Description Reject a pending leave request
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave request ID"
+// @Param review body dto.ReviewLeaveRequest true "Review data"
+// @Success 200 {object} dto.LeaveRequestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/requests/{id}/reject [post]
+func (c *LeaveController) RejectLeaveRequest(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave request ID",
+			"message": "Leave request ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewLeaveRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	leaveRequest, err := c.leaveService.RejectLeaveRequest(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to reject leave request",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveRequest)
+}
+
+// CancelLeaveRequest cancels a pending leave request
+// @Summary Cancel leave request
+// @Description Cancel a pending leave request
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave request ID"
+// @Success 200 {object} dto.LeaveRequestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/requests/{id}/cancel [post]
+func (c *LeaveController) CancelLeaveRequest(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid leave request ID",
+			"message": "Leave request ID must be a valid number",
+		})
+	}
+
+	leaveRequest, err := c.leaveService.CancelLeaveRequest(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to cancel leave request",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, leaveRequest)
+}
+
+// GetEmployeeBalances retrieves leave balances of an employee
+// @Summary Get employee leave balances
+// @Description Get the balance of every leave type with a yearly quota
+// @Tags leave
+// @Accept json
+// @Produce json
+// @Param employee_id path int true "Employee ID"
+// @Param year query int false "Year, defaults to the current year"
+// @Success 200 {array} dto.LeaveBalanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /leave/balances/employee/{employee_id} [get]
+func (c *LeaveController) GetEmployeeBalances(ctx echo.Context) error {
+	employeeIDStr := ctx.Param("employee_id")
+	employeeID, err := strconv.ParseUint(employeeIDStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid number",
+		})
+	}
+
+	year := time.Now().Year()
+	if yearStr := ctx.QueryParam("year"); yearStr != "" {
+		year, err = strconv.Atoi(yearStr)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Invalid year",
+				"message": "Year must be a valid number",
+			})
+		}
+	}
+
+	balances, err := c.leaveService.GetEmployeeBalances(ctx.Request().Context(), employeeID, year)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to get leave balances",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, balances)
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterLeaveRoutes registers all leave routes
+func RegisterLeaveRoutes(e *echo.Group, controller *LeaveController) {
+	// Leave type configuration
+	e.POST("/leave/types", controller.CreateLeaveType)
+	e.GET("/leave/types", controller.ListLeaveTypes)
+	e.GET("/leave/types/:id", controller.GetLeaveType)
+	e.PUT("/leave/types/:id", controller.UpdateLeaveType)
+
+	// Leave requests and approvals
+	e.POST("/leave/requests", controller.CreateLeaveRequest)
+	e.GET("/leave/requests", controller.ListLeaveRequests)
+	e.GET("/leave/requests/:id", controller.GetLeaveRequest)
+	e.POST("/leave/requests/:id/approve", controller.ApproveLeaveRequest)
+	e.POST("/leave/requests/:id/reject", controller.RejectLeaveRequest)
+	e.POST("/leave/requests/:id/cancel", controller.CancelLeaveRequest)
+
+	// Leave balances
+	e.GET("/leave/balances/employee/:employee_id", controller.GetEmployeeBalances)
+}
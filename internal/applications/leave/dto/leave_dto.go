@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateLeaveTypeRequest represents the request to create a leave type
+type CreateLeaveTypeRequest struct {
+	Code        string `json:"code" validate:"required,min=2,max=50"`
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	IsPaid      *bool  `json:"is_paid,omitempty"`
+	AnnualQuota int    `json:"annual_quota,omitempty" validate:"omitempty,min=0,max=366"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// UpdateLeaveTypeRequest represents the request to update a leave type
+type UpdateLeaveTypeRequest struct {
+	Name        string `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	IsPaid      *bool  `json:"is_paid,omitempty"`
+	AnnualQuota *int   `json:"annual_quota,omitempty" validate:"omitempty,min=0,max=366"`
+	IsActive    *bool  `json:"is_active,omitempty"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// LeaveTypeResponse represents the leave type response structure
+type LeaveTypeResponse struct {
+	ID          uint64    `json:"id"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	IsPaid      bool      `json:"is_paid"`
+	AnnualQuota int       `json:"annual_quota"`
+	IsActive    bool      `json:"is_active"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	ModifiedAt  time.Time `json:"modified_at"`
+}
+
+// CreateLeaveRequest represents the request to apply for leave
+type CreateLeaveRequest struct {
+	EmployeeID  uint64 `json:"employee_id" validate:"required"`
+	LeaveTypeID uint64 `json:"leave_type_id" validate:"required"`
+	StartDate   string `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate     string `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Reason      string `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ReviewLeaveRequest represents the request to approve or reject a leave request
+type ReviewLeaveRequest struct {
+	ReviewedBy  uint64 `json:"reviewed_by" validate:"required"`
+	ReviewNotes string `json:"review_notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// LeaveRequestResponse represents the leave request response structure
+type LeaveRequestResponse struct {
+	ID            uint64     `json:"id"`
+	EmployeeID    uint64     `json:"employee_id"`
+	EmployeeName  string     `json:"employee_name,omitempty"`
+	LeaveTypeID   uint64     `json:"leave_type_id"`
+	LeaveTypeName string     `json:"leave_type_name,omitempty"`
+	IsPaid        bool       `json:"is_paid"`
+	StartDate     time.Time  `json:"start_date"`
+	EndDate       time.Time  `json:"end_date"`
+	TotalDays     int        `json:"total_days"`
+	Reason        string     `json:"reason,omitempty"`
+	Status        string     `json:"status"`
+	ReviewedBy    *uint64    `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes   string     `json:"review_notes,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ModifiedAt    time.Time  `json:"modified_at"`
+}
+
+// LeaveRequestListResponse represents the response for leave request list
+type LeaveRequestListResponse struct {
+	LeaveRequests []LeaveRequestResponse `json:"leave_requests"`
+	Total         int                    `json:"total"`
+	Page          int                    `json:"page"`
+	Limit         int                    `json:"limit"`
+}
+
+// LeaveRequestQueryParams represents query parameters for leave request list
+type LeaveRequestQueryParams struct {
+	Page        int    `query:"page" validate:"omitempty,min=1"`
+	Limit       int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID  uint64 `query:"employee_id" validate:"omitempty,min=1"`
+	LeaveTypeID uint64 `query:"leave_type_id" validate:"omitempty,min=1"`
+	Status      string `query:"status" validate:"omitempty,oneof=pending approved rejected cancelled"`
+}
+
+// LeaveBalanceResponse represents the leave balance of an employee for one leave type and year
+type LeaveBalanceResponse struct {
+	EmployeeID    uint64 `json:"employee_id"`
+	LeaveTypeID   uint64 `json:"leave_type_id"`
+	LeaveTypeCode string `json:"leave_type_code"`
+	LeaveTypeName string `json:"leave_type_name"`
+	Year          int    `json:"year"`
+	EntitledDays  int    `json:"entitled_days"`
+	UsedDays      int    `json:"used_days"`
+	RemainingDays int    `json:"remaining_days"`
+}
@@ -0,0 +1,39 @@
+//go:generate wire
+//go:build wireinject
+// +build wireinject
+
+package leave
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/leave/repository"
+	"mceasy/internal/applications/leave/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerLeave = wire.NewSet(
+	repository.NewLeaveRepository,
+	calendar.NewWorkingDayCalendar,
+	periodlock.NewPeriodLock,
+	transaction.NewTrx,
+	service.NewLeaveService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.LeaveRepository), new(*repository.LeaveRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
+	wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.LeaveService), new(*service.LeaveServiceImpl)),
+)
+
+func InitializedLeaveService(dbClient *ent.Client, redisClient *redis.Client) *service.LeaveServiceImpl {
+	wire.Build(providerLeave)
+	return nil
+}
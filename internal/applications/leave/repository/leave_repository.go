@@ -0,0 +1,377 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/leavebalance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/leavetype"
+	"mceasy/internal/applications/leave/dto"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaveRepository defines the interface for leave data operations
+type LeaveRepository interface {
+	CreateLeaveType(ctx context.Context, req *dto.CreateLeaveTypeRequest) (*ent.LeaveType, error)
+	GetLeaveTypeByID(ctx context.Context, id uint64) (*ent.LeaveType, error)
+	GetLeaveTypeByCode(ctx context.Context, code string) (*ent.LeaveType, error)
+	UpdateLeaveType(ctx context.Context, id uint64, req *dto.UpdateLeaveTypeRequest) (*ent.LeaveType, error)
+	ListLeaveTypes(ctx context.Context, activeOnly bool) ([]*ent.LeaveType, error)
+	CreateLeaveRequest(ctx context.Context, req *dto.CreateLeaveRequest, startDate, endDate time.Time, totalDays int) (*ent.LeaveRequest, error)
+	GetLeaveRequestByID(ctx context.Context, id uint64) (*ent.LeaveRequest, error)
+	ListLeaveRequests(ctx context.Context, params *dto.LeaveRequestQueryParams) ([]*ent.LeaveRequest, int, error)
+	HasOverlappingLeave(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (bool, error)
+	ApproveLeaveRequest(ctx context.Context, leaveRequest *ent.LeaveRequest, leaveType *ent.LeaveType, workingDays []time.Time, req *dto.ReviewLeaveRequest) (*ent.LeaveRequest, error)
+	UpdateLeaveRequestStatus(ctx context.Context, id uint64, status leaverequest.Status, req *dto.ReviewLeaveRequest) (*ent.LeaveRequest, error)
+	GetBalance(ctx context.Context, employeeID, leaveTypeID uint64, year int) (*ent.LeaveBalance, error)
+}
+
+// LeaveRepositoryImpl implements the LeaveRepository interface
+type LeaveRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewLeaveRepository creates a new leave repository instance
+func NewLeaveRepository(client *ent.Client) *LeaveRepositoryImpl {
+	return &LeaveRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, an approval and its attendance commit together
+func (r *LeaveRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// CreateLeaveType creates a new leave type
+func (r *LeaveRepositoryImpl) CreateLeaveType(ctx context.Context, req *dto.CreateLeaveTypeRequest) (*ent.LeaveType, error) {
+	query := r.client.LeaveType.Create().
+		SetCode(req.Code).
+		SetName(req.Name).
+		SetAnnualQuota(req.AnnualQuota)
+
+	if req.IsPaid != nil {
+		query = query.SetIsPaid(*req.IsPaid)
+	}
+	if req.Description != "" {
+		query = query.SetDescription(req.Description)
+	}
+
+	return query.Save(ctx)
+}
+
+// GetLeaveTypeByID retrieves a leave type by ID
+func (r *LeaveRepositoryImpl) GetLeaveTypeByID(ctx context.Context, id uint64) (*ent.LeaveType, error) {
+	return r.client.LeaveType.
+		Query().
+		Where(leavetype.ID(id)).
+		Where(leavetype.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// GetLeaveTypeByCode retrieves a leave type by code
+func (r *LeaveRepositoryImpl) GetLeaveTypeByCode(ctx context.Context, code string) (*ent.LeaveType, error) {
+	return r.client.LeaveType.
+		Query().
+		Where(leavetype.Code(code)).
+		Where(leavetype.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// UpdateLeaveType updates a leave type
+func (r *LeaveRepositoryImpl) UpdateLeaveType(ctx context.Context, id uint64, req *dto.UpdateLeaveTypeRequest) (*ent.LeaveType, error) {
+	query := r.client.LeaveType.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.IsPaid != nil {
+		query = query.SetIsPaid(*req.IsPaid)
+	}
+	if req.AnnualQuota != nil {
+		query = query.SetAnnualQuota(*req.AnnualQuota)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+	if req.Description != "" {
+		query = query.SetDescription(req.Description)
+	}
+
+	return query.Save(ctx)
+}
+
+// ListLeaveTypes retrieves all leave types ordered by name
+func (r *LeaveRepositoryImpl) ListLeaveTypes(ctx context.Context, activeOnly bool) ([]*ent.LeaveType, error) {
+	query := r.client.LeaveType.
+		Query().
+		Where(leavetype.DeletedAtIsNil())
+
+	if activeOnly {
+		query = query.Where(leavetype.IsActive(true))
+	}
+
+	return query.Order(ent.Asc(leavetype.FieldName)).All(ctx)
+}
+
+// CreateLeaveRequest creates a pending leave request
+func (r *LeaveRepositoryImpl) CreateLeaveRequest(ctx context.Context, req *dto.CreateLeaveRequest, startDate, endDate time.Time, totalDays int) (*ent.LeaveRequest, error) {
+	query := r.client.LeaveRequest.Create().
+		SetEmployeeID(req.EmployeeID).
+		SetLeaveTypeID(req.LeaveTypeID).
+		SetStartDate(startDate).
+		SetEndDate(endDate).
+		SetTotalDays(totalDays)
+
+	if req.Reason != "" {
+		query = query.SetReason(req.Reason)
+	}
+
+	created, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetLeaveRequestByID(ctx, created.ID)
+}
+
+// GetLeaveRequestByID retrieves a leave request by ID
+func (r *LeaveRepositoryImpl) GetLeaveRequestByID(ctx context.Context, id uint64) (*ent.LeaveRequest, error) {
+	return r.db(ctx).LeaveRequest.
+		Query().
+		Where(leaverequest.ID(id)).
+		Where(leaverequest.DeletedAtIsNil()).
+		WithEmployee().
+		WithLeaveType().
+		First(ctx)
+}
+
+// ListLeaveRequests retrieves leave requests with pagination and filtering
+func (r *LeaveRepositoryImpl) ListLeaveRequests(ctx context.Context, params *dto.LeaveRequestQueryParams) ([]*ent.LeaveRequest, int, error) {
+	query := r.client.LeaveRequest.
+		Query().
+		Where(leaverequest.DeletedAtIsNil()).
+		WithEmployee().
+		WithLeaveType()
+
+	// Apply filters
+	if params.EmployeeID > 0 {
+		query = query.Where(leaverequest.EmployeeID(params.EmployeeID))
+	}
+
+	if params.LeaveTypeID > 0 {
+		query = query.Where(leaverequest.LeaveTypeID(params.LeaveTypeID))
+	}
+
+	if params.Status != "" {
+		query = query.Where(leaverequest.StatusEQ(leaverequest.Status(params.Status)))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count leave requests: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by start_date desc
+	query = query.Order(ent.Desc(leaverequest.FieldStartDate), ent.Desc(leaverequest.FieldID))
+
+	leaveRequests, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch leave requests: %w", err)
+	}
+
+	return leaveRequests, total, nil
+}
+
+// HasOverlappingLeave checks if the employee already has a pending or approved request overlapping the period
+func (r *LeaveRepositoryImpl) HasOverlappingLeave(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (bool, error) {
+	return r.client.LeaveRequest.
+		Query().
+		Where(leaverequest.EmployeeID(employeeID)).
+		Where(leaverequest.StatusIn(leaverequest.StatusPending, leaverequest.StatusApproved)).
+		Where(leaverequest.StartDateLTE(endDate)).
+		Where(leaverequest.EndDateGTE(startDate)).
+		Where(leaverequest.DeletedAtIsNil()).
+		Exist(ctx)
+}
+
+// ApproveLeaveRequest approves a leave request, consumes the leave balance and writes
+// a leave attendance record for every working day, joining the transaction in the context
+func (r *LeaveRepositoryImpl) ApproveLeaveRequest(ctx context.Context, leaveRequest *ent.LeaveRequest, leaveType *ent.LeaveType, workingDays []time.Time, req *dto.ReviewLeaveRequest) (*ent.LeaveRequest, error) {
+	db := r.db(ctx)
+
+	// Claim the request only while it is still pending so a concurrent review cannot approve it twice
+	claimed, err := db.LeaveRequest.Update().
+		Where(leaverequest.ID(leaveRequest.ID)).
+		Where(leaverequest.StatusEQ(leaverequest.StatusPending)).
+		Where(leaverequest.DeletedAtIsNil()).
+		SetStatus(leaverequest.StatusApproved).
+		SetTotalDays(len(workingDays)).
+		SetReviewedBy(req.ReviewedBy).
+		SetReviewedAt(time.Now()).
+		SetReviewNotes(req.ReviewNotes).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve leave request: %w", err)
+	}
+	if claimed == 0 {
+		return nil, fmt.Errorf("leave request #%d is no longer pending", leaveRequest.ID)
+	}
+
+	// Consume the balance for leave types with a yearly quota
+	if leaveType.AnnualQuota > 0 {
+		year := leaveRequest.StartDate.Year()
+		balance, err := db.LeaveBalance.
+			Query().
+			Where(leavebalance.EmployeeID(leaveRequest.EmployeeID)).
+			Where(leavebalance.LeaveTypeID(leaveType.ID)).
+			Where(leavebalance.Year(year)).
+			Where(leavebalance.DeletedAtIsNil()).
+			First(ctx)
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to fetch leave balance: %w", err)
+			}
+			balance, err = db.LeaveBalance.Create().
+				SetEmployeeID(leaveRequest.EmployeeID).
+				SetLeaveTypeID(leaveType.ID).
+				SetYear(year).
+				SetEntitledDays(leaveType.AnnualQuota).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create leave balance: %w", err)
+			}
+		}
+
+		// The days are only added while they fit the entitlement, so concurrent approvals cannot overdraw it
+		consumed, err := db.LeaveBalance.Update().
+			Where(leavebalance.ID(balance.ID)).
+			Where(func(s *sql.Selector) {
+				s.Where(sql.ExprP(fmt.Sprintf("%s + ? <= %s",
+					s.C(leavebalance.FieldUsedDays), s.C(leavebalance.FieldEntitledDays)), len(workingDays)))
+			}).
+			AddUsedDays(len(workingDays)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update leave balance: %w", err)
+		}
+		if consumed == 0 {
+			current, err := db.LeaveBalance.Get(ctx, balance.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch leave balance: %w", err)
+			}
+			return nil, fmt.Errorf("insufficient %s balance: %d days remaining, %d requested",
+				leaveType.Name, current.EntitledDays-current.UsedDays, len(workingDays))
+		}
+	}
+
+	status := attendance.StatusLeave
+	if !leaveType.IsPaid {
+		status = attendance.StatusUnpaidLeave
+	}
+	notes := fmt.Sprintf("%s (leave request #%d)", leaveType.Name, leaveRequest.ID)
+
+	for _, day := range workingDays {
+		// Soft-deleted rows still hold the unique (employee, date) key, so they are looked up too
+		existing, err := db.Attendance.
+			Query().
+			Where(attendance.EmployeeID(leaveRequest.EmployeeID)).
+			Where(attendance.AttendanceDate(day)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to fetch attendance: %w", err)
+		}
+
+		switch {
+		case existing == nil:
+			err = db.Attendance.Create().
+				SetEmployeeID(leaveRequest.EmployeeID).
+				SetAttendanceDate(day).
+				SetStatus(status).
+				SetNotes(notes).
+				SetMarkedByAdmin(true).
+				Exec(ctx)
+		case !existing.DeletedAt.IsZero():
+			// Restore the deleted row as a clean leave day, nothing of the removed record carries over
+			err = db.Attendance.UpdateOneID(existing.ID).
+				ClearDeletedAt().
+				ClearCheckInTime().
+				ClearCheckOutTime().
+				ClearStatusMetadata().
+				ClearCheckInLatitude().
+				ClearCheckInLongitude().
+				ClearCheckInDistanceMeters().
+				ClearCheckOutLatitude().
+				ClearCheckOutLongitude().
+				ClearCheckOutDistanceMeters().
+				ClearCheckInKioskID().
+				SetLocationFlagged(false).
+				SetAutoClosed(false).
+				SetWorkedMinutes(0).
+				SetBreakMinutes(0).
+				SetStatus(status).
+				SetNotes(notes).
+				SetMarkedByAdmin(true).
+				Exec(ctx)
+		default:
+			err = db.Attendance.UpdateOneID(existing.ID).
+				SetStatus(status).
+				SetNotes(notes).
+				SetMarkedByAdmin(true).
+				Exec(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to record leave attendance on %s: %w", day.Format("2006-01-02"), err)
+		}
+	}
+
+	return r.GetLeaveRequestByID(ctx, leaveRequest.ID)
+}
+
+// UpdateLeaveRequestStatus rejects or cancels a leave request, the reviewer is optional
+func (r *LeaveRepositoryImpl) UpdateLeaveRequestStatus(ctx context.Context, id uint64, status leaverequest.Status, req *dto.ReviewLeaveRequest) (*ent.LeaveRequest, error) {
+	query := r.client.LeaveRequest.UpdateOneID(id).
+		SetStatus(status)
+
+	if req != nil {
+		query = query.
+			SetReviewedBy(req.ReviewedBy).
+			SetReviewedAt(time.Now())
+		if req.ReviewNotes != "" {
+			query = query.SetReviewNotes(req.ReviewNotes)
+		}
+	}
+
+	if err := query.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetLeaveRequestByID(ctx, id)
+}
+
+// GetBalance retrieves the leave balance of an employee for a leave type and year
+func (r *LeaveRepositoryImpl) GetBalance(ctx context.Context, employeeID, leaveTypeID uint64, year int) (*ent.LeaveBalance, error) {
+	return r.client.LeaveBalance.
+		Query().
+		Where(leavebalance.EmployeeID(employeeID)).
+		Where(leavebalance.LeaveTypeID(leaveTypeID)).
+		Where(leavebalance.Year(year)).
+		Where(leavebalance.DeletedAtIsNil()).
+		First(ctx)
+}
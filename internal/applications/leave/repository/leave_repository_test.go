@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/attendance"
+	"mceasy/ent/leaverequest"
+	"mceasy/internal/applications/leave/dto"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveRepositoryImpl_ApproveLeaveRequest(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Annual Leave").
+		SetEmail("annual.leave@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	annual, err := client.LeaveType.Create().
+		SetCode("annual").
+		SetName("Annual Leave").
+		SetAnnualQuota(12).
+		Save(ctx)
+	require.NoError(t, err)
+
+	monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	// Monday already has an absent record that the leave must replace
+	_, err = client.Attendance.Create().
+		SetEmployeeID(emp.ID).
+		SetAttendanceDate(monday).
+		SetStatus(attendance.StatusAbsent).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewLeaveRepository(client)
+
+	leaveRequest, err := repo.CreateLeaveRequest(ctx, &dto.CreateLeaveRequest{
+		EmployeeID:  emp.ID,
+		LeaveTypeID: annual.ID,
+	}, monday, tuesday, 2)
+	require.NoError(t, err)
+
+	approved, err := repo.ApproveLeaveRequest(ctx, leaveRequest, annual, []time.Time{monday, tuesday}, &dto.ReviewLeaveRequest{ReviewedBy: 1})
+	require.NoError(t, err)
+	assert.Equal(t, leaverequest.StatusApproved, approved.Status)
+
+	balance, err := repo.GetBalance(ctx, emp.ID, annual.ID, 2025)
+	require.NoError(t, err)
+	assert.Equal(t, 12, balance.EntitledDays)
+	assert.Equal(t, 2, balance.UsedDays)
+
+	records, err := client.Attendance.Query().Where(attendance.EmployeeID(emp.ID)).All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+	for _, record := range records {
+		assert.Equal(t, attendance.StatusLeave, record.Status)
+	}
+
+	t.Run("leave beyond the remaining balance is not approved", func(t *testing.T) {
+		days := make([]time.Time, 11)
+		for i := range days {
+			days[i] = monday.AddDate(0, 1, i)
+		}
+		tooLong, err := repo.CreateLeaveRequest(ctx, &dto.CreateLeaveRequest{
+			EmployeeID:  emp.ID,
+			LeaveTypeID: annual.ID,
+		}, days[0], days[10], len(days))
+		require.NoError(t, err)
+
+		_, err = repo.ApproveLeaveRequest(ctx, tooLong, annual, days, &dto.ReviewLeaveRequest{ReviewedBy: 1})
+		assert.ErrorContains(t, err, "insufficient Annual Leave balance: 10 days remaining, 11 requested")
+
+		unchanged, err := repo.GetBalance(ctx, emp.ID, annual.ID, 2025)
+		require.NoError(t, err)
+		assert.Equal(t, 2, unchanged.UsedDays)
+
+		// The remaining days may be used up exactly
+		exact, err := repo.CreateLeaveRequest(ctx, &dto.CreateLeaveRequest{
+			EmployeeID:  emp.ID,
+			LeaveTypeID: annual.ID,
+		}, days[0], days[9], 10)
+		require.NoError(t, err)
+		_, err = repo.ApproveLeaveRequest(ctx, exact, annual, days[:10], &dto.ReviewLeaveRequest{ReviewedBy: 1})
+		require.NoError(t, err)
+
+		usedUp, err := repo.GetBalance(ctx, emp.ID, annual.ID, 2025)
+		require.NoError(t, err)
+		assert.Equal(t, 12, usedUp.UsedDays)
+	})
+
+	t.Run("overlapping leave is detected", func(t *testing.T) {
+		overlapping, err := repo.HasOverlappingLeave(ctx, emp.ID, tuesday, tuesday.AddDate(0, 0, 3))
+		assert.NoError(t, err)
+		assert.True(t, overlapping)
+	})
+}
+
+func TestLeaveRepositoryImpl_ApproveLeaveRequestOverDeletedAttendance(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Deleted Day").
+		SetEmail("deleted.day@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	sick, err := client.LeaveType.Create().
+		SetCode("sick").
+		SetName("Sick Leave").
+		Save(ctx)
+	require.NoError(t, err)
+
+	wednesday := time.Date(2025, time.August, 6, 0, 0, 0, 0, time.UTC)
+
+	// A mistaken check-in was deleted, its row still holds the (employee, date) key
+	deleted, err := client.Attendance.Create().
+		SetEmployeeID(emp.ID).
+		SetAttendanceDate(wednesday).
+		SetCheckInTime(wednesday.Add(8 * time.Hour)).
+		SetStatus(attendance.StatusPresent).
+		SetWorkedMinutes(240).
+		SetDeletedAt(time.Date(2025, time.August, 6, 12, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewLeaveRepository(client)
+
+	leaveRequest, err := repo.CreateLeaveRequest(ctx, &dto.CreateLeaveRequest{
+		EmployeeID:  emp.ID,
+		LeaveTypeID: sick.ID,
+	}, wednesday, wednesday, 1)
+	require.NoError(t, err)
+
+	_, err = repo.ApproveLeaveRequest(ctx, leaveRequest, sick, []time.Time{wednesday}, &dto.ReviewLeaveRequest{ReviewedBy: 1})
+	require.NoError(t, err)
+
+	restored, err := client.Attendance.Get(ctx, deleted.ID)
+	require.NoError(t, err)
+	assert.True(t, restored.DeletedAt.IsZero())
+	assert.Equal(t, attendance.StatusLeave, restored.Status)
+	assert.True(t, restored.CheckInTime.IsZero())
+	assert.Zero(t, restored.WorkedMinutes)
+
+	t.Run("a request that is no longer pending is not approved again", func(t *testing.T) {
+		_, err := repo.ApproveLeaveRequest(ctx, leaveRequest, sick, []time.Time{wednesday}, &dto.ReviewLeaveRequest{ReviewedBy: 2})
+		assert.ErrorContains(t, err, "no longer pending")
+
+		approved, err := repo.GetLeaveRequestByID(ctx, leaveRequest.ID)
+		require.NoError(t, err)
+		require.NotNil(t, approved.ReviewedBy)
+		assert.Equal(t, uint64(1), *approved.ReviewedBy)
+	})
+}
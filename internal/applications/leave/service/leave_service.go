@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/leaverequest"
+	"mceasy/internal/applications/leave/dto"
+	"mceasy/internal/applications/leave/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+)
+
+// LeaveService defines the interface for leave business logic
+type LeaveService interface {
+	CreateLeaveType(ctx context.Context, req *dto.CreateLeaveTypeRequest) (*dto.LeaveTypeResponse, error)
+	GetLeaveTypeByID(ctx context.Context, id uint64) (*dto.LeaveTypeResponse, error)
+	UpdateLeaveType(ctx context.Context, id uint64, req *dto.UpdateLeaveTypeRequest) (*dto.LeaveTypeResponse, error)
+	ListLeaveTypes(ctx context.Context) ([]dto.LeaveTypeResponse, error)
+	CreateLeaveRequest(ctx context.Context, req *dto.CreateLeaveRequest) (*dto.LeaveRequestResponse, error)
+	GetLeaveRequestByID(ctx context.Context, id uint64) (*dto.LeaveRequestResponse, error)
+	ListLeaveRequests(ctx context.Context, params *dto.LeaveRequestQueryParams) (*dto.LeaveRequestListResponse, error)
+	ApproveLeaveRequest(ctx context.Context, id uint64, req *dto.ReviewLeaveRequest) (*dto.LeaveRequestResponse, error)
+	RejectLeaveRequest(ctx context.Context, id uint64, req *dto.ReviewLeaveRequest) (*dto.LeaveRequestResponse, error)
+	CancelLeaveRequest(ctx context.Context, id uint64) (*dto.LeaveRequestResponse, error)
+	GetEmployeeBalances(ctx context.Context, employeeID uint64, year int) ([]dto.LeaveBalanceResponse, error)
+}
+
+// LeaveServiceImpl implements the LeaveService interface
+type LeaveServiceImpl struct {
+	leaveRepo  repository.LeaveRepository
+	calendar   calendar.WorkingDayCalendar
+	periodLock periodlock.PeriodLock
+	cache      cache.Cache
+	trx        transaction.Trx
+}
+
+// NewLeaveService creates a new leave service instance
+func NewLeaveService(
+	leaveRepo repository.LeaveRepository,
+	calendar calendar.WorkingDayCalendar,
+	periodLock periodlock.PeriodLock,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *LeaveServiceImpl {
+	return &LeaveServiceImpl{
+		leaveRepo:  leaveRepo,
+		calendar:   calendar,
+		periodLock: periodLock,
+		cache:      cache,
+		trx:        trx,
+	}
+}
+
+// CreateLeaveType creates a new leave type
+func (s *LeaveServiceImpl) CreateLeaveType(ctx context.Context, req *dto.CreateLeaveTypeRequest) (*dto.LeaveTypeResponse, error) {
+	existing, _ := s.leaveRepo.GetLeaveTypeByCode(ctx, req.Code)
+	if existing != nil {
+		return nil, fmt.Errorf("leave type with code %s already exists", req.Code)
+	}
+
+	leaveType, err := s.leaveRepo.CreateLeaveType(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leave type: %w", err)
+	}
+
+	return s.mapToLeaveTypeResponse(leaveType), nil
+}
+
+// GetLeaveTypeByID retrieves a leave type by ID
+func (s *LeaveServiceImpl) GetLeaveTypeByID(ctx context.Context, id uint64) (*dto.LeaveTypeResponse, error) {
+	leaveType, err := s.leaveRepo.GetLeaveTypeByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave type not found: %w", err)
+	}
+
+	return s.mapToLeaveTypeResponse(leaveType), nil
+}
+
+// UpdateLeaveType updates a leave type
+func (s *LeaveServiceImpl) UpdateLeaveType(ctx context.Context, id uint64, req *dto.UpdateLeaveTypeRequest) (*dto.LeaveTypeResponse, error) {
+	// Check if leave type exists
+	_, err := s.leaveRepo.GetLeaveTypeByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave type not found: %w", err)
+	}
+
+	leaveType, err := s.leaveRepo.UpdateLeaveType(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update leave type: %w", err)
+	}
+
+	return s.mapToLeaveTypeResponse(leaveType), nil
+}
+
+// ListLeaveTypes retrieves all leave types
+func (s *LeaveServiceImpl) ListLeaveTypes(ctx context.Context) ([]dto.LeaveTypeResponse, error) {
+	leaveTypes, err := s.leaveRepo.ListLeaveTypes(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leave types: %w", err)
+	}
+
+	leaveTypeResponses := make([]dto.LeaveTypeResponse, len(leaveTypes))
+	for i, leaveType := range leaveTypes {
+		leaveTypeResponses[i] = *s.mapToLeaveTypeResponse(leaveType)
+	}
+
+	return leaveTypeResponses, nil
+}
+
+// CreateLeaveRequest submits a leave request covering the employee's working days in the period
+func (s *LeaveServiceImpl) CreateLeaveRequest(ctx context.Context, req *dto.CreateLeaveRequest) (*dto.LeaveRequestResponse, error) {
+	startDate, err := parseLeaveDate(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseLeaveDate(req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("end date cannot be before start date")
+	}
+	// Balances are kept per calendar year
+	if startDate.Year() != endDate.Year() {
+		return nil, fmt.Errorf("leave request cannot span multiple years, split it per year")
+	}
+
+	leaveType, err := s.leaveRepo.GetLeaveTypeByID(ctx, req.LeaveTypeID)
+	if err != nil {
+		return nil, fmt.Errorf("leave type not found: %w", err)
+	}
+	if !leaveType.IsActive {
+		return nil, fmt.Errorf("leave type %s is not active", leaveType.Name)
+	}
+
+	// Rest days and holidays are not counted as leave
+	totalDays, err := s.calendar.CountEmployeeWorkingDays(ctx, req.EmployeeID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count working days: %w", err)
+	}
+	if totalDays == 0 {
+		return nil, fmt.Errorf("requested period has no working days")
+	}
+
+	overlapping, err := s.leaveRepo.HasOverlappingLeave(ctx, req.EmployeeID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check overlapping leave: %w", err)
+	}
+	if overlapping {
+		return nil, fmt.Errorf("employee already has leave requested in this period")
+	}
+
+	remaining, err := s.remainingDays(ctx, req.EmployeeID, leaveType, startDate.Year())
+	if err != nil {
+		return nil, err
+	}
+	if remaining >= 0 && remaining < totalDays {
+		return nil, fmt.Errorf("insufficient %s balance: %d days remaining, %d requested", leaveType.Name, remaining, totalDays)
+	}
+
+	leaveRequest, err := s.leaveRepo.CreateLeaveRequest(ctx, req, startDate, endDate, totalDays)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leave request: %w", err)
+	}
+
+	return s.mapToLeaveRequestResponse(leaveRequest), nil
+}
+
+// GetLeaveRequestByID retrieves a leave request by ID
+func (s *LeaveServiceImpl) GetLeaveRequestByID(ctx context.Context, id uint64) (*dto.LeaveRequestResponse, error) {
+	leaveRequest, err := s.leaveRepo.GetLeaveRequestByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave request not found: %w", err)
+	}
+
+	return s.mapToLeaveRequestResponse(leaveRequest), nil
+}
+
+// ListLeaveRequests retrieves leave requests with pagination and filtering
+func (s *LeaveServiceImpl) ListLeaveRequests(ctx context.Context, params *dto.LeaveRequestQueryParams) (*dto.LeaveRequestListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	leaveRequests, total, err := s.leaveRepo.ListLeaveRequests(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leave requests: %w", err)
+	}
+
+	leaveRequestResponses := make([]dto.LeaveRequestResponse, len(leaveRequests))
+	for i, leaveRequest := range leaveRequests {
+		leaveRequestResponses[i] = *s.mapToLeaveRequestResponse(leaveRequest)
+	}
+
+	return &dto.LeaveRequestListResponse{
+		LeaveRequests: leaveRequestResponses,
+		Total:         total,
+		Page:          params.Page,
+		Limit:         params.Limit,
+	}, nil
+}
+
+// ApproveLeaveRequest approves a pending leave request. Paid leave is recorded in attendance as leave,
+// unpaid leave as unpaid_leave so salary still deducts it.
+func (s *LeaveServiceImpl) ApproveLeaveRequest(ctx context.Context, id uint64, req *dto.ReviewLeaveRequest) (*dto.LeaveRequestResponse, error) {
+	leaveRequest, err := s.leaveRepo.GetLeaveRequestByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave request not found: %w", err)
+	}
+	if leaveRequest.Status != leaverequest.StatusPending {
+		return nil, fmt.Errorf("only pending leave requests can be approved, current status is %s", leaveRequest.Status)
+	}
+
+	// Working days are resolved again as schedules or holidays may have changed since the request
+	workingDays, err := s.calendar.GetEmployeeWorkingDays(ctx, leaveRequest.EmployeeID, leaveRequest.StartDate, leaveRequest.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working days: %w", err)
+	}
+	if len(workingDays) == 0 {
+		return nil, fmt.Errorf("requested period has no working days")
+	}
+
+	var approved *ent.LeaveRequest
+	err = s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		// Approval writes leave into attendance, which a closed payroll month must not change
+		if err := s.periodLock.EnsureRangeOpen(txCtx, leaveRequest.StartDate, leaveRequest.EndDate); err != nil {
+			return err
+		}
+
+		approved, err = s.leaveRepo.ApproveLeaveRequest(txCtx, leaveRequest, leaveRequest.Edges.LeaveType, workingDays, req)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve leave request: %w", err)
+	}
+
+	return s.mapToLeaveRequestResponse(approved), nil
+}
+
+// RejectLeaveRequest rejects a pending leave request
+func (s *LeaveServiceImpl) RejectLeaveRequest(ctx context.Context, id uint64, req *dto.ReviewLeaveRequest) (*dto.LeaveRequestResponse, error) {
+	leaveRequest, err := s.leaveRepo.GetLeaveRequestByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave request not found: %w", err)
+	}
+	if leaveRequest.Status != leaverequest.StatusPending {
+		return nil, fmt.Errorf("only pending leave requests can be rejected, current status is %s", leaveRequest.Status)
+	}
+
+	rejected, err := s.leaveRepo.UpdateLeaveRequestStatus(ctx, id, leaverequest.StatusRejected, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reject leave request: %w", err)
+	}
+
+	return s.mapToLeaveRequestResponse(rejected), nil
+}
+
+// CancelLeaveRequest cancels a pending leave request
+func (s *LeaveServiceImpl) CancelLeaveRequest(ctx context.Context, id uint64) (*dto.LeaveRequestResponse, error) {
+	leaveRequest, err := s.leaveRepo.GetLeaveRequestByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("leave request not found: %w", err)
+	}
+	if leaveRequest.Status != leaverequest.StatusPending {
+		return nil, fmt.Errorf("only pending leave requests can be cancelled, current status is %s", leaveRequest.Status)
+	}
+
+	cancelled, err := s.leaveRepo.UpdateLeaveRequestStatus(ctx, id, leaverequest.StatusCancelled, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel leave request: %w", err)
+	}
+
+	return s.mapToLeaveRequestResponse(cancelled), nil
+}
+
+// GetEmployeeBalances retrieves the balance of every active leave type with a yearly quota
+func (s *LeaveServiceImpl) GetEmployeeBalances(ctx context.Context, employeeID uint64, year int) ([]dto.LeaveBalanceResponse, error) {
+	leaveTypes, err := s.leaveRepo.ListLeaveTypes(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leave types: %w", err)
+	}
+
+	balances := make([]dto.LeaveBalanceResponse, 0, len(leaveTypes))
+	for _, leaveType := range leaveTypes {
+		if leaveType.AnnualQuota == 0 {
+			continue
+		}
+
+		balance := dto.LeaveBalanceResponse{
+			EmployeeID:    employeeID,
+			LeaveTypeID:   leaveType.ID,
+			LeaveTypeCode: leaveType.Code,
+			LeaveTypeName: leaveType.Name,
+			Year:          year,
+			EntitledDays:  leaveType.AnnualQuota,
+		}
+
+		// Balances are created on first approval, until then the full quota is available
+		stored, err := s.leaveRepo.GetBalance(ctx, employeeID, leaveType.ID, year)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to fetch leave balance: %w", err)
+		}
+		if stored != nil {
+			balance.EntitledDays = stored.EntitledDays
+			balance.UsedDays = stored.UsedDays
+		}
+		balance.RemainingDays = balance.EntitledDays - balance.UsedDays
+
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+// remainingDays returns the remaining balance of a leave type, or -1 when the balance is not tracked
+func (s *LeaveServiceImpl) remainingDays(ctx context.Context, employeeID uint64, leaveType *ent.LeaveType, year int) (int, error) {
+	if leaveType.AnnualQuota == 0 {
+		return -1, nil
+	}
+
+	balance, err := s.leaveRepo.GetBalance(ctx, employeeID, leaveType.ID, year)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return leaveType.AnnualQuota, nil
+		}
+		return 0, fmt.Errorf("failed to fetch leave balance: %w", err)
+	}
+
+	return balance.EntitledDays - balance.UsedDays, nil
+}
+
+// mapToLeaveTypeResponse maps an ent.LeaveType to dto.LeaveTypeResponse
+func (s *LeaveServiceImpl) mapToLeaveTypeResponse(leaveType *ent.LeaveType) *dto.LeaveTypeResponse {
+	return &dto.LeaveTypeResponse{
+		ID:          leaveType.ID,
+		Code:        leaveType.Code,
+		Name:        leaveType.Name,
+		IsPaid:      leaveType.IsPaid,
+		AnnualQuota: leaveType.AnnualQuota,
+		IsActive:    leaveType.IsActive,
+		Description: leaveType.Description,
+		CreatedAt:   leaveType.CreatedAt,
+		ModifiedAt:  leaveType.ModifiedAt,
+	}
+}
+
+// mapToLeaveRequestResponse maps an ent.LeaveRequest to dto.LeaveRequestResponse
+func (s *LeaveServiceImpl) mapToLeaveRequestResponse(leaveRequest *ent.LeaveRequest) *dto.LeaveRequestResponse {
+	response := &dto.LeaveRequestResponse{
+		ID:          leaveRequest.ID,
+		EmployeeID:  leaveRequest.EmployeeID,
+		LeaveTypeID: leaveRequest.LeaveTypeID,
+		StartDate:   leaveRequest.StartDate,
+		EndDate:     leaveRequest.EndDate,
+		TotalDays:   leaveRequest.TotalDays,
+		Reason:      leaveRequest.Reason,
+		Status:      string(leaveRequest.Status),
+		ReviewedBy:  leaveRequest.ReviewedBy,
+		ReviewedAt:  leaveRequest.ReviewedAt,
+		ReviewNotes: leaveRequest.ReviewNotes,
+		CreatedAt:   leaveRequest.CreatedAt,
+		ModifiedAt:  leaveRequest.ModifiedAt,
+	}
+
+	// Add employee and leave type information if available
+	if leaveRequest.Edges.Employee != nil {
+		response.EmployeeName = leaveRequest.Edges.Employee.FullName
+	}
+	if leaveRequest.Edges.LeaveType != nil {
+		response.LeaveTypeName = leaveRequest.Edges.LeaveType.Name
+		response.IsPaid = leaveRequest.Edges.LeaveType.IsPaid
+	}
+
+	return response
+}
+
+// parseLeaveDate parses date string in format "2006-01-02"
+func parseLeaveDate(dateStr string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", dateStr)
+	}
+
+	return date, nil
+}
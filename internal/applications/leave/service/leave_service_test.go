@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/attendance"
+	"mceasy/ent/leaverequest"
+	"mceasy/ent/payrollperiod"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/leave/dto"
+	"mceasy/internal/applications/leave/repository"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveServiceImpl_ApproveLeaveRequestInLockedPeriod(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Late Approval").
+		SetEmail("late.approval@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	annual, err := client.LeaveType.Create().
+		SetCode("annual").
+		SetName("Annual Leave").
+		SetAnnualQuota(12).
+		Save(ctx)
+	require.NoError(t, err)
+
+	leaveRepo := repository.NewLeaveRepository(client)
+	leaveService := NewLeaveService(
+		leaveRepo,
+		calendar.NewWorkingDayCalendar(client),
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	// Requested in August, still pending when August payroll was closed
+	monday := time.Date(2025, time.August, 25, 0, 0, 0, 0, time.UTC)
+	leaveRequest, err := leaveRepo.CreateLeaveRequest(ctx, &dto.CreateLeaveRequest{
+		EmployeeID:  emp.ID,
+		LeaveTypeID: annual.ID,
+	}, monday, monday.AddDate(0, 0, 1), 2)
+	require.NoError(t, err)
+
+	_, err = client.PayrollPeriod.Create().
+		SetPeriodMonth(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedAt(time.Date(2025, time.September, 3, 10, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = leaveService.ApproveLeaveRequest(ctx, leaveRequest.ID, &dto.ReviewLeaveRequest{ReviewedBy: 1})
+	bizErr, ok := exceptions.AsBusinessLogicError(err)
+	require.True(t, ok, "expected a business logic error, got %v", err)
+	assert.Equal(t, exceptions.PeriodLocked, bizErr.ErrorCode)
+
+	// Neither the request nor attendance changed
+	unchanged, err := client.LeaveRequest.Get(ctx, leaveRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, leaverequest.StatusPending, unchanged.Status)
+
+	leaveDays, err := client.Attendance.Query().Where(attendance.EmployeeID(emp.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, leaveDays)
+}
@@ -0,0 +1,35 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package leave
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/leave/repository"
+	"mceasy/internal/applications/leave/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from leave_injector.go:
+
+func InitializedLeaveService(dbClient *ent.Client, redisClient *redis.Client) *service.LeaveServiceImpl {
+	leaveRepositoryImpl := repository.NewLeaveRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	periodLockImpl := periodlock.NewPeriodLock(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	leaveServiceImpl := service.NewLeaveService(leaveRepositoryImpl, workingDayCalendarImpl, periodLockImpl, cacheImpl, trxImpl)
+	return leaveServiceImpl
+}
+
+// leave_injector.go:
+
+var providerLeave = wire.NewSet(repository.NewLeaveRepository, calendar.NewWorkingDayCalendar, periodlock.NewPeriodLock, transaction.NewTrx, service.NewLeaveService, cache.NewCache, wire.Bind(new(repository.LeaveRepository), new(*repository.LeaveRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.LeaveService), new(*service.LeaveServiceImpl)))
@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/officelocation/dto"
+	"mceasy/internal/applications/officelocation/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OfficeLocationController handles HTTP requests for office location operations
+type OfficeLocationController struct {
+	officeLocationService service.OfficeLocationService
+}
+
+// NewOfficeLocationController creates a new office location controller instance
+func NewOfficeLocationController(officeLocationService service.OfficeLocationService) *OfficeLocationController {
+	return &OfficeLocationController{
+		officeLocationService: officeLocationService,
+	}
+}
+
+// CreateOfficeLocation registers a new office location
+// @Summary Create a new office location
+// @Description Register an office with coordinates and the radius allowed for check-in and check-out
+// @Tags office-locations
+// @Accept json
+// @Produce json
+// @Param office_location body dto.CreateOfficeLocationRequest true "Office location data"
+// @Success 201 {object} dto.OfficeLocationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /office-locations [post]
+func (c *OfficeLocationController) CreateOfficeLocation(ctx echo.Context) error {
+	var req dto.CreateOfficeLocationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	location, err := c.officeLocationService.CreateOfficeLocation(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create office location",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, location)
+}
+
+// GetOfficeLocation retrieves an office location by ID
+// @Summary Get office location by ID
+// @Description Get office location details by ID
+// @Tags office-locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Office location ID"
+// @Success 200 {object} dto.OfficeLocationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /office-locations/{id} [get]
+func (c *OfficeLocationController) GetOfficeLocation(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid office location ID",
+			"message": "Office location ID must be a valid number",
+		})
+	}
+
+	location, err := c.officeLocationService.GetOfficeLocationByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Office location not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, location)
+}
+
+// UpdateOfficeLocation updates an office location
+// @Summary Update office location
+// @Description Update office location coordinates, radius or enforcement
+// @Tags office-locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Office location ID"
+// @Param office_location body dto.UpdateOfficeLocationRequest true "Office location data"
+// @Success 200 {object} dto.OfficeLocationResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /office-locations/{id} [put]
+func (c *OfficeLocationController) UpdateOfficeLocation(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid office location ID",
+			"message": "Office location ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateOfficeLocationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	location, err := c.officeLocationService.UpdateOfficeLocation(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update office location",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, location)
+}
+
+// DeleteOfficeLocation deletes an office location
+// @Summary Delete office location
+// @Description Soft delete an office location without assigned employees
+// @Tags office-locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Office location ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /office-locations/{id} [delete]
+func (c *OfficeLocationController) DeleteOfficeLocation(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid office location ID",
+			"message": "Office location ID must be a valid number",
+		})
+	}
+
+	err = c.officeLocationService.DeleteOfficeLocation(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete office location",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Office location deleted successfully",
+	})
+}
+
+// ListOfficeLocations retrieves office locations with pagination and filtering
+// @Summary List office locations
+// @Description Get list of office locations with pagination and filtering
+// @Tags office-locations
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by name or address"
+// @Param is_active query bool false "Active status filter"
+// @Success 200 {object} dto.OfficeLocationListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /office-locations [get]
+func (c *OfficeLocationController) ListOfficeLocations(ctx echo.Context) error {
+	var params dto.OfficeLocationQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	locations, err := c.officeLocationService.ListOfficeLocations(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list office locations",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, locations)
+}
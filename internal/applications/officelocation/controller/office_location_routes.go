@@ -0,0 +1,14 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterOfficeLocationRoutes registers all office location routes
+func RegisterOfficeLocationRoutes(e *echo.Group, controller *OfficeLocationController) {
+	e.POST("/office-locations", controller.CreateOfficeLocation)
+	e.GET("/office-locations", controller.ListOfficeLocations)
+	e.GET("/office-locations/:id", controller.GetOfficeLocation)
+	e.PUT("/office-locations/:id", controller.UpdateOfficeLocation)
+	e.DELETE("/office-locations/:id", controller.DeleteOfficeLocation)
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+)
+
+// CreateOfficeLocationRequest represents the request to register an office location
+type CreateOfficeLocationRequest struct {
+	Name         string   `json:"name" validate:"required,min=2,max=100"`
+	Address      string   `json:"address,omitempty" validate:"omitempty,max=500"`
+	Latitude     *float64 `json:"latitude" validate:"required,min=-90,max=90"`
+	Longitude    *float64 `json:"longitude" validate:"required,min=-180,max=180"`
+	RadiusMeters int      `json:"radius_meters,omitempty" validate:"omitempty,min=10,max=10000"`
+	Enforcement  string   `json:"enforcement,omitempty" validate:"omitempty,oneof=reject flag"`
+	Timezone     string   `json:"timezone,omitempty" validate:"omitempty,timezone"`
+}
+
+// UpdateOfficeLocationRequest represents the request to update an office location
+type UpdateOfficeLocationRequest struct {
+	Name         string   `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Address      string   `json:"address,omitempty" validate:"omitempty,max=500"`
+	Latitude     *float64 `json:"latitude,omitempty" validate:"omitempty,min=-90,max=90"`
+	Longitude    *float64 `json:"longitude,omitempty" validate:"omitempty,min=-180,max=180"`
+	RadiusMeters int      `json:"radius_meters,omitempty" validate:"omitempty,min=10,max=10000"`
+	Enforcement  string   `json:"enforcement,omitempty" validate:"omitempty,oneof=reject flag"`
+	Timezone     *string  `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	IsActive     *bool    `json:"is_active,omitempty"`
+}
+
+// OfficeLocationResponse represents the office location response structure
+type OfficeLocationResponse struct {
+	ID           uint64    `json:"id"`
+	Name         string    `json:"name"`
+	Address      string    `json:"address,omitempty"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	RadiusMeters int       `json:"radius_meters"`
+	Enforcement  string    `json:"enforcement"`
+	Timezone     string    `json:"timezone,omitempty"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    time.Time `json:"created_at"`
+	ModifiedAt   time.Time `json:"modified_at"`
+}
+
+// OfficeLocationListResponse represents the response for office location list
+type OfficeLocationListResponse struct {
+	OfficeLocations []OfficeLocationResponse `json:"office_locations"`
+	Total           int                      `json:"total"`
+	Page            int                      `json:"page"`
+	Limit           int                      `json:"limit"`
+}
+
+// OfficeLocationQueryParams represents query parameters for office location list
+type OfficeLocationQueryParams struct {
+	Page     int    `query:"page" validate:"omitempty,min=1"`
+	Limit    int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Search   string `query:"search" validate:"omitempty,max=100"`
+	IsActive *bool  `query:"is_active"`
+}
@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package officelocation
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/officelocation/repository"
+	"mceasy/internal/applications/officelocation/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerOfficeLocation = wire.NewSet(
+	repository.NewOfficeLocationRepository,
+	transaction.NewTrx,
+	service.NewOfficeLocationService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.OfficeLocationRepository), new(*repository.OfficeLocationRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.OfficeLocationService), new(*service.OfficeLocationServiceImpl)),
+)
+
+func InitializedOfficeLocationService(dbClient *ent.Client, redisClient *redis.Client) *service.OfficeLocationServiceImpl {
+	wire.Build(providerOfficeLocation)
+	return nil
+}
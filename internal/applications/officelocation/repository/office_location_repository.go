@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/employee"
+	"mceasy/ent/officelocation"
+	"mceasy/internal/applications/officelocation/dto"
+)
+
+// OfficeLocationRepository defines the interface for office location data operations
+type OfficeLocationRepository interface {
+	Create(ctx context.Context, req *dto.CreateOfficeLocationRequest) (*ent.OfficeLocation, error)
+	GetByID(ctx context.Context, id uint64) (*ent.OfficeLocation, error)
+	GetByName(ctx context.Context, name string) (*ent.OfficeLocation, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateOfficeLocationRequest) (*ent.OfficeLocation, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.OfficeLocationQueryParams) ([]*ent.OfficeLocation, int, error)
+	CountAssignedEmployees(ctx context.Context, id uint64) (int, error)
+}
+
+// OfficeLocationRepositoryImpl implements the OfficeLocationRepository interface
+type OfficeLocationRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewOfficeLocationRepository creates a new office location repository instance
+func NewOfficeLocationRepository(client *ent.Client) *OfficeLocationRepositoryImpl {
+	return &OfficeLocationRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create creates a new office location
+func (r *OfficeLocationRepositoryImpl) Create(ctx context.Context, req *dto.CreateOfficeLocationRequest) (*ent.OfficeLocation, error) {
+	query := r.client.OfficeLocation.Create().
+		SetName(req.Name).
+		SetLatitude(*req.Latitude).
+		SetLongitude(*req.Longitude)
+
+	if req.Address != "" {
+		query = query.SetAddress(req.Address)
+	}
+	if req.RadiusMeters > 0 {
+		query = query.SetRadiusMeters(req.RadiusMeters)
+	}
+	if req.Enforcement != "" {
+		query = query.SetEnforcement(officelocation.Enforcement(req.Enforcement))
+	}
+	if req.Timezone != "" {
+		query = query.SetTimezone(req.Timezone)
+	}
+
+	return query.Save(ctx)
+}
+
+// GetByID retrieves an office location by ID
+func (r *OfficeLocationRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.OfficeLocation, error) {
+	return r.client.OfficeLocation.
+		Query().
+		Where(officelocation.ID(id)).
+		Where(officelocation.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// GetByName retrieves an office location by name
+func (r *OfficeLocationRepositoryImpl) GetByName(ctx context.Context, name string) (*ent.OfficeLocation, error) {
+	return r.client.OfficeLocation.
+		Query().
+		Where(officelocation.Name(name)).
+		Where(officelocation.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// Update updates an office location
+func (r *OfficeLocationRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateOfficeLocationRequest) (*ent.OfficeLocation, error) {
+	query := r.client.OfficeLocation.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.Address != "" {
+		query = query.SetAddress(req.Address)
+	}
+	if req.Latitude != nil {
+		query = query.SetLatitude(*req.Latitude)
+	}
+	if req.Longitude != nil {
+		query = query.SetLongitude(*req.Longitude)
+	}
+	if req.RadiusMeters > 0 {
+		query = query.SetRadiusMeters(req.RadiusMeters)
+	}
+	if req.Enforcement != "" {
+		query = query.SetEnforcement(officelocation.Enforcement(req.Enforcement))
+	}
+	// An empty timezone clears it so the application timezone applies again
+	if req.Timezone != nil {
+		query = query.SetTimezone(*req.Timezone)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	return query.Save(ctx)
+}
+
+// Delete soft deletes an office location
+func (r *OfficeLocationRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.OfficeLocation.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves office locations with pagination and filtering
+func (r *OfficeLocationRepositoryImpl) List(ctx context.Context, params *dto.OfficeLocationQueryParams) ([]*ent.OfficeLocation, int, error) {
+	query := r.client.OfficeLocation.
+		Query().
+		Where(officelocation.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Search != "" {
+		query = query.Where(
+			officelocation.Or(
+				officelocation.NameContainsFold(params.Search),
+				officelocation.AddressContainsFold(params.Search),
+			),
+		)
+	}
+
+	if params.IsActive != nil {
+		query = query.Where(officelocation.IsActive(*params.IsActive))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count office locations: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by name asc
+	query = query.Order(ent.Asc(officelocation.FieldName))
+
+	locations, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch office locations: %w", err)
+	}
+
+	return locations, total, nil
+}
+
+// CountAssignedEmployees counts employees whose check-ins are validated against the office location
+func (r *OfficeLocationRepositoryImpl) CountAssignedEmployees(ctx context.Context, id uint64) (int, error) {
+	return r.client.Employee.
+		Query().
+		Where(employee.OfficeLocationID(id)).
+		Where(employee.DeletedAtIsNil()).
+		Count(ctx)
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/officelocation/dto"
+	"mceasy/internal/applications/officelocation/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// OfficeLocationService defines the interface for office location business logic
+type OfficeLocationService interface {
+	CreateOfficeLocation(ctx context.Context, req *dto.CreateOfficeLocationRequest) (*dto.OfficeLocationResponse, error)
+	GetOfficeLocationByID(ctx context.Context, id uint64) (*dto.OfficeLocationResponse, error)
+	UpdateOfficeLocation(ctx context.Context, id uint64, req *dto.UpdateOfficeLocationRequest) (*dto.OfficeLocationResponse, error)
+	DeleteOfficeLocation(ctx context.Context, id uint64) error
+	ListOfficeLocations(ctx context.Context, params *dto.OfficeLocationQueryParams) (*dto.OfficeLocationListResponse, error)
+}
+
+// OfficeLocationServiceImpl implements the OfficeLocationService interface
+type OfficeLocationServiceImpl struct {
+	officeLocationRepo repository.OfficeLocationRepository
+	cache              cache.Cache
+	trx                transaction.Trx
+}
+
+// NewOfficeLocationService creates a new office location service instance
+func NewOfficeLocationService(
+	officeLocationRepo repository.OfficeLocationRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *OfficeLocationServiceImpl {
+	return &OfficeLocationServiceImpl{
+		officeLocationRepo: officeLocationRepo,
+		cache:              cache,
+		trx:                trx,
+	}
+}
+
+// CreateOfficeLocation registers a new office location
+func (s *OfficeLocationServiceImpl) CreateOfficeLocation(ctx context.Context, req *dto.CreateOfficeLocationRequest) (*dto.OfficeLocationResponse, error) {
+	// Office names must be unique
+	existing, _ := s.officeLocationRepo.GetByName(ctx, req.Name)
+	if existing != nil {
+		return nil, fmt.Errorf("office location with name %s already exists", req.Name)
+	}
+
+	location, err := s.officeLocationRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create office location: %w", err)
+	}
+
+	return s.mapToOfficeLocationResponse(location), nil
+}
+
+// GetOfficeLocationByID retrieves an office location by ID
+func (s *OfficeLocationServiceImpl) GetOfficeLocationByID(ctx context.Context, id uint64) (*dto.OfficeLocationResponse, error) {
+	location, err := s.officeLocationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("office location not found: %w", err)
+	}
+
+	return s.mapToOfficeLocationResponse(location), nil
+}
+
+// UpdateOfficeLocation updates an office location
+func (s *OfficeLocationServiceImpl) UpdateOfficeLocation(ctx context.Context, id uint64, req *dto.UpdateOfficeLocationRequest) (*dto.OfficeLocationResponse, error) {
+	// Check if office location exists
+	_, err := s.officeLocationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("office location not found: %w", err)
+	}
+
+	if req.Name != "" {
+		existing, _ := s.officeLocationRepo.GetByName(ctx, req.Name)
+		if existing != nil && existing.ID != id {
+			return nil, fmt.Errorf("office location with name %s already exists", req.Name)
+		}
+	}
+
+	location, err := s.officeLocationRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update office location: %w", err)
+	}
+
+	return s.mapToOfficeLocationResponse(location), nil
+}
+
+// DeleteOfficeLocation soft deletes an office location that has no employees assigned
+func (s *OfficeLocationServiceImpl) DeleteOfficeLocation(ctx context.Context, id uint64) error {
+	// Check if office location exists
+	location, err := s.officeLocationRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("office location not found: %w", err)
+	}
+
+	assigned, err := s.officeLocationRepo.CountAssignedEmployees(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count assigned employees: %w", err)
+	}
+	if assigned > 0 {
+		return fmt.Errorf("office location %s still has %d employees assigned", location.Name, assigned)
+	}
+
+	err = s.officeLocationRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete office location: %w", err)
+	}
+
+	return nil
+}
+
+// ListOfficeLocations retrieves office locations with pagination and filtering
+func (s *OfficeLocationServiceImpl) ListOfficeLocations(ctx context.Context, params *dto.OfficeLocationQueryParams) (*dto.OfficeLocationListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	locations, total, err := s.officeLocationRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list office locations: %w", err)
+	}
+
+	locationResponses := make([]dto.OfficeLocationResponse, len(locations))
+	for i, location := range locations {
+		locationResponses[i] = *s.mapToOfficeLocationResponse(location)
+	}
+
+	return &dto.OfficeLocationListResponse{
+		OfficeLocations: locationResponses,
+		Total:           total,
+		Page:            params.Page,
+		Limit:           params.Limit,
+	}, nil
+}
+
+// mapToOfficeLocationResponse maps an ent.OfficeLocation to dto.OfficeLocationResponse
+func (s *OfficeLocationServiceImpl) mapToOfficeLocationResponse(location *ent.OfficeLocation) *dto.OfficeLocationResponse {
+	return &dto.OfficeLocationResponse{
+		ID:           location.ID,
+		Name:         location.Name,
+		Address:      location.Address,
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+		RadiusMeters: location.RadiusMeters,
+		Enforcement:  string(location.Enforcement),
+		Timezone:     location.Timezone,
+		IsActive:     location.IsActive,
+		CreatedAt:    location.CreatedAt,
+		ModifiedAt:   location.ModifiedAt,
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package officelocation
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/officelocation/repository"
+	"mceasy/internal/applications/officelocation/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from officelocation_injector.go:
+
+func InitializedOfficeLocationService(dbClient *ent.Client, redisClient *redis.Client) *service.OfficeLocationServiceImpl {
+	officeLocationRepositoryImpl := repository.NewOfficeLocationRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	officeLocationServiceImpl := service.NewOfficeLocationService(officeLocationRepositoryImpl, cacheImpl, trxImpl)
+	return officeLocationServiceImpl
+}
+
+// officelocation_injector.go:
+
+var providerOfficeLocation = wire.NewSet(repository.NewOfficeLocationRepository, transaction.NewTrx, service.NewOfficeLocationService, cache.NewCache, wire.Bind(new(repository.OfficeLocationRepository), new(*repository.OfficeLocationRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.OfficeLocationService), new(*service.OfficeLocationServiceImpl)))
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/overtime/dto"
+	"mceasy/internal/applications/overtime/service"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OvertimeController handles HTTP requests for overtime operations
+type OvertimeController struct {
+	overtimeService service.OvertimeService
+}
+
+// NewOvertimeController creates a new overtime controller instance
+func NewOvertimeController(overtimeService service.OvertimeService) *OvertimeController {
+	return &OvertimeController{
+		overtimeService: overtimeService,
+	}
+}
+
+// GetOvertime retrieves an overtime by ID
+// @Summary Get overtime by ID
+// @Description Get detected overtime details by ID
+// @Tags overtime
+// @Accept json
+// @Produce json
+// @Param id path int true "Overtime ID"
+// @Success 200 {object} dto.OvertimeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /overtime/{id} [get]
+func (c *OvertimeController) GetOvertime(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid overtime ID",
+			"message": "Overtime ID must be a valid number",
+		})
+	}
+
+	record, err := c.overtimeService.GetOvertimeByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Overtime not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, record)
+}
+
+// ListOvertime retrieves overtime with pagination and filtering
+// @Summary List overtime
+// @Description Get list of detected overtime with pagination and filtering
+// @Tags overtime
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param employee_id query int false "Employee ID filter"
+// @Param status query string false "Status filter" Enums(pending, approved, rejected)
+// @Param start_date query string false "Start date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Success 200 {object} dto.OvertimeListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /overtime [get]
+func (c *OvertimeController) ListOvertime(ctx echo.Context) error {
+	var params dto.OvertimeQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	overtimes, err := c.overtimeService.ListOvertime(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list overtime",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, overtimes)
+}
+
+// ApproveOvertime approves pending overtime
+// @Summary Approve overtime
+// @Description Approve detected overtime for payment, optionally for fewer minutes than detected. Overtime of a locked payroll period is refused.
+// @Tags overtime
+// @Accept json
+// @Produce json
+// @Param id path int true "Overtime ID"
+// @Param review body dto.ReviewOvertimeRequest true "Review data"
+// @Success 200 {object} dto.OvertimeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /overtime/{id}/approve [post]
+func (c *OvertimeController) ApproveOvertime(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid overtime ID",
+			"message": "Overtime ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewOvertimeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	record, err := c.overtimeService.ApproveOvertime(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to approve overtime", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to approve overtime",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, record)
+}
+
+// RejectOvertime rejects pending overtime
+// @Summary Reject overtime
+// @Description Reject detected overtime so it is excluded from salary
+// @Tags overtime
+// @Accept json
+// @Produce json
+// @Param id path int true "Overtime ID"
+// @Param review body dto.ReviewOvertimeRequest true "Review data"
+// @Success 200 {object} dto.OvertimeResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /overtime/{id}/reject [post]
+func (c *OvertimeController) RejectOvertime(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid overtime ID",
+			"message": "Overtime ID must be a valid number",
+		})
+	}
+
+	var req dto.ReviewOvertimeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	record, err := c.overtimeService.RejectOvertime(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to reject overtime", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to reject overtime",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, record)
+}
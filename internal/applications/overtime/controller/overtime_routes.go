@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterOvertimeRoutes registers all overtime routes
+func RegisterOvertimeRoutes(e *echo.Group, controller *OvertimeController) {
+	e.GET("/overtime", controller.ListOvertime)
+	e.GET("/overtime/:id", controller.GetOvertime)
+	e.POST("/overtime/:id/approve", controller.ApproveOvertime)
+	e.POST("/overtime/:id/reject", controller.RejectOvertime)
+}
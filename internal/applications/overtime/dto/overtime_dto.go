@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+)
+
+// ReviewOvertimeRequest represents a manager's decision on detected overtime
+type ReviewOvertimeRequest struct {
+	ReviewedBy      uint64 `json:"reviewed_by" validate:"required"`
+	ApprovedMinutes *int   `json:"approved_minutes,omitempty" validate:"omitempty,min=1"`
+	ReviewNotes     string `json:"review_notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// OvertimeResponse represents the overtime response structure
+type OvertimeResponse struct {
+	ID              uint64     `json:"id"`
+	EmployeeID      uint64     `json:"employee_id"`
+	EmployeeName    string     `json:"employee_name,omitempty"`
+	AttendanceID    uint64     `json:"attendance_id"`
+	OvertimeDate    time.Time  `json:"overtime_date"`
+	ScheduledEnd    *time.Time `json:"scheduled_end,omitempty"`
+	ActualEnd       time.Time  `json:"actual_end"`
+	Minutes         int        `json:"minutes"`
+	ApprovedMinutes *int       `json:"approved_minutes,omitempty"`
+	IsRestDay       bool       `json:"is_rest_day"`
+	Status          string     `json:"status"`
+	ReviewedBy      *uint64    `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes     string     `json:"review_notes,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ModifiedAt      time.Time  `json:"modified_at"`
+}
+
+// OvertimeListResponse represents the response for overtime list
+type OvertimeListResponse struct {
+	Overtimes []OvertimeResponse `json:"overtimes"`
+	Total     int                `json:"total"`
+	Page      int                `json:"page"`
+	Limit     int                `json:"limit"`
+}
+
+// OvertimeQueryParams represents query parameters for overtime list
+type OvertimeQueryParams struct {
+	Page       int    `query:"page" validate:"omitempty,min=1"`
+	Limit      int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID uint64 `query:"employee_id" validate:"omitempty,min=1"`
+	Status     string `query:"status" validate:"omitempty,oneof=pending approved rejected"`
+	StartDate  string `query:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate    string `query:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package overtime
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/overtime/repository"
+	"mceasy/internal/applications/overtime/service"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerOvertime = wire.NewSet(
+	repository.NewOvertimeRepository,
+	periodlock.NewPeriodLock,
+	transaction.NewTrx,
+	service.NewOvertimeService,
+
+	wire.Bind(new(repository.OvertimeRepository), new(*repository.OvertimeRepositoryImpl)),
+	wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(service.OvertimeService), new(*service.OvertimeServiceImpl)),
+)
+
+func InitializedOvertimeService(dbClient *ent.Client, redisClient *redis.Client) *service.OvertimeServiceImpl {
+	wire.Build(providerOvertime)
+	return nil
+}
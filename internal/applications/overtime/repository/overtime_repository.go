@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/overtime"
+	"mceasy/internal/applications/overtime/dto"
+)
+
+// OvertimeRepository defines the interface for overtime data operations
+type OvertimeRepository interface {
+	GetByID(ctx context.Context, id uint64) (*ent.Overtime, error)
+	List(ctx context.Context, params *dto.OvertimeQueryParams, startDate, endDate time.Time) ([]*ent.Overtime, int, error)
+	Review(ctx context.Context, id uint64, status overtime.Status, approvedMinutes *int, req *dto.ReviewOvertimeRequest) (bool, error)
+}
+
+// OvertimeRepositoryImpl implements the OvertimeRepository interface
+type OvertimeRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewOvertimeRepository creates a new overtime repository instance
+func NewOvertimeRepository(client *ent.Client) *OvertimeRepositoryImpl {
+	return &OvertimeRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, an approval is checked against the payroll period and claimed
+// together
+func (r *OvertimeRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// GetByID retrieves an overtime by ID
+func (r *OvertimeRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.Overtime, error) {
+	return r.db(ctx).Overtime.
+		Query().
+		Where(overtime.ID(id)).
+		Where(overtime.DeletedAtIsNil()).
+		WithEmployee().
+		First(ctx)
+}
+
+// List retrieves overtime with pagination and filtering
+func (r *OvertimeRepositoryImpl) List(ctx context.Context, params *dto.OvertimeQueryParams, startDate, endDate time.Time) ([]*ent.Overtime, int, error) {
+	query := r.client.Overtime.
+		Query().
+		Where(overtime.DeletedAtIsNil()).
+		WithEmployee()
+
+	// Apply filters
+	if params.EmployeeID > 0 {
+		query = query.Where(overtime.EmployeeID(params.EmployeeID))
+	}
+
+	if params.Status != "" {
+		query = query.Where(overtime.StatusEQ(overtime.Status(params.Status)))
+	}
+
+	if !startDate.IsZero() {
+		query = query.Where(overtime.OvertimeDateGTE(startDate))
+	}
+
+	if !endDate.IsZero() {
+		query = query.Where(overtime.OvertimeDateLTE(endDate))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count overtime: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by overtime_date desc
+	query = query.Order(ent.Desc(overtime.FieldOvertimeDate), ent.Desc(overtime.FieldID))
+
+	overtimes, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch overtime: %w", err)
+	}
+
+	return overtimes, total, nil
+}
+
+// Review records the approval or rejection of an overtime that is still pending and reports whether it was
+// pending, a concurrent review of the same overtime leaves it untouched
+func (r *OvertimeRepositoryImpl) Review(ctx context.Context, id uint64, status overtime.Status, approvedMinutes *int, req *dto.ReviewOvertimeRequest) (bool, error) {
+	query := r.db(ctx).Overtime.Update().
+		Where(overtime.ID(id)).
+		Where(overtime.StatusEQ(overtime.StatusPending)).
+		Where(overtime.DeletedAtIsNil()).
+		SetStatus(status).
+		SetNillableApprovedMinutes(approvedMinutes).
+		SetReviewedBy(req.ReviewedBy).
+		SetReviewedAt(time.Now())
+
+	if req.ReviewNotes != "" {
+		query = query.SetReviewNotes(req.ReviewNotes)
+	}
+
+	claimed, err := query.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return claimed > 0, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/overtime"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/overtime/dto"
+	"mceasy/internal/applications/overtime/repository"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+)
+
+// OvertimeService defines the interface for overtime business logic
+type OvertimeService interface {
+	GetOvertimeByID(ctx context.Context, id uint64) (*dto.OvertimeResponse, error)
+	ListOvertime(ctx context.Context, params *dto.OvertimeQueryParams) (*dto.OvertimeListResponse, error)
+	ApproveOvertime(ctx context.Context, id uint64, req *dto.ReviewOvertimeRequest) (*dto.OvertimeResponse, error)
+	RejectOvertime(ctx context.Context, id uint64, req *dto.ReviewOvertimeRequest) (*dto.OvertimeResponse, error)
+}
+
+// OvertimeServiceImpl implements the OvertimeService interface
+type OvertimeServiceImpl struct {
+	overtimeRepo repository.OvertimeRepository
+	periodLock   periodlock.PeriodLock
+	trx          transaction.Trx
+}
+
+// NewOvertimeService creates a new overtime service instance
+func NewOvertimeService(
+	overtimeRepo repository.OvertimeRepository,
+	periodLock periodlock.PeriodLock,
+	trx transaction.Trx,
+) *OvertimeServiceImpl {
+	return &OvertimeServiceImpl{
+		overtimeRepo: overtimeRepo,
+		periodLock:   periodLock,
+		trx:          trx,
+	}
+}
+
+// GetOvertimeByID retrieves an overtime by ID
+func (s *OvertimeServiceImpl) GetOvertimeByID(ctx context.Context, id uint64) (*dto.OvertimeResponse, error) {
+	record, err := s.overtimeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("overtime not found: %w", err)
+	}
+
+	return s.mapToOvertimeResponse(record), nil
+}
+
+// ListOvertime retrieves overtime with pagination and filtering
+func (s *OvertimeServiceImpl) ListOvertime(ctx context.Context, params *dto.OvertimeQueryParams) (*dto.OvertimeListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	var startDate, endDate time.Time
+	var err error
+
+	if params.StartDate != "" {
+		if startDate, err = parseOvertimeDate(params.StartDate); err != nil {
+			return nil, err
+		}
+	}
+	if params.EndDate != "" {
+		if endDate, err = parseOvertimeDate(params.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	overtimes, total, err := s.overtimeRepo.List(ctx, params, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overtime: %w", err)
+	}
+
+	overtimeResponses := make([]dto.OvertimeResponse, len(overtimes))
+	for i, record := range overtimes {
+		overtimeResponses[i] = *s.mapToOvertimeResponse(record)
+	}
+
+	return &dto.OvertimeListResponse{
+		Overtimes: overtimeResponses,
+		Total:     total,
+		Page:      params.Page,
+		Limit:     params.Limit,
+	}, nil
+}
+
+// ApproveOvertime approves detected overtime for payment, optionally for fewer minutes than detected. Overtime
+// of a locked payroll period is not approved, its salaries were already paid.
+func (s *OvertimeServiceImpl) ApproveOvertime(ctx context.Context, id uint64, req *dto.ReviewOvertimeRequest) (*dto.OvertimeResponse, error) {
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		record, err := s.getPendingOvertime(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.periodLock.EnsureOpen(txCtx, record.OvertimeDate); err != nil {
+			return err
+		}
+
+		approvedMinutes := record.Minutes
+		if req.ApprovedMinutes != nil {
+			if *req.ApprovedMinutes > record.Minutes {
+				return exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+					fmt.Errorf("approved minutes %d exceed the %d detected minutes", *req.ApprovedMinutes, record.Minutes))
+			}
+			approvedMinutes = *req.ApprovedMinutes
+		}
+
+		return s.claimOvertime(txCtx, id, overtime.StatusApproved, &approvedMinutes, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetOvertimeByID(ctx, id)
+}
+
+// RejectOvertime rejects detected overtime so it is not paid
+func (s *OvertimeServiceImpl) RejectOvertime(ctx context.Context, id uint64, req *dto.ReviewOvertimeRequest) (*dto.OvertimeResponse, error) {
+	if _, err := s.getPendingOvertime(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.claimOvertime(ctx, id, overtime.StatusRejected, nil, req); err != nil {
+		return nil, err
+	}
+
+	return s.GetOvertimeByID(ctx, id)
+}
+
+// claimOvertime records the review of an overtime, an InvalidArgument business error is returned when the
+// overtime was reviewed in the meantime
+func (s *OvertimeServiceImpl) claimOvertime(ctx context.Context, id uint64, status overtime.Status, approvedMinutes *int, req *dto.ReviewOvertimeRequest) error {
+	claimed, err := s.overtimeRepo.Review(ctx, id, status, approvedMinutes, req)
+	if err != nil {
+		return fmt.Errorf("failed to review overtime: %w", err)
+	}
+	if !claimed {
+		return exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("overtime %d is no longer pending", id))
+	}
+
+	return nil
+}
+
+// getPendingOvertime retrieves an overtime and ensures it is still waiting for review
+func (s *OvertimeServiceImpl) getPendingOvertime(ctx context.Context, id uint64) (*ent.Overtime, error) {
+	record, err := s.overtimeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("overtime not found: %w", err)
+	}
+
+	if record.Status != overtime.StatusPending {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("overtime %d is already %s", id, record.Status))
+	}
+
+	return record, nil
+}
+
+// mapToOvertimeResponse maps an ent.Overtime to dto.OvertimeResponse
+func (s *OvertimeServiceImpl) mapToOvertimeResponse(record *ent.Overtime) *dto.OvertimeResponse {
+	response := &dto.OvertimeResponse{
+		ID:              record.ID,
+		EmployeeID:      record.EmployeeID,
+		AttendanceID:    record.AttendanceID,
+		OvertimeDate:    record.OvertimeDate,
+		ScheduledEnd:    record.ScheduledEnd,
+		ActualEnd:       record.ActualEnd,
+		Minutes:         record.Minutes,
+		ApprovedMinutes: record.ApprovedMinutes,
+		IsRestDay:       record.IsRestDay,
+		Status:          string(record.Status),
+		ReviewedBy:      record.ReviewedBy,
+		ReviewedAt:      record.ReviewedAt,
+		ReviewNotes:     record.ReviewNotes,
+		CreatedAt:       record.CreatedAt,
+		ModifiedAt:      record.ModifiedAt,
+	}
+
+	// Add employee information if available
+	if record.Edges.Employee != nil {
+		response.EmployeeName = record.Edges.Employee.FullName
+	}
+
+	return response
+}
+
+// parseOvertimeDate parses date string in format "2006-01-02"
+func parseOvertimeDate(dateStr string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", dateStr)
+	}
+
+	return date, nil
+}
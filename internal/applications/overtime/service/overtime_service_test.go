@@ -0,0 +1,108 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollperiod"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/overtime/dto"
+	"mceasy/internal/applications/overtime/repository"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOvertimeServiceImpl_ReviewOvertime(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Budi").
+		SetEmail("budi@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	newOvertime := func(date time.Time) *ent.Overtime {
+		att, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(date).
+			SetStatus("present").
+			Save(ctx)
+		require.NoError(t, err)
+
+		record, err := client.Overtime.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceID(att.ID).
+			SetOvertimeDate(date).
+			SetActualEnd(date.Add(19 * time.Hour)).
+			SetMinutes(120).
+			Save(ctx)
+		require.NoError(t, err)
+		return record
+	}
+
+	_, err = client.PayrollPeriod.Create().
+		SetPeriodMonth(time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedAt(time.Date(2025, time.August, 3, 10, 0, 0, 0, time.UTC)).
+		SetLockedBy(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	overtimeService := NewOvertimeService(
+		repository.NewOvertimeRepository(client),
+		periodlock.NewPeriodLock(client),
+		transaction.NewTrx(client),
+	)
+
+	errorCode := func(err error) int {
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok, "expected a business logic error, got %v", err)
+		return bizErr.ErrorCode
+	}
+
+	t.Run("overtime of a locked payroll period is not approved", func(t *testing.T) {
+		record := newOvertime(time.Date(2025, time.July, 30, 0, 0, 0, 0, time.UTC))
+
+		_, err := overtimeService.ApproveOvertime(ctx, record.ID, &dto.ReviewOvertimeRequest{ReviewedBy: 1})
+		assert.Equal(t, exceptions.PeriodLocked, errorCode(err))
+
+		unchanged, err := overtimeService.GetOvertimeByID(ctx, record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", unchanged.Status)
+		assert.Nil(t, unchanged.ApprovedMinutes)
+	})
+
+	t.Run("overtime is reviewed once", func(t *testing.T) {
+		record := newOvertime(time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC))
+
+		approvedMinutes := 90
+		approved, err := overtimeService.ApproveOvertime(ctx, record.ID, &dto.ReviewOvertimeRequest{ReviewedBy: 1, ApprovedMinutes: &approvedMinutes})
+		require.NoError(t, err)
+		assert.Equal(t, "approved", approved.Status)
+		require.NotNil(t, approved.ApprovedMinutes)
+		assert.Equal(t, 90, *approved.ApprovedMinutes)
+
+		_, err = overtimeService.ApproveOvertime(ctx, record.ID, &dto.ReviewOvertimeRequest{ReviewedBy: 2})
+		assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+		_, err = overtimeService.RejectOvertime(ctx, record.ID, &dto.ReviewOvertimeRequest{ReviewedBy: 2})
+		assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+	})
+
+	t.Run("approved minutes may not exceed the detected minutes", func(t *testing.T) {
+		record := newOvertime(time.Date(2025, time.August, 12, 0, 0, 0, 0, time.UTC))
+
+		approvedMinutes := 150
+		_, err := overtimeService.ApproveOvertime(ctx, record.ID, &dto.ReviewOvertimeRequest{ReviewedBy: 1, ApprovedMinutes: &approvedMinutes})
+		assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+	})
+}
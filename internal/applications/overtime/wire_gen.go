@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package overtime
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/overtime/repository"
+	"mceasy/internal/applications/overtime/service"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from overtime_injector.go:
+
+func InitializedOvertimeService(dbClient *ent.Client, redisClient *redis.Client) *service.OvertimeServiceImpl {
+	overtimeRepositoryImpl := repository.NewOvertimeRepository(dbClient)
+	periodLockImpl := periodlock.NewPeriodLock(dbClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	overtimeServiceImpl := service.NewOvertimeService(overtimeRepositoryImpl, periodLockImpl, trxImpl)
+	return overtimeServiceImpl
+}
+
+// overtime_injector.go:
+
+var providerOvertime = wire.NewSet(repository.NewOvertimeRepository, periodlock.NewPeriodLock, transaction.NewTrx, service.NewOvertimeService, wire.Bind(new(repository.OvertimeRepository), new(*repository.OvertimeRepositoryImpl)), wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(service.OvertimeService), new(*service.OvertimeServiceImpl)))
@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/payrollperiod/dto"
+	"mceasy/internal/applications/payrollperiod/service"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PayrollPeriodController handles HTTP requests for payroll period operations
+type PayrollPeriodController struct {
+	periodService service.PayrollPeriodService
+}
+
+// NewPayrollPeriodController creates a new payroll period controller instance
+func NewPayrollPeriodController(periodService service.PayrollPeriodService) *PayrollPeriodController {
+	return &PayrollPeriodController{
+		periodService: periodService,
+	}
+}
+
+// GetPayrollPeriod retrieves the payroll period of a month
+// @Summary Get payroll period
+// @Description Get the lock status of a month and its lock/unlock audit trail, months never locked are open
+// @Tags payroll-periods
+// @Accept json
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Success 200 {object} dto.PayrollPeriodResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-periods/{month} [get]
+func (c *PayrollPeriodController) GetPayrollPeriod(ctx echo.Context) error {
+	month, err := time.Parse("2006-01", ctx.Param("month"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid month",
+			"message": "Month must be in YYYY-MM format",
+		})
+	}
+
+	period, err := c.periodService.GetPayrollPeriod(ctx.Request().Context(), month)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to get payroll period",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, period)
+}
+
+// ListPayrollPeriods retrieves payroll periods with pagination and filtering
+// @Summary List payroll periods
+// @Description Get list of months that were ever locked, newest first
+// @Tags payroll-periods
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param status query string false "Status filter" Enums(open, locked)
+// @Param year query int false "Year filter"
+// @Success 200 {object} dto.PayrollPeriodListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-periods [get]
+func (c *PayrollPeriodController) ListPayrollPeriods(ctx echo.Context) error {
+	var params dto.PayrollPeriodQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	periods, err := c.periodService.ListPayrollPeriods(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list payroll periods",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, periods)
+}
+
+// LockPayrollPeriod locks a month after its payroll was paid
+// @Summary Lock payroll period
+// @Description Lock a month after its salaries were paid, its attendance and salary calculations are refused afterwards
+// @Tags payroll-periods
+// @Accept json
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Param lock body dto.LockPayrollPeriodRequest true "Lock data"
+// @Success 200 {object} dto.PayrollPeriodResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-periods/{month}/lock [post]
+func (c *PayrollPeriodController) LockPayrollPeriod(ctx echo.Context) error {
+	month, err := time.Parse("2006-01", ctx.Param("month"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid month",
+			"message": "Month must be in YYYY-MM format",
+		})
+	}
+
+	var req dto.LockPayrollPeriodRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	period, err := c.periodService.LockPayrollPeriod(ctx.Request().Context(), month, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to lock payroll period", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to lock payroll period",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, period)
+}
+
+// UnlockPayrollPeriod reopens a locked month
+// @Summary Unlock payroll period
+// @Description Reopen a locked month so its attendance and salaries can be corrected, the admin and the reason are kept in the audit trail
+// @Tags payroll-periods
+// @Accept json
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Param unlock body dto.UnlockPayrollPeriodRequest true "Unlock data"
+// @Success 200 {object} dto.PayrollPeriodResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-periods/{month}/unlock [post]
+func (c *PayrollPeriodController) UnlockPayrollPeriod(ctx echo.Context) error {
+	month, err := time.Parse("2006-01", ctx.Param("month"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid month",
+			"message": "Month must be in YYYY-MM format",
+		})
+	}
+
+	var req dto.UnlockPayrollPeriodRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	period, err := c.periodService.UnlockPayrollPeriod(ctx.Request().Context(), month, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to unlock payroll period", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to unlock payroll period",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, period)
+}
@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterPayrollPeriodRoutes registers all payroll period routes
+func RegisterPayrollPeriodRoutes(e *echo.Group, controller *PayrollPeriodController) {
+	e.GET("/payroll-periods", controller.ListPayrollPeriods)
+	e.GET("/payroll-periods/:month", controller.GetPayrollPeriod)
+	e.POST("/payroll-periods/:month/lock", controller.LockPayrollPeriod)
+	e.POST("/payroll-periods/:month/unlock", controller.UnlockPayrollPeriod)
+}
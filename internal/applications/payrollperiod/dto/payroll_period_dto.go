@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+)
+
+// LockPayrollPeriodRequest represents the request to lock a month after its payroll was paid
+type LockPayrollPeriodRequest struct {
+	LockedBy uint64 `json:"locked_by" validate:"required"`
+	Notes    string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// UnlockPayrollPeriodRequest represents the request to reopen a locked month, the reason is kept in the audit trail
+type UnlockPayrollPeriodRequest struct {
+	UnlockedBy uint64 `json:"unlocked_by" validate:"required"`
+	Reason     string `json:"reason" validate:"required,min=10,max=500"`
+}
+
+// PayrollPeriodResponse represents the payroll period response structure
+type PayrollPeriodResponse struct {
+	ID          uint64                       `json:"id,omitempty"`
+	PeriodMonth string                       `json:"period_month"`
+	Status      string                       `json:"status"`
+	LockedAt    *time.Time                   `json:"locked_at,omitempty"`
+	LockedBy    *uint64                      `json:"locked_by,omitempty"`
+	Events      []PayrollPeriodEventResponse `json:"events,omitempty"`
+	CreatedAt   *time.Time                   `json:"created_at,omitempty"`
+	ModifiedAt  *time.Time                   `json:"modified_at,omitempty"`
+}
+
+// PayrollPeriodEventResponse represents a lock or unlock of a payroll period in the audit trail
+type PayrollPeriodEventResponse struct {
+	ID        uint64    `json:"id"`
+	Action    string    `json:"action"`
+	ActorID   uint64    `json:"actor_id"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PayrollPeriodListResponse represents the response for payroll period list
+type PayrollPeriodListResponse struct {
+	PayrollPeriods []PayrollPeriodResponse `json:"payroll_periods"`
+	Total          int                     `json:"total"`
+	Page           int                     `json:"page"`
+	Limit          int                     `json:"limit"`
+}
+
+// PayrollPeriodQueryParams represents query parameters for payroll period list
+type PayrollPeriodQueryParams struct {
+	Page   int    `query:"page" validate:"omitempty,min=1"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Status string `query:"status" validate:"omitempty,oneof=open locked"`
+	Year   int    `query:"year" validate:"omitempty,min=2000,max=2100"`
+}
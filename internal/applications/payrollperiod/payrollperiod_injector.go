@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package payrollperiod
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/payrollperiod/repository"
+	"mceasy/internal/applications/payrollperiod/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerPayrollPeriod = wire.NewSet(
+	repository.NewPayrollPeriodRepository,
+	transaction.NewTrx,
+	service.NewPayrollPeriodService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.PayrollPeriodRepository), new(*repository.PayrollPeriodRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.PayrollPeriodService), new(*service.PayrollPeriodServiceImpl)),
+)
+
+func InitializedPayrollPeriodService(dbClient *ent.Client, redisClient *redis.Client) *service.PayrollPeriodServiceImpl {
+	wire.Build(providerPayrollPeriod)
+	return nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/internal/applications/payrollperiod/dto"
+)
+
+// PayrollPeriodRepository defines the interface for payroll period data operations
+type PayrollPeriodRepository interface {
+	GetByMonth(ctx context.Context, month time.Time) (*ent.PayrollPeriod, error)
+	Create(ctx context.Context, month time.Time) (*ent.PayrollPeriod, error)
+	Lock(ctx context.Context, id uint64, lockedBy uint64, lockedAt time.Time) error
+	Unlock(ctx context.Context, id uint64) error
+	CreateEvent(ctx context.Context, id uint64, action payrollperiodevent.Action, actorID uint64, reason string) error
+	List(ctx context.Context, params *dto.PayrollPeriodQueryParams) ([]*ent.PayrollPeriod, int, error)
+}
+
+// PayrollPeriodRepositoryImpl implements the PayrollPeriodRepository interface
+type PayrollPeriodRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewPayrollPeriodRepository creates a new payroll period repository instance
+func NewPayrollPeriodRepository(client *ent.Client) *PayrollPeriodRepositoryImpl {
+	return &PayrollPeriodRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, locking a period and writing its audit event commit together
+func (r *PayrollPeriodRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// GetByMonth retrieves the payroll period of a month with its audit trail
+func (r *PayrollPeriodRepositoryImpl) GetByMonth(ctx context.Context, month time.Time) (*ent.PayrollPeriod, error) {
+	return r.db(ctx).PayrollPeriod.
+		Query().
+		Where(payrollperiod.PeriodMonth(month)).
+		Where(payrollperiod.DeletedAtIsNil()).
+		WithEvents(func(q *ent.PayrollPeriodEventQuery) {
+			q.Order(ent.Asc(payrollperiodevent.FieldCreatedAt), ent.Asc(payrollperiodevent.FieldID))
+		}).
+		First(ctx)
+}
+
+// Create creates the open payroll period of a month
+func (r *PayrollPeriodRepositoryImpl) Create(ctx context.Context, month time.Time) (*ent.PayrollPeriod, error) {
+	return r.db(ctx).PayrollPeriod.Create().
+		SetPeriodMonth(month).
+		SetStatus(payrollperiod.StatusOpen).
+		Save(ctx)
+}
+
+// Lock marks a payroll period as locked
+func (r *PayrollPeriodRepositoryImpl) Lock(ctx context.Context, id uint64, lockedBy uint64, lockedAt time.Time) error {
+	return r.db(ctx).PayrollPeriod.
+		UpdateOneID(id).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedBy(lockedBy).
+		SetLockedAt(lockedAt).
+		Exec(ctx)
+}
+
+// Unlock reopens a payroll period, who locked it last stays in the audit trail
+func (r *PayrollPeriodRepositoryImpl) Unlock(ctx context.Context, id uint64) error {
+	return r.db(ctx).PayrollPeriod.
+		UpdateOneID(id).
+		SetStatus(payrollperiod.StatusOpen).
+		ClearLockedBy().
+		ClearLockedAt().
+		Exec(ctx)
+}
+
+// CreateEvent appends a lock or unlock to the audit trail of a payroll period
+func (r *PayrollPeriodRepositoryImpl) CreateEvent(ctx context.Context, id uint64, action payrollperiodevent.Action, actorID uint64, reason string) error {
+	query := r.db(ctx).PayrollPeriodEvent.Create().
+		SetPayrollPeriodID(id).
+		SetAction(action).
+		SetActorID(actorID)
+
+	if reason != "" {
+		query = query.SetReason(reason)
+	}
+
+	return query.Exec(ctx)
+}
+
+// List retrieves payroll periods with pagination and filtering, newest month first
+func (r *PayrollPeriodRepositoryImpl) List(ctx context.Context, params *dto.PayrollPeriodQueryParams) ([]*ent.PayrollPeriod, int, error) {
+	query := r.db(ctx).PayrollPeriod.
+		Query().
+		Where(payrollperiod.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where(payrollperiod.StatusEQ(payrollperiod.Status(params.Status)))
+	}
+
+	if params.Year > 0 {
+		start := time.Date(params.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		query = query.Where(
+			payrollperiod.PeriodMonthGTE(start),
+			payrollperiod.PeriodMonthLT(start.AddDate(1, 0, 0)),
+		)
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count payroll periods: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by period month desc
+	query = query.Order(ent.Desc(payrollperiod.FieldPeriodMonth))
+
+	periods, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch payroll periods: %w", err)
+	}
+
+	return periods, total, nil
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/payrollperiod/dto"
+	"mceasy/internal/applications/payrollperiod/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+
+	"github.com/labstack/gommon/log"
+)
+
+// PayrollPeriodService defines the interface for payroll period business logic
+type PayrollPeriodService interface {
+	GetPayrollPeriod(ctx context.Context, month time.Time) (*dto.PayrollPeriodResponse, error)
+	ListPayrollPeriods(ctx context.Context, params *dto.PayrollPeriodQueryParams) (*dto.PayrollPeriodListResponse, error)
+	LockPayrollPeriod(ctx context.Context, month time.Time, req *dto.LockPayrollPeriodRequest) (*dto.PayrollPeriodResponse, error)
+	UnlockPayrollPeriod(ctx context.Context, month time.Time, req *dto.UnlockPayrollPeriodRequest) (*dto.PayrollPeriodResponse, error)
+}
+
+// PayrollPeriodServiceImpl implements the PayrollPeriodService interface
+type PayrollPeriodServiceImpl struct {
+	periodRepo repository.PayrollPeriodRepository
+	cache      cache.Cache
+	trx        transaction.Trx
+}
+
+// NewPayrollPeriodService creates a new payroll period service instance
+func NewPayrollPeriodService(
+	periodRepo repository.PayrollPeriodRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *PayrollPeriodServiceImpl {
+	return &PayrollPeriodServiceImpl{
+		periodRepo: periodRepo,
+		cache:      cache,
+		trx:        trx,
+	}
+}
+
+// GetPayrollPeriod retrieves the payroll period of a month with its audit trail, months never locked are open
+func (s *PayrollPeriodServiceImpl) GetPayrollPeriod(ctx context.Context, month time.Time) (*dto.PayrollPeriodResponse, error) {
+	month = periodlock.MonthOf(month)
+
+	period, err := s.periodRepo.GetByMonth(ctx, month)
+	if ent.IsNotFound(err) {
+		return &dto.PayrollPeriodResponse{
+			PeriodMonth: periodlock.MonthKey(month),
+			Status:      string(payrollperiod.StatusOpen),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll period: %w", err)
+	}
+
+	return s.mapToPayrollPeriodResponse(period), nil
+}
+
+// ListPayrollPeriods retrieves payroll periods that were ever locked with pagination and filtering
+func (s *PayrollPeriodServiceImpl) ListPayrollPeriods(ctx context.Context, params *dto.PayrollPeriodQueryParams) (*dto.PayrollPeriodListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	periods, total, err := s.periodRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payroll periods: %w", err)
+	}
+
+	periodResponses := make([]dto.PayrollPeriodResponse, len(periods))
+	for i, period := range periods {
+		periodResponses[i] = *s.mapToPayrollPeriodResponse(period)
+	}
+
+	return &dto.PayrollPeriodListResponse{
+		PayrollPeriods: periodResponses,
+		Total:          total,
+		Page:           params.Page,
+		Limit:          params.Limit,
+	}, nil
+}
+
+// LockPayrollPeriod locks a month once its salaries were paid. Attendance and salaries of the month are refused
+// afterwards until the period is unlocked.
+func (s *PayrollPeriodServiceImpl) LockPayrollPeriod(ctx context.Context, month time.Time, req *dto.LockPayrollPeriodRequest) (*dto.PayrollPeriodResponse, error) {
+	month = periodlock.MonthOf(month)
+
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		period, err := s.periodRepo.GetByMonth(txCtx, month)
+		if ent.IsNotFound(err) {
+			period, err = s.periodRepo.Create(txCtx, month)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get payroll period: %w", err)
+		}
+
+		if period.Status == payrollperiod.StatusLocked {
+			return exceptions.NewBusinessLogicError(exceptions.PeriodLocked,
+				fmt.Errorf("payroll period %s is already locked", periodlock.MonthKey(month)))
+		}
+
+		if err := s.periodRepo.Lock(txCtx, period.ID, req.LockedBy, time.Now()); err != nil {
+			return fmt.Errorf("failed to lock payroll period: %w", err)
+		}
+
+		if err := s.periodRepo.CreateEvent(txCtx, period.ID, payrollperiodevent.ActionLock, req.LockedBy, req.Notes); err != nil {
+			return fmt.Errorf("failed to record payroll period event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("payroll period %s locked by user %d", periodlock.MonthKey(month), req.LockedBy)
+
+	return s.GetPayrollPeriod(ctx, month)
+}
+
+// UnlockPayrollPeriod reopens a locked month so its attendance and salaries can be corrected. Who unlocked it
+// and why is kept in the audit trail of the period.
+func (s *PayrollPeriodServiceImpl) UnlockPayrollPeriod(ctx context.Context, month time.Time, req *dto.UnlockPayrollPeriodRequest) (*dto.PayrollPeriodResponse, error) {
+	month = periodlock.MonthOf(month)
+
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		period, err := s.periodRepo.GetByMonth(txCtx, month)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to get payroll period: %w", err)
+		}
+		if period == nil || period.Status != payrollperiod.StatusLocked {
+			return exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+				fmt.Errorf("payroll period %s is not locked", periodlock.MonthKey(month)))
+		}
+
+		if err := s.periodRepo.Unlock(txCtx, period.ID); err != nil {
+			return fmt.Errorf("failed to unlock payroll period: %w", err)
+		}
+
+		if err := s.periodRepo.CreateEvent(txCtx, period.ID, payrollperiodevent.ActionUnlock, req.UnlockedBy, req.Reason); err != nil {
+			return fmt.Errorf("failed to record payroll period event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Warnf("payroll period %s unlocked by user %d: %s", periodlock.MonthKey(month), req.UnlockedBy, req.Reason)
+
+	return s.GetPayrollPeriod(ctx, month)
+}
+
+// mapToPayrollPeriodResponse maps an ent.PayrollPeriod to dto.PayrollPeriodResponse
+func (s *PayrollPeriodServiceImpl) mapToPayrollPeriodResponse(period *ent.PayrollPeriod) *dto.PayrollPeriodResponse {
+	response := &dto.PayrollPeriodResponse{
+		ID:          period.ID,
+		PeriodMonth: periodlock.MonthKey(period.PeriodMonth),
+		Status:      string(period.Status),
+		LockedAt:    period.LockedAt,
+		LockedBy:    period.LockedBy,
+		CreatedAt:   &period.CreatedAt,
+		ModifiedAt:  &period.ModifiedAt,
+	}
+
+	for _, event := range period.Edges.Events {
+		response.Events = append(response.Events, dto.PayrollPeriodEventResponse{
+			ID:        event.ID,
+			Action:    string(event.Action),
+			ActorID:   event.ActorID,
+			Reason:    event.Reason,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return response
+}
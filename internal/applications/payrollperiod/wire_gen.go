@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package payrollperiod
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/payrollperiod/repository"
+	"mceasy/internal/applications/payrollperiod/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from payrollperiod_injector.go:
+
+func InitializedPayrollPeriodService(dbClient *ent.Client, redisClient *redis.Client) *service.PayrollPeriodServiceImpl {
+	payrollPeriodRepositoryImpl := repository.NewPayrollPeriodRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	payrollPeriodServiceImpl := service.NewPayrollPeriodService(payrollPeriodRepositoryImpl, cacheImpl, trxImpl)
+	return payrollPeriodServiceImpl
+}
+
+// payrollperiod_injector.go:
+
+var providerPayrollPeriod = wire.NewSet(repository.NewPayrollPeriodRepository, transaction.NewTrx, service.NewPayrollPeriodService, cache.NewCache, wire.Bind(new(repository.PayrollPeriodRepository), new(*repository.PayrollPeriodRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.PayrollPeriodService), new(*service.PayrollPeriodServiceImpl)))
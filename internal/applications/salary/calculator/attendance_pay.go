@@ -0,0 +1,62 @@
+package calculator
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PayPolicy weights the attendance statuses of a working day when the monthly salary is prorated
+// and deducts a fixed penalty for late days beyond the tolerated ones
+type PayPolicy struct {
+	// ID is 0 for the built-in policy used when none is configured
+	ID                  uint64
+	Name                string
+	PresentWeight       decimal.Decimal
+	LateWeight          decimal.Decimal
+	HalfDayWeight       decimal.Decimal
+	LatePenaltyAmount   decimal.Decimal
+	LatePenaltyFreeDays int
+}
+
+// DefaultPayPolicy is used when no attendance pay policy is configured at all:
+// present and late days are paid in full, a half day pays half and lateness is not penalised
+func DefaultPayPolicy() PayPolicy {
+	return PayPolicy{
+		Name:          "Default",
+		PresentWeight: decimal.NewFromInt(1),
+		LateWeight:    decimal.NewFromInt(1),
+		HalfDayWeight: decimal.New(5, -1),
+	}
+}
+
+// LatePenalty returns the deduction for the late days of a month under the policy
+func (p PayPolicy) LatePenalty(lateDays int) decimal.Decimal {
+	penalised := lateDays - p.LatePenaltyFreeDays
+	if penalised <= 0 || !p.LatePenaltyAmount.IsPositive() {
+		return decimal.Zero
+	}
+
+	return RoundRupiah(p.LatePenaltyAmount.Mul(decimal.NewFromInt(int64(penalised))))
+}
+
+// String describes the weights and penalty of the policy for the calculation formula
+func (p PayPolicy) String() string {
+	description := fmt.Sprintf("%s (present %s, late %s, half day %s", p.Name,
+		p.PresentWeight.String(), p.LateWeight.String(), p.HalfDayWeight.String())
+	if p.LatePenaltyAmount.IsPositive() {
+		description += fmt.Sprintf(", late penalty %s per late day beyond %d", p.LatePenaltyAmount.StringFixed(2), p.LatePenaltyFreeDays)
+	}
+
+	return description + ")"
+}
+
+// ProratedSalary pays the base salary for the paid days out of the working days of the month, rounded to whole
+// rupiah. The base salary is multiplied before it is divided so a full month pays exactly the base salary.
+func ProratedSalary(baseSalary, paidDays decimal.Decimal, workingDays int) decimal.Decimal {
+	if workingDays <= 0 {
+		return baseSalary
+	}
+
+	return RoundRupiah(baseSalary.Mul(paidDays).Div(decimal.NewFromInt(int64(workingDays))))
+}
@@ -0,0 +1,46 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayPolicy(t *testing.T) {
+	policy := PayPolicy{
+		Name:                "Office",
+		PresentWeight:       decimal.NewFromInt(1),
+		LateWeight:          decimal.RequireFromString("0.9"),
+		HalfDayWeight:       decimal.RequireFromString("0.5"),
+		LatePenaltyAmount:   decimal.NewFromInt(25000),
+		LatePenaltyFreeDays: 2,
+	}
+
+	t.Run("late days within the tolerance are not penalised", func(t *testing.T) {
+		assert.True(t, policy.LatePenalty(2).IsZero())
+		assert.Equal(t, "75000", policy.LatePenalty(5).String())
+	})
+
+	t.Run("default policy never penalises", func(t *testing.T) {
+		assert.True(t, DefaultPayPolicy().LatePenalty(10).IsZero())
+	})
+
+	t.Run("description lists weights and penalty", func(t *testing.T) {
+		assert.Equal(t, "Office (present 1, late 0.9, half day 0.5, late penalty 25000.00 per late day beyond 2)", policy.String())
+		assert.Equal(t, "Default (present 1, late 1, half day 0.5)", DefaultPayPolicy().String())
+	})
+
+	t.Run("salary is prorated on fractional paid days", func(t *testing.T) {
+		// 20 working days, 18 present and 2 half days
+		assert.Equal(t, "9500000", ProratedSalary(decimal.NewFromInt(10000000), decimal.NewFromInt(19), 20).String())
+		assert.Equal(t, "10000000", ProratedSalary(decimal.NewFromInt(10000000), decimal.Zero, 0).String())
+	})
+
+	t.Run("prorated salary is rounded half-up to whole rupiah", func(t *testing.T) {
+		// 7,000,000 x 20 / 21 = 6,666,666.67
+		assert.Equal(t, "6666667", ProratedSalary(decimal.NewFromInt(7000000), decimal.NewFromInt(20), 21).String())
+		// 1,000,001 x 0.5 / 1 = 500,000.5
+		assert.Equal(t, "500001", ProratedSalary(decimal.NewFromInt(1000001), decimal.RequireFromString("0.5"), 1).String())
+	})
+}
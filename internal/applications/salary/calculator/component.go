@@ -0,0 +1,92 @@
+package calculator
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// LineKind tells whether a salary line is added to or subtracted from the salary
+type LineKind string
+
+const (
+	LineEarning   LineKind = "earning"
+	LineDeduction LineKind = "deduction"
+	// LineEmployer is paid by the employer on top of the salary, e.g. the employer portion of BPJS
+	LineEmployer LineKind = "employer"
+)
+
+// ComponentMethod is how the monthly amount of a salary component is calculated
+type ComponentMethod string
+
+const (
+	// MethodFixed pays or deducts the same amount every month
+	MethodFixed ComponentMethod = "fixed"
+	// MethodPercentageOfBase is a percentage of the full base salary, not the prorated one
+	MethodPercentageOfBase ComponentMethod = "percentage_of_base"
+	// MethodPerPresentDay is an amount for every day the employee was present, e.g. a meal allowance
+	MethodPerPresentDay ComponentMethod = "per_present_day"
+)
+
+// Component is a salary component as it applies to one employee, assignment overrides already resolved
+type Component struct {
+	ID         uint64
+	Code       string
+	Name       string
+	Kind       LineKind
+	Method     ComponentMethod
+	Amount     decimal.Decimal
+	Percentage decimal.Decimal
+}
+
+// LineItem is an itemized earning or deduction of a salary calculation
+type LineItem struct {
+	// ComponentID is nil for lines that do not come from a salary component
+	ComponentID *uint64
+	Code        string
+	Name        string
+	Kind        LineKind
+	Amount      decimal.Decimal
+	Basis       string
+}
+
+// LineItem calculates the line of the component for a month
+func (c Component) LineItem(baseSalary decimal.Decimal, presentDays int) LineItem {
+	item := LineItem{
+		Code: c.Code,
+		Name: c.Name,
+		Kind: c.Kind,
+	}
+	if c.ID != 0 {
+		id := c.ID
+		item.ComponentID = &id
+	}
+
+	switch c.Method {
+	case MethodPercentageOfBase:
+		item.Amount = RoundRupiah(baseSalary.Mul(c.Percentage).Div(decimal.NewFromInt(100)))
+		item.Basis = fmt.Sprintf("%s%% of %s", c.Percentage.String(), baseSalary.StringFixed(2))
+	case MethodPerPresentDay:
+		item.Amount = RoundRupiah(c.Amount.Mul(decimal.NewFromInt(int64(presentDays))))
+		item.Basis = fmt.Sprintf("%d present days x %s", presentDays, c.Amount.StringFixed(2))
+	default:
+		item.Amount = RoundRupiah(c.Amount)
+		item.Basis = "fixed"
+	}
+
+	return item
+}
+
+// SumLines returns the total earnings and the total deductions of the lines, employer lines are in neither
+func SumLines(items []LineItem) (earnings, deductions decimal.Decimal) {
+	for _, item := range items {
+		switch item.Kind {
+		case LineEarning:
+			earnings = earnings.Add(item.Amount)
+		case LineDeduction:
+			deductions = deductions.Add(item.Amount)
+		}
+	}
+
+	return earnings, deductions
+}
@@ -0,0 +1,48 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponent_LineItem(t *testing.T) {
+	baseSalary := decimal.NewFromInt(7000000)
+
+	t.Run("fixed amount ignores attendance", func(t *testing.T) {
+		transport := Component{ID: 3, Code: "transport", Name: "Transport", Kind: LineEarning, Method: MethodFixed, Amount: decimal.NewFromInt(500000)}
+		item := transport.LineItem(baseSalary, 0)
+		assert.Equal(t, "500000", item.Amount.String())
+		require.NotNil(t, item.ComponentID)
+		assert.Equal(t, uint64(3), *item.ComponentID)
+	})
+
+	t.Run("percentage of base is rounded half-up", func(t *testing.T) {
+		position := Component{Code: "position", Name: "Position", Kind: LineEarning, Method: MethodPercentageOfBase, Percentage: decimal.RequireFromString("2.5")}
+		item := position.LineItem(decimal.NewFromInt(7000001), 0)
+		// 175,000.025
+		assert.Equal(t, "175000", item.Amount.String())
+		assert.Equal(t, "2.5% of 7000001.00", item.Basis)
+		assert.Nil(t, item.ComponentID)
+	})
+
+	t.Run("per present day", func(t *testing.T) {
+		meal := Component{Code: "meal", Name: "Meal", Kind: LineEarning, Method: MethodPerPresentDay, Amount: decimal.RequireFromString("25000.50")}
+		item := meal.LineItem(baseSalary, 21)
+		// 525,010.5
+		assert.Equal(t, "525011", item.Amount.String())
+		assert.Equal(t, "21 present days x 25000.50", item.Basis)
+	})
+}
+
+func TestSumLines(t *testing.T) {
+	earnings, deductions := SumLines([]LineItem{
+		{Kind: LineEarning, Amount: decimal.NewFromInt(7000000)},
+		{Kind: LineEarning, Amount: decimal.NewFromInt(500000)},
+		{Kind: LineDeduction, Amount: decimal.NewFromInt(150000)},
+	})
+	assert.Equal(t, "7500000", earnings.String())
+	assert.Equal(t, "150000", deductions.String())
+}
@@ -0,0 +1,97 @@
+package calculator
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ContributionProgram is BPJS Kesehatan or one of the BPJS Ketenagakerjaan programs
+type ContributionProgram string
+
+const (
+	// ProgramKesehatan is the national health insurance
+	ProgramKesehatan ContributionProgram = "kesehatan"
+	// ProgramJHT is the old age savings (Jaminan Hari Tua)
+	ProgramJHT ContributionProgram = "jht"
+	// ProgramJP is the pension (Jaminan Pensiun)
+	ProgramJP ContributionProgram = "jp"
+	// ProgramJKK is the work accident insurance (Jaminan Kecelakaan Kerja), its rate depends on the risk class
+	ProgramJKK ContributionProgram = "jkk"
+	// ProgramJKM is the death insurance (Jaminan Kematian)
+	ProgramJKM ContributionProgram = "jkm"
+)
+
+// ContributionPrograms lists the programs in the order their lines are itemized
+var ContributionPrograms = []ContributionProgram{ProgramKesehatan, ProgramJHT, ProgramJP, ProgramJKK, ProgramJKM}
+
+var contributionProgramNames = map[ContributionProgram]string{
+	ProgramKesehatan: "BPJS Kesehatan",
+	ProgramJHT:       "BPJS JHT",
+	ProgramJP:        "BPJS JP",
+	ProgramJKK:       "BPJS JKK",
+	ProgramJKM:       "BPJS JKM",
+}
+
+// ContributionRate is the rate of a program in effect for a month, rates are percentages of the wage
+type ContributionRate struct {
+	Program      ContributionProgram
+	EmployerRate decimal.Decimal
+	EmployeeRate decimal.Decimal
+	// WageCap is the highest wage the contribution is calculated from, nil when the program has no cap
+	WageCap *decimal.Decimal
+}
+
+// ContributionLineCode returns the code of the employer or the employee line of a program
+func ContributionLineCode(program ContributionProgram, employer bool) string {
+	if employer {
+		return fmt.Sprintf("bpjs_%s_employer", program)
+	}
+
+	return fmt.Sprintf("bpjs_%s_employee", program)
+}
+
+// TaxableBenefit tells whether the employer portion of the program is taxable income of the employee. The
+// premiums of health, work accident and death insurance are benefits, the old age and pension savings are not.
+func (p ContributionProgram) TaxableBenefit() bool {
+	return p == ProgramKesehatan || p == ProgramJKK || p == ProgramJKM
+}
+
+// PensionSaving tells whether the employee portion of the program reduces the annual taxable income
+func (p ContributionProgram) PensionSaving() bool {
+	return p == ProgramJHT || p == ProgramJP
+}
+
+// Lines calculates the employer and the employee line of the program for a monthly wage, a portion with a zero
+// rate has no line
+func (r ContributionRate) Lines(wage decimal.Decimal) []LineItem {
+	basis := wage
+	capped := ""
+	if r.WageCap != nil && wage.GreaterThan(*r.WageCap) {
+		basis = *r.WageCap
+		capped = " (capped)"
+	}
+
+	name := contributionProgramNames[r.Program]
+	var items []LineItem
+	if r.EmployerRate.IsPositive() {
+		items = append(items, LineItem{
+			Code:   ContributionLineCode(r.Program, true),
+			Name:   name + " (employer)",
+			Kind:   LineEmployer,
+			Amount: RoundRupiah(basis.Mul(r.EmployerRate).Div(hundred)),
+			Basis:  fmt.Sprintf("%s%% of %s%s", r.EmployerRate.String(), basis.StringFixed(2), capped),
+		})
+	}
+	if r.EmployeeRate.IsPositive() {
+		items = append(items, LineItem{
+			Code:   ContributionLineCode(r.Program, false),
+			Name:   name + " (employee)",
+			Kind:   LineDeduction,
+			Amount: RoundRupiah(basis.Mul(r.EmployeeRate).Div(hundred)),
+			Basis:  fmt.Sprintf("%s%% of %s%s", r.EmployeeRate.String(), basis.StringFixed(2), capped),
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,55 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContributionRate_Lines(t *testing.T) {
+	t.Run("wage above the cap contributes from the cap", func(t *testing.T) {
+		wageCap := decimal.NewFromInt(12000000)
+		kesehatan := ContributionRate{Program: ProgramKesehatan, EmployerRate: decimal.NewFromInt(4), EmployeeRate: decimal.NewFromInt(1), WageCap: &wageCap}
+		items := kesehatan.Lines(decimal.NewFromInt(15000000))
+		require.Len(t, items, 2)
+
+		assert.Equal(t, "bpjs_kesehatan_employer", items[0].Code)
+		assert.Equal(t, LineEmployer, items[0].Kind)
+		assert.Equal(t, "480000", items[0].Amount.String())
+		assert.Equal(t, "4% of 12000000.00 (capped)", items[0].Basis)
+
+		assert.Equal(t, "bpjs_kesehatan_employee", items[1].Code)
+		assert.Equal(t, LineDeduction, items[1].Kind)
+		assert.Equal(t, "120000", items[1].Amount.String())
+	})
+
+	t.Run("uncapped program", func(t *testing.T) {
+		jht := ContributionRate{Program: ProgramJHT, EmployerRate: decimal.RequireFromString("3.7"), EmployeeRate: decimal.NewFromInt(2)}
+		items := jht.Lines(decimal.NewFromInt(10000000))
+		require.Len(t, items, 2)
+		assert.Equal(t, "370000", items[0].Amount.String())
+		assert.Equal(t, "200000", items[1].Amount.String())
+		assert.Equal(t, "2% of 10000000.00", items[1].Basis)
+	})
+
+	t.Run("employer only program is rounded half-up", func(t *testing.T) {
+		jkk := ContributionRate{Program: ProgramJKK, EmployerRate: decimal.RequireFromString("0.24")}
+		items := jkk.Lines(decimal.NewFromInt(7654321))
+		require.Len(t, items, 1)
+		// 18,370.3704
+		assert.Equal(t, "18370", items[0].Amount.String())
+		assert.Equal(t, "BPJS JKK (employer)", items[0].Name)
+	})
+}
+
+func TestSumLines_IgnoresEmployerLines(t *testing.T) {
+	earnings, deductions := SumLines([]LineItem{
+		{Kind: LineEarning, Amount: decimal.NewFromInt(10000000)},
+		{Kind: LineEmployer, Amount: decimal.NewFromInt(400000)},
+		{Kind: LineDeduction, Amount: decimal.NewFromInt(100000)},
+	})
+	assert.Equal(t, "10000000", earnings.String())
+	assert.Equal(t, "100000", deductions.String())
+}
@@ -0,0 +1,14 @@
+package calculator
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// RupiahPlaces is the number of decimal places computed amounts are rounded to, salaries are paid in whole rupiah
+const RupiahPlaces = 0
+
+// RoundRupiah rounds a computed amount half-up to whole rupiah: 0.5 rupiah and more rounds up, less rounds down.
+// Only results are rounded, intermediate rates such as the daily or hourly rate keep their precision.
+func RoundRupiah(amount decimal.Decimal) decimal.Decimal {
+	return amount.Round(RupiahPlaces)
+}
@@ -0,0 +1,78 @@
+package calculator
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// HourlyRateDivisor divides the monthly wage into the overtime hourly rate (Kepmenaker 102/2004 article 9)
+const HourlyRateDivisor = 173
+
+// overtimeTier pays the given number of minutes with a multiplier of the hourly rate
+type overtimeTier struct {
+	minutes    int
+	multiplier decimal.Decimal
+}
+
+var (
+	// Working day: 1.5x for the first hour, 2x for every following hour
+	workingDayTiers = []overtimeTier{
+		{minutes: 60, multiplier: decimal.RequireFromString("1.5")},
+		{minutes: math.MaxInt32, multiplier: decimal.NewFromInt(2)},
+	}
+
+	// Rest day or public holiday on a 5 day work week: 2x for the first 8 hours, 3x for the 9th, 4x afterwards
+	fiveDayWeekRestDayTiers = []overtimeTier{
+		{minutes: 8 * 60, multiplier: decimal.NewFromInt(2)},
+		{minutes: 60, multiplier: decimal.NewFromInt(3)},
+		{minutes: math.MaxInt32, multiplier: decimal.NewFromInt(4)},
+	}
+
+	// Rest day or public holiday on a 6 day work week: 2x for the first 7 hours, 3x for the 8th, 4x afterwards
+	sixDayWeekRestDayTiers = []overtimeTier{
+		{minutes: 7 * 60, multiplier: decimal.NewFromInt(2)},
+		{minutes: 60, multiplier: decimal.NewFromInt(3)},
+		{minutes: math.MaxInt32, multiplier: decimal.NewFromInt(4)},
+	}
+)
+
+// OvertimeHourlyRate returns the overtime hourly rate, 1/173 of the monthly wage, unrounded
+func OvertimeHourlyRate(monthlyWage decimal.Decimal) decimal.Decimal {
+	return monthlyWage.Div(decimal.NewFromInt(HourlyRateDivisor))
+}
+
+// OvertimePay calculates the pay for one day of overtime, rounded to whole rupiah. Rest days and public holidays
+// use the multipliers of the work week, a schedule with more than 5 working days per week uses the 6 day week
+// multipliers. Partial hours are paid pro rata.
+func OvertimePay(monthlyWage decimal.Decimal, minutes int, restDay bool, workingDaysPerWeek int) decimal.Decimal {
+	if minutes <= 0 || !monthlyWage.IsPositive() {
+		return decimal.Zero
+	}
+
+	tiers := workingDayTiers
+	if restDay {
+		tiers = fiveDayWeekRestDayTiers
+		if workingDaysPerWeek > 5 {
+			tiers = sixDayWeekRestDayTiers
+		}
+	}
+
+	// Minutes weighted by their multiplier, the wage is divided once at the end to keep the pay exact
+	remaining := minutes
+	weightedMinutes := decimal.Zero
+	for _, tier := range tiers {
+		if remaining <= 0 {
+			break
+		}
+
+		tierMinutes := remaining
+		if tier.minutes < tierMinutes {
+			tierMinutes = tier.minutes
+		}
+		weightedMinutes = weightedMinutes.Add(decimal.NewFromInt(int64(tierMinutes)).Mul(tier.multiplier))
+		remaining -= tierMinutes
+	}
+
+	return RoundRupiah(monthlyWage.Mul(weightedMinutes).Div(decimal.NewFromInt(HourlyRateDivisor * 60)))
+}
@@ -0,0 +1,45 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOvertimePay(t *testing.T) {
+	// 3,460,000 / 173 gives an hourly rate of 20,000
+	monthlyWage := decimal.NewFromInt(3460000)
+
+	t.Run("hourly rate is 1/173 of the monthly wage", func(t *testing.T) {
+		assert.Equal(t, "20000", OvertimeHourlyRate(monthlyWage).String())
+	})
+
+	t.Run("working day pays 1.5x then 2x", func(t *testing.T) {
+		// 1 x 1.5 x 20,000 + 2 x 2 x 20,000
+		assert.Equal(t, "110000", OvertimePay(monthlyWage, 180, false, 5).String())
+	})
+
+	t.Run("partial first hour is paid pro rata", func(t *testing.T) {
+		assert.Equal(t, "15000", OvertimePay(monthlyWage, 30, false, 5).String())
+	})
+
+	t.Run("rest day on a 5 day week", func(t *testing.T) {
+		// 8 x 2 x 20,000 + 1 x 3 x 20,000 + 1 x 4 x 20,000
+		assert.Equal(t, "460000", OvertimePay(monthlyWage, 600, true, 5).String())
+	})
+
+	t.Run("rest day on a 6 day week", func(t *testing.T) {
+		// 7 x 2 x 20,000 + 1 x 3 x 20,000 + 2 x 4 x 20,000
+		assert.Equal(t, "500000", OvertimePay(monthlyWage, 600, true, 6).String())
+	})
+
+	t.Run("no overtime pays nothing", func(t *testing.T) {
+		assert.True(t, OvertimePay(monthlyWage, 0, false, 5).IsZero())
+	})
+
+	t.Run("pay of a wage not divisible by 173 is rounded once", func(t *testing.T) {
+		// 5,000,000 x 1.5 / 173 = 43,352.60
+		assert.Equal(t, "43353", OvertimePay(decimal.NewFromInt(5000000), 60, false, 5).String())
+	})
+}
@@ -0,0 +1,272 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PTKPStatus is the tax status of an employee, single (TK) or married (K) with up to three dependents
+type PTKPStatus string
+
+const (
+	PTKPStatusTK0 PTKPStatus = "TK/0"
+	PTKPStatusTK1 PTKPStatus = "TK/1"
+	PTKPStatusTK2 PTKPStatus = "TK/2"
+	PTKPStatusTK3 PTKPStatus = "TK/3"
+	PTKPStatusK0  PTKPStatus = "K/0"
+	PTKPStatusK1  PTKPStatus = "K/1"
+	PTKPStatusK2  PTKPStatus = "K/2"
+	PTKPStatusK3  PTKPStatus = "K/3"
+)
+
+// TERCategory selects the monthly effective rate table of PP 58/2023 by PTKP status
+type TERCategory string
+
+const (
+	TERCategoryA TERCategory = "A"
+	TERCategoryB TERCategory = "B"
+	TERCategoryC TERCategory = "C"
+)
+
+var (
+	// Annual non-taxable income (PMK 101/2016): 54,000,000 for the employee, 4,500,000 more when married and for
+	// every dependent
+	ptkpByStatus = map[PTKPStatus]int64{
+		PTKPStatusTK0: 54000000,
+		PTKPStatusTK1: 58500000,
+		PTKPStatusTK2: 63000000,
+		PTKPStatusTK3: 67500000,
+		PTKPStatusK0:  58500000,
+		PTKPStatusK1:  63000000,
+		PTKPStatusK2:  67500000,
+		PTKPStatusK3:  72000000,
+	}
+
+	terCategoryByStatus = map[PTKPStatus]TERCategory{
+		PTKPStatusTK0: TERCategoryA,
+		PTKPStatusTK1: TERCategoryA,
+		PTKPStatusK0:  TERCategoryA,
+		PTKPStatusTK2: TERCategoryB,
+		PTKPStatusTK3: TERCategoryB,
+		PTKPStatusK1:  TERCategoryB,
+		PTKPStatusK2:  TERCategoryB,
+		PTKPStatusK3:  TERCategoryC,
+	}
+)
+
+// terBracket applies the rate, in percent, to a monthly gross income up to and including upTo
+type terBracket struct {
+	upTo int64
+	rate string
+}
+
+// Monthly effective rates of the appendix of PP 58/2023, in effect since January 2024
+var terTables = map[TERCategory][]terBracket{
+	TERCategoryA: {
+		{5400000, "0"}, {5650000, "0.25"}, {5950000, "0.5"}, {6300000, "0.75"},
+		{6750000, "1"}, {7500000, "1.25"}, {8550000, "1.5"}, {9650000, "1.75"},
+		{10050000, "2"}, {10350000, "2.25"}, {10700000, "2.5"}, {11050000, "3"},
+		{11600000, "3.5"}, {12500000, "4"}, {13750000, "5"}, {15100000, "6"},
+		{16950000, "7"}, {19750000, "8"}, {24150000, "9"}, {26450000, "10"},
+		{28000000, "11"}, {30050000, "12"}, {32400000, "13"}, {35400000, "14"},
+		{39100000, "15"}, {43850000, "16"}, {47800000, "17"}, {51400000, "18"},
+		{56300000, "19"}, {62200000, "20"}, {68600000, "21"}, {77500000, "22"},
+		{89000000, "23"}, {103000000, "24"}, {125000000, "25"}, {157000000, "26"},
+		{206000000, "27"}, {337000000, "28"}, {454000000, "29"}, {550000000, "30"},
+		{695000000, "31"}, {910000000, "32"}, {1400000000, "33"}, {math.MaxInt64, "34"},
+	},
+	TERCategoryB: {
+		{6200000, "0"}, {6500000, "0.25"}, {6850000, "0.5"}, {7300000, "0.75"},
+		{9200000, "1"}, {10750000, "1.5"}, {11250000, "2"}, {11600000, "2.5"},
+		{12600000, "3"}, {13600000, "4"}, {14950000, "5"}, {16400000, "6"},
+		{18450000, "7"}, {21850000, "8"}, {26000000, "9"}, {27700000, "10"},
+		{29350000, "11"}, {31450000, "12"}, {33950000, "13"}, {37100000, "14"},
+		{41100000, "15"}, {45800000, "16"}, {49500000, "17"}, {53800000, "18"},
+		{58500000, "19"}, {64000000, "20"}, {71000000, "21"}, {80000000, "22"},
+		{93000000, "23"}, {109000000, "24"}, {129000000, "25"}, {163000000, "26"},
+		{211000000, "27"}, {374000000, "28"}, {459000000, "29"}, {555000000, "30"},
+		{704000000, "31"}, {957000000, "32"}, {1405000000, "33"}, {math.MaxInt64, "34"},
+	},
+	TERCategoryC: {
+		{6600000, "0"}, {6950000, "0.25"}, {7350000, "0.5"}, {7800000, "0.75"},
+		{8850000, "1"}, {9800000, "1.25"}, {10950000, "1.5"}, {11200000, "1.75"},
+		{12050000, "2"}, {12950000, "3"}, {14150000, "4"}, {15550000, "5"},
+		{17050000, "6"}, {19500000, "7"}, {22700000, "8"}, {26600000, "9"},
+		{28100000, "10"}, {30100000, "11"}, {32600000, "12"}, {35400000, "13"},
+		{38900000, "14"}, {43000000, "15"}, {47400000, "16"}, {51200000, "17"},
+		{55800000, "18"}, {60400000, "19"}, {66700000, "20"}, {74500000, "21"},
+		{83200000, "22"}, {95600000, "23"}, {110000000, "24"}, {134000000, "25"},
+		{169000000, "26"}, {221000000, "27"}, {390000000, "28"}, {463000000, "29"},
+		{561000000, "30"}, {709000000, "31"}, {965000000, "32"}, {1419000000, "33"},
+		{math.MaxInt64, "34"},
+	},
+}
+
+// article17Tier taxes the given width of the annual taxable income with a rate in percent
+type article17Tier struct {
+	width int64
+	rate  decimal.Decimal
+}
+
+// Progressive rates of Article 17 paragraph 1a of the income tax law as amended by UU HPP 7/2021
+var article17Tiers = []article17Tier{
+	{width: 60000000, rate: decimal.NewFromInt(5)},
+	{width: 190000000, rate: decimal.NewFromInt(15)},
+	{width: 250000000, rate: decimal.NewFromInt(25)},
+	{width: 4500000000, rate: decimal.NewFromInt(30)},
+	{width: math.MaxInt64, rate: decimal.NewFromInt(35)},
+}
+
+var (
+	// Position cost is 5% of the gross income, at most 500,000 a month or 6,000,000 a year
+	positionCostRate       = decimal.RequireFromString("0.05")
+	positionCostMonthlyCap = decimal.NewFromInt(500000)
+
+	// Employees without a tax ID are withheld 20% more (Article 21 paragraph 5a)
+	nonNPWPSurcharge = decimal.RequireFromString("1.2")
+
+	hundred  = decimal.NewFromInt(100)
+	thousand = decimal.NewFromInt(1000)
+)
+
+// AnnualPTKP returns the annual non-taxable income of the status, an unknown status counts as TK/0
+func AnnualPTKP(status PTKPStatus) decimal.Decimal {
+	ptkp, ok := ptkpByStatus[status]
+	if !ok {
+		ptkp = ptkpByStatus[PTKPStatusTK0]
+	}
+
+	return decimal.NewFromInt(ptkp)
+}
+
+// TERCategoryOf returns the TER category of the status, an unknown status counts as TK/0
+func TERCategoryOf(status PTKPStatus) TERCategory {
+	category, ok := terCategoryByStatus[status]
+	if !ok {
+		return TERCategoryA
+	}
+
+	return category
+}
+
+// TERRate returns the monthly effective rate, in percent, of a monthly gross income
+func TERRate(category TERCategory, gross decimal.Decimal) decimal.Decimal {
+	brackets, ok := terTables[category]
+	if !ok {
+		brackets = terTables[TERCategoryA]
+	}
+
+	for _, bracket := range brackets {
+		if gross.LessThanOrEqual(decimal.NewFromInt(bracket.upTo)) {
+			return decimal.RequireFromString(bracket.rate)
+		}
+	}
+
+	return decimal.RequireFromString(brackets[len(brackets)-1].rate)
+}
+
+// Article17Tax returns the annual tax of an annual taxable income with the progressive rates, unrounded
+func Article17Tax(taxableIncome decimal.Decimal) decimal.Decimal {
+	tax := decimal.Zero
+	remaining := taxableIncome
+	for _, tier := range article17Tiers {
+		if !remaining.IsPositive() {
+			break
+		}
+		taxed := decimal.Min(remaining, decimal.NewFromInt(tier.width))
+		tax = tax.Add(taxed.Mul(tier.rate).Div(hundred))
+		remaining = remaining.Sub(taxed)
+	}
+
+	return tax
+}
+
+// PPh21Input is what the PPh 21 of an employee for a month depends on
+type PPh21Input struct {
+	Status  PTKPStatus
+	HasNPWP bool
+	Month   time.Month
+	// Gross is the taxable income of the month
+	Gross decimal.Decimal
+	// Pension is the JHT and JP paid by the employee in the month, it reduces the annual taxable income
+	Pension decimal.Decimal
+	// PriorGross, PriorWithheld and PriorPension are the taxable income, the tax withheld and the pension
+	// contributions of the earlier months of the year, only the December true-up uses them
+	PriorGross    decimal.Decimal
+	PriorWithheld decimal.Decimal
+	PriorPension  decimal.Decimal
+	// PaidMonths is the number of months paid in the year including this one, it caps the position cost
+	PaidMonths int
+}
+
+// PPh21 calculates the income tax withheld from the salary of a month. January to November withhold the TER of
+// the monthly gross income, December withholds the annual tax with the Article 17 rates minus what was withheld
+// before. A December line is an earning when more was withheld than the annual tax, the excess is refunded.
+func PPh21(in PPh21Input) LineItem {
+	item := LineItem{
+		Code: "pph21",
+		Name: "PPh 21",
+		Kind: LineDeduction,
+	}
+
+	surcharge := ""
+	if !in.HasNPWP {
+		surcharge = " x 120% without NPWP"
+	}
+
+	if in.Month != time.December {
+		category := TERCategoryOf(in.Status)
+		rate := TERRate(category, in.Gross)
+		tax := in.Gross.Mul(rate).Div(hundred)
+		if !in.HasNPWP {
+			tax = tax.Mul(nonNPWPSurcharge)
+		}
+		item.Amount = RoundRupiah(tax)
+		item.Basis = fmt.Sprintf("TER %s %s%% of %s%s", category, rate.String(), in.Gross.StringFixed(2), surcharge)
+		return item
+	}
+
+	months := in.PaidMonths
+	if months < 1 {
+		months = 1
+	}
+	if months > 12 {
+		months = 12
+	}
+
+	annualGross := in.PriorGross.Add(in.Gross)
+	positionCost := decimal.Min(
+		annualGross.Mul(positionCostRate),
+		positionCostMonthlyCap.Mul(decimal.NewFromInt(int64(months))),
+	)
+	pension := in.PriorPension.Add(in.Pension)
+	ptkp := AnnualPTKP(in.Status)
+
+	// The annual taxable income is rounded down to whole thousands
+	taxableIncome := annualGross.Sub(positionCost).Sub(pension).Sub(ptkp).Div(thousand).Floor().Mul(thousand)
+	if taxableIncome.IsNegative() {
+		taxableIncome = decimal.Zero
+	}
+
+	annualTax := Article17Tax(taxableIncome)
+	if !in.HasNPWP {
+		annualTax = annualTax.Mul(nonNPWPSurcharge)
+	}
+	annualTax = RoundRupiah(annualTax)
+
+	tax := annualTax.Sub(in.PriorWithheld)
+	if tax.IsNegative() {
+		item.Kind = LineEarning
+		item.Name = "PPh 21 refund"
+		tax = tax.Neg()
+	}
+	item.Amount = tax
+	item.Basis = fmt.Sprintf("annual %s - position cost %s - pension %s - PTKP %s %s = %s, Article 17 tax %s%s - withheld %s",
+		annualGross.StringFixed(2), positionCost.StringFixed(2), pension.StringFixed(2), in.Status, ptkp.StringFixed(2),
+		taxableIncome.StringFixed(2), annualTax.StringFixed(2), surcharge, in.PriorWithheld.StringFixed(2))
+
+	return item
+}
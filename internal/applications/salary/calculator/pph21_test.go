@@ -0,0 +1,134 @@
+package calculator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTERRate(t *testing.T) {
+	assert.Equal(t, TERCategoryA, TERCategoryOf(PTKPStatusK0))
+	assert.Equal(t, TERCategoryB, TERCategoryOf(PTKPStatusTK2))
+	assert.Equal(t, TERCategoryC, TERCategoryOf(PTKPStatusK3))
+	assert.Equal(t, TERCategoryA, TERCategoryOf("unknown"))
+
+	tests := []struct {
+		name     string
+		category TERCategory
+		gross    int64
+		expected string
+	}{
+		{name: "upper bound is inclusive", category: TERCategoryA, gross: 5400000, expected: "0"},
+		{name: "next bracket starts above it", category: TERCategoryA, gross: 5400001, expected: "0.25"},
+		{name: "category A", category: TERCategoryA, gross: 10000000, expected: "2"},
+		{name: "category B", category: TERCategoryB, gross: 10000000, expected: "1.5"},
+		{name: "category C", category: TERCategoryC, gross: 10000000, expected: "1.5"},
+		{name: "top bracket", category: TERCategoryC, gross: 2000000000, expected: "34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TERRate(tt.category, decimal.NewFromInt(tt.gross)).String())
+		})
+	}
+}
+
+func TestArticle17Tax(t *testing.T) {
+	assert.Equal(t, "3000000", Article17Tax(decimal.NewFromInt(60000000)).String())
+	// 5% of 60,000,000 + 15% of 190,000,000 + 25% of 50,000,000
+	assert.Equal(t, "44000000", Article17Tax(decimal.NewFromInt(300000000)).String())
+	assert.True(t, Article17Tax(decimal.Zero).IsZero())
+}
+
+func TestPPh21(t *testing.T) {
+	monthly := decimal.NewFromInt(10000000)
+
+	t.Run("monthly TER", func(t *testing.T) {
+		item := PPh21(PPh21Input{Status: PTKPStatusTK0, HasNPWP: true, Month: time.March, Gross: monthly})
+		assert.Equal(t, LineDeduction, item.Kind)
+		assert.Equal(t, "pph21", item.Code)
+		assert.Equal(t, "200000", item.Amount.String())
+		assert.Equal(t, "TER A 2% of 10000000.00", item.Basis)
+	})
+
+	t.Run("monthly TER without NPWP is 20% higher", func(t *testing.T) {
+		item := PPh21(PPh21Input{Status: PTKPStatusTK0, Month: time.March, Gross: monthly})
+		assert.Equal(t, "240000", item.Amount.String())
+		assert.Equal(t, "TER A 2% of 10000000.00 x 120% without NPWP", item.Basis)
+	})
+
+	t.Run("December withholds the annual tax minus the TER withheld", func(t *testing.T) {
+		// 120,000,000 - 6,000,000 position cost - 54,000,000 PTKP = 60,000,000 taxed 5%
+		item := PPh21(PPh21Input{
+			Status:        PTKPStatusTK0,
+			HasNPWP:       true,
+			Month:         time.December,
+			Gross:         monthly,
+			PriorGross:    decimal.NewFromInt(110000000),
+			PriorWithheld: decimal.NewFromInt(2200000),
+			PaidMonths:    12,
+		})
+		assert.Equal(t, LineDeduction, item.Kind)
+		assert.Equal(t, "800000", item.Amount.String())
+		assert.Contains(t, item.Basis, "PTKP TK/0 54000000.00 = 60000000.00, Article 17 tax 3000000.00")
+	})
+
+	t.Run("December deducts the pension contributions of the employee", func(t *testing.T) {
+		// 120,000,000 - 6,000,000 - 3,600,000 JHT and JP - 54,000,000 = 56,400,000 taxed 5%
+		item := PPh21(PPh21Input{
+			Status:        PTKPStatusTK0,
+			HasNPWP:       true,
+			Month:         time.December,
+			Gross:         monthly,
+			Pension:       decimal.NewFromInt(300000),
+			PriorGross:    decimal.NewFromInt(110000000),
+			PriorWithheld: decimal.NewFromInt(2200000),
+			PriorPension:  decimal.NewFromInt(3300000),
+			PaidMonths:    12,
+		})
+		assert.Equal(t, "620000", item.Amount.String())
+		assert.Contains(t, item.Basis, "- pension 3600000.00 -")
+	})
+
+	t.Run("December without NPWP", func(t *testing.T) {
+		item := PPh21(PPh21Input{
+			Status:        PTKPStatusTK0,
+			Month:         time.December,
+			Gross:         monthly,
+			PriorGross:    decimal.NewFromInt(110000000),
+			PriorWithheld: decimal.NewFromInt(2640000),
+			PaidMonths:    12,
+		})
+		assert.Equal(t, "960000", item.Amount.String())
+	})
+
+	t.Run("December refunds what was withheld too much", func(t *testing.T) {
+		// Hired in October: 60,000,000 - 1,500,000 position cost for 3 months - 54,000,000 = 4,500,000 taxed 5%
+		item := PPh21(PPh21Input{
+			Status:        PTKPStatusTK0,
+			HasNPWP:       true,
+			Month:         time.December,
+			Gross:         decimal.NewFromInt(20000000),
+			PriorGross:    decimal.NewFromInt(40000000),
+			PriorWithheld: decimal.NewFromInt(3600000),
+			PaidMonths:    3,
+		})
+		assert.Equal(t, LineEarning, item.Kind)
+		assert.Equal(t, "PPh 21 refund", item.Name)
+		assert.Equal(t, "3375000", item.Amount.String())
+	})
+
+	t.Run("no tax below PTKP", func(t *testing.T) {
+		item := PPh21(PPh21Input{
+			Status:     PTKPStatusK3,
+			HasNPWP:    true,
+			Month:      time.December,
+			Gross:      decimal.NewFromInt(5000000),
+			PriorGross: decimal.NewFromInt(55000000),
+			PaidMonths: 12,
+		})
+		assert.True(t, item.Amount.IsZero())
+	})
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"net/http"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateBpjsContributionRate puts a BPJS contribution rate in effect from a date
+// @Summary Create BPJS contribution rate
+// @Description Put the employer and employee rates and the wage cap of a BPJS program in effect from a date. Earlier rates keep applying to the months before it. JKK rates are per risk class.
+// @Tags bpjs
+// @Accept json
+// @Produce json
+// @Param rate body dto.CreateBpjsContributionRateRequest true "Contribution rate data"
+// @Success 201 {object} dto.BpjsContributionRateResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /bpjs/rates [post]
+func (c *SalaryController) CreateBpjsContributionRate(ctx echo.Context) error {
+	var req dto.CreateBpjsContributionRateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	rate, err := c.salaryService.CreateBpjsContributionRate(ctx.Request().Context(), &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to create BPJS contribution rate", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create BPJS contribution rate",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, rate)
+}
+
+// ListBpjsContributionRates retrieves the BPJS contribution rates
+// @Summary List BPJS contribution rates
+// @Description Get the BPJS contribution rates of every program with their effective dates, the latest first
+// @Tags bpjs
+// @Accept json
+// @Produce json
+// @Param program query string false "Program filter" Enums(kesehatan, jht, jp, jkk, jkm)
+// @Success 200 {array} dto.BpjsContributionRateResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /bpjs/rates [get]
+func (c *SalaryController) ListBpjsContributionRates(ctx echo.Context) error {
+	var params dto.BpjsContributionRateQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	rates, err := c.salaryService.ListBpjsContributionRates(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list BPJS contribution rates",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, rates)
+}
+
+// GetBpjsContributionReport retrieves the BPJS contributions of a month
+// @Summary Get monthly BPJS contribution report
+// @Description Get the employer and employee BPJS contributions of a month per employee and per program, as calculated on the salaries of the month
+// @Tags bpjs
+// @Accept json
+// @Produce json
+// @Param month query string true "Month (YYYY-MM)"
+// @Param program query string false "Program filter" Enums(kesehatan, jht, jp, jkk, jkm)
+// @Success 200 {object} dto.BpjsContributionReport
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /bpjs/contributions/monthly [get]
+func (c *SalaryController) GetBpjsContributionReport(ctx echo.Context) error {
+	var params dto.BpjsContributionReportParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	report, err := c.salaryService.GetBpjsContributionReport(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to get BPJS contribution report",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreatePayrollRun opens the payroll run of a month
+// @Summary Create payroll run
+// @Description Open the draft payroll run of a month, it owns the salary calculations of the month. A month has one run until it is cancelled.
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param run body dto.CreatePayrollRunRequest true "Payroll run data"
+// @Success 201 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs [post]
+func (c *SalaryController) CreatePayrollRun(ctx echo.Context) error {
+	var req dto.CreatePayrollRunRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	run, err := c.salaryService.CreatePayrollRun(ctx.Request().Context(), &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to create payroll run", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create payroll run",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, run)
+}
+
+// GetPayrollRun retrieves a payroll run by ID
+// @Summary Get payroll run by ID
+// @Description Get a payroll run with its salary calculations, totals and transition audit trail
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /payroll-runs/{id} [get]
+func (c *SalaryController) GetPayrollRun(ctx echo.Context) error {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid payroll run ID",
+			"message": "Payroll run ID must be a valid number",
+		})
+	}
+
+	run, err := c.salaryService.GetPayrollRun(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Payroll run not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, run)
+}
+
+// ListPayrollRuns retrieves payroll runs with pagination and filtering
+// @Summary List payroll runs
+// @Description Get list of payroll runs with their totals, newest month first
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param status query string false "Status filter" Enums(draft, calculated, reviewed, approved, paid, cancelled)
+// @Param year query int false "Year filter"
+// @Success 200 {object} dto.PayrollRunListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs [get]
+func (c *SalaryController) ListPayrollRuns(ctx echo.Context) error {
+	var params dto.PayrollRunQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	runs, err := c.salaryService.ListPayrollRuns(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list payroll runs",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, runs)
+}
+
+// RecalculatePayrollRun calculates the salaries of a payroll run again
+// @Summary Recalculate payroll run
+// @Description Calculate the salary of every employee for the month of the run again, a reviewed run has to be reviewed again
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Param transition body dto.PayrollRunTransitionRequest true "Transition data"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs/{id}/recalculate [post]
+func (c *SalaryController) RecalculatePayrollRun(ctx echo.Context) error {
+	return c.transitionPayrollRun(ctx, "Failed to recalculate payroll run", c.salaryService.RecalculatePayrollRun)
+}
+
+// ReviewPayrollRun marks the calculations of a payroll run as checked
+// @Summary Review payroll run
+// @Description Mark the salary calculations of a calculated run as checked
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Param transition body dto.PayrollRunTransitionRequest true "Transition data"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs/{id}/review [post]
+func (c *SalaryController) ReviewPayrollRun(ctx echo.Context) error {
+	return c.transitionPayrollRun(ctx, "Failed to review payroll run", c.salaryService.ReviewPayrollRun)
+}
+
+// ApprovePayrollRun approves a reviewed payroll run
+// @Summary Approve payroll run
+// @Description Approve a reviewed run, its salary calculations cannot be changed afterwards until it is cancelled
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Param transition body dto.PayrollRunTransitionRequest true "Transition data"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs/{id}/approve [post]
+func (c *SalaryController) ApprovePayrollRun(ctx echo.Context) error {
+	return c.transitionPayrollRun(ctx, "Failed to approve payroll run", c.salaryService.ApprovePayrollRun)
+}
+
+// ClosePayrollRun records that the salaries of an approved payroll run were paid
+// @Summary Close payroll run
+// @Description Mark an approved run as paid, the payroll period of its month is locked
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Param transition body dto.PayrollRunTransitionRequest true "Transition data"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs/{id}/close [post]
+func (c *SalaryController) ClosePayrollRun(ctx echo.Context) error {
+	return c.transitionPayrollRun(ctx, "Failed to close payroll run", c.salaryService.ClosePayrollRun)
+}
+
+// CancelPayrollRun cancels a payroll run that was not paid
+// @Summary Cancel payroll run
+// @Description Cancel a run that was not paid, its salary calculations are released to the next run of the month
+// @Tags payroll-runs
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll Run ID"
+// @Param transition body dto.PayrollRunTransitionRequest true "Transition data"
+// @Success 200 {object} dto.PayrollRunResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /payroll-runs/{id}/cancel [post]
+func (c *SalaryController) CancelPayrollRun(ctx echo.Context) error {
+	return c.transitionPayrollRun(ctx, "Failed to cancel payroll run", c.salaryService.CancelPayrollRun)
+}
+
+// transitionPayrollRun binds and validates a transition request and moves the payroll run with the service method
+func (c *SalaryController) transitionPayrollRun(ctx echo.Context, title string,
+	transition func(context.Context, uint64, *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)) error {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid payroll run ID",
+			"message": "Payroll run ID must be a valid number",
+		})
+	}
+
+	var req dto.PayrollRunTransitionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	run, err := transition(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, title, bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   title,
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, run)
+}
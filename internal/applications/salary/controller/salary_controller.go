@@ -5,8 +5,10 @@ import (
 	"strconv"
 	"time"
 
+	"mceasy/exceptions"
 	"mceasy/internal/applications/salary/dto"
 	"mceasy/internal/applications/salary/service"
+	"mceasy/internal/helper/response"
 
 	"github.com/labstack/echo/v4"
 )
@@ -51,6 +53,9 @@ func (c *SalaryController) CalculateSalary(ctx echo.Context) error {
 	}
 
 	calculation, err := c.salaryService.CalculateSalary(ctx.Request().Context(), &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to calculate salary", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to calculate salary",
@@ -95,7 +100,7 @@ func (c *SalaryController) GetSalaryCalculation(ctx echo.Context) error {
 
 // UpdateSalaryCalculation updates a salary calculation
 // @Summary Update salary calculation
-// @Description Update salary calculation details
+// @Description Update salary calculation details, a changed final salary is recorded as an adjustment line and the deduction amount cannot be set
 // @Tags salary
 // @Accept json
 // @Produce json
@@ -104,6 +109,7 @@ func (c *SalaryController) GetSalaryCalculation(ctx echo.Context) error {
 // @Success 200 {object} dto.SalaryCalculationResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Router /salary/{id} [put]
 func (c *SalaryController) UpdateSalaryCalculation(ctx echo.Context) error {
@@ -132,6 +138,9 @@ func (c *SalaryController) UpdateSalaryCalculation(ctx echo.Context) error {
 	}
 
 	calculation, err := c.salaryService.UpdateSalaryCalculation(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to update salary calculation", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to update salary calculation",
@@ -165,6 +174,9 @@ func (c *SalaryController) DeleteSalaryCalculation(ctx echo.Context) error {
 	}
 
 	err = c.salaryService.DeleteSalaryCalculation(ctx.Request().Context(), id)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to delete salary calculation", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to delete salary calculation",
@@ -347,6 +359,9 @@ func (c *SalaryController) BulkCalculateSalary(ctx echo.Context) error {
 	}
 
 	calculations, err := c.salaryService.BulkCalculateSalary(ctx.Request().Context(), &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to bulk calculate salary", bizErr)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to bulk calculate salary",
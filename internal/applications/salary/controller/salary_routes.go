@@ -22,4 +22,19 @@ func RegisterSalaryRoutes(e *echo.Group, controller *SalaryController) {
 	// Summary operations
 	e.GET("/salary/summary/monthly", controller.GetMonthlySalarySummary)
 	e.GET("/salary/summary/employee/:employee_id", controller.GetEmployeeSalarySummary)
+
+	// Payroll runs own the calculations of a month from draft until paid
+	e.POST("/payroll-runs", controller.CreatePayrollRun)
+	e.GET("/payroll-runs", controller.ListPayrollRuns)
+	e.GET("/payroll-runs/:id", controller.GetPayrollRun)
+	e.POST("/payroll-runs/:id/recalculate", controller.RecalculatePayrollRun)
+	e.POST("/payroll-runs/:id/review", controller.ReviewPayrollRun)
+	e.POST("/payroll-runs/:id/approve", controller.ApprovePayrollRun)
+	e.POST("/payroll-runs/:id/close", controller.ClosePayrollRun)
+	e.POST("/payroll-runs/:id/cancel", controller.CancelPayrollRun)
+
+	// BPJS rates are effective-dated, the contributions are lines of the salary calculations
+	e.POST("/bpjs/rates", controller.CreateBpjsContributionRate)
+	e.GET("/bpjs/rates", controller.ListBpjsContributionRates)
+	e.GET("/bpjs/contributions/monthly", controller.GetBpjsContributionReport)
 }
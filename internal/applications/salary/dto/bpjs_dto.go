@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateBpjsContributionRateRequest represents the request to put a BPJS rate in effect from a date, earlier
+// rates of the program keep applying to the months before it
+type CreateBpjsContributionRateRequest struct {
+	Program       string           `json:"program" validate:"required,oneof=kesehatan jht jp jkk jkm"`
+	RiskClass     int              `json:"risk_class,omitempty" validate:"omitempty,min=1,max=5"`
+	EffectiveFrom string           `json:"effective_from" validate:"required,datetime=2006-01-02"`
+	EmployerRate  *decimal.Decimal `json:"employer_rate" validate:"required,min=0,max=100"`
+	EmployeeRate  *decimal.Decimal `json:"employee_rate" validate:"required,min=0,max=100"`
+	WageCap       *decimal.Decimal `json:"wage_cap,omitempty" validate:"omitempty,min=0"`
+	Notes         string           `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// BpjsContributionRateResponse represents the BPJS contribution rate response structure
+type BpjsContributionRateResponse struct {
+	ID            uint64           `json:"id"`
+	Program       string           `json:"program"`
+	RiskClass     int              `json:"risk_class,omitempty"`
+	EffectiveFrom string           `json:"effective_from"`
+	EmployerRate  decimal.Decimal  `json:"employer_rate"`
+	EmployeeRate  decimal.Decimal  `json:"employee_rate"`
+	WageCap       *decimal.Decimal `json:"wage_cap,omitempty"`
+	Notes         string           `json:"notes,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// BpjsContributionRateQueryParams represents query parameters for BPJS contribution rate list
+type BpjsContributionRateQueryParams struct {
+	Program string `query:"program" validate:"omitempty,oneof=kesehatan jht jp jkk jkm"`
+}
+
+// BpjsContributionReportParams represents query parameters for the monthly BPJS contribution report
+type BpjsContributionReportParams struct {
+	Month   string `query:"month" validate:"required,datetime=2006-01"`
+	Program string `query:"program" validate:"omitempty,oneof=kesehatan jht jp jkk jkm"`
+}
+
+// BpjsProgramContribution represents the employer and employee portions of a program
+type BpjsProgramContribution struct {
+	Program  string          `json:"program"`
+	Employer decimal.Decimal `json:"employer"`
+	Employee decimal.Decimal `json:"employee"`
+	Total    decimal.Decimal `json:"total"`
+}
+
+// BpjsEmployeeContribution represents the contributions of an employee in a month
+type BpjsEmployeeContribution struct {
+	EmployeeID    uint64                    `json:"employee_id"`
+	EmployeeName  string                    `json:"employee_name"`
+	Programs      []BpjsProgramContribution `json:"programs"`
+	TotalEmployer decimal.Decimal           `json:"total_employer"`
+	TotalEmployee decimal.Decimal           `json:"total_employee"`
+}
+
+// BpjsContributionReport represents the BPJS contributions of a month as calculated on the salaries
+type BpjsContributionReport struct {
+	Month         string                     `json:"month"`
+	Programs      []BpjsProgramContribution  `json:"programs"`
+	Employees     []BpjsEmployeeContribution `json:"employees"`
+	TotalEmployer decimal.Decimal            `json:"total_employer"`
+	TotalEmployee decimal.Decimal            `json:"total_employee"`
+	Total         decimal.Decimal            `json:"total"`
+}
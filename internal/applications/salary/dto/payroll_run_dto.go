@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreatePayrollRunRequest represents the request to open the payroll run of a month
+type CreatePayrollRunRequest struct {
+	PeriodMonth string `json:"period_month" validate:"required,datetime=2006-01"`
+	CreatedBy   uint64 `json:"created_by" validate:"required"`
+	Notes       string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// PayrollRunTransitionRequest represents the request to move a payroll run to its next status, the actor and
+// the notes are kept in the audit trail of the run
+type PayrollRunTransitionRequest struct {
+	ActorID uint64 `json:"actor_id" validate:"required"`
+	Notes   string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// PayrollRunResponse represents the payroll run response structure
+type PayrollRunResponse struct {
+	ID                 uint64                      `json:"id"`
+	PeriodMonth        string                      `json:"period_month"`
+	Status             string                      `json:"status"`
+	CreatedBy          uint64                      `json:"created_by"`
+	EmployeeCount      int                         `json:"employee_count"`
+	TotalBaseSalary    decimal.Decimal             `json:"total_base_salary"`
+	TotalFinalSalary   decimal.Decimal             `json:"total_final_salary"`
+	TotalDeductions    decimal.Decimal             `json:"total_deductions"`
+	SalaryCalculations []SalaryCalculationResponse `json:"salary_calculations,omitempty"`
+	Events             []PayrollRunEventResponse   `json:"events,omitempty"`
+	CreatedAt          time.Time                   `json:"created_at"`
+	ModifiedAt         time.Time                   `json:"modified_at"`
+}
+
+// PayrollRunEventResponse represents a transition of a payroll run in the audit trail
+type PayrollRunEventResponse struct {
+	ID         uint64    `json:"id"`
+	Action     string    `json:"action"`
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    uint64    `json:"actor_id"`
+	Notes      string    `json:"notes,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PayrollRunListResponse represents the response for payroll run list
+type PayrollRunListResponse struct {
+	PayrollRuns []PayrollRunResponse `json:"payroll_runs"`
+	Total       int                  `json:"total"`
+	Page        int                  `json:"page"`
+	Limit       int                  `json:"limit"`
+}
+
+// PayrollRunQueryParams represents query parameters for payroll run list
+type PayrollRunQueryParams struct {
+	Page   int    `query:"page" validate:"omitempty,min=1"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Status string `query:"status" validate:"omitempty,oneof=draft calculated reviewed approved paid cancelled"`
+	Year   int    `query:"year" validate:"omitempty,min=2000,max=2100"`
+}
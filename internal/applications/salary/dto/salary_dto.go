@@ -2,13 +2,15 @@ package dto
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // CalculateSalaryRequest represents the request to calculate monthly salary
 type CalculateSalaryRequest struct {
-	EmployeeID         uint64    `json:"employee_id" validate:"required"`
-	CalculationMonth   time.Time `json:"calculation_month" validate:"required"`
-	OverrideBaseSalary *float64  `json:"override_base_salary,omitempty" validate:"omitempty,min=0"`
+	EmployeeID         uint64           `json:"employee_id" validate:"required"`
+	CalculationMonth   time.Time        `json:"calculation_month" validate:"required"`
+	OverrideBaseSalary *decimal.Decimal `json:"override_base_salary,omitempty" validate:"omitempty,min=0"`
 }
 
 // BulkCalculateSalaryRequest represents bulk salary calculation request
@@ -19,30 +21,67 @@ type BulkCalculateSalaryRequest struct {
 
 // UpdateSalaryCalculationRequest represents the request to update salary calculation
 type UpdateSalaryCalculationRequest struct {
-	BaseSalary         *float64 `json:"base_salary,omitempty" validate:"omitempty,min=0"`
-	TotalWorkingDays   *int     `json:"total_working_days,omitempty" validate:"omitempty,min=0"`
-	AbsentDays         *int     `json:"absent_days,omitempty" validate:"omitempty,min=0"`
-	PresentDays        *int     `json:"present_days,omitempty" validate:"omitempty,min=0"`
-	FinalSalary        *float64 `json:"final_salary,omitempty" validate:"omitempty,min=0"`
-	DeductionAmount    *float64 `json:"deduction_amount,omitempty" validate:"omitempty,min=0"`
-	CalculationFormula *string  `json:"calculation_formula,omitempty" validate:"omitempty,max=1000"`
+	BaseSalary         *decimal.Decimal `json:"base_salary,omitempty" validate:"omitempty,min=0"`
+	TotalWorkingDays   *int             `json:"total_working_days,omitempty" validate:"omitempty,min=0"`
+	AbsentDays         *int             `json:"absent_days,omitempty" validate:"omitempty,min=0"`
+	PresentDays        *int             `json:"present_days,omitempty" validate:"omitempty,min=0"`
+	FinalSalary        *decimal.Decimal `json:"final_salary,omitempty" validate:"omitempty,min=0"`
+	DeductionAmount    *decimal.Decimal `json:"deduction_amount,omitempty" validate:"omitempty,min=0"`
+	CalculationFormula *string          `json:"calculation_formula,omitempty" validate:"omitempty,max=1000"`
 }
 
-// SalaryCalculationResponse represents the salary calculation response structure
+// SalaryCalculationResponse represents the salary calculation response structure, amounts are serialized as
+// strings so clients do not lose rupiah to floating point
 type SalaryCalculationResponse struct {
-	ID                 uint64    `json:"id"`
-	EmployeeID         string    `json:"employee_id"`
-	EmployeeName       string    `json:"employee_name"`
-	CalculationMonth   time.Time `json:"calculation_month"`
-	BaseSalary         float64   `json:"base_salary"`
-	TotalWorkingDays   int       `json:"total_working_days"`
-	AbsentDays         int       `json:"absent_days"`
-	PresentDays        int       `json:"present_days"`
-	FinalSalary        float64   `json:"final_salary"`
-	DeductionAmount    float64   `json:"deduction_amount"`
-	CalculationFormula string    `json:"calculation_formula"`
-	CreatedAt          time.Time `json:"created_at"`
-	ModifiedAt         time.Time `json:"modified_at"`
+	ID                 uint64                          `json:"id"`
+	EmployeeID         string                          `json:"employee_id"`
+	EmployeeName       string                          `json:"employee_name"`
+	PayrollRunID       *uint64                         `json:"payroll_run_id,omitempty"`
+	CalculationMonth   time.Time                       `json:"calculation_month"`
+	BaseSalary         decimal.Decimal                 `json:"base_salary"`
+	TotalWorkingDays   int                             `json:"total_working_days"`
+	AbsentDays         int                             `json:"absent_days"`
+	PresentDays        int                             `json:"present_days"`
+	PaidDays           decimal.Decimal                 `json:"paid_days"`
+	LateDays           int                             `json:"late_days"`
+	LatePenalty        decimal.Decimal                 `json:"late_penalty"`
+	PayPolicy          string                          `json:"pay_policy,omitempty"`
+	WorkedMinutes      int                             `json:"worked_minutes"`
+	OvertimeMinutes    int                             `json:"overtime_minutes"`
+	OvertimePay        decimal.Decimal                 `json:"overtime_pay"`
+	TaxableIncome      decimal.Decimal                 `json:"taxable_income"`
+	FinalSalary        decimal.Decimal                 `json:"final_salary"`
+	DeductionAmount    decimal.Decimal                 `json:"deduction_amount"`
+	CalculationFormula string                          `json:"calculation_formula"`
+	Items              []SalaryCalculationItemResponse `json:"items,omitempty"`
+	CreatedAt          time.Time                       `json:"created_at"`
+	ModifiedAt         time.Time                       `json:"modified_at"`
+}
+
+// SalaryCalculationItemResponse represents an itemized earning or deduction of a salary calculation
+type SalaryCalculationItemResponse struct {
+	ID                uint64          `json:"id"`
+	SalaryComponentID *uint64         `json:"salary_component_id,omitempty"`
+	Code              string          `json:"code"`
+	Name              string          `json:"name"`
+	Kind              string          `json:"kind"`
+	Amount            decimal.Decimal `json:"amount"`
+	Basis             string          `json:"basis,omitempty"`
+}
+
+// AttendancePaySummary holds the attendance of an employee in a month weighted by the attendance pay policies
+type AttendancePaySummary struct {
+	// PresentDays counts the days worked, present, late, half day, remote or on a business trip
+	PresentDays int
+	// PaidLeaveDays counts approved paid leave and sick days, they are paid but not worked
+	PaidLeaveDays int
+	AbsentDays    int
+	LateDays      int
+	// PaidDays is the sum of the pay weights of the present, late and half days plus paid leave
+	PaidDays    decimal.Decimal
+	LatePenalty decimal.Decimal
+	// Policies describes every policy applied in the month, in the order of the first day it applied to
+	Policies []string
 }
 
 // SalaryCalculationListResponse represents the response for salary calculation list
@@ -65,14 +104,14 @@ type SalaryCalculationQueryParams struct {
 
 // MonthlySalarySummary represents monthly salary summary
 type MonthlySalarySummary struct {
-	CalculationMonth  time.Time `json:"calculation_month"`
-	TotalEmployees    int       `json:"total_employees"`
-	CalculatedCount   int       `json:"calculated_count"`
-	PendingCount      int       `json:"pending_count"`
-	TotalBaseSalary   float64   `json:"total_base_salary"`
-	TotalFinalSalary  float64   `json:"total_final_salary"`
-	TotalDeductions   float64   `json:"total_deductions"`
-	AverageAttendance float64   `json:"average_attendance_percentage"`
+	CalculationMonth  time.Time       `json:"calculation_month"`
+	TotalEmployees    int             `json:"total_employees"`
+	CalculatedCount   int             `json:"calculated_count"`
+	PendingCount      int             `json:"pending_count"`
+	TotalBaseSalary   decimal.Decimal `json:"total_base_salary"`
+	TotalFinalSalary  decimal.Decimal `json:"total_final_salary"`
+	TotalDeductions   decimal.Decimal `json:"total_deductions"`
+	AverageAttendance float64         `json:"average_attendance_percentage"`
 }
 
 // EmployeeSalarySummary represents salary summary for an employee across months
@@ -81,9 +120,9 @@ type EmployeeSalarySummary struct {
 	EmployeeName      string                      `json:"employee_name"`
 	EmployeeCode      string                      `json:"employee_code"`
 	TotalMonths       int                         `json:"total_months"`
-	TotalBaseSalary   float64                     `json:"total_base_salary"`
-	TotalFinalSalary  float64                     `json:"total_final_salary"`
-	TotalDeductions   float64                     `json:"total_deductions"`
+	TotalBaseSalary   decimal.Decimal             `json:"total_base_salary"`
+	TotalFinalSalary  decimal.Decimal             `json:"total_final_salary"`
+	TotalDeductions   decimal.Decimal             `json:"total_deductions"`
 	AverageAttendance float64                     `json:"average_attendance_percentage"`
 	MonthlySalaries   []SalaryCalculationResponse `json:"monthly_salaries"`
 }
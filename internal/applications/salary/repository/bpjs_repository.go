@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/internal/applications/salary/dto"
+)
+
+// BpjsRepository defines the interface for BPJS contribution data operations
+type BpjsRepository interface {
+	CreateRate(ctx context.Context, req *dto.CreateBpjsContributionRateRequest, effectiveFrom time.Time) (*ent.BpjsContributionRate, error)
+	ListRates(ctx context.Context, params *dto.BpjsContributionRateQueryParams) ([]*ent.BpjsContributionRate, error)
+	GetMonthlyContributions(ctx context.Context, month time.Time, codes []string) ([]*ent.SalaryCalculation, error)
+}
+
+// BpjsRepositoryImpl implements the BpjsRepository interface
+type BpjsRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewBpjsRepository creates a new BPJS repository instance
+func NewBpjsRepository(client *ent.Client) *BpjsRepositoryImpl {
+	return &BpjsRepositoryImpl{
+		client: client,
+	}
+}
+
+// CreateRate creates a BPJS contribution rate in effect from a date
+func (r *BpjsRepositoryImpl) CreateRate(ctx context.Context, req *dto.CreateBpjsContributionRateRequest, effectiveFrom time.Time) (*ent.BpjsContributionRate, error) {
+	query := r.client.BpjsContributionRate.Create().
+		SetProgram(bpjscontributionrate.Program(req.Program)).
+		SetRiskClass(req.RiskClass).
+		SetEffectiveFrom(effectiveFrom).
+		SetEmployerRate(*req.EmployerRate).
+		SetEmployeeRate(*req.EmployeeRate).
+		SetNillableWageCap(req.WageCap)
+
+	if req.Notes != "" {
+		query = query.SetNotes(req.Notes)
+	}
+
+	return query.Save(ctx)
+}
+
+// ListRates retrieves the BPJS contribution rates, the latest of every program first
+func (r *BpjsRepositoryImpl) ListRates(ctx context.Context, params *dto.BpjsContributionRateQueryParams) ([]*ent.BpjsContributionRate, error) {
+	query := r.client.BpjsContributionRate.
+		Query().
+		Where(bpjscontributionrate.DeletedAtIsNil())
+
+	if params.Program != "" {
+		query = query.Where(bpjscontributionrate.ProgramEQ(bpjscontributionrate.Program(params.Program)))
+	}
+
+	rates, err := query.
+		Order(
+			ent.Asc(bpjscontributionrate.FieldProgram),
+			ent.Asc(bpjscontributionrate.FieldRiskClass),
+			ent.Desc(bpjscontributionrate.FieldEffectiveFrom),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch BPJS contribution rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// GetMonthlyContributions retrieves the salary calculations of a month with their BPJS lines of the given codes
+func (r *BpjsRepositoryImpl) GetMonthlyContributions(ctx context.Context, month time.Time, codes []string) ([]*ent.SalaryCalculation, error) {
+	calculations, err := r.client.SalaryCalculation.
+		Query().
+		Where(salarycalculation.CalculationMonth(month)).
+		Where(salarycalculation.DeletedAtIsNil()).
+		Where(salarycalculation.HasItemsWith(salarycalculationitem.CodeIn(codes...))).
+		WithEmployee().
+		WithItems(func(q *ent.SalaryCalculationItemQuery) {
+			q.Where(salarycalculationitem.CodeIn(codes...))
+		}).
+		Order(ent.Asc(salarycalculation.FieldEmployeeID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch BPJS contributions: %w", err)
+	}
+
+	return calculations, nil
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/ent/salarycalculation"
+	"mceasy/internal/applications/salary/dto"
+)
+
+// PayrollRunRepository defines the interface for payroll run data operations
+type PayrollRunRepository interface {
+	Create(ctx context.Context, month time.Time, createdBy uint64) (*ent.PayrollRun, error)
+	GetByID(ctx context.Context, id uint64) (*ent.PayrollRun, error)
+	GetActiveByMonth(ctx context.Context, month time.Time) (*ent.PayrollRun, error)
+	UpdateStatus(ctx context.Context, id uint64, status payrollrun.Status) error
+	CreateEvent(ctx context.Context, id uint64, action payrollrunevent.Action, from *payrollrun.Status, to payrollrun.Status, actorID uint64, notes string) error
+	AttachCalculations(ctx context.Context, id uint64, month time.Time) error
+	ReleaseCalculations(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.PayrollRunQueryParams) ([]*ent.PayrollRun, int, error)
+}
+
+// PayrollRunRepositoryImpl implements the PayrollRunRepository interface
+type PayrollRunRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewPayrollRunRepository creates a new payroll run repository instance
+func NewPayrollRunRepository(client *ent.Client) *PayrollRunRepositoryImpl {
+	return &PayrollRunRepositoryImpl{
+		client: client,
+	}
+}
+
+// db joins the transaction in the context, a transition and its audit event commit together
+func (r *PayrollRunRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
+// Create creates the draft payroll run of a month, a constraint error is returned when the month already has an
+// active run
+func (r *PayrollRunRepositoryImpl) Create(ctx context.Context, month time.Time, createdBy uint64) (*ent.PayrollRun, error) {
+	return r.db(ctx).PayrollRun.Create().
+		SetPeriodMonth(month).
+		SetActiveMonth(month).
+		SetStatus(payrollrun.StatusDraft).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+}
+
+// GetByID retrieves a payroll run with its salary calculations and audit trail
+func (r *PayrollRunRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.PayrollRun, error) {
+	return r.db(ctx).PayrollRun.
+		Query().
+		Where(payrollrun.ID(id)).
+		Where(payrollrun.DeletedAtIsNil()).
+		WithSalaryCalculations(func(q *ent.SalaryCalculationQuery) {
+			q.Where(salarycalculation.DeletedAtIsNil()).
+				WithEmployee().
+				Order(ent.Asc(salarycalculation.FieldEmployeeID))
+		}).
+		WithEvents(func(q *ent.PayrollRunEventQuery) {
+			q.Order(ent.Asc(payrollrunevent.FieldCreatedAt), ent.Asc(payrollrunevent.FieldID))
+		}).
+		First(ctx)
+}
+
+// GetActiveByMonth retrieves the payroll run of a month that was not cancelled, a month has at most one
+func (r *PayrollRunRepositoryImpl) GetActiveByMonth(ctx context.Context, month time.Time) (*ent.PayrollRun, error) {
+	return r.db(ctx).PayrollRun.
+		Query().
+		Where(payrollrun.PeriodMonth(month)).
+		Where(payrollrun.StatusNEQ(payrollrun.StatusCancelled)).
+		Where(payrollrun.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// UpdateStatus moves a payroll run to a status, a cancelled run gives up the active month so a new run can be created
+func (r *PayrollRunRepositoryImpl) UpdateStatus(ctx context.Context, id uint64, status payrollrun.Status) error {
+	query := r.db(ctx).PayrollRun.
+		UpdateOneID(id).
+		SetStatus(status)
+
+	if status == payrollrun.StatusCancelled {
+		query = query.ClearActiveMonth()
+	}
+
+	return query.Exec(ctx)
+}
+
+// CreateEvent appends a transition to the audit trail of a payroll run
+func (r *PayrollRunRepositoryImpl) CreateEvent(ctx context.Context, id uint64, action payrollrunevent.Action, from *payrollrun.Status, to payrollrun.Status, actorID uint64, notes string) error {
+	query := r.db(ctx).PayrollRunEvent.Create().
+		SetPayrollRunID(id).
+		SetAction(action).
+		SetToStatus(payrollrunevent.ToStatus(to)).
+		SetActorID(actorID)
+
+	if from != nil {
+		query = query.SetFromStatus(payrollrunevent.FromStatus(*from))
+	}
+	if notes != "" {
+		query = query.SetNotes(notes)
+	}
+
+	return query.Exec(ctx)
+}
+
+// AttachCalculations hands the salary calculations of the month that belong to no run to the payroll run
+func (r *PayrollRunRepositoryImpl) AttachCalculations(ctx context.Context, id uint64, month time.Time) error {
+	return r.db(ctx).SalaryCalculation.
+		Update().
+		Where(salarycalculation.CalculationMonth(month)).
+		Where(salarycalculation.PayrollRunIDIsNil()).
+		Where(salarycalculation.DeletedAtIsNil()).
+		SetPayrollRunID(id).
+		Exec(ctx)
+}
+
+// ReleaseCalculations detaches the salary calculations from a cancelled payroll run so the next run of the
+// month can own them
+func (r *PayrollRunRepositoryImpl) ReleaseCalculations(ctx context.Context, id uint64) error {
+	return r.db(ctx).SalaryCalculation.
+		Update().
+		Where(salarycalculation.PayrollRunID(id)).
+		ClearPayrollRunID().
+		Exec(ctx)
+}
+
+// List retrieves payroll runs with pagination and filtering, newest month first
+func (r *PayrollRunRepositoryImpl) List(ctx context.Context, params *dto.PayrollRunQueryParams) ([]*ent.PayrollRun, int, error) {
+	query := r.db(ctx).PayrollRun.
+		Query().
+		Where(payrollrun.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where(payrollrun.StatusEQ(payrollrun.Status(params.Status)))
+	}
+
+	if params.Year > 0 {
+		start := time.Date(params.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		query = query.Where(
+			payrollrun.PeriodMonthGTE(start),
+			payrollrun.PeriodMonthLT(start.AddDate(1, 0, 0)),
+		)
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count payroll runs: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by period month desc, the latest run of a month first
+	query = query.Order(ent.Desc(payrollrun.FieldPeriodMonth), ent.Desc(payrollrun.FieldID))
+
+	runs, err := query.
+		WithSalaryCalculations(func(q *ent.SalaryCalculationQuery) {
+			q.Where(salarycalculation.DeletedAtIsNil())
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch payroll runs: %w", err)
+	}
+
+	return runs, total, nil
+}
@@ -3,13 +3,24 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"mceasy/ent"
 	"mceasy/ent/attendance"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/bpjscontributionrate"
 	"mceasy/ent/employee"
+	"mceasy/ent/overtime"
 	"mceasy/ent/salarycalculation"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/internal/applications/salary/calculator"
 	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/component/calendar"
+
+	"github.com/shopspring/decimal"
 )
 
 // SalaryRepository defines the interface for salary calculation data operations
@@ -23,26 +34,45 @@ type SalaryRepository interface {
 	GetMonthlySalarySummary(ctx context.Context, month time.Time) (*dto.MonthlySalarySummary, error)
 	GetEmployeeSalarySummary(ctx context.Context, employeeID uint64, startMonth, endMonth time.Time) (*dto.EmployeeSalarySummary, error)
 	BulkCalculateSalary(ctx context.Context, req *dto.BulkCalculateSalaryRequest) ([]*ent.SalaryCalculation, error)
-	GetWorkingDaysInMonth(ctx context.Context, month time.Time) (int, error)
-	GetAttendanceDataForMonth(ctx context.Context, employeeID uint64, month time.Time) (presentDays, absentDays int, err error)
+	GetWorkingDaysInMonth(ctx context.Context, employeeID uint64, month time.Time) (int, error)
+	GetAttendanceDataForMonth(ctx context.Context, employeeID uint64, month time.Time) (*dto.AttendancePaySummary, error)
+	GetWorkedMinutesForMonth(ctx context.Context, employeeID uint64, month time.Time) (int, error)
+	GetOvertimeForMonth(ctx context.Context, employeeID uint64, month time.Time, baseSalary decimal.Decimal) (minutes int, pay decimal.Decimal, err error)
+	GetSalaryComponents(ctx context.Context, emp *ent.Employee) ([]calculator.Component, error)
+	GetContributionRates(ctx context.Context, month time.Time, riskClass int) ([]calculator.ContributionRate, error)
+	GetTaxYearToDate(ctx context.Context, employeeID uint64, month time.Time) (gross, withheld, pension decimal.Decimal, months int, err error)
 }
 
+// adjustmentCode is the code of the salary line that records a manual change of the final salary
+const adjustmentCode = "adjustment"
+
 // SalaryRepositoryImpl implements the SalaryRepository interface
 type SalaryRepositoryImpl struct {
-	client *ent.Client
+	client   *ent.Client
+	calendar calendar.WorkingDayCalendar
 }
 
 // NewSalaryRepository creates a new salary repository instance
-func NewSalaryRepository(client *ent.Client) *SalaryRepositoryImpl {
+func NewSalaryRepository(client *ent.Client, calendar calendar.WorkingDayCalendar) *SalaryRepositoryImpl {
 	return &SalaryRepositoryImpl{
-		client: client,
+		client:   client,
+		calendar: calendar,
 	}
 }
 
+// db joins the transaction in the context, a payroll run recalculates its salaries in the transition transaction
+func (r *SalaryRepositoryImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return r.client
+}
+
 // CalculateSalary creates or updates a salary calculation
 func (r *SalaryRepositoryImpl) CalculateSalary(ctx context.Context, req *dto.CalculateSalaryRequest) (*ent.SalaryCalculation, error) {
 	// Get employee data
-	emp, err := r.client.Employee.
+	emp, err := r.db(ctx).Employee.
 		Query().
 		Where(employee.ID(req.EmployeeID)).
 		Where(employee.DeletedAtIsNil()).
@@ -58,16 +88,23 @@ func (r *SalaryRepositoryImpl) CalculateSalary(ctx context.Context, req *dto.Cal
 	existing, _ := r.GetByEmployeeAndMonth(ctx, req.EmployeeID, normalizedMonth)
 
 	// Get working days in month
-	totalWorkingDays, err := r.GetWorkingDaysInMonth(ctx, normalizedMonth)
+	totalWorkingDays, err := r.GetWorkingDaysInMonth(ctx, req.EmployeeID, normalizedMonth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate working days: %w", err)
 	}
 
-	// Get attendance data for the month
-	presentDays, absentDays, err := r.GetAttendanceDataForMonth(ctx, req.EmployeeID, normalizedMonth)
+	// Get attendance data for the month, weighted by the attendance pay policies
+	attendanceData, err := r.GetAttendanceDataForMonth(ctx, req.EmployeeID, normalizedMonth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get attendance data: %w", err)
 	}
+	presentDays, absentDays := attendanceData.PresentDays, attendanceData.AbsentDays
+
+	// Get minutes worked in the month from the attendance punches
+	workedMinutes, err := r.GetWorkedMinutesForMonth(ctx, req.EmployeeID, normalizedMonth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worked minutes: %w", err)
+	}
 
 	// Determine base salary
 	baseSalary := emp.BaseSalary
@@ -75,56 +112,232 @@ func (r *SalaryRepositoryImpl) CalculateSalary(ctx context.Context, req *dto.Cal
 		baseSalary = *req.OverrideBaseSalary
 	}
 
-	// Calculate salary: proportional deduction based on absent days
-	// Formula: final_salary = base_salary * (present_days / total_working_days)
-	finalSalary := baseSalary
-	deductionAmount := 0.0
+	// Calculate salary: proportional deduction based on the paid days, a half day only pays its weight
+	// Formula: final_salary = base_salary * (paid_days / total_working_days) - late_penalty
+	proratedSalary := calculator.ProratedSalary(baseSalary, attendanceData.PaidDays, totalWorkingDays)
+
+	// Late penalties never take the salary below zero
+	latePenalty := decimal.Min(attendanceData.LatePenalty, proratedSalary)
+	finalSalary := proratedSalary.Sub(latePenalty)
+	deductionAmount := baseSalary.Sub(finalSalary)
 
-	if totalWorkingDays > 0 {
-		finalSalary = baseSalary * (float64(presentDays) / float64(totalWorkingDays))
-		deductionAmount = baseSalary - finalSalary
+	// Approved overtime is paid on top of the prorated salary as a separate earning
+	overtimeMinutes, overtimePay, err := r.GetOvertimeForMonth(ctx, req.EmployeeID, normalizedMonth, baseSalary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overtime: %w", err)
+	}
+
+	// Allowances, bonuses and other deductions assigned to the employee or their department
+	components, err := r.GetSalaryComponents(ctx, emp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get salary components: %w", err)
 	}
 
 	// Format calculation formula
-	calculationFormula := fmt.Sprintf("Base: %.2f, Working Days: %d, Present: %d, Absent: %d, Final: %.2f * (%d/%d) = %.2f",
-		baseSalary, totalWorkingDays, presentDays, absentDays, baseSalary, presentDays, totalWorkingDays, finalSalary)
+	calculationFormula := fmt.Sprintf("Base: %s, Working Days: %d, Present: %d, Absent: %d, Paid Days: %s, Final: %s * (%s/%d) = %s",
+		baseSalary.StringFixed(2), totalWorkingDays, presentDays, absentDays, attendanceData.PaidDays.String(),
+		baseSalary.StringFixed(2), attendanceData.PaidDays.String(), totalWorkingDays, proratedSalary.StringFixed(2))
+
+	if attendanceData.PaidLeaveDays > 0 {
+		calculationFormula += fmt.Sprintf(", Paid Leave: %d days", attendanceData.PaidLeaveDays)
+	}
+
+	if latePenalty.IsPositive() {
+		calculationFormula += fmt.Sprintf(", Late: %d days, Late Penalty: %s = %s",
+			attendanceData.LateDays, latePenalty.StringFixed(2), finalSalary.StringFixed(2))
+	}
+
+	if overtimeMinutes > 0 {
+		calculationFormula += fmt.Sprintf(", Overtime: %d min = %s, Total: %s",
+			overtimeMinutes, overtimePay.StringFixed(2), finalSalary.Add(overtimePay).StringFixed(2))
+	}
+	finalSalary = finalSalary.Add(overtimePay)
+
+	// Itemize the salary, the lines add up to the final salary
+	items := []calculator.LineItem{{
+		Code:   "base_salary",
+		Name:   "Base salary",
+		Kind:   calculator.LineEarning,
+		Amount: proratedSalary,
+		Basis:  fmt.Sprintf("%s * (%s/%d)", baseSalary.StringFixed(2), attendanceData.PaidDays.String(), totalWorkingDays),
+	}}
+	if overtimePay.IsPositive() {
+		items = append(items, calculator.LineItem{
+			Code:   "overtime",
+			Name:   "Overtime",
+			Kind:   calculator.LineEarning,
+			Amount: overtimePay,
+			Basis:  fmt.Sprintf("%d approved minutes", overtimeMinutes),
+		})
+	}
+	if latePenalty.IsPositive() {
+		items = append(items, calculator.LineItem{
+			Code:   "late_penalty",
+			Name:   "Late penalty",
+			Kind:   calculator.LineDeduction,
+			Amount: latePenalty,
+			Basis:  fmt.Sprintf("%d late days", attendanceData.LateDays),
+		})
+	}
+
+	if len(components) > 0 {
+		lines := make([]string, len(components))
+		for i, component := range components {
+			item := component.LineItem(baseSalary, presentDays)
+			items = append(items, item)
+
+			sign := "+"
+			if item.Kind == calculator.LineDeduction {
+				sign = "-"
+				finalSalary = finalSalary.Sub(item.Amount)
+				deductionAmount = deductionAmount.Add(item.Amount)
+			} else {
+				finalSalary = finalSalary.Add(item.Amount)
+			}
+			lines[i] = fmt.Sprintf("%s %s%s", item.Name, sign, item.Amount.StringFixed(2))
+		}
+		calculationFormula += fmt.Sprintf(", Components: %s, Total: %s", strings.Join(lines, ", "), finalSalary.StringFixed(2))
+	}
 
+	// PPh 21 is withheld from the gross earnings of the month, December settles the tax of the whole year
+	taxableIncome, _ := calculator.SumLines(items)
+
+	// BPJS contributions are calculated from the base salary and the fixed allowances, the employee portions are
+	// deducted from the salary and the employer portions are paid on top of it
+	rates, err := r.GetContributionRates(ctx, normalizedMonth, emp.JkkRiskClass)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BPJS contribution rates: %w", err)
+	}
+	wage := baseSalary
+	for _, component := range components {
+		if component.Kind == calculator.LineEarning && component.Method == calculator.MethodFixed {
+			wage = wage.Add(component.Amount)
+		}
+	}
+	pension := decimal.Zero
+	var contributions []string
+	for _, rate := range rates {
+		for _, item := range rate.Lines(wage) {
+			items = append(items, item)
+
+			if item.Kind == calculator.LineEmployer {
+				// Health, work accident and death premiums paid by the employer are taxable benefits
+				if rate.Program.TaxableBenefit() {
+					taxableIncome = taxableIncome.Add(item.Amount)
+				}
+				continue
+			}
+			finalSalary = finalSalary.Sub(item.Amount)
+			deductionAmount = deductionAmount.Add(item.Amount)
+			if rate.Program.PensionSaving() {
+				pension = pension.Add(item.Amount)
+			}
+			contributions = append(contributions, fmt.Sprintf("%s -%s", item.Name, item.Amount.StringFixed(2)))
+		}
+	}
+	if len(contributions) > 0 {
+		calculationFormula += fmt.Sprintf(", BPJS: %s, Total: %s", strings.Join(contributions, ", "), finalSalary.StringFixed(2))
+	}
+
+	taxInput := calculator.PPh21Input{
+		Status:  calculator.PTKPStatus(emp.PtkpStatus),
+		HasNPWP: emp.Npwp != "",
+		Month:   normalizedMonth.Month(),
+		Gross:   taxableIncome,
+		Pension: pension,
+	}
+	if taxInput.Month == time.December {
+		taxInput.PriorGross, taxInput.PriorWithheld, taxInput.PriorPension, taxInput.PaidMonths, err = r.GetTaxYearToDate(ctx, req.EmployeeID, normalizedMonth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tax year to date: %w", err)
+		}
+		taxInput.PaidMonths++
+	}
+	if tax := calculator.PPh21(taxInput); !tax.Amount.IsZero() {
+		items = append(items, tax)
+
+		sign := "+"
+		if tax.Kind == calculator.LineDeduction {
+			sign = "-"
+			finalSalary = finalSalary.Sub(tax.Amount)
+			deductionAmount = deductionAmount.Add(tax.Amount)
+		} else {
+			finalSalary = finalSalary.Add(tax.Amount)
+		}
+		calculationFormula += fmt.Sprintf(", PPh 21: %s%s (%s), Total: %s", sign, tax.Amount.StringFixed(2), tax.Basis, finalSalary.StringFixed(2))
+	}
+
+	payPolicy := strings.Join(attendanceData.Policies, "; ")
+	if payPolicy != "" {
+		calculationFormula += ", Policy: " + payPolicy
+	}
+
+	var calculation *ent.SalaryCalculation
 	if existing != nil {
 		// Update existing calculation
-		return r.client.SalaryCalculation.
+		calculation, err = r.db(ctx).SalaryCalculation.
 			UpdateOneID(existing.ID).
 			SetBaseSalary(baseSalary).
 			SetTotalWorkingDays(totalWorkingDays).
 			SetAbsentDays(absentDays).
 			SetPresentDays(presentDays).
+			SetPaidDays(attendanceData.PaidDays).
+			SetLateDays(attendanceData.LateDays).
+			SetLatePenalty(latePenalty).
+			SetPayPolicy(payPolicy).
+			SetWorkedMinutes(workedMinutes).
+			SetOvertimeMinutes(overtimeMinutes).
+			SetOvertimePay(overtimePay).
+			SetTaxableIncome(taxableIncome).
+			SetFinalSalary(finalSalary).
+			SetDeductionAmount(deductionAmount).
+			SetCalculationFormula(calculationFormula).
+			Save(ctx)
+	} else {
+		// Create new calculation
+		calculation, err = r.db(ctx).SalaryCalculation.
+			Create().
+			SetEmployeeID(req.EmployeeID).
+			SetCalculationMonth(normalizedMonth).
+			SetBaseSalary(baseSalary).
+			SetTotalWorkingDays(totalWorkingDays).
+			SetAbsentDays(absentDays).
+			SetPresentDays(presentDays).
+			SetPaidDays(attendanceData.PaidDays).
+			SetLateDays(attendanceData.LateDays).
+			SetLatePenalty(latePenalty).
+			SetPayPolicy(payPolicy).
+			SetWorkedMinutes(workedMinutes).
+			SetOvertimeMinutes(overtimeMinutes).
+			SetOvertimePay(overtimePay).
+			SetTaxableIncome(taxableIncome).
 			SetFinalSalary(finalSalary).
 			SetDeductionAmount(deductionAmount).
 			SetCalculationFormula(calculationFormula).
 			Save(ctx)
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	// Create new calculation
-	return r.client.SalaryCalculation.
-		Create().
-		SetEmployeeID(req.EmployeeID).
-		SetCalculationMonth(normalizedMonth).
-		SetBaseSalary(baseSalary).
-		SetTotalWorkingDays(totalWorkingDays).
-		SetAbsentDays(absentDays).
-		SetPresentDays(presentDays).
-		SetFinalSalary(finalSalary).
-		SetDeductionAmount(deductionAmount).
-		SetCalculationFormula(calculationFormula).
-		Save(ctx)
+	if err := r.replaceItems(ctx, calculation.ID, items); err != nil {
+		return nil, fmt.Errorf("failed to save salary lines: %w", err)
+	}
+
+	return r.GetByID(ctx, calculation.ID)
 }
 
 // GetByID retrieves a salary calculation by ID
 func (r *SalaryRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.SalaryCalculation, error) {
-	return r.client.SalaryCalculation.
+	return r.db(ctx).SalaryCalculation.
 		Query().
 		Where(salarycalculation.ID(id)).
 		Where(salarycalculation.DeletedAtIsNil()).
 		WithEmployee().
+		WithPayrollRun().
+		WithItems(func(q *ent.SalaryCalculationItemQuery) {
+			q.Order(ent.Asc(salarycalculationitem.FieldID))
+		}).
 		First(ctx)
 }
 
@@ -133,18 +346,20 @@ func (r *SalaryRepositoryImpl) GetByEmployeeAndMonth(ctx context.Context, employ
 	// Normalize month to first day of month
 	normalizedMonth := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
 
-	return r.client.SalaryCalculation.
+	return r.db(ctx).SalaryCalculation.
 		Query().
 		Where(salarycalculation.EmployeeID(employeeID)).
 		Where(salarycalculation.CalculationMonth(normalizedMonth)).
 		Where(salarycalculation.DeletedAtIsNil()).
 		WithEmployee().
+		WithPayrollRun().
 		First(ctx)
 }
 
-// Update updates a salary calculation record
+// Update updates a salary calculation record. A changed final salary is recorded as an adjustment line, the
+// final salary and the deduction amount are then summed from the lines so they keep adding up.
 func (r *SalaryRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateSalaryCalculationRequest) (*ent.SalaryCalculation, error) {
-	query := r.client.SalaryCalculation.UpdateOneID(id)
+	query := r.db(ctx).SalaryCalculation.UpdateOneID(id)
 
 	if req.BaseSalary != nil {
 		query = query.SetBaseSalary(*req.BaseSalary)
@@ -158,22 +373,76 @@ func (r *SalaryRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.U
 	if req.PresentDays != nil {
 		query = query.SetPresentDays(*req.PresentDays)
 	}
-	if req.FinalSalary != nil {
-		query = query.SetFinalSalary(*req.FinalSalary)
-	}
-	if req.DeductionAmount != nil {
-		query = query.SetDeductionAmount(*req.DeductionAmount)
-	}
 	if req.CalculationFormula != nil {
 		query = query.SetCalculationFormula(*req.CalculationFormula)
 	}
 
-	return query.Save(ctx)
+	if req.FinalSalary != nil {
+		existing, err := r.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		baseSalary := existing.BaseSalary
+		if req.BaseSalary != nil {
+			baseSalary = *req.BaseSalary
+		}
+
+		// The previous adjustment is replaced, the salary is adjusted from the calculated lines
+		proratedSalary := baseSalary
+		var items []calculator.LineItem
+		for _, item := range existing.Edges.Items {
+			if item.Code == adjustmentCode {
+				continue
+			}
+			if item.Code == "base_salary" {
+				proratedSalary = item.Amount
+			}
+			items = append(items, calculator.LineItem{
+				ComponentID: item.SalaryComponentID,
+				Code:        item.Code,
+				Name:        item.Name,
+				Kind:        calculator.LineKind(item.Kind),
+				Amount:      item.Amount,
+				Basis:       item.Basis,
+			})
+		}
+
+		earnings, deductions := calculator.SumLines(items)
+		if adjustment := req.FinalSalary.Sub(earnings.Sub(deductions)); !adjustment.IsZero() {
+			kind := calculator.LineEarning
+			if adjustment.IsNegative() {
+				kind = calculator.LineDeduction
+			}
+			items = append(items, calculator.LineItem{
+				Code:   adjustmentCode,
+				Name:   "Manual adjustment",
+				Kind:   kind,
+				Amount: adjustment.Abs(),
+				Basis:  fmt.Sprintf("final salary set to %s", req.FinalSalary.StringFixed(2)),
+			})
+		}
+
+		earnings, deductions = calculator.SumLines(items)
+		query = query.
+			SetFinalSalary(earnings.Sub(deductions)).
+			SetDeductionAmount(baseSalary.Sub(proratedSalary).Add(deductions))
+
+		if err := r.replaceItems(ctx, id, items); err != nil {
+			return nil, fmt.Errorf("failed to save salary lines: %w", err)
+		}
+	}
+
+	if _, err := query.Save(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
 }
 
 // Delete soft deletes a salary calculation record
 func (r *SalaryRepositoryImpl) Delete(ctx context.Context, id uint64) error {
-	return r.client.SalaryCalculation.
+	return r.db(ctx).SalaryCalculation.
 		UpdateOneID(id).
 		SetDeletedAt(time.Now()).
 		Exec(ctx)
@@ -181,7 +450,7 @@ func (r *SalaryRepositoryImpl) Delete(ctx context.Context, id uint64) error {
 
 // List retrieves salary calculations with pagination and filtering
 func (r *SalaryRepositoryImpl) List(ctx context.Context, params *dto.SalaryCalculationQueryParams) ([]*ent.SalaryCalculation, int, error) {
-	query := r.client.SalaryCalculation.
+	query := r.db(ctx).SalaryCalculation.
 		Query().
 		Where(salarycalculation.DeletedAtIsNil()).
 		WithEmployee()
@@ -235,7 +504,7 @@ func (r *SalaryRepositoryImpl) GetMonthlySalarySummary(ctx context.Context, mont
 	normalizedMonth := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
 
 	// Get total employees (active)
-	totalEmployees, err := r.client.Employee.
+	totalEmployees, err := r.db(ctx).Employee.
 		Query().
 		Where(employee.DeletedAtIsNil()).
 		Count(ctx)
@@ -244,7 +513,7 @@ func (r *SalaryRepositoryImpl) GetMonthlySalarySummary(ctx context.Context, mont
 	}
 
 	// Get calculated salaries for the month
-	calculations, err := r.client.SalaryCalculation.
+	calculations, err := r.db(ctx).SalaryCalculation.
 		Query().
 		Where(salarycalculation.CalculationMonth(normalizedMonth)).
 		Where(salarycalculation.DeletedAtIsNil()).
@@ -257,13 +526,13 @@ func (r *SalaryRepositoryImpl) GetMonthlySalarySummary(ctx context.Context, mont
 	pendingCount := totalEmployees - calculatedCount
 
 	// Calculate totals
-	var totalBaseSalary, totalFinalSalary, totalDeductions float64
+	var totalBaseSalary, totalFinalSalary, totalDeductions decimal.Decimal
 	var totalAttendancePercentage float64
 
 	for _, calc := range calculations {
-		totalBaseSalary += calc.BaseSalary
-		totalFinalSalary += calc.FinalSalary
-		totalDeductions += calc.DeductionAmount
+		totalBaseSalary = totalBaseSalary.Add(calc.BaseSalary)
+		totalFinalSalary = totalFinalSalary.Add(calc.FinalSalary)
+		totalDeductions = totalDeductions.Add(calc.DeductionAmount)
 
 		if calc.TotalWorkingDays > 0 {
 			attendancePercentage := float64(calc.PresentDays) / float64(calc.TotalWorkingDays) * 100
@@ -291,7 +560,7 @@ func (r *SalaryRepositoryImpl) GetMonthlySalarySummary(ctx context.Context, mont
 // GetEmployeeSalarySummary retrieves salary summary for an employee across months
 func (r *SalaryRepositoryImpl) GetEmployeeSalarySummary(ctx context.Context, employeeID uint64, startMonth, endMonth time.Time) (*dto.EmployeeSalarySummary, error) {
 	// Get employee info
-	emp, err := r.client.Employee.
+	emp, err := r.db(ctx).Employee.
 		Query().
 		Where(employee.ID(employeeID)).
 		Where(employee.DeletedAtIsNil()).
@@ -305,7 +574,7 @@ func (r *SalaryRepositoryImpl) GetEmployeeSalarySummary(ctx context.Context, emp
 	normalizedEndMonth := time.Date(endMonth.Year(), endMonth.Month(), 1, 0, 0, 0, 0, endMonth.Location())
 
 	// Get salary calculations for the period
-	calculations, err := r.client.SalaryCalculation.
+	calculations, err := r.db(ctx).SalaryCalculation.
 		Query().
 		Where(salarycalculation.EmployeeID(employeeID)).
 		Where(salarycalculation.CalculationMonthGTE(normalizedStartMonth)).
@@ -318,7 +587,7 @@ func (r *SalaryRepositoryImpl) GetEmployeeSalarySummary(ctx context.Context, emp
 	}
 
 	totalMonths := len(calculations)
-	var totalBaseSalary, totalFinalSalary, totalDeductions float64
+	var totalBaseSalary, totalFinalSalary, totalDeductions decimal.Decimal
 	var totalAttendancePercentage float64
 
 	// Convert to response DTOs and calculate totals
@@ -333,6 +602,13 @@ func (r *SalaryRepositoryImpl) GetEmployeeSalarySummary(ctx context.Context, emp
 			TotalWorkingDays:   calc.TotalWorkingDays,
 			AbsentDays:         calc.AbsentDays,
 			PresentDays:        calc.PresentDays,
+			PaidDays:           calc.PaidDays,
+			LateDays:           calc.LateDays,
+			LatePenalty:        calc.LatePenalty,
+			PayPolicy:          calc.PayPolicy,
+			WorkedMinutes:      calc.WorkedMinutes,
+			OvertimeMinutes:    calc.OvertimeMinutes,
+			OvertimePay:        calc.OvertimePay,
 			FinalSalary:        calc.FinalSalary,
 			DeductionAmount:    calc.DeductionAmount,
 			CalculationFormula: calc.CalculationFormula,
@@ -340,9 +616,9 @@ func (r *SalaryRepositoryImpl) GetEmployeeSalarySummary(ctx context.Context, emp
 			ModifiedAt:         calc.ModifiedAt,
 		}
 
-		totalBaseSalary += calc.BaseSalary
-		totalFinalSalary += calc.FinalSalary
-		totalDeductions += calc.DeductionAmount
+		totalBaseSalary = totalBaseSalary.Add(calc.BaseSalary)
+		totalFinalSalary = totalFinalSalary.Add(calc.FinalSalary)
+		totalDeductions = totalDeductions.Add(calc.DeductionAmount)
 
 		if calc.TotalWorkingDays > 0 {
 			attendancePercentage := float64(calc.PresentDays) / float64(calc.TotalWorkingDays) * 100
@@ -376,7 +652,7 @@ func (r *SalaryRepositoryImpl) BulkCalculateSalary(ctx context.Context, req *dto
 		employeeIDs = req.EmployeeIDs
 	} else {
 		// Get all active employees
-		employees, err := r.client.Employee.
+		employees, err := r.db(ctx).Employee.
 			Query().
 			Where(employee.DeletedAtIsNil()).
 			Select(employee.FieldID).
@@ -409,8 +685,8 @@ func (r *SalaryRepositoryImpl) BulkCalculateSalary(ctx context.Context, req *dto
 	return results, nil
 }
 
-// GetWorkingDaysInMonth calculates total working days in a month (excluding weekends)
-func (r *SalaryRepositoryImpl) GetWorkingDaysInMonth(ctx context.Context, month time.Time) (int, error) {
+// GetWorkingDaysInMonth calculates total working days of an employee in a month (excluding rest days and holidays)
+func (r *SalaryRepositoryImpl) GetWorkingDaysInMonth(ctx context.Context, employeeID uint64, month time.Time) (int, error) {
 	year := month.Year()
 	monthNum := month.Month()
 
@@ -418,19 +694,12 @@ func (r *SalaryRepositoryImpl) GetWorkingDaysInMonth(ctx context.Context, month
 	firstDay := time.Date(year, monthNum, 1, 0, 0, 0, 0, month.Location())
 	lastDay := firstDay.AddDate(0, 1, -1) // Last day of the month
 
-	workingDays := 0
-	for d := firstDay; !d.After(lastDay); d = d.AddDate(0, 0, 1) {
-		weekday := d.Weekday()
-		if weekday != time.Saturday && weekday != time.Sunday {
-			workingDays++
-		}
-	}
-
-	return workingDays, nil
+	return r.calendar.CountEmployeeWorkingDays(ctx, employeeID, firstDay, lastDay)
 }
 
-// GetAttendanceDataForMonth retrieves attendance data for an employee in a specific month
-func (r *SalaryRepositoryImpl) GetAttendanceDataForMonth(ctx context.Context, employeeID uint64, month time.Time) (presentDays, absentDays int, err error) {
+// GetAttendanceDataForMonth retrieves attendance data for an employee in a specific month, every working day is
+// weighted by the attendance pay policy of the work schedule that applied on it
+func (r *SalaryRepositoryImpl) GetAttendanceDataForMonth(ctx context.Context, employeeID uint64, month time.Time) (*dto.AttendancePaySummary, error) {
 	year := month.Year()
 	monthNum := month.Month()
 
@@ -438,40 +707,377 @@ func (r *SalaryRepositoryImpl) GetAttendanceDataForMonth(ctx context.Context, em
 	firstDay := time.Date(year, monthNum, 1, 0, 0, 0, 0, month.Location())
 	lastDay := firstDay.AddDate(0, 1, -1) // Last day of the month
 
+	// Get working days for the month from the employee's schedule, holidays and rest days are never deducted
+	workingDays, err := r.calendar.GetEmployeeWorkingDays(ctx, employeeID, firstDay, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working days: %w", err)
+	}
+
+	isWorkingDay := make(map[string]bool, len(workingDays))
+	for _, d := range workingDays {
+		isWorkingDay[calendar.DateKey(d)] = true
+	}
+
 	// Get attendance records for the month
-	attendanceRecords, err := r.client.Attendance.
+	attendanceRecords, err := r.db(ctx).Attendance.
 		Query().
 		Where(attendance.EmployeeID(employeeID)).
 		Where(attendance.AttendanceDateGTE(firstDay)).
 		Where(attendance.AttendanceDateLTE(lastDay)).
 		Where(attendance.DeletedAtIsNil()).
-		Where(attendance.IsWeekendEQ(false)). // Only working days
+		Order(ent.Asc(attendance.FieldAttendanceDate)).
 		All(ctx)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to fetch attendance records: %w", err)
+		return nil, fmt.Errorf("failed to fetch attendance records: %w", err)
+	}
+
+	resolvePolicy, err := r.payPolicyResolver(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	// The schedules of the month are loaded once, each day is looked up in memory
+	resolveSchedule, err := r.calendar.GetEmployeeScheduleResolver(ctx, employeeID, firstDay, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work schedule: %w", err)
+	}
+
+	summary := &dto.AttendancePaySummary{}
+	applied := make(map[uint64]calculator.PayPolicy)
+	lateDaysByPolicy := make(map[uint64]int)
+
 	// Count present and absent days
 	for _, record := range attendanceRecords {
+		if !isWorkingDay[calendar.DateKey(record.AttendanceDate)] {
+			continue
+		}
+
+		policy := resolvePolicy(resolveSchedule(record.AttendanceDate).AttendancePayPolicyID)
+		if _, ok := applied[policy.ID]; !ok {
+			applied[policy.ID] = policy
+			summary.Policies = append(summary.Policies, policy.String())
+		}
+
+		// Approved paid leave is paid like a full present day without counting as present, so allowances per
+		// present day are not paid for it. Unpaid leave is deducted like an absence.
 		switch record.Status {
-		case attendance.StatusPresent, attendance.StatusLate, attendance.StatusHalfDay:
-			presentDays++
-		case attendance.StatusAbsent:
-			absentDays++
+		case attendance.StatusPresent, attendance.StatusRemote, attendance.StatusBusinessTrip:
+			summary.PresentDays++
+			summary.PaidDays = summary.PaidDays.Add(policy.PresentWeight)
+		case attendance.StatusLate:
+			summary.PresentDays++
+			summary.LateDays++
+			summary.PaidDays = summary.PaidDays.Add(policy.LateWeight)
+			lateDaysByPolicy[policy.ID]++
+		case attendance.StatusHalfDay:
+			summary.PresentDays++
+			summary.PaidDays = summary.PaidDays.Add(policy.HalfDayWeight)
+		case attendance.StatusLeave, attendance.StatusSick:
+			summary.PaidLeaveDays++
+			summary.PaidDays = summary.PaidDays.Add(decimal.NewFromInt(1))
+		case attendance.StatusAbsent, attendance.StatusUnpaidLeave:
+			summary.AbsentDays++
 		}
 	}
 
-	// Calculate working days
-	totalWorkingDays, err := r.GetWorkingDaysInMonth(ctx, month)
-	if err != nil {
-		return 0, 0, err
+	// Late days are counted separately per policy, each policy tolerates its own number of late days
+	for policyID, lateDays := range lateDaysByPolicy {
+		summary.LatePenalty = summary.LatePenalty.Add(applied[policyID].LatePenalty(lateDays))
 	}
 
 	// If we have fewer attendance records than working days, assume missing days are absent
-	recordedDays := presentDays + absentDays
+	totalWorkingDays := len(workingDays)
+	recordedDays := summary.PresentDays + summary.PaidLeaveDays + summary.AbsentDays
 	if recordedDays < totalWorkingDays {
-		absentDays += (totalWorkingDays - recordedDays)
+		summary.AbsentDays += (totalWorkingDays - recordedDays)
+	}
+
+	return summary, nil
+}
+
+// payPolicyResolver loads the attendance pay policies once and returns a function resolving the policy of a
+// work schedule. Schedules without a policy use the default policy, or calculator.DefaultPayPolicy when none is set.
+func (r *SalaryRepositoryImpl) payPolicyResolver(ctx context.Context) (func(*uint64) calculator.PayPolicy, error) {
+	records, err := r.db(ctx).AttendancePayPolicy.
+		Query().
+		Where(attendancepaypolicy.DeletedAtIsNil()).
+		Order(ent.Asc(attendancepaypolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attendance pay policies: %w", err)
+	}
+
+	fallback := calculator.DefaultPayPolicy()
+	policies := make(map[uint64]calculator.PayPolicy, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		policy := newPayPolicy(records[i])
+		policies[policy.ID] = policy
+		if records[i].IsDefault {
+			fallback = policy
+		}
+	}
+
+	return func(policyID *uint64) calculator.PayPolicy {
+		if policyID != nil {
+			if policy, ok := policies[*policyID]; ok {
+				return policy
+			}
+		}
+		return fallback
+	}, nil
+}
+
+// newPayPolicy converts a stored attendance pay policy into a calculator.PayPolicy
+func newPayPolicy(record *ent.AttendancePayPolicy) calculator.PayPolicy {
+	return calculator.PayPolicy{
+		ID:                  record.ID,
+		Name:                record.Name,
+		PresentWeight:       record.PresentWeight,
+		LateWeight:          record.LateWeight,
+		HalfDayWeight:       record.HalfDayWeight,
+		LatePenaltyAmount:   record.LatePenaltyAmount,
+		LatePenaltyFreeDays: record.LatePenaltyFreeDays,
+	}
+}
+
+// GetWorkedMinutesForMonth sums the minutes worked by an employee in a specific month, excluding breaks
+func (r *SalaryRepositoryImpl) GetWorkedMinutesForMonth(ctx context.Context, employeeID uint64, month time.Time) (int, error) {
+	firstDay := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	lastDay := firstDay.AddDate(0, 1, -1)
+
+	attendanceRecords, err := r.db(ctx).Attendance.
+		Query().
+		Where(attendance.EmployeeID(employeeID)).
+		Where(attendance.AttendanceDateGTE(firstDay)).
+		Where(attendance.AttendanceDateLTE(lastDay)).
+		Where(attendance.DeletedAtIsNil()).
+		Select(attendance.FieldWorkedMinutes).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch attendance records: %w", err)
+	}
+
+	workedMinutes := 0
+	for _, record := range attendanceRecords {
+		workedMinutes += record.WorkedMinutes
+	}
+
+	return workedMinutes, nil
+}
+
+// GetOvertimeForMonth sums the approved overtime of an employee in a specific month and its pay
+func (r *SalaryRepositoryImpl) GetOvertimeForMonth(ctx context.Context, employeeID uint64, month time.Time, baseSalary decimal.Decimal) (minutes int, pay decimal.Decimal, err error) {
+	firstDay := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	lastDay := firstDay.AddDate(0, 1, -1)
+
+	// Overtime of a deleted attendance was not worked and is not paid
+	overtimes, err := r.db(ctx).Overtime.
+		Query().
+		Where(overtime.EmployeeID(employeeID)).
+		Where(overtime.OvertimeDateGTE(firstDay)).
+		Where(overtime.OvertimeDateLTE(lastDay)).
+		Where(overtime.StatusEQ(overtime.StatusApproved)).
+		Where(overtime.DeletedAtIsNil()).
+		Where(overtime.HasAttendanceWith(attendance.DeletedAtIsNil())).
+		All(ctx)
+	if err != nil {
+		return 0, decimal.Zero, fmt.Errorf("failed to fetch overtime: %w", err)
+	}
+
+	// The schedules of the month are loaded once, each overtime date is looked up in memory
+	resolveSchedule, err := r.calendar.GetEmployeeScheduleResolver(ctx, employeeID, firstDay, lastDay)
+	if err != nil {
+		return 0, decimal.Zero, fmt.Errorf("failed to get work schedule: %w", err)
+	}
+
+	for _, record := range overtimes {
+		approvedMinutes := record.Minutes
+		if record.ApprovedMinutes != nil {
+			approvedMinutes = *record.ApprovedMinutes
+		}
+
+		// Rest day multipliers depend on the length of the work week on that date
+		schedule := resolveSchedule(record.OvertimeDate)
+
+		minutes += approvedMinutes
+		pay = pay.Add(calculator.OvertimePay(baseSalary, approvedMinutes, record.IsRestDay, len(schedule.WorkingDays)))
+	}
+
+	return minutes, pay, nil
+}
+
+// GetSalaryComponents resolves the active salary components of an employee. A component assigned to the
+// employee wins over the same component assigned to their department, amounts and percentages of the
+// assignment override the ones of the component.
+func (r *SalaryRepositoryImpl) GetSalaryComponents(ctx context.Context, emp *ent.Employee) ([]calculator.Component, error) {
+	assignedTo := salarycomponentassignment.EmployeeID(emp.ID)
+	if emp.Department != "" {
+		assignedTo = salarycomponentassignment.Or(
+			assignedTo,
+			salarycomponentassignment.And(
+				salarycomponentassignment.EmployeeIDIsNil(),
+				salarycomponentassignment.DepartmentEqualFold(emp.Department),
+			),
+		)
+	}
+
+	assignments, err := r.db(ctx).SalaryComponentAssignment.
+		Query().
+		Where(assignedTo).
+		Where(salarycomponentassignment.IsActiveEQ(true)).
+		Where(salarycomponentassignment.DeletedAtIsNil()).
+		Where(salarycomponentassignment.HasSalaryComponentWith(
+			salarycomponent.IsActiveEQ(true),
+			salarycomponent.DeletedAtIsNil(),
+		)).
+		WithSalaryComponent().
+		Order(ent.Asc(salarycomponentassignment.FieldSalaryComponentID), ent.Asc(salarycomponentassignment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch salary component assignments: %w", err)
+	}
+
+	var components []calculator.Component
+	resolved := make(map[uint64]int)
+	for _, assignment := range assignments {
+		definition := assignment.Edges.SalaryComponent
+		component := calculator.Component{
+			ID:         definition.ID,
+			Code:       definition.Code,
+			Name:       definition.Name,
+			Kind:       calculator.LineKind(definition.Kind),
+			Method:     calculator.ComponentMethod(definition.Method),
+			Amount:     definition.Amount,
+			Percentage: definition.Percentage,
+		}
+		if assignment.Amount != nil {
+			component.Amount = *assignment.Amount
+		}
+		if assignment.Percentage != nil {
+			component.Percentage = *assignment.Percentage
+		}
+
+		i, ok := resolved[definition.ID]
+		if !ok {
+			resolved[definition.ID] = len(components)
+			components = append(components, component)
+			continue
+		}
+		if assignment.EmployeeID != nil {
+			components[i] = component
+		}
+	}
+
+	return components, nil
+}
+
+// GetContributionRates retrieves the BPJS rate of every program in effect for the month, the JKK rate of the risk
+// class. A program without a rate in effect is not contributed to.
+func (r *SalaryRepositoryImpl) GetContributionRates(ctx context.Context, month time.Time, riskClass int) ([]calculator.ContributionRate, error) {
+	rows, err := r.db(ctx).BpjsContributionRate.
+		Query().
+		Where(bpjscontributionrate.EffectiveFromLTE(month)).
+		Where(bpjscontributionrate.DeletedAtIsNil()).
+		Order(ent.Desc(bpjscontributionrate.FieldEffectiveFrom), ent.Desc(bpjscontributionrate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch BPJS contribution rates: %w", err)
+	}
+
+	// The latest row of a program is the one in effect
+	inEffect := make(map[calculator.ContributionProgram]*ent.BpjsContributionRate)
+	for _, row := range rows {
+		program := calculator.ContributionProgram(row.Program)
+		if program == calculator.ProgramJKK && row.RiskClass != riskClass {
+			continue
+		}
+		if _, ok := inEffect[program]; !ok {
+			inEffect[program] = row
+		}
+	}
+
+	var rates []calculator.ContributionRate
+	for _, program := range calculator.ContributionPrograms {
+		row, ok := inEffect[program]
+		if !ok {
+			continue
+		}
+		rates = append(rates, calculator.ContributionRate{
+			Program:      program,
+			EmployerRate: row.EmployerRate,
+			EmployeeRate: row.EmployeeRate,
+			WageCap:      row.WageCap,
+		})
+	}
+
+	return rates, nil
+}
+
+// GetTaxYearToDate sums the taxable income, the PPh 21 withheld and the JHT and JP paid by the employee in the
+// months of the year before the month
+func (r *SalaryRepositoryImpl) GetTaxYearToDate(ctx context.Context, employeeID uint64, month time.Time) (gross, withheld, pension decimal.Decimal, months int, err error) {
+	startOfYear := time.Date(month.Year(), time.January, 1, 0, 0, 0, 0, month.Location())
+
+	calculations, err := r.db(ctx).SalaryCalculation.
+		Query().
+		Where(salarycalculation.EmployeeID(employeeID)).
+		Where(salarycalculation.CalculationMonthGTE(startOfYear)).
+		Where(salarycalculation.CalculationMonthLT(month)).
+		Where(salarycalculation.DeletedAtIsNil()).
+		WithItems(func(q *ent.SalaryCalculationItemQuery) {
+			q.Where(
+				salarycalculationitem.CodeIn(
+					"pph21",
+					calculator.ContributionLineCode(calculator.ProgramJHT, false),
+					calculator.ContributionLineCode(calculator.ProgramJP, false),
+				),
+				salarycalculationitem.KindEQ(salarycalculationitem.KindDeduction),
+			)
+		}).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, 0, fmt.Errorf("failed to fetch salary calculations: %w", err)
+	}
+
+	for _, calculation := range calculations {
+		gross = gross.Add(calculation.TaxableIncome)
+		for _, item := range calculation.Edges.Items {
+			if item.Code == "pph21" {
+				withheld = withheld.Add(item.Amount)
+			} else {
+				pension = pension.Add(item.Amount)
+			}
+		}
+	}
+
+	return gross, withheld, pension, len(calculations), nil
+}
+
+// replaceItems replaces the salary lines of a calculation with the lines of its latest calculation
+func (r *SalaryRepositoryImpl) replaceItems(ctx context.Context, calculationID uint64, items []calculator.LineItem) error {
+	_, err := r.db(ctx).SalaryCalculationItem.
+		Delete().
+		Where(salarycalculationitem.SalaryCalculationID(calculationID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.SalaryCalculationItemCreate, len(items))
+	for i, item := range items {
+		builders[i] = r.db(ctx).SalaryCalculationItem.Create().
+			SetSalaryCalculationID(calculationID).
+			SetNillableSalaryComponentID(item.ComponentID).
+			SetCode(item.Code).
+			SetName(item.Name).
+			SetKind(salarycalculationitem.Kind(item.Kind)).
+			SetAmount(item.Amount).
+			SetBasis(item.Basis)
 	}
 
-	return presentDays, absentDays, nil
+	return r.db(ctx).SalaryCalculationItem.CreateBulk(builders...).Exec(ctx)
 }
@@ -0,0 +1,551 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendance"
+	"mceasy/ent/bpjscontributionrate"
+	"mceasy/ent/employee"
+	"mceasy/ent/overtime"
+	"mceasy/ent/salarycalculationitem"
+	"mceasy/ent/salarycomponent"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/component/calendar"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSalaryRepositoryImpl_CalculateSalaryWithPayPolicy(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	// August 2025 has 21 working days from Monday to Friday, 2,100,000 pays 100,000 per day
+	emp, err := client.Employee.Create().
+		SetFullName("Pay Policy").
+		SetEmail("pay.policy@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(2100000)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	record := func(day int, status attendance.Status) {
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)).
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	record(1, attendance.StatusPresent)
+	record(4, attendance.StatusLate)
+	record(5, attendance.StatusLate)
+	record(6, attendance.StatusHalfDay)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	month := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("built-in policy pays half a day for a half day", func(t *testing.T) {
+		data, err := repo.GetAttendanceDataForMonth(ctx, emp.ID, month)
+		require.NoError(t, err)
+		assert.Equal(t, 4, data.PresentDays)
+		assert.Equal(t, 17, data.AbsentDays)
+		assert.Equal(t, 2, data.LateDays)
+		assert.Equal(t, "3.5", data.PaidDays.String())
+		assert.True(t, data.LatePenalty.IsZero())
+		assert.Equal(t, []string{"Default (present 1, late 1, half day 0.5)"}, data.Policies)
+	})
+
+	policy, err := client.AttendancePayPolicy.Create().
+		SetName("Office").
+		SetLateWeight(decimal.RequireFromString("0.9")).
+		SetHalfDayWeight(decimal.RequireFromString("0.5")).
+		SetLatePenaltyAmount(decimal.NewFromInt(50000)).
+		SetLatePenaltyFreeDays(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	schedule, err := client.WorkSchedule.Create().
+		SetName("Office Hours").
+		SetStartTime("09:00").
+		SetEndTime("17:00").
+		SetWorkingDays([]int{1, 2, 3, 4, 5}).
+		SetIsDefault(true).
+		SetAttendancePayPolicyID(policy.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("schedule policy weights late days and penalises lateness", func(t *testing.T) {
+		calculation, err := repo.CalculateSalary(ctx, &dto.CalculateSalaryRequest{
+			EmployeeID:       emp.ID,
+			CalculationMonth: month,
+		})
+		require.NoError(t, err)
+
+		// 1 + 0.9 + 0.9 + 0.5 paid days, one late day beyond the tolerated one
+		assert.Equal(t, "3.3", calculation.PaidDays.String())
+		assert.Equal(t, 4, calculation.PresentDays)
+		assert.Equal(t, 2, calculation.LateDays)
+		assert.Equal(t, "50000", calculation.LatePenalty.String())
+		assert.Equal(t, "280000", calculation.FinalSalary.String())
+		assert.Equal(t, "1820000", calculation.DeductionAmount.String())
+		assert.Equal(t, "Office (present 1, late 0.9, half day 0.5, late penalty 50000.00 per late day beyond 1)", calculation.PayPolicy)
+		assert.Contains(t, calculation.CalculationFormula, "Policy: Office")
+	})
+
+	t.Run("default policy applies to schedules without one", func(t *testing.T) {
+		require.NoError(t, client.WorkSchedule.UpdateOneID(schedule.ID).ClearAttendancePayPolicyID().Exec(ctx))
+		_, err := client.AttendancePayPolicy.Create().
+			SetName("Strict").
+			SetHalfDayWeight(decimal.RequireFromString("0.25")).
+			SetIsDefault(true).
+			Save(ctx)
+		require.NoError(t, err)
+
+		data, err := repo.GetAttendanceDataForMonth(ctx, emp.ID, month)
+		require.NoError(t, err)
+		assert.Equal(t, "3.25", data.PaidDays.String())
+		assert.Equal(t, []string{"Strict (present 1, late 1, half day 0.25)"}, data.Policies)
+	})
+}
+
+func TestSalaryRepositoryImpl_GetAttendanceDataForMonthAwayAndSick(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Field Trip").
+		SetEmail("field.trip@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(2100000)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	record := func(day int, status attendance.Status) {
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)).
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	record(1, attendance.StatusRemote)
+	record(4, attendance.StatusBusinessTrip)
+	record(5, attendance.StatusSick)
+	record(6, attendance.StatusAbsent)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+
+	// Remote and business trip days are worked, a sick day is paid like leave
+	data, err := repo.GetAttendanceDataForMonth(ctx, emp.ID, time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 2, data.PresentDays)
+	assert.Equal(t, 1, data.PaidLeaveDays)
+	assert.Equal(t, 18, data.AbsentDays)
+	assert.Zero(t, data.LateDays)
+	assert.Equal(t, "3", data.PaidDays.String())
+}
+
+func TestSalaryRepositoryImpl_GetOvertimeForMonthSkipsDeletedAttendance(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Late Shift").
+		SetEmail("late.shift@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(1730000)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	approvedOvertime := func(day int) *ent.Attendance {
+		date := time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)
+		record, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(date).
+			SetStatus(attendance.StatusPresent).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = client.Overtime.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceID(record.ID).
+			SetOvertimeDate(date).
+			SetActualEnd(date.Add(19 * time.Hour)).
+			SetMinutes(60).
+			SetStatus(overtime.StatusApproved).
+			Save(ctx)
+		require.NoError(t, err)
+		return record
+	}
+	approvedOvertime(4)
+	deleted := approvedOvertime(5)
+
+	// The attendance was deleted after its overtime was approved
+	require.NoError(t, client.Attendance.UpdateOneID(deleted.ID).SetDeletedAt(time.Now()).Exec(ctx))
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	minutes, pay, err := repo.GetOvertimeForMonth(ctx, emp.ID, time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC), emp.BaseSalary)
+	require.NoError(t, err)
+	assert.Equal(t, 60, minutes)
+	// 1,730,000 / 173 x 1.5 for the first hour
+	assert.Equal(t, "15000", pay.String())
+}
+
+func TestSalaryRepositoryImpl_CalculateSalaryWithComponents(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Component Pay").
+		SetEmail("component.pay@example.com").
+		SetDepartment("Engineering").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(2100000)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	for _, day := range []int{1, 4} {
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)).
+			SetStatus(attendance.StatusPresent).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	component := func(code string, kind salarycomponent.Kind, method salarycomponent.Method, amount, percentage int64, active bool) uint64 {
+		record, err := client.SalaryComponent.Create().
+			SetCode(code).
+			SetName(code).
+			SetKind(kind).
+			SetMethod(method).
+			SetAmount(decimal.NewFromInt(amount)).
+			SetPercentage(decimal.NewFromInt(percentage)).
+			SetIsActive(active).
+			Save(ctx)
+		require.NoError(t, err)
+		return record.ID
+	}
+	assign := func(componentID uint64, employeeID *uint64, department string, amount *decimal.Decimal) {
+		_, err := client.SalaryComponentAssignment.Create().
+			SetSalaryComponentID(componentID).
+			SetNillableEmployeeID(employeeID).
+			SetDepartment(department).
+			SetNillableAmount(amount).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	transport := component("transport", salarycomponent.KindEarning, salarycomponent.MethodFixed, 500000, 0, true)
+	meal := component("meal", salarycomponent.KindEarning, salarycomponent.MethodPerPresentDay, 25000, 0, true)
+	loan := component("loan", salarycomponent.KindDeduction, salarycomponent.MethodFixed, 100000, 0, true)
+	position := component("position", salarycomponent.KindEarning, salarycomponent.MethodPercentageOfBase, 0, 10, true)
+	retired := component("retired", salarycomponent.KindEarning, salarycomponent.MethodFixed, 900000, 0, false)
+
+	// The employee assignment of transport overrides its department assignment
+	override := decimal.NewFromInt(600000)
+	assign(transport, &emp.ID, "", &override)
+	assign(transport, nil, "engineering", nil)
+	assign(meal, nil, "Engineering", nil)
+	assign(loan, &emp.ID, "", nil)
+	assign(position, nil, "Finance", nil)
+	assign(retired, &emp.ID, "", nil)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	request := &dto.CalculateSalaryRequest{
+		EmployeeID:       emp.ID,
+		CalculationMonth: time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for i := 0; i < 2; i++ {
+		// Recalculating replaces the lines instead of adding to them
+		calculation, err := repo.CalculateSalary(ctx, request)
+		require.NoError(t, err)
+
+		// 2,100,000 * 2/21 + 600,000 + 2 x 25,000 - 100,000
+		assert.Equal(t, "750000", calculation.FinalSalary.String())
+		assert.Equal(t, "2000000", calculation.DeductionAmount.String())
+		assert.Contains(t, calculation.CalculationFormula, "Components: transport +600000.00, meal +50000.00, loan -100000.00")
+
+		items := calculation.Edges.Items
+		require.Len(t, items, 4)
+		codes := make([]string, len(items))
+		for j, item := range items {
+			codes[j] = item.Code
+		}
+		assert.Equal(t, []string{"base_salary", "transport", "meal", "loan"}, codes)
+		assert.Equal(t, "200000", items[0].Amount.String())
+		assert.Nil(t, items[0].SalaryComponentID)
+		assert.Equal(t, "50000", items[2].Amount.String())
+		assert.Equal(t, "2 present days x 25000.00", items[2].Basis)
+		assert.Equal(t, salarycalculationitem.KindDeduction, items[3].Kind)
+		require.NotNil(t, items[3].SalaryComponentID)
+		assert.Equal(t, loan, *items[3].SalaryComponentID)
+	}
+
+	t.Run("a changed final salary is recorded as an adjustment line", func(t *testing.T) {
+		calculation, err := repo.GetByEmployeeAndMonth(ctx, emp.ID, request.CalculationMonth)
+		require.NoError(t, err)
+
+		lowered := decimal.NewFromInt(700000)
+		updated, err := repo.Update(ctx, calculation.ID, &dto.UpdateSalaryCalculationRequest{FinalSalary: &lowered})
+		require.NoError(t, err)
+		assert.Equal(t, "700000", updated.FinalSalary.String())
+		// 2,100,000 - 200,000 prorated + 100,000 loan + 50,000 adjustment
+		assert.Equal(t, "2050000", updated.DeductionAmount.String())
+		require.Len(t, updated.Edges.Items, 5)
+		adjustment := updated.Edges.Items[4]
+		assert.Equal(t, "adjustment", adjustment.Code)
+		assert.Equal(t, salarycalculationitem.KindDeduction, adjustment.Kind)
+		assert.Equal(t, "50000", adjustment.Amount.String())
+
+		// A second change replaces the adjustment instead of adding to it
+		raised := decimal.NewFromInt(800000)
+		updated, err = repo.Update(ctx, calculation.ID, &dto.UpdateSalaryCalculationRequest{FinalSalary: &raised})
+		require.NoError(t, err)
+		assert.Equal(t, "800000", updated.FinalSalary.String())
+		assert.Equal(t, "2000000", updated.DeductionAmount.String())
+		require.Len(t, updated.Edges.Items, 5)
+		adjustment = updated.Edges.Items[4]
+		assert.Equal(t, salarycalculationitem.KindEarning, adjustment.Kind)
+		assert.Equal(t, "50000", adjustment.Amount.String())
+	})
+}
+
+func TestSalaryRepositoryImpl_CalculateSalaryAllowancePerPresentDaySkipsLeave(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("On Leave").
+		SetEmail("on.leave@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(2100000)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	record := func(day int, status attendance.Status) {
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)).
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	record(1, attendance.StatusPresent)
+	record(4, attendance.StatusLeave)
+	record(5, attendance.StatusSick)
+
+	meal, err := client.SalaryComponent.Create().
+		SetCode("meal").
+		SetName("meal").
+		SetKind(salarycomponent.KindEarning).
+		SetMethod(salarycomponent.MethodPerPresentDay).
+		SetAmount(decimal.NewFromInt(25000)).
+		SetIsActive(true).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.SalaryComponentAssignment.Create().
+		SetSalaryComponentID(meal.ID).
+		SetEmployeeID(emp.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	calculation, err := repo.CalculateSalary(ctx, &dto.CalculateSalaryRequest{
+		EmployeeID:       emp.ID,
+		CalculationMonth: time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	// Leave and sick days are paid in the base salary, the meal allowance only for the day worked
+	assert.Equal(t, 1, calculation.PresentDays)
+	assert.Equal(t, "3", calculation.PaidDays.String())
+	require.Len(t, calculation.Edges.Items, 2)
+	assert.Equal(t, "300000", calculation.Edges.Items[0].Amount.String())
+	assert.Equal(t, "25000", calculation.Edges.Items[1].Amount.String())
+	assert.Equal(t, "1 present days x 25000.00", calculation.Edges.Items[1].Basis)
+	assert.Equal(t, "325000", calculation.FinalSalary.String())
+	assert.Contains(t, calculation.CalculationFormula, "Paid Leave: 2 days")
+}
+
+func TestSalaryRepositoryImpl_CalculateSalaryWithPPh21(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	// Joined in November, without attendance only the fixed allowance of 10,000,000 is earned
+	emp, err := client.Employee.Create().
+		SetFullName("Tax Payer").
+		SetEmail("tax.payer@example.com").
+		SetHireDate(time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(2100000)).
+		SetTimezone("UTC").
+		SetPtkpStatus(employee.PtkpStatusTK0).
+		SetNpwp("0123456789012345").
+		Save(ctx)
+	require.NoError(t, err)
+
+	allowance, err := client.SalaryComponent.Create().
+		SetCode("allowance").
+		SetName("Allowance").
+		SetKind(salarycomponent.KindEarning).
+		SetMethod(salarycomponent.MethodFixed).
+		SetAmount(decimal.NewFromInt(10000000)).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.SalaryComponentAssignment.Create().
+		SetSalaryComponentID(allowance.ID).
+		SetEmployeeID(emp.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	taxLine := func(calculation *ent.SalaryCalculation) *ent.SalaryCalculationItem {
+		for _, item := range calculation.Edges.Items {
+			if item.Code == "pph21" {
+				return item
+			}
+		}
+		return nil
+	}
+
+	november, err := repo.CalculateSalary(ctx, &dto.CalculateSalaryRequest{
+		EmployeeID:       emp.ID,
+		CalculationMonth: time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "10000000", november.TaxableIncome.String())
+	tax := taxLine(november)
+	require.NotNil(t, tax)
+	assert.Equal(t, salarycalculationitem.KindDeduction, tax.Kind)
+	assert.Equal(t, "200000", tax.Amount.String())
+	assert.Equal(t, "TER A 2% of 10000000.00", tax.Basis)
+	assert.Equal(t, "9800000", november.FinalSalary.String())
+
+	// 20,000,000 a year is below PTKP after the position cost, the TER withheld in November is refunded
+	december, err := repo.CalculateSalary(ctx, &dto.CalculateSalaryRequest{
+		EmployeeID:       emp.ID,
+		CalculationMonth: time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	tax = taxLine(december)
+	require.NotNil(t, tax)
+	assert.Equal(t, salarycalculationitem.KindEarning, tax.Kind)
+	assert.Equal(t, "200000", tax.Amount.String())
+	assert.Contains(t, tax.Basis, "annual 20000000.00 - position cost 1000000.00")
+	assert.Equal(t, "10200000", december.FinalSalary.String())
+	assert.Contains(t, december.CalculationFormula, "PPh 21: +200000.00")
+}
+
+func TestSalaryRepositoryImpl_CalculateSalaryWithBpjs(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	emp, err := client.Employee.Create().
+		SetFullName("Insured").
+		SetEmail("insured@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetBaseSalary(decimal.NewFromInt(12600000)).
+		SetTimezone("UTC").
+		SetNpwp("0123456789012345").
+		SetJkkRiskClass(2).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Present on every working day of August 2025
+	for day := 1; day <= 31; day++ {
+		date := time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC)
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		_, err := client.Attendance.Create().
+			SetEmployeeID(emp.ID).
+			SetAttendanceDate(date).
+			SetStatus(attendance.StatusPresent).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	rate := func(program bpjscontributionrate.Program, riskClass int, effectiveFrom string, employer, employee string, wageCap int64) {
+		effective, err := time.Parse("2006-01-02", effectiveFrom)
+		require.NoError(t, err)
+		query := client.BpjsContributionRate.Create().
+			SetProgram(program).
+			SetRiskClass(riskClass).
+			SetEffectiveFrom(effective).
+			SetEmployerRate(decimal.RequireFromString(employer)).
+			SetEmployeeRate(decimal.RequireFromString(employee))
+		if wageCap > 0 {
+			query = query.SetWageCap(decimal.NewFromInt(wageCap))
+		}
+		_, err = query.Save(ctx)
+		require.NoError(t, err)
+	}
+	rate(bpjscontributionrate.ProgramKesehatan, 0, "2024-01-01", "4", "1", 12000000)
+	rate(bpjscontributionrate.ProgramJht, 0, "2024-01-01", "3.7", "2", 0)
+	rate(bpjscontributionrate.ProgramJp, 0, "2024-03-01", "2", "1", 10042300)
+	rate(bpjscontributionrate.ProgramJp, 0, "2025-03-01", "2", "1", 10547400)
+	rate(bpjscontributionrate.ProgramJp, 0, "2025-09-01", "3", "1.5", 11000000)
+	rate(bpjscontributionrate.ProgramJkk, 1, "2024-01-01", "0.24", "0", 0)
+	rate(bpjscontributionrate.ProgramJkk, 2, "2024-01-01", "0.54", "0", 0)
+	rate(bpjscontributionrate.ProgramJkm, 0, "2024-01-01", "0.3", "0", 0)
+
+	repo := NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client))
+	calculation, err := repo.CalculateSalary(ctx, &dto.CalculateSalaryRequest{
+		EmployeeID:       emp.ID,
+		CalculationMonth: time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	amounts := make(map[string]string)
+	for _, item := range calculation.Edges.Items {
+		amounts[item.Code] = item.Amount.String()
+		if strings.HasSuffix(item.Code, "_employer") {
+			assert.Equal(t, salarycalculationitem.KindEmployer, item.Kind, item.Code)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"base_salary":             "12600000",
+		"bpjs_kesehatan_employer": "480000",
+		"bpjs_kesehatan_employee": "120000",
+		"bpjs_jht_employer":       "466200",
+		"bpjs_jht_employee":       "252000",
+		"bpjs_jp_employer":        "210948",
+		"bpjs_jp_employee":        "105474",
+		"bpjs_jkk_employer":       "68040",
+		"bpjs_jkm_employer":       "37800",
+		"pph21":                   "659292",
+	}, amounts)
+
+	// The health, work accident and death premiums are taxable, TER A 5% of 13,185,840
+	assert.Equal(t, "13185840", calculation.TaxableIncome.String())
+	// Only the employee portions and the tax are deducted from the salary
+	assert.Equal(t, "11463234", calculation.FinalSalary.String())
+	assert.Contains(t, calculation.CalculationFormula, "BPJS: BPJS Kesehatan (employee) -120000.00, BPJS JHT (employee) -252000.00, BPJS JP (employee) -105474.00")
+}
@@ -9,6 +9,8 @@ import (
 	"mceasy/internal/applications/salary/repository"
 	"mceasy/internal/applications/salary/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 
 	"github.com/go-redis/redis/v8"
@@ -17,11 +19,19 @@ import (
 
 var providerSalary = wire.NewSet(
 	repository.NewSalaryRepository,
+	repository.NewPayrollRunRepository,
+	repository.NewBpjsRepository,
+	calendar.NewWorkingDayCalendar,
+	periodlock.NewPeriodLock,
 	transaction.NewTrx,
 	service.NewSalaryService,
 	cache.NewCache,
 
 	wire.Bind(new(repository.SalaryRepository), new(*repository.SalaryRepositoryImpl)),
+	wire.Bind(new(repository.PayrollRunRepository), new(*repository.PayrollRunRepositoryImpl)),
+	wire.Bind(new(repository.BpjsRepository), new(*repository.BpjsRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
+	wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)),
 	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
 	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
 	wire.Bind(new(service.SalaryService), new(*service.SalaryServiceImpl)),
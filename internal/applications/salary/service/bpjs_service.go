@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/calculator"
+	"mceasy/internal/applications/salary/dto"
+)
+
+// CreateBpjsContributionRate puts a BPJS rate in effect from a date, calculations of later months use it
+func (s *SalaryServiceImpl) CreateBpjsContributionRate(ctx context.Context, req *dto.CreateBpjsContributionRateRequest) (*dto.BpjsContributionRateResponse, error) {
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid effective from: %w", err)
+	}
+
+	// Only the JKK rate depends on the risk class of the workplace
+	isJKK := calculator.ContributionProgram(req.Program) == calculator.ProgramJKK
+	if isJKK && req.RiskClass == 0 {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			errors.New("a JKK rate needs the risk_class it applies to"))
+	}
+	if !isJKK && req.RiskClass != 0 {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("the %s rate does not depend on a risk_class", req.Program))
+	}
+
+	rate, err := s.bpjsRepo.CreateRate(ctx, req, effectiveFrom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BPJS contribution rate: %w", err)
+	}
+
+	return s.mapToBpjsContributionRateResponse(rate), nil
+}
+
+// ListBpjsContributionRates retrieves the BPJS contribution rates with their effective dates
+func (s *SalaryServiceImpl) ListBpjsContributionRates(ctx context.Context, params *dto.BpjsContributionRateQueryParams) ([]dto.BpjsContributionRateResponse, error) {
+	rates, err := s.bpjsRepo.ListRates(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list BPJS contribution rates: %w", err)
+	}
+
+	responses := make([]dto.BpjsContributionRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = *s.mapToBpjsContributionRateResponse(rate)
+	}
+
+	return responses, nil
+}
+
+// GetBpjsContributionReport sums the BPJS lines of the salary calculations of a month per employee and program
+func (s *SalaryServiceImpl) GetBpjsContributionReport(ctx context.Context, params *dto.BpjsContributionReportParams) (*dto.BpjsContributionReport, error) {
+	month, err := time.Parse("2006-01", params.Month)
+	if err != nil {
+		return nil, fmt.Errorf("invalid month: %w", err)
+	}
+
+	programs := calculator.ContributionPrograms
+	if params.Program != "" {
+		programs = []calculator.ContributionProgram{calculator.ContributionProgram(params.Program)}
+	}
+
+	// The code of a line tells its program and whether the employer or the employee pays it
+	type portion struct {
+		program  calculator.ContributionProgram
+		employer bool
+	}
+	portions := make(map[string]portion)
+	codes := make([]string, 0, len(programs)*2)
+	for _, program := range programs {
+		for _, employer := range []bool{true, false} {
+			code := calculator.ContributionLineCode(program, employer)
+			portions[code] = portion{program: program, employer: employer}
+			codes = append(codes, code)
+		}
+	}
+
+	calculations, err := s.bpjsRepo.GetMonthlyContributions(ctx, month, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BPJS contributions: %w", err)
+	}
+
+	report := &dto.BpjsContributionReport{
+		Month:     params.Month,
+		Employees: make([]dto.BpjsEmployeeContribution, 0, len(calculations)),
+	}
+	totals := make(map[calculator.ContributionProgram]*dto.BpjsProgramContribution)
+
+	for _, calculation := range calculations {
+		contributions := make(map[calculator.ContributionProgram]*dto.BpjsProgramContribution)
+		for _, item := range calculation.Edges.Items {
+			p := portions[item.Code]
+			for _, byProgram := range []map[calculator.ContributionProgram]*dto.BpjsProgramContribution{contributions, totals} {
+				contribution, ok := byProgram[p.program]
+				if !ok {
+					contribution = &dto.BpjsProgramContribution{Program: string(p.program)}
+					byProgram[p.program] = contribution
+				}
+				if p.employer {
+					contribution.Employer = contribution.Employer.Add(item.Amount)
+				} else {
+					contribution.Employee = contribution.Employee.Add(item.Amount)
+				}
+				contribution.Total = contribution.Total.Add(item.Amount)
+			}
+		}
+
+		employeeContribution := dto.BpjsEmployeeContribution{
+			EmployeeID: calculation.EmployeeID,
+			Programs:   inProgramOrder(programs, contributions),
+		}
+		if calculation.Edges.Employee != nil {
+			employeeContribution.EmployeeName = calculation.Edges.Employee.FullName
+		}
+		for _, contribution := range employeeContribution.Programs {
+			employeeContribution.TotalEmployer = employeeContribution.TotalEmployer.Add(contribution.Employer)
+			employeeContribution.TotalEmployee = employeeContribution.TotalEmployee.Add(contribution.Employee)
+		}
+		report.Employees = append(report.Employees, employeeContribution)
+	}
+
+	report.Programs = inProgramOrder(programs, totals)
+	for _, contribution := range report.Programs {
+		report.TotalEmployer = report.TotalEmployer.Add(contribution.Employer)
+		report.TotalEmployee = report.TotalEmployee.Add(contribution.Employee)
+	}
+	report.Total = report.TotalEmployer.Add(report.TotalEmployee)
+
+	return report, nil
+}
+
+// inProgramOrder lists the contributions of the programs that have one in the order of the programs
+func inProgramOrder(programs []calculator.ContributionProgram, contributions map[calculator.ContributionProgram]*dto.BpjsProgramContribution) []dto.BpjsProgramContribution {
+	ordered := make([]dto.BpjsProgramContribution, 0, len(contributions))
+	for _, program := range programs {
+		if contribution, ok := contributions[program]; ok {
+			ordered = append(ordered, *contribution)
+		}
+	}
+
+	return ordered
+}
+
+// mapToBpjsContributionRateResponse maps an ent.BpjsContributionRate to dto.BpjsContributionRateResponse
+func (s *SalaryServiceImpl) mapToBpjsContributionRateResponse(rate *ent.BpjsContributionRate) *dto.BpjsContributionRateResponse {
+	return &dto.BpjsContributionRateResponse{
+		ID:            rate.ID,
+		Program:       string(rate.Program),
+		RiskClass:     rate.RiskClass,
+		EffectiveFrom: rate.EffectiveFrom.Format("2006-01-02"),
+		EmployerRate:  rate.EmployerRate,
+		EmployeeRate:  rate.EmployeeRate,
+		WageCap:       rate.WageCap,
+		Notes:         rate.Notes,
+		CreatedAt:     rate.CreatedAt,
+	}
+}
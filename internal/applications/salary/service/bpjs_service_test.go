@@ -0,0 +1,116 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/applications/salary/repository"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSalaryServiceImpl_BpjsContributionReport(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	salaryService := NewSalaryService(
+		repository.NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client)),
+		repository.NewPayrollRunRepository(client),
+		repository.NewBpjsRepository(client),
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	percent := func(value string) *decimal.Decimal {
+		rate := decimal.RequireFromString(value)
+		return &rate
+	}
+	wageCap := decimal.NewFromInt(12000000)
+
+	t.Run("only the JKK rate has a risk class", func(t *testing.T) {
+		_, err := salaryService.CreateBpjsContributionRate(ctx, &dto.CreateBpjsContributionRateRequest{
+			Program: "jkk", EffectiveFrom: "2024-01-01", EmployerRate: percent("0.24"), EmployeeRate: percent("0"),
+		})
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok)
+		assert.Equal(t, exceptions.InvalidArgument, bizErr.ErrorCode)
+
+		_, err = salaryService.CreateBpjsContributionRate(ctx, &dto.CreateBpjsContributionRateRequest{
+			Program: "jkm", RiskClass: 1, EffectiveFrom: "2024-01-01", EmployerRate: percent("0.3"), EmployeeRate: percent("0"),
+		})
+		bizErr, ok = exceptions.AsBusinessLogicError(err)
+		require.True(t, ok)
+		assert.Equal(t, exceptions.InvalidArgument, bizErr.ErrorCode)
+	})
+
+	_, err := salaryService.CreateBpjsContributionRate(ctx, &dto.CreateBpjsContributionRateRequest{
+		Program: "kesehatan", EffectiveFrom: "2024-01-01", EmployerRate: percent("4"), EmployeeRate: percent("1"), WageCap: &wageCap,
+	})
+	require.NoError(t, err)
+	jkk, err := salaryService.CreateBpjsContributionRate(ctx, &dto.CreateBpjsContributionRateRequest{
+		Program: "jkk", RiskClass: 1, EffectiveFrom: "2024-01-01", EmployerRate: percent("0.24"), EmployeeRate: percent("0"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01", jkk.EffectiveFrom)
+	assert.Nil(t, jkk.WageCap)
+
+	rates, err := salaryService.ListBpjsContributionRates(ctx, &dto.BpjsContributionRateQueryParams{Program: "jkk"})
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+
+	month := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	for _, insured := range []struct {
+		name       string
+		baseSalary int64
+	}{{"budi", 5000000}, {"sari", 15000000}} {
+		emp, err := client.Employee.Create().
+			SetFullName(insured.name).
+			SetEmail(insured.name + "@example.com").
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetBaseSalary(decimal.NewFromInt(insured.baseSalary)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = salaryService.CalculateSalary(ctx, &dto.CalculateSalaryRequest{EmployeeID: emp.ID, CalculationMonth: month})
+		require.NoError(t, err)
+	}
+
+	report, err := salaryService.GetBpjsContributionReport(ctx, &dto.BpjsContributionReportParams{Month: "2025-08"})
+	require.NoError(t, err)
+	require.Len(t, report.Employees, 2)
+	assert.Equal(t, "sari", report.Employees[1].EmployeeName)
+	// 4% and 1% of the 12,000,000 cap, 0.24% of 15,000,000
+	assert.Equal(t, "516000", report.Employees[1].TotalEmployer.String())
+	assert.Equal(t, "120000", report.Employees[1].TotalEmployee.String())
+
+	require.Len(t, report.Programs, 2)
+	assert.Equal(t, "kesehatan", report.Programs[0].Program)
+	assert.Equal(t, "680000", report.Programs[0].Employer.String())
+	assert.Equal(t, "170000", report.Programs[0].Employee.String())
+	assert.Equal(t, "jkk", report.Programs[1].Program)
+	assert.Equal(t, "48000", report.Programs[1].Employer.String())
+	assert.Equal(t, "898000", report.Total.String())
+
+	t.Run("report of one program", func(t *testing.T) {
+		report, err := salaryService.GetBpjsContributionReport(ctx, &dto.BpjsContributionReportParams{Month: "2025-08", Program: "jkk"})
+		require.NoError(t, err)
+		require.Len(t, report.Programs, 1)
+		assert.Equal(t, "48000", report.TotalEmployer.String())
+		assert.True(t, report.TotalEmployee.IsZero())
+		require.Len(t, report.Employees, 2)
+		assert.Equal(t, "12000", report.Employees[0].TotalEmployer.String())
+	})
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollrun"
+	"mceasy/ent/payrollrunevent"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/component/periodlock"
+
+	"github.com/labstack/gommon/log"
+)
+
+// payrollRunTransition is a move of a payroll run from one of the statuses to the next status
+type payrollRunTransition struct {
+	from []payrollrun.Status
+	to   payrollrun.Status
+}
+
+// payrollRunTransitions is the lifecycle of a payroll run: draft → calculated → reviewed → approved → paid.
+// Recalculating sends a reviewed run back for review, a run can be cancelled until it is paid.
+var payrollRunTransitions = map[payrollrunevent.Action]payrollRunTransition{
+	payrollrunevent.ActionCalculate: {
+		from: []payrollrun.Status{payrollrun.StatusDraft, payrollrun.StatusCalculated, payrollrun.StatusReviewed},
+		to:   payrollrun.StatusCalculated,
+	},
+	payrollrunevent.ActionReview: {
+		from: []payrollrun.Status{payrollrun.StatusCalculated},
+		to:   payrollrun.StatusReviewed,
+	},
+	payrollrunevent.ActionApprove: {
+		from: []payrollrun.Status{payrollrun.StatusReviewed},
+		to:   payrollrun.StatusApproved,
+	},
+	payrollrunevent.ActionPay: {
+		from: []payrollrun.Status{payrollrun.StatusApproved},
+		to:   payrollrun.StatusPaid,
+	},
+	payrollrunevent.ActionCancel: {
+		from: []payrollrun.Status{payrollrun.StatusDraft, payrollrun.StatusCalculated, payrollrun.StatusReviewed, payrollrun.StatusApproved},
+		to:   payrollrun.StatusCancelled,
+	},
+}
+
+// CreatePayrollRun opens the draft payroll run of a month, it owns the salary calculations of the month made so
+// far and every one made afterwards. A month has one run until it is cancelled.
+func (s *SalaryServiceImpl) CreatePayrollRun(ctx context.Context, req *dto.CreatePayrollRunRequest) (*dto.PayrollRunResponse, error) {
+	month, err := time.Parse("2006-01", req.PeriodMonth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid period month: %w", err)
+	}
+
+	if month.After(periodlock.MonthOf(time.Now())) {
+		return nil, fmt.Errorf("cannot create payroll run for future months")
+	}
+
+	var runID uint64
+	err = s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		existing, err := s.payrollRunRepo.GetActiveByMonth(txCtx, month)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to get payroll run: %w", err)
+		}
+		if existing != nil {
+			return exceptions.NewBusinessLogicError(exceptions.DataAlreadyExist,
+				fmt.Errorf("payroll run %d of %s is %s, cancel it before creating another", existing.ID, req.PeriodMonth, existing.Status))
+		}
+
+		run, err := s.payrollRunRepo.Create(txCtx, month, req.CreatedBy)
+		if ent.IsConstraintError(err) {
+			// Another run of the month was created since the check above
+			return exceptions.NewBusinessLogicError(exceptions.DataAlreadyExist,
+				fmt.Errorf("payroll run of %s already exists, cancel it before creating another", req.PeriodMonth))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create payroll run: %w", err)
+		}
+		runID = run.ID
+
+		if err := s.payrollRunRepo.CreateEvent(txCtx, run.ID, payrollrunevent.ActionCreate, nil, run.Status, req.CreatedBy, req.Notes); err != nil {
+			return fmt.Errorf("failed to record payroll run event: %w", err)
+		}
+
+		if err := s.payrollRunRepo.AttachCalculations(txCtx, run.ID, month); err != nil {
+			return fmt.Errorf("failed to attach salary calculations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPayrollRun(ctx, runID)
+}
+
+// GetPayrollRun retrieves a payroll run with its salary calculations and audit trail
+func (s *SalaryServiceImpl) GetPayrollRun(ctx context.Context, id uint64) (*dto.PayrollRunResponse, error) {
+	run, err := s.payrollRunRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("payroll run not found: %w", err)
+	}
+
+	return s.mapToPayrollRunResponse(run, true), nil
+}
+
+// ListPayrollRuns retrieves payroll runs with their totals with pagination and filtering
+func (s *SalaryServiceImpl) ListPayrollRuns(ctx context.Context, params *dto.PayrollRunQueryParams) (*dto.PayrollRunListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	runs, total, err := s.payrollRunRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payroll runs: %w", err)
+	}
+
+	runResponses := make([]dto.PayrollRunResponse, len(runs))
+	for i, run := range runs {
+		runResponses[i] = *s.mapToPayrollRunResponse(run, false)
+	}
+
+	return &dto.PayrollRunListResponse{
+		PayrollRuns: runResponses,
+		Total:       total,
+		Page:        params.Page,
+		Limit:       params.Limit,
+	}, nil
+}
+
+// RecalculatePayrollRun calculates the salary of every employee for the month of the run again, manual changes
+// to its calculations are overwritten. A reviewed run has to be reviewed again.
+func (s *SalaryServiceImpl) RecalculatePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	return s.transitionPayrollRun(ctx, id, payrollrunevent.ActionCalculate, req)
+}
+
+// ReviewPayrollRun marks the calculations of a run as checked
+func (s *SalaryServiceImpl) ReviewPayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	return s.transitionPayrollRun(ctx, id, payrollrunevent.ActionReview, req)
+}
+
+// ApprovePayrollRun approves a reviewed run, its salary calculations cannot be changed afterwards
+func (s *SalaryServiceImpl) ApprovePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	return s.transitionPayrollRun(ctx, id, payrollrunevent.ActionApprove, req)
+}
+
+// ClosePayrollRun records that the salaries of an approved run were paid and locks the payroll period of its month
+func (s *SalaryServiceImpl) ClosePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	return s.transitionPayrollRun(ctx, id, payrollrunevent.ActionPay, req)
+}
+
+// CancelPayrollRun cancels a run that was not paid, its salary calculations are released to the next run of the
+// month and can be changed again
+func (s *SalaryServiceImpl) CancelPayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	return s.transitionPayrollRun(ctx, id, payrollrunevent.ActionCancel, req)
+}
+
+// transitionPayrollRun moves a run to the next status of the action and records who did it in one transaction
+func (s *SalaryServiceImpl) transitionPayrollRun(ctx context.Context, id uint64, action payrollrunevent.Action, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error) {
+	var from, to payrollrun.Status
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		run, err := s.payrollRunRepo.GetByID(txCtx, id)
+		if err != nil {
+			return fmt.Errorf("payroll run not found: %w", err)
+		}
+
+		if err := ensureTransitionAllowed(run, action); err != nil {
+			return err
+		}
+		from, to = run.Status, payrollRunTransitions[action].to
+
+		if action == payrollrunevent.ActionCalculate {
+			if err := s.periodLock.EnsureOpen(txCtx, run.PeriodMonth); err != nil {
+				return err
+			}
+
+			// Employees whose salary cannot be calculated are skipped, as in a bulk calculation
+			if _, err := s.salaryRepo.BulkCalculateSalary(txCtx, &dto.BulkCalculateSalaryRequest{CalculationMonth: run.PeriodMonth}); err != nil {
+				return fmt.Errorf("failed to calculate salaries: %w", err)
+			}
+		}
+
+		if err := s.payrollRunRepo.UpdateStatus(txCtx, run.ID, to); err != nil {
+			return fmt.Errorf("failed to update payroll run: %w", err)
+		}
+
+		if err := s.payrollRunRepo.CreateEvent(txCtx, run.ID, action, &from, to, req.ActorID, req.Notes); err != nil {
+			return fmt.Errorf("failed to record payroll run event: %w", err)
+		}
+
+		switch action {
+		case payrollrunevent.ActionCalculate:
+			// Employees calculated for the first time join the run
+			err = s.payrollRunRepo.AttachCalculations(txCtx, run.ID, run.PeriodMonth)
+		case payrollrunevent.ActionCancel:
+			err = s.payrollRunRepo.ReleaseCalculations(txCtx, run.ID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update salary calculations of payroll run: %w", err)
+		}
+
+		// Paid salaries are final, attendance and salaries of the month are refused until the period is unlocked
+		if action == payrollrunevent.ActionPay {
+			if err := s.periodLock.Lock(txCtx, run.PeriodMonth, req.ActorID, req.Notes); err != nil {
+				return fmt.Errorf("failed to lock payroll period: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("payroll run %d moved from %s to %s by user %d", id, from, to, req.ActorID)
+
+	return s.GetPayrollRun(ctx, id)
+}
+
+// ensureTransitionAllowed returns an InvalidArgument business error when the run cannot take the action in its status
+func ensureTransitionAllowed(run *ent.PayrollRun, action payrollrunevent.Action) error {
+	for _, status := range payrollRunTransitions[action].from {
+		if run.Status == status {
+			return nil
+		}
+	}
+
+	return exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+		fmt.Errorf("payroll run %d is %s and cannot be moved to %s", run.ID, run.Status, payrollRunTransitions[action].to))
+}
+
+// ensurePayrollRunOpen returns the active payroll run of the month, nil when the month has none. Months whose run
+// is approved, or paid while its payroll period is locked, refuse salary calculations.
+func (s *SalaryServiceImpl) ensurePayrollRunOpen(ctx context.Context, month time.Time) (*ent.PayrollRun, error) {
+	run, err := s.payrollRunRepo.GetActiveByMonth(ctx, periodlock.MonthOf(month))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll run: %w", err)
+	}
+
+	if err := s.ensureRunAcceptsChanges(ctx, run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// attachToPayrollRun hands salary calculations made outside a run to the active run of their month
+func (s *SalaryServiceImpl) attachToPayrollRun(ctx context.Context, run *ent.PayrollRun, month time.Time) error {
+	if run == nil {
+		return nil
+	}
+
+	if err := s.payrollRunRepo.AttachCalculations(ctx, run.ID, periodlock.MonthOf(month)); err != nil {
+		return fmt.Errorf("failed to attach salary calculation to payroll run: %w", err)
+	}
+
+	return nil
+}
+
+// ensureCalculationMutable returns a business error when the run of the calculation refuses changes
+func (s *SalaryServiceImpl) ensureCalculationMutable(ctx context.Context, calculation *ent.SalaryCalculation) error {
+	run := calculation.Edges.PayrollRun
+	if run == nil {
+		return nil
+	}
+
+	return s.ensureRunAcceptsChanges(ctx, run)
+}
+
+// ensureRunAcceptsChanges returns a business error when the salaries of the run cannot be changed. An approved run
+// has to be cancelled first. A paid run cannot be cancelled, its salaries follow the payroll period: they are
+// refused while the period is locked and can be corrected once it was unlocked.
+func (s *SalaryServiceImpl) ensureRunAcceptsChanges(ctx context.Context, run *ent.PayrollRun) error {
+	switch run.Status {
+	case payrollrun.StatusApproved:
+		return exceptions.NewBusinessLogicError(exceptions.PayrollApproved,
+			fmt.Errorf("payroll run %d of %s is approved, cancel it before changing its salaries", run.ID, periodlock.MonthKey(run.PeriodMonth)))
+	case payrollrun.StatusPaid:
+		locked, err := s.periodLock.IsLocked(ctx, run.PeriodMonth)
+		if err != nil {
+			return fmt.Errorf("failed to check payroll period: %w", err)
+		}
+		if locked {
+			return exceptions.NewBusinessLogicError(exceptions.PeriodLocked,
+				fmt.Errorf("payroll run %d of %s was paid, unlock the payroll period before changing its salaries", run.ID, periodlock.MonthKey(run.PeriodMonth)))
+		}
+	}
+
+	return nil
+}
+
+// mapToPayrollRunResponse maps an ent.PayrollRun to dto.PayrollRunResponse, the totals are summed from its
+// salary calculations
+func (s *SalaryServiceImpl) mapToPayrollRunResponse(run *ent.PayrollRun, withDetails bool) *dto.PayrollRunResponse {
+	response := &dto.PayrollRunResponse{
+		ID:          run.ID,
+		PeriodMonth: periodlock.MonthKey(run.PeriodMonth),
+		Status:      string(run.Status),
+		CreatedBy:   run.CreatedBy,
+		CreatedAt:   run.CreatedAt,
+		ModifiedAt:  run.ModifiedAt,
+	}
+
+	for _, calculation := range run.Edges.SalaryCalculations {
+		response.EmployeeCount++
+		response.TotalBaseSalary = response.TotalBaseSalary.Add(calculation.BaseSalary)
+		response.TotalFinalSalary = response.TotalFinalSalary.Add(calculation.FinalSalary)
+		response.TotalDeductions = response.TotalDeductions.Add(calculation.DeductionAmount)
+
+		if withDetails {
+			response.SalaryCalculations = append(response.SalaryCalculations, *s.mapToSalaryCalculationResponse(calculation))
+		}
+	}
+
+	if !withDetails {
+		return response
+	}
+
+	for _, event := range run.Edges.Events {
+		eventResponse := dto.PayrollRunEventResponse{
+			ID:        event.ID,
+			Action:    string(event.Action),
+			ToStatus:  string(event.ToStatus),
+			ActorID:   event.ActorID,
+			Notes:     event.Notes,
+			CreatedAt: event.CreatedAt,
+		}
+		if event.FromStatus != nil {
+			eventResponse.FromStatus = string(*event.FromStatus)
+		}
+		response.Events = append(response.Events, eventResponse)
+	}
+
+	return response
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollperiod"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salary/dto"
+	"mceasy/internal/applications/salary/repository"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
+	"mceasy/internal/component/transaction"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSalaryServiceImpl_PayrollRunLifecycle(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	salaryService := NewSalaryService(
+		repository.NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client)),
+		repository.NewPayrollRunRepository(client),
+		repository.NewBpjsRepository(client),
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	month := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{"budi", "sari"} {
+		_, err := client.Employee.Create().
+			SetFullName(name).
+			SetEmail(name + "@example.com").
+			SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetBaseSalary(decimal.NewFromInt(2100000)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// A calculation made before the run exists is owned by it once it is created
+	early, err := salaryService.CalculateSalary(ctx, &dto.CalculateSalaryRequest{EmployeeID: 1, CalculationMonth: month})
+	require.NoError(t, err)
+	assert.Nil(t, early.PayrollRunID)
+
+	errorCode := func(err error) int {
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok, "expected a business logic error, got %v", err)
+		return bizErr.ErrorCode
+	}
+	transition := &dto.PayrollRunTransitionRequest{ActorID: 7}
+
+	run, err := salaryService.CreatePayrollRun(ctx, &dto.CreatePayrollRunRequest{PeriodMonth: "2025-08", CreatedBy: 7})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", run.Status)
+	assert.Equal(t, 1, run.EmployeeCount)
+
+	_, err = salaryService.CreatePayrollRun(ctx, &dto.CreatePayrollRunRequest{PeriodMonth: "2025-08", CreatedBy: 7})
+	assert.Equal(t, exceptions.DataAlreadyExist, errorCode(err))
+
+	t.Run("the database refuses a second active run of the month", func(t *testing.T) {
+		_, err := repository.NewPayrollRunRepository(client).Create(ctx, month, 7)
+		assert.True(t, ent.IsConstraintError(err), "expected a constraint error, got %v", err)
+	})
+
+	t.Run("a draft run cannot be approved", func(t *testing.T) {
+		_, err := salaryService.ApprovePayrollRun(ctx, run.ID, transition)
+		assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+	})
+
+	run, err = salaryService.RecalculatePayrollRun(ctx, run.ID, transition)
+	require.NoError(t, err)
+	assert.Equal(t, "calculated", run.Status)
+	assert.Equal(t, 2, run.EmployeeCount)
+	assert.Equal(t, "4200000", run.TotalBaseSalary.String())
+
+	run, err = salaryService.ReviewPayrollRun(ctx, run.ID, transition)
+	require.NoError(t, err)
+	assert.Equal(t, "reviewed", run.Status)
+
+	run, err = salaryService.ApprovePayrollRun(ctx, run.ID, &dto.PayrollRunTransitionRequest{ActorID: 9, Notes: "checked against bank file"})
+	require.NoError(t, err)
+	assert.Equal(t, "approved", run.Status)
+
+	t.Run("calculations of an approved run are immutable", func(t *testing.T) {
+		finalSalary := decimal.NewFromInt(1)
+		_, err := salaryService.UpdateSalaryCalculation(ctx, early.ID, &dto.UpdateSalaryCalculationRequest{FinalSalary: &finalSalary})
+		assert.Equal(t, exceptions.PayrollApproved, errorCode(err))
+
+		assert.Equal(t, exceptions.PayrollApproved, errorCode(salaryService.DeleteSalaryCalculation(ctx, early.ID)))
+
+		_, err = salaryService.CalculateSalary(ctx, &dto.CalculateSalaryRequest{EmployeeID: 2, CalculationMonth: month})
+		assert.Equal(t, exceptions.PayrollApproved, errorCode(err))
+
+		_, err = salaryService.RecalculatePayrollRun(ctx, run.ID, transition)
+		assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+	})
+
+	run, err = salaryService.ClosePayrollRun(ctx, run.ID, transition)
+	require.NoError(t, err)
+	assert.Equal(t, "paid", run.Status)
+
+	t.Run("paying a run locks its payroll period", func(t *testing.T) {
+		period, err := client.PayrollPeriod.Query().Where(payrollperiod.PeriodMonth(month)).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, payrollperiod.StatusLocked, period.Status)
+		require.NotNil(t, period.LockedBy)
+		assert.Equal(t, uint64(7), *period.LockedBy)
+
+		_, err = salaryService.CalculateSalary(ctx, &dto.CalculateSalaryRequest{EmployeeID: 2, CalculationMonth: month})
+		assert.Equal(t, exceptions.PeriodLocked, errorCode(err))
+	})
+
+	t.Run("salaries of a paid run can be corrected once its period is unlocked", func(t *testing.T) {
+		_, err := client.PayrollPeriod.Update().
+			Where(payrollperiod.PeriodMonth(month)).
+			SetStatus(payrollperiod.StatusOpen).
+			Save(ctx)
+		require.NoError(t, err)
+
+		corrected, err := salaryService.CalculateSalary(ctx, &dto.CalculateSalaryRequest{EmployeeID: 2, CalculationMonth: month})
+		require.NoError(t, err)
+		require.NotNil(t, corrected.PayrollRunID)
+		assert.Equal(t, run.ID, *corrected.PayrollRunID)
+	})
+
+	_, err = salaryService.CancelPayrollRun(ctx, run.ID, transition)
+	assert.Equal(t, exceptions.InvalidArgument, errorCode(err))
+
+	// Every transition is recorded with who made it
+	require.Len(t, run.Events, 5)
+	actions := make([]string, len(run.Events))
+	for i, event := range run.Events {
+		actions[i] = event.Action
+	}
+	assert.Equal(t, []string{"create", "calculate", "review", "approve", "pay"}, actions)
+	assert.Empty(t, run.Events[0].FromStatus)
+	assert.Equal(t, "reviewed", run.Events[3].FromStatus)
+	assert.Equal(t, "approved", run.Events[3].ToStatus)
+	assert.Equal(t, uint64(9), run.Events[3].ActorID)
+	assert.Equal(t, "checked against bank file", run.Events[3].Notes)
+}
+
+func TestSalaryServiceImpl_CancelPayrollRun(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	salaryService := NewSalaryService(
+		repository.NewSalaryRepository(client, calendar.NewWorkingDayCalendar(client)),
+		repository.NewPayrollRunRepository(client),
+		repository.NewBpjsRepository(client),
+		periodlock.NewPeriodLock(client),
+		nil,
+		transaction.NewTrx(client),
+	)
+
+	_, err := client.Employee.Create().
+		SetFullName("budi").
+		SetEmail("budi@example.com").
+		SetHireDate(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		SetTimezone("UTC").
+		Save(ctx)
+	require.NoError(t, err)
+
+	transition := &dto.PayrollRunTransitionRequest{ActorID: 7}
+	run, err := salaryService.CreatePayrollRun(ctx, &dto.CreatePayrollRunRequest{PeriodMonth: "2025-08", CreatedBy: 7})
+	require.NoError(t, err)
+	for _, move := range []func(context.Context, uint64, *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error){
+		salaryService.RecalculatePayrollRun,
+		salaryService.ReviewPayrollRun,
+		salaryService.ApprovePayrollRun,
+	} {
+		run, err = move(ctx, run.ID, transition)
+		require.NoError(t, err)
+	}
+	require.Len(t, run.SalaryCalculations, 1)
+	calculationID := run.SalaryCalculations[0].ID
+
+	// Cancelling an approved run releases its calculations to the next run of the month
+	run, err = salaryService.CancelPayrollRun(ctx, run.ID, transition)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", run.Status)
+	assert.Zero(t, run.EmployeeCount)
+
+	finalSalary := decimal.NewFromInt(1)
+	_, err = salaryService.UpdateSalaryCalculation(ctx, calculationID, &dto.UpdateSalaryCalculationRequest{FinalSalary: &finalSalary})
+	require.NoError(t, err)
+
+	// The deduction amount follows from the salary lines
+	_, err = salaryService.UpdateSalaryCalculation(ctx, calculationID, &dto.UpdateSalaryCalculationRequest{DeductionAmount: &finalSalary})
+	bizErr, ok := exceptions.AsBusinessLogicError(err)
+	require.True(t, ok, "expected a business logic error, got %v", err)
+	assert.Equal(t, exceptions.InvalidArgument, bizErr.ErrorCode)
+
+	next, err := salaryService.CreatePayrollRun(ctx, &dto.CreatePayrollRunRequest{PeriodMonth: "2025-08", CreatedBy: 7})
+	require.NoError(t, err)
+	assert.NotEqual(t, run.ID, next.ID)
+	require.Len(t, next.SalaryCalculations, 1)
+	assert.Equal(t, "1", next.TotalFinalSalary.String())
+}
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"mceasy/ent"
+	"mceasy/exceptions"
 	"mceasy/internal/applications/salary/dto"
 	"mceasy/internal/applications/salary/repository"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 )
 
@@ -23,25 +25,45 @@ type SalaryService interface {
 	GetMonthlySalarySummary(ctx context.Context, month time.Time) (*dto.MonthlySalarySummary, error)
 	GetEmployeeSalarySummary(ctx context.Context, employeeID uint64, startMonth, endMonth time.Time) (*dto.EmployeeSalarySummary, error)
 	BulkCalculateSalary(ctx context.Context, req *dto.BulkCalculateSalaryRequest) ([]dto.SalaryCalculationResponse, error)
+	CreatePayrollRun(ctx context.Context, req *dto.CreatePayrollRunRequest) (*dto.PayrollRunResponse, error)
+	GetPayrollRun(ctx context.Context, id uint64) (*dto.PayrollRunResponse, error)
+	ListPayrollRuns(ctx context.Context, params *dto.PayrollRunQueryParams) (*dto.PayrollRunListResponse, error)
+	RecalculatePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)
+	ReviewPayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)
+	ApprovePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)
+	ClosePayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)
+	CancelPayrollRun(ctx context.Context, id uint64, req *dto.PayrollRunTransitionRequest) (*dto.PayrollRunResponse, error)
+	CreateBpjsContributionRate(ctx context.Context, req *dto.CreateBpjsContributionRateRequest) (*dto.BpjsContributionRateResponse, error)
+	ListBpjsContributionRates(ctx context.Context, params *dto.BpjsContributionRateQueryParams) ([]dto.BpjsContributionRateResponse, error)
+	GetBpjsContributionReport(ctx context.Context, params *dto.BpjsContributionReportParams) (*dto.BpjsContributionReport, error)
 }
 
 // SalaryServiceImpl implements the SalaryService interface
 type SalaryServiceImpl struct {
-	salaryRepo repository.SalaryRepository
-	cache      cache.Cache
-	trx        transaction.Trx
+	salaryRepo     repository.SalaryRepository
+	payrollRunRepo repository.PayrollRunRepository
+	bpjsRepo       repository.BpjsRepository
+	periodLock     periodlock.PeriodLock
+	cache          cache.Cache
+	trx            transaction.Trx
 }
 
 // NewSalaryService creates a new salary service instance
 func NewSalaryService(
 	salaryRepo repository.SalaryRepository,
+	payrollRunRepo repository.PayrollRunRepository,
+	bpjsRepo repository.BpjsRepository,
+	periodLock periodlock.PeriodLock,
 	cache cache.Cache,
 	trx transaction.Trx,
 ) *SalaryServiceImpl {
 	return &SalaryServiceImpl{
-		salaryRepo: salaryRepo,
-		cache:      cache,
-		trx:        trx,
+		salaryRepo:     salaryRepo,
+		payrollRunRepo: payrollRunRepo,
+		bpjsRepo:       bpjsRepo,
+		periodLock:     periodLock,
+		cache:          cache,
+		trx:            trx,
 	}
 }
 
@@ -56,13 +78,35 @@ func (s *SalaryServiceImpl) CalculateSalary(ctx context.Context, req *dto.Calcul
 	}
 
 	// Validate override base salary if provided
-	if req.OverrideBaseSalary != nil && *req.OverrideBaseSalary < 0 {
+	if req.OverrideBaseSalary != nil && req.OverrideBaseSalary.IsNegative() {
 		return nil, fmt.Errorf("base salary cannot be negative")
 	}
 
-	calculation, err := s.salaryRepo.CalculateSalary(ctx, req)
+	// The calculation, its salary lines and its payroll run are saved together
+	var calculation *ent.SalaryCalculation
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		// A paid salary is final until its period is unlocked
+		if err := s.periodLock.EnsureOpen(txCtx, reqMonth); err != nil {
+			return err
+		}
+
+		// An approved payroll run is final until it is cancelled
+		run, err := s.ensurePayrollRunOpen(txCtx, reqMonth)
+		if err != nil {
+			return err
+		}
+
+		calculation, err = s.salaryRepo.CalculateSalary(txCtx, req)
+		if err != nil {
+			return fmt.Errorf("failed to calculate salary: %w", err)
+		}
+
+		return s.attachToPayrollRun(txCtx, run, reqMonth)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate salary: %w", err)
+		return nil, err
 	}
 
 	return s.mapToSalaryCalculationResponse(calculation), nil
@@ -91,7 +135,7 @@ func (s *SalaryServiceImpl) GetSalaryCalculationByEmployeeAndMonth(ctx context.C
 // UpdateSalaryCalculation updates a salary calculation
 func (s *SalaryServiceImpl) UpdateSalaryCalculation(ctx context.Context, id uint64, req *dto.UpdateSalaryCalculationRequest) (*dto.SalaryCalculationResponse, error) {
 	// Validate input values
-	if req.BaseSalary != nil && *req.BaseSalary < 0 {
+	if req.BaseSalary != nil && req.BaseSalary.IsNegative() {
 		return nil, fmt.Errorf("base salary cannot be negative")
 	}
 	if req.TotalWorkingDays != nil && *req.TotalWorkingDays < 0 {
@@ -103,22 +147,43 @@ func (s *SalaryServiceImpl) UpdateSalaryCalculation(ctx context.Context, id uint
 	if req.PresentDays != nil && *req.PresentDays < 0 {
 		return nil, fmt.Errorf("present days cannot be negative")
 	}
-	if req.FinalSalary != nil && *req.FinalSalary < 0 {
+	if req.FinalSalary != nil && req.FinalSalary.IsNegative() {
 		return nil, fmt.Errorf("final salary cannot be negative")
 	}
-	if req.DeductionAmount != nil && *req.DeductionAmount < 0 {
-		return nil, fmt.Errorf("deduction amount cannot be negative")
+	// The deduction amount is summed from the salary lines, a change of the salary is made on the final salary
+	if req.DeductionAmount != nil {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			fmt.Errorf("deduction amount follows from the salary lines, set the final salary to adjust the salary"))
 	}
 
-	// Check if salary calculation exists
-	_, err := s.salaryRepo.GetByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("salary calculation not found: %w", err)
-	}
+	// The calculation and its adjustment line are saved together
+	var calculation *ent.SalaryCalculation
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		// Check if salary calculation exists
+		existing, err := s.salaryRepo.GetByID(txCtx, id)
+		if err != nil {
+			return fmt.Errorf("salary calculation not found: %w", err)
+		}
 
-	calculation, err := s.salaryRepo.Update(ctx, id, req)
+		if err := s.periodLock.EnsureOpen(txCtx, existing.CalculationMonth); err != nil {
+			return err
+		}
+
+		if err := s.ensureCalculationMutable(txCtx, existing); err != nil {
+			return err
+		}
+
+		calculation, err = s.salaryRepo.Update(txCtx, id, req)
+		if err != nil {
+			return fmt.Errorf("failed to update salary calculation: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update salary calculation: %w", err)
+		return nil, err
 	}
 
 	return s.mapToSalaryCalculationResponse(calculation), nil
@@ -127,11 +192,19 @@ func (s *SalaryServiceImpl) UpdateSalaryCalculation(ctx context.Context, id uint
 // DeleteSalaryCalculation soft deletes a salary calculation
 func (s *SalaryServiceImpl) DeleteSalaryCalculation(ctx context.Context, id uint64) error {
 	// Check if salary calculation exists
-	_, err := s.salaryRepo.GetByID(ctx, id)
+	existing, err := s.salaryRepo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("salary calculation not found: %w", err)
 	}
 
+	if err := s.periodLock.EnsureOpen(ctx, existing.CalculationMonth); err != nil {
+		return err
+	}
+
+	if err := s.ensureCalculationMutable(ctx, existing); err != nil {
+		return err
+	}
+
 	err = s.salaryRepo.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete salary calculation: %w", err)
@@ -203,9 +276,28 @@ func (s *SalaryServiceImpl) BulkCalculateSalary(ctx context.Context, req *dto.Bu
 		return nil, fmt.Errorf("cannot calculate salary for future months")
 	}
 
-	calculations, err := s.salaryRepo.BulkCalculateSalary(ctx, req)
+	var calculations []*ent.SalaryCalculation
+	err := s.trx.WithTx(ctx, func(tx *ent.Tx) error {
+		txCtx := ent.NewTxContext(ctx, tx)
+
+		if err := s.periodLock.EnsureOpen(txCtx, reqMonth); err != nil {
+			return err
+		}
+
+		run, err := s.ensurePayrollRunOpen(txCtx, reqMonth)
+		if err != nil {
+			return err
+		}
+
+		calculations, err = s.salaryRepo.BulkCalculateSalary(txCtx, req)
+		if err != nil {
+			return fmt.Errorf("failed to bulk calculate salary: %w", err)
+		}
+
+		return s.attachToPayrollRun(txCtx, run, reqMonth)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to bulk calculate salary: %w", err)
+		return nil, err
 	}
 
 	calculationResponses := make([]dto.SalaryCalculationResponse, len(calculations))
@@ -220,11 +312,20 @@ func (s *SalaryServiceImpl) BulkCalculateSalary(ctx context.Context, req *dto.Bu
 func (s *SalaryServiceImpl) mapToSalaryCalculationResponse(calculation *ent.SalaryCalculation) *dto.SalaryCalculationResponse {
 	response := &dto.SalaryCalculationResponse{
 		ID:                 calculation.ID,
+		PayrollRunID:       calculation.PayrollRunID,
 		CalculationMonth:   calculation.CalculationMonth,
 		BaseSalary:         calculation.BaseSalary,
 		TotalWorkingDays:   calculation.TotalWorkingDays,
 		AbsentDays:         calculation.AbsentDays,
 		PresentDays:        calculation.PresentDays,
+		PaidDays:           calculation.PaidDays,
+		LateDays:           calculation.LateDays,
+		LatePenalty:        calculation.LatePenalty,
+		PayPolicy:          calculation.PayPolicy,
+		WorkedMinutes:      calculation.WorkedMinutes,
+		OvertimeMinutes:    calculation.OvertimeMinutes,
+		OvertimePay:        calculation.OvertimePay,
+		TaxableIncome:      calculation.TaxableIncome,
 		FinalSalary:        calculation.FinalSalary,
 		DeductionAmount:    calculation.DeductionAmount,
 		CalculationFormula: calculation.CalculationFormula,
@@ -238,5 +339,17 @@ func (s *SalaryServiceImpl) mapToSalaryCalculationResponse(calculation *ent.Sala
 		response.EmployeeID = calculation.Edges.Employee.EmployeeID
 	}
 
+	for _, item := range calculation.Edges.Items {
+		response.Items = append(response.Items, dto.SalaryCalculationItemResponse{
+			ID:                item.ID,
+			SalaryComponentID: item.SalaryComponentID,
+			Code:              item.Code,
+			Name:              item.Name,
+			Kind:              string(item.Kind),
+			Amount:            item.Amount,
+			Basis:             item.Basis,
+		})
+	}
+
 	return response
 }
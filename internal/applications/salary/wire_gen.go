@@ -13,19 +13,25 @@ import (
 	"mceasy/internal/applications/salary/repository"
 	"mceasy/internal/applications/salary/service"
 	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/periodlock"
 	"mceasy/internal/component/transaction"
 )
 
 // Injectors from salary_injector.go:
 
 func InitializedSalaryService(dbClient *ent.Client, redisClient *redis.Client) *service.SalaryServiceImpl {
-	salaryRepositoryImpl := repository.NewSalaryRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	salaryRepositoryImpl := repository.NewSalaryRepository(dbClient, workingDayCalendarImpl)
+	payrollRunRepositoryImpl := repository.NewPayrollRunRepository(dbClient)
+	bpjsRepositoryImpl := repository.NewBpjsRepository(dbClient)
+	periodLockImpl := periodlock.NewPeriodLock(dbClient)
 	cacheImpl := cache.NewCache(redisClient)
 	trxImpl := transaction.NewTrx(dbClient)
-	salaryServiceImpl := service.NewSalaryService(salaryRepositoryImpl, cacheImpl, trxImpl)
+	salaryServiceImpl := service.NewSalaryService(salaryRepositoryImpl, payrollRunRepositoryImpl, bpjsRepositoryImpl, periodLockImpl, cacheImpl, trxImpl)
 	return salaryServiceImpl
 }
 
 // salary_injector.go:
 
-var providerSalary = wire.NewSet(repository.NewSalaryRepository, transaction.NewTrx, service.NewSalaryService, cache.NewCache, wire.Bind(new(repository.SalaryRepository), new(*repository.SalaryRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.SalaryService), new(*service.SalaryServiceImpl)))
+var providerSalary = wire.NewSet(repository.NewSalaryRepository, repository.NewPayrollRunRepository, repository.NewBpjsRepository, calendar.NewWorkingDayCalendar, periodlock.NewPeriodLock, transaction.NewTrx, service.NewSalaryService, cache.NewCache, wire.Bind(new(repository.SalaryRepository), new(*repository.SalaryRepositoryImpl)), wire.Bind(new(repository.PayrollRunRepository), new(*repository.PayrollRunRepositoryImpl)), wire.Bind(new(repository.BpjsRepository), new(*repository.BpjsRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(periodlock.PeriodLock), new(*periodlock.PeriodLockImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.SalaryService), new(*service.SalaryServiceImpl)))
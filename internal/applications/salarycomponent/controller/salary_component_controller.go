@@ -0,0 +1,319 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salarycomponent/dto"
+	"mceasy/internal/applications/salarycomponent/service"
+	"mceasy/internal/helper/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SalaryComponentController handles HTTP requests for salary component operations
+type SalaryComponentController struct {
+	salaryComponentService service.SalaryComponentService
+}
+
+// NewSalaryComponentController creates a new salary component controller instance
+func NewSalaryComponentController(salaryComponentService service.SalaryComponentService) *SalaryComponentController {
+	return &SalaryComponentController{
+		salaryComponentService: salaryComponentService,
+	}
+}
+
+// CreateSalaryComponent defines a new earning or deduction
+// @Summary Create a new salary component
+// @Description Define a reusable earning or deduction as a fixed amount, a percentage of the base salary or an amount per present day
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param salary_component body dto.CreateSalaryComponentRequest true "Salary component data"
+// @Success 201 {object} dto.SalaryComponentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components [post]
+func (c *SalaryComponentController) CreateSalaryComponent(ctx echo.Context) error {
+	var req dto.CreateSalaryComponentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	component, err := c.salaryComponentService.CreateSalaryComponent(ctx.Request().Context(), &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to create salary component", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create salary component",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, component)
+}
+
+// GetSalaryComponent retrieves a salary component by ID
+// @Summary Get salary component by ID
+// @Description Get salary component details with the employees and departments it is assigned to
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param id path int true "Salary component ID"
+// @Success 200 {object} dto.SalaryComponentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /salary-components/{id} [get]
+func (c *SalaryComponentController) GetSalaryComponent(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid salary component ID",
+			"message": "Salary component ID must be a valid number",
+		})
+	}
+
+	component, err := c.salaryComponentService.GetSalaryComponentByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Salary component not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, component)
+}
+
+// UpdateSalaryComponent updates a salary component
+// @Summary Update salary component
+// @Description Update the name, amount, percentage or active status of a salary component, its kind and method cannot change
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param id path int true "Salary component ID"
+// @Param salary_component body dto.UpdateSalaryComponentRequest true "Salary component data"
+// @Success 200 {object} dto.SalaryComponentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components/{id} [put]
+func (c *SalaryComponentController) UpdateSalaryComponent(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid salary component ID",
+			"message": "Salary component ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateSalaryComponentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	component, err := c.salaryComponentService.UpdateSalaryComponent(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update salary component",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, component)
+}
+
+// DeleteSalaryComponent deletes a salary component
+// @Summary Delete salary component
+// @Description Soft delete a salary component, lines of past salary calculations are kept
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param id path int true "Salary component ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components/{id} [delete]
+func (c *SalaryComponentController) DeleteSalaryComponent(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid salary component ID",
+			"message": "Salary component ID must be a valid number",
+		})
+	}
+
+	err = c.salaryComponentService.DeleteSalaryComponent(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete salary component",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Salary component deleted successfully",
+	})
+}
+
+// ListSalaryComponents retrieves salary components with pagination and filtering
+// @Summary List salary components
+// @Description Get list of salary components with pagination and filtering
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by code or name"
+// @Param kind query string false "Kind filter" Enums(earning, deduction)
+// @Param is_active query bool false "Active status filter"
+// @Success 200 {object} dto.SalaryComponentListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components [get]
+func (c *SalaryComponentController) ListSalaryComponents(ctx echo.Context) error {
+	var params dto.SalaryComponentQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	components, err := c.salaryComponentService.ListSalaryComponents(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list salary components",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, components)
+}
+
+// AssignSalaryComponent assigns a salary component to an employee or a department
+// @Summary Assign salary component
+// @Description Assign a salary component to one employee or to every employee of a department, optionally overriding its amount or percentage. An employee assignment wins over the department one.
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param id path int true "Salary component ID"
+// @Param assignment body dto.CreateSalaryComponentAssignmentRequest true "Assignment data"
+// @Success 201 {object} dto.SalaryComponentAssignmentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components/{id}/assignments [post]
+func (c *SalaryComponentController) AssignSalaryComponent(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid salary component ID",
+			"message": "Salary component ID must be a valid number",
+		})
+	}
+
+	var req dto.CreateSalaryComponentAssignmentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	assignment, err := c.salaryComponentService.AssignSalaryComponent(ctx.Request().Context(), id, &req)
+	if bizErr, ok := exceptions.AsBusinessLogicError(err); ok {
+		return response.BusinessLogicError(ctx, "Failed to assign salary component", bizErr)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to assign salary component",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, assignment)
+}
+
+// DeleteSalaryComponentAssignment removes an assignment of a salary component
+// @Summary Delete salary component assignment
+// @Description Remove a salary component from an employee or a department, later calculations no longer include it
+// @Tags salary-components
+// @Accept json
+// @Produce json
+// @Param id path int true "Salary component ID"
+// @Param assignment_id path int true "Assignment ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /salary-components/{id}/assignments/{assignment_id} [delete]
+func (c *SalaryComponentController) DeleteSalaryComponentAssignment(ctx echo.Context) error {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid salary component ID",
+			"message": "Salary component ID must be a valid number",
+		})
+	}
+
+	assignmentID, err := strconv.ParseUint(ctx.Param("assignment_id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid assignment ID",
+			"message": "Assignment ID must be a valid number",
+		})
+	}
+
+	err = c.salaryComponentService.DeleteSalaryComponentAssignment(ctx.Request().Context(), id, assignmentID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete salary component assignment",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Salary component assignment deleted successfully",
+	})
+}
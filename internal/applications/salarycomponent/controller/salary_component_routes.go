@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterSalaryComponentRoutes registers all salary component routes
+func RegisterSalaryComponentRoutes(e *echo.Group, controller *SalaryComponentController) {
+	e.POST("/salary-components", controller.CreateSalaryComponent)
+	e.GET("/salary-components", controller.ListSalaryComponents)
+	e.GET("/salary-components/:id", controller.GetSalaryComponent)
+	e.PUT("/salary-components/:id", controller.UpdateSalaryComponent)
+	e.DELETE("/salary-components/:id", controller.DeleteSalaryComponent)
+	e.POST("/salary-components/:id/assignments", controller.AssignSalaryComponent)
+	e.DELETE("/salary-components/:id/assignments/:assignment_id", controller.DeleteSalaryComponentAssignment)
+}
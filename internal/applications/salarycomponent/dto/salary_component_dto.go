@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateSalaryComponentRequest represents the request to define a salary component
+type CreateSalaryComponentRequest struct {
+	Code        string           `json:"code" validate:"required,min=2,max=50"`
+	Name        string           `json:"name" validate:"required,min=2,max=100"`
+	Kind        string           `json:"kind" validate:"required,oneof=earning deduction"`
+	Method      string           `json:"method" validate:"required,oneof=fixed percentage_of_base per_present_day"`
+	Amount      *decimal.Decimal `json:"amount,omitempty" validate:"omitempty,min=0"`
+	Percentage  *decimal.Decimal `json:"percentage,omitempty" validate:"omitempty,min=0,max=100"`
+	Description string           `json:"description,omitempty" validate:"omitempty,max=500"`
+	IsActive    *bool            `json:"is_active,omitempty"`
+}
+
+// UpdateSalaryComponentRequest represents the request to update a salary component, the kind and method of a
+// component cannot change once it was used
+type UpdateSalaryComponentRequest struct {
+	Name        string           `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Amount      *decimal.Decimal `json:"amount,omitempty" validate:"omitempty,min=0"`
+	Percentage  *decimal.Decimal `json:"percentage,omitempty" validate:"omitempty,min=0,max=100"`
+	Description *string          `json:"description,omitempty" validate:"omitempty,max=500"`
+	IsActive    *bool            `json:"is_active,omitempty"`
+}
+
+// CreateSalaryComponentAssignmentRequest represents the request to assign a component to an employee or to
+// every employee of a department
+type CreateSalaryComponentAssignmentRequest struct {
+	EmployeeID *uint64          `json:"employee_id,omitempty" validate:"omitempty,min=1"`
+	Department string           `json:"department,omitempty" validate:"omitempty,max=100"`
+	Amount     *decimal.Decimal `json:"amount,omitempty" validate:"omitempty,min=0"`
+	Percentage *decimal.Decimal `json:"percentage,omitempty" validate:"omitempty,min=0,max=100"`
+}
+
+// SalaryComponentResponse represents the salary component response structure
+type SalaryComponentResponse struct {
+	ID          uint64                              `json:"id"`
+	Code        string                              `json:"code"`
+	Name        string                              `json:"name"`
+	Kind        string                              `json:"kind"`
+	Method      string                              `json:"method"`
+	Amount      decimal.Decimal                     `json:"amount"`
+	Percentage  decimal.Decimal                     `json:"percentage"`
+	Description string                              `json:"description,omitempty"`
+	IsActive    bool                                `json:"is_active"`
+	Assignments []SalaryComponentAssignmentResponse `json:"assignments,omitempty"`
+	CreatedAt   time.Time                           `json:"created_at"`
+	ModifiedAt  time.Time                           `json:"modified_at"`
+}
+
+// SalaryComponentAssignmentResponse represents an assignment of a salary component
+type SalaryComponentAssignmentResponse struct {
+	ID           uint64           `json:"id"`
+	EmployeeID   *uint64          `json:"employee_id,omitempty"`
+	EmployeeName string           `json:"employee_name,omitempty"`
+	Department   string           `json:"department,omitempty"`
+	Amount       *decimal.Decimal `json:"amount,omitempty"`
+	Percentage   *decimal.Decimal `json:"percentage,omitempty"`
+	IsActive     bool             `json:"is_active"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+// SalaryComponentListResponse represents the response for salary component list
+type SalaryComponentListResponse struct {
+	SalaryComponents []SalaryComponentResponse `json:"salary_components"`
+	Total            int                       `json:"total"`
+	Page             int                       `json:"page"`
+	Limit            int                       `json:"limit"`
+}
+
+// SalaryComponentQueryParams represents query parameters for salary component list
+type SalaryComponentQueryParams struct {
+	Page     int    `query:"page" validate:"omitempty,min=1"`
+	Limit    int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Search   string `query:"search" validate:"omitempty,max=100"`
+	Kind     string `query:"kind" validate:"omitempty,oneof=earning deduction"`
+	IsActive *bool  `query:"is_active"`
+}
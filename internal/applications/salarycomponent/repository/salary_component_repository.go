@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/employee"
+	"mceasy/ent/salarycomponent"
+	"mceasy/ent/salarycomponentassignment"
+	"mceasy/internal/applications/salarycomponent/dto"
+)
+
+// SalaryComponentRepository defines the interface for salary component data operations
+type SalaryComponentRepository interface {
+	Create(ctx context.Context, req *dto.CreateSalaryComponentRequest) (*ent.SalaryComponent, error)
+	GetByID(ctx context.Context, id uint64) (*ent.SalaryComponent, error)
+	GetByCode(ctx context.Context, code string) (*ent.SalaryComponent, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateSalaryComponentRequest) (*ent.SalaryComponent, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.SalaryComponentQueryParams) ([]*ent.SalaryComponent, int, error)
+	CreateAssignment(ctx context.Context, componentID uint64, req *dto.CreateSalaryComponentAssignmentRequest) (*ent.SalaryComponentAssignment, error)
+	GetAssignment(ctx context.Context, componentID, assignmentID uint64) (*ent.SalaryComponentAssignment, error)
+	DeleteAssignment(ctx context.Context, assignmentID uint64) error
+	EmployeeExists(ctx context.Context, employeeID uint64) (bool, error)
+}
+
+// SalaryComponentRepositoryImpl implements the SalaryComponentRepository interface
+type SalaryComponentRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewSalaryComponentRepository creates a new salary component repository instance
+func NewSalaryComponentRepository(client *ent.Client) *SalaryComponentRepositoryImpl {
+	return &SalaryComponentRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create creates a new salary component
+func (r *SalaryComponentRepositoryImpl) Create(ctx context.Context, req *dto.CreateSalaryComponentRequest) (*ent.SalaryComponent, error) {
+	query := r.client.SalaryComponent.Create().
+		SetCode(req.Code).
+		SetName(req.Name).
+		SetKind(salarycomponent.Kind(req.Kind)).
+		SetMethod(salarycomponent.Method(req.Method)).
+		SetNillableAmount(req.Amount).
+		SetNillablePercentage(req.Percentage).
+		SetNillableIsActive(req.IsActive)
+
+	if req.Description != "" {
+		query = query.SetDescription(req.Description)
+	}
+
+	return query.Save(ctx)
+}
+
+// GetByID retrieves a salary component with its assignments by ID
+func (r *SalaryComponentRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.SalaryComponent, error) {
+	return r.client.SalaryComponent.
+		Query().
+		Where(salarycomponent.ID(id)).
+		Where(salarycomponent.DeletedAtIsNil()).
+		WithAssignments(func(q *ent.SalaryComponentAssignmentQuery) {
+			q.Where(salarycomponentassignment.DeletedAtIsNil()).
+				WithEmployee().
+				Order(ent.Asc(salarycomponentassignment.FieldID))
+		}).
+		First(ctx)
+}
+
+// GetByCode retrieves a salary component by code
+func (r *SalaryComponentRepositoryImpl) GetByCode(ctx context.Context, code string) (*ent.SalaryComponent, error) {
+	return r.client.SalaryComponent.
+		Query().
+		Where(salarycomponent.Code(code)).
+		Where(salarycomponent.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// Update updates a salary component
+func (r *SalaryComponentRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateSalaryComponentRequest) (*ent.SalaryComponent, error) {
+	query := r.client.SalaryComponent.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.Amount != nil {
+		query = query.SetAmount(*req.Amount)
+	}
+	if req.Percentage != nil {
+		query = query.SetPercentage(*req.Percentage)
+	}
+	if req.Description != nil {
+		query = query.SetDescription(*req.Description)
+	}
+	if req.IsActive != nil {
+		query = query.SetIsActive(*req.IsActive)
+	}
+
+	if _, err := query.Save(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// Delete soft deletes a salary component, lines of past calculations keep their code and name
+func (r *SalaryComponentRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.SalaryComponent.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves salary components with pagination and filtering
+func (r *SalaryComponentRepositoryImpl) List(ctx context.Context, params *dto.SalaryComponentQueryParams) ([]*ent.SalaryComponent, int, error) {
+	query := r.client.SalaryComponent.
+		Query().
+		Where(salarycomponent.DeletedAtIsNil())
+
+	// Apply filters
+	if params.Search != "" {
+		query = query.Where(
+			salarycomponent.Or(
+				salarycomponent.CodeContainsFold(params.Search),
+				salarycomponent.NameContainsFold(params.Search),
+			),
+		)
+	}
+
+	if params.Kind != "" {
+		query = query.Where(salarycomponent.KindEQ(salarycomponent.Kind(params.Kind)))
+	}
+
+	if params.IsActive != nil {
+		query = query.Where(salarycomponent.IsActive(*params.IsActive))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count salary components: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by code asc
+	query = query.Order(ent.Asc(salarycomponent.FieldCode))
+
+	components, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch salary components: %w", err)
+	}
+
+	return components, total, nil
+}
+
+// CreateAssignment assigns a salary component to an employee or a department
+func (r *SalaryComponentRepositoryImpl) CreateAssignment(ctx context.Context, componentID uint64, req *dto.CreateSalaryComponentAssignmentRequest) (*ent.SalaryComponentAssignment, error) {
+	query := r.client.SalaryComponentAssignment.Create().
+		SetSalaryComponentID(componentID).
+		SetNillableEmployeeID(req.EmployeeID).
+		SetNillableAmount(req.Amount).
+		SetNillablePercentage(req.Percentage)
+
+	if req.Department != "" {
+		query = query.SetDepartment(req.Department)
+	}
+
+	assignment, err := query.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetAssignment(ctx, componentID, assignment.ID)
+}
+
+// GetAssignment retrieves an assignment of a salary component
+func (r *SalaryComponentRepositoryImpl) GetAssignment(ctx context.Context, componentID, assignmentID uint64) (*ent.SalaryComponentAssignment, error) {
+	return r.client.SalaryComponentAssignment.
+		Query().
+		Where(salarycomponentassignment.ID(assignmentID)).
+		Where(salarycomponentassignment.SalaryComponentID(componentID)).
+		Where(salarycomponentassignment.DeletedAtIsNil()).
+		WithEmployee().
+		First(ctx)
+}
+
+// DeleteAssignment soft deletes an assignment of a salary component
+func (r *SalaryComponentRepositoryImpl) DeleteAssignment(ctx context.Context, assignmentID uint64) error {
+	return r.client.SalaryComponentAssignment.
+		UpdateOneID(assignmentID).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// EmployeeExists reports whether an employee that was not deleted exists
+func (r *SalaryComponentRepositoryImpl) EmployeeExists(ctx context.Context, employeeID uint64) (bool, error) {
+	return r.client.Employee.
+		Query().
+		Where(employee.ID(employeeID)).
+		Where(employee.DeletedAtIsNil()).
+		Exist(ctx)
+}
@@ -0,0 +1,32 @@
+//go:build wireinject
+// +build wireinject
+
+package salarycomponent
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/salarycomponent/repository"
+	"mceasy/internal/applications/salarycomponent/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerSalaryComponent = wire.NewSet(
+	repository.NewSalaryComponentRepository,
+	transaction.NewTrx,
+	service.NewSalaryComponentService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.SalaryComponentRepository), new(*repository.SalaryComponentRepositoryImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.SalaryComponentService), new(*service.SalaryComponentServiceImpl)),
+)
+
+func InitializedSalaryComponentService(dbClient *ent.Client, redisClient *redis.Client) *service.SalaryComponentServiceImpl {
+	wire.Build(providerSalaryComponent)
+	return nil
+}
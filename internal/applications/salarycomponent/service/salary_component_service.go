@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"mceasy/ent"
+	"mceasy/exceptions"
+	"mceasy/internal/applications/salarycomponent/dto"
+	"mceasy/internal/applications/salarycomponent/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// SalaryComponentService defines the interface for salary component business logic
+type SalaryComponentService interface {
+	CreateSalaryComponent(ctx context.Context, req *dto.CreateSalaryComponentRequest) (*dto.SalaryComponentResponse, error)
+	GetSalaryComponentByID(ctx context.Context, id uint64) (*dto.SalaryComponentResponse, error)
+	UpdateSalaryComponent(ctx context.Context, id uint64, req *dto.UpdateSalaryComponentRequest) (*dto.SalaryComponentResponse, error)
+	DeleteSalaryComponent(ctx context.Context, id uint64) error
+	ListSalaryComponents(ctx context.Context, params *dto.SalaryComponentQueryParams) (*dto.SalaryComponentListResponse, error)
+	AssignSalaryComponent(ctx context.Context, id uint64, req *dto.CreateSalaryComponentAssignmentRequest) (*dto.SalaryComponentAssignmentResponse, error)
+	DeleteSalaryComponentAssignment(ctx context.Context, id, assignmentID uint64) error
+}
+
+// SalaryComponentServiceImpl implements the SalaryComponentService interface
+type SalaryComponentServiceImpl struct {
+	salaryComponentRepo repository.SalaryComponentRepository
+	cache               cache.Cache
+	trx                 transaction.Trx
+}
+
+// NewSalaryComponentService creates a new salary component service instance
+func NewSalaryComponentService(
+	salaryComponentRepo repository.SalaryComponentRepository,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *SalaryComponentServiceImpl {
+	return &SalaryComponentServiceImpl{
+		salaryComponentRepo: salaryComponentRepo,
+		cache:               cache,
+		trx:                 trx,
+	}
+}
+
+// CreateSalaryComponent defines a new earning or deduction
+func (s *SalaryComponentServiceImpl) CreateSalaryComponent(ctx context.Context, req *dto.CreateSalaryComponentRequest) (*dto.SalaryComponentResponse, error) {
+	// Codes identify the lines of salary calculations and must be unique
+	existing, _ := s.salaryComponentRepo.GetByCode(ctx, req.Code)
+	if existing != nil {
+		return nil, exceptions.NewBusinessLogicError(exceptions.DataAlreadyExist,
+			fmt.Errorf("salary component with code %s already exists", req.Code))
+	}
+
+	component, err := s.salaryComponentRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create salary component: %w", err)
+	}
+
+	return s.mapToSalaryComponentResponse(component), nil
+}
+
+// GetSalaryComponentByID retrieves a salary component with its assignments by ID
+func (s *SalaryComponentServiceImpl) GetSalaryComponentByID(ctx context.Context, id uint64) (*dto.SalaryComponentResponse, error) {
+	component, err := s.salaryComponentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("salary component not found: %w", err)
+	}
+
+	return s.mapToSalaryComponentResponse(component), nil
+}
+
+// UpdateSalaryComponent updates a salary component, calculations made before keep their lines until recalculated
+func (s *SalaryComponentServiceImpl) UpdateSalaryComponent(ctx context.Context, id uint64, req *dto.UpdateSalaryComponentRequest) (*dto.SalaryComponentResponse, error) {
+	// Check if salary component exists
+	_, err := s.salaryComponentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("salary component not found: %w", err)
+	}
+
+	component, err := s.salaryComponentRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update salary component: %w", err)
+	}
+
+	return s.mapToSalaryComponentResponse(component), nil
+}
+
+// DeleteSalaryComponent soft deletes a salary component, it is no longer applied to new calculations
+func (s *SalaryComponentServiceImpl) DeleteSalaryComponent(ctx context.Context, id uint64) error {
+	// Check if salary component exists
+	_, err := s.salaryComponentRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("salary component not found: %w", err)
+	}
+
+	err = s.salaryComponentRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete salary component: %w", err)
+	}
+
+	return nil
+}
+
+// ListSalaryComponents retrieves salary components with pagination and filtering
+func (s *SalaryComponentServiceImpl) ListSalaryComponents(ctx context.Context, params *dto.SalaryComponentQueryParams) (*dto.SalaryComponentListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	components, total, err := s.salaryComponentRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list salary components: %w", err)
+	}
+
+	componentResponses := make([]dto.SalaryComponentResponse, len(components))
+	for i, component := range components {
+		componentResponses[i] = *s.mapToSalaryComponentResponse(component)
+	}
+
+	return &dto.SalaryComponentListResponse{
+		SalaryComponents: componentResponses,
+		Total:            total,
+		Page:             params.Page,
+		Limit:            params.Limit,
+	}, nil
+}
+
+// AssignSalaryComponent assigns a salary component to one employee or to every employee of a department
+func (s *SalaryComponentServiceImpl) AssignSalaryComponent(ctx context.Context, id uint64, req *dto.CreateSalaryComponentAssignmentRequest) (*dto.SalaryComponentAssignmentResponse, error) {
+	_, err := s.salaryComponentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("salary component not found: %w", err)
+	}
+
+	req.Department = strings.TrimSpace(req.Department)
+	if (req.EmployeeID == nil) == (req.Department == "") {
+		return nil, exceptions.NewBusinessLogicError(exceptions.InvalidArgument,
+			errors.New("an assignment needs either an employee_id or a department"))
+	}
+
+	if req.EmployeeID != nil {
+		exists, err := s.salaryComponentRepo.EmployeeExists(ctx, *req.EmployeeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check employee: %w", err)
+		}
+		if !exists {
+			return nil, exceptions.NewBusinessLogicError(exceptions.DataNotFound,
+				fmt.Errorf("employee %d not found", *req.EmployeeID))
+		}
+	}
+
+	assignment, err := s.salaryComponentRepo.CreateAssignment(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign salary component: %w", err)
+	}
+
+	return s.mapToAssignmentResponse(assignment), nil
+}
+
+// DeleteSalaryComponentAssignment soft deletes an assignment of a salary component
+func (s *SalaryComponentServiceImpl) DeleteSalaryComponentAssignment(ctx context.Context, id, assignmentID uint64) error {
+	_, err := s.salaryComponentRepo.GetAssignment(ctx, id, assignmentID)
+	if err != nil {
+		return fmt.Errorf("salary component assignment not found: %w", err)
+	}
+
+	err = s.salaryComponentRepo.DeleteAssignment(ctx, assignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete salary component assignment: %w", err)
+	}
+
+	return nil
+}
+
+// mapToSalaryComponentResponse maps an ent.SalaryComponent to dto.SalaryComponentResponse
+func (s *SalaryComponentServiceImpl) mapToSalaryComponentResponse(component *ent.SalaryComponent) *dto.SalaryComponentResponse {
+	response := &dto.SalaryComponentResponse{
+		ID:          component.ID,
+		Code:        component.Code,
+		Name:        component.Name,
+		Kind:        string(component.Kind),
+		Method:      string(component.Method),
+		Amount:      component.Amount,
+		Percentage:  component.Percentage,
+		Description: component.Description,
+		IsActive:    component.IsActive,
+		CreatedAt:   component.CreatedAt,
+		ModifiedAt:  component.ModifiedAt,
+	}
+
+	for _, assignment := range component.Edges.Assignments {
+		response.Assignments = append(response.Assignments, *s.mapToAssignmentResponse(assignment))
+	}
+
+	return response
+}
+
+// mapToAssignmentResponse maps an ent.SalaryComponentAssignment to dto.SalaryComponentAssignmentResponse
+func (s *SalaryComponentServiceImpl) mapToAssignmentResponse(assignment *ent.SalaryComponentAssignment) *dto.SalaryComponentAssignmentResponse {
+	response := &dto.SalaryComponentAssignmentResponse{
+		ID:         assignment.ID,
+		EmployeeID: assignment.EmployeeID,
+		Department: assignment.Department,
+		Amount:     assignment.Amount,
+		Percentage: assignment.Percentage,
+		IsActive:   assignment.IsActive,
+		CreatedAt:  assignment.CreatedAt,
+	}
+
+	if assignment.Edges.Employee != nil {
+		response.EmployeeName = assignment.Edges.Employee.FullName
+	}
+
+	return response
+}
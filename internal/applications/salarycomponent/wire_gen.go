@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package salarycomponent
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/salarycomponent/repository"
+	"mceasy/internal/applications/salarycomponent/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from salarycomponent_injector.go:
+
+func InitializedSalaryComponentService(dbClient *ent.Client, redisClient *redis.Client) *service.SalaryComponentServiceImpl {
+	salaryComponentRepositoryImpl := repository.NewSalaryComponentRepository(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	salaryComponentServiceImpl := service.NewSalaryComponentService(salaryComponentRepositoryImpl, cacheImpl, trxImpl)
+	return salaryComponentServiceImpl
+}
+
+// salarycomponent_injector.go:
+
+var providerSalaryComponent = wire.NewSet(repository.NewSalaryComponentRepository, transaction.NewTrx, service.NewSalaryComponentService, cache.NewCache, wire.Bind(new(repository.SalaryComponentRepository), new(*repository.SalaryComponentRepositoryImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.SalaryComponentService), new(*service.SalaryComponentServiceImpl)))
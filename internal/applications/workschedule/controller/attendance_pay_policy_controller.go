@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mceasy/internal/applications/workschedule/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateAttendancePayPolicy creates a new attendance pay policy
+// @Summary Create an attendance pay policy
+// @Description Create the pay weights of the present, late and half day statuses and an optional late penalty used by the salary calculation
+// @Tags attendance-pay-policies
+// @Accept json
+// @Produce json
+// @Param attendance_pay_policy body dto.CreateAttendancePayPolicyRequest true "Attendance pay policy data"
+// @Success 201 {object} dto.AttendancePayPolicyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance-pay-policies [post]
+func (c *WorkScheduleController) CreateAttendancePayPolicy(ctx echo.Context) error {
+	var req dto.CreateAttendancePayPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	policy, err := c.workScheduleService.CreateAttendancePayPolicy(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create attendance pay policy",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, policy)
+}
+
+// GetAttendancePayPolicy retrieves an attendance pay policy by ID
+// @Summary Get attendance pay policy by ID
+// @Description Get attendance pay policy details by ID
+// @Tags attendance-pay-policies
+// @Accept json
+// @Produce json
+// @Param id path int true "Attendance pay policy ID"
+// @Success 200 {object} dto.AttendancePayPolicyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /attendance-pay-policies/{id} [get]
+func (c *WorkScheduleController) GetAttendancePayPolicy(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid attendance pay policy ID",
+			"message": "Attendance pay policy ID must be a valid number",
+		})
+	}
+
+	policy, err := c.workScheduleService.GetAttendancePayPolicyByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Attendance pay policy not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// UpdateAttendancePayPolicy updates an attendance pay policy
+// @Summary Update attendance pay policy
+// @Description Update the weights or late penalty of a policy, salaries already calculated keep the policy they used
+// @Tags attendance-pay-policies
+// @Accept json
+// @Produce json
+// @Param id path int true "Attendance pay policy ID"
+// @Param attendance_pay_policy body dto.UpdateAttendancePayPolicyRequest true "Attendance pay policy data"
+// @Success 200 {object} dto.AttendancePayPolicyResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance-pay-policies/{id} [put]
+func (c *WorkScheduleController) UpdateAttendancePayPolicy(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid attendance pay policy ID",
+			"message": "Attendance pay policy ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateAttendancePayPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	policy, err := c.workScheduleService.UpdateAttendancePayPolicy(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update attendance pay policy",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, policy)
+}
+
+// DeleteAttendancePayPolicy deletes an attendance pay policy
+// @Summary Delete attendance pay policy
+// @Description Soft delete an attendance pay policy that no work schedule uses
+// @Tags attendance-pay-policies
+// @Accept json
+// @Produce json
+// @Param id path int true "Attendance pay policy ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance-pay-policies/{id} [delete]
+func (c *WorkScheduleController) DeleteAttendancePayPolicy(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid attendance pay policy ID",
+			"message": "Attendance pay policy ID must be a valid number",
+		})
+	}
+
+	err = c.workScheduleService.DeleteAttendancePayPolicy(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete attendance pay policy",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Attendance pay policy deleted successfully",
+	})
+}
+
+// ListAttendancePayPolicies retrieves attendance pay policies with pagination
+// @Summary List attendance pay policies
+// @Description Get list of attendance pay policies with pagination
+// @Tags attendance-pay-policies
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} dto.AttendancePayPolicyListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /attendance-pay-policies [get]
+func (c *WorkScheduleController) ListAttendancePayPolicies(ctx echo.Context) error {
+	var params dto.AttendancePayPolicyQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	policies, err := c.workScheduleService.ListAttendancePayPolicies(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list attendance pay policies",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, policies)
+}
@@ -0,0 +1,373 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"mceasy/internal/applications/workschedule/dto"
+	"mceasy/internal/applications/workschedule/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// WorkScheduleController handles HTTP requests for work schedule and shift assignment operations
+type WorkScheduleController struct {
+	workScheduleService service.WorkScheduleService
+}
+
+// NewWorkScheduleController creates a new work schedule controller instance
+func NewWorkScheduleController(workScheduleService service.WorkScheduleService) *WorkScheduleController {
+	return &WorkScheduleController{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// CreateWorkSchedule creates a new work schedule
+// @Summary Create a new work schedule
+// @Description Create a work schedule with shift times, working weekdays and late threshold
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param work_schedule body dto.CreateWorkScheduleRequest true "Work schedule data"
+// @Success 201 {object} dto.WorkScheduleResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /work-schedules [post]
+func (c *WorkScheduleController) CreateWorkSchedule(ctx echo.Context) error {
+	var req dto.CreateWorkScheduleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	workSchedule, err := c.workScheduleService.CreateWorkSchedule(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to create work schedule",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, workSchedule)
+}
+
+// GetWorkSchedule retrieves a work schedule by ID
+// @Summary Get work schedule by ID
+// @Description Get work schedule details by ID
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Work schedule ID"
+// @Success 200 {object} dto.WorkScheduleResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /work-schedules/{id} [get]
+func (c *WorkScheduleController) GetWorkSchedule(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid work schedule ID",
+			"message": "Work schedule ID must be a valid number",
+		})
+	}
+
+	workSchedule, err := c.workScheduleService.GetWorkScheduleByID(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+			"error":   "Work schedule not found",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, workSchedule)
+}
+
+// UpdateWorkSchedule updates a work schedule
+// @Summary Update work schedule
+// @Description Update work schedule details
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Work schedule ID"
+// @Param work_schedule body dto.UpdateWorkScheduleRequest true "Work schedule data"
+// @Success 200 {object} dto.WorkScheduleResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /work-schedules/{id} [put]
+func (c *WorkScheduleController) UpdateWorkSchedule(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid work schedule ID",
+			"message": "Work schedule ID must be a valid number",
+		})
+	}
+
+	var req dto.UpdateWorkScheduleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	workSchedule, err := c.workScheduleService.UpdateWorkSchedule(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to update work schedule",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, workSchedule)
+}
+
+// DeleteWorkSchedule deletes a work schedule
+// @Summary Delete work schedule
+// @Description Soft delete a work schedule that is no longer assigned
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Work schedule ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /work-schedules/{id} [delete]
+func (c *WorkScheduleController) DeleteWorkSchedule(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid work schedule ID",
+			"message": "Work schedule ID must be a valid number",
+		})
+	}
+
+	err = c.workScheduleService.DeleteWorkSchedule(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete work schedule",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Work schedule deleted successfully",
+	})
+}
+
+// ListWorkSchedules retrieves work schedules with pagination
+// @Summary List work schedules
+// @Description Get list of work schedules with pagination
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} dto.WorkScheduleListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /work-schedules [get]
+func (c *WorkScheduleController) ListWorkSchedules(ctx echo.Context) error {
+	var params dto.WorkScheduleQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	schedules, err := c.workScheduleService.ListWorkSchedules(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list work schedules",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, schedules)
+}
+
+// AssignShift assigns a work schedule to an employee
+// @Summary Assign work schedule
+// @Description Assign a work schedule to an employee from an effective date
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param assignment body dto.AssignShiftRequest true "Shift assignment data"
+// @Success 201 {object} dto.ShiftAssignmentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /shift-assignments [post]
+func (c *WorkScheduleController) AssignShift(ctx echo.Context) error {
+	var req dto.AssignShiftRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	assignment, err := c.workScheduleService.AssignShift(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to assign work schedule",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, assignment)
+}
+
+// DeleteShiftAssignment deletes a shift assignment
+// @Summary Delete shift assignment
+// @Description Soft delete a shift assignment
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param id path int true "Shift assignment ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /shift-assignments/{id} [delete]
+func (c *WorkScheduleController) DeleteShiftAssignment(ctx echo.Context) error {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid shift assignment ID",
+			"message": "Shift assignment ID must be a valid number",
+		})
+	}
+
+	err = c.workScheduleService.DeleteShiftAssignment(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to delete shift assignment",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Shift assignment deleted successfully",
+	})
+}
+
+// ListShiftAssignments retrieves shift assignments with pagination and filtering
+// @Summary List shift assignments
+// @Description Get list of shift assignments with pagination and filtering
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param employee_id query int false "Employee ID filter"
+// @Param work_schedule_id query int false "Work schedule ID filter"
+// @Success 200 {object} dto.ShiftAssignmentListResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /shift-assignments [get]
+func (c *WorkScheduleController) ListShiftAssignments(ctx echo.Context) error {
+	var params dto.ShiftAssignmentQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	assignments, err := c.workScheduleService.ListShiftAssignments(ctx.Request().Context(), &params)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to list shift assignments",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, assignments)
+}
+
+// GetEmployeeSchedule retrieves the work schedule that applies to an employee
+// @Summary Get employee work schedule
+// @Description Resolve the work schedule assigned to an employee on a date
+// @Tags work-schedules
+// @Accept json
+// @Produce json
+// @Param employee_id path int true "Employee ID"
+// @Param date query string false "Date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} dto.EmployeeScheduleResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /shift-assignments/employee/{employee_id} [get]
+func (c *WorkScheduleController) GetEmployeeSchedule(ctx echo.Context) error {
+	employeeIDStr := ctx.Param("employee_id")
+	employeeID, err := strconv.ParseUint(employeeIDStr, 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid number",
+		})
+	}
+
+	// A zero date resolves to today in the employee's timezone
+	var date time.Time
+	if dateStr := ctx.QueryParam("date"); dateStr != "" {
+		date, err = time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Invalid date format",
+				"message": "Date must be in YYYY-MM-DD format",
+			})
+		}
+	}
+
+	schedule, err := c.workScheduleService.GetEmployeeSchedule(ctx.Request().Context(), employeeID, date)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to get employee work schedule",
+			"message": err.Error(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterWorkScheduleRoutes registers all work schedule, shift assignment and attendance pay policy routes
+func RegisterWorkScheduleRoutes(e *echo.Group, controller *WorkScheduleController) {
+	// Work schedule management routes
+	e.POST("/work-schedules", controller.CreateWorkSchedule)
+	e.GET("/work-schedules", controller.ListWorkSchedules)
+	e.GET("/work-schedules/:id", controller.GetWorkSchedule)
+	e.PUT("/work-schedules/:id", controller.UpdateWorkSchedule)
+	e.DELETE("/work-schedules/:id", controller.DeleteWorkSchedule)
+
+	// Shift assignment routes
+	e.GET("/shift-assignments/employee/:employee_id", controller.GetEmployeeSchedule)
+	e.POST("/shift-assignments", controller.AssignShift)
+	e.GET("/shift-assignments", controller.ListShiftAssignments)
+	e.DELETE("/shift-assignments/:id", controller.DeleteShiftAssignment)
+
+	// Attendance pay policy routes
+	e.POST("/attendance-pay-policies", controller.CreateAttendancePayPolicy)
+	e.GET("/attendance-pay-policies", controller.ListAttendancePayPolicies)
+	e.GET("/attendance-pay-policies/:id", controller.GetAttendancePayPolicy)
+	e.PUT("/attendance-pay-policies/:id", controller.UpdateAttendancePayPolicy)
+	e.DELETE("/attendance-pay-policies/:id", controller.DeleteAttendancePayPolicy)
+}
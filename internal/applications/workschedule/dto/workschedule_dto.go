@@ -0,0 +1,163 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateWorkScheduleRequest represents the request to create a work schedule
+type CreateWorkScheduleRequest struct {
+	Name                    string  `json:"name" validate:"required,min=2,max=100"`
+	StartTime               string  `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime                 string  `json:"end_time" validate:"required,datetime=15:04"`
+	WorkingDays             []int   `json:"working_days" validate:"required,min=1,max=7,dive,min=0,max=6"`
+	LateThresholdMinutes    int     `json:"late_threshold_minutes,omitempty" validate:"omitempty,min=0,max=720"`
+	HalfDayThresholdMinutes int     `json:"half_day_threshold_minutes,omitempty" validate:"omitempty,min=0,max=1440"`
+	IsDefault               bool    `json:"is_default,omitempty"`
+	AttendancePayPolicyID   *uint64 `json:"attendance_pay_policy_id,omitempty"`
+}
+
+// UpdateWorkScheduleRequest represents the request to update a work schedule
+type UpdateWorkScheduleRequest struct {
+	Name                    string `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	StartTime               string `json:"start_time,omitempty" validate:"omitempty,datetime=15:04"`
+	EndTime                 string `json:"end_time,omitempty" validate:"omitempty,datetime=15:04"`
+	WorkingDays             []int  `json:"working_days,omitempty" validate:"omitempty,min=1,max=7,dive,min=0,max=6"`
+	LateThresholdMinutes    *int   `json:"late_threshold_minutes,omitempty" validate:"omitempty,min=0,max=720"`
+	HalfDayThresholdMinutes *int   `json:"half_day_threshold_minutes,omitempty" validate:"omitempty,min=0,max=1440"`
+	IsDefault               *bool  `json:"is_default,omitempty"`
+	// AttendancePayPolicyID set to 0 removes the policy so the default policy applies
+	AttendancePayPolicyID *uint64 `json:"attendance_pay_policy_id,omitempty"`
+}
+
+// WorkScheduleResponse represents the work schedule response structure
+type WorkScheduleResponse struct {
+	ID                      uint64    `json:"id"`
+	Name                    string    `json:"name"`
+	StartTime               string    `json:"start_time"`
+	EndTime                 string    `json:"end_time"`
+	WorkingDays             []int     `json:"working_days"`
+	LateThresholdMinutes    int       `json:"late_threshold_minutes"`
+	HalfDayThresholdMinutes int       `json:"half_day_threshold_minutes"`
+	IsDefault               bool      `json:"is_default"`
+	AttendancePayPolicyID   *uint64   `json:"attendance_pay_policy_id,omitempty"`
+	CreatedAt               time.Time `json:"created_at"`
+	ModifiedAt              time.Time `json:"modified_at"`
+}
+
+// WorkScheduleListResponse represents the response for work schedule list
+type WorkScheduleListResponse struct {
+	WorkSchedules []WorkScheduleResponse `json:"work_schedules"`
+	Total         int                    `json:"total"`
+	Page          int                    `json:"page"`
+	Limit         int                    `json:"limit"`
+}
+
+// WorkScheduleQueryParams represents query parameters for work schedule list
+type WorkScheduleQueryParams struct {
+	Page  int `query:"page" validate:"omitempty,min=1"`
+	Limit int `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// AssignShiftRequest represents the request to assign a work schedule to an employee
+type AssignShiftRequest struct {
+	EmployeeID     uint64 `json:"employee_id" validate:"required"`
+	WorkScheduleID uint64 `json:"work_schedule_id" validate:"required"`
+	EffectiveDate  string `json:"effective_date" validate:"required,datetime=2006-01-02"`
+	EndDate        string `json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// ShiftAssignmentResponse represents the shift assignment response structure
+type ShiftAssignmentResponse struct {
+	ID               uint64     `json:"id"`
+	EmployeeID       uint64     `json:"employee_id"`
+	EmployeeName     string     `json:"employee_name,omitempty"`
+	WorkScheduleID   uint64     `json:"work_schedule_id"`
+	WorkScheduleName string     `json:"work_schedule_name,omitempty"`
+	EffectiveDate    time.Time  `json:"effective_date"`
+	EndDate          *time.Time `json:"end_date,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ModifiedAt       time.Time  `json:"modified_at"`
+}
+
+// ShiftAssignmentListResponse represents the response for shift assignment list
+type ShiftAssignmentListResponse struct {
+	ShiftAssignments []ShiftAssignmentResponse `json:"shift_assignments"`
+	Total            int                       `json:"total"`
+	Page             int                       `json:"page"`
+	Limit            int                       `json:"limit"`
+}
+
+// ShiftAssignmentQueryParams represents query parameters for shift assignment list
+type ShiftAssignmentQueryParams struct {
+	Page           int    `query:"page" validate:"omitempty,min=1"`
+	Limit          int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID     uint64 `query:"employee_id" validate:"omitempty,min=1"`
+	WorkScheduleID uint64 `query:"work_schedule_id" validate:"omitempty,min=1"`
+}
+
+// EmployeeScheduleResponse represents the work schedule resolved for an employee on a date
+type EmployeeScheduleResponse struct {
+	EmployeeID              uint64    `json:"employee_id"`
+	Date                    time.Time `json:"date"`
+	WorkScheduleID          uint64    `json:"work_schedule_id,omitempty"`
+	Name                    string    `json:"name"`
+	StartTime               string    `json:"start_time"`
+	EndTime                 string    `json:"end_time"`
+	WorkingDays             []int     `json:"working_days"`
+	LateThresholdMinutes    int       `json:"late_threshold_minutes"`
+	HalfDayThresholdMinutes int       `json:"half_day_threshold_minutes"`
+	AttendancePayPolicyID   *uint64   `json:"attendance_pay_policy_id,omitempty"`
+	IsWorkingDay            bool      `json:"is_working_day"`
+}
+
+// CreateAttendancePayPolicyRequest represents the request to create an attendance pay policy
+type CreateAttendancePayPolicyRequest struct {
+	Name                string           `json:"name" validate:"required,min=2,max=100"`
+	PresentWeight       *decimal.Decimal `json:"present_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	LateWeight          *decimal.Decimal `json:"late_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	HalfDayWeight       *decimal.Decimal `json:"half_day_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	LatePenaltyAmount   decimal.Decimal  `json:"late_penalty_amount,omitempty" validate:"omitempty,min=0"`
+	LatePenaltyFreeDays int              `json:"late_penalty_free_days,omitempty" validate:"omitempty,min=0,max=31"`
+	IsDefault           bool             `json:"is_default,omitempty"`
+}
+
+// UpdateAttendancePayPolicyRequest represents the request to update an attendance pay policy
+type UpdateAttendancePayPolicyRequest struct {
+	Name                string           `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	PresentWeight       *decimal.Decimal `json:"present_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	LateWeight          *decimal.Decimal `json:"late_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	HalfDayWeight       *decimal.Decimal `json:"half_day_weight,omitempty" validate:"omitempty,min=0,max=1"`
+	LatePenaltyAmount   *decimal.Decimal `json:"late_penalty_amount,omitempty" validate:"omitempty,min=0"`
+	LatePenaltyFreeDays *int             `json:"late_penalty_free_days,omitempty" validate:"omitempty,min=0,max=31"`
+	IsDefault           *bool            `json:"is_default,omitempty"`
+}
+
+// AttendancePayPolicyResponse represents the attendance pay policy response structure
+type AttendancePayPolicyResponse struct {
+	ID                  uint64          `json:"id"`
+	Name                string          `json:"name"`
+	PresentWeight       decimal.Decimal `json:"present_weight"`
+	LateWeight          decimal.Decimal `json:"late_weight"`
+	HalfDayWeight       decimal.Decimal `json:"half_day_weight"`
+	LatePenaltyAmount   decimal.Decimal `json:"late_penalty_amount"`
+	LatePenaltyFreeDays int             `json:"late_penalty_free_days"`
+	IsDefault           bool            `json:"is_default"`
+	CreatedAt           time.Time       `json:"created_at"`
+	ModifiedAt          time.Time       `json:"modified_at"`
+}
+
+// AttendancePayPolicyListResponse represents the response for attendance pay policy list
+type AttendancePayPolicyListResponse struct {
+	AttendancePayPolicies []AttendancePayPolicyResponse `json:"attendance_pay_policies"`
+	Total                 int                           `json:"total"`
+	Page                  int                           `json:"page"`
+	Limit                 int                           `json:"limit"`
+}
+
+// AttendancePayPolicyQueryParams represents query parameters for attendance pay policy list
+type AttendancePayPolicyQueryParams struct {
+	Page  int `query:"page" validate:"omitempty,min=1"`
+	Limit int `query:"limit" validate:"omitempty,min=1,max=100"`
+}
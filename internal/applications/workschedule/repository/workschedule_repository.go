@@ -0,0 +1,396 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/attendancepaypolicy"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"mceasy/internal/applications/workschedule/dto"
+)
+
+// WorkScheduleRepository defines the interface for work schedule and shift assignment data operations
+type WorkScheduleRepository interface {
+	Create(ctx context.Context, req *dto.CreateWorkScheduleRequest) (*ent.WorkSchedule, error)
+	GetByID(ctx context.Context, id uint64) (*ent.WorkSchedule, error)
+	GetByName(ctx context.Context, name string) (*ent.WorkSchedule, error)
+	Update(ctx context.Context, id uint64, req *dto.UpdateWorkScheduleRequest) (*ent.WorkSchedule, error)
+	Delete(ctx context.Context, id uint64) error
+	List(ctx context.Context, params *dto.WorkScheduleQueryParams) ([]*ent.WorkSchedule, int, error)
+	SetDefault(ctx context.Context, id uint64) error
+	CountActiveAssignments(ctx context.Context, workScheduleID uint64) (int, error)
+	CreateAssignment(ctx context.Context, req *dto.AssignShiftRequest, effectiveDate time.Time, endDate *time.Time) (*ent.ShiftAssignment, error)
+	GetAssignmentByID(ctx context.Context, id uint64) (*ent.ShiftAssignment, error)
+	DeleteAssignment(ctx context.Context, id uint64) error
+	ListAssignments(ctx context.Context, params *dto.ShiftAssignmentQueryParams) ([]*ent.ShiftAssignment, int, error)
+	CreatePayPolicy(ctx context.Context, req *dto.CreateAttendancePayPolicyRequest) (*ent.AttendancePayPolicy, error)
+	GetPayPolicyByID(ctx context.Context, id uint64) (*ent.AttendancePayPolicy, error)
+	GetPayPolicyByName(ctx context.Context, name string) (*ent.AttendancePayPolicy, error)
+	UpdatePayPolicy(ctx context.Context, id uint64, req *dto.UpdateAttendancePayPolicyRequest) (*ent.AttendancePayPolicy, error)
+	DeletePayPolicy(ctx context.Context, id uint64) error
+	ListPayPolicies(ctx context.Context, params *dto.AttendancePayPolicyQueryParams) ([]*ent.AttendancePayPolicy, int, error)
+	SetDefaultPayPolicy(ctx context.Context, id uint64) error
+	CountSchedulesWithPayPolicy(ctx context.Context, payPolicyID uint64) (int, error)
+}
+
+// WorkScheduleRepositoryImpl implements the WorkScheduleRepository interface
+type WorkScheduleRepositoryImpl struct {
+	client *ent.Client
+}
+
+// NewWorkScheduleRepository creates a new work schedule repository instance
+func NewWorkScheduleRepository(client *ent.Client) *WorkScheduleRepositoryImpl {
+	return &WorkScheduleRepositoryImpl{
+		client: client,
+	}
+}
+
+// Create creates a new work schedule
+func (r *WorkScheduleRepositoryImpl) Create(ctx context.Context, req *dto.CreateWorkScheduleRequest) (*ent.WorkSchedule, error) {
+	return r.client.WorkSchedule.Create().
+		SetName(req.Name).
+		SetStartTime(req.StartTime).
+		SetEndTime(req.EndTime).
+		SetWorkingDays(req.WorkingDays).
+		SetLateThresholdMinutes(req.LateThresholdMinutes).
+		SetHalfDayThresholdMinutes(req.HalfDayThresholdMinutes).
+		SetNillableAttendancePayPolicyID(req.AttendancePayPolicyID).
+		Save(ctx)
+}
+
+// GetByID retrieves a work schedule by ID
+func (r *WorkScheduleRepositoryImpl) GetByID(ctx context.Context, id uint64) (*ent.WorkSchedule, error) {
+	return r.client.WorkSchedule.
+		Query().
+		Where(workschedule.ID(id)).
+		Where(workschedule.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// GetByName retrieves a work schedule by name
+func (r *WorkScheduleRepositoryImpl) GetByName(ctx context.Context, name string) (*ent.WorkSchedule, error) {
+	return r.client.WorkSchedule.
+		Query().
+		Where(workschedule.Name(name)).
+		Where(workschedule.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// Update updates a work schedule, the default flag is handled by SetDefault
+func (r *WorkScheduleRepositoryImpl) Update(ctx context.Context, id uint64, req *dto.UpdateWorkScheduleRequest) (*ent.WorkSchedule, error) {
+	query := r.client.WorkSchedule.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.StartTime != "" {
+		query = query.SetStartTime(req.StartTime)
+	}
+	if req.EndTime != "" {
+		query = query.SetEndTime(req.EndTime)
+	}
+	if len(req.WorkingDays) > 0 {
+		query = query.SetWorkingDays(req.WorkingDays)
+	}
+	if req.LateThresholdMinutes != nil {
+		query = query.SetLateThresholdMinutes(*req.LateThresholdMinutes)
+	}
+	if req.HalfDayThresholdMinutes != nil {
+		query = query.SetHalfDayThresholdMinutes(*req.HalfDayThresholdMinutes)
+	}
+	if req.IsDefault != nil && !*req.IsDefault {
+		query = query.SetIsDefault(false)
+	}
+	if req.AttendancePayPolicyID != nil {
+		if *req.AttendancePayPolicyID == 0 {
+			query = query.ClearAttendancePayPolicyID()
+		} else {
+			query = query.SetAttendancePayPolicyID(*req.AttendancePayPolicyID)
+		}
+	}
+
+	return query.Save(ctx)
+}
+
+// Delete soft deletes a work schedule
+func (r *WorkScheduleRepositoryImpl) Delete(ctx context.Context, id uint64) error {
+	return r.client.WorkSchedule.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List retrieves work schedules with pagination
+func (r *WorkScheduleRepositoryImpl) List(ctx context.Context, params *dto.WorkScheduleQueryParams) ([]*ent.WorkSchedule, int, error) {
+	query := r.client.WorkSchedule.
+		Query().
+		Where(workschedule.DeletedAtIsNil())
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count work schedules: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by name asc
+	query = query.Order(ent.Asc(workschedule.FieldName))
+
+	schedules, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch work schedules: %w", err)
+	}
+
+	return schedules, total, nil
+}
+
+// SetDefault makes the given work schedule the only default schedule
+func (r *WorkScheduleRepositoryImpl) SetDefault(ctx context.Context, id uint64) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.WorkSchedule.Update().
+		Where(workschedule.IsDefault(true)).
+		Where(workschedule.IDNEQ(id)).
+		SetIsDefault(false).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear default work schedule: %w", err)
+	}
+
+	err = tx.WorkSchedule.UpdateOneID(id).
+		SetIsDefault(true).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set default work schedule: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// CountActiveAssignments counts shift assignments that still reference the work schedule
+func (r *WorkScheduleRepositoryImpl) CountActiveAssignments(ctx context.Context, workScheduleID uint64) (int, error) {
+	return r.client.ShiftAssignment.
+		Query().
+		Where(shiftassignment.WorkScheduleID(workScheduleID)).
+		Where(shiftassignment.DeletedAtIsNil()).
+		Count(ctx)
+}
+
+// CreateAssignment assigns a work schedule to an employee from the effective date
+func (r *WorkScheduleRepositoryImpl) CreateAssignment(ctx context.Context, req *dto.AssignShiftRequest, effectiveDate time.Time, endDate *time.Time) (*ent.ShiftAssignment, error) {
+	created, err := r.client.ShiftAssignment.Create().
+		SetEmployeeID(req.EmployeeID).
+		SetWorkScheduleID(req.WorkScheduleID).
+		SetEffectiveDate(effectiveDate).
+		SetNillableEndDate(endDate).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetAssignmentByID(ctx, created.ID)
+}
+
+// GetAssignmentByID retrieves a shift assignment by ID
+func (r *WorkScheduleRepositoryImpl) GetAssignmentByID(ctx context.Context, id uint64) (*ent.ShiftAssignment, error) {
+	return r.client.ShiftAssignment.
+		Query().
+		Where(shiftassignment.ID(id)).
+		Where(shiftassignment.DeletedAtIsNil()).
+		WithEmployee().
+		WithWorkSchedule().
+		First(ctx)
+}
+
+// DeleteAssignment soft deletes a shift assignment
+func (r *WorkScheduleRepositoryImpl) DeleteAssignment(ctx context.Context, id uint64) error {
+	return r.client.ShiftAssignment.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ListAssignments retrieves shift assignments with pagination and filtering
+func (r *WorkScheduleRepositoryImpl) ListAssignments(ctx context.Context, params *dto.ShiftAssignmentQueryParams) ([]*ent.ShiftAssignment, int, error) {
+	query := r.client.ShiftAssignment.
+		Query().
+		Where(shiftassignment.DeletedAtIsNil()).
+		WithEmployee().
+		WithWorkSchedule()
+
+	// Apply filters
+	if params.EmployeeID > 0 {
+		query = query.Where(shiftassignment.EmployeeID(params.EmployeeID))
+	}
+
+	if params.WorkScheduleID > 0 {
+		query = query.Where(shiftassignment.WorkScheduleID(params.WorkScheduleID))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count shift assignments: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by effective_date desc
+	query = query.Order(ent.Desc(shiftassignment.FieldEffectiveDate), ent.Desc(shiftassignment.FieldID))
+
+	assignments, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch shift assignments: %w", err)
+	}
+
+	return assignments, total, nil
+}
+
+// CreatePayPolicy creates a new attendance pay policy, weights that are not given keep their defaults
+func (r *WorkScheduleRepositoryImpl) CreatePayPolicy(ctx context.Context, req *dto.CreateAttendancePayPolicyRequest) (*ent.AttendancePayPolicy, error) {
+	return r.client.AttendancePayPolicy.Create().
+		SetName(req.Name).
+		SetNillablePresentWeight(req.PresentWeight).
+		SetNillableLateWeight(req.LateWeight).
+		SetNillableHalfDayWeight(req.HalfDayWeight).
+		SetLatePenaltyAmount(req.LatePenaltyAmount).
+		SetLatePenaltyFreeDays(req.LatePenaltyFreeDays).
+		Save(ctx)
+}
+
+// GetPayPolicyByID retrieves an attendance pay policy by ID
+func (r *WorkScheduleRepositoryImpl) GetPayPolicyByID(ctx context.Context, id uint64) (*ent.AttendancePayPolicy, error) {
+	return r.client.AttendancePayPolicy.
+		Query().
+		Where(attendancepaypolicy.ID(id)).
+		Where(attendancepaypolicy.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// GetPayPolicyByName retrieves an attendance pay policy by name
+func (r *WorkScheduleRepositoryImpl) GetPayPolicyByName(ctx context.Context, name string) (*ent.AttendancePayPolicy, error) {
+	return r.client.AttendancePayPolicy.
+		Query().
+		Where(attendancepaypolicy.Name(name)).
+		Where(attendancepaypolicy.DeletedAtIsNil()).
+		First(ctx)
+}
+
+// UpdatePayPolicy updates an attendance pay policy, the default flag is handled by SetDefaultPayPolicy
+func (r *WorkScheduleRepositoryImpl) UpdatePayPolicy(ctx context.Context, id uint64, req *dto.UpdateAttendancePayPolicyRequest) (*ent.AttendancePayPolicy, error) {
+	query := r.client.AttendancePayPolicy.UpdateOneID(id)
+
+	if req.Name != "" {
+		query = query.SetName(req.Name)
+	}
+	if req.PresentWeight != nil {
+		query = query.SetPresentWeight(*req.PresentWeight)
+	}
+	if req.LateWeight != nil {
+		query = query.SetLateWeight(*req.LateWeight)
+	}
+	if req.HalfDayWeight != nil {
+		query = query.SetHalfDayWeight(*req.HalfDayWeight)
+	}
+	if req.LatePenaltyAmount != nil {
+		query = query.SetLatePenaltyAmount(*req.LatePenaltyAmount)
+	}
+	if req.LatePenaltyFreeDays != nil {
+		query = query.SetLatePenaltyFreeDays(*req.LatePenaltyFreeDays)
+	}
+	if req.IsDefault != nil && !*req.IsDefault {
+		query = query.SetIsDefault(false)
+	}
+
+	return query.Save(ctx)
+}
+
+// DeletePayPolicy soft deletes an attendance pay policy
+func (r *WorkScheduleRepositoryImpl) DeletePayPolicy(ctx context.Context, id uint64) error {
+	return r.client.AttendancePayPolicy.
+		UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		SetIsDefault(false).
+		Exec(ctx)
+}
+
+// ListPayPolicies retrieves attendance pay policies with pagination
+func (r *WorkScheduleRepositoryImpl) ListPayPolicies(ctx context.Context, params *dto.AttendancePayPolicyQueryParams) ([]*ent.AttendancePayPolicy, int, error) {
+	query := r.client.AttendancePayPolicy.
+		Query().
+		Where(attendancepaypolicy.DeletedAtIsNil())
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendance pay policies: %w", err)
+	}
+
+	// Apply pagination
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	// Order by name asc
+	query = query.Order(ent.Asc(attendancepaypolicy.FieldName))
+
+	policies, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch attendance pay policies: %w", err)
+	}
+
+	return policies, total, nil
+}
+
+// SetDefaultPayPolicy makes the given attendance pay policy the only default policy
+func (r *WorkScheduleRepositoryImpl) SetDefaultPayPolicy(ctx context.Context, id uint64) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.AttendancePayPolicy.Update().
+		Where(attendancepaypolicy.IsDefault(true)).
+		Where(attendancepaypolicy.IDNEQ(id)).
+		SetIsDefault(false).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear default attendance pay policy: %w", err)
+	}
+
+	err = tx.AttendancePayPolicy.UpdateOneID(id).
+		SetIsDefault(true).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set default attendance pay policy: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// CountSchedulesWithPayPolicy counts work schedules that still use the attendance pay policy
+func (r *WorkScheduleRepositoryImpl) CountSchedulesWithPayPolicy(ctx context.Context, payPolicyID uint64) (int, error) {
+	return r.client.WorkSchedule.
+		Query().
+		Where(workschedule.AttendancePayPolicyID(payPolicyID)).
+		Where(workschedule.DeletedAtIsNil()).
+		Count(ctx)
+}
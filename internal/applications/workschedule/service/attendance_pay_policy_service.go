@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/workschedule/dto"
+)
+
+// CreateAttendancePayPolicy creates a new attendance pay policy
+func (s *WorkScheduleServiceImpl) CreateAttendancePayPolicy(ctx context.Context, req *dto.CreateAttendancePayPolicyRequest) (*dto.AttendancePayPolicyResponse, error) {
+	// Policy names must be unique
+	existing, _ := s.workScheduleRepo.GetPayPolicyByName(ctx, req.Name)
+	if existing != nil {
+		return nil, fmt.Errorf("attendance pay policy %s already exists", req.Name)
+	}
+
+	policy, err := s.workScheduleRepo.CreatePayPolicy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attendance pay policy: %w", err)
+	}
+
+	if req.IsDefault {
+		if err := s.workScheduleRepo.SetDefaultPayPolicy(ctx, policy.ID); err != nil {
+			return nil, err
+		}
+		policy.IsDefault = true
+	}
+
+	return s.mapToAttendancePayPolicyResponse(policy), nil
+}
+
+// GetAttendancePayPolicyByID retrieves an attendance pay policy by ID
+func (s *WorkScheduleServiceImpl) GetAttendancePayPolicyByID(ctx context.Context, id uint64) (*dto.AttendancePayPolicyResponse, error) {
+	policy, err := s.workScheduleRepo.GetPayPolicyByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance pay policy not found: %w", err)
+	}
+
+	return s.mapToAttendancePayPolicyResponse(policy), nil
+}
+
+// UpdateAttendancePayPolicy updates an attendance pay policy, salaries already calculated keep the policy they used
+func (s *WorkScheduleServiceImpl) UpdateAttendancePayPolicy(ctx context.Context, id uint64, req *dto.UpdateAttendancePayPolicyRequest) (*dto.AttendancePayPolicyResponse, error) {
+	// Check if attendance pay policy exists
+	current, err := s.workScheduleRepo.GetPayPolicyByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("attendance pay policy not found: %w", err)
+	}
+
+	if req.Name != "" && req.Name != current.Name {
+		existing, _ := s.workScheduleRepo.GetPayPolicyByName(ctx, req.Name)
+		if existing != nil {
+			return nil, fmt.Errorf("attendance pay policy %s already exists", req.Name)
+		}
+	}
+
+	policy, err := s.workScheduleRepo.UpdatePayPolicy(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update attendance pay policy: %w", err)
+	}
+
+	if req.IsDefault != nil && *req.IsDefault {
+		if err := s.workScheduleRepo.SetDefaultPayPolicy(ctx, id); err != nil {
+			return nil, err
+		}
+		policy.IsDefault = true
+	}
+
+	return s.mapToAttendancePayPolicyResponse(policy), nil
+}
+
+// DeleteAttendancePayPolicy soft deletes an attendance pay policy that no work schedule uses
+func (s *WorkScheduleServiceImpl) DeleteAttendancePayPolicy(ctx context.Context, id uint64) error {
+	// Check if attendance pay policy exists
+	_, err := s.workScheduleRepo.GetPayPolicyByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("attendance pay policy not found: %w", err)
+	}
+
+	used, err := s.workScheduleRepo.CountSchedulesWithPayPolicy(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count work schedules: %w", err)
+	}
+	if used > 0 {
+		return fmt.Errorf("attendance pay policy is still used by %d work schedules", used)
+	}
+
+	err = s.workScheduleRepo.DeletePayPolicy(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attendance pay policy: %w", err)
+	}
+
+	return nil
+}
+
+// ListAttendancePayPolicies retrieves attendance pay policies with pagination
+func (s *WorkScheduleServiceImpl) ListAttendancePayPolicies(ctx context.Context, params *dto.AttendancePayPolicyQueryParams) (*dto.AttendancePayPolicyListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	policies, total, err := s.workScheduleRepo.ListPayPolicies(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendance pay policies: %w", err)
+	}
+
+	policyResponses := make([]dto.AttendancePayPolicyResponse, len(policies))
+	for i, policy := range policies {
+		policyResponses[i] = *s.mapToAttendancePayPolicyResponse(policy)
+	}
+
+	return &dto.AttendancePayPolicyListResponse{
+		AttendancePayPolicies: policyResponses,
+		Total:                 total,
+		Page:                  params.Page,
+		Limit:                 params.Limit,
+	}, nil
+}
+
+// checkPayPolicy checks that the attendance pay policy given for a work schedule exists, 0 clears the policy
+func (s *WorkScheduleServiceImpl) checkPayPolicy(ctx context.Context, payPolicyID *uint64) error {
+	if payPolicyID == nil || *payPolicyID == 0 {
+		return nil
+	}
+
+	if _, err := s.workScheduleRepo.GetPayPolicyByID(ctx, *payPolicyID); err != nil {
+		return fmt.Errorf("attendance pay policy not found: %w", err)
+	}
+
+	return nil
+}
+
+// mapToAttendancePayPolicyResponse maps an ent.AttendancePayPolicy to dto.AttendancePayPolicyResponse
+func (s *WorkScheduleServiceImpl) mapToAttendancePayPolicyResponse(policy *ent.AttendancePayPolicy) *dto.AttendancePayPolicyResponse {
+	return &dto.AttendancePayPolicyResponse{
+		ID:                  policy.ID,
+		Name:                policy.Name,
+		PresentWeight:       policy.PresentWeight,
+		LateWeight:          policy.LateWeight,
+		HalfDayWeight:       policy.HalfDayWeight,
+		LatePenaltyAmount:   policy.LatePenaltyAmount,
+		LatePenaltyFreeDays: policy.LatePenaltyFreeDays,
+		IsDefault:           policy.IsDefault,
+		CreatedAt:           policy.CreatedAt,
+		ModifiedAt:          policy.ModifiedAt,
+	}
+}
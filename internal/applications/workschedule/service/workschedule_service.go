@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/applications/workschedule/dto"
+	"mceasy/internal/applications/workschedule/repository"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+)
+
+// WorkScheduleService defines the interface for work schedule business logic
+type WorkScheduleService interface {
+	CreateWorkSchedule(ctx context.Context, req *dto.CreateWorkScheduleRequest) (*dto.WorkScheduleResponse, error)
+	GetWorkScheduleByID(ctx context.Context, id uint64) (*dto.WorkScheduleResponse, error)
+	UpdateWorkSchedule(ctx context.Context, id uint64, req *dto.UpdateWorkScheduleRequest) (*dto.WorkScheduleResponse, error)
+	DeleteWorkSchedule(ctx context.Context, id uint64) error
+	ListWorkSchedules(ctx context.Context, params *dto.WorkScheduleQueryParams) (*dto.WorkScheduleListResponse, error)
+	AssignShift(ctx context.Context, req *dto.AssignShiftRequest) (*dto.ShiftAssignmentResponse, error)
+	DeleteShiftAssignment(ctx context.Context, id uint64) error
+	ListShiftAssignments(ctx context.Context, params *dto.ShiftAssignmentQueryParams) (*dto.ShiftAssignmentListResponse, error)
+	GetEmployeeSchedule(ctx context.Context, employeeID uint64, date time.Time) (*dto.EmployeeScheduleResponse, error)
+	CreateAttendancePayPolicy(ctx context.Context, req *dto.CreateAttendancePayPolicyRequest) (*dto.AttendancePayPolicyResponse, error)
+	GetAttendancePayPolicyByID(ctx context.Context, id uint64) (*dto.AttendancePayPolicyResponse, error)
+	UpdateAttendancePayPolicy(ctx context.Context, id uint64, req *dto.UpdateAttendancePayPolicyRequest) (*dto.AttendancePayPolicyResponse, error)
+	DeleteAttendancePayPolicy(ctx context.Context, id uint64) error
+	ListAttendancePayPolicies(ctx context.Context, params *dto.AttendancePayPolicyQueryParams) (*dto.AttendancePayPolicyListResponse, error)
+}
+
+// WorkScheduleServiceImpl implements the WorkScheduleService interface
+type WorkScheduleServiceImpl struct {
+	workScheduleRepo repository.WorkScheduleRepository
+	calendar         calendar.WorkingDayCalendar
+	cache            cache.Cache
+	trx              transaction.Trx
+}
+
+// NewWorkScheduleService creates a new work schedule service instance
+func NewWorkScheduleService(
+	workScheduleRepo repository.WorkScheduleRepository,
+	calendar calendar.WorkingDayCalendar,
+	cache cache.Cache,
+	trx transaction.Trx,
+) *WorkScheduleServiceImpl {
+	return &WorkScheduleServiceImpl{
+		workScheduleRepo: workScheduleRepo,
+		calendar:         calendar,
+		cache:            cache,
+		trx:              trx,
+	}
+}
+
+// CreateWorkSchedule creates a new work schedule
+func (s *WorkScheduleServiceImpl) CreateWorkSchedule(ctx context.Context, req *dto.CreateWorkScheduleRequest) (*dto.WorkScheduleResponse, error) {
+	if err := validateShiftTimes(req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	// Schedule names must be unique
+	existing, _ := s.workScheduleRepo.GetByName(ctx, req.Name)
+	if existing != nil {
+		return nil, fmt.Errorf("work schedule %s already exists", req.Name)
+	}
+
+	if req.AttendancePayPolicyID != nil && *req.AttendancePayPolicyID == 0 {
+		req.AttendancePayPolicyID = nil
+	}
+	if err := s.checkPayPolicy(ctx, req.AttendancePayPolicyID); err != nil {
+		return nil, err
+	}
+
+	workSchedule, err := s.workScheduleRepo.Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create work schedule: %w", err)
+	}
+
+	if req.IsDefault {
+		if err := s.workScheduleRepo.SetDefault(ctx, workSchedule.ID); err != nil {
+			return nil, err
+		}
+		workSchedule.IsDefault = true
+	}
+
+	return s.mapToWorkScheduleResponse(workSchedule), nil
+}
+
+// GetWorkScheduleByID retrieves a work schedule by ID
+func (s *WorkScheduleServiceImpl) GetWorkScheduleByID(ctx context.Context, id uint64) (*dto.WorkScheduleResponse, error) {
+	workSchedule, err := s.workScheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("work schedule not found: %w", err)
+	}
+
+	return s.mapToWorkScheduleResponse(workSchedule), nil
+}
+
+// UpdateWorkSchedule updates a work schedule
+func (s *WorkScheduleServiceImpl) UpdateWorkSchedule(ctx context.Context, id uint64, req *dto.UpdateWorkScheduleRequest) (*dto.WorkScheduleResponse, error) {
+	// Check if work schedule exists
+	current, err := s.workScheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("work schedule not found: %w", err)
+	}
+
+	startTime, endTime := current.StartTime, current.EndTime
+	if req.StartTime != "" {
+		startTime = req.StartTime
+	}
+	if req.EndTime != "" {
+		endTime = req.EndTime
+	}
+	if err := validateShiftTimes(startTime, endTime); err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" && req.Name != current.Name {
+		existing, _ := s.workScheduleRepo.GetByName(ctx, req.Name)
+		if existing != nil {
+			return nil, fmt.Errorf("work schedule %s already exists", req.Name)
+		}
+	}
+
+	if err := s.checkPayPolicy(ctx, req.AttendancePayPolicyID); err != nil {
+		return nil, err
+	}
+
+	workSchedule, err := s.workScheduleRepo.Update(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update work schedule: %w", err)
+	}
+
+	if req.IsDefault != nil && *req.IsDefault {
+		if err := s.workScheduleRepo.SetDefault(ctx, id); err != nil {
+			return nil, err
+		}
+		workSchedule.IsDefault = true
+	}
+
+	return s.mapToWorkScheduleResponse(workSchedule), nil
+}
+
+// DeleteWorkSchedule soft deletes a work schedule that is no longer assigned
+func (s *WorkScheduleServiceImpl) DeleteWorkSchedule(ctx context.Context, id uint64) error {
+	// Check if work schedule exists
+	_, err := s.workScheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("work schedule not found: %w", err)
+	}
+
+	assigned, err := s.workScheduleRepo.CountActiveAssignments(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count shift assignments: %w", err)
+	}
+	if assigned > 0 {
+		return fmt.Errorf("work schedule is still assigned to %d shift assignments", assigned)
+	}
+
+	err = s.workScheduleRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete work schedule: %w", err)
+	}
+
+	return nil
+}
+
+// ListWorkSchedules retrieves work schedules with pagination
+func (s *WorkScheduleServiceImpl) ListWorkSchedules(ctx context.Context, params *dto.WorkScheduleQueryParams) (*dto.WorkScheduleListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	schedules, total, err := s.workScheduleRepo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list work schedules: %w", err)
+	}
+
+	scheduleResponses := make([]dto.WorkScheduleResponse, len(schedules))
+	for i, workSchedule := range schedules {
+		scheduleResponses[i] = *s.mapToWorkScheduleResponse(workSchedule)
+	}
+
+	return &dto.WorkScheduleListResponse{
+		WorkSchedules: scheduleResponses,
+		Total:         total,
+		Page:          params.Page,
+		Limit:         params.Limit,
+	}, nil
+}
+
+// AssignShift assigns a work schedule to an employee, the latest effective assignment wins
+func (s *WorkScheduleServiceImpl) AssignShift(ctx context.Context, req *dto.AssignShiftRequest) (*dto.ShiftAssignmentResponse, error) {
+	_, err := s.workScheduleRepo.GetByID(ctx, req.WorkScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("work schedule not found: %w", err)
+	}
+
+	effectiveDate, err := parseScheduleDate(req.EffectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var endDate *time.Time
+	if req.EndDate != "" {
+		parsed, err := parseScheduleDate(req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if parsed.Before(effectiveDate) {
+			return nil, fmt.Errorf("end date cannot be before effective date")
+		}
+		endDate = &parsed
+	}
+
+	assignment, err := s.workScheduleRepo.CreateAssignment(ctx, req, effectiveDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign work schedule: %w", err)
+	}
+
+	return s.mapToShiftAssignmentResponse(assignment), nil
+}
+
+// DeleteShiftAssignment soft deletes a shift assignment
+func (s *WorkScheduleServiceImpl) DeleteShiftAssignment(ctx context.Context, id uint64) error {
+	// Check if shift assignment exists
+	_, err := s.workScheduleRepo.GetAssignmentByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("shift assignment not found: %w", err)
+	}
+
+	err = s.workScheduleRepo.DeleteAssignment(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete shift assignment: %w", err)
+	}
+
+	return nil
+}
+
+// ListShiftAssignments retrieves shift assignments with pagination and filtering
+func (s *WorkScheduleServiceImpl) ListShiftAssignments(ctx context.Context, params *dto.ShiftAssignmentQueryParams) (*dto.ShiftAssignmentListResponse, error) {
+	// Set default pagination if not provided
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	assignments, total, err := s.workScheduleRepo.ListAssignments(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shift assignments: %w", err)
+	}
+
+	assignmentResponses := make([]dto.ShiftAssignmentResponse, len(assignments))
+	for i, assignment := range assignments {
+		assignmentResponses[i] = *s.mapToShiftAssignmentResponse(assignment)
+	}
+
+	return &dto.ShiftAssignmentListResponse{
+		ShiftAssignments: assignmentResponses,
+		Total:            total,
+		Page:             params.Page,
+		Limit:            params.Limit,
+	}, nil
+}
+
+// GetEmployeeSchedule resolves the work schedule that applies to an employee on a date
+func (s *WorkScheduleServiceImpl) GetEmployeeSchedule(ctx context.Context, employeeID uint64, date time.Time) (*dto.EmployeeScheduleResponse, error) {
+	if date.IsZero() {
+		loc, err := s.calendar.GetEmployeeLocation(ctx, employeeID)
+		if err != nil {
+			return nil, err
+		}
+		date = calendar.Today(loc)
+	}
+
+	schedule, err := s.calendar.GetEmployeeSchedule(ctx, employeeID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve work schedule: %w", err)
+	}
+
+	workingDay, err := s.calendar.IsEmployeeWorkingDay(ctx, employeeID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check working day: %w", err)
+	}
+
+	workingDays := make([]int, len(schedule.WorkingDays))
+	for i, day := range schedule.WorkingDays {
+		workingDays[i] = int(day)
+	}
+
+	return &dto.EmployeeScheduleResponse{
+		EmployeeID:              employeeID,
+		Date:                    date,
+		WorkScheduleID:          schedule.WorkScheduleID,
+		Name:                    schedule.Name,
+		StartTime:               schedule.StartTime,
+		EndTime:                 schedule.EndTime,
+		WorkingDays:             workingDays,
+		LateThresholdMinutes:    schedule.LateThresholdMinutes,
+		HalfDayThresholdMinutes: schedule.HalfDayThresholdMinutes,
+		AttendancePayPolicyID:   schedule.AttendancePayPolicyID,
+		IsWorkingDay:            workingDay,
+	}, nil
+}
+
+// mapToWorkScheduleResponse maps an ent.WorkSchedule to dto.WorkScheduleResponse
+func (s *WorkScheduleServiceImpl) mapToWorkScheduleResponse(workSchedule *ent.WorkSchedule) *dto.WorkScheduleResponse {
+	return &dto.WorkScheduleResponse{
+		ID:                      workSchedule.ID,
+		Name:                    workSchedule.Name,
+		StartTime:               workSchedule.StartTime,
+		EndTime:                 workSchedule.EndTime,
+		WorkingDays:             workSchedule.WorkingDays,
+		LateThresholdMinutes:    workSchedule.LateThresholdMinutes,
+		HalfDayThresholdMinutes: workSchedule.HalfDayThresholdMinutes,
+		IsDefault:               workSchedule.IsDefault,
+		AttendancePayPolicyID:   workSchedule.AttendancePayPolicyID,
+		CreatedAt:               workSchedule.CreatedAt,
+		ModifiedAt:              workSchedule.ModifiedAt,
+	}
+}
+
+// mapToShiftAssignmentResponse maps an ent.ShiftAssignment to dto.ShiftAssignmentResponse
+func (s *WorkScheduleServiceImpl) mapToShiftAssignmentResponse(assignment *ent.ShiftAssignment) *dto.ShiftAssignmentResponse {
+	response := &dto.ShiftAssignmentResponse{
+		ID:             assignment.ID,
+		EmployeeID:     assignment.EmployeeID,
+		WorkScheduleID: assignment.WorkScheduleID,
+		EffectiveDate:  assignment.EffectiveDate,
+		EndDate:        assignment.EndDate,
+		CreatedAt:      assignment.CreatedAt,
+		ModifiedAt:     assignment.ModifiedAt,
+	}
+
+	// Add employee and schedule information if available
+	if assignment.Edges.Employee != nil {
+		response.EmployeeName = assignment.Edges.Employee.FullName
+	}
+	if assignment.Edges.WorkSchedule != nil {
+		response.WorkScheduleName = assignment.Edges.WorkSchedule.Name
+	}
+
+	return response
+}
+
+// validateShiftTimes checks that start and end are valid HH:MM clock times.
+// An end before the start is an overnight shift ending on the next day.
+func validateShiftTimes(startTime, endTime string) error {
+	if _, _, err := calendar.ParseClock(startTime); err != nil {
+		return err
+	}
+	if _, _, err := calendar.ParseClock(endTime); err != nil {
+		return err
+	}
+	if startTime == endTime {
+		return fmt.Errorf("end time %s must differ from start time %s", endTime, startTime)
+	}
+	return nil
+}
+
+// parseScheduleDate parses date string in format "2006-01-02"
+func parseScheduleDate(dateStr string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", dateStr)
+	}
+
+	return date, nil
+}
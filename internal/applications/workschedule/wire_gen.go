@@ -0,0 +1,33 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package workschedule
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"mceasy/ent"
+	"mceasy/internal/applications/workschedule/repository"
+	"mceasy/internal/applications/workschedule/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+)
+
+// Injectors from workschedule_injector.go:
+
+func InitializedWorkScheduleService(dbClient *ent.Client, redisClient *redis.Client) *service.WorkScheduleServiceImpl {
+	workScheduleRepositoryImpl := repository.NewWorkScheduleRepository(dbClient)
+	workingDayCalendarImpl := calendar.NewWorkingDayCalendar(dbClient)
+	cacheImpl := cache.NewCache(redisClient)
+	trxImpl := transaction.NewTrx(dbClient)
+	workScheduleServiceImpl := service.NewWorkScheduleService(workScheduleRepositoryImpl, workingDayCalendarImpl, cacheImpl, trxImpl)
+	return workScheduleServiceImpl
+}
+
+// workschedule_injector.go:
+
+var providerWorkSchedule = wire.NewSet(repository.NewWorkScheduleRepository, calendar.NewWorkingDayCalendar, transaction.NewTrx, service.NewWorkScheduleService, cache.NewCache, wire.Bind(new(repository.WorkScheduleRepository), new(*repository.WorkScheduleRepositoryImpl)), wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)), wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)), wire.Bind(new(cache.Cache), new(*cache.CacheImpl)), wire.Bind(new(service.WorkScheduleService), new(*service.WorkScheduleServiceImpl)))
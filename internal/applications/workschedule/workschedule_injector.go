@@ -0,0 +1,36 @@
+//go:generate wire
+//go:build wireinject
+// +build wireinject
+
+package workschedule
+
+import (
+	"mceasy/ent"
+	"mceasy/internal/applications/workschedule/repository"
+	"mceasy/internal/applications/workschedule/service"
+	"mceasy/internal/component/cache"
+	"mceasy/internal/component/calendar"
+	"mceasy/internal/component/transaction"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+)
+
+var providerWorkSchedule = wire.NewSet(
+	repository.NewWorkScheduleRepository,
+	calendar.NewWorkingDayCalendar,
+	transaction.NewTrx,
+	service.NewWorkScheduleService,
+	cache.NewCache,
+
+	wire.Bind(new(repository.WorkScheduleRepository), new(*repository.WorkScheduleRepositoryImpl)),
+	wire.Bind(new(calendar.WorkingDayCalendar), new(*calendar.WorkingDayCalendarImpl)),
+	wire.Bind(new(transaction.Trx), new(*transaction.TrxImpl)),
+	wire.Bind(new(cache.Cache), new(*cache.CacheImpl)),
+	wire.Bind(new(service.WorkScheduleService), new(*service.WorkScheduleServiceImpl)),
+)
+
+func InitializedWorkScheduleService(dbClient *ent.Client, redisClient *redis.Client) *service.WorkScheduleServiceImpl {
+	wire.Build(providerWorkSchedule)
+	return nil
+}
@@ -0,0 +1,271 @@
+package biometric
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PunchState is the in/out state a fingerprint terminal records with a punch
+type PunchState int
+
+const (
+	// StateUnknown is used when the log has no state column or the terminal did not record one
+	StateUnknown     PunchState = -1
+	StateCheckIn     PunchState = 0
+	StateCheckOut    PunchState = 1
+	StateBreakOut    PunchState = 2
+	StateBreakIn     PunchState = 3
+	StateOvertimeIn  PunchState = 4
+	StateOvertimeOut PunchState = 5
+)
+
+// sniffSize is how much of the log is read ahead to find the delimiter of the first line
+const sniffSize = 4096
+
+// timestampLayouts are the timestamp formats exported by the terminals, without a zone
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+}
+
+// Punch is a single line of a punch log
+type Punch struct {
+	Line int
+	PIN  string
+	// Time is the wall clock time of the terminal, stored in UTC until the employee's timezone is known
+	Time       time.Time
+	VerifyMode int
+	State      PunchState
+}
+
+// LineError reports a line of a punch log that could not be read
+type LineError struct {
+	Line    int
+	Message string
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// PunchLog is the content of a punch log file
+type PunchLog struct {
+	Lines   int
+	Punches []Punch
+	Errors  []LineError
+}
+
+// logColumns tells where verify mode and in/out state are, user PIN and timestamp are always the first two
+type logColumns struct {
+	verifyMode int
+	state      int
+}
+
+var (
+	// exportColumns is the column order of the logs exported to files
+	exportColumns = logColumns{verifyMode: 2, state: 3}
+
+	// attLogColumns is the column order of ATTLOG records pushed with the ADMS protocol
+	attLogColumns = logColumns{verifyMode: 3, state: 2}
+)
+
+// ParseLog reads a CSV or TSV punch log with the columns user PIN, timestamp, verify mode and in/out state.
+// Verify mode and state are optional, further columns such as the work code are ignored. A header line is
+// skipped, lines that cannot be read are reported instead of failing the whole log.
+func ParseLog(r io.Reader) (*PunchLog, error) {
+	return parseLog(r, exportColumns)
+}
+
+// ParseAttLog reads ATTLOG records pushed by a terminal with the ADMS protocol, tab separated with the columns
+// user PIN, timestamp, in/out state, verify mode and work code
+func ParseAttLog(r io.Reader) (*PunchLog, error) {
+	return parseLog(r, attLogColumns)
+}
+
+func parseLog(r io.Reader, columns logColumns) (*PunchLog, error) {
+	reader := bufio.NewReaderSize(r, sniffSize)
+
+	delimiter, err := sniffDelimiter(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	records := csv.NewReader(reader)
+	records.Comma = delimiter
+	records.FieldsPerRecord = -1
+	records.LazyQuotes = true
+	records.TrimLeadingSpace = true
+	records.ReuseRecord = true
+
+	log := &PunchLog{}
+	for {
+		record, err := records.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := records.FieldPos(0)
+		if err != nil {
+			log.Errors = append(log.Errors, LineError{Line: line, Message: err.Error()})
+			continue
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		log.Lines++
+		punch, err := parseRecord(record, columns)
+		if err != nil {
+			// The first line may be a header naming the columns
+			if log.Lines == 1 && isHeader(record) {
+				log.Lines--
+				continue
+			}
+			log.Errors = append(log.Errors, LineError{Line: line, Message: err.Error()})
+			continue
+		}
+
+		punch.Line = line
+		log.Punches = append(log.Punches, *punch)
+	}
+
+	return log, nil
+}
+
+// Dedupe drops punches repeated by an employee with the same state within window of the previous kept punch,
+// e.g. a finger placed twice or the same log exported twice. Punches must be in file order, the result is
+// sorted by PIN and time.
+func Dedupe(punches []Punch, window time.Duration) (kept []Punch, duplicates int) {
+	sorted := make([]Punch, len(punches))
+	copy(sorted, punches)
+	sortPunches(sorted)
+
+	last := make(map[string]Punch)
+	for _, punch := range sorted {
+		if previous, ok := last[punch.PIN]; ok && previous.State == punch.State && punch.Time.Sub(previous.Time) <= window {
+			duplicates++
+			continue
+		}
+		last[punch.PIN] = punch
+		kept = append(kept, punch)
+	}
+
+	return kept, duplicates
+}
+
+// sniffDelimiter picks tab, semicolon or comma from the first line that is not empty
+func sniffDelimiter(reader *bufio.Reader) (rune, error) {
+	peeked, err := reader.Peek(sniffSize)
+	if err != nil && err != io.EOF {
+		return 0, fmt.Errorf("failed to read punch log: %w", err)
+	}
+
+	line := strings.TrimLeft(string(peeked), "\ufeff\r\n")
+	if end := strings.IndexAny(line, "\r\n"); end >= 0 {
+		line = line[:end]
+	}
+
+	switch {
+	case strings.Contains(line, "\t"):
+		return '\t', nil
+	case strings.Contains(line, ";"):
+		return ';', nil
+	default:
+		return ',', nil
+	}
+}
+
+func parseRecord(record []string, columns logColumns) (*Punch, error) {
+	if len(record) < 2 {
+		return nil, fmt.Errorf("expected at least user PIN and timestamp, got %d columns", len(record))
+	}
+
+	pin := strings.TrimPrefix(strings.TrimSpace(record[0]), "\ufeff")
+	if pin == "" {
+		return nil, fmt.Errorf("user PIN is empty")
+	}
+
+	punchTime, err := parseTimestamp(strings.TrimSpace(record[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	punch := &Punch{PIN: pin, Time: punchTime, State: StateUnknown}
+
+	if value := column(record, columns.verifyMode); value != "" {
+		punch.VerifyMode, err = strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verify mode %q", value)
+		}
+	}
+
+	if value := column(record, columns.state); value != "" {
+		state, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid in/out state %q", value)
+		}
+		if state >= int(StateCheckIn) && state <= int(StateOvertimeOut) {
+			punch.State = PunchState(state)
+		}
+	}
+
+	return punch, nil
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp %q, expected YYYY-MM-DD HH:MM:SS", value)
+}
+
+// sortPunches orders punches by PIN and time, keeping the file order of punches at the same time
+func sortPunches(punches []Punch) {
+	sort.SliceStable(punches, func(i, j int) bool {
+		if punches[i].PIN != punches[j].PIN {
+			return punches[i].PIN < punches[j].PIN
+		}
+		return punches[i].Time.Before(punches[j].Time)
+	})
+}
+
+func column(record []string, index int) string {
+	if index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func isHeader(record []string) bool {
+	if len(record) < 2 {
+		return false
+	}
+	_, err := strconv.Atoi(strings.TrimSpace(record[0]))
+	if err == nil {
+		return false
+	}
+	_, err = parseTimestamp(strings.TrimSpace(record[1]))
+	return err != nil
+}
@@ -0,0 +1,67 @@
+package biometric
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLog(t *testing.T) {
+	t.Run("tab separated log without header", func(t *testing.T) {
+		content := "1001\t2025-08-11 08:55:02\t1\t0\t0\n" +
+			"1001\t2025-08-11 17:04:40\t1\t1\t0\n"
+
+		log, err := ParseLog(strings.NewReader(content))
+		require.NoError(t, err)
+		assert.Empty(t, log.Errors)
+		require.Len(t, log.Punches, 2)
+
+		assert.Equal(t, "1001", log.Punches[0].PIN)
+		assert.Equal(t, time.Date(2025, time.August, 11, 8, 55, 2, 0, time.UTC), log.Punches[0].Time)
+		assert.Equal(t, 1, log.Punches[0].VerifyMode)
+		assert.Equal(t, StateCheckIn, log.Punches[0].State)
+		assert.Equal(t, StateCheckOut, log.Punches[1].State)
+		assert.Equal(t, 2, log.Punches[1].Line)
+	})
+
+	t.Run("comma separated log with header and bad lines", func(t *testing.T) {
+		content := "\ufeffPIN,DateTime,Verify,State\r\n" +
+			"1001,2025/08/11 08:55,15,0\r\n" +
+			"1002,yesterday,1,0\r\n" +
+			"\r\n" +
+			"1003,2025-08-11 09:01:00\r\n"
+
+		log, err := ParseLog(strings.NewReader(content))
+		require.NoError(t, err)
+		assert.Equal(t, 3, log.Lines)
+		require.Len(t, log.Punches, 2)
+		assert.Equal(t, StateUnknown, log.Punches[1].State)
+
+		require.Len(t, log.Errors, 1)
+		assert.Equal(t, 3, log.Errors[0].Line)
+		assert.Contains(t, log.Errors[0].Message, "invalid timestamp")
+	})
+}
+
+func TestDedupe(t *testing.T) {
+	at := func(minute, second int) time.Time {
+		return time.Date(2025, time.August, 11, 8, minute, second, 0, time.UTC)
+	}
+
+	kept, duplicates := Dedupe([]Punch{
+		{PIN: "1001", Time: at(55, 0), State: StateCheckIn},
+		{PIN: "1002", Time: at(56, 0), State: StateCheckIn},
+		{PIN: "1001", Time: at(55, 20), State: StateCheckIn},
+		{PIN: "1001", Time: at(55, 0), State: StateCheckIn},
+		{PIN: "1001", Time: at(59, 0), State: StateCheckIn},
+	}, time.Minute)
+
+	assert.Equal(t, 2, duplicates)
+	require.Len(t, kept, 3)
+	assert.Equal(t, at(55, 0), kept[0].Time)
+	assert.Equal(t, at(59, 0), kept[1].Time)
+	assert.Equal(t, "1002", kept[2].PIN)
+}
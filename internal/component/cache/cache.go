@@ -18,6 +18,7 @@ type Cache interface {
 	UpdateWithOptimisticLock(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	CreateRawString(ctx context.Context, key string, value string, expiration time.Duration) (bool, error)
 	GetRawString(ctx context.Context, key string) (string, error)
+	Increment(ctx context.Context, key string, expiration time.Duration) (int64, error)
 	RawUpdateWithOptimisticLock(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	GetRedisClient() *redis.Client
 }
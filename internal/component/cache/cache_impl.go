@@ -55,6 +55,25 @@ func (c *CacheImpl) GetRawString(ctx context.Context, key string) (string, error
 	return val, nil
 }
 
+// Increment adds one to the counter stored at key and returns the new count. The expiration starts with the
+// first increment, so the counter counts within a fixed window.
+func (c *CacheImpl) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	count, err := c.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		log.Errorf("Failed to increment counter on Redis: %s # err %s", key, err)
+		return 0, err
+	}
+
+	if count == 1 {
+		if err := c.redisClient.Expire(ctx, key, expiration).Err(); err != nil {
+			log.Errorf("Failed to set counter expiration on Redis: %s # err %s", key, err)
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
 func (c *CacheImpl) Create(ctx context.Context, key string, data interface{}, expiration time.Duration) (bool, error) {
 
 	serializedData, err := msgpack.Marshal(&data)
@@ -70,6 +70,20 @@ func TestCachingImpl(t *testing.T) {
 		}
 	})
 
+	// Test method Increment
+	t.Run("Increment", func(t *testing.T) {
+		for want := int64(1); want <= 3; want++ {
+			count, err := cachingService.Increment(context.Background(), "myCounter", expiration)
+			assert.NoError(t, err)
+			assert.Equal(t, want, count)
+		}
+
+		raw, err := cachingService.GetRawString(context.Background(), "myCounter")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", raw)
+		assert.Equal(t, expiration, mockRedis.TTL("myCounter"))
+	})
+
 }
 
 func TestCachingServiceImpl_failureConnection(t *testing.T) {
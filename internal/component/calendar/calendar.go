@@ -0,0 +1,37 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"mceasy/ent"
+)
+
+// WorkingDayCalendar is the single source of truth for deciding whether a date is a working day.
+// Salary, attendance validation, the dashboard and the auto-absent job all read from it.
+// The Employee variants additionally honour the work schedule assigned to the employee.
+type WorkingDayCalendar interface {
+	IsWorkingDay(ctx context.Context, date time.Time) (bool, error)
+	GetHoliday(ctx context.Context, date time.Time) (*ent.Holiday, error)
+	GetHolidays(ctx context.Context, startDate, endDate time.Time) ([]*ent.Holiday, error)
+	GetWorkingDays(ctx context.Context, startDate, endDate time.Time) ([]time.Time, error)
+	CountWorkingDays(ctx context.Context, startDate, endDate time.Time) (int, error)
+	GetEmployeeSchedule(ctx context.Context, employeeID uint64, date time.Time) (*Schedule, error)
+	GetEmployeeScheduleResolver(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (func(time.Time) *Schedule, error)
+	GetEmployeeShiftDate(ctx context.Context, employeeID uint64, punch time.Time) (time.Time, error)
+	GetEmployeeLocation(ctx context.Context, employeeID uint64) (*time.Location, error)
+	IsEmployeeWorkingDay(ctx context.Context, employeeID uint64, date time.Time) (bool, error)
+	GetEmployeeWorkingDays(ctx context.Context, employeeID uint64, startDate, endDate time.Time) ([]time.Time, error)
+	CountEmployeeWorkingDays(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (int, error)
+}
+
+// IsWeekend checks if the given date is a weekend (Saturday or Sunday)
+func IsWeekend(date time.Time) bool {
+	weekday := date.Weekday()
+	return weekday == time.Saturday || weekday == time.Sunday
+}
+
+// DateKey formats a date as YYYY-MM-DD so dates can be compared regardless of location
+func DateKey(date time.Time) string {
+	return date.Format("2006-01-02")
+}
@@ -0,0 +1,299 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/employee"
+	"mceasy/ent/holiday"
+	"mceasy/ent/shiftassignment"
+	"mceasy/ent/workschedule"
+	"mceasy/internal/helper"
+)
+
+type WorkingDayCalendarImpl struct {
+	client *ent.Client
+}
+
+func NewWorkingDayCalendar(client *ent.Client) *WorkingDayCalendarImpl {
+	return &WorkingDayCalendarImpl{client: client}
+}
+
+// IsWorkingDay returns false for weekends and registered holidays
+func (c *WorkingDayCalendarImpl) IsWorkingDay(ctx context.Context, date time.Time) (bool, error) {
+	if IsWeekend(date) {
+		return false, nil
+	}
+
+	found, err := c.GetHoliday(ctx, date)
+	if err != nil {
+		return false, err
+	}
+
+	return found == nil, nil
+}
+
+// GetHoliday returns the holiday registered on the given date, or nil when the date is not a holiday
+func (c *WorkingDayCalendarImpl) GetHoliday(ctx context.Context, date time.Time) (*ent.Holiday, error) {
+	holidays, err := c.GetHolidays(ctx, date, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(holidays) == 0 {
+		return nil, nil
+	}
+
+	return holidays[0], nil
+}
+
+// GetHolidays returns all holidays between startDate and endDate (inclusive)
+func (c *WorkingDayCalendarImpl) GetHolidays(ctx context.Context, startDate, endDate time.Time) ([]*ent.Holiday, error) {
+	// Widen the query by a day on both sides so DATE columns stored in another location are not missed,
+	// then filter on the calendar date itself.
+	from := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location()).AddDate(0, 0, -1)
+	to := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).AddDate(0, 0, 1)
+
+	records, err := c.client.Holiday.
+		Query().
+		Where(holiday.HolidayDateGTE(from)).
+		Where(holiday.HolidayDateLTE(to)).
+		Where(holiday.DeletedAtIsNil()).
+		Order(ent.Asc(holiday.FieldHolidayDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+
+	startKey, endKey := DateKey(startDate), DateKey(endDate)
+
+	var holidays []*ent.Holiday
+	for _, record := range records {
+		key := DateKey(record.HolidayDate)
+		if key >= startKey && key <= endKey {
+			holidays = append(holidays, record)
+		}
+	}
+
+	return holidays, nil
+}
+
+// GetWorkingDays lists every working day between startDate and endDate (inclusive)
+func (c *WorkingDayCalendarImpl) GetWorkingDays(ctx context.Context, startDate, endDate time.Time) ([]time.Time, error) {
+	holidays, err := c.GetHolidays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	holidayDates := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		holidayDates[DateKey(h.HolidayDate)] = true
+	}
+
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location())
+
+	var workingDays []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if IsWeekend(d) || holidayDates[DateKey(d)] {
+			continue
+		}
+		workingDays = append(workingDays, d)
+	}
+
+	return workingDays, nil
+}
+
+// CountWorkingDays counts working days between startDate and endDate (inclusive)
+func (c *WorkingDayCalendarImpl) CountWorkingDays(ctx context.Context, startDate, endDate time.Time) (int, error) {
+	workingDays, err := c.GetWorkingDays(ctx, startDate, endDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(workingDays), nil
+}
+
+// GetEmployeeSchedule resolves the work schedule assigned to an employee on the given date.
+// Employees without an active assignment fall back to the default work schedule.
+func (c *WorkingDayCalendarImpl) GetEmployeeSchedule(ctx context.Context, employeeID uint64, date time.Time) (*Schedule, error) {
+	resolve, err := c.scheduleResolver(ctx, employeeID, date, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolve(date), nil
+}
+
+// GetEmployeeScheduleResolver loads the shift assignments of an employee between startDate and endDate once and
+// returns a function resolving the work schedule of a date in that range, for callers looking up many dates
+func (c *WorkingDayCalendarImpl) GetEmployeeScheduleResolver(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (func(time.Time) *Schedule, error) {
+	return c.scheduleResolver(ctx, employeeID, startDate, endDate)
+}
+
+// GetEmployeeShiftDate returns the date of the shift a punch belongs to. A punch made after midnight
+// but before the end of the previous day's overnight shift belongs to the previous day.
+// The punch is read in the employee's timezone and the date is returned as UTC midnight.
+func (c *WorkingDayCalendarImpl) GetEmployeeShiftDate(ctx context.Context, employeeID uint64, punch time.Time) (time.Time, error) {
+	loc, err := c.GetEmployeeLocation(ctx, employeeID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date := DateIn(punch, loc)
+	yesterday := date.AddDate(0, 0, -1)
+
+	resolve, err := c.scheduleResolver(ctx, employeeID, yesterday, date)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	previous := resolve(yesterday)
+	if previous.IsOvernight() && previous.IsWorkingWeekday(yesterday) &&
+		punch.Before(previous.EndOn(yesterday)) && punch.Before(resolve(date).StartOn(date)) {
+		return yesterday, nil
+	}
+
+	return date, nil
+}
+
+// GetEmployeeLocation resolves the timezone of an employee, unknown employees get the application timezone
+func (c *WorkingDayCalendarImpl) GetEmployeeLocation(ctx context.Context, employeeID uint64) (*time.Location, error) {
+	record, err := c.client.Employee.
+		Query().
+		Where(employee.ID(employeeID)).
+		WithOfficeLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return helper.ApplicationLocation(), nil
+		}
+		return nil, fmt.Errorf("failed to fetch employee timezone: %w", err)
+	}
+
+	return EmployeeLocation(record), nil
+}
+
+// IsEmployeeWorkingDay returns false for the employee's rest days and registered holidays
+func (c *WorkingDayCalendarImpl) IsEmployeeWorkingDay(ctx context.Context, employeeID uint64, date time.Time) (bool, error) {
+	workingDays, err := c.GetEmployeeWorkingDays(ctx, employeeID, date, date)
+	if err != nil {
+		return false, err
+	}
+
+	return len(workingDays) > 0, nil
+}
+
+// GetEmployeeWorkingDays lists every working day of the employee between startDate and endDate (inclusive)
+func (c *WorkingDayCalendarImpl) GetEmployeeWorkingDays(ctx context.Context, employeeID uint64, startDate, endDate time.Time) ([]time.Time, error) {
+	resolve, err := c.scheduleResolver(ctx, employeeID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := c.GetHolidays(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	holidayDates := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		holidayDates[DateKey(h.HolidayDate)] = true
+	}
+
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location())
+
+	var workingDays []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !resolve(d).IsWorkingWeekday(d) || holidayDates[DateKey(d)] {
+			continue
+		}
+		workingDays = append(workingDays, d)
+	}
+
+	return workingDays, nil
+}
+
+// CountEmployeeWorkingDays counts working days of the employee between startDate and endDate (inclusive)
+func (c *WorkingDayCalendarImpl) CountEmployeeWorkingDays(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (int, error) {
+	workingDays, err := c.GetEmployeeWorkingDays(ctx, employeeID, startDate, endDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(workingDays), nil
+}
+
+// scheduleResolver loads every shift assignment of the employee overlapping the date range once
+// and returns a function resolving the schedule for a single date within that range
+func (c *WorkingDayCalendarImpl) scheduleResolver(ctx context.Context, employeeID uint64, startDate, endDate time.Time) (func(time.Time) *Schedule, error) {
+	from := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location()).AddDate(0, 0, -1)
+	to := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, endDate.Location()).AddDate(0, 0, 1)
+
+	assignments, err := c.client.ShiftAssignment.
+		Query().
+		Where(shiftassignment.EmployeeID(employeeID)).
+		Where(shiftassignment.DeletedAtIsNil()).
+		Where(shiftassignment.EffectiveDateLTE(to)).
+		Where(shiftassignment.Or(
+			shiftassignment.EndDateIsNil(),
+			shiftassignment.EndDateGTE(from),
+		)).
+		Where(shiftassignment.HasWorkScheduleWith(workschedule.DeletedAtIsNil())).
+		WithWorkSchedule().
+		Order(ent.Desc(shiftassignment.FieldEffectiveDate), ent.Desc(shiftassignment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch shift assignments: %w", err)
+	}
+
+	fallback, err := c.defaultSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shift times are read in the employee's timezone
+	loc, err := c.GetEmployeeLocation(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	fallback.Location = loc
+
+	return func(date time.Time) *Schedule {
+		key := DateKey(date)
+		// Assignments are ordered newest first, so the latest effective assignment wins
+		for _, assignment := range assignments {
+			if DateKey(assignment.EffectiveDate) > key {
+				continue
+			}
+			if assignment.EndDate != nil && DateKey(*assignment.EndDate) < key {
+				continue
+			}
+			schedule := NewScheduleFromEntity(assignment.Edges.WorkSchedule)
+			schedule.Location = loc
+			return schedule
+		}
+		return fallback
+	}, nil
+}
+
+// defaultSchedule returns the work schedule flagged as default, or DefaultSchedule when none is configured
+func (c *WorkingDayCalendarImpl) defaultSchedule(ctx context.Context) (*Schedule, error) {
+	record, err := c.client.WorkSchedule.
+		Query().
+		Where(workschedule.IsDefault(true)).
+		Where(workschedule.DeletedAtIsNil()).
+		Order(ent.Asc(workschedule.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSchedule(), nil
+		}
+		return nil, fmt.Errorf("failed to fetch default work schedule: %w", err)
+	}
+
+	return NewScheduleFromEntity(record), nil
+}
@@ -0,0 +1,215 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/holiday"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkingDayCalendarImpl(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	// 18 August 2025 (Monday) is cuti bersama for Independence Day
+	_, err := client.Holiday.Create().
+		SetHolidayDate(time.Date(2025, time.August, 18, 0, 0, 0, 0, time.UTC)).
+		SetName("Cuti Bersama Hari Kemerdekaan").
+		SetHolidayType(holiday.HolidayTypeCutiBersama).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Deleted holidays must be ignored
+	_, err = client.Holiday.Create().
+		SetHolidayDate(time.Date(2025, time.August, 19, 0, 0, 0, 0, time.UTC)).
+		SetName("Cancelled").
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	workingCalendar := NewWorkingDayCalendar(client)
+
+	t.Run("holiday is not a working day", func(t *testing.T) {
+		workingDay, err := workingCalendar.IsWorkingDay(ctx, time.Date(2025, time.August, 18, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.False(t, workingDay)
+	})
+
+	t.Run("weekend is not a working day", func(t *testing.T) {
+		workingDay, err := workingCalendar.IsWorkingDay(ctx, time.Date(2025, time.August, 17, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.False(t, workingDay)
+	})
+
+	t.Run("deleted holiday is a working day", func(t *testing.T) {
+		workingDay, err := workingCalendar.IsWorkingDay(ctx, time.Date(2025, time.August, 19, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.True(t, workingDay)
+	})
+
+	t.Run("get holiday returns nil outside holidays", func(t *testing.T) {
+		found, err := workingCalendar.GetHoliday(ctx, time.Date(2025, time.August, 20, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("count working days excludes weekends and holidays", func(t *testing.T) {
+		// August 2025 has 21 weekdays, one of them is cuti bersama
+		count, err := workingCalendar.CountWorkingDays(ctx,
+			time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.August, 31, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, 20, count)
+	})
+
+	t.Run("employee schedule falls back to default", func(t *testing.T) {
+		schedule, err := workingCalendar.GetEmployeeSchedule(ctx, 1, time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, "09:00", schedule.StartTime)
+		assert.False(t, schedule.IsWorkingWeekday(time.Date(2025, time.August, 2, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("count employee working days follows shift assignment", func(t *testing.T) {
+		emp, err := client.Employee.Create().
+			SetFullName("Weekend Shift").
+			SetEmail("weekend.shift@example.com").
+			SetHireDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+
+		weekendShift, err := client.WorkSchedule.Create().
+			SetName("Weekend Shift").
+			SetStartTime("07:00").
+			SetEndTime("15:00").
+			SetWorkingDays([]int{int(time.Saturday), int(time.Sunday), int(time.Monday)}).
+			SetLateThresholdMinutes(15).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = client.ShiftAssignment.Create().
+			SetEmployeeID(emp.ID).
+			SetWorkScheduleID(weekendShift.ID).
+			SetEffectiveDate(time.Date(2025, time.August, 11, 0, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		schedule, err := workingCalendar.GetEmployeeSchedule(ctx, emp.ID, time.Date(2025, time.August, 16, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, weekendShift.ID, schedule.WorkScheduleID)
+		assert.Equal(t, time.Date(2025, time.August, 16, 7, 15, 0, 0, time.UTC), schedule.LateAfter(time.Date(2025, time.August, 16, 0, 0, 0, 0, time.UTC)))
+
+		// 6 weekdays on the default schedule before 11 August, then 8 weekend shift days
+		// (the Monday cuti bersama on 18 August is excluded)
+		count, err := workingCalendar.CountEmployeeWorkingDays(ctx, emp.ID,
+			time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.August, 31, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, 14, count)
+
+		resolve, err := workingCalendar.GetEmployeeScheduleResolver(ctx, emp.ID,
+			time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.August, 31, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.NotEqual(t, weekendShift.ID, resolve(time.Date(2025, time.August, 8, 0, 0, 0, 0, time.UTC)).WorkScheduleID)
+		assert.Equal(t, weekendShift.ID, resolve(time.Date(2025, time.August, 30, 0, 0, 0, 0, time.UTC)).WorkScheduleID)
+	})
+
+	t.Run("overnight shift punch after midnight belongs to previous day", func(t *testing.T) {
+		emp, err := client.Employee.Create().
+			SetFullName("Night Shift").
+			SetEmail("night.shift@example.com").
+			SetHireDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetTimezone("UTC").
+			Save(ctx)
+		require.NoError(t, err)
+
+		nightShift, err := client.WorkSchedule.Create().
+			SetName("Night Shift").
+			SetStartTime("22:00").
+			SetEndTime("06:00").
+			SetWorkingDays([]int{1, 2, 3, 4, 5}).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = client.ShiftAssignment.Create().
+			SetEmployeeID(emp.ID).
+			SetWorkScheduleID(nightShift.ID).
+			SetEffectiveDate(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+		schedule, err := workingCalendar.GetEmployeeSchedule(ctx, emp.ID, monday)
+		require.NoError(t, err)
+		assert.True(t, schedule.IsOvernight())
+		assert.Equal(t, time.Date(2025, time.August, 5, 6, 0, 0, 0, time.UTC), schedule.EndOn(monday))
+
+		shiftDate, err := workingCalendar.GetEmployeeShiftDate(ctx, emp.ID, time.Date(2025, time.August, 5, 0, 30, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, monday, shiftDate)
+
+		shiftDate, err = workingCalendar.GetEmployeeShiftDate(ctx, emp.ID, time.Date(2025, time.August, 5, 21, 50, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2025, time.August, 5, 0, 0, 0, 0, time.UTC), shiftDate)
+	})
+
+	t.Run("shift times follow the office timezone", func(t *testing.T) {
+		jayapura, err := time.LoadLocation("Asia/Jayapura")
+		require.NoError(t, err)
+
+		office, err := client.OfficeLocation.Create().
+			SetName("Jayapura Branch").
+			SetLatitude(-2.5337).
+			SetLongitude(140.7181).
+			SetTimezone("Asia/Jayapura").
+			Save(ctx)
+		require.NoError(t, err)
+
+		emp, err := client.Employee.Create().
+			SetFullName("Jayapura Staff").
+			SetEmail("jayapura.staff@example.com").
+			SetHireDate(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			SetOfficeLocationID(office.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		loc, err := workingCalendar.GetEmployeeLocation(ctx, emp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Jayapura", loc.String())
+
+		// The default 09:00 start is 00:00 UTC in Jayapura (UTC+9)
+		monday := time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC)
+		schedule, err := workingCalendar.GetEmployeeSchedule(ctx, emp.ID, monday)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, time.August, 4, 0, 0, 0, 0, time.UTC), schedule.StartOn(monday).UTC())
+
+		// 07:30 WIT on Tuesday is still Monday in UTC, the shift date follows the employee
+		shiftDate, err := workingCalendar.GetEmployeeShiftDate(ctx, emp.ID, time.Date(2025, time.August, 5, 7, 30, 0, 0, jayapura))
+		assert.NoError(t, err)
+		assert.Equal(t, monday.AddDate(0, 0, 1), shiftDate)
+	})
+}
+
+func TestDateIn(t *testing.T) {
+	jayapura, err := time.LoadLocation("Asia/Jayapura")
+	require.NoError(t, err)
+
+	// 20:30 UTC is already the next morning in Jayapura (UTC+9)
+	punch := time.Date(2024, 3, 4, 20, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), DateIn(punch, jayapura))
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), DateIn(punch, time.UTC))
+}
+
+func TestResolveLocation(t *testing.T) {
+	assert.Equal(t, "Asia/Makassar", ResolveLocation("Asia/Makassar", "Asia/Jayapura").String())
+	assert.Equal(t, "Asia/Jayapura", ResolveLocation("", "Asia/Jayapura").String())
+	assert.Equal(t, "Asia/Jayapura", ResolveLocation("Not/AZone", "Asia/Jayapura").String())
+}
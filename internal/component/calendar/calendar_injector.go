@@ -0,0 +1,20 @@
+//go:build wireinject
+// +build wireinject
+
+package calendar
+
+import (
+	"mceasy/ent"
+
+	"github.com/google/wire"
+)
+
+var provider = wire.NewSet(
+	NewWorkingDayCalendar,
+	wire.Bind(new(WorkingDayCalendar), new(*WorkingDayCalendarImpl)),
+)
+
+func InitializedWorkingDayCalendar(dbClient *ent.Client) *WorkingDayCalendarImpl {
+	wire.Build(provider)
+	return nil
+}
@@ -0,0 +1,120 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+)
+
+// Schedule is the work schedule that applies to an employee on a given date
+type Schedule struct {
+	WorkScheduleID          uint64
+	Name                    string
+	StartTime               string
+	EndTime                 string
+	WorkingDays             []time.Weekday
+	LateThresholdMinutes    int
+	HalfDayThresholdMinutes int
+	// AttendancePayPolicyID is the pay policy of the schedule, the default policy applies when nil
+	AttendancePayPolicyID *uint64
+	// Location is the timezone of the employee the shift times are read in,
+	// the location of the date passed in is used when it is not set
+	Location *time.Location
+}
+
+// DefaultSchedule is used when no work schedule is configured at all: Monday to Friday, 09:00 to 17:00
+func DefaultSchedule() *Schedule {
+	return &Schedule{
+		Name:      "Default",
+		StartTime: "09:00",
+		EndTime:   "17:00",
+		WorkingDays: []time.Weekday{
+			time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+		},
+	}
+}
+
+// NewScheduleFromEntity converts a stored work schedule into a Schedule
+func NewScheduleFromEntity(workSchedule *ent.WorkSchedule) *Schedule {
+	workingDays := make([]time.Weekday, 0, len(workSchedule.WorkingDays))
+	for _, day := range workSchedule.WorkingDays {
+		workingDays = append(workingDays, time.Weekday(day))
+	}
+
+	return &Schedule{
+		WorkScheduleID:          workSchedule.ID,
+		Name:                    workSchedule.Name,
+		StartTime:               workSchedule.StartTime,
+		EndTime:                 workSchedule.EndTime,
+		WorkingDays:             workingDays,
+		LateThresholdMinutes:    workSchedule.LateThresholdMinutes,
+		HalfDayThresholdMinutes: workSchedule.HalfDayThresholdMinutes,
+		AttendancePayPolicyID:   workSchedule.AttendancePayPolicyID,
+	}
+}
+
+// IsWorkingWeekday checks if the weekday of the given date is part of the schedule
+func (s *Schedule) IsWorkingWeekday(date time.Time) bool {
+	for _, day := range s.WorkingDays {
+		if day == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// StartOn returns the shift start on the given calendar date
+func (s *Schedule) StartOn(date time.Time) time.Time {
+	hour, minute, _ := ParseClock(s.StartTime)
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, s.location(date))
+}
+
+// EndOn returns the shift end for the shift starting on the given date,
+// overnight shifts end on the following calendar day
+func (s *Schedule) EndOn(date time.Time) time.Time {
+	hour, minute, _ := ParseClock(s.EndTime)
+	end := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, s.location(date))
+	if s.IsOvernight() {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// location returns the timezone shift times are read in on the given date
+func (s *Schedule) location(date time.Time) *time.Location {
+	if s.Location != nil {
+		return s.Location
+	}
+	return date.Location()
+}
+
+// IsOvernight checks if the shift crosses midnight, i.e. it ends at or before the time it starts
+func (s *Schedule) IsOvernight() bool {
+	startHour, startMinute, _ := ParseClock(s.StartTime)
+	endHour, endMinute, _ := ParseClock(s.EndTime)
+	return endHour*60+endMinute <= startHour*60+startMinute
+}
+
+// LateAfter returns the moment after which a check-in on the given date counts as late
+func (s *Schedule) LateAfter(date time.Time) time.Time {
+	return s.StartOn(date).Add(time.Duration(s.LateThresholdMinutes) * time.Minute)
+}
+
+// HalfDayAfter returns the moment after which a check-in on the given date counts as half day.
+// The second return value is false when the schedule has no half day threshold.
+func (s *Schedule) HalfDayAfter(date time.Time) (time.Time, bool) {
+	if s.HalfDayThresholdMinutes <= 0 {
+		return time.Time{}, false
+	}
+	return s.StartOn(date).Add(time.Duration(s.HalfDayThresholdMinutes) * time.Minute), true
+}
+
+// ParseClock parses a wall clock time in HH:MM format
+func ParseClock(clock string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time format: %s, expected HH:MM", clock)
+	}
+	return t.Hour(), t.Minute(), nil
+}
@@ -0,0 +1,44 @@
+package calendar
+
+import (
+	"time"
+
+	"mceasy/ent"
+	"mceasy/internal/helper"
+)
+
+// ResolveLocation picks the timezone of an employee: the employee's own timezone, then the timezone of
+// the assigned office, then the application timezone. Unknown zone names are skipped.
+func ResolveLocation(employeeTimezone, officeTimezone string) *time.Location {
+	for _, name := range []string{employeeTimezone, officeTimezone} {
+		if name == "" {
+			continue
+		}
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+
+	return helper.ApplicationLocation()
+}
+
+// EmployeeLocation resolves the timezone of an employee loaded with its office location edge
+func EmployeeLocation(record *ent.Employee) *time.Location {
+	officeTimezone := ""
+	if record.Edges.OfficeLocation != nil {
+		officeTimezone = record.Edges.OfficeLocation.Timezone
+	}
+
+	return ResolveLocation(record.Timezone, officeTimezone)
+}
+
+// DateIn returns the calendar date of t in loc as UTC midnight, the form attendance dates are stored in
+func DateIn(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns the current date in loc as UTC midnight
+func Today(loc *time.Location) time.Time {
+	return DateIn(time.Now(), loc)
+}
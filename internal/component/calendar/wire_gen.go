@@ -0,0 +1,25 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package calendar
+
+import (
+	"github.com/google/wire"
+	"mceasy/ent"
+)
+
+// Injectors from calendar_injector.go:
+
+func InitializedWorkingDayCalendar(dbClient *ent.Client) *WorkingDayCalendarImpl {
+	workingDayCalendarImpl := NewWorkingDayCalendar(dbClient)
+	return workingDayCalendarImpl
+}
+
+// calendar_injector.go:
+
+var provider = wire.NewSet(
+	NewWorkingDayCalendar, wire.Bind(new(WorkingDayCalendar), new(*WorkingDayCalendarImpl)),
+)
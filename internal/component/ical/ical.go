@@ -0,0 +1,134 @@
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentType is the media type of an iCalendar feed
+	ContentType = "text/calendar; charset=utf-8"
+
+	// maxLineOctets is the longest content line RFC 5545 allows before it has to be folded
+	maxLineOctets = 75
+
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+)
+
+// textEscaper escapes TEXT property values as RFC 5545 section 3.3.11 requires
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// Event is an all-day VEVENT
+type Event struct {
+	// UID identifies the event across fetches of the feed, calendar apps update the event with the same UID
+	UID         string
+	Summary     string
+	Description string
+	Categories  []string
+	// Start is the first day of the event
+	Start time.Time
+	// End is the day after the last day of the event, an event of one day ends on the next day
+	End time.Time
+}
+
+// Calendar is a read-only VCALENDAR published as a feed
+type Calendar struct {
+	// ProdID identifies the application that generated the calendar
+	ProdID string
+	// Name is the name calendar apps show for the subscription
+	Name string
+	// RefreshInterval suggests calendar apps how often to fetch the feed again
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Encode renders the calendar as an RFC 5545 document, stamp is the time the document is generated at
+func (c *Calendar) Encode(stamp time.Time) []byte {
+	var buf bytes.Buffer
+	write := func(line string) {
+		writeFolded(&buf, line)
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:" + c.ProdID)
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	if c.Name != "" {
+		write("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		interval := formatDuration(c.RefreshInterval)
+		write("REFRESH-INTERVAL;VALUE=DURATION:" + interval)
+		write("X-PUBLISHED-TTL:" + interval)
+	}
+
+	dtStamp := stamp.UTC().Format(dateTimeFormat)
+	for _, event := range c.Events {
+		write("BEGIN:VEVENT")
+		write("UID:" + event.UID)
+		write("DTSTAMP:" + dtStamp)
+		write("DTSTART;VALUE=DATE:" + event.Start.Format(dateFormat))
+		write("DTEND;VALUE=DATE:" + event.End.Format(dateFormat))
+		write("SUMMARY:" + escapeText(event.Summary))
+		if event.Description != "" {
+			write("DESCRIPTION:" + escapeText(event.Description))
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = escapeText(category)
+			}
+			write("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		// All-day absences must not block the free/busy time of the people looking at the feed
+		write("TRANSP:TRANSPARENT")
+		write("END:VEVENT")
+	}
+
+	write("END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// escapeText escapes a TEXT property value
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// writeFolded writes a content line terminated by CRLF, folding it into lines of at most 75 octets without
+// splitting a UTF-8 character. Continuation lines start with a space.
+func writeFolded(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the length of a continuation line
+		limit = maxLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+// formatDuration renders a duration of whole minutes as an RFC 5545 DURATION value, e.g. PT1H30M
+func formatDuration(d time.Duration) string {
+	hours := int(d / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	value := "PT"
+	if hours > 0 {
+		value += strconv.Itoa(hours) + "H"
+	}
+	if minutes > 0 || hours == 0 {
+		value += strconv.Itoa(minutes) + "M"
+	}
+	return value
+}
@@ -0,0 +1,78 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	calendar := &Calendar{
+		ProdID:          "-//mceasy//Attendance//EN",
+		Name:            "Engineering; team",
+		RefreshInterval: time.Hour,
+		Events: []Event{
+			{
+				UID:         "leave-7@mceasy",
+				Summary:     "Budi Santoso, Annual Leave",
+				Description: "Family trip\nBack on Monday",
+				Categories:  []string{"leave"},
+				Start:       time.Date(2025, time.August, 13, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2025, time.August, 16, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	got := string(calendar.Encode(time.Date(2025, time.August, 11, 8, 30, 0, 0, time.FixedZone("WIB", 7*3600))))
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//mceasy//Attendance//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Engineering\; team`,
+		"REFRESH-INTERVAL;VALUE=DURATION:PT1H",
+		"X-PUBLISHED-TTL:PT1H",
+		"BEGIN:VEVENT",
+		"UID:leave-7@mceasy",
+		"DTSTAMP:20250811T013000Z",
+		"DTSTART;VALUE=DATE:20250813",
+		"DTEND;VALUE=DATE:20250816",
+		`SUMMARY:Budi Santoso\, Annual Leave`,
+		`DESCRIPTION:Family trip\nBack on Monday`,
+		"CATEGORIES:leave",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	assert.Equal(t, want, got)
+}
+
+func TestWriteFolded(t *testing.T) {
+	var buf bytes.Buffer
+	// 70 ASCII octets followed by two-octet characters, the fold must not split one
+	line := "SUMMARY:" + strings.Repeat("a", 62) + strings.Repeat("é", 40)
+	writeFolded(&buf, line)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 1)
+	unfolded := lines[0]
+	for i, folded := range lines {
+		assert.LessOrEqual(t, len(folded), maxLineOctets, "line %d", i)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(folded, " "), "line %d", i)
+			unfolded += folded[1:]
+		}
+	}
+	assert.Equal(t, line, unfolded)
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "PT1H", formatDuration(time.Hour))
+	assert.Equal(t, "PT1H30M", formatDuration(90*time.Minute))
+	assert.Equal(t, "PT15M", formatDuration(15*time.Minute))
+}
@@ -0,0 +1,25 @@
+package periodlock
+
+import (
+	"context"
+	"time"
+)
+
+// PeriodLock guards attendance and salary writes against months whose payroll was closed.
+// Attendance, corrections, imports and salary calculation all check it before writing.
+type PeriodLock interface {
+	IsLocked(ctx context.Context, date time.Time) (bool, error)
+	EnsureOpen(ctx context.Context, date time.Time) error
+	EnsureRangeOpen(ctx context.Context, startDate, endDate time.Time) error
+	Lock(ctx context.Context, date time.Time, lockedBy uint64, reason string) error
+}
+
+// MonthOf returns the first day of the month of the date as UTC midnight, the key of a payroll period
+func MonthOf(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthKey formats the month of a date as YYYY-MM
+func MonthKey(date time.Time) string {
+	return date.Format("2006-01")
+}
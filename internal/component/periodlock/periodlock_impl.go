@@ -0,0 +1,111 @@
+package periodlock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mceasy/ent"
+	"mceasy/ent/payrollperiod"
+	"mceasy/ent/payrollperiodevent"
+	"mceasy/exceptions"
+)
+
+type PeriodLockImpl struct {
+	client *ent.Client
+}
+
+func NewPeriodLock(client *ent.Client) *PeriodLockImpl {
+	return &PeriodLockImpl{client: client}
+}
+
+// db reads through the transaction in the context so a lock taken in the same transaction is seen
+func (l *PeriodLockImpl) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return l.client
+}
+
+// IsLocked reports whether the payroll period of the date's month is locked
+func (l *PeriodLockImpl) IsLocked(ctx context.Context, date time.Time) (bool, error) {
+	return l.db(ctx).PayrollPeriod.
+		Query().
+		Where(payrollperiod.PeriodMonth(MonthOf(date))).
+		Where(payrollperiod.StatusEQ(payrollperiod.StatusLocked)).
+		Where(payrollperiod.DeletedAtIsNil()).
+		Exist(ctx)
+}
+
+// EnsureOpen returns a PeriodLocked business error when the date falls in a locked payroll period
+func (l *PeriodLockImpl) EnsureOpen(ctx context.Context, date time.Time) error {
+	return l.EnsureRangeOpen(ctx, date, date)
+}
+
+// EnsureRangeOpen returns a PeriodLocked business error naming the first locked month between the dates (inclusive)
+func (l *PeriodLockImpl) EnsureRangeOpen(ctx context.Context, startDate, endDate time.Time) error {
+	locked, err := l.db(ctx).PayrollPeriod.
+		Query().
+		Where(payrollperiod.PeriodMonthGTE(MonthOf(startDate))).
+		Where(payrollperiod.PeriodMonthLTE(MonthOf(endDate))).
+		Where(payrollperiod.StatusEQ(payrollperiod.StatusLocked)).
+		Where(payrollperiod.DeletedAtIsNil()).
+		Order(ent.Asc(payrollperiod.FieldPeriodMonth)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check payroll period: %w", err)
+	}
+
+	return exceptions.NewBusinessLogicError(exceptions.PeriodLocked,
+		fmt.Errorf("payroll period %s is locked, unlock it before changing its attendance or salaries", MonthKey(locked.PeriodMonth)))
+}
+
+// Lock locks the payroll period of the date's month and records who locked it, a period that is already locked
+// is left as it is. Closing a payroll run locks its month in the transaction of the run.
+func (l *PeriodLockImpl) Lock(ctx context.Context, date time.Time, lockedBy uint64, reason string) error {
+	period, err := l.db(ctx).PayrollPeriod.
+		Query().
+		Where(payrollperiod.PeriodMonth(MonthOf(date))).
+		Where(payrollperiod.DeletedAtIsNil()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		period, err = l.db(ctx).PayrollPeriod.Create().
+			SetPeriodMonth(MonthOf(date)).
+			SetStatus(payrollperiod.StatusOpen).
+			Save(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get payroll period: %w", err)
+	}
+
+	if period.Status == payrollperiod.StatusLocked {
+		return nil
+	}
+
+	err = l.db(ctx).PayrollPeriod.
+		UpdateOneID(period.ID).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedBy(lockedBy).
+		SetLockedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock payroll period: %w", err)
+	}
+
+	event := l.db(ctx).PayrollPeriodEvent.Create().
+		SetPayrollPeriodID(period.ID).
+		SetAction(payrollperiodevent.ActionLock).
+		SetActorID(lockedBy)
+	if reason != "" {
+		event = event.SetReason(reason)
+	}
+	if err := event.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record payroll period event: %w", err)
+	}
+
+	return nil
+}
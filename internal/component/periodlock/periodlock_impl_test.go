@@ -0,0 +1,69 @@
+package periodlock
+
+import (
+	"testing"
+	"time"
+
+	"mceasy/ent/payrollperiod"
+	"mceasy/exceptions"
+	"mceasy/test"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodLockImpl(t *testing.T) {
+	client, ctx := test.DbConnection(t)
+	t.Cleanup(func() {
+		test.DbConnectionClose(client)
+	})
+
+	_, err := client.PayrollPeriod.Create().
+		SetPeriodMonth(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)).
+		SetStatus(payrollperiod.StatusLocked).
+		SetLockedAt(time.Date(2025, time.September, 3, 10, 0, 0, 0, time.UTC)).
+		SetLockedBy(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// An unlocked period does not block writes
+	_, err = client.PayrollPeriod.Create().
+		SetPeriodMonth(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	periodLock := NewPeriodLock(client)
+
+	t.Run("any day of a locked month is locked", func(t *testing.T) {
+		locked, err := periodLock.IsLocked(ctx, time.Date(2025, time.August, 31, 23, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		locked, err = periodLock.IsLocked(ctx, time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, locked)
+	})
+
+	t.Run("writes to a locked month are a business error", func(t *testing.T) {
+		err := periodLock.EnsureOpen(ctx, time.Date(2025, time.August, 15, 0, 0, 0, 0, time.UTC))
+		bizErr, ok := exceptions.AsBusinessLogicError(err)
+		require.True(t, ok)
+		assert.Equal(t, exceptions.PeriodLocked, bizErr.ErrorCode)
+		assert.Contains(t, bizErr.Detail(), "2025-08")
+
+		assert.NoError(t, periodLock.EnsureOpen(ctx, time.Date(2025, time.July, 31, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("range spanning a locked month is refused", func(t *testing.T) {
+		assert.NoError(t, periodLock.EnsureRangeOpen(ctx,
+			time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.July, 31, 0, 0, 0, 0, time.UTC)))
+
+		err := periodLock.EnsureRangeOpen(ctx,
+			time.Date(2025, time.July, 20, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.September, 10, 0, 0, 0, 0, time.UTC))
+		_, ok := exceptions.AsBusinessLogicError(err)
+		assert.True(t, ok)
+	})
+}
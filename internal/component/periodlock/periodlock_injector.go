@@ -0,0 +1,20 @@
+//go:build wireinject
+// +build wireinject
+
+package periodlock
+
+import (
+	"mceasy/ent"
+
+	"github.com/google/wire"
+)
+
+var provider = wire.NewSet(
+	NewPeriodLock,
+	wire.Bind(new(PeriodLock), new(*PeriodLockImpl)),
+)
+
+func InitializedPeriodLock(dbClient *ent.Client) *PeriodLockImpl {
+	wire.Build(provider)
+	return nil
+}
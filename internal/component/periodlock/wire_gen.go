@@ -0,0 +1,25 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package periodlock
+
+import (
+	"github.com/google/wire"
+	"mceasy/ent"
+)
+
+// Injectors from periodlock_injector.go:
+
+func InitializedPeriodLock(dbClient *ent.Client) *PeriodLockImpl {
+	periodLockImpl := NewPeriodLock(dbClient)
+	return periodLockImpl
+}
+
+// periodlock_injector.go:
+
+var provider = wire.NewSet(
+	NewPeriodLock, wire.Bind(new(PeriodLock), new(*PeriodLockImpl)),
+)
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Job is a unit of background work run by the scheduler
+type Job func(ctx context.Context) error
+
+// Scheduler runs jobs in-process at a fixed interval
+type Scheduler interface {
+	// Every registers a job that runs once on start and then after every interval
+	Every(name string, interval time.Duration, job Job)
+	// Start runs the registered jobs until Stop is called or ctx is cancelled
+	Start(ctx context.Context)
+	// Stop cancels the jobs and waits for running ones to finish
+	Stop()
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      Job
+}
+
+// SchedulerImpl implements the Scheduler interface with one goroutine per job, so a job never overlaps itself
+type SchedulerImpl struct {
+	jobs   []scheduledJob
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a new scheduler instance
+func NewScheduler() *SchedulerImpl {
+	return &SchedulerImpl{}
+}
+
+// Every registers a job that runs once on start and then after every interval
+func (s *SchedulerImpl) Every(name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		log.Errorf("Scheduled job %s is not registered, invalid interval %s", name, interval)
+		return
+	}
+
+	s.jobs = append(s.jobs, scheduledJob{
+		name:     name,
+		interval: interval,
+		run:      job,
+	})
+}
+
+// Start runs the registered jobs until Stop is called or ctx is cancelled
+func (s *SchedulerImpl) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job scheduledJob) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Stop cancels the jobs and waits for running ones to finish
+func (s *SchedulerImpl) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *SchedulerImpl) loop(ctx context.Context, job scheduledJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce runs a job and keeps the scheduler alive when it fails or panics
+func (s *SchedulerImpl) runOnce(ctx context.Context, job scheduledJob) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Scheduled job %s panicked: %v", job.name, r)
+		}
+	}()
+
+	if err := job.run(ctx); err != nil {
+		log.Errorf("Scheduled job %s failed: %s", job.name, err)
+	}
+}
@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerImpl(t *testing.T) {
+	var runs, failures atomic.Int32
+
+	jobScheduler := NewScheduler()
+	jobScheduler.Every("count", 10*time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+	jobScheduler.Every("fail", 10*time.Millisecond, func(ctx context.Context) error {
+		failures.Add(1)
+		return errors.New("boom")
+	})
+
+	jobScheduler.Start(context.Background())
+	time.Sleep(55 * time.Millisecond)
+	jobScheduler.Stop()
+
+	stoppedAt := runs.Load()
+	assert.GreaterOrEqual(t, stoppedAt, int32(3))
+	assert.GreaterOrEqual(t, failures.Load(), int32(3), "failing job keeps running")
+
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stoppedAt, runs.Load(), "no runs after stop")
+}
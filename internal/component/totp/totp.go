@@ -0,0 +1,107 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is how long a code stays valid before the next one is shown
+	Period = 30 * time.Second
+
+	// Digits is the length of a code
+	Digits = 6
+
+	// secretSize is the number of random bytes of a secret, 160 bits as recommended for HMAC-SHA1
+	secretSize = 20
+)
+
+// encoding is unpadded base32 as used by authenticator apps
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random base32 encoded secret
+func GenerateSecret() (string, error) {
+	key := make([]byte, secretSize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	return encoding.EncodeToString(key), nil
+}
+
+// Step returns the number of the period the time falls in
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// ExpiresAt returns when the code of the period the time falls in stops being shown
+func ExpiresAt(t time.Time) time.Time {
+	return time.Unix((Step(t)+1)*int64(Period/time.Second), 0).In(t.Location())
+}
+
+// Code returns the RFC 6238 code of the secret for the period the time falls in
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, Step(t)), nil
+}
+
+// Verify reports whether the code belongs to the period the time falls in or to one of the previous periods
+// up to skew. Codes of later periods are never accepted, a code can only be known once it was shown.
+func Verify(secret, candidate string, t time.Time, skew int) (bool, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return false, err
+	}
+
+	if len(candidate) != Digits {
+		return false, nil
+	}
+
+	step := Step(t)
+	for i := 0; i <= skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(code(key, step-int64(i))), []byte(candidate)) == 1 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid secret: empty")
+	}
+
+	return key, nil
+}
+
+// code computes the HOTP value of RFC 4226 for the counter
+func code(key []byte, counter int64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation picks four bytes at the offset given by the low nibble of the last byte
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
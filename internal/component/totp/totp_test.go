@@ -0,0 +1,73 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 key of the RFC 6238 test vectors
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// Test vectors of RFC 6238 appendix B, truncated to six digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "time %d", tt.unix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	shownAt := time.Date(2025, time.August, 11, 8, 0, 5, 0, time.UTC)
+	code, err := Code(secret, shownAt)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		code string
+		want bool
+	}{
+		{"same period", shownAt.Add(20 * time.Second), code, true},
+		{"previous period within skew", shownAt.Add(Period), code, true},
+		{"outside skew", shownAt.Add(2 * Period), code, false},
+		{"future period", shownAt.Add(-Period), code, false},
+		{"wrong code", shownAt, "000000", code == "000000"},
+		{"wrong length", shownAt, code[:5], false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(secret, tt.code, tt.at, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = Verify("not base32!", code, shownAt, 1)
+	assert.Error(t, err)
+}
+
+func TestExpiresAt(t *testing.T) {
+	at := time.Date(2025, time.August, 11, 8, 0, 5, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, time.August, 11, 8, 0, 30, 0, time.UTC), ExpiresAt(at))
+}
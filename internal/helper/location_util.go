@@ -0,0 +1,25 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultApplicationTimezone is used when application.timezone is not configured
+const DefaultApplicationTimezone = "Asia/Jakarta"
+
+// ApplicationLocation returns the configured application.timezone, falling back to Asia/Jakarta (UTC+7)
+func ApplicationLocation() *time.Location {
+	name := viper.GetString("application.timezone")
+	if name == "" {
+		name = DefaultApplicationTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone("WIB", 7*60*60)
+	}
+
+	return loc
+}
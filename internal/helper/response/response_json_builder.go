@@ -3,6 +3,7 @@ package response
 import (
 	"errors"
 	"github.com/labstack/echo/v4"
+	"mceasy/exceptions"
 	"net/http"
 	"time"
 )
@@ -69,3 +70,14 @@ func Success(ctx echo.Context, data interface{}) error {
 func Error(ctx echo.Context, httpCode int, err error, data interface{}) error {
 	return Base(ctx, httpCode, httpCode, http.StatusText(httpCode), data, err)
 }
+
+// BusinessLogicError responds with the HTTP status of the error code, in the error format of the domain controllers
+func BusinessLogicError(ctx echo.Context, title string, err *exceptions.BusinessLogicError) error {
+	reason := exceptions.BusinessLogicReason(err.ErrorCode)
+
+	return ctx.JSON(reason.HttpCode, map[string]interface{}{
+		"error":      title,
+		"error_code": reason.ErrCode,
+		"message":    err.Detail(),
+	})
+}
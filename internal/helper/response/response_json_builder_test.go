@@ -2,6 +2,8 @@ package response
 
 import (
 	"errors"
+	"fmt"
+	"mceasy/exceptions"
 	"mceasy/internal/helper"
 	"net/http"
 	"net/http/httptest"
@@ -151,3 +153,25 @@ func TestError(t *testing.T) {
 	assert.Equal(t, "some error occurred", errorJson)
 
 }
+
+func TestBusinessLogicError(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	cause := fmt.Errorf("failed to update attendance: %w",
+		exceptions.NewBusinessLogicError(exceptions.PeriodLocked, errors.New("payroll period 2025-08 is locked")))
+	bizErr, ok := exceptions.AsBusinessLogicError(cause)
+	assert.True(t, ok)
+
+	err := BusinessLogicError(c, "Failed to update attendance", bizErr)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	codeJson, _ := helper.GetFieldBytes(rec.Body.Bytes(), "error_code")
+	assert.Equal(t, float64(exceptions.PeriodLocked), codeJson)
+
+	messageJson, _ := helper.GetFieldBytes(rec.Body.Bytes(), "message")
+	assert.Equal(t, "payroll period 2025-08 is locked", messageJson)
+}
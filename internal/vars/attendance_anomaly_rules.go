@@ -0,0 +1,53 @@
+package vars
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// AttendanceAnomalyRules holds the configurable rules of the attendance anomaly detector
+type AttendanceAnomalyRules struct {
+	// MissingCheckOut reports a check-in without check-out once the shift ended more than the grace ago
+	MissingCheckOut      bool
+	MissingCheckOutGrace time.Duration
+
+	// LongDay reports days where check-out is more than the threshold after check-in
+	LongDay          bool
+	LongDayThreshold time.Duration
+
+	// OddHourCheckIn reports check-ins more than the lead before the scheduled start, e.g. at 03:00 for a 09:00 shift
+	OddHourCheckIn     bool
+	OddHourCheckInLead time.Duration
+
+	// EarlyCheckOut reports check-outs more than the tolerance before the scheduled end
+	EarlyCheckOut          bool
+	EarlyCheckOutTolerance time.Duration
+}
+
+// GetAttendanceAnomalyRules reads the anomaly rules from the configuration, rules are enabled unless switched off
+func GetAttendanceAnomalyRules() AttendanceAnomalyRules {
+	return AttendanceAnomalyRules{
+		MissingCheckOut:        ruleEnabled("attendance.anomaly.missingCheckOut.enabled"),
+		MissingCheckOutGrace:   ruleDuration("attendance.anomaly.missingCheckOut.grace", "4h"),
+		LongDay:                ruleEnabled("attendance.anomaly.longDay.enabled"),
+		LongDayThreshold:       ruleDuration("attendance.anomaly.longDay.threshold", "16h"),
+		OddHourCheckIn:         ruleEnabled("attendance.anomaly.oddHourCheckIn.enabled"),
+		OddHourCheckInLead:     ruleDuration("attendance.anomaly.oddHourCheckIn.lead", "4h"),
+		EarlyCheckOut:          ruleEnabled("attendance.anomaly.earlyCheckOut.enabled"),
+		EarlyCheckOutTolerance: ruleDuration("attendance.anomaly.earlyCheckOut.tolerance", "30m"),
+	}
+}
+
+func ruleEnabled(key string) bool {
+	return !viper.IsSet(key) || viper.GetBool(key)
+}
+
+func ruleDuration(key, fallback string) time.Duration {
+	value := viper.GetString(key)
+	if value == "" {
+		value = fallback
+	}
+
+	return convertStringToDuration(value)
+}
@@ -0,0 +1,43 @@
+package vars
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// AutoCheckOutAtShiftEnd records the forgotten check-out at the scheduled end of the shift
+	AutoCheckOutAtShiftEnd = "shift_end"
+	// AutoCheckOutAtCutoff records the forgotten check-out at the cut-off time of the day
+	AutoCheckOutAtCutoff = "cutoff"
+)
+
+// AutoCheckOutPolicy holds the configuration of the job closing attendance records without check-out
+type AutoCheckOutPolicy struct {
+	// CloseAt is AutoCheckOutAtShiftEnd or AutoCheckOutAtCutoff
+	CloseAt string
+	// Cutoff is the wall clock time in the employee's timezone used with AutoCheckOutAtCutoff, e.g. 23:59
+	Cutoff string
+	// Grace is how long after the check-out time the employee can still check out before the job does
+	Grace time.Duration
+}
+
+// GetAutoCheckOutPolicy reads the auto check-out policy from the configuration
+func GetAutoCheckOutPolicy() AutoCheckOutPolicy {
+	closeAt := viper.GetString("attendance.autoCheckOut.closeAt")
+	if closeAt != AutoCheckOutAtCutoff {
+		closeAt = AutoCheckOutAtShiftEnd
+	}
+
+	cutoff := viper.GetString("attendance.autoCheckOut.cutoff")
+	if cutoff == "" {
+		cutoff = "23:59"
+	}
+
+	return AutoCheckOutPolicy{
+		CloseAt: closeAt,
+		Cutoff:  cutoff,
+		Grace:   ruleDuration("attendance.autoCheckOut.grace", "2h"),
+	}
+}
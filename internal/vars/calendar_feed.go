@@ -0,0 +1,36 @@
+package vars
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// CalendarFeedWindow holds the range of days rendered in the iCalendar feeds around the day they are fetched
+type CalendarFeedWindow struct {
+	// PastDays is how many days before today the feed starts
+	PastDays int
+	// FutureDays is how many days after today the feed ends, approved leave and holidays are known ahead
+	FutureDays int
+	// RefreshInterval suggests calendar apps how often to fetch the feed again
+	RefreshInterval time.Duration
+}
+
+// GetCalendarFeedWindow reads the calendar feed window from the configuration
+func GetCalendarFeedWindow() CalendarFeedWindow {
+	pastDays := viper.GetInt("calendarFeed.pastDays")
+	if pastDays <= 0 {
+		pastDays = 90
+	}
+
+	futureDays := viper.GetInt("calendarFeed.futureDays")
+	if futureDays <= 0 {
+		futureDays = 365
+	}
+
+	return CalendarFeedWindow{
+		PastDays:        pastDays,
+		FutureDays:      futureDays,
+		RefreshInterval: ruleDuration("calendarFeed.refreshInterval", "1h"),
+	}
+}
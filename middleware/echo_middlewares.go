@@ -54,7 +54,8 @@ func CorsConfig() echo.MiddlewareFunc {
 	corsAllowedHost := viper.GetString("application.cors.allowedHost")
 	return middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{corsAllowedHost},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+		// X-Kiosk-Token authenticates kiosk tablets polling their check-in codes
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, "X-Kiosk-Token"},
 		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
 	})
 }
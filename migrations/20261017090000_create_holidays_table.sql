@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE holidays (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    holiday_date DATE NOT NULL COMMENT 'Date of the holiday',
+    name VARCHAR(255) NOT NULL,
+    holiday_type ENUM('national', 'cuti_bersama', 'company') NOT NULL DEFAULT 'national' COMMENT 'National public holiday, collective leave (cuti bersama) or company holiday',
+    description TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_holiday_date (holiday_date),
+    INDEX idx_holiday_type (holiday_type),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE holidays;
+-- +goose StatementEnd
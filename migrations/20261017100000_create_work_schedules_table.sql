@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE work_schedules (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    start_time VARCHAR(5) NOT NULL COMMENT 'Shift start in HH:MM',
+    end_time VARCHAR(5) NOT NULL COMMENT 'Shift end in HH:MM',
+    working_days JSON NOT NULL COMMENT 'Working weekdays, 0 = Sunday through 6 = Saturday',
+    late_threshold_minutes INT NOT NULL DEFAULT 0 COMMENT 'Grace period after start before a check-in counts as late',
+    half_day_threshold_minutes INT NOT NULL DEFAULT 0 COMMENT 'Minutes after start before a check-in counts as half day, 0 disables',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Applied to employees without an active shift assignment',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    UNIQUE KEY unique_name (name),
+    INDEX idx_is_default (is_default),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO work_schedules (name, start_time, end_time, working_days, late_threshold_minutes, is_default)
+VALUES ('Office Hours', '09:00', '17:00', '[1,2,3,4,5]', 0, TRUE);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE shift_assignments (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    employee_id BIGINT UNSIGNED NOT NULL,
+    work_schedule_id BIGINT UNSIGNED NOT NULL,
+    effective_date DATE NOT NULL COMMENT 'First date the schedule applies to the employee',
+    end_date DATE NULL COMMENT 'Last date the schedule applies, open ended when empty',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    FOREIGN KEY (work_schedule_id) REFERENCES work_schedules(id) ON DELETE CASCADE,
+    INDEX idx_employee_effective_date (employee_id, effective_date),
+    INDEX idx_work_schedule_id (work_schedule_id),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE shift_assignments;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE work_schedules;
+-- +goose StatementEnd
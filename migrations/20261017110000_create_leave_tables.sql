@@ -0,0 +1,97 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE leave_types (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL COMMENT 'Stable identifier like annual, sick, maternity, unpaid',
+    name VARCHAR(100) NOT NULL,
+    is_paid BOOLEAN NOT NULL DEFAULT TRUE COMMENT 'Paid leave is not deducted from salary',
+    annual_quota INT NOT NULL DEFAULT 0 COMMENT 'Days granted per year, 0 means the balance is not tracked',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    description TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    UNIQUE KEY unique_code (code),
+    INDEX idx_is_active (is_active),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO leave_types (code, name, is_paid, annual_quota, description) VALUES
+    ('annual', 'Annual Leave', TRUE, 12, 'Cuti tahunan'),
+    ('sick', 'Sick Leave', TRUE, 0, 'Requires a doctor''s note'),
+    ('maternity', 'Maternity Leave', TRUE, 90, 'Cuti melahirkan'),
+    ('unpaid', 'Unpaid Leave', FALSE, 0, 'Deducted from salary');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE leave_requests (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    employee_id BIGINT UNSIGNED NOT NULL,
+    leave_type_id BIGINT UNSIGNED NOT NULL,
+    start_date DATE NOT NULL COMMENT 'First day of leave',
+    end_date DATE NOT NULL COMMENT 'Last day of leave',
+    total_days INT NOT NULL DEFAULT 0 COMMENT 'Working days covered by the request',
+    reason TEXT NULL,
+    status ENUM('pending', 'approved', 'rejected', 'cancelled') NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT UNSIGNED NULL COMMENT 'User who approved or rejected the request',
+    reviewed_at TIMESTAMP NULL,
+    review_notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    FOREIGN KEY (leave_type_id) REFERENCES leave_types(id) ON DELETE CASCADE,
+    INDEX idx_employee_start_date (employee_id, start_date),
+    INDEX idx_leave_type_id (leave_type_id),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE leave_balances (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    employee_id BIGINT UNSIGNED NOT NULL,
+    leave_type_id BIGINT UNSIGNED NOT NULL,
+    year INT NOT NULL COMMENT 'Calendar year of the balance',
+    entitled_days INT NOT NULL DEFAULT 0,
+    used_days INT NOT NULL DEFAULT 0 COMMENT 'Working days taken by approved leave requests',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    FOREIGN KEY (leave_type_id) REFERENCES leave_types(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_employee_type_year (employee_id, leave_type_id, year),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances MODIFY COLUMN status ENUM('present', 'absent', 'late', 'half_day', 'leave', 'unpaid_leave') NOT NULL DEFAULT 'absent';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE attendances SET status = 'absent' WHERE status IN ('leave', 'unpaid_leave');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances MODIFY COLUMN status ENUM('present', 'absent', 'late', 'half_day') NOT NULL DEFAULT 'absent';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE leave_balances;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE leave_requests;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE leave_types;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendance_corrections (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    attendance_id BIGINT UNSIGNED NOT NULL,
+    employee_id BIGINT UNSIGNED NOT NULL COMMENT 'Employee who requested the correction',
+    original_check_in_time DATETIME NULL COMMENT 'Check-in time when the correction was requested',
+    original_check_out_time DATETIME NULL COMMENT 'Check-out time when the correction was requested',
+    original_status VARCHAR(20) NOT NULL COMMENT 'Attendance status when the correction was requested',
+    proposed_check_in_time DATETIME NULL,
+    proposed_check_out_time DATETIME NULL,
+    proposed_status VARCHAR(20) NULL,
+    reason TEXT NOT NULL,
+    status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT UNSIGNED NULL COMMENT 'Manager who approved or rejected the correction',
+    reviewed_at TIMESTAMP NULL,
+    review_notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE CASCADE,
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    INDEX idx_attendance_id (attendance_id),
+    INDEX idx_employee_id (employee_id),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attendance_corrections;
+-- +goose StatementEnd
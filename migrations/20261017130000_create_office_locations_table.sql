@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE office_locations (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    address TEXT NULL,
+    latitude DOUBLE NOT NULL,
+    longitude DOUBLE NOT NULL,
+    radius_meters INT NOT NULL DEFAULT 100 COMMENT 'Maximum distance from the coordinates for a check-in or check-out',
+    enforcement ENUM('reject', 'flag') NOT NULL DEFAULT 'reject' COMMENT 'reject refuses punches outside the radius, flag stores them for review',
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_is_active (is_active),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN office_location_id BIGINT UNSIGNED NULL COMMENT 'Office whose geofence applies to check-in and check-out',
+    ADD INDEX idx_office_location_id (office_location_id),
+    ADD CONSTRAINT fk_employees_office_location FOREIGN KEY (office_location_id) REFERENCES office_locations(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    ADD COLUMN check_in_latitude DOUBLE NULL,
+    ADD COLUMN check_in_longitude DOUBLE NULL,
+    ADD COLUMN check_in_distance_meters DOUBLE NULL COMMENT 'Distance between the check-in and the assigned office',
+    ADD COLUMN check_out_latitude DOUBLE NULL,
+    ADD COLUMN check_out_longitude DOUBLE NULL,
+    ADD COLUMN check_out_distance_meters DOUBLE NULL COMMENT 'Distance between the check-out and the assigned office',
+    ADD COLUMN location_flagged BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'True when a punch was outside the office radius and needs review',
+    ADD INDEX idx_location_flagged (location_flagged);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attendances
+    DROP INDEX idx_location_flagged,
+    DROP COLUMN location_flagged,
+    DROP COLUMN check_out_distance_meters,
+    DROP COLUMN check_out_longitude,
+    DROP COLUMN check_out_latitude,
+    DROP COLUMN check_in_distance_meters,
+    DROP COLUMN check_in_longitude,
+    DROP COLUMN check_in_latitude;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    DROP FOREIGN KEY fk_employees_office_location,
+    DROP INDEX idx_office_location_id,
+    DROP COLUMN office_location_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE office_locations;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendance_punches (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    attendance_id BIGINT UNSIGNED NOT NULL COMMENT 'Daily attendance the punch belongs to',
+    punch_type ENUM('check_in', 'check_out', 'break_start', 'break_end') NOT NULL,
+    punch_time DATETIME NOT NULL,
+    latitude DOUBLE NULL,
+    longitude DOUBLE NULL,
+    distance_meters DOUBLE NULL COMMENT 'Distance between the punch and the assigned office',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE CASCADE,
+    INDEX idx_attendance_punch_time (attendance_id, punch_time),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    ADD COLUMN worked_minutes INT NOT NULL DEFAULT 0 COMMENT 'Minutes worked derived from the punches, excluding breaks',
+    ADD COLUMN break_minutes INT NOT NULL DEFAULT 0 COMMENT 'Minutes spent on breaks derived from the punches';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    ADD COLUMN worked_minutes INT NOT NULL DEFAULT 0 COMMENT 'Minutes worked in the month from attendance punches, excluding breaks';
+-- +goose StatementEnd
+
+-- Existing check-in and check-out pairs become the first punches of their day
+-- +goose StatementBegin
+INSERT INTO attendance_punches (attendance_id, punch_type, punch_time)
+SELECT id, 'check_in', check_in_time FROM attendances
+WHERE check_in_time IS NOT NULL AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO attendance_punches (attendance_id, punch_type, punch_time)
+SELECT id, 'check_out', check_out_time FROM attendances
+WHERE check_out_time IS NOT NULL AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE attendances
+SET worked_minutes = TIMESTAMPDIFF(MINUTE, check_in_time, check_out_time)
+WHERE check_in_time IS NOT NULL AND check_out_time > check_in_time AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE salary_calculations DROP COLUMN worked_minutes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    DROP COLUMN break_minutes,
+    DROP COLUMN worked_minutes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE attendance_punches;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE overtimes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    employee_id BIGINT UNSIGNED NOT NULL,
+    attendance_id BIGINT UNSIGNED NOT NULL UNIQUE COMMENT 'Attendance the overtime was detected on, one overtime per day',
+    overtime_date DATE NOT NULL COMMENT 'Shift date of the attendance',
+    scheduled_end DATETIME NULL COMMENT 'Scheduled end of the shift, empty on rest days and holidays',
+    actual_end DATETIME NOT NULL COMMENT 'Last check-out of the day',
+    minutes INT NOT NULL COMMENT 'Detected overtime minutes, all worked minutes on rest days and holidays',
+    approved_minutes INT NULL COMMENT 'Minutes approved for payment, may be lower than detected',
+    is_rest_day BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Rest day or public holiday, paid with the rest day multipliers',
+    status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT UNSIGNED NULL,
+    reviewed_at TIMESTAMP NULL,
+    review_notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE CASCADE,
+    INDEX idx_employee_overtime_date (employee_id, overtime_date),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    ADD COLUMN overtime_minutes INT NOT NULL DEFAULT 0 COMMENT 'Approved overtime minutes in the month',
+    ADD COLUMN overtime_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00 COMMENT 'Overtime earnings per Kepmenaker 102/2004, paid on top of the prorated base salary';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    DROP COLUMN overtime_pay,
+    DROP COLUMN overtime_minutes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE overtimes;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Auto-absent now runs from the application scheduler, which respects schedules, leave and holidays
+DROP EVENT IF EXISTS auto_mark_absent;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE EVENT auto_mark_absent
+ON SCHEDULE EVERY 5 MINUTE
+STARTS NOW()
+DO
+BEGIN
+    DECLARE indonesian_time DATETIME;
+    DECLARE indonesian_hour INT;
+    DECLARE indonesian_date DATE;
+
+    SET indonesian_time = DATE_ADD(UTC_TIMESTAMP(), INTERVAL 7 HOUR);
+    SET indonesian_hour = HOUR(indonesian_time);
+    SET indonesian_date = DATE(indonesian_time);
+
+    IF indonesian_hour >= HOUR(indonesian_time) AND DAYOFWEEK(indonesian_date) NOT IN (1, 7) THEN
+
+        INSERT INTO attendances (employee_id, attendance_date, status, is_weekend, marked_by_admin, created_at)
+        SELECT
+            e.id,
+            indonesian_date,
+            'absent',
+            FALSE,
+            TRUE,
+            indonesian_time
+        FROM employees e
+        WHERE e.is_active = TRUE
+        AND e.deleted_at IS NULL
+        AND NOT EXISTS (
+            SELECT 1 FROM attendances a
+            WHERE a.employee_id = e.id
+            AND a.attendance_date = indonesian_date
+            AND a.deleted_at IS NULL
+        );
+
+    END IF;
+END;
+-- +goose StatementEnd
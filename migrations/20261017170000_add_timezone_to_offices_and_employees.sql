@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE office_locations
+    ADD COLUMN timezone VARCHAR(64) NULL COMMENT 'IANA timezone used for attendance day boundaries and late detection';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN timezone VARCHAR(64) NULL COMMENT 'IANA timezone of the employee, overrides the office timezone when set';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees
+    DROP COLUMN timezone;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE office_locations
+    DROP COLUMN timezone;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN device_pin VARCHAR(32) NULL COMMENT 'User PIN enrolled on the fingerprint terminals, maps device punch logs to the employee',
+    ADD UNIQUE INDEX idx_device_pin (device_pin);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees
+    DROP INDEX idx_device_pin,
+    DROP COLUMN device_pin;
+-- +goose StatementEnd
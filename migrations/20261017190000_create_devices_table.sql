@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE devices (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    serial_number VARCHAR(64) NOT NULL UNIQUE COMMENT 'Serial number the terminal identifies itself with on every request',
+    name VARCHAR(100) NULL,
+    status ENUM('pending', 'active', 'disabled') NOT NULL DEFAULT 'pending' COMMENT 'pending terminals registered themselves and wait for approval, only active terminals may push punches',
+    office_location_id BIGINT UNSIGNED NULL COMMENT 'Office the terminal is installed at',
+    timezone VARCHAR(64) NULL COMMENT 'IANA timezone of the terminal clock, the office timezone applies when empty',
+    push_version VARCHAR(32) NULL COMMENT 'Push protocol version reported by the terminal',
+    device_info VARCHAR(255) NULL COMMENT 'Firmware, user and punch counts reported by the terminal',
+    ip_address VARCHAR(45) NULL COMMENT 'Address of the last request of the terminal',
+    attlog_stamp VARCHAR(32) NULL COMMENT 'Stamp of the last ATTLOG push, the terminal resumes uploading after it',
+    last_seen_at TIMESTAMP NULL,
+    last_punch_at TIMESTAMP NULL COMMENT 'Terminal clock time of the newest punch pushed',
+    clock_offset_seconds INT NULL COMMENT 'Terminal clock minus server time, measured on real-time pushes of a single punch',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_status (status),
+    INDEX idx_office_location_id (office_location_id),
+    INDEX idx_deleted_at (deleted_at),
+    CONSTRAINT fk_devices_office_location FOREIGN KEY (office_location_id) REFERENCES office_locations(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE devices;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE kiosks (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    secret VARCHAR(64) NOT NULL COMMENT 'Base32 TOTP secret the rotating check-in codes are signed with',
+    office_location_id BIGINT UNSIGNED NULL COMMENT 'Office the kiosk is installed at, only employees of this office may check in on it',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_office_location_id (office_location_id),
+    INDEX idx_is_active (is_active),
+    INDEX idx_deleted_at (deleted_at),
+    CONSTRAINT fk_kiosks_office_location FOREIGN KEY (office_location_id) REFERENCES office_locations(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    ADD COLUMN check_in_kiosk_id BIGINT UNSIGNED NULL COMMENT 'Kiosk whose rotating code was scanned for the first check-in',
+    ADD CONSTRAINT fk_attendances_check_in_kiosk FOREIGN KEY (check_in_kiosk_id) REFERENCES kiosks(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendance_punches
+    ADD COLUMN kiosk_id BIGINT UNSIGNED NULL COMMENT 'Kiosk whose rotating code was scanned for the punch',
+    ADD CONSTRAINT fk_attendance_punches_kiosk FOREIGN KEY (kiosk_id) REFERENCES kiosks(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attendance_punches
+    DROP FOREIGN KEY fk_attendance_punches_kiosk,
+    DROP COLUMN kiosk_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    DROP FOREIGN KEY fk_attendances_check_in_kiosk,
+    DROP COLUMN check_in_kiosk_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE kiosks;
+-- +goose StatementEnd
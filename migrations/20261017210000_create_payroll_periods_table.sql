@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE payroll_periods (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    period_month DATE NOT NULL UNIQUE COMMENT 'First day of the month (YYYY-MM-01), months without a row are open',
+    status ENUM('open', 'locked') NOT NULL DEFAULT 'open' COMMENT 'Attendance and salaries of a locked month cannot be changed',
+    locked_at TIMESTAMP NULL,
+    locked_by BIGINT UNSIGNED NULL COMMENT 'User who locked the period after payroll was paid',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE payroll_period_events (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    payroll_period_id BIGINT UNSIGNED NOT NULL COMMENT 'Foreign key to payroll_periods table',
+    action ENUM('lock', 'unlock') NOT NULL,
+    actor_id BIGINT UNSIGNED NOT NULL COMMENT 'User who locked or unlocked the period',
+    reason TEXT NULL COMMENT 'Why the period was unlocked, required for unlocks',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_payroll_period_created_at (payroll_period_id, created_at),
+    CONSTRAINT fk_payroll_period_events_period FOREIGN KEY (payroll_period_id) REFERENCES payroll_periods(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE payroll_period_events;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE payroll_periods;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendance_pay_policies (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    present_weight DECIMAL(4,3) NOT NULL DEFAULT 1.000 COMMENT 'Share of a day''s pay earned by a present day',
+    late_weight DECIMAL(4,3) NOT NULL DEFAULT 1.000 COMMENT 'Share of a day''s pay earned by a late day',
+    half_day_weight DECIMAL(4,3) NOT NULL DEFAULT 0.500 COMMENT 'Share of a day''s pay earned by a half day',
+    late_penalty_amount DECIMAL(15,2) NOT NULL DEFAULT 0.00 COMMENT 'Fixed deduction for every late day beyond the free late days, 0 disables',
+    late_penalty_free_days INT NOT NULL DEFAULT 0 COMMENT 'Late days per month tolerated before the late penalty applies',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Applied to work schedules without an attendance pay policy',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_is_default (is_default),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE work_schedules
+    ADD COLUMN attendance_pay_policy_id BIGINT UNSIGNED NULL COMMENT 'Pay weights of the attendance statuses, the default policy applies when empty',
+    ADD INDEX idx_attendance_pay_policy_id (attendance_pay_policy_id),
+    ADD CONSTRAINT fk_work_schedules_attendance_pay_policy FOREIGN KEY (attendance_pay_policy_id) REFERENCES attendance_pay_policies(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    ADD COLUMN paid_days DECIMAL(6,3) NOT NULL DEFAULT 0.000 COMMENT 'Present days weighted by the attendance pay policy, the salary is prorated on them' AFTER present_days,
+    ADD COLUMN late_days INT NOT NULL DEFAULT 0 COMMENT 'Number of late working days' AFTER paid_days,
+    ADD COLUMN late_penalty DECIMAL(15,2) NOT NULL DEFAULT 0.00 COMMENT 'Late penalty deducted under the attendance pay policy' AFTER late_days,
+    ADD COLUMN pay_policy TEXT NULL COMMENT 'Attendance pay policies applied in the month (for audit purposes)' AFTER late_penalty;
+-- +goose StatementEnd
+
+-- Salaries calculated before this change paid every present day in full
+-- +goose StatementBegin
+UPDATE salary_calculations SET paid_days = present_days;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    DROP COLUMN pay_policy,
+    DROP COLUMN late_penalty,
+    DROP COLUMN late_days,
+    DROP COLUMN paid_days;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE work_schedules
+    DROP FOREIGN KEY fk_work_schedules_attendance_pay_policy,
+    DROP INDEX idx_attendance_pay_policy_id,
+    DROP COLUMN attendance_pay_policy_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE attendance_pay_policies;
+-- +goose StatementEnd
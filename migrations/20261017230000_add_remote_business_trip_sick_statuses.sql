@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attendances MODIFY COLUMN status ENUM('present', 'absent', 'late', 'half_day', 'leave', 'unpaid_leave', 'remote', 'business_trip', 'sick') NOT NULL DEFAULT 'absent';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances
+    ADD COLUMN status_metadata JSON NULL COMMENT 'Details of the status, e.g. the destination of a business trip or the sick note of a sick day' AFTER status;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attendances DROP COLUMN status_metadata;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE attendances SET status = 'present' WHERE status IN ('remote', 'business_trip');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE attendances SET status = 'leave' WHERE status = 'sick';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances MODIFY COLUMN status ENUM('present', 'absent', 'late', 'half_day', 'leave', 'unpaid_leave') NOT NULL DEFAULT 'absent';
+-- +goose StatementEnd
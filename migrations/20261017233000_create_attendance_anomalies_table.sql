@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendance_anomalies (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    attendance_id BIGINT UNSIGNED NOT NULL,
+    employee_id BIGINT UNSIGNED NOT NULL COMMENT 'Employee whose attendance looks wrong',
+    attendance_date DATE NOT NULL COMMENT 'Date of the attendance, copied for filtering by payroll month',
+    rule ENUM('missing_check_out', 'long_day', 'odd_hour_check_in', 'early_check_out') NOT NULL COMMENT 'Detection rule that found the anomaly',
+    details TEXT NOT NULL COMMENT 'What the rule found, e.g. the length of the day',
+    status ENUM('open', 'resolved') NOT NULL DEFAULT 'open',
+    resolved_by BIGINT UNSIGNED NULL COMMENT 'HR user who resolved the anomaly, empty when the detector found it fixed',
+    resolved_at TIMESTAMP NULL,
+    resolution_notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE CASCADE,
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_attendance_rule (attendance_id, rule),
+    INDEX idx_employee_id (employee_id),
+    INDEX idx_attendance_date (attendance_date),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attendance_anomalies;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attendances
+    ADD COLUMN auto_closed BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'True when the check-out was recorded by the auto check-out job instead of the employee' AFTER location_flagged,
+    ADD INDEX idx_auto_closed (auto_closed);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attendances
+    DROP INDEX idx_auto_closed,
+    DROP COLUMN auto_closed;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendar_feeds (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    scope ENUM('employee', 'department', 'company') NOT NULL COMMENT 'Whose attendance and leave the feed shows, holidays are shown in every feed',
+    employee_id BIGINT UNSIGNED NULL COMMENT 'Employee of an employee feed',
+    department VARCHAR(100) NULL COMMENT 'Department of a department feed',
+    token VARCHAR(64) NOT NULL COMMENT 'Random token in the feed URL, calendar apps cannot send credentials',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    last_accessed_at TIMESTAMP NULL COMMENT 'Last time a calendar app fetched the feed',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    UNIQUE KEY uk_token (token),
+    INDEX idx_scope (scope),
+    INDEX idx_employee_id (employee_id),
+    INDEX idx_department (department),
+    INDEX idx_deleted_at (deleted_at),
+    CONSTRAINT fk_calendar_feeds_employee FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE calendar_feeds;
+-- +goose StatementEnd
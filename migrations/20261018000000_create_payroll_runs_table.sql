@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE payroll_runs (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    period_month DATE NOT NULL COMMENT 'First day of the month (YYYY-MM-01) the run pays',
+    status ENUM('draft', 'calculated', 'reviewed', 'approved', 'paid', 'cancelled') NOT NULL DEFAULT 'draft' COMMENT 'Salary calculations of an approved or paid run cannot be changed',
+    created_by BIGINT UNSIGNED NOT NULL COMMENT 'User who created the run',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_period_month_status (period_month, status),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE payroll_run_events (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    payroll_run_id BIGINT UNSIGNED NOT NULL COMMENT 'Foreign key to payroll_runs table',
+    action ENUM('create', 'calculate', 'review', 'approve', 'pay', 'cancel') NOT NULL,
+    from_status ENUM('draft', 'calculated', 'reviewed', 'approved', 'paid', 'cancelled') NULL COMMENT 'Status of the run before the transition, empty when it was created',
+    to_status ENUM('draft', 'calculated', 'reviewed', 'approved', 'paid', 'cancelled') NOT NULL COMMENT 'Status of the run after the transition',
+    actor_id BIGINT UNSIGNED NOT NULL COMMENT 'User who made the transition',
+    notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_payroll_run_created_at (payroll_run_id, created_at),
+    CONSTRAINT fk_payroll_run_events_run FOREIGN KEY (payroll_run_id) REFERENCES payroll_runs(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    ADD COLUMN payroll_run_id BIGINT UNSIGNED NULL COMMENT 'Payroll run that owns the calculation, empty until a run is created for the month' AFTER employee_id,
+    ADD INDEX idx_payroll_run_id (payroll_run_id),
+    ADD CONSTRAINT fk_salary_calculations_payroll_run FOREIGN KEY (payroll_run_id) REFERENCES payroll_runs(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    DROP FOREIGN KEY fk_salary_calculations_payroll_run,
+    DROP INDEX idx_payroll_run_id,
+    DROP COLUMN payroll_run_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE payroll_run_events;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE payroll_runs;
+-- +goose StatementEnd
@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE salary_components (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE COMMENT 'Stable identifier of the component on payslips and exports, e.g. transport_allowance',
+    name VARCHAR(100) NOT NULL,
+    kind ENUM('earning', 'deduction') NOT NULL COMMENT 'Earnings are added to the salary, deductions are subtracted from it',
+    method ENUM('fixed', 'percentage_of_base', 'per_present_day') NOT NULL COMMENT 'How the amount of the component is calculated every month',
+    amount DECIMAL(15,2) NOT NULL DEFAULT 0.00 COMMENT 'Monthly amount of a fixed component, or the amount per present day',
+    percentage DECIMAL(7,4) NOT NULL DEFAULT 0.0000 COMMENT 'Percentage of the base salary of a percentage_of_base component',
+    description TEXT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE COMMENT 'Inactive components are skipped by salary calculations',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_kind (kind),
+    INDEX idx_is_active (is_active),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE salary_component_assignments (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    salary_component_id BIGINT UNSIGNED NOT NULL COMMENT 'Foreign key to salary_components table',
+    employee_id BIGINT UNSIGNED NULL COMMENT 'Employee the component is paid to or deducted from, empty for a department assignment',
+    department VARCHAR(100) NULL COMMENT 'Department whose employees get the component, an employee assignment of the same component wins',
+    amount DECIMAL(15,2) NULL COMMENT 'Overrides the amount of the component for this assignment',
+    percentage DECIMAL(7,4) NULL COMMENT 'Overrides the percentage of the component for this assignment',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_salary_component_id (salary_component_id),
+    INDEX idx_employee_id (employee_id),
+    INDEX idx_department (department),
+    INDEX idx_deleted_at (deleted_at),
+    CONSTRAINT fk_salary_component_assignments_component FOREIGN KEY (salary_component_id) REFERENCES salary_components(id) ON DELETE CASCADE,
+    CONSTRAINT fk_salary_component_assignments_employee FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE salary_calculation_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    salary_calculation_id BIGINT UNSIGNED NOT NULL COMMENT 'Foreign key to salary_calculations table',
+    salary_component_id BIGINT UNSIGNED NULL COMMENT 'Component the line was calculated from, empty for the prorated base salary, overtime and late penalty',
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    kind ENUM('earning', 'deduction') NOT NULL,
+    amount DECIMAL(15,2) NOT NULL COMMENT 'Amount of the line rounded to whole rupiah',
+    basis TEXT NULL COMMENT 'How the amount was calculated (for audit purposes)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_salary_calculation_id (salary_calculation_id),
+    INDEX idx_salary_component_id (salary_component_id),
+    CONSTRAINT fk_salary_calculation_items_calculation FOREIGN KEY (salary_calculation_id) REFERENCES salary_calculations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_salary_calculation_items_component FOREIGN KEY (salary_component_id) REFERENCES salary_components(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE salary_calculation_items;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE salary_component_assignments;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE salary_components;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN ptkp_status ENUM('TK/0', 'TK/1', 'TK/2', 'TK/3', 'K/0', 'K/1', 'K/2', 'K/3') NOT NULL DEFAULT 'TK/0' COMMENT 'PTKP status for PPh 21, single (TK) or married (K) with the number of dependents' AFTER device_pin,
+    ADD COLUMN npwp VARCHAR(16) NULL COMMENT 'Tax ID (NPWP or NIK), PPh 21 is withheld 20% higher without one' AFTER ptkp_status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    ADD COLUMN taxable_income DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT 'Gross earnings of the month subject to PPh 21, summed by the December true-up' AFTER overtime_pay;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE salary_calculations
+    DROP COLUMN taxable_income;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    DROP COLUMN npwp,
+    DROP COLUMN ptkp_status;
+-- +goose StatementEnd
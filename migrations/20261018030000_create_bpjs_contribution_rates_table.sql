@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE bpjs_contribution_rates (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    program ENUM('kesehatan', 'jht', 'jp', 'jkk', 'jkm') NOT NULL COMMENT 'BPJS Kesehatan or one of the BPJS Ketenagakerjaan programs',
+    risk_class TINYINT NOT NULL DEFAULT 0 COMMENT 'JKK risk class the rate applies to, 0 for the other programs',
+    effective_from DATE NOT NULL COMMENT 'First day the rate applies, it applies until a later row of the program takes over',
+    employer_rate DECIMAL(7,4) NOT NULL DEFAULT 0 COMMENT 'Percentage of the wage paid by the employer',
+    employee_rate DECIMAL(7,4) NOT NULL DEFAULT 0 COMMENT 'Percentage of the wage deducted from the salary',
+    wage_cap DECIMAL(15,2) NULL COMMENT 'Highest monthly wage contributions are calculated from, no cap when empty',
+    notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    INDEX idx_program_risk_class_effective_from (program, risk_class, effective_from),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO bpjs_contribution_rates (program, risk_class, effective_from, employer_rate, employee_rate, wage_cap, notes) VALUES
+    ('kesehatan', 0, '2024-01-01', 4.0000, 1.0000, 12000000.00, 'Perpres 64/2020'),
+    ('jht', 0, '2024-01-01', 3.7000, 2.0000, NULL, 'PP 46/2015'),
+    ('jp', 0, '2024-01-01', 2.0000, 1.0000, 9559600.00, 'PP 45/2015, wage cap of March 2023'),
+    ('jp', 0, '2024-03-01', 2.0000, 1.0000, 10042300.00, 'PP 45/2015, wage cap of March 2024'),
+    ('jp', 0, '2025-03-01', 2.0000, 1.0000, 10547400.00, 'PP 45/2015, wage cap of March 2025'),
+    ('jkk', 1, '2024-01-01', 0.2400, 0.0000, NULL, 'PP 44/2015, very low risk'),
+    ('jkk', 2, '2024-01-01', 0.5400, 0.0000, NULL, 'PP 44/2015, low risk'),
+    ('jkk', 3, '2024-01-01', 0.8900, 0.0000, NULL, 'PP 44/2015, medium risk'),
+    ('jkk', 4, '2024-01-01', 1.2700, 0.0000, NULL, 'PP 44/2015, high risk'),
+    ('jkk', 5, '2024-01-01', 1.7400, 0.0000, NULL, 'PP 44/2015, very high risk'),
+    ('jkm', 0, '2024-01-01', 0.3000, 0.0000, NULL, 'PP 44/2015');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN jkk_risk_class TINYINT NOT NULL DEFAULT 1 COMMENT 'BPJS JKK work accident risk class of the workplace, 1 (very low) to 5 (very high)' AFTER npwp;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculation_items
+    MODIFY COLUMN kind ENUM('earning', 'deduction', 'employer') NOT NULL COMMENT 'Earnings and deductions add up to the final salary, employer lines are paid on top of it';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM salary_calculation_items WHERE kind = 'employer';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE salary_calculation_items
+    MODIFY COLUMN kind ENUM('earning', 'deduction') NOT NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE employees
+    DROP COLUMN jkk_risk_class;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE bpjs_contribution_rates;
+-- +goose StatementEnd
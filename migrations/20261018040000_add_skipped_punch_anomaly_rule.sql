@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attendance_anomalies
+    MODIFY COLUMN rule ENUM('missing_check_out', 'long_day', 'odd_hour_check_in', 'early_check_out', 'skipped_punch') NOT NULL COMMENT 'Detection rule that found the anomaly, skipped_punch is raised by punch imports';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM attendance_anomalies WHERE rule = 'skipped_punch';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendance_anomalies
+    MODIFY COLUMN rule ENUM('missing_check_out', 'long_day', 'odd_hour_check_in', 'early_check_out') NOT NULL COMMENT 'Detection rule that found the anomaly';
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE device_punch_rejections (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    device_id BIGINT UNSIGNED NOT NULL COMMENT 'Terminal that pushed the punch',
+    device_pin VARCHAR(32) NOT NULL COMMENT 'PIN the punch was recorded for on the terminal',
+    punch_time TIMESTAMP NOT NULL COMMENT 'Punch time as read from the terminal clock',
+    punch_state INT NOT NULL DEFAULT 0 COMMENT 'In/out state the terminal recorded',
+    verify_mode INT NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL COMMENT 'Why the punch was not recorded, e.g. an unknown PIN or a locked payroll period',
+    status ENUM('open', 'resolved') NOT NULL DEFAULT 'open',
+    resolved_by BIGINT UNSIGNED NULL COMMENT 'HR user who reviewed the punch',
+    resolved_at TIMESTAMP NULL,
+    resolution_notes TEXT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    modified_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    FOREIGN KEY (device_id) REFERENCES devices(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_device_pin_punch_time (device_id, device_pin, punch_time),
+    INDEX idx_status (status),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE device_punch_rejections;
+-- +goose StatementEnd
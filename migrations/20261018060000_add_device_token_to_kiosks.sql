@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE kiosks
+    ADD COLUMN device_token_hash VARCHAR(64) NULL COMMENT 'SHA-256 of the token the kiosk tablet sends to fetch its codes, empty until a token is issued' AFTER secret;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE kiosks
+    DROP COLUMN device_token_hash;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- A month with more than one run that was not cancelled fails here with a duplicate entry naming the month,
+-- before the table is changed. Cancel the extra runs of that month and migrate again.
+-- +goose StatementBegin
+CREATE TEMPORARY TABLE active_payroll_run_months (
+    period_month DATE PRIMARY KEY
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO active_payroll_run_months (period_month)
+SELECT period_month
+FROM payroll_runs
+WHERE status <> 'cancelled' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TEMPORARY TABLE active_payroll_run_months;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE payroll_runs
+    ADD COLUMN active_month DATE NULL COMMENT 'Period month while the run is not cancelled, its unique index keeps one active run per month' AFTER status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE payroll_runs
+SET active_month = period_month
+WHERE status <> 'cancelled' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE payroll_runs
+    ADD UNIQUE KEY unique_active_month (active_month);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payroll_runs
+    DROP INDEX unique_active_month,
+    DROP COLUMN active_month;
+-- +goose StatementEnd
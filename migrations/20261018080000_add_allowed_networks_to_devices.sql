@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE devices
+    ADD COLUMN allowed_networks JSON NULL COMMENT 'IP addresses or CIDR ranges the terminal may push from, an active terminal without any is refused' AFTER ip_address;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Active terminals keep pushing from the address they were last seen at
+UPDATE devices
+SET allowed_networks = JSON_ARRAY(ip_address)
+WHERE status = 'active' AND ip_address IS NOT NULL AND ip_address <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE devices
+    DROP COLUMN allowed_networks;
+-- +goose StatementEnd